@@ -3,9 +3,14 @@ package handler
 import (
 	"net/http"
 
+	"suaybsimsek.com/blog-api/internal/app"
 	adminavatarhandler "suaybsimsek.com/blog-api/pkg/web/adminavatar"
 )
 
+var handler = app.Handler(func(container *app.Container) http.Handler {
+	return adminavatarhandler.NewHandler(container.Services)
+})
+
 func Handler(w http.ResponseWriter, r *http.Request) {
-	adminavatarhandler.Handler(w, r)
+	handler(w, r)
 }
@@ -3,9 +3,14 @@ package admingraphql
 import (
 	"net/http"
 
+	"suaybsimsek.com/blog-api/internal/app"
 	admingraphqlhandler "suaybsimsek.com/blog-api/pkg/web/admingraphql"
 )
 
+var handler = app.Handler(func(container *app.Container) http.Handler {
+	return admingraphqlhandler.NewHandler(container.Services)
+})
+
 func Handler(w http.ResponseWriter, r *http.Request) {
-	admingraphqlhandler.Handler(w, r)
+	handler(w, r)
 }
@@ -0,0 +1,16 @@
+package handler
+
+import (
+	"net/http"
+
+	"suaybsimsek.com/blog-api/internal/app"
+	adminmediaupload "suaybsimsek.com/blog-api/pkg/web/adminmediaupload"
+)
+
+var handler = app.Handler(func(container *app.Container) http.Handler {
+	return adminmediaupload.NewHandler(container.Services)
+})
+
+func Handler(w http.ResponseWriter, r *http.Request) {
+	handler(w, r)
+}
@@ -3,9 +3,14 @@ package googlecallback
 import (
 	"net/http"
 
+	"suaybsimsek.com/blog-api/internal/app"
 	oauthcallback "suaybsimsek.com/blog-api/pkg/web/oauthcallback"
 )
 
+var handler = app.Handler(func(container *app.Container) http.Handler {
+	return oauthcallback.NewGoogleHandler(container.Services)
+})
+
 func Handler(w http.ResponseWriter, r *http.Request) {
-	oauthcallback.GoogleHandler(w, r)
+	handler(w, r)
 }
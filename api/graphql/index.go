@@ -3,9 +3,14 @@ package handler
 import (
 	"net/http"
 
+	"suaybsimsek.com/blog-api/internal/app"
 	graphhandler "suaybsimsek.com/blog-api/pkg/web/graphql"
 )
 
+var handler = app.Handler(func(container *app.Container) http.Handler {
+	return graphhandler.NewHandler(container.Services)
+})
+
 func Handler(w http.ResponseWriter, r *http.Request) {
-	graphhandler.Handler(w, r)
+	handler(w, r)
 }
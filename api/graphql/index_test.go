@@ -9,6 +9,8 @@ import (
 
 func TestHandlerDelegatesToGraphQLHandler(t *testing.T) {
 	t.Setenv("API_CORS_ORIGIN", "http://localhost:3000")
+	t.Setenv("STORAGE", "memory")
+	t.Setenv("STORAGE_CONTENT_DIR", "../../content")
 
 	request := httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewBufferString(`{"query":"query { __typename }"}`))
 	request.Header.Set("Content-Type", "application/json")
@@ -0,0 +1,11 @@
+package handler
+
+import (
+	"net/http"
+
+	jwkshandler "suaybsimsek.com/blog-api/pkg/web/jwks"
+)
+
+func Handler(w http.ResponseWriter, r *http.Request) {
+	jwkshandler.Handler(w, r)
+}
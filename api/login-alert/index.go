@@ -0,0 +1,16 @@
+package loginalert
+
+import (
+	"net/http"
+
+	"suaybsimsek.com/blog-api/internal/app"
+	loginalerthandler "suaybsimsek.com/blog-api/pkg/web/loginalert"
+)
+
+var handler = app.Handler(func(container *app.Container) http.Handler {
+	return loginalerthandler.NewHandler(container.Services)
+})
+
+func Handler(w http.ResponseWriter, r *http.Request) {
+	handler(w, r)
+}
@@ -3,9 +3,14 @@ package media
 import (
 	"net/http"
 
+	"suaybsimsek.com/blog-api/internal/app"
 	mediaassethandler "suaybsimsek.com/blog-api/pkg/web/mediaasset"
 )
 
+var handler = app.Handler(func(container *app.Container) http.Handler {
+	return mediaassethandler.NewHandler(container.Services)
+})
+
 func Handler(w http.ResponseWriter, r *http.Request) {
-	mediaassethandler.Handler(w, r)
+	handler(w, r)
 }
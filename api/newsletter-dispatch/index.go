@@ -3,9 +3,14 @@ package handler
 import (
 	"net/http"
 
+	"suaybsimsek.com/blog-api/internal/app"
 	dispatchhandler "suaybsimsek.com/blog-api/pkg/web/newsletterdispatch"
 )
 
+var handler = app.Handler(func(container *app.Container) http.Handler {
+	return dispatchhandler.NewHandler(container.Mongo)
+})
+
 func Handler(w http.ResponseWriter, r *http.Request) {
-	dispatchhandler.Handler(w, r)
+	handler(w, r)
 }
@@ -4,13 +4,42 @@ import (
 	"net/http"
 	"strings"
 
+	"suaybsimsek.com/blog-api/internal/app"
+	appservice "suaybsimsek.com/blog-api/internal/service"
 	admingithubhandler "suaybsimsek.com/blog-api/pkg/web/admingithub"
 	admingooglehandler "suaybsimsek.com/blog-api/pkg/web/admingoogle"
+	oidcauthhandler "suaybsimsek.com/blog-api/pkg/web/oidcauth"
 	readergithubhandler "suaybsimsek.com/blog-api/pkg/web/readergithub"
 	readergooglehandler "suaybsimsek.com/blog-api/pkg/web/readergoogle"
 )
 
+var handler = app.Handler(func(container *app.Container) http.Handler {
+	return newStartHandler(container.Services)
+})
+
 func Handler(w http.ResponseWriter, r *http.Request) {
+	handler(w, r)
+}
+
+func newStartHandler(services *appservice.Service) http.HandlerFunc {
+	starts := map[string]http.HandlerFunc{
+		"google:admin":  admingooglehandler.NewStartHandler(services),
+		"google:reader": readergooglehandler.NewStartHandler(services),
+		"github:admin":  admingithubhandler.NewStartHandler(services),
+		"github:reader": readergithubhandler.NewStartHandler(services),
+	}
+	oidcStart := oidcauthhandler.NewStartHandler(services)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if start, ok := starts[resolveStartKey(r)]; ok {
+			start(w, r)
+			return
+		}
+		oidcStart(w, r)
+	}
+}
+
+func resolveStartKey(r *http.Request) string {
 	provider := strings.TrimSpace(strings.ToLower(r.URL.Query().Get("provider")))
 	flow := strings.TrimSpace(strings.ToLower(r.URL.Query().Get("flow")))
 
@@ -23,16 +52,5 @@ func Handler(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	switch provider + ":" + flow {
-	case "google:admin":
-		admingooglehandler.Start(w, r)
-	case "google:reader":
-		readergooglehandler.Start(w, r)
-	case "github:admin":
-		admingithubhandler.Start(w, r)
-	case "github:reader":
-		readergithubhandler.Start(w, r)
-	default:
-		http.NotFound(w, r)
-	}
+	return provider + ":" + flow
 }
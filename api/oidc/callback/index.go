@@ -0,0 +1,16 @@
+package oidccallback
+
+import (
+	"net/http"
+
+	"suaybsimsek.com/blog-api/internal/app"
+	oidcauth "suaybsimsek.com/blog-api/pkg/web/oidcauth"
+)
+
+var handler = app.Handler(func(container *app.Container) http.Handler {
+	return oidcauth.NewHandler(container.Services)
+})
+
+func Handler(w http.ResponseWriter, r *http.Request) {
+	handler(w, r)
+}
@@ -3,9 +3,14 @@ package readerauth
 import (
 	"net/http"
 
+	"suaybsimsek.com/blog-api/internal/app"
 	readerauthhandler "suaybsimsek.com/blog-api/pkg/web/readerauth"
 )
 
+var handler = app.Handler(func(container *app.Container) http.Handler {
+	return readerauthhandler.NewHandler(container.Services)
+})
+
 func Handler(w http.ResponseWriter, r *http.Request) {
-	readerauthhandler.Handler(w, r)
+	handler(w, r)
 }
@@ -2,21 +2,33 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"log/slog"
 	"net/http"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"strings"
+	"syscall"
+	"time"
 
 	adminavatarapi "suaybsimsek.com/blog-api/api/admin-avatar"
 	admingraphqlapi "suaybsimsek.com/blog-api/api/admin-graphql"
+	adminmediauploadapi "suaybsimsek.com/blog-api/api/admin-media-upload"
+	contentschedulerapi "suaybsimsek.com/blog-api/api/content-scheduler"
 	githubcallbackapi "suaybsimsek.com/blog-api/api/github/callback"
 	googlecallbackapi "suaybsimsek.com/blog-api/api/google/callback"
 	graphqlapi "suaybsimsek.com/blog-api/api/graphql"
+	jwksapi "suaybsimsek.com/blog-api/api/jwks"
+	loginalertapi "suaybsimsek.com/blog-api/api/login-alert"
 	mediaapi "suaybsimsek.com/blog-api/api/media"
+	mediagcapi "suaybsimsek.com/blog-api/api/media-gc"
 	newsletterdispatch "suaybsimsek.com/blog-api/api/newsletter-dispatch"
 	oauthconnectapi "suaybsimsek.com/blog-api/api/oauth/connect"
+	oidccallbackapi "suaybsimsek.com/blog-api/api/oidc/callback"
+	postredirectapi "suaybsimsek.com/blog-api/api/post-redirect"
 	readerauthapi "suaybsimsek.com/blog-api/api/reader-auth"
+	"suaybsimsek.com/blog-api/internal/app"
 	appconfig "suaybsimsek.com/blog-api/internal/config"
 )
 
@@ -51,28 +63,64 @@ func loadDotEnv(path string) {
 	}
 }
 
+const shutdownTimeout = 10 * time.Second
+
 func main() {
 	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, nil)))
 
 	loadDotEnv(filepath.Join(".", ".env.local"))
+	if err := run(); err != nil {
+		slog.Error("local go api terminated", "error", err)
+		os.Exit(1)
+	}
+}
+
+// run serves the API until SIGINT or SIGTERM, then drains the open requests and releases the container's pool.
+func run() error {
 	httpConfig := appconfig.ResolveHTTPConfig()
 
+	container, err := app.Init()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		closeCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := container.Close(closeCtx); err != nil {
+			slog.Error("application container close failed", "error", err)
+		}
+	}()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	mux := http.NewServeMux()
 	mux.HandleFunc("/graphql", graphqlapi.Handler)
 	mux.HandleFunc("/api/graphql", graphqlapi.Handler)
 	mux.HandleFunc("/api/admin/graphql", admingraphqlapi.Handler)
+	mux.HandleFunc("/api/admin/media-uploads", adminmediauploadapi.Handler)
+	mux.HandleFunc("/api/admin/media-uploads/", adminmediauploadapi.Handler)
 	mux.HandleFunc("/api/admin-avatar", adminavatarapi.Handler)
 	mux.HandleFunc("/api/oauth/connect", oauthconnectapi.Handler)
 	mux.HandleFunc("/api/github/connect", oauthconnectapi.Handler)
 	mux.HandleFunc("/api/github/callback", githubcallbackapi.Handler)
 	mux.HandleFunc("/api/google/connect", oauthconnectapi.Handler)
 	mux.HandleFunc("/api/google/callback", googlecallbackapi.Handler)
+	mux.HandleFunc("/api/oidc/callback", oidccallbackapi.Handler)
+	mux.HandleFunc("/api/login-alert", loginalertapi.Handler)
 	mux.HandleFunc("/api/media", mediaapi.Handler)
 	mux.HandleFunc("/api/media/", mediaapi.Handler)
+	mux.HandleFunc("/api/post-redirect", postredirectapi.Handler)
+	mux.HandleFunc("/api/post-redirect/", postredirectapi.Handler)
 	mux.HandleFunc("/api/reader-auth/session", readerauthapi.Handler)
 	mux.HandleFunc("/api/reader-auth/logout", readerauthapi.Handler)
+	mux.HandleFunc("/api/reader-auth/unlink", readerauthapi.Handler)
 	mux.HandleFunc("/graphiql", graphqlapi.Handler)
 	mux.HandleFunc("/api/newsletter-dispatch", newsletterdispatch.Handler)
+	mux.HandleFunc("/api/content-scheduler", contentschedulerapi.Handler)
+	mux.HandleFunc("/api/media-gc", mediagcapi.Handler)
+	mux.HandleFunc("/api/jwks", jwksapi.Handler)
+	mux.HandleFunc("/.well-known/jwks.json", jwksapi.Handler)
 	mux.HandleFunc("/health", func(w http.ResponseWriter, _ *http.Request) {
 		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
 		_, _ = w.Write([]byte("ok"))
@@ -84,9 +132,23 @@ func main() {
 		ReadHeaderTimeout: httpConfig.ReadHeaderTimeout,
 	}
 
+	container.Services.StartAdminContentScheduler(ctx)
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.ListenAndServe()
+	}()
+
 	slog.Info("local go api listening", "url", "http://localhost:"+httpConfig.LocalPort)
-	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-		slog.Error("local go api terminated", "error", err)
-		os.Exit(1)
+	select {
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
 	}
+
+	slog.Info("local go api shutting down")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	return server.Shutdown(shutdownCtx)
 }
@@ -0,0 +1,175 @@
+// Package app wires the process-wide dependencies shared by the local server and the serverless entrypoints.
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/repository"
+	"suaybsimsek.com/blog-api/internal/service"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Container owns the pooled MongoDB client of the process, the repositories built on it and the Service running on
+// them. With STORAGE=memory it owns an in-memory store instead, and Mongo stays nil.
+type Container struct {
+	Database     appconfig.DatabaseConfig
+	Mongo        *mongo.Client
+	Memory       *repository.MemoryStore
+	Repositories service.Dependencies
+	Services     *service.Service
+}
+
+var (
+	defaultContainer     *Container
+	defaultContainerErr  error
+	defaultContainerOnce sync.Once
+)
+
+// New connects a MongoDB client with the pool, read preference and write concern from DatabaseConfig, builds the
+// repositories on it and the Service the handlers run on. With STORAGE=memory it skips MongoDB and seeds an
+// in-memory store from the markdown content tree instead.
+func New(ctx context.Context) (*Container, error) {
+	storageConfig := appconfig.ResolveStorageConfig()
+	if storageConfig.Backend == appconfig.StorageMemory {
+		return newMemoryContainer(ctx, storageConfig)
+	}
+
+	databaseConfig, err := appconfig.ResolveDatabaseConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := appconfig.NewMongoClient(ctx, databaseConfig, repository.MongoAppName)
+	if err != nil {
+		return nil, fmt.Errorf("mongodb connect failed: %w", err)
+	}
+
+	repositories := newServiceDependencies(client)
+	container := &Container{
+		Database:     databaseConfig,
+		Mongo:        client,
+		Repositories: repositories,
+		Services:     service.New(repositories),
+	}
+
+	return container, nil
+}
+
+func newMemoryContainer(ctx context.Context, storageConfig appconfig.StorageConfig) (*Container, error) {
+	store := repository.NewMemoryStore()
+	if err := repository.SeedMemoryStore(store, storageConfig.ContentDir); err != nil {
+		return nil, fmt.Errorf("memory store seed failed: %w", err)
+	}
+
+	repositories := newMemoryServiceDependencies(store)
+	container := &Container{
+		Memory:       store,
+		Repositories: repositories,
+		Services:     service.New(repositories),
+	}
+	// The seed only writes posts, so related posts are computed here like after an admin write.
+	if err := container.Services.RefreshAllRelatedPosts(ctx); err != nil {
+		return nil, fmt.Errorf("memory store related posts failed: %w", err)
+	}
+
+	return container, nil
+}
+
+// Init builds the process-wide container on first use and returns it. Entrypoints call it on every request through
+// Handler, so a serverless instance connects once per cold start. A failure is kept and returned on every call.
+func Init() (*Container, error) {
+	defaultContainerOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		defaultContainer, defaultContainerErr = New(ctx)
+		if defaultContainerErr != nil {
+			slog.Error("application container init failed", "error", defaultContainerErr)
+		}
+	})
+
+	return defaultContainer, defaultContainerErr
+}
+
+// Close disconnects the MongoDB client. A memory container has nothing to release.
+func (container *Container) Close(ctx context.Context) error {
+	if container == nil || container.Mongo == nil {
+		return nil
+	}
+
+	return container.Mongo.Disconnect(ctx)
+}
+
+// MongoDatabase returns the configured database on the pooled client, for scripts that work on collections directly.
+// A memory container has no database and returns repository.ErrMongoClientUnavailable.
+func (container *Container) MongoDatabase() (*mongo.Database, error) {
+	if container == nil || container.Mongo == nil {
+		return nil, repository.ErrMongoClientUnavailable
+	}
+
+	return container.Mongo.Database(container.Database.Name), nil
+}
+
+func newServiceDependencies(client *mongo.Client) service.Dependencies {
+	comments := repository.NewCommentRepository(client)
+
+	return service.Dependencies{
+		AdminAccessTokens:   repository.NewAdminAccessTokenRepository(client),
+		AdminAuditLogs:      repository.NewAdminAuditLogRepository(client),
+		AdminAvatars:        repository.NewAdminAvatarRepository(client),
+		AdminContent:        repository.NewAdminContentRepository(client),
+		AdminDashboard:      repository.NewAdminDashboardMongoRepository(client),
+		AdminLoginAttempts:  repository.NewAdminLoginAttemptRepository(client),
+		AdminMediaAssets:    repository.NewAdminMediaAssetRepository(client),
+		AdminMediaUploads:   repository.NewAdminMediaUploadSessionRepository(client),
+		AdminNewsletter:     repository.NewAdminNewsletterRepository(client),
+		AdminPasskeys:       repository.NewAdminPasskeyRepository(client),
+		AdminRefreshTokens:  repository.NewAdminRefreshTokenMongoRepository(client),
+		AdminUsers:          repository.NewAdminUserRepository(client),
+		Comments:            comments,
+		ErrorMessages:       repository.NewErrorMessageRepository(client),
+		LoginHistory:        repository.NewLoginHistoryRepository(client),
+		MediaBlobStores:     repository.NewMediaBlobStores(client),
+		Newsletter:          repository.NewNewsletterMongoRepository(client),
+		OIDCIdentities:      repository.NewOIDCIdentityRepository(client),
+		Posts:               repository.NewPostMongoRepository(client),
+		RateLimits:          repository.NewRateLimitRepository(client),
+		ReaderPostLikes:     repository.NewReaderPostLikeRepository(client),
+		ReaderRefreshTokens: repository.NewReaderRefreshTokenMongoRepository(client),
+		ReaderUsers:         repository.NewReaderUserRepository(client),
+	}
+}
+
+func newMemoryServiceDependencies(store *repository.MemoryStore) service.Dependencies {
+	return service.Dependencies{
+		AdminAccessTokens:   repository.NewAdminAccessTokenMemoryRepository(store),
+		AdminAuditLogs:      repository.NewAdminAuditLogMemoryRepository(store),
+		AdminAvatars:        repository.NewAdminAvatarMemoryRepository(store),
+		AdminContent:        repository.NewAdminContentMemoryRepository(store),
+		AdminDashboard:      repository.NewAdminDashboardMemoryRepository(store),
+		AdminLoginAttempts:  repository.NewAdminLoginAttemptMemoryRepository(store),
+		AdminMediaAssets:    repository.NewAdminMediaAssetMemoryRepository(store),
+		AdminMediaUploads:   repository.NewAdminMediaUploadSessionMemoryRepository(store),
+		AdminNewsletter:     repository.NewAdminNewsletterMemoryRepository(store),
+		AdminPasskeys:       repository.NewAdminPasskeyMemoryRepository(store),
+		AdminRefreshTokens:  repository.NewAdminRefreshTokenMemoryRepository(store),
+		AdminUsers:          repository.NewAdminUserMemoryRepository(store),
+		Comments:            repository.NewCommentMemoryRepository(store),
+		ErrorMessages:       repository.NewErrorMessageMemoryRepository(store),
+		LoginHistory:        repository.NewLoginHistoryMemoryRepository(store),
+		MediaBlobStores:     repository.NewMediaBlobStores(nil),
+		Newsletter:          repository.NewNewsletterMemoryRepository(store),
+		OIDCIdentities:      repository.NewOIDCIdentityMemoryRepository(store),
+		Posts:               repository.NewPostMemoryRepository(store),
+		RateLimits:          repository.NewRateLimitMemoryRepository(store),
+		ReaderPostLikes:     repository.NewReaderPostLikeMemoryRepository(store),
+		ReaderRefreshTokens: repository.NewReaderRefreshTokenMemoryRepository(store),
+		ReaderUsers:         repository.NewReaderUserMemoryRepository(store),
+	}
+}
@@ -0,0 +1,117 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestNew(t *testing.T) {
+	t.Setenv("MONGODB_URI", "")
+	t.Setenv("MONGODB_DATABASE", "")
+	if _, err := New(context.Background()); err == nil {
+		t.Fatal("expected an error without database configuration")
+	}
+
+	t.Setenv("MONGODB_URI", "mongodb://localhost:27017")
+	t.Setenv("MONGODB_DATABASE", "blog")
+	t.Setenv("MONGODB_MAX_POOL_SIZE", "5")
+	container, err := New(context.Background())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	t.Cleanup(func() {
+		_ = container.Close(context.Background())
+	})
+
+	if container.Mongo == nil || container.Database.Name != "blog" || container.Database.MaxPoolSize != 5 {
+		t.Fatalf("unexpected container %#v", container)
+	}
+	if container.Repositories.Posts == nil || container.Repositories.Comments == nil || container.Services == nil {
+		t.Fatalf("expected repositories and services to be built, got %#v", container)
+	}
+
+	if err := (*Container)(nil).Close(context.Background()); err != nil {
+		t.Fatalf("nil Close() error = %v", err)
+	}
+}
+
+func TestNewWithMemoryStorage(t *testing.T) {
+	t.Setenv("STORAGE", "memory")
+	t.Setenv("STORAGE_CONTENT_DIR", "../../content")
+	t.Setenv("MONGODB_URI", "")
+	t.Setenv("MONGODB_DATABASE", "")
+
+	container, err := New(context.Background())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if container.Mongo != nil || container.Memory == nil {
+		t.Fatalf("unexpected memory container %#v", container)
+	}
+	if err := container.Close(context.Background()); err != nil {
+		t.Fatalf("memory Close() error = %v", err)
+	}
+
+	total, err := container.Repositories.Posts.CountPosts(context.Background(), bson.M{"locale": "en"})
+	if err != nil || total == 0 {
+		t.Fatalf("expected the content tree to be seeded, got %d, %v", total, err)
+	}
+
+	posts, err := container.Repositories.Posts.FindPosts(context.Background(), bson.M{"locale": "en"}, "desc", 0, 0)
+	if err != nil {
+		t.Fatalf("FindPosts() error = %v", err)
+	}
+	withRelated := 0
+	for _, post := range posts {
+		related, err := container.Repositories.Posts.FindRelatedPosts(context.Background(), "en", post.ID)
+		if err == nil && related != nil && len(related.Items) > 0 {
+			withRelated++
+		}
+	}
+	if withRelated == 0 {
+		t.Fatal("expected related posts to be computed after the seed")
+	}
+
+	t.Setenv("STORAGE_CONTENT_DIR", "container_test.go")
+	if _, err := New(context.Background()); err == nil {
+		t.Fatal("expected an error when the content tree cannot be read")
+	}
+}
+
+func TestHandlerRejectsRequestsWhenInitFails(t *testing.T) {
+	resetDefaultContainer := func() {
+		defaultContainer, defaultContainerErr, defaultContainerOnce = nil, nil, sync.Once{}
+	}
+	resetDefaultContainer()
+	t.Cleanup(resetDefaultContainer)
+	t.Setenv("STORAGE", "")
+	t.Setenv("MONGODB_URI", "")
+	t.Setenv("MONGODB_DATABASE", "")
+
+	built := false
+	handler := Handler(func(*Container) http.Handler {
+		built = true
+		return http.NotFoundHandler()
+	})
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, "/api/graphql", nil))
+	if recorder.Code != http.StatusInternalServerError || built {
+		t.Fatalf("Handler() status = %d, built = %t; want 500 without building the handler", recorder.Code, built)
+	}
+
+	resetDefaultContainer()
+	t.Setenv("STORAGE", "memory")
+	t.Setenv("STORAGE_CONTENT_DIR", "../../content")
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, "/api/graphql", nil))
+	if recorder.Code != http.StatusNotFound || !built {
+		t.Fatalf("Handler() status = %d, built = %t; want the built handler to serve", recorder.Code, built)
+	}
+}
@@ -0,0 +1,32 @@
+package app
+
+import (
+	"net/http"
+	"sync"
+
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpapi"
+)
+
+// Handler turns build into the handler of an entrypoint. The first request builds the process container with Init and
+// the handler from it. While Init fails, every request gets a 500 and build never runs, so no handler serves requests
+// without its repositories.
+func Handler(build func(*Container) http.Handler) http.HandlerFunc {
+	var (
+		handler     http.Handler
+		handlerOnce sync.Once
+	)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		container, err := Init()
+		if err != nil {
+			httpapi.WriteErrorWithContext(r.Context(), w, apperrors.Internal("application unavailable", err))
+			return
+		}
+
+		handlerOnce.Do(func() {
+			handler = build(container)
+		})
+		handler.ServeHTTP(w, r)
+	}
+}
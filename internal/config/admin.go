@@ -18,6 +18,9 @@ const (
 
 type AdminConfig struct {
 	JWTSecret          string
+	JWTAlgorithm       string
+	JWTKeys            string
+	JWTSigningKeyID    string
 	AccessCookieName   string
 	RefreshCookieName  string
 	CSRFCookieName     string
@@ -57,6 +60,9 @@ func ResolveAdminConfig() AdminConfig {
 
 	return AdminConfig{
 		JWTSecret:          strings.TrimSpace(getenv("JWT_SECRET")),
+		JWTAlgorithm:       resolveJWTAlgorithm("ADMIN_JWT_ALGORITHM"),
+		JWTKeys:            ResolveJWTKeys(),
+		JWTSigningKeyID:    resolveJWTSigningKeyID("ADMIN_JWT_SIGNING_KEY_ID"),
 		AccessCookieName:   accessCookieName,
 		RefreshCookieName:  refreshCookieName,
 		CSRFCookieName:     csrfCookieName,
@@ -83,6 +89,11 @@ func resolveDurationEnv(name string, fallback time.Duration) time.Duration {
 	return parsed
 }
 
+// JWTConfigured reports whether admin tokens can be issued with the selected algorithm.
+func (config AdminConfig) JWTConfigured() bool {
+	return jwtConfigured(config.JWTSecret, config.JWTAlgorithm, config.JWTKeys)
+}
+
 func resolveAdminSecureCookie() bool {
 	if value, ok := resolveOptionalBoolEnv("COOKIE_SECURE"); ok {
 		return value
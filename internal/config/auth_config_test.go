@@ -1,6 +1,7 @@
 package config
 
 import (
+	"strings"
 	"testing"
 	"time"
 )
@@ -40,6 +41,34 @@ func TestResolveAdminAndReaderConfigs(t *testing.T) {
 	if readerCfg.AccessCookieName != "reader_access_custom" || readerCfg.RefreshTTL != 72*time.Hour || !readerCfg.SecureCookies {
 		t.Fatalf("unexpected reader cookie/ttl config: %#v", readerCfg)
 	}
+	if adminCfg.JWTAlgorithm != DefaultJWTAlgorithm || !adminCfg.JWTConfigured() {
+		t.Fatalf("expected HS256 by default: %#v", adminCfg)
+	}
+}
+
+func TestResolveJWTAlgorithmAndKeys(t *testing.T) {
+	t.Setenv("JWT_SECRET", "")
+	t.Setenv("JWT_ALGORITHM", "EdDSA")
+	t.Setenv("READER_JWT_ALGORITHM", "ES256")
+	t.Setenv("JWT_SIGNING_KEY_ID", "shared")
+	t.Setenv("ADMIN_JWT_SIGNING_KEY_ID", "admin-key")
+
+	adminCfg := ResolveAdminConfig()
+	readerCfg := ResolveReaderConfig()
+	if adminCfg.JWTAlgorithm != "EdDSA" || adminCfg.JWTSigningKeyID != "admin-key" {
+		t.Fatalf("unexpected admin jwt config: %#v", adminCfg)
+	}
+	if readerCfg.JWTAlgorithm != "ES256" || readerCfg.JWTSigningKeyID != "shared" {
+		t.Fatalf("unexpected reader jwt config: %#v", readerCfg)
+	}
+	if adminCfg.JWTConfigured() {
+		t.Fatal("expected EdDSA without JWT_KEYS to be unconfigured")
+	}
+
+	t.Setenv("JWT_KEYS", `{"keys":[]}`)
+	if !ResolveAdminConfig().JWTConfigured() {
+		t.Fatal("expected EdDSA with JWT_KEYS to be configured")
+	}
 }
 
 func TestResolveOAuthConfigs(t *testing.T) {
@@ -57,3 +86,40 @@ func TestResolveOAuthConfigs(t *testing.T) {
 		t.Fatal("expected oauth configs to be enabled")
 	}
 }
+
+func TestResolveOIDCProviders(t *testing.T) {
+	t.Setenv("OIDC_PROVIDERS", "GitLab, azure-ad")
+	t.Setenv("OIDC_GITLAB_ISSUER", "https://gitlab.com/")
+	t.Setenv("OIDC_GITLAB_CLIENT_ID", "gitlab-id")
+	t.Setenv("OIDC_GITLAB_CLIENT_SECRET", "gitlab-secret")
+	t.Setenv("OIDC_GITLAB_NAME", "GitLab")
+	t.Setenv("OIDC_AZURE_AD_ISSUER", "https://login.microsoftonline.com/tenant/v2.0")
+	t.Setenv("OIDC_AZURE_AD_CLIENT_ID", "azure-id")
+	t.Setenv("OIDC_AZURE_AD_SCOPES", "email,profile")
+
+	providers, err := ResolveOIDCProviders()
+	if err != nil {
+		t.Fatalf("ResolveOIDCProviders returned error: %v", err)
+	}
+	if len(providers) != 2 || providers[0].ID != "gitlab" || providers[0].Issuer != "https://gitlab.com" || providers[0].Name != "GitLab" {
+		t.Fatalf("unexpected providers %#v", providers)
+	}
+	if providers[1].Name != "azure-ad" || strings.Join(providers[1].Scopes, " ") != "openid email profile" {
+		t.Fatalf("unexpected azure provider %#v", providers[1])
+	}
+
+	provider, found, err := ResolveOIDCProvider("azure-ad")
+	if err != nil || !found || provider.ClientID != "azure-id" {
+		t.Fatalf("ResolveOIDCProvider() = %#v, %v, %v", provider, found, err)
+	}
+	if _, found, _ := ResolveOIDCProvider("keycloak"); found {
+		t.Fatal("expected unknown provider to be missing")
+	}
+
+	for _, invalid := range []string{"google", "gitlab,gitlab", "Bad_ID", "keycloak"} {
+		t.Setenv("OIDC_PROVIDERS", invalid)
+		if _, err := ResolveOIDCProviders(); err == nil {
+			t.Fatalf("expected %q to be rejected", invalid)
+		}
+	}
+}
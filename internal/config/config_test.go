@@ -125,3 +125,54 @@ func TestDatabaseHelpers(t *testing.T) {
 		t.Fatalf("BuildMongoClientOptions().ServerSelectionTimeout = %#v", clientOptions.ServerSelectionTimeout)
 	}
 }
+
+func TestDatabasePoolSettings(t *testing.T) {
+	t.Setenv("MONGODB_DATABASE", "blog")
+	t.Setenv("MONGODB_URI", "mongodb://localhost:27017/?maxPoolSize=20&readPreference=secondary")
+
+	cfg, err := ResolveDatabaseConfig()
+	if err != nil {
+		t.Fatalf("ResolveDatabaseConfig() error = %v", err)
+	}
+	if cfg.MaxPoolSize != 0 || cfg.MinPoolSize != 0 || cfg.ReadPreference != "" || cfg.WriteConcern != "" {
+		t.Fatalf("expected unset pool settings, got %#v", cfg)
+	}
+	clientOptions := BuildMongoClientOptions(cfg, "")
+	if clientOptions.MaxPoolSize == nil || *clientOptions.MaxPoolSize != 20 || clientOptions.ReadPreference == nil ||
+		clientOptions.ReadPreference.Mode().String() != "secondary" {
+		t.Fatalf("expected the URI settings to be kept, got %#v", clientOptions)
+	}
+
+	t.Setenv("MONGODB_MAX_POOL_SIZE", "8")
+	t.Setenv("MONGODB_MIN_POOL_SIZE", "12")
+	t.Setenv("MONGODB_READ_PREFERENCE", "nearest")
+	t.Setenv("MONGODB_WRITE_CONCERN", "Majority")
+	cfg, err = ResolveDatabaseConfig()
+	if err != nil {
+		t.Fatalf("ResolveDatabaseConfig() error = %v", err)
+	}
+	clientOptions = BuildMongoClientOptions(cfg, "")
+	if *clientOptions.MaxPoolSize != 8 || clientOptions.MinPoolSize == nil || *clientOptions.MinPoolSize != 8 {
+		t.Fatalf("unexpected pool sizes %v %v", clientOptions.MaxPoolSize, clientOptions.MinPoolSize)
+	}
+	if clientOptions.ReadPreference.Mode().String() != "nearest" {
+		t.Fatalf("unexpected read preference %v", clientOptions.ReadPreference)
+	}
+	if clientOptions.WriteConcern == nil || clientOptions.WriteConcern.W != "majority" {
+		t.Fatalf("unexpected write concern %#v", clientOptions.WriteConcern)
+	}
+
+	t.Setenv("MONGODB_MAX_POOL_SIZE", "-1")
+	t.Setenv("MONGODB_READ_PREFERENCE", "fastest")
+	t.Setenv("MONGODB_WRITE_CONCERN", "2")
+	cfg, err = ResolveDatabaseConfig()
+	if err != nil {
+		t.Fatalf("ResolveDatabaseConfig() error = %v", err)
+	}
+	if cfg.MaxPoolSize != 0 || cfg.ReadPreference != "" || cfg.WriteConcern != "2" {
+		t.Fatalf("unexpected config %#v", cfg)
+	}
+	if concern := BuildMongoClientOptions(cfg, "").WriteConcern; concern == nil || concern.W != 2 {
+		t.Fatalf("unexpected write concern %#v", concern)
+	}
+}
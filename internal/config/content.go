@@ -0,0 +1,24 @@
+package config
+
+import "time"
+
+const (
+	DefaultContentSchedulerInterval  = time.Minute
+	DefaultContentSchedulerBatchSize = 50
+)
+
+type ContentSchedulerConfig struct {
+	Enabled          bool
+	Interval         time.Duration
+	BatchSize        int
+	NotifyNewsletter bool
+}
+
+func ResolveContentSchedulerConfig() ContentSchedulerConfig {
+	return ContentSchedulerConfig{
+		Enabled:          resolveBoolEnv("CONTENT_SCHEDULER_ENABLED", true),
+		Interval:         resolveDurationEnv("CONTENT_SCHEDULER_INTERVAL", DefaultContentSchedulerInterval),
+		BatchSize:        ResolvePositiveIntEnv("CONTENT_SCHEDULER_BATCH_SIZE", DefaultContentSchedulerBatchSize),
+		NotifyNewsletter: resolveBoolEnv("CONTENT_SCHEDULER_NOTIFY_NEWSLETTER", false),
+	}
+}
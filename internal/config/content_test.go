@@ -0,0 +1,61 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestResolveContentSchedulerConfig(t *testing.T) {
+	t.Run("uses defaults", func(t *testing.T) {
+		cfg := ResolveContentSchedulerConfig()
+
+		if !cfg.Enabled {
+			t.Fatal("expected scheduler to be enabled by default")
+		}
+		if cfg.Interval != DefaultContentSchedulerInterval {
+			t.Fatalf("Interval = %s", cfg.Interval)
+		}
+		if cfg.BatchSize != DefaultContentSchedulerBatchSize {
+			t.Fatalf("BatchSize = %d", cfg.BatchSize)
+		}
+		if cfg.NotifyNewsletter {
+			t.Fatal("expected newsletter notification to be disabled by default")
+		}
+	})
+
+	t.Run("uses configured values", func(t *testing.T) {
+		t.Setenv("CONTENT_SCHEDULER_ENABLED", "false")
+		t.Setenv("CONTENT_SCHEDULER_INTERVAL", "5m")
+		t.Setenv("CONTENT_SCHEDULER_BATCH_SIZE", "10")
+		t.Setenv("CONTENT_SCHEDULER_NOTIFY_NEWSLETTER", "true")
+
+		cfg := ResolveContentSchedulerConfig()
+
+		if cfg.Enabled {
+			t.Fatal("expected scheduler to be disabled")
+		}
+		if cfg.Interval != 5*time.Minute {
+			t.Fatalf("Interval = %s", cfg.Interval)
+		}
+		if cfg.BatchSize != 10 {
+			t.Fatalf("BatchSize = %d", cfg.BatchSize)
+		}
+		if !cfg.NotifyNewsletter {
+			t.Fatal("expected newsletter notification to be enabled")
+		}
+	})
+
+	t.Run("falls back on invalid values", func(t *testing.T) {
+		t.Setenv("CONTENT_SCHEDULER_INTERVAL", "soon")
+		t.Setenv("CONTENT_SCHEDULER_BATCH_SIZE", "-1")
+
+		cfg := ResolveContentSchedulerConfig()
+
+		if cfg.Interval != DefaultContentSchedulerInterval {
+			t.Fatalf("Interval = %s", cfg.Interval)
+		}
+		if cfg.BatchSize != DefaultContentSchedulerBatchSize {
+			t.Fatalf("BatchSize = %d", cfg.BatchSize)
+		}
+	})
+}
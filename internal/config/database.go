@@ -2,15 +2,20 @@ package config
 
 import (
 	"context"
+	"strconv"
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
 )
 
 const (
 	DefaultMongoConnectTimeout         = 10 * time.Second
 	DefaultMongoServerSelectionTimeout = 10 * time.Second
+	MongoWriteConcernMajority          = "majority"
 )
 
 type DatabaseConfig struct {
@@ -18,6 +23,14 @@ type DatabaseConfig struct {
 	Name                   string
 	ConnectTimeout         time.Duration
 	ServerSelectionTimeout time.Duration
+	// The pool settings, read preference and write concern override the URI only when set; zero values keep the URI
+	// or driver defaults.
+	MaxPoolSize uint64
+	MinPoolSize uint64
+	// ReadPreference is a read preference mode such as primary or secondaryPreferred.
+	ReadPreference string
+	// WriteConcern is "majority" or a number of acknowledging members.
+	WriteConcern string
 }
 
 func ResolveDatabaseConfig() (DatabaseConfig, error) {
@@ -36,6 +49,10 @@ func ResolveDatabaseConfig() (DatabaseConfig, error) {
 		Name:                   name,
 		ConnectTimeout:         DefaultMongoConnectTimeout,
 		ServerSelectionTimeout: DefaultMongoServerSelectionTimeout,
+		MaxPoolSize:            uint64(resolveNonNegativeIntEnv("MONGODB_MAX_POOL_SIZE", 0)),
+		MinPoolSize:            uint64(resolveNonNegativeIntEnv("MONGODB_MIN_POOL_SIZE", 0)),
+		ReadPreference:         resolveMongoReadPreference(),
+		WriteConcern:           resolveMongoWriteConcern(),
 	}, nil
 }
 
@@ -48,10 +65,73 @@ func BuildMongoClientOptions(cfg DatabaseConfig, appName string) *options.Client
 	if appName != "" {
 		clientOptions.SetAppName(appName)
 	}
+	if cfg.MaxPoolSize > 0 {
+		clientOptions.SetMaxPoolSize(cfg.MaxPoolSize)
+	}
+	if minPoolSize := cfg.MinPoolSize; minPoolSize > 0 {
+		if cfg.MaxPoolSize > 0 && minPoolSize > cfg.MaxPoolSize {
+			minPoolSize = cfg.MaxPoolSize
+		}
+		clientOptions.SetMinPoolSize(minPoolSize)
+	}
+	if mode, err := readpref.ModeFromString(cfg.ReadPreference); err == nil {
+		if preference, err := readpref.New(mode); err == nil {
+			clientOptions.SetReadPreference(preference)
+		}
+	}
+	if concern := buildMongoWriteConcern(cfg.WriteConcern); concern != nil {
+		clientOptions.SetWriteConcern(concern)
+	}
 
 	return clientOptions
 }
 
+func resolveNonNegativeIntEnv(name string, fallback int) int {
+	value := strings.TrimSpace(getenv(name))
+	if value == "" {
+		return fallback
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil || parsed < 0 {
+		return fallback
+	}
+
+	return parsed
+}
+
+func resolveMongoReadPreference() string {
+	value := strings.TrimSpace(getenv("MONGODB_READ_PREFERENCE"))
+	if _, err := readpref.ModeFromString(value); err != nil {
+		return ""
+	}
+
+	return value
+}
+
+func resolveMongoWriteConcern() string {
+	value := strings.ToLower(strings.TrimSpace(getenv("MONGODB_WRITE_CONCERN")))
+	if value == MongoWriteConcernMajority {
+		return value
+	}
+	if members, err := strconv.Atoi(value); err == nil && members >= 0 {
+		return value
+	}
+
+	return ""
+}
+
+func buildMongoWriteConcern(value string) *writeconcern.WriteConcern {
+	if value == MongoWriteConcernMajority {
+		return writeconcern.Majority()
+	}
+	if members, err := strconv.Atoi(value); err == nil && members >= 0 {
+		return &writeconcern.WriteConcern{W: members}
+	}
+
+	return nil
+}
+
 func NewMongoClient(ctx context.Context, cfg DatabaseConfig, appName string) (*mongo.Client, error) {
 	return mongo.Connect(ctx, BuildMongoClientOptions(cfg, appName))
 }
@@ -0,0 +1,40 @@
+package config
+
+import "strings"
+
+const DefaultJWTAlgorithm = "HS256"
+
+// resolveJWTAlgorithm reads the signing algorithm for one token issuer, falling back to the shared JWT_ALGORITHM.
+func resolveJWTAlgorithm(name string) string {
+	if value := strings.TrimSpace(getenv(name)); value != "" {
+		return value
+	}
+	if value := strings.TrimSpace(getenv("JWT_ALGORITHM")); value != "" {
+		return value
+	}
+
+	return DefaultJWTAlgorithm
+}
+
+// resolveJWTSigningKeyID reads the kid of the active signing key for one token issuer, falling back to the shared
+// JWT_SIGNING_KEY_ID.
+func resolveJWTSigningKeyID(name string) string {
+	if value := strings.TrimSpace(getenv(name)); value != "" {
+		return value
+	}
+
+	return strings.TrimSpace(getenv("JWT_SIGNING_KEY_ID"))
+}
+
+// ResolveJWTKeys returns the JSON Web Key Set shared by the admin and reader token issuers.
+func ResolveJWTKeys() string {
+	return strings.TrimSpace(getenv("JWT_KEYS"))
+}
+
+func jwtConfigured(secret, algorithm, keys string) bool {
+	if strings.EqualFold(strings.TrimSpace(algorithm), DefaultJWTAlgorithm) {
+		return strings.TrimSpace(secret) != ""
+	}
+
+	return strings.TrimSpace(keys) != ""
+}
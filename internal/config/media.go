@@ -0,0 +1,97 @@
+package config
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	MediaStorageInline     = "inline"
+	MediaStorageGridFS     = "gridfs"
+	MediaStorageFilesystem = "filesystem"
+	MediaStorageS3         = "s3"
+
+	DefaultMediaStorageDir       = "data/media"
+	DefaultMediaS3Region         = "us-east-1"
+	DefaultInlineMediaMaxBytes   = 5 * 1024 * 1024
+	DefaultExternalMediaMaxBytes = 20 * 1024 * 1024
+	DefaultMediaGCGracePeriod    = 7 * 24 * time.Hour
+	DefaultMediaGCMinimumAge     = 24 * time.Hour
+)
+
+type MediaStorageConfig struct {
+	Backend           string
+	MaxAssetBytes     int
+	FilesystemDir     string
+	S3Endpoint        string
+	S3Region          string
+	S3Bucket          string
+	S3AccessKeyID     string
+	S3SecretAccessKey string
+}
+
+// MediaGarbageCollectionConfig controls how unused uploads are quarantined and purged. MinimumAge keeps freshly
+// uploaded assets out of quarantine while an editor has not yet saved the post that uses them.
+type MediaGarbageCollectionConfig struct {
+	GracePeriod time.Duration
+	MinimumAge  time.Duration
+}
+
+func ResolveMediaStorageConfig() MediaStorageConfig {
+	backend := NormalizeMediaStorageBackend(getenv("MEDIA_STORAGE_BACKEND"))
+	if backend == "" {
+		backend = MediaStorageInline
+	}
+
+	// Inline blobs live inside the asset document, so they stay well below Mongo's 16MB document limit.
+	maxAssetBytes := DefaultInlineMediaMaxBytes
+	if backend != MediaStorageInline {
+		maxAssetBytes = ResolvePositiveIntEnv("MEDIA_ASSET_MAX_BYTES", DefaultExternalMediaMaxBytes)
+	}
+
+	filesystemDir := strings.TrimSpace(getenv("MEDIA_STORAGE_DIR"))
+	if filesystemDir == "" {
+		filesystemDir = DefaultMediaStorageDir
+	}
+
+	region := strings.TrimSpace(getenv("MEDIA_S3_REGION"))
+	if region == "" {
+		region = DefaultMediaS3Region
+	}
+
+	return MediaStorageConfig{
+		Backend:           backend,
+		MaxAssetBytes:     maxAssetBytes,
+		FilesystemDir:     filesystemDir,
+		S3Endpoint:        strings.TrimRight(strings.TrimSpace(getenv("MEDIA_S3_ENDPOINT")), "/"),
+		S3Region:          region,
+		S3Bucket:          strings.TrimSpace(getenv("MEDIA_S3_BUCKET")),
+		S3AccessKeyID:     strings.TrimSpace(getenv("MEDIA_S3_ACCESS_KEY_ID")),
+		S3SecretAccessKey: strings.TrimSpace(getenv("MEDIA_S3_SECRET_ACCESS_KEY")),
+	}
+}
+
+// NormalizeMediaStorageBackend returns the canonical backend name, or "" when the value is unknown.
+func NormalizeMediaStorageBackend(value string) string {
+	switch resolved := strings.ToLower(strings.TrimSpace(value)); resolved {
+	case MediaStorageInline, MediaStorageGridFS, MediaStorageFilesystem, MediaStorageS3:
+		return resolved
+	case "fs", "local":
+		return MediaStorageFilesystem
+	default:
+		return ""
+	}
+}
+
+// ResolveMediaCopyright returns the notice written into uploaded images once their metadata is stripped, or ""
+// to keep no metadata at all.
+func ResolveMediaCopyright() string {
+	return strings.TrimSpace(getenv("MEDIA_COPYRIGHT"))
+}
+
+func ResolveMediaGarbageCollectionConfig() MediaGarbageCollectionConfig {
+	return MediaGarbageCollectionConfig{
+		GracePeriod: resolveDurationEnv("MEDIA_GC_GRACE_PERIOD", DefaultMediaGCGracePeriod),
+		MinimumAge:  resolveDurationEnv("MEDIA_GC_MIN_AGE", DefaultMediaGCMinimumAge),
+	}
+}
@@ -0,0 +1,72 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestResolveMediaStorageConfig(t *testing.T) {
+	t.Run("defaults to inline storage", func(t *testing.T) {
+		t.Setenv("MEDIA_STORAGE_BACKEND", "")
+		t.Setenv("MEDIA_ASSET_MAX_BYTES", "99999999")
+
+		cfg := ResolveMediaStorageConfig()
+
+		if cfg.Backend != MediaStorageInline {
+			t.Fatalf("Backend = %q", cfg.Backend)
+		}
+		if cfg.MaxAssetBytes != DefaultInlineMediaMaxBytes {
+			t.Fatalf("MaxAssetBytes = %d", cfg.MaxAssetBytes)
+		}
+		if cfg.FilesystemDir != DefaultMediaStorageDir || cfg.S3Region != DefaultMediaS3Region {
+			t.Fatalf("unexpected defaults: %#v", cfg)
+		}
+	})
+
+	t.Run("uses configured values", func(t *testing.T) {
+		t.Setenv("MEDIA_STORAGE_BACKEND", " S3 ")
+		t.Setenv("MEDIA_ASSET_MAX_BYTES", "1048576")
+		t.Setenv("MEDIA_S3_ENDPOINT", "http://localhost:9000/")
+		t.Setenv("MEDIA_S3_REGION", "eu-central-1")
+		t.Setenv("MEDIA_S3_BUCKET", "media")
+		t.Setenv("MEDIA_S3_ACCESS_KEY_ID", "access")
+		t.Setenv("MEDIA_S3_SECRET_ACCESS_KEY", "secret")
+
+		cfg := ResolveMediaStorageConfig()
+
+		if cfg.Backend != MediaStorageS3 || cfg.MaxAssetBytes != 1048576 {
+			t.Fatalf("unexpected backend config: %#v", cfg)
+		}
+		if cfg.S3Endpoint != "http://localhost:9000" || cfg.S3Region != "eu-central-1" || cfg.S3Bucket != "media" {
+			t.Fatalf("unexpected s3 config: %#v", cfg)
+		}
+		if cfg.S3AccessKeyID != "access" || cfg.S3SecretAccessKey != "secret" {
+			t.Fatalf("unexpected s3 credentials: %#v", cfg)
+		}
+	})
+
+	t.Run("falls back on unknown backends", func(t *testing.T) {
+		t.Setenv("MEDIA_STORAGE_BACKEND", "dropbox")
+
+		if cfg := ResolveMediaStorageConfig(); cfg.Backend != MediaStorageInline {
+			t.Fatalf("Backend = %q", cfg.Backend)
+		}
+		if NormalizeMediaStorageBackend("local") != MediaStorageFilesystem {
+			t.Fatal("expected local alias to map to filesystem")
+		}
+	})
+}
+
+func TestResolveMediaGarbageCollectionConfig(t *testing.T) {
+	t.Setenv("MEDIA_GC_GRACE_PERIOD", "72h")
+	t.Setenv("MEDIA_GC_MIN_AGE", "not-a-duration")
+
+	cfg := ResolveMediaGarbageCollectionConfig()
+
+	if cfg.GracePeriod != 72*time.Hour {
+		t.Fatalf("GracePeriod = %v", cfg.GracePeriod)
+	}
+	if cfg.MinimumAge != DefaultMediaGCMinimumAge {
+		t.Fatalf("MinimumAge = %v", cfg.MinimumAge)
+	}
+}
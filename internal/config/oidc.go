@@ -0,0 +1,111 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// OIDCProviderConfig is one generic OpenID Connect provider listed in OIDC_PROVIDERS, such as GitLab, Keycloak or
+// Microsoft Entra ID. Its settings are read from OIDC_<ID>_* variables, where <ID> is the upper-cased provider id
+// with dashes turned into underscores.
+type OIDCProviderConfig struct {
+	ID           string
+	Name         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+}
+
+var oidcProviderIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,31}$`)
+
+// reservedOIDCProviderIDs are handled by the dedicated Google and GitHub integrations.
+var reservedOIDCProviderIDs = map[string]struct{}{"google": {}, "github": {}}
+
+// ResolveOIDCProviders returns the configured providers in the order they are listed. A listed provider without an
+// issuer or client id is reported as an error instead of being skipped silently.
+func ResolveOIDCProviders() ([]OIDCProviderConfig, error) {
+	rawIDs := strings.TrimSpace(getenv("OIDC_PROVIDERS"))
+	if rawIDs == "" {
+		return nil, nil
+	}
+
+	providers := make([]OIDCProviderConfig, 0)
+	seen := make(map[string]struct{})
+	for _, rawID := range strings.Split(rawIDs, ",") {
+		id := strings.ToLower(strings.TrimSpace(rawID))
+		if id == "" {
+			continue
+		}
+		if !oidcProviderIDPattern.MatchString(id) {
+			return nil, fmt.Errorf("invalid OIDC_PROVIDERS entry %q: use lowercase letters, digits and dashes", id)
+		}
+		if _, reserved := reservedOIDCProviderIDs[id]; reserved {
+			return nil, fmt.Errorf("invalid OIDC_PROVIDERS entry %q: the id is reserved", id)
+		}
+		if _, duplicate := seen[id]; duplicate {
+			return nil, fmt.Errorf("duplicate OIDC_PROVIDERS entry %q", id)
+		}
+		seen[id] = struct{}{}
+
+		provider, err := resolveOIDCProvider(id)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, provider)
+	}
+
+	return providers, nil
+}
+
+// ResolveOIDCProvider returns the configured provider with the given id.
+func ResolveOIDCProvider(id string) (OIDCProviderConfig, bool, error) {
+	providers, err := ResolveOIDCProviders()
+	if err != nil {
+		return OIDCProviderConfig{}, false, err
+	}
+
+	resolvedID := strings.ToLower(strings.TrimSpace(id))
+	for _, provider := range providers {
+		if provider.ID == resolvedID {
+			return provider, true, nil
+		}
+	}
+
+	return OIDCProviderConfig{}, false, nil
+}
+
+func resolveOIDCProvider(id string) (OIDCProviderConfig, error) {
+	prefix := "OIDC_" + strings.ToUpper(strings.ReplaceAll(id, "-", "_")) + "_"
+
+	issuer, err := requiredEnv(prefix + "ISSUER")
+	if err != nil {
+		return OIDCProviderConfig{}, err
+	}
+	clientID, err := requiredEnv(prefix + "CLIENT_ID")
+	if err != nil {
+		return OIDCProviderConfig{}, err
+	}
+
+	// openid is what makes the provider return an ID token, so it is always requested.
+	scopes := strings.Fields(strings.ReplaceAll(getenv(prefix+"SCOPES"), ",", " "))
+	if len(scopes) > 0 && !slices.Contains(scopes, "openid") {
+		scopes = append([]string{"openid"}, scopes...)
+	}
+
+	name := strings.TrimSpace(getenv(prefix + "NAME"))
+	if name == "" {
+		name = id
+	}
+
+	return OIDCProviderConfig{
+		ID:           id,
+		Name:         name,
+		Issuer:       strings.TrimRight(issuer, "/"),
+		ClientID:     clientID,
+		ClientSecret: strings.TrimSpace(getenv(prefix + "CLIENT_SECRET")),
+		Scopes:       scopes,
+	}, nil
+}
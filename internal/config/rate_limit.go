@@ -0,0 +1,40 @@
+package config
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	RateLimitStoreMongo  = "mongo"
+	RateLimitStoreMemory = "memory"
+)
+
+// RateLimitPolicy limits an operation to MaxAttempts per client within a sliding Window.
+type RateLimitPolicy struct {
+	Store       string
+	MaxAttempts int
+	Window      time.Duration
+}
+
+// ResolveRateLimitPolicy reads RATE_LIMIT_<OPERATION>_MAX and RATE_LIMIT_<OPERATION>_WINDOW, where <OPERATION> is the
+// upper-cased operation with dashes turned into underscores, and falls back to the given defaults. RATE_LIMIT_STORE
+// selects where attempts are counted: "mongo" (default) shares them across instances, "memory" keeps them per process.
+func ResolveRateLimitPolicy(operation string, maxAttempts int, window time.Duration) RateLimitPolicy {
+	prefix := "RATE_LIMIT_" + strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(operation), "-", "_"))
+
+	return RateLimitPolicy{
+		Store:       resolveRateLimitStore(),
+		MaxAttempts: ResolvePositiveIntEnv(prefix+"_MAX", maxAttempts),
+		Window:      resolveDurationEnv(prefix+"_WINDOW", window),
+	}
+}
+
+func resolveRateLimitStore() string {
+	switch strings.ToLower(strings.TrimSpace(getenv("RATE_LIMIT_STORE"))) {
+	case RateLimitStoreMemory:
+		return RateLimitStoreMemory
+	default:
+		return RateLimitStoreMongo
+	}
+}
@@ -0,0 +1,29 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestResolveRateLimitPolicy(t *testing.T) {
+	policy := ResolveRateLimitPolicy("newsletter-subscribe", 5, time.Minute)
+	if policy.Store != RateLimitStoreMongo || policy.MaxAttempts != 5 || policy.Window != time.Minute {
+		t.Fatalf("default ResolveRateLimitPolicy() = %#v", policy)
+	}
+
+	t.Setenv("RATE_LIMIT_STORE", " Memory ")
+	t.Setenv("RATE_LIMIT_NEWSLETTER_SUBSCRIBE_MAX", "12")
+	t.Setenv("RATE_LIMIT_NEWSLETTER_SUBSCRIBE_WINDOW", "90s")
+	policy = ResolveRateLimitPolicy("newsletter-subscribe", 5, time.Minute)
+	if policy.Store != RateLimitStoreMemory || policy.MaxAttempts != 12 || policy.Window != 90*time.Second {
+		t.Fatalf("configured ResolveRateLimitPolicy() = %#v", policy)
+	}
+
+	t.Setenv("RATE_LIMIT_STORE", "redis")
+	t.Setenv("RATE_LIMIT_COMMENT_MAX", "0")
+	t.Setenv("RATE_LIMIT_COMMENT_WINDOW", "soon")
+	policy = ResolveRateLimitPolicy("comment", 3, 10*time.Minute)
+	if policy.Store != RateLimitStoreMongo || policy.MaxAttempts != 3 || policy.Window != 10*time.Minute {
+		t.Fatalf("invalid ResolveRateLimitPolicy() = %#v", policy)
+	}
+}
@@ -17,6 +17,9 @@ const (
 
 type ReaderConfig struct {
 	JWTSecret          string
+	JWTAlgorithm       string
+	JWTKeys            string
+	JWTSigningKeyID    string
 	AccessCookieName   string
 	RefreshCookieName  string
 	JWTIssuer          string
@@ -50,6 +53,9 @@ func ResolveReaderConfig() ReaderConfig {
 
 	return ReaderConfig{
 		JWTSecret:          strings.TrimSpace(getenv("JWT_SECRET")),
+		JWTAlgorithm:       resolveJWTAlgorithm("READER_JWT_ALGORITHM"),
+		JWTKeys:            ResolveJWTKeys(),
+		JWTSigningKeyID:    resolveJWTSigningKeyID("READER_JWT_SIGNING_KEY_ID"),
 		AccessCookieName:   accessCookieName,
 		RefreshCookieName:  refreshCookieName,
 		JWTIssuer:          issuer,
@@ -61,6 +67,11 @@ func ResolveReaderConfig() ReaderConfig {
 	}
 }
 
+// JWTConfigured reports whether reader tokens can be issued with the selected algorithm.
+func (config ReaderConfig) JWTConfigured() bool {
+	return jwtConfigured(config.JWTSecret, config.JWTAlgorithm, config.JWTKeys)
+}
+
 func resolveReaderSecureCookie() bool {
 	if value, ok := resolveOptionalBoolEnv("COOKIE_SECURE"); ok {
 		return value
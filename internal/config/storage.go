@@ -0,0 +1,32 @@
+package config
+
+import "strings"
+
+const (
+	StorageMongo  = "mongo"
+	StorageMemory = "memory"
+
+	DefaultStorageContentDir = "content"
+)
+
+type StorageConfig struct {
+	// Backend is "mongo" (default) or "memory", which keeps every repository in process and needs no database.
+	Backend string
+	// ContentDir is the markdown tree the memory backend is seeded from.
+	ContentDir string
+}
+
+// ResolveStorageConfig reads STORAGE and STORAGE_CONTENT_DIR. Unknown backends fall back to Mongo.
+func ResolveStorageConfig() StorageConfig {
+	backend := StorageMongo
+	if strings.ToLower(strings.TrimSpace(getenv("STORAGE"))) == StorageMemory {
+		backend = StorageMemory
+	}
+
+	contentDir := strings.TrimSpace(getenv("STORAGE_CONTENT_DIR"))
+	if contentDir == "" {
+		contentDir = DefaultStorageContentDir
+	}
+
+	return StorageConfig{Backend: backend, ContentDir: contentDir}
+}
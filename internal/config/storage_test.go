@@ -0,0 +1,22 @@
+package config
+
+import "testing"
+
+func TestResolveStorageConfig(t *testing.T) {
+	cfg := ResolveStorageConfig()
+	if cfg.Backend != StorageMongo || cfg.ContentDir != DefaultStorageContentDir {
+		t.Fatalf("default ResolveStorageConfig() = %#v", cfg)
+	}
+
+	t.Setenv("STORAGE", " Memory ")
+	t.Setenv("STORAGE_CONTENT_DIR", " ./fixtures ")
+	cfg = ResolveStorageConfig()
+	if cfg.Backend != StorageMemory || cfg.ContentDir != "./fixtures" {
+		t.Fatalf("configured ResolveStorageConfig() = %#v", cfg)
+	}
+
+	t.Setenv("STORAGE", "postgres")
+	if cfg = ResolveStorageConfig(); cfg.Backend != StorageMongo {
+		t.Fatalf("unknown ResolveStorageConfig() = %#v", cfg)
+	}
+}
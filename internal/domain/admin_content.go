@@ -8,6 +8,11 @@ const (
 	AdminContentPostStatusPublished = "published"
 )
 
+const (
+	AdminContentPublishTriggerSchedule = "schedule"
+	AdminContentPublishTriggerManual   = "manual"
+)
+
 type AdminContentPostFilter struct {
 	Locale          string
 	PreferredLocale string
@@ -77,6 +82,13 @@ type AdminContentTopicListResult struct {
 	Size  int
 }
 
+type AdminContentSeriesListResult struct {
+	Items []AdminContentSeriesGroupRecord
+	Total int
+	Page  int
+	Size  int
+}
+
 type AdminContentCategoryListResult struct {
 	Items []AdminContentCategoryGroupRecord
 	Total int
@@ -167,6 +179,22 @@ type AdminContentTopicGroupRecord struct {
 	TR        *AdminContentTopicRecord
 }
 
+type AdminContentSeriesRecord struct {
+	Locale      string
+	ID          string
+	Name        string
+	Description string
+	PostIDs     []string
+	UpdatedAt   time.Time
+}
+
+type AdminContentSeriesGroupRecord struct {
+	ID        string
+	Preferred AdminContentSeriesRecord
+	EN        *AdminContentSeriesRecord
+	TR        *AdminContentSeriesRecord
+}
+
 type AdminContentCategoryRecord struct {
 	Locale    string
 	ID        string
@@ -192,6 +220,14 @@ type AdminContentTopicInput struct {
 	Link   string
 }
 
+type AdminContentSeriesInput struct {
+	Locale      string
+	ID          string
+	Name        string
+	Description string
+	PostIDs     []string
+}
+
 type AdminContentCategoryInput struct {
 	Locale string
 	ID     string
@@ -200,3 +236,73 @@ type AdminContentCategoryInput struct {
 	Icon   string
 	Link   string
 }
+
+type AdminContentTopicMergeInput struct {
+	SourceIDs []string
+	TargetID  string
+	DryRun    bool
+}
+
+type AdminContentCategoryRenameInput struct {
+	SourceID string
+	TargetID string
+	DryRun   bool
+}
+
+type AdminContentPostTaxonomyRewrite struct {
+	Post     AdminContentPostRecord
+	Category *AdminContentCategoryRecord
+	Topics   []AdminContentTopicRecord
+}
+
+type AdminContentTaxonomyRewritePlan struct {
+	Posts            []AdminContentPostTaxonomyRewrite
+	UpsertCategories []AdminContentCategoryRecord
+	DeleteCategories []AdminContentCategoryRecord
+	DeleteTopics     []AdminContentTopicRecord
+}
+
+type AdminContentTaxonomyRewritePost struct {
+	Locale string
+	ID     string
+	Title  string
+}
+
+type AdminContentTaxonomyRewriteResult struct {
+	DryRun            bool
+	Posts             []AdminContentTaxonomyRewritePost
+	PostsUpdated      int
+	RevisionsCreated  int
+	TaxonomiesRemoved int
+}
+
+type AdminContentPostRenameInput struct {
+	SourceID string
+	TargetID string
+}
+
+type AdminContentPostRenameResult struct {
+	SourceID          string
+	TargetID          string
+	PostsUpdated      int
+	RevisionsUpdated  int
+	CommentsUpdated   int
+	SeriesUpdated     int
+	AliasesRedirected int
+}
+
+type AdminContentPostPublishedEvent struct {
+	Locale      string
+	PostID      string
+	Title       string
+	Source      string
+	Trigger     string
+	ScheduledAt time.Time
+	PublishedAt time.Time
+}
+
+type AdminContentScheduleRunResult struct {
+	Published []AdminContentPostPublishedEvent
+	Failed    int
+	RanAt     time.Time
+}
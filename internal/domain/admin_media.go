@@ -1,13 +1,19 @@
 package domain
 
-import "time"
+import (
+	"io"
+	"time"
+)
 
 type AdminMediaLibraryFilter struct {
-	Query string
-	Kind  string
-	Sort  string
-	Page  int
-	Size  int
+	Query       string
+	Kind        string
+	Tag         string
+	Folder      string
+	Quarantined bool
+	Sort        string
+	Page        int
+	Size        int
 }
 
 type AdminMediaLibraryListPayload struct {
@@ -18,35 +24,127 @@ type AdminMediaLibraryListPayload struct {
 }
 
 type AdminMediaAssetRecord struct {
-	ID          string
-	Name        string
-	ContentType string
+	ID            string
+	Name          string
+	ContentType   string
+	Digest        string
+	SizeBytes     int
+	Width         int
+	Height        int
+	Data          []byte
+	Placeholder   MediaPlaceholder
+	Metadata      AdminMediaAssetMetadata
+	Storage       string
+	StorageKey    string
+	CreatedBy     string
+	QuarantinedAt time.Time
+	PurgeAfter    time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type AdminMediaLibraryItem struct {
+	ID            string
+	Kind          string
+	Name          string
+	Value         string
+	PreviewURL    string
+	ContentType   string
+	Width         int
+	Height        int
+	Placeholder   MediaPlaceholder
+	Metadata      AdminMediaAssetMetadata
+	SizeBytes     int
+	UsageCount    int
+	QuarantinedAt time.Time
+	PurgeAfter    time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// MediaPlaceholder is the low-quality preview computed when an image is uploaded.
+type MediaPlaceholder struct {
+	DominantColor string
+	BlurHash      string
+}
+
+// AdminMediaAssetMetadata holds the editorial fields of an uploaded asset. Folder is a virtual "/"-separated path;
+// assets are not moved in storage when it changes.
+type AdminMediaAssetMetadata struct {
+	AltTexts []MediaAltText
+	Caption  string
+	Credit   string
+	Tags     []string
+	Folder   string
+}
+
+type MediaAltText struct {
+	Locale string
+	Text   string
+}
+
+type AdminMediaLibraryFacets struct {
+	Folders []string
+	Tags    []string
+}
+
+type AdminMediaUploadInput struct {
+	FileName string
+	DataURL  string
+	File     io.Reader
+}
+
+type AdminMediaAssetVariant struct {
+	AssetID     string
 	Digest      string
-	SizeBytes   int
 	Width       int
 	Height      int
+	ContentType string
 	Data        []byte
-	CreatedBy   string
 	CreatedAt   time.Time
-	UpdatedAt   time.Time
 }
 
-type AdminMediaLibraryItem struct {
-	ID          string
-	Kind        string
-	Name        string
-	Value       string
-	PreviewURL  string
-	ContentType string
-	Width       int
-	Height      int
-	SizeBytes   int
-	UsageCount  int
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+type AdminMediaStorageMigrationResult struct {
+	TargetBackend string
+	DryRun        bool
+	Scanned       int
+	Migrated      int
+	Skipped       int
+	Failed        int
 }
 
-type AdminMediaUploadInput struct {
-	FileName string
-	DataURL  string
+// AdminMediaGarbageCollectionResult reports one garbage collection run over the media library.
+type AdminMediaGarbageCollectionResult struct {
+	DryRun         bool
+	Scanned        int
+	Quarantined    int
+	Restored       int
+	Purged         int
+	Failed         int
+	ReclaimedBytes int64
+	RanAt          time.Time
+}
+
+type AdminMediaUploadSessionInput struct {
+	FileName  string
+	SizeBytes int
+	Checksum  string
+}
+
+type AdminMediaUploadSession struct {
+	ID            string
+	FileName      string
+	SizeBytes     int
+	Checksum      string
+	UploadedBytes int
+	ChunkSize     int
+	CreatedBy     string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	ExpiresAt     time.Time
+}
+
+type AdminMediaUploadChunk struct {
+	Offset int
+	Data   []byte
 }
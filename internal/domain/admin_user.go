@@ -2,6 +2,12 @@ package domain
 
 import "time"
 
+const (
+	AdminUserStatusActive   = "active"
+	AdminUserStatusInvited  = "invited"
+	AdminUserStatusDisabled = "disabled"
+)
+
 type AdminUser struct {
 	ID                    string
 	Name                  string
@@ -19,6 +25,15 @@ type AdminUser struct {
 	GithubEmail           string
 	GithubLinkedAt        *time.Time
 	Roles                 []string
+	Status                string
+	CreatedAt             *time.Time
+	InvitationExpiresAt   *time.Time
+	TwoFactorEnabledAt    *time.Time
+	RecoveryCodesLeft     int
+	// AccessTokenID and AccessTokenScopes are set when the request authenticated with a personal access token. The
+	// scopes narrow the permissions granted by Roles.
+	AccessTokenID     string
+	AccessTokenScopes []string
 }
 
 type AdminUserRecord struct {
@@ -27,6 +42,9 @@ type AdminUserRecord struct {
 	PasswordVersion      int64
 	PendingEmailChange   *AdminPendingEmailChange
 	PendingPasswordReset *AdminPendingPasswordReset
+	PendingInvitation    *AdminPendingInvitation
+	TwoFactor            *AdminTwoFactor
+	PendingTwoFactor     *AdminPendingTwoFactor
 }
 
 type AdminPendingEmailChange struct {
@@ -44,6 +62,35 @@ type AdminPendingPasswordReset struct {
 	ExpiresAt   time.Time
 }
 
+type AdminPendingInvitation struct {
+	TokenHash string
+	Locale    string
+	InvitedBy string
+	InvitedAt time.Time
+	ExpiresAt time.Time
+}
+
+// AdminTwoFactor is an enabled TOTP enrollment. LastUsedStep is the newest accepted time step, so a code cannot be
+// used twice.
+type AdminTwoFactor struct {
+	Secret             string
+	RecoveryCodeHashes []string
+	LastUsedStep       int64
+	EnabledAt          time.Time
+}
+
+type AdminPendingTwoFactor struct {
+	Secret    string
+	ExpiresAt time.Time
+}
+
+type AdminInvitationInput struct {
+	Email  string
+	Name   string
+	Roles  []string
+	Locale string
+}
+
 type AdminRefreshTokenRecord struct {
 	JTI         string
 	UserID      string
@@ -70,3 +117,43 @@ type AdminSessionRecord struct {
 	ExpiresAt   time.Time
 	Persistent  bool
 }
+
+// AdminPasskeyRecord is a WebAuthn credential registered by an admin. CredentialID is the base64url credential id
+// and PublicKey the COSE key returned at registration.
+type AdminPasskeyRecord struct {
+	ID           string
+	UserID       string
+	CredentialID string
+	PublicKey    []byte
+	Algorithm    int64
+	SignCount    uint32
+	Transports   []string
+	Name         string
+	CreatedAt    time.Time
+	LastUsedAt   *time.Time
+}
+
+// AdminLoginAttemptRecord counts recent failed attempts for one throttle key, such as an account or a client IP.
+type AdminLoginAttemptRecord struct {
+	Key           string
+	Failures      int
+	LastFailureAt time.Time
+	LockedUntil   *time.Time
+	ExpiresAt     time.Time
+}
+
+// AdminAccessTokenRecord is a personal access token used by automation instead of the cookie session. Only the hash of
+// the token is stored; Prefix keeps its first characters so the admin can tell tokens apart.
+type AdminAccessTokenRecord struct {
+	ID         string
+	UserID     string
+	Name       string
+	Prefix     string
+	TokenHash  string
+	Scopes     []string
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	LastUsedAt *time.Time
+	LastUsedIP string
+	UseCount   int64
+}
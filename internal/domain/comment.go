@@ -28,10 +28,11 @@ type CommentListResult struct {
 }
 
 type CommentMutationResult struct {
-	Status           string         `json:"status"`
-	PostID           string         `json:"postId,omitempty"`
-	Comment          *CommentRecord `json:"comment,omitempty"`
-	ModerationStatus string         `json:"moderationStatus,omitempty"`
+	Status            string         `json:"status"`
+	PostID            string         `json:"postId,omitempty"`
+	Comment           *CommentRecord `json:"comment,omitempty"`
+	ModerationStatus  string         `json:"moderationStatus,omitempty"`
+	RetryAfterSeconds int            `json:"retryAfterSeconds,omitempty"`
 }
 
 type AdminCommentFilter struct {
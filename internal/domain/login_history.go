@@ -0,0 +1,27 @@
+package domain
+
+import "time"
+
+// Account types that sign in to the site.
+const (
+	AccountTypeAdmin  = "admin"
+	AccountTypeReader = "reader"
+)
+
+// Kinds of sign-in attributes remembered for login alerts.
+const (
+	LoginHistoryKindDevice  = "device"
+	LoginHistoryKindCountry = "country"
+)
+
+// LoginHistoryRecord remembers a device fingerprint or country an admin or reader has signed in from, so a sign-in
+// from somewhere new can be reported.
+type LoginHistoryRecord struct {
+	AccountType string
+	UserID      string
+	Kind        string
+	Value       string
+	FirstSeenAt time.Time
+	LastSeenAt  time.Time
+	ExpiresAt   time.Time
+}
@@ -0,0 +1,20 @@
+package domain
+
+import "time"
+
+// Account types an OpenID Connect identity can be linked to.
+const (
+	OIDCAccountTypeAdmin  = AccountTypeAdmin
+	OIDCAccountTypeReader = AccountTypeReader
+)
+
+// OIDCIdentityRecord links the subject of a generic OpenID Connect provider to an admin or reader account. An account
+// has at most one identity per provider.
+type OIDCIdentityRecord struct {
+	AccountType string
+	UserID      string
+	Provider    string
+	Subject     string
+	Email       string
+	LinkedAt    time.Time
+}
@@ -35,6 +35,40 @@ type PostRecord struct {
 	ScheduledAt    time.Time     `json:"-" bson:"scheduledAt,omitempty"`
 }
 
+type PostRelatedItem struct {
+	PostID string
+	Score  float64
+}
+
+type PostRelatedRecord struct {
+	Locale     string
+	PostID     string
+	Items      []PostRelatedItem
+	ComputedAt time.Time
+}
+
+type PostSeriesRecord struct {
+	Locale      string
+	ID          string
+	Name        string
+	Description string
+	PostIDs     []string
+	UpdatedAt   time.Time
+}
+
+type PostSeriesResponse struct {
+	Status string
+	Locale string
+	PostID string
+
+	Series *PostSeriesRecord
+	Posts  []PostRecord
+
+	Position int
+	Previous *PostRecord
+	Next     *PostRecord
+}
+
 type PostContentResponse struct {
 	Status string `json:"status"`
 
@@ -47,6 +81,7 @@ type PostContentResponse struct {
 	Sort  string       `json:"sort,omitempty"`
 
 	PostID           string           `json:"postId,omitempty"`
+	RedirectTo       string           `json:"redirectTo,omitempty"`
 	Likes            int64            `json:"likes,omitempty"`
 	LikesByPostID    map[string]int64 `json:"likesByPostId,omitempty"`
 	Hits             int64            `json:"hits,omitempty"`
@@ -0,0 +1,11 @@
+package domain
+
+import "time"
+
+// RateLimitRecord holds the attempts counted under one rate limit key within the current window.
+type RateLimitRecord struct {
+	Key       string
+	Hits      []time.Time
+	Allowed   bool
+	ExpiresAt time.Time
+}
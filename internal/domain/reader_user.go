@@ -19,6 +19,9 @@ type ReaderUser struct {
 type ReaderUserRecord struct {
 	ReaderUser
 	SessionVersion int64
+	// NameCustomized is set once the reader edits their display name, so provider sign-ins stop overwriting it.
+	NameCustomized bool
+	CreatedAt      time.Time
 }
 
 type ReaderRefreshTokenRecord struct {
@@ -36,3 +39,23 @@ type ReaderRefreshTokenRecord struct {
 	RevokedAt   *time.Time
 	ReplacedBy  string
 }
+
+type ReaderSessionRecord struct {
+	ID          string
+	UserAgent   string
+	RemoteIP    string
+	CountryCode string
+	LastSeenAt  time.Time
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+	Persistent  bool
+}
+
+// ReaderPostLikeRecord remembers a like given by a signed-in reader. Anonymous likes only update the post counter.
+type ReaderPostLikeRecord struct {
+	ReaderID    string
+	PostID      string
+	Count       int64
+	FirstLikeAt time.Time
+	LastLikeAt  time.Time
+}
@@ -0,0 +1,28 @@
+package admingraphql
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+
+	"suaybsimsek.com/blog-api/internal/graphql/admin/model"
+	appservice "suaybsimsek.com/blog-api/internal/service"
+)
+
+// NewDirectiveRoot wires the admin schema directives.
+func NewDirectiveRoot() DirectiveRoot {
+	return DirectiveRoot{HasPermission: hasPermissionDirective}
+}
+
+// hasPermissionDirective runs the field resolver only when the signed-in admin's roles grant permission.
+func hasPermissionDirective(
+	ctx context.Context,
+	_ any,
+	next graphql.Resolver,
+	permission model.AdminPermission,
+) (any, error) {
+	if err := appservice.RequireAdminPermission(getAdminUser(ctx), appservice.AdminPermission(permission)); err != nil {
+		return nil, err
+	}
+	return next(ctx)
+}
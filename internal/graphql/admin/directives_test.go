@@ -0,0 +1,81 @@
+package admingraphql
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/graphql/admin/model"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+)
+
+func TestHasPermissionDirective(t *testing.T) {
+	called := 0
+	next := func(context.Context) (any, error) {
+		called++
+		return "ok", nil
+	}
+	directive := NewDirectiveRoot().HasPermission
+
+	editorCtx := WithAdminUser(context.Background(), &domain.AdminUser{ID: "admin-1", Roles: []string{"editor"}})
+	if result, err := directive(editorCtx, nil, next, model.AdminPermissionContentWrite); err != nil || result != "ok" {
+		t.Fatalf("expected editor to write content, got result=%v err=%v", result, err)
+	}
+
+	_, err := directive(editorCtx, nil, next, model.AdminPermissionNewsletterManage)
+	if appErr := apperrors.From(err); appErr.Code != "ADMIN_FORBIDDEN" || appErr.HTTPStatus != http.StatusForbidden {
+		t.Fatalf("expected forbidden for editor, got %v", err)
+	}
+
+	_, err = directive(context.Background(), nil, next, model.AdminPermissionAccount)
+	if apperrors.From(err).HTTPStatus != http.StatusUnauthorized {
+		t.Fatalf("expected unauthorized without admin, got %v", err)
+	}
+
+	if called != 1 {
+		t.Fatalf("expected resolver to run once, ran %d times", called)
+	}
+}
+
+func TestAdminSchemaOperationsRequirePermission(t *testing.T) {
+	public := map[string]struct{}{
+		"me":                         {},
+		"validatePasswordResetToken": {},
+		"googleAuthStatus":           {},
+		"githubAuthStatus":           {},
+		"oidcProviders":              {},
+		"login":                      {},
+		"refreshAdminSession":        {},
+		"logout":                     {},
+		"requestPasswordReset":       {},
+		"confirmPasswordReset":       {},
+		"confirmEmailChange":         {},
+		"validateInvitation":         {},
+		"acceptInvitation":           {},
+		"verifyTwoFactorLogin":       {},
+		"startPasskeyLogin":          {},
+		"passkeyLogin":               {},
+	}
+
+	for _, operation := range []string{"AdminQuery", "AdminMutation"} {
+		definition := parsedSchema.Types[operation]
+		if definition == nil {
+			t.Fatalf("schema has no %s type", operation)
+		}
+		for _, field := range definition.Fields {
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+			_, isPublic := public[field.Name]
+			hasDirective := field.Directives.ForName("hasPermission") != nil
+			if !isPublic && !hasDirective {
+				t.Errorf("%s.%s must declare @hasPermission", operation, field.Name)
+			}
+			if isPublic && hasDirective {
+				t.Errorf("%s.%s is public and must not declare @hasPermission", operation, field.Name)
+			}
+		}
+	}
+}
@@ -9,16 +9,48 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/99designs/gqlgen/graphql"
 	"suaybsimsek.com/blog-api/pkg/graphql/scalars"
 )
 
+type AdminAcceptInvitationInput struct {
+	Token           string          `json:"token"`
+	NewPassword     string          `json:"newPassword"`
+	ConfirmPassword string          `json:"confirmPassword"`
+	Locale          *scalars.Locale `json:"locale,omitempty"`
+}
+
+type AdminAccessToken struct {
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	Prefix     string            `json:"prefix"`
+	Scopes     []AdminPermission `json:"scopes"`
+	CreatedAt  time.Time         `json:"createdAt"`
+	ExpiresAt  time.Time         `json:"expiresAt"`
+	LastUsedAt *time.Time        `json:"lastUsedAt,omitempty"`
+	LastUsedIP *string           `json:"lastUsedIp,omitempty"`
+	UseCount   int               `json:"useCount"`
+}
+
+type AdminAccessTokenCreatePayload struct {
+	Token       string            `json:"token"`
+	AccessToken *AdminAccessToken `json:"accessToken"`
+}
+
+type AdminAccessTokenRevokePayload struct {
+	Success bool `json:"success"`
+}
+
 type AdminAccountDeletePayload struct {
 	Success bool `json:"success"`
 }
 
 type AdminAuthPayload struct {
-	Success bool       `json:"success"`
-	User    *AdminUser `json:"user,omitempty"`
+	Success      bool       `json:"success"`
+	User         *AdminUser `json:"user,omitempty"`
+	MfaRequired  bool       `json:"mfaRequired"`
+	MfaToken     *string    `json:"mfaToken,omitempty"`
+	MfaExpiresAt *time.Time `json:"mfaExpiresAt,omitempty"`
 }
 
 type AdminBulkCommentMutationPayload struct {
@@ -178,6 +210,16 @@ type AdminContentPostListPayload struct {
 	Size  int                      `json:"size"`
 }
 
+type AdminContentPostRenamePayload struct {
+	SourceID          string `json:"sourceId"`
+	TargetID          string `json:"targetId"`
+	PostsUpdated      int    `json:"postsUpdated"`
+	RevisionsUpdated  int    `json:"revisionsUpdated"`
+	CommentsUpdated   int    `json:"commentsUpdated"`
+	SeriesUpdated     int    `json:"seriesUpdated"`
+	AliasesRedirected int    `json:"aliasesRedirected"`
+}
+
 type AdminContentPostRevision struct {
 	ID             string                 `json:"id"`
 	Locale         scalars.Locale         `json:"locale"`
@@ -207,6 +249,37 @@ type AdminContentPostRevisionListPayload struct {
 	Size  int                         `json:"size"`
 }
 
+type AdminContentSeries struct {
+	Locale      scalars.Locale `json:"locale"`
+	ID          string         `json:"id"`
+	Name        string         `json:"name"`
+	Description *string        `json:"description,omitempty"`
+	PostIds     []string       `json:"postIds"`
+	UpdatedAt   *time.Time     `json:"updatedAt,omitempty"`
+}
+
+type AdminContentSeriesGroup struct {
+	ID        string              `json:"id"`
+	Preferred *AdminContentSeries `json:"preferred"`
+	En        *AdminContentSeries `json:"en,omitempty"`
+	Tr        *AdminContentSeries `json:"tr,omitempty"`
+}
+
+type AdminContentSeriesInput struct {
+	Locale      scalars.Locale `json:"locale"`
+	ID          string         `json:"id"`
+	Name        string         `json:"name"`
+	Description *string        `json:"description,omitempty"`
+	PostIds     []string       `json:"postIds"`
+}
+
+type AdminContentSeriesListPayload struct {
+	Items []*AdminContentSeriesGroup `json:"items"`
+	Total int                        `json:"total"`
+	Page  int                        `json:"page"`
+	Size  int                        `json:"size"`
+}
+
 type AdminContentTaxonomyFilterInput struct {
 	Locale          *scalars.Locale `json:"locale,omitempty"`
 	PreferredLocale *scalars.Locale `json:"preferredLocale,omitempty"`
@@ -215,6 +288,20 @@ type AdminContentTaxonomyFilterInput struct {
 	Size            *int            `json:"size,omitempty"`
 }
 
+type AdminContentTaxonomyRewritePayload struct {
+	DryRun            bool                               `json:"dryRun"`
+	Posts             []*AdminContentTaxonomyRewritePost `json:"posts"`
+	PostsUpdated      int                                `json:"postsUpdated"`
+	RevisionsCreated  int                                `json:"revisionsCreated"`
+	TaxonomiesRemoved int                                `json:"taxonomiesRemoved"`
+}
+
+type AdminContentTaxonomyRewritePost struct {
+	Locale scalars.Locale `json:"locale"`
+	ID     string         `json:"id"`
+	Title  string         `json:"title"`
+}
+
 type AdminContentTopic struct {
 	Locale    scalars.Locale `json:"locale"`
 	ID        string         `json:"id"`
@@ -246,6 +333,12 @@ type AdminContentTopicListPayload struct {
 	Size  int                       `json:"size"`
 }
 
+type AdminCreateAccessTokenInput struct {
+	Name          string            `json:"name"`
+	Scopes        []AdminPermission `json:"scopes"`
+	ExpiresInDays *int              `json:"expiresInDays,omitempty"`
+}
+
 type AdminCreateErrorMessageInput struct {
 	Key     *AdminErrorMessageKeyInput `json:"key"`
 	Message string                     `json:"message"`
@@ -316,6 +409,11 @@ type AdminEmailChangeRequestPayload struct {
 	ExpiresAt    time.Time     `json:"expiresAt"`
 }
 
+type AdminEnableTwoFactorInput struct {
+	CurrentPassword string `json:"currentPassword"`
+	Code            string `json:"code"`
+}
+
 type AdminErrorMessage struct {
 	Scope     string         `json:"scope"`
 	Locale    scalars.Locale `json:"locale"`
@@ -364,6 +462,12 @@ type AdminErrorMessageListPayload struct {
 	Size  int                  `json:"size"`
 }
 
+type AdminFinishPasskeyRegistrationInput struct {
+	ChallengeToken string  `json:"challengeToken"`
+	Credential     string  `json:"credential"`
+	Name           *string `json:"name,omitempty"`
+}
+
 type AdminGithubAuthStatus struct {
 	Enabled        bool `json:"enabled"`
 	LoginAvailable bool `json:"loginAvailable"`
@@ -392,6 +496,24 @@ type AdminGoogleDisconnectPayload struct {
 	User    *AdminUser `json:"user,omitempty"`
 }
 
+type AdminInvitationAcceptPayload struct {
+	Success bool           `json:"success"`
+	Locale  scalars.Locale `json:"locale"`
+}
+
+type AdminInvitationValidationPayload struct {
+	Status string         `json:"status"`
+	Locale scalars.Locale `json:"locale"`
+	Email  *scalars.Email `json:"email,omitempty"`
+}
+
+type AdminInviteInput struct {
+	Email  scalars.Email   `json:"email"`
+	Name   *string         `json:"name,omitempty"`
+	Roles  []string        `json:"roles"`
+	Locale *scalars.Locale `json:"locale,omitempty"`
+}
+
 type AdminLoginInput struct {
 	Email      scalars.Email `json:"email"`
 	Password   string        `json:"password"`
@@ -407,27 +529,65 @@ type AdminMe struct {
 	User          *AdminUser `json:"user,omitempty"`
 }
 
+type AdminMediaAltText struct {
+	Locale scalars.Locale `json:"locale"`
+	Text   string         `json:"text"`
+}
+
+type AdminMediaAltTextInput struct {
+	Locale scalars.Locale `json:"locale"`
+	Text   string         `json:"text"`
+}
+
+type AdminMediaGarbageCollectionPayload struct {
+	DryRun           bool      `json:"dryRun"`
+	ScannedCount     int       `json:"scannedCount"`
+	QuarantinedCount int       `json:"quarantinedCount"`
+	RestoredCount    int       `json:"restoredCount"`
+	PurgedCount      int       `json:"purgedCount"`
+	FailedCount      int       `json:"failedCount"`
+	ReclaimedBytes   int       `json:"reclaimedBytes"`
+	RanAt            time.Time `json:"ranAt"`
+}
+
+type AdminMediaLibraryFacets struct {
+	Folders []string `json:"folders"`
+	Tags    []string `json:"tags"`
+}
+
 type AdminMediaLibraryFilterInput struct {
-	Query *string                    `json:"query,omitempty"`
-	Kind  *AdminMediaLibraryItemKind `json:"kind,omitempty"`
-	Sort  *AdminMediaLibrarySort     `json:"sort,omitempty"`
-	Page  *int                       `json:"page,omitempty"`
-	Size  *int                       `json:"size,omitempty"`
+	Query       *string                    `json:"query,omitempty"`
+	Kind        *AdminMediaLibraryItemKind `json:"kind,omitempty"`
+	Tag         *string                    `json:"tag,omitempty"`
+	Folder      *string                    `json:"folder,omitempty"`
+	Quarantined *bool                      `json:"quarantined,omitempty"`
+	Sort        *AdminMediaLibrarySort     `json:"sort,omitempty"`
+	Page        *int                       `json:"page,omitempty"`
+	Size        *int                       `json:"size,omitempty"`
 }
 
 type AdminMediaLibraryItem struct {
-	ID          string                    `json:"id"`
-	Kind        AdminMediaLibraryItemKind `json:"kind"`
-	Name        string                    `json:"name"`
-	Value       string                    `json:"value"`
-	PreviewURL  string                    `json:"previewUrl"`
-	ContentType *string                   `json:"contentType,omitempty"`
-	Width       *int                      `json:"width,omitempty"`
-	Height      *int                      `json:"height,omitempty"`
-	SizeBytes   int                       `json:"sizeBytes"`
-	UsageCount  int                       `json:"usageCount"`
-	CreatedAt   *time.Time                `json:"createdAt,omitempty"`
-	UpdatedAt   *time.Time                `json:"updatedAt,omitempty"`
+	ID            string                    `json:"id"`
+	Kind          AdminMediaLibraryItemKind `json:"kind"`
+	Name          string                    `json:"name"`
+	Value         string                    `json:"value"`
+	PreviewURL    string                    `json:"previewUrl"`
+	ContentType   *string                   `json:"contentType,omitempty"`
+	Width         *int                      `json:"width,omitempty"`
+	Height        *int                      `json:"height,omitempty"`
+	DominantColor *string                   `json:"dominantColor,omitempty"`
+	BlurHash      *string                   `json:"blurHash,omitempty"`
+	AltTexts      []*AdminMediaAltText      `json:"altTexts"`
+	Caption       *string                   `json:"caption,omitempty"`
+	Credit        *string                   `json:"credit,omitempty"`
+	Tags          []string                  `json:"tags"`
+	Folder        *string                   `json:"folder,omitempty"`
+	SizeBytes     int                       `json:"sizeBytes"`
+	UsageCount    int                       `json:"usageCount"`
+	QuarantinedAt *time.Time                `json:"quarantinedAt,omitempty"`
+	PurgeAfter    *time.Time                `json:"purgeAfter,omitempty"`
+	CreatedAt     *time.Time                `json:"createdAt,omitempty"`
+	UpdatedAt     *time.Time                `json:"updatedAt,omitempty"`
 }
 
 type AdminMediaLibraryListPayload struct {
@@ -437,6 +597,21 @@ type AdminMediaLibraryListPayload struct {
 	Size  int                      `json:"size"`
 }
 
+type AdminMergeContentTopicsInput struct {
+	SourceIds []string `json:"sourceIds"`
+	TargetID  string   `json:"targetId"`
+	DryRun    *bool    `json:"dryRun,omitempty"`
+}
+
+type AdminMoveMediaAssetsInput struct {
+	Ids    []string `json:"ids"`
+	Folder *string  `json:"folder,omitempty"`
+}
+
+type AdminMoveMediaAssetsPayload struct {
+	SuccessCount int `json:"successCount"`
+}
+
 type AdminMutation struct {
 }
 
@@ -552,6 +727,52 @@ type AdminNewsletterTestSendPayload struct {
 	PostTitle *string        `json:"postTitle,omitempty"`
 }
 
+type AdminOIDCConnectPayload struct {
+	URL scalars.URL `json:"url"`
+}
+
+type AdminOIDCDisconnectPayload struct {
+	Success bool `json:"success"`
+}
+
+type AdminOIDCLink struct {
+	Provider     string         `json:"provider"`
+	ProviderName string         `json:"providerName"`
+	Email        *scalars.Email `json:"email,omitempty"`
+	LinkedAt     time.Time      `json:"linkedAt"`
+}
+
+type AdminOIDCProvider struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	LoginAvailable bool   `json:"loginAvailable"`
+}
+
+type AdminPasskey struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Algorithm  string     `json:"algorithm"`
+	Transports []string   `json:"transports"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+}
+
+type AdminPasskeyCeremonyPayload struct {
+	OptionsJSON    string    `json:"optionsJson"`
+	ChallengeToken string    `json:"challengeToken"`
+	ExpiresAt      time.Time `json:"expiresAt"`
+}
+
+type AdminPasskeyLoginInput struct {
+	ChallengeToken string `json:"challengeToken"`
+	Credential     string `json:"credential"`
+	RememberMe     *bool  `json:"rememberMe,omitempty"`
+}
+
+type AdminPasskeyRevokePayload struct {
+	Success bool `json:"success"`
+}
+
 type AdminPasswordChangePayload struct {
 	Success bool `json:"success"`
 }
@@ -573,6 +794,17 @@ type AdminPasswordResetValidationPayload struct {
 type AdminQuery struct {
 }
 
+type AdminRenameContentCategoryInput struct {
+	SourceID string `json:"sourceId"`
+	TargetID string `json:"targetId"`
+	DryRun   *bool  `json:"dryRun,omitempty"`
+}
+
+type AdminRenameContentPostInput struct {
+	SourceID string `json:"sourceId"`
+	TargetID string `json:"targetId"`
+}
+
 type AdminRequestEmailChangeInput struct {
 	NewEmail        scalars.Email   `json:"newEmail"`
 	CurrentPassword string          `json:"currentPassword"`
@@ -620,6 +852,26 @@ type AdminStartGoogleConnectInput struct {
 	Locale *scalars.Locale `json:"locale,omitempty"`
 }
 
+type AdminStartOIDCConnectInput struct {
+	Provider string          `json:"provider"`
+	Locale   *scalars.Locale `json:"locale,omitempty"`
+}
+
+type AdminTwoFactorEnrollmentPayload struct {
+	Secret          string    `json:"secret"`
+	ProvisioningURI string    `json:"provisioningUri"`
+	ExpiresAt       time.Time `json:"expiresAt"`
+}
+
+type AdminTwoFactorPasswordInput struct {
+	CurrentPassword string `json:"currentPassword"`
+}
+
+type AdminTwoFactorRecoveryCodesPayload struct {
+	RecoveryCodes []string   `json:"recoveryCodes"`
+	User          *AdminUser `json:"user,omitempty"`
+}
+
 type AdminUpdateCommentStatusInput struct {
 	CommentID string             `json:"commentId"`
 	Status    AdminCommentStatus `json:"status"`
@@ -650,31 +902,52 @@ type AdminUpdateErrorMessageInput struct {
 	Message string                     `json:"message"`
 }
 
+type AdminUpdateMediaAssetMetadataInput struct {
+	AltTexts []*AdminMediaAltTextInput `json:"altTexts"`
+	Caption  *string                   `json:"caption,omitempty"`
+	Credit   *string                   `json:"credit,omitempty"`
+	Tags     []string                  `json:"tags"`
+	Folder   *string                   `json:"folder,omitempty"`
+}
+
 type AdminUpdateNewsletterSubscriberStatusInput struct {
 	Email  scalars.Email                   `json:"email"`
 	Status AdminNewsletterSubscriberStatus `json:"status"`
 }
 
 type AdminUploadMediaAssetInput struct {
-	FileName string `json:"fileName"`
-	DataURL  string `json:"dataUrl"`
+	FileName string          `json:"fileName"`
+	DataURL  *string         `json:"dataUrl,omitempty"`
+	File     *graphql.Upload `json:"file,omitempty"`
 }
 
 type AdminUser struct {
-	ID                    string         `json:"id"`
-	Name                  *string        `json:"name,omitempty"`
-	Username              *string        `json:"username,omitempty"`
-	AvatarURL             *scalars.URL   `json:"avatarUrl,omitempty"`
-	Email                 scalars.Email  `json:"email"`
-	PendingEmail          *scalars.Email `json:"pendingEmail,omitempty"`
-	PendingEmailExpiresAt *time.Time     `json:"pendingEmailExpiresAt,omitempty"`
-	GoogleLinked          bool           `json:"googleLinked"`
-	GoogleEmail           *scalars.Email `json:"googleEmail,omitempty"`
-	GoogleLinkedAt        *time.Time     `json:"googleLinkedAt,omitempty"`
-	GithubLinked          bool           `json:"githubLinked"`
-	GithubEmail           *scalars.Email `json:"githubEmail,omitempty"`
-	GithubLinkedAt        *time.Time     `json:"githubLinkedAt,omitempty"`
-	Roles                 []string       `json:"roles"`
+	ID                    string            `json:"id"`
+	Name                  *string           `json:"name,omitempty"`
+	Username              *string           `json:"username,omitempty"`
+	AvatarURL             *scalars.URL      `json:"avatarUrl,omitempty"`
+	Email                 scalars.Email     `json:"email"`
+	PendingEmail          *scalars.Email    `json:"pendingEmail,omitempty"`
+	PendingEmailExpiresAt *time.Time        `json:"pendingEmailExpiresAt,omitempty"`
+	GoogleLinked          bool              `json:"googleLinked"`
+	GoogleEmail           *scalars.Email    `json:"googleEmail,omitempty"`
+	GoogleLinkedAt        *time.Time        `json:"googleLinkedAt,omitempty"`
+	GithubLinked          bool              `json:"githubLinked"`
+	GithubEmail           *scalars.Email    `json:"githubEmail,omitempty"`
+	GithubLinkedAt        *time.Time        `json:"githubLinkedAt,omitempty"`
+	Roles                 []string          `json:"roles"`
+	Permissions           []AdminPermission `json:"permissions"`
+	Status                AdminUserStatus   `json:"status"`
+	CreatedAt             *time.Time        `json:"createdAt,omitempty"`
+	InvitationExpiresAt   *time.Time        `json:"invitationExpiresAt,omitempty"`
+	TwoFactorEnabled      bool              `json:"twoFactorEnabled"`
+	TwoFactorEnabledAt    *time.Time        `json:"twoFactorEnabledAt,omitempty"`
+	RecoveryCodesLeft     int               `json:"recoveryCodesLeft"`
+}
+
+type AdminVerifyTwoFactorLoginInput struct {
+	MfaToken string `json:"mfaToken"`
+	Code     string `json:"code"`
 }
 
 type AdminAuditStatus string
@@ -1182,6 +1455,138 @@ func (e AdminNewsletterSubscriberStatus) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type AdminPermission string
+
+const (
+	AdminPermissionAccount             AdminPermission = "ACCOUNT"
+	AdminPermissionDashboardRead       AdminPermission = "DASHBOARD_READ"
+	AdminPermissionContentRead         AdminPermission = "CONTENT_READ"
+	AdminPermissionContentWrite        AdminPermission = "CONTENT_WRITE"
+	AdminPermissionContentDelete       AdminPermission = "CONTENT_DELETE"
+	AdminPermissionMediaRead           AdminPermission = "MEDIA_READ"
+	AdminPermissionMediaWrite          AdminPermission = "MEDIA_WRITE"
+	AdminPermissionMediaDelete         AdminPermission = "MEDIA_DELETE"
+	AdminPermissionCommentsModerate    AdminPermission = "COMMENTS_MODERATE"
+	AdminPermissionNewsletterManage    AdminPermission = "NEWSLETTER_MANAGE"
+	AdminPermissionErrorMessagesManage AdminPermission = "ERROR_MESSAGES_MANAGE"
+	AdminPermissionUsersManage         AdminPermission = "USERS_MANAGE"
+)
+
+var AllAdminPermission = []AdminPermission{
+	AdminPermissionAccount,
+	AdminPermissionDashboardRead,
+	AdminPermissionContentRead,
+	AdminPermissionContentWrite,
+	AdminPermissionContentDelete,
+	AdminPermissionMediaRead,
+	AdminPermissionMediaWrite,
+	AdminPermissionMediaDelete,
+	AdminPermissionCommentsModerate,
+	AdminPermissionNewsletterManage,
+	AdminPermissionErrorMessagesManage,
+	AdminPermissionUsersManage,
+}
+
+func (e AdminPermission) IsValid() bool {
+	switch e {
+	case AdminPermissionAccount, AdminPermissionDashboardRead, AdminPermissionContentRead, AdminPermissionContentWrite, AdminPermissionContentDelete, AdminPermissionMediaRead, AdminPermissionMediaWrite, AdminPermissionMediaDelete, AdminPermissionCommentsModerate, AdminPermissionNewsletterManage, AdminPermissionErrorMessagesManage, AdminPermissionUsersManage:
+		return true
+	}
+	return false
+}
+
+func (e AdminPermission) String() string {
+	return string(e)
+}
+
+func (e *AdminPermission) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AdminPermission(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AdminPermission", str)
+	}
+	return nil
+}
+
+func (e AdminPermission) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *AdminPermission) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e AdminPermission) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type AdminUserStatus string
+
+const (
+	AdminUserStatusActive   AdminUserStatus = "ACTIVE"
+	AdminUserStatusInvited  AdminUserStatus = "INVITED"
+	AdminUserStatusDisabled AdminUserStatus = "DISABLED"
+)
+
+var AllAdminUserStatus = []AdminUserStatus{
+	AdminUserStatusActive,
+	AdminUserStatusInvited,
+	AdminUserStatusDisabled,
+}
+
+func (e AdminUserStatus) IsValid() bool {
+	switch e {
+	case AdminUserStatusActive, AdminUserStatusInvited, AdminUserStatusDisabled:
+		return true
+	}
+	return false
+}
+
+func (e AdminUserStatus) String() string {
+	return string(e)
+}
+
+func (e *AdminUserStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AdminUserStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AdminUserStatus", str)
+	}
+	return nil
+}
+
+func (e AdminUserStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *AdminUserStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e AdminUserStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type ContentSource string
 
 const (
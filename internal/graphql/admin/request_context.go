@@ -9,6 +9,8 @@ import (
 	"suaybsimsek.com/blog-api/internal/domain"
 	appservice "suaybsimsek.com/blog-api/internal/service"
 	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpapi"
+	"suaybsimsek.com/blog-api/pkg/httpauth"
 )
 
 type (
@@ -29,6 +31,7 @@ func withRequestContext(ctx context.Context, request *http.Request, responseWrit
 
 func WithAdminRequestContext(
 	ctx context.Context,
+	services *appservice.Service,
 	request *http.Request,
 	responseWriter http.ResponseWriter,
 ) (context.Context, error) {
@@ -37,6 +40,16 @@ func WithAdminRequestContext(
 		return ctx, nil
 	}
 
+	// A bearer token replaces the cookie session entirely, so a request carrying one never falls back to cookies.
+	if token, ok := httpauth.BearerToken(request); ok {
+		user, err := services.ResolveAdminFromPersonalAccessToken(ctx, token)
+		if err != nil {
+			httpapi.LogError(ctx, "admin access token lookup failed", err, "component", "admin_graphql")
+			return ctx, nil
+		}
+		return WithAdminUser(ctx, user), nil
+	}
+
 	config := appconfig.ResolveAdminConfig()
 	if config.AccessCookieName == "" {
 		return ctx, nil
@@ -47,7 +60,7 @@ func WithAdminRequestContext(
 		return ctx, nil
 	}
 
-	user, err := appservice.ResolveAdminFromAccessToken(ctx, cookie.Value)
+	user, err := services.ResolveAdminFromAccessToken(ctx, cookie.Value)
 	if err != nil {
 		return ctx, nil
 	}
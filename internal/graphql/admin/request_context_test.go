@@ -48,7 +48,7 @@ func TestAdminRequestContextHelpers(t *testing.T) {
 func TestWithAdminRequestContextWithoutSessionFallsBackGracefully(t *testing.T) {
 	recorder := httptest.NewRecorder()
 
-	ctx, err := WithAdminRequestContext(context.Background(), nil, recorder)
+	ctx, err := WithAdminRequestContext(context.Background(), nil, nil, recorder)
 	if err != nil {
 		t.Fatalf("WithAdminRequestContext(nil) returned error: %v", err)
 	}
@@ -57,7 +57,7 @@ func TestWithAdminRequestContextWithoutSessionFallsBackGracefully(t *testing.T)
 	}
 
 	requestWithoutCookie := httptest.NewRequest(http.MethodGet, "/admin", nil)
-	ctx, err = WithAdminRequestContext(context.Background(), requestWithoutCookie, recorder)
+	ctx, err = WithAdminRequestContext(context.Background(), nil, requestWithoutCookie, recorder)
 	if err != nil {
 		t.Fatalf("WithAdminRequestContext without cookie returned error: %v", err)
 	}
@@ -67,7 +67,7 @@ func TestWithAdminRequestContextWithoutSessionFallsBackGracefully(t *testing.T)
 
 	requestWithBlankCookie := httptest.NewRequest(http.MethodGet, "/admin", nil)
 	requestWithBlankCookie.AddCookie(&http.Cookie{Name: "admin_access", Value: ""})
-	ctx, err = WithAdminRequestContext(context.Background(), requestWithBlankCookie, recorder)
+	ctx, err = WithAdminRequestContext(context.Background(), nil, requestWithBlankCookie, recorder)
 	if err != nil {
 		t.Fatalf("WithAdminRequestContext with blank cookie returned error: %v", err)
 	}
@@ -75,3 +75,17 @@ func TestWithAdminRequestContextWithoutSessionFallsBackGracefully(t *testing.T)
 		t.Fatal("expected blank session cookie not to resolve a user")
 	}
 }
+
+func TestWithAdminRequestContextIgnoresCookiesForBearerRequests(t *testing.T) {
+	request := httptest.NewRequest(http.MethodGet, "/admin", nil)
+	request.Header.Set("Authorization", "Bearer not-an-access-token")
+	request.AddCookie(&http.Cookie{Name: "admin_access", Value: "session-token"})
+
+	ctx, err := WithAdminRequestContext(context.Background(), nil, request, httptest.NewRecorder())
+	if err != nil {
+		t.Fatalf("WithAdminRequestContext returned error: %v", err)
+	}
+	if getAdminUser(ctx) != nil {
+		t.Fatal("expected an unknown bearer token not to resolve a user")
+	}
+}
@@ -1,4 +1,8 @@
 package admingraphql
 
+import appservice "suaybsimsek.com/blog-api/internal/service"
+
 // Resolver contains admin GraphQL resolver dependencies.
-type Resolver struct{}
+type Resolver struct {
+	Service *appservice.Service
+}
@@ -6,17 +6,18 @@ import (
 
 	appconfig "suaybsimsek.com/blog-api/internal/config"
 	"suaybsimsek.com/blog-api/internal/graphql/admin/model"
+	appservice "suaybsimsek.com/blog-api/internal/service"
 	"suaybsimsek.com/blog-api/pkg/apperrors"
 	appscalars "suaybsimsek.com/blog-api/pkg/graphql/scalars"
 )
 
 // Dashboard is the resolver for the dashboard field.
-func (*adminQueryResolver) Dashboard(ctx context.Context) (*model.AdminDashboard, error) {
+func (r *adminQueryResolver) Dashboard(ctx context.Context) (*model.AdminDashboard, error) {
 	if _, err := requireAdminUser(ctx); err != nil {
 		return nil, err
 	}
 
-	payload, err := queryAdminDashboardFn(ctx)
+	payload, err := queryAdminDashboardFn(r.Service, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -25,13 +26,13 @@ func (*adminQueryResolver) Dashboard(ctx context.Context) (*model.AdminDashboard
 }
 
 // ActiveSessions is the resolver for the activeSessions field.
-func (*adminQueryResolver) ActiveSessions(ctx context.Context) ([]*model.AdminSession, error) {
+func (r *adminQueryResolver) ActiveSessions(ctx context.Context) ([]*model.AdminSession, error) {
 	adminUser, err := requireAdminUser(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	sessions, err := listActiveAdminSessionsFn(ctx, adminUser)
+	sessions, err := listActiveAdminSessionsFn(r.Service, ctx, adminUser)
 	if err != nil {
 		return nil, err
 	}
@@ -41,7 +42,7 @@ func (*adminQueryResolver) ActiveSessions(ctx context.Context) ([]*model.AdminSe
 }
 
 // ChangeName is the resolver for the changeName field.
-func (*adminMutationResolver) ChangeName(
+func (r *adminMutationResolver) ChangeName(
 	ctx context.Context,
 	input model.AdminChangeNameInput,
 ) (*model.AdminAuthPayload, error) {
@@ -50,7 +51,7 @@ func (*adminMutationResolver) ChangeName(
 		return nil, err
 	}
 
-	updatedAdminUser, err := changeAdminNameFn(ctx, adminUser, input.Name)
+	updatedAdminUser, err := changeAdminNameFn(r.Service, ctx, adminUser, input.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -62,7 +63,7 @@ func (*adminMutationResolver) ChangeName(
 }
 
 // ChangeAvatar is the resolver for the changeAvatar field.
-func (*adminMutationResolver) ChangeAvatar(
+func (r *adminMutationResolver) ChangeAvatar(
 	ctx context.Context,
 	input model.AdminChangeAvatarInput,
 ) (*model.AdminAuthPayload, error) {
@@ -71,7 +72,7 @@ func (*adminMutationResolver) ChangeAvatar(
 		return nil, err
 	}
 
-	updatedAdminUser, err := changeAdminAvatarFn(ctx, adminUser, urlPointerToStringPointer(input.AvatarURL))
+	updatedAdminUser, err := changeAdminAvatarFn(r.Service, ctx, adminUser, urlPointerToStringPointer(input.AvatarURL))
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +84,7 @@ func (*adminMutationResolver) ChangeAvatar(
 }
 
 // ChangeUsername is the resolver for the changeUsername field.
-func (*adminMutationResolver) ChangeUsername(
+func (r *adminMutationResolver) ChangeUsername(
 	ctx context.Context,
 	input model.AdminChangeUsernameInput,
 ) (*model.AdminAuthPayload, error) {
@@ -92,7 +93,7 @@ func (*adminMutationResolver) ChangeUsername(
 		return nil, err
 	}
 
-	updatedAdminUser, err := changeAdminUsernameFn(ctx, adminUser, input.NewUsername)
+	updatedAdminUser, err := changeAdminUsernameFn(r.Service, ctx, adminUser, input.NewUsername)
 	if err != nil {
 		return nil, err
 	}
@@ -104,7 +105,7 @@ func (*adminMutationResolver) ChangeUsername(
 }
 
 // RequestEmailChange is the resolver for the requestEmailChange field.
-func (*adminMutationResolver) RequestEmailChange(
+func (r *adminMutationResolver) RequestEmailChange(
 	ctx context.Context,
 	input model.AdminRequestEmailChangeInput,
 ) (*model.AdminEmailChangeRequestPayload, error) {
@@ -114,6 +115,7 @@ func (*adminMutationResolver) RequestEmailChange(
 	}
 
 	payload, err := requestAdminEmailChangeFn(
+		r.Service,
 		ctx,
 		adminUser,
 		string(input.NewEmail),
@@ -132,7 +134,7 @@ func (*adminMutationResolver) RequestEmailChange(
 }
 
 // DeleteAccount is the resolver for the deleteAccount field.
-func (*adminMutationResolver) DeleteAccount(
+func (r *adminMutationResolver) DeleteAccount(
 	ctx context.Context,
 	input model.AdminDeleteAccountInput,
 ) (*model.AdminAccountDeletePayload, error) {
@@ -141,7 +143,7 @@ func (*adminMutationResolver) DeleteAccount(
 		return nil, err
 	}
 
-	if err := deleteAdminAccountFn(ctx, adminUser, input.CurrentPassword); err != nil {
+	if err := deleteAdminAccountFn(r.Service, ctx, adminUser, input.CurrentPassword); err != nil {
 		return nil, err
 	}
 
@@ -153,7 +155,7 @@ func (*adminMutationResolver) DeleteAccount(
 }
 
 // ChangePassword is the resolver for the changePassword field.
-func (*adminMutationResolver) ChangePassword(
+func (r *adminMutationResolver) ChangePassword(
 	ctx context.Context,
 	input model.AdminChangePasswordInput,
 ) (*model.AdminPasswordChangePayload, error) {
@@ -163,6 +165,7 @@ func (*adminMutationResolver) ChangePassword(
 	}
 
 	if err := changeAdminPasswordFn(
+		r.Service,
 		ctx,
 		adminUser,
 		input.CurrentPassword,
@@ -179,14 +182,93 @@ func (*adminMutationResolver) ChangePassword(
 	return &model.AdminPasswordChangePayload{Success: true}, nil
 }
 
+// StartTwoFactorEnrollment is the resolver for the startTwoFactorEnrollment field.
+func (r *adminMutationResolver) StartTwoFactorEnrollment(
+	ctx context.Context,
+	input model.AdminTwoFactorPasswordInput,
+) (*model.AdminTwoFactorEnrollmentPayload, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollment, err := startAdminTwoFactorEnrollmentFn(r.Service, ctx, adminUser, input.CurrentPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.AdminTwoFactorEnrollmentPayload{
+		Secret:          enrollment.Secret,
+		ProvisioningURI: enrollment.ProvisioningURI,
+		ExpiresAt:       enrollment.ExpiresAt,
+	}, nil
+}
+
+// EnableTwoFactor is the resolver for the enableTwoFactor field.
+func (r *adminMutationResolver) EnableTwoFactor(
+	ctx context.Context,
+	input model.AdminEnableTwoFactorInput,
+) (*model.AdminTwoFactorRecoveryCodesPayload, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := enableAdminTwoFactorFn(r.Service, ctx, adminUser, input.CurrentPassword, input.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapAdminTwoFactorRecoveryCodes(result), nil
+}
+
+// DisableTwoFactor is the resolver for the disableTwoFactor field.
+func (r *adminMutationResolver) DisableTwoFactor(
+	ctx context.Context,
+	input model.AdminTwoFactorPasswordInput,
+) (*model.AdminAuthPayload, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedUser, err := disableAdminTwoFactorFn(r.Service, ctx, adminUser, input.CurrentPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.AdminAuthPayload{
+		Success: true,
+		User:    mapAdminUser(updatedUser),
+	}, nil
+}
+
+// RegenerateTwoFactorRecoveryCodes is the resolver for the regenerateTwoFactorRecoveryCodes field.
+func (r *adminMutationResolver) RegenerateTwoFactorRecoveryCodes(
+	ctx context.Context,
+	input model.AdminTwoFactorPasswordInput,
+) (*model.AdminTwoFactorRecoveryCodesPayload, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := regenerateAdminRecoveryCodesFn(r.Service, ctx, adminUser, input.CurrentPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapAdminTwoFactorRecoveryCodes(result), nil
+}
+
 // RevokeSession is the resolver for the revokeSession field.
-func (*adminMutationResolver) RevokeSession(ctx context.Context, sessionID string) (*model.AdminSessionRevokePayload, error) {
+func (r *adminMutationResolver) RevokeSession(ctx context.Context, sessionID string) (*model.AdminSessionRevokePayload, error) {
 	adminUser, err := requireAdminUser(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	revoked, err := revokeAdminSessionFn(ctx, adminUser, sessionID)
+	revoked, err := revokeAdminSessionFn(r.Service, ctx, adminUser, sessionID)
 	if err != nil {
 		return nil, err
 	}
@@ -202,16 +284,83 @@ func (*adminMutationResolver) RevokeSession(ctx context.Context, sessionID strin
 }
 
 // RevokeAllSessions is the resolver for the revokeAllSessions field.
-func (*adminMutationResolver) RevokeAllSessions(ctx context.Context) (*model.AdminSessionRevokePayload, error) {
+func (r *adminMutationResolver) RevokeAllSessions(ctx context.Context) (*model.AdminSessionRevokePayload, error) {
 	adminUser, err := requireAdminUser(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := revokeAllAdminSessionsFn(ctx, adminUser); err != nil {
+	if err := revokeAllAdminSessionsFn(r.Service, ctx, adminUser); err != nil {
 		return nil, err
 	}
 
 	clearAdminSessionCookies(getResponseWriter(ctx), appconfig.ResolveAdminConfig())
 	return &model.AdminSessionRevokePayload{Success: true}, nil
 }
+
+// AccessTokens is the resolver for the accessTokens field.
+func (r *adminQueryResolver) AccessTokens(ctx context.Context) ([]*model.AdminAccessToken, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := listAdminAccessTokensFn(r.Service, ctx, adminUser)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.AdminAccessToken, 0, len(tokens))
+	for index := range tokens {
+		items = append(items, mapAdminAccessToken(&tokens[index]))
+	}
+	return items, nil
+}
+
+// CreateAccessToken is the resolver for the createAccessToken field.
+func (r *adminMutationResolver) CreateAccessToken(
+	ctx context.Context,
+	input model.AdminCreateAccessTokenInput,
+) (*model.AdminAccessTokenCreatePayload, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := make([]appservice.AdminPermission, 0, len(input.Scopes))
+	for _, scope := range input.Scopes {
+		scopes = append(scopes, appservice.AdminPermission(scope))
+	}
+	expiresInDays := 0
+	if input.ExpiresInDays != nil {
+		expiresInDays = *input.ExpiresInDays
+	}
+
+	created, err := createAdminAccessTokenFn(r.Service, ctx, adminUser, input.Name, scopes, expiresInDays)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.AdminAccessTokenCreatePayload{
+		Token:       created.Token,
+		AccessToken: mapAdminAccessToken(&created.Record),
+	}, nil
+}
+
+// RevokeAccessToken is the resolver for the revokeAccessToken field.
+func (r *adminMutationResolver) RevokeAccessToken(ctx context.Context, id string) (*model.AdminAccessTokenRevokePayload, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := revokeAdminAccessTokenFn(r.Service, ctx, adminUser, id)
+	if err != nil {
+		return nil, err
+	}
+	if !revoked {
+		return nil, apperrors.BadRequest("access token not found")
+	}
+
+	return &model.AdminAccessTokenRevokePayload{Success: true}, nil
+}
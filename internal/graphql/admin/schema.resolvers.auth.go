@@ -6,6 +6,7 @@ import (
 
 	appconfig "suaybsimsek.com/blog-api/internal/config"
 	"suaybsimsek.com/blog-api/internal/graphql/admin/model"
+	appservice "suaybsimsek.com/blog-api/internal/service"
 	"suaybsimsek.com/blog-api/pkg/apperrors"
 	appscalars "suaybsimsek.com/blog-api/pkg/graphql/scalars"
 	"suaybsimsek.com/blog-api/pkg/httpauth"
@@ -25,12 +26,12 @@ func (*adminQueryResolver) Me(ctx context.Context) (*model.AdminMe, error) {
 }
 
 // ValidatePasswordResetToken is the resolver for the validatePasswordResetToken field.
-func (*adminQueryResolver) ValidatePasswordResetToken(
+func (r *adminQueryResolver) ValidatePasswordResetToken(
 	ctx context.Context,
 	token string,
 	locale *appscalars.Locale,
 ) (*model.AdminPasswordResetValidationPayload, error) {
-	result, err := validateAdminPasswordResetTokenFn(ctx, strings.TrimSpace(token), localePointerValue(locale))
+	result, err := validateAdminPasswordResetTokenFn(r.Service, ctx, strings.TrimSpace(token), localePointerValue(locale))
 	if err != nil {
 		return nil, err
 	}
@@ -42,8 +43,8 @@ func (*adminQueryResolver) ValidatePasswordResetToken(
 }
 
 // GoogleAuthStatus is the resolver for the googleAuthStatus field.
-func (*adminQueryResolver) GoogleAuthStatus(ctx context.Context) (*model.AdminGoogleAuthStatus, error) {
-	payload, err := queryAdminGoogleAuthStatusFn(ctx)
+func (r *adminQueryResolver) GoogleAuthStatus(ctx context.Context) (*model.AdminGoogleAuthStatus, error) {
+	payload, err := queryAdminGoogleAuthStatusFn(r.Service, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -59,8 +60,8 @@ func (*adminQueryResolver) GoogleAuthStatus(ctx context.Context) (*model.AdminGo
 }
 
 // GithubAuthStatus is the resolver for the githubAuthStatus field.
-func (*adminQueryResolver) GithubAuthStatus(ctx context.Context) (*model.AdminGithubAuthStatus, error) {
-	payload, err := queryAdminGithubAuthStatusFn(ctx)
+func (r *adminQueryResolver) GithubAuthStatus(ctx context.Context) (*model.AdminGithubAuthStatus, error) {
+	payload, err := queryAdminGithubAuthStatusFn(r.Service, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -76,9 +77,10 @@ func (*adminQueryResolver) GithubAuthStatus(ctx context.Context) (*model.AdminGi
 }
 
 // Login is the resolver for the login field.
-func (*adminMutationResolver) Login(ctx context.Context, input model.AdminLoginInput) (*model.AdminAuthPayload, error) {
+func (r *adminMutationResolver) Login(ctx context.Context, input model.AdminLoginInput) (*model.AdminAuthPayload, error) {
 	rememberMe := input.RememberMe != nil && *input.RememberMe
 	payload, err := loginAdminFn(
+		r.Service,
 		ctx,
 		strings.TrimSpace(string(input.Email)),
 		input.Password,
@@ -88,7 +90,37 @@ func (*adminMutationResolver) Login(ctx context.Context, input model.AdminLoginI
 	if err != nil {
 		return nil, err
 	}
+	if payload.MFARequired {
+		return &model.AdminAuthPayload{
+			MfaRequired:  true,
+			MfaToken:     &payload.MFAToken,
+			MfaExpiresAt: &payload.MFAExpiresAt,
+		}, nil
+	}
+
+	return setAdminLoginCookies(ctx, payload), nil
+}
 
+// VerifyTwoFactorLogin is the resolver for the verifyTwoFactorLogin field.
+func (r *adminMutationResolver) VerifyTwoFactorLogin(
+	ctx context.Context,
+	input model.AdminVerifyTwoFactorLoginInput,
+) (*model.AdminAuthPayload, error) {
+	payload, err := completeAdminTwoFactorLoginFn(
+		r.Service,
+		ctx,
+		input.MfaToken,
+		input.Code,
+		resolveAdminSessionMetadata(ctx, getRequest(ctx)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return setAdminLoginCookies(ctx, payload), nil
+}
+
+func setAdminLoginCookies(ctx context.Context, payload *appservice.AdminAuthResponse) *model.AdminAuthPayload {
 	responseWriter := getResponseWriter(ctx)
 	config := appconfig.ResolveAdminConfig()
 	httpauth.SetSessionCookie(
@@ -103,7 +135,7 @@ func (*adminMutationResolver) Login(ctx context.Context, input model.AdminLoginI
 	return &model.AdminAuthPayload{
 		Success: payload.Success,
 		User:    mapAdminUser(payload.User),
-	}, nil
+	}
 }
 
 // StartGoogleConnect is the resolver for the startGoogleConnect field.
@@ -129,13 +161,13 @@ func (*adminMutationResolver) StartGoogleConnect(
 }
 
 // DisconnectGoogle is the resolver for the disconnectGoogle field.
-func (*adminMutationResolver) DisconnectGoogle(ctx context.Context) (*model.AdminGoogleDisconnectPayload, error) {
+func (r *adminMutationResolver) DisconnectGoogle(ctx context.Context) (*model.AdminGoogleDisconnectPayload, error) {
 	adminUser, err := requireAdminUser(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	updatedUser, err := disconnectAdminGoogleAccountFn(ctx, adminUser)
+	updatedUser, err := disconnectAdminGoogleAccountFn(r.Service, ctx, adminUser)
 	if err != nil {
 		return nil, err
 	}
@@ -169,13 +201,13 @@ func (*adminMutationResolver) StartGithubConnect(
 }
 
 // DisconnectGithub is the resolver for the disconnectGithub field.
-func (*adminMutationResolver) DisconnectGithub(ctx context.Context) (*model.AdminGithubDisconnectPayload, error) {
+func (r *adminMutationResolver) DisconnectGithub(ctx context.Context) (*model.AdminGithubDisconnectPayload, error) {
 	adminUser, err := requireAdminUser(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	updatedUser, err := disconnectAdminGithubAccountFn(ctx, adminUser)
+	updatedUser, err := disconnectAdminGithubAccountFn(r.Service, ctx, adminUser)
 	if err != nil {
 		return nil, err
 	}
@@ -187,7 +219,7 @@ func (*adminMutationResolver) DisconnectGithub(ctx context.Context) (*model.Admi
 }
 
 // RefreshAdminSession is the resolver for the refreshAdminSession field.
-func (*adminMutationResolver) RefreshAdminSession(ctx context.Context) (*model.AdminAuthPayload, error) {
+func (r *adminMutationResolver) RefreshAdminSession(ctx context.Context) (*model.AdminAuthPayload, error) {
 	request := getRequest(ctx)
 	if request == nil {
 		return nil, apperrors.Config("missing admin request context", nil)
@@ -200,6 +232,7 @@ func (*adminMutationResolver) RefreshAdminSession(ctx context.Context) (*model.A
 	}
 
 	payload, err := refreshAdminSessionFn(
+		r.Service,
 		ctx,
 		strings.TrimSpace(refreshCookie.Value),
 		resolveAdminSessionMetadata(ctx, request),
@@ -225,13 +258,13 @@ func (*adminMutationResolver) RefreshAdminSession(ctx context.Context) (*model.A
 }
 
 // Logout is the resolver for the logout field.
-func (*adminMutationResolver) Logout(ctx context.Context) (*model.AdminLogoutPayload, error) {
+func (r *adminMutationResolver) Logout(ctx context.Context) (*model.AdminLogoutPayload, error) {
 	request := getRequest(ctx)
 	responseWriter := getResponseWriter(ctx)
 	config := appconfig.ResolveAdminConfig()
 	if request != nil {
 		if refreshCookie, err := request.Cookie(config.RefreshCookieName); err == nil {
-			if err := logoutAdminFn(ctx, strings.TrimSpace(refreshCookie.Value)); err != nil {
+			if err := logoutAdminFn(r.Service, ctx, strings.TrimSpace(refreshCookie.Value)); err != nil {
 				return nil, err
 			}
 		}
@@ -242,11 +275,11 @@ func (*adminMutationResolver) Logout(ctx context.Context) (*model.AdminLogoutPay
 }
 
 // RequestPasswordReset is the resolver for the requestPasswordReset field.
-func (*adminMutationResolver) RequestPasswordReset(
+func (r *adminMutationResolver) RequestPasswordReset(
 	ctx context.Context,
 	input model.AdminRequestPasswordResetInput,
 ) (*model.AdminPasswordResetRequestPayload, error) {
-	if err := requestAdminPasswordResetFn(ctx, string(input.Email), localePointerValue(input.Locale)); err != nil {
+	if err := requestAdminPasswordResetFn(r.Service, ctx, string(input.Email), localePointerValue(input.Locale)); err != nil {
 		return nil, err
 	}
 
@@ -254,12 +287,12 @@ func (*adminMutationResolver) RequestPasswordReset(
 }
 
 // ConfirmEmailChange is the resolver for the confirmEmailChange field.
-func (*adminMutationResolver) ConfirmEmailChange(
+func (r *adminMutationResolver) ConfirmEmailChange(
 	ctx context.Context,
 	token string,
 	locale *appscalars.Locale,
 ) (*model.AdminEmailChangeConfirmPayload, error) {
-	result, err := confirmAdminEmailChangeFn(ctx, strings.TrimSpace(token), localePointerValue(locale))
+	result, err := confirmAdminEmailChangeFn(r.Service, ctx, strings.TrimSpace(token), localePointerValue(locale))
 	if err != nil {
 		return nil, err
 	}
@@ -271,11 +304,12 @@ func (*adminMutationResolver) ConfirmEmailChange(
 }
 
 // ConfirmPasswordReset is the resolver for the confirmPasswordReset field.
-func (*adminMutationResolver) ConfirmPasswordReset(
+func (r *adminMutationResolver) ConfirmPasswordReset(
 	ctx context.Context,
 	input model.AdminConfirmPasswordResetInput,
 ) (*model.AdminPasswordResetConfirmPayload, error) {
 	result, err := resetAdminPasswordWithTokenFn(
+		r.Service,
 		ctx,
 		strings.TrimSpace(input.Token),
 		input.NewPassword,
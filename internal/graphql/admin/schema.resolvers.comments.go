@@ -9,7 +9,7 @@ import (
 )
 
 // Comments is the resolver for the comments field.
-func (*adminQueryResolver) Comments(
+func (r *adminQueryResolver) Comments(
 	ctx context.Context,
 	filter *model.AdminCommentFilterInput,
 ) (*model.AdminCommentListPayload, error) {
@@ -37,7 +37,7 @@ func (*adminQueryResolver) Comments(
 		}
 	}
 
-	payload, err := listAdminCommentsFn(ctx, adminUser, resolvedFilter)
+	payload, err := listAdminCommentsFn(r.Service, ctx, adminUser, resolvedFilter)
 	if err != nil {
 		return nil, err
 	}
@@ -46,7 +46,7 @@ func (*adminQueryResolver) Comments(
 }
 
 // UpdateCommentStatus is the resolver for the updateCommentStatus field.
-func (*adminMutationResolver) UpdateCommentStatus(
+func (r *adminMutationResolver) UpdateCommentStatus(
 	ctx context.Context,
 	input model.AdminUpdateCommentStatusInput,
 ) (*model.AdminComment, error) {
@@ -56,6 +56,7 @@ func (*adminMutationResolver) UpdateCommentStatus(
 	}
 
 	updated, err := updateAdminCommentStatusFn(
+		r.Service,
 		ctx,
 		adminUser,
 		strings.TrimSpace(input.CommentID),
@@ -69,7 +70,7 @@ func (*adminMutationResolver) UpdateCommentStatus(
 }
 
 // DeleteComment is the resolver for the deleteComment field.
-func (*adminMutationResolver) DeleteComment(
+func (r *adminMutationResolver) DeleteComment(
 	ctx context.Context,
 	input model.AdminDeleteCommentInput,
 ) (*model.AdminDeletePayload, error) {
@@ -78,7 +79,7 @@ func (*adminMutationResolver) DeleteComment(
 		return nil, err
 	}
 
-	if err := deleteAdminCommentFn(ctx, adminUser, strings.TrimSpace(input.CommentID)); err != nil {
+	if err := deleteAdminCommentFn(r.Service, ctx, adminUser, strings.TrimSpace(input.CommentID)); err != nil {
 		return nil, err
 	}
 
@@ -86,7 +87,7 @@ func (*adminMutationResolver) DeleteComment(
 }
 
 // BulkUpdateCommentStatus is the resolver for the bulkUpdateCommentStatus field.
-func (*adminMutationResolver) BulkUpdateCommentStatus(
+func (r *adminMutationResolver) BulkUpdateCommentStatus(
 	ctx context.Context,
 	input model.AdminBulkUpdateCommentStatusInput,
 ) (*model.AdminBulkCommentMutationPayload, error) {
@@ -101,6 +102,7 @@ func (*adminMutationResolver) BulkUpdateCommentStatus(
 	}
 
 	successCount, err := bulkUpdateAdminCommentStatusFn(
+		r.Service,
 		ctx,
 		adminUser,
 		commentIDs,
@@ -114,7 +116,7 @@ func (*adminMutationResolver) BulkUpdateCommentStatus(
 }
 
 // BulkDeleteComments is the resolver for the bulkDeleteComments field.
-func (*adminMutationResolver) BulkDeleteComments(
+func (r *adminMutationResolver) BulkDeleteComments(
 	ctx context.Context,
 	input model.AdminBulkDeleteCommentsInput,
 ) (*model.AdminBulkCommentMutationPayload, error) {
@@ -128,7 +130,7 @@ func (*adminMutationResolver) BulkDeleteComments(
 		commentIDs = append(commentIDs, strings.TrimSpace(commentID))
 	}
 
-	successCount, err := bulkDeleteAdminCommentsFn(ctx, adminUser, commentIDs)
+	successCount, err := bulkDeleteAdminCommentsFn(r.Service, ctx, adminUser, commentIDs)
 	if err != nil {
 		return nil, err
 	}
@@ -10,7 +10,7 @@ import (
 )
 
 // ContentPostRevisions is the resolver for the contentPostRevisions field.
-func (*adminQueryResolver) ContentPostRevisions(
+func (r *adminQueryResolver) ContentPostRevisions(
 	ctx context.Context,
 	input model.AdminContentEntityKeyInput,
 	page *int,
@@ -22,6 +22,7 @@ func (*adminQueryResolver) ContentPostRevisions(
 	}
 
 	payload, err := listAdminContentPostRevisionsFn(
+		r.Service,
 		ctx,
 		adminUser,
 		normalizeAdminLocale(input.Locale),
@@ -37,7 +38,7 @@ func (*adminQueryResolver) ContentPostRevisions(
 }
 
 // ContentPosts is the resolver for the contentPosts field.
-func (*adminQueryResolver) ContentPosts( // NOSONAR
+func (r *adminQueryResolver) ContentPosts( // NOSONAR
 	ctx context.Context,
 	filter *model.AdminContentPostFilterInput,
 ) (*model.AdminContentPostListPayload, error) {
@@ -74,7 +75,7 @@ func (*adminQueryResolver) ContentPosts( // NOSONAR
 		}
 	}
 
-	payload, err := listAdminContentPostsFn(ctx, adminUser, resolvedFilter)
+	payload, err := listAdminContentPostsFn(r.Service, ctx, adminUser, resolvedFilter)
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +84,7 @@ func (*adminQueryResolver) ContentPosts( // NOSONAR
 }
 
 // ContentPost is the resolver for the contentPost field.
-func (*adminQueryResolver) ContentPost(
+func (r *adminQueryResolver) ContentPost(
 	ctx context.Context,
 	input model.AdminContentEntityKeyInput,
 ) (*model.AdminContentPost, error) {
@@ -93,6 +94,7 @@ func (*adminQueryResolver) ContentPost(
 	}
 
 	record, err := getAdminContentPostFn(
+		r.Service,
 		ctx,
 		adminUser,
 		normalizeAdminLocale(input.Locale),
@@ -106,7 +108,7 @@ func (*adminQueryResolver) ContentPost(
 }
 
 // ContentTopicsPage is the resolver for the contentTopicsPage field.
-func (*adminQueryResolver) ContentTopicsPage(
+func (r *adminQueryResolver) ContentTopicsPage(
 	ctx context.Context,
 	filter *model.AdminContentTaxonomyFilterInput,
 ) (*model.AdminContentTopicListPayload, error) {
@@ -134,7 +136,7 @@ func (*adminQueryResolver) ContentTopicsPage(
 		}
 	}
 
-	payload, err := listAdminContentTopicsPageFn(ctx, adminUser, resolvedFilter)
+	payload, err := listAdminContentTopicsPageFn(r.Service, ctx, adminUser, resolvedFilter)
 	if err != nil {
 		return nil, err
 	}
@@ -143,7 +145,7 @@ func (*adminQueryResolver) ContentTopicsPage(
 }
 
 // ContentCategoriesPage is the resolver for the contentCategoriesPage field.
-func (*adminQueryResolver) ContentCategoriesPage(
+func (r *adminQueryResolver) ContentCategoriesPage(
 	ctx context.Context,
 	filter *model.AdminContentTaxonomyFilterInput,
 ) (*model.AdminContentCategoryListPayload, error) {
@@ -171,7 +173,7 @@ func (*adminQueryResolver) ContentCategoriesPage(
 		}
 	}
 
-	payload, err := listAdminContentCategoriesPageFn(ctx, adminUser, resolvedFilter)
+	payload, err := listAdminContentCategoriesPageFn(r.Service, ctx, adminUser, resolvedFilter)
 	if err != nil {
 		return nil, err
 	}
@@ -179,8 +181,45 @@ func (*adminQueryResolver) ContentCategoriesPage(
 	return mapAdminContentCategoryListPayload(payload), nil
 }
 
+// ContentSeriesPage is the resolver for the contentSeriesPage field.
+func (r *adminQueryResolver) ContentSeriesPage(
+	ctx context.Context,
+	filter *model.AdminContentTaxonomyFilterInput,
+) (*model.AdminContentSeriesListPayload, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvedFilter := domain.AdminContentTaxonomyFilter{}
+	if filter != nil {
+		if filter.Locale != nil {
+			resolvedFilter.Locale = normalizeAdminLocalePointer(filter.Locale)
+		}
+		if filter.PreferredLocale != nil {
+			resolvedFilter.PreferredLocale = normalizeAdminLocalePointer(filter.PreferredLocale)
+		}
+		if filter.Query != nil {
+			resolvedFilter.Query = strings.TrimSpace(*filter.Query)
+		}
+		if filter.Page != nil {
+			resolvedFilter.Page = filter.Page
+		}
+		if filter.Size != nil {
+			resolvedFilter.Size = filter.Size
+		}
+	}
+
+	payload, err := listAdminContentSeriesPageFn(r.Service, ctx, adminUser, resolvedFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapAdminContentSeriesListPayload(payload), nil
+}
+
 // ContentTopics is the resolver for the contentTopics field.
-func (*adminQueryResolver) ContentTopics(
+func (r *adminQueryResolver) ContentTopics(
 	ctx context.Context,
 	locale *appscalars.Locale,
 	query *string,
@@ -197,7 +236,7 @@ func (*adminQueryResolver) ContentTopics(
 		resolvedQuery = strings.TrimSpace(*query)
 	}
 
-	records, err := listAdminContentTopicsFn(ctx, adminUser, resolvedLocale, resolvedQuery)
+	records, err := listAdminContentTopicsFn(r.Service, ctx, adminUser, resolvedLocale, resolvedQuery)
 	if err != nil {
 		return nil, err
 	}
@@ -206,7 +245,7 @@ func (*adminQueryResolver) ContentTopics(
 }
 
 // ContentCategories is the resolver for the contentCategories field.
-func (*adminQueryResolver) ContentCategories(
+func (r *adminQueryResolver) ContentCategories(
 	ctx context.Context,
 	locale *appscalars.Locale,
 ) ([]*model.AdminContentCategory, error) {
@@ -217,7 +256,7 @@ func (*adminQueryResolver) ContentCategories(
 
 	resolvedLocale := normalizeAdminLocalePointer(locale)
 
-	records, err := listAdminContentCategoriesFn(ctx, adminUser, resolvedLocale)
+	records, err := listAdminContentCategoriesFn(r.Service, ctx, adminUser, resolvedLocale)
 	if err != nil {
 		return nil, err
 	}
@@ -226,7 +265,7 @@ func (*adminQueryResolver) ContentCategories(
 }
 
 // MediaLibrary is the resolver for the mediaLibrary field.
-func (*adminQueryResolver) MediaLibrary(
+func (r *adminQueryResolver) MediaLibrary(
 	ctx context.Context,
 	filter *model.AdminMediaLibraryFilterInput,
 ) (*model.AdminMediaLibraryListPayload, error) {
@@ -243,6 +282,15 @@ func (*adminQueryResolver) MediaLibrary(
 		if filter.Kind != nil {
 			resolvedFilter.Kind = strings.TrimSpace(filter.Kind.String())
 		}
+		if filter.Tag != nil {
+			resolvedFilter.Tag = strings.TrimSpace(*filter.Tag)
+		}
+		if filter.Folder != nil {
+			resolvedFilter.Folder = strings.TrimSpace(*filter.Folder)
+		}
+		if filter.Quarantined != nil {
+			resolvedFilter.Quarantined = *filter.Quarantined
+		}
 		if filter.Sort != nil {
 			resolvedFilter.Sort = strings.TrimSpace(filter.Sort.String())
 		}
@@ -254,7 +302,7 @@ func (*adminQueryResolver) MediaLibrary(
 		}
 	}
 
-	records, err := listAdminMediaLibraryFn(ctx, adminUser, resolvedFilter)
+	records, err := listAdminMediaLibraryFn(r.Service, ctx, adminUser, resolvedFilter)
 	if err != nil {
 		return nil, err
 	}
@@ -267,8 +315,26 @@ func (*adminQueryResolver) MediaLibrary(
 	}, nil
 }
 
+// MediaLibraryFacets is the resolver for the mediaLibraryFacets field.
+func (r *adminQueryResolver) MediaLibraryFacets(ctx context.Context) (*model.AdminMediaLibraryFacets, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	facets, err := listAdminMediaLibraryFacetsFn(r.Service, ctx, adminUser)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.AdminMediaLibraryFacets{
+		Folders: append([]string{}, facets.Folders...),
+		Tags:    append([]string{}, facets.Tags...),
+	}, nil
+}
+
 // UpdateContentPostMetadata is the resolver for the updateContentPostMetadata field.
-func (*adminMutationResolver) UpdateContentPostMetadata(
+func (r *adminMutationResolver) UpdateContentPostMetadata(
 	ctx context.Context,
 	input model.AdminUpdateContentPostMetadataInput,
 ) (*model.AdminContentPost, error) {
@@ -277,7 +343,7 @@ func (*adminMutationResolver) UpdateContentPostMetadata(
 		return nil, err
 	}
 
-	updated, err := updateAdminContentPostMetadataFn(ctx, adminUser, domain.AdminContentPostMetadataInput{
+	updated, err := updateAdminContentPostMetadataFn(r.Service, ctx, adminUser, domain.AdminContentPostMetadataInput{
 		Locale:        normalizeAdminLocale(input.Locale),
 		ID:            strings.TrimSpace(input.ID),
 		Title:         toOptionalTrimmedInputString(input.Title),
@@ -298,7 +364,7 @@ func (*adminMutationResolver) UpdateContentPostMetadata(
 }
 
 // UpdateContentPostContent is the resolver for the updateContentPostContent field.
-func (*adminMutationResolver) UpdateContentPostContent(
+func (r *adminMutationResolver) UpdateContentPostContent(
 	ctx context.Context,
 	input model.AdminUpdateContentPostContentInput,
 ) (*model.AdminContentPost, error) {
@@ -307,7 +373,7 @@ func (*adminMutationResolver) UpdateContentPostContent(
 		return nil, err
 	}
 
-	updated, err := updateAdminContentPostContentFn(ctx, adminUser, domain.AdminContentPostContentInput{
+	updated, err := updateAdminContentPostContentFn(r.Service, ctx, adminUser, domain.AdminContentPostContentInput{
 		Locale:  normalizeAdminLocale(input.Locale),
 		ID:      strings.TrimSpace(input.ID),
 		Content: input.Content,
@@ -320,7 +386,7 @@ func (*adminMutationResolver) UpdateContentPostContent(
 }
 
 // RestoreContentPostRevision is the resolver for the restoreContentPostRevision field.
-func (*adminMutationResolver) RestoreContentPostRevision(
+func (r *adminMutationResolver) RestoreContentPostRevision(
 	ctx context.Context,
 	input model.AdminRestoreContentPostRevisionInput,
 ) (*model.AdminContentPost, error) {
@@ -330,6 +396,7 @@ func (*adminMutationResolver) RestoreContentPostRevision(
 	}
 
 	updated, err := restoreAdminContentPostRevisionFn(
+		r.Service,
 		ctx,
 		adminUser,
 		normalizeAdminLocale(input.Locale),
@@ -344,7 +411,7 @@ func (*adminMutationResolver) RestoreContentPostRevision(
 }
 
 // UploadMediaAsset is the resolver for the uploadMediaAsset field.
-func (*adminMutationResolver) UploadMediaAsset(
+func (r *adminMutationResolver) UploadMediaAsset(
 	ctx context.Context,
 	input model.AdminUploadMediaAssetInput,
 ) (*model.AdminMediaLibraryItem, error) {
@@ -353,10 +420,7 @@ func (*adminMutationResolver) UploadMediaAsset(
 		return nil, err
 	}
 
-	record, err := uploadAdminMediaAssetFn(ctx, adminUser, domain.AdminMediaUploadInput{
-		FileName: strings.TrimSpace(input.FileName),
-		DataURL:  strings.TrimSpace(input.DataURL),
-	})
+	record, err := uploadAdminMediaAssetFn(r.Service, ctx, adminUser, mapAdminMediaUploadInput(input))
 	if err != nil {
 		return nil, err
 	}
@@ -365,7 +429,7 @@ func (*adminMutationResolver) UploadMediaAsset(
 }
 
 // ReplaceMediaAsset is the resolver for the replaceMediaAsset field.
-func (*adminMutationResolver) ReplaceMediaAsset(
+func (r *adminMutationResolver) ReplaceMediaAsset(
 	ctx context.Context,
 	id string,
 	input model.AdminUploadMediaAssetInput,
@@ -375,10 +439,7 @@ func (*adminMutationResolver) ReplaceMediaAsset(
 		return nil, err
 	}
 
-	record, err := replaceAdminMediaAssetFn(ctx, adminUser, strings.TrimSpace(id), domain.AdminMediaUploadInput{
-		FileName: strings.TrimSpace(input.FileName),
-		DataURL:  strings.TrimSpace(input.DataURL),
-	})
+	record, err := replaceAdminMediaAssetFn(r.Service, ctx, adminUser, strings.TrimSpace(id), mapAdminMediaUploadInput(input))
 	if err != nil {
 		return nil, err
 	}
@@ -386,22 +447,101 @@ func (*adminMutationResolver) ReplaceMediaAsset(
 	return mapAdminMediaLibraryItem(record), nil
 }
 
+// UpdateMediaAssetMetadata is the resolver for the updateMediaAssetMetadata field.
+func (r *adminMutationResolver) UpdateMediaAssetMetadata(
+	ctx context.Context,
+	id string,
+	input model.AdminUpdateMediaAssetMetadataInput,
+) (*model.AdminMediaLibraryItem, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := updateAdminMediaAssetMetadataFn(r.Service, ctx, adminUser, strings.TrimSpace(id), mapAdminMediaAssetMetadataInput(input))
+	if err != nil {
+		return nil, err
+	}
+
+	return mapAdminMediaLibraryItem(record), nil
+}
+
+// MoveMediaAssets is the resolver for the moveMediaAssets field.
+func (r *adminMutationResolver) MoveMediaAssets(
+	ctx context.Context,
+	input model.AdminMoveMediaAssetsInput,
+) (*model.AdminMoveMediaAssetsPayload, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	moved, err := moveAdminMediaAssetsToFolderFn(r.Service, ctx, adminUser, input.Ids, stringPointerValue(input.Folder))
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.AdminMoveMediaAssetsPayload{SuccessCount: moved}, nil
+}
+
 // DeleteMediaAsset is the resolver for the deleteMediaAsset field.
-func (*adminMutationResolver) DeleteMediaAsset(ctx context.Context, id string) (*model.AdminDeletePayload, error) {
+func (r *adminMutationResolver) DeleteMediaAsset(ctx context.Context, id string) (*model.AdminDeletePayload, error) {
 	adminUser, err := requireAdminUser(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := deleteAdminMediaAssetFn(ctx, adminUser, strings.TrimSpace(id)); err != nil {
+	if err := deleteAdminMediaAssetFn(r.Service, ctx, adminUser, strings.TrimSpace(id)); err != nil {
 		return nil, err
 	}
 
 	return &model.AdminDeletePayload{Success: true}, nil
 }
 
+// CollectMediaGarbage is the resolver for the collectMediaGarbage field.
+func (r *adminMutationResolver) CollectMediaGarbage(
+	ctx context.Context,
+	dryRun *bool,
+) (*model.AdminMediaGarbageCollectionPayload, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := collectAdminMediaGarbageFn(r.Service, ctx, adminUser, dryRun != nil && *dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.AdminMediaGarbageCollectionPayload{
+		DryRun:           result.DryRun,
+		ScannedCount:     result.Scanned,
+		QuarantinedCount: result.Quarantined,
+		RestoredCount:    result.Restored,
+		PurgedCount:      result.Purged,
+		FailedCount:      result.Failed,
+		ReclaimedBytes:   int(result.ReclaimedBytes),
+		RanAt:            result.RanAt.UTC(),
+	}, nil
+}
+
+// RestoreMediaAsset is the resolver for the restoreMediaAsset field.
+func (r *adminMutationResolver) RestoreMediaAsset(ctx context.Context, id string) (*model.AdminMediaLibraryItem, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := restoreAdminMediaAssetFn(r.Service, ctx, adminUser, strings.TrimSpace(id))
+	if err != nil {
+		return nil, err
+	}
+
+	return mapAdminMediaLibraryItem(item), nil
+}
+
 // DeleteContentPost is the resolver for the deleteContentPost field.
-func (*adminMutationResolver) DeleteContentPost(
+func (r *adminMutationResolver) DeleteContentPost(
 	ctx context.Context,
 	input model.AdminContentEntityKeyInput,
 ) (*model.AdminDeletePayload, error) {
@@ -411,6 +551,7 @@ func (*adminMutationResolver) DeleteContentPost(
 	}
 
 	if err := deleteAdminContentPostFn(
+		r.Service,
 		ctx,
 		adminUser,
 		normalizeAdminLocale(input.Locale),
@@ -423,7 +564,7 @@ func (*adminMutationResolver) DeleteContentPost(
 }
 
 // CreateContentTopic is the resolver for the createContentTopic field.
-func (*adminMutationResolver) CreateContentTopic(
+func (r *adminMutationResolver) CreateContentTopic(
 	ctx context.Context,
 	input model.AdminContentTopicInput,
 ) (*model.AdminContentTopic, error) {
@@ -432,7 +573,7 @@ func (*adminMutationResolver) CreateContentTopic(
 		return nil, err
 	}
 
-	saved, err := createAdminContentTopicFn(ctx, adminUser, mapAdminContentTopicInput(input))
+	saved, err := createAdminContentTopicFn(r.Service, ctx, adminUser, mapAdminContentTopicInput(input))
 	if err != nil {
 		return nil, err
 	}
@@ -441,7 +582,7 @@ func (*adminMutationResolver) CreateContentTopic(
 }
 
 // UpdateContentTopic is the resolver for the updateContentTopic field.
-func (*adminMutationResolver) UpdateContentTopic(
+func (r *adminMutationResolver) UpdateContentTopic(
 	ctx context.Context,
 	input model.AdminContentTopicInput,
 ) (*model.AdminContentTopic, error) {
@@ -450,7 +591,7 @@ func (*adminMutationResolver) UpdateContentTopic(
 		return nil, err
 	}
 
-	saved, err := updateAdminContentTopicFn(ctx, adminUser, mapAdminContentTopicInput(input))
+	saved, err := updateAdminContentTopicFn(r.Service, ctx, adminUser, mapAdminContentTopicInput(input))
 	if err != nil {
 		return nil, err
 	}
@@ -459,7 +600,7 @@ func (*adminMutationResolver) UpdateContentTopic(
 }
 
 // DeleteContentTopic is the resolver for the deleteContentTopic field.
-func (*adminMutationResolver) DeleteContentTopic(
+func (r *adminMutationResolver) DeleteContentTopic(
 	ctx context.Context,
 	input model.AdminContentEntityKeyInput,
 ) (*model.AdminDeletePayload, error) {
@@ -469,6 +610,7 @@ func (*adminMutationResolver) DeleteContentTopic(
 	}
 
 	if err := deleteAdminContentTopicFn(
+		r.Service,
 		ctx,
 		adminUser,
 		normalizeAdminLocale(input.Locale),
@@ -481,7 +623,7 @@ func (*adminMutationResolver) DeleteContentTopic(
 }
 
 // CreateContentCategory is the resolver for the createContentCategory field.
-func (*adminMutationResolver) CreateContentCategory(
+func (r *adminMutationResolver) CreateContentCategory(
 	ctx context.Context,
 	input model.AdminContentCategoryInput,
 ) (*model.AdminContentCategory, error) {
@@ -490,7 +632,7 @@ func (*adminMutationResolver) CreateContentCategory(
 		return nil, err
 	}
 
-	saved, err := createAdminContentCategoryFn(ctx, adminUser, mapAdminContentCategoryInput(input))
+	saved, err := createAdminContentCategoryFn(r.Service, ctx, adminUser, mapAdminContentCategoryInput(input))
 	if err != nil {
 		return nil, err
 	}
@@ -499,7 +641,7 @@ func (*adminMutationResolver) CreateContentCategory(
 }
 
 // UpdateContentCategory is the resolver for the updateContentCategory field.
-func (*adminMutationResolver) UpdateContentCategory(
+func (r *adminMutationResolver) UpdateContentCategory(
 	ctx context.Context,
 	input model.AdminContentCategoryInput,
 ) (*model.AdminContentCategory, error) {
@@ -508,7 +650,7 @@ func (*adminMutationResolver) UpdateContentCategory(
 		return nil, err
 	}
 
-	saved, err := updateAdminContentCategoryFn(ctx, adminUser, mapAdminContentCategoryInput(input))
+	saved, err := updateAdminContentCategoryFn(r.Service, ctx, adminUser, mapAdminContentCategoryInput(input))
 	if err != nil {
 		return nil, err
 	}
@@ -517,7 +659,7 @@ func (*adminMutationResolver) UpdateContentCategory(
 }
 
 // DeleteContentCategory is the resolver for the deleteContentCategory field.
-func (*adminMutationResolver) DeleteContentCategory(
+func (r *adminMutationResolver) DeleteContentCategory(
 	ctx context.Context,
 	input model.AdminContentEntityKeyInput,
 ) (*model.AdminDeletePayload, error) {
@@ -527,6 +669,7 @@ func (*adminMutationResolver) DeleteContentCategory(
 	}
 
 	if err := deleteAdminContentCategoryFn(
+		r.Service,
 		ctx,
 		adminUser,
 		normalizeAdminLocale(input.Locale),
@@ -537,3 +680,127 @@ func (*adminMutationResolver) DeleteContentCategory(
 
 	return &model.AdminDeletePayload{Success: true}, nil
 }
+
+// CreateContentSeries is the resolver for the createContentSeries field.
+func (r *adminMutationResolver) CreateContentSeries(
+	ctx context.Context,
+	input model.AdminContentSeriesInput,
+) (*model.AdminContentSeries, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := createAdminContentSeriesFn(r.Service, ctx, adminUser, mapAdminContentSeriesInput(input))
+	if err != nil {
+		return nil, err
+	}
+
+	return mapAdminContentSeries(saved), nil
+}
+
+// UpdateContentSeries is the resolver for the updateContentSeries field.
+func (r *adminMutationResolver) UpdateContentSeries(
+	ctx context.Context,
+	input model.AdminContentSeriesInput,
+) (*model.AdminContentSeries, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := updateAdminContentSeriesFn(r.Service, ctx, adminUser, mapAdminContentSeriesInput(input))
+	if err != nil {
+		return nil, err
+	}
+
+	return mapAdminContentSeries(saved), nil
+}
+
+// DeleteContentSeries is the resolver for the deleteContentSeries field.
+func (r *adminMutationResolver) DeleteContentSeries(
+	ctx context.Context,
+	input model.AdminContentEntityKeyInput,
+) (*model.AdminDeletePayload, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := deleteAdminContentSeriesFn(
+		r.Service,
+		ctx,
+		adminUser,
+		normalizeAdminLocale(input.Locale),
+		strings.TrimSpace(input.ID),
+	); err != nil {
+		return nil, err
+	}
+
+	return &model.AdminDeletePayload{Success: true}, nil
+}
+
+// RenameContentPost is the resolver for the renameContentPost field.
+func (r *adminMutationResolver) RenameContentPost(
+	ctx context.Context,
+	input model.AdminRenameContentPostInput,
+) (*model.AdminContentPostRenamePayload, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := renameAdminContentPostFn(r.Service, ctx, adminUser, domain.AdminContentPostRenameInput{
+		SourceID: strings.TrimSpace(input.SourceID),
+		TargetID: strings.TrimSpace(input.TargetID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapAdminContentPostRenamePayload(result), nil
+}
+
+// MergeContentTopics is the resolver for the mergeContentTopics field.
+func (r *adminMutationResolver) MergeContentTopics(
+	ctx context.Context,
+	input model.AdminMergeContentTopicsInput,
+) (*model.AdminContentTaxonomyRewritePayload, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := mergeAdminContentTopicsFn(r.Service, ctx, adminUser, domain.AdminContentTopicMergeInput{
+		SourceIDs: input.SourceIds,
+		TargetID:  strings.TrimSpace(input.TargetID),
+		DryRun:    input.DryRun != nil && *input.DryRun,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapAdminContentTaxonomyRewritePayload(result), nil
+}
+
+// RenameContentCategory is the resolver for the renameContentCategory field.
+func (r *adminMutationResolver) RenameContentCategory(
+	ctx context.Context,
+	input model.AdminRenameContentCategoryInput,
+) (*model.AdminContentTaxonomyRewritePayload, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := renameAdminContentCategoryFn(r.Service, ctx, adminUser, domain.AdminContentCategoryRenameInput{
+		SourceID: strings.TrimSpace(input.SourceID),
+		TargetID: strings.TrimSpace(input.TargetID),
+		DryRun:   input.DryRun != nil && *input.DryRun,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapAdminContentTaxonomyRewritePayload(result), nil
+}
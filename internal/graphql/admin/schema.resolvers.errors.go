@@ -10,7 +10,7 @@ import (
 )
 
 // ErrorMessages is the resolver for the errorMessages field.
-func (*adminQueryResolver) ErrorMessages(
+func (r *adminQueryResolver) ErrorMessages(
 	ctx context.Context,
 	filter *model.AdminErrorMessageFilterInput,
 ) (*model.AdminErrorMessageListPayload, error) {
@@ -38,7 +38,7 @@ func (*adminQueryResolver) ErrorMessages(
 		}
 	}
 
-	payload, err := listAdminErrorMessagesFn(ctx, adminUser, resolvedFilter)
+	payload, err := listAdminErrorMessagesFn(r.Service, ctx, adminUser, resolvedFilter)
 	if err != nil {
 		return nil, err
 	}
@@ -47,7 +47,7 @@ func (*adminQueryResolver) ErrorMessages(
 }
 
 // ErrorMessageAuditLogs is the resolver for the errorMessageAuditLogs field.
-func (*adminQueryResolver) ErrorMessageAuditLogs(
+func (r *adminQueryResolver) ErrorMessageAuditLogs(
 	ctx context.Context,
 	limit *int,
 ) ([]*model.AdminErrorMessageAuditLog, error) {
@@ -61,7 +61,7 @@ func (*adminQueryResolver) ErrorMessageAuditLogs(
 		resolvedLimit = *limit
 	}
 
-	records, err := listAdminErrorMessageAuditLogsFn(ctx, adminUser, resolvedLimit)
+	records, err := listAdminErrorMessageAuditLogsFn(r.Service, ctx, adminUser, resolvedLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -70,7 +70,7 @@ func (*adminQueryResolver) ErrorMessageAuditLogs(
 }
 
 // CreateErrorMessage is the resolver for the createErrorMessage field.
-func (*adminMutationResolver) CreateErrorMessage(
+func (r *adminMutationResolver) CreateErrorMessage(
 	ctx context.Context,
 	input model.AdminCreateErrorMessageInput,
 ) (*model.AdminErrorMessage, error) {
@@ -83,6 +83,7 @@ func (*adminMutationResolver) CreateErrorMessage(
 	}
 
 	saved, err := createAdminErrorMessageFn(
+		r.Service,
 		ctx,
 		adminUser,
 		mapAdminErrorMessageKey(*input.Key),
@@ -96,7 +97,7 @@ func (*adminMutationResolver) CreateErrorMessage(
 }
 
 // UpdateErrorMessage is the resolver for the updateErrorMessage field.
-func (*adminMutationResolver) UpdateErrorMessage(
+func (r *adminMutationResolver) UpdateErrorMessage(
 	ctx context.Context,
 	input model.AdminUpdateErrorMessageInput,
 ) (*model.AdminErrorMessage, error) {
@@ -109,6 +110,7 @@ func (*adminMutationResolver) UpdateErrorMessage(
 	}
 
 	updated, err := updateAdminErrorMessageFn(
+		r.Service,
 		ctx,
 		adminUser,
 		mapAdminErrorMessageKey(*input.Key),
@@ -122,7 +124,7 @@ func (*adminMutationResolver) UpdateErrorMessage(
 }
 
 // DeleteErrorMessage is the resolver for the deleteErrorMessage field.
-func (*adminMutationResolver) DeleteErrorMessage(
+func (r *adminMutationResolver) DeleteErrorMessage(
 	ctx context.Context,
 	input model.AdminErrorMessageKeyInput,
 ) (*model.AdminDeletePayload, error) {
@@ -131,7 +133,7 @@ func (*adminMutationResolver) DeleteErrorMessage(
 		return nil, err
 	}
 
-	if err := deleteAdminErrorMessageFn(ctx, adminUser, mapAdminErrorMessageKey(input)); err != nil {
+	if err := deleteAdminErrorMessageFn(r.Service, ctx, adminUser, mapAdminErrorMessageKey(input)); err != nil {
 		return nil, err
 	}
 
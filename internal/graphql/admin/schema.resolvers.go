@@ -7,6 +7,7 @@ package admingraphql
 import (
 	"context"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -17,70 +18,109 @@ import (
 	appscalars "suaybsimsek.com/blog-api/pkg/graphql/scalars"
 	"suaybsimsek.com/blog-api/pkg/httpapi"
 	"suaybsimsek.com/blog-api/pkg/httpauth"
+	"suaybsimsek.com/blog-api/pkg/webauthn"
 )
 
 var (
-	validateAdminPasswordResetTokenFn       = appservice.ValidateAdminPasswordResetToken
-	queryAdminGoogleAuthStatusFn            = appservice.QueryAdminGoogleAuthStatus
-	queryAdminGithubAuthStatusFn            = appservice.QueryAdminGithubAuthStatus
-	queryAdminDashboardFn                   = appservice.QueryAdminDashboard
-	listAdminCommentsFn                     = appservice.ListAdminComments
-	listActiveAdminSessionsFn               = appservice.ListActiveAdminSessions
-	listAdminNewsletterSubscribersFn        = appservice.ListAdminNewsletterSubscribers
-	listAdminNewsletterCampaignsFn          = appservice.ListAdminNewsletterCampaigns
-	listAdminNewsletterDeliveryFailuresFn   = appservice.ListAdminNewsletterDeliveryFailures
-	listAdminErrorMessagesFn                = appservice.ListAdminErrorMessages
-	listAdminContentPostsFn                 = appservice.ListAdminContentPosts
-	getAdminContentPostFn                   = appservice.GetAdminContentPost
-	listAdminContentPostRevisionsFn         = appservice.ListAdminContentPostRevisions
-	listAdminContentTopicsPageFn            = appservice.ListAdminContentTopicsPage
-	listAdminContentCategoriesPageFn        = appservice.ListAdminContentCategoriesPage
-	listAdminContentTopicsFn                = appservice.ListAdminContentTopics
-	listAdminContentCategoriesFn            = appservice.ListAdminContentCategories
-	listAdminMediaLibraryFn                 = appservice.ListAdminMediaLibrary
-	listAdminErrorMessageAuditLogsFn        = appservice.ListAdminErrorMessageAuditLogs
-	loginAdminFn                            = appservice.LoginAdmin
+	validateAdminPasswordResetTokenFn       = (*appservice.Service).ValidateAdminPasswordResetToken
+	queryAdminGoogleAuthStatusFn            = (*appservice.Service).QueryAdminGoogleAuthStatus
+	queryAdminGithubAuthStatusFn            = (*appservice.Service).QueryAdminGithubAuthStatus
+	queryAdminDashboardFn                   = (*appservice.Service).QueryAdminDashboard
+	listAdminCommentsFn                     = (*appservice.Service).ListAdminComments
+	listActiveAdminSessionsFn               = (*appservice.Service).ListActiveAdminSessions
+	listAdminNewsletterSubscribersFn        = (*appservice.Service).ListAdminNewsletterSubscribers
+	listAdminNewsletterCampaignsFn          = (*appservice.Service).ListAdminNewsletterCampaigns
+	listAdminNewsletterDeliveryFailuresFn   = (*appservice.Service).ListAdminNewsletterDeliveryFailures
+	listAdminErrorMessagesFn                = (*appservice.Service).ListAdminErrorMessages
+	listAdminContentPostsFn                 = (*appservice.Service).ListAdminContentPosts
+	getAdminContentPostFn                   = (*appservice.Service).GetAdminContentPost
+	listAdminContentPostRevisionsFn         = (*appservice.Service).ListAdminContentPostRevisions
+	listAdminContentTopicsPageFn            = (*appservice.Service).ListAdminContentTopicsPage
+	listAdminContentCategoriesPageFn        = (*appservice.Service).ListAdminContentCategoriesPage
+	listAdminContentSeriesPageFn            = (*appservice.Service).ListAdminContentSeriesPage
+	listAdminContentTopicsFn                = (*appservice.Service).ListAdminContentTopics
+	listAdminContentCategoriesFn            = (*appservice.Service).ListAdminContentCategories
+	listAdminMediaLibraryFn                 = (*appservice.Service).ListAdminMediaLibrary
+	listAdminMediaLibraryFacetsFn           = (*appservice.Service).ListAdminMediaLibraryFacets
+	listAdminErrorMessageAuditLogsFn        = (*appservice.Service).ListAdminErrorMessageAuditLogs
+	loginAdminFn                            = (*appservice.Service).LoginAdmin
 	startAdminGoogleConnectFn               = appservice.StartAdminGoogleConnect
-	disconnectAdminGoogleAccountFn          = appservice.DisconnectAdminGoogleAccount
+	disconnectAdminGoogleAccountFn          = (*appservice.Service).DisconnectAdminGoogleAccount
 	startAdminGithubConnectFn               = appservice.StartAdminGithubConnect
-	disconnectAdminGithubAccountFn          = appservice.DisconnectAdminGithubAccount
-	refreshAdminSessionFn                   = appservice.RefreshAdminSession
-	logoutAdminFn                           = appservice.LogoutAdmin
-	requestAdminPasswordResetFn             = appservice.RequestAdminPasswordReset
-	resetAdminPasswordWithTokenFn           = appservice.ResetAdminPasswordWithToken
-	changeAdminNameFn                       = appservice.ChangeAdminName
-	changeAdminAvatarFn                     = appservice.ChangeAdminAvatar
-	changeAdminUsernameFn                   = appservice.ChangeAdminUsername
-	requestAdminEmailChangeFn               = appservice.RequestAdminEmailChange
-	confirmAdminEmailChangeFn               = appservice.ConfirmAdminEmailChange
-	deleteAdminAccountFn                    = appservice.DeleteAdminAccount
-	changeAdminPasswordFn                   = appservice.ChangeAdminPassword
-	revokeAdminSessionFn                    = appservice.RevokeAdminSession
-	revokeAllAdminSessionsFn                = appservice.RevokeAllAdminSessions
-	updateAdminCommentStatusFn              = appservice.UpdateAdminCommentStatus
-	deleteAdminCommentFn                    = appservice.DeleteAdminComment
-	bulkUpdateAdminCommentStatusFn          = appservice.BulkUpdateAdminCommentStatus
-	bulkDeleteAdminCommentsFn               = appservice.BulkDeleteAdminComments
-	updateAdminNewsletterSubscriberStatusFn = appservice.UpdateAdminNewsletterSubscriberStatus
-	deleteAdminNewsletterSubscriberFn       = appservice.DeleteAdminNewsletterSubscriber
+	disconnectAdminGithubAccountFn          = (*appservice.Service).DisconnectAdminGithubAccount
+	refreshAdminSessionFn                   = (*appservice.Service).RefreshAdminSession
+	logoutAdminFn                           = (*appservice.Service).LogoutAdmin
+	requestAdminPasswordResetFn             = (*appservice.Service).RequestAdminPasswordReset
+	resetAdminPasswordWithTokenFn           = (*appservice.Service).ResetAdminPasswordWithToken
+	changeAdminNameFn                       = (*appservice.Service).ChangeAdminName
+	changeAdminAvatarFn                     = (*appservice.Service).ChangeAdminAvatar
+	changeAdminUsernameFn                   = (*appservice.Service).ChangeAdminUsername
+	requestAdminEmailChangeFn               = (*appservice.Service).RequestAdminEmailChange
+	confirmAdminEmailChangeFn               = (*appservice.Service).ConfirmAdminEmailChange
+	deleteAdminAccountFn                    = (*appservice.Service).DeleteAdminAccount
+	changeAdminPasswordFn                   = (*appservice.Service).ChangeAdminPassword
+	revokeAdminSessionFn                    = (*appservice.Service).RevokeAdminSession
+	revokeAllAdminSessionsFn                = (*appservice.Service).RevokeAllAdminSessions
+	updateAdminCommentStatusFn              = (*appservice.Service).UpdateAdminCommentStatus
+	deleteAdminCommentFn                    = (*appservice.Service).DeleteAdminComment
+	bulkUpdateAdminCommentStatusFn          = (*appservice.Service).BulkUpdateAdminCommentStatus
+	bulkDeleteAdminCommentsFn               = (*appservice.Service).BulkDeleteAdminComments
+	updateAdminNewsletterSubscriberStatusFn = (*appservice.Service).UpdateAdminNewsletterSubscriberStatus
+	deleteAdminNewsletterSubscriberFn       = (*appservice.Service).DeleteAdminNewsletterSubscriber
 	triggerAdminNewsletterDispatchFn        = appservice.TriggerAdminNewsletterDispatch
 	sendAdminNewsletterTestEmailFn          = appservice.SendAdminNewsletterTestEmail
-	createAdminErrorMessageFn               = appservice.CreateAdminErrorMessage
-	updateAdminErrorMessageFn               = appservice.UpdateAdminErrorMessage
-	deleteAdminErrorMessageFn               = appservice.DeleteAdminErrorMessage
-	updateAdminContentPostMetadataFn        = appservice.UpdateAdminContentPostMetadata
-	updateAdminContentPostContentFn         = appservice.UpdateAdminContentPostContent
-	restoreAdminContentPostRevisionFn       = appservice.RestoreAdminContentPostRevision
-	uploadAdminMediaAssetFn                 = appservice.UploadAdminMediaAsset
-	replaceAdminMediaAssetFn                = appservice.ReplaceAdminMediaAsset
-	deleteAdminMediaAssetFn                 = appservice.DeleteAdminMediaAsset
-	deleteAdminContentPostFn                = appservice.DeleteAdminContentPost
-	createAdminContentTopicFn               = appservice.CreateAdminContentTopic
-	updateAdminContentTopicFn               = appservice.UpdateAdminContentTopic
-	deleteAdminContentTopicFn               = appservice.DeleteAdminContentTopic
-	createAdminContentCategoryFn            = appservice.CreateAdminContentCategory
-	updateAdminContentCategoryFn            = appservice.UpdateAdminContentCategory
-	deleteAdminContentCategoryFn            = appservice.DeleteAdminContentCategory
+	createAdminErrorMessageFn               = (*appservice.Service).CreateAdminErrorMessage
+	updateAdminErrorMessageFn               = (*appservice.Service).UpdateAdminErrorMessage
+	deleteAdminErrorMessageFn               = (*appservice.Service).DeleteAdminErrorMessage
+	updateAdminContentPostMetadataFn        = (*appservice.Service).UpdateAdminContentPostMetadata
+	updateAdminContentPostContentFn         = (*appservice.Service).UpdateAdminContentPostContent
+	restoreAdminContentPostRevisionFn       = (*appservice.Service).RestoreAdminContentPostRevision
+	uploadAdminMediaAssetFn                 = (*appservice.Service).UploadAdminMediaAsset
+	replaceAdminMediaAssetFn                = (*appservice.Service).ReplaceAdminMediaAsset
+	updateAdminMediaAssetMetadataFn         = (*appservice.Service).UpdateAdminMediaAssetMetadata
+	moveAdminMediaAssetsToFolderFn          = (*appservice.Service).MoveAdminMediaAssetsToFolder
+	deleteAdminMediaAssetFn                 = (*appservice.Service).DeleteAdminMediaAsset
+	collectAdminMediaGarbageFn              = (*appservice.Service).CollectAdminMediaGarbage
+	restoreAdminMediaAssetFn                = (*appservice.Service).RestoreAdminMediaAsset
+	deleteAdminContentPostFn                = (*appservice.Service).DeleteAdminContentPost
+	createAdminContentTopicFn               = (*appservice.Service).CreateAdminContentTopic
+	updateAdminContentTopicFn               = (*appservice.Service).UpdateAdminContentTopic
+	deleteAdminContentTopicFn               = (*appservice.Service).DeleteAdminContentTopic
+	createAdminContentCategoryFn            = (*appservice.Service).CreateAdminContentCategory
+	updateAdminContentCategoryFn            = (*appservice.Service).UpdateAdminContentCategory
+	deleteAdminContentCategoryFn            = (*appservice.Service).DeleteAdminContentCategory
+	createAdminContentSeriesFn              = (*appservice.Service).CreateAdminContentSeries
+	updateAdminContentSeriesFn              = (*appservice.Service).UpdateAdminContentSeries
+	deleteAdminContentSeriesFn              = (*appservice.Service).DeleteAdminContentSeries
+	renameAdminContentPostFn                = (*appservice.Service).RenameAdminContentPost
+	mergeAdminContentTopicsFn               = (*appservice.Service).MergeAdminContentTopics
+	renameAdminContentCategoryFn            = (*appservice.Service).RenameAdminContentCategory
+	listAdminUsersFn                        = (*appservice.Service).ListAdminUsers
+	validateAdminInvitationTokenFn          = (*appservice.Service).ValidateAdminInvitationToken
+	acceptAdminInvitationFn                 = (*appservice.Service).AcceptAdminInvitation
+	inviteAdminUserFn                       = (*appservice.Service).InviteAdminUser
+	disableAdminUserFn                      = (*appservice.Service).DisableAdminUser
+	enableAdminUserFn                       = (*appservice.Service).EnableAdminUser
+	unlockAdminUserFn                       = (*appservice.Service).UnlockAdminUser
+	updateAdminUserRolesFn                  = (*appservice.Service).UpdateAdminUserRoles
+	completeAdminTwoFactorLoginFn           = (*appservice.Service).CompleteAdminTwoFactorLogin
+	startAdminTwoFactorEnrollmentFn         = (*appservice.Service).StartAdminTwoFactorEnrollment
+	enableAdminTwoFactorFn                  = (*appservice.Service).EnableAdminTwoFactor
+	disableAdminTwoFactorFn                 = (*appservice.Service).DisableAdminTwoFactor
+	regenerateAdminRecoveryCodesFn          = (*appservice.Service).RegenerateAdminTwoFactorRecoveryCodes
+	listAdminPasskeysFn                     = (*appservice.Service).ListAdminPasskeys
+	startAdminPasskeyLoginFn                = appservice.StartAdminPasskeyLogin
+	loginAdminWithPasskeyFn                 = (*appservice.Service).LoginAdminWithPasskey
+	startAdminPasskeyRegistrationFn         = (*appservice.Service).StartAdminPasskeyRegistration
+	finishAdminPasskeyRegistrationFn        = (*appservice.Service).FinishAdminPasskeyRegistration
+	revokeAdminPasskeyFn                    = (*appservice.Service).RevokeAdminPasskey
+	listAdminAccessTokensFn                 = (*appservice.Service).ListAdminAccessTokens
+	createAdminAccessTokenFn                = (*appservice.Service).CreateAdminAccessToken
+	revokeAdminAccessTokenFn                = (*appservice.Service).RevokeAdminAccessToken
+	queryAdminOIDCProvidersFn               = (*appservice.Service).QueryAdminOIDCProviders
+	listAdminOIDCLinksFn                    = (*appservice.Service).ListAdminOIDCLinks
+	startAdminOIDCConnectFn                 = appservice.StartAdminOIDCConnect
+	disconnectAdminOIDCAccountFn            = (*appservice.Service).DisconnectAdminOIDCAccount
 )
 
 // AdminMutation returns AdminMutationResolver implementation.
@@ -114,9 +154,43 @@ func mapAdminUser(user *domain.AdminUser) *model.AdminUser {
 		GithubEmail:           toOptionalAdminEmail(user.GithubEmail),
 		GithubLinkedAt:        user.GithubLinkedAt,
 		Roles:                 append([]string{}, user.Roles...),
+		Permissions:           mapAdminPermissions(appservice.ResolveAdminPermissions(user)),
+		Status:                mapAdminUserStatus(user.Status),
+		CreatedAt:             user.CreatedAt,
+		InvitationExpiresAt:   user.InvitationExpiresAt,
+		TwoFactorEnabled:      user.TwoFactorEnabledAt != nil,
+		TwoFactorEnabledAt:    user.TwoFactorEnabledAt,
+		RecoveryCodesLeft:     user.RecoveryCodesLeft,
 	}
 }
 
+func mapAdminTwoFactorRecoveryCodes(result *appservice.AdminTwoFactorRecoveryCodes) *model.AdminTwoFactorRecoveryCodesPayload {
+	if result == nil {
+		return &model.AdminTwoFactorRecoveryCodesPayload{RecoveryCodes: []string{}}
+	}
+
+	return &model.AdminTwoFactorRecoveryCodesPayload{
+		RecoveryCodes: append([]string{}, result.Codes...),
+		User:          mapAdminUser(result.User),
+	}
+}
+
+func mapAdminUserStatus(status string) model.AdminUserStatus {
+	resolved := model.AdminUserStatus(strings.ToUpper(strings.TrimSpace(status)))
+	if !resolved.IsValid() {
+		return model.AdminUserStatusActive
+	}
+	return resolved
+}
+
+func mapAdminPermissions(permissions []appservice.AdminPermission) []model.AdminPermission {
+	items := make([]model.AdminPermission, 0, len(permissions))
+	for _, permission := range permissions {
+		items = append(items, model.AdminPermission(permission))
+	}
+	return items
+}
+
 func toOptionalAdminProfileName(value string) *string {
 	return toOptionalAdminString(value)
 }
@@ -244,6 +318,72 @@ func mapAdminSessions(items []domain.AdminSessionRecord, currentSessionID string
 	return mapped
 }
 
+func mapAdminPasskey(item *domain.AdminPasskeyRecord) *model.AdminPasskey {
+	if item == nil {
+		return nil
+	}
+
+	algorithm := strconv.FormatInt(item.Algorithm, 10)
+	switch item.Algorithm {
+	case webauthn.AlgorithmES256:
+		algorithm = "ES256"
+	case webauthn.AlgorithmRS256:
+		algorithm = "RS256"
+	}
+
+	var lastUsedAt *time.Time
+	if item.LastUsedAt != nil {
+		value := item.LastUsedAt.UTC()
+		lastUsedAt = &value
+	}
+
+	return &model.AdminPasskey{
+		ID:         item.ID,
+		Name:       item.Name,
+		Algorithm:  algorithm,
+		Transports: append([]string{}, item.Transports...),
+		CreatedAt:  item.CreatedAt.UTC(),
+		LastUsedAt: lastUsedAt,
+	}
+}
+
+func mapAdminAccessToken(item *domain.AdminAccessTokenRecord) *model.AdminAccessToken {
+	if item == nil {
+		return nil
+	}
+
+	scopes := make([]model.AdminPermission, 0, len(item.Scopes))
+	for _, scope := range item.Scopes {
+		scopes = append(scopes, model.AdminPermission(scope))
+	}
+
+	var lastUsedAt *time.Time
+	if item.LastUsedAt != nil {
+		value := item.LastUsedAt.UTC()
+		lastUsedAt = &value
+	}
+
+	return &model.AdminAccessToken{
+		ID:         item.ID,
+		Name:       item.Name,
+		Prefix:     item.Prefix,
+		Scopes:     scopes,
+		CreatedAt:  item.CreatedAt.UTC(),
+		ExpiresAt:  item.ExpiresAt.UTC(),
+		LastUsedAt: lastUsedAt,
+		LastUsedIP: toOptionalAdminString(item.LastUsedIP),
+		UseCount:   int(item.UseCount),
+	}
+}
+
+func mapAdminPasskeyCeremony(ceremony *appservice.AdminPasskeyCeremony) *model.AdminPasskeyCeremonyPayload {
+	return &model.AdminPasskeyCeremonyPayload{
+		OptionsJSON:    ceremony.OptionsJSON,
+		ChallengeToken: ceremony.ChallengeToken,
+		ExpiresAt:      ceremony.ExpiresAt.UTC(),
+	}
+}
+
 func mapAdminNewsletterSubscriberListPayload(
 	payload *domain.AdminNewsletterSubscriberListResult,
 ) *model.AdminNewsletterSubscriberListPayload {
@@ -597,6 +737,107 @@ func mapAdminContentTopicGroup(item *domain.AdminContentTopicGroupRecord) *model
 	}
 }
 
+func mapAdminContentSeriesListPayload(
+	payload *domain.AdminContentSeriesListResult,
+) *model.AdminContentSeriesListPayload {
+	if payload == nil {
+		return &model.AdminContentSeriesListPayload{
+			Items: []*model.AdminContentSeriesGroup{},
+			Total: 0,
+			Page:  1,
+			Size:  20,
+		}
+	}
+
+	mapped := make([]*model.AdminContentSeriesGroup, 0, len(payload.Items))
+	for _, item := range payload.Items {
+		mapped = append(mapped, &model.AdminContentSeriesGroup{
+			ID:        item.ID,
+			Preferred: mapAdminContentSeries(&item.Preferred),
+			En:        mapAdminContentSeries(item.EN),
+			Tr:        mapAdminContentSeries(item.TR),
+		})
+	}
+
+	return &model.AdminContentSeriesListPayload{
+		Items: mapped,
+		Total: payload.Total,
+		Page:  payload.Page,
+		Size:  payload.Size,
+	}
+}
+
+func mapAdminContentSeries(item *domain.AdminContentSeriesRecord) *model.AdminContentSeries {
+	if item == nil {
+		return nil
+	}
+
+	return &model.AdminContentSeries{
+		Locale:      appscalars.Locale(item.Locale),
+		ID:          item.ID,
+		Name:        item.Name,
+		Description: toOptionalAdminString(item.Description),
+		PostIds:     append([]string{}, item.PostIDs...),
+		UpdatedAt:   toOptionalAdminTime(item.UpdatedAt),
+	}
+}
+
+func mapAdminContentSeriesInput(input model.AdminContentSeriesInput) domain.AdminContentSeriesInput {
+	postIDs := make([]string, 0, len(input.PostIds))
+	for _, postID := range input.PostIds {
+		postIDs = append(postIDs, strings.TrimSpace(postID))
+	}
+
+	return domain.AdminContentSeriesInput{
+		Locale:      normalizeAdminLocale(input.Locale),
+		ID:          strings.TrimSpace(input.ID),
+		Name:        strings.TrimSpace(input.Name),
+		Description: strings.TrimSpace(stringPointerValue(input.Description)),
+		PostIDs:     postIDs,
+	}
+}
+
+func mapAdminContentPostRenamePayload(result *domain.AdminContentPostRenameResult) *model.AdminContentPostRenamePayload {
+	if result == nil {
+		return nil
+	}
+
+	return &model.AdminContentPostRenamePayload{
+		SourceID:          result.SourceID,
+		TargetID:          result.TargetID,
+		PostsUpdated:      result.PostsUpdated,
+		RevisionsUpdated:  result.RevisionsUpdated,
+		CommentsUpdated:   result.CommentsUpdated,
+		SeriesUpdated:     result.SeriesUpdated,
+		AliasesRedirected: result.AliasesRedirected,
+	}
+}
+
+func mapAdminContentTaxonomyRewritePayload(
+	result *domain.AdminContentTaxonomyRewriteResult,
+) *model.AdminContentTaxonomyRewritePayload {
+	if result == nil {
+		return &model.AdminContentTaxonomyRewritePayload{Posts: []*model.AdminContentTaxonomyRewritePost{}}
+	}
+
+	posts := make([]*model.AdminContentTaxonomyRewritePost, 0, len(result.Posts))
+	for _, post := range result.Posts {
+		posts = append(posts, &model.AdminContentTaxonomyRewritePost{
+			Locale: appscalars.Locale(post.Locale),
+			ID:     post.ID,
+			Title:  post.Title,
+		})
+	}
+
+	return &model.AdminContentTaxonomyRewritePayload{
+		DryRun:            result.DryRun,
+		Posts:             posts,
+		PostsUpdated:      result.PostsUpdated,
+		RevisionsCreated:  result.RevisionsCreated,
+		TaxonomiesRemoved: result.TaxonomiesRemoved,
+	}
+}
+
 func mapAdminContentCategoryGroups(items []domain.AdminContentCategoryGroupRecord) []*model.AdminContentCategoryGroup {
 	mapped := make([]*model.AdminContentCategoryGroup, 0, len(items))
 	for _, item := range items {
@@ -799,21 +1040,78 @@ func mapAdminMediaLibraryItem(item *domain.AdminMediaLibraryItem) *model.AdminMe
 	}
 
 	return &model.AdminMediaLibraryItem{
-		ID:          strings.TrimSpace(item.ID),
-		Kind:        kind,
-		Name:        strings.TrimSpace(item.Name),
-		Value:       strings.TrimSpace(item.Value),
-		PreviewURL:  strings.TrimSpace(item.PreviewURL),
-		ContentType: toOptionalAdminString(item.ContentType),
-		Width:       toOptionalAdminInt(item.Width),
-		Height:      toOptionalAdminInt(item.Height),
-		SizeBytes:   item.SizeBytes,
-		UsageCount:  item.UsageCount,
-		CreatedAt:   toOptionalAdminTime(item.CreatedAt),
-		UpdatedAt:   toOptionalAdminTime(item.UpdatedAt),
+		ID:            strings.TrimSpace(item.ID),
+		Kind:          kind,
+		Name:          strings.TrimSpace(item.Name),
+		Value:         strings.TrimSpace(item.Value),
+		PreviewURL:    strings.TrimSpace(item.PreviewURL),
+		ContentType:   toOptionalAdminString(item.ContentType),
+		Width:         toOptionalAdminInt(item.Width),
+		Height:        toOptionalAdminInt(item.Height),
+		DominantColor: toOptionalAdminString(item.Placeholder.DominantColor),
+		BlurHash:      toOptionalAdminString(item.Placeholder.BlurHash),
+		AltTexts:      mapAdminMediaAltTexts(item.Metadata.AltTexts),
+		Caption:       toOptionalAdminString(item.Metadata.Caption),
+		Credit:        toOptionalAdminString(item.Metadata.Credit),
+		Tags:          append([]string{}, item.Metadata.Tags...),
+		Folder:        toOptionalAdminString(item.Metadata.Folder),
+		SizeBytes:     item.SizeBytes,
+		UsageCount:    item.UsageCount,
+		QuarantinedAt: toOptionalAdminTime(item.QuarantinedAt),
+		PurgeAfter:    toOptionalAdminTime(item.PurgeAfter),
+		CreatedAt:     toOptionalAdminTime(item.CreatedAt),
+		UpdatedAt:     toOptionalAdminTime(item.UpdatedAt),
+	}
+}
+
+func mapAdminMediaAltTexts(items []domain.MediaAltText) []*model.AdminMediaAltText {
+	mapped := make([]*model.AdminMediaAltText, 0, len(items))
+	for _, item := range items {
+		mapped = append(mapped, &model.AdminMediaAltText{
+			Locale: appscalars.Locale(item.Locale),
+			Text:   item.Text,
+		})
+	}
+	return mapped
+}
+
+func mapAdminMediaAssetMetadataInput(input model.AdminUpdateMediaAssetMetadataInput) domain.AdminMediaAssetMetadata {
+	altTexts := make([]domain.MediaAltText, 0, len(input.AltTexts))
+	for _, altText := range input.AltTexts {
+		if altText == nil {
+			continue
+		}
+		altTexts = append(altTexts, domain.MediaAltText{
+			Locale: normalizeAdminLocale(altText.Locale),
+			Text:   altText.Text,
+		})
+	}
+
+	return domain.AdminMediaAssetMetadata{
+		AltTexts: altTexts,
+		Caption:  stringPointerValue(input.Caption),
+		Credit:   stringPointerValue(input.Credit),
+		Tags:     append([]string{}, input.Tags...),
+		Folder:   stringPointerValue(input.Folder),
 	}
 }
 
+// mapAdminMediaUploadInput prefers a multipart file over an inline data URL and falls back to the uploaded
+// file name when the client leaves fileName blank.
+func mapAdminMediaUploadInput(input model.AdminUploadMediaAssetInput) domain.AdminMediaUploadInput {
+	mapped := domain.AdminMediaUploadInput{
+		FileName: strings.TrimSpace(input.FileName),
+		DataURL:  strings.TrimSpace(stringPointerValue(input.DataURL)),
+	}
+	if input.File != nil && input.File.File != nil {
+		mapped.File = input.File.File
+		if mapped.FileName == "" {
+			mapped.FileName = strings.TrimSpace(input.File.Filename)
+		}
+	}
+	return mapped
+}
+
 func mapAdminAuditLogs(items []domain.AdminAuditLogRecord) []*model.AdminErrorMessageAuditLog {
 	mapped := make([]*model.AdminErrorMessageAuditLog, 0, len(items))
 	for _, item := range items {
@@ -1237,7 +1535,7 @@ func resolveCurrentRefreshSessionID(ctx context.Context) string {
 	}
 
 	config := appconfig.ResolveAdminConfig()
-	if strings.TrimSpace(config.JWTSecret) == "" {
+	if !config.JWTConfigured() {
 		return ""
 	}
 
@@ -1246,7 +1544,7 @@ func resolveCurrentRefreshSessionID(ctx context.Context) string {
 		return ""
 	}
 
-	claims, err := httpauth.VerifyHS256JWT(strings.TrimSpace(refreshCookie.Value), config.JWTSecret, "refresh", time.Now().UTC())
+	claims, err := appservice.VerifyAdminJWT(config, strings.TrimSpace(refreshCookie.Value), "refresh", time.Now().UTC())
 	if err != nil {
 		return ""
 	}
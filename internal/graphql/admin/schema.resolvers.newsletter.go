@@ -9,7 +9,7 @@ import (
 )
 
 // NewsletterSubscribers is the resolver for the newsletterSubscribers field.
-func (*adminQueryResolver) NewsletterSubscribers(
+func (r *adminQueryResolver) NewsletterSubscribers(
 	ctx context.Context,
 	filter *model.AdminNewsletterSubscriberFilterInput,
 ) (*model.AdminNewsletterSubscriberListPayload, error) {
@@ -37,7 +37,7 @@ func (*adminQueryResolver) NewsletterSubscribers(
 		}
 	}
 
-	payload, err := listAdminNewsletterSubscribersFn(ctx, adminUser, resolvedFilter)
+	payload, err := listAdminNewsletterSubscribersFn(r.Service, ctx, adminUser, resolvedFilter)
 	if err != nil {
 		return nil, err
 	}
@@ -46,7 +46,7 @@ func (*adminQueryResolver) NewsletterSubscribers(
 }
 
 // NewsletterCampaigns is the resolver for the newsletterCampaigns field.
-func (*adminQueryResolver) NewsletterCampaigns(
+func (r *adminQueryResolver) NewsletterCampaigns(
 	ctx context.Context,
 	filter *model.AdminNewsletterCampaignFilterInput,
 ) (*model.AdminNewsletterCampaignListPayload, error) {
@@ -74,7 +74,7 @@ func (*adminQueryResolver) NewsletterCampaigns(
 		}
 	}
 
-	payload, err := listAdminNewsletterCampaignsFn(ctx, adminUser, resolvedFilter)
+	payload, err := listAdminNewsletterCampaignsFn(r.Service, ctx, adminUser, resolvedFilter)
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +83,7 @@ func (*adminQueryResolver) NewsletterCampaigns(
 }
 
 // NewsletterCampaignFailures is the resolver for the newsletterCampaignFailures field.
-func (*adminQueryResolver) NewsletterCampaignFailures(
+func (r *adminQueryResolver) NewsletterCampaignFailures(
 	ctx context.Context,
 	filter model.AdminNewsletterDeliveryFailureFilterInput,
 ) (*model.AdminNewsletterDeliveryFailureListPayload, error) {
@@ -103,7 +103,7 @@ func (*adminQueryResolver) NewsletterCampaignFailures(
 		resolvedFilter.Size = filter.Size
 	}
 
-	payload, err := listAdminNewsletterDeliveryFailuresFn(ctx, adminUser, resolvedFilter)
+	payload, err := listAdminNewsletterDeliveryFailuresFn(r.Service, ctx, adminUser, resolvedFilter)
 	if err != nil {
 		return nil, err
 	}
@@ -112,7 +112,7 @@ func (*adminQueryResolver) NewsletterCampaignFailures(
 }
 
 // UpdateNewsletterSubscriberStatus is the resolver for the updateNewsletterSubscriberStatus field.
-func (*adminMutationResolver) UpdateNewsletterSubscriberStatus(
+func (r *adminMutationResolver) UpdateNewsletterSubscriberStatus(
 	ctx context.Context,
 	input model.AdminUpdateNewsletterSubscriberStatusInput,
 ) (*model.AdminNewsletterSubscriber, error) {
@@ -122,6 +122,7 @@ func (*adminMutationResolver) UpdateNewsletterSubscriberStatus(
 	}
 
 	updated, err := updateAdminNewsletterSubscriberStatusFn(
+		r.Service,
 		ctx,
 		adminUser,
 		string(input.Email),
@@ -135,7 +136,7 @@ func (*adminMutationResolver) UpdateNewsletterSubscriberStatus(
 }
 
 // DeleteNewsletterSubscriber is the resolver for the deleteNewsletterSubscriber field.
-func (*adminMutationResolver) DeleteNewsletterSubscriber(
+func (r *adminMutationResolver) DeleteNewsletterSubscriber(
 	ctx context.Context,
 	input model.AdminDeleteNewsletterSubscriberInput,
 ) (*model.AdminDeletePayload, error) {
@@ -144,7 +145,7 @@ func (*adminMutationResolver) DeleteNewsletterSubscriber(
 		return nil, err
 	}
 
-	if err := deleteAdminNewsletterSubscriberFn(ctx, adminUser, string(input.Email)); err != nil {
+	if err := deleteAdminNewsletterSubscriberFn(r.Service, ctx, adminUser, string(input.Email)); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,89 @@
+package admingraphql
+
+import (
+	"context"
+	"strings"
+
+	"suaybsimsek.com/blog-api/internal/graphql/admin/model"
+	appscalars "suaybsimsek.com/blog-api/pkg/graphql/scalars"
+)
+
+// OidcProviders is the resolver for the oidcProviders field.
+func (r *adminQueryResolver) OidcProviders(ctx context.Context) ([]*model.AdminOIDCProvider, error) {
+	providers, err := queryAdminOIDCProvidersFn(r.Service, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.AdminOIDCProvider, 0, len(providers))
+	for _, provider := range providers {
+		items = append(items, &model.AdminOIDCProvider{
+			ID:             provider.ID,
+			Name:           provider.Name,
+			LoginAvailable: provider.LoginAvailable,
+		})
+	}
+	return items, nil
+}
+
+// OidcLinks is the resolver for the oidcLinks field.
+func (r *adminQueryResolver) OidcLinks(ctx context.Context) ([]*model.AdminOIDCLink, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	links, err := listAdminOIDCLinksFn(r.Service, ctx, adminUser)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.AdminOIDCLink, 0, len(links))
+	for _, link := range links {
+		items = append(items, &model.AdminOIDCLink{
+			Provider:     link.Provider,
+			ProviderName: link.ProviderName,
+			Email:        toOptionalAdminEmail(link.Email),
+			LinkedAt:     link.LinkedAt,
+		})
+	}
+	return items, nil
+}
+
+// StartOIDCConnect is the resolver for the startOIDCConnect field.
+func (*adminMutationResolver) StartOIDCConnect(
+	ctx context.Context,
+	input model.AdminStartOIDCConnectInput,
+) (*model.AdminOIDCConnectPayload, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := startAdminOIDCConnectFn(
+		ctx,
+		adminUser,
+		strings.TrimSpace(input.Provider),
+		localePointerValue(input.Locale),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.AdminOIDCConnectPayload{URL: appscalars.URL(payload.URL)}, nil
+}
+
+// DisconnectOidc is the resolver for the disconnectOIDC field.
+func (r *adminMutationResolver) DisconnectOidc(ctx context.Context, provider string) (*model.AdminOIDCDisconnectPayload, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	success, err := disconnectAdminOIDCAccountFn(r.Service, ctx, adminUser, strings.TrimSpace(provider))
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.AdminOIDCDisconnectPayload{Success: success}, nil
+}
@@ -0,0 +1,110 @@
+package admingraphql
+
+import (
+	"context"
+
+	"suaybsimsek.com/blog-api/internal/graphql/admin/model"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+)
+
+// Passkeys is the resolver for the passkeys field.
+func (r *adminQueryResolver) Passkeys(ctx context.Context) ([]*model.AdminPasskey, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	passkeys, err := listAdminPasskeysFn(r.Service, ctx, adminUser)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.AdminPasskey, 0, len(passkeys))
+	for index := range passkeys {
+		items = append(items, mapAdminPasskey(&passkeys[index]))
+	}
+	return items, nil
+}
+
+// StartPasskeyLogin is the resolver for the startPasskeyLogin field.
+func (*adminMutationResolver) StartPasskeyLogin(ctx context.Context) (*model.AdminPasskeyCeremonyPayload, error) {
+	ceremony, err := startAdminPasskeyLoginFn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapAdminPasskeyCeremony(ceremony), nil
+}
+
+// PasskeyLogin is the resolver for the passkeyLogin field.
+func (r *adminMutationResolver) PasskeyLogin(ctx context.Context, input model.AdminPasskeyLoginInput) (*model.AdminAuthPayload, error) {
+	rememberMe := input.RememberMe != nil && *input.RememberMe
+	payload, err := loginAdminWithPasskeyFn(
+		r.Service,
+		ctx,
+		input.ChallengeToken,
+		input.Credential,
+		rememberMe,
+		resolveAdminSessionMetadata(ctx, getRequest(ctx)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return setAdminLoginCookies(ctx, payload), nil
+}
+
+// StartPasskeyRegistration is the resolver for the startPasskeyRegistration field.
+func (r *adminMutationResolver) StartPasskeyRegistration(ctx context.Context) (*model.AdminPasskeyCeremonyPayload, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ceremony, err := startAdminPasskeyRegistrationFn(r.Service, ctx, adminUser)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapAdminPasskeyCeremony(ceremony), nil
+}
+
+// FinishPasskeyRegistration is the resolver for the finishPasskeyRegistration field.
+func (r *adminMutationResolver) FinishPasskeyRegistration(
+	ctx context.Context,
+	input model.AdminFinishPasskeyRegistrationInput,
+) (*model.AdminPasskey, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+	passkey, err := finishAdminPasskeyRegistrationFn(r.Service, ctx, adminUser, input.ChallengeToken, input.Credential, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapAdminPasskey(passkey), nil
+}
+
+// RevokePasskey is the resolver for the revokePasskey field.
+func (r *adminMutationResolver) RevokePasskey(ctx context.Context, id string) (*model.AdminPasskeyRevokePayload, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := revokeAdminPasskeyFn(r.Service, ctx, adminUser, id)
+	if err != nil {
+		return nil, err
+	}
+	if !revoked {
+		return nil, apperrors.BadRequest("passkey not found")
+	}
+
+	return &model.AdminPasskeyRevokePayload{Success: true}, nil
+}
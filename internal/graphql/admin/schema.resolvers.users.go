@@ -0,0 +1,154 @@
+package admingraphql
+
+import (
+	"context"
+	"strings"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/graphql/admin/model"
+	appscalars "suaybsimsek.com/blog-api/pkg/graphql/scalars"
+)
+
+// AdminUsers is the resolver for the adminUsers field.
+func (r *adminQueryResolver) AdminUsers(ctx context.Context) ([]*model.AdminUser, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := listAdminUsersFn(r.Service, ctx, adminUser)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.AdminUser, 0, len(users))
+	for index := range users {
+		items = append(items, mapAdminUser(&users[index]))
+	}
+	return items, nil
+}
+
+// ValidateInvitation is the resolver for the validateInvitation field.
+func (r *adminQueryResolver) ValidateInvitation(
+	ctx context.Context,
+	token string,
+	locale *appscalars.Locale,
+) (*model.AdminInvitationValidationPayload, error) {
+	result, err := validateAdminInvitationTokenFn(r.Service, ctx, strings.TrimSpace(token), localePointerValue(locale))
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.AdminInvitationValidationPayload{
+		Status: strings.TrimSpace(result.Status),
+		Locale: appscalars.Locale(appscalars.NormalizeLocaleOutput(result.Locale)),
+		Email:  toOptionalAdminEmail(result.Email),
+	}, nil
+}
+
+// AcceptInvitation is the resolver for the acceptInvitation field.
+func (r *adminMutationResolver) AcceptInvitation(
+	ctx context.Context,
+	input model.AdminAcceptInvitationInput,
+) (*model.AdminInvitationAcceptPayload, error) {
+	result, err := acceptAdminInvitationFn(
+		r.Service,
+		ctx,
+		strings.TrimSpace(input.Token),
+		input.NewPassword,
+		input.ConfirmPassword,
+		localePointerValue(input.Locale),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.AdminInvitationAcceptPayload{
+		Success: result.Success,
+		Locale:  appscalars.Locale(appscalars.NormalizeLocaleOutput(result.Locale)),
+	}, nil
+}
+
+// InviteAdmin is the resolver for the inviteAdmin field.
+func (r *adminMutationResolver) InviteAdmin(ctx context.Context, input model.AdminInviteInput) (*model.AdminUser, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+	invited, err := inviteAdminUserFn(r.Service, ctx, adminUser, domain.AdminInvitationInput{
+		Email:  string(input.Email),
+		Name:   name,
+		Roles:  input.Roles,
+		Locale: localePointerValue(input.Locale),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapAdminUser(invited), nil
+}
+
+// DisableAdmin is the resolver for the disableAdmin field.
+func (r *adminMutationResolver) DisableAdmin(ctx context.Context, id string) (*model.AdminUser, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	disabled, err := disableAdminUserFn(r.Service, ctx, adminUser, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapAdminUser(disabled), nil
+}
+
+// EnableAdmin is the resolver for the enableAdmin field.
+func (r *adminMutationResolver) EnableAdmin(ctx context.Context, id string) (*model.AdminUser, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	enabled, err := enableAdminUserFn(r.Service, ctx, adminUser, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapAdminUser(enabled), nil
+}
+
+// UnlockAdmin is the resolver for the unlockAdmin field.
+func (r *adminMutationResolver) UnlockAdmin(ctx context.Context, id string) (*model.AdminUser, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	unlocked, err := unlockAdminUserFn(r.Service, ctx, adminUser, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapAdminUser(unlocked), nil
+}
+
+// UpdateAdminRoles is the resolver for the updateAdminRoles field.
+func (r *adminMutationResolver) UpdateAdminRoles(ctx context.Context, id string, roles []string) (*model.AdminUser, error) {
+	adminUser, err := requireAdminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := updateAdminUserRolesFn(r.Service, ctx, adminUser, id, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapAdminUser(updated), nil
+}
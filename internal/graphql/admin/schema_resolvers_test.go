@@ -8,6 +8,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/99designs/gqlgen/graphql"
+
 	appconfig "suaybsimsek.com/blog-api/internal/config"
 	"suaybsimsek.com/blog-api/internal/domain"
 	"suaybsimsek.com/blog-api/internal/graphql/admin/model"
@@ -581,19 +583,19 @@ func TestAdminQueryResolvers(t *testing.T) {
 		listAdminErrorMessageAuditLogsFn = originalListAdminErrorMessageAuditLogsFn
 	})
 
-	queryAdminGoogleAuthStatusFn = func(context.Context) (*appservice.AdminGoogleAuthStatusResult, error) {
+	queryAdminGoogleAuthStatusFn = func(*appservice.Service, context.Context) (*appservice.AdminGoogleAuthStatusResult, error) {
 		return &appservice.AdminGoogleAuthStatusResult{Enabled: true, LoginAvailable: true}, nil
 	}
-	queryAdminGithubAuthStatusFn = func(context.Context) (*appservice.AdminGithubAuthStatusResult, error) {
+	queryAdminGithubAuthStatusFn = func(*appservice.Service, context.Context) (*appservice.AdminGithubAuthStatusResult, error) {
 		return &appservice.AdminGithubAuthStatusResult{Enabled: true}, nil
 	}
-	queryAdminDashboardFn = func(context.Context) (*domain.AdminDashboard, error) {
+	queryAdminDashboardFn = func(*appservice.Service, context.Context) (*domain.AdminDashboard, error) {
 		return &domain.AdminDashboard{
 			TotalPosts:       7,
 			TotalSubscribers: 11,
 		}, nil
 	}
-	listAdminCommentsFn = func(_ context.Context, user *domain.AdminUser, filter domain.AdminCommentFilter) (*domain.AdminCommentListResult, error) {
+	listAdminCommentsFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, filter domain.AdminCommentFilter) (*domain.AdminCommentListResult, error) {
 		if user.ID != "admin-1" || filter.Status != "approved" || filter.PostID != "post-1" || filter.Query != "alpha" || filter.Page == nil || *filter.Page != 2 || filter.Size == nil || *filter.Size != 15 {
 			t.Fatalf("unexpected comment filter: %#v", filter)
 		}
@@ -604,7 +606,7 @@ func TestAdminQueryResolvers(t *testing.T) {
 			Size:  15,
 		}, nil
 	}
-	listActiveAdminSessionsFn = func(_ context.Context, user *domain.AdminUser) ([]domain.AdminSessionRecord, error) {
+	listActiveAdminSessionsFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser) ([]domain.AdminSessionRecord, error) {
 		if user.ID != "admin-1" {
 			t.Fatalf("unexpected session user: %#v", user)
 		}
@@ -619,7 +621,7 @@ func TestAdminQueryResolvers(t *testing.T) {
 			Persistent:  true,
 		}}, nil
 	}
-	listAdminNewsletterSubscribersFn = func(_ context.Context, user *domain.AdminUser, filter domain.AdminNewsletterSubscriberFilter) (*domain.AdminNewsletterSubscriberListResult, error) {
+	listAdminNewsletterSubscribersFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, filter domain.AdminNewsletterSubscriberFilter) (*domain.AdminNewsletterSubscriberListResult, error) {
 		if user.ID != "admin-1" || filter.Locale != "tr" || filter.Status != "active" || filter.Query != "alpha" || filter.Page == nil || *filter.Page != 2 || filter.Size == nil || *filter.Size != 15 {
 			t.Fatalf("unexpected subscriber filter: %#v", filter)
 		}
@@ -630,7 +632,7 @@ func TestAdminQueryResolvers(t *testing.T) {
 			Size:  15,
 		}, nil
 	}
-	listAdminNewsletterCampaignsFn = func(_ context.Context, user *domain.AdminUser, filter domain.AdminNewsletterCampaignFilter) (*domain.AdminNewsletterCampaignListResult, error) {
+	listAdminNewsletterCampaignsFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, filter domain.AdminNewsletterCampaignFilter) (*domain.AdminNewsletterCampaignListResult, error) {
 		if user.ID != "admin-1" || filter.Locale != "tr" || filter.Status != "sent" || filter.Query != "alpha" || filter.Page == nil || *filter.Page != 2 || filter.Size == nil || *filter.Size != 15 {
 			t.Fatalf("unexpected campaign filter: %#v", filter)
 		}
@@ -641,7 +643,7 @@ func TestAdminQueryResolvers(t *testing.T) {
 			Size:  15,
 		}, nil
 	}
-	listAdminNewsletterDeliveryFailuresFn = func(_ context.Context, user *domain.AdminUser, filter domain.AdminNewsletterDeliveryFailureFilter) (*domain.AdminNewsletterDeliveryFailureListResult, error) {
+	listAdminNewsletterDeliveryFailuresFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, filter domain.AdminNewsletterDeliveryFailureFilter) (*domain.AdminNewsletterDeliveryFailureListResult, error) {
 		if user.ID != "admin-1" || filter.Locale != "tr" || filter.ItemKey != "alpha-item" || filter.Page == nil || *filter.Page != 2 || filter.Size == nil || *filter.Size != 15 {
 			t.Fatalf("unexpected failure filter: %#v", filter)
 		}
@@ -652,7 +654,7 @@ func TestAdminQueryResolvers(t *testing.T) {
 			Size:  15,
 		}, nil
 	}
-	listAdminErrorMessagesFn = func(_ context.Context, user *domain.AdminUser, filter domain.AdminErrorMessageFilter) (*domain.AdminErrorMessageListResult, error) {
+	listAdminErrorMessagesFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, filter domain.AdminErrorMessageFilter) (*domain.AdminErrorMessageListResult, error) {
 		if user.ID != "admin-1" || filter.Locale != "tr" || filter.Code != "ERR_1" || filter.Query != "alpha" || filter.Page == nil || *filter.Page != 2 || filter.Size == nil || *filter.Size != 15 {
 			t.Fatalf("unexpected error message filter: %#v", filter)
 		}
@@ -663,7 +665,7 @@ func TestAdminQueryResolvers(t *testing.T) {
 			Size:  15,
 		}, nil
 	}
-	listAdminContentPostsFn = func(_ context.Context, user *domain.AdminUser, filter domain.AdminContentPostFilter) (*domain.AdminContentPostListResult, error) {
+	listAdminContentPostsFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, filter domain.AdminContentPostFilter) (*domain.AdminContentPostListResult, error) {
 		if user.ID != "admin-1" || filter.Locale != "tr" || filter.PreferredLocale != "en" || filter.Source != "medium" || filter.Query != "alpha" || filter.CategoryID != "category-1" || filter.TopicID != "topic-1" || filter.Page == nil || *filter.Page != 2 || filter.Size == nil || *filter.Size != 15 {
 			t.Fatalf("unexpected content post filter: %#v", filter)
 		}
@@ -684,13 +686,13 @@ func TestAdminQueryResolvers(t *testing.T) {
 			Size:  15,
 		}, nil
 	}
-	getAdminContentPostFn = func(_ context.Context, user *domain.AdminUser, contentLocale, id string) (*domain.AdminContentPostRecord, error) {
+	getAdminContentPostFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, contentLocale, id string) (*domain.AdminContentPostRecord, error) {
 		if user.ID != "admin-1" || contentLocale != "tr" || id != "post-1" {
 			t.Fatalf("unexpected content entity key: %q %q", contentLocale, id)
 		}
 		return &domain.AdminContentPostRecord{Locale: "tr", ID: "post-1", Title: "Alpha", Source: "blog", PublishedDate: "2026-03-22"}, nil
 	}
-	listAdminContentTopicsPageFn = func(_ context.Context, user *domain.AdminUser, filter domain.AdminContentTaxonomyFilter) (*domain.AdminContentTopicListResult, error) {
+	listAdminContentTopicsPageFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, filter domain.AdminContentTaxonomyFilter) (*domain.AdminContentTopicListResult, error) {
 		if user.ID != "admin-1" || filter.Locale != "tr" || filter.PreferredLocale != "en" || filter.Query != "alpha" || filter.Page == nil || *filter.Page != 2 || filter.Size == nil || *filter.Size != 15 {
 			t.Fatalf("unexpected topic page filter: %#v", filter)
 		}
@@ -701,7 +703,7 @@ func TestAdminQueryResolvers(t *testing.T) {
 			Size:  15,
 		}, nil
 	}
-	listAdminContentCategoriesPageFn = func(_ context.Context, user *domain.AdminUser, filter domain.AdminContentTaxonomyFilter) (*domain.AdminContentCategoryListResult, error) {
+	listAdminContentCategoriesPageFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, filter domain.AdminContentTaxonomyFilter) (*domain.AdminContentCategoryListResult, error) {
 		if user.ID != "admin-1" || filter.Locale != "tr" || filter.PreferredLocale != "en" || filter.Query != "alpha" || filter.Page == nil || *filter.Page != 2 || filter.Size == nil || *filter.Size != 15 {
 			t.Fatalf("unexpected category page filter: %#v", filter)
 		}
@@ -712,19 +714,19 @@ func TestAdminQueryResolvers(t *testing.T) {
 			Size:  15,
 		}, nil
 	}
-	listAdminContentTopicsFn = func(_ context.Context, user *domain.AdminUser, contentLocale, text string) ([]domain.AdminContentTopicRecord, error) {
+	listAdminContentTopicsFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, contentLocale, text string) ([]domain.AdminContentTopicRecord, error) {
 		if user.ID != "admin-1" || contentLocale != "tr" || text != "alpha" {
 			t.Fatalf("unexpected topics lookup: %q %q", contentLocale, text)
 		}
 		return []domain.AdminContentTopicRecord{{Locale: "tr", ID: "topic-1", Name: "Alpha", Color: "#fff", UpdatedAt: now}}, nil
 	}
-	listAdminContentCategoriesFn = func(_ context.Context, user *domain.AdminUser, contentLocale string) ([]domain.AdminContentCategoryRecord, error) {
+	listAdminContentCategoriesFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, contentLocale string) ([]domain.AdminContentCategoryRecord, error) {
 		if user.ID != "admin-1" || contentLocale != "tr" {
 			t.Fatalf("unexpected categories lookup: %q", contentLocale)
 		}
 		return []domain.AdminContentCategoryRecord{{Locale: "tr", ID: "category-1", Name: "Alpha", Color: "#000", UpdatedAt: now}}, nil
 	}
-	listAdminErrorMessageAuditLogsFn = func(_ context.Context, user *domain.AdminUser, limit int) ([]domain.AdminAuditLogRecord, error) {
+	listAdminErrorMessageAuditLogsFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, limit int) ([]domain.AdminAuditLogRecord, error) {
 		if user.ID != "admin-1" || limit != 5 {
 			t.Fatalf("unexpected audit log limit: %d", limit)
 		}
@@ -935,7 +937,7 @@ func TestAdminMutationAuthResolvers(t *testing.T) {
 		revokeAllAdminSessionsFn = originalRevokeAllAdminSessionsFn
 	})
 
-	loginAdminFn = func(_ context.Context, email, password string, rememberMe bool, metadata appservice.AdminSessionMetadata) (*appservice.AdminAuthResponse, error) {
+	loginAdminFn = func(_ *appservice.Service, _ context.Context, email, password string, rememberMe bool, metadata appservice.AdminSessionMetadata) (*appservice.AdminAuthResponse, error) {
 		if email != "admin@example.com" || password != "password" || !rememberMe || metadata.RemoteIP != "203.0.113.20" || metadata.CountryCode != "TR" {
 			t.Fatalf("unexpected login call: %q %q %t %#v", email, password, rememberMe, metadata)
 		}
@@ -954,7 +956,7 @@ func TestAdminMutationAuthResolvers(t *testing.T) {
 		}
 		return &appservice.AdminGoogleConnectResult{URL: "/api/oauth/connect?provider=google"}, nil
 	}
-	disconnectAdminGoogleAccountFn = func(_ context.Context, user *domain.AdminUser) (*domain.AdminUser, error) {
+	disconnectAdminGoogleAccountFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser) (*domain.AdminUser, error) {
 		if user.ID != "admin-1" {
 			t.Fatalf("unexpected google disconnect user: %#v", user)
 		}
@@ -966,13 +968,13 @@ func TestAdminMutationAuthResolvers(t *testing.T) {
 		}
 		return &appservice.AdminGithubConnectResult{URL: "/api/oauth/connect?provider=github"}, nil
 	}
-	disconnectAdminGithubAccountFn = func(_ context.Context, user *domain.AdminUser) (*domain.AdminUser, error) {
+	disconnectAdminGithubAccountFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser) (*domain.AdminUser, error) {
 		if user.ID != "admin-1" {
 			t.Fatalf("unexpected github disconnect user: %#v", user)
 		}
 		return &domain.AdminUser{ID: "admin-1", Email: "admin@example.com"}, nil
 	}
-	refreshAdminSessionFn = func(_ context.Context, token string, metadata appservice.AdminSessionMetadata) (*appservice.AdminAuthResponse, error) {
+	refreshAdminSessionFn = func(_ *appservice.Service, _ context.Context, token string, metadata appservice.AdminSessionMetadata) (*appservice.AdminAuthResponse, error) {
 		if token != "refresh-session" || metadata.RemoteIP != "203.0.113.20" || metadata.CountryCode != "TR" {
 			t.Fatalf("unexpected refresh input: %q %#v", token, metadata)
 		}
@@ -984,25 +986,25 @@ func TestAdminMutationAuthResolvers(t *testing.T) {
 			RememberMe:   false,
 		}, nil
 	}
-	logoutAdminFn = func(_ context.Context, token string) error {
+	logoutAdminFn = func(_ *appservice.Service, _ context.Context, token string) error {
 		if token != "refresh-session" {
 			t.Fatalf("unexpected logout token: %q", token)
 		}
 		return nil
 	}
-	requestAdminPasswordResetFn = func(_ context.Context, email, locale string) error {
+	requestAdminPasswordResetFn = func(_ *appservice.Service, _ context.Context, email, locale string) error {
 		if email != "admin@example.com" || locale != "tr" {
 			t.Fatalf("unexpected password reset request input: %q %q", email, locale)
 		}
 		return nil
 	}
-	validateAdminPasswordResetTokenFn = func(_ context.Context, token, locale string) (*appservice.AdminPasswordResetValidationResult, error) {
+	validateAdminPasswordResetTokenFn = func(_ *appservice.Service, _ context.Context, token, locale string) (*appservice.AdminPasswordResetValidationResult, error) {
 		if token != "reset-token" || locale != "tr" {
 			t.Fatalf("unexpected password reset token validation input: %q %q", token, locale)
 		}
 		return &appservice.AdminPasswordResetValidationResult{Status: "success", Locale: "tr"}, nil
 	}
-	resetAdminPasswordWithTokenFn = func(
+	resetAdminPasswordWithTokenFn = func(_ *appservice.Service,
 		_ context.Context,
 		token,
 		newPassword,
@@ -1020,13 +1022,13 @@ func TestAdminMutationAuthResolvers(t *testing.T) {
 		}
 		return &appservice.AdminPasswordResetResult{Success: true, Locale: "tr"}, nil
 	}
-	confirmAdminEmailChangeFn = func(_ context.Context, token, locale string) (*appservice.AdminEmailChangeConfirmResult, error) {
+	confirmAdminEmailChangeFn = func(_ *appservice.Service, _ context.Context, token, locale string) (*appservice.AdminEmailChangeConfirmResult, error) {
 		if token != "confirm-token" || locale != "tr" {
 			t.Fatalf("unexpected email change confirm input: %q %q", token, locale)
 		}
 		return &appservice.AdminEmailChangeConfirmResult{Status: "success", Locale: "tr"}, nil
 	}
-	changeAdminNameFn = func(_ context.Context, user *domain.AdminUser, name string) (*domain.AdminUser, error) {
+	changeAdminNameFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, name string) (*domain.AdminUser, error) {
 		if user.ID != "admin-1" || name != "Display Name" {
 			t.Fatalf("unexpected change name input: %q %#v", name, user)
 		}
@@ -1034,7 +1036,7 @@ func TestAdminMutationAuthResolvers(t *testing.T) {
 		updatedUser.Name = name
 		return &updatedUser, nil
 	}
-	changeAdminAvatarFn = func(_ context.Context, user *domain.AdminUser, avatarURL *string) (*domain.AdminUser, error) {
+	changeAdminAvatarFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, avatarURL *string) (*domain.AdminUser, error) {
 		if user.ID != "admin-1" || avatarURL == nil || *avatarURL != "/avatar.png" {
 			t.Fatalf("unexpected change avatar input: %#v %#v", avatarURL, user)
 		}
@@ -1042,7 +1044,7 @@ func TestAdminMutationAuthResolvers(t *testing.T) {
 		updatedUser.AvatarURL = strings.TrimSpace(*avatarURL)
 		return &updatedUser, nil
 	}
-	changeAdminUsernameFn = func(_ context.Context, user *domain.AdminUser, username string) (*domain.AdminUser, error) {
+	changeAdminUsernameFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, username string) (*domain.AdminUser, error) {
 		if user.ID != "admin-1" || username != " next-admin " {
 			t.Fatalf("unexpected change username input: %q %#v", username, user)
 		}
@@ -1050,31 +1052,31 @@ func TestAdminMutationAuthResolvers(t *testing.T) {
 		updatedUser.Username = "next-admin"
 		return &updatedUser, nil
 	}
-	requestAdminEmailChangeFn = func(_ context.Context, user *domain.AdminUser, newEmail, currentPassword, locale string) (*appservice.AdminEmailChangeRequestResult, error) {
+	requestAdminEmailChangeFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, newEmail, currentPassword, locale string) (*appservice.AdminEmailChangeRequestResult, error) {
 		if user.ID != "admin-1" || newEmail != "next@example.com" || currentPassword != "password" || locale != "tr" {
 			t.Fatalf("unexpected email change request input: %q %q %q", newEmail, currentPassword, locale)
 		}
 		return &appservice.AdminEmailChangeRequestResult{Success: true, PendingEmail: "next@example.com", ExpiresAt: now.Add(time.Hour)}, nil
 	}
-	deleteAdminAccountFn = func(_ context.Context, user *domain.AdminUser, currentPassword string) error {
+	deleteAdminAccountFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, currentPassword string) error {
 		if user.ID != "admin-1" || currentPassword != "password" {
 			t.Fatalf("unexpected delete account input: %q %#v", currentPassword, user)
 		}
 		return nil
 	}
-	changeAdminPasswordFn = func(_ context.Context, user *domain.AdminUser, currentPassword, newPassword, confirmPassword string) error {
+	changeAdminPasswordFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, currentPassword, newPassword, confirmPassword string) error {
 		if user.ID != "admin-1" || currentPassword != "current" || newPassword != "new-password" || confirmPassword != "new-password" {
 			t.Fatalf("unexpected change password input: %q %q %q", currentPassword, newPassword, confirmPassword)
 		}
 		return nil
 	}
-	revokeAdminSessionFn = func(_ context.Context, user *domain.AdminUser, sessionID string) (bool, error) {
+	revokeAdminSessionFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, sessionID string) (bool, error) {
 		if user.ID != "admin-1" || sessionID != "session-123" {
 			t.Fatalf("unexpected revoke session input: %q %#v", sessionID, user)
 		}
 		return true, nil
 	}
-	revokeAllAdminSessionsFn = func(_ context.Context, user *domain.AdminUser) error {
+	revokeAllAdminSessionsFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser) error {
 		if user.ID != "admin-1" {
 			t.Fatalf("unexpected revoke all user: %#v", user)
 		}
@@ -1272,37 +1274,37 @@ func TestAdminMutationManagementResolvers(t *testing.T) {
 		deleteAdminContentCategoryFn = originalDeleteAdminContentCategoryFn
 	})
 
-	updateAdminCommentStatusFn = func(_ context.Context, user *domain.AdminUser, commentID, status string) (*domain.CommentRecord, error) {
+	updateAdminCommentStatusFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, commentID, status string) (*domain.CommentRecord, error) {
 		if user.ID != "admin-1" || commentID != "comment-1" || status != "approved" {
 			t.Fatalf("unexpected update comment status input: %q %q", commentID, status)
 		}
 		return &domain.CommentRecord{ID: "comment-1", PostID: "post-1", AuthorName: "Reader", AuthorEmail: "reader@example.com", Content: "hello", Status: "approved", CreatedAt: now, UpdatedAt: now}, nil
 	}
-	deleteAdminCommentFn = func(_ context.Context, user *domain.AdminUser, commentID string) error {
+	deleteAdminCommentFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, commentID string) error {
 		if user.ID != "admin-1" || commentID != "comment-1" {
 			t.Fatalf("unexpected delete comment input: %q", commentID)
 		}
 		return nil
 	}
-	bulkUpdateAdminCommentStatusFn = func(_ context.Context, user *domain.AdminUser, commentIDs []string, status string) (int, error) {
+	bulkUpdateAdminCommentStatusFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, commentIDs []string, status string) (int, error) {
 		if user.ID != "admin-1" || status != "spam" || len(commentIDs) != 2 || commentIDs[0] != "comment-1" || commentIDs[1] != "comment-2" {
 			t.Fatalf("unexpected bulk update input: %#v %q", commentIDs, status)
 		}
 		return 2, nil
 	}
-	bulkDeleteAdminCommentsFn = func(_ context.Context, user *domain.AdminUser, commentIDs []string) (int, error) {
+	bulkDeleteAdminCommentsFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, commentIDs []string) (int, error) {
 		if user.ID != "admin-1" || len(commentIDs) != 2 || commentIDs[0] != "comment-1" || commentIDs[1] != "comment-2" {
 			t.Fatalf("unexpected bulk delete input: %#v", commentIDs)
 		}
 		return 2, nil
 	}
-	updateAdminNewsletterSubscriberStatusFn = func(_ context.Context, user *domain.AdminUser, email, status string) (*domain.AdminNewsletterSubscriberRecord, error) {
+	updateAdminNewsletterSubscriberStatusFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, email, status string) (*domain.AdminNewsletterSubscriberRecord, error) {
 		if user.ID != "admin-1" || email != "reader@example.com" || status != "active" {
 			t.Fatalf("unexpected update subscriber input: %q %q", email, status)
 		}
 		return &domain.AdminNewsletterSubscriberRecord{Email: email, Locale: "tr", Status: status, UpdatedAt: now, CreatedAt: now}, nil
 	}
-	deleteAdminNewsletterSubscriberFn = func(_ context.Context, user *domain.AdminUser, email string) error {
+	deleteAdminNewsletterSubscriberFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, email string) error {
 		if user.ID != "admin-1" || email != "reader@example.com" {
 			t.Fatalf("unexpected delete subscriber input: %q", email)
 		}
@@ -1320,73 +1322,73 @@ func TestAdminMutationManagementResolvers(t *testing.T) {
 		}
 		return &domain.AdminNewsletterTestSendResult{Success: true, Message: "queued", Timestamp: now, Email: email, Locale: locale, ItemKey: itemKey, PostTitle: "Alpha"}, nil
 	}
-	createAdminErrorMessageFn = func(_ context.Context, user *domain.AdminUser, key domain.AdminErrorMessageKey, message string) (*domain.AdminErrorMessageView, error) {
+	createAdminErrorMessageFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, key domain.AdminErrorMessageKey, message string) (*domain.AdminErrorMessageView, error) {
 		if user.ID != "admin-1" || key.Scope != "admin" || key.Locale != "tr" || key.Code != "ERR_1" || message != "Created" {
 			t.Fatalf("unexpected create error message input: %#v %q", key, message)
 		}
 		return &domain.AdminErrorMessageView{AdminErrorMessageKey: key, Message: message, UpdatedAt: now}, nil
 	}
-	updateAdminErrorMessageFn = func(_ context.Context, user *domain.AdminUser, key domain.AdminErrorMessageKey, message string) (*domain.AdminErrorMessageView, error) {
+	updateAdminErrorMessageFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, key domain.AdminErrorMessageKey, message string) (*domain.AdminErrorMessageView, error) {
 		if user.ID != "admin-1" || key.Scope != "admin" || key.Locale != "tr" || key.Code != "ERR_1" || message != "Updated" {
 			t.Fatalf("unexpected update error message input: %#v %q", key, message)
 		}
 		return &domain.AdminErrorMessageView{AdminErrorMessageKey: key, Message: message, UpdatedAt: now}, nil
 	}
-	deleteAdminErrorMessageFn = func(_ context.Context, user *domain.AdminUser, key domain.AdminErrorMessageKey) error {
+	deleteAdminErrorMessageFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, key domain.AdminErrorMessageKey) error {
 		if user.ID != "admin-1" || key.Scope != "admin" || key.Locale != "tr" || key.Code != "ERR_1" {
 			t.Fatalf("unexpected delete error key: %#v", key)
 		}
 		return nil
 	}
-	updateAdminContentPostMetadataFn = func(_ context.Context, user *domain.AdminUser, input domain.AdminContentPostMetadataInput) (*domain.AdminContentPostRecord, error) {
+	updateAdminContentPostMetadataFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, input domain.AdminContentPostMetadataInput) (*domain.AdminContentPostRecord, error) {
 		if user.ID != "admin-1" || input.Locale != "tr" || input.ID != "post-1" || input.Title == nil || *input.Title != "Alpha" || input.CategoryID != "category-1" || len(input.TopicIDs) != 2 || input.TopicIDs[0] != "topic-1" || input.TopicIDs[1] != "topic-2" {
 			t.Fatalf("unexpected content metadata input: %#v", input)
 		}
 		return &domain.AdminContentPostRecord{Locale: input.Locale, ID: input.ID, Title: *input.Title, Source: "blog", PublishedDate: "2026-03-22"}, nil
 	}
-	updateAdminContentPostContentFn = func(_ context.Context, user *domain.AdminUser, input domain.AdminContentPostContentInput) (*domain.AdminContentPostRecord, error) {
+	updateAdminContentPostContentFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, input domain.AdminContentPostContentInput) (*domain.AdminContentPostRecord, error) {
 		if user.ID != "admin-1" || input.Locale != "tr" || input.ID != "post-1" || input.Content != "Body" {
 			t.Fatalf("unexpected content body input: %#v", input)
 		}
 		return &domain.AdminContentPostRecord{Locale: input.Locale, ID: input.ID, Title: "Alpha", Content: input.Content, Source: "blog", PublishedDate: "2026-03-22"}, nil
 	}
-	deleteAdminContentPostFn = func(_ context.Context, user *domain.AdminUser, locale, id string) error {
+	deleteAdminContentPostFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, locale, id string) error {
 		if user.ID != "admin-1" || locale != "tr" || id != "post-1" {
 			t.Fatalf("unexpected delete content post input: %q %q", locale, id)
 		}
 		return nil
 	}
-	createAdminContentTopicFn = func(_ context.Context, user *domain.AdminUser, input domain.AdminContentTopicInput) (*domain.AdminContentTopicRecord, error) {
+	createAdminContentTopicFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, input domain.AdminContentTopicInput) (*domain.AdminContentTopicRecord, error) {
 		if user.ID != "admin-1" || input.Locale != "tr" || input.ID != "topic-1" || input.Name != "Alpha Topic" || input.Color != "#fff" || input.Link != "https://example.com/topic" {
 			t.Fatalf("unexpected create topic input: %#v", input)
 		}
 		return &domain.AdminContentTopicRecord{Locale: input.Locale, ID: input.ID, Name: input.Name, Color: input.Color, Link: input.Link, UpdatedAt: now}, nil
 	}
-	updateAdminContentTopicFn = func(_ context.Context, user *domain.AdminUser, input domain.AdminContentTopicInput) (*domain.AdminContentTopicRecord, error) {
+	updateAdminContentTopicFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, input domain.AdminContentTopicInput) (*domain.AdminContentTopicRecord, error) {
 		if user.ID != "admin-1" || input.Locale != "tr" || input.ID != "topic-1" {
 			t.Fatalf("unexpected update topic input: %#v", input)
 		}
 		return &domain.AdminContentTopicRecord{Locale: input.Locale, ID: input.ID, Name: input.Name, Color: input.Color, Link: input.Link, UpdatedAt: now}, nil
 	}
-	deleteAdminContentTopicFn = func(_ context.Context, user *domain.AdminUser, locale, id string) error {
+	deleteAdminContentTopicFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, locale, id string) error {
 		if user.ID != "admin-1" || locale != "tr" || id != "topic-1" {
 			t.Fatalf("unexpected delete topic input: %q %q", locale, id)
 		}
 		return nil
 	}
-	createAdminContentCategoryFn = func(_ context.Context, user *domain.AdminUser, input domain.AdminContentCategoryInput) (*domain.AdminContentCategoryRecord, error) {
+	createAdminContentCategoryFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, input domain.AdminContentCategoryInput) (*domain.AdminContentCategoryRecord, error) {
 		if user.ID != "admin-1" || input.Locale != "tr" || input.ID != "category-1" || input.Name != "Alpha Category" || input.Color != "#000" || input.Icon != "tag" || input.Link != "https://example.com/category" {
 			t.Fatalf("unexpected create category input: %#v", input)
 		}
 		return &domain.AdminContentCategoryRecord{Locale: input.Locale, ID: input.ID, Name: input.Name, Color: input.Color, Icon: input.Icon, Link: input.Link, UpdatedAt: now}, nil
 	}
-	updateAdminContentCategoryFn = func(_ context.Context, user *domain.AdminUser, input domain.AdminContentCategoryInput) (*domain.AdminContentCategoryRecord, error) {
+	updateAdminContentCategoryFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, input domain.AdminContentCategoryInput) (*domain.AdminContentCategoryRecord, error) {
 		if user.ID != "admin-1" || input.Locale != "tr" || input.ID != "category-1" {
 			t.Fatalf("unexpected update category input: %#v", input)
 		}
 		return &domain.AdminContentCategoryRecord{Locale: input.Locale, ID: input.ID, Name: input.Name, Color: input.Color, Icon: input.Icon, Link: input.Link, UpdatedAt: now}, nil
 	}
-	deleteAdminContentCategoryFn = func(_ context.Context, user *domain.AdminUser, locale, id string) error {
+	deleteAdminContentCategoryFn = func(_ *appservice.Service, _ context.Context, user *domain.AdminUser, locale, id string) error {
 		if user.ID != "admin-1" || locale != "tr" || id != "category-1" {
 			t.Fatalf("unexpected delete category input: %q %q", locale, id)
 		}
@@ -1590,3 +1592,672 @@ func datePtr(value string) *appscalars.Date {
 	resolved := appscalars.Date(value)
 	return &resolved
 }
+
+func TestAdminContentSeriesResolvers(t *testing.T) {
+	originalListFn := listAdminContentSeriesPageFn
+	originalCreateFn := createAdminContentSeriesFn
+	originalUpdateFn := updateAdminContentSeriesFn
+	originalDeleteFn := deleteAdminContentSeriesFn
+	t.Cleanup(func() {
+		listAdminContentSeriesPageFn = originalListFn
+		createAdminContentSeriesFn = originalCreateFn
+		updateAdminContentSeriesFn = originalUpdateFn
+		deleteAdminContentSeriesFn = originalDeleteFn
+	})
+
+	now := time.Date(2026, time.March, 20, 10, 0, 0, 0, time.UTC)
+	record := &domain.AdminContentSeriesRecord{
+		Locale:    "en",
+		ID:        "go-basics",
+		Name:      "Go Basics",
+		PostIDs:   []string{"part-one", "part-two"},
+		UpdatedAt: now,
+	}
+	listAdminContentSeriesPageFn = func(_ *appservice.Service, _ context.Context, _ *domain.AdminUser, filter domain.AdminContentTaxonomyFilter) (*domain.AdminContentSeriesListResult, error) {
+		if filter.PreferredLocale != "tr" || filter.Query != "go" {
+			t.Fatalf("unexpected series filter: %#v", filter)
+		}
+		return &domain.AdminContentSeriesListResult{
+			Items: []domain.AdminContentSeriesGroupRecord{{ID: "go-basics", Preferred: *record, EN: record}},
+			Total: 1,
+			Page:  1,
+			Size:  20,
+		}, nil
+	}
+	saveFn := func(_ *appservice.Service, _ context.Context, _ *domain.AdminUser, input domain.AdminContentSeriesInput) (*domain.AdminContentSeriesRecord, error) {
+		if input.Locale != "en" || input.Description != "Intro" || len(input.PostIDs) != 2 || input.PostIDs[0] != "part-one" {
+			t.Fatalf("unexpected series input: %#v", input)
+		}
+		return record, nil
+	}
+	createAdminContentSeriesFn = saveFn
+	updateAdminContentSeriesFn = saveFn
+	deleteAdminContentSeriesFn = func(_ *appservice.Service, _ context.Context, _ *domain.AdminUser, locale, seriesID string) error {
+		if locale != "en" || seriesID != "go-basics" {
+			t.Fatalf("unexpected delete args: %q %q", locale, seriesID)
+		}
+		return nil
+	}
+
+	ctx := WithAdminUser(context.Background(), &domain.AdminUser{ID: "admin-1"})
+	queryResolver := &adminQueryResolver{Resolver: &Resolver{}}
+	mutationResolver := &adminMutationResolver{Resolver: &Resolver{}}
+
+	preferredLocale := appscalars.Locale("tr")
+	query := " go "
+	page, err := queryResolver.ContentSeriesPage(ctx, &model.AdminContentTaxonomyFilterInput{
+		PreferredLocale: &preferredLocale,
+		Query:           &query,
+	})
+	if err != nil || page.Total != 1 || len(page.Items) != 1 || page.Items[0].Preferred.ID != "go-basics" || page.Items[0].Tr != nil {
+		t.Fatalf("ContentSeriesPage() = %#v, %v", page, err)
+	}
+
+	description := " Intro "
+	input := model.AdminContentSeriesInput{
+		Locale:      appscalars.Locale("en"),
+		ID:          " go-basics ",
+		Name:        "Go Basics",
+		Description: &description,
+		PostIds:     []string{" part-one ", "part-two"},
+	}
+	created, err := mutationResolver.CreateContentSeries(ctx, input)
+	if err != nil || created.ID != "go-basics" || len(created.PostIds) != 2 || created.Description != nil {
+		t.Fatalf("CreateContentSeries() = %#v, %v", created, err)
+	}
+	if updated, err := mutationResolver.UpdateContentSeries(ctx, input); err != nil || updated.UpdatedAt == nil {
+		t.Fatalf("UpdateContentSeries() = %#v, %v", updated, err)
+	}
+	deleted, err := mutationResolver.DeleteContentSeries(ctx, model.AdminContentEntityKeyInput{Locale: "en", ID: " go-basics "})
+	if err != nil || !deleted.Success {
+		t.Fatalf("DeleteContentSeries() = %#v, %v", deleted, err)
+	}
+
+	if _, err := queryResolver.ContentSeriesPage(context.Background(), nil); err == nil {
+		t.Fatal("expected unauthenticated series page to fail")
+	}
+	if empty := mapAdminContentSeriesListPayload(nil); empty.Size != 20 || len(empty.Items) != 0 {
+		t.Fatalf("unexpected empty payload: %#v", empty)
+	}
+}
+
+func TestAdminContentTaxonomyRewriteResolvers(t *testing.T) {
+	originalMergeFn := mergeAdminContentTopicsFn
+	originalRenameFn := renameAdminContentCategoryFn
+	t.Cleanup(func() {
+		mergeAdminContentTopicsFn = originalMergeFn
+		renameAdminContentCategoryFn = originalRenameFn
+	})
+
+	mergeAdminContentTopicsFn = func(_ *appservice.Service, _ context.Context, _ *domain.AdminUser, input domain.AdminContentTopicMergeInput) (*domain.AdminContentTaxonomyRewriteResult, error) {
+		if input.TargetID != "go" || len(input.SourceIDs) != 1 || input.SourceIDs[0] != "golang" || !input.DryRun {
+			t.Fatalf("unexpected merge input: %#v", input)
+		}
+		return &domain.AdminContentTaxonomyRewriteResult{
+			DryRun: true,
+			Posts:  []domain.AdminContentTaxonomyRewritePost{{Locale: "en", ID: "first", Title: "First"}},
+		}, nil
+	}
+	renameAdminContentCategoryFn = func(_ *appservice.Service, _ context.Context, _ *domain.AdminUser, input domain.AdminContentCategoryRenameInput) (*domain.AdminContentTaxonomyRewriteResult, error) {
+		if input.SourceID != "programming" || input.TargetID != "software" || input.DryRun {
+			t.Fatalf("unexpected rename input: %#v", input)
+		}
+		return &domain.AdminContentTaxonomyRewriteResult{PostsUpdated: 2, RevisionsCreated: 2, TaxonomiesRemoved: 1}, nil
+	}
+
+	mutationResolver := &adminMutationResolver{Resolver: &Resolver{}}
+	if _, err := mutationResolver.MergeContentTopics(context.Background(), model.AdminMergeContentTopicsInput{}); err == nil {
+		t.Fatal("expected unauthenticated merge to fail")
+	}
+
+	ctx := WithAdminUser(context.Background(), &domain.AdminUser{ID: "admin-1"})
+	dryRun := true
+	merged, err := mutationResolver.MergeContentTopics(ctx, model.AdminMergeContentTopicsInput{
+		SourceIds: []string{"golang"},
+		TargetID:  " go ",
+		DryRun:    &dryRun,
+	})
+	if err != nil || !merged.DryRun || len(merged.Posts) != 1 || merged.Posts[0].ID != "first" || merged.Posts[0].Locale != "en" {
+		t.Fatalf("MergeContentTopics() = %#v, %v", merged, err)
+	}
+
+	renamed, err := mutationResolver.RenameContentCategory(ctx, model.AdminRenameContentCategoryInput{
+		SourceID: "programming",
+		TargetID: "software",
+	})
+	if err != nil || renamed.DryRun || renamed.PostsUpdated != 2 || renamed.RevisionsCreated != 2 || renamed.TaxonomiesRemoved != 1 || renamed.Posts == nil {
+		t.Fatalf("RenameContentCategory() = %#v, %v", renamed, err)
+	}
+}
+
+func TestAdminRenameContentPostResolver(t *testing.T) {
+	originalRenameFn := renameAdminContentPostFn
+	t.Cleanup(func() {
+		renameAdminContentPostFn = originalRenameFn
+	})
+
+	renameAdminContentPostFn = func(_ *appservice.Service, _ context.Context, _ *domain.AdminUser, input domain.AdminContentPostRenameInput) (*domain.AdminContentPostRenameResult, error) {
+		if input.SourceID != "old-post" || input.TargetID != "new-post" {
+			t.Fatalf("unexpected rename input: %#v", input)
+		}
+		return &domain.AdminContentPostRenameResult{
+			SourceID:         "old-post",
+			TargetID:         "new-post",
+			PostsUpdated:     2,
+			RevisionsUpdated: 5,
+			CommentsUpdated:  3,
+		}, nil
+	}
+
+	mutationResolver := &adminMutationResolver{Resolver: &Resolver{}}
+	if _, err := mutationResolver.RenameContentPost(context.Background(), model.AdminRenameContentPostInput{}); err == nil {
+		t.Fatal("expected unauthenticated rename to fail")
+	}
+
+	ctx := WithAdminUser(context.Background(), &domain.AdminUser{ID: "admin-1"})
+	payload, err := mutationResolver.RenameContentPost(ctx, model.AdminRenameContentPostInput{
+		SourceID: " old-post ",
+		TargetID: "new-post ",
+	})
+	if err != nil || payload.TargetID != "new-post" || payload.PostsUpdated != 2 || payload.RevisionsUpdated != 5 || payload.CommentsUpdated != 3 {
+		t.Fatalf("RenameContentPost() = %#v, %v", payload, err)
+	}
+}
+
+func TestAdminUploadMediaAssetResolverAcceptsMultipartFile(t *testing.T) {
+	originalUploadFn := uploadAdminMediaAssetFn
+	t.Cleanup(func() {
+		uploadAdminMediaAssetFn = originalUploadFn
+	})
+
+	uploadAdminMediaAssetFn = func(_ *appservice.Service, _ context.Context, _ *domain.AdminUser, input domain.AdminMediaUploadInput) (*domain.AdminMediaLibraryItem, error) {
+		if input.File == nil || input.DataURL != "" || input.FileName != "cover.png" {
+			t.Fatalf("unexpected upload input: %#v", input)
+		}
+		return &domain.AdminMediaLibraryItem{ID: "asset-1", Kind: "UPLOADED", Name: input.FileName}, nil
+	}
+
+	mutationResolver := &adminMutationResolver{Resolver: &Resolver{}}
+	ctx := WithAdminUser(context.Background(), &domain.AdminUser{ID: "admin-1"})
+	item, err := mutationResolver.UploadMediaAsset(ctx, model.AdminUploadMediaAssetInput{
+		FileName: " ",
+		File:     &graphql.Upload{File: strings.NewReader("png"), Filename: "cover.png"},
+	})
+	if err != nil || item.ID != "asset-1" || item.Name != "cover.png" {
+		t.Fatalf("UploadMediaAsset() = %#v, %v", item, err)
+	}
+}
+
+func TestAdminUpdateMediaAssetMetadataResolverMapsInputAndItem(t *testing.T) {
+	originalUpdateFn := updateAdminMediaAssetMetadataFn
+	t.Cleanup(func() {
+		updateAdminMediaAssetMetadataFn = originalUpdateFn
+	})
+
+	updateAdminMediaAssetMetadataFn = func(_ *appservice.Service,
+		_ context.Context,
+		_ *domain.AdminUser,
+		id string,
+		input domain.AdminMediaAssetMetadata,
+	) (*domain.AdminMediaLibraryItem, error) {
+		if id != "asset-1" || len(input.AltTexts) != 1 || input.AltTexts[0].Locale != "tr" || input.Folder != "blog" {
+			t.Fatalf("unexpected metadata update %q %#v", id, input)
+		}
+		return &domain.AdminMediaLibraryItem{
+			ID:       id,
+			Kind:     "UPLOADED",
+			Metadata: input,
+		}, nil
+	}
+
+	mutationResolver := &adminMutationResolver{Resolver: &Resolver{}}
+	ctx := WithAdminUser(context.Background(), &domain.AdminUser{ID: "admin-1"})
+	folder := "blog"
+	item, err := mutationResolver.UpdateMediaAssetMetadata(ctx, " asset-1 ", model.AdminUpdateMediaAssetMetadataInput{
+		AltTexts: []*model.AdminMediaAltTextInput{{Locale: appscalars.Locale("TR"), Text: "Galata Kulesi"}},
+		Tags:     []string{"travel"},
+		Folder:   &folder,
+	})
+	if err != nil {
+		t.Fatalf("UpdateMediaAssetMetadata() error = %v", err)
+	}
+	if len(item.AltTexts) != 1 || item.AltTexts[0].Text != "Galata Kulesi" || item.Folder == nil || *item.Folder != "blog" {
+		t.Fatalf("UpdateMediaAssetMetadata() = %#v", item)
+	}
+	if item.Caption != nil || len(item.Tags) != 1 {
+		t.Fatalf("unexpected caption or tags %#v", item)
+	}
+}
+
+func TestAdminCollectMediaGarbageResolverMapsResult(t *testing.T) {
+	originalCollectFn := collectAdminMediaGarbageFn
+	t.Cleanup(func() {
+		collectAdminMediaGarbageFn = originalCollectFn
+	})
+
+	ranAt := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	collectAdminMediaGarbageFn = func(_ *appservice.Service,
+		_ context.Context,
+		_ *domain.AdminUser,
+		dryRun bool,
+	) (*domain.AdminMediaGarbageCollectionResult, error) {
+		return &domain.AdminMediaGarbageCollectionResult{
+			DryRun:         dryRun,
+			Scanned:        12,
+			Quarantined:    3,
+			Purged:         2,
+			ReclaimedBytes: 4096,
+			RanAt:          ranAt,
+		}, nil
+	}
+
+	mutationResolver := &adminMutationResolver{Resolver: &Resolver{}}
+	ctx := WithAdminUser(context.Background(), &domain.AdminUser{ID: "admin-1"})
+	dryRun := true
+	payload, err := mutationResolver.CollectMediaGarbage(ctx, &dryRun)
+	if err != nil {
+		t.Fatalf("CollectMediaGarbage() error = %v", err)
+	}
+	if !payload.DryRun || payload.ScannedCount != 12 || payload.QuarantinedCount != 3 || payload.PurgedCount != 2 {
+		t.Fatalf("unexpected payload %#v", payload)
+	}
+	if payload.ReclaimedBytes != 4096 || !payload.RanAt.Equal(ranAt) {
+		t.Fatalf("unexpected reclaimed bytes or time %#v", payload)
+	}
+
+	if _, err := mutationResolver.CollectMediaGarbage(context.Background(), nil); err == nil {
+		t.Fatal("expected unauthenticated garbage collection to fail")
+	}
+}
+
+func TestAdminUserManagementResolvers(t *testing.T) {
+	originalListFn := listAdminUsersFn
+	originalInviteFn := inviteAdminUserFn
+	originalAcceptFn := acceptAdminInvitationFn
+	originalUnlockFn := unlockAdminUserFn
+	t.Cleanup(func() {
+		listAdminUsersFn = originalListFn
+		inviteAdminUserFn = originalInviteFn
+		acceptAdminInvitationFn = originalAcceptFn
+		unlockAdminUserFn = originalUnlockFn
+	})
+
+	expiresAt := time.Date(2026, 3, 17, 12, 0, 0, 0, time.UTC)
+	listAdminUsersFn = func(_ *appservice.Service, _ context.Context, _ *domain.AdminUser) ([]domain.AdminUser, error) {
+		return []domain.AdminUser{
+			{ID: "admin-1", Email: "owner@example.com", Roles: []string{"owner"}},
+			{ID: "admin-2", Email: "editor@example.com", Roles: []string{"editor"}, Status: domain.AdminUserStatusInvited, InvitationExpiresAt: &expiresAt},
+		}, nil
+	}
+	inviteAdminUserFn = func(_ *appservice.Service, _ context.Context, _ *domain.AdminUser, input domain.AdminInvitationInput) (*domain.AdminUser, error) {
+		if input.Email != "editor@example.com" || input.Name != "Editor" || input.Locale != "tr" || len(input.Roles) != 1 {
+			t.Fatalf("unexpected invitation input: %#v", input)
+		}
+		return &domain.AdminUser{ID: "admin-2", Email: input.Email, Roles: input.Roles, Status: domain.AdminUserStatusInvited}, nil
+	}
+	acceptAdminInvitationFn = func(_ *appservice.Service, _ context.Context, token, newPassword, confirmPassword, locale string) (*appservice.AdminInvitationAcceptResult, error) {
+		if token != "invite-token" || newPassword != confirmPassword || locale != "" {
+			t.Fatalf("unexpected accept input %q %q %q", token, newPassword, locale)
+		}
+		return &appservice.AdminInvitationAcceptResult{Success: true, Locale: "tr"}, nil
+	}
+
+	queryResolver := &adminQueryResolver{Resolver: &Resolver{}}
+	if _, err := queryResolver.AdminUsers(context.Background()); err == nil {
+		t.Fatal("expected unauthenticated admin listing to fail")
+	}
+
+	ctx := WithAdminUser(context.Background(), &domain.AdminUser{ID: "admin-1", Roles: []string{"owner"}})
+	users, err := queryResolver.AdminUsers(ctx)
+	if err != nil || len(users) != 2 {
+		t.Fatalf("AdminUsers() = %#v, %v", users, err)
+	}
+	if users[0].Status != model.AdminUserStatusActive || users[1].Status != model.AdminUserStatusInvited {
+		t.Fatalf("unexpected statuses %q %q", users[0].Status, users[1].Status)
+	}
+	if users[1].InvitationExpiresAt == nil || !users[1].InvitationExpiresAt.Equal(expiresAt) {
+		t.Fatalf("unexpected invitation expiry %#v", users[1].InvitationExpiresAt)
+	}
+
+	mutationResolver := &adminMutationResolver{Resolver: &Resolver{}}
+	name := "Editor"
+	locale := appscalars.Locale("tr")
+	invited, err := mutationResolver.InviteAdmin(ctx, model.AdminInviteInput{
+		Email:  appscalars.Email("editor@example.com"),
+		Name:   &name,
+		Roles:  []string{"editor"},
+		Locale: &locale,
+	})
+	if err != nil || invited.ID != "admin-2" || invited.Status != model.AdminUserStatusInvited {
+		t.Fatalf("InviteAdmin() = %#v, %v", invited, err)
+	}
+
+	accepted, err := mutationResolver.AcceptInvitation(context.Background(), model.AdminAcceptInvitationInput{
+		Token:           " invite-token ",
+		NewPassword:     "new-password",
+		ConfirmPassword: "new-password",
+	})
+	if err != nil || !accepted.Success || accepted.Locale != "tr" {
+		t.Fatalf("AcceptInvitation() = %#v, %v", accepted, err)
+	}
+
+	unlockAdminUserFn = func(_ *appservice.Service, _ context.Context, adminUser *domain.AdminUser, id string) (*domain.AdminUser, error) {
+		if adminUser.ID != "admin-1" || id != "admin-2" {
+			t.Fatalf("unexpected unlock input %#v %q", adminUser, id)
+		}
+		return &domain.AdminUser{ID: id, Email: "editor@example.com", Roles: []string{"editor"}}, nil
+	}
+	if _, err := mutationResolver.UnlockAdmin(context.Background(), "admin-2"); err == nil {
+		t.Fatal("expected unauthenticated unlock to fail")
+	}
+	unlocked, err := mutationResolver.UnlockAdmin(ctx, "admin-2")
+	if err != nil || unlocked.ID != "admin-2" || unlocked.Status != model.AdminUserStatusActive {
+		t.Fatalf("UnlockAdmin() = %#v, %v", unlocked, err)
+	}
+}
+
+func TestAdminTwoFactorResolvers(t *testing.T) {
+	originalLoginFn := loginAdminFn
+	originalCompleteFn := completeAdminTwoFactorLoginFn
+	originalEnableFn := enableAdminTwoFactorFn
+	originalDisableFn := disableAdminTwoFactorFn
+	t.Cleanup(func() {
+		loginAdminFn = originalLoginFn
+		completeAdminTwoFactorLoginFn = originalCompleteFn
+		enableAdminTwoFactorFn = originalEnableFn
+		disableAdminTwoFactorFn = originalDisableFn
+	})
+
+	expiresAt := time.Date(2026, 3, 17, 12, 5, 0, 0, time.UTC)
+	enabledAt := expiresAt.Add(-time.Hour)
+	loginAdminFn = func(_ *appservice.Service, _ context.Context, _, _ string, _ bool, _ appservice.AdminSessionMetadata) (*appservice.AdminAuthResponse, error) {
+		return &appservice.AdminAuthResponse{MFARequired: true, MFAToken: "mfa-token", MFAExpiresAt: expiresAt}, nil
+	}
+	completeAdminTwoFactorLoginFn = func(_ *appservice.Service, _ context.Context, mfaToken, code string, _ appservice.AdminSessionMetadata) (*appservice.AdminAuthResponse, error) {
+		if mfaToken != "mfa-token" || code != "123456" {
+			t.Fatalf("unexpected two-factor login input %q %q", mfaToken, code)
+		}
+		return &appservice.AdminAuthResponse{
+			Success:     true,
+			AccessToken: "access-token",
+			User:        &domain.AdminUser{ID: "admin-1", TwoFactorEnabledAt: &enabledAt, RecoveryCodesLeft: 9},
+		}, nil
+	}
+	enableAdminTwoFactorFn = func(_ *appservice.Service, _ context.Context, _ *domain.AdminUser, currentPassword, code string) (*appservice.AdminTwoFactorRecoveryCodes, error) {
+		if currentPassword != "password" || code != "654321" {
+			t.Fatalf("unexpected enable input %q %q", currentPassword, code)
+		}
+		return &appservice.AdminTwoFactorRecoveryCodes{
+			Codes: []string{"abcde-fghij"},
+			User:  &domain.AdminUser{ID: "admin-1", TwoFactorEnabledAt: &enabledAt, RecoveryCodesLeft: 1},
+		}, nil
+	}
+	disableAdminTwoFactorFn = func(_ *appservice.Service, _ context.Context, _ *domain.AdminUser, currentPassword string) (*domain.AdminUser, error) {
+		if currentPassword != "password" {
+			t.Fatalf("unexpected disable password %q", currentPassword)
+		}
+		return &domain.AdminUser{ID: "admin-1"}, nil
+	}
+
+	mutationResolver := &adminMutationResolver{Resolver: &Resolver{}}
+	loginRecorder := httptest.NewRecorder()
+	loginCtx := withRequestContext(context.Background(), httptest.NewRequest(http.MethodPost, "/admin/graphql", nil), loginRecorder)
+	challenge, err := mutationResolver.Login(loginCtx, model.AdminLoginInput{Email: "admin@example.com", Password: "password"})
+	if err != nil || challenge.Success || !challenge.MfaRequired || challenge.MfaToken == nil || *challenge.MfaToken != "mfa-token" {
+		t.Fatalf("Login() = %#v, %v", challenge, err)
+	}
+	if cookies := loginRecorder.Result().Cookies(); len(cookies) != 0 {
+		t.Fatalf("expected no session cookies before second factor, got %#v", cookies)
+	}
+
+	verifyRecorder := httptest.NewRecorder()
+	verifyCtx := withRequestContext(context.Background(), httptest.NewRequest(http.MethodPost, "/admin/graphql", nil), verifyRecorder)
+	verified, err := mutationResolver.VerifyTwoFactorLogin(verifyCtx, model.AdminVerifyTwoFactorLoginInput{MfaToken: "mfa-token", Code: "123456"})
+	if err != nil || !verified.Success || verified.User == nil || !verified.User.TwoFactorEnabled || verified.User.RecoveryCodesLeft != 9 {
+		t.Fatalf("VerifyTwoFactorLogin() = %#v, %v", verified, err)
+	}
+	if cookies := verifyRecorder.Result().Cookies(); len(cookies) == 0 {
+		t.Fatal("expected session cookies after second factor")
+	}
+
+	if _, err := mutationResolver.EnableTwoFactor(context.Background(), model.AdminEnableTwoFactorInput{}); err == nil {
+		t.Fatal("expected unauthenticated enable to fail")
+	}
+	authCtx := WithAdminUser(context.Background(), &domain.AdminUser{ID: "admin-1", Roles: []string{"owner"}})
+	enabled, err := mutationResolver.EnableTwoFactor(authCtx, model.AdminEnableTwoFactorInput{CurrentPassword: "password", Code: "654321"})
+	if err != nil || len(enabled.RecoveryCodes) != 1 || enabled.User == nil || !enabled.User.TwoFactorEnabled {
+		t.Fatalf("EnableTwoFactor() = %#v, %v", enabled, err)
+	}
+
+	disabled, err := mutationResolver.DisableTwoFactor(authCtx, model.AdminTwoFactorPasswordInput{CurrentPassword: "password"})
+	if err != nil || !disabled.Success || disabled.User == nil || disabled.User.TwoFactorEnabled {
+		t.Fatalf("DisableTwoFactor() = %#v, %v", disabled, err)
+	}
+}
+
+func TestAdminPasskeyResolvers(t *testing.T) {
+	originalListFn := listAdminPasskeysFn
+	originalStartLoginFn := startAdminPasskeyLoginFn
+	originalLoginFn := loginAdminWithPasskeyFn
+	originalFinishFn := finishAdminPasskeyRegistrationFn
+	originalRevokeFn := revokeAdminPasskeyFn
+	t.Cleanup(func() {
+		listAdminPasskeysFn = originalListFn
+		startAdminPasskeyLoginFn = originalStartLoginFn
+		loginAdminWithPasskeyFn = originalLoginFn
+		finishAdminPasskeyRegistrationFn = originalFinishFn
+		revokeAdminPasskeyFn = originalRevokeFn
+	})
+
+	createdAt := time.Date(2026, 3, 17, 12, 0, 0, 0, time.UTC)
+	listAdminPasskeysFn = func(_ *appservice.Service, _ context.Context, _ *domain.AdminUser) ([]domain.AdminPasskeyRecord, error) {
+		return []domain.AdminPasskeyRecord{
+			{ID: "passkey-1", Name: "Laptop", Algorithm: -7, Transports: []string{"internal"}, CreatedAt: createdAt},
+			{ID: "passkey-2", Name: "Key", Algorithm: -257, CreatedAt: createdAt, LastUsedAt: &createdAt},
+		}, nil
+	}
+	startAdminPasskeyLoginFn = func(context.Context) (*appservice.AdminPasskeyCeremony, error) {
+		return &appservice.AdminPasskeyCeremony{OptionsJSON: `{"challenge":"abc"}`, ChallengeToken: "challenge-token", ExpiresAt: createdAt}, nil
+	}
+	loginAdminWithPasskeyFn = func(_ *appservice.Service, _ context.Context, challengeToken, credential string, rememberMe bool, _ appservice.AdminSessionMetadata) (*appservice.AdminAuthResponse, error) {
+		if challengeToken != "challenge-token" || credential != "{}" || !rememberMe {
+			t.Fatalf("unexpected passkey login input %q %q %v", challengeToken, credential, rememberMe)
+		}
+		return &appservice.AdminAuthResponse{Success: true, AccessToken: "access-token", User: &domain.AdminUser{ID: "admin-1"}}, nil
+	}
+	finishAdminPasskeyRegistrationFn = func(_ *appservice.Service, _ context.Context, _ *domain.AdminUser, challengeToken, credential, name string) (*domain.AdminPasskeyRecord, error) {
+		if challengeToken != "registration-token" || credential != "{}" || name != "Laptop" {
+			t.Fatalf("unexpected registration input %q %q %q", challengeToken, credential, name)
+		}
+		return &domain.AdminPasskeyRecord{ID: "passkey-3", Name: name, Algorithm: -7, CreatedAt: createdAt}, nil
+	}
+	revokeAdminPasskeyFn = func(_ *appservice.Service, _ context.Context, _ *domain.AdminUser, id string) (bool, error) {
+		return id == "passkey-1", nil
+	}
+
+	queryResolver := &adminQueryResolver{Resolver: &Resolver{}}
+	if _, err := queryResolver.Passkeys(context.Background()); err == nil {
+		t.Fatal("expected unauthenticated passkey listing to fail")
+	}
+	authCtx := WithAdminUser(context.Background(), &domain.AdminUser{ID: "admin-1", Roles: []string{"owner"}})
+	passkeys, err := queryResolver.Passkeys(authCtx)
+	if err != nil || len(passkeys) != 2 || passkeys[0].Algorithm != "ES256" || passkeys[1].Algorithm != "RS256" {
+		t.Fatalf("Passkeys() = %#v, %v", passkeys, err)
+	}
+	if passkeys[0].LastUsedAt != nil || passkeys[1].LastUsedAt == nil {
+		t.Fatalf("unexpected last used timestamps %#v %#v", passkeys[0].LastUsedAt, passkeys[1].LastUsedAt)
+	}
+
+	mutationResolver := &adminMutationResolver{Resolver: &Resolver{}}
+	ceremony, err := mutationResolver.StartPasskeyLogin(context.Background())
+	if err != nil || ceremony.ChallengeToken != "challenge-token" || ceremony.OptionsJSON != `{"challenge":"abc"}` {
+		t.Fatalf("StartPasskeyLogin() = %#v, %v", ceremony, err)
+	}
+
+	recorder := httptest.NewRecorder()
+	loginCtx := withRequestContext(context.Background(), httptest.NewRequest(http.MethodPost, "/admin/graphql", nil), recorder)
+	rememberMe := true
+	loggedIn, err := mutationResolver.PasskeyLogin(loginCtx, model.AdminPasskeyLoginInput{
+		ChallengeToken: "challenge-token",
+		Credential:     "{}",
+		RememberMe:     &rememberMe,
+	})
+	if err != nil || !loggedIn.Success || loggedIn.User == nil || loggedIn.User.ID != "admin-1" {
+		t.Fatalf("PasskeyLogin() = %#v, %v", loggedIn, err)
+	}
+	if cookies := recorder.Result().Cookies(); len(cookies) == 0 {
+		t.Fatal("expected session cookies after passkey login")
+	}
+
+	name := "Laptop"
+	registered, err := mutationResolver.FinishPasskeyRegistration(authCtx, model.AdminFinishPasskeyRegistrationInput{
+		ChallengeToken: "registration-token",
+		Credential:     "{}",
+		Name:           &name,
+	})
+	if err != nil || registered.ID != "passkey-3" || registered.Algorithm != "ES256" {
+		t.Fatalf("FinishPasskeyRegistration() = %#v, %v", registered, err)
+	}
+
+	if revoked, err := mutationResolver.RevokePasskey(authCtx, "passkey-1"); err != nil || !revoked.Success {
+		t.Fatalf("RevokePasskey() = %#v, %v", revoked, err)
+	}
+	if _, err := mutationResolver.RevokePasskey(authCtx, "missing"); err == nil {
+		t.Fatal("expected unknown passkey revoke to fail")
+	}
+}
+
+func TestAdminAccessTokenResolvers(t *testing.T) {
+	originalListFn := listAdminAccessTokensFn
+	originalCreateFn := createAdminAccessTokenFn
+	originalRevokeFn := revokeAdminAccessTokenFn
+	t.Cleanup(func() {
+		listAdminAccessTokensFn = originalListFn
+		createAdminAccessTokenFn = originalCreateFn
+		revokeAdminAccessTokenFn = originalRevokeFn
+	})
+
+	createdAt := time.Date(2026, 3, 17, 12, 0, 0, 0, time.UTC)
+	record := domain.AdminAccessTokenRecord{
+		ID:         "token-1",
+		Name:       "Docs pipeline",
+		Prefix:     "blog_pat_abcdef",
+		Scopes:     []string{"CONTENT_READ", "CONTENT_WRITE"},
+		CreatedAt:  createdAt,
+		ExpiresAt:  createdAt.Add(30 * 24 * time.Hour),
+		LastUsedAt: &createdAt,
+		LastUsedIP: "203.0.113.10",
+		UseCount:   4,
+	}
+	listAdminAccessTokensFn = func(_ *appservice.Service, _ context.Context, _ *domain.AdminUser) ([]domain.AdminAccessTokenRecord, error) {
+		return []domain.AdminAccessTokenRecord{record}, nil
+	}
+	createAdminAccessTokenFn = func(_ *appservice.Service,
+		_ context.Context,
+		_ *domain.AdminUser,
+		name string,
+		scopes []appservice.AdminPermission,
+		expiresInDays int,
+	) (*appservice.AdminAccessTokenSecret, error) {
+		if name != "Docs pipeline" || len(scopes) != 2 || scopes[1] != appservice.AdminPermissionContentWrite || expiresInDays != 7 {
+			t.Fatalf("unexpected create input %q %v %d", name, scopes, expiresInDays)
+		}
+		return &appservice.AdminAccessTokenSecret{Token: "blog_pat_secret", Record: record}, nil
+	}
+	revokeAdminAccessTokenFn = func(_ *appservice.Service, _ context.Context, _ *domain.AdminUser, id string) (bool, error) {
+		return id == "token-1", nil
+	}
+
+	queryResolver := &adminQueryResolver{Resolver: &Resolver{}}
+	if _, err := queryResolver.AccessTokens(context.Background()); err == nil {
+		t.Fatal("expected unauthenticated access token listing to fail")
+	}
+	authCtx := WithAdminUser(context.Background(), &domain.AdminUser{ID: "admin-1", Roles: []string{"owner"}})
+	tokens, err := queryResolver.AccessTokens(authCtx)
+	if err != nil || len(tokens) != 1 || tokens[0].Prefix != "blog_pat_abcdef" || tokens[0].UseCount != 4 {
+		t.Fatalf("AccessTokens() = %#v, %v", tokens, err)
+	}
+	if tokens[0].LastUsedIP == nil || *tokens[0].LastUsedIP != "203.0.113.10" || tokens[0].Scopes[0] != model.AdminPermissionContentRead {
+		t.Fatalf("unexpected access token %#v", tokens[0])
+	}
+
+	mutationResolver := &adminMutationResolver{Resolver: &Resolver{}}
+	expiresInDays := 7
+	created, err := mutationResolver.CreateAccessToken(authCtx, model.AdminCreateAccessTokenInput{
+		Name:          "Docs pipeline",
+		Scopes:        []model.AdminPermission{model.AdminPermissionContentRead, model.AdminPermissionContentWrite},
+		ExpiresInDays: &expiresInDays,
+	})
+	if err != nil || created.Token != "blog_pat_secret" || created.AccessToken.ID != "token-1" {
+		t.Fatalf("CreateAccessToken() = %#v, %v", created, err)
+	}
+
+	if revoked, err := mutationResolver.RevokeAccessToken(authCtx, "token-1"); err != nil || !revoked.Success {
+		t.Fatalf("RevokeAccessToken() = %#v, %v", revoked, err)
+	}
+	if _, err := mutationResolver.RevokeAccessToken(authCtx, "missing"); err == nil {
+		t.Fatal("expected unknown access token to fail")
+	}
+}
+
+func TestAdminOIDCResolvers(t *testing.T) {
+	originalProvidersFn := queryAdminOIDCProvidersFn
+	originalLinksFn := listAdminOIDCLinksFn
+	originalStartFn := startAdminOIDCConnectFn
+	originalDisconnectFn := disconnectAdminOIDCAccountFn
+	t.Cleanup(func() {
+		queryAdminOIDCProvidersFn = originalProvidersFn
+		listAdminOIDCLinksFn = originalLinksFn
+		startAdminOIDCConnectFn = originalStartFn
+		disconnectAdminOIDCAccountFn = originalDisconnectFn
+	})
+
+	linkedAt := time.Date(2026, 3, 18, 9, 0, 0, 0, time.UTC)
+	queryAdminOIDCProvidersFn = func(*appservice.Service, context.Context) ([]appservice.AdminOIDCProviderStatus, error) {
+		return []appservice.AdminOIDCProviderStatus{{ID: "gitlab", Name: "GitLab", LoginAvailable: true}}, nil
+	}
+	listAdminOIDCLinksFn = func(_ *appservice.Service, _ context.Context, _ *domain.AdminUser) ([]appservice.AdminOIDCLink, error) {
+		return []appservice.AdminOIDCLink{{Provider: "gitlab", ProviderName: "GitLab", Email: "Admin@Example.com", LinkedAt: linkedAt}}, nil
+	}
+	startAdminOIDCConnectFn = func(
+		_ context.Context,
+		_ *domain.AdminUser,
+		providerID string,
+		locale string,
+	) (*appservice.AdminOIDCConnectResult, error) {
+		if providerID != "gitlab" || locale != "tr" {
+			t.Fatalf("unexpected connect input %q %q", providerID, locale)
+		}
+		return &appservice.AdminOIDCConnectResult{URL: "/api/oauth/connect?provider=gitlab&flow=admin&intent=connect&locale=tr"}, nil
+	}
+	disconnectAdminOIDCAccountFn = func(_ *appservice.Service, _ context.Context, _ *domain.AdminUser, providerID string) (bool, error) {
+		return providerID == "gitlab", nil
+	}
+
+	queryResolver := &adminQueryResolver{Resolver: &Resolver{}}
+	providers, err := queryResolver.OidcProviders(context.Background())
+	if err != nil || len(providers) != 1 || providers[0].ID != "gitlab" || !providers[0].LoginAvailable {
+		t.Fatalf("OidcProviders() = %#v, %v", providers, err)
+	}
+	if _, err := queryResolver.OidcLinks(context.Background()); err == nil {
+		t.Fatal("expected unauthenticated link listing to fail")
+	}
+
+	authCtx := WithAdminUser(context.Background(), &domain.AdminUser{ID: "admin-1", Roles: []string{"owner"}})
+	links, err := queryResolver.OidcLinks(authCtx)
+	if err != nil || len(links) != 1 || links[0].Email == nil || *links[0].Email != "admin@example.com" || !links[0].LinkedAt.Equal(linkedAt) {
+		t.Fatalf("OidcLinks() = %#v, %v", links, err)
+	}
+
+	mutationResolver := &adminMutationResolver{Resolver: &Resolver{}}
+	locale := appscalars.Locale("tr")
+	started, err := mutationResolver.StartOIDCConnect(authCtx, model.AdminStartOIDCConnectInput{Provider: " gitlab ", Locale: &locale})
+	if err != nil || !strings.Contains(string(started.URL), "provider=gitlab") {
+		t.Fatalf("StartOIDCConnect() = %#v, %v", started, err)
+	}
+
+	disconnected, err := mutationResolver.DisconnectOidc(authCtx, "gitlab")
+	if err != nil || !disconnected.Success {
+		t.Fatalf("DisconnectOidc() = %#v, %v", disconnected, err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"suaybsimsek.com/blog-api/internal/graphql/model"
+	appservice "suaybsimsek.com/blog-api/internal/service"
 	appscalars "suaybsimsek.com/blog-api/pkg/graphql/scalars"
 )
 
@@ -145,3 +146,35 @@ func mapCommentModerationStatus(value string) *model.CommentModerationStatus {
 		return nil
 	}
 }
+
+func mapReaderAccountStatus(value string) model.ReaderAccountStatus {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "success":
+		return model.ReaderAccountStatusSuccess
+	case statusServiceUnavailable:
+		return model.ReaderAccountStatusServiceUnavailable
+	case "unauthorized":
+		return model.ReaderAccountStatusUnauthorized
+	case "invalid-name":
+		return model.ReaderAccountStatusInvalidName
+	case "invalid-provider":
+		return model.ReaderAccountStatusInvalidProvider
+	case "last-sign-in-method":
+		return model.ReaderAccountStatusLastSignInMethod
+	case statusNotFound:
+		return model.ReaderAccountStatusNotFound
+	default:
+		return model.ReaderAccountStatusFailed
+	}
+}
+
+func mapReaderCommentDeletion(value model.ReaderCommentDeletion) string {
+	switch value {
+	case model.ReaderCommentDeletionAnonymize:
+		return appservice.ReaderCommentsAnonymize
+	case model.ReaderCommentDeletionDelete:
+		return appservice.ReaderCommentsDelete
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,62 @@
+package graphql
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"suaybsimsek.com/blog-api/internal/app"
+)
+
+// TestResolversOnMemoryStorage runs the public resolvers against the in-memory repositories seeded from a markdown
+// tree, with no database.
+func TestResolversOnMemoryStorage(t *testing.T) {
+	contentDir := t.TempDir()
+	postsDir := filepath.Join(contentDir, "posts", "en")
+	if err := os.MkdirAll(postsDir, 0o755); err != nil {
+		t.Fatalf("MkdirAll() error = %v", err)
+	}
+	for name, body := range map[string]string{
+		"alpha-post.md": "---\ntitle: 'Alpha'\npublishedDate: '2026-03-01'\nsummary: 'First post'\nreadingTime: '3 min read'\n" +
+			"topics:\n  - id: 'go'\n    name: 'Go'\n    color: 'blue'\n---\n\n# Alpha\n",
+		"beta-post.md": "---\ntitle: 'Beta'\npublishedDate: '2026-03-02'\nsummary: 'Second post'\nreadingTime: '5 min read'\n---\n\nBeta body\n",
+	} {
+		if err := os.WriteFile(filepath.Join(postsDir, name), []byte(body), 0o644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+
+	t.Setenv("STORAGE", "memory")
+	t.Setenv("STORAGE_CONTENT_DIR", contentDir)
+	container, err := app.New(context.Background())
+	if err != nil {
+		t.Fatalf("app.New() error = %v", err)
+	}
+	resolver := &Resolver{Service: container.Services}
+
+	connection, err := (&queryResolver{resolver}).Posts(context.Background(), "en", nil)
+	if err != nil {
+		t.Fatalf("Posts() error = %v", err)
+	}
+	if connection.Total != 2 || len(connection.Nodes) != 2 || connection.Nodes[0].ID != "beta-post" {
+		t.Fatalf("connection = %#v", connection)
+	}
+
+	postResult, err := (&queryResolver{resolver}).Post(context.Background(), "en", "alpha-post")
+	if err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+	if postResult.Node == nil || postResult.Node.Title != "Alpha" || postResult.Node.ReadingTime != 3 || len(postResult.Node.Topics) != 1 {
+		t.Fatalf("postResult = %#v", postResult)
+	}
+
+	first, err := (&mutationResolver{resolver}).IncrementPostLike(context.Background(), "alpha-post")
+	if err != nil || first.Likes == nil {
+		t.Fatalf("IncrementPostLike() = %#v, %v", first, err)
+	}
+	second, err := (&mutationResolver{resolver}).IncrementPostLike(context.Background(), "alpha-post")
+	if err != nil || second.Likes == nil || *second.Likes != *first.Likes+1 {
+		t.Fatalf("second IncrementPostLike() = %#v, %v", second, err)
+	}
+}
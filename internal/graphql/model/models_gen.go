@@ -49,6 +49,8 @@ type CommentMutationResult struct {
 	Status           CommentMutationStatus    `json:"status"`
 	PostID           string                   `json:"postId"`
 	ModerationStatus *CommentModerationStatus `json:"moderationStatus,omitempty"`
+	// Seconds to wait before trying again, set when the status is RATE_LIMITED.
+	RetryAfterSeconds *int `json:"retryAfterSeconds,omitempty"`
 }
 
 // Comment tree node with at most one reply level.
@@ -57,6 +59,31 @@ type CommentThread struct {
 	Replies []*Comment `json:"replies"`
 }
 
+// Input payload used when a signed-in reader deletes their account.
+type DeleteReaderAccountInput struct {
+	// What happens to the comments the reader has written.
+	Comments ReaderCommentDeletion `json:"comments"`
+}
+
+// Result of a reader account deletion.
+type DeleteReaderAccountResult struct {
+	Status ReaderAccountStatus `json:"status"`
+	// Number of comments that were anonymized or deleted.
+	CommentsAffected int `json:"commentsAffected"`
+}
+
+// Low-quality preview computed when an image is uploaded.
+type MediaPlaceholder struct {
+	// Intrinsic image width in pixels.
+	Width int `json:"width"`
+	// Intrinsic image height in pixels.
+	Height int `json:"height"`
+	// Most common color as a #rrggbb hex string.
+	DominantColor *string `json:"dominantColor,omitempty"`
+	// BlurHash string decoded client-side into a blurred preview.
+	BlurHash *string `json:"blurHash,omitempty"`
+}
+
 // Write operations for engagement counters and newsletter flows.
 type Mutation struct {
 }
@@ -67,6 +94,8 @@ type NewsletterMutationResult struct {
 	Status NewsletterMutationStatus `json:"status"`
 	// Optional client route that the frontend should navigate to after the operation.
 	ForwardTo *string `json:"forwardTo,omitempty"`
+	// Seconds to wait before trying again, set when the status is RATE_LIMITED.
+	RetryAfterSeconds *int `json:"retryAfterSeconds,omitempty"`
 }
 
 // Input payload used when resending a newsletter confirmation email.
@@ -113,6 +142,10 @@ type Post struct {
 	SearchText string `json:"searchText"`
 	// Thumbnail image path.
 	Thumbnail *string `json:"thumbnail,omitempty"`
+	// Placeholder to render while an uploaded thumbnail loads; null for external thumbnails.
+	ThumbnailPlaceholder *MediaPlaceholder `json:"thumbnailPlaceholder,omitempty"`
+	// Alt text written for an uploaded thumbnail in the requested locale; null when none was provided.
+	ThumbnailAlt *string `json:"thumbnailAlt,omitempty"`
 	// Topic badges linked to the post.
 	Topics []*Topic `json:"topics,omitempty"`
 	// Estimated reading time in minutes.
@@ -121,6 +154,10 @@ type Post struct {
 	Source *ContentSource `json:"source,omitempty"`
 	// Canonical source URL when the post originates from an external feed.
 	URL *scalars.URL `json:"url,omitempty"`
+	// Precomputed related posts in the same locale, ordered by relevance. Limit defaults to 3 and is capped at 12.
+	RelatedPosts []*Post `json:"relatedPosts"`
+	// Series membership with previous and next navigation when the post belongs to a series.
+	Series *PostSeriesNavigation `json:"series,omitempty"`
 }
 
 // Category badge metadata displayed with a post.
@@ -189,6 +226,20 @@ type PostResult struct {
 	Node *Post `json:"node,omitempty"`
 	// Engagement counters for the resolved post when available.
 	Engagement *PostEngagement `json:"engagement,omitempty"`
+	// Current post identifier when the requested identifier belongs to a renamed post.
+	RedirectTo *string `json:"redirectTo,omitempty"`
+}
+
+// Position of a post inside its series with neighbouring parts.
+type PostSeriesNavigation struct {
+	// Series the post belongs to.
+	Series *Series `json:"series"`
+	// One-based position of the post among the publicly visible parts.
+	Position int `json:"position"`
+	// Previous part in reading order, if any.
+	Previous *Post `json:"previous,omitempty"`
+	// Next part in reading order, if any.
+	Next *Post `json:"next,omitempty"`
 }
 
 // Pagination and filtering controls for the posts query.
@@ -207,6 +258,86 @@ type PostsQueryInput struct {
 type Query struct {
 }
 
+// Profile of the signed-in reader.
+type ReaderAccount struct {
+	ID                string                  `json:"id"`
+	Name              string                  `json:"name"`
+	Email             scalars.Email           `json:"email"`
+	AvatarURL         *scalars.URL            `json:"avatarUrl,omitempty"`
+	LastLoginProvider *string                 `json:"lastLoginProvider,omitempty"`
+	CreatedAt         *time.Time              `json:"createdAt,omitempty"`
+	Providers         []*ReaderLinkedProvider `json:"providers"`
+}
+
+// Result of reader account mutations without a payload.
+type ReaderAccountMutationResult struct {
+	Status ReaderAccountStatus `json:"status"`
+}
+
+// Result of reader account queries and profile updates.
+type ReaderAccountResult struct {
+	Status  ReaderAccountStatus `json:"status"`
+	Account *ReaderAccount      `json:"account,omitempty"`
+}
+
+// Data export of the signed-in reader.
+type ReaderDataExportResult struct {
+	Status ReaderAccountStatus `json:"status"`
+	// JSON document with the account, sessions, comments, likes and newsletter subscription.
+	Data *string `json:"data,omitempty"`
+}
+
+// Sign-in method linked to a reader account.
+type ReaderLinkedProvider struct {
+	ID       string         `json:"id"`
+	Name     string         `json:"name"`
+	Email    *scalars.Email `json:"email,omitempty"`
+	LinkedAt *time.Time     `json:"linkedAt,omitempty"`
+}
+
+// Browser session of the signed-in reader.
+type ReaderSession struct {
+	ID             string    `json:"id"`
+	Device         *string   `json:"device,omitempty"`
+	IPAddress      *string   `json:"ipAddress,omitempty"`
+	CountryCode    *string   `json:"countryCode,omitempty"`
+	LastActivityAt time.Time `json:"lastActivityAt"`
+	CreatedAt      time.Time `json:"createdAt"`
+	ExpiresAt      time.Time `json:"expiresAt"`
+	Persistent     bool      `json:"persistent"`
+	Current        bool      `json:"current"`
+}
+
+// Active sessions of the signed-in reader.
+type ReaderSessionListResult struct {
+	Status   ReaderAccountStatus `json:"status"`
+	Sessions []*ReaderSession    `json:"sessions"`
+}
+
+// Ordered collection of posts published as a multi-part article.
+type Series struct {
+	// Stable series identifier.
+	ID string `json:"id"`
+	// Display name.
+	Name string `json:"name"`
+	// Optional series introduction.
+	Description *string `json:"description,omitempty"`
+	// Publicly visible posts in reading order.
+	Posts []*Post `json:"posts"`
+	// Number of publicly visible posts in the series.
+	Total int `json:"total"`
+}
+
+// Single series lookup payload.
+type SeriesResult struct {
+	// Operation status such as success, not-found, or failed.
+	Status ContentQueryStatus `json:"status"`
+	// Locale used to resolve the response.
+	Locale scalars.Locale `json:"locale"`
+	// Resolved series when found.
+	Node *Series `json:"node,omitempty"`
+}
+
 // Topic badge metadata displayed with a post.
 type Topic struct {
 	// Stable topic identifier.
@@ -219,6 +350,12 @@ type Topic struct {
 	Link *scalars.URL `json:"link,omitempty"`
 }
 
+// Input payload used when a signed-in reader edits their profile.
+type UpdateReaderProfileInput struct {
+	// Display name shown publicly with the reader's comments.
+	Name string `json:"name"`
+}
+
 // Moderation state attached to stored comments.
 type CommentModerationStatus string
 
@@ -682,6 +819,132 @@ func (e PostMetricStatus) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// Status values returned by reader account operations.
+type ReaderAccountStatus string
+
+const (
+	ReaderAccountStatusSuccess            ReaderAccountStatus = "SUCCESS"
+	ReaderAccountStatusFailed             ReaderAccountStatus = "FAILED"
+	ReaderAccountStatusServiceUnavailable ReaderAccountStatus = "SERVICE_UNAVAILABLE"
+	ReaderAccountStatusUnauthorized       ReaderAccountStatus = "UNAUTHORIZED"
+	ReaderAccountStatusInvalidName        ReaderAccountStatus = "INVALID_NAME"
+	ReaderAccountStatusInvalidProvider    ReaderAccountStatus = "INVALID_PROVIDER"
+	ReaderAccountStatusLastSignInMethod   ReaderAccountStatus = "LAST_SIGN_IN_METHOD"
+	ReaderAccountStatusNotFound           ReaderAccountStatus = "NOT_FOUND"
+)
+
+var AllReaderAccountStatus = []ReaderAccountStatus{
+	ReaderAccountStatusSuccess,
+	ReaderAccountStatusFailed,
+	ReaderAccountStatusServiceUnavailable,
+	ReaderAccountStatusUnauthorized,
+	ReaderAccountStatusInvalidName,
+	ReaderAccountStatusInvalidProvider,
+	ReaderAccountStatusLastSignInMethod,
+	ReaderAccountStatusNotFound,
+}
+
+func (e ReaderAccountStatus) IsValid() bool {
+	switch e {
+	case ReaderAccountStatusSuccess, ReaderAccountStatusFailed, ReaderAccountStatusServiceUnavailable, ReaderAccountStatusUnauthorized, ReaderAccountStatusInvalidName, ReaderAccountStatusInvalidProvider, ReaderAccountStatusLastSignInMethod, ReaderAccountStatusNotFound:
+		return true
+	}
+	return false
+}
+
+func (e ReaderAccountStatus) String() string {
+	return string(e)
+}
+
+func (e *ReaderAccountStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ReaderAccountStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ReaderAccountStatus", str)
+	}
+	return nil
+}
+
+func (e ReaderAccountStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ReaderAccountStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ReaderAccountStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// How the comments of a deleted reader account are handled.
+type ReaderCommentDeletion string
+
+const (
+	// Comments stay in their threads under a neutral author name.
+	ReaderCommentDeletionAnonymize ReaderCommentDeletion = "ANONYMIZE"
+	// Comments are removed.
+	ReaderCommentDeletionDelete ReaderCommentDeletion = "DELETE"
+)
+
+var AllReaderCommentDeletion = []ReaderCommentDeletion{
+	ReaderCommentDeletionAnonymize,
+	ReaderCommentDeletionDelete,
+}
+
+func (e ReaderCommentDeletion) IsValid() bool {
+	switch e {
+	case ReaderCommentDeletionAnonymize, ReaderCommentDeletionDelete:
+		return true
+	}
+	return false
+}
+
+func (e ReaderCommentDeletion) String() string {
+	return string(e)
+}
+
+func (e *ReaderCommentDeletion) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ReaderCommentDeletion(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ReaderCommentDeletion", str)
+	}
+	return nil
+}
+
+func (e ReaderCommentDeletion) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ReaderCommentDeletion) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ReaderCommentDeletion) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 // Supported published date sort directions.
 type SortOrder string
 
@@ -1,6 +1,7 @@
 package graphql
 
 import (
+	"context"
 	"math"
 	"sort"
 	"strings"
@@ -8,6 +9,8 @@ import (
 	"suaybsimsek.com/blog-api/internal/graphql/model"
 	appservice "suaybsimsek.com/blog-api/internal/service"
 	appscalars "suaybsimsek.com/blog-api/pkg/graphql/scalars"
+
+	gql "github.com/99designs/gqlgen/graphql"
 )
 
 func toOptionalString(value string) *string {
@@ -18,6 +21,13 @@ func toOptionalString(value string) *string {
 	return &trimmed
 }
 
+func toOptionalPositiveInt(value int) *int {
+	if value <= 0 {
+		return nil
+	}
+	return &value
+}
+
 func toOptionalDate(value string) *appscalars.Date {
 	trimmed := strings.TrimSpace(value)
 	if trimmed == "" {
@@ -195,6 +205,33 @@ func mapPosts(posts []appservice.PostRecord) []*model.Post {
 	return result
 }
 
+func mapOptionalPost(post *appservice.PostRecord) *model.Post {
+	if post == nil {
+		return nil
+	}
+
+	mapped := mapPosts([]appservice.PostRecord{*post})
+	if len(mapped) == 0 {
+		return nil
+	}
+	return mapped[0]
+}
+
+func mapSeries(payload appservice.SeriesResponse) *model.Series {
+	if payload.Series == nil {
+		return nil
+	}
+
+	posts := mapPosts(payload.Posts)
+	return &model.Series{
+		ID:          payload.Series.ID,
+		Name:        payload.Series.Name,
+		Description: toOptionalString(payload.Series.Description),
+		Posts:       posts,
+		Total:       len(posts),
+	}
+}
+
 func derefString(value *string) string {
 	if value == nil {
 		return ""
@@ -214,3 +251,15 @@ func mapSortOrder(value *model.SortOrder) string {
 		return "desc"
 	}
 }
+
+// resolvePostLocaleFromContext finds the locale argument of the query that produced the current post.
+func resolvePostLocaleFromContext(ctx context.Context) string {
+	for fieldContext := gql.GetFieldContext(ctx); fieldContext != nil; fieldContext = fieldContext.Parent {
+		locale, ok := fieldContext.Args["locale"].(appscalars.Locale)
+		if !ok {
+			continue
+		}
+		return strings.TrimSpace(mapLocaleInput(locale))
+	}
+	return ""
+}
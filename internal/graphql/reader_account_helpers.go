@@ -0,0 +1,80 @@
+package graphql
+
+import (
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/graphql/model"
+	appservice "suaybsimsek.com/blog-api/internal/service"
+	appscalars "suaybsimsek.com/blog-api/pkg/graphql/scalars"
+)
+
+func mapReaderAccountResult(payload appservice.ReaderAccountResult) *model.ReaderAccountResult {
+	result := &model.ReaderAccountResult{Status: mapReaderAccountStatus(payload.Status)}
+	if payload.Account == nil {
+		return result
+	}
+
+	account := payload.Account
+	providers := make([]*model.ReaderLinkedProvider, 0, len(account.Providers))
+	for _, provider := range account.Providers {
+		var linkedAt *time.Time
+		if provider.LinkedAt != nil {
+			value := provider.LinkedAt.UTC()
+			linkedAt = &value
+		}
+
+		providers = append(providers, &model.ReaderLinkedProvider{
+			ID:       provider.ID,
+			Name:     provider.Name,
+			Email:    toOptionalEmail(provider.Email),
+			LinkedAt: linkedAt,
+		})
+	}
+
+	var createdAt *time.Time
+	if !account.CreatedAt.IsZero() {
+		value := account.CreatedAt.UTC()
+		createdAt = &value
+	}
+
+	result.Account = &model.ReaderAccount{
+		ID:                strings.TrimSpace(account.User.ID),
+		Name:              strings.TrimSpace(account.User.Name),
+		Email:             appscalars.Email(strings.TrimSpace(account.User.Email)),
+		AvatarURL:         toOptionalURL(strings.TrimSpace(account.User.AvatarURL)),
+		LastLoginProvider: toOptionalString(strings.TrimSpace(account.User.LastLoginProvider)),
+		CreatedAt:         createdAt,
+		Providers:         providers,
+	}
+	return result
+}
+
+func mapReaderSessions(items []appservice.ReaderSession) []*model.ReaderSession {
+	sessions := make([]*model.ReaderSession, 0, len(items))
+	for _, item := range items {
+		sessions = append(sessions, &model.ReaderSession{
+			ID:             item.ID,
+			Device:         toOptionalString(item.Device),
+			IPAddress:      toOptionalString(item.RemoteIP),
+			CountryCode:    toOptionalString(item.CountryCode),
+			LastActivityAt: item.LastSeenAt.UTC(),
+			CreatedAt:      item.CreatedAt.UTC(),
+			ExpiresAt:      item.ExpiresAt.UTC(),
+			Persistent:     item.Persistent,
+			Current:        item.Current,
+		})
+	}
+
+	return sessions
+}
+
+func toOptionalEmail(value string) *appscalars.Email {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return nil
+	}
+
+	email := appscalars.Email(trimmed)
+	return &email
+}
@@ -15,7 +15,11 @@ type (
 	readerUserContextKey struct{}
 )
 
-func WithPublicRequestContext(ctx context.Context, request *http.Request) (context.Context, error) {
+func WithPublicRequestContext(
+	ctx context.Context,
+	services *appservice.Service,
+	request *http.Request,
+) (context.Context, error) {
 	if request != nil {
 		ctx = context.WithValue(ctx, requestContextKey{}, request)
 	}
@@ -33,7 +37,7 @@ func WithPublicRequestContext(ctx context.Context, request *http.Request) (conte
 		return ctx, nil
 	}
 
-	user, err := appservice.ResolveReaderFromAccessToken(ctx, cookie.Value)
+	user, err := services.ResolveReaderFromAccessToken(ctx, cookie.Value)
 	if err != nil || user == nil {
 		return ctx, nil
 	}
@@ -41,6 +45,24 @@ func WithPublicRequestContext(ctx context.Context, request *http.Request) (conte
 	return context.WithValue(ctx, readerUserContextKey{}, user), nil
 }
 
+// getReaderRefreshToken returns the reader refresh cookie of the request, which identifies the current session.
+func getReaderRefreshToken(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+
+	request, _ := ctx.Value(requestContextKey{}).(*http.Request)
+	if request == nil {
+		return ""
+	}
+
+	cookie, err := request.Cookie(appconfig.ResolveReaderConfig().RefreshCookieName)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(cookie.Value)
+}
+
 func getReaderUser(ctx context.Context) *domain.ReaderUser {
 	if ctx == nil {
 		return nil
@@ -8,7 +8,7 @@ import (
 )
 
 func TestWithPublicRequestContextWithoutReaderSessionFallsBackGracefully(t *testing.T) {
-	ctx, err := WithPublicRequestContext(context.Background(), nil)
+	ctx, err := WithPublicRequestContext(context.Background(), nil, nil)
 	if err != nil {
 		t.Fatalf("WithPublicRequestContext(nil) returned error: %v", err)
 	}
@@ -17,7 +17,7 @@ func TestWithPublicRequestContextWithoutReaderSessionFallsBackGracefully(t *test
 	}
 
 	requestWithoutCookie := httptest.NewRequest(http.MethodGet, "/", nil)
-	ctx, err = WithPublicRequestContext(context.Background(), requestWithoutCookie)
+	ctx, err = WithPublicRequestContext(context.Background(), nil, requestWithoutCookie)
 	if err != nil {
 		t.Fatalf("WithPublicRequestContext without cookie returned error: %v", err)
 	}
@@ -27,7 +27,7 @@ func TestWithPublicRequestContextWithoutReaderSessionFallsBackGracefully(t *test
 
 	requestWithBlankCookie := httptest.NewRequest(http.MethodGet, "/", nil)
 	requestWithBlankCookie.AddCookie(&http.Cookie{Name: "reader_access", Value: ""})
-	ctx, err = WithPublicRequestContext(context.Background(), requestWithBlankCookie)
+	ctx, err = WithPublicRequestContext(context.Background(), nil, requestWithBlankCookie)
 	if err != nil {
 		t.Fatalf("WithPublicRequestContext with blank cookie returned error: %v", err)
 	}
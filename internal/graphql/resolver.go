@@ -1,4 +1,8 @@
 package graphql
 
+import appservice "suaybsimsek.com/blog-api/internal/service"
+
 // Resolver contains GraphQL resolver dependencies.
-type Resolver struct{}
+type Resolver struct {
+	Service *appservice.Service
+}
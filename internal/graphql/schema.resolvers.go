@@ -6,6 +6,7 @@ package graphql
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -16,16 +17,31 @@ import (
 )
 
 var (
-	queryContentFn  = appservice.QueryContent
-	queryPostFn     = appservice.QueryPost
-	listCommentsFn  = appservice.ListComments
-	incrementLikeFn = appservice.IncrementLike
-	incrementHitFn  = appservice.IncrementHit
-	subscribeFn     = appservice.Subscribe
-	resendFn        = appservice.Resend
-	confirmFn       = appservice.Confirm
-	unsubscribeFn   = appservice.Unsubscribe
-	addCommentFn    = appservice.AddComment
+	queryContentFn     = (*appservice.Service).QueryContent
+	queryPostFn        = (*appservice.Service).QueryPost
+	listCommentsFn     = (*appservice.Service).ListComments
+	incrementLikeFn    = (*appservice.Service).IncrementLike
+	incrementHitFn     = (*appservice.Service).IncrementHit
+	subscribeFn        = (*appservice.Service).Subscribe
+	resendFn           = (*appservice.Service).Resend
+	confirmFn          = (*appservice.Service).Confirm
+	unsubscribeFn      = (*appservice.Service).Unsubscribe
+	addCommentFn       = (*appservice.Service).AddComment
+	relatedPostsFn     = (*appservice.Service).QueryRelatedPosts
+	seriesFn           = (*appservice.Service).QuerySeries
+	postSeriesFn       = (*appservice.Service).QueryPostSeries
+	mediaPlaceholderFn = (*appservice.Service).QueryMediaPlaceholder
+	mediaAltTextFn     = (*appservice.Service).QueryMediaAltText
+
+	recordReaderLikeFn     = (*appservice.Service).RecordReaderPostLike
+	readerAccountFn        = (*appservice.Service).GetReaderAccount
+	readerSessionsFn       = (*appservice.Service).ListReaderSessions
+	readerSessionIDFn      = appservice.ResolveReaderSessionID
+	readerDataExportFn     = (*appservice.Service).ExportReaderData
+	updateReaderProfileFn  = (*appservice.Service).UpdateReaderProfile
+	unlinkReaderProviderFn = (*appservice.Service).UnlinkReaderProvider
+	revokeReaderSessionFn  = (*appservice.Service).RevokeReaderSession
+	deleteReaderAccountFn  = (*appservice.Service).DeleteReaderAccount
 )
 
 // Posts is the resolver for the posts field.
@@ -51,7 +67,7 @@ func (r *queryResolver) Posts(ctx context.Context, locale appscalars.Locale, inp
 		queryInput.ScopeIDs = append([]string{}, input.ScopeIds...)
 	}
 
-	payload := queryContentFn(ctx, queryInput)
+	payload := queryContentFn(r.Service, ctx, queryInput)
 	total := payload.Total
 	if total < 0 {
 		total = 0
@@ -94,7 +110,7 @@ func (r *queryResolver) Post(ctx context.Context, locale appscalars.Locale, id s
 		return nil, fmt.Errorf("id is required")
 	}
 
-	payload := queryPostFn(ctx, appservice.PostQueryInput{
+	payload := queryPostFn(r.Service, ctx, appservice.PostQueryInput{
 		Locale: normalizedLocale,
 		PostID: normalizedID,
 	})
@@ -110,11 +126,40 @@ func (r *queryResolver) Post(ctx context.Context, locale appscalars.Locale, id s
 		engagement = mappedEngagement[0]
 	}
 
+	var redirectTo *string
+	if payload.RedirectTo != "" {
+		redirectTo = &payload.RedirectTo
+	}
+
 	return &model.PostResult{
 		Status:     mapContentQueryStatus(payload.Status),
 		Locale:     mapLocaleOutput(payload.Locale),
 		Node:       node,
 		Engagement: engagement,
+		RedirectTo: redirectTo,
+	}, nil
+}
+
+// Series is the resolver for the series field.
+func (r *queryResolver) Series(ctx context.Context, locale appscalars.Locale, id string) (*model.SeriesResult, error) {
+	normalizedLocale := strings.TrimSpace(mapLocaleInput(locale))
+	if normalizedLocale == "" {
+		return nil, fmt.Errorf("locale is required")
+	}
+
+	normalizedID := strings.TrimSpace(id)
+	if normalizedID == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+
+	payload := seriesFn(r.Service, ctx, appservice.SeriesQueryInput{
+		Locale:   normalizedLocale,
+		SeriesID: normalizedID,
+	})
+	return &model.SeriesResult{
+		Status: mapContentQueryStatus(payload.Status),
+		Locale: mapLocaleOutput(payload.Locale),
+		Node:   mapSeries(payload),
 	}, nil
 }
 
@@ -125,7 +170,7 @@ func (r *queryResolver) Comments(ctx context.Context, postID string) (*model.Com
 		return nil, fmt.Errorf("postId is required")
 	}
 
-	payload := listCommentsFn(ctx, appservice.CommentQueryInput{
+	payload := listCommentsFn(r.Service, ctx, appservice.CommentQueryInput{
 		PostID: normalizedPostID,
 	})
 
@@ -137,9 +182,42 @@ func (r *queryResolver) Comments(ctx context.Context, postID string) (*model.Com
 	}, nil
 }
 
+// ReaderAccount is the resolver for the readerAccount field.
+func (r *queryResolver) ReaderAccount(ctx context.Context) (*model.ReaderAccountResult, error) {
+	return mapReaderAccountResult(readerAccountFn(r.Service, ctx, getReaderUser(ctx))), nil
+}
+
+// ReaderSessions is the resolver for the readerSessions field.
+func (r *queryResolver) ReaderSessions(ctx context.Context) (*model.ReaderSessionListResult, error) {
+	payload := readerSessionsFn(r.Service, ctx, getReaderUser(ctx), readerSessionIDFn(getReaderRefreshToken(ctx)))
+	return &model.ReaderSessionListResult{
+		Status:   mapReaderAccountStatus(payload.Status),
+		Sessions: mapReaderSessions(payload.Sessions),
+	}, nil
+}
+
+// ReaderDataExport is the resolver for the readerDataExport field.
+func (r *queryResolver) ReaderDataExport(ctx context.Context) (*model.ReaderDataExportResult, error) {
+	payload := readerDataExportFn(r.Service, ctx, getReaderUser(ctx))
+	if payload.Data == nil {
+		return &model.ReaderDataExportResult{Status: mapReaderAccountStatus(payload.Status)}, nil
+	}
+
+	data, err := json.Marshal(payload.Data)
+	if err != nil {
+		return &model.ReaderDataExportResult{Status: model.ReaderAccountStatusFailed}, nil
+	}
+
+	encoded := string(data)
+	return &model.ReaderDataExportResult{
+		Status: mapReaderAccountStatus(payload.Status),
+		Data:   &encoded,
+	}, nil
+}
+
 // IncrementPostLike is the resolver for the incrementPostLike field.
 func (r *mutationResolver) IncrementPostLike(ctx context.Context, postID string) (*model.PostMetricResult, error) {
-	payload := incrementLikeFn(ctx, postID)
+	payload := incrementLikeFn(r.Service, ctx, postID)
 	resolvedPostID := strings.TrimSpace(payload.PostID)
 	if resolvedPostID == "" {
 		resolvedPostID = strings.TrimSpace(postID)
@@ -149,6 +227,9 @@ func (r *mutationResolver) IncrementPostLike(ctx context.Context, postID string)
 		Status: mapPostMetricStatus(payload.Status),
 		PostID: resolvedPostID,
 	}
+	if result.Status == model.PostMetricStatusSuccess {
+		recordReaderLikeFn(r.Service, ctx, getReaderUser(ctx), resolvedPostID)
+	}
 	if payload.Likes > 0 || result.Status == model.PostMetricStatusSuccess {
 		likes := toGraphQLInt(payload.Likes)
 		result.Likes = &likes
@@ -163,7 +244,7 @@ func (r *mutationResolver) IncrementPostLike(ctx context.Context, postID string)
 
 // IncrementPostHit is the resolver for the incrementPostHit field.
 func (r *mutationResolver) IncrementPostHit(ctx context.Context, postID string) (*model.PostMetricResult, error) {
-	payload := incrementHitFn(ctx, postID)
+	payload := incrementHitFn(r.Service, ctx, postID)
 	resolvedPostID := strings.TrimSpace(payload.PostID)
 	if resolvedPostID == "" {
 		resolvedPostID = strings.TrimSpace(postID)
@@ -191,6 +272,7 @@ func (r *mutationResolver) SubscribeNewsletter(
 	input model.NewsletterSubscribeInput,
 ) (*model.NewsletterMutationResult, error) {
 	payload := subscribeFn(
+		r.Service,
 		ctx,
 		appservice.SubscribeInput{
 			Locale:   strings.TrimSpace(mapLocaleInput(input.Locale)),
@@ -203,8 +285,9 @@ func (r *mutationResolver) SubscribeNewsletter(
 	)
 
 	return &model.NewsletterMutationResult{
-		Status:    mapNewsletterMutationStatus(payload.Status),
-		ForwardTo: toOptionalString(payload.ForwardTo),
+		Status:            mapNewsletterMutationStatus(payload.Status),
+		ForwardTo:         toOptionalString(payload.ForwardTo),
+		RetryAfterSeconds: toOptionalPositiveInt(payload.RetryAfterSeconds),
 	}, nil
 }
 
@@ -214,6 +297,7 @@ func (r *mutationResolver) ResendNewsletterConfirmation(
 	input model.NewsletterResendInput,
 ) (*model.NewsletterMutationResult, error) {
 	payload := resendFn(
+		r.Service,
 		ctx,
 		appservice.ResendInput{
 			Locale: strings.TrimSpace(mapLocaleInput(input.Locale)),
@@ -224,7 +308,8 @@ func (r *mutationResolver) ResendNewsletterConfirmation(
 	)
 
 	return &model.NewsletterMutationResult{
-		Status: mapNewsletterMutationStatus(payload.Status),
+		Status:            mapNewsletterMutationStatus(payload.Status),
+		RetryAfterSeconds: toOptionalPositiveInt(payload.RetryAfterSeconds),
 	}, nil
 }
 
@@ -233,7 +318,7 @@ func (r *mutationResolver) ConfirmNewsletterSubscription(
 	ctx context.Context,
 	token string,
 ) (*model.NewsletterMutationResult, error) {
-	payload := confirmFn(ctx, strings.TrimSpace(token))
+	payload := confirmFn(r.Service, ctx, strings.TrimSpace(token))
 	return &model.NewsletterMutationResult{
 		Status: mapNewsletterMutationStatus(payload.Status),
 	}, nil
@@ -244,7 +329,7 @@ func (r *mutationResolver) UnsubscribeNewsletter(
 	ctx context.Context,
 	token string,
 ) (*model.NewsletterMutationResult, error) {
-	payload := unsubscribeFn(ctx, strings.TrimSpace(token))
+	payload := unsubscribeFn(r.Service, ctx, strings.TrimSpace(token))
 	return &model.NewsletterMutationResult{
 		Status: mapNewsletterMutationStatus(payload.Status),
 	}, nil
@@ -255,6 +340,7 @@ func (r *mutationResolver) AddComment(ctx context.Context, input model.AddCommen
 	readerUser := getReaderUser(ctx)
 
 	payload := addCommentFn(
+		r.Service,
 		ctx,
 		appservice.AddCommentInput{
 			PostID:                       strings.TrimSpace(input.PostID),
@@ -275,20 +361,137 @@ func (r *mutationResolver) AddComment(ctx context.Context, input model.AddCommen
 	}
 
 	return &model.CommentMutationResult{
-		Status:           mapCommentMutationStatus(payload.Status),
-		PostID:           resolvedPostID,
-		ModerationStatus: mapCommentModerationStatus(payload.ModerationStatus),
+		Status:            mapCommentMutationStatus(payload.Status),
+		PostID:            resolvedPostID,
+		ModerationStatus:  mapCommentModerationStatus(payload.ModerationStatus),
+		RetryAfterSeconds: toOptionalPositiveInt(payload.RetryAfterSeconds),
+	}, nil
+}
+
+// UpdateReaderProfile is the resolver for the updateReaderProfile field.
+func (r *mutationResolver) UpdateReaderProfile(
+	ctx context.Context,
+	input model.UpdateReaderProfileInput,
+) (*model.ReaderAccountResult, error) {
+	return mapReaderAccountResult(updateReaderProfileFn(r.Service, ctx, getReaderUser(ctx), input.Name)), nil
+}
+
+// UnlinkReaderProvider is the resolver for the unlinkReaderProvider field.
+func (r *mutationResolver) UnlinkReaderProvider(ctx context.Context, provider string) (*model.ReaderAccountMutationResult, error) {
+	payload := unlinkReaderProviderFn(r.Service, ctx, getReaderUser(ctx), strings.TrimSpace(provider))
+	return &model.ReaderAccountMutationResult{Status: mapReaderAccountStatus(payload.Status)}, nil
+}
+
+// RevokeReaderSession is the resolver for the revokeReaderSession field.
+func (r *mutationResolver) RevokeReaderSession(ctx context.Context, id string) (*model.ReaderAccountMutationResult, error) {
+	payload := revokeReaderSessionFn(r.Service, ctx, getReaderUser(ctx), strings.TrimSpace(id))
+	return &model.ReaderAccountMutationResult{Status: mapReaderAccountStatus(payload.Status)}, nil
+}
+
+// DeleteReaderAccount is the resolver for the deleteReaderAccount field.
+func (r *mutationResolver) DeleteReaderAccount(
+	ctx context.Context,
+	input model.DeleteReaderAccountInput,
+) (*model.DeleteReaderAccountResult, error) {
+	payload := deleteReaderAccountFn(r.Service, ctx, getReaderUser(ctx), mapReaderCommentDeletion(input.Comments))
+	return &model.DeleteReaderAccountResult{
+		Status:           mapReaderAccountStatus(payload.Status),
+		CommentsAffected: payload.CommentsAffected,
+	}, nil
+}
+
+// RelatedPosts is the resolver for the relatedPosts field.
+func (r *postResolver) RelatedPosts(ctx context.Context, obj *model.Post, limit *int) ([]*model.Post, error) {
+	if obj == nil {
+		return []*model.Post{}, nil
+	}
+
+	locale := resolvePostLocaleFromContext(ctx)
+	if locale == "" {
+		return []*model.Post{}, nil
+	}
+
+	payload := relatedPostsFn(r.Service, ctx, appservice.RelatedPostsQueryInput{
+		Locale: locale,
+		PostID: obj.ID,
+		Limit:  limit,
+	})
+	return mapPosts(payload.Posts), nil
+}
+
+// Series is the resolver for the series field.
+func (r *postResolver) Series(ctx context.Context, obj *model.Post) (*model.PostSeriesNavigation, error) {
+	if obj == nil {
+		return nil, nil
+	}
+
+	locale := resolvePostLocaleFromContext(ctx)
+	if locale == "" {
+		return nil, nil
+	}
+
+	payload := postSeriesFn(r.Service, ctx, appservice.PostSeriesQueryInput{
+		Locale: locale,
+		PostID: obj.ID,
+	})
+	series := mapSeries(payload)
+	if series == nil || payload.Position <= 0 {
+		return nil, nil
+	}
+
+	return &model.PostSeriesNavigation{
+		Series:   series,
+		Position: payload.Position,
+		Previous: mapOptionalPost(payload.Previous),
+		Next:     mapOptionalPost(payload.Next),
+	}, nil
+}
+
+// ThumbnailPlaceholder is the resolver for the thumbnailPlaceholder field.
+func (r *postResolver) ThumbnailPlaceholder(ctx context.Context, obj *model.Post) (*model.MediaPlaceholder, error) {
+	if obj == nil || obj.Thumbnail == nil || strings.TrimSpace(*obj.Thumbnail) == "" {
+		return nil, nil
+	}
+
+	placeholder := mediaPlaceholderFn(r.Service, ctx, *obj.Thumbnail)
+	if placeholder == nil {
+		return nil, nil
+	}
+
+	return &model.MediaPlaceholder{
+		Width:         placeholder.Width,
+		Height:        placeholder.Height,
+		DominantColor: toOptionalString(placeholder.DominantColor),
+		BlurHash:      toOptionalString(placeholder.BlurHash),
 	}, nil
 }
 
+// ThumbnailAlt is the resolver for the thumbnailAlt field.
+func (r *postResolver) ThumbnailAlt(ctx context.Context, obj *model.Post) (*string, error) {
+	if obj == nil || obj.Thumbnail == nil || strings.TrimSpace(*obj.Thumbnail) == "" {
+		return nil, nil
+	}
+
+	locale := resolvePostLocaleFromContext(ctx)
+	if locale == "" {
+		return nil, nil
+	}
+
+	return toOptionalString(mediaAltTextFn(r.Service, ctx, *obj.Thumbnail, locale)), nil
+}
+
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
+// Post returns PostResolver implementation.
+func (r *Resolver) Post() PostResolver { return &postResolver{r} }
+
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
 type (
 	mutationResolver struct{ *Resolver }
+	postResolver     struct{ *Resolver }
 	queryResolver    struct{ *Resolver }
 )
 
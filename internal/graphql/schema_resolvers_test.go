@@ -4,12 +4,16 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"suaybsimsek.com/blog-api/internal/domain"
 	"suaybsimsek.com/blog-api/internal/graphql/model"
 	appservice "suaybsimsek.com/blog-api/internal/service"
+	appscalars "suaybsimsek.com/blog-api/pkg/graphql/scalars"
+
+	gql "github.com/99designs/gqlgen/graphql"
 )
 
 func TestQueryResolverPostsAndPost(t *testing.T) {
@@ -20,7 +24,7 @@ func TestQueryResolverPostsAndPost(t *testing.T) {
 		queryPostFn = originalQueryPostFn
 	})
 
-	queryContentFn = func(_ context.Context, input appservice.ContentQueryInput) appservice.ContentResponse {
+	queryContentFn = func(_ *appservice.Service, _ context.Context, input appservice.ContentQueryInput) appservice.ContentResponse {
 		if input.Locale != "tr" || input.Sort != "asc" || len(input.ScopeIDs) != 1 || input.ScopeIDs[0] != "alpha-post" {
 			t.Fatalf("query content input = %#v", input)
 		}
@@ -36,7 +40,7 @@ func TestQueryResolverPostsAndPost(t *testing.T) {
 			Sort:          "desc",
 		}
 	}
-	queryPostFn = func(_ context.Context, input appservice.PostQueryInput) appservice.ContentResponse {
+	queryPostFn = func(_ *appservice.Service, _ context.Context, input appservice.PostQueryInput) appservice.ContentResponse {
 		if input.Locale != "tr" || input.PostID != "alpha-post" {
 			t.Fatalf("query post input = %#v", input)
 		}
@@ -71,9 +75,23 @@ func TestQueryResolverPostsAndPost(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Post() error = %v", err)
 	}
-	if postResult.Node == nil || postResult.Engagement == nil {
+	if postResult.Node == nil || postResult.Engagement == nil || postResult.RedirectTo != nil {
 		t.Fatalf("postResult = %#v", postResult)
 	}
+
+	queryPostFn = func(_ *appservice.Service, _ context.Context, input appservice.PostQueryInput) appservice.ContentResponse {
+		return appservice.ContentResponse{
+			Status:     "success",
+			Locale:     "tr",
+			PostID:     "beta-post",
+			RedirectTo: "beta-post",
+			Posts:      []appservice.PostRecord{{ID: "beta-post", Title: "Beta", PublishedDate: "2026-03-01", Summary: "Summary", SearchText: "beta", ReadingTimeMin: 2}},
+		}
+	}
+	redirected, err := (&queryResolver{&Resolver{}}).Post(context.Background(), "tr", "alpha-post")
+	if err != nil || redirected.RedirectTo == nil || *redirected.RedirectTo != "beta-post" || redirected.Node == nil {
+		t.Fatalf("redirected postResult = %#v, %v", redirected, err)
+	}
 }
 
 func TestMutationResolverMetricsAndNewsletter(t *testing.T) {
@@ -92,26 +110,30 @@ func TestMutationResolverMetricsAndNewsletter(t *testing.T) {
 		unsubscribeFn = originalUnsubscribeFn
 	})
 
-	incrementLikeFn = func(context.Context, string) appservice.ContentResponse {
+	incrementLikeFn = func(*appservice.Service, context.Context, string) appservice.ContentResponse {
 		return appservice.ContentResponse{Status: "success", PostID: "", Likes: 7}
 	}
-	incrementHitFn = func(context.Context, string) appservice.ContentResponse {
+	incrementHitFn = func(*appservice.Service, context.Context, string) appservice.ContentResponse {
 		return appservice.ContentResponse{Status: "failed", PostID: "alpha-post", Hits: 9}
 	}
-	subscribeFn = func(_ context.Context, input appservice.SubscribeInput, meta appservice.RequestMetadata) appservice.Result {
+	subscribeFn = func(_ *appservice.Service, _ context.Context, input appservice.SubscribeInput, meta appservice.RequestMetadata) appservice.Result {
 		if input.Locale != "tr" || input.Email != "reader@example.com" || input.FormName != "footer" || meta.ClientIP != "203.0.113.5" {
 			t.Fatalf("subscribe input = %#v %#v", input, meta)
 		}
 		return appservice.Result{Status: "success", ForwardTo: "/tr/thanks"}
 	}
-	resendFn = func(_ context.Context, input appservice.ResendInput, meta appservice.RequestMetadata) appservice.Result {
+	resendFn = func(_ *appservice.Service, _ context.Context, input appservice.ResendInput, meta appservice.RequestMetadata) appservice.Result {
 		if input.Locale != "tr" || input.Email != "reader@example.com" || meta.AcceptLanguage != "tr-TR" {
 			t.Fatalf("resend input = %#v %#v", input, meta)
 		}
-		return appservice.Result{Status: "rate-limited"}
+		return appservice.Result{Status: "rate-limited", RetryAfterSeconds: 42}
+	}
+	confirmFn = func(*appservice.Service, context.Context, string) appservice.Result {
+		return appservice.Result{Status: "expired"}
+	}
+	unsubscribeFn = func(*appservice.Service, context.Context, string) appservice.Result {
+		return appservice.Result{Status: "success"}
 	}
-	confirmFn = func(context.Context, string) appservice.Result { return appservice.Result{Status: "expired"} }
-	unsubscribeFn = func(context.Context, string) appservice.Result { return appservice.Result{Status: "success"} }
 
 	request := httptest.NewRequest(http.MethodPost, "/graphql", nil)
 	request.RemoteAddr = "203.0.113.5:8080"
@@ -152,9 +174,13 @@ func TestMutationResolverMetricsAndNewsletter(t *testing.T) {
 		Locale: "tr",
 		Email:  "reader@example.com",
 	})
-	if err != nil || resendResult.Status != model.NewsletterMutationStatusRateLimited {
+	if err != nil || resendResult.Status != model.NewsletterMutationStatusRateLimited ||
+		resendResult.RetryAfterSeconds == nil || *resendResult.RetryAfterSeconds != 42 {
 		t.Fatalf("resendResult = %#v, %v", resendResult, err)
 	}
+	if subscribeResult.RetryAfterSeconds != nil {
+		t.Fatalf("expected no retry hint on success, got %#v", subscribeResult)
+	}
 
 	confirmResult, err := (&mutationResolver{&Resolver{}}).ConfirmNewsletterSubscription(ctx, " token ")
 	if err != nil || confirmResult.Status != model.NewsletterMutationStatusExpired {
@@ -177,7 +203,7 @@ func TestQueryResolverComments(t *testing.T) {
 		listCommentsFn = originalListCommentsFn
 	})
 
-	listCommentsFn = func(_ context.Context, input appservice.CommentQueryInput) domain.CommentListResult {
+	listCommentsFn = func(_ *appservice.Service, _ context.Context, input appservice.CommentQueryInput) domain.CommentListResult {
 		if input.PostID != "alpha-post" {
 			t.Fatalf("unexpected comment query input: %#v", input)
 		}
@@ -205,6 +231,168 @@ func TestQueryResolverComments(t *testing.T) {
 	}
 }
 
+func TestPostResolverRelatedPosts(t *testing.T) {
+	originalRelatedPostsFn := relatedPostsFn
+	t.Cleanup(func() {
+		relatedPostsFn = originalRelatedPostsFn
+	})
+
+	relatedPostsFn = func(_ *appservice.Service, _ context.Context, input appservice.RelatedPostsQueryInput) appservice.ContentResponse {
+		if input.Locale != "tr" || input.PostID != "alpha-post" || input.Limit == nil || *input.Limit != 2 {
+			t.Fatalf("unexpected related posts input: %#v", input)
+		}
+		return appservice.ContentResponse{
+			Status: "success",
+			Posts:  []appservice.PostRecord{{ID: "beta-post", Title: "Beta", PublishedDate: "2026-03-02", Summary: "Summary", SearchText: "beta", ReadingTimeMin: 4}},
+		}
+	}
+
+	resolver := &postResolver{&Resolver{}}
+	rootCtx := gql.WithFieldContext(context.Background(), &gql.FieldContext{
+		Args: map[string]any{"locale": appscalars.Locale("tr")},
+	})
+	ctx := gql.WithFieldContext(rootCtx, &gql.FieldContext{Args: map[string]any{}})
+
+	limit := 2
+	related, err := resolver.RelatedPosts(ctx, &model.Post{ID: "alpha-post"}, &limit)
+	if err != nil {
+		t.Fatalf("RelatedPosts() error = %v", err)
+	}
+	if len(related) != 1 || related[0].ID != "beta-post" {
+		t.Fatalf("RelatedPosts() = %#v", related)
+	}
+
+	withoutLocale, err := resolver.RelatedPosts(context.Background(), &model.Post{ID: "alpha-post"}, nil)
+	if err != nil || len(withoutLocale) != 0 {
+		t.Fatalf("RelatedPosts(without locale) = %#v, %v", withoutLocale, err)
+	}
+}
+
+func TestPostResolverThumbnailPlaceholder(t *testing.T) {
+	originalMediaPlaceholderFn := mediaPlaceholderFn
+	t.Cleanup(func() {
+		mediaPlaceholderFn = originalMediaPlaceholderFn
+	})
+
+	mediaPlaceholderFn = func(_ *appservice.Service, _ context.Context, thumbnail string) *appservice.MediaPlaceholder {
+		if thumbnail != "/api/media/cover" {
+			return nil
+		}
+		return &appservice.MediaPlaceholder{Width: 1200, Height: 630, DominantColor: "#336699"}
+	}
+
+	resolver := &postResolver{&Resolver{}}
+	thumbnail := "/api/media/cover"
+	placeholder, err := resolver.ThumbnailPlaceholder(context.Background(), &model.Post{ID: "alpha-post", Thumbnail: &thumbnail})
+	if err != nil {
+		t.Fatalf("ThumbnailPlaceholder() error = %v", err)
+	}
+	if placeholder == nil || placeholder.Width != 1200 || placeholder.Height != 630 {
+		t.Fatalf("ThumbnailPlaceholder() = %#v", placeholder)
+	}
+	if placeholder.DominantColor == nil || *placeholder.DominantColor != "#336699" || placeholder.BlurHash != nil {
+		t.Fatalf("unexpected placeholder colors %#v", placeholder)
+	}
+
+	external := "https://cdn.example.com/cover.webp"
+	for _, post := range []*model.Post{nil, {ID: "alpha-post"}, {ID: "alpha-post", Thumbnail: &external}} {
+		placeholder, err := resolver.ThumbnailPlaceholder(context.Background(), post)
+		if err != nil || placeholder != nil {
+			t.Fatalf("ThumbnailPlaceholder(%#v) = %#v, %v", post, placeholder, err)
+		}
+	}
+}
+
+func TestPostResolverThumbnailAlt(t *testing.T) {
+	originalMediaAltTextFn := mediaAltTextFn
+	t.Cleanup(func() {
+		mediaAltTextFn = originalMediaAltTextFn
+	})
+
+	mediaAltTextFn = func(_ *appservice.Service, _ context.Context, thumbnail, locale string) string {
+		if thumbnail != "/api/media/cover" || locale != "tr" {
+			t.Fatalf("unexpected alt text lookup %q %q", thumbnail, locale)
+		}
+		return "Galata Kulesi"
+	}
+
+	resolver := &postResolver{&Resolver{}}
+	ctx := gql.WithFieldContext(context.Background(), &gql.FieldContext{
+		Args: map[string]any{"locale": appscalars.Locale("tr")},
+	})
+	thumbnail := "/api/media/cover"
+	alt, err := resolver.ThumbnailAlt(ctx, &model.Post{ID: "alpha-post", Thumbnail: &thumbnail})
+	if err != nil || alt == nil || *alt != "Galata Kulesi" {
+		t.Fatalf("ThumbnailAlt() = %v, %v", alt, err)
+	}
+
+	withoutLocale, err := resolver.ThumbnailAlt(context.Background(), &model.Post{ID: "alpha-post", Thumbnail: &thumbnail})
+	if err != nil || withoutLocale != nil {
+		t.Fatalf("ThumbnailAlt(without locale) = %v, %v", withoutLocale, err)
+	}
+}
+
+func TestSeriesResolvers(t *testing.T) {
+	originalSeriesFn := seriesFn
+	originalPostSeriesFn := postSeriesFn
+	t.Cleanup(func() {
+		seriesFn = originalSeriesFn
+		postSeriesFn = originalPostSeriesFn
+	})
+
+	partOne := appservice.PostRecord{ID: "part-one", Title: "One", PublishedDate: "2026-03-01", Summary: "Summary", SearchText: "one", ReadingTimeMin: 3}
+	partTwo := appservice.PostRecord{ID: "part-two", Title: "Two", PublishedDate: "2026-03-02", Summary: "Summary", SearchText: "two", ReadingTimeMin: 4}
+	series := &domain.PostSeriesRecord{ID: "go-basics", Name: "Go Basics", Description: "Intro"}
+
+	seriesFn = func(_ *appservice.Service, _ context.Context, input appservice.SeriesQueryInput) appservice.SeriesResponse {
+		if input.Locale != "en" || input.SeriesID != "go-basics" {
+			t.Fatalf("unexpected series input: %#v", input)
+		}
+		return appservice.SeriesResponse{Status: "success", Locale: "en", Series: series, Posts: []appservice.PostRecord{partOne, partTwo}}
+	}
+	postSeriesFn = func(_ *appservice.Service, _ context.Context, input appservice.PostSeriesQueryInput) appservice.SeriesResponse {
+		if input.Locale != "en" || input.PostID != "part-two" {
+			t.Fatalf("unexpected post series input: %#v", input)
+		}
+		return appservice.SeriesResponse{
+			Status:   "success",
+			Locale:   "en",
+			Series:   series,
+			Posts:    []appservice.PostRecord{partOne, partTwo},
+			Position: 2,
+			Previous: &partOne,
+		}
+	}
+
+	result, err := (&queryResolver{&Resolver{}}).Series(context.Background(), appscalars.Locale("en"), " go-basics ")
+	if err != nil || result.Status != model.ContentQueryStatusSuccess || result.Node == nil || result.Node.Total != 2 ||
+		result.Node.Posts[0].ID != "part-one" || result.Node.Description == nil {
+		t.Fatalf("Series() = %#v, %v", result, err)
+	}
+	if _, err := (&queryResolver{&Resolver{}}).Series(context.Background(), appscalars.Locale("en"), " "); err == nil {
+		t.Fatal("expected empty series id to fail")
+	}
+
+	ctx := gql.WithFieldContext(context.Background(), &gql.FieldContext{
+		Args: map[string]any{"locale": appscalars.Locale("en")},
+	})
+	navigation, err := (&postResolver{&Resolver{}}).Series(ctx, &model.Post{ID: "part-two"})
+	if err != nil || navigation == nil || navigation.Position != 2 || navigation.Series.ID != "go-basics" ||
+		navigation.Previous == nil || navigation.Previous.ID != "part-one" || navigation.Next != nil {
+		t.Fatalf("Post.Series() = %#v, %v", navigation, err)
+	}
+
+	postSeriesFn = func(*appservice.Service, context.Context, appservice.PostSeriesQueryInput) appservice.SeriesResponse {
+		return appservice.SeriesResponse{Status: "not-found"}
+	}
+	if missing, err := (&postResolver{&Resolver{}}).Series(ctx, &model.Post{ID: "part-two"}); err != nil || missing != nil {
+		t.Fatalf("Post.Series(not found) = %#v, %v", missing, err)
+	}
+	if withoutLocale, err := (&postResolver{&Resolver{}}).Series(context.Background(), &model.Post{ID: "part-two"}); err != nil || withoutLocale != nil {
+		t.Fatalf("Post.Series(without locale) = %#v, %v", withoutLocale, err)
+	}
+}
+
 func TestMutationResolverAddCommentAndReaderHelpers(t *testing.T) {
 	originalAddCommentFn := addCommentFn
 	t.Cleanup(func() {
@@ -224,7 +412,7 @@ func TestMutationResolverAddCommentAndReaderHelpers(t *testing.T) {
 		AvatarURL: " https://example.com/avatar.png ",
 	})
 
-	addCommentFn = func(_ context.Context, input appservice.AddCommentInput, meta appservice.RequestMetadata) domain.CommentMutationResult {
+	addCommentFn = func(_ *appservice.Service, _ context.Context, input appservice.AddCommentInput, meta appservice.RequestMetadata) domain.CommentMutationResult {
 		if input.PostID != "alpha-post" || input.ParentID != "comment-root" || input.AuthorName != "Guest Reader" || input.AuthorEmail != "guest@example.com" || input.AuthenticatedAuthorName != "Reader User" || input.AuthenticatedAuthorEmail != "reader@example.com" || input.AuthenticatedAuthorAvatarURL != "https://example.com/avatar.png" || input.Content != "Hello there" {
 			t.Fatalf("unexpected add comment input: %#v", input)
 		}
@@ -257,3 +445,135 @@ func TestMutationResolverAddCommentAndReaderHelpers(t *testing.T) {
 		t.Fatal("expected nil reader helpers to return empty strings")
 	}
 }
+
+func TestReaderAccountResolvers(t *testing.T) {
+	originalReaderAccountFn := readerAccountFn
+	originalReaderSessionsFn := readerSessionsFn
+	originalReaderSessionIDFn := readerSessionIDFn
+	originalReaderDataExportFn := readerDataExportFn
+	originalUpdateReaderProfileFn := updateReaderProfileFn
+	originalUnlinkReaderProviderFn := unlinkReaderProviderFn
+	originalRevokeReaderSessionFn := revokeReaderSessionFn
+	originalDeleteReaderAccountFn := deleteReaderAccountFn
+	t.Cleanup(func() {
+		readerAccountFn = originalReaderAccountFn
+		readerSessionsFn = originalReaderSessionsFn
+		readerSessionIDFn = originalReaderSessionIDFn
+		readerDataExportFn = originalReaderDataExportFn
+		updateReaderProfileFn = originalUpdateReaderProfileFn
+		unlinkReaderProviderFn = originalUnlinkReaderProviderFn
+		revokeReaderSessionFn = originalRevokeReaderSessionFn
+		deleteReaderAccountFn = originalDeleteReaderAccountFn
+	})
+
+	reader := &domain.ReaderUser{ID: "reader-1", Name: "Reader One", Email: "reader@example.com"}
+	linkedAt := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	account := &appservice.ReaderAccount{
+		User:      *reader,
+		CreatedAt: linkedAt,
+		Providers: []appservice.ReaderLinkedProvider{{ID: "google", Name: "Google", Email: "reader@example.com", LinkedAt: &linkedAt}},
+	}
+
+	request := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+	request.AddCookie(&http.Cookie{Name: "reader_refresh", Value: "refresh-token"})
+	ctx := context.WithValue(context.Background(), requestContextKey{}, request)
+	ctx = context.WithValue(ctx, readerUserContextKey{}, reader)
+
+	readerAccountFn = func(_ *appservice.Service, _ context.Context, user *domain.ReaderUser) appservice.ReaderAccountResult {
+		if user != reader {
+			t.Fatalf("unexpected reader %#v", user)
+		}
+		return appservice.ReaderAccountResult{Status: "success", Account: account}
+	}
+	accountResult, err := (&queryResolver{&Resolver{}}).ReaderAccount(ctx)
+	if err != nil || accountResult.Status != model.ReaderAccountStatusSuccess || accountResult.Account == nil {
+		t.Fatalf("ReaderAccount() = %#v, %v", accountResult, err)
+	}
+	if accountResult.Account.Email != "reader@example.com" || len(accountResult.Account.Providers) != 1 ||
+		accountResult.Account.Providers[0].LinkedAt == nil || accountResult.Account.AvatarURL != nil {
+		t.Fatalf("unexpected account %#v", accountResult.Account)
+	}
+
+	readerSessionIDFn = func(token string) string {
+		if token != "refresh-token" {
+			t.Fatalf("unexpected refresh token %q", token)
+		}
+		return "jti-1"
+	}
+	readerSessionsFn = func(_ *appservice.Service, _ context.Context, _ *domain.ReaderUser, currentSessionID string) appservice.ReaderSessionsResult {
+		return appservice.ReaderSessionsResult{Status: "success", Sessions: []appservice.ReaderSession{{
+			ReaderSessionRecord: domain.ReaderSessionRecord{ID: "jti-1", CountryCode: "TR", LastSeenAt: linkedAt},
+			Device:              "Firefox / Linux",
+			Current:             currentSessionID == "jti-1",
+		}}}
+	}
+	sessionsResult, err := (&queryResolver{&Resolver{}}).ReaderSessions(ctx)
+	if err != nil || len(sessionsResult.Sessions) != 1 || !sessionsResult.Sessions[0].Current ||
+		sessionsResult.Sessions[0].IPAddress != nil || *sessionsResult.Sessions[0].Device != "Firefox / Linux" {
+		t.Fatalf("ReaderSessions() = %#v, %v", sessionsResult, err)
+	}
+
+	readerDataExportFn = func(*appservice.Service, context.Context, *domain.ReaderUser) appservice.ReaderDataExportResult {
+		return appservice.ReaderDataExportResult{Status: "success", Data: &appservice.ReaderDataExport{
+			Account: appservice.ReaderDataExportAccount{ID: "reader-1", Email: "reader@example.com"},
+		}}
+	}
+	exportResult, err := (&queryResolver{&Resolver{}}).ReaderDataExport(ctx)
+	if err != nil || exportResult.Data == nil || !strings.Contains(*exportResult.Data, `"email":"reader@example.com"`) {
+		t.Fatalf("ReaderDataExport() = %#v, %v", exportResult, err)
+	}
+
+	readerDataExportFn = func(*appservice.Service, context.Context, *domain.ReaderUser) appservice.ReaderDataExportResult {
+		return appservice.ReaderDataExportResult{Status: "unauthorized"}
+	}
+	exportResult, _ = (&queryResolver{&Resolver{}}).ReaderDataExport(ctx)
+	if exportResult.Status != model.ReaderAccountStatusUnauthorized || exportResult.Data != nil {
+		t.Fatalf("expected an unauthorized export, got %#v", exportResult)
+	}
+
+	updateReaderProfileFn = func(_ *appservice.Service, _ context.Context, _ *domain.ReaderUser, name string) appservice.ReaderAccountResult {
+		if name != "x" {
+			t.Fatalf("unexpected name %q", name)
+		}
+		return appservice.ReaderAccountResult{Status: "invalid-name"}
+	}
+	profileResult, err := (&mutationResolver{&Resolver{}}).UpdateReaderProfile(ctx, model.UpdateReaderProfileInput{Name: "x"})
+	if err != nil || profileResult.Status != model.ReaderAccountStatusInvalidName || profileResult.Account != nil {
+		t.Fatalf("UpdateReaderProfile() = %#v, %v", profileResult, err)
+	}
+
+	unlinkReaderProviderFn = func(_ *appservice.Service, _ context.Context, _ *domain.ReaderUser, provider string) appservice.ReaderAccountMutationResult {
+		if provider != "github" {
+			t.Fatalf("unexpected provider %q", provider)
+		}
+		return appservice.ReaderAccountMutationResult{Status: "last-sign-in-method"}
+	}
+	unlinkResult, err := (&mutationResolver{&Resolver{}}).UnlinkReaderProvider(ctx, " github ")
+	if err != nil || unlinkResult.Status != model.ReaderAccountStatusLastSignInMethod {
+		t.Fatalf("UnlinkReaderProvider() = %#v, %v", unlinkResult, err)
+	}
+
+	revokeReaderSessionFn = func(_ *appservice.Service, _ context.Context, _ *domain.ReaderUser, id string) appservice.ReaderAccountMutationResult {
+		if id != "jti-2" {
+			t.Fatalf("unexpected session id %q", id)
+		}
+		return appservice.ReaderAccountMutationResult{Status: "not-found"}
+	}
+	revokeResult, err := (&mutationResolver{&Resolver{}}).RevokeReaderSession(ctx, " jti-2 ")
+	if err != nil || revokeResult.Status != model.ReaderAccountStatusNotFound {
+		t.Fatalf("RevokeReaderSession() = %#v, %v", revokeResult, err)
+	}
+
+	deleteReaderAccountFn = func(_ *appservice.Service, _ context.Context, _ *domain.ReaderUser, comments string) appservice.DeleteReaderAccountResult {
+		if comments != appservice.ReaderCommentsAnonymize {
+			t.Fatalf("unexpected comments option %q", comments)
+		}
+		return appservice.DeleteReaderAccountResult{Status: "success", CommentsAffected: 3}
+	}
+	deleteResult, err := (&mutationResolver{&Resolver{}}).DeleteReaderAccount(ctx, model.DeleteReaderAccountInput{
+		Comments: model.ReaderCommentDeletionAnonymize,
+	})
+	if err != nil || deleteResult.Status != model.ReaderAccountStatusSuccess || deleteResult.CommentsAffected != 3 {
+		t.Fatalf("DeleteReaderAccount() = %#v, %v", deleteResult, err)
+	}
+}
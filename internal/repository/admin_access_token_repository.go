@@ -0,0 +1,276 @@
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type AdminAccessTokenRepository interface {
+	Create(ctx context.Context, record domain.AdminAccessTokenRecord) error
+	FindActiveByToken(ctx context.Context, rawToken string, now time.Time) (*domain.AdminAccessTokenRecord, error)
+	ListByUserID(ctx context.Context, userID string, now time.Time) ([]domain.AdminAccessTokenRecord, error)
+	RecordUse(ctx context.Context, id string, usedAt time.Time, remoteIP string) error
+	DeleteByIDAndUserID(ctx context.Context, id, userID string) (bool, error)
+	DeleteAllByUserID(ctx context.Context, userID string) error
+}
+
+var ErrAdminAccessTokenRepositoryUnavailable = errors.New("admin access token repository unavailable")
+
+const (
+	adminAccessTokensCollectionName             = "admin_access_tokens"
+	adminAccessTokenRepositoryUnavailableFormat = "%w: %v"
+	maxAdminAccessTokensPerUser                 = 50
+)
+
+type adminAccessTokenMongoRepository struct {
+	client *mongo.Client
+}
+
+type adminAccessTokenDocument struct {
+	ID         string     `bson:"id"`
+	UserID     string     `bson:"userId"`
+	Name       string     `bson:"name"`
+	Prefix     string     `bson:"prefix"`
+	TokenHash  string     `bson:"tokenHash"`
+	Scopes     []string   `bson:"scopes"`
+	CreatedAt  time.Time  `bson:"createdAt"`
+	ExpiresAt  time.Time  `bson:"expiresAt"`
+	LastUsedAt *time.Time `bson:"lastUsedAt,omitempty"`
+	LastUsedIP string     `bson:"lastUsedIp,omitempty"`
+	UseCount   int64      `bson:"useCount"`
+}
+
+var (
+	adminAccessTokenIndexesOnce sync.Once
+	adminAccessTokenIndexesErr  error
+)
+
+func NewAdminAccessTokenRepository(client *mongo.Client) AdminAccessTokenRepository {
+	return &adminAccessTokenMongoRepository{client: client}
+}
+
+// HashAdminAccessToken returns the value stored in place of a personal access token.
+func HashAdminAccessToken(token string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(token)))
+	return hex.EncodeToString(sum[:])
+}
+
+func (repo *adminAccessTokenMongoRepository) Create(ctx context.Context, record domain.AdminAccessTokenRecord) error {
+	collection, err := getAdminAccessTokensCollection(repo.client)
+	if err != nil {
+		return fmt.Errorf(adminAccessTokenRepositoryUnavailableFormat, ErrAdminAccessTokenRepositoryUnavailable, err)
+	}
+
+	_, err = collection.InsertOne(ctx, adminAccessTokenDocument{
+		ID:        strings.TrimSpace(record.ID),
+		UserID:    strings.TrimSpace(record.UserID),
+		Name:      strings.TrimSpace(record.Name),
+		Prefix:    strings.TrimSpace(record.Prefix),
+		TokenHash: strings.TrimSpace(record.TokenHash),
+		Scopes:    append([]string{}, record.Scopes...),
+		CreatedAt: record.CreatedAt.UTC(),
+		ExpiresAt: record.ExpiresAt.UTC(),
+	})
+	return err
+}
+
+func (repo *adminAccessTokenMongoRepository) FindActiveByToken(
+	ctx context.Context,
+	rawToken string,
+	now time.Time,
+) (*domain.AdminAccessTokenRecord, error) {
+	collection, err := getAdminAccessTokensCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminAccessTokenRepositoryUnavailableFormat, ErrAdminAccessTokenRepositoryUnavailable, err)
+	}
+
+	var document adminAccessTokenDocument
+	err = collection.FindOne(ctx, bson.M{
+		"tokenHash": HashAdminAccessToken(rawToken),
+		"expiresAt": bson.M{"$gt": now.UTC()},
+	}).Decode(&document)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	record := mapAdminAccessTokenDocument(document)
+	return &record, nil
+}
+
+func (repo *adminAccessTokenMongoRepository) ListByUserID(
+	ctx context.Context,
+	userID string,
+	now time.Time,
+) ([]domain.AdminAccessTokenRecord, error) {
+	collection, err := getAdminAccessTokensCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminAccessTokenRepositoryUnavailableFormat, ErrAdminAccessTokenRepositoryUnavailable, err)
+	}
+
+	cursor, err := collection.Find(
+		ctx,
+		bson.M{
+			"userId":    strings.TrimSpace(userID),
+			"expiresAt": bson.M{"$gt": now.UTC()},
+		},
+		options.Find().
+			SetSort(bson.D{{Key: "createdAt", Value: -1}}).
+			SetLimit(maxAdminAccessTokensPerUser),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	records := make([]domain.AdminAccessTokenRecord, 0)
+	for cursor.Next(ctx) {
+		var document adminAccessTokenDocument
+		if err := cursor.Decode(&document); err != nil {
+			return nil, err
+		}
+		records = append(records, mapAdminAccessTokenDocument(document))
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// RecordUse stores when and from where a token was last used and counts the use.
+func (repo *adminAccessTokenMongoRepository) RecordUse(
+	ctx context.Context,
+	id string,
+	usedAt time.Time,
+	remoteIP string,
+) error {
+	collection, err := getAdminAccessTokensCollection(repo.client)
+	if err != nil {
+		return fmt.Errorf(adminAccessTokenRepositoryUnavailableFormat, ErrAdminAccessTokenRepositoryUnavailable, err)
+	}
+
+	_, err = collection.UpdateOne(
+		ctx,
+		bson.M{"id": strings.TrimSpace(id)},
+		bson.M{
+			"$set": bson.M{
+				"lastUsedAt": usedAt.UTC(),
+				"lastUsedIp": strings.TrimSpace(remoteIP),
+			},
+			"$inc": bson.M{"useCount": 1},
+		},
+	)
+	return err
+}
+
+func (repo *adminAccessTokenMongoRepository) DeleteByIDAndUserID(ctx context.Context, id, userID string) (bool, error) {
+	collection, err := getAdminAccessTokensCollection(repo.client)
+	if err != nil {
+		return false, fmt.Errorf(adminAccessTokenRepositoryUnavailableFormat, ErrAdminAccessTokenRepositoryUnavailable, err)
+	}
+
+	result, err := collection.DeleteOne(ctx, bson.M{
+		"id":     strings.TrimSpace(id),
+		"userId": strings.TrimSpace(userID),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return result.DeletedCount > 0, nil
+}
+
+func (repo *adminAccessTokenMongoRepository) DeleteAllByUserID(ctx context.Context, userID string) error {
+	collection, err := getAdminAccessTokensCollection(repo.client)
+	if err != nil {
+		return fmt.Errorf(adminAccessTokenRepositoryUnavailableFormat, ErrAdminAccessTokenRepositoryUnavailable, err)
+	}
+
+	_, err = collection.DeleteMany(ctx, bson.M{"userId": strings.TrimSpace(userID)})
+	return err
+}
+
+func mapAdminAccessTokenDocument(document adminAccessTokenDocument) domain.AdminAccessTokenRecord {
+	return domain.AdminAccessTokenRecord{
+		ID:         strings.TrimSpace(document.ID),
+		UserID:     strings.TrimSpace(document.UserID),
+		Name:       strings.TrimSpace(document.Name),
+		Prefix:     strings.TrimSpace(document.Prefix),
+		TokenHash:  strings.TrimSpace(document.TokenHash),
+		Scopes:     document.Scopes,
+		CreatedAt:  document.CreatedAt,
+		ExpiresAt:  document.ExpiresAt,
+		LastUsedAt: document.LastUsedAt,
+		LastUsedIP: strings.TrimSpace(document.LastUsedIP),
+		UseCount:   document.UseCount,
+	}
+}
+
+func getAdminAccessTokensCollection(client *mongo.Client) (*mongo.Collection, error) {
+	databaseConfig, err := appconfig.ResolveDatabaseConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	if client == nil {
+		return nil, ErrMongoClientUnavailable
+	}
+
+	collection := client.Database(databaseConfig.Name).Collection(adminAccessTokensCollectionName)
+	if err := ensureAdminAccessTokenIndexes(collection); err != nil {
+		return nil, err
+	}
+
+	return collection, nil
+}
+
+func ensureAdminAccessTokenIndexes(collection *mongo.Collection) error {
+	adminAccessTokenIndexesOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		indexes := []mongo.IndexModel{
+			{
+				Keys:    bson.D{{Key: "id", Value: 1}},
+				Options: options.Index().SetUnique(true).SetName("uniq_admin_access_token_id"),
+			},
+			{
+				Keys:    bson.D{{Key: "tokenHash", Value: 1}},
+				Options: options.Index().SetUnique(true).SetName("uniq_admin_access_token_hash"),
+			},
+			{
+				Keys:    bson.D{{Key: "userId", Value: 1}, {Key: "createdAt", Value: -1}},
+				Options: options.Index().SetName("idx_admin_access_token_user_created"),
+			},
+			{
+				Keys:    bson.D{{Key: "expiresAt", Value: 1}},
+				Options: options.Index().SetExpireAfterSeconds(0).SetName("ttl_admin_access_token_expires"),
+			},
+		}
+
+		if _, err := collection.Indexes().CreateMany(ctx, indexes); err != nil {
+			adminAccessTokenIndexesErr = fmt.Errorf("create admin access token index failed: %w", err)
+		}
+	})
+
+	return adminAccessTokenIndexesErr
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+)
+
+type adminAccessTokenMemoryRepository struct {
+	store *MemoryStore
+}
+
+// NewAdminAccessTokenMemoryRepository returns an access token repository backed by the given in-memory store.
+func NewAdminAccessTokenMemoryRepository(store *MemoryStore) AdminAccessTokenRepository {
+	return &adminAccessTokenMemoryRepository{store: store}
+}
+
+func (r *adminAccessTokenMemoryRepository) Create(_ context.Context, record domain.AdminAccessTokenRecord) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.adminAccessTokens = append(r.store.adminAccessTokens, adminAccessTokenDocument{
+		ID:        strings.TrimSpace(record.ID),
+		UserID:    strings.TrimSpace(record.UserID),
+		Name:      strings.TrimSpace(record.Name),
+		Prefix:    strings.TrimSpace(record.Prefix),
+		TokenHash: strings.TrimSpace(record.TokenHash),
+		Scopes:    append([]string{}, record.Scopes...),
+		CreatedAt: record.CreatedAt.UTC(),
+		ExpiresAt: record.ExpiresAt.UTC(),
+	})
+	return nil
+}
+
+func (r *adminAccessTokenMemoryRepository) FindActiveByToken(
+	_ context.Context,
+	rawToken string,
+	now time.Time,
+) (*domain.AdminAccessTokenRecord, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	tokenHash := HashAdminAccessToken(rawToken)
+	for _, document := range r.store.adminAccessTokens {
+		if document.TokenHash == tokenHash && document.ExpiresAt.After(now.UTC()) {
+			record := mapMemoryAdminAccessTokenDocument(document)
+			return &record, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *adminAccessTokenMemoryRepository) ListByUserID(
+	_ context.Context,
+	userID string,
+	now time.Time,
+) ([]domain.AdminAccessTokenRecord, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	resolvedUserID := strings.TrimSpace(userID)
+	records := make([]domain.AdminAccessTokenRecord, 0)
+	for _, document := range r.store.adminAccessTokens {
+		if document.UserID == resolvedUserID && document.ExpiresAt.After(now.UTC()) {
+			records = append(records, mapMemoryAdminAccessTokenDocument(document))
+		}
+	}
+
+	slices.SortStableFunc(records, func(left, right domain.AdminAccessTokenRecord) int {
+		return right.CreatedAt.Compare(left.CreatedAt)
+	})
+	if len(records) > maxAdminAccessTokensPerUser {
+		records = records[:maxAdminAccessTokensPerUser]
+	}
+
+	return records, nil
+}
+
+func (r *adminAccessTokenMemoryRepository) RecordUse(
+	_ context.Context,
+	id string,
+	usedAt time.Time,
+	remoteIP string,
+) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	resolvedID := strings.TrimSpace(id)
+	for index := range r.store.adminAccessTokens {
+		document := &r.store.adminAccessTokens[index]
+		if document.ID != resolvedID {
+			continue
+		}
+
+		lastUsedAt := usedAt.UTC()
+		document.LastUsedAt = &lastUsedAt
+		document.LastUsedIP = strings.TrimSpace(remoteIP)
+		document.UseCount++
+		return nil
+	}
+
+	return nil
+}
+
+func (r *adminAccessTokenMemoryRepository) DeleteByIDAndUserID(_ context.Context, id, userID string) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	resolvedID := strings.TrimSpace(id)
+	resolvedUserID := strings.TrimSpace(userID)
+	for index, document := range r.store.adminAccessTokens {
+		if document.ID == resolvedID && document.UserID == resolvedUserID {
+			r.store.adminAccessTokens = slices.Delete(r.store.adminAccessTokens, index, index+1)
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (r *adminAccessTokenMemoryRepository) DeleteAllByUserID(_ context.Context, userID string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	resolvedUserID := strings.TrimSpace(userID)
+	r.store.adminAccessTokens = slices.DeleteFunc(r.store.adminAccessTokens, func(document adminAccessTokenDocument) bool {
+		return document.UserID == resolvedUserID
+	})
+	return nil
+}
+
+func mapMemoryAdminAccessTokenDocument(document adminAccessTokenDocument) domain.AdminAccessTokenRecord {
+	record := mapAdminAccessTokenDocument(document)
+	record.Scopes = slices.Clone(record.Scopes)
+	if record.LastUsedAt != nil {
+		lastUsedAt := *record.LastUsedAt
+		record.LastUsedAt = &lastUsedAt
+	}
+	return record
+}
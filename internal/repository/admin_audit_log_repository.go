@@ -29,17 +29,21 @@ const (
 	adminAuditLogRepositoryErrorFormat = "%w: %v"
 )
 
-type adminAuditLogMongoRepository struct{}
+type adminAuditLogMongoRepository struct {
+	client *mongo.Client
+}
 
 var (
 	adminAuditLogIndexesOnce sync.Once
 	adminAuditLogIndexesErr  error
 )
 
-func NewAdminAuditLogRepository() AdminAuditLogRepository { return &adminAuditLogMongoRepository{} }
+func NewAdminAuditLogRepository(client *mongo.Client) AdminAuditLogRepository {
+	return &adminAuditLogMongoRepository{client: client}
+}
 
-func (*adminAuditLogMongoRepository) Create(ctx context.Context, record domain.AdminAuditLogRecord) error {
-	collection, err := getAdminAuditLogCollection()
+func (repo *adminAuditLogMongoRepository) Create(ctx context.Context, record domain.AdminAuditLogRecord) error {
+	collection, err := getAdminAuditLogCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(adminAuditLogRepositoryErrorFormat, ErrAdminAuditLogRepositoryUnavailable, err)
 	}
@@ -85,12 +89,12 @@ func (*adminAuditLogMongoRepository) Create(ctx context.Context, record domain.A
 	return nil
 }
 
-func (*adminAuditLogMongoRepository) ListRecentByResource(
+func (repo *adminAuditLogMongoRepository) ListRecentByResource(
 	ctx context.Context,
 	resource string,
 	limit int,
 ) ([]domain.AdminAuditLogRecord, error) {
-	collection, err := getAdminAuditLogCollection()
+	collection, err := getAdminAuditLogCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminAuditLogRepositoryErrorFormat, ErrAdminAuditLogRepositoryUnavailable, err)
 	}
@@ -176,15 +180,14 @@ func (*adminAuditLogMongoRepository) ListRecentByResource(
 	return records, nil
 }
 
-func getAdminAuditLogCollection() (*mongo.Collection, error) {
+func getAdminAuditLogCollection(client *mongo.Client) (*mongo.Collection, error) {
 	databaseConfig, err := appconfig.ResolveDatabaseConfig()
 	if err != nil {
 		return nil, err
 	}
 
-	client, err := getAdminMongoClient()
-	if err != nil {
-		return nil, err
+	if client == nil {
+		return nil, ErrMongoClientUnavailable
 	}
 
 	collection := client.Database(databaseConfig.Name).Collection(adminAuditLogCollectionName)
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/pkg/httpauth"
+)
+
+type adminAuditLogMemoryRepository struct {
+	store *MemoryStore
+}
+
+// NewAdminAuditLogMemoryRepository returns an audit log repository backed by the given in-memory store.
+func NewAdminAuditLogMemoryRepository(store *MemoryStore) AdminAuditLogRepository {
+	return &adminAuditLogMemoryRepository{store: store}
+}
+
+func (r *adminAuditLogMemoryRepository) Create(_ context.Context, record domain.AdminAuditLogRecord) error {
+	createdAt := record.CreatedAt.UTC()
+	if createdAt.IsZero() {
+		createdAt = time.Now().UTC()
+	}
+
+	recordID := strings.TrimSpace(record.ID)
+	if recordID == "" {
+		id, err := httpauth.GenerateOpaqueToken(18)
+		if err != nil {
+			return err
+		}
+		recordID = id
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.adminAuditLogs = append(r.store.adminAuditLogs, domain.AdminAuditLogRecord{
+		ID:          recordID,
+		ActorID:     strings.TrimSpace(record.ActorID),
+		ActorEmail:  strings.TrimSpace(strings.ToLower(record.ActorEmail)),
+		Action:      strings.TrimSpace(record.Action),
+		Resource:    strings.TrimSpace(record.Resource),
+		Scope:       strings.TrimSpace(record.Scope),
+		Locale:      strings.TrimSpace(strings.ToLower(record.Locale)),
+		Code:        strings.TrimSpace(strings.ToUpper(record.Code)),
+		BeforeValue: strings.TrimSpace(record.BeforeValue),
+		AfterValue:  strings.TrimSpace(record.AfterValue),
+		Status:      strings.TrimSpace(strings.ToLower(record.Status)),
+		FailureCode: strings.TrimSpace(strings.ToUpper(record.FailureCode)),
+		RequestID:   strings.TrimSpace(record.RequestID),
+		RemoteIP:    strings.TrimSpace(record.RemoteIP),
+		CountryCode: strings.TrimSpace(strings.ToUpper(record.CountryCode)),
+		UserAgent:   strings.TrimSpace(record.UserAgent),
+		CreatedAt:   createdAt,
+	})
+	return nil
+}
+
+func (r *adminAuditLogMemoryRepository) ListRecentByResource(
+	_ context.Context,
+	resource string,
+	limit int,
+) ([]domain.AdminAuditLogRecord, error) {
+	resolvedResource := strings.TrimSpace(resource)
+	if resolvedResource == "" {
+		return []domain.AdminAuditLogRecord{}, nil
+	}
+
+	resolvedLimit := min(max(limit, 0), 100)
+	if resolvedLimit == 0 {
+		resolvedLimit = 20
+	}
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	records := make([]domain.AdminAuditLogRecord, 0)
+	for _, record := range r.store.adminAuditLogs {
+		if record.Resource == resolvedResource && record.Action != "" {
+			records = append(records, record)
+		}
+	}
+
+	slices.SortStableFunc(records, func(left, right domain.AdminAuditLogRecord) int {
+		return right.CreatedAt.Compare(left.CreatedAt)
+	})
+	if len(records) > resolvedLimit {
+		records = records[:resolvedLimit]
+	}
+
+	return records, nil
+}
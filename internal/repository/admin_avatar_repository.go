@@ -29,23 +29,21 @@ const (
 	adminAvatarRepositoryUnavailableError = "%w: %v"
 )
 
-type adminAvatarMongoRepository struct{}
+type adminAvatarMongoRepository struct {
+	client *mongo.Client
+}
 
 var (
-	adminAvatarMongoClient     *mongo.Client
-	adminAvatarMongoInitErr    error
-	adminAvatarMongoClientOnce sync.Once
-
 	adminAvatarIndexesOnce sync.Once
 	adminAvatarIndexesErr  error
 )
 
-func NewAdminAvatarRepository() AdminAvatarRepository {
-	return &adminAvatarMongoRepository{}
+func NewAdminAvatarRepository(client *mongo.Client) AdminAvatarRepository {
+	return &adminAvatarMongoRepository{client: client}
 }
 
-func (*adminAvatarMongoRepository) UpsertByUserID(ctx context.Context, record domain.AdminAvatarRecord) error {
-	collection, err := getAdminAvatarsCollection()
+func (repo *adminAvatarMongoRepository) UpsertByUserID(ctx context.Context, record domain.AdminAvatarRecord) error {
+	collection, err := getAdminAvatarsCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(adminAvatarRepositoryUnavailableError, ErrAdminAvatarRepositoryUnavailable, err)
 	}
@@ -101,8 +99,8 @@ func (*adminAvatarMongoRepository) UpsertByUserID(ctx context.Context, record do
 	return err
 }
 
-func (*adminAvatarMongoRepository) FindByUserID(ctx context.Context, userID string) (*domain.AdminAvatarRecord, error) {
-	collection, err := getAdminAvatarsCollection()
+func (repo *adminAvatarMongoRepository) FindByUserID(ctx context.Context, userID string) (*domain.AdminAvatarRecord, error) {
+	collection, err := getAdminAvatarsCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminAvatarRepositoryUnavailableError, ErrAdminAvatarRepositoryUnavailable, err)
 	}
@@ -172,8 +170,8 @@ func (*adminAvatarMongoRepository) FindByUserID(ctx context.Context, userID stri
 	}, nil
 }
 
-func (*adminAvatarMongoRepository) DeleteByUserID(ctx context.Context, userID string) error {
-	collection, err := getAdminAvatarsCollection()
+func (repo *adminAvatarMongoRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	collection, err := getAdminAvatarsCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(adminAvatarRepositoryUnavailableError, ErrAdminAvatarRepositoryUnavailable, err)
 	}
@@ -182,42 +180,14 @@ func (*adminAvatarMongoRepository) DeleteByUserID(ctx context.Context, userID st
 	return err
 }
 
-func getAdminAvatarMongoClient() (*mongo.Client, error) {
-	adminAvatarMongoClientOnce.Do(func() {
-		databaseConfig, err := appconfig.ResolveDatabaseConfig()
-		if err != nil {
-			adminAvatarMongoInitErr = err
-			return
-		}
-
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
-
-		client, err := appconfig.NewMongoClient(ctx, databaseConfig, "blog-api-admin-avatars")
-		if err != nil {
-			adminAvatarMongoInitErr = fmt.Errorf("mongodb connect failed: %w", err)
-			return
-		}
-
-		adminAvatarMongoClient = client
-	})
-
-	if adminAvatarMongoInitErr != nil {
-		return nil, adminAvatarMongoInitErr
-	}
-
-	return adminAvatarMongoClient, nil
-}
-
-func getAdminAvatarsCollection() (*mongo.Collection, error) {
+func getAdminAvatarsCollection(client *mongo.Client) (*mongo.Collection, error) {
 	databaseConfig, err := appconfig.ResolveDatabaseConfig()
 	if err != nil {
 		return nil, err
 	}
 
-	client, err := getAdminAvatarMongoClient()
-	if err != nil {
-		return nil, err
+	if client == nil {
+		return nil, ErrMongoClientUnavailable
 	}
 
 	collection := client.Database(databaseConfig.Name).Collection(adminAvatarsCollectionName)
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+)
+
+type adminAvatarMemoryRepository struct {
+	store *MemoryStore
+}
+
+// NewAdminAvatarMemoryRepository returns an avatar repository backed by the given in-memory store.
+func NewAdminAvatarMemoryRepository(store *MemoryStore) AdminAvatarRepository {
+	return &adminAvatarMemoryRepository{store: store}
+}
+
+func (r *adminAvatarMemoryRepository) UpsertByUserID(_ context.Context, record domain.AdminAvatarRecord) error {
+	userID := strings.TrimSpace(record.UserID)
+	if userID == "" {
+		return errors.New("admin avatar user id is required")
+	}
+
+	variants := make([]domain.AdminAvatarVariant, 0, len(record.Variants))
+	for _, variant := range record.Variants {
+		if variant.Size <= 0 || strings.TrimSpace(variant.ContentType) == "" || len(variant.Data) == 0 {
+			continue
+		}
+		variant.ContentType = strings.TrimSpace(variant.ContentType)
+		variants = append(variants, variant)
+	}
+
+	sourceContentType := strings.TrimSpace(record.Source.ContentType)
+	if sourceContentType == "" || len(record.Source.Data) == 0 {
+		return errors.New("admin avatar source is required")
+	}
+
+	updatedAt := record.UpdatedAt.UTC()
+	if updatedAt.IsZero() {
+		updatedAt = time.Now().UTC()
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.adminAvatars[userID] = cloneAdminAvatarRecord(domain.AdminAvatarRecord{
+		UserID:    userID,
+		Digest:    strings.TrimSpace(record.Digest),
+		Version:   record.Version,
+		Source:    domain.AdminAvatarSource{ContentType: sourceContentType, Data: record.Source.Data},
+		Variants:  variants,
+		UpdatedAt: updatedAt,
+	})
+	return nil
+}
+
+func (r *adminAvatarMemoryRepository) FindByUserID(_ context.Context, userID string) (*domain.AdminAvatarRecord, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	record, ok := r.store.adminAvatars[strings.TrimSpace(userID)]
+	if !ok {
+		return nil, nil
+	}
+
+	cloned := cloneAdminAvatarRecord(record)
+	return &cloned, nil
+}
+
+func (r *adminAvatarMemoryRepository) DeleteByUserID(_ context.Context, userID string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	delete(r.store.adminAvatars, strings.TrimSpace(userID))
+	return nil
+}
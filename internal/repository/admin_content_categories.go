@@ -14,11 +14,11 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-func (*adminContentMongoRepository) ListCategories(
+func (repo *adminContentMongoRepository) ListCategories(
 	ctx context.Context,
 	locale string,
 ) ([]domain.AdminContentCategoryRecord, error) {
-	categoriesCollection, err := getPostCategoriesCollection()
+	categoriesCollection, err := getPostCategoriesCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
 	}
@@ -83,11 +83,11 @@ func (*adminContentMongoRepository) ListCategories(
 	return items, nil
 }
 
-func (*adminContentMongoRepository) ListAllCategories(
+func (repo *adminContentMongoRepository) ListAllCategories(
 	ctx context.Context,
 	filter domain.AdminContentTaxonomyFilter,
 ) ([]domain.AdminContentCategoryRecord, error) {
-	categoriesCollection, err := getPostCategoriesCollection()
+	categoriesCollection, err := getPostCategoriesCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
 	}
@@ -156,11 +156,11 @@ func (*adminContentMongoRepository) ListAllCategories(
 	return items, nil
 }
 
-func (*adminContentMongoRepository) ListCategoryGroups(
+func (repo *adminContentMongoRepository) ListCategoryGroups(
 	ctx context.Context,
 	filter domain.AdminContentTaxonomyFilter,
 ) (*domain.AdminContentCategoryListResult, error) {
-	categoriesCollection, err := getPostCategoriesCollection()
+	categoriesCollection, err := getPostCategoriesCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
 	}
@@ -225,12 +225,12 @@ func (*adminContentMongoRepository) ListCategoryGroups(
 	}, nil
 }
 
-func (*adminContentMongoRepository) FindCategoryByLocaleAndID(
+func (repo *adminContentMongoRepository) FindCategoryByLocaleAndID(
 	ctx context.Context,
 	locale string,
 	categoryID string,
 ) (*domain.AdminContentCategoryRecord, error) {
-	categoriesCollection, err := getPostCategoriesCollection()
+	categoriesCollection, err := getPostCategoriesCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
 	}
@@ -266,12 +266,12 @@ func (*adminContentMongoRepository) FindCategoryByLocaleAndID(
 	}, nil
 }
 
-func (*adminContentMongoRepository) UpsertCategory(
+func (repo *adminContentMongoRepository) UpsertCategory(
 	ctx context.Context,
 	record domain.AdminContentCategoryRecord,
 	now time.Time,
 ) (*domain.AdminContentCategoryRecord, error) {
-	categoriesCollection, err := getPostCategoriesCollection()
+	categoriesCollection, err := getPostCategoriesCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
 	}
@@ -313,19 +313,19 @@ func (*adminContentMongoRepository) UpsertCategory(
 		return nil, err
 	}
 
-	return (&adminContentMongoRepository{}).FindCategoryByLocaleAndID(
+	return repo.FindCategoryByLocaleAndID(
 		ctx,
 		record.Locale,
 		record.ID,
 	)
 }
 
-func (*adminContentMongoRepository) DeleteCategoryByLocaleAndID(
+func (repo *adminContentMongoRepository) DeleteCategoryByLocaleAndID(
 	ctx context.Context,
 	locale string,
 	categoryID string,
 ) (bool, error) {
-	categoriesCollection, err := getPostCategoriesCollection()
+	categoriesCollection, err := getPostCategoriesCollection(repo.client)
 	if err != nil {
 		return false, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
 	}
@@ -341,12 +341,12 @@ func (*adminContentMongoRepository) DeleteCategoryByLocaleAndID(
 	return result.DeletedCount > 0, nil
 }
 
-func (*adminContentMongoRepository) SyncCategoryOnPosts(
+func (repo *adminContentMongoRepository) SyncCategoryOnPosts(
 	ctx context.Context,
 	record domain.AdminContentCategoryRecord,
 	now time.Time,
 ) error {
-	postsCollection, err := getPostContentCollection()
+	postsCollection, err := getPostContentCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
 	}
@@ -382,13 +382,13 @@ func (*adminContentMongoRepository) SyncCategoryOnPosts(
 	return err
 }
 
-func (*adminContentMongoRepository) ClearCategoryFromPosts(
+func (repo *adminContentMongoRepository) ClearCategoryFromPosts(
 	ctx context.Context,
 	locale string,
 	categoryID string,
 	now time.Time,
 ) error {
-	postsCollection, err := getPostContentCollection()
+	postsCollection, err := getPostContentCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
 	}
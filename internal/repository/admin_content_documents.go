@@ -82,6 +82,20 @@ type adminContentTopicGroupAggregateDocument struct {
 	Variants []adminContentTopicAggregateVariantDocument `bson:"variants"`
 }
 
+type adminContentSeriesAggregateVariantDocument struct {
+	Locale      string    `bson:"locale"`
+	ID          string    `bson:"id"`
+	Name        string    `bson:"name"`
+	Description string    `bson:"description"`
+	PostIDs     []string  `bson:"postIds"`
+	UpdatedAt   time.Time `bson:"updatedAt"`
+}
+
+type adminContentSeriesGroupAggregateDocument struct {
+	ID       string                                       `bson:"id"`
+	Variants []adminContentSeriesAggregateVariantDocument `bson:"variants"`
+}
+
 type adminContentCategoryAggregateVariantDocument struct {
 	Locale    string    `bson:"locale"`
 	ID        string    `bson:"id"`
@@ -308,6 +308,45 @@ func mapAdminContentTopicGroupAggregateDocument(
 	return group, true
 }
 
+func mapAdminContentSeriesGroupAggregateDocument(
+	doc adminContentSeriesGroupAggregateDocument,
+	preferredLocale string,
+) (domain.AdminContentSeriesGroupRecord, bool) {
+	group := domain.AdminContentSeriesGroupRecord{ID: strings.TrimSpace(strings.ToLower(doc.ID))}
+
+	for _, variant := range doc.Variants {
+		mapped := mapAdminContentSeriesDocument(postSeriesDocument(variant))
+		switch mapped.Locale {
+		case adminContentLocaleEN:
+			if group.EN == nil {
+				group.EN = &mapped
+			}
+		case adminContentLocaleTR:
+			if group.TR == nil {
+				group.TR = &mapped
+			}
+		}
+	}
+
+	assignAdminContentPreferredSeries(&group, preferredLocale)
+	if strings.TrimSpace(group.Preferred.ID) == "" {
+		return domain.AdminContentSeriesGroupRecord{}, false
+	}
+
+	return group, true
+}
+
+func mapAdminContentSeriesDocument(doc postSeriesDocument) domain.AdminContentSeriesRecord {
+	return domain.AdminContentSeriesRecord{
+		Locale:      strings.TrimSpace(strings.ToLower(doc.Locale)),
+		ID:          strings.TrimSpace(strings.ToLower(doc.ID)),
+		Name:        strings.TrimSpace(doc.Name),
+		Description: strings.TrimSpace(doc.Description),
+		PostIDs:     normalizePostSeriesPostIDs(doc.PostIDs),
+		UpdatedAt:   doc.UpdatedAt,
+	}
+}
+
 func mapAdminContentCategoryGroupAggregateDocument(
 	doc adminContentCategoryGroupAggregateDocument,
 	preferredLocale string,
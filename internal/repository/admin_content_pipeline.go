@@ -207,6 +207,75 @@ func buildAdminContentTopicGroupPipeline(filter domain.AdminContentTaxonomyFilte
 	}
 }
 
+func buildAdminContentSeriesFilter(filter domain.AdminContentTaxonomyFilter) bson.M {
+	query := bson.M{}
+
+	resolvedLocale := strings.TrimSpace(strings.ToLower(filter.Locale))
+	if resolvedLocale != "" {
+		query["locale"] = resolvedLocale
+	}
+
+	resolvedSearchQuery := strings.TrimSpace(filter.Query)
+	if resolvedSearchQuery != "" {
+		searchPattern := primitive.Regex{
+			Pattern: regexp.QuoteMeta(resolvedSearchQuery),
+			Options: "i",
+		}
+		query["$or"] = bson.A{
+			bson.M{"id": searchPattern},
+			bson.M{"name": searchPattern},
+			bson.M{"description": searchPattern},
+		}
+	}
+
+	return query
+}
+
+func buildAdminContentSeriesGroupPipeline(filter domain.AdminContentTaxonomyFilter) mongo.Pipeline {
+	query := buildAdminContentSeriesFilter(filter)
+	sortName := buildAdminContentPreferredNameExpression(strings.TrimSpace(strings.ToLower(filter.PreferredLocale)))
+
+	return mongo.Pipeline{
+		bson.D{{Key: "$match", Value: query}},
+		bson.D{{Key: "$group", Value: bson.M{
+			"_id": "$id",
+			"id":  bson.M{"$first": "$id"},
+			"variants": bson.M{"$push": bson.M{
+				"locale":      "$locale",
+				"id":          "$id",
+				"name":        "$name",
+				"description": "$description",
+				"postIds":     "$postIds",
+				"updatedAt":   "$updatedAt",
+			}},
+			"enName": bson.M{"$max": bson.M{
+				"$cond": bson.A{
+					bson.M{"$eq": bson.A{"$locale", adminContentLocaleEN}},
+					"$name",
+					"",
+				},
+			}},
+			"trName": bson.M{"$max": bson.M{
+				"$cond": bson.A{
+					bson.M{"$eq": bson.A{"$locale", adminContentLocaleTR}},
+					"$name",
+					"",
+				},
+			}},
+		}}},
+		bson.D{{Key: "$addFields", Value: bson.M{"sortName": sortName}}},
+		bson.D{{Key: "$sort", Value: bson.D{
+			{Key: "sortName", Value: 1},
+			{Key: "id", Value: 1},
+		}}},
+		bson.D{{Key: "$project", Value: bson.M{
+			"_id":      0,
+			"id":       1,
+			"variants": 1,
+		}}},
+	}
+}
+
 func buildAdminContentCategoryFilter(filter domain.AdminContentTaxonomyFilter) bson.M {
 	query := bson.M{}
 
@@ -404,6 +473,31 @@ func assignAdminContentPreferredTopic(group *domain.AdminContentTopicGroupRecord
 	}
 }
 
+func assignAdminContentPreferredSeries(group *domain.AdminContentSeriesGroupRecord, preferredLocale string) {
+	if group == nil {
+		return
+	}
+
+	if preferredLocale == adminContentLocaleTR {
+		if group.TR != nil {
+			group.Preferred = *group.TR
+			return
+		}
+		if group.EN != nil {
+			group.Preferred = *group.EN
+			return
+		}
+	}
+
+	if group.EN != nil {
+		group.Preferred = *group.EN
+		return
+	}
+	if group.TR != nil {
+		group.Preferred = *group.TR
+	}
+}
+
 func assignAdminContentPreferredCategory(group *domain.AdminContentCategoryGroupRecord, preferredLocale string) {
 	if group == nil {
 		return
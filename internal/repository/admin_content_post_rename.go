@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// RenamePost moves every locale variant of a post to a new id together with its engagement,
+// comments, revisions and series membership, leaving an alias so old links keep resolving.
+func (repo *adminContentMongoRepository) RenamePost(
+	ctx context.Context,
+	sourceID string,
+	targetID string,
+	now time.Time,
+) (*domain.AdminContentPostRenameResult, error) {
+	postsCollection, err := getPostContentCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
+	}
+	revisionsCollection, err := getPostRevisionsCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
+	}
+	likesCollection, err := getPostLikesCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
+	}
+	hitsCollection, err := getPostHitsCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
+	}
+	commentsCollection, err := getPostCommentsCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
+	}
+	seriesCollection, err := getPostSeriesCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
+	}
+	relatedCollection, err := getPostRelatedCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
+	}
+	aliasesCollection, err := getPostIDAliasesCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
+	}
+
+	resolvedSourceID := strings.TrimSpace(strings.ToLower(sourceID))
+	resolvedTargetID := strings.TrimSpace(strings.ToLower(targetID))
+	resolvedNow := now.UTC()
+	if resolvedNow.IsZero() {
+		resolvedNow = time.Now().UTC()
+	}
+
+	session, err := repo.client.StartSession()
+	if err != nil {
+		return nil, err
+	}
+	defer session.EndSession(ctx)
+
+	result := &domain.AdminContentPostRenameResult{SourceID: resolvedSourceID, TargetID: resolvedTargetID}
+	_, err = session.WithTransaction(ctx, func(sessionContext mongo.SessionContext) (any, error) {
+		*result = domain.AdminContentPostRenameResult{SourceID: resolvedSourceID, TargetID: resolvedTargetID}
+
+		postsResult, updateErr := postsCollection.UpdateMany(
+			sessionContext,
+			bson.M{"id": resolvedSourceID},
+			bson.M{"$set": bson.M{"id": resolvedTargetID, "updatedAt": resolvedNow}},
+		)
+		if updateErr != nil {
+			return nil, updateErr
+		}
+		if postsResult.MatchedCount == 0 {
+			return nil, ErrAdminContentPostNotFound
+		}
+		result.PostsUpdated = int(postsResult.ModifiedCount)
+
+		revisionsResult, updateErr := revisionsCollection.UpdateMany(
+			sessionContext,
+			bson.M{"postId": resolvedSourceID},
+			bson.M{"$set": bson.M{"postId": resolvedTargetID}},
+		)
+		if updateErr != nil {
+			return nil, updateErr
+		}
+		result.RevisionsUpdated = int(revisionsResult.ModifiedCount)
+
+		// Counters are unique per post id, so leftovers from a deleted post with the target id are dropped first.
+		for _, counters := range []*mongo.Collection{likesCollection, hitsCollection} {
+			if _, deleteErr := counters.DeleteMany(sessionContext, bson.M{"postId": resolvedTargetID}); deleteErr != nil {
+				return nil, deleteErr
+			}
+			if _, updateErr := counters.UpdateMany(
+				sessionContext,
+				bson.M{"postId": resolvedSourceID},
+				bson.M{"$set": bson.M{"postId": resolvedTargetID}},
+			); updateErr != nil {
+				return nil, updateErr
+			}
+		}
+
+		commentsResult, updateErr := commentsCollection.UpdateMany(
+			sessionContext,
+			bson.M{"postId": resolvedSourceID},
+			bson.M{"$set": bson.M{"postId": resolvedTargetID}},
+		)
+		if updateErr != nil {
+			return nil, updateErr
+		}
+		result.CommentsUpdated = int(commentsResult.ModifiedCount)
+
+		seriesResult, updateErr := seriesCollection.UpdateMany(
+			sessionContext,
+			bson.M{"postIds": resolvedSourceID},
+			bson.M{"$set": bson.M{"postIds.$": resolvedTargetID, "updatedAt": resolvedNow}},
+		)
+		if updateErr != nil {
+			return nil, updateErr
+		}
+		result.SeriesUpdated = int(seriesResult.ModifiedCount)
+
+		if _, deleteErr := relatedCollection.DeleteMany(
+			sessionContext,
+			bson.M{"postId": resolvedSourceID},
+		); deleteErr != nil {
+			return nil, deleteErr
+		}
+
+		// Renaming back to a previous id reclaims it, and older aliases follow the post to its new id.
+		if _, deleteErr := aliasesCollection.DeleteMany(
+			sessionContext,
+			bson.M{"aliasId": resolvedTargetID},
+		); deleteErr != nil {
+			return nil, deleteErr
+		}
+		aliasesResult, updateErr := aliasesCollection.UpdateMany(
+			sessionContext,
+			bson.M{"postId": resolvedSourceID},
+			bson.M{"$set": bson.M{"postId": resolvedTargetID}},
+		)
+		if updateErr != nil {
+			return nil, updateErr
+		}
+		result.AliasesRedirected = int(aliasesResult.ModifiedCount)
+
+		if _, insertErr := aliasesCollection.InsertOne(sessionContext, postIDAliasDocument{
+			AliasID:   resolvedSourceID,
+			PostID:    resolvedTargetID,
+			CreatedAt: resolvedNow,
+		}); insertErr != nil {
+			return nil, insertErr
+		}
+
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
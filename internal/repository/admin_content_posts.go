@@ -15,11 +15,11 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-func (*adminContentMongoRepository) ListPostGroups(
+func (repo *adminContentMongoRepository) ListPostGroups(
 	ctx context.Context,
 	filter domain.AdminContentPostFilter,
 ) (*domain.AdminContentPostListResult, error) {
-	postsCollection, err := getPostContentCollection()
+	postsCollection, err := getPostContentCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
 	}
@@ -84,11 +84,11 @@ func (*adminContentMongoRepository) ListPostGroups(
 	}, nil
 }
 
-func (*adminContentMongoRepository) ListAllPosts(
+func (repo *adminContentMongoRepository) ListAllPosts(
 	ctx context.Context,
 	filter domain.AdminContentPostFilter,
 ) ([]domain.AdminContentPostRecord, error) {
-	postsCollection, err := getPostContentCollection()
+	postsCollection, err := getPostContentCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
 	}
@@ -146,12 +146,12 @@ func (*adminContentMongoRepository) ListAllPosts(
 	return items, nil
 }
 
-func (*adminContentMongoRepository) FindPostByLocaleAndID(
+func (repo *adminContentMongoRepository) FindPostByLocaleAndID(
 	ctx context.Context,
 	locale string,
 	postID string,
 ) (*domain.AdminContentPostRecord, error) {
-	postsCollection, err := getPostContentCollection()
+	postsCollection, err := getPostContentCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
 	}
@@ -197,14 +197,14 @@ func (*adminContentMongoRepository) FindPostByLocaleAndID(
 	return &mapped, nil
 }
 
-func (*adminContentMongoRepository) ListPostRevisions(
+func (repo *adminContentMongoRepository) ListPostRevisions(
 	ctx context.Context,
 	locale string,
 	postID string,
 	page int,
 	size int,
 ) (*domain.AdminContentPostRevisionListResult, error) {
-	revisionsCollection, err := getPostRevisionsCollection()
+	revisionsCollection, err := getPostRevisionsCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
 	}
@@ -264,13 +264,13 @@ func (*adminContentMongoRepository) ListPostRevisions(
 	}, nil
 }
 
-func (*adminContentMongoRepository) FindPostRevisionByID(
+func (repo *adminContentMongoRepository) FindPostRevisionByID(
 	ctx context.Context,
 	locale string,
 	postID string,
 	revisionID string,
 ) (*domain.AdminContentPostRevisionRecord, error) {
-	revisionsCollection, err := getPostRevisionsCollection()
+	revisionsCollection, err := getPostRevisionsCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
 	}
@@ -295,13 +295,13 @@ func (*adminContentMongoRepository) FindPostRevisionByID(
 	return &mapped, nil
 }
 
-func (*adminContentMongoRepository) CreatePostRevision(
+func (repo *adminContentMongoRepository) CreatePostRevision(
 	ctx context.Context,
 	record domain.AdminContentPostRecord,
 	revisionNumber int,
 	now time.Time,
 ) (*domain.AdminContentPostRevisionRecord, error) {
-	revisionsCollection, err := getPostRevisionsCollection()
+	revisionsCollection, err := getPostRevisionsCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
 	}
@@ -324,7 +324,7 @@ func (*adminContentMongoRepository) CreatePostRevision(
 	return &mapped, nil
 }
 
-func (*adminContentMongoRepository) UpdatePostMetadata(
+func (repo *adminContentMongoRepository) UpdatePostMetadata(
 	ctx context.Context,
 	locale string,
 	postID string,
@@ -334,7 +334,7 @@ func (*adminContentMongoRepository) UpdatePostMetadata(
 	revisionStamp *domain.AdminContentPostRevisionStamp,
 	now time.Time,
 ) (*domain.AdminContentPostRecord, error) {
-	postsCollection, err := getPostContentCollection()
+	postsCollection, err := getPostContentCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
 	}
@@ -344,37 +344,8 @@ func (*adminContentMongoRepository) UpdatePostMetadata(
 		resolvedNow = time.Now().UTC()
 	}
 
-	categoryValue := any(nil)
-	if category != nil {
-		categoryDocument := bson.M{
-			"id":    strings.TrimSpace(strings.ToLower(category.ID)),
-			"name":  strings.TrimSpace(category.Name),
-			"color": strings.TrimSpace(strings.ToLower(category.Color)),
-		}
-		if icon := strings.TrimSpace(category.Icon); icon != "" {
-			categoryDocument["icon"] = icon
-		}
-		categoryValue = categoryDocument
-	}
-
-	topicValues := make([]bson.M, 0, len(topics))
-	topicIDs := make([]string, 0, len(topics))
-	for _, topic := range topics {
-		resolvedID := strings.TrimSpace(strings.ToLower(topic.ID))
-		if resolvedID == "" {
-			continue
-		}
-		resolvedTopic := bson.M{
-			"id":    resolvedID,
-			"name":  strings.TrimSpace(topic.Name),
-			"color": strings.TrimSpace(strings.ToLower(topic.Color)),
-		}
-		if link := strings.TrimSpace(topic.Link); link != "" {
-			resolvedTopic["link"] = link
-		}
-		topicValues = append(topicValues, resolvedTopic)
-		topicIDs = append(topicIDs, resolvedID)
-	}
+	categoryValue := buildAdminContentPostCategoryValue(category)
+	topicValues, topicIDs := buildAdminContentPostTopicValues(topics)
 
 	setFields := bson.M{
 		"title":         strings.TrimSpace(fields.Title),
@@ -442,7 +413,7 @@ func (*adminContentMongoRepository) UpdatePostMetadata(
 	return &mapped, nil
 }
 
-func (*adminContentMongoRepository) UpdatePostContent(
+func (repo *adminContentMongoRepository) UpdatePostContent(
 	ctx context.Context,
 	locale string,
 	postID string,
@@ -450,7 +421,7 @@ func (*adminContentMongoRepository) UpdatePostContent(
 	revisionStamp *domain.AdminContentPostRevisionStamp,
 	now time.Time,
 ) (*domain.AdminContentPostRecord, error) {
-	postsCollection, err := getPostContentCollection()
+	postsCollection, err := getPostContentCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
 	}
@@ -519,13 +490,13 @@ func (*adminContentMongoRepository) UpdatePostContent(
 	return &mapped, nil
 }
 
-func (*adminContentMongoRepository) RestorePostRevision(
+func (repo *adminContentMongoRepository) RestorePostRevision(
 	ctx context.Context,
 	revision domain.AdminContentPostRevisionRecord,
 	revisionStamp *domain.AdminContentPostRevisionStamp,
 	now time.Time,
 ) (*domain.AdminContentPostRecord, error) {
-	postsCollection, err := getPostContentCollection()
+	postsCollection, err := getPostContentCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
 	}
@@ -607,15 +578,16 @@ func (*adminContentMongoRepository) RestorePostRevision(
 	return &mapped, nil
 }
 
-func (*adminContentMongoRepository) DeletePostByLocaleAndID(ctx context.Context, locale, postID string) (bool, error) {
-	postsCollection, err := getPostContentCollection()
+func (repo *adminContentMongoRepository) DeletePostByLocaleAndID(ctx context.Context, locale, postID string) (bool, error) {
+	postsCollection, err := getPostContentCollection(repo.client)
 	if err != nil {
 		return false, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
 	}
 
+	resolvedLocale := strings.TrimSpace(strings.ToLower(locale))
 	resolvedPostID := strings.TrimSpace(strings.ToLower(postID))
 	result, err := postsCollection.DeleteOne(ctx, bson.M{
-		"locale": strings.TrimSpace(strings.ToLower(locale)),
+		"locale": resolvedLocale,
 		"id":     resolvedPostID,
 	})
 	if err != nil {
@@ -625,6 +597,10 @@ func (*adminContentMongoRepository) DeletePostByLocaleAndID(ctx context.Context,
 		return false, nil
 	}
 
+	if err := removeDeletedPostReferences(ctx, repo.client, resolvedLocale, resolvedPostID); err != nil {
+		return true, err
+	}
+
 	remainingCount, err := postsCollection.CountDocuments(ctx, bson.M{"id": resolvedPostID})
 	if err != nil {
 		return true, err
@@ -633,12 +609,45 @@ func (*adminContentMongoRepository) DeletePostByLocaleAndID(ctx context.Context,
 		return true, nil
 	}
 
-	if likesCollection, likesErr := getPostLikesCollection(); likesErr == nil {
+	if likesCollection, likesErr := getPostLikesCollection(repo.client); likesErr == nil {
 		_, _ = likesCollection.DeleteOne(ctx, bson.M{"postId": resolvedPostID})
 	}
-	if hitsCollection, hitsErr := getPostHitsCollection(); hitsErr == nil {
+	if hitsCollection, hitsErr := getPostHitsCollection(repo.client); hitsErr == nil {
 		_, _ = hitsCollection.DeleteOne(ctx, bson.M{"postId": resolvedPostID})
 	}
 
 	return true, nil
 }
+
+// removeDeletedPostReferences drops a deleted locale variant from the series and related posts of its locale, so
+// later series edits do not trip over an id that no longer exists.
+func removeDeletedPostReferences(ctx context.Context, client *mongo.Client, locale, postID string) error {
+	seriesCollection, err := getPostSeriesCollection(client)
+	if err != nil {
+		return fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
+	}
+	relatedCollection, err := getPostRelatedCollection(client)
+	if err != nil {
+		return fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
+	}
+
+	if _, err := seriesCollection.UpdateMany(
+		ctx,
+		bson.M{"locale": locale, "postIds": postID},
+		bson.M{
+			"$pull": bson.M{"postIds": postID},
+			"$set":  bson.M{"updatedAt": time.Now().UTC()},
+		},
+	); err != nil {
+		return err
+	}
+	if _, err := relatedCollection.DeleteOne(ctx, bson.M{"locale": locale, "postId": postID}); err != nil {
+		return err
+	}
+	_, err = relatedCollection.UpdateMany(
+		ctx,
+		bson.M{"locale": locale, "items.postId": postID},
+		bson.M{"$pull": bson.M{"items": bson.M{"postId": postID}}},
+	)
+	return err
+}
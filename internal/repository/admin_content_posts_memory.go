@@ -0,0 +1,596 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (r *adminContentMemoryRepository) ListPostGroups(
+	_ context.Context,
+	filter domain.AdminContentPostFilter,
+) (*domain.AdminContentPostListResult, error) {
+	resolvedPreferredLocale := strings.TrimSpace(strings.ToLower(filter.PreferredLocale))
+	if resolvedPreferredLocale == "" {
+		resolvedPreferredLocale = adminContentLocaleEN
+	}
+
+	type postGroup struct {
+		document        adminContentPostGroupAggregateDocument
+		sortPublishedAt time.Time
+	}
+
+	posts := r.filterPosts(filter)
+	slices.SortStableFunc(posts, func(left, right memoryPost) int {
+		if order := memoryPostSortPublishedAt(right).Compare(memoryPostSortPublishedAt(left)); order != 0 {
+			return order
+		}
+		return cmp.Or(
+			strings.Compare(left.ID, right.ID),
+			strings.Compare(left.Source, right.Source),
+			strings.Compare(left.Locale, right.Locale),
+		)
+	})
+
+	groups := make([]postGroup, 0)
+	groupIndexes := make(map[[2]string]int)
+	for _, post := range posts {
+		key := [2]string{post.ID, post.Source}
+		index, exists := groupIndexes[key]
+		if !exists {
+			index = len(groups)
+			groupIndexes[key] = index
+			groups = append(groups, postGroup{
+				document:        adminContentPostGroupAggregateDocument{ID: post.ID, Source: post.Source},
+				sortPublishedAt: memoryPostSortPublishedAt(post),
+			})
+		}
+		variant := post.adminDocument()
+		variant.Content = ""
+		variant.ContentMode = ""
+		groups[index].document.Variants = append(groups[index].document.Variants, variant)
+	}
+
+	total := len(groups)
+	resolvedPage, resolvedSize, skip := resolveAdminContentPagination(filter.Page, filter.Size, total)
+	items := make([]domain.AdminContentPostGroupRecord, 0, resolvedSize)
+	for _, group := range memoryWindow(groups, skip, resolvedSize) {
+		if item, ok := mapAdminContentPostGroupAggregateDocument(group.document, resolvedPreferredLocale); ok {
+			items = append(items, item)
+		}
+	}
+
+	return &domain.AdminContentPostListResult{
+		Items: items,
+		Total: total,
+		Page:  resolvedPage,
+		Size:  resolvedSize,
+	}, nil
+}
+
+func (r *adminContentMemoryRepository) ListAllPosts(
+	_ context.Context,
+	filter domain.AdminContentPostFilter,
+) ([]domain.AdminContentPostRecord, error) {
+	posts := r.filterPosts(filter)
+	slices.SortStableFunc(posts, func(left, right memoryPost) int {
+		if order := right.PublishedAt.Compare(left.PublishedAt); order != 0 {
+			return order
+		}
+		return strings.Compare(left.ID, right.ID)
+	})
+
+	items := make([]domain.AdminContentPostRecord, 0, len(posts))
+	for _, post := range posts {
+		post.Content = ""
+		post.ContentMode = ""
+		post.ContentUpdatedAt = time.Time{}
+		items = append(items, post.adminRecord())
+	}
+
+	return items, nil
+}
+
+func (r *adminContentMemoryRepository) FindPostByLocaleAndID(
+	_ context.Context,
+	locale string,
+	postID string,
+) (*domain.AdminContentPostRecord, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	post := r.store.findPost(strings.TrimSpace(strings.ToLower(locale)), strings.TrimSpace(strings.ToLower(postID)))
+	if post == nil {
+		return nil, nil
+	}
+
+	mapped := post.adminRecord()
+	return &mapped, nil
+}
+
+func (r *adminContentMemoryRepository) ListPostRevisions(
+	_ context.Context,
+	locale string,
+	postID string,
+	page int,
+	size int,
+) (*domain.AdminContentPostRevisionListResult, error) {
+	resolvedLocale := strings.TrimSpace(strings.ToLower(locale))
+	resolvedPostID := strings.TrimSpace(strings.ToLower(postID))
+
+	r.store.mu.RLock()
+	revisions := make([]adminContentPostRevisionDocument, 0)
+	for _, revision := range r.store.postRevisions {
+		if revision.Locale == resolvedLocale && revision.PostID == resolvedPostID {
+			revisions = append(revisions, revision)
+		}
+	}
+	r.store.mu.RUnlock()
+
+	slices.SortStableFunc(revisions, func(left, right adminContentPostRevisionDocument) int {
+		if order := cmp.Compare(right.RevisionNumber, left.RevisionNumber); order != 0 {
+			return order
+		}
+		return right.CreatedAt.Compare(left.CreatedAt)
+	})
+
+	total := len(revisions)
+	resolvedPage, resolvedSize, skip := resolveAdminContentPagination(&page, &size, total)
+	items := make([]domain.AdminContentPostRevisionRecord, 0, resolvedSize)
+	for _, revision := range memoryWindow(revisions, skip, resolvedSize) {
+		items = append(items, mapAdminContentPostRevisionDocument(revision))
+	}
+
+	return &domain.AdminContentPostRevisionListResult{
+		Items: items,
+		Total: total,
+		Page:  resolvedPage,
+		Size:  resolvedSize,
+	}, nil
+}
+
+func (r *adminContentMemoryRepository) FindPostRevisionByID(
+	_ context.Context,
+	locale string,
+	postID string,
+	revisionID string,
+) (*domain.AdminContentPostRevisionRecord, error) {
+	resolvedLocale := strings.TrimSpace(strings.ToLower(locale))
+	resolvedPostID := strings.TrimSpace(strings.ToLower(postID))
+	resolvedRevisionID := strings.TrimSpace(revisionID)
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, revision := range r.store.postRevisions {
+		if revision.ID == resolvedRevisionID && revision.Locale == resolvedLocale && revision.PostID == resolvedPostID {
+			mapped := mapAdminContentPostRevisionDocument(revision)
+			return &mapped, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *adminContentMemoryRepository) CreatePostRevision(
+	_ context.Context,
+	record domain.AdminContentPostRecord,
+	revisionNumber int,
+	now time.Time,
+) (*domain.AdminContentPostRevisionRecord, error) {
+	resolvedNow := resolveMemoryContentNow(now)
+	if revisionNumber <= 0 {
+		revisionNumber = max(record.RevisionCount, 0) + 1
+	}
+
+	document := buildAdminContentPostRevisionDocument(record, primitive.NewObjectID().Hex(), revisionNumber, resolvedNow)
+
+	r.store.mu.Lock()
+	r.store.postRevisions = append(r.store.postRevisions, document)
+	r.store.mu.Unlock()
+
+	mapped := mapAdminContentPostRevisionDocument(document)
+	return &mapped, nil
+}
+
+func (r *adminContentMemoryRepository) UpdatePostMetadata(
+	_ context.Context,
+	locale string,
+	postID string,
+	fields domain.AdminContentPostMetadataFields,
+	category *domain.AdminContentCategoryRecord,
+	topics []domain.AdminContentTopicRecord,
+	revisionStamp *domain.AdminContentPostRevisionStamp,
+	now time.Time,
+) (*domain.AdminContentPostRecord, error) {
+	resolvedNow := resolveMemoryContentNow(now)
+	topicValues, topicIDs := memoryPostTopics(topics)
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return r.updatePost(locale, postID, func(post *memoryPost) {
+		post.Title = strings.TrimSpace(fields.Title)
+		post.Summary = strings.TrimSpace(fields.Summary)
+		post.Thumbnail = strings.TrimSpace(fields.Thumbnail)
+		post.PublishedDate = strings.TrimSpace(fields.PublishedDate)
+		post.UpdatedDate = strings.TrimSpace(fields.UpdatedDate)
+		post.Category = memoryPostCategory(category)
+		post.Topics = topicValues
+		post.TopicIDs = topicIDs
+		post.Status = strings.TrimSpace(strings.ToLower(fields.Status))
+		post.ScheduledAt = fields.ScheduledAt.UTC()
+		post.UpdatedAt = resolvedNow
+		applyMemoryPostRevisionStamp(post, revisionStamp)
+	})
+}
+
+func (r *adminContentMemoryRepository) UpdatePostContent(
+	_ context.Context,
+	locale string,
+	postID string,
+	content string,
+	revisionStamp *domain.AdminContentPostRevisionStamp,
+	now time.Time,
+) (*domain.AdminContentPostRecord, error) {
+	resolvedNow := resolveMemoryContentNow(now)
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return r.updatePost(locale, postID, func(post *memoryPost) {
+		post.Content = content
+		post.ContentMode = "admin"
+		post.ContentUpdatedAt = resolvedNow
+		post.UpdatedAt = resolvedNow
+		applyMemoryPostRevisionStamp(post, revisionStamp)
+	})
+}
+
+func (r *adminContentMemoryRepository) RestorePostRevision(
+	_ context.Context,
+	revision domain.AdminContentPostRevisionRecord,
+	revisionStamp *domain.AdminContentPostRevisionStamp,
+	now time.Time,
+) (*domain.AdminContentPostRecord, error) {
+	resolvedNow := resolveMemoryContentNow(now)
+	category, topics := memoryPostTaxonomyFromRevision(revision)
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return r.updatePost(revision.Locale, revision.PostID, func(post *memoryPost) {
+		post.Title = strings.TrimSpace(revision.Title)
+		post.Summary = strings.TrimSpace(revision.Summary)
+		post.Content = revision.Content
+		post.ContentMode = strings.TrimSpace(strings.ToLower(revision.ContentMode))
+		post.Thumbnail = strings.TrimSpace(revision.Thumbnail)
+		post.PublishedDate = strings.TrimSpace(revision.PublishedDate)
+		post.UpdatedDate = strings.TrimSpace(revision.UpdatedDate)
+		post.Category = category
+		post.Topics = topics
+		post.TopicIDs = slices.Clone(revision.TopicIDs)
+		post.ReadingTimeMin = revision.ReadingTimeMin
+		post.Status = strings.TrimSpace(strings.ToLower(revision.Status))
+		post.ScheduledAt = revision.ScheduledAt.UTC()
+		post.ContentUpdatedAt = revision.ContentUpdatedAt.UTC()
+		post.UpdatedAt = resolvedNow
+		applyMemoryPostRevisionStamp(post, revisionStamp)
+	})
+}
+
+func (r *adminContentMemoryRepository) DeletePostByLocaleAndID(_ context.Context, locale, postID string) (bool, error) {
+	resolvedLocale := strings.TrimSpace(strings.ToLower(locale))
+	resolvedPostID := strings.TrimSpace(strings.ToLower(postID))
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	index := slices.IndexFunc(r.store.posts, func(post memoryPost) bool {
+		return post.Locale == resolvedLocale && post.ID == resolvedPostID
+	})
+	if index < 0 {
+		return false, nil
+	}
+
+	r.store.posts = slices.Delete(r.store.posts, index, index+1)
+	now := time.Now().UTC()
+	for seriesIndex := range r.store.postSeries {
+		document := &r.store.postSeries[seriesIndex]
+		if document.Locale != resolvedLocale || !slices.Contains(document.PostIDs, resolvedPostID) {
+			continue
+		}
+		document.PostIDs = slices.DeleteFunc(slices.Clone(document.PostIDs), func(id string) bool {
+			return id == resolvedPostID
+		})
+		document.UpdatedAt = now
+	}
+	r.store.postRelated = slices.DeleteFunc(r.store.postRelated, func(record domain.PostRelatedRecord) bool {
+		return record.Locale == resolvedLocale && record.PostID == resolvedPostID
+	})
+	for relatedIndex := range r.store.postRelated {
+		record := &r.store.postRelated[relatedIndex]
+		if record.Locale != resolvedLocale {
+			continue
+		}
+		record.Items = slices.DeleteFunc(slices.Clone(record.Items), func(item domain.PostRelatedItem) bool {
+			return item.PostID == resolvedPostID
+		})
+	}
+	if !slices.ContainsFunc(r.store.posts, func(post memoryPost) bool { return post.ID == resolvedPostID }) {
+		delete(r.store.postLikes, resolvedPostID)
+		delete(r.store.postHits, resolvedPostID)
+	}
+
+	return true, nil
+}
+
+// RenamePost moves every locale variant of a post to a new id together with its engagement, comments, revisions
+// and series membership, leaving an alias so old links keep resolving.
+func (r *adminContentMemoryRepository) RenamePost(
+	_ context.Context,
+	sourceID string,
+	targetID string,
+	now time.Time,
+) (*domain.AdminContentPostRenameResult, error) {
+	resolvedSourceID := strings.TrimSpace(strings.ToLower(sourceID))
+	resolvedTargetID := strings.TrimSpace(strings.ToLower(targetID))
+	resolvedNow := resolveMemoryContentNow(now)
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	// Every check runs before the first change, so a failed rename leaves the store untouched like an aborted
+	// transaction.
+	matched := false
+	for _, post := range r.store.posts {
+		if post.ID != resolvedSourceID {
+			continue
+		}
+		matched = true
+		if resolvedSourceID != resolvedTargetID && r.store.findPost(post.Locale, resolvedTargetID) != nil {
+			return nil, fmt.Errorf("post %q already exists in locale %q", resolvedTargetID, post.Locale)
+		}
+	}
+	if !matched {
+		return nil, ErrAdminContentPostNotFound
+	}
+	if resolvedSourceID != resolvedTargetID && slices.ContainsFunc(r.store.postIDAliases, func(alias postIDAliasDocument) bool {
+		return alias.AliasID == resolvedSourceID
+	}) {
+		return nil, fmt.Errorf("post id alias %q already exists", resolvedSourceID)
+	}
+
+	result := &domain.AdminContentPostRenameResult{SourceID: resolvedSourceID, TargetID: resolvedTargetID}
+	renamed := resolvedSourceID != resolvedTargetID
+
+	for index := range r.store.posts {
+		if r.store.posts[index].ID == resolvedSourceID {
+			r.store.posts[index].ID = resolvedTargetID
+			r.store.posts[index].UpdatedAt = resolvedNow
+			result.PostsUpdated++
+		}
+	}
+
+	for index := range r.store.postRevisions {
+		if r.store.postRevisions[index].PostID == resolvedSourceID && renamed {
+			r.store.postRevisions[index].PostID = resolvedTargetID
+			result.RevisionsUpdated++
+		}
+	}
+
+	// Counters are unique per post id, so leftovers from a deleted post with the target id are dropped first.
+	for _, counters := range []map[string]int64{r.store.postLikes, r.store.postHits} {
+		value, exists := counters[resolvedSourceID]
+		delete(counters, resolvedTargetID)
+		if exists {
+			delete(counters, resolvedSourceID)
+			counters[resolvedTargetID] = value
+		}
+	}
+
+	for index := range r.store.comments {
+		if r.store.comments[index].PostID == resolvedSourceID && renamed {
+			r.store.comments[index].PostID = resolvedTargetID
+			result.CommentsUpdated++
+		}
+	}
+
+	for index := range r.store.postSeries {
+		series := &r.store.postSeries[index]
+		position := slices.Index(series.PostIDs, resolvedSourceID)
+		if position < 0 {
+			continue
+		}
+		series.PostIDs = slices.Clone(series.PostIDs)
+		series.PostIDs[position] = resolvedTargetID
+		series.UpdatedAt = resolvedNow
+		result.SeriesUpdated++
+	}
+
+	r.store.postRelated = slices.DeleteFunc(r.store.postRelated, func(record domain.PostRelatedRecord) bool {
+		return record.PostID == resolvedSourceID
+	})
+
+	// Renaming back to a previous id reclaims it, and older aliases follow the post to its new id.
+	r.store.postIDAliases = slices.DeleteFunc(r.store.postIDAliases, func(alias postIDAliasDocument) bool {
+		return alias.AliasID == resolvedTargetID
+	})
+	for index := range r.store.postIDAliases {
+		if r.store.postIDAliases[index].PostID == resolvedSourceID && renamed {
+			r.store.postIDAliases[index].PostID = resolvedTargetID
+			result.AliasesRedirected++
+		}
+	}
+	r.store.postIDAliases = append(r.store.postIDAliases, postIDAliasDocument{
+		AliasID:   resolvedSourceID,
+		PostID:    resolvedTargetID,
+		CreatedAt: resolvedNow,
+	})
+
+	return result, nil
+}
+
+func (r *adminContentMemoryRepository) ListDueScheduledPosts(
+	_ context.Context,
+	now time.Time,
+	limit int,
+) ([]domain.AdminContentPostRecord, error) {
+	resolvedNow := resolveMemoryContentNow(now)
+	resolvedLimit := limit
+	if resolvedLimit <= 0 || resolvedLimit > adminContentScheduleMaxBatchSize {
+		resolvedLimit = adminContentScheduleMaxBatchSize
+	}
+
+	r.store.mu.RLock()
+	due := make([]memoryPost, 0)
+	for _, post := range r.store.posts {
+		if post.Status == domain.AdminContentPostStatusScheduled && !post.ScheduledAt.IsZero() &&
+			!post.ScheduledAt.After(resolvedNow) {
+			due = append(due, post.clone())
+		}
+	}
+	r.store.mu.RUnlock()
+
+	slices.SortStableFunc(due, func(left, right memoryPost) int {
+		return cmp.Or(
+			left.ScheduledAt.Compare(right.ScheduledAt),
+			strings.Compare(left.Locale, right.Locale),
+			strings.Compare(left.ID, right.ID),
+		)
+	})
+
+	items := make([]domain.AdminContentPostRecord, 0, min(len(due), resolvedLimit))
+	for _, post := range due[:min(len(due), resolvedLimit)] {
+		items = append(items, post.adminRecord())
+	}
+
+	return items, nil
+}
+
+func (r *adminContentMemoryRepository) PublishScheduledPost(
+	_ context.Context,
+	locale string,
+	postID string,
+	publishedAt time.Time,
+	revisionStamp *domain.AdminContentPostRevisionStamp,
+	now time.Time,
+) (*domain.AdminContentPostRecord, error) {
+	resolvedNow := resolveMemoryContentNow(now)
+	resolvedPublishedAt := publishedAt.UTC()
+	if publishedAt.IsZero() {
+		resolvedPublishedAt = resolvedNow
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	// The status guard keeps concurrent scheduler runs from publishing the same post twice.
+	post := r.store.findPost(strings.TrimSpace(strings.ToLower(locale)), strings.TrimSpace(strings.ToLower(postID)))
+	if post == nil || post.Status != domain.AdminContentPostStatusScheduled {
+		return nil, ErrAdminContentPostNotFound
+	}
+
+	post.Status = domain.AdminContentPostStatusPublished
+	post.PublishedAt = resolvedPublishedAt
+	post.ScheduledAt = time.Time{}
+	post.UpdatedAt = resolvedNow
+	applyMemoryPostRevisionStamp(post, revisionStamp)
+
+	mapped := post.adminRecord()
+	return &mapped, nil
+}
+
+// ApplyTaxonomyRewrite rewrites post taxonomy references, records a revision per post and updates the taxonomy
+// collections. Every post is checked first, so a missing post leaves no partial merge behind.
+func (r *adminContentMemoryRepository) ApplyTaxonomyRewrite(
+	_ context.Context,
+	plan domain.AdminContentTaxonomyRewritePlan,
+	now time.Time,
+) error {
+	resolvedNow := resolveMemoryContentNow(now)
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, rewrite := range plan.Posts {
+		if r.store.findPost(
+			strings.TrimSpace(strings.ToLower(rewrite.Post.Locale)),
+			strings.TrimSpace(strings.ToLower(rewrite.Post.ID)),
+		) == nil {
+			return ErrAdminContentPostNotFound
+		}
+	}
+
+	for _, rewrite := range plan.Posts {
+		revisionNumber := max(rewrite.Post.RevisionCount, 0) + 1
+		r.store.postRevisions = append(r.store.postRevisions, buildAdminContentPostRevisionDocument(
+			rewrite.Post,
+			primitive.NewObjectID().Hex(),
+			revisionNumber,
+			resolvedNow,
+		))
+
+		_, _ = r.updatePost(rewrite.Post.Locale, rewrite.Post.ID, func(post *memoryPost) {
+			post.RevisionCount = revisionNumber
+			post.LatestRevisionAt = resolvedNow
+			post.UpdatedAt = resolvedNow
+			if rewrite.Category != nil {
+				post.Category = memoryPostCategory(rewrite.Category)
+			}
+			if rewrite.Topics != nil {
+				post.Topics, post.TopicIDs = memoryPostTopics(rewrite.Topics)
+			}
+		})
+	}
+
+	for _, category := range plan.UpsertCategories {
+		r.store.upsertCategory(category, resolvedNow)
+	}
+	for _, category := range plan.DeleteCategories {
+		r.store.deleteCategory(category.Locale, category.ID)
+	}
+	for _, topic := range plan.DeleteTopics {
+		r.store.deleteTopic(topic.Locale, topic.ID)
+	}
+
+	return nil
+}
+
+// filterPosts returns copies of the posts matching the admin post filter.
+func (r *adminContentMemoryRepository) filterPosts(filter domain.AdminContentPostFilter) []memoryPost {
+	query := buildAdminContentPostFilter(filter)
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	posts := make([]memoryPost, 0)
+	for _, post := range r.store.posts {
+		if matchMemoryFilter(post.filterDocument(), query) {
+			posts = append(posts, post.clone())
+		}
+	}
+
+	return posts
+}
+
+// memoryPostSortPublishedAt falls back to the published date when a post has no publish time, like the Mongo
+// group pipeline.
+func memoryPostSortPublishedAt(post memoryPost) time.Time {
+	if !post.PublishedAt.IsZero() {
+		return post.PublishedAt
+	}
+
+	publishedAt, err := time.Parse(time.DateOnly, strings.TrimSpace(post.PublishedDate))
+	if err != nil {
+		return time.Time{}
+	}
+	return publishedAt
+}
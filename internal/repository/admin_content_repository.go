@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"suaybsimsek.com/blog-api/internal/domain"
+
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 var (
@@ -76,6 +78,16 @@ type AdminContentRepository interface {
 		now time.Time,
 	) (*domain.AdminContentPostRecord, error)
 	DeletePostByLocaleAndID(ctx context.Context, locale, postID string) (bool, error)
+	RenamePost(ctx context.Context, sourceID, targetID string, now time.Time) (*domain.AdminContentPostRenameResult, error)
+	ListDueScheduledPosts(ctx context.Context, now time.Time, limit int) ([]domain.AdminContentPostRecord, error)
+	PublishScheduledPost(
+		ctx context.Context,
+		locale string,
+		postID string,
+		publishedAt time.Time,
+		revisionStamp *domain.AdminContentPostRevisionStamp,
+		now time.Time,
+	) (*domain.AdminContentPostRecord, error)
 	ListTopics(ctx context.Context, locale, query string) ([]domain.AdminContentTopicRecord, error)
 	ListTopicGroups(ctx context.Context, filter domain.AdminContentTaxonomyFilter) (*domain.AdminContentTopicListResult, error)
 	ListAllTopics(ctx context.Context, filter domain.AdminContentTaxonomyFilter) ([]domain.AdminContentTopicRecord, error)
@@ -84,6 +96,10 @@ type AdminContentRepository interface {
 	DeleteTopicByLocaleAndID(ctx context.Context, locale, topicID string) (bool, error)
 	SyncTopicOnPosts(ctx context.Context, record domain.AdminContentTopicRecord, now time.Time) error
 	RemoveTopicFromPosts(ctx context.Context, locale, topicID string, now time.Time) error
+	ListSeriesGroups(ctx context.Context, filter domain.AdminContentTaxonomyFilter) (*domain.AdminContentSeriesListResult, error)
+	FindSeriesByLocaleAndID(ctx context.Context, locale, seriesID string) (*domain.AdminContentSeriesRecord, error)
+	UpsertSeries(ctx context.Context, record domain.AdminContentSeriesRecord, now time.Time) (*domain.AdminContentSeriesRecord, error)
+	DeleteSeriesByLocaleAndID(ctx context.Context, locale, seriesID string) (bool, error)
 	ListCategories(ctx context.Context, locale string) ([]domain.AdminContentCategoryRecord, error)
 	ListCategoryGroups(ctx context.Context, filter domain.AdminContentTaxonomyFilter) (*domain.AdminContentCategoryListResult, error)
 	ListAllCategories(ctx context.Context, filter domain.AdminContentTaxonomyFilter) ([]domain.AdminContentCategoryRecord, error)
@@ -96,8 +112,13 @@ type AdminContentRepository interface {
 	DeleteCategoryByLocaleAndID(ctx context.Context, locale, categoryID string) (bool, error)
 	SyncCategoryOnPosts(ctx context.Context, record domain.AdminContentCategoryRecord, now time.Time) error
 	ClearCategoryFromPosts(ctx context.Context, locale, categoryID string, now time.Time) error
+	ApplyTaxonomyRewrite(ctx context.Context, plan domain.AdminContentTaxonomyRewritePlan, now time.Time) error
 }
 
-type adminContentMongoRepository struct{}
+type adminContentMongoRepository struct {
+	client *mongo.Client
+}
 
-func NewAdminContentRepository() AdminContentRepository { return &adminContentMongoRepository{} }
+func NewAdminContentRepository(client *mongo.Client) AdminContentRepository {
+	return &adminContentMongoRepository{client: client}
+}
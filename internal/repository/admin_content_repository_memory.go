@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+)
+
+type adminContentMemoryRepository struct {
+	store *MemoryStore
+}
+
+// NewAdminContentMemoryRepository returns an admin content repository backed by the given in-memory store. Renames
+// and taxonomy rewrites hold the store lock for their whole run, which stands in for the Mongo transactions.
+func NewAdminContentMemoryRepository(store *MemoryStore) AdminContentRepository {
+	return &adminContentMemoryRepository{store: store}
+}
+
+// updatePost applies the change to the stored post and returns its admin view. The caller must hold the lock.
+func (r *adminContentMemoryRepository) updatePost(
+	locale string,
+	postID string,
+	update func(post *memoryPost),
+) (*domain.AdminContentPostRecord, error) {
+	post := r.store.findPost(strings.TrimSpace(strings.ToLower(locale)), strings.TrimSpace(strings.ToLower(postID)))
+	if post == nil {
+		return nil, ErrAdminContentPostNotFound
+	}
+
+	update(post)
+	mapped := post.adminRecord()
+	return &mapped, nil
+}
+
+func applyMemoryPostRevisionStamp(post *memoryPost, revisionStamp *domain.AdminContentPostRevisionStamp) {
+	if revisionStamp != nil && revisionStamp.Number > 0 {
+		post.RevisionCount = revisionStamp.Number
+	}
+	if revisionStamp != nil && !revisionStamp.CreatedAt.IsZero() {
+		post.LatestRevisionAt = revisionStamp.CreatedAt.UTC()
+	}
+}
+
+func resolveMemoryContentNow(now time.Time) time.Time {
+	resolvedNow := now.UTC()
+	if resolvedNow.IsZero() {
+		resolvedNow = time.Now().UTC()
+	}
+	return resolvedNow
+}
+
+// memoryPostCategory builds the category embedded in posts, like buildAdminContentPostCategoryValue.
+func memoryPostCategory(category *domain.AdminContentCategoryRecord) *domain.PostCategory {
+	if category == nil {
+		return nil
+	}
+
+	return &domain.PostCategory{
+		ID:    strings.TrimSpace(strings.ToLower(category.ID)),
+		Name:  strings.TrimSpace(category.Name),
+		Color: strings.TrimSpace(strings.ToLower(category.Color)),
+		Icon:  strings.TrimSpace(category.Icon),
+	}
+}
+
+// memoryPostTopics builds the topics embedded in posts, like buildAdminContentPostTopicValues.
+func memoryPostTopics(topics []domain.AdminContentTopicRecord) ([]domain.PostTopic, []string) {
+	topicValues := make([]domain.PostTopic, 0, len(topics))
+	topicIDs := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		resolvedID := strings.TrimSpace(strings.ToLower(topic.ID))
+		if resolvedID == "" {
+			continue
+		}
+
+		resolvedTopic := domain.PostTopic{
+			ID:    resolvedID,
+			Name:  strings.TrimSpace(topic.Name),
+			Color: strings.TrimSpace(strings.ToLower(topic.Color)),
+		}
+		if link := strings.TrimSpace(topic.Link); link != "" {
+			resolvedTopic.Link = &link
+		}
+		topicValues = append(topicValues, resolvedTopic)
+		topicIDs = append(topicIDs, resolvedID)
+	}
+
+	return topicValues, topicIDs
+}
+
+// memoryPostTaxonomyFromRevision rebuilds the embedded category and topics a revision recorded, which keep only
+// their ids and names.
+func memoryPostTaxonomyFromRevision(revision domain.AdminContentPostRevisionRecord) (*domain.PostCategory, []domain.PostTopic) {
+	var category *domain.PostCategory
+	resolvedCategoryID := strings.TrimSpace(strings.ToLower(revision.CategoryID))
+	resolvedCategoryName := strings.TrimSpace(revision.CategoryName)
+	if resolvedCategoryID != "" || resolvedCategoryName != "" {
+		category = &domain.PostCategory{ID: resolvedCategoryID, Name: resolvedCategoryName}
+	}
+
+	topics := make([]domain.PostTopic, 0, len(revision.TopicIDs))
+	for index, topicID := range revision.TopicIDs {
+		resolvedID := strings.TrimSpace(strings.ToLower(topicID))
+		if resolvedID == "" {
+			continue
+		}
+		name := ""
+		if index < len(revision.TopicNames) {
+			name = strings.TrimSpace(revision.TopicNames[index])
+		}
+		topics = append(topics, domain.PostTopic{ID: resolvedID, Name: name})
+	}
+
+	return category, topics
+}
@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const adminContentScheduleMaxBatchSize = 500
+
+func (repo *adminContentMongoRepository) ListDueScheduledPosts(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]domain.AdminContentPostRecord, error) {
+	postsCollection, err := getPostContentCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
+	}
+
+	resolvedNow := now.UTC()
+	if resolvedNow.IsZero() {
+		resolvedNow = time.Now().UTC()
+	}
+	resolvedLimit := limit
+	if resolvedLimit <= 0 || resolvedLimit > adminContentScheduleMaxBatchSize {
+		resolvedLimit = adminContentScheduleMaxBatchSize
+	}
+
+	cursor, err := postsCollection.Find(
+		ctx,
+		bson.M{
+			"status":      domain.AdminContentPostStatusScheduled,
+			"scheduledAt": bson.M{"$lte": resolvedNow},
+		},
+		options.Find().
+			SetSort(bson.D{
+				{Key: "scheduledAt", Value: 1},
+				{Key: "locale", Value: 1},
+				{Key: "id", Value: 1},
+			}).
+			SetLimit(int64(resolvedLimit)).
+			SetProjection(bson.M{
+				"locale":           1,
+				"id":               1,
+				"title":            1,
+				"summary":          1,
+				"content":          1,
+				"contentMode":      1,
+				"thumbnail":        1,
+				"source":           1,
+				"publishedAt":      1,
+				"publishedDate":    1,
+				"updatedDate":      1,
+				"category":         1,
+				"topics":           1,
+				"topicIds":         1,
+				"readingTimeMin":   1,
+				"status":           1,
+				"scheduledAt":      1,
+				"contentUpdatedAt": 1,
+				"revisionCount":    1,
+				"latestRevisionAt": 1,
+				"updatedAt":        1,
+			}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	items := make([]domain.AdminContentPostRecord, 0)
+	for cursor.Next(ctx) {
+		var doc adminContentPostDocument
+		if decodeErr := cursor.Decode(&doc); decodeErr != nil {
+			return nil, decodeErr
+		}
+		items = append(items, mapAdminContentPostDocument(doc))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (repo *adminContentMongoRepository) PublishScheduledPost(
+	ctx context.Context,
+	locale string,
+	postID string,
+	publishedAt time.Time,
+	revisionStamp *domain.AdminContentPostRevisionStamp,
+	now time.Time,
+) (*domain.AdminContentPostRecord, error) {
+	postsCollection, err := getPostContentCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
+	}
+
+	resolvedNow := now.UTC()
+	if resolvedNow.IsZero() {
+		resolvedNow = time.Now().UTC()
+	}
+	resolvedPublishedAt := publishedAt.UTC()
+	if publishedAt.IsZero() {
+		resolvedPublishedAt = resolvedNow
+	}
+
+	setFields := bson.M{
+		"status":      domain.AdminContentPostStatusPublished,
+		"publishedAt": resolvedPublishedAt,
+		"scheduledAt": nil,
+		"updatedAt":   resolvedNow,
+	}
+	if revisionStamp != nil && revisionStamp.Number > 0 {
+		setFields["revisionCount"] = revisionStamp.Number
+	}
+	if revisionStamp != nil && !revisionStamp.CreatedAt.IsZero() {
+		setFields["latestRevisionAt"] = revisionStamp.CreatedAt.UTC()
+	}
+
+	// The status guard keeps concurrent scheduler runs from publishing the same post twice.
+	var updated adminContentPostDocument
+	err = postsCollection.FindOneAndUpdate(
+		ctx,
+		bson.M{
+			"locale": strings.TrimSpace(strings.ToLower(locale)),
+			"id":     strings.TrimSpace(strings.ToLower(postID)),
+			"status": domain.AdminContentPostStatusScheduled,
+		},
+		bson.M{
+			"$set": setFields,
+		},
+		options.FindOneAndUpdate().
+			SetReturnDocument(options.After).
+			SetProjection(bson.M{
+				"locale":           1,
+				"id":               1,
+				"title":            1,
+				"summary":          1,
+				"content":          1,
+				"contentMode":      1,
+				"thumbnail":        1,
+				"source":           1,
+				"publishedAt":      1,
+				"publishedDate":    1,
+				"updatedDate":      1,
+				"category":         1,
+				"topics":           1,
+				"topicIds":         1,
+				"readingTimeMin":   1,
+				"status":           1,
+				"scheduledAt":      1,
+				"contentUpdatedAt": 1,
+				"revisionCount":    1,
+				"latestRevisionAt": 1,
+				"updatedAt":        1,
+			}),
+	).Decode(&updated)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrAdminContentPostNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	mapped := mapAdminContentPostDocument(updated)
+	return &mapped, nil
+}
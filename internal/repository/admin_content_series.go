@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (repo *adminContentMongoRepository) ListSeriesGroups(
+	ctx context.Context,
+	filter domain.AdminContentTaxonomyFilter,
+) (*domain.AdminContentSeriesListResult, error) {
+	seriesCollection, err := getPostSeriesCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
+	}
+
+	resolvedPreferredLocale := strings.TrimSpace(strings.ToLower(filter.PreferredLocale))
+	if resolvedPreferredLocale == "" {
+		resolvedPreferredLocale = adminContentLocaleEN
+	}
+
+	basePipeline := buildAdminContentSeriesGroupPipeline(filter)
+	total, err := aggregateAdminContentTotal(ctx, seriesCollection, basePipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvedPage, resolvedSize, skip := resolveAdminContentPagination(filter.Page, filter.Size, total)
+	if total == 0 {
+		return &domain.AdminContentSeriesListResult{
+			Items: []domain.AdminContentSeriesGroupRecord{},
+			Total: 0,
+			Page:  resolvedPage,
+			Size:  resolvedSize,
+		}, nil
+	}
+
+	itemsPipeline := append(mongo.Pipeline{}, basePipeline...)
+	itemsPipeline = append(itemsPipeline,
+		bson.D{{Key: "$skip", Value: skip}},
+		bson.D{{Key: "$limit", Value: resolvedSize}},
+	)
+
+	cursor, err := seriesCollection.Aggregate(ctx, itemsPipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	items := make([]domain.AdminContentSeriesGroupRecord, 0, resolvedSize)
+	for cursor.Next(ctx) {
+		var doc adminContentSeriesGroupAggregateDocument
+		if decodeErr := cursor.Decode(&doc); decodeErr != nil {
+			return nil, decodeErr
+		}
+
+		item, ok := mapAdminContentSeriesGroupAggregateDocument(doc, resolvedPreferredLocale)
+		if !ok {
+			continue
+		}
+		items = append(items, item)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return &domain.AdminContentSeriesListResult{
+		Items: items,
+		Total: total,
+		Page:  resolvedPage,
+		Size:  resolvedSize,
+	}, nil
+}
+
+func (repo *adminContentMongoRepository) FindSeriesByLocaleAndID(
+	ctx context.Context,
+	locale string,
+	seriesID string,
+) (*domain.AdminContentSeriesRecord, error) {
+	seriesCollection, err := getPostSeriesCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
+	}
+
+	var doc postSeriesDocument
+	err = seriesCollection.FindOne(ctx, bson.M{
+		"locale": strings.TrimSpace(strings.ToLower(locale)),
+		"id":     strings.TrimSpace(strings.ToLower(seriesID)),
+	}).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	record := mapAdminContentSeriesDocument(doc)
+	return &record, nil
+}
+
+func (repo *adminContentMongoRepository) UpsertSeries(
+	ctx context.Context,
+	record domain.AdminContentSeriesRecord,
+	now time.Time,
+) (*domain.AdminContentSeriesRecord, error) {
+	seriesCollection, err := getPostSeriesCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
+	}
+
+	resolvedNow := now.UTC()
+	if resolvedNow.IsZero() {
+		resolvedNow = time.Now().UTC()
+	}
+
+	update := bson.M{
+		"locale":      strings.TrimSpace(strings.ToLower(record.Locale)),
+		"id":          strings.TrimSpace(strings.ToLower(record.ID)),
+		"name":        strings.TrimSpace(record.Name),
+		"description": strings.TrimSpace(record.Description),
+		"postIds":     normalizePostSeriesPostIDs(record.PostIDs),
+		"updatedAt":   resolvedNow,
+	}
+
+	_, err = seriesCollection.UpdateOne(
+		ctx,
+		bson.M{
+			"locale": update["locale"],
+			"id":     update["id"],
+		},
+		bson.M{
+			"$set": update,
+			"$setOnInsert": bson.M{
+				"createdAt": resolvedNow,
+			},
+		},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return repo.FindSeriesByLocaleAndID(
+		ctx,
+		record.Locale,
+		record.ID,
+	)
+}
+
+func (repo *adminContentMongoRepository) DeleteSeriesByLocaleAndID(ctx context.Context, locale, seriesID string) (bool, error) {
+	seriesCollection, err := getPostSeriesCollection(repo.client)
+	if err != nil {
+		return false, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
+	}
+
+	result, err := seriesCollection.DeleteOne(ctx, bson.M{
+		"locale": strings.TrimSpace(strings.ToLower(locale)),
+		"id":     strings.TrimSpace(strings.ToLower(seriesID)),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return result.DeletedCount > 0, nil
+}
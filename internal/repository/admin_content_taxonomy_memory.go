@@ -0,0 +1,654 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (r *adminContentMemoryRepository) ListTopics(
+	_ context.Context,
+	locale string,
+	query string,
+) ([]domain.AdminContentTopicRecord, error) {
+	resolvedLocale := strings.TrimSpace(strings.ToLower(locale))
+	resolvedSearchQuery := strings.TrimSpace(query)
+
+	return r.filterTopics(func(topic domain.AdminContentTopicRecord) bool {
+		return (resolvedLocale == "" || topic.Locale == resolvedLocale) &&
+			(resolvedSearchQuery == "" || memoryContainsFold(topic.Name, resolvedSearchQuery))
+	}), nil
+}
+
+func (r *adminContentMemoryRepository) ListAllTopics(
+	_ context.Context,
+	filter domain.AdminContentTaxonomyFilter,
+) ([]domain.AdminContentTopicRecord, error) {
+	query := buildAdminContentTopicFilter(filter)
+
+	return r.filterTopics(func(topic domain.AdminContentTopicRecord) bool {
+		return matchMemoryFilter(bson.M{"locale": topic.Locale, "id": topic.ID, "name": topic.Name}, query)
+	}), nil
+}
+
+func (r *adminContentMemoryRepository) ListTopicGroups(
+	ctx context.Context,
+	filter domain.AdminContentTaxonomyFilter,
+) (*domain.AdminContentTopicListResult, error) {
+	resolvedPreferredLocale := resolveMemoryTaxonomyPreferredLocale(filter.PreferredLocale)
+	topics, err := r.ListAllTopics(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := groupMemoryTaxonomy(topics, filter.PreferredLocale, func(topic domain.AdminContentTopicRecord) (string, string, string) {
+		return topic.Locale, topic.ID, topic.Name
+	})
+
+	total := len(groups)
+	resolvedPage, resolvedSize, skip := resolveAdminContentPagination(filter.Page, filter.Size, total)
+	items := make([]domain.AdminContentTopicGroupRecord, 0, resolvedSize)
+	for _, group := range memoryWindow(groups, skip, resolvedSize) {
+		document := adminContentTopicGroupAggregateDocument{ID: group[0].ID}
+		for _, topic := range group {
+			document.Variants = append(document.Variants, adminContentTopicAggregateVariantDocument{
+				Locale:    topic.Locale,
+				ID:        topic.ID,
+				Name:      topic.Name,
+				Color:     topic.Color,
+				Link:      topic.Link,
+				UpdatedAt: topic.UpdatedAt,
+			})
+		}
+		if item, ok := mapAdminContentTopicGroupAggregateDocument(document, resolvedPreferredLocale); ok {
+			items = append(items, item)
+		}
+	}
+
+	return &domain.AdminContentTopicListResult{
+		Items: items,
+		Total: total,
+		Page:  resolvedPage,
+		Size:  resolvedSize,
+	}, nil
+}
+
+func (r *adminContentMemoryRepository) FindTopicByLocaleAndID(
+	_ context.Context,
+	locale string,
+	topicID string,
+) (*domain.AdminContentTopicRecord, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return r.store.findTopic(locale, topicID), nil
+}
+
+func (r *adminContentMemoryRepository) UpsertTopic(
+	_ context.Context,
+	record domain.AdminContentTopicRecord,
+	now time.Time,
+) (*domain.AdminContentTopicRecord, error) {
+	resolvedNow := resolveMemoryContentNow(now)
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.upsertTopic(record, resolvedNow)
+	return r.store.findTopic(record.Locale, record.ID), nil
+}
+
+func (r *adminContentMemoryRepository) DeleteTopicByLocaleAndID(_ context.Context, locale, topicID string) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return r.store.deleteTopic(locale, topicID), nil
+}
+
+func (r *adminContentMemoryRepository) SyncTopicOnPosts(
+	_ context.Context,
+	record domain.AdminContentTopicRecord,
+	now time.Time,
+) error {
+	resolvedLocale := strings.TrimSpace(strings.ToLower(record.Locale))
+	resolvedTopicID := strings.TrimSpace(strings.ToLower(record.ID))
+	if resolvedLocale == "" || resolvedTopicID == "" {
+		return nil
+	}
+
+	var link *string
+	if resolvedLink := strings.TrimSpace(record.Link); resolvedLink != "" {
+		link = &resolvedLink
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for index := range r.store.posts {
+		post := &r.store.posts[index]
+		if post.Locale != resolvedLocale || !slices.Contains(post.TopicIDs, resolvedTopicID) {
+			continue
+		}
+
+		post.Topics = slices.Clone(post.Topics)
+		for topicIndex := range post.Topics {
+			if post.Topics[topicIndex].ID != resolvedTopicID {
+				continue
+			}
+			post.Topics[topicIndex].Name = strings.TrimSpace(record.Name)
+			post.Topics[topicIndex].Color = strings.TrimSpace(strings.ToLower(record.Color))
+			post.Topics[topicIndex].Link = cloneMemoryValue(link)
+		}
+		post.UpdatedAt = now.UTC()
+	}
+
+	return nil
+}
+
+func (r *adminContentMemoryRepository) RemoveTopicFromPosts(
+	_ context.Context,
+	locale string,
+	topicID string,
+	now time.Time,
+) error {
+	resolvedLocale := strings.TrimSpace(strings.ToLower(locale))
+	resolvedTopicID := strings.TrimSpace(strings.ToLower(topicID))
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for index := range r.store.posts {
+		post := &r.store.posts[index]
+		if post.Locale != resolvedLocale || !slices.Contains(post.TopicIDs, resolvedTopicID) {
+			continue
+		}
+
+		post.Topics = slices.DeleteFunc(slices.Clone(post.Topics), func(topic domain.PostTopic) bool {
+			return topic.ID == resolvedTopicID
+		})
+		post.TopicIDs = slices.DeleteFunc(slices.Clone(post.TopicIDs), func(id string) bool {
+			return id == resolvedTopicID
+		})
+		post.UpdatedAt = now.UTC()
+	}
+
+	return nil
+}
+
+func (r *adminContentMemoryRepository) ListSeriesGroups(
+	_ context.Context,
+	filter domain.AdminContentTaxonomyFilter,
+) (*domain.AdminContentSeriesListResult, error) {
+	resolvedPreferredLocale := resolveMemoryTaxonomyPreferredLocale(filter.PreferredLocale)
+	query := buildAdminContentSeriesFilter(filter)
+
+	r.store.mu.RLock()
+	series := make([]postSeriesDocument, 0)
+	for _, document := range r.store.postSeries {
+		if matchMemoryFilter(bson.M{
+			"locale":      document.Locale,
+			"id":          document.ID,
+			"name":        document.Name,
+			"description": document.Description,
+		}, query) {
+			document.PostIDs = slices.Clone(document.PostIDs)
+			series = append(series, document)
+		}
+	}
+	r.store.mu.RUnlock()
+
+	groups := groupMemoryTaxonomy(series, filter.PreferredLocale, func(document postSeriesDocument) (string, string, string) {
+		return document.Locale, document.ID, document.Name
+	})
+
+	total := len(groups)
+	resolvedPage, resolvedSize, skip := resolveAdminContentPagination(filter.Page, filter.Size, total)
+	items := make([]domain.AdminContentSeriesGroupRecord, 0, resolvedSize)
+	for _, group := range memoryWindow(groups, skip, resolvedSize) {
+		aggregate := adminContentSeriesGroupAggregateDocument{ID: group[0].ID}
+		for _, document := range group {
+			aggregate.Variants = append(aggregate.Variants, adminContentSeriesAggregateVariantDocument(document))
+		}
+		if item, ok := mapAdminContentSeriesGroupAggregateDocument(aggregate, resolvedPreferredLocale); ok {
+			items = append(items, item)
+		}
+	}
+
+	return &domain.AdminContentSeriesListResult{
+		Items: items,
+		Total: total,
+		Page:  resolvedPage,
+		Size:  resolvedSize,
+	}, nil
+}
+
+func (r *adminContentMemoryRepository) FindSeriesByLocaleAndID(
+	_ context.Context,
+	locale string,
+	seriesID string,
+) (*domain.AdminContentSeriesRecord, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return r.store.findSeries(locale, seriesID), nil
+}
+
+func (r *adminContentMemoryRepository) UpsertSeries(
+	_ context.Context,
+	record domain.AdminContentSeriesRecord,
+	now time.Time,
+) (*domain.AdminContentSeriesRecord, error) {
+	document := postSeriesDocument{
+		Locale:      strings.TrimSpace(strings.ToLower(record.Locale)),
+		ID:          strings.TrimSpace(strings.ToLower(record.ID)),
+		Name:        strings.TrimSpace(record.Name),
+		Description: strings.TrimSpace(record.Description),
+		PostIDs:     normalizePostSeriesPostIDs(record.PostIDs),
+		UpdatedAt:   resolveMemoryContentNow(now),
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	index := slices.IndexFunc(r.store.postSeries, func(existing postSeriesDocument) bool {
+		return existing.Locale == document.Locale && existing.ID == document.ID
+	})
+	if index < 0 {
+		r.store.postSeries = append(r.store.postSeries, document)
+	} else {
+		r.store.postSeries[index] = document
+	}
+
+	return r.store.findSeries(record.Locale, record.ID), nil
+}
+
+func (r *adminContentMemoryRepository) DeleteSeriesByLocaleAndID(_ context.Context, locale, seriesID string) (bool, error) {
+	resolvedLocale := strings.TrimSpace(strings.ToLower(locale))
+	resolvedSeriesID := strings.TrimSpace(strings.ToLower(seriesID))
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	count := len(r.store.postSeries)
+	r.store.postSeries = slices.DeleteFunc(r.store.postSeries, func(document postSeriesDocument) bool {
+		return document.Locale == resolvedLocale && document.ID == resolvedSeriesID
+	})
+
+	return len(r.store.postSeries) < count, nil
+}
+
+func (r *adminContentMemoryRepository) ListCategories(
+	_ context.Context,
+	locale string,
+) ([]domain.AdminContentCategoryRecord, error) {
+	resolvedLocale := strings.TrimSpace(strings.ToLower(locale))
+
+	return r.filterCategories(func(category domain.AdminContentCategoryRecord) bool {
+		return resolvedLocale == "" || category.Locale == resolvedLocale
+	}), nil
+}
+
+func (r *adminContentMemoryRepository) ListAllCategories(
+	_ context.Context,
+	filter domain.AdminContentTaxonomyFilter,
+) ([]domain.AdminContentCategoryRecord, error) {
+	query := buildAdminContentCategoryFilter(filter)
+
+	return r.filterCategories(func(category domain.AdminContentCategoryRecord) bool {
+		return matchMemoryFilter(bson.M{
+			"locale": category.Locale,
+			"id":     category.ID,
+			"name":   category.Name,
+			"icon":   category.Icon,
+		}, query)
+	}), nil
+}
+
+func (r *adminContentMemoryRepository) ListCategoryGroups(
+	ctx context.Context,
+	filter domain.AdminContentTaxonomyFilter,
+) (*domain.AdminContentCategoryListResult, error) {
+	resolvedPreferredLocale := resolveMemoryTaxonomyPreferredLocale(filter.PreferredLocale)
+	categories, err := r.ListAllCategories(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := groupMemoryTaxonomy(categories, filter.PreferredLocale, func(category domain.AdminContentCategoryRecord) (string, string, string) {
+		return category.Locale, category.ID, category.Name
+	})
+
+	total := len(groups)
+	resolvedPage, resolvedSize, skip := resolveAdminContentPagination(filter.Page, filter.Size, total)
+	items := make([]domain.AdminContentCategoryGroupRecord, 0, resolvedSize)
+	for _, group := range memoryWindow(groups, skip, resolvedSize) {
+		document := adminContentCategoryGroupAggregateDocument{ID: group[0].ID}
+		for _, category := range group {
+			document.Variants = append(document.Variants, adminContentCategoryAggregateVariantDocument(category))
+		}
+		if item, ok := mapAdminContentCategoryGroupAggregateDocument(document, resolvedPreferredLocale); ok {
+			items = append(items, item)
+		}
+	}
+
+	return &domain.AdminContentCategoryListResult{
+		Items: items,
+		Total: total,
+		Page:  resolvedPage,
+		Size:  resolvedSize,
+	}, nil
+}
+
+func (r *adminContentMemoryRepository) FindCategoryByLocaleAndID(
+	_ context.Context,
+	locale string,
+	categoryID string,
+) (*domain.AdminContentCategoryRecord, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return r.store.findCategory(locale, categoryID), nil
+}
+
+func (r *adminContentMemoryRepository) UpsertCategory(
+	_ context.Context,
+	record domain.AdminContentCategoryRecord,
+	now time.Time,
+) (*domain.AdminContentCategoryRecord, error) {
+	resolvedNow := resolveMemoryContentNow(now)
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.upsertCategory(record, resolvedNow)
+	return r.store.findCategory(record.Locale, record.ID), nil
+}
+
+func (r *adminContentMemoryRepository) DeleteCategoryByLocaleAndID(
+	_ context.Context,
+	locale string,
+	categoryID string,
+) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return r.store.deleteCategory(locale, categoryID), nil
+}
+
+func (r *adminContentMemoryRepository) SyncCategoryOnPosts(
+	_ context.Context,
+	record domain.AdminContentCategoryRecord,
+	now time.Time,
+) error {
+	resolvedLocale := strings.TrimSpace(strings.ToLower(record.Locale))
+	resolvedCategoryID := strings.TrimSpace(strings.ToLower(record.ID))
+	if resolvedLocale == "" || resolvedCategoryID == "" {
+		return nil
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for index := range r.store.posts {
+		post := &r.store.posts[index]
+		if post.Locale != resolvedLocale || post.Category == nil || post.Category.ID != resolvedCategoryID {
+			continue
+		}
+
+		post.Category = memoryPostCategory(&record)
+		post.UpdatedAt = now.UTC()
+	}
+
+	return nil
+}
+
+func (r *adminContentMemoryRepository) ClearCategoryFromPosts(
+	_ context.Context,
+	locale string,
+	categoryID string,
+	now time.Time,
+) error {
+	resolvedLocale := strings.TrimSpace(strings.ToLower(locale))
+	resolvedCategoryID := strings.TrimSpace(strings.ToLower(categoryID))
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for index := range r.store.posts {
+		post := &r.store.posts[index]
+		if post.Locale != resolvedLocale || post.Category == nil || post.Category.ID != resolvedCategoryID {
+			continue
+		}
+
+		post.Category = nil
+		post.UpdatedAt = now.UTC()
+	}
+
+	return nil
+}
+
+func (r *adminContentMemoryRepository) filterTopics(
+	match func(domain.AdminContentTopicRecord) bool,
+) []domain.AdminContentTopicRecord {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	items := make([]domain.AdminContentTopicRecord, 0)
+	for _, topic := range r.store.topics {
+		if topic.Locale != "" && topic.ID != "" && topic.Name != "" && match(topic) {
+			items = append(items, topic)
+		}
+	}
+	slices.SortStableFunc(items, func(left, right domain.AdminContentTopicRecord) int {
+		return cmp.Or(
+			strings.Compare(left.Locale, right.Locale),
+			strings.Compare(left.Name, right.Name),
+			strings.Compare(left.ID, right.ID),
+		)
+	})
+
+	return items
+}
+
+func (r *adminContentMemoryRepository) filterCategories(
+	match func(domain.AdminContentCategoryRecord) bool,
+) []domain.AdminContentCategoryRecord {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	items := make([]domain.AdminContentCategoryRecord, 0)
+	for _, category := range r.store.categories {
+		if category.Locale != "" && category.ID != "" && category.Name != "" && match(category) {
+			items = append(items, category)
+		}
+	}
+	slices.SortStableFunc(items, func(left, right domain.AdminContentCategoryRecord) int {
+		return cmp.Or(
+			strings.Compare(left.Locale, right.Locale),
+			strings.Compare(left.Name, right.Name),
+			strings.Compare(left.ID, right.ID),
+		)
+	})
+
+	return items
+}
+
+// findTopic returns a copy of the stored topic. The caller must hold the lock.
+func (s *MemoryStore) findTopic(locale, topicID string) *domain.AdminContentTopicRecord {
+	resolvedLocale := strings.TrimSpace(strings.ToLower(locale))
+	resolvedTopicID := strings.TrimSpace(strings.ToLower(topicID))
+	for _, topic := range s.topics {
+		if topic.Locale == resolvedLocale && topic.ID == resolvedTopicID {
+			return &topic
+		}
+	}
+
+	return nil
+}
+
+// upsertTopic keeps a stored link when the record has none, as the Mongo $set does. The caller must hold the lock.
+func (s *MemoryStore) upsertTopic(record domain.AdminContentTopicRecord, now time.Time) {
+	topic := domain.AdminContentTopicRecord{
+		Locale:    strings.TrimSpace(strings.ToLower(record.Locale)),
+		ID:        strings.TrimSpace(strings.ToLower(record.ID)),
+		Name:      strings.TrimSpace(record.Name),
+		Color:     strings.TrimSpace(strings.ToLower(record.Color)),
+		Link:      strings.TrimSpace(record.Link),
+		UpdatedAt: now,
+	}
+
+	index := slices.IndexFunc(s.topics, func(existing domain.AdminContentTopicRecord) bool {
+		return existing.Locale == topic.Locale && existing.ID == topic.ID
+	})
+	if index < 0 {
+		s.topics = append(s.topics, topic)
+		return
+	}
+	if topic.Link == "" {
+		topic.Link = s.topics[index].Link
+	}
+	s.topics[index] = topic
+}
+
+// deleteTopic removes the stored topic. The caller must hold the lock.
+func (s *MemoryStore) deleteTopic(locale, topicID string) bool {
+	resolvedLocale := strings.TrimSpace(strings.ToLower(locale))
+	resolvedTopicID := strings.TrimSpace(strings.ToLower(topicID))
+
+	count := len(s.topics)
+	s.topics = slices.DeleteFunc(s.topics, func(topic domain.AdminContentTopicRecord) bool {
+		return topic.Locale == resolvedLocale && topic.ID == resolvedTopicID
+	})
+
+	return len(s.topics) < count
+}
+
+// findSeries returns a copy of the stored series. The caller must hold the lock.
+func (s *MemoryStore) findSeries(locale, seriesID string) *domain.AdminContentSeriesRecord {
+	resolvedLocale := strings.TrimSpace(strings.ToLower(locale))
+	resolvedSeriesID := strings.TrimSpace(strings.ToLower(seriesID))
+	for _, document := range s.postSeries {
+		if document.Locale == resolvedLocale && document.ID == resolvedSeriesID {
+			record := mapAdminContentSeriesDocument(document)
+			record.PostIDs = slices.Clone(record.PostIDs)
+			return &record
+		}
+	}
+
+	return nil
+}
+
+// findCategory returns a copy of the stored category. The caller must hold the lock.
+func (s *MemoryStore) findCategory(locale, categoryID string) *domain.AdminContentCategoryRecord {
+	resolvedLocale := strings.TrimSpace(strings.ToLower(locale))
+	resolvedCategoryID := strings.TrimSpace(strings.ToLower(categoryID))
+	for _, category := range s.categories {
+		if category.Locale == resolvedLocale && category.ID == resolvedCategoryID {
+			return &category
+		}
+	}
+
+	return nil
+}
+
+// upsertCategory keeps a stored icon or link when the record has none, as the Mongo $set does. The caller must
+// hold the lock.
+func (s *MemoryStore) upsertCategory(record domain.AdminContentCategoryRecord, now time.Time) {
+	category := domain.AdminContentCategoryRecord{
+		Locale:    strings.TrimSpace(strings.ToLower(record.Locale)),
+		ID:        strings.TrimSpace(strings.ToLower(record.ID)),
+		Name:      strings.TrimSpace(record.Name),
+		Color:     strings.TrimSpace(strings.ToLower(record.Color)),
+		Icon:      strings.TrimSpace(record.Icon),
+		Link:      strings.TrimSpace(record.Link),
+		UpdatedAt: now,
+	}
+
+	index := slices.IndexFunc(s.categories, func(existing domain.AdminContentCategoryRecord) bool {
+		return existing.Locale == category.Locale && existing.ID == category.ID
+	})
+	if index < 0 {
+		s.categories = append(s.categories, category)
+		return
+	}
+	if category.Icon == "" {
+		category.Icon = s.categories[index].Icon
+	}
+	if category.Link == "" {
+		category.Link = s.categories[index].Link
+	}
+	s.categories[index] = category
+}
+
+// deleteCategory removes the stored category. The caller must hold the lock.
+func (s *MemoryStore) deleteCategory(locale, categoryID string) bool {
+	resolvedLocale := strings.TrimSpace(strings.ToLower(locale))
+	resolvedCategoryID := strings.TrimSpace(strings.ToLower(categoryID))
+
+	count := len(s.categories)
+	s.categories = slices.DeleteFunc(s.categories, func(category domain.AdminContentCategoryRecord) bool {
+		return category.Locale == resolvedLocale && category.ID == resolvedCategoryID
+	})
+
+	return len(s.categories) < count
+}
+
+func resolveMemoryTaxonomyPreferredLocale(preferredLocale string) string {
+	resolvedPreferredLocale := strings.TrimSpace(strings.ToLower(preferredLocale))
+	if resolvedPreferredLocale == "" {
+		return adminContentLocaleEN
+	}
+	return resolvedPreferredLocale
+}
+
+// groupMemoryTaxonomy groups locale variants by id and orders the groups by the name in the preferred locale,
+// falling back to the other locale, like the Mongo group pipelines.
+func groupMemoryTaxonomy[T any](
+	items []T,
+	preferredLocale string,
+	key func(T) (locale string, id string, name string),
+) [][]T {
+	groups := make([][]T, 0)
+	groupIndexes := make(map[string]int)
+	for _, item := range items {
+		_, id, _ := key(item)
+		index, exists := groupIndexes[id]
+		if !exists {
+			index = len(groups)
+			groupIndexes[id] = index
+			groups = append(groups, nil)
+		}
+		groups[index] = append(groups[index], item)
+	}
+
+	sortName := func(group []T) string {
+		names := map[string]string{}
+		for _, item := range group {
+			locale, _, name := key(item)
+			names[locale] = max(names[locale], name)
+		}
+		if strings.TrimSpace(strings.ToLower(preferredLocale)) == adminContentLocaleTR {
+			return cmp.Or(names[adminContentLocaleTR], names[adminContentLocaleEN])
+		}
+		return cmp.Or(names[adminContentLocaleEN], names[adminContentLocaleTR])
+	}
+	groupID := func(group []T) string {
+		_, id, _ := key(group[0])
+		return id
+	}
+
+	slices.SortStableFunc(groups, func(left, right []T) int {
+		return cmp.Or(
+			strings.Compare(sortName(left), sortName(right)),
+			strings.Compare(groupID(left), groupID(right)),
+		)
+	})
+
+	return groups
+}
@@ -0,0 +1,197 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ApplyTaxonomyRewrite rewrites post taxonomy references, records a revision per post and
+// updates the taxonomy collections in a single transaction so a failure leaves no partial merge.
+func (repo *adminContentMongoRepository) ApplyTaxonomyRewrite(
+	ctx context.Context,
+	plan domain.AdminContentTaxonomyRewritePlan,
+	now time.Time,
+) error {
+	postsCollection, err := getPostContentCollection(repo.client)
+	if err != nil {
+		return fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
+	}
+	revisionsCollection, err := getPostRevisionsCollection(repo.client)
+	if err != nil {
+		return fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
+	}
+	topicsCollection, err := getPostTopicsCollection(repo.client)
+	if err != nil {
+		return fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
+	}
+	categoriesCollection, err := getPostCategoriesCollection(repo.client)
+	if err != nil {
+		return fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
+	}
+
+	resolvedNow := now.UTC()
+	if resolvedNow.IsZero() {
+		resolvedNow = time.Now().UTC()
+	}
+
+	session, err := repo.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessionContext mongo.SessionContext) (any, error) {
+		for _, rewrite := range plan.Posts {
+			if err := applyAdminContentPostTaxonomyRewrite(
+				sessionContext,
+				postsCollection,
+				revisionsCollection,
+				rewrite,
+				resolvedNow,
+			); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, category := range plan.UpsertCategories {
+			update := bson.M{
+				"locale":    strings.TrimSpace(strings.ToLower(category.Locale)),
+				"id":        strings.TrimSpace(strings.ToLower(category.ID)),
+				"name":      strings.TrimSpace(category.Name),
+				"color":     strings.TrimSpace(strings.ToLower(category.Color)),
+				"updatedAt": resolvedNow,
+			}
+			if icon := strings.TrimSpace(category.Icon); icon != "" {
+				update["icon"] = icon
+			}
+			if link := strings.TrimSpace(category.Link); link != "" {
+				update["link"] = link
+			}
+			if _, err := categoriesCollection.UpdateOne(
+				sessionContext,
+				bson.M{"locale": update["locale"], "id": update["id"]},
+				bson.M{"$set": update, "$setOnInsert": bson.M{"createdAt": resolvedNow}},
+				options.Update().SetUpsert(true),
+			); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, category := range plan.DeleteCategories {
+			if _, err := categoriesCollection.DeleteOne(sessionContext, bson.M{
+				"locale": strings.TrimSpace(strings.ToLower(category.Locale)),
+				"id":     strings.TrimSpace(strings.ToLower(category.ID)),
+			}); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, topic := range plan.DeleteTopics {
+			if _, err := topicsCollection.DeleteOne(sessionContext, bson.M{
+				"locale": strings.TrimSpace(strings.ToLower(topic.Locale)),
+				"id":     strings.TrimSpace(strings.ToLower(topic.ID)),
+			}); err != nil {
+				return nil, err
+			}
+		}
+
+		return nil, nil
+	})
+	return err
+}
+
+func applyAdminContentPostTaxonomyRewrite(
+	ctx context.Context,
+	postsCollection *mongo.Collection,
+	revisionsCollection *mongo.Collection,
+	rewrite domain.AdminContentPostTaxonomyRewrite,
+	now time.Time,
+) error {
+	revisionNumber := max(rewrite.Post.RevisionCount, 0) + 1
+	revision := buildAdminContentPostRevisionDocument(
+		rewrite.Post,
+		primitive.NewObjectID().Hex(),
+		revisionNumber,
+		now,
+	)
+	if _, err := revisionsCollection.InsertOne(ctx, revision); err != nil {
+		return err
+	}
+
+	setFields := bson.M{
+		"revisionCount":    revisionNumber,
+		"latestRevisionAt": now,
+		"updatedAt":        now,
+	}
+	if rewrite.Category != nil {
+		setFields["category"] = buildAdminContentPostCategoryValue(rewrite.Category)
+	}
+	if rewrite.Topics != nil {
+		topicValues, topicIDs := buildAdminContentPostTopicValues(rewrite.Topics)
+		setFields["topics"] = topicValues
+		setFields["topicIds"] = topicIDs
+	}
+
+	result, err := postsCollection.UpdateOne(
+		ctx,
+		bson.M{
+			"locale": strings.TrimSpace(strings.ToLower(rewrite.Post.Locale)),
+			"id":     strings.TrimSpace(strings.ToLower(rewrite.Post.ID)),
+		},
+		bson.M{"$set": setFields},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrAdminContentPostNotFound
+	}
+	return nil
+}
+
+func buildAdminContentPostCategoryValue(category *domain.AdminContentCategoryRecord) any {
+	if category == nil {
+		return nil
+	}
+
+	categoryDocument := bson.M{
+		"id":    strings.TrimSpace(strings.ToLower(category.ID)),
+		"name":  strings.TrimSpace(category.Name),
+		"color": strings.TrimSpace(strings.ToLower(category.Color)),
+	}
+	if icon := strings.TrimSpace(category.Icon); icon != "" {
+		categoryDocument["icon"] = icon
+	}
+	return categoryDocument
+}
+
+func buildAdminContentPostTopicValues(topics []domain.AdminContentTopicRecord) ([]bson.M, []string) {
+	topicValues := make([]bson.M, 0, len(topics))
+	topicIDs := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		resolvedID := strings.TrimSpace(strings.ToLower(topic.ID))
+		if resolvedID == "" {
+			continue
+		}
+		resolvedTopic := bson.M{
+			"id":    resolvedID,
+			"name":  strings.TrimSpace(topic.Name),
+			"color": strings.TrimSpace(strings.ToLower(topic.Color)),
+		}
+		if link := strings.TrimSpace(topic.Link); link != "" {
+			resolvedTopic["link"] = link
+		}
+		topicValues = append(topicValues, resolvedTopic)
+		topicIDs = append(topicIDs, resolvedID)
+	}
+	return topicValues, topicIDs
+}
@@ -16,8 +16,8 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-func (*adminContentMongoRepository) ListTopics(ctx context.Context, locale, query string) ([]domain.AdminContentTopicRecord, error) {
-	topicsCollection, err := getPostTopicsCollection()
+func (repo *adminContentMongoRepository) ListTopics(ctx context.Context, locale, query string) ([]domain.AdminContentTopicRecord, error) {
+	topicsCollection, err := getPostTopicsCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
 	}
@@ -88,11 +88,11 @@ func (*adminContentMongoRepository) ListTopics(ctx context.Context, locale, quer
 	return items, nil
 }
 
-func (*adminContentMongoRepository) ListAllTopics(
+func (repo *adminContentMongoRepository) ListAllTopics(
 	ctx context.Context,
 	filter domain.AdminContentTaxonomyFilter,
 ) ([]domain.AdminContentTopicRecord, error) {
-	topicsCollection, err := getPostTopicsCollection()
+	topicsCollection, err := getPostTopicsCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
 	}
@@ -158,11 +158,11 @@ func (*adminContentMongoRepository) ListAllTopics(
 	return items, nil
 }
 
-func (*adminContentMongoRepository) ListTopicGroups(
+func (repo *adminContentMongoRepository) ListTopicGroups(
 	ctx context.Context,
 	filter domain.AdminContentTaxonomyFilter,
 ) (*domain.AdminContentTopicListResult, error) {
-	topicsCollection, err := getPostTopicsCollection()
+	topicsCollection, err := getPostTopicsCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
 	}
@@ -227,12 +227,12 @@ func (*adminContentMongoRepository) ListTopicGroups(
 	}, nil
 }
 
-func (*adminContentMongoRepository) FindTopicByLocaleAndID(
+func (repo *adminContentMongoRepository) FindTopicByLocaleAndID(
 	ctx context.Context,
 	locale string,
 	topicID string,
 ) (*domain.AdminContentTopicRecord, error) {
-	topicsCollection, err := getPostTopicsCollection()
+	topicsCollection, err := getPostTopicsCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
 	}
@@ -266,12 +266,12 @@ func (*adminContentMongoRepository) FindTopicByLocaleAndID(
 	}, nil
 }
 
-func (*adminContentMongoRepository) UpsertTopic(
+func (repo *adminContentMongoRepository) UpsertTopic(
 	ctx context.Context,
 	record domain.AdminContentTopicRecord,
 	now time.Time,
 ) (*domain.AdminContentTopicRecord, error) {
-	topicsCollection, err := getPostTopicsCollection()
+	topicsCollection, err := getPostTopicsCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
 	}
@@ -310,15 +310,15 @@ func (*adminContentMongoRepository) UpsertTopic(
 		return nil, err
 	}
 
-	return (&adminContentMongoRepository{}).FindTopicByLocaleAndID(
+	return repo.FindTopicByLocaleAndID(
 		ctx,
 		record.Locale,
 		record.ID,
 	)
 }
 
-func (*adminContentMongoRepository) DeleteTopicByLocaleAndID(ctx context.Context, locale, topicID string) (bool, error) {
-	topicsCollection, err := getPostTopicsCollection()
+func (repo *adminContentMongoRepository) DeleteTopicByLocaleAndID(ctx context.Context, locale, topicID string) (bool, error) {
+	topicsCollection, err := getPostTopicsCollection(repo.client)
 	if err != nil {
 		return false, fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
 	}
@@ -334,12 +334,12 @@ func (*adminContentMongoRepository) DeleteTopicByLocaleAndID(ctx context.Context
 	return result.DeletedCount > 0, nil
 }
 
-func (*adminContentMongoRepository) SyncTopicOnPosts(
+func (repo *adminContentMongoRepository) SyncTopicOnPosts(
 	ctx context.Context,
 	record domain.AdminContentTopicRecord,
 	now time.Time,
 ) error {
-	postsCollection, err := getPostContentCollection()
+	postsCollection, err := getPostContentCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
 	}
@@ -379,13 +379,13 @@ func (*adminContentMongoRepository) SyncTopicOnPosts(
 	return err
 }
 
-func (*adminContentMongoRepository) RemoveTopicFromPosts(
+func (repo *adminContentMongoRepository) RemoveTopicFromPosts(
 	ctx context.Context,
 	locale string,
 	topicID string,
 	now time.Time,
 ) error {
-	postsCollection, err := getPostContentCollection()
+	postsCollection, err := getPostContentCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(adminContentRepositoryUnavailableFormat, ErrAdminContentRepositoryUnavailable, err)
 	}
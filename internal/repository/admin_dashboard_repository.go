@@ -27,14 +27,16 @@ type AdminDashboardRepository interface {
 	BuildContentHealthSummary(ctx context.Context) (domain.AdminDashboardContentHealth, error)
 }
 
-type adminDashboardMongoRepository struct{}
+type adminDashboardMongoRepository struct {
+	client *mongo.Client
+}
 
-func NewAdminDashboardMongoRepository() AdminDashboardRepository {
-	return &adminDashboardMongoRepository{}
+func NewAdminDashboardMongoRepository(client *mongo.Client) AdminDashboardRepository {
+	return &adminDashboardMongoRepository{client: client}
 }
 
-func (*adminDashboardMongoRepository) CountDistinctPosts(ctx context.Context) (int, error) {
-	collection, err := getPostContentCollection()
+func (repo *adminDashboardMongoRepository) CountDistinctPosts(ctx context.Context) (int, error) {
+	collection, err := getPostContentCollection(repo.client)
 	if err != nil {
 		return 0, fmt.Errorf(adminDashboardRepositoryUnavailableFormat, ErrAdminDashboardRepositoryUnavailable, err)
 	}
@@ -49,8 +51,8 @@ func (*adminDashboardMongoRepository) CountDistinctPosts(ctx context.Context) (i
 	return len(values), nil
 }
 
-func (*adminDashboardMongoRepository) CountActiveSubscribers(ctx context.Context) (int, error) {
-	collection, err := getNewsletterCollection()
+func (repo *adminDashboardMongoRepository) CountActiveSubscribers(ctx context.Context) (int, error) {
+	collection, err := getNewsletterCollection(repo.client)
 	if err != nil {
 		return 0, fmt.Errorf(adminDashboardRepositoryUnavailableFormat, ErrAdminDashboardRepositoryUnavailable, err)
 	}
@@ -63,11 +65,11 @@ func (*adminDashboardMongoRepository) CountActiveSubscribers(ctx context.Context
 	return int(total), nil
 }
 
-func (*adminDashboardMongoRepository) ListTopPostsByHits(
+func (repo *adminDashboardMongoRepository) ListTopPostsByHits(
 	ctx context.Context,
 	limit int,
 ) ([]domain.AdminDashboardPostMetric, error) {
-	collection, err := getPostHitsCollection()
+	collection, err := getPostHitsCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminDashboardRepositoryUnavailableFormat, ErrAdminDashboardRepositoryUnavailable, err)
 	}
@@ -77,14 +79,14 @@ func (*adminDashboardMongoRepository) ListTopPostsByHits(
 		return nil, err
 	}
 
-	return resolveDashboardPosts(ctx, metrics, "hits")
+	return resolveDashboardPosts(ctx, repo.client, metrics, "hits")
 }
 
-func (*adminDashboardMongoRepository) ListTopPostsByLikes(
+func (repo *adminDashboardMongoRepository) ListTopPostsByLikes(
 	ctx context.Context,
 	limit int,
 ) ([]domain.AdminDashboardPostMetric, error) {
-	collection, err := getPostLikesCollection()
+	collection, err := getPostLikesCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminDashboardRepositoryUnavailableFormat, ErrAdminDashboardRepositoryUnavailable, err)
 	}
@@ -94,11 +96,11 @@ func (*adminDashboardMongoRepository) ListTopPostsByLikes(
 		return nil, err
 	}
 
-	return resolveDashboardPosts(ctx, metrics, "likes")
+	return resolveDashboardPosts(ctx, repo.client, metrics, "likes")
 }
 
-func (*adminDashboardMongoRepository) BuildContentHealthSummary(ctx context.Context) (domain.AdminDashboardContentHealth, error) {
-	collection, err := getPostContentCollection()
+func (repo *adminDashboardMongoRepository) BuildContentHealthSummary(ctx context.Context) (domain.AdminDashboardContentHealth, error) {
+	collection, err := getPostContentCollection(repo.client)
 	if err != nil {
 		return domain.AdminDashboardContentHealth{}, fmt.Errorf(adminDashboardRepositoryUnavailableFormat, ErrAdminDashboardRepositoryUnavailable, err)
 	}
@@ -113,29 +115,6 @@ func (*adminDashboardMongoRepository) BuildContentHealthSummary(ctx context.Cont
 		return domain.AdminDashboardContentHealth{}, err
 	}
 
-	enIDs := toStringSet(enIDsRaw)
-	trIDs := toStringSet(trIDsRaw)
-
-	missingTr := 0
-	for postID := range enIDs {
-		if _, exists := trIDs[postID]; !exists {
-			missingTr++
-		}
-	}
-
-	missingEn := 0
-	for postID := range trIDs {
-		if _, exists := enIDs[postID]; !exists {
-			missingEn++
-		}
-	}
-
-	localePairCoverage := 100
-	if len(enIDs) > 0 {
-		covered := max(len(enIDs)-missingTr, 0)
-		localePairCoverage = int(float64(covered)/float64(len(enIDs))*100 + 0.5)
-	}
-
 	cursor, err := collection.Find(
 		ctx,
 		bson.M{
@@ -158,16 +137,59 @@ func (*adminDashboardMongoRepository) BuildContentHealthSummary(ctx context.Cont
 		_ = cursor.Close(ctx)
 	}()
 
-	type contentDoc struct {
-		ID            string `bson:"id"`
-		Title         string `bson:"title"`
-		UpdatedDate   string `bson:"updatedDate"`
-		PublishedDate string `bson:"publishedDate"`
-		Thumbnail     string `bson:"thumbnail"`
-		Category      *struct {
-			ID   string `bson:"id"`
-			Name string `bson:"name"`
-		} `bson:"category"`
+	docs := make([]dashboardContentDoc, 0, 12)
+	for cursor.Next(ctx) {
+		var doc dashboardContentDoc
+		if err := cursor.Decode(&doc); err != nil {
+			return domain.AdminDashboardContentHealth{}, err
+		}
+		docs = append(docs, doc)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return domain.AdminDashboardContentHealth{}, err
+	}
+
+	return summarizeDashboardContentHealth(toStringSet(enIDsRaw), toStringSet(trIDsRaw), docs), nil
+}
+
+type dashboardContentDoc struct {
+	ID            string `bson:"id"`
+	Title         string `bson:"title"`
+	UpdatedDate   string `bson:"updatedDate"`
+	PublishedDate string `bson:"publishedDate"`
+	Thumbnail     string `bson:"thumbnail"`
+	Category      *struct {
+		ID   string `bson:"id"`
+		Name string `bson:"name"`
+	} `bson:"category"`
+}
+
+// summarizeDashboardContentHealth builds the content health summary from the post ids of each locale and the
+// English non-Medium posts.
+func summarizeDashboardContentHealth(
+	enIDs map[string]struct{},
+	trIDs map[string]struct{},
+	docs []dashboardContentDoc,
+) domain.AdminDashboardContentHealth {
+	missingTr := 0
+	for postID := range enIDs {
+		if _, exists := trIDs[postID]; !exists {
+			missingTr++
+		}
+	}
+
+	missingEn := 0
+	for postID := range trIDs {
+		if _, exists := enIDs[postID]; !exists {
+			missingEn++
+		}
+	}
+
+	localePairCoverage := 100
+	if len(enIDs) > 0 {
+		covered := max(len(enIDs)-missingTr, 0)
+		localePairCoverage = int(float64(covered)/float64(len(enIDs))*100 + 0.5)
 	}
 
 	type latestCandidate struct {
@@ -176,15 +198,10 @@ func (*adminDashboardMongoRepository) BuildContentHealthSummary(ctx context.Cont
 	}
 
 	missingThumbnails := 0
-	latestCandidates := make([]latestCandidate, 0, 12)
+	latestCandidates := make([]latestCandidate, 0, len(docs))
 	categoryCount := make(map[string]*domain.AdminDashboardCategory)
 
-	for cursor.Next(ctx) {
-		var doc contentDoc
-		if err := cursor.Decode(&doc); err != nil {
-			return domain.AdminDashboardContentHealth{}, err
-		}
-
+	for _, doc := range docs {
 		if strings.TrimSpace(doc.Thumbnail) == "" {
 			missingThumbnails++
 		}
@@ -226,10 +243,6 @@ func (*adminDashboardMongoRepository) BuildContentHealthSummary(ctx context.Cont
 		})
 	}
 
-	if err := cursor.Err(); err != nil {
-		return domain.AdminDashboardContentHealth{}, err
-	}
-
 	sort.SliceStable(latestCandidates, func(i, j int) bool {
 		return latestCandidates[i].at.After(latestCandidates[j].at)
 	})
@@ -256,7 +269,7 @@ func (*adminDashboardMongoRepository) BuildContentHealthSummary(ctx context.Cont
 		MissingThumbnails:   missingThumbnails,
 		LatestUpdatedPosts:  latestUpdatedPosts,
 		DominantCategory:    dominantCategory,
-	}, nil
+	}
 }
 
 type dashboardMetricDoc struct {
@@ -321,6 +334,7 @@ func fetchTopMetrics(ctx context.Context, collection *mongo.Collection, field st
 
 func resolveDashboardPosts(
 	ctx context.Context,
+	client *mongo.Client,
 	metrics []dashboardMetricDoc,
 	field string,
 ) ([]domain.AdminDashboardPostMetric, error) {
@@ -328,7 +342,7 @@ func resolveDashboardPosts(
 		return []domain.AdminDashboardPostMetric{}, nil
 	}
 
-	contentCollection, err := getPostContentCollection()
+	contentCollection, err := getPostContentCollection(client)
 	if err != nil {
 		return nil, fmt.Errorf(adminDashboardRepositoryUnavailableFormat, ErrAdminDashboardRepositoryUnavailable, err)
 	}
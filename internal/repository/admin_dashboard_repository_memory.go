@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+)
+
+type adminDashboardMemoryRepository struct {
+	store *MemoryStore
+}
+
+// NewAdminDashboardMemoryRepository returns an admin dashboard repository backed by the given in-memory store.
+func NewAdminDashboardMemoryRepository(store *MemoryStore) AdminDashboardRepository {
+	return &adminDashboardMemoryRepository{store: store}
+}
+
+func (r *adminDashboardMemoryRepository) CountDistinctPosts(_ context.Context) (int, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	postIDs := make(map[string]struct{})
+	for _, post := range r.store.posts {
+		if post.Source != "medium" {
+			postIDs[post.ID] = struct{}{}
+		}
+	}
+
+	return len(postIDs), nil
+}
+
+func (r *adminDashboardMemoryRepository) CountActiveSubscribers(_ context.Context) (int, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	total := 0
+	for _, subscriber := range r.store.newsletterSubscribers {
+		if subscriber.Status == "active" {
+			total++
+		}
+	}
+
+	return total, nil
+}
+
+func (r *adminDashboardMemoryRepository) ListTopPostsByHits(
+	_ context.Context,
+	limit int,
+) ([]domain.AdminDashboardPostMetric, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return r.listTopPosts(r.store.postHits, "hits", limit), nil
+}
+
+func (r *adminDashboardMemoryRepository) ListTopPostsByLikes(
+	_ context.Context,
+	limit int,
+) ([]domain.AdminDashboardPostMetric, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return r.listTopPosts(r.store.postLikes, "likes", limit), nil
+}
+
+func (r *adminDashboardMemoryRepository) BuildContentHealthSummary(_ context.Context) (domain.AdminDashboardContentHealth, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	enIDs := make(map[string]struct{})
+	trIDs := make(map[string]struct{})
+	docs := make([]dashboardContentDoc, 0, 12)
+	for _, post := range r.store.posts {
+		switch post.Locale {
+		case adminContentLocaleEN:
+			enIDs[post.ID] = struct{}{}
+		case adminContentLocaleTR:
+			trIDs[post.ID] = struct{}{}
+		}
+		if post.Locale != adminContentLocaleEN || post.Source == "medium" {
+			continue
+		}
+
+		doc := dashboardContentDoc{
+			ID:            post.ID,
+			Title:         post.Title,
+			UpdatedDate:   post.UpdatedDate,
+			PublishedDate: post.PublishedDate,
+			Thumbnail:     post.Thumbnail,
+		}
+		if post.Category != nil {
+			doc.Category = &struct {
+				ID   string `bson:"id"`
+				Name string `bson:"name"`
+			}{ID: post.Category.ID, Name: post.Category.Name}
+		}
+		docs = append(docs, doc)
+	}
+
+	return summarizeDashboardContentHealth(enIDs, trIDs, docs), nil
+}
+
+// listTopPosts ranks the counters and attaches the English variant of each post, or any variant when there is
+// none. The caller must hold the lock.
+func (r *adminDashboardMemoryRepository) listTopPosts(
+	counters map[string]int64,
+	field string,
+	limit int,
+) []domain.AdminDashboardPostMetric {
+	if limit <= 0 {
+		limit = 5
+	}
+
+	metrics := make([]dashboardMetricDoc, 0, len(counters))
+	for postID, value := range counters {
+		if postID != "" {
+			metrics = append(metrics, dashboardMetricDoc{PostID: postID, Value: value})
+		}
+	}
+	slices.SortFunc(metrics, func(left, right dashboardMetricDoc) int {
+		return cmp.Or(cmp.Compare(right.Value, left.Value), strings.Compare(left.PostID, right.PostID))
+	})
+	metrics = metrics[:min(len(metrics), limit)]
+
+	result := make([]domain.AdminDashboardPostMetric, 0, len(metrics))
+	for _, metric := range metrics {
+		item := domain.AdminDashboardPostMetric{PostID: metric.PostID}
+		for _, post := range r.store.posts {
+			if post.ID != metric.PostID || item.Locale == adminContentLocaleEN {
+				continue
+			}
+			item.Title = post.Title
+			item.Locale = post.Locale
+			item.PublishedDate = post.PublishedDate
+		}
+
+		if field == "hits" {
+			item.Hits = metric.Value
+		} else {
+			item.Likes = metric.Value
+		}
+
+		result = append(result, item)
+	}
+
+	return result
+}
@@ -0,0 +1,218 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type AdminLoginAttemptRepository interface {
+	FindByKey(ctx context.Context, key string) (*domain.AdminLoginAttemptRecord, error)
+	RecordFailure(ctx context.Context, key string, at time.Time, window time.Duration) (*domain.AdminLoginAttemptRecord, error)
+	Lock(ctx context.Context, key string, at, until time.Time) (bool, error)
+	DeleteByKeys(ctx context.Context, keys ...string) error
+}
+
+var ErrAdminLoginAttemptRepositoryUnavailable = errors.New("admin login attempt repository unavailable")
+
+const (
+	adminLoginAttemptsCollectionName             = "admin_login_attempts"
+	adminLoginAttemptRepositoryUnavailableFormat = "%w: %v"
+)
+
+type adminLoginAttemptMongoRepository struct {
+	client *mongo.Client
+}
+
+type adminLoginAttemptDocument struct {
+	Key           string     `bson:"key"`
+	Failures      int        `bson:"failures"`
+	LastFailureAt time.Time  `bson:"lastFailureAt"`
+	LockedUntil   *time.Time `bson:"lockedUntil,omitempty"`
+	ExpiresAt     time.Time  `bson:"expiresAt"`
+}
+
+var (
+	adminLoginAttemptIndexesOnce sync.Once
+	adminLoginAttemptIndexesErr  error
+)
+
+func NewAdminLoginAttemptRepository(client *mongo.Client) AdminLoginAttemptRepository {
+	return &adminLoginAttemptMongoRepository{client: client}
+}
+
+func (repo *adminLoginAttemptMongoRepository) FindByKey(
+	ctx context.Context,
+	key string,
+) (*domain.AdminLoginAttemptRecord, error) {
+	collection, err := getAdminLoginAttemptsCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminLoginAttemptRepositoryUnavailableFormat, ErrAdminLoginAttemptRepositoryUnavailable, err)
+	}
+
+	var document adminLoginAttemptDocument
+	err = collection.FindOne(ctx, bson.M{"key": strings.TrimSpace(key)}).Decode(&document)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	record := mapAdminLoginAttemptDocument(document)
+	return &record, nil
+}
+
+// RecordFailure atomically counts one more failure for key. The count starts over once the previous window has
+// passed, and the document never expires before an active lock does.
+func (repo *adminLoginAttemptMongoRepository) RecordFailure(
+	ctx context.Context,
+	key string,
+	at time.Time,
+	window time.Duration,
+) (*domain.AdminLoginAttemptRecord, error) {
+	collection, err := getAdminLoginAttemptsCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminLoginAttemptRepositoryUnavailableFormat, ErrAdminLoginAttemptRepositoryUnavailable, err)
+	}
+
+	resolvedAt := at.UTC()
+	windowEnd := resolvedAt.Add(window)
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"key": strings.TrimSpace(key),
+			"failures": bson.M{"$cond": bson.A{
+				bson.M{"$gt": bson.A{"$expiresAt", resolvedAt}},
+				bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$failures", 0}}, 1}},
+				1,
+			}},
+			"lastFailureAt": resolvedAt,
+			"expiresAt":     bson.M{"$max": bson.A{windowEnd, bson.M{"$ifNull": bson.A{"$lockedUntil", windowEnd}}}},
+		}}},
+	}
+
+	var document adminLoginAttemptDocument
+	err = collection.FindOneAndUpdate(
+		ctx,
+		bson.M{"key": strings.TrimSpace(key)},
+		update,
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&document)
+	if err != nil {
+		return nil, err
+	}
+
+	record := mapAdminLoginAttemptDocument(document)
+	return &record, nil
+}
+
+// Lock locks key until the given time and restarts its failure count. It reports false when the key was already
+// locked at the given time, so concurrent failures trigger a single lockout.
+func (repo *adminLoginAttemptMongoRepository) Lock(ctx context.Context, key string, at, until time.Time) (bool, error) {
+	collection, err := getAdminLoginAttemptsCollection(repo.client)
+	if err != nil {
+		return false, fmt.Errorf(adminLoginAttemptRepositoryUnavailableFormat, ErrAdminLoginAttemptRepositoryUnavailable, err)
+	}
+
+	result, err := collection.UpdateOne(
+		ctx,
+		bson.M{
+			"key": strings.TrimSpace(key),
+			"$or": bson.A{
+				bson.M{"lockedUntil": bson.M{"$exists": false}},
+				bson.M{"lockedUntil": bson.M{"$lte": at.UTC()}},
+			},
+		},
+		bson.M{
+			"$set": bson.M{"lockedUntil": until.UTC(), "failures": 0},
+			"$max": bson.M{"expiresAt": until.UTC()},
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return result.MatchedCount > 0, nil
+}
+
+func (repo *adminLoginAttemptMongoRepository) DeleteByKeys(ctx context.Context, keys ...string) error {
+	collection, err := getAdminLoginAttemptsCollection(repo.client)
+	if err != nil {
+		return fmt.Errorf(adminLoginAttemptRepositoryUnavailableFormat, ErrAdminLoginAttemptRepositoryUnavailable, err)
+	}
+
+	resolvedKeys := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if resolvedKey := strings.TrimSpace(key); resolvedKey != "" {
+			resolvedKeys = append(resolvedKeys, resolvedKey)
+		}
+	}
+	if len(resolvedKeys) == 0 {
+		return nil
+	}
+
+	_, err = collection.DeleteMany(ctx, bson.M{"key": bson.M{"$in": resolvedKeys}})
+	return err
+}
+
+func mapAdminLoginAttemptDocument(document adminLoginAttemptDocument) domain.AdminLoginAttemptRecord {
+	return domain.AdminLoginAttemptRecord{
+		Key:           strings.TrimSpace(document.Key),
+		Failures:      document.Failures,
+		LastFailureAt: document.LastFailureAt,
+		LockedUntil:   document.LockedUntil,
+		ExpiresAt:     document.ExpiresAt,
+	}
+}
+
+func getAdminLoginAttemptsCollection(client *mongo.Client) (*mongo.Collection, error) {
+	databaseConfig, err := appconfig.ResolveDatabaseConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	if client == nil {
+		return nil, ErrMongoClientUnavailable
+	}
+
+	collection := client.Database(databaseConfig.Name).Collection(adminLoginAttemptsCollectionName)
+	if err := ensureAdminLoginAttemptIndexes(collection); err != nil {
+		return nil, err
+	}
+
+	return collection, nil
+}
+
+func ensureAdminLoginAttemptIndexes(collection *mongo.Collection) error {
+	adminLoginAttemptIndexesOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		indexes := []mongo.IndexModel{
+			{
+				Keys:    bson.D{{Key: "key", Value: 1}},
+				Options: options.Index().SetUnique(true).SetName("uniq_admin_login_attempt_key"),
+			},
+			{
+				Keys:    bson.D{{Key: "expiresAt", Value: 1}},
+				Options: options.Index().SetExpireAfterSeconds(0).SetName("ttl_admin_login_attempt_expires"),
+			},
+		}
+
+		if _, err := collection.Indexes().CreateMany(ctx, indexes); err != nil {
+			adminLoginAttemptIndexesErr = fmt.Errorf("create admin login attempt index failed: %w", err)
+		}
+	})
+
+	return adminLoginAttemptIndexesErr
+}
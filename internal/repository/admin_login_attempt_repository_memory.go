@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+)
+
+type adminLoginAttemptMemoryRepository struct {
+	store *MemoryStore
+}
+
+// NewAdminLoginAttemptMemoryRepository returns a login attempt repository backed by the given in-memory store.
+func NewAdminLoginAttemptMemoryRepository(store *MemoryStore) AdminLoginAttemptRepository {
+	return &adminLoginAttemptMemoryRepository{store: store}
+}
+
+func (r *adminLoginAttemptMemoryRepository) FindByKey(
+	_ context.Context,
+	key string,
+) (*domain.AdminLoginAttemptRecord, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	document, ok := r.store.adminLoginAttempts[strings.TrimSpace(key)]
+	if !ok {
+		return nil, nil
+	}
+
+	record := mapMemoryAdminLoginAttemptDocument(document)
+	return &record, nil
+}
+
+func (r *adminLoginAttemptMemoryRepository) RecordFailure(
+	_ context.Context,
+	key string,
+	at time.Time,
+	window time.Duration,
+) (*domain.AdminLoginAttemptRecord, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	resolvedKey := strings.TrimSpace(key)
+	resolvedAt := at.UTC()
+	windowEnd := resolvedAt.Add(window)
+
+	document, ok := r.store.adminLoginAttempts[resolvedKey]
+	if ok && document.ExpiresAt.After(resolvedAt) {
+		document.Failures++
+	} else {
+		document.Failures = 1
+	}
+	document.Key = resolvedKey
+	document.LastFailureAt = resolvedAt
+	document.ExpiresAt = windowEnd
+	if document.LockedUntil != nil && document.LockedUntil.After(windowEnd) {
+		document.ExpiresAt = *document.LockedUntil
+	}
+	r.store.adminLoginAttempts[resolvedKey] = document
+
+	record := mapMemoryAdminLoginAttemptDocument(document)
+	return &record, nil
+}
+
+func (r *adminLoginAttemptMemoryRepository) Lock(_ context.Context, key string, at, until time.Time) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	resolvedKey := strings.TrimSpace(key)
+	document, ok := r.store.adminLoginAttempts[resolvedKey]
+	if !ok || (document.LockedUntil != nil && document.LockedUntil.After(at.UTC())) {
+		return false, nil
+	}
+
+	lockedUntil := until.UTC()
+	document.LockedUntil = &lockedUntil
+	document.Failures = 0
+	if lockedUntil.After(document.ExpiresAt) {
+		document.ExpiresAt = lockedUntil
+	}
+	r.store.adminLoginAttempts[resolvedKey] = document
+
+	return true, nil
+}
+
+func (r *adminLoginAttemptMemoryRepository) DeleteByKeys(_ context.Context, keys ...string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, key := range keys {
+		delete(r.store.adminLoginAttempts, strings.TrimSpace(key))
+	}
+	return nil
+}
+
+func mapMemoryAdminLoginAttemptDocument(document adminLoginAttemptDocument) domain.AdminLoginAttemptRecord {
+	record := mapAdminLoginAttemptDocument(document)
+	if record.LockedUntil != nil {
+		lockedUntil := *record.LockedUntil
+		record.LockedUntil = &lockedUntil
+	}
+	return record
+}
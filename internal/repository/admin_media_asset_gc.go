@@ -0,0 +1,208 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var mediaAssetReferencePattern = regexp.MustCompile(`/api/media/([A-Za-z0-9][A-Za-z0-9_-]*)`)
+
+// mediaAssetReferenceSource names the string fields of a content collection that may point at uploaded assets.
+type mediaAssetReferenceSource struct {
+	collection func(client *mongo.Client) (*mongo.Collection, error)
+	fields     []string
+}
+
+var mediaAssetReferenceSources = []mediaAssetReferenceSource{
+	{collection: getPostContentCollection, fields: []string{"thumbnail", "content"}},
+	{collection: getPostRevisionsCollection, fields: []string{"thumbnail", "content"}},
+	{collection: getPostTopicsCollection, fields: []string{"link"}},
+	{collection: getPostCategoriesCollection, fields: []string{"icon", "link"}},
+}
+
+// ListReferencedMediaAssetIDs returns the ids of every uploaded asset referenced by posts, revisions, topics or
+// categories, whether as a thumbnail, inside markdown content or as an icon or link.
+func (repo *adminMediaAssetMongoRepository) ListReferencedMediaAssetIDs(ctx context.Context) ([]string, error) {
+	referenced := map[string]struct{}{}
+	for _, source := range mediaAssetReferenceSources {
+		collection, err := source.collection(repo.client)
+		if err != nil {
+			return nil, fmt.Errorf(adminMediaAssetRepositoryUnavailableFormat, ErrAdminMediaAssetRepositoryUnavailable, err)
+		}
+		if err := collectReferencedMediaAssetIDs(ctx, collection, source.fields, referenced); err != nil {
+			return nil, err
+		}
+	}
+
+	ids := make([]string, 0, len(referenced))
+	for id := range referenced {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids, nil
+}
+
+// ListMediaAssetsForGarbageCollection returns every uploaded asset without its inline image data.
+func (repo *adminMediaAssetMongoRepository) ListMediaAssetsForGarbageCollection(
+	ctx context.Context,
+) ([]domain.AdminMediaAssetRecord, error) {
+	mediaCollection, err := getPostMediaAssetsCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminMediaAssetRepositoryUnavailableFormat, ErrAdminMediaAssetRepositoryUnavailable, err)
+	}
+
+	cursor, err := mediaCollection.Find(
+		ctx,
+		bson.M{},
+		options.Find().
+			SetProjection(bson.M{"data": 0}).
+			SetSort(bson.D{{Key: "createdAt", Value: 1}}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	var docs []adminMediaAssetDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	records := make([]domain.AdminMediaAssetRecord, 0, len(docs))
+	for _, doc := range docs {
+		records = append(records, mapAdminMediaAssetDocument(doc))
+	}
+	return records, nil
+}
+
+// QuarantineMediaAssets marks assets as unused until purgeAfter. Assets that are already quarantined keep their
+// original deadline.
+func (repo *adminMediaAssetMongoRepository) QuarantineMediaAssets(
+	ctx context.Context,
+	ids []string,
+	quarantinedAt time.Time,
+	purgeAfter time.Time,
+) (int, error) {
+	mediaCollection, err := getPostMediaAssetsCollection(repo.client)
+	if err != nil {
+		return 0, fmt.Errorf(adminMediaAssetRepositoryUnavailableFormat, ErrAdminMediaAssetRepositoryUnavailable, err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	result, err := mediaCollection.UpdateMany(
+		ctx,
+		bson.M{"id": bson.M{"$in": ids}, "quarantinedAt": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{
+			"quarantinedAt": quarantinedAt.UTC(),
+			"purgeAfter":    purgeAfter.UTC(),
+		}},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return int(result.ModifiedCount), nil
+}
+
+// RestoreMediaAssets takes assets out of quarantine and returns how many were quarantined.
+func (repo *adminMediaAssetMongoRepository) RestoreMediaAssets(ctx context.Context, ids []string) (int, error) {
+	mediaCollection, err := getPostMediaAssetsCollection(repo.client)
+	if err != nil {
+		return 0, fmt.Errorf(adminMediaAssetRepositoryUnavailableFormat, ErrAdminMediaAssetRepositoryUnavailable, err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	result, err := mediaCollection.UpdateMany(
+		ctx,
+		bson.M{"id": bson.M{"$in": ids}, "quarantinedAt": bson.M{"$exists": true}},
+		bson.M{"$unset": bson.M{"quarantinedAt": "", "purgeAfter": ""}},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return int(result.ModifiedCount), nil
+}
+
+// DeleteQuarantinedMediaAsset hard-deletes an asset whose grace period ended before now. It reports false when the
+// asset was restored or removed in the meantime.
+func (repo *adminMediaAssetMongoRepository) DeleteQuarantinedMediaAsset(
+	ctx context.Context,
+	id string,
+	now time.Time,
+) (bool, error) {
+	mediaCollection, err := getPostMediaAssetsCollection(repo.client)
+	if err != nil {
+		return false, fmt.Errorf(adminMediaAssetRepositoryUnavailableFormat, ErrAdminMediaAssetRepositoryUnavailable, err)
+	}
+
+	resolvedID := strings.TrimSpace(id)
+	result, err := mediaCollection.DeleteOne(ctx, bson.M{
+		"id":            resolvedID,
+		"quarantinedAt": bson.M{"$exists": true},
+		"purgeAfter":    bson.M{"$lte": now.UTC()},
+	})
+	if err != nil {
+		return false, err
+	}
+	if result.DeletedCount > 0 {
+		_ = deleteStaleMediaAssetVariants(ctx, repo.client, resolvedID, "")
+	}
+
+	return result.DeletedCount > 0, nil
+}
+
+func collectReferencedMediaAssetIDs(
+	ctx context.Context,
+	collection *mongo.Collection,
+	fields []string,
+	referenced map[string]struct{},
+) error {
+	pattern := primitive.Regex{Pattern: regexp.QuoteMeta("/api/media/")}
+	conditions := make(bson.A, 0, len(fields))
+	projection := bson.M{"_id": 0}
+	for _, field := range fields {
+		conditions = append(conditions, bson.M{field: pattern})
+		projection[field] = 1
+	}
+
+	cursor, err := collection.Find(ctx, bson.M{"$or": conditions}, options.Find().SetProjection(projection))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	for cursor.Next(ctx) {
+		for _, field := range fields {
+			if value, ok := cursor.Current.Lookup(field).StringValueOK(); ok {
+				extractMediaAssetReferenceIDs(value, referenced)
+			}
+		}
+	}
+	return cursor.Err()
+}
+
+// extractMediaAssetReferenceIDs adds the asset id of every /api/media/{id} occurrence in value. Absolute URLs on
+// any host count too, so an asset is never collected because of how a link was written.
+func extractMediaAssetReferenceIDs(value string, referenced map[string]struct{}) {
+	for _, match := range mediaAssetReferencePattern.FindAllStringSubmatch(value, -1) {
+		referenced[match[1]] = struct{}{}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 var (
@@ -36,28 +38,60 @@ type AdminMediaAssetRepository interface {
 	) (*domain.AdminMediaLibraryListPayload, error)
 	FindMediaAssetByID(ctx context.Context, id string) (*domain.AdminMediaAssetRecord, error)
 	FindMediaAssetByDigest(ctx context.Context, digest string) (*domain.AdminMediaAssetRecord, error)
+	FindMediaAssetPreview(ctx context.Context, id string) (*domain.AdminMediaAssetRecord, error)
+	ListMediaLibraryFacets(ctx context.Context) (*domain.AdminMediaLibraryFacets, error)
 	CountMediaAssetUsage(ctx context.Context, value string) (int, error)
 	CreateMediaAsset(ctx context.Context, record domain.AdminMediaAssetRecord) (*domain.AdminMediaAssetRecord, error)
 	ReplaceMediaAsset(ctx context.Context, record domain.AdminMediaAssetRecord) (*domain.AdminMediaAssetRecord, error)
+	UpdateMediaAssetMetadata(
+		ctx context.Context,
+		id string,
+		metadata domain.AdminMediaAssetMetadata,
+		updatedAt time.Time,
+	) error
+	MoveMediaAssetsToFolder(ctx context.Context, ids []string, folder string, updatedAt time.Time) (int, error)
 	DeleteMediaAssetByID(ctx context.Context, id string) (bool, error)
+	FindMediaAssetVariant(
+		ctx context.Context,
+		assetID string,
+		digest string,
+		width int,
+		contentType string,
+	) (*domain.AdminMediaAssetVariant, error)
+	UpsertMediaAssetVariant(ctx context.Context, variant domain.AdminMediaAssetVariant) error
+	ListMediaAssetsOutsideStorage(ctx context.Context, backend string, limit int) ([]domain.AdminMediaAssetRecord, error)
+	UpdateMediaAssetStorage(
+		ctx context.Context,
+		record domain.AdminMediaAssetRecord,
+		storage string,
+		storageKey string,
+		data []byte,
+	) (bool, error)
+	ListReferencedMediaAssetIDs(ctx context.Context) ([]string, error)
+	ListMediaAssetsForGarbageCollection(ctx context.Context) ([]domain.AdminMediaAssetRecord, error)
+	QuarantineMediaAssets(ctx context.Context, ids []string, quarantinedAt time.Time, purgeAfter time.Time) (int, error)
+	RestoreMediaAssets(ctx context.Context, ids []string) (int, error)
+	DeleteQuarantinedMediaAsset(ctx context.Context, id string, now time.Time) (bool, error)
 }
 
-type adminMediaAssetMongoRepository struct{}
+type adminMediaAssetMongoRepository struct {
+	client *mongo.Client
+}
 
-func NewAdminMediaAssetRepository() AdminMediaAssetRepository {
-	return &adminMediaAssetMongoRepository{}
+func NewAdminMediaAssetRepository(client *mongo.Client) AdminMediaAssetRepository {
+	return &adminMediaAssetMongoRepository{client: client}
 }
 
-func (*adminMediaAssetMongoRepository) ListMediaLibraryItems(
+func (repo *adminMediaAssetMongoRepository) ListMediaLibraryItems(
 	ctx context.Context,
 	filter domain.AdminMediaLibraryFilter,
 ) (*domain.AdminMediaLibraryListPayload, error) {
-	mediaCollection, err := getPostMediaAssetsCollection()
+	mediaCollection, err := getPostMediaAssetsCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminMediaAssetRepositoryUnavailableFormat, ErrAdminMediaAssetRepositoryUnavailable, err)
 	}
 
-	postsCollection, err := getPostContentCollection()
+	postsCollection, err := getPostContentCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminMediaAssetRepositoryUnavailableFormat, ErrAdminMediaAssetRepositoryUnavailable, err)
 	}
@@ -72,6 +106,18 @@ func (*adminMediaAssetMongoRepository) ListMediaLibraryItems(
 		resolvedSize = 10
 	}
 
+	// Tags, folders and quarantine only exist on uploaded assets, so referenced thumbnails never match them.
+	if strings.TrimSpace(filter.Tag) != "" || strings.TrimSpace(filter.Folder) != "" || filter.Quarantined {
+		if resolvedKind == "REFERENCE" {
+			return &domain.AdminMediaLibraryListPayload{
+				Items: []domain.AdminMediaLibraryItem{},
+				Page:  resolvedPage,
+				Size:  resolvedSize,
+			}, nil
+		}
+		resolvedKind = "UPLOADED"
+	}
+
 	sortStage := bson.D{{Key: "$sort", Value: buildAdminMediaLibrarySortDocument(filter.Sort)}}
 	facetStage := bson.D{{Key: "$facet", Value: bson.M{
 		"items": bson.A{
@@ -88,7 +134,7 @@ func (*adminMediaAssetMongoRepository) ListMediaLibraryItems(
 		return aggregateAdminMediaLibraryPayload(
 			ctx,
 			mediaCollection,
-			append(buildUploadedMediaLibraryPipeline(postsCollection.Name(), filter.Query), sortStage, facetStage),
+			append(buildUploadedMediaLibraryPipeline(postsCollection.Name(), filter), sortStage, facetStage),
 			resolvedPage,
 			resolvedSize,
 		)
@@ -101,7 +147,7 @@ func (*adminMediaAssetMongoRepository) ListMediaLibraryItems(
 			resolvedSize,
 		)
 	default:
-		pipeline := append(buildUploadedMediaLibraryPipeline(postsCollection.Name(), filter.Query),
+		pipeline := append(buildUploadedMediaLibraryPipeline(postsCollection.Name(), filter),
 			bson.D{{Key: "$unionWith", Value: bson.M{
 				"coll":     postsCollection.Name(),
 				"pipeline": buildReferencedMediaLibraryPipeline(filter.Query),
@@ -113,11 +159,11 @@ func (*adminMediaAssetMongoRepository) ListMediaLibraryItems(
 	}
 }
 
-func (*adminMediaAssetMongoRepository) FindMediaAssetByID(
+func (repo *adminMediaAssetMongoRepository) FindMediaAssetByID(
 	ctx context.Context,
 	id string,
 ) (*domain.AdminMediaAssetRecord, error) {
-	mediaCollection, err := getPostMediaAssetsCollection()
+	mediaCollection, err := getPostMediaAssetsCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminMediaAssetRepositoryUnavailableFormat, ErrAdminMediaAssetRepositoryUnavailable, err)
 	}
@@ -138,11 +184,11 @@ func (*adminMediaAssetMongoRepository) FindMediaAssetByID(
 	return &record, nil
 }
 
-func (*adminMediaAssetMongoRepository) FindMediaAssetByDigest(
+func (repo *adminMediaAssetMongoRepository) FindMediaAssetByDigest(
 	ctx context.Context,
 	digest string,
 ) (*domain.AdminMediaAssetRecord, error) {
-	mediaCollection, err := getPostMediaAssetsCollection()
+	mediaCollection, err := getPostMediaAssetsCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminMediaAssetRepositoryUnavailableFormat, ErrAdminMediaAssetRepositoryUnavailable, err)
 	}
@@ -163,11 +209,67 @@ func (*adminMediaAssetMongoRepository) FindMediaAssetByDigest(
 	return &record, nil
 }
 
-func (*adminMediaAssetMongoRepository) CreateMediaAsset(
+// FindMediaAssetPreview loads an asset's dimensions, placeholder and alt texts without its inline image data.
+func (repo *adminMediaAssetMongoRepository) FindMediaAssetPreview(
+	ctx context.Context,
+	id string,
+) (*domain.AdminMediaAssetRecord, error) {
+	mediaCollection, err := getPostMediaAssetsCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminMediaAssetRepositoryUnavailableFormat, ErrAdminMediaAssetRepositoryUnavailable, err)
+	}
+
+	var doc adminMediaAssetDocument
+	err = mediaCollection.FindOne(
+		ctx,
+		bson.M{"id": strings.TrimSpace(id)},
+		options.FindOne().SetProjection(bson.M{
+			"id":                1,
+			"contentType":       1,
+			"width":             1,
+			"height":            1,
+			"placeholder":       1,
+			"metadata.altTexts": 1,
+		}),
+	).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	record := mapAdminMediaAssetDocument(doc)
+	return &record, nil
+}
+
+// ListMediaLibraryFacets returns the folders and tags in use so the admin panel can offer them as filters.
+func (repo *adminMediaAssetMongoRepository) ListMediaLibraryFacets(ctx context.Context) (*domain.AdminMediaLibraryFacets, error) {
+	mediaCollection, err := getPostMediaAssetsCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminMediaAssetRepositoryUnavailableFormat, ErrAdminMediaAssetRepositoryUnavailable, err)
+	}
+
+	folders, err := mediaCollection.Distinct(ctx, "metadata.folder", bson.M{"metadata.folder": bson.M{"$nin": bson.A{"", nil}}})
+	if err != nil {
+		return nil, err
+	}
+	tags, err := mediaCollection.Distinct(ctx, "metadata.tags", bson.M{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.AdminMediaLibraryFacets{
+		Folders: collectSortedDistinctStrings(folders),
+		Tags:    collectSortedDistinctStrings(tags),
+	}, nil
+}
+
+func (repo *adminMediaAssetMongoRepository) CreateMediaAsset(
 	ctx context.Context,
 	record domain.AdminMediaAssetRecord,
 ) (*domain.AdminMediaAssetRecord, error) {
-	mediaCollection, err := getPostMediaAssetsCollection()
+	mediaCollection, err := getPostMediaAssetsCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminMediaAssetRepositoryUnavailableFormat, ErrAdminMediaAssetRepositoryUnavailable, err)
 	}
@@ -190,6 +292,10 @@ func (*adminMediaAssetMongoRepository) CreateMediaAsset(
 		"width":       record.Width,
 		"height":      record.Height,
 		"data":        append([]byte(nil), record.Data...),
+		"placeholder": buildAdminMediaPlaceholderDocument(record.Placeholder),
+		"metadata":    buildAdminMediaMetadataDocument(record.Metadata),
+		"storage":     resolveAdminMediaAssetStorage(record.Storage),
+		"storageKey":  strings.TrimSpace(record.StorageKey),
 		"createdBy":   strings.TrimSpace(record.CreatedBy),
 		"createdAt":   now,
 		"updatedAt":   updatedAt,
@@ -205,11 +311,11 @@ func (*adminMediaAssetMongoRepository) CreateMediaAsset(
 	return &created, nil
 }
 
-func (*adminMediaAssetMongoRepository) ReplaceMediaAsset(
+func (repo *adminMediaAssetMongoRepository) ReplaceMediaAsset(
 	ctx context.Context,
 	record domain.AdminMediaAssetRecord,
 ) (*domain.AdminMediaAssetRecord, error) {
-	mediaCollection, err := getPostMediaAssetsCollection()
+	mediaCollection, err := getPostMediaAssetsCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminMediaAssetRepositoryUnavailableFormat, ErrAdminMediaAssetRepositoryUnavailable, err)
 	}
@@ -229,6 +335,9 @@ func (*adminMediaAssetMongoRepository) ReplaceMediaAsset(
 			"width":       record.Width,
 			"height":      record.Height,
 			"data":        append([]byte(nil), record.Data...),
+			"placeholder": buildAdminMediaPlaceholderDocument(record.Placeholder),
+			"storage":     resolveAdminMediaAssetStorage(record.Storage),
+			"storageKey":  strings.TrimSpace(record.StorageKey),
 			"updatedAt":   record.UpdatedAt.UTC(),
 		},
 	}
@@ -240,13 +349,71 @@ func (*adminMediaAssetMongoRepository) ReplaceMediaAsset(
 	if result.MatchedCount == 0 {
 		return nil, ErrAdminMediaAssetNotFound
 	}
+	// Variants are keyed by digest, so a failed cleanup only leaves unreachable documents behind.
+	_ = deleteStaleMediaAssetVariants(ctx, repo.client, resolvedID, record.Digest)
 
 	replaced := record
 	return &replaced, nil
 }
 
-func (*adminMediaAssetMongoRepository) CountMediaAssetUsage(ctx context.Context, value string) (int, error) {
-	postsCollection, err := getPostContentCollection()
+func (repo *adminMediaAssetMongoRepository) UpdateMediaAssetMetadata(
+	ctx context.Context,
+	id string,
+	metadata domain.AdminMediaAssetMetadata,
+	updatedAt time.Time,
+) error {
+	mediaCollection, err := getPostMediaAssetsCollection(repo.client)
+	if err != nil {
+		return fmt.Errorf(adminMediaAssetRepositoryUnavailableFormat, ErrAdminMediaAssetRepositoryUnavailable, err)
+	}
+
+	result, err := mediaCollection.UpdateOne(
+		ctx,
+		bson.M{"id": strings.TrimSpace(id)},
+		bson.M{"$set": bson.M{
+			"metadata":  buildAdminMediaMetadataDocument(metadata),
+			"updatedAt": updatedAt.UTC(),
+		}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrAdminMediaAssetNotFound
+	}
+	return nil
+}
+
+func (repo *adminMediaAssetMongoRepository) MoveMediaAssetsToFolder(
+	ctx context.Context,
+	ids []string,
+	folder string,
+	updatedAt time.Time,
+) (int, error) {
+	mediaCollection, err := getPostMediaAssetsCollection(repo.client)
+	if err != nil {
+		return 0, fmt.Errorf(adminMediaAssetRepositoryUnavailableFormat, ErrAdminMediaAssetRepositoryUnavailable, err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	result, err := mediaCollection.UpdateMany(
+		ctx,
+		bson.M{"id": bson.M{"$in": ids}},
+		bson.M{"$set": bson.M{
+			"metadata.folder": strings.TrimSpace(folder),
+			"updatedAt":       updatedAt.UTC(),
+		}},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return int(result.MatchedCount), nil
+}
+
+func (repo *adminMediaAssetMongoRepository) CountMediaAssetUsage(ctx context.Context, value string) (int, error) {
+	postsCollection, err := getPostContentCollection(repo.client)
 	if err != nil {
 		return 0, fmt.Errorf(adminMediaAssetRepositoryUnavailableFormat, ErrAdminMediaAssetRepositoryUnavailable, err)
 	}
@@ -294,8 +461,8 @@ func (*adminMediaAssetMongoRepository) CountMediaAssetUsage(ctx context.Context,
 	return doc.UsageCount, nil
 }
 
-func (*adminMediaAssetMongoRepository) DeleteMediaAssetByID(ctx context.Context, id string) (bool, error) {
-	mediaCollection, err := getPostMediaAssetsCollection()
+func (repo *adminMediaAssetMongoRepository) DeleteMediaAssetByID(ctx context.Context, id string) (bool, error) {
+	mediaCollection, err := getPostMediaAssetsCollection(repo.client)
 	if err != nil {
 		return false, fmt.Errorf(adminMediaAssetRepositoryUnavailableFormat, ErrAdminMediaAssetRepositoryUnavailable, err)
 	}
@@ -304,22 +471,49 @@ func (*adminMediaAssetMongoRepository) DeleteMediaAssetByID(ctx context.Context,
 	if err != nil {
 		return false, err
 	}
+	if result.DeletedCount > 0 {
+		_ = deleteStaleMediaAssetVariants(ctx, repo.client, id, "")
+	}
 
 	return result.DeletedCount > 0, nil
 }
 
 type adminMediaAssetDocument struct {
-	ID          string    `bson:"id"`
-	Name        string    `bson:"name"`
-	ContentType string    `bson:"contentType"`
-	Digest      string    `bson:"digest"`
-	SizeBytes   int       `bson:"sizeBytes"`
-	Width       int       `bson:"width"`
-	Height      int       `bson:"height"`
-	Data        []byte    `bson:"data"`
-	CreatedBy   string    `bson:"createdBy"`
-	CreatedAt   time.Time `bson:"createdAt"`
-	UpdatedAt   time.Time `bson:"updatedAt"`
+	ID            string                        `bson:"id"`
+	Name          string                        `bson:"name"`
+	ContentType   string                        `bson:"contentType"`
+	Digest        string                        `bson:"digest"`
+	SizeBytes     int                           `bson:"sizeBytes"`
+	Width         int                           `bson:"width"`
+	Height        int                           `bson:"height"`
+	Data          []byte                        `bson:"data"`
+	Placeholder   adminMediaPlaceholderDocument `bson:"placeholder"`
+	Metadata      adminMediaMetadataDocument    `bson:"metadata"`
+	Storage       string                        `bson:"storage"`
+	StorageKey    string                        `bson:"storageKey"`
+	CreatedBy     string                        `bson:"createdBy"`
+	QuarantinedAt time.Time                     `bson:"quarantinedAt,omitempty"`
+	PurgeAfter    time.Time                     `bson:"purgeAfter,omitempty"`
+	CreatedAt     time.Time                     `bson:"createdAt"`
+	UpdatedAt     time.Time                     `bson:"updatedAt"`
+}
+
+type adminMediaPlaceholderDocument struct {
+	DominantColor string `bson:"dominantColor,omitempty"`
+	BlurHash      string `bson:"blurHash,omitempty"`
+}
+
+type adminMediaMetadataDocument struct {
+	AltTexts []adminMediaAltTextDocument `bson:"altTexts,omitempty"`
+	Caption  string                      `bson:"caption,omitempty"`
+	Credit   string                      `bson:"credit,omitempty"`
+	Tags     []string                    `bson:"tags,omitempty"`
+	Folder   string                      `bson:"folder,omitempty"`
+}
+
+type adminMediaAltTextDocument struct {
+	Locale string `bson:"locale"`
+	Text   string `bson:"text"`
 }
 
 type adminMediaLibraryAggregateResult struct {
@@ -330,36 +524,107 @@ type adminMediaLibraryAggregateResult struct {
 }
 
 type adminMediaLibraryItemDocument struct {
-	ID          string    `bson:"id"`
-	Kind        string    `bson:"kind"`
-	Name        string    `bson:"name"`
-	Value       string    `bson:"value"`
-	PreviewURL  string    `bson:"previewUrl"`
-	ContentType string    `bson:"contentType"`
-	Width       int       `bson:"width"`
-	Height      int       `bson:"height"`
-	SizeBytes   int       `bson:"sizeBytes"`
-	UsageCount  int       `bson:"usageCount"`
-	CreatedAt   time.Time `bson:"createdAt,omitempty"`
-	UpdatedAt   time.Time `bson:"updatedAt,omitempty"`
+	ID            string                        `bson:"id"`
+	Kind          string                        `bson:"kind"`
+	Name          string                        `bson:"name"`
+	Value         string                        `bson:"value"`
+	PreviewURL    string                        `bson:"previewUrl"`
+	ContentType   string                        `bson:"contentType"`
+	Width         int                           `bson:"width"`
+	Height        int                           `bson:"height"`
+	Placeholder   adminMediaPlaceholderDocument `bson:"placeholder"`
+	Metadata      adminMediaMetadataDocument    `bson:"metadata"`
+	SizeBytes     int                           `bson:"sizeBytes"`
+	UsageCount    int                           `bson:"usageCount"`
+	QuarantinedAt time.Time                     `bson:"quarantinedAt,omitempty"`
+	PurgeAfter    time.Time                     `bson:"purgeAfter,omitempty"`
+	CreatedAt     time.Time                     `bson:"createdAt,omitempty"`
+	UpdatedAt     time.Time                     `bson:"updatedAt,omitempty"`
 }
 
 func mapAdminMediaAssetDocument(doc adminMediaAssetDocument) domain.AdminMediaAssetRecord {
 	return domain.AdminMediaAssetRecord{
-		ID:          strings.TrimSpace(doc.ID),
-		Name:        strings.TrimSpace(doc.Name),
-		ContentType: strings.TrimSpace(doc.ContentType),
-		Digest:      strings.TrimSpace(strings.ToLower(doc.Digest)),
-		SizeBytes:   doc.SizeBytes,
-		Width:       doc.Width,
-		Height:      doc.Height,
-		Data:        append([]byte(nil), doc.Data...),
-		CreatedBy:   strings.TrimSpace(doc.CreatedBy),
-		CreatedAt:   doc.CreatedAt,
-		UpdatedAt:   doc.UpdatedAt,
+		ID:            strings.TrimSpace(doc.ID),
+		Name:          strings.TrimSpace(doc.Name),
+		ContentType:   strings.TrimSpace(doc.ContentType),
+		Digest:        strings.TrimSpace(strings.ToLower(doc.Digest)),
+		SizeBytes:     doc.SizeBytes,
+		Width:         doc.Width,
+		Height:        doc.Height,
+		Data:          append([]byte(nil), doc.Data...),
+		Placeholder:   mapAdminMediaPlaceholderDocument(doc.Placeholder),
+		Metadata:      mapAdminMediaMetadataDocument(doc.Metadata),
+		Storage:       resolveAdminMediaAssetStorage(doc.Storage),
+		StorageKey:    strings.TrimSpace(doc.StorageKey),
+		CreatedBy:     strings.TrimSpace(doc.CreatedBy),
+		QuarantinedAt: doc.QuarantinedAt,
+		PurgeAfter:    doc.PurgeAfter,
+		CreatedAt:     doc.CreatedAt,
+		UpdatedAt:     doc.UpdatedAt,
+	}
+}
+
+func buildAdminMediaPlaceholderDocument(placeholder domain.MediaPlaceholder) adminMediaPlaceholderDocument {
+	return adminMediaPlaceholderDocument{
+		DominantColor: strings.TrimSpace(placeholder.DominantColor),
+		BlurHash:      strings.TrimSpace(placeholder.BlurHash),
 	}
 }
 
+func mapAdminMediaPlaceholderDocument(doc adminMediaPlaceholderDocument) domain.MediaPlaceholder {
+	return domain.MediaPlaceholder{
+		DominantColor: strings.TrimSpace(doc.DominantColor),
+		BlurHash:      strings.TrimSpace(doc.BlurHash),
+	}
+}
+
+func buildAdminMediaMetadataDocument(metadata domain.AdminMediaAssetMetadata) adminMediaMetadataDocument {
+	altTexts := make([]adminMediaAltTextDocument, 0, len(metadata.AltTexts))
+	for _, altText := range metadata.AltTexts {
+		altTexts = append(altTexts, adminMediaAltTextDocument{
+			Locale: strings.TrimSpace(altText.Locale),
+			Text:   strings.TrimSpace(altText.Text),
+		})
+	}
+
+	return adminMediaMetadataDocument{
+		AltTexts: altTexts,
+		Caption:  strings.TrimSpace(metadata.Caption),
+		Credit:   strings.TrimSpace(metadata.Credit),
+		Tags:     append([]string(nil), metadata.Tags...),
+		Folder:   strings.TrimSpace(metadata.Folder),
+	}
+}
+
+func mapAdminMediaMetadataDocument(doc adminMediaMetadataDocument) domain.AdminMediaAssetMetadata {
+	altTexts := make([]domain.MediaAltText, 0, len(doc.AltTexts))
+	for _, altText := range doc.AltTexts {
+		altTexts = append(altTexts, domain.MediaAltText{
+			Locale: strings.TrimSpace(altText.Locale),
+			Text:   strings.TrimSpace(altText.Text),
+		})
+	}
+
+	return domain.AdminMediaAssetMetadata{
+		AltTexts: altTexts,
+		Caption:  strings.TrimSpace(doc.Caption),
+		Credit:   strings.TrimSpace(doc.Credit),
+		Tags:     append([]string{}, doc.Tags...),
+		Folder:   strings.TrimSpace(doc.Folder),
+	}
+}
+
+func collectSortedDistinctStrings(values []any) []string {
+	resolved := make([]string, 0, len(values))
+	for _, value := range values {
+		if text, ok := value.(string); ok && strings.TrimSpace(text) != "" {
+			resolved = append(resolved, strings.TrimSpace(text))
+		}
+	}
+	slices.Sort(resolved)
+	return slices.Compact(resolved)
+}
+
 func aggregateAdminMediaLibraryPayload(
 	ctx context.Context,
 	collection *mongo.Collection,
@@ -394,20 +659,7 @@ func aggregateAdminMediaLibraryPayload(
 
 	items := make([]domain.AdminMediaLibraryItem, 0, len(result.Items))
 	for _, item := range result.Items {
-		items = append(items, domain.AdminMediaLibraryItem{
-			ID:          strings.TrimSpace(item.ID),
-			Kind:        strings.TrimSpace(item.Kind),
-			Name:        strings.TrimSpace(item.Name),
-			Value:       strings.TrimSpace(item.Value),
-			PreviewURL:  strings.TrimSpace(item.PreviewURL),
-			ContentType: strings.TrimSpace(item.ContentType),
-			Width:       item.Width,
-			Height:      item.Height,
-			SizeBytes:   item.SizeBytes,
-			UsageCount:  item.UsageCount,
-			CreatedAt:   item.CreatedAt,
-			UpdatedAt:   item.UpdatedAt,
-		})
+		items = append(items, mapAdminMediaLibraryItemDocument(item))
 	}
 
 	total := 0
@@ -423,16 +675,32 @@ func aggregateAdminMediaLibraryPayload(
 	}, nil
 }
 
-func buildUploadedMediaLibraryPipeline(postsCollectionName, query string) mongo.Pipeline {
-	match := bson.M{}
-	if search := strings.TrimSpace(query); search != "" {
-		match["name"] = primitive.Regex{Pattern: regexp.QuoteMeta(search), Options: "i"}
+func mapAdminMediaLibraryItemDocument(item adminMediaLibraryItemDocument) domain.AdminMediaLibraryItem {
+	return domain.AdminMediaLibraryItem{
+		ID:            strings.TrimSpace(item.ID),
+		Kind:          strings.TrimSpace(item.Kind),
+		Name:          strings.TrimSpace(item.Name),
+		Value:         strings.TrimSpace(item.Value),
+		PreviewURL:    strings.TrimSpace(item.PreviewURL),
+		ContentType:   strings.TrimSpace(item.ContentType),
+		Width:         item.Width,
+		Height:        item.Height,
+		Placeholder:   mapAdminMediaPlaceholderDocument(item.Placeholder),
+		Metadata:      mapAdminMediaMetadataDocument(item.Metadata),
+		SizeBytes:     item.SizeBytes,
+		UsageCount:    item.UsageCount,
+		QuarantinedAt: item.QuarantinedAt,
+		PurgeAfter:    item.PurgeAfter,
+		CreatedAt:     item.CreatedAt,
+		UpdatedAt:     item.UpdatedAt,
 	}
+}
 
+func buildUploadedMediaLibraryPipeline(postsCollectionName string, filter domain.AdminMediaLibraryFilter) mongo.Pipeline {
 	valueExpr := bson.M{"$concat": bson.A{"/api/media/", "$id"}}
 
 	return mongo.Pipeline{
-		bson.D{{Key: "$match", Value: match}},
+		bson.D{{Key: "$match", Value: buildUploadedMediaLibraryFilter(filter)}},
 		bson.D{{Key: "$lookup", Value: bson.M{
 			"from": postsCollectionName,
 			"let":  bson.M{"thumbnailValue": valueExpr},
@@ -459,24 +727,56 @@ func buildUploadedMediaLibraryPipeline(postsCollectionName, query string) mongo.
 			"sortName":   bson.M{"$toLower": "$name"},
 		}}},
 		bson.D{{Key: "$project", Value: bson.M{
-			"_id":         0,
-			"id":          "$id",
-			"kind":        1,
-			"name":        "$name",
-			"value":       1,
-			"previewUrl":  1,
-			"contentType": "$contentType",
-			"width":       "$width",
-			"height":      "$height",
-			"sizeBytes":   "$sizeBytes",
-			"usageCount":  1,
-			"createdAt":   "$createdAt",
-			"updatedAt":   "$updatedAt",
-			"sortName":    1,
+			"_id":           0,
+			"id":            "$id",
+			"kind":          1,
+			"name":          "$name",
+			"value":         1,
+			"previewUrl":    1,
+			"contentType":   "$contentType",
+			"width":         "$width",
+			"height":        "$height",
+			"placeholder":   "$placeholder",
+			"metadata":      "$metadata",
+			"sizeBytes":     "$sizeBytes",
+			"usageCount":    1,
+			"quarantinedAt": "$quarantinedAt",
+			"purgeAfter":    "$purgeAfter",
+			"createdAt":     "$createdAt",
+			"updatedAt":     "$updatedAt",
+			"sortName":      1,
 		}}},
 	}
 }
 
+// buildUploadedMediaLibraryFilter matches uploaded assets against the library search, tag, folder and
+// quarantine filters.
+func buildUploadedMediaLibraryFilter(filter domain.AdminMediaLibraryFilter) bson.M {
+	match := bson.M{}
+	if search := strings.TrimSpace(filter.Query); search != "" {
+		regex := primitive.Regex{Pattern: regexp.QuoteMeta(search), Options: "i"}
+		match["$or"] = bson.A{
+			bson.M{"name": regex},
+			bson.M{"metadata.caption": regex},
+			bson.M{"metadata.credit": regex},
+			bson.M{"metadata.altTexts.text": regex},
+			bson.M{"metadata.tags": regex},
+		}
+	}
+	if tag := strings.TrimSpace(filter.Tag); tag != "" {
+		match["metadata.tags"] = tag
+	}
+	if folder := strings.Trim(strings.TrimSpace(filter.Folder), "/"); folder != "" {
+		// A folder filter includes its subfolders.
+		match["metadata.folder"] = primitive.Regex{Pattern: "^" + regexp.QuoteMeta(folder) + "(?:/|$)"}
+	}
+	if filter.Quarantined {
+		match["quarantinedAt"] = bson.M{"$type": "date"}
+	}
+
+	return match
+}
+
 func buildReferencedMediaLibraryPipeline(query string) mongo.Pipeline {
 	match := bson.M{
 		"thumbnail": bson.M{
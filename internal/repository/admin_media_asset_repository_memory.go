@@ -0,0 +1,757 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var memoryUploadedMediaValuePattern = regexp.MustCompile(`(?i)^/api/media(?:/|\?)`)
+
+type adminMediaAssetMemoryRepository struct {
+	store *MemoryStore
+}
+
+// memoryMediaLibraryItem is a library row together with the lowercase name the library sorts by.
+type memoryMediaLibraryItem struct {
+	document adminMediaLibraryItemDocument
+	sortName string
+}
+
+// NewAdminMediaAssetMemoryRepository returns an admin media asset repository backed by the given in-memory store.
+func NewAdminMediaAssetMemoryRepository(store *MemoryStore) AdminMediaAssetRepository {
+	return &adminMediaAssetMemoryRepository{store: store}
+}
+
+func (r *adminMediaAssetMemoryRepository) ListMediaLibraryItems(
+	_ context.Context,
+	filter domain.AdminMediaLibraryFilter,
+) (*domain.AdminMediaLibraryListPayload, error) {
+	resolvedKind := strings.TrimSpace(strings.ToUpper(filter.Kind))
+	resolvedPage := filter.Page
+	if resolvedPage <= 0 {
+		resolvedPage = 1
+	}
+	resolvedSize := filter.Size
+	if resolvedSize <= 0 {
+		resolvedSize = 10
+	}
+
+	// Tags, folders and quarantine only exist on uploaded assets, so referenced thumbnails never match them.
+	if strings.TrimSpace(filter.Tag) != "" || strings.TrimSpace(filter.Folder) != "" || filter.Quarantined {
+		if resolvedKind == "REFERENCE" {
+			return &domain.AdminMediaLibraryListPayload{
+				Items: []domain.AdminMediaLibraryItem{},
+				Page:  resolvedPage,
+				Size:  resolvedSize,
+			}, nil
+		}
+		resolvedKind = "UPLOADED"
+	}
+
+	r.store.mu.RLock()
+	rows := make([]memoryMediaLibraryItem, 0)
+	if resolvedKind != "REFERENCE" {
+		rows = append(rows, r.listUploadedMediaLibraryItems(filter)...)
+	}
+	if resolvedKind != "UPLOADED" {
+		rows = append(rows, r.listReferencedMediaLibraryItems(filter.Query)...)
+	}
+	r.store.mu.RUnlock()
+
+	slices.SortStableFunc(rows, compareMemoryMediaLibraryItems(buildAdminMediaLibrarySortDocument(filter.Sort)))
+
+	items := make([]domain.AdminMediaLibraryItem, 0, resolvedSize)
+	for _, row := range memoryPage(rows, resolvedPage, resolvedSize) {
+		items = append(items, mapAdminMediaLibraryItemDocument(row.document))
+	}
+
+	return &domain.AdminMediaLibraryListPayload{
+		Items: items,
+		Total: len(rows),
+		Page:  resolvedPage,
+		Size:  resolvedSize,
+	}, nil
+}
+
+func (r *adminMediaAssetMemoryRepository) FindMediaAssetByID(
+	_ context.Context,
+	id string,
+) (*domain.AdminMediaAssetRecord, error) {
+	resolvedID := strings.TrimSpace(id)
+
+	return r.findMediaAsset(func(doc adminMediaAssetDocument) bool { return doc.ID == resolvedID }), nil
+}
+
+func (r *adminMediaAssetMemoryRepository) FindMediaAssetByDigest(
+	_ context.Context,
+	digest string,
+) (*domain.AdminMediaAssetRecord, error) {
+	resolvedDigest := strings.TrimSpace(strings.ToLower(digest))
+
+	return r.findMediaAsset(func(doc adminMediaAssetDocument) bool { return doc.Digest == resolvedDigest }), nil
+}
+
+// FindMediaAssetPreview loads an asset's dimensions, placeholder and alt texts without its inline image data.
+func (r *adminMediaAssetMemoryRepository) FindMediaAssetPreview(
+	_ context.Context,
+	id string,
+) (*domain.AdminMediaAssetRecord, error) {
+	resolvedID := strings.TrimSpace(id)
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, doc := range r.store.mediaAssets {
+		if doc.ID != resolvedID {
+			continue
+		}
+
+		record := mapAdminMediaAssetDocument(adminMediaAssetDocument{
+			ID:          doc.ID,
+			ContentType: doc.ContentType,
+			Width:       doc.Width,
+			Height:      doc.Height,
+			Placeholder: doc.Placeholder,
+			Metadata:    adminMediaMetadataDocument{AltTexts: doc.Metadata.AltTexts},
+		})
+		return &record, nil
+	}
+
+	return nil, nil
+}
+
+// ListMediaLibraryFacets returns the folders and tags in use so the admin panel can offer them as filters.
+func (r *adminMediaAssetMemoryRepository) ListMediaLibraryFacets(_ context.Context) (*domain.AdminMediaLibraryFacets, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	folders := make([]any, 0, len(r.store.mediaAssets))
+	tags := make([]any, 0, len(r.store.mediaAssets))
+	for _, doc := range r.store.mediaAssets {
+		folders = append(folders, doc.Metadata.Folder)
+		for _, tag := range doc.Metadata.Tags {
+			tags = append(tags, tag)
+		}
+	}
+
+	return &domain.AdminMediaLibraryFacets{
+		Folders: collectSortedDistinctStrings(folders),
+		Tags:    collectSortedDistinctStrings(tags),
+	}, nil
+}
+
+func (r *adminMediaAssetMemoryRepository) CountMediaAssetUsage(_ context.Context, value string) (int, error) {
+	resolvedValue := strings.TrimSpace(value)
+	if resolvedValue == "" {
+		return 0, nil
+	}
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return r.store.countThumbnailUsage(resolvedValue), nil
+}
+
+func (r *adminMediaAssetMemoryRepository) CreateMediaAsset(
+	_ context.Context,
+	record domain.AdminMediaAssetRecord,
+) (*domain.AdminMediaAssetRecord, error) {
+	now := record.CreatedAt.UTC()
+	if now.IsZero() {
+		now = time.Now().UTC()
+	}
+	updatedAt := record.UpdatedAt.UTC()
+	if updatedAt.IsZero() {
+		updatedAt = now
+	}
+
+	document := adminMediaAssetDocument{
+		ID:          strings.TrimSpace(record.ID),
+		Name:        strings.TrimSpace(record.Name),
+		ContentType: strings.TrimSpace(record.ContentType),
+		Digest:      strings.TrimSpace(strings.ToLower(record.Digest)),
+		SizeBytes:   record.SizeBytes,
+		Width:       record.Width,
+		Height:      record.Height,
+		Data:        append([]byte(nil), record.Data...),
+		Placeholder: buildAdminMediaPlaceholderDocument(record.Placeholder),
+		Metadata:    buildAdminMediaMetadataDocument(record.Metadata),
+		Storage:     resolveAdminMediaAssetStorage(record.Storage),
+		StorageKey:  strings.TrimSpace(record.StorageKey),
+		CreatedBy:   strings.TrimSpace(record.CreatedBy),
+		CreatedAt:   now,
+		UpdatedAt:   updatedAt,
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	// Ids and digests are unique, like the Mongo indexes on the collection.
+	if slices.ContainsFunc(r.store.mediaAssets, func(existing adminMediaAssetDocument) bool {
+		return existing.ID == document.ID || (document.Digest != "" && existing.Digest == document.Digest)
+	}) {
+		return nil, fmt.Errorf("media asset %q already exists", document.ID)
+	}
+	r.store.mediaAssets = append(r.store.mediaAssets, document)
+
+	created := record
+	created.CreatedAt = now
+	created.UpdatedAt = updatedAt
+	return &created, nil
+}
+
+func (r *adminMediaAssetMemoryRepository) ReplaceMediaAsset(
+	_ context.Context,
+	record domain.AdminMediaAssetRecord,
+) (*domain.AdminMediaAssetRecord, error) {
+	resolvedID := strings.TrimSpace(record.ID)
+	if resolvedID == "" {
+		return nil, ErrAdminMediaAssetNotFound
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	doc := r.store.findMediaAsset(resolvedID)
+	if doc == nil {
+		return nil, ErrAdminMediaAssetNotFound
+	}
+
+	doc.Name = strings.TrimSpace(record.Name)
+	doc.ContentType = strings.TrimSpace(record.ContentType)
+	doc.Digest = strings.TrimSpace(strings.ToLower(record.Digest))
+	doc.SizeBytes = record.SizeBytes
+	doc.Width = record.Width
+	doc.Height = record.Height
+	doc.Data = append([]byte(nil), record.Data...)
+	doc.Placeholder = buildAdminMediaPlaceholderDocument(record.Placeholder)
+	doc.Storage = resolveAdminMediaAssetStorage(record.Storage)
+	doc.StorageKey = strings.TrimSpace(record.StorageKey)
+	doc.UpdatedAt = record.UpdatedAt.UTC()
+	r.store.deleteStaleMediaAssetVariants(resolvedID, record.Digest)
+
+	replaced := record
+	return &replaced, nil
+}
+
+func (r *adminMediaAssetMemoryRepository) UpdateMediaAssetMetadata(
+	_ context.Context,
+	id string,
+	metadata domain.AdminMediaAssetMetadata,
+	updatedAt time.Time,
+) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	doc := r.store.findMediaAsset(strings.TrimSpace(id))
+	if doc == nil {
+		return ErrAdminMediaAssetNotFound
+	}
+
+	doc.Metadata = buildAdminMediaMetadataDocument(metadata)
+	doc.UpdatedAt = updatedAt.UTC()
+	return nil
+}
+
+func (r *adminMediaAssetMemoryRepository) MoveMediaAssetsToFolder(
+	_ context.Context,
+	ids []string,
+	folder string,
+	updatedAt time.Time,
+) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	matched := 0
+	for index := range r.store.mediaAssets {
+		doc := &r.store.mediaAssets[index]
+		if !slices.Contains(ids, doc.ID) {
+			continue
+		}
+		doc.Metadata.Folder = strings.TrimSpace(folder)
+		doc.UpdatedAt = updatedAt.UTC()
+		matched++
+	}
+
+	return matched, nil
+}
+
+func (r *adminMediaAssetMemoryRepository) DeleteMediaAssetByID(_ context.Context, id string) (bool, error) {
+	resolvedID := strings.TrimSpace(id)
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return r.store.deleteMediaAsset(func(doc adminMediaAssetDocument) bool { return doc.ID == resolvedID }), nil
+}
+
+func (r *adminMediaAssetMemoryRepository) FindMediaAssetVariant(
+	_ context.Context,
+	assetID string,
+	digest string,
+	width int,
+	contentType string,
+) (*domain.AdminMediaAssetVariant, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	index := r.store.indexOfMediaAssetVariant(assetID, digest, width, contentType)
+	if index < 0 {
+		return nil, nil
+	}
+
+	doc := r.store.mediaVariants[index]
+	return &domain.AdminMediaAssetVariant{
+		AssetID:     strings.TrimSpace(doc.AssetID),
+		Digest:      strings.TrimSpace(doc.Digest),
+		Width:       doc.Width,
+		Height:      doc.Height,
+		ContentType: strings.TrimSpace(doc.ContentType),
+		Data:        append([]byte(nil), doc.Data...),
+		CreatedAt:   doc.CreatedAt,
+	}, nil
+}
+
+func (r *adminMediaAssetMemoryRepository) UpsertMediaAssetVariant(
+	_ context.Context,
+	variant domain.AdminMediaAssetVariant,
+) error {
+	createdAt := variant.CreatedAt.UTC()
+	if createdAt.IsZero() {
+		createdAt = time.Now().UTC()
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	index := r.store.indexOfMediaAssetVariant(variant.AssetID, variant.Digest, variant.Width, variant.ContentType)
+	if index >= 0 {
+		r.store.mediaVariants[index].Height = variant.Height
+		r.store.mediaVariants[index].Data = append([]byte(nil), variant.Data...)
+		return nil
+	}
+
+	r.store.mediaVariants = append(r.store.mediaVariants, adminMediaAssetVariantDocument{
+		AssetID:     strings.TrimSpace(variant.AssetID),
+		Digest:      strings.TrimSpace(strings.ToLower(variant.Digest)),
+		Width:       variant.Width,
+		Height:      variant.Height,
+		ContentType: strings.TrimSpace(variant.ContentType),
+		Data:        append([]byte(nil), variant.Data...),
+		CreatedAt:   createdAt,
+	})
+	return nil
+}
+
+// ListMediaAssetsOutsideStorage returns assets whose blob is not yet held by backend.
+func (r *adminMediaAssetMemoryRepository) ListMediaAssetsOutsideStorage(
+	_ context.Context,
+	backend string,
+	limit int,
+) ([]domain.AdminMediaAssetRecord, error) {
+	resolvedBackend := resolveAdminMediaAssetStorage(backend)
+
+	records := r.listMediaAssets(func(doc adminMediaAssetDocument) bool {
+		if resolvedBackend == appconfig.MediaStorageInline {
+			return doc.Storage != "" && doc.Storage != appconfig.MediaStorageInline
+		}
+		return doc.Storage != resolvedBackend
+	}, true)
+	if limit > 0 && len(records) > limit {
+		records = records[:limit]
+	}
+
+	return records, nil
+}
+
+// UpdateMediaAssetStorage points an asset at its new blob location. The digest guards against
+// overwriting an asset that was replaced while its blob was being copied.
+func (r *adminMediaAssetMemoryRepository) UpdateMediaAssetStorage(
+	_ context.Context,
+	record domain.AdminMediaAssetRecord,
+	storage string,
+	storageKey string,
+	data []byte,
+) (bool, error) {
+	resolvedDigest := strings.TrimSpace(strings.ToLower(record.Digest))
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	doc := r.store.findMediaAsset(strings.TrimSpace(record.ID))
+	if doc == nil || doc.Digest != resolvedDigest {
+		return false, nil
+	}
+
+	doc.Storage = resolveAdminMediaAssetStorage(storage)
+	doc.StorageKey = strings.TrimSpace(storageKey)
+	doc.Data = nil
+	if len(data) > 0 {
+		doc.Data = append([]byte(nil), data...)
+	}
+	return true, nil
+}
+
+// ListReferencedMediaAssetIDs returns the ids of every uploaded asset referenced by posts, revisions, topics or
+// categories, whether as a thumbnail, inside markdown content or as an icon or link.
+func (r *adminMediaAssetMemoryRepository) ListReferencedMediaAssetIDs(_ context.Context) ([]string, error) {
+	r.store.mu.RLock()
+	referenced := map[string]struct{}{}
+	for _, post := range r.store.posts {
+		extractMediaAssetReferenceIDs(post.Thumbnail, referenced)
+		extractMediaAssetReferenceIDs(post.Content, referenced)
+	}
+	for _, revision := range r.store.postRevisions {
+		extractMediaAssetReferenceIDs(revision.Thumbnail, referenced)
+		extractMediaAssetReferenceIDs(revision.Content, referenced)
+	}
+	for _, topic := range r.store.topics {
+		extractMediaAssetReferenceIDs(topic.Link, referenced)
+	}
+	for _, category := range r.store.categories {
+		extractMediaAssetReferenceIDs(category.Icon, referenced)
+		extractMediaAssetReferenceIDs(category.Link, referenced)
+	}
+	r.store.mu.RUnlock()
+
+	ids := make([]string, 0, len(referenced))
+	for id := range referenced {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids, nil
+}
+
+// ListMediaAssetsForGarbageCollection returns every uploaded asset without its inline image data.
+func (r *adminMediaAssetMemoryRepository) ListMediaAssetsForGarbageCollection(
+	_ context.Context,
+) ([]domain.AdminMediaAssetRecord, error) {
+	return r.listMediaAssets(func(adminMediaAssetDocument) bool { return true }, false), nil
+}
+
+// QuarantineMediaAssets marks assets as unused until purgeAfter. Assets that are already quarantined keep their
+// original deadline.
+func (r *adminMediaAssetMemoryRepository) QuarantineMediaAssets(
+	_ context.Context,
+	ids []string,
+	quarantinedAt time.Time,
+	purgeAfter time.Time,
+) (int, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	modified := 0
+	for index := range r.store.mediaAssets {
+		doc := &r.store.mediaAssets[index]
+		if !slices.Contains(ids, doc.ID) || !doc.QuarantinedAt.IsZero() {
+			continue
+		}
+		doc.QuarantinedAt = quarantinedAt.UTC()
+		doc.PurgeAfter = purgeAfter.UTC()
+		modified++
+	}
+
+	return modified, nil
+}
+
+// RestoreMediaAssets takes assets out of quarantine and returns how many were quarantined.
+func (r *adminMediaAssetMemoryRepository) RestoreMediaAssets(_ context.Context, ids []string) (int, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	modified := 0
+	for index := range r.store.mediaAssets {
+		doc := &r.store.mediaAssets[index]
+		if !slices.Contains(ids, doc.ID) || doc.QuarantinedAt.IsZero() {
+			continue
+		}
+		doc.QuarantinedAt = time.Time{}
+		doc.PurgeAfter = time.Time{}
+		modified++
+	}
+
+	return modified, nil
+}
+
+// DeleteQuarantinedMediaAsset hard-deletes an asset whose grace period ended before now. It reports false when the
+// asset was restored or removed in the meantime.
+func (r *adminMediaAssetMemoryRepository) DeleteQuarantinedMediaAsset(
+	_ context.Context,
+	id string,
+	now time.Time,
+) (bool, error) {
+	resolvedID := strings.TrimSpace(id)
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return r.store.deleteMediaAsset(func(doc adminMediaAssetDocument) bool {
+		return doc.ID == resolvedID && !doc.QuarantinedAt.IsZero() && !doc.PurgeAfter.After(now.UTC())
+	}), nil
+}
+
+func (r *adminMediaAssetMemoryRepository) findMediaAsset(
+	match func(adminMediaAssetDocument) bool,
+) *domain.AdminMediaAssetRecord {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, doc := range r.store.mediaAssets {
+		if match(doc) {
+			record := mapAdminMediaAssetDocument(doc)
+			return &record
+		}
+	}
+
+	return nil
+}
+
+// listMediaAssets returns the matching assets oldest first, with or without their inline data.
+func (r *adminMediaAssetMemoryRepository) listMediaAssets(
+	match func(adminMediaAssetDocument) bool,
+	withData bool,
+) []domain.AdminMediaAssetRecord {
+	r.store.mu.RLock()
+	docs := make([]adminMediaAssetDocument, 0)
+	for _, doc := range r.store.mediaAssets {
+		if !match(doc) {
+			continue
+		}
+		if !withData {
+			doc.Data = nil
+		}
+		docs = append(docs, doc)
+	}
+	r.store.mu.RUnlock()
+
+	slices.SortStableFunc(docs, func(left, right adminMediaAssetDocument) int {
+		return left.CreatedAt.Compare(right.CreatedAt)
+	})
+
+	records := make([]domain.AdminMediaAssetRecord, 0, len(docs))
+	for _, doc := range docs {
+		records = append(records, mapAdminMediaAssetDocument(doc))
+	}
+	return records
+}
+
+// listUploadedMediaLibraryItems builds the library rows of uploaded assets. The caller must hold the lock.
+func (r *adminMediaAssetMemoryRepository) listUploadedMediaLibraryItems(
+	filter domain.AdminMediaLibraryFilter,
+) []memoryMediaLibraryItem {
+	query := buildUploadedMediaLibraryFilter(filter)
+
+	rows := make([]memoryMediaLibraryItem, 0)
+	for _, doc := range r.store.mediaAssets {
+		altTexts := make([]string, 0, len(doc.Metadata.AltTexts))
+		for _, altText := range doc.Metadata.AltTexts {
+			altTexts = append(altTexts, altText.Text)
+		}
+		filterDocument := bson.M{
+			"name":                   doc.Name,
+			"metadata.caption":       doc.Metadata.Caption,
+			"metadata.credit":        doc.Metadata.Credit,
+			"metadata.altTexts.text": altTexts,
+			"metadata.tags":          doc.Metadata.Tags,
+			"metadata.folder":        doc.Metadata.Folder,
+		}
+		if !doc.QuarantinedAt.IsZero() {
+			filterDocument["quarantinedAt"] = doc.QuarantinedAt
+		}
+		if !matchMemoryFilter(filterDocument, query) {
+			continue
+		}
+
+		value := "/api/media/" + doc.ID
+		rows = append(rows, memoryMediaLibraryItem{
+			document: adminMediaLibraryItemDocument{
+				ID:            doc.ID,
+				Kind:          "UPLOADED",
+				Name:          doc.Name,
+				Value:         value,
+				PreviewURL:    value,
+				ContentType:   doc.ContentType,
+				Width:         doc.Width,
+				Height:        doc.Height,
+				Placeholder:   doc.Placeholder,
+				Metadata:      doc.Metadata,
+				SizeBytes:     doc.SizeBytes,
+				UsageCount:    r.store.countThumbnailUsage(value),
+				QuarantinedAt: doc.QuarantinedAt,
+				PurgeAfter:    doc.PurgeAfter,
+				CreatedAt:     doc.CreatedAt,
+				UpdatedAt:     doc.UpdatedAt,
+			},
+			sortName: strings.ToLower(doc.Name),
+		})
+	}
+
+	return rows
+}
+
+// listReferencedMediaLibraryItems groups the external thumbnails posts point at into library rows. The caller must
+// hold the lock.
+func (r *adminMediaAssetMemoryRepository) listReferencedMediaLibraryItems(query string) []memoryMediaLibraryItem {
+	search := strings.TrimSpace(query)
+
+	type reference struct {
+		title     string
+		updatedAt time.Time
+		postIDs   map[string]struct{}
+	}
+
+	references := make(map[string]*reference)
+	values := make([]string, 0)
+	for _, post := range r.store.posts {
+		if post.Thumbnail == "" || memoryUploadedMediaValuePattern.MatchString(post.Thumbnail) {
+			continue
+		}
+		if search != "" && !memoryContainsFold(post.Thumbnail, search) && !memoryContainsFold(post.Title, search) {
+			continue
+		}
+
+		current, exists := references[post.Thumbnail]
+		if !exists {
+			current = &reference{title: post.Title, postIDs: map[string]struct{}{}}
+			references[post.Thumbnail] = current
+			values = append(values, post.Thumbnail)
+		}
+		if post.UpdatedAt.After(current.updatedAt) {
+			current.updatedAt = post.UpdatedAt
+		}
+		current.postIDs[post.ID] = struct{}{}
+	}
+
+	rows := make([]memoryMediaLibraryItem, 0, len(values))
+	for _, value := range values {
+		current := references[value]
+		name := resolveMemoryReferencedMediaName(current.title, value)
+		rows = append(rows, memoryMediaLibraryItem{
+			document: adminMediaLibraryItemDocument{
+				ID:         "ref:" + value,
+				Kind:       "REFERENCE",
+				Name:       name,
+				Value:      value,
+				PreviewURL: value,
+				UsageCount: len(current.postIDs),
+				CreatedAt:  current.updatedAt,
+				UpdatedAt:  current.updatedAt,
+			},
+			sortName: strings.ToLower(name),
+		})
+	}
+
+	return rows
+}
+
+// countThumbnailUsage counts the distinct posts using value as their thumbnail. The caller must hold the lock.
+func (s *MemoryStore) countThumbnailUsage(value string) int {
+	postIDs := make(map[string]struct{})
+	for _, post := range s.posts {
+		if post.Thumbnail == value {
+			postIDs[post.ID] = struct{}{}
+		}
+	}
+
+	return len(postIDs)
+}
+
+// findMediaAsset returns the stored asset for in-place updates. The caller must hold the lock.
+func (s *MemoryStore) findMediaAsset(id string) *adminMediaAssetDocument {
+	for index := range s.mediaAssets {
+		if s.mediaAssets[index].ID == id {
+			return &s.mediaAssets[index]
+		}
+	}
+
+	return nil
+}
+
+// deleteMediaAsset removes the first matching asset together with its cached variants. The caller must hold the
+// lock.
+func (s *MemoryStore) deleteMediaAsset(match func(adminMediaAssetDocument) bool) bool {
+	index := slices.IndexFunc(s.mediaAssets, match)
+	if index < 0 {
+		return false
+	}
+
+	assetID := s.mediaAssets[index].ID
+	s.mediaAssets = slices.Delete(s.mediaAssets, index, index+1)
+	s.deleteStaleMediaAssetVariants(assetID, "")
+	return true
+}
+
+// deleteStaleMediaAssetVariants drops cached variants of an asset that no longer match keepDigest. An empty
+// keepDigest removes every variant of the asset. The caller must hold the lock.
+func (s *MemoryStore) deleteStaleMediaAssetVariants(assetID, keepDigest string) {
+	resolvedAssetID := strings.TrimSpace(assetID)
+	resolvedDigest := strings.TrimSpace(strings.ToLower(keepDigest))
+	s.mediaVariants = slices.DeleteFunc(s.mediaVariants, func(doc adminMediaAssetVariantDocument) bool {
+		return doc.AssetID == resolvedAssetID && (resolvedDigest == "" || doc.Digest != resolvedDigest)
+	})
+}
+
+// indexOfMediaAssetVariant finds a cached variant by its unique key. The caller must hold the lock.
+func (s *MemoryStore) indexOfMediaAssetVariant(assetID, digest string, width int, contentType string) int {
+	key := buildAdminMediaAssetVariantFilter(assetID, digest, width, contentType)
+
+	return slices.IndexFunc(s.mediaVariants, func(doc adminMediaAssetVariantDocument) bool {
+		return doc.AssetID == key["assetId"] && doc.Digest == key["digest"] && doc.Width == width &&
+			doc.ContentType == key["contentType"]
+	})
+}
+
+// resolveMemoryReferencedMediaName names a referenced thumbnail after its post title, falling back to the file
+// name without extension, like the Mongo reference pipeline.
+func resolveMemoryReferencedMediaName(title, value string) string {
+	if trimmedTitle := strings.TrimSpace(title); trimmedTitle != "" {
+		return trimmedTitle
+	}
+
+	segments := strings.Split(value, "/")
+	fileName, _, _ := strings.Cut(segments[len(segments)-1], ".")
+	return strings.TrimSpace(strings.NewReplacer("-", " ", "_", " ").Replace(fileName))
+}
+
+// compareMemoryMediaLibraryItems orders library rows by the same keys as buildAdminMediaLibrarySortDocument.
+func compareMemoryMediaLibraryItems(sortDocument bson.D) func(left, right memoryMediaLibraryItem) int {
+	field := func(item memoryMediaLibraryItem, key string) any {
+		switch key {
+		case "sortName":
+			return item.sortName
+		case "updatedAt":
+			return item.document.UpdatedAt
+		case "usageCount":
+			return item.document.UsageCount
+		default:
+			return item.document.SizeBytes
+		}
+	}
+
+	return func(left, right memoryMediaLibraryItem) int {
+		for _, element := range sortDocument {
+			order, _ := compareMemoryFilterValues(field(left, element.Key), field(right, element.Key))
+			if direction, _ := element.Value.(int); direction < 0 {
+				order = -order
+			}
+			if order != 0 {
+				return order
+			}
+		}
+		return cmp.Compare(left.document.ID, right.document.ID)
+	}
+}
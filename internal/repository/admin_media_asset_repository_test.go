@@ -4,7 +4,10 @@ import (
 	"reflect"
 	"testing"
 
+	"suaybsimsek.com/blog-api/internal/domain"
+
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 func TestBuildAdminMediaLibrarySortDocument(t *testing.T) {
@@ -73,3 +76,63 @@ func TestBuildAdminMediaLibrarySortDocument(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildUploadedMediaLibraryPipelineFiltersMetadata(t *testing.T) {
+	t.Parallel()
+
+	pipeline := buildUploadedMediaLibraryPipeline("posts", domain.AdminMediaLibraryFilter{
+		Query:  "harbor",
+		Tag:    "travel",
+		Folder: "/blog/2026/",
+	})
+	match, ok := pipeline[0][0].Value.(bson.M)
+	if !ok || pipeline[0][0].Key != "$match" {
+		t.Fatalf("expected leading $match stage, got %#v", pipeline[0])
+	}
+
+	if match["metadata.tags"] != "travel" {
+		t.Fatalf("expected tag filter, got %#v", match["metadata.tags"])
+	}
+	folder, ok := match["metadata.folder"].(primitive.Regex)
+	if !ok || folder.Pattern != "^blog/2026(?:/|$)" {
+		t.Fatalf("expected folder prefix filter, got %#v", match["metadata.folder"])
+	}
+	search, ok := match["$or"].(bson.A)
+	if !ok || len(search) != 5 {
+		t.Fatalf("expected search across name and metadata fields, got %#v", match["$or"])
+	}
+	if _, ok := match["quarantinedAt"]; ok {
+		t.Fatalf("expected no quarantine filter by default, got %#v", match["quarantinedAt"])
+	}
+
+	quarantined := buildUploadedMediaLibraryPipeline("posts", domain.AdminMediaLibraryFilter{Quarantined: true})
+	if match := quarantined[0][0].Value.(bson.M); !reflect.DeepEqual(match["quarantinedAt"], bson.M{"$type": "date"}) {
+		t.Fatalf("expected quarantine filter, got %#v", match["quarantinedAt"])
+	}
+}
+
+func TestCollectSortedDistinctStrings(t *testing.T) {
+	t.Parallel()
+
+	actual := collectSortedDistinctStrings([]any{"travel", " go ", "", nil, 3, "go"})
+	if !reflect.DeepEqual(actual, []string{"go", "travel"}) {
+		t.Fatalf("unexpected values %#v", actual)
+	}
+}
+
+func TestExtractMediaAssetReferenceIDs(t *testing.T) {
+	t.Parallel()
+
+	referenced := map[string]struct{}{}
+	extractMediaAssetReferenceIDs("/api/media/cover-1", referenced)
+	extractMediaAssetReferenceIDs(
+		"![One](/api/media/inline-1?w=640) and ![Two](https://blog.example.com/api/media/inline_2)\n[doc](/api/mediakit)",
+		referenced,
+	)
+	extractMediaAssetReferenceIDs("https://cdn.example.com/icons/go.svg", referenced)
+
+	expected := map[string]struct{}{"cover-1": {}, "inline-1": {}, "inline_2": {}}
+	if !reflect.DeepEqual(referenced, expected) {
+		t.Fatalf("unexpected references %#v", referenced)
+	}
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ListMediaAssetsOutsideStorage returns assets whose blob is not yet held by backend.
+// Documents written before pluggable storage have no storage field and count as inline.
+func (repo *adminMediaAssetMongoRepository) ListMediaAssetsOutsideStorage(
+	ctx context.Context,
+	backend string,
+	limit int,
+) ([]domain.AdminMediaAssetRecord, error) {
+	mediaCollection, err := getPostMediaAssetsCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminMediaAssetRepositoryUnavailableFormat, ErrAdminMediaAssetRepositoryUnavailable, err)
+	}
+
+	filter := bson.M{"storage": bson.M{"$ne": resolveAdminMediaAssetStorage(backend)}}
+	if resolveAdminMediaAssetStorage(backend) == appconfig.MediaStorageInline {
+		filter = bson.M{"storage": bson.M{"$exists": true, "$nin": bson.A{"", appconfig.MediaStorageInline}}}
+	}
+
+	findOptions := options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}})
+	if limit > 0 {
+		findOptions.SetLimit(int64(limit))
+	}
+
+	cursor, err := mediaCollection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	var docs []adminMediaAssetDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	records := make([]domain.AdminMediaAssetRecord, 0, len(docs))
+	for _, doc := range docs {
+		records = append(records, mapAdminMediaAssetDocument(doc))
+	}
+	return records, nil
+}
+
+// UpdateMediaAssetStorage points an asset at its new blob location. The digest guards against
+// overwriting an asset that was replaced while its blob was being copied.
+func (repo *adminMediaAssetMongoRepository) UpdateMediaAssetStorage(
+	ctx context.Context,
+	record domain.AdminMediaAssetRecord,
+	storage string,
+	storageKey string,
+	data []byte,
+) (bool, error) {
+	mediaCollection, err := getPostMediaAssetsCollection(repo.client)
+	if err != nil {
+		return false, fmt.Errorf(adminMediaAssetRepositoryUnavailableFormat, ErrAdminMediaAssetRepositoryUnavailable, err)
+	}
+
+	set := bson.M{
+		"storage":    resolveAdminMediaAssetStorage(storage),
+		"storageKey": strings.TrimSpace(storageKey),
+	}
+	update := bson.M{"$set": set}
+	if len(data) > 0 {
+		set["data"] = append([]byte(nil), data...)
+	} else {
+		update["$unset"] = bson.M{"data": ""}
+	}
+
+	result, err := mediaCollection.UpdateOne(
+		ctx,
+		bson.M{
+			"id":     strings.TrimSpace(record.ID),
+			"digest": strings.TrimSpace(strings.ToLower(record.Digest)),
+		},
+		update,
+	)
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
+
+func resolveAdminMediaAssetStorage(value string) string {
+	if backend := appconfig.NormalizeMediaStorageBackend(value); backend != "" {
+		return backend
+	}
+	return appconfig.MediaStorageInline
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (repo *adminMediaAssetMongoRepository) FindMediaAssetVariant(
+	ctx context.Context,
+	assetID string,
+	digest string,
+	width int,
+	contentType string,
+) (*domain.AdminMediaAssetVariant, error) {
+	variantsCollection, err := getPostMediaVariantsCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminMediaAssetRepositoryUnavailableFormat, ErrAdminMediaAssetRepositoryUnavailable, err)
+	}
+
+	var doc adminMediaAssetVariantDocument
+	err = variantsCollection.FindOne(
+		ctx,
+		buildAdminMediaAssetVariantFilter(assetID, digest, width, contentType),
+	).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.AdminMediaAssetVariant{
+		AssetID:     strings.TrimSpace(doc.AssetID),
+		Digest:      strings.TrimSpace(doc.Digest),
+		Width:       doc.Width,
+		Height:      doc.Height,
+		ContentType: strings.TrimSpace(doc.ContentType),
+		Data:        append([]byte(nil), doc.Data...),
+		CreatedAt:   doc.CreatedAt,
+	}, nil
+}
+
+func (repo *adminMediaAssetMongoRepository) UpsertMediaAssetVariant(
+	ctx context.Context,
+	variant domain.AdminMediaAssetVariant,
+) error {
+	variantsCollection, err := getPostMediaVariantsCollection(repo.client)
+	if err != nil {
+		return fmt.Errorf(adminMediaAssetRepositoryUnavailableFormat, ErrAdminMediaAssetRepositoryUnavailable, err)
+	}
+
+	createdAt := variant.CreatedAt.UTC()
+	if createdAt.IsZero() {
+		createdAt = time.Now().UTC()
+	}
+
+	_, err = variantsCollection.UpdateOne(
+		ctx,
+		buildAdminMediaAssetVariantFilter(variant.AssetID, variant.Digest, variant.Width, variant.ContentType),
+		bson.M{
+			"$set": bson.M{
+				"height":    variant.Height,
+				"sizeBytes": len(variant.Data),
+				"data":      append([]byte(nil), variant.Data...),
+			},
+			"$setOnInsert": bson.M{"createdAt": createdAt},
+		},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+// deleteStaleMediaAssetVariants drops cached variants of an asset that no longer match keepDigest.
+// An empty keepDigest removes every variant of the asset.
+func deleteStaleMediaAssetVariants(ctx context.Context, client *mongo.Client, assetID, keepDigest string) error {
+	variantsCollection, err := getPostMediaVariantsCollection(client)
+	if err != nil {
+		return fmt.Errorf(adminMediaAssetRepositoryUnavailableFormat, ErrAdminMediaAssetRepositoryUnavailable, err)
+	}
+
+	filter := bson.M{"assetId": strings.TrimSpace(assetID)}
+	if resolvedDigest := strings.TrimSpace(strings.ToLower(keepDigest)); resolvedDigest != "" {
+		filter["digest"] = bson.M{"$ne": resolvedDigest}
+	}
+
+	_, err = variantsCollection.DeleteMany(ctx, filter)
+	return err
+}
+
+type adminMediaAssetVariantDocument struct {
+	AssetID     string    `bson:"assetId"`
+	Digest      string    `bson:"digest"`
+	Width       int       `bson:"width"`
+	Height      int       `bson:"height"`
+	ContentType string    `bson:"contentType"`
+	Data        []byte    `bson:"data"`
+	CreatedAt   time.Time `bson:"createdAt"`
+}
+
+func buildAdminMediaAssetVariantFilter(assetID, digest string, width int, contentType string) bson.M {
+	return bson.M{
+		"assetId":     strings.TrimSpace(assetID),
+		"digest":      strings.TrimSpace(strings.ToLower(digest)),
+		"width":       width,
+		"contentType": strings.TrimSpace(contentType),
+	}
+}
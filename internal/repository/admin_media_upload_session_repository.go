@@ -0,0 +1,240 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type AdminMediaUploadSessionRepository interface {
+	CreateMediaUploadSession(
+		ctx context.Context,
+		session domain.AdminMediaUploadSession,
+	) (*domain.AdminMediaUploadSession, error)
+	FindMediaUploadSession(ctx context.Context, id string) (*domain.AdminMediaUploadSession, error)
+	AppendMediaUploadChunk(
+		ctx context.Context,
+		session domain.AdminMediaUploadSession,
+		offset int,
+		data []byte,
+	) (*domain.AdminMediaUploadSession, error)
+	ListMediaUploadChunks(ctx context.Context, id string) ([]domain.AdminMediaUploadChunk, error)
+	DeleteMediaUploadSession(ctx context.Context, id string) (bool, error)
+}
+
+type adminMediaUploadSessionMongoRepository struct {
+	client *mongo.Client
+}
+
+func NewAdminMediaUploadSessionRepository(client *mongo.Client) AdminMediaUploadSessionRepository {
+	return &adminMediaUploadSessionMongoRepository{client: client}
+}
+
+type adminMediaUploadSessionDocument struct {
+	ID            string    `bson:"_id"`
+	FileName      string    `bson:"fileName"`
+	SizeBytes     int       `bson:"sizeBytes"`
+	Checksum      string    `bson:"checksum"`
+	UploadedBytes int       `bson:"uploadedBytes"`
+	ChunkSize     int       `bson:"chunkSize"`
+	CreatedBy     string    `bson:"createdBy"`
+	CreatedAt     time.Time `bson:"createdAt"`
+	UpdatedAt     time.Time `bson:"updatedAt"`
+	ExpiresAt     time.Time `bson:"expiresAt"`
+}
+
+type adminMediaUploadChunkDocument struct {
+	SessionID string    `bson:"sessionId"`
+	Offset    int       `bson:"offset"`
+	Data      []byte    `bson:"data"`
+	CreatedAt time.Time `bson:"createdAt"`
+	ExpiresAt time.Time `bson:"expiresAt"`
+}
+
+func (repo *adminMediaUploadSessionMongoRepository) CreateMediaUploadSession(
+	ctx context.Context,
+	session domain.AdminMediaUploadSession,
+) (*domain.AdminMediaUploadSession, error) {
+	sessionsCollection, _, err := getPostMediaUploadCollections(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminMediaAssetRepositoryUnavailableFormat, ErrAdminMediaAssetRepositoryUnavailable, err)
+	}
+
+	doc := adminMediaUploadSessionDocument{
+		ID:            strings.TrimSpace(session.ID),
+		FileName:      strings.TrimSpace(session.FileName),
+		SizeBytes:     session.SizeBytes,
+		Checksum:      strings.TrimSpace(strings.ToLower(session.Checksum)),
+		UploadedBytes: 0,
+		ChunkSize:     session.ChunkSize,
+		CreatedBy:     strings.TrimSpace(session.CreatedBy),
+		CreatedAt:     session.CreatedAt.UTC(),
+		UpdatedAt:     session.CreatedAt.UTC(),
+		ExpiresAt:     session.ExpiresAt.UTC(),
+	}
+	if _, err := sessionsCollection.InsertOne(ctx, doc); err != nil {
+		return nil, err
+	}
+
+	created := mapAdminMediaUploadSession(doc)
+	return &created, nil
+}
+
+func (repo *adminMediaUploadSessionMongoRepository) FindMediaUploadSession(
+	ctx context.Context,
+	id string,
+) (*domain.AdminMediaUploadSession, error) {
+	sessionsCollection, _, err := getPostMediaUploadCollections(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminMediaAssetRepositoryUnavailableFormat, ErrAdminMediaAssetRepositoryUnavailable, err)
+	}
+
+	var doc adminMediaUploadSessionDocument
+	err = sessionsCollection.FindOne(ctx, bson.M{
+		"_id": strings.TrimSpace(id),
+		// The TTL monitor only runs once a minute, so expired sessions are filtered explicitly.
+		"expiresAt": bson.M{"$gt": time.Now().UTC()},
+	}).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	session := mapAdminMediaUploadSession(doc)
+	return &session, nil
+}
+
+// AppendMediaUploadChunk stores data at offset and advances the session only while its uploaded byte count still
+// equals offset. A nil session is returned when a concurrent writer moved the session first; the chunk written by
+// the loser is caught by the whole-file checksum when the upload completes.
+func (repo *adminMediaUploadSessionMongoRepository) AppendMediaUploadChunk(
+	ctx context.Context,
+	session domain.AdminMediaUploadSession,
+	offset int,
+	data []byte,
+) (*domain.AdminMediaUploadSession, error) {
+	sessionsCollection, chunksCollection, err := getPostMediaUploadCollections(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminMediaAssetRepositoryUnavailableFormat, ErrAdminMediaAssetRepositoryUnavailable, err)
+	}
+
+	resolvedID := strings.TrimSpace(session.ID)
+	now := time.Now().UTC()
+	_, err = chunksCollection.UpdateOne(
+		ctx,
+		bson.M{"sessionId": resolvedID, "offset": offset},
+		bson.M{
+			"$set": bson.M{
+				"data":      append([]byte(nil), data...),
+				"createdAt": now,
+				"expiresAt": session.ExpiresAt.UTC(),
+			},
+		},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc adminMediaUploadSessionDocument
+	err = sessionsCollection.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": resolvedID, "uploadedBytes": offset},
+		bson.M{
+			"$inc": bson.M{"uploadedBytes": len(data)},
+			"$set": bson.M{"updatedAt": now},
+		},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	updated := mapAdminMediaUploadSession(doc)
+	return &updated, nil
+}
+
+func (repo *adminMediaUploadSessionMongoRepository) ListMediaUploadChunks(
+	ctx context.Context,
+	id string,
+) ([]domain.AdminMediaUploadChunk, error) {
+	_, chunksCollection, err := getPostMediaUploadCollections(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminMediaAssetRepositoryUnavailableFormat, ErrAdminMediaAssetRepositoryUnavailable, err)
+	}
+
+	cursor, err := chunksCollection.Find(
+		ctx,
+		bson.M{"sessionId": strings.TrimSpace(id)},
+		options.Find().SetSort(bson.D{{Key: "offset", Value: 1}}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	chunks := make([]domain.AdminMediaUploadChunk, 0)
+	for cursor.Next(ctx) {
+		var doc adminMediaUploadChunkDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		chunks = append(chunks, domain.AdminMediaUploadChunk{
+			Offset: doc.Offset,
+			Data:   doc.Data,
+		})
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return chunks, nil
+}
+
+func (repo *adminMediaUploadSessionMongoRepository) DeleteMediaUploadSession(ctx context.Context, id string) (bool, error) {
+	sessionsCollection, chunksCollection, err := getPostMediaUploadCollections(repo.client)
+	if err != nil {
+		return false, fmt.Errorf(adminMediaAssetRepositoryUnavailableFormat, ErrAdminMediaAssetRepositoryUnavailable, err)
+	}
+
+	resolvedID := strings.TrimSpace(id)
+	result, err := sessionsCollection.DeleteOne(ctx, bson.M{"_id": resolvedID})
+	if err != nil {
+		return false, err
+	}
+	if _, err := chunksCollection.DeleteMany(ctx, bson.M{"sessionId": resolvedID}); err != nil {
+		return false, err
+	}
+
+	return result.DeletedCount > 0, nil
+}
+
+func mapAdminMediaUploadSession(doc adminMediaUploadSessionDocument) domain.AdminMediaUploadSession {
+	return domain.AdminMediaUploadSession{
+		ID:            strings.TrimSpace(doc.ID),
+		FileName:      strings.TrimSpace(doc.FileName),
+		SizeBytes:     doc.SizeBytes,
+		Checksum:      strings.TrimSpace(doc.Checksum),
+		UploadedBytes: doc.UploadedBytes,
+		ChunkSize:     doc.ChunkSize,
+		CreatedBy:     strings.TrimSpace(doc.CreatedBy),
+		CreatedAt:     doc.CreatedAt,
+		UpdatedAt:     doc.UpdatedAt,
+		ExpiresAt:     doc.ExpiresAt,
+	}
+}
@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+)
+
+type adminMediaUploadSessionMemoryRepository struct {
+	store *MemoryStore
+}
+
+// NewAdminMediaUploadSessionMemoryRepository returns an admin media upload session repository backed by the given
+// in-memory store.
+func NewAdminMediaUploadSessionMemoryRepository(store *MemoryStore) AdminMediaUploadSessionRepository {
+	return &adminMediaUploadSessionMemoryRepository{store: store}
+}
+
+func (r *adminMediaUploadSessionMemoryRepository) CreateMediaUploadSession(
+	_ context.Context,
+	session domain.AdminMediaUploadSession,
+) (*domain.AdminMediaUploadSession, error) {
+	doc := adminMediaUploadSessionDocument{
+		ID:            strings.TrimSpace(session.ID),
+		FileName:      strings.TrimSpace(session.FileName),
+		SizeBytes:     session.SizeBytes,
+		Checksum:      strings.TrimSpace(strings.ToLower(session.Checksum)),
+		UploadedBytes: 0,
+		ChunkSize:     session.ChunkSize,
+		CreatedBy:     strings.TrimSpace(session.CreatedBy),
+		CreatedAt:     session.CreatedAt.UTC(),
+		UpdatedAt:     session.CreatedAt.UTC(),
+		ExpiresAt:     session.ExpiresAt.UTC(),
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if slices.ContainsFunc(r.store.mediaUploadSessions, func(existing adminMediaUploadSessionDocument) bool {
+		return existing.ID == doc.ID
+	}) {
+		return nil, fmt.Errorf("media upload session %q already exists", doc.ID)
+	}
+	r.store.mediaUploadSessions = append(r.store.mediaUploadSessions, doc)
+
+	created := mapAdminMediaUploadSession(doc)
+	return &created, nil
+}
+
+func (r *adminMediaUploadSessionMemoryRepository) FindMediaUploadSession(
+	_ context.Context,
+	id string,
+) (*domain.AdminMediaUploadSession, error) {
+	resolvedID := strings.TrimSpace(id)
+	now := time.Now().UTC()
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, doc := range r.store.mediaUploadSessions {
+		if doc.ID == resolvedID && doc.ExpiresAt.After(now) {
+			session := mapAdminMediaUploadSession(doc)
+			return &session, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// AppendMediaUploadChunk stores data at offset and advances the session only while its uploaded byte count still
+// equals offset, returning a nil session when a concurrent writer moved it first.
+func (r *adminMediaUploadSessionMemoryRepository) AppendMediaUploadChunk(
+	_ context.Context,
+	session domain.AdminMediaUploadSession,
+	offset int,
+	data []byte,
+) (*domain.AdminMediaUploadSession, error) {
+	resolvedID := strings.TrimSpace(session.ID)
+	now := time.Now().UTC()
+	chunk := adminMediaUploadChunkDocument{
+		SessionID: resolvedID,
+		Offset:    offset,
+		Data:      append([]byte(nil), data...),
+		CreatedAt: now,
+		ExpiresAt: session.ExpiresAt.UTC(),
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	chunkIndex := slices.IndexFunc(r.store.mediaUploadChunks, func(existing adminMediaUploadChunkDocument) bool {
+		return existing.SessionID == resolvedID && existing.Offset == offset
+	})
+	if chunkIndex < 0 {
+		r.store.mediaUploadChunks = append(r.store.mediaUploadChunks, chunk)
+	} else {
+		r.store.mediaUploadChunks[chunkIndex] = chunk
+	}
+
+	sessionIndex := slices.IndexFunc(r.store.mediaUploadSessions, func(existing adminMediaUploadSessionDocument) bool {
+		return existing.ID == resolvedID && existing.UploadedBytes == offset
+	})
+	if sessionIndex < 0 {
+		return nil, nil
+	}
+
+	doc := &r.store.mediaUploadSessions[sessionIndex]
+	doc.UploadedBytes += len(data)
+	doc.UpdatedAt = now
+
+	updated := mapAdminMediaUploadSession(*doc)
+	return &updated, nil
+}
+
+func (r *adminMediaUploadSessionMemoryRepository) ListMediaUploadChunks(
+	_ context.Context,
+	id string,
+) ([]domain.AdminMediaUploadChunk, error) {
+	resolvedID := strings.TrimSpace(id)
+
+	r.store.mu.RLock()
+	docs := make([]adminMediaUploadChunkDocument, 0)
+	for _, doc := range r.store.mediaUploadChunks {
+		if doc.SessionID == resolvedID {
+			docs = append(docs, doc)
+		}
+	}
+	r.store.mu.RUnlock()
+
+	slices.SortFunc(docs, func(left, right adminMediaUploadChunkDocument) int {
+		return cmp.Compare(left.Offset, right.Offset)
+	})
+
+	chunks := make([]domain.AdminMediaUploadChunk, 0, len(docs))
+	for _, doc := range docs {
+		chunks = append(chunks, domain.AdminMediaUploadChunk{
+			Offset: doc.Offset,
+			Data:   append([]byte(nil), doc.Data...),
+		})
+	}
+
+	return chunks, nil
+}
+
+func (r *adminMediaUploadSessionMemoryRepository) DeleteMediaUploadSession(_ context.Context, id string) (bool, error) {
+	resolvedID := strings.TrimSpace(id)
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	count := len(r.store.mediaUploadSessions)
+	r.store.mediaUploadSessions = slices.DeleteFunc(r.store.mediaUploadSessions, func(doc adminMediaUploadSessionDocument) bool {
+		return doc.ID == resolvedID
+	})
+	r.store.mediaUploadChunks = slices.DeleteFunc(r.store.mediaUploadChunks, func(doc adminMediaUploadChunkDocument) bool {
+		return doc.SessionID == resolvedID
+	})
+
+	return len(r.store.mediaUploadSessions) < count, nil
+}
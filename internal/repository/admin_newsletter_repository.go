@@ -58,19 +58,21 @@ type AdminNewsletterRepository interface {
 	DeleteSubscriberByEmail(ctx context.Context, email string) (bool, error)
 }
 
-type adminNewsletterMongoRepository struct{}
+type adminNewsletterMongoRepository struct {
+	client *mongo.Client
+}
 
-func NewAdminNewsletterRepository() AdminNewsletterRepository {
-	return &adminNewsletterMongoRepository{}
+func NewAdminNewsletterRepository(client *mongo.Client) AdminNewsletterRepository {
+	return &adminNewsletterMongoRepository{client: client}
 }
 
-func (*adminNewsletterMongoRepository) ListSubscribers(
+func (repo *adminNewsletterMongoRepository) ListSubscribers(
 	ctx context.Context,
 	filter domain.AdminNewsletterSubscriberFilter,
 	page int,
 	size int,
 ) (*domain.AdminNewsletterSubscriberListResult, error) {
-	collection, err := getNewsletterCollection()
+	collection, err := getNewsletterCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminNewsletterRepositoryUnavailableFormat, ErrAdminNewsletterRepositoryUnavailable, err)
 	}
@@ -144,13 +146,13 @@ func (*adminNewsletterMongoRepository) ListSubscribers(
 	}, nil
 }
 
-func (*adminNewsletterMongoRepository) UpdateSubscriberStatusByEmail(
+func (repo *adminNewsletterMongoRepository) UpdateSubscriberStatusByEmail(
 	ctx context.Context,
 	email string,
 	status string,
 	now time.Time,
 ) (*domain.AdminNewsletterSubscriberRecord, error) {
-	collection, err := getNewsletterCollection()
+	collection, err := getNewsletterCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminNewsletterRepositoryUnavailableFormat, ErrAdminNewsletterRepositoryUnavailable, err)
 	}
@@ -223,13 +225,13 @@ func (*adminNewsletterMongoRepository) UpdateSubscriberStatusByEmail(
 	), nil
 }
 
-func (*adminNewsletterMongoRepository) ListCampaigns(
+func (repo *adminNewsletterMongoRepository) ListCampaigns(
 	ctx context.Context,
 	filter domain.AdminNewsletterCampaignFilter,
 	page int,
 	size int,
 ) (*domain.AdminNewsletterCampaignListResult, error) {
-	collection, err := getAdminNewsletterCampaignsCollection()
+	collection, err := getAdminNewsletterCampaignsCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminNewsletterRepositoryUnavailableFormat, ErrAdminNewsletterRepositoryUnavailable, err)
 	}
@@ -306,7 +308,7 @@ func (*adminNewsletterMongoRepository) ListCampaigns(
 		return nil, err
 	}
 
-	if err := enrichAdminNewsletterCampaignSummaries(ctx, items); err != nil {
+	if err := enrichAdminNewsletterCampaignSummaries(ctx, repo.client, items); err != nil {
 		return nil, err
 	}
 
@@ -318,13 +320,13 @@ func (*adminNewsletterMongoRepository) ListCampaigns(
 	}, nil
 }
 
-func (*adminNewsletterMongoRepository) ListDeliveryFailures(
+func (repo *adminNewsletterMongoRepository) ListDeliveryFailures(
 	ctx context.Context,
 	filter domain.AdminNewsletterDeliveryFailureFilter,
 	page int,
 	size int,
 ) (*domain.AdminNewsletterDeliveryFailureListResult, error) {
-	collection, err := getAdminNewsletterDeliveriesCollection()
+	collection, err := getAdminNewsletterDeliveriesCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminNewsletterRepositoryUnavailableFormat, ErrAdminNewsletterRepositoryUnavailable, err)
 	}
@@ -384,8 +386,8 @@ func (*adminNewsletterMongoRepository) ListDeliveryFailures(
 	}, nil
 }
 
-func (*adminNewsletterMongoRepository) DeleteSubscriberByEmail(ctx context.Context, email string) (bool, error) {
-	collection, err := getNewsletterCollection()
+func (repo *adminNewsletterMongoRepository) DeleteSubscriberByEmail(ctx context.Context, email string) (bool, error) {
+	collection, err := getNewsletterCollection(repo.client)
 	if err != nil {
 		return false, fmt.Errorf(adminNewsletterRepositoryUnavailableFormat, ErrAdminNewsletterRepositoryUnavailable, err)
 	}
@@ -475,12 +477,12 @@ func decodeAdminNewsletterCampaign(cursor *mongo.Cursor) (*domain.AdminNewslette
 	}, nil
 }
 
-func enrichAdminNewsletterCampaignSummaries(ctx context.Context, items []domain.AdminNewsletterCampaignRecord) error { // NOSONAR
+func enrichAdminNewsletterCampaignSummaries(ctx context.Context, client *mongo.Client, items []domain.AdminNewsletterCampaignRecord) error { // NOSONAR
 	if len(items) == 0 {
 		return nil
 	}
 
-	collection, err := getPostContentCollection()
+	collection, err := getPostContentCollection(client)
 	if err != nil {
 		return err
 	}
@@ -698,29 +700,27 @@ func normalizeAdminNewsletterRecord( // NOSONAR
 	}
 }
 
-func getAdminNewsletterCampaignsCollection() (*mongo.Collection, error) {
+func getAdminNewsletterCampaignsCollection(client *mongo.Client) (*mongo.Collection, error) {
 	databaseConfig, err := appconfig.ResolveDatabaseConfig()
 	if err != nil {
 		return nil, err
 	}
 
-	client, err := getAdminMongoClient()
-	if err != nil {
-		return nil, err
+	if client == nil {
+		return nil, ErrMongoClientUnavailable
 	}
 
 	return client.Database(databaseConfig.Name).Collection("newsletter_campaigns"), nil
 }
 
-func getAdminNewsletterDeliveriesCollection() (*mongo.Collection, error) {
+func getAdminNewsletterDeliveriesCollection(client *mongo.Client) (*mongo.Collection, error) {
 	databaseConfig, err := appconfig.ResolveDatabaseConfig()
 	if err != nil {
 		return nil, err
 	}
 
-	client, err := getAdminMongoClient()
-	if err != nil {
-		return nil, err
+	if client == nil {
+		return nil, ErrMongoClientUnavailable
 	}
 
 	return client.Database(databaseConfig.Name).Collection("newsletter_deliveries"), nil
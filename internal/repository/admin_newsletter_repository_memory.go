@@ -0,0 +1,230 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+)
+
+type adminNewsletterMemoryRepository struct {
+	store *MemoryStore
+}
+
+// NewAdminNewsletterMemoryRepository returns an admin newsletter repository backed by the given in-memory store.
+func NewAdminNewsletterMemoryRepository(store *MemoryStore) AdminNewsletterRepository {
+	return &adminNewsletterMemoryRepository{store: store}
+}
+
+func (r *adminNewsletterMemoryRepository) ListSubscribers(
+	_ context.Context,
+	filter domain.AdminNewsletterSubscriberFilter,
+	page int,
+	size int,
+) (*domain.AdminNewsletterSubscriberListResult, error) {
+	resolvedPage := max(1, page)
+	resolvedSize := max(1, size)
+	locale := strings.TrimSpace(strings.ToLower(filter.Locale))
+	status := strings.TrimSpace(strings.ToLower(filter.Status))
+	searchQuery := strings.TrimSpace(filter.Query)
+
+	r.store.mu.RLock()
+	matched := make([]memoryNewsletterSubscriber, 0)
+	for _, subscriber := range r.store.newsletterSubscribers {
+		if (locale != "" && subscriber.Locale != locale) || (status != "" && subscriber.Status != status) ||
+			(searchQuery != "" && !memoryContainsFold(subscriber.Email, searchQuery)) {
+			continue
+		}
+		subscriber.Tags = slices.Clone(subscriber.Tags)
+		matched = append(matched, subscriber)
+	}
+	r.store.mu.RUnlock()
+
+	slices.SortStableFunc(matched, func(left, right memoryNewsletterSubscriber) int {
+		if order := right.UpdatedAt.Compare(left.UpdatedAt); order != 0 {
+			return order
+		}
+		return strings.Compare(left.Email, right.Email)
+	})
+
+	items := make([]domain.AdminNewsletterSubscriberRecord, 0, resolvedSize)
+	for _, subscriber := range memoryPage(matched, resolvedPage, resolvedSize) {
+		if record := mapMemoryNewsletterSubscriber(subscriber); record != nil {
+			items = append(items, *record)
+		}
+	}
+
+	return &domain.AdminNewsletterSubscriberListResult{
+		Items: items,
+		Total: len(matched),
+		Page:  resolvedPage,
+		Size:  resolvedSize,
+	}, nil
+}
+
+func (r *adminNewsletterMemoryRepository) UpdateSubscriberStatusByEmail(
+	_ context.Context,
+	email string,
+	status string,
+	now time.Time,
+) (*domain.AdminNewsletterSubscriberRecord, error) {
+	resolvedEmail := strings.TrimSpace(strings.ToLower(email))
+	resolvedStatus := strings.TrimSpace(strings.ToLower(status))
+	resolvedNow := now.UTC()
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	subscriber := r.store.findNewsletterSubscriber(resolvedEmail)
+	if subscriber == nil {
+		return nil, ErrAdminNewsletterSubscriberNotFound
+	}
+
+	subscriber.Status = resolvedStatus
+	subscriber.UpdatedAt = resolvedNow
+	switch resolvedStatus {
+	case "active":
+		subscriber.ConfirmedAt = &resolvedNow
+		subscriber.UnsubscribedAt = nil
+	case "unsubscribed":
+		subscriber.UnsubscribedAt = &resolvedNow
+	default:
+		subscriber.ConfirmedAt = nil
+		subscriber.UnsubscribedAt = nil
+	}
+
+	return mapMemoryNewsletterSubscriber(*subscriber), nil
+}
+
+func (r *adminNewsletterMemoryRepository) ListCampaigns(
+	_ context.Context,
+	filter domain.AdminNewsletterCampaignFilter,
+	page int,
+	size int,
+) (*domain.AdminNewsletterCampaignListResult, error) {
+	resolvedPage := max(1, page)
+	resolvedSize := max(1, size)
+	locale := strings.TrimSpace(strings.ToLower(filter.Locale))
+	status := strings.TrimSpace(strings.ToLower(filter.Status))
+	searchQuery := strings.TrimSpace(filter.Query)
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	matched := make([]domain.AdminNewsletterCampaignRecord, 0)
+	for _, campaign := range r.store.newsletterCampaigns {
+		if (locale != "" && campaign.Locale != locale) || (status != "" && campaign.Status != status) {
+			continue
+		}
+		if searchQuery != "" && !memoryContainsFold(campaign.Title, searchQuery) &&
+			!memoryContainsFold(campaign.ItemKey, searchQuery) {
+			continue
+		}
+		matched = append(matched, campaign)
+	}
+
+	slices.SortStableFunc(matched, func(left, right domain.AdminNewsletterCampaignRecord) int {
+		if order := right.LastRunAt.Compare(left.LastRunAt); order != 0 {
+			return order
+		}
+		if order := right.CreatedAt.Compare(left.CreatedAt); order != 0 {
+			return order
+		}
+		return strings.Compare(left.Locale, right.Locale)
+	})
+
+	items := memoryPage(matched, resolvedPage, resolvedSize)
+	for index := range items {
+		if strings.TrimSpace(items[index].Summary) != "" {
+			continue
+		}
+
+		postID := extractAdminNewsletterPostIDFromLink(items[index].Link)
+		if postID == "" {
+			continue
+		}
+		if post := r.store.findPost(items[index].Locale, postID); post != nil {
+			items[index].Summary = strings.TrimSpace(post.Summary)
+		}
+	}
+
+	return &domain.AdminNewsletterCampaignListResult{
+		Items: items,
+		Total: len(matched),
+		Page:  resolvedPage,
+		Size:  resolvedSize,
+	}, nil
+}
+
+func (r *adminNewsletterMemoryRepository) ListDeliveryFailures(
+	_ context.Context,
+	filter domain.AdminNewsletterDeliveryFailureFilter,
+	page int,
+	size int,
+) (*domain.AdminNewsletterDeliveryFailureListResult, error) {
+	resolvedPage := max(1, page)
+	resolvedSize := max(1, size)
+	locale := strings.TrimSpace(strings.ToLower(filter.Locale))
+	itemKey := strings.TrimSpace(filter.ItemKey)
+
+	r.store.mu.RLock()
+	matched := make([]domain.AdminNewsletterDeliveryFailureRecord, 0)
+	for _, delivery := range r.store.newsletterDeliveries {
+		if delivery.Status == "failed" && delivery.Locale == locale && delivery.ItemKey == itemKey {
+			matched = append(matched, delivery)
+		}
+	}
+	r.store.mu.RUnlock()
+
+	slices.SortStableFunc(matched, func(left, right domain.AdminNewsletterDeliveryFailureRecord) int {
+		if order := right.LastAttemptAt.Compare(left.LastAttemptAt); order != 0 {
+			return order
+		}
+		if order := right.UpdatedAt.Compare(left.UpdatedAt); order != 0 {
+			return order
+		}
+		return strings.Compare(left.Email, right.Email)
+	})
+
+	return &domain.AdminNewsletterDeliveryFailureListResult{
+		Items: memoryPage(matched, resolvedPage, resolvedSize),
+		Total: len(matched),
+		Page:  resolvedPage,
+		Size:  resolvedSize,
+	}, nil
+}
+
+func (r *adminNewsletterMemoryRepository) DeleteSubscriberByEmail(_ context.Context, email string) (bool, error) {
+	resolvedEmail := strings.TrimSpace(strings.ToLower(email))
+	if resolvedEmail == "" {
+		return false, errors.New("invalid subscriber email")
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	before := len(r.store.newsletterSubscribers)
+	r.store.newsletterSubscribers = slices.DeleteFunc(
+		r.store.newsletterSubscribers,
+		func(subscriber memoryNewsletterSubscriber) bool { return subscriber.Email == resolvedEmail },
+	)
+	return len(r.store.newsletterSubscribers) < before, nil
+}
+
+func mapMemoryNewsletterSubscriber(subscriber memoryNewsletterSubscriber) *domain.AdminNewsletterSubscriberRecord {
+	return normalizeAdminNewsletterRecord(
+		subscriber.Email,
+		subscriber.Locale,
+		subscriber.Status,
+		subscriber.Tags,
+		subscriber.FormName,
+		subscriber.Source,
+		subscriber.UpdatedAt,
+		subscriber.CreatedAt,
+		subscriber.ConfirmedAt,
+		subscriber.UnsubscribedAt,
+	)
+}
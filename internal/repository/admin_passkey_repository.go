@@ -0,0 +1,267 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type AdminPasskeyRepository interface {
+	Create(ctx context.Context, record domain.AdminPasskeyRecord) error
+	FindByCredentialID(ctx context.Context, credentialID string) (*domain.AdminPasskeyRecord, error)
+	ListByUserID(ctx context.Context, userID string) ([]domain.AdminPasskeyRecord, error)
+	RecordAssertion(ctx context.Context, id string, previous, next uint32, challengeHash string, usedAt time.Time) (bool, error)
+	DeleteByIDAndUserID(ctx context.Context, id, userID string) (bool, error)
+}
+
+var (
+	ErrAdminPasskeyRepositoryUnavailable = errors.New("admin passkey repository unavailable")
+	ErrAdminPasskeyExists                = errors.New("admin passkey already registered")
+)
+
+const (
+	adminPasskeysCollectionName             = "admin_passkeys"
+	adminPasskeyRepositoryUnavailableFormat = "%w: %v"
+	maxAdminPasskeysPerUser                 = 50
+)
+
+type adminPasskeyMongoRepository struct {
+	client *mongo.Client
+}
+
+type adminPasskeyDocument struct {
+	ID           string     `bson:"id"`
+	UserID       string     `bson:"userId"`
+	CredentialID string     `bson:"credentialId"`
+	PublicKey    []byte     `bson:"publicKey"`
+	Algorithm    int64      `bson:"algorithm"`
+	SignCount    int64      `bson:"signCount"`
+	Transports   []string   `bson:"transports"`
+	Name         string     `bson:"name"`
+	CreatedAt    time.Time  `bson:"createdAt"`
+	LastUsedAt   *time.Time `bson:"lastUsedAt,omitempty"`
+	// LastChallenge is the hash of the challenge of the last accepted assertion.
+	LastChallenge string `bson:"lastChallenge,omitempty"`
+}
+
+var (
+	adminPasskeyIndexesOnce sync.Once
+	adminPasskeyIndexesErr  error
+)
+
+func NewAdminPasskeyRepository(client *mongo.Client) AdminPasskeyRepository {
+	return &adminPasskeyMongoRepository{client: client}
+}
+
+func (repo *adminPasskeyMongoRepository) Create(ctx context.Context, record domain.AdminPasskeyRecord) error {
+	collection, err := getAdminPasskeysCollection(repo.client)
+	if err != nil {
+		return fmt.Errorf(adminPasskeyRepositoryUnavailableFormat, ErrAdminPasskeyRepositoryUnavailable, err)
+	}
+
+	_, err = collection.InsertOne(ctx, adminPasskeyDocument{
+		ID:           strings.TrimSpace(record.ID),
+		UserID:       strings.TrimSpace(record.UserID),
+		CredentialID: strings.TrimSpace(record.CredentialID),
+		PublicKey:    append([]byte{}, record.PublicKey...),
+		Algorithm:    record.Algorithm,
+		SignCount:    int64(record.SignCount),
+		Transports:   append([]string{}, record.Transports...),
+		Name:         strings.TrimSpace(record.Name),
+		CreatedAt:    record.CreatedAt.UTC(),
+		LastUsedAt:   record.LastUsedAt,
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return ErrAdminPasskeyExists
+	}
+	return err
+}
+
+func (repo *adminPasskeyMongoRepository) FindByCredentialID(
+	ctx context.Context,
+	credentialID string,
+) (*domain.AdminPasskeyRecord, error) {
+	collection, err := getAdminPasskeysCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminPasskeyRepositoryUnavailableFormat, ErrAdminPasskeyRepositoryUnavailable, err)
+	}
+
+	var document adminPasskeyDocument
+	err = collection.FindOne(ctx, bson.M{"credentialId": strings.TrimSpace(credentialID)}).Decode(&document)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	record := mapAdminPasskeyDocument(document)
+	return &record, nil
+}
+
+func (repo *adminPasskeyMongoRepository) ListByUserID(ctx context.Context, userID string) ([]domain.AdminPasskeyRecord, error) {
+	collection, err := getAdminPasskeysCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminPasskeyRepositoryUnavailableFormat, ErrAdminPasskeyRepositoryUnavailable, err)
+	}
+
+	cursor, err := collection.Find(
+		ctx,
+		bson.M{"userId": strings.TrimSpace(userID)},
+		options.Find().
+			SetSort(bson.D{{Key: "createdAt", Value: -1}}).
+			SetLimit(maxAdminPasskeysPerUser),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	records := make([]domain.AdminPasskeyRecord, 0)
+	for cursor.Next(ctx) {
+		var document adminPasskeyDocument
+		if err := cursor.Decode(&document); err != nil {
+			return nil, err
+		}
+		records = append(records, mapAdminPasskeyDocument(document))
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// RecordAssertion stores the counter and challenge of a verified assertion. It reports false when another login
+// already moved the counter past previous or used the same challenge, so an assertion cannot be accepted twice even by
+// authenticators that always report a zero counter.
+func (repo *adminPasskeyMongoRepository) RecordAssertion(
+	ctx context.Context,
+	id string,
+	previous uint32,
+	next uint32,
+	challengeHash string,
+	usedAt time.Time,
+) (bool, error) {
+	collection, err := getAdminPasskeysCollection(repo.client)
+	if err != nil {
+		return false, fmt.Errorf(adminPasskeyRepositoryUnavailableFormat, ErrAdminPasskeyRepositoryUnavailable, err)
+	}
+
+	result, err := collection.UpdateOne(
+		ctx,
+		bson.M{
+			"id":            strings.TrimSpace(id),
+			"signCount":     int64(previous),
+			"lastChallenge": bson.M{"$ne": strings.TrimSpace(challengeHash)},
+		},
+		bson.M{
+			"$set": bson.M{
+				"signCount":     int64(next),
+				"lastChallenge": strings.TrimSpace(challengeHash),
+				"lastUsedAt":    usedAt.UTC(),
+			},
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return result.MatchedCount > 0, nil
+}
+
+func (repo *adminPasskeyMongoRepository) DeleteByIDAndUserID(ctx context.Context, id, userID string) (bool, error) {
+	collection, err := getAdminPasskeysCollection(repo.client)
+	if err != nil {
+		return false, fmt.Errorf(adminPasskeyRepositoryUnavailableFormat, ErrAdminPasskeyRepositoryUnavailable, err)
+	}
+
+	result, err := collection.DeleteOne(ctx, bson.M{
+		"id":     strings.TrimSpace(id),
+		"userId": strings.TrimSpace(userID),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return result.DeletedCount > 0, nil
+}
+
+func mapAdminPasskeyDocument(document adminPasskeyDocument) domain.AdminPasskeyRecord {
+	signCount := uint32(0)
+	if document.SignCount > 0 && document.SignCount <= 1<<32-1 {
+		signCount = uint32(document.SignCount)
+	}
+
+	return domain.AdminPasskeyRecord{
+		ID:           strings.TrimSpace(document.ID),
+		UserID:       strings.TrimSpace(document.UserID),
+		CredentialID: strings.TrimSpace(document.CredentialID),
+		PublicKey:    document.PublicKey,
+		Algorithm:    document.Algorithm,
+		SignCount:    signCount,
+		Transports:   document.Transports,
+		Name:         strings.TrimSpace(document.Name),
+		CreatedAt:    document.CreatedAt,
+		LastUsedAt:   document.LastUsedAt,
+	}
+}
+
+func getAdminPasskeysCollection(client *mongo.Client) (*mongo.Collection, error) {
+	databaseConfig, err := appconfig.ResolveDatabaseConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	if client == nil {
+		return nil, ErrMongoClientUnavailable
+	}
+
+	collection := client.Database(databaseConfig.Name).Collection(adminPasskeysCollectionName)
+	if err := ensureAdminPasskeyIndexes(collection); err != nil {
+		return nil, err
+	}
+
+	return collection, nil
+}
+
+func ensureAdminPasskeyIndexes(collection *mongo.Collection) error {
+	adminPasskeyIndexesOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		indexes := []mongo.IndexModel{
+			{
+				Keys:    bson.D{{Key: "id", Value: 1}},
+				Options: options.Index().SetUnique(true).SetName("uniq_admin_passkey_id"),
+			},
+			{
+				Keys:    bson.D{{Key: "credentialId", Value: 1}},
+				Options: options.Index().SetUnique(true).SetName("uniq_admin_passkey_credential"),
+			},
+			{
+				Keys:    bson.D{{Key: "userId", Value: 1}, {Key: "createdAt", Value: -1}},
+				Options: options.Index().SetName("idx_admin_passkey_user_created"),
+			},
+		}
+
+		if _, err := collection.Indexes().CreateMany(ctx, indexes); err != nil {
+			adminPasskeyIndexesErr = fmt.Errorf("create admin passkey index failed: %w", err)
+		}
+	})
+
+	return adminPasskeyIndexesErr
+}
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+)
+
+type adminPasskeyMemoryRepository struct {
+	store *MemoryStore
+}
+
+// NewAdminPasskeyMemoryRepository returns a passkey repository backed by the given in-memory store.
+func NewAdminPasskeyMemoryRepository(store *MemoryStore) AdminPasskeyRepository {
+	return &adminPasskeyMemoryRepository{store: store}
+}
+
+func (r *adminPasskeyMemoryRepository) Create(_ context.Context, record domain.AdminPasskeyRecord) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	document := adminPasskeyDocument{
+		ID:           strings.TrimSpace(record.ID),
+		UserID:       strings.TrimSpace(record.UserID),
+		CredentialID: strings.TrimSpace(record.CredentialID),
+		PublicKey:    append([]byte{}, record.PublicKey...),
+		Algorithm:    record.Algorithm,
+		SignCount:    int64(record.SignCount),
+		Transports:   append([]string{}, record.Transports...),
+		Name:         strings.TrimSpace(record.Name),
+		CreatedAt:    record.CreatedAt.UTC(),
+		LastUsedAt:   cloneMemoryValue(record.LastUsedAt),
+	}
+	for _, existing := range r.store.adminPasskeys {
+		if existing.ID == document.ID || existing.CredentialID == document.CredentialID {
+			return ErrAdminPasskeyExists
+		}
+	}
+
+	r.store.adminPasskeys = append(r.store.adminPasskeys, document)
+	return nil
+}
+
+func (r *adminPasskeyMemoryRepository) FindByCredentialID(
+	_ context.Context,
+	credentialID string,
+) (*domain.AdminPasskeyRecord, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	resolvedCredentialID := strings.TrimSpace(credentialID)
+	for _, document := range r.store.adminPasskeys {
+		if document.CredentialID == resolvedCredentialID {
+			record := mapMemoryAdminPasskeyDocument(document)
+			return &record, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *adminPasskeyMemoryRepository) ListByUserID(_ context.Context, userID string) ([]domain.AdminPasskeyRecord, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	resolvedUserID := strings.TrimSpace(userID)
+	records := make([]domain.AdminPasskeyRecord, 0)
+	for _, document := range r.store.adminPasskeys {
+		if document.UserID == resolvedUserID {
+			records = append(records, mapMemoryAdminPasskeyDocument(document))
+		}
+	}
+
+	slices.SortStableFunc(records, func(left, right domain.AdminPasskeyRecord) int {
+		return right.CreatedAt.Compare(left.CreatedAt)
+	})
+	if len(records) > maxAdminPasskeysPerUser {
+		records = records[:maxAdminPasskeysPerUser]
+	}
+
+	return records, nil
+}
+
+func (r *adminPasskeyMemoryRepository) RecordAssertion(
+	_ context.Context,
+	id string,
+	previous uint32,
+	next uint32,
+	challengeHash string,
+	usedAt time.Time,
+) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	resolvedID := strings.TrimSpace(id)
+	resolvedChallengeHash := strings.TrimSpace(challengeHash)
+	for index := range r.store.adminPasskeys {
+		document := &r.store.adminPasskeys[index]
+		if document.ID != resolvedID || document.SignCount != int64(previous) ||
+			document.LastChallenge == resolvedChallengeHash {
+			continue
+		}
+
+		lastUsedAt := usedAt.UTC()
+		document.SignCount = int64(next)
+		document.LastChallenge = resolvedChallengeHash
+		document.LastUsedAt = &lastUsedAt
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (r *adminPasskeyMemoryRepository) DeleteByIDAndUserID(_ context.Context, id, userID string) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	resolvedID := strings.TrimSpace(id)
+	resolvedUserID := strings.TrimSpace(userID)
+	for index, document := range r.store.adminPasskeys {
+		if document.ID == resolvedID && document.UserID == resolvedUserID {
+			r.store.adminPasskeys = slices.Delete(r.store.adminPasskeys, index, index+1)
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func mapMemoryAdminPasskeyDocument(document adminPasskeyDocument) domain.AdminPasskeyRecord {
+	record := mapAdminPasskeyDocument(document)
+	record.PublicKey = slices.Clone(record.PublicKey)
+	record.Transports = slices.Clone(record.Transports)
+	record.LastUsedAt = cloneMemoryValue(record.LastUsedAt)
+	return record
+}
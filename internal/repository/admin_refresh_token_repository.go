@@ -21,10 +21,12 @@ import (
 type AdminRefreshTokenRepository interface {
 	Create(ctx context.Context, record domain.AdminRefreshTokenRecord) error
 	FindActiveByToken(ctx context.Context, jti, rawToken string, now time.Time) (*domain.AdminRefreshTokenRecord, error)
+	FindByToken(ctx context.Context, jti, rawToken string) (*domain.AdminRefreshTokenRecord, error)
 	ListActiveByUserID(ctx context.Context, userID string, now time.Time, limit int) ([]domain.AdminSessionRecord, error)
 	Rotate(ctx context.Context, currentJTI string, replacement domain.AdminRefreshTokenRecord, now time.Time) error
 	RevokeByJTIAndUserID(ctx context.Context, jti, userID string, now time.Time) (bool, error)
 	RevokeByJTI(ctx context.Context, jti string, now time.Time) error
+	RevokeFamily(ctx context.Context, jti string, now time.Time) (int, error)
 	RevokeAllByUserID(ctx context.Context, userID string, now time.Time) error
 }
 
@@ -38,23 +40,21 @@ const (
 	adminRefreshTokenRepositoryUnavailableFormat = "%w: %v"
 )
 
-type adminRefreshTokenMongoRepository struct{}
+type adminRefreshTokenMongoRepository struct {
+	client *mongo.Client
+}
 
 var (
-	adminRefreshTokenMongoClient     *mongo.Client
-	adminRefreshTokenMongoInitErr    error
-	adminRefreshTokenMongoClientOnce sync.Once
-
 	adminRefreshTokenIndexesOnce sync.Once
 	adminRefreshTokenIndexesErr  error
 )
 
-func NewAdminRefreshTokenMongoRepository() AdminRefreshTokenRepository {
-	return &adminRefreshTokenMongoRepository{}
+func NewAdminRefreshTokenMongoRepository(client *mongo.Client) AdminRefreshTokenRepository {
+	return &adminRefreshTokenMongoRepository{client: client}
 }
 
-func (*adminRefreshTokenMongoRepository) Create(ctx context.Context, record domain.AdminRefreshTokenRecord) error {
-	collection, err := getAdminRefreshTokensCollection()
+func (repo *adminRefreshTokenMongoRepository) Create(ctx context.Context, record domain.AdminRefreshTokenRecord) error {
+	collection, err := getAdminRefreshTokensCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(adminRefreshTokenRepositoryUnavailableFormat, ErrAdminRefreshTokenRepositoryUnavailable, err)
 	}
@@ -83,32 +83,18 @@ func (*adminRefreshTokenMongoRepository) Create(ctx context.Context, record doma
 	return err
 }
 
-func (*adminRefreshTokenMongoRepository) FindActiveByToken(
+func (repo *adminRefreshTokenMongoRepository) FindActiveByToken(
 	ctx context.Context,
 	jti string,
 	rawToken string,
 	now time.Time,
 ) (*domain.AdminRefreshTokenRecord, error) {
-	collection, err := getAdminRefreshTokensCollection()
+	collection, err := getAdminRefreshTokensCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminRefreshTokenRepositoryUnavailableFormat, ErrAdminRefreshTokenRepositoryUnavailable, err)
 	}
 
-	var document struct {
-		JTI         string     `bson:"jti"`
-		UserID      string     `bson:"userId"`
-		TokenHash   string     `bson:"tokenHash"`
-		Persistent  bool       `bson:"persistent"`
-		UserAgent   string     `bson:"userAgent"`
-		RemoteIP    string     `bson:"remoteIP"`
-		CountryCode string     `bson:"countryCode"`
-		LastSeenAt  time.Time  `bson:"lastSeenAt"`
-		ExpiresAt   time.Time  `bson:"expiresAt"`
-		CreatedAt   time.Time  `bson:"createdAt"`
-		RotatedAt   *time.Time `bson:"rotatedAt"`
-		RevokedAt   *time.Time `bson:"revokedAt"`
-		ReplacedBy  string     `bson:"replacedBy"`
-	}
+	var document adminRefreshTokenDocument
 
 	filter := bson.M{
 		"$and": bson.A{
@@ -130,30 +116,46 @@ func (*adminRefreshTokenMongoRepository) FindActiveByToken(
 		return nil, nil
 	}
 
-	return &domain.AdminRefreshTokenRecord{
-		JTI:         document.JTI,
-		UserID:      document.UserID,
-		TokenHash:   document.TokenHash,
-		Persistent:  document.Persistent,
-		UserAgent:   document.UserAgent,
-		RemoteIP:    document.RemoteIP,
-		CountryCode: strings.TrimSpace(strings.ToUpper(document.CountryCode)),
-		LastSeenAt:  document.LastSeenAt,
-		ExpiresAt:   document.ExpiresAt,
-		CreatedAt:   document.CreatedAt,
-		RotatedAt:   document.RotatedAt,
-		RevokedAt:   document.RevokedAt,
-		ReplacedBy:  document.ReplacedBy,
-	}, nil
+	record := mapAdminRefreshTokenDocument(document)
+	return &record, nil
 }
 
-func (*adminRefreshTokenMongoRepository) ListActiveByUserID(
+// FindByToken loads a refresh token whatever its state, so a replayed token that was already rotated can be told apart
+// from one that never existed.
+func (repo *adminRefreshTokenMongoRepository) FindByToken(
+	ctx context.Context,
+	jti string,
+	rawToken string,
+) (*domain.AdminRefreshTokenRecord, error) {
+	collection, err := getAdminRefreshTokensCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminRefreshTokenRepositoryUnavailableFormat, ErrAdminRefreshTokenRepositoryUnavailable, err)
+	}
+
+	var document adminRefreshTokenDocument
+	err = collection.FindOne(ctx, bson.M{"jti": strings.TrimSpace(jti)}).Decode(&document)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(document.TokenHash, HashAdminRefreshToken(rawToken)) {
+		return nil, nil
+	}
+
+	record := mapAdminRefreshTokenDocument(document)
+	return &record, nil
+}
+
+func (repo *adminRefreshTokenMongoRepository) ListActiveByUserID(
 	ctx context.Context,
 	userID string,
 	now time.Time,
 	limit int,
 ) ([]domain.AdminSessionRecord, error) {
-	collection, err := getAdminRefreshTokensCollection()
+	collection, err := getAdminRefreshTokensCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminRefreshTokenRepositoryUnavailableFormat, ErrAdminRefreshTokenRepositoryUnavailable, err)
 	}
@@ -231,13 +233,13 @@ func (*adminRefreshTokenMongoRepository) ListActiveByUserID(
 	return sessions, nil
 }
 
-func (*adminRefreshTokenMongoRepository) Rotate(
+func (repo *adminRefreshTokenMongoRepository) Rotate(
 	ctx context.Context,
 	currentJTI string,
 	replacement domain.AdminRefreshTokenRecord,
 	now time.Time,
 ) error {
-	collection, err := getAdminRefreshTokensCollection()
+	collection, err := getAdminRefreshTokensCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(adminRefreshTokenRepositoryUnavailableFormat, ErrAdminRefreshTokenRepositoryUnavailable, err)
 	}
@@ -295,13 +297,13 @@ func (*adminRefreshTokenMongoRepository) Rotate(
 	return nil
 }
 
-func (*adminRefreshTokenMongoRepository) RevokeByJTIAndUserID(
+func (repo *adminRefreshTokenMongoRepository) RevokeByJTIAndUserID(
 	ctx context.Context,
 	jti string,
 	userID string,
 	now time.Time,
 ) (bool, error) {
-	collection, err := getAdminRefreshTokensCollection()
+	collection, err := getAdminRefreshTokensCollection(repo.client)
 	if err != nil {
 		return false, fmt.Errorf(adminRefreshTokenRepositoryUnavailableFormat, ErrAdminRefreshTokenRepositoryUnavailable, err)
 	}
@@ -329,8 +331,8 @@ func (*adminRefreshTokenMongoRepository) RevokeByJTIAndUserID(
 	return result.MatchedCount > 0, nil
 }
 
-func (*adminRefreshTokenMongoRepository) RevokeByJTI(ctx context.Context, jti string, now time.Time) error {
-	collection, err := getAdminRefreshTokensCollection()
+func (repo *adminRefreshTokenMongoRepository) RevokeByJTI(ctx context.Context, jti string, now time.Time) error {
+	collection, err := getAdminRefreshTokensCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(adminRefreshTokenRepositoryUnavailableFormat, ErrAdminRefreshTokenRepositoryUnavailable, err)
 	}
@@ -352,8 +354,63 @@ func (*adminRefreshTokenMongoRepository) RevokeByJTI(ctx context.Context, jti st
 	return err
 }
 
-func (*adminRefreshTokenMongoRepository) RevokeAllByUserID(ctx context.Context, userID string, now time.Time) error {
-	collection, err := getAdminRefreshTokensCollection()
+// RevokeFamily revokes jti and every token that was issued by rotating it, following the replacedBy links. It returns
+// how many of those tokens were still unrevoked.
+func (repo *adminRefreshTokenMongoRepository) RevokeFamily(ctx context.Context, jti string, now time.Time) (int, error) {
+	collection, err := getAdminRefreshTokensCollection(repo.client)
+	if err != nil {
+		return 0, fmt.Errorf(adminRefreshTokenRepositoryUnavailableFormat, ErrAdminRefreshTokenRepositoryUnavailable, err)
+	}
+
+	cursor, err := collection.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"jti": strings.TrimSpace(jti)}}},
+		{{Key: "$graphLookup", Value: bson.M{
+			"from":             adminRefreshTokensCollectionName,
+			"startWith":        "$replacedBy",
+			"connectFromField": "replacedBy",
+			"connectToField":   "jti",
+			"as":               "descendants",
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"jtis": bson.M{"$concatArrays": bson.A{bson.A{"$jti"}, "$descendants.jti"}},
+		}}},
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	var family struct {
+		JTIs []string `bson:"jtis"`
+	}
+	if !cursor.Next(ctx) {
+		return 0, cursor.Err()
+	}
+	if err := cursor.Decode(&family); err != nil {
+		return 0, err
+	}
+
+	result, err := collection.UpdateMany(
+		ctx,
+		bson.M{
+			"$and": bson.A{
+				bson.M{"jti": bson.M{"$in": family.JTIs}},
+				unsetOrNullFilter("revokedAt"),
+			},
+		},
+		bson.M{"$set": bson.M{"revokedAt": now}},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(result.ModifiedCount), nil
+}
+
+func (repo *adminRefreshTokenMongoRepository) RevokeAllByUserID(ctx context.Context, userID string, now time.Time) error {
+	collection, err := getAdminRefreshTokensCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(adminRefreshTokenRepositoryUnavailableFormat, ErrAdminRefreshTokenRepositoryUnavailable, err)
 	}
@@ -375,6 +432,40 @@ func (*adminRefreshTokenMongoRepository) RevokeAllByUserID(ctx context.Context,
 	return err
 }
 
+type adminRefreshTokenDocument struct {
+	JTI         string     `bson:"jti"`
+	UserID      string     `bson:"userId"`
+	TokenHash   string     `bson:"tokenHash"`
+	Persistent  bool       `bson:"persistent"`
+	UserAgent   string     `bson:"userAgent"`
+	RemoteIP    string     `bson:"remoteIP"`
+	CountryCode string     `bson:"countryCode"`
+	LastSeenAt  time.Time  `bson:"lastSeenAt"`
+	ExpiresAt   time.Time  `bson:"expiresAt"`
+	CreatedAt   time.Time  `bson:"createdAt"`
+	RotatedAt   *time.Time `bson:"rotatedAt"`
+	RevokedAt   *time.Time `bson:"revokedAt"`
+	ReplacedBy  string     `bson:"replacedBy"`
+}
+
+func mapAdminRefreshTokenDocument(document adminRefreshTokenDocument) domain.AdminRefreshTokenRecord {
+	return domain.AdminRefreshTokenRecord{
+		JTI:         document.JTI,
+		UserID:      document.UserID,
+		TokenHash:   document.TokenHash,
+		Persistent:  document.Persistent,
+		UserAgent:   document.UserAgent,
+		RemoteIP:    document.RemoteIP,
+		CountryCode: strings.TrimSpace(strings.ToUpper(document.CountryCode)),
+		LastSeenAt:  document.LastSeenAt,
+		ExpiresAt:   document.ExpiresAt,
+		CreatedAt:   document.CreatedAt,
+		RotatedAt:   document.RotatedAt,
+		RevokedAt:   document.RevokedAt,
+		ReplacedBy:  document.ReplacedBy,
+	}
+}
+
 func unsetOrNullFilter(field string) bson.M {
 	return bson.M{
 		"$or": bson.A{
@@ -384,42 +475,14 @@ func unsetOrNullFilter(field string) bson.M {
 	}
 }
 
-func getAdminRefreshTokenMongoClient() (*mongo.Client, error) {
-	adminRefreshTokenMongoClientOnce.Do(func() {
-		databaseConfig, err := appconfig.ResolveDatabaseConfig()
-		if err != nil {
-			adminRefreshTokenMongoInitErr = err
-			return
-		}
-
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
-
-		client, err := appconfig.NewMongoClient(ctx, databaseConfig, "blog-api-admin-refresh-tokens")
-		if err != nil {
-			adminRefreshTokenMongoInitErr = fmt.Errorf("mongodb connect failed: %w", err)
-			return
-		}
-
-		adminRefreshTokenMongoClient = client
-	})
-
-	if adminRefreshTokenMongoInitErr != nil {
-		return nil, adminRefreshTokenMongoInitErr
-	}
-
-	return adminRefreshTokenMongoClient, nil
-}
-
-func getAdminRefreshTokensCollection() (*mongo.Collection, error) {
+func getAdminRefreshTokensCollection(client *mongo.Client) (*mongo.Collection, error) {
 	databaseConfig, err := appconfig.ResolveDatabaseConfig()
 	if err != nil {
 		return nil, err
 	}
 
-	client, err := getAdminRefreshTokenMongoClient()
-	if err != nil {
-		return nil, err
+	if client == nil {
+		return nil, ErrMongoClientUnavailable
 	}
 
 	collection := client.Database(databaseConfig.Name).Collection(adminRefreshTokensCollectionName)
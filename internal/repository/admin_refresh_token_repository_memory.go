@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+)
+
+type adminRefreshTokenMemoryRepository struct {
+	store *MemoryStore
+}
+
+// NewAdminRefreshTokenMemoryRepository returns an admin refresh token repository backed by the given in-memory store.
+func NewAdminRefreshTokenMemoryRepository(store *MemoryStore) AdminRefreshTokenRepository {
+	return &adminRefreshTokenMemoryRepository{store: store}
+}
+
+func (r *adminRefreshTokenMemoryRepository) Create(_ context.Context, record domain.AdminRefreshTokenRecord) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.adminRefreshTokens.insert(memoryRefreshToken(record))
+	return nil
+}
+
+func (r *adminRefreshTokenMemoryRepository) FindActiveByToken(
+	_ context.Context,
+	jti string,
+	rawToken string,
+	now time.Time,
+) (*domain.AdminRefreshTokenRecord, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	token, ok := r.store.adminRefreshTokens.find(jti, HashAdminRefreshToken(rawToken))
+	if !ok || !token.active(now) {
+		return nil, nil
+	}
+
+	record := domain.AdminRefreshTokenRecord(token)
+	return &record, nil
+}
+
+func (r *adminRefreshTokenMemoryRepository) FindByToken(
+	_ context.Context,
+	jti string,
+	rawToken string,
+) (*domain.AdminRefreshTokenRecord, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	token, ok := r.store.adminRefreshTokens.find(jti, HashAdminRefreshToken(rawToken))
+	if !ok {
+		return nil, nil
+	}
+
+	record := domain.AdminRefreshTokenRecord(token)
+	return &record, nil
+}
+
+func (r *adminRefreshTokenMemoryRepository) ListActiveByUserID(
+	_ context.Context,
+	userID string,
+	now time.Time,
+	limit int,
+) ([]domain.AdminSessionRecord, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	tokens := r.store.adminRefreshTokens.listActive(userID, now, resolveMemorySessionLimit(limit))
+	sessions := make([]domain.AdminSessionRecord, 0, len(tokens))
+	for _, token := range tokens {
+		sessions = append(sessions, domain.AdminSessionRecord{
+			ID:          token.JTI,
+			UserAgent:   token.UserAgent,
+			RemoteIP:    token.RemoteIP,
+			CountryCode: token.CountryCode,
+			LastSeenAt:  token.LastSeenAt,
+			CreatedAt:   token.CreatedAt,
+			ExpiresAt:   token.ExpiresAt,
+			Persistent:  token.Persistent,
+		})
+	}
+
+	return sessions, nil
+}
+
+func (r *adminRefreshTokenMemoryRepository) Rotate(
+	_ context.Context,
+	currentJTI string,
+	replacement domain.AdminRefreshTokenRecord,
+	now time.Time,
+) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if !r.store.adminRefreshTokens.rotate(currentJTI, memoryRefreshToken(replacement), now, true) {
+		return ErrAdminRefreshTokenNotFound
+	}
+	return nil
+}
+
+func (r *adminRefreshTokenMemoryRepository) RevokeByJTIAndUserID(
+	_ context.Context,
+	jti string,
+	userID string,
+	now time.Time,
+) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	resolvedJTI := strings.TrimSpace(jti)
+	resolvedUserID := strings.TrimSpace(userID)
+	return r.store.adminRefreshTokens.revoke(now, func(token memoryRefreshToken) bool {
+		return token.JTI == resolvedJTI && token.UserID == resolvedUserID && token.RotatedAt == nil
+	}), nil
+}
+
+func (r *adminRefreshTokenMemoryRepository) RevokeByJTI(_ context.Context, jti string, now time.Time) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	resolvedJTI := strings.TrimSpace(jti)
+	r.store.adminRefreshTokens.revoke(now, func(token memoryRefreshToken) bool {
+		return token.JTI == resolvedJTI
+	})
+	return nil
+}
+
+func (r *adminRefreshTokenMemoryRepository) RevokeFamily(_ context.Context, jti string, now time.Time) (int, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return r.store.adminRefreshTokens.revokeFamily(jti, now), nil
+}
+
+func (r *adminRefreshTokenMemoryRepository) RevokeAllByUserID(_ context.Context, userID string, now time.Time) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	resolvedUserID := strings.TrimSpace(userID)
+	r.store.adminRefreshTokens.revokeAll(now, func(token memoryRefreshToken) bool {
+		return token.UserID == resolvedUserID
+	})
+	return nil
+}
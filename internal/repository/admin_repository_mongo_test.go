@@ -23,8 +23,8 @@ func TestAdminDashboardRepositoryWithMockData(t *testing.T) {
 		t.Cleanup(resetPostRepositoryState)
 		t.Cleanup(resetNewsletterRepositoryState)
 		configureRepositoryMockDatabase(t, "blog_test")
-		useMockPostClient(mt)
-		useMockNewsletterClient(mt)
+		skipPostIndexCreation()
+		skipNewsletterIndexCreation()
 
 		mt.AddMockResponses(
 			mtest.CreateSuccessResponse(bson.E{Key: "values", Value: bson.A{"alpha-post", "beta-post"}}),
@@ -66,7 +66,7 @@ func TestAdminDashboardRepositoryWithMockData(t *testing.T) {
 			),
 		)
 
-		repository := NewAdminDashboardMongoRepository()
+		repository := NewAdminDashboardMongoRepository(mt.Client)
 		ctx := context.Background()
 
 		totalPosts, err := repository.CountDistinctPosts(ctx)
@@ -109,7 +109,7 @@ func TestAdminNewsletterRepositoryListSubscribersAndUpdateWithMockData(t *testin
 		resetNewsletterRepositoryState()
 		t.Cleanup(resetNewsletterRepositoryState)
 		configureRepositoryMockDatabase(t, "blog_test")
-		useMockNewsletterClient(mt)
+		skipNewsletterIndexCreation()
 
 		now := time.Date(2026, time.March, 22, 10, 0, 0, 0, time.UTC)
 
@@ -142,7 +142,7 @@ func TestAdminNewsletterRepositoryListSubscribersAndUpdateWithMockData(t *testin
 			}}),
 		)
 
-		repository := NewAdminNewsletterRepository()
+		repository := NewAdminNewsletterRepository(mt.Client)
 		ctx := context.Background()
 
 		result, err := repository.ListSubscribers(ctx, domain.AdminNewsletterSubscriberFilter{
@@ -178,8 +178,8 @@ func TestAdminNewsletterRepositoryCampaignAndFailureListsWithMockData(t *testing
 		t.Cleanup(resetPostRepositoryState)
 		t.Cleanup(resetAdminRepositoryState)
 		configureRepositoryMockDatabase(t, "blog_test")
-		useMockPostClient(mt)
-		useMockAdminClient(mt)
+		skipPostIndexCreation()
+		skipAdminUserIndexCreation()
 
 		now := time.Date(2026, time.March, 22, 10, 0, 0, 0, time.UTC)
 
@@ -220,7 +220,7 @@ func TestAdminNewsletterRepositoryCampaignAndFailureListsWithMockData(t *testing
 			),
 		)
 
-		repository := NewAdminNewsletterRepository()
+		repository := NewAdminNewsletterRepository(mt.Client)
 		ctx := context.Background()
 
 		campaigns, err := repository.ListCampaigns(ctx, domain.AdminNewsletterCampaignFilter{
@@ -257,7 +257,7 @@ func TestAdminUserRepositoryWithMockData(t *testing.T) {
 		resetAdminRepositoryState()
 		t.Cleanup(resetAdminRepositoryState)
 		configureRepositoryMockDatabase(t, "blog_test")
-		useMockAdminClient(mt)
+		skipAdminUserIndexCreation()
 
 		now := time.Date(2026, time.March, 22, 10, 0, 0, 0, time.UTC)
 
@@ -311,7 +311,7 @@ func TestAdminUserRepositoryWithMockData(t *testing.T) {
 			mockUpdateResponse(1, 1),
 		)
 
-		repository := NewAdminUserRepository()
+		repository := NewAdminUserRepository(mt.Client)
 		ctx := context.Background()
 
 		record, err := repository.FindByEmail(ctx, " ADMIN@example.com ")
@@ -327,6 +327,9 @@ func TestAdminUserRepositoryWithMockData(t *testing.T) {
 		if len(record.Roles) != 1 || record.Roles[0] != "admin" || record.PendingPasswordReset == nil || record.PendingPasswordReset.Locale != "en" {
 			t.Fatalf("unexpected normalized record: %#v", record)
 		}
+		if record.Status != "active" || record.PendingInvitation != nil || record.InvitationExpiresAt != nil {
+			t.Fatalf("expected legacy record to be active without invitation: %#v", record)
+		}
 
 		hasGoogle, err := repository.HasAnyGoogleLink(ctx)
 		if err != nil || !hasGoogle {
@@ -393,6 +396,63 @@ func TestAdminUserRepositoryWithMockData(t *testing.T) {
 	})
 }
 
+func TestAdminContentRepositoryDeletePostPrunesSeriesAndRelatedWithMockData(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().CreateClient(false))
+	mt.RunOpts("mock delete post references", mtest.NewOptions().
+		ClientType(mtest.Mock).
+		DatabaseName("blog_test").
+		CreateCollection(false), func(mt *mtest.T) {
+		resetPostRepositoryState()
+		t.Cleanup(resetPostRepositoryState)
+		configureRepositoryMockDatabase(t, "blog_test")
+		skipPostIndexCreation()
+
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: int32(1)}),
+			mockUpdateResponse(1, 1),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: int32(1)}),
+			mockUpdateResponse(2, 2),
+			mockCountDocumentsResponse("blog_test."+postsCollectionName, 1),
+		)
+
+		deleted, err := NewAdminContentRepository(mt.Client).DeletePostByLocaleAndID(context.Background(), "EN", " Alpha-Post ")
+		if err != nil || !deleted {
+			t.Fatalf("DeletePostByLocaleAndID() = %v, %v", deleted, err)
+		}
+
+		wantCommands := []struct {
+			name       string
+			collection string
+		}{
+			{name: "delete", collection: postsCollectionName},
+			{name: "update", collection: seriesCollectionName},
+			{name: "delete", collection: postRelatedCollectionName},
+			{name: "update", collection: postRelatedCollectionName},
+			{name: "aggregate", collection: postsCollectionName},
+		}
+		events := mt.GetAllStartedEvents()
+		if len(events) != len(wantCommands) {
+			t.Fatalf("started %d commands, want %d", len(events), len(wantCommands))
+		}
+		for index, want := range wantCommands {
+			event := events[index]
+			collection, _ := event.Command.Lookup(event.CommandName).StringValueOK()
+			if event.CommandName != want.name || collection != want.collection {
+				t.Fatalf("command %d = %s %s, want %s %s", index, event.CommandName, collection, want.name, want.collection)
+			}
+		}
+
+		seriesUpdate := events[1].Command.Lookup("updates").Array().Index(0).Value().Document()
+		if postID := seriesUpdate.Lookup("u", "$pull", "postIds").StringValue(); postID != "alpha-post" {
+			t.Fatalf("series $pull postIds = %q", postID)
+		}
+		relatedUpdate := events[3].Command.Lookup("updates").Array().Index(0).Value().Document()
+		if postID := relatedUpdate.Lookup("q", "items.postId").StringValue(); postID != "alpha-post" {
+			t.Fatalf("related items filter postId = %q", postID)
+		}
+	})
+}
+
 func TestAdminContentRepositoryWithMockData(t *testing.T) {
 	mt := mtest.New(t, mtest.NewOptions().CreateClient(false))
 	mt.RunOpts("mock admin content", mtest.NewOptions().
@@ -402,7 +462,7 @@ func TestAdminContentRepositoryWithMockData(t *testing.T) {
 		resetPostRepositoryState()
 		t.Cleanup(resetPostRepositoryState)
 		configureRepositoryMockDatabase(t, "blog_test")
-		useMockPostClient(mt)
+		skipPostIndexCreation()
 
 		now := time.Date(2026, time.March, 22, 10, 0, 0, 0, time.UTC)
 
@@ -499,6 +559,9 @@ func TestAdminContentRepositoryWithMockData(t *testing.T) {
 				{Key: "updatedAt", Value: now},
 			}}),
 			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: int32(1)}),
+			mockUpdateResponse(1, 1),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: int32(1)}),
+			mockUpdateResponse(1, 1),
 			mockCountDocumentsResponse("blog_test."+postsCollectionName, 0),
 			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: int32(1)}),
 			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: int32(1)}),
@@ -574,7 +637,7 @@ func TestAdminContentRepositoryWithMockData(t *testing.T) {
 			mockUpdateResponse(1, 1),
 		)
 
-		repository := NewAdminContentRepository()
+		repository := NewAdminContentRepository(mt.Client)
 		ctx := context.Background()
 
 		postGroups, err := repository.ListPostGroups(ctx, domain.AdminContentPostFilter{PreferredLocale: "tr"})
@@ -723,7 +786,7 @@ func TestAdminAuditLogRepositoryWithMockData(t *testing.T) {
 			adminAuditLogIndexesErr = nil
 		})
 		configureRepositoryMockDatabase(t, "blog_test")
-		useMockAdminClient(mt)
+		skipAdminUserIndexCreation()
 		adminAuditLogIndexesErr = nil
 		markOnceDone(&adminAuditLogIndexesOnce)
 
@@ -754,7 +817,7 @@ func TestAdminAuditLogRepositoryWithMockData(t *testing.T) {
 			),
 		)
 
-		repository := NewAdminAuditLogRepository()
+		repository := NewAdminAuditLogRepository(mt.Client)
 		ctx := context.Background()
 
 		if err := repository.Create(ctx, domain.AdminAuditLogRecord{
@@ -789,7 +852,7 @@ func TestCommentRepositoryWithMockData(t *testing.T) {
 		t.Cleanup(resetPostRepositoryState)
 		t.Cleanup(resetCommentRepositoryState)
 		configureRepositoryMockDatabase(t, "blog_test")
-		useMockPostClient(mt)
+		skipPostIndexCreation()
 		postCommentsIndexesErr = nil
 		markOnceDone(&postCommentsIndexesOnce)
 
@@ -835,7 +898,7 @@ func TestCommentRepositoryWithMockData(t *testing.T) {
 			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: int64(2)}),
 		)
 
-		repository := NewCommentRepository()
+		repository := NewCommentRepository(mt.Client)
 		ctx := context.Background()
 
 		approved, err := repository.ListApprovedByPost(ctx, "alpha-post")
@@ -898,7 +961,7 @@ func TestAdminRefreshTokenRepositoryWithMockData(t *testing.T) {
 		resetAdminRefreshTokenRepositoryState()
 		t.Cleanup(resetAdminRefreshTokenRepositoryState)
 		configureRepositoryMockDatabase(t, "blog_test")
-		useMockAdminRefreshTokenClient(mt)
+		skipAdminRefreshTokenIndexCreation()
 
 		now := time.Date(2026, time.March, 22, 10, 0, 0, 0, time.UTC)
 		rawToken := " raw-token "
@@ -938,7 +1001,7 @@ func TestAdminRefreshTokenRepositoryWithMockData(t *testing.T) {
 			mockUpdateResponse(2, 2),
 		)
 
-		repository := NewAdminRefreshTokenMongoRepository()
+		repository := NewAdminRefreshTokenMongoRepository(mt.Client)
 		ctx := context.Background()
 
 		err := repository.Create(ctx, domain.AdminRefreshTokenRecord{
@@ -1011,7 +1074,7 @@ func TestErrorMessageRepositoryWithMockData(t *testing.T) {
 		resetErrorMessageRepositoryState()
 		t.Cleanup(resetErrorMessageRepositoryState)
 		configureRepositoryMockDatabase(t, "blog_test")
-		useMockErrorMessageClient(mt)
+		skipErrorMessageIndexCreation()
 
 		now := time.Date(2026, time.March, 22, 10, 0, 0, 0, time.UTC)
 
@@ -1033,7 +1096,7 @@ func TestErrorMessageRepositoryWithMockData(t *testing.T) {
 			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: int32(1)}),
 		)
 
-		repository := NewErrorMessageRepository()
+		repository := NewErrorMessageRepository(mt.Client)
 		ctx := context.Background()
 
 		items, err := repository.ListByScope(ctx, " auth ")
@@ -1069,7 +1132,7 @@ func TestAdminAvatarRepositoryWithMockData(t *testing.T) {
 		resetAdminAvatarRepositoryState()
 		t.Cleanup(resetAdminAvatarRepositoryState)
 		configureRepositoryMockDatabase(t, "blog_test")
-		useMockAdminAvatarClient(mt)
+		skipAdminAvatarIndexCreation()
 
 		now := time.Date(2026, time.March, 22, 10, 0, 0, 0, time.UTC)
 
@@ -1096,7 +1159,7 @@ func TestAdminAvatarRepositoryWithMockData(t *testing.T) {
 			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: int32(1)}),
 		)
 
-		repository := NewAdminAvatarRepository()
+		repository := NewAdminAvatarRepository(mt.Client)
 		ctx := context.Background()
 
 		err := repository.UpsertByUserID(ctx, domain.AdminAvatarRecord{
@@ -1137,60 +1200,46 @@ func configureRepositoryMockDatabase(t *testing.T, databaseName string) {
 	t.Setenv("MONGODB_DATABASE", databaseName)
 }
 
-func useMockPostClient(mt *mtest.T) {
-	postMongoClient = mt.Client
-	postMongoInitErr = nil
-	markOnceDone(&postMongoOnce)
+func skipPostIndexCreation() {
 	postLikesIndexesErr = nil
 	postHitsIndexesErr = nil
 	postContentIndexesErr = nil
 	postTopicIndexesErr = nil
 	postCategoryIndexesErr = nil
+	postSeriesIndexesErr = nil
+	postRelatedIndexesErr = nil
 	markOnceDone(&postLikesIndexesOnce)
 	markOnceDone(&postHitsIndexesOnce)
 	markOnceDone(&postContentIndexesOnce)
 	markOnceDone(&postTopicIndexesOnce)
 	markOnceDone(&postCategoryIndexesOnce)
+	markOnceDone(&postSeriesIndexesOnce)
+	markOnceDone(&postRelatedIndexesOnce)
 }
 
-func useMockNewsletterClient(mt *mtest.T) {
-	newsletterMongoClient = mt.Client
-	newsletterMongoInitErr = nil
-	markOnceDone(&newsletterMongoClientOnce)
+func skipNewsletterIndexCreation() {
 	newsletterIndexesErr = nil
 	markOnceDone(&newsletterIndexesOnce)
 }
 
-func useMockAdminClient(mt *mtest.T) {
-	adminMongoClient = mt.Client
-	adminMongoInitErr = nil
-	markOnceDone(&adminMongoClientOnce)
+func skipAdminUserIndexCreation() {
 	adminUserIndexesErr = nil
 	readerUserIndexesErr = nil
 	markOnceDone(&adminUserIndexesOnce)
 	markOnceDone(&readerUserIndexesOnce)
 }
 
-func useMockAdminRefreshTokenClient(mt *mtest.T) {
-	adminRefreshTokenMongoClient = mt.Client
-	adminRefreshTokenMongoInitErr = nil
-	markOnceDone(&adminRefreshTokenMongoClientOnce)
+func skipAdminRefreshTokenIndexCreation() {
 	adminRefreshTokenIndexesErr = nil
 	markOnceDone(&adminRefreshTokenIndexesOnce)
 }
 
-func useMockErrorMessageClient(mt *mtest.T) {
-	errorMessageMongoClient = mt.Client
-	errorMessageMongoInitErr = nil
-	markOnceDone(&errorMessageMongoClientOnce)
+func skipErrorMessageIndexCreation() {
 	errorMessageIndexesErr = nil
 	markOnceDone(&errorMessageIndexesOnce)
 }
 
-func useMockAdminAvatarClient(mt *mtest.T) {
-	adminAvatarMongoClient = mt.Client
-	adminAvatarMongoInitErr = nil
-	markOnceDone(&adminAvatarMongoClientOnce)
+func skipAdminAvatarIndexCreation() {
 	adminAvatarIndexesErr = nil
 	markOnceDone(&adminAvatarIndexesOnce)
 }
@@ -0,0 +1,266 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// activeAdminStatusFilter matches admins that may sign in. Documents created before statuses existed have no status
+// and count as active.
+func activeAdminStatusFilter() bson.M {
+	return bson.M{"$nin": bson.A{domain.AdminUserStatusDisabled, domain.AdminUserStatusInvited}}
+}
+
+// Create inserts a new admin. Records without a status are stored as active.
+func (repo *adminMongoRepository) Create(ctx context.Context, record domain.AdminUserRecord) error {
+	collection, err := getAdminUsersCollection(repo.client)
+	if err != nil {
+		return fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
+	}
+
+	status := strings.TrimSpace(record.Status)
+	if status == "" {
+		status = domain.AdminUserStatusActive
+	}
+
+	now := time.Now().UTC()
+	document := bson.M{
+		"id":              strings.TrimSpace(record.ID),
+		"name":            strings.TrimSpace(record.Name),
+		"email":           strings.TrimSpace(strings.ToLower(record.Email)),
+		"roles":           normalizeAdminRoles(record.Roles),
+		"status":          status,
+		"passwordVersion": record.PasswordVersion,
+		"createdAt":       now,
+	}
+	if passwordHash := strings.TrimSpace(record.PasswordHash); passwordHash != "" {
+		document["passwordHash"] = passwordHash
+	}
+	if record.PendingInvitation != nil {
+		document["pendingInvitation"] = buildAdminPendingInvitationDocument(*record.PendingInvitation)
+	}
+
+	if _, err := collection.InsertOne(ctx, document); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrAdminEmailAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+// List returns every admin, including invited and disabled ones, ordered by email.
+func (repo *adminMongoRepository) List(ctx context.Context) ([]domain.AdminUserRecord, error) {
+	collection, err := getAdminUsersCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
+	}
+
+	cursor, err := collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "email", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	var docs []adminUserDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	records := make([]domain.AdminUserRecord, 0, len(docs))
+	for _, doc := range docs {
+		if record := mapAdminUserDocument(doc); record != nil {
+			records = append(records, *record)
+		}
+	}
+	return records, nil
+}
+
+// FindByIDAnyStatus loads an admin regardless of whether it is active, invited or disabled.
+func (repo *adminMongoRepository) FindByIDAnyStatus(ctx context.Context, id string) (*domain.AdminUserRecord, error) {
+	collection, err := getAdminUsersCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
+	}
+
+	return findAdminUser(ctx, collection, bson.M{"id": strings.TrimSpace(id)})
+}
+
+func (repo *adminMongoRepository) FindByPendingInvitationTokenHash(
+	ctx context.Context,
+	tokenHash string,
+) (*domain.AdminUserRecord, error) {
+	collection, err := getAdminUsersCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
+	}
+
+	return findAdminUser(ctx, collection, bson.M{
+		adminPendingInvitationTokenHashKey: strings.TrimSpace(tokenHash),
+		"status":                           domain.AdminUserStatusInvited,
+	})
+}
+
+// RefreshInvitationByID replaces the roles and invitation link of an admin that has not accepted yet.
+func (repo *adminMongoRepository) RefreshInvitationByID(
+	ctx context.Context,
+	id string,
+	roles []string,
+	pending domain.AdminPendingInvitation,
+) error {
+	collection, err := getAdminUsersCollection(repo.client)
+	if err != nil {
+		return fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
+	}
+
+	result, err := collection.UpdateOne(
+		ctx,
+		bson.M{
+			"id":     strings.TrimSpace(id),
+			"status": domain.AdminUserStatusInvited,
+		},
+		bson.M{
+			"$set": bson.M{
+				"roles":             normalizeAdminRoles(roles),
+				"pendingInvitation": buildAdminPendingInvitationDocument(pending),
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrAdminUserNotFound
+	}
+
+	return nil
+}
+
+// AcceptInvitationByID activates an invited admin. An empty password hash leaves the account without a password, for
+// invitees that sign in with Google or GitHub.
+func (repo *adminMongoRepository) AcceptInvitationByID(ctx context.Context, id, passwordHash string) error {
+	collection, err := getAdminUsersCollection(repo.client)
+	if err != nil {
+		return fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
+	}
+
+	setFields := bson.M{
+		"status":      domain.AdminUserStatusActive,
+		"activatedAt": time.Now().UTC(),
+	}
+	if resolvedPasswordHash := strings.TrimSpace(passwordHash); resolvedPasswordHash != "" {
+		setFields["passwordHash"] = resolvedPasswordHash
+	}
+
+	result, err := collection.UpdateOne(
+		ctx,
+		bson.M{
+			"id":     strings.TrimSpace(id),
+			"status": domain.AdminUserStatusInvited,
+		},
+		bson.M{
+			"$set": setFields,
+			adminMongoUnsetOperator: bson.M{
+				"pendingInvitation": "",
+			},
+			"$inc": bson.M{
+				"passwordVersion": 1,
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrAdminUserNotFound
+	}
+
+	return nil
+}
+
+func (repo *adminMongoRepository) EnableByID(ctx context.Context, id string) error {
+	collection, err := getAdminUsersCollection(repo.client)
+	if err != nil {
+		return fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
+	}
+
+	result, err := collection.UpdateOne(
+		ctx,
+		bson.M{
+			"id":     strings.TrimSpace(id),
+			"status": domain.AdminUserStatusDisabled,
+		},
+		bson.M{
+			"$set": bson.M{
+				"status": domain.AdminUserStatusActive,
+			},
+			adminMongoUnsetOperator: bson.M{
+				"disabledAt": "",
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrAdminUserNotFound
+	}
+
+	return nil
+}
+
+func (repo *adminMongoRepository) UpdateRolesByID(ctx context.Context, id string, roles []string) error {
+	collection, err := getAdminUsersCollection(repo.client)
+	if err != nil {
+		return fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
+	}
+
+	result, err := collection.UpdateOne(
+		ctx,
+		bson.M{"id": strings.TrimSpace(id)},
+		bson.M{
+			"$set": bson.M{
+				"roles": normalizeAdminRoles(roles),
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrAdminUserNotFound
+	}
+
+	return nil
+}
+
+func buildAdminPendingInvitationDocument(pending domain.AdminPendingInvitation) bson.M {
+	return bson.M{
+		"tokenHash": strings.TrimSpace(pending.TokenHash),
+		"locale":    strings.TrimSpace(strings.ToLower(pending.Locale)),
+		"invitedBy": strings.TrimSpace(pending.InvitedBy),
+		"invitedAt": pending.InvitedAt.UTC(),
+		"expiresAt": pending.ExpiresAt.UTC(),
+	}
+}
+
+func normalizeAdminRoles(roles []string) []string {
+	resolved := make([]string, 0, len(roles))
+	for _, role := range roles {
+		if trimmed := strings.TrimSpace(strings.ToLower(role)); trimmed != "" {
+			resolved = append(resolved, trimmed)
+		}
+	}
+	return resolved
+}
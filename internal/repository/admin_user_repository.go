@@ -42,6 +42,20 @@ type AdminUserRepository interface {
 	ClearGithubLinkByID(ctx context.Context, id string) error
 	UpdateAvatarByID(ctx context.Context, id, avatarURL, avatarDigest string, avatarVersion int64) error
 	DisableByID(ctx context.Context, id string) error
+	Create(ctx context.Context, record domain.AdminUserRecord) error
+	List(ctx context.Context) ([]domain.AdminUserRecord, error)
+	FindByIDAnyStatus(ctx context.Context, id string) (*domain.AdminUserRecord, error)
+	FindByPendingInvitationTokenHash(ctx context.Context, tokenHash string) (*domain.AdminUserRecord, error)
+	RefreshInvitationByID(ctx context.Context, id string, roles []string, pending domain.AdminPendingInvitation) error
+	AcceptInvitationByID(ctx context.Context, id, passwordHash string) error
+	EnableByID(ctx context.Context, id string) error
+	UpdateRolesByID(ctx context.Context, id string, roles []string) error
+	SetPendingTwoFactorByID(ctx context.Context, id string, pending domain.AdminPendingTwoFactor) error
+	EnableTwoFactorByID(ctx context.Context, id string, twoFactor domain.AdminTwoFactor) error
+	DisableTwoFactorByID(ctx context.Context, id string) error
+	ReplaceTwoFactorRecoveryCodesByID(ctx context.Context, id string, recoveryCodeHashes []string) error
+	ConsumeTwoFactorStepByID(ctx context.Context, id string, step int64) (bool, error)
+	ConsumeTwoFactorRecoveryCodeByID(ctx context.Context, id, recoveryCodeHash string) (bool, error)
 }
 
 var (
@@ -57,115 +71,116 @@ const (
 	adminUsersCollectionName              = "admin_users"
 	adminUsersRepositoryUnavailableFormat = "%w: %v"
 	adminPendingPasswordResetTokenHashKey = "pendingPasswordReset.tokenHash"
+	adminPendingInvitationTokenHashKey    = "pendingInvitation.tokenHash"
 	adminMongoExistsOperator              = "$exists"
 	adminMongoUnsetOperator               = "$unset"
 )
 
-type adminMongoRepository struct{}
+type adminMongoRepository struct {
+	client *mongo.Client
+}
 
 var (
-	adminMongoClient     *mongo.Client
-	adminMongoInitErr    error
-	adminMongoClientOnce sync.Once
-
 	adminUserIndexesOnce sync.Once
 	adminUserIndexesErr  error
 )
 
-func NewAdminUserRepository() AdminUserRepository { return &adminMongoRepository{} }
+func NewAdminUserRepository(client *mongo.Client) AdminUserRepository {
+	return &adminMongoRepository{client: client}
+}
 
-func (*adminMongoRepository) FindByEmail(ctx context.Context, email string) (*domain.AdminUserRecord, error) {
-	collection, err := getAdminUsersCollection()
+func (repo *adminMongoRepository) FindByEmail(ctx context.Context, email string) (*domain.AdminUserRecord, error) {
+	collection, err := getAdminUsersCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
 	}
 
 	return findAdminUser(ctx, collection, bson.M{
 		"email":  strings.TrimSpace(strings.ToLower(email)),
-		"status": bson.M{"$ne": "disabled"},
+		"status": activeAdminStatusFilter(),
 	})
 }
 
-func (*adminMongoRepository) FindByID(ctx context.Context, id string) (*domain.AdminUserRecord, error) {
-	collection, err := getAdminUsersCollection()
+func (repo *adminMongoRepository) FindByID(ctx context.Context, id string) (*domain.AdminUserRecord, error) {
+	collection, err := getAdminUsersCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
 	}
 
 	return findAdminUser(ctx, collection, bson.M{
 		"id":     strings.TrimSpace(id),
-		"status": bson.M{"$ne": "disabled"},
+		"status": activeAdminStatusFilter(),
 	})
 }
 
-func (*adminMongoRepository) FindByUsername(ctx context.Context, username string) (*domain.AdminUserRecord, error) {
-	collection, err := getAdminUsersCollection()
+func (repo *adminMongoRepository) FindByUsername(ctx context.Context, username string) (*domain.AdminUserRecord, error) {
+	collection, err := getAdminUsersCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
 	}
 
 	return findAdminUser(ctx, collection, bson.M{
 		"username": strings.TrimSpace(username),
-		"status":   bson.M{"$ne": "disabled"},
+		"status":   activeAdminStatusFilter(),
 	})
 }
 
-func (*adminMongoRepository) FindByGoogleSubject(ctx context.Context, subject string) (*domain.AdminUserRecord, error) {
-	collection, err := getAdminUsersCollection()
+func (repo *adminMongoRepository) FindByGoogleSubject(ctx context.Context, subject string) (*domain.AdminUserRecord, error) {
+	collection, err := getAdminUsersCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
 	}
 
 	return findAdminUser(ctx, collection, bson.M{
 		"googleSubject": strings.TrimSpace(subject),
-		"status":        bson.M{"$ne": "disabled"},
+		"status":        activeAdminStatusFilter(),
 	})
 }
 
-func (*adminMongoRepository) FindByGithubSubject(ctx context.Context, subject string) (*domain.AdminUserRecord, error) {
-	collection, err := getAdminUsersCollection()
+func (repo *adminMongoRepository) FindByGithubSubject(ctx context.Context, subject string) (*domain.AdminUserRecord, error) {
+	collection, err := getAdminUsersCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
 	}
 
 	return findAdminUser(ctx, collection, bson.M{
 		"githubSubject": strings.TrimSpace(subject),
-		"status":        bson.M{"$ne": "disabled"},
+		"status":        activeAdminStatusFilter(),
 	})
 }
 
-func (*adminMongoRepository) FindByPendingEmailChangeTokenHash(
+func (repo *adminMongoRepository) FindByPendingEmailChangeTokenHash(
 	ctx context.Context,
 	tokenHash string,
 ) (*domain.AdminUserRecord, error) {
-	collection, err := getAdminUsersCollection()
+	collection, err := getAdminUsersCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
 	}
 
 	return findAdminUser(ctx, collection, bson.M{
 		"pendingEmailChange.tokenHash": strings.TrimSpace(tokenHash),
-		"status":                       bson.M{"$ne": "disabled"},
+		"status":                       activeAdminStatusFilter(),
 	})
 }
 
-func (*adminMongoRepository) FindByPendingPasswordResetTokenHash(
+func (repo *adminMongoRepository) FindByPendingPasswordResetTokenHash(
 	ctx context.Context,
 	tokenHash string,
 ) (*domain.AdminUserRecord, error) {
-	collection, err := getAdminUsersCollection()
+	collection, err := getAdminUsersCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
 	}
 
 	return findAdminUser(ctx, collection, bson.M{
 		adminPendingPasswordResetTokenHashKey: strings.TrimSpace(tokenHash),
-		"status":                              bson.M{"$ne": "disabled"},
+		"status":                              activeAdminStatusFilter(),
 	})
 }
 
-func (*adminMongoRepository) HasAnyGoogleLink(ctx context.Context) (bool, error) {
-	collection, err := getAdminUsersCollection()
+func (repo *adminMongoRepository) HasAnyGoogleLink(ctx context.Context) (bool, error) {
+	collection, err := getAdminUsersCollection(repo.client)
 	if err != nil {
 		return false, fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
 	}
@@ -176,7 +191,7 @@ func (*adminMongoRepository) HasAnyGoogleLink(ctx context.Context) (bool, error)
 			"$type":                  "string",
 			"$ne":                    "",
 		},
-		"status": bson.M{"$ne": "disabled"},
+		"status": activeAdminStatusFilter(),
 	}, options.Count().SetLimit(1))
 	if err != nil {
 		return false, err
@@ -185,8 +200,8 @@ func (*adminMongoRepository) HasAnyGoogleLink(ctx context.Context) (bool, error)
 	return count > 0, nil
 }
 
-func (*adminMongoRepository) HasAnyGithubLink(ctx context.Context) (bool, error) {
-	collection, err := getAdminUsersCollection()
+func (repo *adminMongoRepository) HasAnyGithubLink(ctx context.Context) (bool, error) {
+	collection, err := getAdminUsersCollection(repo.client)
 	if err != nil {
 		return false, fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
 	}
@@ -197,7 +212,7 @@ func (*adminMongoRepository) HasAnyGithubLink(ctx context.Context) (bool, error)
 			"$type":                  "string",
 			"$ne":                    "",
 		},
-		"status": bson.M{"$ne": "disabled"},
+		"status": activeAdminStatusFilter(),
 	}, options.Count().SetLimit(1))
 	if err != nil {
 		return false, err
@@ -206,8 +221,8 @@ func (*adminMongoRepository) HasAnyGithubLink(ctx context.Context) (bool, error)
 	return count > 0, nil
 }
 
-func (*adminMongoRepository) UpdatePasswordHashByID(ctx context.Context, id, passwordHash string) error {
-	collection, err := getAdminUsersCollection()
+func (repo *adminMongoRepository) UpdatePasswordHashByID(ctx context.Context, id, passwordHash string) error {
+	collection, err := getAdminUsersCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
 	}
@@ -216,7 +231,7 @@ func (*adminMongoRepository) UpdatePasswordHashByID(ctx context.Context, id, pas
 		ctx,
 		bson.M{
 			"id":     strings.TrimSpace(id),
-			"status": bson.M{"$ne": "disabled"},
+			"status": activeAdminStatusFilter(),
 		},
 		bson.M{
 			"$set": bson.M{
@@ -240,8 +255,8 @@ func (*adminMongoRepository) UpdatePasswordHashByID(ctx context.Context, id, pas
 	return nil
 }
 
-func (*adminMongoRepository) UpdateNameByID(ctx context.Context, id, name string) error {
-	collection, err := getAdminUsersCollection()
+func (repo *adminMongoRepository) UpdateNameByID(ctx context.Context, id, name string) error {
+	collection, err := getAdminUsersCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
 	}
@@ -250,7 +265,7 @@ func (*adminMongoRepository) UpdateNameByID(ctx context.Context, id, name string
 		ctx,
 		bson.M{
 			"id":     strings.TrimSpace(id),
-			"status": bson.M{"$ne": "disabled"},
+			"status": activeAdminStatusFilter(),
 		},
 		bson.M{
 			"$set": bson.M{
@@ -268,8 +283,8 @@ func (*adminMongoRepository) UpdateNameByID(ctx context.Context, id, name string
 	return nil
 }
 
-func (*adminMongoRepository) UpdateUsernameByID(ctx context.Context, id, username string) error {
-	collection, err := getAdminUsersCollection()
+func (repo *adminMongoRepository) UpdateUsernameByID(ctx context.Context, id, username string) error {
+	collection, err := getAdminUsersCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
 	}
@@ -278,7 +293,7 @@ func (*adminMongoRepository) UpdateUsernameByID(ctx context.Context, id, usernam
 		ctx,
 		bson.M{
 			"id":     strings.TrimSpace(id),
-			"status": bson.M{"$ne": "disabled"},
+			"status": activeAdminStatusFilter(),
 		},
 		bson.M{
 			"$set": bson.M{
@@ -299,12 +314,12 @@ func (*adminMongoRepository) UpdateUsernameByID(ctx context.Context, id, usernam
 	return nil
 }
 
-func (*adminMongoRepository) SetPendingEmailChangeByID(
+func (repo *adminMongoRepository) SetPendingEmailChangeByID(
 	ctx context.Context,
 	id string,
 	pending domain.AdminPendingEmailChange,
 ) error {
-	collection, err := getAdminUsersCollection()
+	collection, err := getAdminUsersCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
 	}
@@ -313,7 +328,7 @@ func (*adminMongoRepository) SetPendingEmailChangeByID(
 		ctx,
 		bson.M{
 			"id":     strings.TrimSpace(id),
-			"status": bson.M{"$ne": "disabled"},
+			"status": activeAdminStatusFilter(),
 		},
 		bson.M{
 			"$set": bson.M{
@@ -337,8 +352,8 @@ func (*adminMongoRepository) SetPendingEmailChangeByID(
 	return nil
 }
 
-func (*adminMongoRepository) ClearPendingEmailChangeByID(ctx context.Context, id string) error {
-	collection, err := getAdminUsersCollection()
+func (repo *adminMongoRepository) ClearPendingEmailChangeByID(ctx context.Context, id string) error {
+	collection, err := getAdminUsersCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
 	}
@@ -347,7 +362,7 @@ func (*adminMongoRepository) ClearPendingEmailChangeByID(ctx context.Context, id
 		ctx,
 		bson.M{
 			"id":     strings.TrimSpace(id),
-			"status": bson.M{"$ne": "disabled"},
+			"status": activeAdminStatusFilter(),
 		},
 		bson.M{
 			adminMongoUnsetOperator: bson.M{
@@ -365,12 +380,12 @@ func (*adminMongoRepository) ClearPendingEmailChangeByID(ctx context.Context, id
 	return nil
 }
 
-func (*adminMongoRepository) SetPendingPasswordResetByID(
+func (repo *adminMongoRepository) SetPendingPasswordResetByID(
 	ctx context.Context,
 	id string,
 	pending domain.AdminPendingPasswordReset,
 ) error {
-	collection, err := getAdminUsersCollection()
+	collection, err := getAdminUsersCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
 	}
@@ -379,7 +394,7 @@ func (*adminMongoRepository) SetPendingPasswordResetByID(
 		ctx,
 		bson.M{
 			"id":     strings.TrimSpace(id),
-			"status": bson.M{"$ne": "disabled"},
+			"status": activeAdminStatusFilter(),
 		},
 		bson.M{
 			"$set": bson.M{
@@ -402,8 +417,8 @@ func (*adminMongoRepository) SetPendingPasswordResetByID(
 	return nil
 }
 
-func (*adminMongoRepository) ClearPendingPasswordResetByID(ctx context.Context, id string) error {
-	collection, err := getAdminUsersCollection()
+func (repo *adminMongoRepository) ClearPendingPasswordResetByID(ctx context.Context, id string) error {
+	collection, err := getAdminUsersCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
 	}
@@ -412,7 +427,7 @@ func (*adminMongoRepository) ClearPendingPasswordResetByID(ctx context.Context,
 		ctx,
 		bson.M{
 			"id":     strings.TrimSpace(id),
-			"status": bson.M{"$ne": "disabled"},
+			"status": activeAdminStatusFilter(),
 		},
 		bson.M{
 			adminMongoUnsetOperator: bson.M{
@@ -430,8 +445,8 @@ func (*adminMongoRepository) ClearPendingPasswordResetByID(ctx context.Context,
 	return nil
 }
 
-func (*adminMongoRepository) UpdateEmailByID(ctx context.Context, id, email string) error {
-	collection, err := getAdminUsersCollection()
+func (repo *adminMongoRepository) UpdateEmailByID(ctx context.Context, id, email string) error {
+	collection, err := getAdminUsersCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
 	}
@@ -440,7 +455,7 @@ func (*adminMongoRepository) UpdateEmailByID(ctx context.Context, id, email stri
 		ctx,
 		bson.M{
 			"id":     strings.TrimSpace(id),
-			"status": bson.M{"$ne": "disabled"},
+			"status": activeAdminStatusFilter(),
 		},
 		bson.M{
 			"$set": bson.M{
@@ -467,12 +482,12 @@ func (*adminMongoRepository) UpdateEmailByID(ctx context.Context, id, email stri
 	return nil
 }
 
-func (*adminMongoRepository) UpdateGoogleLinkByID(
+func (repo *adminMongoRepository) UpdateGoogleLinkByID(
 	ctx context.Context,
 	id, subject, email string,
 	linkedAt time.Time,
 ) error {
-	collection, err := getAdminUsersCollection()
+	collection, err := getAdminUsersCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
 	}
@@ -481,7 +496,7 @@ func (*adminMongoRepository) UpdateGoogleLinkByID(
 		ctx,
 		bson.M{
 			"id":     strings.TrimSpace(id),
-			"status": bson.M{"$ne": "disabled"},
+			"status": activeAdminStatusFilter(),
 		},
 		bson.M{
 			"$set": bson.M{
@@ -504,8 +519,8 @@ func (*adminMongoRepository) UpdateGoogleLinkByID(
 	return nil
 }
 
-func (*adminMongoRepository) ClearGoogleLinkByID(ctx context.Context, id string) error {
-	collection, err := getAdminUsersCollection()
+func (repo *adminMongoRepository) ClearGoogleLinkByID(ctx context.Context, id string) error {
+	collection, err := getAdminUsersCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
 	}
@@ -514,7 +529,7 @@ func (*adminMongoRepository) ClearGoogleLinkByID(ctx context.Context, id string)
 		ctx,
 		bson.M{
 			"id":     strings.TrimSpace(id),
-			"status": bson.M{"$ne": "disabled"},
+			"status": activeAdminStatusFilter(),
 		},
 		bson.M{
 			adminMongoUnsetOperator: bson.M{
@@ -534,12 +549,12 @@ func (*adminMongoRepository) ClearGoogleLinkByID(ctx context.Context, id string)
 	return nil
 }
 
-func (*adminMongoRepository) UpdateGithubLinkByID(
+func (repo *adminMongoRepository) UpdateGithubLinkByID(
 	ctx context.Context,
 	id, subject, email string,
 	linkedAt time.Time,
 ) error {
-	collection, err := getAdminUsersCollection()
+	collection, err := getAdminUsersCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
 	}
@@ -558,7 +573,7 @@ func (*adminMongoRepository) UpdateGithubLinkByID(
 		ctx,
 		bson.M{
 			"id":     strings.TrimSpace(id),
-			"status": bson.M{"$ne": "disabled"},
+			"status": activeAdminStatusFilter(),
 		},
 		bson.M{
 			"$set": setFields,
@@ -577,8 +592,8 @@ func (*adminMongoRepository) UpdateGithubLinkByID(
 	return nil
 }
 
-func (*adminMongoRepository) ClearGithubLinkByID(ctx context.Context, id string) error {
-	collection, err := getAdminUsersCollection()
+func (repo *adminMongoRepository) ClearGithubLinkByID(ctx context.Context, id string) error {
+	collection, err := getAdminUsersCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
 	}
@@ -587,7 +602,7 @@ func (*adminMongoRepository) ClearGithubLinkByID(ctx context.Context, id string)
 		ctx,
 		bson.M{
 			"id":     strings.TrimSpace(id),
-			"status": bson.M{"$ne": "disabled"},
+			"status": activeAdminStatusFilter(),
 		},
 		bson.M{
 			adminMongoUnsetOperator: bson.M{
@@ -607,14 +622,14 @@ func (*adminMongoRepository) ClearGithubLinkByID(ctx context.Context, id string)
 	return nil
 }
 
-func (*adminMongoRepository) UpdateAvatarByID(
+func (repo *adminMongoRepository) UpdateAvatarByID(
 	ctx context.Context,
 	id,
 	avatarURL,
 	avatarDigest string,
 	avatarVersion int64,
 ) error {
-	collection, err := getAdminUsersCollection()
+	collection, err := getAdminUsersCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
 	}
@@ -623,7 +638,7 @@ func (*adminMongoRepository) UpdateAvatarByID(
 		ctx,
 		bson.M{
 			"id":     strings.TrimSpace(id),
-			"status": bson.M{"$ne": "disabled"},
+			"status": activeAdminStatusFilter(),
 		},
 		bson.M{
 			"$set": bson.M{
@@ -643,8 +658,8 @@ func (*adminMongoRepository) UpdateAvatarByID(
 	return nil
 }
 
-func (*adminMongoRepository) DisableByID(ctx context.Context, id string) error {
-	collection, err := getAdminUsersCollection()
+func (repo *adminMongoRepository) DisableByID(ctx context.Context, id string) error {
+	collection, err := getAdminUsersCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
 	}
@@ -653,13 +668,16 @@ func (*adminMongoRepository) DisableByID(ctx context.Context, id string) error {
 		ctx,
 		bson.M{
 			"id":     strings.TrimSpace(id),
-			"status": bson.M{"$ne": "disabled"},
+			"status": bson.M{"$ne": domain.AdminUserStatusDisabled},
 		},
 		bson.M{
 			"$set": bson.M{
-				"status":     "disabled",
+				"status":     domain.AdminUserStatusDisabled,
 				"disabledAt": time.Now().UTC(),
 			},
+			adminMongoUnsetOperator: bson.M{
+				"pendingInvitation": "",
+			},
 			"$inc": bson.M{
 				"passwordVersion": 1,
 			},
@@ -675,42 +693,14 @@ func (*adminMongoRepository) DisableByID(ctx context.Context, id string) error {
 	return nil
 }
 
-func getAdminMongoClient() (*mongo.Client, error) {
-	adminMongoClientOnce.Do(func() {
-		databaseConfig, err := appconfig.ResolveDatabaseConfig()
-		if err != nil {
-			adminMongoInitErr = err
-			return
-		}
-
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
-
-		client, err := appconfig.NewMongoClient(ctx, databaseConfig, "blog-api-admin-users")
-		if err != nil {
-			adminMongoInitErr = fmt.Errorf("mongodb connect failed: %w", err)
-			return
-		}
-
-		adminMongoClient = client
-	})
-
-	if adminMongoInitErr != nil {
-		return nil, adminMongoInitErr
-	}
-
-	return adminMongoClient, nil
-}
-
-func getAdminUsersCollection() (*mongo.Collection, error) {
+func getAdminUsersCollection(client *mongo.Client) (*mongo.Collection, error) {
 	databaseConfig, err := appconfig.ResolveDatabaseConfig()
 	if err != nil {
 		return nil, err
 	}
 
-	client, err := getAdminMongoClient()
-	if err != nil {
-		return nil, err
+	if client == nil {
+		return nil, ErrMongoClientUnavailable
 	}
 
 	collection := client.Database(databaseConfig.Name).Collection(adminUsersCollectionName)
@@ -787,6 +777,18 @@ func ensureAdminUserIndexes(collection *mongo.Collection) error {
 						},
 					}),
 			},
+			{
+				Keys: bson.D{{Key: adminPendingInvitationTokenHashKey, Value: 1}},
+				Options: options.Index().
+					SetUnique(true).
+					SetName("uniq_admin_user_pending_invitation_token_hash").
+					SetPartialFilterExpression(bson.M{
+						adminPendingInvitationTokenHashKey: bson.M{
+							adminMongoExistsOperator: true,
+							"$type":                  "string",
+						},
+					}),
+			},
 		}
 
 		if _, err := collection.Indexes().CreateMany(ctx, indexes); err != nil {
@@ -797,39 +799,59 @@ func ensureAdminUserIndexes(collection *mongo.Collection) error {
 	return adminUserIndexesErr
 }
 
-func findAdminUser(ctx context.Context, collection *mongo.Collection, filter bson.M) (*domain.AdminUserRecord, error) {
-	var doc struct {
-		ID                 string     `bson:"id"`
-		Name               string     `bson:"name"`
-		Username           string     `bson:"username"`
-		AvatarURL          string     `bson:"avatarUrl"`
-		AvatarDigest       string     `bson:"avatarDigest"`
-		AvatarVersion      int64      `bson:"avatarVersion"`
-		Email              string     `bson:"email"`
-		GoogleSubject      string     `bson:"googleSubject"`
-		GoogleEmail        string     `bson:"googleEmail"`
-		GithubSubject      string     `bson:"githubSubject"`
-		GithubEmail        string     `bson:"githubEmail"`
-		PasswordHash       string     `bson:"passwordHash"`
-		PasswordVersion    int64      `bson:"passwordVersion"`
-		Roles              []string   `bson:"roles"`
-		GoogleLinkedAt     *time.Time `bson:"googleLinkedAt"`
-		GithubLinkedAt     *time.Time `bson:"githubLinkedAt"`
-		PendingEmailChange *struct {
-			NewEmail    string    `bson:"newEmail"`
-			TokenHash   string    `bson:"tokenHash"`
-			Locale      string    `bson:"locale"`
-			RequestedAt time.Time `bson:"requestedAt"`
-			ExpiresAt   time.Time `bson:"expiresAt"`
-		} `bson:"pendingEmailChange"`
-		PendingPasswordReset *struct {
-			TokenHash   string    `bson:"tokenHash"`
-			Locale      string    `bson:"locale"`
-			RequestedAt time.Time `bson:"requestedAt"`
-			ExpiresAt   time.Time `bson:"expiresAt"`
-		} `bson:"pendingPasswordReset"`
-	}
+type adminUserDocument struct {
+	ID                 string     `bson:"id"`
+	Name               string     `bson:"name"`
+	Username           string     `bson:"username"`
+	AvatarURL          string     `bson:"avatarUrl"`
+	AvatarDigest       string     `bson:"avatarDigest"`
+	AvatarVersion      int64      `bson:"avatarVersion"`
+	Email              string     `bson:"email"`
+	GoogleSubject      string     `bson:"googleSubject"`
+	GoogleEmail        string     `bson:"googleEmail"`
+	GithubSubject      string     `bson:"githubSubject"`
+	GithubEmail        string     `bson:"githubEmail"`
+	PasswordHash       string     `bson:"passwordHash"`
+	PasswordVersion    int64      `bson:"passwordVersion"`
+	Roles              []string   `bson:"roles"`
+	Status             string     `bson:"status"`
+	CreatedAt          *time.Time `bson:"createdAt"`
+	GoogleLinkedAt     *time.Time `bson:"googleLinkedAt"`
+	GithubLinkedAt     *time.Time `bson:"githubLinkedAt"`
+	PendingEmailChange *struct {
+		NewEmail    string    `bson:"newEmail"`
+		TokenHash   string    `bson:"tokenHash"`
+		Locale      string    `bson:"locale"`
+		RequestedAt time.Time `bson:"requestedAt"`
+		ExpiresAt   time.Time `bson:"expiresAt"`
+	} `bson:"pendingEmailChange"`
+	PendingPasswordReset *struct {
+		TokenHash   string    `bson:"tokenHash"`
+		Locale      string    `bson:"locale"`
+		RequestedAt time.Time `bson:"requestedAt"`
+		ExpiresAt   time.Time `bson:"expiresAt"`
+	} `bson:"pendingPasswordReset"`
+	PendingInvitation *struct {
+		TokenHash string    `bson:"tokenHash"`
+		Locale    string    `bson:"locale"`
+		InvitedBy string    `bson:"invitedBy"`
+		InvitedAt time.Time `bson:"invitedAt"`
+		ExpiresAt time.Time `bson:"expiresAt"`
+	} `bson:"pendingInvitation"`
+	TwoFactor *struct {
+		Secret             string    `bson:"secret"`
+		RecoveryCodeHashes []string  `bson:"recoveryCodeHashes"`
+		LastUsedStep       int64     `bson:"lastUsedStep"`
+		EnabledAt          time.Time `bson:"enabledAt"`
+	} `bson:"twoFactor"`
+	PendingTwoFactor *struct {
+		Secret    string    `bson:"secret"`
+		ExpiresAt time.Time `bson:"expiresAt"`
+	} `bson:"pendingTwoFactor"`
+}
 
+func findAdminUser(ctx context.Context, collection *mongo.Collection, filter bson.M) (*domain.AdminUserRecord, error) {
+	var doc adminUserDocument
 	err := collection.FindOne(ctx, filter).Decode(&doc)
 	if errors.Is(err, mongo.ErrNoDocuments) {
 		return nil, nil
@@ -838,8 +860,12 @@ func findAdminUser(ctx context.Context, collection *mongo.Collection, filter bso
 		return nil, err
 	}
 
+	return mapAdminUserDocument(doc), nil
+}
+
+func mapAdminUserDocument(doc adminUserDocument) *domain.AdminUserRecord {
 	if doc.ID == "" || doc.Email == "" {
-		return nil, nil
+		return nil
 	}
 
 	roles := doc.Roles
@@ -847,10 +873,20 @@ func findAdminUser(ctx context.Context, collection *mongo.Collection, filter bso
 		roles = []string{"admin"}
 	}
 
+	status := strings.TrimSpace(doc.Status)
+	if status == "" {
+		status = domain.AdminUserStatusActive
+	}
+
 	var pendingEmail string
 	var pendingEmailExpiresAt *time.Time
 	var pendingChange *domain.AdminPendingEmailChange
 	var pendingPasswordReset *domain.AdminPendingPasswordReset
+	var pendingInvitation *domain.AdminPendingInvitation
+	var invitationExpiresAt *time.Time
+	var twoFactor *domain.AdminTwoFactor
+	var pendingTwoFactor *domain.AdminPendingTwoFactor
+	var twoFactorEnabledAt *time.Time
 	if doc.PendingEmailChange != nil {
 		pendingEmail = strings.TrimSpace(strings.ToLower(doc.PendingEmailChange.NewEmail))
 		expiresAt := doc.PendingEmailChange.ExpiresAt
@@ -871,6 +907,34 @@ func findAdminUser(ctx context.Context, collection *mongo.Collection, filter bso
 			ExpiresAt:   doc.PendingPasswordReset.ExpiresAt,
 		}
 	}
+	if doc.PendingInvitation != nil {
+		expiresAt := doc.PendingInvitation.ExpiresAt
+		invitationExpiresAt = &expiresAt
+		pendingInvitation = &domain.AdminPendingInvitation{
+			TokenHash: strings.TrimSpace(doc.PendingInvitation.TokenHash),
+			Locale:    strings.TrimSpace(strings.ToLower(doc.PendingInvitation.Locale)),
+			InvitedBy: strings.TrimSpace(doc.PendingInvitation.InvitedBy),
+			InvitedAt: doc.PendingInvitation.InvitedAt,
+			ExpiresAt: doc.PendingInvitation.ExpiresAt,
+		}
+	}
+
+	if doc.TwoFactor != nil && strings.TrimSpace(doc.TwoFactor.Secret) != "" {
+		enabledAt := doc.TwoFactor.EnabledAt
+		twoFactorEnabledAt = &enabledAt
+		twoFactor = &domain.AdminTwoFactor{
+			Secret:             strings.TrimSpace(doc.TwoFactor.Secret),
+			RecoveryCodeHashes: append([]string{}, doc.TwoFactor.RecoveryCodeHashes...),
+			LastUsedStep:       doc.TwoFactor.LastUsedStep,
+			EnabledAt:          doc.TwoFactor.EnabledAt,
+		}
+	}
+	if doc.PendingTwoFactor != nil {
+		pendingTwoFactor = &domain.AdminPendingTwoFactor{
+			Secret:    strings.TrimSpace(doc.PendingTwoFactor.Secret),
+			ExpiresAt: doc.PendingTwoFactor.ExpiresAt,
+		}
+	}
 
 	return &domain.AdminUserRecord{
 		AdminUser: domain.AdminUser{
@@ -890,12 +954,20 @@ func findAdminUser(ctx context.Context, collection *mongo.Collection, filter bso
 			GithubEmail:           strings.TrimSpace(strings.ToLower(doc.GithubEmail)),
 			GithubLinkedAt:        doc.GithubLinkedAt,
 			Roles:                 roles,
+			Status:                status,
+			CreatedAt:             doc.CreatedAt,
+			InvitationExpiresAt:   invitationExpiresAt,
+			TwoFactorEnabledAt:    twoFactorEnabledAt,
+			RecoveryCodesLeft:     recoveryCodesLeft(twoFactor),
 		},
 		PasswordHash:         strings.TrimSpace(doc.PasswordHash),
 		PasswordVersion:      doc.PasswordVersion,
 		PendingEmailChange:   pendingChange,
 		PendingPasswordReset: pendingPasswordReset,
-	}, nil
+		PendingInvitation:    pendingInvitation,
+		TwoFactor:            twoFactor,
+		PendingTwoFactor:     pendingTwoFactor,
+	}
 }
 
 func resolveAdminAvatarURL(userID, digest string, version int64, legacyAvatarURL string) string {
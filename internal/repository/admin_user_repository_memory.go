@@ -0,0 +1,605 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+)
+
+type adminUserMemoryRepository struct {
+	store *MemoryStore
+}
+
+// NewAdminUserMemoryRepository returns an admin user repository backed by the given in-memory store.
+func NewAdminUserMemoryRepository(store *MemoryStore) AdminUserRepository {
+	return &adminUserMemoryRepository{store: store}
+}
+
+func (r *adminUserMemoryRepository) FindByEmail(_ context.Context, email string) (*domain.AdminUserRecord, error) {
+	resolvedEmail := strings.TrimSpace(strings.ToLower(email))
+	return r.find(func(record *domain.AdminUserRecord) bool {
+		return isActiveMemoryAdminUser(record) && record.Email == resolvedEmail
+	}), nil
+}
+
+func (r *adminUserMemoryRepository) FindByID(_ context.Context, id string) (*domain.AdminUserRecord, error) {
+	resolvedID := strings.TrimSpace(id)
+	return r.find(func(record *domain.AdminUserRecord) bool {
+		return isActiveMemoryAdminUser(record) && record.ID == resolvedID
+	}), nil
+}
+
+func (r *adminUserMemoryRepository) FindByUsername(_ context.Context, username string) (*domain.AdminUserRecord, error) {
+	resolvedUsername := strings.TrimSpace(username)
+	return r.find(func(record *domain.AdminUserRecord) bool {
+		return isActiveMemoryAdminUser(record) && record.Username == resolvedUsername
+	}), nil
+}
+
+func (r *adminUserMemoryRepository) FindByGoogleSubject(_ context.Context, subject string) (*domain.AdminUserRecord, error) {
+	resolvedSubject := strings.TrimSpace(subject)
+	return r.find(func(record *domain.AdminUserRecord) bool {
+		return isActiveMemoryAdminUser(record) && record.GoogleSubject == resolvedSubject
+	}), nil
+}
+
+func (r *adminUserMemoryRepository) FindByGithubSubject(_ context.Context, subject string) (*domain.AdminUserRecord, error) {
+	resolvedSubject := strings.TrimSpace(subject)
+	return r.find(func(record *domain.AdminUserRecord) bool {
+		return isActiveMemoryAdminUser(record) && record.GithubSubject == resolvedSubject
+	}), nil
+}
+
+func (r *adminUserMemoryRepository) FindByPendingEmailChangeTokenHash(
+	_ context.Context,
+	tokenHash string,
+) (*domain.AdminUserRecord, error) {
+	resolvedTokenHash := strings.TrimSpace(tokenHash)
+	return r.find(func(record *domain.AdminUserRecord) bool {
+		return isActiveMemoryAdminUser(record) && record.PendingEmailChange != nil &&
+			record.PendingEmailChange.TokenHash == resolvedTokenHash
+	}), nil
+}
+
+func (r *adminUserMemoryRepository) FindByPendingPasswordResetTokenHash(
+	_ context.Context,
+	tokenHash string,
+) (*domain.AdminUserRecord, error) {
+	resolvedTokenHash := strings.TrimSpace(tokenHash)
+	return r.find(func(record *domain.AdminUserRecord) bool {
+		return isActiveMemoryAdminUser(record) && record.PendingPasswordReset != nil &&
+			record.PendingPasswordReset.TokenHash == resolvedTokenHash
+	}), nil
+}
+
+func (r *adminUserMemoryRepository) HasAnyGoogleLink(_ context.Context) (bool, error) {
+	return r.find(func(record *domain.AdminUserRecord) bool {
+		return isActiveMemoryAdminUser(record) && record.GoogleSubject != ""
+	}) != nil, nil
+}
+
+func (r *adminUserMemoryRepository) HasAnyGithubLink(_ context.Context) (bool, error) {
+	return r.find(func(record *domain.AdminUserRecord) bool {
+		return isActiveMemoryAdminUser(record) && record.GithubSubject != ""
+	}) != nil, nil
+}
+
+func (r *adminUserMemoryRepository) UpdatePasswordHashByID(_ context.Context, id, passwordHash string) error {
+	return r.updateActive(id, func(record *domain.AdminUserRecord) error {
+		record.PasswordHash = strings.TrimSpace(passwordHash)
+		record.PendingPasswordReset = nil
+		record.PasswordVersion++
+		return nil
+	})
+}
+
+func (r *adminUserMemoryRepository) UpdateNameByID(_ context.Context, id, name string) error {
+	return r.updateActive(id, func(record *domain.AdminUserRecord) error {
+		record.Name = strings.TrimSpace(name)
+		return nil
+	})
+}
+
+func (r *adminUserMemoryRepository) UpdateUsernameByID(_ context.Context, id, username string) error {
+	resolvedUsername := strings.TrimSpace(username)
+	return r.updateActive(id, func(record *domain.AdminUserRecord) error {
+		if resolvedUsername != "" && r.taken(record.ID, func(other *domain.AdminUserRecord) bool {
+			return other.Username == resolvedUsername
+		}) {
+			return ErrAdminUsernameAlreadyExists
+		}
+		record.Username = resolvedUsername
+		return nil
+	})
+}
+
+func (r *adminUserMemoryRepository) SetPendingEmailChangeByID(
+	_ context.Context,
+	id string,
+	pending domain.AdminPendingEmailChange,
+) error {
+	return r.updateActive(id, func(record *domain.AdminUserRecord) error {
+		record.PendingEmailChange = &domain.AdminPendingEmailChange{
+			NewEmail:    strings.TrimSpace(strings.ToLower(pending.NewEmail)),
+			TokenHash:   strings.TrimSpace(pending.TokenHash),
+			Locale:      strings.TrimSpace(strings.ToLower(pending.Locale)),
+			RequestedAt: pending.RequestedAt,
+			ExpiresAt:   pending.ExpiresAt,
+		}
+		return nil
+	})
+}
+
+func (r *adminUserMemoryRepository) ClearPendingEmailChangeByID(_ context.Context, id string) error {
+	return r.updateActive(id, func(record *domain.AdminUserRecord) error {
+		record.PendingEmailChange = nil
+		return nil
+	})
+}
+
+func (r *adminUserMemoryRepository) SetPendingPasswordResetByID(
+	_ context.Context,
+	id string,
+	pending domain.AdminPendingPasswordReset,
+) error {
+	return r.updateActive(id, func(record *domain.AdminUserRecord) error {
+		record.PendingPasswordReset = &domain.AdminPendingPasswordReset{
+			TokenHash:   strings.TrimSpace(pending.TokenHash),
+			Locale:      strings.TrimSpace(strings.ToLower(pending.Locale)),
+			RequestedAt: pending.RequestedAt,
+			ExpiresAt:   pending.ExpiresAt,
+		}
+		return nil
+	})
+}
+
+func (r *adminUserMemoryRepository) ClearPendingPasswordResetByID(_ context.Context, id string) error {
+	return r.updateActive(id, func(record *domain.AdminUserRecord) error {
+		record.PendingPasswordReset = nil
+		return nil
+	})
+}
+
+func (r *adminUserMemoryRepository) UpdateEmailByID(_ context.Context, id, email string) error {
+	resolvedEmail := strings.TrimSpace(strings.ToLower(email))
+	return r.updateActive(id, func(record *domain.AdminUserRecord) error {
+		if r.taken(record.ID, func(other *domain.AdminUserRecord) bool { return other.Email == resolvedEmail }) {
+			return ErrAdminEmailAlreadyExists
+		}
+		record.Email = resolvedEmail
+		record.PendingEmailChange = nil
+		record.PasswordVersion++
+		return nil
+	})
+}
+
+func (r *adminUserMemoryRepository) UpdateGoogleLinkByID(
+	_ context.Context,
+	id, subject, email string,
+	linkedAt time.Time,
+) error {
+	resolvedSubject := strings.TrimSpace(subject)
+	return r.updateActive(id, func(record *domain.AdminUserRecord) error {
+		if r.taken(record.ID, func(other *domain.AdminUserRecord) bool {
+			return other.GoogleSubject == resolvedSubject
+		}) {
+			return ErrAdminGoogleAlreadyExists
+		}
+		resolvedLinkedAt := linkedAt.UTC()
+		record.GoogleSubject = resolvedSubject
+		record.GoogleEmail = strings.TrimSpace(strings.ToLower(email))
+		record.GoogleLinkedAt = &resolvedLinkedAt
+		return nil
+	})
+}
+
+func (r *adminUserMemoryRepository) ClearGoogleLinkByID(_ context.Context, id string) error {
+	return r.updateActive(id, func(record *domain.AdminUserRecord) error {
+		record.GoogleSubject = ""
+		record.GoogleEmail = ""
+		record.GoogleLinkedAt = nil
+		return nil
+	})
+}
+
+func (r *adminUserMemoryRepository) UpdateGithubLinkByID(
+	_ context.Context,
+	id, subject, email string,
+	linkedAt time.Time,
+) error {
+	resolvedSubject := strings.TrimSpace(subject)
+	return r.updateActive(id, func(record *domain.AdminUserRecord) error {
+		if r.taken(record.ID, func(other *domain.AdminUserRecord) bool {
+			return other.GithubSubject == resolvedSubject
+		}) {
+			return ErrAdminGithubAlreadyExists
+		}
+		resolvedLinkedAt := linkedAt.UTC()
+		record.GithubSubject = resolvedSubject
+		record.GithubEmail = strings.TrimSpace(strings.ToLower(email))
+		record.GithubLinkedAt = &resolvedLinkedAt
+		return nil
+	})
+}
+
+func (r *adminUserMemoryRepository) ClearGithubLinkByID(_ context.Context, id string) error {
+	return r.updateActive(id, func(record *domain.AdminUserRecord) error {
+		record.GithubSubject = ""
+		record.GithubEmail = ""
+		record.GithubLinkedAt = nil
+		return nil
+	})
+}
+
+func (r *adminUserMemoryRepository) UpdateAvatarByID(
+	_ context.Context,
+	id,
+	avatarURL,
+	avatarDigest string,
+	avatarVersion int64,
+) error {
+	return r.updateActive(id, func(record *domain.AdminUserRecord) error {
+		record.AvatarURL = strings.TrimSpace(avatarURL)
+		record.AvatarDigest = strings.TrimSpace(avatarDigest)
+		record.AvatarVersion = avatarVersion
+		return nil
+	})
+}
+
+func (r *adminUserMemoryRepository) DisableByID(_ context.Context, id string) error {
+	return r.update(id, func(record *domain.AdminUserRecord) bool {
+		return record.Status != domain.AdminUserStatusDisabled
+	}, func(record *domain.AdminUserRecord) error {
+		record.Status = domain.AdminUserStatusDisabled
+		record.PendingInvitation = nil
+		record.PasswordVersion++
+		return nil
+	})
+}
+
+// Create inserts a new admin. Records without a status are stored as active.
+func (r *adminUserMemoryRepository) Create(_ context.Context, record domain.AdminUserRecord) error {
+	status := strings.TrimSpace(record.Status)
+	if status == "" {
+		status = domain.AdminUserStatusActive
+	}
+
+	createdAt := time.Now().UTC()
+	stored := domain.AdminUserRecord{
+		AdminUser: domain.AdminUser{
+			ID:        strings.TrimSpace(record.ID),
+			Name:      strings.TrimSpace(record.Name),
+			Email:     strings.TrimSpace(strings.ToLower(record.Email)),
+			Roles:     normalizeAdminRoles(record.Roles),
+			Status:    status,
+			CreatedAt: &createdAt,
+		},
+		PasswordHash:    strings.TrimSpace(record.PasswordHash),
+		PasswordVersion: record.PasswordVersion,
+	}
+	if record.PendingInvitation != nil {
+		stored.PendingInvitation = normalizeMemoryAdminPendingInvitation(*record.PendingInvitation)
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if r.taken("", func(other *domain.AdminUserRecord) bool {
+		return other.ID == stored.ID || other.Email == stored.Email
+	}) {
+		return ErrAdminEmailAlreadyExists
+	}
+
+	r.store.adminUsers = append(r.store.adminUsers, stored)
+	return nil
+}
+
+// List returns every admin, including invited and disabled ones, ordered by email.
+func (r *adminUserMemoryRepository) List(_ context.Context) ([]domain.AdminUserRecord, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	records := make([]domain.AdminUserRecord, 0, len(r.store.adminUsers))
+	for index := range r.store.adminUsers {
+		if record := mapMemoryAdminUser(&r.store.adminUsers[index]); record != nil {
+			records = append(records, *record)
+		}
+	}
+
+	slices.SortStableFunc(records, func(left, right domain.AdminUserRecord) int {
+		return strings.Compare(left.Email, right.Email)
+	})
+	return records, nil
+}
+
+func (r *adminUserMemoryRepository) FindByIDAnyStatus(_ context.Context, id string) (*domain.AdminUserRecord, error) {
+	resolvedID := strings.TrimSpace(id)
+	return r.find(func(record *domain.AdminUserRecord) bool {
+		return record.ID == resolvedID
+	}), nil
+}
+
+func (r *adminUserMemoryRepository) FindByPendingInvitationTokenHash(
+	_ context.Context,
+	tokenHash string,
+) (*domain.AdminUserRecord, error) {
+	resolvedTokenHash := strings.TrimSpace(tokenHash)
+	return r.find(func(record *domain.AdminUserRecord) bool {
+		return record.Status == domain.AdminUserStatusInvited && record.PendingInvitation != nil &&
+			record.PendingInvitation.TokenHash == resolvedTokenHash
+	}), nil
+}
+
+func (r *adminUserMemoryRepository) RefreshInvitationByID(
+	_ context.Context,
+	id string,
+	roles []string,
+	pending domain.AdminPendingInvitation,
+) error {
+	return r.update(id, isInvitedMemoryAdminUser, func(record *domain.AdminUserRecord) error {
+		record.Roles = normalizeAdminRoles(roles)
+		record.PendingInvitation = normalizeMemoryAdminPendingInvitation(pending)
+		return nil
+	})
+}
+
+// AcceptInvitationByID activates an invited admin. An empty password hash leaves the account without a password, for
+// invitees that sign in with Google or GitHub.
+func (r *adminUserMemoryRepository) AcceptInvitationByID(_ context.Context, id, passwordHash string) error {
+	return r.update(id, isInvitedMemoryAdminUser, func(record *domain.AdminUserRecord) error {
+		record.Status = domain.AdminUserStatusActive
+		if resolvedPasswordHash := strings.TrimSpace(passwordHash); resolvedPasswordHash != "" {
+			record.PasswordHash = resolvedPasswordHash
+		}
+		record.PendingInvitation = nil
+		record.PasswordVersion++
+		return nil
+	})
+}
+
+func (r *adminUserMemoryRepository) EnableByID(_ context.Context, id string) error {
+	return r.update(id, func(record *domain.AdminUserRecord) bool {
+		return record.Status == domain.AdminUserStatusDisabled
+	}, func(record *domain.AdminUserRecord) error {
+		record.Status = domain.AdminUserStatusActive
+		return nil
+	})
+}
+
+func (r *adminUserMemoryRepository) UpdateRolesByID(_ context.Context, id string, roles []string) error {
+	return r.update(id, func(*domain.AdminUserRecord) bool { return true }, func(record *domain.AdminUserRecord) error {
+		record.Roles = normalizeAdminRoles(roles)
+		return nil
+	})
+}
+
+func (r *adminUserMemoryRepository) SetPendingTwoFactorByID(
+	_ context.Context,
+	id string,
+	pending domain.AdminPendingTwoFactor,
+) error {
+	return r.updateActive(id, func(record *domain.AdminUserRecord) error {
+		if record.TwoFactor != nil {
+			return ErrAdminUserNotFound
+		}
+		record.PendingTwoFactor = &domain.AdminPendingTwoFactor{
+			Secret:    strings.TrimSpace(pending.Secret),
+			ExpiresAt: pending.ExpiresAt,
+		}
+		return nil
+	})
+}
+
+// EnableTwoFactorByID stores the enrollment and drops the pending secret. It fails with ErrAdminUserNotFound when
+// two-factor authentication is already enabled.
+func (r *adminUserMemoryRepository) EnableTwoFactorByID(
+	_ context.Context,
+	id string,
+	twoFactor domain.AdminTwoFactor,
+) error {
+	return r.updateActive(id, func(record *domain.AdminUserRecord) error {
+		if record.TwoFactor != nil {
+			return ErrAdminUserNotFound
+		}
+		record.TwoFactor = &domain.AdminTwoFactor{
+			Secret:             strings.TrimSpace(twoFactor.Secret),
+			RecoveryCodeHashes: append([]string{}, twoFactor.RecoveryCodeHashes...),
+			LastUsedStep:       twoFactor.LastUsedStep,
+			EnabledAt:          twoFactor.EnabledAt,
+		}
+		record.PendingTwoFactor = nil
+		return nil
+	})
+}
+
+func (r *adminUserMemoryRepository) DisableTwoFactorByID(_ context.Context, id string) error {
+	return r.updateActive(id, func(record *domain.AdminUserRecord) error {
+		record.TwoFactor = nil
+		record.PendingTwoFactor = nil
+		return nil
+	})
+}
+
+func (r *adminUserMemoryRepository) ReplaceTwoFactorRecoveryCodesByID(
+	_ context.Context,
+	id string,
+	recoveryCodeHashes []string,
+) error {
+	return r.updateActive(id, func(record *domain.AdminUserRecord) error {
+		if record.TwoFactor == nil {
+			return ErrAdminUserNotFound
+		}
+		record.TwoFactor.RecoveryCodeHashes = append([]string{}, recoveryCodeHashes...)
+		return nil
+	})
+}
+
+// ConsumeTwoFactorStepByID records step as the newest accepted TOTP step. It reports false when the step is not
+// newer than the last accepted one, which means the code was already used.
+func (r *adminUserMemoryRepository) ConsumeTwoFactorStepByID(_ context.Context, id string, step int64) (bool, error) {
+	return consumeAdminTwoFactorResult(r.updateActive(id, func(record *domain.AdminUserRecord) error {
+		if record.TwoFactor == nil || record.TwoFactor.LastUsedStep >= step {
+			return ErrAdminUserNotFound
+		}
+		record.TwoFactor.LastUsedStep = step
+		return nil
+	}))
+}
+
+// ConsumeTwoFactorRecoveryCodeByID removes a recovery code hash. It reports false when the code is unknown or was
+// already used.
+func (r *adminUserMemoryRepository) ConsumeTwoFactorRecoveryCodeByID(
+	_ context.Context,
+	id, recoveryCodeHash string,
+) (bool, error) {
+	resolvedHash := strings.TrimSpace(recoveryCodeHash)
+	return consumeAdminTwoFactorResult(r.updateActive(id, func(record *domain.AdminUserRecord) error {
+		if record.TwoFactor == nil || !slices.Contains(record.TwoFactor.RecoveryCodeHashes, resolvedHash) {
+			return ErrAdminUserNotFound
+		}
+		record.TwoFactor.RecoveryCodeHashes = slices.DeleteFunc(
+			record.TwoFactor.RecoveryCodeHashes,
+			func(hash string) bool { return hash == resolvedHash },
+		)
+		return nil
+	}))
+}
+
+func (r *adminUserMemoryRepository) find(match func(*domain.AdminUserRecord) bool) *domain.AdminUserRecord {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for index := range r.store.adminUsers {
+		if record := &r.store.adminUsers[index]; match(record) {
+			return mapMemoryAdminUser(record)
+		}
+	}
+	return nil
+}
+
+// taken reports whether an admin other than ownerID matches. Callers hold the store lock.
+func (r *adminUserMemoryRepository) taken(ownerID string, match func(*domain.AdminUserRecord) bool) bool {
+	for index := range r.store.adminUsers {
+		if record := &r.store.adminUsers[index]; record.ID != ownerID && match(record) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *adminUserMemoryRepository) updateActive(id string, apply func(*domain.AdminUserRecord) error) error {
+	return r.update(id, isActiveMemoryAdminUser, apply)
+}
+
+// update applies the change to a copy of the admin with id when match accepts it, so a rejected change leaves the
+// stored admin untouched. It fails with ErrAdminUserNotFound when no admin matches.
+func (r *adminUserMemoryRepository) update(
+	id string,
+	match func(*domain.AdminUserRecord) bool,
+	apply func(*domain.AdminUserRecord) error,
+) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	resolvedID := strings.TrimSpace(id)
+	for index := range r.store.adminUsers {
+		record := &r.store.adminUsers[index]
+		if record.ID != resolvedID || !match(record) {
+			continue
+		}
+
+		updated := cloneMemoryAdminUser(*record)
+		if err := apply(&updated); err != nil {
+			return err
+		}
+		*record = updated
+		return nil
+	}
+
+	return ErrAdminUserNotFound
+}
+
+func isActiveMemoryAdminUser(record *domain.AdminUserRecord) bool {
+	return record.Status != domain.AdminUserStatusDisabled && record.Status != domain.AdminUserStatusInvited
+}
+
+func isInvitedMemoryAdminUser(record *domain.AdminUserRecord) bool {
+	return record.Status == domain.AdminUserStatusInvited
+}
+
+func normalizeMemoryAdminPendingInvitation(pending domain.AdminPendingInvitation) *domain.AdminPendingInvitation {
+	return &domain.AdminPendingInvitation{
+		TokenHash: strings.TrimSpace(pending.TokenHash),
+		Locale:    strings.TrimSpace(strings.ToLower(pending.Locale)),
+		InvitedBy: strings.TrimSpace(pending.InvitedBy),
+		InvitedAt: pending.InvitedAt.UTC(),
+		ExpiresAt: pending.ExpiresAt.UTC(),
+	}
+}
+
+// mapMemoryAdminUser returns a copy of a stored admin with the derived fields filled in the way mapAdminUserDocument
+// fills them for Mongo documents.
+func mapMemoryAdminUser(stored *domain.AdminUserRecord) *domain.AdminUserRecord {
+	if stored.ID == "" || stored.Email == "" {
+		return nil
+	}
+
+	record := cloneMemoryAdminUser(*stored)
+	record.AvatarURL = resolveAdminAvatarURL(record.ID, record.AvatarDigest, record.AvatarVersion, record.AvatarURL)
+	if len(record.Roles) == 0 {
+		record.Roles = []string{"admin"}
+	}
+	if record.Status == "" {
+		record.Status = domain.AdminUserStatusActive
+	}
+
+	record.PendingEmail = ""
+	record.PendingEmailExpiresAt = nil
+	if record.PendingEmailChange != nil {
+		expiresAt := record.PendingEmailChange.ExpiresAt
+		record.PendingEmail = record.PendingEmailChange.NewEmail
+		record.PendingEmailExpiresAt = &expiresAt
+	}
+
+	record.InvitationExpiresAt = nil
+	if record.PendingInvitation != nil {
+		expiresAt := record.PendingInvitation.ExpiresAt
+		record.InvitationExpiresAt = &expiresAt
+	}
+
+	if record.TwoFactor != nil && record.TwoFactor.Secret == "" {
+		record.TwoFactor = nil
+	}
+	record.TwoFactorEnabledAt = nil
+	if record.TwoFactor != nil {
+		enabledAt := record.TwoFactor.EnabledAt
+		record.TwoFactorEnabledAt = &enabledAt
+	}
+	record.RecoveryCodesLeft = recoveryCodesLeft(record.TwoFactor)
+
+	return &record
+}
+
+func cloneMemoryAdminUser(record domain.AdminUserRecord) domain.AdminUserRecord {
+	record.Roles = slices.Clone(record.Roles)
+	record.AccessTokenScopes = slices.Clone(record.AccessTokenScopes)
+	record.CreatedAt = cloneMemoryValue(record.CreatedAt)
+	record.GoogleLinkedAt = cloneMemoryValue(record.GoogleLinkedAt)
+	record.GithubLinkedAt = cloneMemoryValue(record.GithubLinkedAt)
+	record.PendingEmailExpiresAt = cloneMemoryValue(record.PendingEmailExpiresAt)
+	record.InvitationExpiresAt = cloneMemoryValue(record.InvitationExpiresAt)
+	record.TwoFactorEnabledAt = cloneMemoryValue(record.TwoFactorEnabledAt)
+	record.PendingEmailChange = cloneMemoryValue(record.PendingEmailChange)
+	record.PendingPasswordReset = cloneMemoryValue(record.PendingPasswordReset)
+	record.PendingInvitation = cloneMemoryValue(record.PendingInvitation)
+	record.PendingTwoFactor = cloneMemoryValue(record.PendingTwoFactor)
+	if record.TwoFactor != nil {
+		twoFactor := *record.TwoFactor
+		twoFactor.RecoveryCodeHashes = slices.Clone(twoFactor.RecoveryCodeHashes)
+		record.TwoFactor = &twoFactor
+	}
+	return record
+}
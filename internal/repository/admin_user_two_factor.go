@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	adminTwoFactorKey              = "twoFactor"
+	adminPendingTwoFactorKey       = "pendingTwoFactor"
+	adminTwoFactorRecoveryCodesKey = "twoFactor.recoveryCodeHashes"
+)
+
+func (repo *adminMongoRepository) SetPendingTwoFactorByID(
+	ctx context.Context,
+	id string,
+	pending domain.AdminPendingTwoFactor,
+) error {
+	return updateActiveAdminUser(ctx, repo.client, bson.M{
+		"id":              strings.TrimSpace(id),
+		adminTwoFactorKey: bson.M{adminMongoExistsOperator: false},
+	}, bson.M{
+		"$set": bson.M{
+			adminPendingTwoFactorKey: bson.M{
+				"secret":    strings.TrimSpace(pending.Secret),
+				"expiresAt": pending.ExpiresAt,
+			},
+		},
+	})
+}
+
+// EnableTwoFactorByID stores the enrollment and drops the pending secret. It fails with ErrAdminUserNotFound when
+// two-factor authentication is already enabled.
+func (repo *adminMongoRepository) EnableTwoFactorByID(ctx context.Context, id string, twoFactor domain.AdminTwoFactor) error {
+	return updateActiveAdminUser(ctx, repo.client, bson.M{
+		"id":              strings.TrimSpace(id),
+		adminTwoFactorKey: bson.M{adminMongoExistsOperator: false},
+	}, bson.M{
+		"$set": bson.M{
+			adminTwoFactorKey: bson.M{
+				"secret":             strings.TrimSpace(twoFactor.Secret),
+				"recoveryCodeHashes": append([]string{}, twoFactor.RecoveryCodeHashes...),
+				"lastUsedStep":       twoFactor.LastUsedStep,
+				"enabledAt":          twoFactor.EnabledAt,
+			},
+		},
+		adminMongoUnsetOperator: bson.M{adminPendingTwoFactorKey: ""},
+	})
+}
+
+func (repo *adminMongoRepository) DisableTwoFactorByID(ctx context.Context, id string) error {
+	return updateActiveAdminUser(ctx, repo.client, bson.M{
+		"id": strings.TrimSpace(id),
+	}, bson.M{
+		adminMongoUnsetOperator: bson.M{
+			adminTwoFactorKey:        "",
+			adminPendingTwoFactorKey: "",
+		},
+	})
+}
+
+func (repo *adminMongoRepository) ReplaceTwoFactorRecoveryCodesByID(
+	ctx context.Context,
+	id string,
+	recoveryCodeHashes []string,
+) error {
+	return updateActiveAdminUser(ctx, repo.client, bson.M{
+		"id":              strings.TrimSpace(id),
+		adminTwoFactorKey: bson.M{adminMongoExistsOperator: true},
+	}, bson.M{
+		"$set": bson.M{adminTwoFactorRecoveryCodesKey: append([]string{}, recoveryCodeHashes...)},
+	})
+}
+
+// ConsumeTwoFactorStepByID records step as the newest accepted TOTP step. It reports false when the step is not
+// newer than the last accepted one, which means the code was already used.
+func (repo *adminMongoRepository) ConsumeTwoFactorStepByID(ctx context.Context, id string, step int64) (bool, error) {
+	err := updateActiveAdminUser(ctx, repo.client, bson.M{
+		"id":                     strings.TrimSpace(id),
+		"twoFactor.lastUsedStep": bson.M{"$lt": step},
+	}, bson.M{
+		"$set": bson.M{"twoFactor.lastUsedStep": step},
+	})
+	return consumeAdminTwoFactorResult(err)
+}
+
+// ConsumeTwoFactorRecoveryCodeByID removes a recovery code hash. It reports false when the code is unknown or was
+// already used.
+func (repo *adminMongoRepository) ConsumeTwoFactorRecoveryCodeByID(
+	ctx context.Context,
+	id, recoveryCodeHash string,
+) (bool, error) {
+	resolvedHash := strings.TrimSpace(recoveryCodeHash)
+	err := updateActiveAdminUser(ctx, repo.client, bson.M{
+		"id":                           strings.TrimSpace(id),
+		adminTwoFactorRecoveryCodesKey: resolvedHash,
+	}, bson.M{
+		"$pull": bson.M{adminTwoFactorRecoveryCodesKey: resolvedHash},
+	})
+	return consumeAdminTwoFactorResult(err)
+}
+
+func updateActiveAdminUser(ctx context.Context, client *mongo.Client, filter, update bson.M) error {
+	collection, err := getAdminUsersCollection(client)
+	if err != nil {
+		return fmt.Errorf(adminUsersRepositoryUnavailableFormat, ErrAdminUserRepositoryUnavailable, err)
+	}
+
+	filter["status"] = activeAdminStatusFilter()
+	result, err := collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrAdminUserNotFound
+	}
+
+	return nil
+}
+
+func consumeAdminTwoFactorResult(err error) (bool, error) {
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, ErrAdminUserNotFound) {
+		return false, nil
+	}
+	return false, err
+}
+
+func recoveryCodesLeft(twoFactor *domain.AdminTwoFactor) int {
+	if twoFactor == nil {
+		return 0
+	}
+	return len(twoFactor.RecoveryCodeHashes)
+}
@@ -59,9 +59,14 @@ type CommentRepository interface {
 	) (int, error)
 	DeleteCommentByID(ctx context.Context, id string) (bool, error)
 	DeleteCommentsByIDs(ctx context.Context, ids []string) (int, error)
+	ListCommentsByAuthorEmail(ctx context.Context, email string) ([]domain.CommentRecord, error)
+	AnonymizeCommentsByAuthorEmail(ctx context.Context, email string, authorName string, now time.Time) (int, error)
+	DeleteCommentsByAuthorEmail(ctx context.Context, email string) (int, error)
 }
 
-type commentMongoRepository struct{}
+type commentMongoRepository struct {
+	client *mongo.Client
+}
 
 type approvedCommentCountResult struct {
 	PostID string `bson:"_id"`
@@ -77,8 +82,8 @@ type commentSingleFinder interface {
 	FindOne(context.Context, any, ...*options.FindOneOptions) *mongo.SingleResult
 }
 
-func NewCommentRepository() CommentRepository {
-	return &commentMongoRepository{}
+func NewCommentRepository(client *mongo.Client) CommentRepository {
+	return &commentMongoRepository{client: client}
 }
 
 func ensurePostCommentIndexes(collection *mongo.Collection) error {
@@ -121,6 +126,13 @@ func ensurePostCommentIndexes(collection *mongo.Collection) error {
 				},
 				Options: options.Index().SetName("idx_post_comment_ip_created"),
 			},
+			{
+				Keys: bson.D{
+					{Key: "authorEmail", Value: 1},
+					{Key: "createdAt", Value: 1},
+				},
+				Options: options.Index().SetName("idx_post_comment_author_email_created"),
+			},
 		}
 
 		if _, err := collection.Indexes().CreateMany(ctx, indexes); err != nil {
@@ -132,8 +144,8 @@ func ensurePostCommentIndexes(collection *mongo.Collection) error {
 	return postCommentsIndexesErr
 }
 
-func getPostCommentsCollection() (*mongo.Collection, error) {
-	collection, err := getPostCollection(postCommentsCollectionName)
+func getPostCommentsCollection(client *mongo.Client) (*mongo.Collection, error) {
+	collection, err := getPostCollection(client, postCommentsCollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -143,8 +155,8 @@ func getPostCommentsCollection() (*mongo.Collection, error) {
 	return collection, nil
 }
 
-func (*commentMongoRepository) ListApprovedByPost(ctx context.Context, postID string) ([]domain.CommentRecord, error) {
-	collection, err := getPostCommentsCollection()
+func (repo *commentMongoRepository) ListApprovedByPost(ctx context.Context, postID string) ([]domain.CommentRecord, error) {
+	collection, err := getPostCommentsCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(commentRepositoryUnavailableFormat, ErrCommentRepositoryUnavailable, err)
 	}
@@ -182,8 +194,8 @@ func (*commentMongoRepository) ListApprovedByPost(ctx context.Context, postID st
 	return comments, nil
 }
 
-func (*commentMongoRepository) CountApprovedByPost(ctx context.Context, postID string) (int, error) {
-	collection, err := getPostCommentsCollection()
+func (repo *commentMongoRepository) CountApprovedByPost(ctx context.Context, postID string) (int, error) {
+	collection, err := getPostCommentsCollection(repo.client)
 	if err != nil {
 		return 0, fmt.Errorf(commentRepositoryUnavailableFormat, ErrCommentRepositoryUnavailable, err)
 	}
@@ -202,8 +214,8 @@ func (*commentMongoRepository) CountApprovedByPost(ctx context.Context, postID s
 	return int(total), nil
 }
 
-func (*commentMongoRepository) CountApprovedByPosts(ctx context.Context, postIDs []string) (map[string]int64, error) {
-	collection, err := getPostCommentsCollection()
+func (repo *commentMongoRepository) CountApprovedByPosts(ctx context.Context, postIDs []string) (map[string]int64, error) {
+	collection, err := getPostCommentsCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(commentRepositoryUnavailableFormat, ErrCommentRepositoryUnavailable, err)
 	}
@@ -262,8 +274,8 @@ func (*commentMongoRepository) CountApprovedByPosts(ctx context.Context, postIDs
 	return counts, nil
 }
 
-func (*commentMongoRepository) CreateComment(ctx context.Context, input domain.CommentRecord) error {
-	collection, err := getPostCommentsCollection()
+func (repo *commentMongoRepository) CreateComment(ctx context.Context, input domain.CommentRecord) error {
+	collection, err := getPostCommentsCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(commentRepositoryUnavailableFormat, ErrCommentRepositoryUnavailable, err)
 	}
@@ -272,8 +284,8 @@ func (*commentMongoRepository) CreateComment(ctx context.Context, input domain.C
 	return err
 }
 
-func (*commentMongoRepository) FindCommentByID(ctx context.Context, id string) (*domain.CommentRecord, error) {
-	collection, err := getPostCommentsCollection()
+func (repo *commentMongoRepository) FindCommentByID(ctx context.Context, id string) (*domain.CommentRecord, error) {
+	collection, err := getPostCommentsCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(commentRepositoryUnavailableFormat, ErrCommentRepositoryUnavailable, err)
 	}
@@ -293,13 +305,13 @@ func findCommentByIDInCollection(ctx context.Context, finder commentSingleFinder
 	return &item, nil
 }
 
-func (*commentMongoRepository) ListComments(
+func (repo *commentMongoRepository) ListComments(
 	ctx context.Context,
 	filter domain.AdminCommentFilter,
 	page int,
 	size int,
 ) (*domain.AdminCommentListResult, error) {
-	collection, err := getPostCommentsCollection()
+	collection, err := getPostCommentsCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(commentRepositoryUnavailableFormat, ErrCommentRepositoryUnavailable, err)
 	}
@@ -369,14 +381,14 @@ func (*commentMongoRepository) ListComments(
 	}, nil
 }
 
-func (*commentMongoRepository) UpdateCommentStatusByID(
+func (repo *commentMongoRepository) UpdateCommentStatusByID(
 	ctx context.Context,
 	id string,
 	status string,
 	moderationNote string,
 	now time.Time,
 ) (*domain.CommentRecord, error) {
-	collection, err := getPostCommentsCollection()
+	collection, err := getPostCommentsCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(commentRepositoryUnavailableFormat, ErrCommentRepositoryUnavailable, err)
 	}
@@ -412,8 +424,8 @@ func (*commentMongoRepository) UpdateCommentStatusByID(
 	return &updated, nil
 }
 
-func (*commentMongoRepository) DeleteCommentByID(ctx context.Context, id string) (bool, error) {
-	collection, err := getPostCommentsCollection()
+func (repo *commentMongoRepository) DeleteCommentByID(ctx context.Context, id string) (bool, error) {
+	collection, err := getPostCommentsCollection(repo.client)
 	if err != nil {
 		return false, fmt.Errorf(commentRepositoryUnavailableFormat, ErrCommentRepositoryUnavailable, err)
 	}
@@ -426,14 +438,14 @@ func (*commentMongoRepository) DeleteCommentByID(ctx context.Context, id string)
 	return result.DeletedCount > 0, nil
 }
 
-func (*commentMongoRepository) UpdateCommentStatusByIDs(
+func (repo *commentMongoRepository) UpdateCommentStatusByIDs(
 	ctx context.Context,
 	ids []string,
 	status string,
 	moderationNote string,
 	now time.Time,
 ) (int, error) {
-	collection, err := getPostCommentsCollection()
+	collection, err := getPostCommentsCollection(repo.client)
 	if err != nil {
 		return 0, fmt.Errorf(commentRepositoryUnavailableFormat, ErrCommentRepositoryUnavailable, err)
 	}
@@ -475,8 +487,8 @@ func (*commentMongoRepository) UpdateCommentStatusByIDs(
 	return int(result.MatchedCount), nil
 }
 
-func (*commentMongoRepository) DeleteCommentsByIDs(ctx context.Context, ids []string) (int, error) {
-	collection, err := getPostCommentsCollection()
+func (repo *commentMongoRepository) DeleteCommentsByIDs(ctx context.Context, ids []string) (int, error) {
+	collection, err := getPostCommentsCollection(repo.client)
 	if err != nil {
 		return 0, fmt.Errorf(commentRepositoryUnavailableFormat, ErrCommentRepositoryUnavailable, err)
 	}
@@ -500,3 +512,104 @@ func (*commentMongoRepository) DeleteCommentsByIDs(ctx context.Context, ids []st
 
 	return int(result.DeletedCount), nil
 }
+
+// ListCommentsByAuthorEmail returns every comment written with the email, whatever its moderation status.
+func (repo *commentMongoRepository) ListCommentsByAuthorEmail(ctx context.Context, email string) ([]domain.CommentRecord, error) {
+	collection, err := getPostCommentsCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(commentRepositoryUnavailableFormat, ErrCommentRepositoryUnavailable, err)
+	}
+
+	resolvedEmail := strings.TrimSpace(strings.ToLower(email))
+	if resolvedEmail == "" {
+		return []domain.CommentRecord{}, nil
+	}
+
+	cursor, err := collection.Find(
+		ctx,
+		bson.M{"authorEmail": resolvedEmail},
+		options.Find().SetSort(bson.D{
+			{Key: "createdAt", Value: 1},
+			{Key: "id", Value: 1},
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	comments := make([]domain.CommentRecord, 0)
+	for cursor.Next(ctx) {
+		var item domain.CommentRecord
+		if decodeErr := cursor.Decode(&item); decodeErr != nil {
+			return nil, decodeErr
+		}
+		comments = append(comments, item)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+// AnonymizeCommentsByAuthorEmail keeps the comments in their threads but drops everything that identifies the author.
+func (repo *commentMongoRepository) AnonymizeCommentsByAuthorEmail(
+	ctx context.Context,
+	email string,
+	authorName string,
+	now time.Time,
+) (int, error) {
+	collection, err := getPostCommentsCollection(repo.client)
+	if err != nil {
+		return 0, fmt.Errorf(commentRepositoryUnavailableFormat, ErrCommentRepositoryUnavailable, err)
+	}
+
+	resolvedEmail := strings.TrimSpace(strings.ToLower(email))
+	if resolvedEmail == "" {
+		return 0, nil
+	}
+
+	result, err := collection.UpdateMany(
+		ctx,
+		bson.M{"authorEmail": resolvedEmail},
+		bson.M{
+			"$set": bson.M{
+				"authorName":  strings.TrimSpace(authorName),
+				"authorEmail": "",
+				"updatedAt":   now.UTC(),
+			},
+			"$unset": bson.M{
+				"authorAvatarUrl": "",
+				"ipHash":          "",
+				"userAgentHash":   "",
+			},
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(result.ModifiedCount), nil
+}
+
+func (repo *commentMongoRepository) DeleteCommentsByAuthorEmail(ctx context.Context, email string) (int, error) {
+	collection, err := getPostCommentsCollection(repo.client)
+	if err != nil {
+		return 0, fmt.Errorf(commentRepositoryUnavailableFormat, ErrCommentRepositoryUnavailable, err)
+	}
+
+	resolvedEmail := strings.TrimSpace(strings.ToLower(email))
+	if resolvedEmail == "" {
+		return 0, nil
+	}
+
+	result, err := collection.DeleteMany(ctx, bson.M{"authorEmail": resolvedEmail})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(result.DeletedCount), nil
+}
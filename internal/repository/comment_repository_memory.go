@@ -0,0 +1,294 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+)
+
+type commentMemoryRepository struct {
+	store *MemoryStore
+}
+
+// NewCommentMemoryRepository returns a comment repository backed by the given in-memory store.
+func NewCommentMemoryRepository(store *MemoryStore) CommentRepository {
+	return &commentMemoryRepository{store: store}
+}
+
+func (r *commentMemoryRepository) ListApprovedByPost(_ context.Context, postID string) ([]domain.CommentRecord, error) {
+	resolvedPostID := strings.TrimSpace(strings.ToLower(postID))
+	comments := r.filter(func(comment domain.CommentRecord) bool {
+		return comment.PostID == resolvedPostID && comment.Status == "approved"
+	})
+	sortMemoryCommentsByCreatedAt(comments)
+	return comments, nil
+}
+
+func (r *commentMemoryRepository) CountApprovedByPost(_ context.Context, postID string) (int, error) {
+	resolvedPostID := strings.TrimSpace(strings.ToLower(postID))
+	return len(r.filter(func(comment domain.CommentRecord) bool {
+		return comment.PostID == resolvedPostID && comment.Status == "approved"
+	})), nil
+}
+
+func (r *commentMemoryRepository) CountApprovedByPosts(_ context.Context, postIDs []string) (map[string]int64, error) {
+	wanted := make(map[string]struct{}, len(postIDs))
+	for _, postID := range postIDs {
+		if normalizedPostID := strings.TrimSpace(strings.ToLower(postID)); normalizedPostID != "" {
+			wanted[normalizedPostID] = struct{}{}
+		}
+	}
+
+	counts := make(map[string]int64, len(wanted))
+	if len(wanted) == 0 {
+		return counts, nil
+	}
+
+	for _, comment := range r.filter(func(comment domain.CommentRecord) bool {
+		_, exists := wanted[comment.PostID]
+		return exists && comment.Status == "approved"
+	}) {
+		counts[comment.PostID]++
+	}
+
+	return counts, nil
+}
+
+func (r *commentMemoryRepository) CreateComment(_ context.Context, input domain.CommentRecord) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if r.indexOf(input.ID) >= 0 {
+		return fmt.Errorf("comment %q already exists", input.ID)
+	}
+
+	r.store.comments = append(r.store.comments, cloneMemoryComment(input))
+	return nil
+}
+
+func (r *commentMemoryRepository) FindCommentByID(_ context.Context, id string) (*domain.CommentRecord, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	index := r.indexOf(strings.TrimSpace(id))
+	if index < 0 {
+		return nil, ErrCommentNotFound
+	}
+
+	comment := cloneMemoryComment(r.store.comments[index])
+	return &comment, nil
+}
+
+func (r *commentMemoryRepository) ListComments(
+	_ context.Context,
+	filter domain.AdminCommentFilter,
+	page int,
+	size int,
+) (*domain.AdminCommentListResult, error) {
+	resolvedPage := max(1, page)
+	resolvedSize := max(1, size)
+	status := strings.TrimSpace(strings.ToLower(filter.Status))
+	postID := strings.TrimSpace(strings.ToLower(filter.PostID))
+	searchQuery := strings.TrimSpace(filter.Query)
+
+	comments := r.filter(func(comment domain.CommentRecord) bool {
+		if (status != "" && comment.Status != status) || (postID != "" && comment.PostID != postID) {
+			return false
+		}
+		return searchQuery == "" || slices.ContainsFunc(
+			[]string{comment.AuthorName, comment.AuthorEmail, comment.Content, comment.PostTitle, comment.PostID},
+			func(value string) bool { return memoryContainsFold(value, searchQuery) },
+		)
+	})
+	slices.SortStableFunc(comments, func(left, right domain.CommentRecord) int {
+		if order := right.CreatedAt.Compare(left.CreatedAt); order != 0 {
+			return order
+		}
+		return strings.Compare(left.ID, right.ID)
+	})
+
+	return &domain.AdminCommentListResult{
+		Items: memoryPage(comments, resolvedPage, resolvedSize),
+		Total: len(comments),
+		Page:  resolvedPage,
+		Size:  resolvedSize,
+	}, nil
+}
+
+func (r *commentMemoryRepository) UpdateCommentStatusByID(
+	_ context.Context,
+	id string,
+	status string,
+	moderationNote string,
+	now time.Time,
+) (*domain.CommentRecord, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	index := r.indexOf(strings.TrimSpace(id))
+	if index < 0 {
+		return nil, ErrCommentNotFound
+	}
+
+	moderateMemoryComment(&r.store.comments[index], status, moderationNote, now)
+	comment := cloneMemoryComment(r.store.comments[index])
+	return &comment, nil
+}
+
+func (r *commentMemoryRepository) UpdateCommentStatusByIDs(
+	_ context.Context,
+	ids []string,
+	status string,
+	moderationNote string,
+	now time.Time,
+) (int, error) {
+	resolvedIDs := resolveMemoryCommentIDs(ids)
+	if len(resolvedIDs) == 0 {
+		return 0, nil
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	matched := 0
+	for index := range r.store.comments {
+		if slices.Contains(resolvedIDs, r.store.comments[index].ID) {
+			moderateMemoryComment(&r.store.comments[index], status, moderationNote, now)
+			matched++
+		}
+	}
+
+	return matched, nil
+}
+
+func (r *commentMemoryRepository) DeleteCommentByID(_ context.Context, id string) (bool, error) {
+	resolvedID := strings.TrimSpace(id)
+	return r.deleteWhere(func(comment domain.CommentRecord) bool { return comment.ID == resolvedID }) > 0, nil
+}
+
+func (r *commentMemoryRepository) DeleteCommentsByIDs(_ context.Context, ids []string) (int, error) {
+	resolvedIDs := resolveMemoryCommentIDs(ids)
+	if len(resolvedIDs) == 0 {
+		return 0, nil
+	}
+
+	return r.deleteWhere(func(comment domain.CommentRecord) bool { return slices.Contains(resolvedIDs, comment.ID) }), nil
+}
+
+func (r *commentMemoryRepository) ListCommentsByAuthorEmail(_ context.Context, email string) ([]domain.CommentRecord, error) {
+	resolvedEmail := strings.TrimSpace(strings.ToLower(email))
+	if resolvedEmail == "" {
+		return []domain.CommentRecord{}, nil
+	}
+
+	comments := r.filter(func(comment domain.CommentRecord) bool { return comment.AuthorEmail == resolvedEmail })
+	sortMemoryCommentsByCreatedAt(comments)
+	return comments, nil
+}
+
+func (r *commentMemoryRepository) AnonymizeCommentsByAuthorEmail(
+	_ context.Context,
+	email string,
+	authorName string,
+	now time.Time,
+) (int, error) {
+	resolvedEmail := strings.TrimSpace(strings.ToLower(email))
+	if resolvedEmail == "" {
+		return 0, nil
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	anonymized := 0
+	for index := range r.store.comments {
+		comment := &r.store.comments[index]
+		if comment.AuthorEmail != resolvedEmail {
+			continue
+		}
+
+		comment.AuthorName = strings.TrimSpace(authorName)
+		comment.AuthorEmail = ""
+		comment.UpdatedAt = now.UTC()
+		comment.AuthorAvatarURL = ""
+		comment.IPHash = ""
+		comment.UserAgentHash = ""
+		anonymized++
+	}
+
+	return anonymized, nil
+}
+
+func (r *commentMemoryRepository) DeleteCommentsByAuthorEmail(_ context.Context, email string) (int, error) {
+	resolvedEmail := strings.TrimSpace(strings.ToLower(email))
+	if resolvedEmail == "" {
+		return 0, nil
+	}
+
+	return r.deleteWhere(func(comment domain.CommentRecord) bool { return comment.AuthorEmail == resolvedEmail }), nil
+}
+
+func (r *commentMemoryRepository) filter(match func(domain.CommentRecord) bool) []domain.CommentRecord {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	comments := make([]domain.CommentRecord, 0)
+	for _, comment := range r.store.comments {
+		if match(comment) {
+			comments = append(comments, cloneMemoryComment(comment))
+		}
+	}
+
+	return comments
+}
+
+func (r *commentMemoryRepository) deleteWhere(match func(domain.CommentRecord) bool) int {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	before := len(r.store.comments)
+	r.store.comments = slices.DeleteFunc(r.store.comments, match)
+	return before - len(r.store.comments)
+}
+
+func (r *commentMemoryRepository) indexOf(id string) int {
+	return slices.IndexFunc(r.store.comments, func(comment domain.CommentRecord) bool { return comment.ID == id })
+}
+
+func moderateMemoryComment(comment *domain.CommentRecord, status, moderationNote string, now time.Time) {
+	resolvedNow := now.UTC()
+	comment.Status = strings.TrimSpace(strings.ToLower(status))
+	comment.UpdatedAt = resolvedNow
+	comment.ModeratedAt = &resolvedNow
+	comment.ModerationNote = strings.TrimSpace(moderationNote)
+}
+
+func resolveMemoryCommentIDs(ids []string) []string {
+	resolvedIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if trimmed := strings.TrimSpace(id); trimmed != "" {
+			resolvedIDs = append(resolvedIDs, trimmed)
+		}
+	}
+
+	return resolvedIDs
+}
+
+func sortMemoryCommentsByCreatedAt(comments []domain.CommentRecord) {
+	slices.SortStableFunc(comments, func(left, right domain.CommentRecord) int {
+		if order := left.CreatedAt.Compare(right.CreatedAt); order != 0 {
+			return order
+		}
+		return strings.Compare(left.ID, right.ID)
+	})
+}
+
+func cloneMemoryComment(comment domain.CommentRecord) domain.CommentRecord {
+	comment.ParentID = cloneMemoryValue(comment.ParentID)
+	comment.ModeratedAt = cloneMemoryValue(comment.ModeratedAt)
+	return comment
+}
@@ -29,21 +29,21 @@ const (
 	errorMessageUnavailableErrorFormat = "%w: %v"
 )
 
-type errorMessageMongoRepository struct{}
+type errorMessageMongoRepository struct {
+	client *mongo.Client
+}
 
 var (
-	errorMessageMongoClient     *mongo.Client
-	errorMessageMongoInitErr    error
-	errorMessageMongoClientOnce sync.Once
-
 	errorMessageIndexesOnce sync.Once
 	errorMessageIndexesErr  error
 )
 
-func NewErrorMessageRepository() ErrorMessageRepository { return &errorMessageMongoRepository{} }
+func NewErrorMessageRepository(client *mongo.Client) ErrorMessageRepository {
+	return &errorMessageMongoRepository{client: client}
+}
 
-func (*errorMessageMongoRepository) ListByScope(ctx context.Context, scope string) ([]domain.ErrorMessageRecord, error) {
-	collection, err := getErrorMessagesCollection()
+func (repo *errorMessageMongoRepository) ListByScope(ctx context.Context, scope string) ([]domain.ErrorMessageRecord, error) {
+	collection, err := getErrorMessagesCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(errorMessageUnavailableErrorFormat, ErrErrorMessageRepositoryUnavailable, err)
 	}
@@ -95,8 +95,8 @@ func (*errorMessageMongoRepository) ListByScope(ctx context.Context, scope strin
 	return records, nil
 }
 
-func (*errorMessageMongoRepository) UpsertMany(ctx context.Context, records []domain.ErrorMessageRecord) error {
-	collection, err := getErrorMessagesCollection()
+func (repo *errorMessageMongoRepository) UpsertMany(ctx context.Context, records []domain.ErrorMessageRecord) error {
+	collection, err := getErrorMessagesCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(errorMessageUnavailableErrorFormat, ErrErrorMessageRepositoryUnavailable, err)
 	}
@@ -146,8 +146,8 @@ func (*errorMessageMongoRepository) UpsertMany(ctx context.Context, records []do
 	return err
 }
 
-func (*errorMessageMongoRepository) DeleteByKey(ctx context.Context, scope, locale, code string) (bool, error) {
-	collection, err := getErrorMessagesCollection()
+func (repo *errorMessageMongoRepository) DeleteByKey(ctx context.Context, scope, locale, code string) (bool, error) {
+	collection, err := getErrorMessagesCollection(repo.client)
 	if err != nil {
 		return false, fmt.Errorf(errorMessageUnavailableErrorFormat, ErrErrorMessageRepositoryUnavailable, err)
 	}
@@ -171,42 +171,14 @@ func (*errorMessageMongoRepository) DeleteByKey(ctx context.Context, scope, loca
 	return result.DeletedCount > 0, nil
 }
 
-func getErrorMessageMongoClient() (*mongo.Client, error) {
-	errorMessageMongoClientOnce.Do(func() {
-		databaseConfig, err := appconfig.ResolveDatabaseConfig()
-		if err != nil {
-			errorMessageMongoInitErr = err
-			return
-		}
-
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
-
-		client, err := appconfig.NewMongoClient(ctx, databaseConfig, "blog-api-error-message-catalog")
-		if err != nil {
-			errorMessageMongoInitErr = fmt.Errorf("mongodb connect failed: %w", err)
-			return
-		}
-
-		errorMessageMongoClient = client
-	})
-
-	if errorMessageMongoInitErr != nil {
-		return nil, errorMessageMongoInitErr
-	}
-
-	return errorMessageMongoClient, nil
-}
-
-func getErrorMessagesCollection() (*mongo.Collection, error) {
+func getErrorMessagesCollection(client *mongo.Client) (*mongo.Collection, error) {
 	databaseConfig, err := appconfig.ResolveDatabaseConfig()
 	if err != nil {
 		return nil, err
 	}
 
-	client, err := getErrorMessageMongoClient()
-	if err != nil {
-		return nil, err
+	if client == nil {
+		return nil, ErrMongoClientUnavailable
 	}
 
 	collection := client.Database(databaseConfig.Name).Collection(errorMessagesCollectionName)
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+)
+
+type errorMessageMemoryRepository struct {
+	store *MemoryStore
+}
+
+// NewErrorMessageMemoryRepository returns an error message repository backed by the given in-memory store.
+func NewErrorMessageMemoryRepository(store *MemoryStore) ErrorMessageRepository {
+	return &errorMessageMemoryRepository{store: store}
+}
+
+func (r *errorMessageMemoryRepository) ListByScope(_ context.Context, scope string) ([]domain.ErrorMessageRecord, error) {
+	trimmedScope := strings.TrimSpace(scope)
+	if trimmedScope == "" {
+		return nil, nil
+	}
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	records := make([]domain.ErrorMessageRecord, 0)
+	for _, record := range r.store.errorMessages {
+		if record.Scope == trimmedScope {
+			records = append(records, record)
+		}
+	}
+
+	return records, nil
+}
+
+func (r *errorMessageMemoryRepository) UpsertMany(_ context.Context, records []domain.ErrorMessageRecord) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, record := range records {
+		resolved := domain.ErrorMessageRecord{
+			Scope:     strings.TrimSpace(record.Scope),
+			Locale:    strings.TrimSpace(strings.ToLower(record.Locale)),
+			Code:      strings.TrimSpace(strings.ToUpper(record.Code)),
+			Message:   strings.TrimSpace(record.Message),
+			UpdatedAt: record.UpdatedAt.UTC(),
+		}
+		if resolved.Scope == "" || resolved.Locale == "" || resolved.Code == "" || resolved.Message == "" {
+			continue
+		}
+		if resolved.UpdatedAt.IsZero() {
+			resolved.UpdatedAt = time.Now().UTC()
+		}
+
+		index := r.indexOf(resolved.Scope, resolved.Locale, resolved.Code)
+		if index >= 0 {
+			r.store.errorMessages[index] = resolved
+			continue
+		}
+		r.store.errorMessages = append(r.store.errorMessages, resolved)
+	}
+
+	return nil
+}
+
+func (r *errorMessageMemoryRepository) DeleteByKey(_ context.Context, scope, locale, code string) (bool, error) {
+	resolvedScope := strings.TrimSpace(scope)
+	resolvedLocale := strings.TrimSpace(strings.ToLower(locale))
+	resolvedCode := strings.TrimSpace(strings.ToUpper(code))
+	if resolvedScope == "" || resolvedLocale == "" || resolvedCode == "" {
+		return false, errors.New("invalid error message key")
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	index := r.indexOf(resolvedScope, resolvedLocale, resolvedCode)
+	if index < 0 {
+		return false, nil
+	}
+
+	r.store.errorMessages = append(r.store.errorMessages[:index], r.store.errorMessages[index+1:]...)
+	return true, nil
+}
+
+func (r *errorMessageMemoryRepository) indexOf(scope, locale, code string) int {
+	for index, record := range r.store.errorMessages {
+		if record.Scope == scope && record.Locale == locale && record.Code == code {
+			return index
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type LoginHistoryRepository interface {
+	HasAny(ctx context.Context, accountType, userID string) (bool, error)
+	Remember(ctx context.Context, record domain.LoginHistoryRecord) (bool, error)
+	DeleteByUserID(ctx context.Context, accountType, userID string) error
+}
+
+var ErrLoginHistoryRepositoryUnavailable = errors.New("login history repository unavailable")
+
+const (
+	loginHistoryCollectionName              = "login_history"
+	loginHistoryRepositoryUnavailableFormat = "%w: %v"
+)
+
+type loginHistoryMongoRepository struct {
+	client *mongo.Client
+}
+
+var (
+	loginHistoryIndexesOnce sync.Once
+	loginHistoryIndexesErr  error
+)
+
+func NewLoginHistoryRepository(client *mongo.Client) LoginHistoryRepository {
+	return &loginHistoryMongoRepository{client: client}
+}
+
+func (repo *loginHistoryMongoRepository) HasAny(ctx context.Context, accountType, userID string) (bool, error) {
+	collection, err := getLoginHistoryCollection(repo.client)
+	if err != nil {
+		return false, fmt.Errorf(loginHistoryRepositoryUnavailableFormat, ErrLoginHistoryRepositoryUnavailable, err)
+	}
+
+	count, err := collection.CountDocuments(
+		ctx,
+		bson.M{"accountType": strings.TrimSpace(accountType), "userId": strings.TrimSpace(userID)},
+		options.Count().SetLimit(1),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// Remember stores the value or refreshes its last use, and reports whether the value had not been seen before.
+func (repo *loginHistoryMongoRepository) Remember(ctx context.Context, record domain.LoginHistoryRecord) (bool, error) {
+	collection, err := getLoginHistoryCollection(repo.client)
+	if err != nil {
+		return false, fmt.Errorf(loginHistoryRepositoryUnavailableFormat, ErrLoginHistoryRepositoryUnavailable, err)
+	}
+
+	result, err := collection.UpdateOne(
+		ctx,
+		bson.M{
+			"accountType": strings.TrimSpace(record.AccountType),
+			"userId":      strings.TrimSpace(record.UserID),
+			"kind":        strings.TrimSpace(record.Kind),
+			"value":       strings.TrimSpace(record.Value),
+		},
+		bson.M{
+			"$set": bson.M{
+				"lastSeenAt": record.LastSeenAt.UTC(),
+				"expiresAt":  record.ExpiresAt.UTC(),
+			},
+			"$setOnInsert": bson.M{
+				"firstSeenAt": record.FirstSeenAt.UTC(),
+			},
+		},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		// A concurrent sign-in inserted the same value first.
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return result.UpsertedCount > 0, nil
+}
+
+func (repo *loginHistoryMongoRepository) DeleteByUserID(ctx context.Context, accountType, userID string) error {
+	collection, err := getLoginHistoryCollection(repo.client)
+	if err != nil {
+		return fmt.Errorf(loginHistoryRepositoryUnavailableFormat, ErrLoginHistoryRepositoryUnavailable, err)
+	}
+
+	_, err = collection.DeleteMany(
+		ctx,
+		bson.M{"accountType": strings.TrimSpace(accountType), "userId": strings.TrimSpace(userID)},
+	)
+	return err
+}
+
+func getLoginHistoryCollection(client *mongo.Client) (*mongo.Collection, error) {
+	databaseConfig, err := appconfig.ResolveDatabaseConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	if client == nil {
+		return nil, ErrMongoClientUnavailable
+	}
+
+	collection := client.Database(databaseConfig.Name).Collection(loginHistoryCollectionName)
+	if err := ensureLoginHistoryIndexes(collection); err != nil {
+		return nil, err
+	}
+
+	return collection, nil
+}
+
+func ensureLoginHistoryIndexes(collection *mongo.Collection) error {
+	loginHistoryIndexesOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		indexes := []mongo.IndexModel{
+			{
+				Keys: bson.D{
+					{Key: "accountType", Value: 1},
+					{Key: "userId", Value: 1},
+					{Key: "kind", Value: 1},
+					{Key: "value", Value: 1},
+				},
+				Options: options.Index().SetUnique(true).SetName("uniq_login_history_value"),
+			},
+			{
+				Keys:    bson.D{{Key: "expiresAt", Value: 1}},
+				Options: options.Index().SetName("ttl_login_history_expires_at").SetExpireAfterSeconds(0),
+			},
+		}
+
+		if _, err := collection.Indexes().CreateMany(ctx, indexes); err != nil {
+			loginHistoryIndexesErr = fmt.Errorf("create login history index failed: %w", err)
+		}
+	})
+
+	return loginHistoryIndexesErr
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+)
+
+type loginHistoryMemoryRepository struct {
+	store *MemoryStore
+}
+
+// NewLoginHistoryMemoryRepository returns a login history repository backed by the given in-memory store.
+func NewLoginHistoryMemoryRepository(store *MemoryStore) LoginHistoryRepository {
+	return &loginHistoryMemoryRepository{store: store}
+}
+
+func (r *loginHistoryMemoryRepository) HasAny(_ context.Context, accountType, userID string) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	resolvedAccountType := strings.TrimSpace(accountType)
+	resolvedUserID := strings.TrimSpace(userID)
+	return slices.ContainsFunc(r.store.loginHistory, func(record domain.LoginHistoryRecord) bool {
+		return record.AccountType == resolvedAccountType && record.UserID == resolvedUserID
+	}), nil
+}
+
+// Remember stores the value or refreshes its last use, and reports whether the value had not been seen before.
+func (r *loginHistoryMemoryRepository) Remember(_ context.Context, record domain.LoginHistoryRecord) (bool, error) {
+	resolved := domain.LoginHistoryRecord{
+		AccountType: strings.TrimSpace(record.AccountType),
+		UserID:      strings.TrimSpace(record.UserID),
+		Kind:        strings.TrimSpace(record.Kind),
+		Value:       strings.TrimSpace(record.Value),
+		FirstSeenAt: record.FirstSeenAt.UTC(),
+		LastSeenAt:  record.LastSeenAt.UTC(),
+		ExpiresAt:   record.ExpiresAt.UTC(),
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for index := range r.store.loginHistory {
+		existing := &r.store.loginHistory[index]
+		if existing.AccountType == resolved.AccountType && existing.UserID == resolved.UserID &&
+			existing.Kind == resolved.Kind && existing.Value == resolved.Value {
+			existing.LastSeenAt = resolved.LastSeenAt
+			existing.ExpiresAt = resolved.ExpiresAt
+			return false, nil
+		}
+	}
+
+	r.store.loginHistory = append(r.store.loginHistory, resolved)
+	return true, nil
+}
+
+func (r *loginHistoryMemoryRepository) DeleteByUserID(_ context.Context, accountType, userID string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	resolvedAccountType := strings.TrimSpace(accountType)
+	resolvedUserID := strings.TrimSpace(userID)
+	r.store.loginHistory = slices.DeleteFunc(r.store.loginHistory, func(record domain.LoginHistoryRecord) bool {
+		return record.AccountType == resolvedAccountType && record.UserID == resolvedUserID
+	})
+	return nil
+}
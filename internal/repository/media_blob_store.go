@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var (
+	ErrMediaBlobStoreUnavailable = errors.New("media blob store unavailable")
+	ErrMediaBlobNotFound         = errors.New("media blob not found")
+)
+
+const mediaBlobStoreUnavailableFormat = "%w: %v"
+
+var mediaBlobKeyPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{0,199}$`)
+
+// MediaBlobStore keeps media bytes outside of the asset documents.
+// Keys are generated by the media service and never contain path separators.
+type MediaBlobStore interface {
+	Backend() string
+	Put(ctx context.Context, key, contentType string, body io.Reader, size int64) error
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// MediaBlobStores opens the blob store of a storage backend. GridFS stores use the client the container built it with.
+type MediaBlobStores struct {
+	client *mongo.Client
+}
+
+// NewMediaBlobStores returns the stores of every backend. client is nil for a memory container, whose GridFS store then
+// reports ErrMongoClientUnavailable.
+func NewMediaBlobStores(client *mongo.Client) *MediaBlobStores {
+	return &MediaBlobStores{client: client}
+}
+
+// ForBackend builds the store for backend. Inline assets keep their bytes in the
+// asset document, so the inline backend has no store and resolves to nil.
+func (stores *MediaBlobStores) ForBackend(backend string, cfg appconfig.MediaStorageConfig) (MediaBlobStore, error) {
+	switch appconfig.NormalizeMediaStorageBackend(backend) {
+	case appconfig.MediaStorageInline:
+		return nil, nil
+	case appconfig.MediaStorageGridFS:
+		return &mediaGridFSBlobStore{client: stores.client}, nil
+	case appconfig.MediaStorageFilesystem:
+		return NewMediaFilesystemBlobStore(cfg.FilesystemDir)
+	case appconfig.MediaStorageS3:
+		return NewMediaS3BlobStore(cfg)
+	default:
+		return nil, fmt.Errorf(mediaBlobStoreUnavailableFormat, ErrMediaBlobStoreUnavailable, "unknown backend "+backend)
+	}
+}
+
+func validateMediaBlobKey(key string) error {
+	if !mediaBlobKeyPattern.MatchString(key) {
+		return fmt.Errorf("invalid media blob key %q", key)
+	}
+	return nil
+}
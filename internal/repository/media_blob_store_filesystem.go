@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+)
+
+type mediaFilesystemBlobStore struct {
+	root string
+}
+
+func NewMediaFilesystemBlobStore(root string) (MediaBlobStore, error) {
+	resolvedRoot := strings.TrimSpace(root)
+	if resolvedRoot == "" {
+		return nil, fmt.Errorf(mediaBlobStoreUnavailableFormat, ErrMediaBlobStoreUnavailable, "media storage dir is required")
+	}
+
+	absoluteRoot, err := filepath.Abs(resolvedRoot)
+	if err != nil {
+		return nil, fmt.Errorf(mediaBlobStoreUnavailableFormat, ErrMediaBlobStoreUnavailable, err)
+	}
+	return &mediaFilesystemBlobStore{root: absoluteRoot}, nil
+}
+
+func (*mediaFilesystemBlobStore) Backend() string { return appconfig.MediaStorageFilesystem }
+
+func (store *mediaFilesystemBlobStore) Put(
+	ctx context.Context,
+	key string,
+	_ string,
+	body io.Reader,
+	_ int64,
+) error {
+	if err := validateMediaBlobKey(key); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(store.root, 0o750); err != nil {
+		return fmt.Errorf(mediaBlobStoreUnavailableFormat, ErrMediaBlobStoreUnavailable, err)
+	}
+
+	// Write to a temporary file first so readers never observe a partially written blob.
+	tempFile, err := os.CreateTemp(store.root, "."+key+".*.tmp")
+	if err != nil {
+		return fmt.Errorf(mediaBlobStoreUnavailableFormat, ErrMediaBlobStoreUnavailable, err)
+	}
+	tempPath := tempFile.Name()
+	defer func() {
+		_ = os.Remove(tempPath)
+	}()
+
+	if _, err := io.Copy(tempFile, contextReader{ctx: ctx, reader: body}); err != nil {
+		_ = tempFile.Close()
+		return err
+	}
+	if err := tempFile.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tempPath, filepath.Join(store.root, key))
+}
+
+func (store *mediaFilesystemBlobStore) Open(_ context.Context, key string) (io.ReadCloser, error) {
+	if err := validateMediaBlobKey(key); err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(filepath.Join(store.root, key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrMediaBlobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+func (store *mediaFilesystemBlobStore) Delete(_ context.Context, key string) error {
+	if err := validateMediaBlobKey(key); err != nil {
+		return err
+	}
+
+	err := os.Remove(filepath.Join(store.root, key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// contextReader stops long copies once the request context is cancelled.
+type contextReader struct {
+	ctx    context.Context
+	reader io.Reader
+}
+
+func (reader contextReader) Read(buffer []byte) (int, error) {
+	if err := reader.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return reader.reader.Read(buffer)
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/gridfs"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const mediaBlobsBucketName = "admin_media_blobs"
+
+type mediaGridFSBlobStore struct {
+	client *mongo.Client
+}
+
+func (*mediaGridFSBlobStore) Backend() string { return appconfig.MediaStorageGridFS }
+
+func (store *mediaGridFSBlobStore) Put(
+	ctx context.Context,
+	key string,
+	contentType string,
+	body io.Reader,
+	_ int64,
+) error {
+	if err := validateMediaBlobKey(key); err != nil {
+		return err
+	}
+	bucket, err := getMediaBlobsBucket(ctx, store.client)
+	if err != nil {
+		return err
+	}
+
+	previousIDs, err := findMediaGridFSFileIDs(ctx, bucket, key)
+	if err != nil {
+		return err
+	}
+
+	uploadOptions := options.GridFSUpload().SetMetadata(bson.M{"contentType": contentType})
+	if _, err := bucket.UploadFromStream(key, body, uploadOptions); err != nil {
+		return err
+	}
+
+	// GridFS allows duplicate file names; drop older revisions once the new upload is complete.
+	for _, fileID := range previousIDs {
+		if err := bucket.DeleteContext(ctx, fileID); err != nil && !errors.Is(err, gridfs.ErrFileNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (repo *mediaGridFSBlobStore) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	if err := validateMediaBlobKey(key); err != nil {
+		return nil, err
+	}
+	bucket, err := getMediaBlobsBucket(ctx, repo.client)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := bucket.OpenDownloadStreamByName(key)
+	if errors.Is(err, gridfs.ErrFileNotFound) {
+		return nil, ErrMediaBlobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+func (repo *mediaGridFSBlobStore) Delete(ctx context.Context, key string) error {
+	if err := validateMediaBlobKey(key); err != nil {
+		return err
+	}
+	bucket, err := getMediaBlobsBucket(ctx, repo.client)
+	if err != nil {
+		return err
+	}
+
+	fileIDs, err := findMediaGridFSFileIDs(ctx, bucket, key)
+	if err != nil {
+		return err
+	}
+	for _, fileID := range fileIDs {
+		if err := bucket.DeleteContext(ctx, fileID); err != nil && !errors.Is(err, gridfs.ErrFileNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+func getMediaBlobsBucket(ctx context.Context, client *mongo.Client) (*gridfs.Bucket, error) {
+	databaseConfig, err := appconfig.ResolveDatabaseConfig()
+	if err != nil {
+		return nil, fmt.Errorf(mediaBlobStoreUnavailableFormat, ErrMediaBlobStoreUnavailable, err)
+	}
+	if client == nil {
+		return nil, fmt.Errorf(mediaBlobStoreUnavailableFormat, ErrMediaBlobStoreUnavailable, ErrMongoClientUnavailable)
+	}
+
+	bucket, err := gridfs.NewBucket(
+		client.Database(databaseConfig.Name),
+		options.GridFSBucket().SetName(mediaBlobsBucketName),
+	)
+	if err != nil {
+		return nil, fmt.Errorf(mediaBlobStoreUnavailableFormat, ErrMediaBlobStoreUnavailable, err)
+	}
+	// Upload and download streams take deadlines instead of contexts.
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = bucket.SetWriteDeadline(deadline)
+		_ = bucket.SetReadDeadline(deadline)
+	}
+	return bucket, nil
+}
+
+func findMediaGridFSFileIDs(ctx context.Context, bucket *gridfs.Bucket, key string) ([]any, error) {
+	cursor, err := bucket.FindContext(ctx, bson.M{"filename": key})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	var files []struct {
+		ID any `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &files); err != nil {
+		return nil, err
+	}
+
+	fileIDs := make([]any, 0, len(files))
+	for _, file := range files {
+		fileIDs = append(fileIDs, file.ID)
+	}
+	return fileIDs, nil
+}
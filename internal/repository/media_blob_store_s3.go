@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+)
+
+const (
+	mediaS3SigningAlgorithm = "AWS4-HMAC-SHA256"
+	mediaS3UnsignedPayload  = "UNSIGNED-PAYLOAD"
+	mediaS3SignedHeaders    = "host;x-amz-content-sha256;x-amz-date"
+	mediaS3ErrorBodyLimit   = 512
+)
+
+// mediaS3BlobStore talks to S3-compatible object storage (AWS S3, MinIO, R2) using
+// path-style URLs and SigV4 request signing.
+type mediaS3BlobStore struct {
+	endpoint        *url.URL
+	region          string
+	bucket          string
+	accessKeyID     string
+	secretAccessKey string
+	client          *http.Client
+	now             func() time.Time
+}
+
+func NewMediaS3BlobStore(cfg appconfig.MediaStorageConfig) (MediaBlobStore, error) {
+	if cfg.S3Endpoint == "" || cfg.S3Bucket == "" || cfg.S3AccessKeyID == "" || cfg.S3SecretAccessKey == "" {
+		return nil, fmt.Errorf(
+			mediaBlobStoreUnavailableFormat,
+			ErrMediaBlobStoreUnavailable,
+			"s3 endpoint, bucket and credentials are required",
+		)
+	}
+
+	endpoint, err := url.Parse(cfg.S3Endpoint)
+	if err != nil || endpoint.Host == "" || (endpoint.Scheme != "http" && endpoint.Scheme != "https") {
+		return nil, fmt.Errorf(mediaBlobStoreUnavailableFormat, ErrMediaBlobStoreUnavailable, "invalid s3 endpoint")
+	}
+
+	return &mediaS3BlobStore{
+		endpoint:        endpoint,
+		region:          cfg.S3Region,
+		bucket:          cfg.S3Bucket,
+		accessKeyID:     cfg.S3AccessKeyID,
+		secretAccessKey: cfg.S3SecretAccessKey,
+		client:          &http.Client{Timeout: 30 * time.Second},
+		now:             time.Now,
+	}, nil
+}
+
+func (*mediaS3BlobStore) Backend() string { return appconfig.MediaStorageS3 }
+
+func (store *mediaS3BlobStore) Put(
+	ctx context.Context,
+	key string,
+	contentType string,
+	body io.Reader,
+	size int64,
+) error {
+	request, err := store.newRequest(ctx, http.MethodPut, key, body)
+	if err != nil {
+		return err
+	}
+	request.ContentLength = size
+	if contentType != "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+
+	response, err := store.do(request)
+	if err != nil {
+		return err
+	}
+	_ = response.Body.Close()
+	return nil
+}
+
+func (store *mediaS3BlobStore) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	request, err := store.newRequest(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := store.do(request)
+	if err != nil {
+		return nil, err
+	}
+	return response.Body, nil
+}
+
+func (store *mediaS3BlobStore) Delete(ctx context.Context, key string) error {
+	request, err := store.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := store.do(request)
+	if errors.Is(err, ErrMediaBlobNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	_ = response.Body.Close()
+	return nil
+}
+
+func (store *mediaS3BlobStore) newRequest(
+	ctx context.Context,
+	method string,
+	key string,
+	body io.Reader,
+) (*http.Request, error) {
+	if err := validateMediaBlobKey(key); err != nil {
+		return nil, err
+	}
+
+	objectURL := *store.endpoint
+	objectURL.Path = strings.TrimRight(store.endpoint.Path, "/") + "/" + url.PathEscape(store.bucket) + "/" + key
+	objectURL.RawPath = ""
+
+	request, err := http.NewRequestWithContext(ctx, method, objectURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	signMediaS3Request(request, store.region, store.accessKeyID, store.secretAccessKey, store.now().UTC())
+	return request, nil
+}
+
+func (store *mediaS3BlobStore) do(request *http.Request) (*http.Response, error) {
+	response, err := store.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf(mediaBlobStoreUnavailableFormat, ErrMediaBlobStoreUnavailable, err)
+	}
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return response, nil
+	}
+
+	defer func() {
+		_ = response.Body.Close()
+	}()
+	if response.StatusCode == http.StatusNotFound {
+		return nil, ErrMediaBlobNotFound
+	}
+	message, _ := io.ReadAll(io.LimitReader(response.Body, mediaS3ErrorBodyLimit))
+	return nil, fmt.Errorf("s3 %s %s failed with status %d: %s",
+		request.Method, request.URL.Path, response.StatusCode, strings.TrimSpace(string(message)))
+}
+
+func signMediaS3Request(request *http.Request, region, accessKeyID, secretAccessKey string, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	shortDate := now.Format("20060102")
+	request.Header.Set("X-Amz-Date", amzDate)
+	request.Header.Set("X-Amz-Content-Sha256", mediaS3UnsignedPayload)
+
+	canonicalRequest := strings.Join([]string{
+		request.Method,
+		request.URL.EscapedPath(),
+		request.URL.RawQuery,
+		"host:" + request.URL.Host + "\n" +
+			"x-amz-content-sha256:" + mediaS3UnsignedPayload + "\n" +
+			"x-amz-date:" + amzDate + "\n",
+		mediaS3SignedHeaders,
+		mediaS3UnsignedPayload,
+	}, "\n")
+
+	scope := shortDate + "/" + region + "/s3/aws4_request"
+	canonicalHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		mediaS3SigningAlgorithm,
+		amzDate,
+		scope,
+		hex.EncodeToString(canonicalHash[:]),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+secretAccessKey), shortDate)
+	signingKey = hmacSHA256(signingKey, region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	request.Header.Set("Authorization", fmt.Sprintf(
+		"%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		mediaS3SigningAlgorithm,
+		accessKeyID,
+		scope,
+		mediaS3SignedHeaders,
+		signature,
+	))
+}
+
+func hmacSHA256(key []byte, value string) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(value))
+	return mac.Sum(nil)
+}
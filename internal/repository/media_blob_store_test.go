@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+)
+
+func TestMediaFilesystemBlobStoreRoundTrip(t *testing.T) {
+	store, err := NewMediaBlobStores(nil).ForBackend(appconfig.MediaStorageFilesystem, appconfig.MediaStorageConfig{FilesystemDir: t.TempDir()})
+	if err != nil || store.Backend() != appconfig.MediaStorageFilesystem {
+		t.Fatalf("ForBackend() = %#v, %v", store, err)
+	}
+
+	checkMediaBlobStoreRoundTrip(t, store)
+
+	if err := store.Put(context.Background(), "../escape", "image/png", strings.NewReader("x"), 1); err == nil {
+		t.Fatal("expected path traversal key to be rejected")
+	}
+}
+
+func TestMediaS3BlobStoreRoundTrip(t *testing.T) {
+	objects := map[string][]byte{}
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization := r.Header.Get("Authorization")
+		if !strings.HasPrefix(authorization, "AWS4-HMAC-SHA256 Credential=access/20260301/us-east-1/s3/aws4_request") ||
+			r.Header.Get("X-Amz-Date") != "20260301T120000Z" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if !strings.HasPrefix(r.URL.Path, "/media/") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case http.MethodPut:
+			data, _ := io.ReadAll(r.Body)
+			objects[r.URL.Path] = data
+		case http.MethodGet:
+			data, ok := objects[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(data)
+		case http.MethodDelete:
+			delete(objects, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	store, err := NewMediaBlobStores(nil).ForBackend(appconfig.MediaStorageS3, appconfig.MediaStorageConfig{
+		S3Endpoint:        server.URL,
+		S3Region:          "us-east-1",
+		S3Bucket:          "media",
+		S3AccessKeyID:     "access",
+		S3SecretAccessKey: "secret",
+	})
+	if err != nil {
+		t.Fatalf("ForBackend() error = %v", err)
+	}
+	store.(*mediaS3BlobStore).now = func() time.Time { return time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC) }
+
+	checkMediaBlobStoreRoundTrip(t, store)
+	if _, ok := objects["/media/asset-1-abc"]; ok {
+		t.Fatal("expected object to be deleted")
+	}
+
+	if _, err := NewMediaBlobStores(nil).ForBackend(appconfig.MediaStorageS3, appconfig.MediaStorageConfig{S3Endpoint: server.URL}); !errors.Is(err, ErrMediaBlobStoreUnavailable) {
+		t.Fatalf("expected missing credentials to be rejected, got %v", err)
+	}
+}
+
+func TestSignMediaS3RequestIsDeterministic(t *testing.T) {
+	now := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	first, _ := http.NewRequest(http.MethodGet, "https://s3.example.com/media/asset-1-abc", nil)
+	second, _ := http.NewRequest(http.MethodGet, "https://s3.example.com/media/asset-1-abc", nil)
+	other, _ := http.NewRequest(http.MethodGet, "https://s3.example.com/media/asset-2-abc", nil)
+
+	signMediaS3Request(first, "us-east-1", "access", "secret", now)
+	signMediaS3Request(second, "us-east-1", "access", "secret", now)
+	signMediaS3Request(other, "us-east-1", "access", "secret", now)
+
+	if first.Header.Get("Authorization") != second.Header.Get("Authorization") {
+		t.Fatal("expected identical requests to produce identical signatures")
+	}
+	if first.Header.Get("Authorization") == other.Header.Get("Authorization") {
+		t.Fatal("expected the object path to be part of the signature")
+	}
+}
+
+func TestMediaBlobStoresResolveBackends(t *testing.T) {
+	stores := NewMediaBlobStores(nil)
+	if store, err := stores.ForBackend(appconfig.MediaStorageInline, appconfig.MediaStorageConfig{}); store != nil || err != nil {
+		t.Fatalf("expected inline storage to have no blob store, got %#v, %v", store, err)
+	}
+	if store, err := stores.ForBackend(appconfig.MediaStorageGridFS, appconfig.MediaStorageConfig{}); err != nil || store.Backend() != appconfig.MediaStorageGridFS {
+		t.Fatalf("expected gridfs store, got %#v, %v", store, err)
+	}
+	if _, err := stores.ForBackend("dropbox", appconfig.MediaStorageConfig{}); !errors.Is(err, ErrMediaBlobStoreUnavailable) {
+		t.Fatalf("expected unknown backend to fail, got %v", err)
+	}
+}
+
+func checkMediaBlobStoreRoundTrip(t *testing.T, store MediaBlobStore) {
+	t.Helper()
+	ctx := context.Background()
+
+	if err := store.Put(ctx, "asset-1-abc", "image/png", strings.NewReader("first"), 5); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if err := store.Put(ctx, "asset-1-abc", "image/png", strings.NewReader("second"), 6); err != nil {
+		t.Fatalf("Put() overwrite error = %v", err)
+	}
+
+	body, err := store.Open(ctx, "asset-1-abc")
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	data, _ := io.ReadAll(body)
+	_ = body.Close()
+	if string(data) != "second" {
+		t.Fatalf("Open() = %q", data)
+	}
+
+	if err := store.Delete(ctx, "asset-1-abc"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if err := store.Delete(ctx, "asset-1-abc"); err != nil {
+		t.Fatalf("Delete() of a missing blob error = %v", err)
+	}
+	if _, err := store.Open(ctx, "asset-1-abc"); !errors.Is(err, ErrMediaBlobNotFound) {
+		t.Fatalf("Open() after delete error = %v", err)
+	}
+}
@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// memoryRefreshToken is the shape shared by admin and reader refresh tokens, so both in-memory repositories rotate and
+// revoke through the same table code.
+type memoryRefreshToken struct {
+	JTI         string
+	UserID      string
+	TokenHash   string
+	Persistent  bool
+	UserAgent   string
+	RemoteIP    string
+	CountryCode string
+	LastSeenAt  time.Time
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+	RotatedAt   *time.Time
+	RevokedAt   *time.Time
+	ReplacedBy  string
+}
+
+type memoryRefreshTokenTable []memoryRefreshToken
+
+func (token memoryRefreshToken) active(now time.Time) bool {
+	return token.ExpiresAt.After(now) && token.RevokedAt == nil && token.RotatedAt == nil
+}
+
+func (token memoryRefreshToken) clone() memoryRefreshToken {
+	token.RotatedAt = cloneMemoryValue(token.RotatedAt)
+	token.RevokedAt = cloneMemoryValue(token.RevokedAt)
+	return token
+}
+
+func (table *memoryRefreshTokenTable) insert(token memoryRefreshToken) {
+	token.JTI = strings.TrimSpace(token.JTI)
+	token.UserID = strings.TrimSpace(token.UserID)
+	token.TokenHash = strings.TrimSpace(token.TokenHash)
+	token.UserAgent = strings.TrimSpace(token.UserAgent)
+	token.RemoteIP = strings.TrimSpace(token.RemoteIP)
+	token.CountryCode = strings.TrimSpace(strings.ToUpper(token.CountryCode))
+	token.ReplacedBy = strings.TrimSpace(token.ReplacedBy)
+	*table = append(*table, token.clone())
+}
+
+func (table *memoryRefreshTokenTable) index(jti string) int {
+	resolvedJTI := strings.TrimSpace(jti)
+	return slices.IndexFunc(*table, func(token memoryRefreshToken) bool {
+		return token.JTI == resolvedJTI
+	})
+}
+
+// find returns the token with jti when its hash matches tokenHash.
+func (table *memoryRefreshTokenTable) find(jti, tokenHash string) (memoryRefreshToken, bool) {
+	index := table.index(jti)
+	if index < 0 || !strings.EqualFold((*table)[index].TokenHash, tokenHash) {
+		return memoryRefreshToken{}, false
+	}
+	return (*table)[index].clone(), true
+}
+
+func (table *memoryRefreshTokenTable) listActive(userID string, now time.Time, limit int) []memoryRefreshToken {
+	resolvedUserID := strings.TrimSpace(userID)
+	tokens := make([]memoryRefreshToken, 0)
+	for _, token := range *table {
+		if token.UserID == resolvedUserID && token.active(now) {
+			tokens = append(tokens, token.clone())
+		}
+	}
+
+	slices.SortStableFunc(tokens, func(left, right memoryRefreshToken) int {
+		if order := right.LastSeenAt.Compare(left.LastSeenAt); order != 0 {
+			return order
+		}
+		return right.CreatedAt.Compare(left.CreatedAt)
+	})
+	if len(tokens) > limit {
+		tokens = tokens[:limit]
+	}
+	return tokens
+}
+
+// rotate marks currentJTI as replaced by replacement and stores the replacement. It reports false when the current
+// token is missing, revoked or already rotated; expired tokens only rotate when allowExpired is set.
+func (table *memoryRefreshTokenTable) rotate(
+	currentJTI string,
+	replacement memoryRefreshToken,
+	now time.Time,
+	allowExpired bool,
+) bool {
+	index := table.index(currentJTI)
+	if index < 0 {
+		return false
+	}
+
+	current := &(*table)[index]
+	if current.RevokedAt != nil || current.RotatedAt != nil || (!allowExpired && !current.ExpiresAt.After(now)) {
+		return false
+	}
+
+	rotatedAt := now
+	current.RotatedAt = &rotatedAt
+	current.ReplacedBy = strings.TrimSpace(replacement.JTI)
+	table.insert(replacement)
+	return true
+}
+
+// revoke revokes the first unrevoked token accepted by match and reports whether one was found.
+func (table *memoryRefreshTokenTable) revoke(now time.Time, match func(memoryRefreshToken) bool) bool {
+	for index := range *table {
+		token := &(*table)[index]
+		if token.RevokedAt != nil || !match(*token) {
+			continue
+		}
+
+		revokedAt := now
+		token.RevokedAt = &revokedAt
+		return true
+	}
+	return false
+}
+
+func (table *memoryRefreshTokenTable) revokeAll(now time.Time, match func(memoryRefreshToken) bool) int {
+	revoked := 0
+	for index := range *table {
+		token := &(*table)[index]
+		if token.RevokedAt != nil || !match(*token) {
+			continue
+		}
+
+		revokedAt := now
+		token.RevokedAt = &revokedAt
+		revoked++
+	}
+	return revoked
+}
+
+// revokeFamily revokes jti and every token issued by rotating it, following the replacedBy links.
+func (table *memoryRefreshTokenTable) revokeFamily(jti string, now time.Time) int {
+	family := make(map[string]struct{})
+	for next := strings.TrimSpace(jti); next != ""; {
+		if _, seen := family[next]; seen {
+			break
+		}
+		index := table.index(next)
+		if index < 0 {
+			break
+		}
+		family[next] = struct{}{}
+		next = (*table)[index].ReplacedBy
+	}
+
+	return table.revokeAll(now, func(token memoryRefreshToken) bool {
+		_, ok := family[token.JTI]
+		return ok
+	})
+}
+
+func (table *memoryRefreshTokenTable) deleteByUserID(userID string) {
+	resolvedUserID := strings.TrimSpace(userID)
+	*table = slices.DeleteFunc(*table, func(token memoryRefreshToken) bool {
+		return token.UserID == resolvedUserID
+	})
+}
+
+func resolveMemorySessionLimit(limit int) int {
+	if limit <= 0 || limit > 100 {
+		return 20
+	}
+	return limit
+}
@@ -0,0 +1,234 @@
+package repository
+
+import (
+	"cmp"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+
+	"gopkg.in/yaml.v3"
+)
+
+var memorySeedPostIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,127}$`)
+
+type memorySeedFrontmatter struct {
+	Title         string `yaml:"title"`
+	Summary       string `yaml:"summary"`
+	PublishedDate string `yaml:"publishedDate"`
+	UpdatedDate   string `yaml:"updatedDate"`
+	Thumbnail     string `yaml:"thumbnail"`
+	ReadingTime   string `yaml:"readingTime"`
+	Category      *struct {
+		ID    string `yaml:"id"`
+		Name  string `yaml:"name"`
+		Color string `yaml:"color"`
+		Icon  string `yaml:"icon"`
+	} `yaml:"category"`
+	Topics []struct {
+		ID    string `yaml:"id"`
+		Name  string `yaml:"name"`
+		Color string `yaml:"color"`
+		Link  string `yaml:"link"`
+	} `yaml:"topics"`
+}
+
+// SeedMemoryStore loads the markdown posts under dir/posts/<locale>/*.md into the store, together with the topics and
+// categories their frontmatter references, the way the content sync script fills Mongo. A missing posts directory
+// leaves the store empty.
+func SeedMemoryStore(store *MemoryStore, dir string) error {
+	postsDir := filepath.Join(dir, "posts")
+	localeEntries, err := os.ReadDir(postsDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("read content posts directory: %w", err)
+	}
+
+	now := time.Now().UTC()
+	posts := make([]memoryPost, 0)
+	for _, localeEntry := range localeEntries {
+		if !localeEntry.IsDir() {
+			continue
+		}
+		locale := strings.TrimSpace(strings.ToLower(localeEntry.Name()))
+		localePosts, err := loadMemorySeedPosts(filepath.Join(postsDir, localeEntry.Name()), locale, now)
+		if err != nil {
+			return err
+		}
+		posts = append(posts, localePosts...)
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	for _, post := range posts {
+		for _, topic := range post.Topics {
+			if store.findTopic(post.Locale, topic.ID) == nil {
+				record := domain.AdminContentTopicRecord{Locale: post.Locale, ID: topic.ID, Name: topic.Name, Color: topic.Color}
+				if topic.Link != nil {
+					record.Link = *topic.Link
+				}
+				store.upsertTopic(record, now)
+			}
+		}
+		if post.Category != nil && store.findCategory(post.Locale, post.Category.ID) == nil {
+			store.upsertCategory(domain.AdminContentCategoryRecord{
+				Locale: post.Locale,
+				ID:     post.Category.ID,
+				Name:   post.Category.Name,
+				Color:  post.Category.Color,
+				Icon:   post.Category.Icon,
+			}, now)
+		}
+
+		if existing := store.findPost(post.Locale, post.ID); existing != nil {
+			*existing = post
+			continue
+		}
+		store.posts = append(store.posts, post)
+	}
+
+	return nil
+}
+
+func loadMemorySeedPosts(localeDir, locale string, now time.Time) ([]memoryPost, error) {
+	entries, err := os.ReadDir(localeDir)
+	if err != nil {
+		return nil, fmt.Errorf("read locale markdown directory %s: %w", locale, err)
+	}
+
+	posts := make([]memoryPost, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(strings.ToLower(entry.Name()), ".md") {
+			continue
+		}
+
+		postID := strings.TrimSpace(strings.ToLower(strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))))
+		if !memorySeedPostIDPattern.MatchString(postID) {
+			continue
+		}
+
+		filePath := filepath.Join(localeDir, entry.Name())
+		raw, err := os.ReadFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("read markdown post %s: %w", filePath, err)
+		}
+		post, err := parseMemorySeedPost(string(raw), locale, postID, now)
+		if err != nil {
+			return nil, fmt.Errorf("parse markdown post %s: %w", filePath, err)
+		}
+		posts = append(posts, post)
+	}
+
+	slices.SortFunc(posts, func(a, b memoryPost) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	return posts, nil
+}
+
+func parseMemorySeedPost(raw, locale, postID string, now time.Time) (memoryPost, error) {
+	frontmatter, content := splitMemorySeedMarkdown(raw)
+
+	var metadata memorySeedFrontmatter
+	if err := yaml.Unmarshal([]byte(frontmatter), &metadata); err != nil {
+		return memoryPost{}, err
+	}
+
+	post := memoryPost{
+		Locale:           locale,
+		ID:               postID,
+		Title:            strings.TrimSpace(metadata.Title),
+		Summary:          strings.TrimSpace(metadata.Summary),
+		Content:          content,
+		ContentMode:      "markdown",
+		Thumbnail:        strings.TrimSpace(metadata.Thumbnail),
+		Source:           "blog",
+		PublishedDate:    strings.TrimSpace(metadata.PublishedDate),
+		UpdatedDate:      strings.TrimSpace(metadata.UpdatedDate),
+		ReadingTimeMin:   parseMemorySeedReadingTime(metadata.ReadingTime),
+		ContentUpdatedAt: now,
+		UpdatedAt:        now,
+	}
+	post.PublishedAt = now
+	for _, layout := range []string{time.DateOnly, time.RFC3339} {
+		if parsed, err := time.Parse(layout, post.PublishedDate); err == nil {
+			post.PublishedAt = parsed.UTC()
+			break
+		}
+	}
+
+	if category := metadata.Category; category != nil {
+		if id := strings.TrimSpace(strings.ToLower(category.ID)); id != "" {
+			post.Category = &domain.PostCategory{
+				ID:    id,
+				Name:  cmp.Or(strings.TrimSpace(category.Name), id),
+				Color: cmp.Or(strings.ToLower(strings.TrimSpace(category.Color)), "blue"),
+				Icon:  strings.TrimSpace(category.Icon),
+			}
+		}
+	}
+
+	searchParts := []string{post.Title, post.Summary}
+	for _, rawTopic := range metadata.Topics {
+		id := strings.TrimSpace(strings.ToLower(rawTopic.ID))
+		if id == "" || slices.Contains(post.TopicIDs, id) {
+			continue
+		}
+		topic := domain.PostTopic{
+			ID:    id,
+			Name:  cmp.Or(strings.TrimSpace(rawTopic.Name), id),
+			Color: cmp.Or(strings.ToLower(strings.TrimSpace(rawTopic.Color)), "blue"),
+		}
+		if link := strings.TrimSpace(rawTopic.Link); link != "" {
+			topic.Link = &link
+		}
+		post.Topics = append(post.Topics, topic)
+		post.TopicIDs = append(post.TopicIDs, id)
+		searchParts = append(searchParts, topic.Name)
+	}
+	post.SearchText = strings.ToLower(strings.Join(slices.DeleteFunc(searchParts, func(part string) bool {
+		return part == ""
+	}), " "))
+
+	return post, nil
+}
+
+// splitMemorySeedMarkdown separates the YAML frontmatter from the markdown body.
+func splitMemorySeedMarkdown(raw string) (string, string) {
+	normalized := strings.ReplaceAll(raw, "\r\n", "\n")
+	normalized = strings.TrimPrefix(normalized, "\uFEFF")
+	if !strings.HasPrefix(normalized, "---\n") {
+		return "", strings.TrimSpace(normalized)
+	}
+
+	rest := normalized[len("---\n"):]
+	for _, separator := range []string{"\n---\n", "\n...\n"} {
+		if frontmatter, content, ok := strings.Cut(rest, separator); ok {
+			return frontmatter, strings.TrimSpace(content)
+		}
+	}
+
+	return "", strings.TrimSpace(normalized)
+}
+
+// parseMemorySeedReadingTime reads the minutes out of values such as "3 min read".
+func parseMemorySeedReadingTime(raw string) int {
+	fields := strings.Fields(raw)
+	if len(fields) == 0 {
+		return 0
+	}
+	minutes, err := strconv.Atoi(fields[0])
+	if err != nil || minutes < 0 {
+		return 0
+	}
+
+	return minutes
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+)
+
+// MemoryStore holds the collections behind the in-memory repositories. Every repository built on one store shares a
+// single lock, so operations spanning collections, such as renaming a post, stay consistent without transactions.
+type MemoryStore struct {
+	mu sync.RWMutex
+
+	adminAccessTokens   []adminAccessTokenDocument
+	adminAuditLogs      []domain.AdminAuditLogRecord
+	adminAvatars        map[string]domain.AdminAvatarRecord
+	adminLoginAttempts  map[string]adminLoginAttemptDocument
+	adminPasskeys       []adminPasskeyDocument
+	adminRefreshTokens  memoryRefreshTokenTable
+	adminUsers          []domain.AdminUserRecord
+	loginHistory        []domain.LoginHistoryRecord
+	oidcIdentities      []domain.OIDCIdentityRecord
+	readerPostLikes     []domain.ReaderPostLikeRecord
+	readerRefreshTokens memoryRefreshTokenTable
+	readerUsers         []domain.ReaderUserRecord
+
+	categories    []domain.AdminContentCategoryRecord
+	comments      []domain.CommentRecord
+	postHits      map[string]int64
+	postIDAliases []postIDAliasDocument
+	postLikes     map[string]int64
+	postRelated   []domain.PostRelatedRecord
+	postRevisions []adminContentPostRevisionDocument
+	postSeries    []postSeriesDocument
+	posts         []memoryPost
+	topics        []domain.AdminContentTopicRecord
+
+	mediaAssets         []adminMediaAssetDocument
+	mediaUploadChunks   []adminMediaUploadChunkDocument
+	mediaUploadSessions []adminMediaUploadSessionDocument
+	mediaVariants       []adminMediaAssetVariantDocument
+
+	errorMessages         []domain.ErrorMessageRecord
+	newsletterCampaigns   []domain.AdminNewsletterCampaignRecord
+	newsletterDeliveries  []domain.AdminNewsletterDeliveryFailureRecord
+	newsletterSubscribers []memoryNewsletterSubscriber
+	rateLimits            map[string][]time.Time
+}
+
+// NewMemoryStore returns an empty store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		adminAvatars:       make(map[string]domain.AdminAvatarRecord),
+		adminLoginAttempts: make(map[string]adminLoginAttemptDocument),
+		postHits:           make(map[string]int64),
+		postLikes:          make(map[string]int64),
+		rateLimits:         make(map[string][]time.Time),
+	}
+}
+
+func cloneAdminAvatarRecord(record domain.AdminAvatarRecord) domain.AdminAvatarRecord {
+	record.Source.Data = slices.Clone(record.Source.Data)
+	variants := make([]domain.AdminAvatarVariant, 0, len(record.Variants))
+	for _, variant := range record.Variants {
+		variant.Data = slices.Clone(variant.Data)
+		variants = append(variants, variant)
+	}
+	record.Variants = variants
+	return record
+}
+
+// cloneMemoryValue copies the value behind an optional field so callers never share it with the store.
+func cloneMemoryValue[T any](value *T) *T {
+	if value == nil {
+		return nil
+	}
+
+	cloned := *value
+	return &cloned
+}
+
+// memoryContainsFold reports whether value contains query regardless of case, like an escaped case-insensitive
+// Mongo regex.
+func memoryContainsFold(value, query string) bool {
+	return strings.Contains(strings.ToLower(value), strings.ToLower(query))
+}
+
+// memoryPage returns the items of the 1-based page, like a skip and limit on a sorted Mongo cursor.
+func memoryPage[T any](items []T, page, size int) []T {
+	return memoryWindow(items, int64((page-1)*size), size)
+}
+
+// memoryWindow returns at most size items after skipping the first ones.
+func memoryWindow[T any](items []T, skip int64, size int) []T {
+	if skip < 0 || size <= 0 || skip >= int64(len(items)) {
+		return make([]T, 0)
+	}
+
+	start := int(skip)
+	return slices.Clone(items[start:min(start+size, len(items))])
+}
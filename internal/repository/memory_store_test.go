@@ -0,0 +1,224 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func newSeededMemoryStore(t *testing.T) *MemoryStore {
+	t.Helper()
+
+	contentDir := t.TempDir()
+	files := map[string]string{
+		"en/alpha-post.md": "---\ntitle: 'Alpha'\npublishedDate: '2026-03-01'\nsummary: 'First post'\nreadingTime: '3 min read'\n" +
+			"category:\n  id: 'programming'\n  name: 'Programming'\n  color: 'blue'\n  icon: 'code'\n" +
+			"topics:\n  - id: 'go'\n    name: 'Go'\n    color: 'cyan'\n---\n\n# Alpha\r\n",
+		"en/beta-post.md": "---\ntitle: 'Beta'\npublishedDate: '2026-03-02'\nsummary: 'Second post'\n---\nBeta body\n",
+		"tr/alpha-post.md": "---\ntitle: 'Alfa'\npublishedDate: '2026-03-01'\nsummary: 'İlk yazı'\n" +
+			"topics:\n  - id: 'go'\n    name: 'Go'\n---\nAlfa\n",
+		"en/Invalid_Name.md": "---\ntitle: 'Skipped'\n---\n",
+	}
+	for name, body := range files {
+		path := filepath.Join(contentDir, "posts", filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("MkdirAll() error = %v", err)
+		}
+		if err := os.WriteFile(path, []byte(body), 0o644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+
+	store := NewMemoryStore()
+	if err := SeedMemoryStore(store, contentDir); err != nil {
+		t.Fatalf("SeedMemoryStore() error = %v", err)
+	}
+	return store
+}
+
+func TestSeedMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	store := newSeededMemoryStore(t)
+	posts := NewPostMemoryRepository(store)
+	content := NewAdminContentMemoryRepository(store)
+
+	total, err := posts.CountPosts(ctx, bson.M{"locale": "en"})
+	if err != nil || total != 2 {
+		t.Fatalf("CountPosts() = %d, %v", total, err)
+	}
+
+	records, err := posts.FindPosts(ctx, bson.M{"locale": "en", "topicIds": "go"}, "desc", 0, 10)
+	if err != nil || len(records) != 1 {
+		t.Fatalf("FindPosts() = %#v, %v", records, err)
+	}
+	post := records[0]
+	if post.ID != "alpha-post" || post.ReadingTimeMin != 3 || post.Source != "blog" || post.Category == nil ||
+		post.Category.Icon != "code" || post.SearchText != "alpha first post go" ||
+		!post.PublishedAt.Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("seeded post = %#v", post)
+	}
+
+	adminPost, err := content.FindPostByLocaleAndID(ctx, "en", "alpha-post")
+	if err != nil || adminPost == nil || adminPost.Content != "# Alpha" || adminPost.ContentMode != "markdown" {
+		t.Fatalf("FindPostByLocaleAndID() = %#v, %v", adminPost, err)
+	}
+
+	topics, err := content.ListTopics(ctx, "tr", "")
+	if err != nil || len(topics) != 1 || topics[0].ID != "go" || topics[0].Color != "blue" {
+		t.Fatalf("ListTopics() = %#v, %v", topics, err)
+	}
+	categories, err := content.ListCategories(ctx, "en")
+	if err != nil || len(categories) != 1 || categories[0].Name != "Programming" {
+		t.Fatalf("ListCategories() = %#v, %v", categories, err)
+	}
+
+	if err := SeedMemoryStore(NewMemoryStore(), t.TempDir()); err != nil {
+		t.Fatalf("SeedMemoryStore() without posts error = %v", err)
+	}
+}
+
+func TestAdminContentMemoryRepositoryRenamePost(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 4, 1, 12, 0, 0, 0, time.UTC)
+	store := newSeededMemoryStore(t)
+	posts := NewPostMemoryRepository(store)
+	content := NewAdminContentMemoryRepository(store)
+
+	likes, err := posts.IncrementPostLike(ctx, "alpha-post", now)
+	if err != nil {
+		t.Fatalf("IncrementPostLike() error = %v", err)
+	}
+	source, err := content.FindPostByLocaleAndID(ctx, "en", "alpha-post")
+	if err != nil || source == nil {
+		t.Fatalf("FindPostByLocaleAndID() = %#v, %v", source, err)
+	}
+	if _, err := content.CreatePostRevision(ctx, *source, 1, now); err != nil {
+		t.Fatalf("CreatePostRevision() error = %v", err)
+	}
+
+	if _, err := content.RenamePost(ctx, "alpha-post", "beta-post", now); err == nil {
+		t.Fatal("expected RenamePost() onto an existing post to fail")
+	}
+	if _, err := content.RenamePost(ctx, "missing-post", "delta-post", now); !errors.Is(err, ErrAdminContentPostNotFound) {
+		t.Fatalf("RenamePost() of a missing post error = %v", err)
+	}
+
+	result, err := content.RenamePost(ctx, "alpha-post", "gamma-post", now)
+	if err != nil {
+		t.Fatalf("RenamePost() error = %v", err)
+	}
+	if result.PostsUpdated != 2 || result.RevisionsUpdated != 1 {
+		t.Fatalf("RenamePost() result = %#v", result)
+	}
+
+	if record, _ := content.FindPostByLocaleAndID(ctx, "tr", "alpha-post"); record != nil {
+		t.Fatalf("expected the source post to be gone, got %#v", record)
+	}
+	if aliasTarget, err := posts.FindPostIDAlias(ctx, "alpha-post"); err != nil || aliasTarget != "gamma-post" {
+		t.Fatalf("FindPostIDAlias() = %q, %v", aliasTarget, err)
+	}
+	moved := posts.ResolveLikesByPostID(ctx, []domain.PostRecord{{ID: "gamma-post"}})
+	if moved["gamma-post"] != likes {
+		t.Fatalf("ResolveLikesByPostID() = %#v, want %d", moved, likes)
+	}
+	revisions, err := content.ListPostRevisions(ctx, "en", "gamma-post", 1, 10)
+	if err != nil || revisions.Total != 1 {
+		t.Fatalf("ListPostRevisions() = %#v, %v", revisions, err)
+	}
+}
+
+func TestAdminContentMemoryRepositoryDeletePostPrunesSeriesAndRelated(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 4, 1, 12, 0, 0, 0, time.UTC)
+	store := newSeededMemoryStore(t)
+	posts := NewPostMemoryRepository(store)
+	content := NewAdminContentMemoryRepository(store)
+
+	for _, locale := range []string{"en", "tr"} {
+		if _, err := content.UpsertSeries(ctx, domain.AdminContentSeriesRecord{
+			Locale:  locale,
+			ID:      "go-series",
+			Name:    "Go Series",
+			PostIDs: []string{"alpha-post", "beta-post"},
+		}, now); err != nil {
+			t.Fatalf("UpsertSeries(%s) error = %v", locale, err)
+		}
+	}
+	if err := posts.ReplaceRelatedPosts(ctx, "en", []domain.PostRelatedRecord{
+		{PostID: "alpha-post", Items: []domain.PostRelatedItem{{PostID: "beta-post", Score: 1}}},
+		{PostID: "beta-post", Items: []domain.PostRelatedItem{{PostID: "alpha-post", Score: 1}}},
+	}); err != nil {
+		t.Fatalf("ReplaceRelatedPosts() error = %v", err)
+	}
+
+	deleted, err := content.DeletePostByLocaleAndID(ctx, "en", "alpha-post")
+	if err != nil || !deleted {
+		t.Fatalf("DeletePostByLocaleAndID() = %v, %v", deleted, err)
+	}
+
+	series, err := content.FindSeriesByLocaleAndID(ctx, "en", "go-series")
+	if err != nil || series == nil || len(series.PostIDs) != 1 || series.PostIDs[0] != "beta-post" {
+		t.Fatalf("FindSeriesByLocaleAndID(en) = %#v, %v", series, err)
+	}
+	otherLocale, err := content.FindSeriesByLocaleAndID(ctx, "tr", "go-series")
+	if err != nil || otherLocale == nil || len(otherLocale.PostIDs) != 2 {
+		t.Fatalf("FindSeriesByLocaleAndID(tr) = %#v, %v", otherLocale, err)
+	}
+	if related, err := posts.FindRelatedPosts(ctx, "en", "alpha-post"); err != nil || related != nil {
+		t.Fatalf("FindRelatedPosts(alpha-post) = %#v, %v", related, err)
+	}
+	related, err := posts.FindRelatedPosts(ctx, "en", "beta-post")
+	if err != nil || related == nil || len(related.Items) != 0 {
+		t.Fatalf("FindRelatedPosts(beta-post) = %#v, %v", related, err)
+	}
+}
+
+func TestAdminUserMemoryRepositoryIsConcurrencySafe(t *testing.T) {
+	ctx := context.Background()
+	repo := NewAdminUserMemoryRepository(NewMemoryStore())
+
+	var wg sync.WaitGroup
+	for index := range 16 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			email := fmt.Sprintf("admin-%d@example.com", index)
+			if err := repo.Create(ctx, domain.AdminUserRecord{AdminUser: domain.AdminUser{
+				ID:    fmt.Sprintf("admin-%d", index),
+				Name:  "Admin",
+				Email: email,
+			}}); err != nil {
+				t.Errorf("Create() error = %v", err)
+			}
+			if _, err := repo.FindByEmail(ctx, email); err != nil {
+				t.Errorf("FindByEmail() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	records, err := repo.List(ctx)
+	if err != nil || len(records) != 16 {
+		t.Fatalf("List() = %d records, %v", len(records), err)
+	}
+
+	records[0].Roles = append(records[0].Roles, "mutated")
+	stored, err := repo.FindByID(ctx, records[0].ID)
+	if err != nil || stored == nil || len(stored.Roles) == len(records[0].Roles) {
+		t.Fatalf("expected List() to return copies, got %#v, %v", stored, err)
+	}
+
+	err = repo.Create(ctx, domain.AdminUserRecord{AdminUser: domain.AdminUser{ID: "duplicate", Email: "admin-0@example.com"}})
+	if !errors.Is(err, ErrAdminEmailAlreadyExists) {
+		t.Fatalf("duplicate Create() error = %v", err)
+	}
+}
@@ -0,0 +1,9 @@
+package repository
+
+import "errors"
+
+// MongoAppName identifies the API's connections in MongoDB logs and metrics.
+const MongoAppName = "blog-api"
+
+// ErrMongoClientUnavailable is returned by a Mongo repository that was built without a client.
+var ErrMongoClientUnavailable = errors.New("mongodb client is not configured")
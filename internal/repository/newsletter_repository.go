@@ -39,46 +39,17 @@ type NewsletterRepository interface {
 	UnsubscribeByEmail(ctx context.Context, email string, now time.Time) error
 }
 
-type newsletterMongoRepository struct{}
+type newsletterMongoRepository struct {
+	client *mongo.Client
+}
 
 var (
-	newsletterMongoClient     *mongo.Client
-	newsletterMongoInitErr    error
-	newsletterMongoClientOnce sync.Once
-
 	newsletterIndexesOnce sync.Once
 	newsletterIndexesErr  error
 )
 
-func NewNewsletterMongoRepository() NewsletterRepository {
-	return &newsletterMongoRepository{}
-}
-
-func getNewsletterMongoClient() (*mongo.Client, error) {
-	newsletterMongoClientOnce.Do(func() {
-		databaseConfig, err := appconfig.ResolveDatabaseConfig()
-		if err != nil {
-			newsletterMongoInitErr = err
-			return
-		}
-
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
-
-		client, err := appconfig.NewMongoClient(ctx, databaseConfig, "blog-api-newsletter")
-		if err != nil {
-			newsletterMongoInitErr = fmt.Errorf("mongodb connect failed: %w", err)
-			return
-		}
-
-		newsletterMongoClient = client
-	})
-
-	if newsletterMongoInitErr != nil {
-		return nil, newsletterMongoInitErr
-	}
-
-	return newsletterMongoClient, nil
+func NewNewsletterMongoRepository(client *mongo.Client) NewsletterRepository {
+	return &newsletterMongoRepository{client: client}
 }
 
 func ensureNewsletterSubscriberIndexes(collection *mongo.Collection) error {
@@ -119,15 +90,14 @@ func ensureNewsletterSubscriberIndexes(collection *mongo.Collection) error {
 	return newsletterIndexesErr
 }
 
-func getNewsletterCollection() (*mongo.Collection, error) {
+func getNewsletterCollection(client *mongo.Client) (*mongo.Collection, error) {
 	databaseConfig, err := appconfig.ResolveDatabaseConfig()
 	if err != nil {
 		return nil, err
 	}
 
-	client, err := getNewsletterMongoClient()
-	if err != nil {
-		return nil, err
+	if client == nil {
+		return nil, ErrMongoClientUnavailable
 	}
 
 	collection := client.Database(databaseConfig.Name).Collection(newsletterCollectionName)
@@ -138,8 +108,8 @@ func getNewsletterCollection() (*mongo.Collection, error) {
 	return collection, nil
 }
 
-func (*newsletterMongoRepository) GetStatusByEmail(ctx context.Context, email string) (string, bool, error) {
-	collection, err := getNewsletterCollection()
+func (repo *newsletterMongoRepository) GetStatusByEmail(ctx context.Context, email string) (string, bool, error) {
+	collection, err := getNewsletterCollection(repo.client)
 	if err != nil {
 		return "", false, fmt.Errorf(newsletterRepositoryUnavailableFormat, ErrNewsletterRepositoryUnavailable, err)
 	}
@@ -162,8 +132,8 @@ func getStatusByEmailFromCollection(ctx context.Context, collection newsletterSi
 	return existing.Status, true, nil
 }
 
-func (*newsletterMongoRepository) UpsertPendingSubscription(ctx context.Context, input NewsletterPendingSubscription) error {
-	collection, err := getNewsletterCollection()
+func (repo *newsletterMongoRepository) UpsertPendingSubscription(ctx context.Context, input NewsletterPendingSubscription) error {
+	collection, err := getNewsletterCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(newsletterRepositoryUnavailableFormat, ErrNewsletterRepositoryUnavailable, err)
 	}
@@ -204,8 +174,8 @@ func upsertPendingSubscriptionInCollection(
 	return err
 }
 
-func (*newsletterMongoRepository) UpdatePendingSubscription(ctx context.Context, input NewsletterPendingSubscription) error {
-	collection, err := getNewsletterCollection()
+func (repo *newsletterMongoRepository) UpdatePendingSubscription(ctx context.Context, input NewsletterPendingSubscription) error {
+	collection, err := getNewsletterCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(newsletterRepositoryUnavailableFormat, ErrNewsletterRepositoryUnavailable, err)
 	}
@@ -235,8 +205,8 @@ func updatePendingSubscriptionInCollection(
 	return err
 }
 
-func (*newsletterMongoRepository) ConfirmByTokenHash(ctx context.Context, tokenHash string, now time.Time) (bool, error) {
-	collection, err := getNewsletterCollection()
+func (repo *newsletterMongoRepository) ConfirmByTokenHash(ctx context.Context, tokenHash string, now time.Time) (bool, error) {
+	collection, err := getNewsletterCollection(repo.client)
 	if err != nil {
 		return false, fmt.Errorf(newsletterRepositoryUnavailableFormat, ErrNewsletterRepositoryUnavailable, err)
 	}
@@ -276,8 +246,8 @@ func confirmByTokenHashInCollection(
 	return result.MatchedCount > 0, nil
 }
 
-func (*newsletterMongoRepository) UnsubscribeByEmail(ctx context.Context, email string, now time.Time) error {
-	collection, err := getNewsletterCollection()
+func (repo *newsletterMongoRepository) UnsubscribeByEmail(ctx context.Context, email string, now time.Time) error {
+	collection, err := getNewsletterCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(newsletterRepositoryUnavailableFormat, ErrNewsletterRepositoryUnavailable, err)
 	}
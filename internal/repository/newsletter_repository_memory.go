@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+// memoryNewsletterSubscriber mirrors one document of the newsletter subscriber collection.
+type memoryNewsletterSubscriber struct {
+	Email                 string
+	Locale                string
+	Status                string
+	Tags                  []string
+	FormName              string
+	Source                string
+	IPHash                string
+	UserAgent             string
+	ConfirmTokenHash      string
+	ConfirmTokenExpiresAt time.Time
+	ConfirmRequestedAt    time.Time
+	ConfirmedAt           *time.Time
+	UnsubscribedAt        *time.Time
+	UpdatedAt             time.Time
+	CreatedAt             time.Time
+}
+
+func (subscriber *memoryNewsletterSubscriber) clearConfirmation() {
+	subscriber.ConfirmTokenHash = ""
+	subscriber.ConfirmTokenExpiresAt = time.Time{}
+	subscriber.ConfirmRequestedAt = time.Time{}
+}
+
+type newsletterMemoryRepository struct {
+	store *MemoryStore
+}
+
+// NewNewsletterMemoryRepository returns a newsletter subscriber repository backed by the given in-memory store.
+func NewNewsletterMemoryRepository(store *MemoryStore) NewsletterRepository {
+	return &newsletterMemoryRepository{store: store}
+}
+
+func (r *newsletterMemoryRepository) GetStatusByEmail(_ context.Context, email string) (string, bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	subscriber := r.store.findNewsletterSubscriber(email)
+	if subscriber == nil {
+		return "", false, nil
+	}
+
+	return subscriber.Status, true, nil
+}
+
+func (r *newsletterMemoryRepository) UpsertPendingSubscription(_ context.Context, input NewsletterPendingSubscription) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	subscriber := r.store.findNewsletterSubscriber(input.Email)
+	if subscriber == nil {
+		r.store.newsletterSubscribers = append(r.store.newsletterSubscribers, memoryNewsletterSubscriber{Email: input.Email})
+		subscriber = &r.store.newsletterSubscribers[len(r.store.newsletterSubscribers)-1]
+		if input.CreatedAt != nil {
+			subscriber.CreatedAt = *input.CreatedAt
+		}
+	}
+
+	subscriber.Tags = slices.Clone(input.Tags)
+	subscriber.FormName = input.FormName
+	subscriber.Source = input.Source
+	applyNewsletterPendingSubscription(subscriber, input)
+	return nil
+}
+
+func (r *newsletterMemoryRepository) UpdatePendingSubscription(_ context.Context, input NewsletterPendingSubscription) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if subscriber := r.store.findNewsletterSubscriber(input.Email); subscriber != nil {
+		applyNewsletterPendingSubscription(subscriber, input)
+	}
+	return nil
+}
+
+func (r *newsletterMemoryRepository) ConfirmByTokenHash(_ context.Context, tokenHash string, now time.Time) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for index := range r.store.newsletterSubscribers {
+		subscriber := &r.store.newsletterSubscribers[index]
+		if subscriber.ConfirmTokenHash != tokenHash || subscriber.Status != "pending" ||
+			!subscriber.ConfirmTokenExpiresAt.After(now) {
+			continue
+		}
+
+		subscriber.Status = "active"
+		subscriber.ConfirmedAt = &now
+		subscriber.UpdatedAt = now
+		subscriber.clearConfirmation()
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (r *newsletterMemoryRepository) UnsubscribeByEmail(_ context.Context, email string, now time.Time) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if subscriber := r.store.findNewsletterSubscriber(email); subscriber != nil {
+		subscriber.Status = "unsubscribed"
+		subscriber.UpdatedAt = now
+		subscriber.UnsubscribedAt = &now
+		subscriber.clearConfirmation()
+	}
+	return nil
+}
+
+func applyNewsletterPendingSubscription(subscriber *memoryNewsletterSubscriber, input NewsletterPendingSubscription) {
+	subscriber.Locale = input.Locale
+	subscriber.Status = "pending"
+	subscriber.UpdatedAt = input.UpdatedAt
+	subscriber.IPHash = input.IPHash
+	subscriber.UserAgent = input.UserAgent
+	subscriber.ConfirmTokenHash = input.ConfirmTokenHash
+	subscriber.ConfirmTokenExpiresAt = input.ConfirmTokenExpiresAt
+	subscriber.ConfirmRequestedAt = input.ConfirmRequestedAt
+}
+
+// findNewsletterSubscriber returns the stored subscriber with exactly the given email. The caller must hold the lock.
+func (s *MemoryStore) findNewsletterSubscriber(email string) *memoryNewsletterSubscriber {
+	for index := range s.newsletterSubscribers {
+		if s.newsletterSubscribers[index].Email == email {
+			return &s.newsletterSubscribers[index]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,267 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type OIDCIdentityRepository interface {
+	FindBySubject(ctx context.Context, accountType, provider, subject string) (*domain.OIDCIdentityRecord, error)
+	ListByUserID(ctx context.Context, accountType, userID string) ([]domain.OIDCIdentityRecord, error)
+	HasAnyByProvider(ctx context.Context, accountType, provider string) (bool, error)
+	Link(ctx context.Context, record domain.OIDCIdentityRecord) error
+	Unlink(ctx context.Context, accountType, userID, provider string) (bool, error)
+	DeleteByUserID(ctx context.Context, accountType, userID string) error
+}
+
+var (
+	ErrOIDCIdentityRepositoryUnavailable = errors.New("oidc identity repository unavailable")
+	ErrOIDCIdentityAlreadyLinked         = errors.New("oidc identity is already linked to another account")
+)
+
+const (
+	oidcIdentitiesCollectionName            = "oidc_identities"
+	oidcIdentityRepositoryUnavailableFormat = "%w: %v"
+	maxOIDCIdentitiesPerUser                = 50
+)
+
+type oidcIdentityMongoRepository struct {
+	client *mongo.Client
+}
+
+type oidcIdentityDocument struct {
+	AccountType string    `bson:"accountType"`
+	UserID      string    `bson:"userId"`
+	Provider    string    `bson:"provider"`
+	Subject     string    `bson:"subject"`
+	Email       string    `bson:"email,omitempty"`
+	LinkedAt    time.Time `bson:"linkedAt"`
+}
+
+var (
+	oidcIdentityIndexesOnce sync.Once
+	oidcIdentityIndexesErr  error
+)
+
+func NewOIDCIdentityRepository(client *mongo.Client) OIDCIdentityRepository {
+	return &oidcIdentityMongoRepository{client: client}
+}
+
+func (repo *oidcIdentityMongoRepository) FindBySubject(
+	ctx context.Context,
+	accountType, provider, subject string,
+) (*domain.OIDCIdentityRecord, error) {
+	collection, err := getOIDCIdentitiesCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(oidcIdentityRepositoryUnavailableFormat, ErrOIDCIdentityRepositoryUnavailable, err)
+	}
+
+	var document oidcIdentityDocument
+	err = collection.FindOne(ctx, bson.M{
+		"accountType": strings.TrimSpace(accountType),
+		"provider":    strings.TrimSpace(provider),
+		"subject":     strings.TrimSpace(subject),
+	}).Decode(&document)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	record := mapOIDCIdentityDocument(document)
+	return &record, nil
+}
+
+func (repo *oidcIdentityMongoRepository) ListByUserID(
+	ctx context.Context,
+	accountType, userID string,
+) ([]domain.OIDCIdentityRecord, error) {
+	collection, err := getOIDCIdentitiesCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(oidcIdentityRepositoryUnavailableFormat, ErrOIDCIdentityRepositoryUnavailable, err)
+	}
+
+	cursor, err := collection.Find(
+		ctx,
+		bson.M{"accountType": strings.TrimSpace(accountType), "userId": strings.TrimSpace(userID)},
+		options.Find().SetSort(bson.D{{Key: "provider", Value: 1}}).SetLimit(maxOIDCIdentitiesPerUser),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	records := make([]domain.OIDCIdentityRecord, 0)
+	for cursor.Next(ctx) {
+		var document oidcIdentityDocument
+		if err := cursor.Decode(&document); err != nil {
+			return nil, err
+		}
+		records = append(records, mapOIDCIdentityDocument(document))
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+func (repo *oidcIdentityMongoRepository) HasAnyByProvider(ctx context.Context, accountType, provider string) (bool, error) {
+	collection, err := getOIDCIdentitiesCollection(repo.client)
+	if err != nil {
+		return false, fmt.Errorf(oidcIdentityRepositoryUnavailableFormat, ErrOIDCIdentityRepositoryUnavailable, err)
+	}
+
+	count, err := collection.CountDocuments(
+		ctx,
+		bson.M{"accountType": strings.TrimSpace(accountType), "provider": strings.TrimSpace(provider)},
+		options.Count().SetLimit(1),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// Link stores the identity, replacing the account's previous identity at the same provider. It returns
+// ErrOIDCIdentityAlreadyLinked when the subject already belongs to another account.
+func (repo *oidcIdentityMongoRepository) Link(ctx context.Context, record domain.OIDCIdentityRecord) error {
+	collection, err := getOIDCIdentitiesCollection(repo.client)
+	if err != nil {
+		return fmt.Errorf(oidcIdentityRepositoryUnavailableFormat, ErrOIDCIdentityRepositoryUnavailable, err)
+	}
+
+	filter := bson.M{
+		"accountType": strings.TrimSpace(record.AccountType),
+		"userId":      strings.TrimSpace(record.UserID),
+		"provider":    strings.TrimSpace(record.Provider),
+	}
+	_, err = collection.UpdateOne(
+		ctx,
+		filter,
+		bson.M{
+			"$set": bson.M{
+				"subject":  strings.TrimSpace(record.Subject),
+				"email":    strings.TrimSpace(strings.ToLower(record.Email)),
+				"linkedAt": record.LinkedAt.UTC(),
+			},
+		},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrOIDCIdentityAlreadyLinked
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (repo *oidcIdentityMongoRepository) Unlink(ctx context.Context, accountType, userID, provider string) (bool, error) {
+	collection, err := getOIDCIdentitiesCollection(repo.client)
+	if err != nil {
+		return false, fmt.Errorf(oidcIdentityRepositoryUnavailableFormat, ErrOIDCIdentityRepositoryUnavailable, err)
+	}
+
+	result, err := collection.DeleteOne(ctx, bson.M{
+		"accountType": strings.TrimSpace(accountType),
+		"userId":      strings.TrimSpace(userID),
+		"provider":    strings.TrimSpace(provider),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return result.DeletedCount > 0, nil
+}
+
+func (repo *oidcIdentityMongoRepository) DeleteByUserID(ctx context.Context, accountType, userID string) error {
+	collection, err := getOIDCIdentitiesCollection(repo.client)
+	if err != nil {
+		return fmt.Errorf(oidcIdentityRepositoryUnavailableFormat, ErrOIDCIdentityRepositoryUnavailable, err)
+	}
+
+	_, err = collection.DeleteMany(ctx, bson.M{
+		"accountType": strings.TrimSpace(accountType),
+		"userId":      strings.TrimSpace(userID),
+	})
+	return err
+}
+
+func mapOIDCIdentityDocument(document oidcIdentityDocument) domain.OIDCIdentityRecord {
+	return domain.OIDCIdentityRecord{
+		AccountType: strings.TrimSpace(document.AccountType),
+		UserID:      strings.TrimSpace(document.UserID),
+		Provider:    strings.TrimSpace(document.Provider),
+		Subject:     strings.TrimSpace(document.Subject),
+		Email:       strings.TrimSpace(document.Email),
+		LinkedAt:    document.LinkedAt,
+	}
+}
+
+func getOIDCIdentitiesCollection(client *mongo.Client) (*mongo.Collection, error) {
+	databaseConfig, err := appconfig.ResolveDatabaseConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	if client == nil {
+		return nil, ErrMongoClientUnavailable
+	}
+
+	collection := client.Database(databaseConfig.Name).Collection(oidcIdentitiesCollectionName)
+	if err := ensureOIDCIdentityIndexes(collection); err != nil {
+		return nil, err
+	}
+
+	return collection, nil
+}
+
+func ensureOIDCIdentityIndexes(collection *mongo.Collection) error {
+	oidcIdentityIndexesOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		indexes := []mongo.IndexModel{
+			{
+				Keys: bson.D{
+					{Key: "accountType", Value: 1},
+					{Key: "provider", Value: 1},
+					{Key: "subject", Value: 1},
+				},
+				Options: options.Index().SetUnique(true).SetName("uniq_oidc_identity_subject"),
+			},
+			{
+				Keys: bson.D{
+					{Key: "accountType", Value: 1},
+					{Key: "userId", Value: 1},
+					{Key: "provider", Value: 1},
+				},
+				Options: options.Index().SetUnique(true).SetName("uniq_oidc_identity_user_provider"),
+			},
+		}
+
+		if _, err := collection.Indexes().CreateMany(ctx, indexes); err != nil {
+			oidcIdentityIndexesErr = fmt.Errorf("create oidc identity index failed: %w", err)
+		}
+	})
+
+	return oidcIdentityIndexesErr
+}
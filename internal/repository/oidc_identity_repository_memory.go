@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+)
+
+type oidcIdentityMemoryRepository struct {
+	store *MemoryStore
+}
+
+// NewOIDCIdentityMemoryRepository returns an OpenID Connect identity repository backed by the given in-memory store.
+func NewOIDCIdentityMemoryRepository(store *MemoryStore) OIDCIdentityRepository {
+	return &oidcIdentityMemoryRepository{store: store}
+}
+
+func (r *oidcIdentityMemoryRepository) FindBySubject(
+	_ context.Context,
+	accountType, provider, subject string,
+) (*domain.OIDCIdentityRecord, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	resolvedAccountType := strings.TrimSpace(accountType)
+	resolvedProvider := strings.TrimSpace(provider)
+	resolvedSubject := strings.TrimSpace(subject)
+	for _, record := range r.store.oidcIdentities {
+		if record.AccountType == resolvedAccountType && record.Provider == resolvedProvider &&
+			record.Subject == resolvedSubject {
+			return &record, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *oidcIdentityMemoryRepository) ListByUserID(
+	_ context.Context,
+	accountType, userID string,
+) ([]domain.OIDCIdentityRecord, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	resolvedAccountType := strings.TrimSpace(accountType)
+	resolvedUserID := strings.TrimSpace(userID)
+	records := make([]domain.OIDCIdentityRecord, 0)
+	for _, record := range r.store.oidcIdentities {
+		if record.AccountType == resolvedAccountType && record.UserID == resolvedUserID {
+			records = append(records, record)
+		}
+	}
+
+	slices.SortStableFunc(records, func(left, right domain.OIDCIdentityRecord) int {
+		return strings.Compare(left.Provider, right.Provider)
+	})
+	if len(records) > maxOIDCIdentitiesPerUser {
+		records = records[:maxOIDCIdentitiesPerUser]
+	}
+	return records, nil
+}
+
+func (r *oidcIdentityMemoryRepository) HasAnyByProvider(_ context.Context, accountType, provider string) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	resolvedAccountType := strings.TrimSpace(accountType)
+	resolvedProvider := strings.TrimSpace(provider)
+	return slices.ContainsFunc(r.store.oidcIdentities, func(record domain.OIDCIdentityRecord) bool {
+		return record.AccountType == resolvedAccountType && record.Provider == resolvedProvider
+	}), nil
+}
+
+// Link stores the identity, replacing the account's previous identity at the same provider. It returns
+// ErrOIDCIdentityAlreadyLinked when the subject already belongs to another account.
+func (r *oidcIdentityMemoryRepository) Link(_ context.Context, record domain.OIDCIdentityRecord) error {
+	resolved := domain.OIDCIdentityRecord{
+		AccountType: strings.TrimSpace(record.AccountType),
+		UserID:      strings.TrimSpace(record.UserID),
+		Provider:    strings.TrimSpace(record.Provider),
+		Subject:     strings.TrimSpace(record.Subject),
+		Email:       strings.TrimSpace(strings.ToLower(record.Email)),
+		LinkedAt:    record.LinkedAt.UTC(),
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	existing := -1
+	for index, other := range r.store.oidcIdentities {
+		if other.AccountType != resolved.AccountType || other.Provider != resolved.Provider {
+			continue
+		}
+		if other.UserID == resolved.UserID {
+			existing = index
+			continue
+		}
+		if other.Subject == resolved.Subject {
+			return ErrOIDCIdentityAlreadyLinked
+		}
+	}
+
+	if existing >= 0 {
+		r.store.oidcIdentities[existing] = resolved
+		return nil
+	}
+
+	r.store.oidcIdentities = append(r.store.oidcIdentities, resolved)
+	return nil
+}
+
+func (r *oidcIdentityMemoryRepository) Unlink(_ context.Context, accountType, userID, provider string) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	resolvedAccountType := strings.TrimSpace(accountType)
+	resolvedUserID := strings.TrimSpace(userID)
+	resolvedProvider := strings.TrimSpace(provider)
+	for index, record := range r.store.oidcIdentities {
+		if record.AccountType == resolvedAccountType && record.UserID == resolvedUserID &&
+			record.Provider == resolvedProvider {
+			r.store.oidcIdentities = slices.Delete(r.store.oidcIdentities, index, index+1)
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (r *oidcIdentityMemoryRepository) DeleteByUserID(_ context.Context, accountType, userID string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	resolvedAccountType := strings.TrimSpace(accountType)
+	resolvedUserID := strings.TrimSpace(userID)
+	r.store.oidcIdentities = slices.DeleteFunc(r.store.oidcIdentities, func(record domain.OIDCIdentityRecord) bool {
+		return record.AccountType == resolvedAccountType && record.UserID == resolvedUserID
+	})
+	return nil
+}
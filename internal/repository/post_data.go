@@ -24,8 +24,14 @@ const (
 	postsCollectionName         = "newsletter_posts"
 	topicsCollectionName        = "newsletter_topics"
 	categoriesCollectionName    = "newsletter_categories"
+	seriesCollectionName        = "newsletter_series"
 	mediaAssetsCollectionName   = "admin_media_assets"
+	mediaVariantsCollectionName = "admin_media_asset_variants"
+	mediaUploadsCollectionName  = "admin_media_upload_sessions"
+	mediaChunksCollectionName   = "admin_media_upload_chunks"
 	postRevisionsCollectionName = "admin_content_post_revisions"
+	postRelatedCollectionName   = "post_related_posts"
+	postIDAliasesCollectionName = "post_id_aliases"
 	maxBatchPostIDs             = 60
 	maxScopePostIDs             = 5000
 	defaultPageSize             = 20
@@ -35,10 +41,6 @@ const (
 var (
 	postIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,127}$`)
 
-	postMongoClient  *mongo.Client
-	postMongoInitErr error
-	postMongoOnce    sync.Once
-
 	postLikesIndexesOnce sync.Once
 	postLikesIndexesErr  error
 
@@ -54,11 +56,26 @@ var (
 	postCategoryIndexesOnce sync.Once
 	postCategoryIndexesErr  error
 
+	postSeriesIndexesOnce sync.Once
+	postSeriesIndexesErr  error
+
 	postMediaAssetIndexesOnce sync.Once
 	postMediaAssetIndexesErr  error
 
+	postMediaVariantIndexesOnce sync.Once
+	postMediaVariantIndexesErr  error
+
+	postMediaUploadIndexesOnce sync.Once
+	postMediaUploadIndexesErr  error
+
 	postRevisionIndexesOnce sync.Once
 	postRevisionIndexesErr  error
+
+	postRelatedIndexesOnce sync.Once
+	postRelatedIndexesErr  error
+
+	postIDAliasIndexesOnce sync.Once
+	postIDAliasIndexesErr  error
 )
 
 type (
@@ -109,42 +126,14 @@ func computeInitialHits(postID string) int64 {
 	return max(hits, 700)
 }
 
-func getPostMongoClient() (*mongo.Client, error) {
-	postMongoOnce.Do(func() {
-		databaseConfig, err := appconfig.ResolveDatabaseConfig()
-		if err != nil {
-			postMongoInitErr = err
-			return
-		}
-
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
-
-		client, err := appconfig.NewMongoClient(ctx, databaseConfig, "blog-api-posts")
-		if err != nil {
-			postMongoInitErr = fmt.Errorf("mongodb connect failed: %w", err)
-			return
-		}
-
-		postMongoClient = client
-	})
-
-	if postMongoInitErr != nil {
-		return nil, postMongoInitErr
-	}
-
-	return postMongoClient, nil
-}
-
-func getPostCollection(name string) (*mongo.Collection, error) {
+func getPostCollection(client *mongo.Client, name string) (*mongo.Collection, error) {
 	databaseConfig, databaseErr := appconfig.ResolveDatabaseConfig()
 	if databaseErr != nil {
 		return nil, databaseErr
 	}
 
-	client, err := getPostMongoClient()
-	if err != nil {
-		return nil, err
+	if client == nil {
+		return nil, ErrMongoClientUnavailable
 	}
 
 	return client.Database(databaseConfig.Name).Collection(name), nil
@@ -235,6 +224,13 @@ func ensurePostContentIndexes(postsCollection *mongo.Collection) error {
 				},
 				Options: options.Index().SetName("idx_newsletter_post_locale_reading_time"),
 			},
+			{
+				Keys: bson.D{
+					{Key: "status", Value: 1},
+					{Key: "scheduledAt", Value: 1},
+				},
+				Options: options.Index().SetName("idx_newsletter_post_status_scheduled_at"),
+			},
 		}
 
 		if _, err := postsCollection.Indexes().CreateMany(ctx, indexes); err != nil {
@@ -306,6 +302,36 @@ func ensurePostCategoryIndexes(categoriesCollection *mongo.Collection) error {
 	return postCategoryIndexesErr
 }
 
+func ensurePostSeriesIndexes(seriesCollection *mongo.Collection) error {
+	postSeriesIndexesOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		indexes := []mongo.IndexModel{
+			{
+				Keys: bson.D{
+					{Key: "locale", Value: 1},
+					{Key: "id", Value: 1},
+				},
+				Options: options.Index().SetName("uniq_newsletter_series_locale_id").SetUnique(true),
+			},
+			{
+				Keys: bson.D{
+					{Key: "locale", Value: 1},
+					{Key: "postIds", Value: 1},
+				},
+				Options: options.Index().SetName("idx_newsletter_series_locale_post_ids"),
+			},
+		}
+
+		if _, err := seriesCollection.Indexes().CreateMany(ctx, indexes); err != nil {
+			postSeriesIndexesErr = fmt.Errorf("newsletter_series index create failed: %w", err)
+		}
+	})
+
+	return postSeriesIndexesErr
+}
+
 func ensurePostMediaAssetIndexes(mediaCollection *mongo.Collection) error {
 	postMediaAssetIndexesOnce.Do(func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -328,6 +354,18 @@ func ensurePostMediaAssetIndexes(mediaCollection *mongo.Collection) error {
 				Keys:    bson.D{{Key: "name", Value: 1}},
 				Options: options.Index().SetName("idx_admin_media_asset_name"),
 			},
+			{
+				Keys:    bson.D{{Key: "metadata.folder", Value: 1}},
+				Options: options.Index().SetName("idx_admin_media_asset_folder"),
+			},
+			{
+				Keys:    bson.D{{Key: "metadata.tags", Value: 1}},
+				Options: options.Index().SetName("idx_admin_media_asset_tags"),
+			},
+			{
+				Keys:    bson.D{{Key: "quarantinedAt", Value: 1}},
+				Options: options.Index().SetName("idx_admin_media_asset_quarantined").SetSparse(true),
+			},
 		}
 
 		if _, err := mediaCollection.Indexes().CreateMany(ctx, indexes); err != nil {
@@ -338,6 +376,69 @@ func ensurePostMediaAssetIndexes(mediaCollection *mongo.Collection) error {
 	return postMediaAssetIndexesErr
 }
 
+func ensurePostMediaVariantIndexes(variantsCollection *mongo.Collection) error {
+	postMediaVariantIndexesOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		indexes := []mongo.IndexModel{
+			{
+				Keys: bson.D{
+					{Key: "assetId", Value: 1},
+					{Key: "digest", Value: 1},
+					{Key: "width", Value: 1},
+					{Key: "contentType", Value: 1},
+				},
+				Options: options.Index().SetName("uniq_admin_media_variant_key").SetUnique(true),
+			},
+			{
+				Keys:    bson.D{{Key: "assetId", Value: 1}},
+				Options: options.Index().SetName("idx_admin_media_variant_asset"),
+			},
+		}
+
+		if _, err := variantsCollection.Indexes().CreateMany(ctx, indexes); err != nil {
+			postMediaVariantIndexesErr = fmt.Errorf("admin media variant index create failed: %w", err)
+		}
+	})
+
+	return postMediaVariantIndexesErr
+}
+
+func ensurePostMediaUploadIndexes(sessionsCollection, chunksCollection *mongo.Collection) error {
+	postMediaUploadIndexesOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		sessionIndexes := []mongo.IndexModel{
+			{
+				Keys:    bson.D{{Key: "expiresAt", Value: 1}},
+				Options: options.Index().SetName("ttl_admin_media_upload_expires_at").SetExpireAfterSeconds(0),
+			},
+		}
+		if _, err := sessionsCollection.Indexes().CreateMany(ctx, sessionIndexes); err != nil {
+			postMediaUploadIndexesErr = fmt.Errorf("admin media upload index create failed: %w", err)
+			return
+		}
+
+		chunkIndexes := []mongo.IndexModel{
+			{
+				Keys:    bson.D{{Key: "sessionId", Value: 1}, {Key: "offset", Value: 1}},
+				Options: options.Index().SetName("uniq_admin_media_upload_chunk_offset").SetUnique(true),
+			},
+			{
+				Keys:    bson.D{{Key: "expiresAt", Value: 1}},
+				Options: options.Index().SetName("ttl_admin_media_upload_chunk_expires_at").SetExpireAfterSeconds(0),
+			},
+		}
+		if _, err := chunksCollection.Indexes().CreateMany(ctx, chunkIndexes); err != nil {
+			postMediaUploadIndexesErr = fmt.Errorf("admin media upload chunk index create failed: %w", err)
+		}
+	})
+
+	return postMediaUploadIndexesErr
+}
+
 func ensurePostRevisionIndexes(revisionsCollection *mongo.Collection) error {
 	postRevisionIndexesOnce.Do(func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -374,8 +475,55 @@ func ensurePostRevisionIndexes(revisionsCollection *mongo.Collection) error {
 	return postRevisionIndexesErr
 }
 
-func getPostLikesCollection() (*mongo.Collection, error) {
-	collection, err := getPostCollection(postLikesCollectionName)
+func ensurePostRelatedIndexes(relatedCollection *mongo.Collection) error {
+	postRelatedIndexesOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		indexes := []mongo.IndexModel{
+			{
+				Keys: bson.D{
+					{Key: "locale", Value: 1},
+					{Key: "postId", Value: 1},
+				},
+				Options: options.Index().SetName("uniq_post_related_locale_post").SetUnique(true),
+			},
+		}
+
+		if _, err := relatedCollection.Indexes().CreateMany(ctx, indexes); err != nil {
+			postRelatedIndexesErr = fmt.Errorf("post related index create failed: %w", err)
+		}
+	})
+
+	return postRelatedIndexesErr
+}
+
+func ensurePostIDAliasIndexes(aliasesCollection *mongo.Collection) error {
+	postIDAliasIndexesOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		indexes := []mongo.IndexModel{
+			{
+				Keys:    bson.D{{Key: "aliasId", Value: 1}},
+				Options: options.Index().SetName("uniq_post_id_alias_alias_id").SetUnique(true),
+			},
+			{
+				Keys:    bson.D{{Key: "postId", Value: 1}},
+				Options: options.Index().SetName("idx_post_id_alias_post_id"),
+			},
+		}
+
+		if _, err := aliasesCollection.Indexes().CreateMany(ctx, indexes); err != nil {
+			postIDAliasIndexesErr = fmt.Errorf("post_id_aliases index create failed: %w", err)
+		}
+	})
+
+	return postIDAliasIndexesErr
+}
+
+func getPostLikesCollection(client *mongo.Client) (*mongo.Collection, error) {
+	collection, err := getPostCollection(client, postLikesCollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -385,8 +533,8 @@ func getPostLikesCollection() (*mongo.Collection, error) {
 	return collection, nil
 }
 
-func getPostHitsCollection() (*mongo.Collection, error) {
-	collection, err := getPostCollection(postHitsCollectionName)
+func getPostHitsCollection(client *mongo.Client) (*mongo.Collection, error) {
+	collection, err := getPostCollection(client, postHitsCollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -396,8 +544,8 @@ func getPostHitsCollection() (*mongo.Collection, error) {
 	return collection, nil
 }
 
-func getPostContentCollection() (*mongo.Collection, error) {
-	collection, err := getPostCollection(postsCollectionName)
+func getPostContentCollection(client *mongo.Client) (*mongo.Collection, error) {
+	collection, err := getPostCollection(client, postsCollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -407,8 +555,8 @@ func getPostContentCollection() (*mongo.Collection, error) {
 	return collection, nil
 }
 
-func getPostTopicsCollection() (*mongo.Collection, error) {
-	collection, err := getPostCollection(topicsCollectionName)
+func getPostTopicsCollection(client *mongo.Client) (*mongo.Collection, error) {
+	collection, err := getPostCollection(client, topicsCollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -418,8 +566,8 @@ func getPostTopicsCollection() (*mongo.Collection, error) {
 	return collection, nil
 }
 
-func getPostCategoriesCollection() (*mongo.Collection, error) {
-	collection, err := getPostCollection(categoriesCollectionName)
+func getPostCategoriesCollection(client *mongo.Client) (*mongo.Collection, error) {
+	collection, err := getPostCollection(client, categoriesCollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -429,8 +577,19 @@ func getPostCategoriesCollection() (*mongo.Collection, error) {
 	return collection, nil
 }
 
-func getPostMediaAssetsCollection() (*mongo.Collection, error) {
-	collection, err := getPostCollection(mediaAssetsCollectionName)
+func getPostSeriesCollection(client *mongo.Client) (*mongo.Collection, error) {
+	collection, err := getPostCollection(client, seriesCollectionName)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensurePostSeriesIndexes(collection); err != nil {
+		return nil, err
+	}
+	return collection, nil
+}
+
+func getPostMediaAssetsCollection(client *mongo.Client) (*mongo.Collection, error) {
+	collection, err := getPostCollection(client, mediaAssetsCollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -440,8 +599,45 @@ func getPostMediaAssetsCollection() (*mongo.Collection, error) {
 	return collection, nil
 }
 
-func getPostRevisionsCollection() (*mongo.Collection, error) {
-	collection, err := getPostCollection(postRevisionsCollectionName)
+func getPostMediaVariantsCollection(client *mongo.Client) (*mongo.Collection, error) {
+	collection, err := getPostCollection(client, mediaVariantsCollectionName)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensurePostMediaVariantIndexes(collection); err != nil {
+		return nil, err
+	}
+	return collection, nil
+}
+
+func getPostMediaUploadCollections(client *mongo.Client) (*mongo.Collection, *mongo.Collection, error) {
+	sessionsCollection, err := getPostCollection(client, mediaUploadsCollectionName)
+	if err != nil {
+		return nil, nil, err
+	}
+	chunksCollection, err := getPostCollection(client, mediaChunksCollectionName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := ensurePostMediaUploadIndexes(sessionsCollection, chunksCollection); err != nil {
+		return nil, nil, err
+	}
+	return sessionsCollection, chunksCollection, nil
+}
+
+func getPostRelatedCollection(client *mongo.Client) (*mongo.Collection, error) {
+	collection, err := getPostCollection(client, postRelatedCollectionName)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensurePostRelatedIndexes(collection); err != nil {
+		return nil, err
+	}
+	return collection, nil
+}
+
+func getPostRevisionsCollection(client *mongo.Client) (*mongo.Collection, error) {
+	collection, err := getPostCollection(client, postRevisionsCollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -451,6 +647,17 @@ func getPostRevisionsCollection() (*mongo.Collection, error) {
 	return collection, nil
 }
 
+func getPostIDAliasesCollection(client *mongo.Client) (*mongo.Collection, error) {
+	collection, err := getPostCollection(client, postIDAliasesCollectionName)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensurePostIDAliasIndexes(collection); err != nil {
+		return nil, err
+	}
+	return collection, nil
+}
+
 func ensurePostLikeDocuments(ctx context.Context, collection postBulkWriter, postIDs []string, now time.Time) error {
 	if len(postIDs) == 0 {
 		return nil
@@ -801,13 +1008,13 @@ func collectPostIDs(posts []PostRecord) []string {
 	return postIDs
 }
 
-func resolvePostLikesByPostID(ctx context.Context, posts []PostRecord) map[string]int64 {
+func resolvePostLikesByPostID(ctx context.Context, client *mongo.Client, posts []PostRecord) map[string]int64 {
 	postIDs := collectPostIDs(posts)
 	if len(postIDs) == 0 {
 		return nil
 	}
 
-	likesCollection, err := getPostLikesCollection()
+	likesCollection, err := getPostLikesCollection(client)
 	if err != nil {
 		return nil
 	}
@@ -823,13 +1030,13 @@ func resolvePostLikesByPostID(ctx context.Context, posts []PostRecord) map[strin
 	return nil
 }
 
-func resolvePostHitsByPostID(ctx context.Context, posts []PostRecord) map[string]int64 {
+func resolvePostHitsByPostID(ctx context.Context, client *mongo.Client, posts []PostRecord) map[string]int64 {
 	postIDs := collectPostIDs(posts)
 	if len(postIDs) == 0 {
 		return nil
 	}
 
-	hitsCollection, err := getPostHitsCollection()
+	hitsCollection, err := getPostHitsCollection(client)
 	if err != nil {
 		return nil
 	}
@@ -0,0 +1,326 @@
+package repository
+
+import (
+	"cmp"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// memoryPost mirrors one document of the posts collection, which both the public post repository and the admin
+// content repository read with their own projections.
+type memoryPost struct {
+	Locale           string
+	ID               string
+	Title            string
+	Summary          string
+	Content          string
+	ContentMode      string
+	SearchText       string
+	Thumbnail        string
+	Source           string
+	Link             string
+	PublishedAt      time.Time
+	PublishedDate    string
+	UpdatedDate      string
+	Category         *domain.PostCategory
+	Topics           []domain.PostTopic
+	TopicIDs         []string
+	ReadingTimeMin   int
+	Status           string
+	ScheduledAt      time.Time
+	ContentUpdatedAt time.Time
+	UpdatedAt        time.Time
+	RevisionCount    int
+	LatestRevisionAt time.Time
+}
+
+func (post memoryPost) clone() memoryPost {
+	post.Category = cloneMemoryValue(post.Category)
+	post.Topics = slices.Clone(post.Topics)
+	post.TopicIDs = slices.Clone(post.TopicIDs)
+	return post
+}
+
+func (post memoryPost) postRecord() domain.PostRecord {
+	record := domain.PostRecord{
+		ID:             post.ID,
+		Title:          post.Title,
+		Category:       cloneMemoryValue(post.Category),
+		PublishedDate:  post.PublishedDate,
+		UpdatedDate:    &post.UpdatedDate,
+		Summary:        post.Summary,
+		SearchText:     post.SearchText,
+		Thumbnail:      &post.Thumbnail,
+		Topics:         slices.Clone(post.Topics),
+		TopicIDs:       slices.Clone(post.TopicIDs),
+		ReadingTimeMin: post.ReadingTimeMin,
+		Source:         post.Source,
+		Link:           &post.Link,
+		PublishedAt:    post.PublishedAt,
+		Status:         post.Status,
+		ScheduledAt:    post.ScheduledAt,
+	}
+
+	return normalizePostForResponse(record)
+}
+
+func (post memoryPost) adminDocument() adminContentPostDocument {
+	document := adminContentPostDocument{
+		Locale:           post.Locale,
+		ID:               post.ID,
+		Title:            post.Title,
+		Summary:          post.Summary,
+		Content:          post.Content,
+		ContentMode:      post.ContentMode,
+		Thumbnail:        post.Thumbnail,
+		Source:           post.Source,
+		PublishedAt:      post.PublishedAt,
+		PublishedDate:    post.PublishedDate,
+		UpdatedDate:      post.UpdatedDate,
+		TopicIDs:         slices.Clone(post.TopicIDs),
+		ReadingTimeMin:   post.ReadingTimeMin,
+		Status:           post.Status,
+		ScheduledAt:      post.ScheduledAt,
+		ContentUpdatedAt: post.ContentUpdatedAt,
+		UpdatedAt:        post.UpdatedAt,
+		RevisionCount:    post.RevisionCount,
+		LatestRevisionAt: post.LatestRevisionAt,
+	}
+
+	if post.Category != nil {
+		document.Category = &struct {
+			ID   string `bson:"id"`
+			Name string `bson:"name"`
+		}{ID: post.Category.ID, Name: post.Category.Name}
+	}
+	for _, topic := range post.Topics {
+		document.Topics = append(document.Topics, struct {
+			ID   string `bson:"id"`
+			Name string `bson:"name"`
+		}{ID: topic.ID, Name: topic.Name})
+	}
+
+	return document
+}
+
+func (post memoryPost) adminRecord() domain.AdminContentPostRecord {
+	return mapAdminContentPostDocument(post.adminDocument())
+}
+
+// filterDocument returns the fields a Mongo filter may query, leaving out the ones Mongo would not store.
+func (post memoryPost) filterDocument() bson.M {
+	document := bson.M{
+		"locale":        post.Locale,
+		"id":            post.ID,
+		"title":         post.Title,
+		"summary":       post.Summary,
+		"searchText":    post.SearchText,
+		"publishedDate": post.PublishedDate,
+	}
+	if post.Source != "" {
+		document["source"] = post.Source
+	}
+	if post.Status != "" {
+		document["status"] = post.Status
+	}
+	if !post.PublishedAt.IsZero() {
+		document["publishedAt"] = post.PublishedAt
+	}
+	if !post.ScheduledAt.IsZero() {
+		document["scheduledAt"] = post.ScheduledAt
+	}
+	if post.Category != nil {
+		document[adminContentCategoryIDField] = post.Category.ID
+	}
+	if len(post.TopicIDs) > 0 {
+		document["topicIds"] = post.TopicIDs
+	}
+
+	return document
+}
+
+// findPost returns the stored post with the given locale and id. The caller must hold the lock.
+func (s *MemoryStore) findPost(locale, postID string) *memoryPost {
+	for index := range s.posts {
+		if s.posts[index].Locale == locale && s.posts[index].ID == postID {
+			return &s.posts[index]
+		}
+	}
+
+	return nil
+}
+
+// matchMemoryFilter evaluates the subset of the Mongo query language the services build for posts: field
+// equality, regular expressions, $and, $or, $nor, $exists, $eq, $ne, $in, $nin, $lt, $lte, $gt and $gte. Unknown
+// operators never match.
+func matchMemoryFilter(document, filter bson.M) bool {
+	for key, condition := range filter {
+		switch key {
+		case "$and":
+			for _, clause := range memoryFilterValues(condition) {
+				if nested, ok := clause.(bson.M); !ok || !matchMemoryFilter(document, nested) {
+					return false
+				}
+			}
+		case "$or", "$nor":
+			matched := slices.ContainsFunc(memoryFilterValues(condition), func(clause any) bool {
+				nested, ok := clause.(bson.M)
+				return ok && matchMemoryFilter(document, nested)
+			})
+			if matched != (key == "$or") {
+				return false
+			}
+		default:
+			value, present := document[key]
+			if !matchMemoryCondition(value, present, condition) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func matchMemoryCondition(value any, present bool, condition any) bool {
+	if pattern, ok := condition.(primitive.Regex); ok {
+		return present && matchMemoryRegex(value, pattern)
+	}
+
+	operators, ok := condition.(bson.M)
+	if !ok {
+		return present && memoryFilterEqual(value, condition)
+	}
+
+	for operator, operand := range operators {
+		var matched bool
+		switch operator {
+		case "$exists":
+			exists, _ := operand.(bool)
+			matched = present == exists
+		case "$type":
+			switch operand {
+			case "date":
+				_, matched = value.(time.Time)
+			case "string":
+				_, matched = value.(string)
+			}
+			matched = present && matched
+		case "$eq":
+			matched = present && memoryFilterEqual(value, operand)
+		case "$ne":
+			matched = !present || !memoryFilterEqual(value, operand)
+		case "$in":
+			matched = present && slices.ContainsFunc(memoryFilterValues(operand), func(candidate any) bool {
+				return memoryFilterEqual(value, candidate)
+			})
+		case "$nin":
+			matched = !present || !slices.ContainsFunc(memoryFilterValues(operand), func(candidate any) bool {
+				return memoryFilterEqual(value, candidate)
+			})
+		case "$lt", "$lte", "$gt", "$gte":
+			order, comparable := compareMemoryFilterValues(value, operand)
+			matched = present && comparable && ((operator == "$lt" && order < 0) ||
+				(operator == "$lte" && order <= 0) || (operator == "$gt" && order > 0) ||
+				(operator == "$gte" && order >= 0))
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchMemoryRegex applies a regex condition to a string or to any element of a string array.
+func matchMemoryRegex(value any, pattern primitive.Regex) bool {
+	expression := pattern.Pattern
+	if strings.Contains(pattern.Options, "i") {
+		expression = "(?i)" + expression
+	}
+	compiled, err := regexp.Compile(expression)
+	if err != nil {
+		return false
+	}
+
+	switch typed := value.(type) {
+	case string:
+		return compiled.MatchString(typed)
+	case []string:
+		return slices.ContainsFunc(typed, compiled.MatchString)
+	default:
+		return false
+	}
+}
+
+// memoryFilterEqual compares like Mongo equality, where an array field matches any of its elements.
+func memoryFilterEqual(value, operand any) bool {
+	if values, ok := value.([]string); ok {
+		return slices.ContainsFunc(values, func(element string) bool { return memoryFilterEqual(element, operand) })
+	}
+
+	order, comparable := compareMemoryFilterValues(value, operand)
+	return comparable && order == 0
+}
+
+func compareMemoryFilterValues(left, right any) (int, bool) {
+	switch leftValue := left.(type) {
+	case string:
+		rightValue, ok := right.(string)
+		return strings.Compare(leftValue, rightValue), ok
+	case time.Time:
+		rightValue, ok := right.(time.Time)
+		return leftValue.Compare(rightValue), ok
+	case bool:
+		rightValue, ok := right.(bool)
+		return 0, ok && leftValue == rightValue
+	}
+
+	leftNumber, leftOK := memoryFilterNumber(left)
+	rightNumber, rightOK := memoryFilterNumber(right)
+	return cmp.Compare(leftNumber, rightNumber), leftOK && rightOK
+}
+
+func memoryFilterNumber(value any) (float64, bool) {
+	switch number := value.(type) {
+	case int:
+		return float64(number), true
+	case int32:
+		return float64(number), true
+	case int64:
+		return float64(number), true
+	case float64:
+		return number, true
+	default:
+		return 0, false
+	}
+}
+
+func memoryFilterValues(value any) []any {
+	switch values := value.(type) {
+	case bson.A:
+		return values
+	case []any:
+		return values
+	case []bson.M:
+		converted := make([]any, 0, len(values))
+		for _, element := range values {
+			converted = append(converted, element)
+		}
+		return converted
+	case []string:
+		converted := make([]any, 0, len(values))
+		for _, element := range values {
+			converted = append(converted, element)
+		}
+		return converted
+	default:
+		return []any{value}
+	}
+}
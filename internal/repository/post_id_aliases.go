@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type postIDAliasDocument struct {
+	AliasID   string    `bson:"aliasId"`
+	PostID    string    `bson:"postId"`
+	CreatedAt time.Time `bson:"createdAt"`
+}
+
+func queryPostIDAlias(ctx context.Context, collection postSingleFinder, aliasID string) (string, error) {
+	var doc postIDAliasDocument
+	err := collection.FindOne(ctx, bson.M{
+		"aliasId": strings.TrimSpace(strings.ToLower(aliasID)),
+	}).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(strings.ToLower(doc.PostID)), nil
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type postRelatedItemDocument struct {
+	PostID string  `bson:"postId"`
+	Score  float64 `bson:"score"`
+}
+
+type postRelatedDocument struct {
+	Locale     string                    `bson:"locale"`
+	PostID     string                    `bson:"postId"`
+	Items      []postRelatedItemDocument `bson:"items"`
+	ComputedAt time.Time                 `bson:"computedAt"`
+}
+
+func queryPostRelatedRecord(
+	ctx context.Context,
+	collection postSingleFinder,
+	locale string,
+	postID string,
+) (*domain.PostRelatedRecord, error) {
+	var doc postRelatedDocument
+	err := collection.FindOne(ctx, bson.M{
+		"locale": strings.TrimSpace(strings.ToLower(locale)),
+		"postId": strings.TrimSpace(strings.ToLower(postID)),
+	}).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]domain.PostRelatedItem, 0, len(doc.Items))
+	for _, item := range doc.Items {
+		items = append(items, domain.PostRelatedItem{PostID: item.PostID, Score: item.Score})
+	}
+
+	return &domain.PostRelatedRecord{
+		Locale:     doc.Locale,
+		PostID:     doc.PostID,
+		Items:      items,
+		ComputedAt: doc.ComputedAt,
+	}, nil
+}
+
+func replacePostRelatedRecords(
+	ctx context.Context,
+	collection postBulkWriter,
+	locale string,
+	records []domain.PostRelatedRecord,
+) error {
+	resolvedLocale := strings.TrimSpace(strings.ToLower(locale))
+	postIDs := make([]string, 0, len(records))
+	operations := make([]mongo.WriteModel, 0, len(records)+1)
+	for _, record := range records {
+		postID := strings.TrimSpace(strings.ToLower(record.PostID))
+		if postID == "" {
+			continue
+		}
+
+		items := make([]postRelatedItemDocument, 0, len(record.Items))
+		for _, item := range record.Items {
+			items = append(items, postRelatedItemDocument{
+				PostID: strings.TrimSpace(strings.ToLower(item.PostID)),
+				Score:  item.Score,
+			})
+		}
+
+		postIDs = append(postIDs, postID)
+		operations = append(operations, mongo.NewReplaceOneModel().
+			SetFilter(bson.M{"locale": resolvedLocale, "postId": postID}).
+			SetReplacement(postRelatedDocument{
+				Locale:     resolvedLocale,
+				PostID:     postID,
+				Items:      items,
+				ComputedAt: record.ComputedAt.UTC(),
+			}).
+			SetUpsert(true))
+	}
+
+	// Posts that are no longer public lose their stale related lists in the same batch.
+	operations = append(operations, mongo.NewDeleteManyModel().SetFilter(bson.M{
+		"locale": resolvedLocale,
+		"postId": bson.M{"$nin": postIDs},
+	}))
+
+	_, err := collection.BulkWrite(ctx, operations, options.BulkWrite().SetOrdered(false))
+	return err
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	"suaybsimsek.com/blog-api/internal/domain"
 )
@@ -25,17 +26,24 @@ type PostRepository interface {
 	ResolveHitsByPostID(ctx context.Context, posts []domain.PostRecord) map[string]int64
 	IncrementPostLike(ctx context.Context, postID string, now time.Time) (int64, error)
 	IncrementPostHit(ctx context.Context, postID string, now time.Time) (int64, error)
+	FindRelatedPosts(ctx context.Context, locale, postID string) (*domain.PostRelatedRecord, error)
+	ReplaceRelatedPosts(ctx context.Context, locale string, records []domain.PostRelatedRecord) error
+	FindSeriesByID(ctx context.Context, locale, seriesID string) (*domain.PostSeriesRecord, error)
+	FindSeriesByPostID(ctx context.Context, locale, postID string) (*domain.PostSeriesRecord, error)
+	FindPostIDAlias(ctx context.Context, aliasID string) (string, error)
 }
 
-type postMongoRepository struct{}
+type postMongoRepository struct {
+	client *mongo.Client
+}
 
 // NewPostMongoRepository returns the default MongoDB-backed repository.
-func NewPostMongoRepository() PostRepository {
-	return &postMongoRepository{}
+func NewPostMongoRepository(client *mongo.Client) PostRepository {
+	return &postMongoRepository{client: client}
 }
 
-func (*postMongoRepository) CountPosts(ctx context.Context, filter bson.M) (int, error) {
-	collection, err := getPostContentCollection()
+func (repo *postMongoRepository) CountPosts(ctx context.Context, filter bson.M) (int, error) {
+	collection, err := getPostContentCollection(repo.client)
 	if err != nil {
 		return 0, fmt.Errorf(postRepositoryUnavailableFormat, ErrPostRepositoryUnavailable, err)
 	}
@@ -48,14 +56,14 @@ func (*postMongoRepository) CountPosts(ctx context.Context, filter bson.M) (int,
 	return int(total), nil
 }
 
-func (*postMongoRepository) FindPosts(
+func (repo *postMongoRepository) FindPosts(
 	ctx context.Context,
 	filter bson.M,
 	sortOrder string,
 	skip int64,
 	limit int64,
 ) ([]domain.PostRecord, error) {
-	collection, err := getPostContentCollection()
+	collection, err := getPostContentCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(postRepositoryUnavailableFormat, ErrPostRepositoryUnavailable, err)
 	}
@@ -63,8 +71,8 @@ func (*postMongoRepository) FindPosts(
 	return queryPostRecords(ctx, collection, filter, sortOrder, skip, limit)
 }
 
-func (*postMongoRepository) FindPostByID(ctx context.Context, locale, postID string) (*domain.PostRecord, error) {
-	collection, err := getPostContentCollection()
+func (repo *postMongoRepository) FindPostByID(ctx context.Context, locale, postID string) (*domain.PostRecord, error) {
+	collection, err := getPostContentCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(postRepositoryUnavailableFormat, ErrPostRepositoryUnavailable, err)
 	}
@@ -72,8 +80,8 @@ func (*postMongoRepository) FindPostByID(ctx context.Context, locale, postID str
 	return queryPostRecordByID(ctx, collection, locale, postID)
 }
 
-func (*postMongoRepository) FindPostByIDAnyLocale(ctx context.Context, postID string) (*domain.PostRecord, error) {
-	collection, err := getPostContentCollection()
+func (repo *postMongoRepository) FindPostByIDAnyLocale(ctx context.Context, postID string) (*domain.PostRecord, error) {
+	collection, err := getPostContentCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(postRepositoryUnavailableFormat, ErrPostRepositoryUnavailable, err)
 	}
@@ -81,16 +89,16 @@ func (*postMongoRepository) FindPostByIDAnyLocale(ctx context.Context, postID st
 	return queryPostRecordByIDAnyLocale(ctx, collection, postID)
 }
 
-func (*postMongoRepository) ResolveLikesByPostID(ctx context.Context, posts []domain.PostRecord) map[string]int64 {
-	return resolvePostLikesByPostID(ctx, posts)
+func (repo *postMongoRepository) ResolveLikesByPostID(ctx context.Context, posts []domain.PostRecord) map[string]int64 {
+	return resolvePostLikesByPostID(ctx, repo.client, posts)
 }
 
-func (*postMongoRepository) ResolveHitsByPostID(ctx context.Context, posts []domain.PostRecord) map[string]int64 {
-	return resolvePostHitsByPostID(ctx, posts)
+func (repo *postMongoRepository) ResolveHitsByPostID(ctx context.Context, posts []domain.PostRecord) map[string]int64 {
+	return resolvePostHitsByPostID(ctx, repo.client, posts)
 }
 
-func (*postMongoRepository) IncrementPostLike(ctx context.Context, postID string, now time.Time) (int64, error) {
-	collection, err := getPostLikesCollection()
+func (repo *postMongoRepository) IncrementPostLike(ctx context.Context, postID string, now time.Time) (int64, error) {
+	collection, err := getPostLikesCollection(repo.client)
 	if err != nil {
 		return 0, fmt.Errorf(postRepositoryUnavailableFormat, ErrPostRepositoryUnavailable, err)
 	}
@@ -98,11 +106,56 @@ func (*postMongoRepository) IncrementPostLike(ctx context.Context, postID string
 	return incrementPostLikeValue(ctx, collection, postID, now)
 }
 
-func (*postMongoRepository) IncrementPostHit(ctx context.Context, postID string, now time.Time) (int64, error) {
-	collection, err := getPostHitsCollection()
+func (repo *postMongoRepository) IncrementPostHit(ctx context.Context, postID string, now time.Time) (int64, error) {
+	collection, err := getPostHitsCollection(repo.client)
 	if err != nil {
 		return 0, fmt.Errorf(postRepositoryUnavailableFormat, ErrPostRepositoryUnavailable, err)
 	}
 
 	return incrementPostHitValue(ctx, collection, postID, now)
 }
+
+func (repo *postMongoRepository) FindRelatedPosts(ctx context.Context, locale, postID string) (*domain.PostRelatedRecord, error) {
+	collection, err := getPostRelatedCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(postRepositoryUnavailableFormat, ErrPostRepositoryUnavailable, err)
+	}
+
+	return queryPostRelatedRecord(ctx, collection, locale, postID)
+}
+
+func (repo *postMongoRepository) ReplaceRelatedPosts(ctx context.Context, locale string, records []domain.PostRelatedRecord) error {
+	collection, err := getPostRelatedCollection(repo.client)
+	if err != nil {
+		return fmt.Errorf(postRepositoryUnavailableFormat, ErrPostRepositoryUnavailable, err)
+	}
+
+	return replacePostRelatedRecords(ctx, collection, locale, records)
+}
+
+func (repo *postMongoRepository) FindSeriesByID(ctx context.Context, locale, seriesID string) (*domain.PostSeriesRecord, error) {
+	collection, err := getPostSeriesCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(postRepositoryUnavailableFormat, ErrPostRepositoryUnavailable, err)
+	}
+
+	return queryPostSeriesByID(ctx, collection, locale, seriesID)
+}
+
+func (repo *postMongoRepository) FindSeriesByPostID(ctx context.Context, locale, postID string) (*domain.PostSeriesRecord, error) {
+	collection, err := getPostSeriesCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(postRepositoryUnavailableFormat, ErrPostRepositoryUnavailable, err)
+	}
+
+	return queryPostSeriesByPostID(ctx, collection, locale, postID)
+}
+
+func (repo *postMongoRepository) FindPostIDAlias(ctx context.Context, aliasID string) (string, error) {
+	collection, err := getPostIDAliasesCollection(repo.client)
+	if err != nil {
+		return "", fmt.Errorf(postRepositoryUnavailableFormat, ErrPostRepositoryUnavailable, err)
+	}
+
+	return queryPostIDAlias(ctx, collection, aliasID)
+}
@@ -0,0 +1,280 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type postMemoryRepository struct {
+	store *MemoryStore
+}
+
+// NewPostMemoryRepository returns a post repository backed by the given in-memory store.
+func NewPostMemoryRepository(store *MemoryStore) PostRepository {
+	return &postMemoryRepository{store: store}
+}
+
+func (r *postMemoryRepository) CountPosts(_ context.Context, filter bson.M) (int, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	total := 0
+	for _, post := range r.store.posts {
+		if matchMemoryFilter(post.filterDocument(), filter) {
+			total++
+		}
+	}
+
+	return total, nil
+}
+
+func (r *postMemoryRepository) FindPosts(
+	_ context.Context,
+	filter bson.M,
+	sortOrder string,
+	skip int64,
+	limit int64,
+) ([]domain.PostRecord, error) {
+	r.store.mu.RLock()
+	matched := make([]memoryPost, 0)
+	for _, post := range r.store.posts {
+		if matchMemoryFilter(post.filterDocument(), filter) {
+			matched = append(matched, post)
+		}
+	}
+	r.store.mu.RUnlock()
+
+	slices.SortStableFunc(matched, func(left, right memoryPost) int {
+		order := left.PublishedAt.Compare(right.PublishedAt)
+		if sortOrder != "asc" {
+			order = -order
+		}
+		if order != 0 {
+			return order
+		}
+		return strings.Compare(left.ID, right.ID)
+	})
+
+	if skip > 0 {
+		matched = matched[min(int(skip), len(matched)):]
+	}
+	if limit > 0 {
+		matched = matched[:min(int(limit), len(matched))]
+	}
+
+	posts := make([]domain.PostRecord, 0, len(matched))
+	for _, post := range matched {
+		posts = append(posts, post.postRecord())
+	}
+
+	return posts, nil
+}
+
+func (r *postMemoryRepository) FindPostByID(_ context.Context, locale, postID string) (*domain.PostRecord, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	post := r.store.findPost(locale, postID)
+	if post == nil {
+		return nil, nil
+	}
+
+	record := post.postRecord()
+	return &record, nil
+}
+
+func (r *postMemoryRepository) FindPostByIDAnyLocale(_ context.Context, postID string) (*domain.PostRecord, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, post := range r.store.posts {
+		if post.ID == postID {
+			record := post.postRecord()
+			return &record, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *postMemoryRepository) ResolveLikesByPostID(_ context.Context, posts []domain.PostRecord) map[string]int64 {
+	return r.resolveCounters(r.store.postLikes, posts, computeInitialLikes)
+}
+
+func (r *postMemoryRepository) ResolveHitsByPostID(_ context.Context, posts []domain.PostRecord) map[string]int64 {
+	return r.resolveCounters(r.store.postHits, posts, computeInitialHits)
+}
+
+func (r *postMemoryRepository) IncrementPostLike(_ context.Context, postID string, _ time.Time) (int64, error) {
+	return r.incrementCounter(r.store.postLikes, postID, computeInitialLikes), nil
+}
+
+func (r *postMemoryRepository) IncrementPostHit(_ context.Context, postID string, _ time.Time) (int64, error) {
+	return r.incrementCounter(r.store.postHits, postID, computeInitialHits), nil
+}
+
+// resolveCounters seeds the missing counters of the posts like the Mongo repository does on first read.
+func (r *postMemoryRepository) resolveCounters(
+	counters map[string]int64,
+	posts []domain.PostRecord,
+	initial func(string) int64,
+) map[string]int64 {
+	postIDs := collectPostIDs(posts)
+	if len(postIDs) == 0 {
+		return nil
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	values := make(map[string]int64, len(postIDs))
+	for _, postID := range postIDs {
+		if _, exists := counters[postID]; !exists {
+			counters[postID] = initial(postID)
+		}
+		values[postID] = counters[postID]
+	}
+
+	return values
+}
+
+func (r *postMemoryRepository) incrementCounter(counters map[string]int64, postID string, initial func(string) int64) int64 {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, exists := counters[postID]; !exists {
+		counters[postID] = initial(postID)
+	}
+	counters[postID]++
+	return counters[postID]
+}
+
+func (r *postMemoryRepository) FindRelatedPosts(_ context.Context, locale, postID string) (*domain.PostRelatedRecord, error) {
+	resolvedLocale := strings.TrimSpace(strings.ToLower(locale))
+	resolvedPostID := strings.TrimSpace(strings.ToLower(postID))
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, record := range r.store.postRelated {
+		if record.Locale == resolvedLocale && record.PostID == resolvedPostID {
+			record.Items = slices.Clone(record.Items)
+			return &record, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *postMemoryRepository) ReplaceRelatedPosts(_ context.Context, locale string, records []domain.PostRelatedRecord) error {
+	resolvedLocale := strings.TrimSpace(strings.ToLower(locale))
+	replacements := make([]domain.PostRelatedRecord, 0, len(records))
+	for _, record := range records {
+		postID := strings.TrimSpace(strings.ToLower(record.PostID))
+		if postID == "" {
+			continue
+		}
+
+		items := make([]domain.PostRelatedItem, 0, len(record.Items))
+		for _, item := range record.Items {
+			items = append(items, domain.PostRelatedItem{
+				PostID: strings.TrimSpace(strings.ToLower(item.PostID)),
+				Score:  item.Score,
+			})
+		}
+		replacements = append(replacements, domain.PostRelatedRecord{
+			Locale:     resolvedLocale,
+			PostID:     postID,
+			Items:      items,
+			ComputedAt: record.ComputedAt.UTC(),
+		})
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	// Posts that are no longer public lose their stale related lists along with the replacement.
+	r.store.postRelated = slices.DeleteFunc(r.store.postRelated, func(record domain.PostRelatedRecord) bool {
+		return record.Locale == resolvedLocale
+	})
+	for _, replacement := range replacements {
+		r.store.postRelated = slices.DeleteFunc(r.store.postRelated, func(record domain.PostRelatedRecord) bool {
+			return record.Locale == replacement.Locale && record.PostID == replacement.PostID
+		})
+		r.store.postRelated = append(r.store.postRelated, replacement)
+	}
+
+	return nil
+}
+
+func (r *postMemoryRepository) FindSeriesByID(_ context.Context, locale, seriesID string) (*domain.PostSeriesRecord, error) {
+	resolvedLocale := strings.TrimSpace(strings.ToLower(locale))
+	resolvedSeriesID := strings.TrimSpace(strings.ToLower(seriesID))
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, series := range r.store.postSeries {
+		if series.Locale == resolvedLocale && series.ID == resolvedSeriesID {
+			return mapMemoryPostSeries(series), nil
+		}
+	}
+
+	return nil, nil
+}
+
+// FindSeriesByPostID returns the series listing the post; the lowest series id wins, like in the Mongo repository.
+func (r *postMemoryRepository) FindSeriesByPostID(_ context.Context, locale, postID string) (*domain.PostSeriesRecord, error) {
+	resolvedLocale := strings.TrimSpace(strings.ToLower(locale))
+	resolvedPostID := strings.TrimSpace(strings.ToLower(postID))
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var found *postSeriesDocument
+	for index, series := range r.store.postSeries {
+		if series.Locale != resolvedLocale || !slices.Contains(series.PostIDs, resolvedPostID) {
+			continue
+		}
+		if found == nil || series.ID < found.ID {
+			found = &r.store.postSeries[index]
+		}
+	}
+	if found == nil {
+		return nil, nil
+	}
+
+	return mapMemoryPostSeries(*found), nil
+}
+
+func (r *postMemoryRepository) FindPostIDAlias(_ context.Context, aliasID string) (string, error) {
+	resolvedAliasID := strings.TrimSpace(strings.ToLower(aliasID))
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, alias := range r.store.postIDAliases {
+		if alias.AliasID == resolvedAliasID {
+			return strings.TrimSpace(strings.ToLower(alias.PostID)), nil
+		}
+	}
+
+	return "", nil
+}
+
+func mapMemoryPostSeries(series postSeriesDocument) *domain.PostSeriesRecord {
+	return &domain.PostSeriesRecord{
+		Locale:      strings.TrimSpace(strings.ToLower(series.Locale)),
+		ID:          strings.TrimSpace(strings.ToLower(series.ID)),
+		Name:        strings.TrimSpace(series.Name),
+		Description: strings.TrimSpace(series.Description),
+		PostIDs:     normalizePostSeriesPostIDs(series.PostIDs),
+		UpdatedAt:   series.UpdatedAt,
+	}
+}
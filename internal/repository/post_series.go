@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type postSeriesDocument struct {
+	Locale      string    `bson:"locale"`
+	ID          string    `bson:"id"`
+	Name        string    `bson:"name"`
+	Description string    `bson:"description"`
+	PostIDs     []string  `bson:"postIds"`
+	UpdatedAt   time.Time `bson:"updatedAt"`
+}
+
+func queryPostSeriesByID(
+	ctx context.Context,
+	collection postSingleFinder,
+	locale string,
+	seriesID string,
+) (*domain.PostSeriesRecord, error) {
+	return queryPostSeriesRecord(ctx, collection, bson.M{
+		"locale": strings.TrimSpace(strings.ToLower(locale)),
+		"id":     strings.TrimSpace(strings.ToLower(seriesID)),
+	})
+}
+
+func queryPostSeriesByPostID(
+	ctx context.Context,
+	collection postSingleFinder,
+	locale string,
+	postID string,
+) (*domain.PostSeriesRecord, error) {
+	// A post may be listed in more than one series; the lowest series id wins so navigation stays stable.
+	return queryPostSeriesRecord(ctx, collection, bson.M{
+		"locale":  strings.TrimSpace(strings.ToLower(locale)),
+		"postIds": strings.TrimSpace(strings.ToLower(postID)),
+	}, options.FindOne().SetSort(bson.D{{Key: "id", Value: 1}}))
+}
+
+func queryPostSeriesRecord(
+	ctx context.Context,
+	collection postSingleFinder,
+	filter bson.M,
+	opts ...*options.FindOneOptions,
+) (*domain.PostSeriesRecord, error) {
+	var doc postSeriesDocument
+	err := collection.FindOne(ctx, filter, opts...).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.PostSeriesRecord{
+		Locale:      strings.TrimSpace(strings.ToLower(doc.Locale)),
+		ID:          strings.TrimSpace(strings.ToLower(doc.ID)),
+		Name:        strings.TrimSpace(doc.Name),
+		Description: strings.TrimSpace(doc.Description),
+		PostIDs:     normalizePostSeriesPostIDs(doc.PostIDs),
+		UpdatedAt:   doc.UpdatedAt,
+	}, nil
+}
+
+func normalizePostSeriesPostIDs(values []string) []string {
+	postIDs := make([]string, 0, len(values))
+	for _, value := range values {
+		postID := strings.TrimSpace(strings.ToLower(value))
+		if postID == "" {
+			continue
+		}
+		postIDs = append(postIDs, postID)
+	}
+	return postIDs
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type RateLimitRepository interface {
+	Hit(ctx context.Context, key string, now time.Time, maxAttempts int, window time.Duration) (*domain.RateLimitRecord, error)
+}
+
+var ErrRateLimitRepositoryUnavailable = errors.New("rate limit repository unavailable")
+
+const (
+	rateLimitsCollectionName             = "rate_limits"
+	rateLimitRepositoryUnavailableFormat = "%w: %v"
+)
+
+type rateLimitMongoRepository struct {
+	client *mongo.Client
+}
+
+type rateLimitDocument struct {
+	Key       string      `bson:"key"`
+	Hits      []time.Time `bson:"hits"`
+	Allowed   bool        `bson:"allowed"`
+	ExpiresAt time.Time   `bson:"expiresAt"`
+}
+
+var (
+	rateLimitIndexesOnce sync.Once
+	rateLimitIndexesErr  error
+)
+
+func NewRateLimitRepository(client *mongo.Client) RateLimitRepository {
+	return &rateLimitMongoRepository{client: client}
+}
+
+// Hit atomically drops the attempts under key that fell out of the sliding window and, while fewer than maxAttempts
+// remain, counts one more at now. The returned record reports whether the attempt was counted and keeps the hits
+// still inside the window, oldest first. The document expires once its newest hit leaves the window.
+func (repo *rateLimitMongoRepository) Hit(
+	ctx context.Context,
+	key string,
+	now time.Time,
+	maxAttempts int,
+	window time.Duration,
+) (*domain.RateLimitRecord, error) {
+	collection, err := getRateLimitsCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(rateLimitRepositoryUnavailableFormat, ErrRateLimitRepositoryUnavailable, err)
+	}
+
+	resolvedKey := strings.TrimSpace(key)
+	resolvedNow := now.UTC()
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"key": resolvedKey,
+			"hits": bson.M{"$filter": bson.M{
+				"input": bson.M{"$ifNull": bson.A{"$hits", bson.A{}}},
+				"as":    "hit",
+				"cond":  bson.M{"$gt": bson.A{"$$hit", resolvedNow.Add(-window)}},
+			}},
+		}}},
+		{{Key: "$set", Value: bson.M{
+			"allowed": bson.M{"$lt": bson.A{bson.M{"$size": "$hits"}, maxAttempts}},
+			"hits": bson.M{"$cond": bson.A{
+				bson.M{"$lt": bson.A{bson.M{"$size": "$hits"}, maxAttempts}},
+				bson.M{"$concatArrays": bson.A{"$hits", bson.A{resolvedNow}}},
+				"$hits",
+			}},
+		}}},
+		{{Key: "$set", Value: bson.M{
+			"expiresAt": bson.M{"$add": bson.A{bson.M{"$max": "$hits"}, window.Milliseconds()}},
+		}}},
+	}
+
+	hit := func() (rateLimitDocument, error) {
+		var document rateLimitDocument
+		err := collection.FindOneAndUpdate(
+			ctx,
+			bson.M{"key": resolvedKey},
+			update,
+			options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+		).Decode(&document)
+		return document, err
+	}
+
+	document, err := hit()
+	// A concurrent attempt created the document first, so the retry updates it instead.
+	if mongo.IsDuplicateKeyError(err) {
+		document, err = hit()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make([]time.Time, 0, len(document.Hits))
+	for _, value := range document.Hits {
+		hits = append(hits, value.UTC())
+	}
+
+	return &domain.RateLimitRecord{
+		Key:       strings.TrimSpace(document.Key),
+		Hits:      hits,
+		Allowed:   document.Allowed,
+		ExpiresAt: document.ExpiresAt,
+	}, nil
+}
+
+func getRateLimitsCollection(client *mongo.Client) (*mongo.Collection, error) {
+	databaseConfig, err := appconfig.ResolveDatabaseConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	if client == nil {
+		return nil, ErrMongoClientUnavailable
+	}
+
+	collection := client.Database(databaseConfig.Name).Collection(rateLimitsCollectionName)
+	if err := ensureRateLimitIndexes(collection); err != nil {
+		return nil, err
+	}
+
+	return collection, nil
+}
+
+func ensureRateLimitIndexes(collection *mongo.Collection) error {
+	rateLimitIndexesOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		indexes := []mongo.IndexModel{
+			{
+				Keys:    bson.D{{Key: "key", Value: 1}},
+				Options: options.Index().SetUnique(true).SetName("uniq_rate_limit_key"),
+			},
+			{
+				Keys:    bson.D{{Key: "expiresAt", Value: 1}},
+				Options: options.Index().SetExpireAfterSeconds(0).SetName("ttl_rate_limit_expires"),
+			},
+		}
+
+		if _, err := collection.Indexes().CreateMany(ctx, indexes); err != nil {
+			rateLimitIndexesErr = fmt.Errorf("create rate limit index failed: %w", err)
+		}
+	})
+
+	return rateLimitIndexesErr
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+)
+
+type rateLimitMemoryRepository struct {
+	store *MemoryStore
+}
+
+// NewRateLimitMemoryRepository returns a rate limit repository backed by the given in-memory store. The window is
+// shared by every caller of the store, so it only limits a single process.
+func NewRateLimitMemoryRepository(store *MemoryStore) RateLimitRepository {
+	return &rateLimitMemoryRepository{store: store}
+}
+
+func (r *rateLimitMemoryRepository) Hit(
+	_ context.Context,
+	key string,
+	now time.Time,
+	maxAttempts int,
+	window time.Duration,
+) (*domain.RateLimitRecord, error) {
+	resolvedKey := strings.TrimSpace(key)
+	resolvedNow := now.UTC()
+	windowStart := resolvedNow.Add(-window)
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	hits := make([]time.Time, 0)
+	for _, hit := range r.store.rateLimits[resolvedKey] {
+		if hit.After(windowStart) {
+			hits = append(hits, hit)
+		}
+	}
+
+	allowed := len(hits) < maxAttempts
+	if allowed {
+		hits = append(hits, resolvedNow)
+	}
+
+	var expiresAt time.Time
+	if len(hits) > 0 {
+		expiresAt = slices.MaxFunc(hits, time.Time.Compare).Add(window)
+	}
+
+	r.store.rateLimits[resolvedKey] = hits
+	return &domain.RateLimitRecord{
+		Key:       resolvedKey,
+		Hits:      slices.Clone(hits),
+		Allowed:   allowed,
+		ExpiresAt: expiresAt,
+	}, nil
+}
@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ReaderPostLikeRepository interface {
+	Record(ctx context.Context, readerID, postID string, now time.Time) error
+	ListByReaderID(ctx context.Context, readerID string) ([]domain.ReaderPostLikeRecord, error)
+	DeleteByReaderID(ctx context.Context, readerID string) error
+}
+
+var ErrReaderPostLikeRepositoryUnavailable = errors.New("reader post like repository unavailable")
+
+const (
+	readerPostLikesCollectionName             = "reader_post_likes"
+	readerPostLikeRepositoryUnavailableFormat = "%w: %v"
+)
+
+type readerPostLikeMongoRepository struct {
+	client *mongo.Client
+}
+
+var (
+	readerPostLikeIndexesOnce sync.Once
+	readerPostLikeIndexesErr  error
+)
+
+func NewReaderPostLikeRepository(client *mongo.Client) ReaderPostLikeRepository {
+	return &readerPostLikeMongoRepository{client: client}
+}
+
+// Record adds one like of the post to the reader's history.
+func (repo *readerPostLikeMongoRepository) Record(ctx context.Context, readerID, postID string, now time.Time) error {
+	collection, err := getReaderPostLikesCollection(repo.client)
+	if err != nil {
+		return fmt.Errorf(readerPostLikeRepositoryUnavailableFormat, ErrReaderPostLikeRepositoryUnavailable, err)
+	}
+
+	filter := bson.M{
+		"readerId": strings.TrimSpace(readerID),
+		"postId":   strings.TrimSpace(strings.ToLower(postID)),
+	}
+	update := bson.M{
+		"$inc":         bson.M{"count": 1},
+		"$set":         bson.M{"lastLikeAt": now.UTC()},
+		"$setOnInsert": bson.M{"firstLikeAt": now.UTC()},
+	}
+
+	_, err = collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	// A concurrent like inserted the document first, so the retry only has to update it.
+	if mongo.IsDuplicateKeyError(err) {
+		_, err = collection.UpdateOne(ctx, filter, update)
+	}
+	return err
+}
+
+func (repo *readerPostLikeMongoRepository) ListByReaderID(ctx context.Context, readerID string) ([]domain.ReaderPostLikeRecord, error) {
+	collection, err := getReaderPostLikesCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(readerPostLikeRepositoryUnavailableFormat, ErrReaderPostLikeRepositoryUnavailable, err)
+	}
+
+	cursor, err := collection.Find(
+		ctx,
+		bson.M{"readerId": strings.TrimSpace(readerID)},
+		options.Find().SetSort(bson.D{{Key: "firstLikeAt", Value: 1}, {Key: "postId", Value: 1}}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	type likeDoc struct {
+		ReaderID    string    `bson:"readerId"`
+		PostID      string    `bson:"postId"`
+		Count       int64     `bson:"count"`
+		FirstLikeAt time.Time `bson:"firstLikeAt"`
+		LastLikeAt  time.Time `bson:"lastLikeAt"`
+	}
+
+	likes := make([]domain.ReaderPostLikeRecord, 0)
+	for cursor.Next(ctx) {
+		var doc likeDoc
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+
+		likes = append(likes, domain.ReaderPostLikeRecord{
+			ReaderID:    strings.TrimSpace(doc.ReaderID),
+			PostID:      strings.TrimSpace(doc.PostID),
+			Count:       doc.Count,
+			FirstLikeAt: doc.FirstLikeAt,
+			LastLikeAt:  doc.LastLikeAt,
+		})
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return likes, nil
+}
+
+func (repo *readerPostLikeMongoRepository) DeleteByReaderID(ctx context.Context, readerID string) error {
+	collection, err := getReaderPostLikesCollection(repo.client)
+	if err != nil {
+		return fmt.Errorf(readerPostLikeRepositoryUnavailableFormat, ErrReaderPostLikeRepositoryUnavailable, err)
+	}
+
+	_, err = collection.DeleteMany(ctx, bson.M{"readerId": strings.TrimSpace(readerID)})
+	return err
+}
+
+func getReaderPostLikesCollection(client *mongo.Client) (*mongo.Collection, error) {
+	databaseConfig, err := appconfig.ResolveDatabaseConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	if client == nil {
+		return nil, ErrMongoClientUnavailable
+	}
+
+	collection := client.Database(databaseConfig.Name).Collection(readerPostLikesCollectionName)
+	if err := ensureReaderPostLikeIndexes(collection); err != nil {
+		return nil, err
+	}
+
+	return collection, nil
+}
+
+func ensureReaderPostLikeIndexes(collection *mongo.Collection) error {
+	readerPostLikeIndexesOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		indexes := []mongo.IndexModel{
+			{
+				Keys:    bson.D{{Key: "readerId", Value: 1}, {Key: "postId", Value: 1}},
+				Options: options.Index().SetUnique(true).SetName("uniq_reader_post_like"),
+			},
+		}
+
+		if _, err := collection.Indexes().CreateMany(ctx, indexes); err != nil {
+			readerPostLikeIndexesErr = fmt.Errorf("create reader post like index failed: %w", err)
+		}
+	})
+
+	return readerPostLikeIndexesErr
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+)
+
+type readerPostLikeMemoryRepository struct {
+	store *MemoryStore
+}
+
+// NewReaderPostLikeMemoryRepository returns a reader like history repository backed by the given in-memory store.
+func NewReaderPostLikeMemoryRepository(store *MemoryStore) ReaderPostLikeRepository {
+	return &readerPostLikeMemoryRepository{store: store}
+}
+
+// Record adds one like of the post to the reader's history.
+func (r *readerPostLikeMemoryRepository) Record(_ context.Context, readerID, postID string, now time.Time) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	resolvedReaderID := strings.TrimSpace(readerID)
+	resolvedPostID := strings.TrimSpace(strings.ToLower(postID))
+	for index := range r.store.readerPostLikes {
+		like := &r.store.readerPostLikes[index]
+		if like.ReaderID == resolvedReaderID && like.PostID == resolvedPostID {
+			like.Count++
+			like.LastLikeAt = now.UTC()
+			return nil
+		}
+	}
+
+	r.store.readerPostLikes = append(r.store.readerPostLikes, domain.ReaderPostLikeRecord{
+		ReaderID:    resolvedReaderID,
+		PostID:      resolvedPostID,
+		Count:       1,
+		FirstLikeAt: now.UTC(),
+		LastLikeAt:  now.UTC(),
+	})
+	return nil
+}
+
+func (r *readerPostLikeMemoryRepository) ListByReaderID(_ context.Context, readerID string) ([]domain.ReaderPostLikeRecord, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	resolvedReaderID := strings.TrimSpace(readerID)
+	likes := make([]domain.ReaderPostLikeRecord, 0)
+	for _, like := range r.store.readerPostLikes {
+		if like.ReaderID == resolvedReaderID {
+			likes = append(likes, like)
+		}
+	}
+
+	slices.SortStableFunc(likes, func(left, right domain.ReaderPostLikeRecord) int {
+		if order := left.FirstLikeAt.Compare(right.FirstLikeAt); order != 0 {
+			return order
+		}
+		return strings.Compare(left.PostID, right.PostID)
+	})
+	return likes, nil
+}
+
+func (r *readerPostLikeMemoryRepository) DeleteByReaderID(_ context.Context, readerID string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	resolvedReaderID := strings.TrimSpace(readerID)
+	r.store.readerPostLikes = slices.DeleteFunc(r.store.readerPostLikes, func(like domain.ReaderPostLikeRecord) bool {
+		return like.ReaderID == resolvedReaderID
+	})
+	return nil
+}
@@ -21,8 +21,14 @@ import (
 type ReaderRefreshTokenRepository interface {
 	Create(ctx context.Context, record domain.ReaderRefreshTokenRecord) error
 	FindActiveByToken(ctx context.Context, jti, rawToken string, now time.Time) (*domain.ReaderRefreshTokenRecord, error)
+	FindByToken(ctx context.Context, jti, rawToken string) (*domain.ReaderRefreshTokenRecord, error)
 	Rotate(ctx context.Context, currentJTI string, replacement domain.ReaderRefreshTokenRecord, now time.Time) error
 	RevokeByJTI(ctx context.Context, jti string, now time.Time) error
+	RevokeFamily(ctx context.Context, jti string, now time.Time) (int, error)
+	RevokeAllByUserID(ctx context.Context, userID string, now time.Time) error
+	ListActiveByUserID(ctx context.Context, userID string, now time.Time, limit int) ([]domain.ReaderSessionRecord, error)
+	RevokeByJTIAndUserID(ctx context.Context, jti, userID string, now time.Time) (bool, error)
+	DeleteAllByUserID(ctx context.Context, userID string) error
 }
 
 var (
@@ -35,19 +41,21 @@ const (
 	readerRefreshTokenRepositoryUnavailableFormat = "%w: %v"
 )
 
-type readerRefreshTokenMongoRepository struct{}
+type readerRefreshTokenMongoRepository struct {
+	client *mongo.Client
+}
 
 var (
 	readerRefreshTokenIndexesOnce sync.Once
 	readerRefreshTokenIndexesErr  error
 )
 
-func NewReaderRefreshTokenMongoRepository() ReaderRefreshTokenRepository {
-	return &readerRefreshTokenMongoRepository{}
+func NewReaderRefreshTokenMongoRepository(client *mongo.Client) ReaderRefreshTokenRepository {
+	return &readerRefreshTokenMongoRepository{client: client}
 }
 
-func (*readerRefreshTokenMongoRepository) Create(ctx context.Context, record domain.ReaderRefreshTokenRecord) error {
-	collection, err := getReaderRefreshTokensCollection()
+func (repo *readerRefreshTokenMongoRepository) Create(ctx context.Context, record domain.ReaderRefreshTokenRecord) error {
+	collection, err := getReaderRefreshTokensCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(readerRefreshTokenRepositoryUnavailableFormat, ErrReaderRefreshTokenRepositoryUnavailable, err)
 	}
@@ -76,32 +84,18 @@ func (*readerRefreshTokenMongoRepository) Create(ctx context.Context, record dom
 	return err
 }
 
-func (*readerRefreshTokenMongoRepository) FindActiveByToken(
+func (repo *readerRefreshTokenMongoRepository) FindActiveByToken(
 	ctx context.Context,
 	jti string,
 	rawToken string,
 	now time.Time,
 ) (*domain.ReaderRefreshTokenRecord, error) {
-	collection, err := getReaderRefreshTokensCollection()
+	collection, err := getReaderRefreshTokensCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(readerRefreshTokenRepositoryUnavailableFormat, ErrReaderRefreshTokenRepositoryUnavailable, err)
 	}
 
-	var document struct {
-		JTI         string     `bson:"jti"`
-		UserID      string     `bson:"userId"`
-		TokenHash   string     `bson:"tokenHash"`
-		Persistent  bool       `bson:"persistent"`
-		UserAgent   string     `bson:"userAgent"`
-		RemoteIP    string     `bson:"remoteIP"`
-		CountryCode string     `bson:"countryCode"`
-		LastSeenAt  time.Time  `bson:"lastSeenAt"`
-		ExpiresAt   time.Time  `bson:"expiresAt"`
-		CreatedAt   time.Time  `bson:"createdAt"`
-		RotatedAt   *time.Time `bson:"rotatedAt"`
-		RevokedAt   *time.Time `bson:"revokedAt"`
-		ReplacedBy  string     `bson:"replacedBy"`
-	}
+	var document readerRefreshTokenDocument
 
 	filter := bson.M{
 		"$and": bson.A{
@@ -123,34 +117,46 @@ func (*readerRefreshTokenMongoRepository) FindActiveByToken(
 		return nil, nil
 	}
 
-	return &domain.ReaderRefreshTokenRecord{
-		JTI:         document.JTI,
-		UserID:      document.UserID,
-		TokenHash:   document.TokenHash,
-		Persistent:  document.Persistent,
-		UserAgent:   document.UserAgent,
-		RemoteIP:    document.RemoteIP,
-		CountryCode: strings.TrimSpace(strings.ToUpper(document.CountryCode)),
-		LastSeenAt:  document.LastSeenAt,
-		ExpiresAt:   document.ExpiresAt,
-		CreatedAt:   document.CreatedAt,
-		RotatedAt:   document.RotatedAt,
-		RevokedAt:   document.RevokedAt,
-		ReplacedBy:  document.ReplacedBy,
-	}, nil
+	record := mapReaderRefreshTokenDocument(document)
+	return &record, nil
+}
+
+// FindByToken loads a refresh token whatever its state, so a replayed token that was already rotated can be told apart
+// from one that never existed.
+func (repo *readerRefreshTokenMongoRepository) FindByToken(
+	ctx context.Context,
+	jti string,
+	rawToken string,
+) (*domain.ReaderRefreshTokenRecord, error) {
+	collection, err := getReaderRefreshTokensCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(readerRefreshTokenRepositoryUnavailableFormat, ErrReaderRefreshTokenRepositoryUnavailable, err)
+	}
+
+	var document readerRefreshTokenDocument
+	err = collection.FindOne(ctx, bson.M{"jti": strings.TrimSpace(jti)}).Decode(&document)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(document.TokenHash, HashReaderRefreshToken(rawToken)) {
+		return nil, nil
+	}
+
+	record := mapReaderRefreshTokenDocument(document)
+	return &record, nil
 }
 
-func (*readerRefreshTokenMongoRepository) Rotate(
+func (repo *readerRefreshTokenMongoRepository) Rotate(
 	ctx context.Context,
 	currentJTI string,
 	replacement domain.ReaderRefreshTokenRecord,
 	now time.Time,
 ) error {
-	collection, err := getReaderRefreshTokensCollection()
-	if err != nil {
-		return fmt.Errorf(readerRefreshTokenRepositoryUnavailableFormat, ErrReaderRefreshTokenRepositoryUnavailable, err)
-	}
-	client, err := getAdminMongoClient()
+	collection, err := getReaderRefreshTokensCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(readerRefreshTokenRepositoryUnavailableFormat, ErrReaderRefreshTokenRepositoryUnavailable, err)
 	}
@@ -168,7 +174,7 @@ func (*readerRefreshTokenMongoRepository) Rotate(
 		"createdAt":   replacement.CreatedAt,
 	}
 
-	session, err := client.StartSession()
+	session, err := repo.client.StartSession()
 	if err != nil {
 		return err
 	}
@@ -208,8 +214,8 @@ func (*readerRefreshTokenMongoRepository) Rotate(
 	return err
 }
 
-func (*readerRefreshTokenMongoRepository) RevokeByJTI(ctx context.Context, jti string, now time.Time) error {
-	collection, err := getReaderRefreshTokensCollection()
+func (repo *readerRefreshTokenMongoRepository) RevokeByJTI(ctx context.Context, jti string, now time.Time) error {
+	collection, err := getReaderRefreshTokensCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(readerRefreshTokenRepositoryUnavailableFormat, ErrReaderRefreshTokenRepositoryUnavailable, err)
 	}
@@ -227,17 +233,235 @@ func (*readerRefreshTokenMongoRepository) RevokeByJTI(ctx context.Context, jti s
 	return err
 }
 
-func getReaderRefreshTokensCollection() (*mongo.Collection, error) {
-	databaseConfig, err := appconfig.ResolveDatabaseConfig()
+// RevokeFamily revokes jti and every token that was issued by rotating it, following the replacedBy links. It returns
+// how many of those tokens were still unrevoked.
+func (repo *readerRefreshTokenMongoRepository) RevokeFamily(ctx context.Context, jti string, now time.Time) (int, error) {
+	collection, err := getReaderRefreshTokensCollection(repo.client)
+	if err != nil {
+		return 0, fmt.Errorf(readerRefreshTokenRepositoryUnavailableFormat, ErrReaderRefreshTokenRepositoryUnavailable, err)
+	}
+
+	cursor, err := collection.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"jti": strings.TrimSpace(jti)}}},
+		{{Key: "$graphLookup", Value: bson.M{
+			"from":             readerRefreshTokensCollectionName,
+			"startWith":        "$replacedBy",
+			"connectFromField": "replacedBy",
+			"connectToField":   "jti",
+			"as":               "descendants",
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"jtis": bson.M{"$concatArrays": bson.A{bson.A{"$jti"}, "$descendants.jti"}},
+		}}},
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	var family struct {
+		JTIs []string `bson:"jtis"`
+	}
+	if !cursor.Next(ctx) {
+		return 0, cursor.Err()
+	}
+	if err := cursor.Decode(&family); err != nil {
+		return 0, err
+	}
+
+	result, err := collection.UpdateMany(
+		ctx,
+		bson.M{
+			"$and": bson.A{
+				bson.M{"jti": bson.M{"$in": family.JTIs}},
+				unsetOrNullFilter("revokedAt"),
+			},
+		},
+		bson.M{"$set": bson.M{"revokedAt": now}},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(result.ModifiedCount), nil
+}
+
+func (repo *readerRefreshTokenMongoRepository) RevokeAllByUserID(ctx context.Context, userID string, now time.Time) error {
+	collection, err := getReaderRefreshTokensCollection(repo.client)
+	if err != nil {
+		return fmt.Errorf(readerRefreshTokenRepositoryUnavailableFormat, ErrReaderRefreshTokenRepositoryUnavailable, err)
+	}
+
+	_, err = collection.UpdateMany(
+		ctx,
+		bson.M{
+			"$and": bson.A{
+				bson.M{"userId": strings.TrimSpace(userID)},
+				unsetOrNullFilter("revokedAt"),
+			},
+		},
+		bson.M{
+			"$set": bson.M{
+				"revokedAt": now,
+			},
+		},
+	)
+	return err
+}
+
+func (repo *readerRefreshTokenMongoRepository) ListActiveByUserID(
+	ctx context.Context,
+	userID string,
+	now time.Time,
+	limit int,
+) ([]domain.ReaderSessionRecord, error) {
+	collection, err := getReaderRefreshTokensCollection(repo.client)
+	if err != nil {
+		return nil, fmt.Errorf(readerRefreshTokenRepositoryUnavailableFormat, ErrReaderRefreshTokenRepositoryUnavailable, err)
+	}
+
+	resolvedLimit := limit
+	if resolvedLimit <= 0 || resolvedLimit > 100 {
+		resolvedLimit = 20
+	}
+
+	cursor, err := collection.Find(
+		ctx,
+		bson.M{
+			"$and": bson.A{
+				bson.M{"userId": strings.TrimSpace(userID)},
+				bson.M{"expiresAt": bson.M{"$gt": now}},
+				unsetOrNullFilter("revokedAt"),
+				unsetOrNullFilter("rotatedAt"),
+			},
+		},
+		options.Find().
+			SetSort(bson.D{{Key: "lastSeenAt", Value: -1}, {Key: "createdAt", Value: -1}}).
+			SetLimit(int64(resolvedLimit)),
+	)
 	if err != nil {
 		return nil, err
 	}
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	sessions := make([]domain.ReaderSessionRecord, 0, resolvedLimit)
+	for cursor.Next(ctx) {
+		var document readerRefreshTokenDocument
+		if err := cursor.Decode(&document); err != nil {
+			return nil, err
+		}
+
+		record := mapReaderRefreshTokenDocument(document)
+		sessions = append(sessions, domain.ReaderSessionRecord{
+			ID:          strings.TrimSpace(record.JTI),
+			UserAgent:   strings.TrimSpace(record.UserAgent),
+			RemoteIP:    strings.TrimSpace(record.RemoteIP),
+			CountryCode: record.CountryCode,
+			LastSeenAt:  record.LastSeenAt,
+			CreatedAt:   record.CreatedAt,
+			ExpiresAt:   record.ExpiresAt,
+			Persistent:  record.Persistent,
+		})
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+func (repo *readerRefreshTokenMongoRepository) RevokeByJTIAndUserID(
+	ctx context.Context,
+	jti, userID string,
+	now time.Time,
+) (bool, error) {
+	collection, err := getReaderRefreshTokensCollection(repo.client)
+	if err != nil {
+		return false, fmt.Errorf(readerRefreshTokenRepositoryUnavailableFormat, ErrReaderRefreshTokenRepositoryUnavailable, err)
+	}
+
+	result, err := collection.UpdateOne(
+		ctx,
+		bson.M{
+			"$and": bson.A{
+				bson.M{"jti": strings.TrimSpace(jti)},
+				bson.M{"userId": strings.TrimSpace(userID)},
+				unsetOrNullFilter("revokedAt"),
+				unsetOrNullFilter("rotatedAt"),
+			},
+		},
+		bson.M{
+			"$set": bson.M{
+				"revokedAt": now,
+			},
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return result.MatchedCount > 0, nil
+}
+
+func (repo *readerRefreshTokenMongoRepository) DeleteAllByUserID(ctx context.Context, userID string) error {
+	collection, err := getReaderRefreshTokensCollection(repo.client)
+	if err != nil {
+		return fmt.Errorf(readerRefreshTokenRepositoryUnavailableFormat, ErrReaderRefreshTokenRepositoryUnavailable, err)
+	}
+
+	_, err = collection.DeleteMany(ctx, bson.M{"userId": strings.TrimSpace(userID)})
+	return err
+}
+
+type readerRefreshTokenDocument struct {
+	JTI         string     `bson:"jti"`
+	UserID      string     `bson:"userId"`
+	TokenHash   string     `bson:"tokenHash"`
+	Persistent  bool       `bson:"persistent"`
+	UserAgent   string     `bson:"userAgent"`
+	RemoteIP    string     `bson:"remoteIP"`
+	CountryCode string     `bson:"countryCode"`
+	LastSeenAt  time.Time  `bson:"lastSeenAt"`
+	ExpiresAt   time.Time  `bson:"expiresAt"`
+	CreatedAt   time.Time  `bson:"createdAt"`
+	RotatedAt   *time.Time `bson:"rotatedAt"`
+	RevokedAt   *time.Time `bson:"revokedAt"`
+	ReplacedBy  string     `bson:"replacedBy"`
+}
+
+func mapReaderRefreshTokenDocument(document readerRefreshTokenDocument) domain.ReaderRefreshTokenRecord {
+	return domain.ReaderRefreshTokenRecord{
+		JTI:         document.JTI,
+		UserID:      document.UserID,
+		TokenHash:   document.TokenHash,
+		Persistent:  document.Persistent,
+		UserAgent:   document.UserAgent,
+		RemoteIP:    document.RemoteIP,
+		CountryCode: strings.TrimSpace(strings.ToUpper(document.CountryCode)),
+		LastSeenAt:  document.LastSeenAt,
+		ExpiresAt:   document.ExpiresAt,
+		CreatedAt:   document.CreatedAt,
+		RotatedAt:   document.RotatedAt,
+		RevokedAt:   document.RevokedAt,
+		ReplacedBy:  document.ReplacedBy,
+	}
+}
 
-	client, err := getAdminMongoClient()
+func getReaderRefreshTokensCollection(client *mongo.Client) (*mongo.Collection, error) {
+	databaseConfig, err := appconfig.ResolveDatabaseConfig()
 	if err != nil {
 		return nil, err
 	}
 
+	if client == nil {
+		return nil, ErrMongoClientUnavailable
+	}
+
 	collection := client.Database(databaseConfig.Name).Collection(readerRefreshTokensCollectionName)
 	if err := ensureReaderRefreshTokenIndexes(collection); err != nil {
 		return nil, err
@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+)
+
+type readerRefreshTokenMemoryRepository struct {
+	store *MemoryStore
+}
+
+// NewReaderRefreshTokenMemoryRepository returns a reader refresh token repository backed by the given in-memory store.
+func NewReaderRefreshTokenMemoryRepository(store *MemoryStore) ReaderRefreshTokenRepository {
+	return &readerRefreshTokenMemoryRepository{store: store}
+}
+
+func (r *readerRefreshTokenMemoryRepository) Create(_ context.Context, record domain.ReaderRefreshTokenRecord) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.readerRefreshTokens.insert(memoryRefreshToken(record))
+	return nil
+}
+
+func (r *readerRefreshTokenMemoryRepository) FindActiveByToken(
+	_ context.Context,
+	jti string,
+	rawToken string,
+	now time.Time,
+) (*domain.ReaderRefreshTokenRecord, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	token, ok := r.store.readerRefreshTokens.find(jti, HashReaderRefreshToken(rawToken))
+	if !ok || !token.active(now) {
+		return nil, nil
+	}
+
+	record := domain.ReaderRefreshTokenRecord(token)
+	return &record, nil
+}
+
+func (r *readerRefreshTokenMemoryRepository) FindByToken(
+	_ context.Context,
+	jti string,
+	rawToken string,
+) (*domain.ReaderRefreshTokenRecord, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	token, ok := r.store.readerRefreshTokens.find(jti, HashReaderRefreshToken(rawToken))
+	if !ok {
+		return nil, nil
+	}
+
+	record := domain.ReaderRefreshTokenRecord(token)
+	return &record, nil
+}
+
+func (r *readerRefreshTokenMemoryRepository) Rotate(
+	_ context.Context,
+	currentJTI string,
+	replacement domain.ReaderRefreshTokenRecord,
+	now time.Time,
+) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	// Like the Mongo transaction, the replacement starts without rotation or revocation state.
+	replacement.RotatedAt = nil
+	replacement.RevokedAt = nil
+	replacement.ReplacedBy = ""
+	if !r.store.readerRefreshTokens.rotate(currentJTI, memoryRefreshToken(replacement), now, false) {
+		return ErrReaderRefreshTokenNotFound
+	}
+	return nil
+}
+
+func (r *readerRefreshTokenMemoryRepository) RevokeByJTI(_ context.Context, jti string, now time.Time) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	resolvedJTI := strings.TrimSpace(jti)
+	r.store.readerRefreshTokens.revoke(now, func(token memoryRefreshToken) bool {
+		return token.JTI == resolvedJTI
+	})
+	return nil
+}
+
+func (r *readerRefreshTokenMemoryRepository) RevokeFamily(_ context.Context, jti string, now time.Time) (int, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return r.store.readerRefreshTokens.revokeFamily(jti, now), nil
+}
+
+func (r *readerRefreshTokenMemoryRepository) RevokeAllByUserID(_ context.Context, userID string, now time.Time) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	resolvedUserID := strings.TrimSpace(userID)
+	r.store.readerRefreshTokens.revokeAll(now, func(token memoryRefreshToken) bool {
+		return token.UserID == resolvedUserID
+	})
+	return nil
+}
+
+func (r *readerRefreshTokenMemoryRepository) ListActiveByUserID(
+	_ context.Context,
+	userID string,
+	now time.Time,
+	limit int,
+) ([]domain.ReaderSessionRecord, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	tokens := r.store.readerRefreshTokens.listActive(userID, now, resolveMemorySessionLimit(limit))
+	sessions := make([]domain.ReaderSessionRecord, 0, len(tokens))
+	for _, token := range tokens {
+		sessions = append(sessions, domain.ReaderSessionRecord{
+			ID:          token.JTI,
+			UserAgent:   token.UserAgent,
+			RemoteIP:    token.RemoteIP,
+			CountryCode: token.CountryCode,
+			LastSeenAt:  token.LastSeenAt,
+			CreatedAt:   token.CreatedAt,
+			ExpiresAt:   token.ExpiresAt,
+			Persistent:  token.Persistent,
+		})
+	}
+
+	return sessions, nil
+}
+
+func (r *readerRefreshTokenMemoryRepository) RevokeByJTIAndUserID(
+	_ context.Context,
+	jti, userID string,
+	now time.Time,
+) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	resolvedJTI := strings.TrimSpace(jti)
+	resolvedUserID := strings.TrimSpace(userID)
+	return r.store.readerRefreshTokens.revoke(now, func(token memoryRefreshToken) bool {
+		return token.JTI == resolvedJTI && token.UserID == resolvedUserID && token.RotatedAt == nil
+	}), nil
+}
+
+func (r *readerRefreshTokenMemoryRepository) DeleteAllByUserID(_ context.Context, userID string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.readerRefreshTokens.deleteByUserID(userID)
+	return nil
+}
@@ -25,6 +25,10 @@ type ReaderUserRepository interface {
 	UpdateGoogleIdentityByID(ctx context.Context, id, subject, email, name, avatarURL string, linkedAt time.Time) error
 	UpdateGithubIdentityByID(ctx context.Context, id, subject, email, name, avatarURL string, linkedAt time.Time) error
 	UpdateLastSeenProviderByID(ctx context.Context, id, provider string) error
+	IncrementSessionVersionByID(ctx context.Context, id string) error
+	UpdateNameByID(ctx context.Context, id, name string) error
+	UnlinkProviderByID(ctx context.Context, id, provider string) (bool, error)
+	DeleteByID(ctx context.Context, id string) (bool, error)
 }
 
 var (
@@ -33,6 +37,7 @@ var (
 	ErrReaderEmailAlreadyExists        = errors.New("reader email already exists")
 	ErrReaderGoogleAlreadyExists       = errors.New("reader google subject already exists")
 	ErrReaderGithubAlreadyExists       = errors.New("reader github subject already exists")
+	ErrReaderProviderUnsupported       = errors.New("reader provider is not supported")
 )
 
 const (
@@ -40,19 +45,21 @@ const (
 	readerUsersRepositoryUnavailableFormat = "%w: %v"
 )
 
-type readerMongoRepository struct{}
+type readerMongoRepository struct {
+	client *mongo.Client
+}
 
 var (
 	readerUserIndexesOnce sync.Once
 	readerUserIndexesErr  error
 )
 
-func NewReaderUserRepository() ReaderUserRepository {
-	return &readerMongoRepository{}
+func NewReaderUserRepository(client *mongo.Client) ReaderUserRepository {
+	return &readerMongoRepository{client: client}
 }
 
-func (*readerMongoRepository) FindByID(ctx context.Context, id string) (*domain.ReaderUserRecord, error) {
-	collection, err := getReaderUsersCollection()
+func (repo *readerMongoRepository) FindByID(ctx context.Context, id string) (*domain.ReaderUserRecord, error) {
+	collection, err := getReaderUsersCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(readerUsersRepositoryUnavailableFormat, ErrReaderUserRepositoryUnavailable, err)
 	}
@@ -63,8 +70,8 @@ func (*readerMongoRepository) FindByID(ctx context.Context, id string) (*domain.
 	})
 }
 
-func (*readerMongoRepository) FindByEmail(ctx context.Context, email string) (*domain.ReaderUserRecord, error) {
-	collection, err := getReaderUsersCollection()
+func (repo *readerMongoRepository) FindByEmail(ctx context.Context, email string) (*domain.ReaderUserRecord, error) {
+	collection, err := getReaderUsersCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(readerUsersRepositoryUnavailableFormat, ErrReaderUserRepositoryUnavailable, err)
 	}
@@ -75,8 +82,8 @@ func (*readerMongoRepository) FindByEmail(ctx context.Context, email string) (*d
 	})
 }
 
-func (*readerMongoRepository) FindByGoogleSubject(ctx context.Context, subject string) (*domain.ReaderUserRecord, error) {
-	collection, err := getReaderUsersCollection()
+func (repo *readerMongoRepository) FindByGoogleSubject(ctx context.Context, subject string) (*domain.ReaderUserRecord, error) {
+	collection, err := getReaderUsersCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(readerUsersRepositoryUnavailableFormat, ErrReaderUserRepositoryUnavailable, err)
 	}
@@ -87,8 +94,8 @@ func (*readerMongoRepository) FindByGoogleSubject(ctx context.Context, subject s
 	})
 }
 
-func (*readerMongoRepository) FindByGithubSubject(ctx context.Context, subject string) (*domain.ReaderUserRecord, error) {
-	collection, err := getReaderUsersCollection()
+func (repo *readerMongoRepository) FindByGithubSubject(ctx context.Context, subject string) (*domain.ReaderUserRecord, error) {
+	collection, err := getReaderUsersCollection(repo.client)
 	if err != nil {
 		return nil, fmt.Errorf(readerUsersRepositoryUnavailableFormat, ErrReaderUserRepositoryUnavailable, err)
 	}
@@ -99,8 +106,8 @@ func (*readerMongoRepository) FindByGithubSubject(ctx context.Context, subject s
 	})
 }
 
-func (*readerMongoRepository) Create(ctx context.Context, record domain.ReaderUserRecord) error {
-	collection, err := getReaderUsersCollection()
+func (repo *readerMongoRepository) Create(ctx context.Context, record domain.ReaderUserRecord) error {
+	collection, err := getReaderUsersCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(readerUsersRepositoryUnavailableFormat, ErrReaderUserRepositoryUnavailable, err)
 	}
@@ -148,12 +155,12 @@ func (*readerMongoRepository) Create(ctx context.Context, record domain.ReaderUs
 	return nil
 }
 
-func (*readerMongoRepository) UpdateGoogleIdentityByID(
+func (repo *readerMongoRepository) UpdateGoogleIdentityByID(
 	ctx context.Context,
 	id, subject, email, name, avatarURL string,
 	linkedAt time.Time,
 ) error {
-	collection, err := getReaderUsersCollection()
+	collection, err := getReaderUsersCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(readerUsersRepositoryUnavailableFormat, ErrReaderUserRepositoryUnavailable, err)
 	}
@@ -187,12 +194,12 @@ func (*readerMongoRepository) UpdateGoogleIdentityByID(
 	return nil
 }
 
-func (*readerMongoRepository) UpdateGithubIdentityByID(
+func (repo *readerMongoRepository) UpdateGithubIdentityByID(
 	ctx context.Context,
 	id, subject, email, name, avatarURL string,
 	linkedAt time.Time,
 ) error {
-	collection, err := getReaderUsersCollection()
+	collection, err := getReaderUsersCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(readerUsersRepositoryUnavailableFormat, ErrReaderUserRepositoryUnavailable, err)
 	}
@@ -226,8 +233,8 @@ func (*readerMongoRepository) UpdateGithubIdentityByID(
 	return nil
 }
 
-func (*readerMongoRepository) UpdateLastSeenProviderByID(ctx context.Context, id, provider string) error {
-	collection, err := getReaderUsersCollection()
+func (repo *readerMongoRepository) UpdateLastSeenProviderByID(ctx context.Context, id, provider string) error {
+	collection, err := getReaderUsersCollection(repo.client)
 	if err != nil {
 		return fmt.Errorf(readerUsersRepositoryUnavailableFormat, ErrReaderUserRepositoryUnavailable, err)
 	}
@@ -252,17 +259,125 @@ func (*readerMongoRepository) UpdateLastSeenProviderByID(ctx context.Context, id
 	return nil
 }
 
-func getReaderUsersCollection() (*mongo.Collection, error) {
-	databaseConfig, err := appconfig.ResolveDatabaseConfig()
+func (repo *readerMongoRepository) IncrementSessionVersionByID(ctx context.Context, id string) error {
+	collection, err := getReaderUsersCollection(repo.client)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf(readerUsersRepositoryUnavailableFormat, ErrReaderUserRepositoryUnavailable, err)
+	}
+
+	result, err := collection.UpdateOne(
+		ctx,
+		bson.M{"id": strings.TrimSpace(id)},
+		// Older documents have no version and are read as version 1, so they move straight to 2.
+		mongo.Pipeline{
+			{{Key: "$set", Value: bson.M{
+				"sessionVersion": bson.M{"$add": bson.A{
+					bson.M{"$max": bson.A{bson.M{"$ifNull": bson.A{"$sessionVersion", 1}}, 1}},
+					1,
+				}},
+				"updatedAt": time.Now().UTC(),
+			}}},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrReaderUserNotFound
+	}
+
+	return nil
+}
+
+// UpdateNameByID stores a display name chosen by the reader and marks it as customized.
+func (repo *readerMongoRepository) UpdateNameByID(ctx context.Context, id, name string) error {
+	collection, err := getReaderUsersCollection(repo.client)
+	if err != nil {
+		return fmt.Errorf(readerUsersRepositoryUnavailableFormat, ErrReaderUserRepositoryUnavailable, err)
 	}
 
-	client, err := getAdminMongoClient()
+	result, err := collection.UpdateOne(
+		ctx,
+		bson.M{"id": strings.TrimSpace(id), "status": bson.M{"$ne": "disabled"}},
+		bson.M{
+			"$set": bson.M{
+				"name":           strings.TrimSpace(name),
+				"nameCustomized": true,
+				"updatedAt":      time.Now().UTC(),
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrReaderUserNotFound
+	}
+
+	return nil
+}
+
+// UnlinkProviderByID removes the google or github identity of a reader. It reports whether one was linked.
+func (repo *readerMongoRepository) UnlinkProviderByID(ctx context.Context, id, provider string) (bool, error) {
+	resolvedProvider := strings.TrimSpace(strings.ToLower(provider))
+	if resolvedProvider != "google" && resolvedProvider != "github" {
+		return false, ErrReaderProviderUnsupported
+	}
+
+	collection, err := getReaderUsersCollection(repo.client)
+	if err != nil {
+		return false, fmt.Errorf(readerUsersRepositoryUnavailableFormat, ErrReaderUserRepositoryUnavailable, err)
+	}
+
+	result, err := collection.UpdateOne(
+		ctx,
+		bson.M{
+			"id":                         strings.TrimSpace(id),
+			"status":                     bson.M{"$ne": "disabled"},
+			resolvedProvider + "Subject": bson.M{"$exists": true},
+		},
+		bson.M{
+			"$unset": bson.M{
+				resolvedProvider + "Subject":  "",
+				resolvedProvider + "Email":    "",
+				resolvedProvider + "LinkedAt": "",
+			},
+			"$set": bson.M{
+				"updatedAt": time.Now().UTC(),
+			},
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return result.ModifiedCount > 0, nil
+}
+
+func (repo *readerMongoRepository) DeleteByID(ctx context.Context, id string) (bool, error) {
+	collection, err := getReaderUsersCollection(repo.client)
+	if err != nil {
+		return false, fmt.Errorf(readerUsersRepositoryUnavailableFormat, ErrReaderUserRepositoryUnavailable, err)
+	}
+
+	result, err := collection.DeleteOne(ctx, bson.M{"id": strings.TrimSpace(id)})
+	if err != nil {
+		return false, err
+	}
+
+	return result.DeletedCount > 0, nil
+}
+
+func getReaderUsersCollection(client *mongo.Client) (*mongo.Collection, error) {
+	databaseConfig, err := appconfig.ResolveDatabaseConfig()
 	if err != nil {
 		return nil, err
 	}
 
+	if client == nil {
+		return nil, ErrMongoClientUnavailable
+	}
+
 	collection := client.Database(databaseConfig.Name).Collection(readerUsersCollectionName)
 	if err := ensureReaderUserIndexes(collection); err != nil {
 		return nil, err
@@ -361,6 +476,8 @@ func findReaderUser(ctx context.Context, collection *mongo.Collection, filter bs
 		GithubEmail       string     `bson:"githubEmail"`
 		GithubLinkedAt    *time.Time `bson:"githubLinkedAt"`
 		SessionVersion    int64      `bson:"sessionVersion"`
+		NameCustomized    bool       `bson:"nameCustomized"`
+		CreatedAt         time.Time  `bson:"createdAt"`
 	}
 
 	err := collection.FindOne(ctx, filter).Decode(&doc)
@@ -394,5 +511,7 @@ func findReaderUser(ctx context.Context, collection *mongo.Collection, filter bs
 			GithubLinkedAt:    doc.GithubLinkedAt,
 		},
 		SessionVersion: sessionVersion,
+		NameCustomized: doc.NameCustomized,
+		CreatedAt:      doc.CreatedAt,
 	}, nil
 }
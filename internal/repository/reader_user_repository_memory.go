@@ -0,0 +1,258 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+)
+
+type readerUserMemoryRepository struct {
+	store *MemoryStore
+}
+
+// NewReaderUserMemoryRepository returns a reader user repository backed by the given in-memory store.
+func NewReaderUserMemoryRepository(store *MemoryStore) ReaderUserRepository {
+	return &readerUserMemoryRepository{store: store}
+}
+
+func (r *readerUserMemoryRepository) FindByID(_ context.Context, id string) (*domain.ReaderUserRecord, error) {
+	resolvedID := strings.TrimSpace(id)
+	return r.find(func(record *domain.ReaderUserRecord) bool { return record.ID == resolvedID }), nil
+}
+
+func (r *readerUserMemoryRepository) FindByEmail(_ context.Context, email string) (*domain.ReaderUserRecord, error) {
+	resolvedEmail := strings.TrimSpace(strings.ToLower(email))
+	return r.find(func(record *domain.ReaderUserRecord) bool { return record.Email == resolvedEmail }), nil
+}
+
+func (r *readerUserMemoryRepository) FindByGoogleSubject(_ context.Context, subject string) (*domain.ReaderUserRecord, error) {
+	resolvedSubject := strings.TrimSpace(subject)
+	return r.find(func(record *domain.ReaderUserRecord) bool {
+		return record.GoogleSubject != "" && record.GoogleSubject == resolvedSubject
+	}), nil
+}
+
+func (r *readerUserMemoryRepository) FindByGithubSubject(_ context.Context, subject string) (*domain.ReaderUserRecord, error) {
+	resolvedSubject := strings.TrimSpace(subject)
+	return r.find(func(record *domain.ReaderUserRecord) bool {
+		return record.GithubSubject != "" && record.GithubSubject == resolvedSubject
+	}), nil
+}
+
+func (r *readerUserMemoryRepository) Create(_ context.Context, record domain.ReaderUserRecord) error {
+	now := time.Now().UTC()
+	stored := domain.ReaderUserRecord{
+		ReaderUser: domain.ReaderUser{
+			ID:                strings.TrimSpace(record.ID),
+			Name:              strings.TrimSpace(record.Name),
+			Email:             strings.TrimSpace(strings.ToLower(record.Email)),
+			AvatarURL:         strings.TrimSpace(record.AvatarURL),
+			LastLoginProvider: strings.TrimSpace(record.LastLoginProvider),
+			GoogleLinkedAt:    cloneMemoryValue(record.GoogleLinkedAt),
+			GithubLinkedAt:    cloneMemoryValue(record.GithubLinkedAt),
+		},
+		SessionVersion: record.SessionVersion,
+		CreatedAt:      now,
+	}
+	if googleSubject := strings.TrimSpace(record.GoogleSubject); googleSubject != "" {
+		stored.GoogleSubject = googleSubject
+		stored.GoogleEmail = strings.TrimSpace(strings.ToLower(record.GoogleEmail))
+	}
+	if githubSubject := strings.TrimSpace(record.GithubSubject); githubSubject != "" {
+		stored.GithubSubject = githubSubject
+		stored.GithubEmail = strings.TrimSpace(strings.ToLower(record.GithubEmail))
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if r.conflicts(&stored) {
+		if stored.GoogleSubject != "" {
+			return ErrReaderGoogleAlreadyExists
+		}
+		if stored.GithubSubject != "" {
+			return ErrReaderGithubAlreadyExists
+		}
+		return ErrReaderEmailAlreadyExists
+	}
+
+	r.store.readerUsers = append(r.store.readerUsers, stored)
+	return nil
+}
+
+func (r *readerUserMemoryRepository) UpdateGoogleIdentityByID(
+	_ context.Context,
+	id, subject, email, name, avatarURL string,
+	linkedAt time.Time,
+) error {
+	return r.update(id, ErrReaderGoogleAlreadyExists, func(record *domain.ReaderUserRecord) {
+		resolvedLinkedAt := linkedAt
+		record.Name = strings.TrimSpace(name)
+		record.Email = strings.TrimSpace(strings.ToLower(email))
+		record.AvatarURL = strings.TrimSpace(avatarURL)
+		record.GoogleSubject = strings.TrimSpace(subject)
+		record.GoogleEmail = record.Email
+		record.GoogleLinkedAt = &resolvedLinkedAt
+		record.LastLoginProvider = "google"
+	})
+}
+
+func (r *readerUserMemoryRepository) UpdateGithubIdentityByID(
+	_ context.Context,
+	id, subject, email, name, avatarURL string,
+	linkedAt time.Time,
+) error {
+	return r.update(id, ErrReaderGithubAlreadyExists, func(record *domain.ReaderUserRecord) {
+		resolvedLinkedAt := linkedAt
+		record.Name = strings.TrimSpace(name)
+		record.Email = strings.TrimSpace(strings.ToLower(email))
+		record.AvatarURL = strings.TrimSpace(avatarURL)
+		record.GithubSubject = strings.TrimSpace(subject)
+		record.GithubEmail = record.Email
+		record.GithubLinkedAt = &resolvedLinkedAt
+		record.LastLoginProvider = "github"
+	})
+}
+
+func (r *readerUserMemoryRepository) UpdateLastSeenProviderByID(_ context.Context, id, provider string) error {
+	return r.update(id, nil, func(record *domain.ReaderUserRecord) {
+		record.LastLoginProvider = strings.TrimSpace(provider)
+	})
+}
+
+func (r *readerUserMemoryRepository) IncrementSessionVersionByID(_ context.Context, id string) error {
+	return r.update(id, nil, func(record *domain.ReaderUserRecord) {
+		// Records without a version are read as version 1, so they move straight to 2.
+		record.SessionVersion = max(record.SessionVersion, 1) + 1
+	})
+}
+
+// UpdateNameByID stores a display name chosen by the reader and marks it as customized.
+func (r *readerUserMemoryRepository) UpdateNameByID(_ context.Context, id, name string) error {
+	return r.update(id, nil, func(record *domain.ReaderUserRecord) {
+		record.Name = strings.TrimSpace(name)
+		record.NameCustomized = true
+	})
+}
+
+// UnlinkProviderByID removes the google or github identity of a reader. It reports whether one was linked.
+func (r *readerUserMemoryRepository) UnlinkProviderByID(_ context.Context, id, provider string) (bool, error) {
+	resolvedProvider := strings.TrimSpace(strings.ToLower(provider))
+	if resolvedProvider != "google" && resolvedProvider != "github" {
+		return false, ErrReaderProviderUnsupported
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record := r.indexByID(id)
+	if record == nil {
+		return false, nil
+	}
+
+	switch resolvedProvider {
+	case "google":
+		if record.GoogleSubject == "" {
+			return false, nil
+		}
+		record.GoogleSubject = ""
+		record.GoogleEmail = ""
+		record.GoogleLinkedAt = nil
+	default:
+		if record.GithubSubject == "" {
+			return false, nil
+		}
+		record.GithubSubject = ""
+		record.GithubEmail = ""
+		record.GithubLinkedAt = nil
+	}
+
+	return true, nil
+}
+
+func (r *readerUserMemoryRepository) DeleteByID(_ context.Context, id string) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	resolvedID := strings.TrimSpace(id)
+	before := len(r.store.readerUsers)
+	r.store.readerUsers = slices.DeleteFunc(r.store.readerUsers, func(record domain.ReaderUserRecord) bool {
+		return record.ID == resolvedID
+	})
+	return len(r.store.readerUsers) < before, nil
+}
+
+func (r *readerUserMemoryRepository) find(match func(*domain.ReaderUserRecord) bool) *domain.ReaderUserRecord {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for index := range r.store.readerUsers {
+		record := &r.store.readerUsers[index]
+		if record.ID == "" || record.Email == "" || !match(record) {
+			continue
+		}
+
+		mapped := cloneMemoryReaderUser(*record)
+		mapped.SessionVersion = max(mapped.SessionVersion, 1)
+		return &mapped
+	}
+	return nil
+}
+
+// indexByID returns the stored reader with id. Callers hold the store lock.
+func (r *readerUserMemoryRepository) indexByID(id string) *domain.ReaderUserRecord {
+	resolvedID := strings.TrimSpace(id)
+	for index := range r.store.readerUsers {
+		if r.store.readerUsers[index].ID == resolvedID {
+			return &r.store.readerUsers[index]
+		}
+	}
+	return nil
+}
+
+// conflicts reports whether another reader already holds the id, email or a provider subject of record, the keys
+// that carry unique indexes in Mongo. Callers hold the store lock.
+func (r *readerUserMemoryRepository) conflicts(record *domain.ReaderUserRecord) bool {
+	for index := range r.store.readerUsers {
+		other := &r.store.readerUsers[index]
+		if other == record {
+			continue
+		}
+		if other.ID == record.ID || other.Email == record.Email ||
+			(record.GoogleSubject != "" && other.GoogleSubject == record.GoogleSubject) ||
+			(record.GithubSubject != "" && other.GithubSubject == record.GithubSubject) {
+			return true
+		}
+	}
+	return false
+}
+
+// update applies the change to the reader with id. When the change would break a unique key it is dropped and
+// duplicateErr returned.
+func (r *readerUserMemoryRepository) update(id string, duplicateErr error, apply func(*domain.ReaderUserRecord)) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record := r.indexByID(id)
+	if record == nil {
+		return ErrReaderUserNotFound
+	}
+
+	previous := cloneMemoryReaderUser(*record)
+	apply(record)
+	if duplicateErr != nil && r.conflicts(record) {
+		*record = previous
+		return duplicateErr
+	}
+
+	return nil
+}
+
+func cloneMemoryReaderUser(record domain.ReaderUserRecord) domain.ReaderUserRecord {
+	record.GoogleLinkedAt = cloneMemoryValue(record.GoogleLinkedAt)
+	record.GithubLinkedAt = cloneMemoryValue(record.GithubLinkedAt)
+	return record
+}
@@ -17,29 +17,22 @@ import (
 )
 
 func resetPostRepositoryState() {
-	postMongoClient = nil
-	postMongoInitErr = nil
-	postMongoOnce = sync.Once{}
 	postLikesIndexesOnce = sync.Once{}
 	postLikesIndexesErr = nil
 	postHitsIndexesOnce = sync.Once{}
 	postHitsIndexesErr = nil
 	postContentIndexesOnce = sync.Once{}
 	postContentIndexesErr = nil
+	postRelatedIndexesOnce = sync.Once{}
+	postRelatedIndexesErr = nil
 }
 
 func resetNewsletterRepositoryState() {
-	newsletterMongoClient = nil
-	newsletterMongoInitErr = nil
-	newsletterMongoClientOnce = sync.Once{}
 	newsletterIndexesOnce = sync.Once{}
 	newsletterIndexesErr = nil
 }
 
 func resetAdminRepositoryState() {
-	adminMongoClient = nil
-	adminMongoInitErr = nil
-	adminMongoClientOnce = sync.Once{}
 	adminUserIndexesOnce = sync.Once{}
 	adminUserIndexesErr = nil
 	readerUserIndexesOnce = sync.Once{}
@@ -47,9 +40,6 @@ func resetAdminRepositoryState() {
 }
 
 func resetAdminRefreshTokenRepositoryState() {
-	adminRefreshTokenMongoClient = nil
-	adminRefreshTokenMongoInitErr = nil
-	adminRefreshTokenMongoClientOnce = sync.Once{}
 	adminRefreshTokenIndexesOnce = sync.Once{}
 	adminRefreshTokenIndexesErr = nil
 }
@@ -60,17 +50,11 @@ func resetReaderRefreshTokenRepositoryState() {
 }
 
 func resetErrorMessageRepositoryState() {
-	errorMessageMongoClient = nil
-	errorMessageMongoInitErr = nil
-	errorMessageMongoClientOnce = sync.Once{}
 	errorMessageIndexesOnce = sync.Once{}
 	errorMessageIndexesErr = nil
 }
 
 func resetAdminAvatarRepositoryState() {
-	adminAvatarMongoClient = nil
-	adminAvatarMongoInitErr = nil
-	adminAvatarMongoClientOnce = sync.Once{}
 	adminAvatarIndexesOnce = sync.Once{}
 	adminAvatarIndexesErr = nil
 }
@@ -175,8 +159,8 @@ func (mock *updateOneMock) UpdateOne(
 }
 
 func TestPostRepositoryHelpers(t *testing.T) {
-	if repository, ok := NewPostMongoRepository().(*postMongoRepository); !ok || repository == nil {
-		t.Fatalf("NewPostMongoRepository() = %#v", repository)
+	if repository, ok := NewPostMongoRepository(nil).(*postMongoRepository); !ok || repository == nil {
+		t.Fatalf("NewPostMongoRepository(nil) = %#v", repository)
 	}
 
 	if got, ok := normalizePostID(" Alpha-Post "); !ok || got != "alpha-post" {
@@ -291,7 +275,7 @@ func TestPostRepositoryUnavailablePaths(t *testing.T) {
 	t.Setenv("MONGODB_URI", "")
 	t.Setenv("MONGODB_DATABASE", "")
 
-	repository := NewPostMongoRepository()
+	repository := NewPostMongoRepository(nil)
 	ctx := context.Background()
 
 	if _, err := repository.CountPosts(ctx, bson.M{}); !errors.Is(err, ErrPostRepositoryUnavailable) {
@@ -309,6 +293,21 @@ func TestPostRepositoryUnavailablePaths(t *testing.T) {
 	if _, err := repository.IncrementPostHit(ctx, "alpha-post", time.Now().UTC()); !errors.Is(err, ErrPostRepositoryUnavailable) {
 		t.Fatalf("IncrementPostHit() error = %v", err)
 	}
+	if _, err := repository.FindRelatedPosts(ctx, "en", "alpha-post"); !errors.Is(err, ErrPostRepositoryUnavailable) {
+		t.Fatalf("FindRelatedPosts() error = %v", err)
+	}
+	if err := repository.ReplaceRelatedPosts(ctx, "en", nil); !errors.Is(err, ErrPostRepositoryUnavailable) {
+		t.Fatalf("ReplaceRelatedPosts() error = %v", err)
+	}
+	if _, err := repository.FindSeriesByID(ctx, "en", "alpha-series"); !errors.Is(err, ErrPostRepositoryUnavailable) {
+		t.Fatalf("FindSeriesByID() error = %v", err)
+	}
+	if _, err := repository.FindSeriesByPostID(ctx, "en", "alpha-post"); !errors.Is(err, ErrPostRepositoryUnavailable) {
+		t.Fatalf("FindSeriesByPostID() error = %v", err)
+	}
+	if _, err := repository.FindPostIDAlias(ctx, "old-post"); !errors.Is(err, ErrPostRepositoryUnavailable) {
+		t.Fatalf("FindPostIDAlias() error = %v", err)
+	}
 
 	if got := repository.ResolveLikesByPostID(ctx, []domain.PostRecord{{ID: "alpha-post"}}); got != nil {
 		t.Fatalf("ResolveLikesByPostID() = %#v", got)
@@ -466,12 +465,12 @@ func TestNewsletterRepositoryUnavailablePaths(t *testing.T) {
 	t.Setenv("MONGODB_URI", "")
 	t.Setenv("MONGODB_DATABASE", "")
 
-	repository := NewNewsletterMongoRepository()
+	repository := NewNewsletterMongoRepository(nil)
 	ctx := context.Background()
 	now := time.Now().UTC()
 
 	if concreteRepository, ok := repository.(*newsletterMongoRepository); !ok || concreteRepository == nil {
-		t.Fatalf("NewNewsletterMongoRepository() = %#v", repository)
+		t.Fatalf("NewNewsletterMongoRepository(nil) = %#v", repository)
 	}
 
 	_, found, err := repository.GetStatusByEmail(ctx, "reader@example.com")
@@ -586,13 +585,13 @@ func TestRepositoryErrorMessagesKeepUnderlyingReason(t *testing.T) {
 	t.Setenv("MONGODB_URI", "")
 	t.Setenv("MONGODB_DATABASE", "")
 
-	postRepository := NewPostMongoRepository()
+	postRepository := NewPostMongoRepository(nil)
 	_, postErr := postRepository.CountPosts(context.Background(), bson.M{})
 	if postErr == nil || !strings.Contains(postErr.Error(), "missing required env: MONGODB_URI") {
 		t.Fatalf("post error = %v", postErr)
 	}
 
-	newsletterRepository := NewNewsletterMongoRepository()
+	newsletterRepository := NewNewsletterMongoRepository(nil)
 	_, _, newsletterErr := newsletterRepository.GetStatusByEmail(context.Background(), "reader@example.com")
 	if newsletterErr == nil || !strings.Contains(newsletterErr.Error(), "missing required env: MONGODB_URI") {
 		t.Fatalf("newsletter error = %v", newsletterErr)
@@ -677,3 +676,119 @@ func TestNewsletterRepositoryCollectionHelperErrors(t *testing.T) {
 		t.Fatalf("unsubscribeByEmailInCollection() error = %v", err)
 	}
 }
+
+func TestPostSeriesRecordHelpers(t *testing.T) {
+	record, err := queryPostSeriesByID(context.Background(), &singleFindMock{doc: bson.M{
+		"locale":      "EN",
+		"id":          "Go-Basics",
+		"name":        " Go Basics ",
+		"description": "Intro",
+		"postIds":     bson.A{"Part-One", " ", "part-two"},
+	}}, "en", "go-basics")
+	if err != nil {
+		t.Fatalf("queryPostSeriesByID() error = %v", err)
+	}
+	if record == nil || record.ID != "go-basics" || record.Name != "Go Basics" || len(record.PostIDs) != 2 || record.PostIDs[0] != "part-one" {
+		t.Fatalf("unexpected series record: %#v", record)
+	}
+
+	missing, err := queryPostSeriesByPostID(context.Background(), &singleFindMock{doc: bson.M{}, err: mongo.ErrNoDocuments}, "en", "part-one")
+	if err != nil || missing != nil {
+		t.Fatalf("queryPostSeriesByPostID() = %#v, %v", missing, err)
+	}
+}
+
+func TestQueryPostIDAlias(t *testing.T) {
+	target, err := queryPostIDAlias(context.Background(), &singleFindMock{doc: bson.M{
+		"aliasId": "old-post",
+		"postId":  " New-Post ",
+	}}, "Old-Post")
+	if err != nil || target != "new-post" {
+		t.Fatalf("queryPostIDAlias() = %q, %v", target, err)
+	}
+
+	missing, err := queryPostIDAlias(context.Background(), &singleFindMock{doc: bson.M{}, err: mongo.ErrNoDocuments}, "old-post")
+	if err != nil || missing != "" {
+		t.Fatalf("queryPostIDAlias() missing = %q, %v", missing, err)
+	}
+
+	if _, err := queryPostIDAlias(context.Background(), &singleFindMock{doc: bson.M{}, err: errors.New("boom")}, "old-post"); err == nil {
+		t.Fatal("expected queryPostIDAlias() to surface find errors")
+	}
+}
+
+func TestPostRelatedRecordHelpers(t *testing.T) {
+	computedAt := time.Date(2026, time.March, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("query maps stored items", func(t *testing.T) {
+		record, err := queryPostRelatedRecord(context.Background(), &singleFindMock{doc: bson.M{
+			"locale":     "en",
+			"postId":     "alpha-post",
+			"items":      bson.A{bson.M{"postId": "beta-post", "score": 1.25}},
+			"computedAt": computedAt,
+		}}, " EN ", " Alpha-Post ")
+		if err != nil {
+			t.Fatalf("queryPostRelatedRecord() error = %v", err)
+		}
+		if record == nil || len(record.Items) != 1 || record.Items[0].PostID != "beta-post" || record.Items[0].Score != 1.25 {
+			t.Fatalf("unexpected related record: %#v", record)
+		}
+	})
+
+	t.Run("query returns nil when missing", func(t *testing.T) {
+		record, err := queryPostRelatedRecord(context.Background(), &singleFindMock{doc: bson.M{}, err: mongo.ErrNoDocuments}, "en", "alpha-post")
+		if err != nil || record != nil {
+			t.Fatalf("queryPostRelatedRecord() = %#v, %v", record, err)
+		}
+	})
+
+	t.Run("replace upserts records and prunes stale posts", func(t *testing.T) {
+		writer := &bulkWriteMock{}
+		err := replacePostRelatedRecords(context.Background(), writer, "EN", []domain.PostRelatedRecord{
+			{PostID: "alpha-post", Items: []domain.PostRelatedItem{{PostID: "beta-post", Score: 2}}, ComputedAt: computedAt},
+			{PostID: " "},
+		})
+		if err != nil {
+			t.Fatalf("replacePostRelatedRecords() error = %v", err)
+		}
+		if writer.writeCount != 1 || len(writer.lastModels) != 2 {
+			t.Fatalf("unexpected bulk write: count=%d models=%d", writer.writeCount, len(writer.lastModels))
+		}
+		if _, ok := writer.lastModels[0].(*mongo.ReplaceOneModel); !ok {
+			t.Fatalf("expected replace model, got %T", writer.lastModels[0])
+		}
+		deleteModel, ok := writer.lastModels[1].(*mongo.DeleteManyModel)
+		if !ok {
+			t.Fatalf("expected delete model, got %T", writer.lastModels[1])
+		}
+		filter, _ := deleteModel.Filter.(bson.M)
+		if filter["locale"] != "en" {
+			t.Fatalf("unexpected delete filter: %#v", deleteModel.Filter)
+		}
+	})
+}
+
+func TestMongoRepositoriesUseTheirClient(t *testing.T) {
+	t.Setenv("MONGODB_URI", "mongodb://localhost:27017")
+	t.Setenv("MONGODB_DATABASE", "blog_test")
+
+	if _, err := getPostCollection(nil, postsCollectionName); !errors.Is(err, ErrMongoClientUnavailable) {
+		t.Fatalf("getPostCollection(nil) error = %v, want ErrMongoClientUnavailable", err)
+	}
+
+	client, err := mongo.Connect(context.Background(), options.Client().ApplyURI("mongodb://localhost:27017"))
+	if err != nil {
+		t.Fatalf("mongo.Connect() error = %v", err)
+	}
+	t.Cleanup(func() {
+		_ = client.Disconnect(context.Background())
+	})
+
+	collection, err := getPostCollection(client, postsCollectionName)
+	if err != nil || collection.Database().Client() != client || collection.Database().Name() != "blog_test" {
+		t.Fatalf("getPostCollection() = %#v, %v; want a collection on the given client", collection, err)
+	}
+	if repository, ok := NewPostMongoRepository(client).(*postMongoRepository); !ok || repository.client != client {
+		t.Fatalf("NewPostMongoRepository() = %#v", repository)
+	}
+}
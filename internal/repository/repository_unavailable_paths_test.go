@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"sync"
@@ -16,7 +17,7 @@ func TestAdminUserRepositoryUnavailablePaths(t *testing.T) {
 	t.Setenv("MONGODB_URI", "")
 	t.Setenv("MONGODB_DATABASE", "")
 
-	repository := NewAdminUserRepository()
+	repository := NewAdminUserRepository(nil)
 	ctx := context.Background()
 	now := time.Date(2026, time.March, 22, 10, 0, 0, 0, time.UTC)
 
@@ -45,6 +46,25 @@ func TestAdminUserRepositoryUnavailablePaths(t *testing.T) {
 	checkUnavailableError(t, ErrAdminUserRepositoryUnavailable, repository.ClearGithubLinkByID(ctx, "admin-1"))
 	checkUnavailableError(t, ErrAdminUserRepositoryUnavailable, repository.UpdateAvatarByID(ctx, "admin-1", "/avatar", "digest", 1))
 	checkUnavailableError(t, ErrAdminUserRepositoryUnavailable, repository.DisableByID(ctx, "admin-1"))
+	checkUnavailableError(t, ErrAdminUserRepositoryUnavailable, repository.Create(ctx, domain.AdminUserRecord{
+		AdminUser: domain.AdminUser{ID: "admin-2", Email: "new@example.com", Roles: []string{"editor"}},
+	}))
+	checkUnavailableError(t, ErrAdminUserRepositoryUnavailable, repository.RefreshInvitationByID(ctx, "admin-2", []string{"editor"}, domain.AdminPendingInvitation{
+		TokenHash: "token-hash",
+		InvitedAt: now,
+		ExpiresAt: now.Add(time.Hour),
+	}))
+	checkUnavailableError(t, ErrAdminUserRepositoryUnavailable, repository.AcceptInvitationByID(ctx, "admin-2", "hash"))
+	checkUnavailableError(t, ErrAdminUserRepositoryUnavailable, repository.EnableByID(ctx, "admin-1"))
+	checkUnavailableError(t, ErrAdminUserRepositoryUnavailable, repository.UpdateRolesByID(ctx, "admin-1", []string{"owner"}))
+	checkUnavailableError(t, ErrAdminUserRepositoryUnavailable, repository.SetPendingTwoFactorByID(ctx, "admin-1", domain.AdminPendingTwoFactor{}))
+	checkUnavailableError(t, ErrAdminUserRepositoryUnavailable, repository.EnableTwoFactorByID(ctx, "admin-1", domain.AdminTwoFactor{}))
+	checkUnavailableError(t, ErrAdminUserRepositoryUnavailable, repository.DisableTwoFactorByID(ctx, "admin-1"))
+	checkUnavailableError(t, ErrAdminUserRepositoryUnavailable, repository.ReplaceTwoFactorRecoveryCodesByID(ctx, "admin-1", nil))
+	_, err := repository.ConsumeTwoFactorStepByID(ctx, "admin-1", 1)
+	checkUnavailableError(t, ErrAdminUserRepositoryUnavailable, err)
+	_, err = repository.ConsumeTwoFactorRecoveryCodeByID(ctx, "admin-1", "hash")
+	checkUnavailableError(t, ErrAdminUserRepositoryUnavailable, err)
 
 	if _, err := repository.FindByEmail(ctx, "admin@example.com"); !errors.Is(err, ErrAdminUserRepositoryUnavailable) {
 		t.Fatalf("FindByEmail() error = %v", err)
@@ -67,6 +87,15 @@ func TestAdminUserRepositoryUnavailablePaths(t *testing.T) {
 	if _, err := repository.FindByPendingPasswordResetTokenHash(ctx, "token-hash"); !errors.Is(err, ErrAdminUserRepositoryUnavailable) {
 		t.Fatalf("FindByPendingPasswordResetTokenHash() error = %v", err)
 	}
+	if _, err := repository.FindByPendingInvitationTokenHash(ctx, "token-hash"); !errors.Is(err, ErrAdminUserRepositoryUnavailable) {
+		t.Fatalf("FindByPendingInvitationTokenHash() error = %v", err)
+	}
+	if _, err := repository.FindByIDAnyStatus(ctx, "admin-1"); !errors.Is(err, ErrAdminUserRepositoryUnavailable) {
+		t.Fatalf("FindByIDAnyStatus() error = %v", err)
+	}
+	if _, err := repository.List(ctx); !errors.Is(err, ErrAdminUserRepositoryUnavailable) {
+		t.Fatalf("List() error = %v", err)
+	}
 	if _, err := repository.HasAnyGoogleLink(ctx); !errors.Is(err, ErrAdminUserRepositoryUnavailable) {
 		t.Fatalf("HasAnyGoogleLink() error = %v", err)
 	}
@@ -81,7 +110,7 @@ func TestReaderUserRepositoryUnavailablePaths(t *testing.T) {
 	t.Setenv("MONGODB_URI", "")
 	t.Setenv("MONGODB_DATABASE", "")
 
-	repository := NewReaderUserRepository()
+	repository := NewReaderUserRepository(nil)
 	ctx := context.Background()
 	now := time.Date(2026, time.March, 22, 10, 0, 0, 0, time.UTC)
 
@@ -113,6 +142,8 @@ func TestReaderUserRepositoryUnavailablePaths(t *testing.T) {
 		now,
 	))
 	checkUnavailableError(t, ErrReaderUserRepositoryUnavailable, repository.UpdateLastSeenProviderByID(ctx, "reader-1", "github"))
+	checkUnavailableError(t, ErrReaderUserRepositoryUnavailable, repository.IncrementSessionVersionByID(ctx, "reader-1"))
+	checkUnavailableError(t, ErrReaderUserRepositoryUnavailable, repository.UpdateNameByID(ctx, "reader-1", "Reader One"))
 
 	if _, err := repository.FindByID(ctx, "reader-1"); !errors.Is(err, ErrReaderUserRepositoryUnavailable) {
 		t.Fatalf("FindByID() error = %v", err)
@@ -126,6 +157,15 @@ func TestReaderUserRepositoryUnavailablePaths(t *testing.T) {
 	if _, err := repository.FindByGithubSubject(ctx, "github-subject"); !errors.Is(err, ErrReaderUserRepositoryUnavailable) {
 		t.Fatalf("FindByGithubSubject() error = %v", err)
 	}
+	if _, err := repository.UnlinkProviderByID(ctx, "reader-1", "google"); !errors.Is(err, ErrReaderUserRepositoryUnavailable) {
+		t.Fatalf("UnlinkProviderByID() error = %v", err)
+	}
+	if _, err := repository.UnlinkProviderByID(ctx, "reader-1", "gitlab"); !errors.Is(err, ErrReaderProviderUnsupported) {
+		t.Fatalf("UnlinkProviderByID() unsupported provider error = %v", err)
+	}
+	if _, err := repository.DeleteByID(ctx, "reader-1"); !errors.Is(err, ErrReaderUserRepositoryUnavailable) {
+		t.Fatalf("DeleteByID() error = %v", err)
+	}
 }
 
 func TestAdminRefreshTokenRepositoryUnavailablePaths(t *testing.T) {
@@ -134,7 +174,7 @@ func TestAdminRefreshTokenRepositoryUnavailablePaths(t *testing.T) {
 	t.Setenv("MONGODB_URI", "")
 	t.Setenv("MONGODB_DATABASE", "")
 
-	repository := NewAdminRefreshTokenMongoRepository()
+	repository := NewAdminRefreshTokenMongoRepository(nil)
 	ctx := context.Background()
 	now := time.Date(2026, time.March, 22, 10, 0, 0, 0, time.UTC)
 
@@ -152,6 +192,12 @@ func TestAdminRefreshTokenRepositoryUnavailablePaths(t *testing.T) {
 	if _, err := repository.RevokeByJTIAndUserID(ctx, "jti-1", "admin-1", now); !errors.Is(err, ErrAdminRefreshTokenRepositoryUnavailable) {
 		t.Fatalf("RevokeByJTIAndUserID() error = %v", err)
 	}
+	if _, err := repository.FindByToken(ctx, "jti-1", "raw-token"); !errors.Is(err, ErrAdminRefreshTokenRepositoryUnavailable) {
+		t.Fatalf("FindByToken() error = %v", err)
+	}
+	if _, err := repository.RevokeFamily(ctx, "jti-1", now); !errors.Is(err, ErrAdminRefreshTokenRepositoryUnavailable) {
+		t.Fatalf("RevokeFamily() error = %v", err)
+	}
 }
 
 func TestReaderRefreshTokenRepositoryUnavailablePaths(t *testing.T) {
@@ -162,17 +208,31 @@ func TestReaderRefreshTokenRepositoryUnavailablePaths(t *testing.T) {
 	t.Setenv("MONGODB_URI", "")
 	t.Setenv("MONGODB_DATABASE", "")
 
-	repository := NewReaderRefreshTokenMongoRepository()
+	repository := NewReaderRefreshTokenMongoRepository(nil)
 	ctx := context.Background()
 	now := time.Date(2026, time.March, 22, 10, 0, 0, 0, time.UTC)
 
 	checkUnavailableError(t, ErrReaderRefreshTokenRepositoryUnavailable, repository.Create(ctx, domain.ReaderRefreshTokenRecord{JTI: "jti-1"}))
 	checkUnavailableError(t, ErrReaderRefreshTokenRepositoryUnavailable, repository.Rotate(ctx, "jti-1", domain.ReaderRefreshTokenRecord{JTI: "jti-2"}, now))
 	checkUnavailableError(t, ErrReaderRefreshTokenRepositoryUnavailable, repository.RevokeByJTI(ctx, "jti-1", now))
+	checkUnavailableError(t, ErrReaderRefreshTokenRepositoryUnavailable, repository.RevokeAllByUserID(ctx, "reader-1", now))
+	checkUnavailableError(t, ErrReaderRefreshTokenRepositoryUnavailable, repository.DeleteAllByUserID(ctx, "reader-1"))
 
 	if _, err := repository.FindActiveByToken(ctx, "jti-1", "raw-token", now); !errors.Is(err, ErrReaderRefreshTokenRepositoryUnavailable) {
 		t.Fatalf("FindActiveByToken() error = %v", err)
 	}
+	if _, err := repository.FindByToken(ctx, "jti-1", "raw-token"); !errors.Is(err, ErrReaderRefreshTokenRepositoryUnavailable) {
+		t.Fatalf("FindByToken() error = %v", err)
+	}
+	if _, err := repository.RevokeFamily(ctx, "jti-1", now); !errors.Is(err, ErrReaderRefreshTokenRepositoryUnavailable) {
+		t.Fatalf("RevokeFamily() error = %v", err)
+	}
+	if _, err := repository.ListActiveByUserID(ctx, "reader-1", now, 10); !errors.Is(err, ErrReaderRefreshTokenRepositoryUnavailable) {
+		t.Fatalf("ListActiveByUserID() error = %v", err)
+	}
+	if _, err := repository.RevokeByJTIAndUserID(ctx, "jti-1", "reader-1", now); !errors.Is(err, ErrReaderRefreshTokenRepositoryUnavailable) {
+		t.Fatalf("RevokeByJTIAndUserID() error = %v", err)
+	}
 }
 
 func TestCommentRepositoryUnavailablePaths(t *testing.T) {
@@ -183,7 +243,7 @@ func TestCommentRepositoryUnavailablePaths(t *testing.T) {
 	t.Setenv("MONGODB_URI", "")
 	t.Setenv("MONGODB_DATABASE", "")
 
-	repository := NewCommentRepository()
+	repository := NewCommentRepository(nil)
 	ctx := context.Background()
 	now := time.Date(2026, time.March, 22, 10, 0, 0, 0, time.UTC)
 
@@ -216,6 +276,15 @@ func TestCommentRepositoryUnavailablePaths(t *testing.T) {
 	if _, err := repository.DeleteCommentsByIDs(ctx, []string{"comment-1"}); !errors.Is(err, ErrCommentRepositoryUnavailable) {
 		t.Fatalf("DeleteCommentsByIDs() error = %v", err)
 	}
+	if _, err := repository.ListCommentsByAuthorEmail(ctx, "reader@example.com"); !errors.Is(err, ErrCommentRepositoryUnavailable) {
+		t.Fatalf("ListCommentsByAuthorEmail() error = %v", err)
+	}
+	if _, err := repository.AnonymizeCommentsByAuthorEmail(ctx, "reader@example.com", "Former reader", now); !errors.Is(err, ErrCommentRepositoryUnavailable) {
+		t.Fatalf("AnonymizeCommentsByAuthorEmail() error = %v", err)
+	}
+	if _, err := repository.DeleteCommentsByAuthorEmail(ctx, "reader@example.com"); !errors.Is(err, ErrCommentRepositoryUnavailable) {
+		t.Fatalf("DeleteCommentsByAuthorEmail() error = %v", err)
+	}
 }
 
 func TestAdminContentRepositoryUnavailablePaths(t *testing.T) {
@@ -224,12 +293,12 @@ func TestAdminContentRepositoryUnavailablePaths(t *testing.T) {
 	t.Setenv("MONGODB_URI", "")
 	t.Setenv("MONGODB_DATABASE", "")
 
-	repository := NewAdminContentRepository()
+	repository := NewAdminContentRepository(nil)
 	ctx := context.Background()
 	now := time.Date(2026, time.March, 22, 10, 0, 0, 0, time.UTC)
 
 	if concreteRepository, ok := repository.(*adminContentMongoRepository); !ok || concreteRepository == nil {
-		t.Fatalf("NewAdminContentRepository() = %#v", repository)
+		t.Fatalf("NewAdminContentRepository(nil) = %#v", repository)
 	}
 
 	if _, err := repository.ListPostGroups(ctx, domain.AdminContentPostFilter{}); !errors.Is(err, ErrAdminContentRepositoryUnavailable) {
@@ -256,6 +325,27 @@ func TestAdminContentRepositoryUnavailablePaths(t *testing.T) {
 	if _, err := repository.DeletePostByLocaleAndID(ctx, "en", "alpha-post"); !errors.Is(err, ErrAdminContentRepositoryUnavailable) {
 		t.Fatalf("DeletePostByLocaleAndID() error = %v", err)
 	}
+	if _, err := repository.RenamePost(ctx, "alpha-post", "beta-post", now); !errors.Is(err, ErrAdminContentRepositoryUnavailable) {
+		t.Fatalf("RenamePost() error = %v", err)
+	}
+	if _, err := repository.ListDueScheduledPosts(ctx, now, 10); !errors.Is(err, ErrAdminContentRepositoryUnavailable) {
+		t.Fatalf("ListDueScheduledPosts() error = %v", err)
+	}
+	if _, err := repository.PublishScheduledPost(ctx, "en", "alpha-post", now, nil, now); !errors.Is(err, ErrAdminContentRepositoryUnavailable) {
+		t.Fatalf("PublishScheduledPost() error = %v", err)
+	}
+	if _, err := repository.ListSeriesGroups(ctx, domain.AdminContentTaxonomyFilter{}); !errors.Is(err, ErrAdminContentRepositoryUnavailable) {
+		t.Fatalf("ListSeriesGroups() error = %v", err)
+	}
+	if _, err := repository.FindSeriesByLocaleAndID(ctx, "en", "alpha-series"); !errors.Is(err, ErrAdminContentRepositoryUnavailable) {
+		t.Fatalf("FindSeriesByLocaleAndID() error = %v", err)
+	}
+	if _, err := repository.UpsertSeries(ctx, domain.AdminContentSeriesRecord{Locale: "en", ID: "alpha-series"}, now); !errors.Is(err, ErrAdminContentRepositoryUnavailable) {
+		t.Fatalf("UpsertSeries() error = %v", err)
+	}
+	if _, err := repository.DeleteSeriesByLocaleAndID(ctx, "en", "alpha-series"); !errors.Is(err, ErrAdminContentRepositoryUnavailable) {
+		t.Fatalf("DeleteSeriesByLocaleAndID() error = %v", err)
+	}
 	if _, err := repository.ListTopics(ctx, "en", "alpha"); !errors.Is(err, ErrAdminContentRepositoryUnavailable) {
 		t.Fatalf("ListTopics() error = %v", err)
 	}
@@ -296,6 +386,7 @@ func TestAdminContentRepositoryUnavailablePaths(t *testing.T) {
 	}
 	checkUnavailableError(t, ErrAdminContentRepositoryUnavailable, repository.SyncCategoryOnPosts(ctx, domain.AdminContentCategoryRecord{Locale: "en", ID: "tech"}, now))
 	checkUnavailableError(t, ErrAdminContentRepositoryUnavailable, repository.ClearCategoryFromPosts(ctx, "en", "tech", now))
+	checkUnavailableError(t, ErrAdminContentRepositoryUnavailable, repository.ApplyTaxonomyRewrite(ctx, domain.AdminContentTaxonomyRewritePlan{}, now))
 }
 
 func TestAdminAvatarRepositoryUnavailablePaths(t *testing.T) {
@@ -304,7 +395,7 @@ func TestAdminAvatarRepositoryUnavailablePaths(t *testing.T) {
 	t.Setenv("MONGODB_URI", "")
 	t.Setenv("MONGODB_DATABASE", "")
 
-	repository := NewAdminAvatarRepository()
+	repository := NewAdminAvatarRepository(nil)
 	ctx := context.Background()
 
 	record := domain.AdminAvatarRecord{
@@ -323,13 +414,89 @@ func TestAdminAvatarRepositoryUnavailablePaths(t *testing.T) {
 	}
 }
 
+func TestAdminMediaAssetRepositoryUnavailablePaths(t *testing.T) {
+	resetPostRepositoryState()
+	t.Cleanup(resetPostRepositoryState)
+	t.Setenv("MONGODB_URI", "")
+	t.Setenv("MONGODB_DATABASE", "")
+
+	repository := NewAdminMediaAssetRepository(nil)
+	ctx := context.Background()
+
+	if _, err := repository.FindMediaAssetPreview(ctx, "cover"); !errors.Is(err, ErrAdminMediaAssetRepositoryUnavailable) {
+		t.Fatalf("FindMediaAssetPreview() error = %v", err)
+	}
+	if _, err := repository.ListMediaLibraryFacets(ctx); !errors.Is(err, ErrAdminMediaAssetRepositoryUnavailable) {
+		t.Fatalf("ListMediaLibraryFacets() error = %v", err)
+	}
+	checkUnavailableError(t, ErrAdminMediaAssetRepositoryUnavailable, repository.UpdateMediaAssetMetadata(ctx, "cover", domain.AdminMediaAssetMetadata{}, time.Now()))
+	if _, err := repository.MoveMediaAssetsToFolder(ctx, []string{"cover"}, "blog", time.Now()); !errors.Is(err, ErrAdminMediaAssetRepositoryUnavailable) {
+		t.Fatalf("MoveMediaAssetsToFolder() error = %v", err)
+	}
+	if _, err := repository.FindMediaAssetVariant(ctx, "cover", "digest", 640, "image/webp"); !errors.Is(err, ErrAdminMediaAssetRepositoryUnavailable) {
+		t.Fatalf("FindMediaAssetVariant() error = %v", err)
+	}
+	checkUnavailableError(t, ErrAdminMediaAssetRepositoryUnavailable, repository.UpsertMediaAssetVariant(ctx, domain.AdminMediaAssetVariant{
+		AssetID:     "cover",
+		Digest:      "digest",
+		Width:       640,
+		ContentType: "image/webp",
+		Data:        []byte("image"),
+	}))
+	if _, err := repository.ListMediaAssetsOutsideStorage(ctx, "gridfs", 10); !errors.Is(err, ErrAdminMediaAssetRepositoryUnavailable) {
+		t.Fatalf("ListMediaAssetsOutsideStorage() error = %v", err)
+	}
+	if _, err := repository.UpdateMediaAssetStorage(ctx, domain.AdminMediaAssetRecord{ID: "cover"}, "gridfs", "cover-digest", nil); !errors.Is(err, ErrAdminMediaAssetRepositoryUnavailable) {
+		t.Fatalf("UpdateMediaAssetStorage() error = %v", err)
+	}
+	if _, err := repository.ListReferencedMediaAssetIDs(ctx); !errors.Is(err, ErrAdminMediaAssetRepositoryUnavailable) {
+		t.Fatalf("ListReferencedMediaAssetIDs() error = %v", err)
+	}
+	if _, err := repository.ListMediaAssetsForGarbageCollection(ctx); !errors.Is(err, ErrAdminMediaAssetRepositoryUnavailable) {
+		t.Fatalf("ListMediaAssetsForGarbageCollection() error = %v", err)
+	}
+	if _, err := repository.QuarantineMediaAssets(ctx, []string{"cover"}, time.Now(), time.Now()); !errors.Is(err, ErrAdminMediaAssetRepositoryUnavailable) {
+		t.Fatalf("QuarantineMediaAssets() error = %v", err)
+	}
+	if _, err := repository.RestoreMediaAssets(ctx, []string{"cover"}); !errors.Is(err, ErrAdminMediaAssetRepositoryUnavailable) {
+		t.Fatalf("RestoreMediaAssets() error = %v", err)
+	}
+	if _, err := repository.DeleteQuarantinedMediaAsset(ctx, "cover", time.Now()); !errors.Is(err, ErrAdminMediaAssetRepositoryUnavailable) {
+		t.Fatalf("DeleteQuarantinedMediaAsset() error = %v", err)
+	}
+
+	uploadRepository := NewAdminMediaUploadSessionRepository(nil)
+	if _, err := uploadRepository.CreateMediaUploadSession(ctx, domain.AdminMediaUploadSession{ID: "upload-1"}); !errors.Is(err, ErrAdminMediaAssetRepositoryUnavailable) {
+		t.Fatalf("CreateMediaUploadSession() error = %v", err)
+	}
+	if _, err := uploadRepository.FindMediaUploadSession(ctx, "upload-1"); !errors.Is(err, ErrAdminMediaAssetRepositoryUnavailable) {
+		t.Fatalf("FindMediaUploadSession() error = %v", err)
+	}
+	if _, err := uploadRepository.AppendMediaUploadChunk(ctx, domain.AdminMediaUploadSession{ID: "upload-1"}, 0, []byte("image")); !errors.Is(err, ErrAdminMediaAssetRepositoryUnavailable) {
+		t.Fatalf("AppendMediaUploadChunk() error = %v", err)
+	}
+	if _, err := uploadRepository.ListMediaUploadChunks(ctx, "upload-1"); !errors.Is(err, ErrAdminMediaAssetRepositoryUnavailable) {
+		t.Fatalf("ListMediaUploadChunks() error = %v", err)
+	}
+	if _, err := uploadRepository.DeleteMediaUploadSession(ctx, "upload-1"); !errors.Is(err, ErrAdminMediaAssetRepositoryUnavailable) {
+		t.Fatalf("DeleteMediaUploadSession() error = %v", err)
+	}
+
+	blobStore := &mediaGridFSBlobStore{}
+	checkUnavailableError(t, ErrMediaBlobStoreUnavailable, blobStore.Put(ctx, "cover-digest", "image/png", bytes.NewReader([]byte("image")), 5))
+	checkUnavailableError(t, ErrMediaBlobStoreUnavailable, blobStore.Delete(ctx, "cover-digest"))
+	if _, err := blobStore.Open(ctx, "cover-digest"); !errors.Is(err, ErrMediaBlobStoreUnavailable) {
+		t.Fatalf("Open() error = %v", err)
+	}
+}
+
 func TestErrorMessageRepositoryUnavailablePaths(t *testing.T) {
 	resetErrorMessageRepositoryState()
 	t.Cleanup(resetErrorMessageRepositoryState)
 	t.Setenv("MONGODB_URI", "")
 	t.Setenv("MONGODB_DATABASE", "")
 
-	repository := NewErrorMessageRepository()
+	repository := NewErrorMessageRepository(nil)
 	ctx := context.Background()
 
 	checkUnavailableError(t, ErrErrorMessageRepositoryUnavailable, repository.UpsertMany(ctx, []domain.ErrorMessageRecord{{
@@ -359,11 +526,11 @@ func TestAdminAuditLogRepositoryUnavailablePaths(t *testing.T) {
 	t.Setenv("MONGODB_URI", "")
 	t.Setenv("MONGODB_DATABASE", "")
 
-	repository := NewAdminAuditLogRepository()
+	repository := NewAdminAuditLogRepository(nil)
 	ctx := context.Background()
 
 	if concreteRepository, ok := repository.(*adminAuditLogMongoRepository); !ok || concreteRepository == nil {
-		t.Fatalf("NewAdminAuditLogRepository() = %#v", repository)
+		t.Fatalf("NewAdminAuditLogRepository(nil) = %#v", repository)
 	}
 
 	checkUnavailableError(t, ErrAdminAuditLogRepositoryUnavailable, repository.Create(ctx, domain.AdminAuditLogRecord{
@@ -375,6 +542,65 @@ func TestAdminAuditLogRepositoryUnavailablePaths(t *testing.T) {
 	}
 }
 
+func TestAdminPasskeyRepositoryUnavailablePaths(t *testing.T) {
+	resetAdminRepositoryState()
+	adminPasskeyIndexesOnce = sync.Once{}
+	adminPasskeyIndexesErr = nil
+	t.Cleanup(func() {
+		resetAdminRepositoryState()
+		adminPasskeyIndexesOnce = sync.Once{}
+		adminPasskeyIndexesErr = nil
+	})
+	t.Setenv("MONGODB_URI", "")
+	t.Setenv("MONGODB_DATABASE", "")
+
+	repository := NewAdminPasskeyRepository(nil)
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	checkUnavailableError(t, ErrAdminPasskeyRepositoryUnavailable, repository.Create(ctx, domain.AdminPasskeyRecord{ID: "passkey-1"}))
+	if _, err := repository.FindByCredentialID(ctx, "credential-1"); !errors.Is(err, ErrAdminPasskeyRepositoryUnavailable) {
+		t.Fatalf("FindByCredentialID() error = %v", err)
+	}
+	if _, err := repository.ListByUserID(ctx, "admin-1"); !errors.Is(err, ErrAdminPasskeyRepositoryUnavailable) {
+		t.Fatalf("ListByUserID() error = %v", err)
+	}
+	if _, err := repository.RecordAssertion(ctx, "passkey-1", 1, 2, "challenge-hash", now); !errors.Is(err, ErrAdminPasskeyRepositoryUnavailable) {
+		t.Fatalf("RecordAssertion() error = %v", err)
+	}
+	if _, err := repository.DeleteByIDAndUserID(ctx, "passkey-1", "admin-1"); !errors.Is(err, ErrAdminPasskeyRepositoryUnavailable) {
+		t.Fatalf("DeleteByIDAndUserID() error = %v", err)
+	}
+}
+
+func TestAdminLoginAttemptRepositoryUnavailablePaths(t *testing.T) {
+	resetAdminRepositoryState()
+	adminLoginAttemptIndexesOnce = sync.Once{}
+	adminLoginAttemptIndexesErr = nil
+	t.Cleanup(func() {
+		resetAdminRepositoryState()
+		adminLoginAttemptIndexesOnce = sync.Once{}
+		adminLoginAttemptIndexesErr = nil
+	})
+	t.Setenv("MONGODB_URI", "")
+	t.Setenv("MONGODB_DATABASE", "")
+
+	repository := NewAdminLoginAttemptRepository(nil)
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	if _, err := repository.FindByKey(ctx, "login:account:admin@example.com"); !errors.Is(err, ErrAdminLoginAttemptRepositoryUnavailable) {
+		t.Fatalf("FindByKey() error = %v", err)
+	}
+	if _, err := repository.RecordFailure(ctx, "login:account:admin@example.com", now, time.Minute); !errors.Is(err, ErrAdminLoginAttemptRepositoryUnavailable) {
+		t.Fatalf("RecordFailure() error = %v", err)
+	}
+	if _, err := repository.Lock(ctx, "login:account:admin@example.com", now, now.Add(time.Minute)); !errors.Is(err, ErrAdminLoginAttemptRepositoryUnavailable) {
+		t.Fatalf("Lock() error = %v", err)
+	}
+	checkUnavailableError(t, ErrAdminLoginAttemptRepositoryUnavailable, repository.DeleteByKeys(ctx, "login:account:admin@example.com"))
+}
+
 func TestAdminDashboardRepositoryUnavailablePaths(t *testing.T) {
 	resetPostRepositoryState()
 	resetNewsletterRepositoryState()
@@ -383,7 +609,7 @@ func TestAdminDashboardRepositoryUnavailablePaths(t *testing.T) {
 	t.Setenv("MONGODB_URI", "")
 	t.Setenv("MONGODB_DATABASE", "")
 
-	repository := NewAdminDashboardMongoRepository()
+	repository := NewAdminDashboardMongoRepository(nil)
 	ctx := context.Background()
 
 	if _, err := repository.CountDistinctPosts(ctx); !errors.Is(err, ErrAdminDashboardRepositoryUnavailable) {
@@ -413,7 +639,7 @@ func TestAdminNewsletterRepositoryUnavailablePaths(t *testing.T) {
 	t.Setenv("MONGODB_URI", "")
 	t.Setenv("MONGODB_DATABASE", "")
 
-	repository := NewAdminNewsletterRepository()
+	repository := NewAdminNewsletterRepository(nil)
 	ctx := context.Background()
 	now := time.Date(2026, time.March, 22, 10, 0, 0, 0, time.UTC)
 
@@ -440,3 +666,138 @@ func checkUnavailableError(t *testing.T, target, err error) {
 		t.Fatalf("expected error %v, got %v", target, err)
 	}
 }
+
+func TestAdminAccessTokenRepositoryUnavailablePaths(t *testing.T) {
+	resetAdminRepositoryState()
+	adminAccessTokenIndexesOnce = sync.Once{}
+	adminAccessTokenIndexesErr = nil
+	t.Cleanup(func() {
+		resetAdminRepositoryState()
+		adminAccessTokenIndexesOnce = sync.Once{}
+		adminAccessTokenIndexesErr = nil
+	})
+	t.Setenv("MONGODB_URI", "")
+	t.Setenv("MONGODB_DATABASE", "")
+
+	repository := NewAdminAccessTokenRepository(nil)
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	checkUnavailableError(t, ErrAdminAccessTokenRepositoryUnavailable, repository.Create(ctx, domain.AdminAccessTokenRecord{ID: "token-1"}))
+	if _, err := repository.FindActiveByToken(ctx, "raw-token", now); !errors.Is(err, ErrAdminAccessTokenRepositoryUnavailable) {
+		t.Fatalf("FindActiveByToken() error = %v", err)
+	}
+	if _, err := repository.ListByUserID(ctx, "admin-1", now); !errors.Is(err, ErrAdminAccessTokenRepositoryUnavailable) {
+		t.Fatalf("ListByUserID() error = %v", err)
+	}
+	checkUnavailableError(t, ErrAdminAccessTokenRepositoryUnavailable, repository.RecordUse(ctx, "token-1", now, "203.0.113.10"))
+	if _, err := repository.DeleteByIDAndUserID(ctx, "token-1", "admin-1"); !errors.Is(err, ErrAdminAccessTokenRepositoryUnavailable) {
+		t.Fatalf("DeleteByIDAndUserID() error = %v", err)
+	}
+	checkUnavailableError(t, ErrAdminAccessTokenRepositoryUnavailable, repository.DeleteAllByUserID(ctx, "admin-1"))
+}
+
+func TestOIDCIdentityRepositoryUnavailablePaths(t *testing.T) {
+	resetAdminRepositoryState()
+	oidcIdentityIndexesOnce = sync.Once{}
+	oidcIdentityIndexesErr = nil
+	t.Cleanup(func() {
+		resetAdminRepositoryState()
+		oidcIdentityIndexesOnce = sync.Once{}
+		oidcIdentityIndexesErr = nil
+	})
+	t.Setenv("MONGODB_URI", "")
+	t.Setenv("MONGODB_DATABASE", "")
+
+	repository := NewOIDCIdentityRepository(nil)
+	ctx := context.Background()
+
+	if _, err := repository.FindBySubject(ctx, domain.OIDCAccountTypeAdmin, "gitlab", "subject"); !errors.Is(err, ErrOIDCIdentityRepositoryUnavailable) {
+		t.Fatalf("FindBySubject() error = %v", err)
+	}
+	if _, err := repository.ListByUserID(ctx, domain.OIDCAccountTypeReader, "reader-1"); !errors.Is(err, ErrOIDCIdentityRepositoryUnavailable) {
+		t.Fatalf("ListByUserID() error = %v", err)
+	}
+	if _, err := repository.HasAnyByProvider(ctx, domain.OIDCAccountTypeAdmin, "gitlab"); !errors.Is(err, ErrOIDCIdentityRepositoryUnavailable) {
+		t.Fatalf("HasAnyByProvider() error = %v", err)
+	}
+	checkUnavailableError(t, ErrOIDCIdentityRepositoryUnavailable, repository.Link(ctx, domain.OIDCIdentityRecord{
+		AccountType: domain.OIDCAccountTypeAdmin,
+		UserID:      "admin-1",
+		Provider:    "gitlab",
+		Subject:     "subject",
+	}))
+	checkUnavailableError(t, ErrOIDCIdentityRepositoryUnavailable, repository.DeleteByUserID(ctx, domain.OIDCAccountTypeReader, "reader-1"))
+	if _, err := repository.Unlink(ctx, domain.OIDCAccountTypeAdmin, "admin-1", "gitlab"); !errors.Is(err, ErrOIDCIdentityRepositoryUnavailable) {
+		t.Fatalf("Unlink() error = %v", err)
+	}
+}
+
+func TestLoginHistoryRepositoryUnavailablePaths(t *testing.T) {
+	resetAdminRepositoryState()
+	loginHistoryIndexesOnce = sync.Once{}
+	loginHistoryIndexesErr = nil
+	t.Cleanup(func() {
+		resetAdminRepositoryState()
+		loginHistoryIndexesOnce = sync.Once{}
+		loginHistoryIndexesErr = nil
+	})
+	t.Setenv("MONGODB_URI", "")
+	t.Setenv("MONGODB_DATABASE", "")
+
+	repository := NewLoginHistoryRepository(nil)
+	ctx := context.Background()
+
+	if _, err := repository.HasAny(ctx, domain.AccountTypeAdmin, "admin-1"); !errors.Is(err, ErrLoginHistoryRepositoryUnavailable) {
+		t.Fatalf("HasAny() error = %v", err)
+	}
+	if _, err := repository.Remember(ctx, domain.LoginHistoryRecord{
+		AccountType: domain.AccountTypeReader,
+		UserID:      "reader-1",
+		Kind:        domain.LoginHistoryKindCountry,
+		Value:       "TR",
+	}); !errors.Is(err, ErrLoginHistoryRepositoryUnavailable) {
+		t.Fatalf("Remember() error = %v", err)
+	}
+	checkUnavailableError(t, ErrLoginHistoryRepositoryUnavailable, repository.DeleteByUserID(ctx, domain.AccountTypeReader, "reader-1"))
+}
+
+func TestReaderPostLikeRepositoryUnavailablePaths(t *testing.T) {
+	resetAdminRepositoryState()
+	readerPostLikeIndexesOnce = sync.Once{}
+	readerPostLikeIndexesErr = nil
+	t.Cleanup(func() {
+		resetAdminRepositoryState()
+		readerPostLikeIndexesOnce = sync.Once{}
+		readerPostLikeIndexesErr = nil
+	})
+	t.Setenv("MONGODB_URI", "")
+	t.Setenv("MONGODB_DATABASE", "")
+
+	repository := NewReaderPostLikeRepository(nil)
+	ctx := context.Background()
+
+	checkUnavailableError(t, ErrReaderPostLikeRepositoryUnavailable, repository.Record(ctx, "reader-1", "alpha-post", time.Now().UTC()))
+	checkUnavailableError(t, ErrReaderPostLikeRepositoryUnavailable, repository.DeleteByReaderID(ctx, "reader-1"))
+	if _, err := repository.ListByReaderID(ctx, "reader-1"); !errors.Is(err, ErrReaderPostLikeRepositoryUnavailable) {
+		t.Fatalf("ListByReaderID() error = %v", err)
+	}
+}
+
+func TestRateLimitRepositoryUnavailablePaths(t *testing.T) {
+	resetAdminRepositoryState()
+	rateLimitIndexesOnce = sync.Once{}
+	rateLimitIndexesErr = nil
+	t.Cleanup(func() {
+		resetAdminRepositoryState()
+		rateLimitIndexesOnce = sync.Once{}
+		rateLimitIndexesErr = nil
+	})
+	t.Setenv("MONGODB_URI", "")
+	t.Setenv("MONGODB_DATABASE", "")
+
+	repository := NewRateLimitRepository(nil)
+	if _, err := repository.Hit(context.Background(), "comment:client", time.Now().UTC(), 3, time.Minute); !errors.Is(err, ErrRateLimitRepositoryUnavailable) {
+		t.Fatalf("Hit() error = %v", err)
+	}
+}
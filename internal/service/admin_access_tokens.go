@@ -0,0 +1,287 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/repository"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpapi"
+	"suaybsimsek.com/blog-api/pkg/httpauth"
+)
+
+// AdminAccessTokenSecret is returned once when a token is created; only its hash is kept afterwards.
+type AdminAccessTokenSecret struct {
+	Token  string
+	Record domain.AdminAccessTokenRecord
+}
+
+const (
+	// AdminAccessTokenPrefix marks personal access tokens so they are recognizable in logs and secret scanners.
+	AdminAccessTokenPrefix          = "blog_pat_"
+	adminAccessTokenDisplayLength   = len(AdminAccessTokenPrefix) + 6
+	defaultAdminAccessTokenLifetime = 30
+	maxAdminAccessTokenLifetime     = 365
+	maxAdminAccessTokenNameLength   = 64
+	maxAdminAccessTokensPerUser     = 20
+
+	adminCodeAccessTokenScopesInvalid   = "ADMIN_ACCESS_TOKEN_SCOPES_INVALID"
+	adminCodeAccessTokenExpiryInvalid   = "ADMIN_ACCESS_TOKEN_EXPIRY_INVALID"
+	adminCodeAccessTokenNameRequired    = "ADMIN_ACCESS_TOKEN_NAME_REQUIRED"
+	adminCodeAccessTokenLimitReached    = "ADMIN_ACCESS_TOKEN_LIMIT_REACHED"
+	adminCodeAccessTokenSessionRequired = "ADMIN_ACCESS_TOKEN_SESSION_REQUIRED"
+
+	adminAccessTokenAuditResource = "admin_access_token"
+	adminAccessTokenAuditStatus   = "success"
+	adminAccessTokenActionCreated = "created"
+	adminAccessTokenActionRevoked = "revoked"
+)
+
+// CreateAdminAccessToken issues a personal access token limited to scopes, which must be permissions the admin already
+// has. Tokens can only be managed from a signed-in session, so a leaked token cannot mint or revoke others.
+func (s *Service) CreateAdminAccessToken(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	name string,
+	scopes []AdminPermission,
+	expiresInDays int,
+) (*AdminAccessTokenSecret, error) {
+	if err := requireAdminSessionAuthentication(adminUser); err != nil {
+		return nil, err
+	}
+
+	resolvedName := strings.TrimSpace(name)
+	if resolvedName == "" {
+		return nil, apperrors.New(adminCodeAccessTokenNameRequired, "access token name is required", http.StatusBadRequest, nil)
+	}
+	if len([]rune(resolvedName)) > maxAdminAccessTokenNameLength {
+		resolvedName = string([]rune(resolvedName)[:maxAdminAccessTokenNameLength])
+	}
+
+	resolvedScopes, err := normalizeAdminAccessTokenScopes(adminUser, scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	if expiresInDays == 0 {
+		expiresInDays = defaultAdminAccessTokenLifetime
+	}
+	if expiresInDays < 1 || expiresInDays > maxAdminAccessTokenLifetime {
+		return nil, apperrors.New(
+			adminCodeAccessTokenExpiryInvalid,
+			"access token expiry must be between 1 and 365 days",
+			http.StatusBadRequest,
+			nil,
+		)
+	}
+
+	now := nowUTCFn()
+	existing, err := s.adminAccessTokens.ListByUserID(ctx, adminUser.ID, now)
+	if err != nil {
+		return nil, apperrors.Internal("failed to load access tokens", err)
+	}
+	if len(existing) >= maxAdminAccessTokensPerUser {
+		return nil, apperrors.New(
+			adminCodeAccessTokenLimitReached,
+			"access token limit reached",
+			http.StatusConflict,
+			nil,
+		)
+	}
+
+	secret, err := httpauth.GenerateOpaqueToken(32)
+	if err != nil {
+		return nil, apperrors.Internal("failed to generate access token", err)
+	}
+	tokenID, err := httpauth.GenerateOpaqueToken(18)
+	if err != nil {
+		return nil, apperrors.Internal("failed to generate access token id", err)
+	}
+
+	token := AdminAccessTokenPrefix + secret
+	record := domain.AdminAccessTokenRecord{
+		ID:        tokenID,
+		UserID:    adminUser.ID,
+		Name:      resolvedName,
+		Prefix:    token[:adminAccessTokenDisplayLength],
+		TokenHash: repository.HashAdminAccessToken(token),
+		Scopes:    resolvedScopes,
+		CreatedAt: now,
+		ExpiresAt: now.Add(time.Duration(expiresInDays) * 24 * time.Hour),
+	}
+	if err := s.adminAccessTokens.Create(ctx, record); err != nil {
+		return nil, apperrors.Internal("failed to store access token", err)
+	}
+	if err := s.recordAdminAccessTokenAudit(ctx, adminUser, adminAccessTokenActionCreated, record); err != nil {
+		return nil, err
+	}
+
+	return &AdminAccessTokenSecret{Token: token, Record: record}, nil
+}
+
+// ListAdminAccessTokens returns the admin's personal access tokens that have not expired yet.
+func (s *Service) ListAdminAccessTokens(ctx context.Context, adminUser *domain.AdminUser) ([]domain.AdminAccessTokenRecord, error) {
+	if err := requireAdminAuthentication(adminUser); err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.adminAccessTokens.ListByUserID(ctx, adminUser.ID, nowUTCFn())
+	if err != nil {
+		return nil, apperrors.Internal("failed to load access tokens", err)
+	}
+
+	return tokens, nil
+}
+
+// RevokeAdminAccessToken deletes one of the admin's personal access tokens and audits it. Like creation, it needs a
+// signed-in session.
+func (s *Service) RevokeAdminAccessToken(ctx context.Context, adminUser *domain.AdminUser, tokenID string) (bool, error) {
+	if err := requireAdminSessionAuthentication(adminUser); err != nil {
+		return false, err
+	}
+
+	resolvedTokenID := strings.TrimSpace(tokenID)
+	if resolvedTokenID == "" {
+		return false, apperrors.BadRequest("access token id is required")
+	}
+
+	revoked, err := s.adminAccessTokens.DeleteByIDAndUserID(ctx, resolvedTokenID, adminUser.ID)
+	if err != nil {
+		return false, apperrors.Internal("failed to revoke access token", err)
+	}
+	if !revoked {
+		return false, nil
+	}
+
+	record := domain.AdminAccessTokenRecord{ID: resolvedTokenID}
+	if err := s.recordAdminAccessTokenAudit(ctx, adminUser, adminAccessTokenActionRevoked, record); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ResolveAdminFromPersonalAccessToken authenticates an Authorization: Bearer token. Unknown, expired and revoked
+// tokens, and tokens of disabled admins, resolve to no admin. Every accepted use is recorded on the token.
+func (s *Service) ResolveAdminFromPersonalAccessToken(ctx context.Context, token string) (*domain.AdminUser, error) {
+	resolvedToken := strings.TrimSpace(token)
+	if !strings.HasPrefix(resolvedToken, AdminAccessTokenPrefix) {
+		return nil, nil
+	}
+
+	now := nowUTCFn()
+	record, err := s.adminAccessTokens.FindActiveByToken(ctx, resolvedToken, now)
+	if err != nil {
+		return nil, err
+	}
+	if record == nil {
+		return nil, nil
+	}
+
+	userRecord, err := s.adminUsers.FindByID(ctx, record.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if userRecord == nil ||
+		userRecord.Status == domain.AdminUserStatusDisabled ||
+		userRecord.Status == domain.AdminUserStatusInvited {
+		return nil, nil
+	}
+
+	trace, _ := httpapi.RequestTraceFromContext(ctx)
+	if err := s.adminAccessTokens.RecordUse(ctx, record.ID, now, trace.RemoteIP); err != nil {
+		return nil, err
+	}
+
+	adminUser := userRecord.AdminUser
+	adminUser.AccessTokenID = record.ID
+	adminUser.AccessTokenScopes = append([]string{}, record.Scopes...)
+	return &adminUser, nil
+}
+
+// revokeAllAdminAccessTokens deletes every personal access token of an admin. It runs wherever a password change or
+// a disabled account signs the admin out, so tokens do not outlive the credentials they were created under.
+func (s *Service) revokeAllAdminAccessTokens(ctx context.Context, userID string) error {
+	if err := s.adminAccessTokens.DeleteAllByUserID(ctx, userID); err != nil {
+		return apperrors.Internal("failed to revoke access tokens", err)
+	}
+	return nil
+}
+
+// requireAdminSessionAuthentication rejects requests authenticated with a personal access token. Operations that
+// change credentials or account security use it, so a scoped token cannot widen itself into a full session.
+func requireAdminSessionAuthentication(adminUser *domain.AdminUser) error {
+	if err := requireAdminAuthentication(adminUser); err != nil {
+		return err
+	}
+	if strings.TrimSpace(adminUser.AccessTokenID) != "" {
+		return apperrors.New(
+			adminCodeAccessTokenSessionRequired,
+			"access tokens and account security can only be managed from a signed-in session",
+			http.StatusForbidden,
+			nil,
+		)
+	}
+	return nil
+}
+
+func normalizeAdminAccessTokenScopes(adminUser *domain.AdminUser, scopes []AdminPermission) ([]string, error) {
+	granted := ResolveAdminPermissions(adminUser)
+	for _, permission := range scopes {
+		if !slices.Contains(granted, permission) {
+			return nil, newAdminAccessTokenScopesInvalidError()
+		}
+	}
+
+	resolved := make([]string, 0, len(scopes))
+	for _, permission := range allAdminPermissions {
+		if slices.Contains(scopes, permission) {
+			resolved = append(resolved, string(permission))
+		}
+	}
+	if len(resolved) == 0 {
+		return nil, newAdminAccessTokenScopesInvalidError()
+	}
+
+	return resolved, nil
+}
+
+func newAdminAccessTokenScopesInvalidError() error {
+	return apperrors.New(
+		adminCodeAccessTokenScopesInvalid,
+		"select at least one permission you have",
+		http.StatusBadRequest,
+		nil,
+	)
+}
+
+func (s *Service) recordAdminAccessTokenAudit(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	action string,
+	record domain.AdminAccessTokenRecord,
+) error {
+	trace, _ := httpapi.RequestTraceFromContext(ctx)
+	auditRecord := domain.AdminAuditLogRecord{
+		ActorID:     strings.TrimSpace(adminUser.ID),
+		ActorEmail:  strings.TrimSpace(strings.ToLower(adminUser.Email)),
+		Action:      action,
+		Resource:    adminAccessTokenAuditResource,
+		BeforeValue: strings.TrimSpace(record.ID),
+		AfterValue:  strings.Join(record.Scopes, ","),
+		Status:      adminAccessTokenAuditStatus,
+		RequestID:   httpapi.RequestIDFromContext(ctx),
+		RemoteIP:    strings.TrimSpace(trace.RemoteIP),
+		CountryCode: strings.TrimSpace(strings.ToUpper(trace.CountryCode)),
+		UserAgent:   strings.TrimSpace(trace.UserAgent),
+		CreatedAt:   nowUTCFn(),
+	}
+
+	if err := s.adminAuditLogs.Create(ctx, auditRecord); err != nil {
+		return apperrors.Internal("failed to persist admin audit log", err)
+	}
+	return nil
+}
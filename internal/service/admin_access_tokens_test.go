@@ -0,0 +1,320 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/repository"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpapi"
+)
+
+type adminAccessTokenStubRepository struct {
+	records []domain.AdminAccessTokenRecord
+	uses    []string
+}
+
+func (repo *adminAccessTokenStubRepository) Create(_ context.Context, record domain.AdminAccessTokenRecord) error {
+	repo.records = append(repo.records, record)
+	return nil
+}
+
+func (repo *adminAccessTokenStubRepository) FindActiveByToken(
+	_ context.Context,
+	rawToken string,
+	now time.Time,
+) (*domain.AdminAccessTokenRecord, error) {
+	for index := range repo.records {
+		record := repo.records[index]
+		if record.TokenHash == repository.HashAdminAccessToken(rawToken) && record.ExpiresAt.After(now) {
+			return &record, nil
+		}
+	}
+	return nil, nil
+}
+
+func (repo *adminAccessTokenStubRepository) ListByUserID(
+	_ context.Context,
+	userID string,
+	now time.Time,
+) ([]domain.AdminAccessTokenRecord, error) {
+	records := make([]domain.AdminAccessTokenRecord, 0)
+	for _, record := range repo.records {
+		if record.UserID == userID && record.ExpiresAt.After(now) {
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+func (repo *adminAccessTokenStubRepository) RecordUse(_ context.Context, id string, _ time.Time, remoteIP string) error {
+	repo.uses = append(repo.uses, id+"@"+remoteIP)
+	return nil
+}
+
+func (repo *adminAccessTokenStubRepository) DeleteByIDAndUserID(_ context.Context, id, userID string) (bool, error) {
+	for index, record := range repo.records {
+		if record.ID == id && record.UserID == userID {
+			repo.records = append(repo.records[:index], repo.records[index+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (repo *adminAccessTokenStubRepository) DeleteAllByUserID(_ context.Context, userID string) error {
+	repo.records = slices.DeleteFunc(repo.records, func(record domain.AdminAccessTokenRecord) bool {
+		return record.UserID == userID
+	})
+	return nil
+}
+
+// stubAdminAccessTokensOwnedBy swaps in a token store holding one personal access token for each user id.
+func stubAdminAccessTokensOwnedBy(t *testing.T, userIDs ...string) *adminAccessTokenStubRepository {
+	t.Helper()
+
+	previousTokensRepo := adminAccessTokensRepository
+	t.Cleanup(func() {
+		adminAccessTokensRepository = previousTokensRepo
+	})
+
+	tokens := &adminAccessTokenStubRepository{}
+	for _, userID := range userIDs {
+		tokens.records = append(tokens.records, domain.AdminAccessTokenRecord{ID: "token-" + userID, UserID: userID})
+	}
+	adminAccessTokensRepository = tokens
+	return tokens
+}
+
+func stubAdminAccessTokens(t *testing.T) (*adminAccessTokenStubRepository, *adminErrorMessageManagementAuditStub) {
+	t.Helper()
+
+	previousTokensRepo := adminAccessTokensRepository
+	previousUsersRepo := adminUsersRepository
+	previousAuditRepo := adminAuditLogRepo
+	t.Cleanup(func() {
+		adminAccessTokensRepository = previousTokensRepo
+		adminUsersRepository = previousUsersRepo
+		adminAuditLogRepo = previousAuditRepo
+	})
+
+	tokens := &adminAccessTokenStubRepository{}
+	audit := &adminErrorMessageManagementAuditStub{}
+	adminAccessTokensRepository = tokens
+	adminAuditLogRepo = audit
+	return tokens, audit
+}
+
+func TestCreateAdminAccessTokenAuthenticatesWithNarrowedScopes(t *testing.T) {
+	tokens, audit := stubAdminAccessTokens(t)
+	users := newAdminAuthEmailChangeStubUserRepository(&domain.AdminUserRecord{
+		AdminUser: domain.AdminUser{ID: "admin-1", Email: "admin@example.com", Roles: []string{AdminRoleEditor}, Status: domain.AdminUserStatusActive},
+	})
+	adminUsersRepository = users
+	adminUser := &users.byID["admin-1"].AdminUser
+
+	created, err := testService().CreateAdminAccessToken(
+		context.Background(),
+		adminUser,
+		" Docs pipeline ",
+		[]AdminPermission{AdminPermissionContentWrite, AdminPermissionContentRead},
+		7,
+	)
+	if err != nil {
+		t.Fatalf("CreateAdminAccessToken returned error: %v", err)
+	}
+	if !strings.HasPrefix(created.Token, AdminAccessTokenPrefix) || !strings.HasPrefix(created.Token, created.Record.Prefix) {
+		t.Fatalf("unexpected token %q prefix %q", created.Token, created.Record.Prefix)
+	}
+	if len(tokens.records) != 1 || tokens.records[0].TokenHash == created.Token || tokens.records[0].Name != "Docs pipeline" {
+		t.Fatalf("unexpected stored tokens %#v", tokens.records)
+	}
+	if scopes := strings.Join(created.Record.Scopes, ","); scopes != "CONTENT_READ,CONTENT_WRITE" {
+		t.Fatalf("unexpected scopes %q", scopes)
+	}
+	if len(audit.records) != 1 || audit.records[0].Action != adminAccessTokenActionCreated || audit.records[0].Resource != adminAccessTokenAuditResource {
+		t.Fatalf("unexpected audit records %#v", audit.records)
+	}
+
+	ctx := httpapi.WithRequestTrace(context.Background(), httpapi.RequestTrace{RemoteIP: "203.0.113.10"})
+	resolved, err := testService().ResolveAdminFromPersonalAccessToken(ctx, created.Token)
+	if err != nil || resolved == nil || resolved.ID != "admin-1" || resolved.AccessTokenID != created.Record.ID {
+		t.Fatalf("ResolveAdminFromPersonalAccessToken() = %#v, %v", resolved, err)
+	}
+	if len(tokens.uses) != 1 || tokens.uses[0] != created.Record.ID+"@203.0.113.10" {
+		t.Fatalf("expected the use to be recorded, got %v", tokens.uses)
+	}
+
+	if err := RequireAdminPermission(resolved, AdminPermissionContentWrite); err != nil {
+		t.Fatalf("expected scoped permission to be granted, got %v", err)
+	}
+	// Editors may upload media, but the token was not given that scope.
+	if err := RequireAdminPermission(resolved, AdminPermissionMediaWrite); apperrors.From(err).Code != adminCodeForbidden {
+		t.Fatalf("expected unscoped permission to be denied, got %v", err)
+	}
+
+	if _, err := testService().CreateAdminAccessToken(context.Background(), resolved, "nested", []AdminPermission{AdminPermissionContentRead}, 1); apperrors.From(err).Code != adminCodeAccessTokenSessionRequired {
+		t.Fatalf("expected token-authenticated creation to fail, got %v", err)
+	}
+	if _, err := testService().RevokeAdminAccessToken(context.Background(), resolved, created.Record.ID); apperrors.From(err).Code != adminCodeAccessTokenSessionRequired {
+		t.Fatalf("expected token-authenticated revocation to fail, got %v", err)
+	}
+
+	revoked, err := testService().RevokeAdminAccessToken(context.Background(), adminUser, created.Record.ID)
+	if err != nil || !revoked {
+		t.Fatalf("RevokeAdminAccessToken() = %v, %v", revoked, err)
+	}
+	if resolved, err := testService().ResolveAdminFromPersonalAccessToken(ctx, created.Token); err != nil || resolved != nil {
+		t.Fatalf("expected revoked token to be rejected, got %#v, %v", resolved, err)
+	}
+	if len(audit.records) != 2 || audit.records[1].Action != adminAccessTokenActionRevoked {
+		t.Fatalf("unexpected audit records %#v", audit.records)
+	}
+}
+
+func TestCreateAdminAccessTokenValidatesInput(t *testing.T) {
+	stubAdminAccessTokens(t)
+	editor := &domain.AdminUser{ID: "admin-1", Roles: []string{AdminRoleEditor}}
+
+	cases := []struct {
+		name    string
+		scopes  []AdminPermission
+		days    int
+		code    string
+		summary string
+	}{
+		{name: "", scopes: []AdminPermission{AdminPermissionContentRead}, code: adminCodeAccessTokenNameRequired, summary: "blank name"},
+		{name: "ci", scopes: nil, code: adminCodeAccessTokenScopesInvalid, summary: "no scopes"},
+		{name: "ci", scopes: []AdminPermission{AdminPermissionUsersManage}, code: adminCodeAccessTokenScopesInvalid, summary: "scope the admin lacks"},
+		{name: "ci", scopes: []AdminPermission{"UNKNOWN"}, code: adminCodeAccessTokenScopesInvalid, summary: "unknown scope"},
+		{name: "ci", scopes: []AdminPermission{AdminPermissionContentRead}, days: 400, code: adminCodeAccessTokenExpiryInvalid, summary: "expiry too long"},
+		{name: "ci", scopes: []AdminPermission{AdminPermissionContentRead}, days: -1, code: adminCodeAccessTokenExpiryInvalid, summary: "negative expiry"},
+	}
+	for _, testCase := range cases {
+		if _, err := testService().CreateAdminAccessToken(context.Background(), editor, testCase.name, testCase.scopes, testCase.days); apperrors.From(err).Code != testCase.code {
+			t.Fatalf("%s: expected %s, got %v", testCase.summary, testCase.code, err)
+		}
+	}
+
+	created, err := testService().CreateAdminAccessToken(context.Background(), editor, "ci", []AdminPermission{AdminPermissionContentRead}, 0)
+	if err != nil || created.Record.ExpiresAt.Sub(created.Record.CreatedAt) != defaultAdminAccessTokenLifetime*24*time.Hour {
+		t.Fatalf("expected default expiry, got %#v, %v", created, err)
+	}
+}
+
+func TestResolveAdminFromPersonalAccessTokenRejectsDisabledAdmins(t *testing.T) {
+	tokens, _ := stubAdminAccessTokens(t)
+	users := newAdminAuthEmailChangeStubUserRepository(&domain.AdminUserRecord{
+		AdminUser: domain.AdminUser{ID: "admin-1", Email: "admin@example.com", Roles: []string{AdminRoleOwner}, Status: domain.AdminUserStatusDisabled},
+	})
+	adminUsersRepository = users
+
+	token := AdminAccessTokenPrefix + "secret"
+	tokens.records = append(tokens.records, domain.AdminAccessTokenRecord{
+		ID:        "token-1",
+		UserID:    "admin-1",
+		TokenHash: repository.HashAdminAccessToken(token),
+		Scopes:    []string{string(AdminPermissionContentRead)},
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+
+	if resolved, err := testService().ResolveAdminFromPersonalAccessToken(context.Background(), token); err != nil || resolved != nil {
+		t.Fatalf("expected disabled admin to be rejected, got %#v, %v", resolved, err)
+	}
+	if resolved, err := testService().ResolveAdminFromPersonalAccessToken(context.Background(), "session-jwt"); err != nil || resolved != nil {
+		t.Fatalf("expected non access token to be ignored, got %#v, %v", resolved, err)
+	}
+	if len(tokens.uses) != 0 {
+		t.Fatalf("expected no recorded use, got %v", tokens.uses)
+	}
+}
+
+func TestAdminSecurityOperationsRejectPersonalAccessTokens(t *testing.T) {
+	ctx := context.Background()
+	adminUser := &domain.AdminUser{ID: "admin-1", Roles: []string{AdminRoleOwner}, AccessTokenID: "token-1"}
+	services := testService()
+
+	operations := map[string]func() error{
+		"ChangeAdminPassword": func() error {
+			return services.ChangeAdminPassword(ctx, adminUser, "current", "next-password", "next-password")
+		},
+		"RequestAdminEmailChange": func() error {
+			_, err := services.RequestAdminEmailChange(ctx, adminUser, "new@example.com", "current", "en")
+			return err
+		},
+		"DeleteAdminAccount": func() error {
+			return services.DeleteAdminAccount(ctx, adminUser, "current")
+		},
+		"RevokeAdminSession": func() error {
+			_, err := services.RevokeAdminSession(ctx, adminUser, "session-1")
+			return err
+		},
+		"RevokeAllAdminSessions": func() error {
+			return services.RevokeAllAdminSessions(ctx, adminUser)
+		},
+		"StartAdminGoogleConnect": func() error {
+			_, err := StartAdminGoogleConnect(ctx, adminUser, "en")
+			return err
+		},
+		"DisconnectAdminGoogleAccount": func() error {
+			_, err := services.DisconnectAdminGoogleAccount(ctx, adminUser)
+			return err
+		},
+		"StartAdminGithubConnect": func() error {
+			_, err := StartAdminGithubConnect(ctx, adminUser, "en")
+			return err
+		},
+		"DisconnectAdminGithubAccount": func() error {
+			_, err := services.DisconnectAdminGithubAccount(ctx, adminUser)
+			return err
+		},
+		"StartAdminOIDCConnect": func() error {
+			_, err := StartAdminOIDCConnect(ctx, adminUser, "corp", "en")
+			return err
+		},
+		"DisconnectAdminOIDCAccount": func() error {
+			_, err := services.DisconnectAdminOIDCAccount(ctx, adminUser, "corp")
+			return err
+		},
+		"StartAdminPasskeyRegistration": func() error {
+			_, err := services.StartAdminPasskeyRegistration(ctx, adminUser)
+			return err
+		},
+		"FinishAdminPasskeyRegistration": func() error {
+			_, err := services.FinishAdminPasskeyRegistration(ctx, adminUser, "challenge", "{}", "Laptop")
+			return err
+		},
+		"RevokeAdminPasskey": func() error {
+			_, err := services.RevokeAdminPasskey(ctx, adminUser, "passkey-1")
+			return err
+		},
+		"StartAdminTwoFactorEnrollment": func() error {
+			_, err := services.StartAdminTwoFactorEnrollment(ctx, adminUser, "current")
+			return err
+		},
+		"EnableAdminTwoFactor": func() error {
+			_, err := services.EnableAdminTwoFactor(ctx, adminUser, "current", "123456")
+			return err
+		},
+		"DisableAdminTwoFactor": func() error {
+			_, err := services.DisableAdminTwoFactor(ctx, adminUser, "current")
+			return err
+		},
+		"RegenerateAdminTwoFactorRecoveryCodes": func() error {
+			_, err := services.RegenerateAdminTwoFactorRecoveryCodes(ctx, adminUser, "current")
+			return err
+		},
+	}
+
+	for name, operation := range operations {
+		appErr := apperrors.From(operation())
+		if appErr == nil || appErr.Code != adminCodeAccessTokenSessionRequired || appErr.HTTPStatus != http.StatusForbidden {
+			t.Fatalf("%s: expected a token-authenticated call to be forbidden, got %#v", name, appErr)
+		}
+	}
+}
@@ -23,6 +23,10 @@ type AdminAuthResponse struct {
 	RefreshToken string
 	RememberMe   bool
 	RefreshTTL   time.Duration
+	// MFARequired is set instead of the tokens when the password was correct but a second factor is still needed.
+	MFARequired  bool
+	MFAToken     string
+	MFAExpiresAt time.Time
 }
 
 type AdminSessionMetadata struct {
@@ -80,15 +84,12 @@ const (
 var adminUsernamePattern = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
 
 var (
-	adminUsersRepository                    repository.AdminUserRepository         = repository.NewAdminUserRepository()
-	adminRefreshTokensRepository            repository.AdminRefreshTokenRepository = repository.NewAdminRefreshTokenMongoRepository()
-	adminAvatarRepository                   repository.AdminAvatarRepository       = repository.NewAdminAvatarRepository()
-	sendAdminEmailChangeConfirmationEmailFn                                        = sendAdminEmailChangeConfirmationEmail
-	sendAdminEmailChangeNoticeEmailFn                                              = sendAdminEmailChangeNoticeEmail
-	sendAdminPasswordResetEmailFn                                                  = sendAdminPasswordResetEmail
+	sendAdminEmailChangeConfirmationEmailFn = sendAdminEmailChangeConfirmationEmail
+	sendAdminEmailChangeNoticeEmailFn       = sendAdminEmailChangeNoticeEmail
+	sendAdminPasswordResetEmailFn           = sendAdminPasswordResetEmail
 )
 
-func LoginAdmin(
+func (s *Service) LoginAdmin(
 	ctx context.Context,
 	email string,
 	password string,
@@ -96,31 +97,45 @@ func LoginAdmin(
 	metadata AdminSessionMetadata,
 ) (*AdminAuthResponse, error) {
 	config := appconfig.ResolveAdminConfig()
-	if strings.TrimSpace(config.JWTSecret) == "" {
+	if !config.JWTConfigured() {
 		return nil, apperrors.Config("admin jwt is not configured", nil)
 	}
 
-	userRecord, err := adminUsersRepository.FindByEmail(ctx, strings.TrimSpace(strings.ToLower(email)))
+	resolvedEmail := strings.TrimSpace(strings.ToLower(email))
+	throttleSubjects := adminLoginThrottleSubjects(resolvedEmail, metadata.RemoteIP)
+	if err := s.checkAdminThrottle(ctx, throttleSubjects, adminCodeLoginThrottled, adminCodeLoginLocked); err != nil {
+		return nil, err
+	}
+
+	userRecord, err := s.adminUsers.FindByEmail(ctx, resolvedEmail)
 	if err != nil {
 		return nil, apperrors.Internal(adminLoadAdminUserMessage, err)
 	}
 	if userRecord == nil {
-		return nil, apperrors.Unauthorized("invalid credentials")
+		return nil, s.failAdminLogin(ctx, throttleSubjects, nil, apperrors.Unauthorized("invalid credentials"))
 	}
 	if err := verifyAdminPassword(userRecord, password); err != nil {
-		return nil, apperrors.Unauthorized("invalid credentials")
+		return nil, s.failAdminLogin(ctx, throttleSubjects, userRecord, apperrors.Unauthorized("invalid credentials"))
+	}
+	// Failures are only forgotten once the second factor is verified too, so a known password cannot be used to
+	// reset the counter between guesses at the code.
+	if userRecord.TwoFactor != nil {
+		return issueAdminMFAChallenge(config, userRecord, rememberMe)
+	}
+	if err := s.clearAdminLoginFailures(ctx, resolvedEmail); err != nil {
+		return nil, err
 	}
 
-	return issueAdminTokens(ctx, config, userRecord, "", rememberMe, metadata)
+	return s.issueAdminTokens(ctx, config, userRecord, "", rememberMe, metadata)
 }
 
-func RefreshAdminSession(ctx context.Context, token string, metadata AdminSessionMetadata) (*AdminAuthResponse, error) {
+func (s *Service) RefreshAdminSession(ctx context.Context, token string, metadata AdminSessionMetadata) (*AdminAuthResponse, error) {
 	config := appconfig.ResolveAdminConfig()
-	if strings.TrimSpace(config.JWTSecret) == "" {
+	if !config.JWTConfigured() {
 		return nil, apperrors.Config("admin jwt is not configured", nil)
 	}
 
-	claims, err := httpauth.VerifyHS256JWT(token, config.JWTSecret, "refresh", time.Now().UTC())
+	claims, err := VerifyAdminJWT(config, token, "refresh", time.Now().UTC())
 	if err != nil {
 		return nil, apperrors.Unauthorized("invalid admin session")
 	}
@@ -128,11 +143,14 @@ func RefreshAdminSession(ctx context.Context, token string, metadata AdminSessio
 		return nil, apperrors.Unauthorized("invalid admin session")
 	}
 
-	record, err := adminRefreshTokensRepository.FindActiveByToken(ctx, claims.ID, token, time.Now().UTC())
+	record, err := s.adminRefreshTokens.FindActiveByToken(ctx, claims.ID, token, time.Now().UTC())
 	if err != nil {
 		return nil, toAdminSessionError(err)
 	}
 	if record == nil {
+		if err := s.handleAdminRefreshTokenReuse(ctx, claims.ID, token); err != nil {
+			return nil, err
+		}
 		return nil, apperrors.Unauthorized("invalid admin session")
 	}
 
@@ -141,7 +159,7 @@ func RefreshAdminSession(ctx context.Context, token string, metadata AdminSessio
 		return nil, apperrors.Unauthorized("invalid admin session")
 	}
 
-	userRecord, err := adminUsersRepository.FindByID(ctx, resolvedUserID)
+	userRecord, err := s.adminUsers.FindByID(ctx, resolvedUserID)
 	if err != nil {
 		return nil, apperrors.Internal(adminLoadAdminUserMessage, err)
 	}
@@ -155,16 +173,16 @@ func RefreshAdminSession(ctx context.Context, token string, metadata AdminSessio
 		return nil, apperrors.Unauthorized("invalid admin session")
 	}
 
-	return issueAdminTokens(ctx, config, userRecord, claims.ID, record.Persistent, metadata)
+	return s.issueAdminTokens(ctx, config, userRecord, claims.ID, record.Persistent, metadata)
 }
 
-func LogoutAdmin(ctx context.Context, token string) error {
+func (s *Service) LogoutAdmin(ctx context.Context, token string) error {
 	config := appconfig.ResolveAdminConfig()
-	if strings.TrimSpace(config.JWTSecret) == "" || strings.TrimSpace(token) == "" {
+	if !config.JWTConfigured() || strings.TrimSpace(token) == "" {
 		return nil
 	}
 
-	claims, err := httpauth.VerifyHS256JWT(token, config.JWTSecret, "refresh", time.Now().UTC())
+	claims, err := VerifyAdminJWT(config, token, "refresh", time.Now().UTC())
 	if err != nil {
 		if errors.Is(err, httpauth.ErrInvalidJWT) || errors.Is(err, httpauth.ErrExpiredJWT) || errors.Is(err, httpauth.ErrUnsupportedJWT) {
 			return nil
@@ -175,25 +193,25 @@ func LogoutAdmin(ctx context.Context, token string) error {
 		return nil
 	}
 
-	if err := adminRefreshTokensRepository.RevokeByJTI(ctx, claims.ID, time.Now().UTC()); err != nil {
+	if err := s.adminRefreshTokens.RevokeByJTI(ctx, claims.ID, time.Now().UTC()); err != nil {
 		return toAdminSessionError(err)
 	}
 
 	return nil
 }
 
-func ChangeAdminPassword(
+func (s *Service) ChangeAdminPassword(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	currentPassword string,
 	newPassword string,
 	confirmPassword string,
 ) error {
-	if err := requireAdminAuthentication(adminUser); err != nil {
+	if err := requireAdminSessionAuthentication(adminUser); err != nil {
 		return err
 	}
 
-	userRecord, err := loadAdminUserRecord(ctx, adminUser.ID)
+	userRecord, err := s.loadAdminUserRecord(ctx, adminUser.ID)
 	if err != nil {
 		return err
 	}
@@ -216,18 +234,18 @@ func ChangeAdminPassword(
 		return apperrors.Internal("failed to hash admin password", err)
 	}
 
-	if err := adminUsersRepository.UpdatePasswordHashByID(ctx, userRecord.ID, string(passwordHashBytes)); err != nil {
+	if err := s.adminUsers.UpdatePasswordHashByID(ctx, userRecord.ID, string(passwordHashBytes)); err != nil {
 		if errors.Is(err, repository.ErrAdminUserNotFound) {
 			return apperrors.Unauthorized(adminAuthRequiredMessage)
 		}
 		return apperrors.Internal("failed to update admin password", err)
 	}
 
-	if err := adminRefreshTokensRepository.RevokeAllByUserID(ctx, userRecord.ID, time.Now().UTC()); err != nil {
+	if err := s.adminRefreshTokens.RevokeAllByUserID(ctx, userRecord.ID, time.Now().UTC()); err != nil {
 		return toAdminSessionError(err)
 	}
 
-	return nil
+	return s.revokeAllAdminAccessTokens(ctx, userRecord.ID)
 }
 
 func requireAdminAuthentication(adminUser *domain.AdminUser) error {
@@ -238,8 +256,8 @@ func requireAdminAuthentication(adminUser *domain.AdminUser) error {
 	return nil
 }
 
-func loadAdminUserRecord(ctx context.Context, adminUserID string) (*domain.AdminUserRecord, error) {
-	userRecord, err := adminUsersRepository.FindByID(ctx, adminUserID)
+func (s *Service) loadAdminUserRecord(ctx context.Context, adminUserID string) (*domain.AdminUserRecord, error) {
+	userRecord, err := s.adminUsers.FindByID(ctx, adminUserID)
 	if err != nil {
 		return nil, apperrors.Internal(adminLoadAdminUserMessage, err)
 	}
@@ -250,8 +268,8 @@ func loadAdminUserRecord(ctx context.Context, adminUserID string) (*domain.Admin
 	return userRecord, nil
 }
 
-func reloadAdminUser(ctx context.Context, adminUserID string) (*domain.AdminUser, error) {
-	updatedUserRecord, err := loadAdminUserRecord(ctx, adminUserID)
+func (s *Service) reloadAdminUser(ctx context.Context, adminUserID string) (*domain.AdminUser, error) {
+	updatedUserRecord, err := s.loadAdminUserRecord(ctx, adminUserID)
 	if err != nil {
 		return nil, err
 	}
@@ -267,7 +285,7 @@ func validateAdminCurrentPassword(userRecord *domain.AdminUserRecord, currentPas
 	return nil
 }
 
-func issueAdminTokens(
+func (s *Service) issueAdminTokens(
 	ctx context.Context,
 	config appconfig.AdminConfig,
 	userRecord *domain.AdminUserRecord,
@@ -285,7 +303,8 @@ func issueAdminTokens(
 	if rememberMe {
 		refreshTTL = config.RememberRefreshTTL
 	}
-	accessToken, err := httpauth.IssueHS256JWT(
+	accessToken, err := issueAdminJWT(
+		config,
 		httpauth.JWTClaims{
 			Subject:         adminID,
 			Email:           userRecord.Email,
@@ -297,7 +316,6 @@ func issueAdminTokens(
 			IssuedAt:        now.Unix(),
 			ExpiresAt:       now.Add(config.AccessTTL).Unix(),
 		},
-		config.JWTSecret,
 	)
 	if err != nil {
 		return nil, apperrors.Internal("failed to issue admin access token", err)
@@ -308,7 +326,8 @@ func issueAdminTokens(
 		return nil, apperrors.Internal("failed to issue admin refresh token", err)
 	}
 
-	refreshToken, err := httpauth.IssueHS256JWT(
+	refreshToken, err := issueAdminJWT(
+		config,
 		httpauth.JWTClaims{
 			ID:              refreshJTI,
 			Subject:         adminID,
@@ -321,7 +340,6 @@ func issueAdminTokens(
 			IssuedAt:        now.Unix(),
 			ExpiresAt:       now.Add(refreshTTL).Unix(),
 		},
-		config.JWTSecret,
 	)
 	if err != nil {
 		return nil, apperrors.Internal("failed to issue admin refresh token", err)
@@ -341,11 +359,12 @@ func issueAdminTokens(
 	}
 
 	if strings.TrimSpace(currentRefreshJTI) == "" {
-		if err := adminRefreshTokensRepository.Create(ctx, refreshRecord); err != nil {
+		if err := s.adminRefreshTokens.Create(ctx, refreshRecord); err != nil {
 			return nil, toAdminSessionError(err)
 		}
+		s.notifyAdminNewLoginSource(ctx, config, userRecord, metadata, now)
 	} else {
-		if err := adminRefreshTokensRepository.Rotate(ctx, currentRefreshJTI, refreshRecord, now); err != nil {
+		if err := s.adminRefreshTokens.Rotate(ctx, currentRefreshJTI, refreshRecord, now); err != nil {
 			if errors.Is(err, repository.ErrAdminRefreshTokenNotFound) {
 				return nil, apperrors.Unauthorized("invalid admin session")
 			}
@@ -102,7 +102,7 @@ func TestChangeAdminAvatarStoresAvatarAndUpdatesUser(t *testing.T) {
 	adminAvatarRepository = avatarRepo
 
 	input := makePNGDataURL(t)
-	updated, err := ChangeAdminAvatar(context.Background(), &domain.AdminUser{ID: "admin-1"}, &input)
+	updated, err := testService().ChangeAdminAvatar(context.Background(), &domain.AdminUser{ID: "admin-1"}, &input)
 	if err != nil {
 		t.Fatalf("ChangeAdminAvatar returned error: %v", err)
 	}
@@ -156,7 +156,7 @@ func TestResolveAdminAvatarAssetGeneratesMissingVariantAndCachesIt(t *testing.T)
 	}
 	adminAvatarRepository = avatarRepo
 
-	asset, err := ResolveAdminAvatarAsset(context.Background(), "admin-1", 64, digest, 4)
+	asset, err := testService().ResolveAdminAvatarAsset(context.Background(), "admin-1", 64, digest, 4)
 	if err != nil {
 		t.Fatalf("ResolveAdminAvatarAsset returned error: %v", err)
 	}
@@ -198,7 +198,7 @@ func TestChangeAdminAvatarClearsExistingAvatar(t *testing.T) {
 	adminAvatarRepository = avatarRepo
 
 	empty := "   "
-	updated, err := ChangeAdminAvatar(context.Background(), &domain.AdminUser{ID: "admin-1"}, &empty)
+	updated, err := testService().ChangeAdminAvatar(context.Background(), &domain.AdminUser{ID: "admin-1"}, &empty)
 	if err != nil {
 		t.Fatalf("ChangeAdminAvatar returned error: %v", err)
 	}
@@ -283,11 +283,11 @@ func TestAdminAvatarAndPasswordHelpersHandleErrors(t *testing.T) {
 	adminAvatarRepository = &adminAuthAvatarStubRepository{}
 
 	empty := " "
-	if _, err := ChangeAdminAvatar(context.Background(), &domain.AdminUser{ID: "admin-1"}, &empty); err == nil {
+	if _, err := testService().ChangeAdminAvatar(context.Background(), &domain.AdminUser{ID: "admin-1"}, &empty); err == nil {
 		t.Fatal("expected avatar update auth/not found error")
 	}
 
-	if _, err := ChangeAdminAvatar(context.Background(), &domain.AdminUser{ID: "admin-1"}, strPtr("data:image/png;base64,invalid")); err == nil {
+	if _, err := testService().ChangeAdminAvatar(context.Background(), &domain.AdminUser{ID: "admin-1"}, strPtr("data:image/png;base64,invalid")); err == nil {
 		t.Fatal("expected invalid avatar payload error")
 	}
 }
@@ -322,13 +322,13 @@ func TestResolveAdminAvatarAssetRejectsMissingOrMismatchedAvatar(t *testing.T) {
 		},
 	}
 
-	if _, err := ResolveAdminAvatarAsset(context.Background(), "", 64, "digest", 2); err == nil {
+	if _, err := testService().ResolveAdminAvatarAsset(context.Background(), "", 64, "digest", 2); err == nil {
 		t.Fatal("expected user id required error")
 	}
-	if _, err := ResolveAdminAvatarAsset(context.Background(), "admin-1", 64, "", 2); err == nil {
+	if _, err := testService().ResolveAdminAvatarAsset(context.Background(), "admin-1", 64, "", 2); err == nil {
 		t.Fatal("expected missing digest not found error")
 	}
-	if _, err := ResolveAdminAvatarAsset(context.Background(), "admin-1", 64, "digest", 3); err == nil {
+	if _, err := testService().ResolveAdminAvatarAsset(context.Background(), "admin-1", 64, "digest", 3); err == nil {
 		t.Fatal("expected version mismatch not found error")
 	}
 }
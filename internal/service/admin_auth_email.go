@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"net/url"
 	"strings"
 
@@ -12,6 +13,7 @@ import (
 	"suaybsimsek.com/blog-api/internal/repository"
 	adminmailpkg "suaybsimsek.com/blog-api/pkg/adminmail"
 	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpapi"
 	newsletterpkg "suaybsimsek.com/blog-api/pkg/newsletter"
 )
 
@@ -23,21 +25,21 @@ type adminEmailChangeRequest struct {
 	Pending    domain.AdminPendingEmailChange
 }
 
-func RequestAdminEmailChange(
+func (s *Service) RequestAdminEmailChange(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	newEmail string,
 	currentPassword string,
 	locale string,
 ) (*AdminEmailChangeRequestResult, error) {
-	if err := requireAdminAuthentication(adminUser); err != nil {
+	if err := requireAdminSessionAuthentication(adminUser); err != nil {
 		return nil, err
 	}
 	if strings.TrimSpace(currentPassword) == "" {
 		return nil, apperrors.BadRequest("current password is required")
 	}
 
-	userRecord, err := loadAdminUserRecord(ctx, adminUser.ID)
+	userRecord, err := s.loadAdminUserRecord(ctx, adminUser.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -45,7 +47,7 @@ func RequestAdminEmailChange(
 		return nil, err
 	}
 
-	resolvedEmail, err := resolveAdminEmailChangeTarget(ctx, userRecord, newEmail)
+	resolvedEmail, err := s.resolveAdminEmailChangeTarget(ctx, userRecord, newEmail)
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +57,7 @@ func RequestAdminEmailChange(
 		return nil, err
 	}
 
-	if err := adminUsersRepository.SetPendingEmailChangeByID(ctx, userRecord.ID, request.Pending); err != nil {
+	if err := s.adminUsers.SetPendingEmailChangeByID(ctx, userRecord.ID, request.Pending); err != nil {
 		if errors.Is(err, repository.ErrAdminUserNotFound) {
 			return nil, apperrors.Unauthorized(adminAuthRequiredMessage)
 		}
@@ -69,7 +71,7 @@ func RequestAdminEmailChange(
 		request.Locale,
 		request.SiteURL,
 	); err != nil {
-		_ = adminUsersRepository.ClearPendingEmailChangeByID(ctx, userRecord.ID)
+		_ = s.adminUsers.ClearPendingEmailChangeByID(ctx, userRecord.ID)
 		return nil, apperrors.ServiceUnavailable("failed to send email change confirmation", err)
 	}
 
@@ -82,7 +84,7 @@ func RequestAdminEmailChange(
 	}, nil
 }
 
-func ConfirmAdminEmailChange(
+func (s *Service) ConfirmAdminEmailChange(
 	ctx context.Context,
 	token string,
 	localeHint string,
@@ -96,7 +98,7 @@ func ConfirmAdminEmailChange(
 		}, nil
 	}
 
-	userRecord, err := adminUsersRepository.FindByPendingEmailChangeTokenHash(ctx, hashValue(resolvedToken))
+	userRecord, err := s.adminUsers.FindByPendingEmailChangeTokenHash(ctx, hashValue(resolvedToken))
 	if err != nil {
 		return nil, apperrors.Internal("failed to load admin email change request", err)
 	}
@@ -114,7 +116,7 @@ func ConfirmAdminEmailChange(
 
 	now := nowUTCFn()
 	if now.After(pending.ExpiresAt) {
-		if clearErr := adminUsersRepository.ClearPendingEmailChangeByID(ctx, userRecord.ID); clearErr != nil &&
+		if clearErr := s.adminUsers.ClearPendingEmailChangeByID(ctx, userRecord.ID); clearErr != nil &&
 			!errors.Is(clearErr, repository.ErrAdminUserNotFound) {
 			return nil, apperrors.Internal("failed to clear expired admin email change request", clearErr)
 		}
@@ -124,9 +126,9 @@ func ConfirmAdminEmailChange(
 		}, nil
 	}
 
-	if err := adminUsersRepository.UpdateEmailByID(ctx, userRecord.ID, pending.NewEmail); err != nil {
+	if err := s.adminUsers.UpdateEmailByID(ctx, userRecord.ID, pending.NewEmail); err != nil {
 		if errors.Is(err, repository.ErrAdminEmailAlreadyExists) {
-			_ = adminUsersRepository.ClearPendingEmailChangeByID(ctx, userRecord.ID)
+			_ = s.adminUsers.ClearPendingEmailChangeByID(ctx, userRecord.ID)
 			return &AdminEmailChangeConfirmResult{
 				Status: string(adminmailpkg.StatusFailed),
 				Locale: resolvedLocale,
@@ -141,7 +143,7 @@ func ConfirmAdminEmailChange(
 		return nil, apperrors.Internal("failed to update admin email", err)
 	}
 
-	if err := adminRefreshTokensRepository.RevokeAllByUserID(ctx, userRecord.ID, now); err != nil {
+	if err := s.adminRefreshTokens.RevokeAllByUserID(ctx, userRecord.ID, now); err != nil {
 		return nil, toAdminSessionError(err)
 	}
 
@@ -151,7 +153,7 @@ func ConfirmAdminEmailChange(
 	}, nil
 }
 
-func resolveAdminEmailChangeTarget(
+func (s *Service) resolveAdminEmailChangeTarget(
 	ctx context.Context,
 	userRecord *domain.AdminUserRecord,
 	newEmail string,
@@ -164,7 +166,7 @@ func resolveAdminEmailChangeTarget(
 		return "", apperrors.BadRequest("new email must be different from current email")
 	}
 
-	existingUser, err := adminUsersRepository.FindByEmail(ctx, resolvedEmail)
+	existingUser, err := s.adminUsers.FindByEmail(ctx, resolvedEmail)
 	if err != nil {
 		return "", apperrors.Internal("failed to validate admin email", err)
 	}
@@ -258,3 +260,55 @@ func sendAdminEmailChangeNoticeEmail(
 
 	return newsletterpkg.SendHTMLEmail(cfg, recipientEmail, subject, htmlBody, nil)
 }
+
+func sendAdminLockoutNoticeEmail(
+	cfg appconfig.MailConfig,
+	recipientEmail,
+	locale,
+	siteURL string,
+) error {
+	subject, htmlBody, err := adminmailpkg.AccountLockedNoticeEmail(locale, siteURL)
+	if err != nil {
+		return fmt.Errorf("build admin lockout notice email failed: %w", err)
+	}
+
+	return newsletterpkg.SendHTMLEmail(cfg, recipientEmail, subject, htmlBody, nil)
+}
+
+func sendAdminSessionReuseNoticeEmail(
+	cfg appconfig.MailConfig,
+	recipientEmail,
+	locale,
+	siteURL string,
+) error {
+	subject, htmlBody, err := adminmailpkg.SessionReuseNoticeEmail(locale, siteURL)
+	if err != nil {
+		return fmt.Errorf("build admin session reuse notice email failed: %w", err)
+	}
+
+	return newsletterpkg.SendHTMLEmail(cfg, recipientEmail, subject, htmlBody, nil)
+}
+
+// notifyAdminSecurityEvent emails an admin about a security event. Failures are only logged, because the event itself
+// has already been handled.
+func notifyAdminSecurityEvent(
+	ctx context.Context,
+	userRecord *domain.AdminUserRecord,
+	event string,
+	send func(appconfig.MailConfig, string, string, string) error,
+) {
+	siteURL, err := resolveSiteURLFn()
+	if err != nil {
+		httpapi.LogError(ctx, "admin "+event+" notice site url is not configured", err)
+		return
+	}
+	mailCfg, err := resolveMailConfigFn()
+	if err != nil {
+		httpapi.LogError(ctx, "admin "+event+" notice mail transport is not configured", err)
+		return
+	}
+
+	if err := send(mailCfg, userRecord.Email, resolveAdminErrorLocale(ctx), siteURL); err != nil {
+		httpapi.LogError(ctx, "admin "+event+" notice email failed", err, slog.String("userId", userRecord.ID))
+	}
+}
@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"errors"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -281,7 +283,199 @@ func (*adminAuthEmailChangeStubUserRepository) UpdateAvatarByID(_ context.Contex
 	return nil
 }
 
-func (*adminAuthEmailChangeStubUserRepository) DisableByID(_ context.Context, _ string) error {
+func (stub *adminAuthEmailChangeStubUserRepository) DisableByID(_ context.Context, id string) error {
+	if user := stub.byID[id]; user != nil {
+		user.Status = domain.AdminUserStatusDisabled
+		user.PendingInvitation = nil
+	}
+	return nil
+}
+
+func (stub *adminAuthEmailChangeStubUserRepository) Create(_ context.Context, record domain.AdminUserRecord) error {
+	if stub.byEmail[record.Email] != nil {
+		return repository.ErrAdminEmailAlreadyExists
+	}
+
+	user := record
+	stub.byID[user.ID] = &user
+	stub.byEmail[user.Email] = &user
+	return nil
+}
+
+func (stub *adminAuthEmailChangeStubUserRepository) List(_ context.Context) ([]domain.AdminUserRecord, error) {
+	records := make([]domain.AdminUserRecord, 0, len(stub.byID))
+	for _, user := range stub.byID {
+		records = append(records, *user)
+	}
+	slices.SortFunc(records, func(left, right domain.AdminUserRecord) int {
+		return strings.Compare(left.Email, right.Email)
+	})
+	return records, nil
+}
+
+func (stub *adminAuthEmailChangeStubUserRepository) FindByIDAnyStatus(
+	_ context.Context,
+	id string,
+) (*domain.AdminUserRecord, error) {
+	return stub.byID[id], nil
+}
+
+func (stub *adminAuthEmailChangeStubUserRepository) FindByPendingInvitationTokenHash(
+	_ context.Context,
+	tokenHash string,
+) (*domain.AdminUserRecord, error) {
+	for _, user := range stub.byID {
+		if user.Status == domain.AdminUserStatusInvited && user.PendingInvitation != nil &&
+			user.PendingInvitation.TokenHash == tokenHash {
+			return user, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (stub *adminAuthEmailChangeStubUserRepository) RefreshInvitationByID(
+	_ context.Context,
+	id string,
+	roles []string,
+	pending domain.AdminPendingInvitation,
+) error {
+	user := stub.byID[id]
+	if user == nil || user.Status != domain.AdminUserStatusInvited {
+		return repository.ErrAdminUserNotFound
+	}
+
+	user.Roles = roles
+	user.PendingInvitation = &pending
+	return nil
+}
+
+func (stub *adminAuthEmailChangeStubUserRepository) AcceptInvitationByID(
+	_ context.Context,
+	id, passwordHash string,
+) error {
+	user := stub.byID[id]
+	if user == nil || user.Status != domain.AdminUserStatusInvited {
+		return repository.ErrAdminUserNotFound
+	}
+
+	user.Status = domain.AdminUserStatusActive
+	user.PendingInvitation = nil
+	if passwordHash != "" {
+		user.PasswordHash = passwordHash
+	}
+	user.PasswordVersion++
+	return nil
+}
+
+func (stub *adminAuthEmailChangeStubUserRepository) EnableByID(_ context.Context, id string) error {
+	user := stub.byID[id]
+	if user == nil || user.Status != domain.AdminUserStatusDisabled {
+		return repository.ErrAdminUserNotFound
+	}
+
+	user.Status = domain.AdminUserStatusActive
+	return nil
+}
+
+func (stub *adminAuthEmailChangeStubUserRepository) SetPendingTwoFactorByID(
+	_ context.Context,
+	id string,
+	pending domain.AdminPendingTwoFactor,
+) error {
+	user := stub.byID[id]
+	if user == nil || user.TwoFactor != nil {
+		return repository.ErrAdminUserNotFound
+	}
+
+	user.PendingTwoFactor = &pending
+	return nil
+}
+
+func (stub *adminAuthEmailChangeStubUserRepository) EnableTwoFactorByID(
+	_ context.Context,
+	id string,
+	twoFactor domain.AdminTwoFactor,
+) error {
+	user := stub.byID[id]
+	if user == nil || user.TwoFactor != nil {
+		return repository.ErrAdminUserNotFound
+	}
+
+	user.TwoFactor = &twoFactor
+	user.PendingTwoFactor = nil
+	user.TwoFactorEnabledAt = &twoFactor.EnabledAt
+	user.RecoveryCodesLeft = len(twoFactor.RecoveryCodeHashes)
+	return nil
+}
+
+func (stub *adminAuthEmailChangeStubUserRepository) DisableTwoFactorByID(_ context.Context, id string) error {
+	user := stub.byID[id]
+	if user == nil {
+		return repository.ErrAdminUserNotFound
+	}
+
+	user.TwoFactor = nil
+	user.PendingTwoFactor = nil
+	user.TwoFactorEnabledAt = nil
+	user.RecoveryCodesLeft = 0
+	return nil
+}
+
+func (stub *adminAuthEmailChangeStubUserRepository) ReplaceTwoFactorRecoveryCodesByID(
+	_ context.Context,
+	id string,
+	recoveryCodeHashes []string,
+) error {
+	user := stub.byID[id]
+	if user == nil || user.TwoFactor == nil {
+		return repository.ErrAdminUserNotFound
+	}
+
+	user.TwoFactor.RecoveryCodeHashes = recoveryCodeHashes
+	user.RecoveryCodesLeft = len(recoveryCodeHashes)
+	return nil
+}
+
+func (stub *adminAuthEmailChangeStubUserRepository) ConsumeTwoFactorStepByID(
+	_ context.Context,
+	id string,
+	step int64,
+) (bool, error) {
+	user := stub.byID[id]
+	if user == nil || user.TwoFactor == nil || user.TwoFactor.LastUsedStep >= step {
+		return false, nil
+	}
+
+	user.TwoFactor.LastUsedStep = step
+	return true, nil
+}
+
+func (stub *adminAuthEmailChangeStubUserRepository) ConsumeTwoFactorRecoveryCodeByID(
+	_ context.Context,
+	id, recoveryCodeHash string,
+) (bool, error) {
+	user := stub.byID[id]
+	if user == nil || user.TwoFactor == nil {
+		return false, nil
+	}
+
+	index := slices.Index(user.TwoFactor.RecoveryCodeHashes, recoveryCodeHash)
+	if index < 0 {
+		return false, nil
+	}
+	user.TwoFactor.RecoveryCodeHashes = slices.Delete(user.TwoFactor.RecoveryCodeHashes, index, index+1)
+	user.RecoveryCodesLeft = len(user.TwoFactor.RecoveryCodeHashes)
+	return true, nil
+}
+
+func (stub *adminAuthEmailChangeStubUserRepository) UpdateRolesByID(_ context.Context, id string, roles []string) error {
+	user := stub.byID[id]
+	if user == nil {
+		return repository.ErrAdminUserNotFound
+	}
+
+	user.Roles = roles
 	return nil
 }
 
@@ -333,6 +527,18 @@ func (*adminAuthEmailChangeStubRefreshRepository) RevokeByJTI(context.Context, s
 	return nil
 }
 
+func (*adminAuthEmailChangeStubRefreshRepository) FindByToken(
+	context.Context,
+	string,
+	string,
+) (*domain.AdminRefreshTokenRecord, error) {
+	return nil, nil
+}
+
+func (*adminAuthEmailChangeStubRefreshRepository) RevokeFamily(context.Context, string, time.Time) (int, error) {
+	return 0, nil
+}
+
 func (stub *adminAuthEmailChangeStubRefreshRepository) RevokeAllByUserID(
 	_ context.Context,
 	userID string,
@@ -414,7 +620,7 @@ func TestRequestAdminEmailChangeStoresPendingRequestAndSendsMail(t *testing.T) {
 		return nil
 	}
 
-	result, err := RequestAdminEmailChange(
+	result, err := testService().RequestAdminEmailChange(
 		context.Background(),
 		&domain.AdminUser{ID: "admin-1"},
 		"new-admin@example.com",
@@ -475,7 +681,7 @@ func TestConfirmAdminEmailChangeUpdatesEmailAndRevokesSessions(t *testing.T) {
 	adminRefreshTokensRepository = refreshRepo
 	nowUTCFn = func() time.Time { return time.Date(2026, time.March, 15, 20, 10, 0, 0, time.UTC) }
 
-	result, err := ConfirmAdminEmailChange(context.Background(), "confirm-token", "tr")
+	result, err := testService().ConfirmAdminEmailChange(context.Background(), "confirm-token", "tr")
 	if err != nil {
 		t.Fatalf("ConfirmAdminEmailChange returned error: %v", err)
 	}
@@ -521,7 +727,7 @@ func TestConfirmAdminEmailChangeReturnsExpiredWhenPendingRequestIsExpired(t *tes
 	adminUsersRepository = repo
 	nowUTCFn = func() time.Time { return time.Date(2026, time.March, 15, 21, 0, 0, 0, time.UTC) }
 
-	result, err := ConfirmAdminEmailChange(context.Background(), "confirm-token", "en")
+	result, err := testService().ConfirmAdminEmailChange(context.Background(), "confirm-token", "en")
 	if err != nil {
 		t.Fatalf("ConfirmAdminEmailChange returned error: %v", err)
 	}
@@ -559,7 +765,7 @@ func TestConfirmAdminEmailChangeReturnsFailedWhenEmailBecomesTaken(t *testing.T)
 	adminUsersRepository = repo
 	nowUTCFn = func() time.Time { return time.Now().UTC() }
 
-	result, err := ConfirmAdminEmailChange(context.Background(), "confirm-token", "en")
+	result, err := testService().ConfirmAdminEmailChange(context.Background(), "confirm-token", "en")
 	if err != nil {
 		t.Fatalf("ConfirmAdminEmailChange returned error: %v", err)
 	}
@@ -604,7 +810,7 @@ func TestRequestAdminEmailChangeFailsWhenConfirmationMailCannotBeSent(t *testing
 		return errors.New("smtp down")
 	}
 
-	_, err = RequestAdminEmailChange(
+	_, err = testService().RequestAdminEmailChange(
 		context.Background(),
 		&domain.AdminUser{ID: "admin-1"},
 		"new-admin@example.com",
@@ -643,13 +849,13 @@ func TestAdminEmailChangeHelpersHandleValidationAndInvalidStates(t *testing.T) {
 		repo.byEmail[taken.Email] = taken
 		adminUsersRepository = repo
 
-		if _, err := resolveAdminEmailChangeTarget(context.Background(), current, "admin@example.com"); err == nil {
+		if _, err := testService().resolveAdminEmailChangeTarget(context.Background(), current, "admin@example.com"); err == nil {
 			t.Fatal("expected same email rejection")
 		}
-		if _, err := resolveAdminEmailChangeTarget(context.Background(), current, "taken@example.com"); err == nil {
+		if _, err := testService().resolveAdminEmailChangeTarget(context.Background(), current, "taken@example.com"); err == nil {
 			t.Fatal("expected taken email rejection")
 		}
-		resolved, err := resolveAdminEmailChangeTarget(context.Background(), current, " New-Admin@Example.com ")
+		resolved, err := testService().resolveAdminEmailChangeTarget(context.Background(), current, " New-Admin@Example.com ")
 		if err != nil || resolved != "new-admin@example.com" {
 			t.Fatalf("unexpected resolved target: %q err=%v", resolved, err)
 		}
@@ -684,13 +890,13 @@ func TestAdminEmailChangeHelpersHandleValidationAndInvalidStates(t *testing.T) {
 	})
 
 	t.Run("request and confirm handle auth and invalid links", func(t *testing.T) {
-		if _, err := RequestAdminEmailChange(context.Background(), nil, "new@example.com", "password", "en"); err == nil {
+		if _, err := testService().RequestAdminEmailChange(context.Background(), nil, "new@example.com", "password", "en"); err == nil {
 			t.Fatal("expected auth error")
 		}
-		if _, err := RequestAdminEmailChange(context.Background(), &domain.AdminUser{ID: "admin-1"}, "new@example.com", "", "en"); err == nil {
+		if _, err := testService().RequestAdminEmailChange(context.Background(), &domain.AdminUser{ID: "admin-1"}, "new@example.com", "", "en"); err == nil {
 			t.Fatal("expected current password required error")
 		}
-		result, err := ConfirmAdminEmailChange(context.Background(), "", "tr")
+		result, err := testService().ConfirmAdminEmailChange(context.Background(), "", "tr")
 		if err != nil {
 			t.Fatalf("ConfirmAdminEmailChange returned error: %v", err)
 		}
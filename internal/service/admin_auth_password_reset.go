@@ -12,12 +12,13 @@ import (
 	"suaybsimsek.com/blog-api/internal/repository"
 	adminmailpkg "suaybsimsek.com/blog-api/pkg/adminmail"
 	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpapi"
 	newsletterpkg "suaybsimsek.com/blog-api/pkg/newsletter"
 
 	"golang.org/x/crypto/bcrypt"
 )
 
-func RequestAdminPasswordReset(ctx context.Context, email, locale string) error {
+func (s *Service) RequestAdminPasswordReset(ctx context.Context, email, locale string) error {
 	resolvedEmail, err := newsletterpkg.NormalizeSubscriberEmail(email)
 	if err != nil {
 		return apperrors.New(
@@ -28,7 +29,22 @@ func RequestAdminPasswordReset(ctx context.Context, email, locale string) error
 		)
 	}
 
-	userRecord, err := adminUsersRepository.FindByEmail(ctx, resolvedEmail)
+	// Requests are counted before the lookup so unknown addresses are throttled the same way as real admins.
+	trace, _ := httpapi.RequestTraceFromContext(ctx)
+	throttleSubjects := adminPasswordResetThrottleSubjects(resolvedEmail, trace.RemoteIP)
+	if err := s.checkAdminThrottle(
+		ctx,
+		throttleSubjects,
+		adminCodePasswordResetThrottled,
+		adminCodePasswordResetThrottled,
+	); err != nil {
+		return err
+	}
+	if _, err := s.recordAdminThrottleFailure(ctx, throttleSubjects, nil); err != nil {
+		return err
+	}
+
+	userRecord, err := s.adminUsers.FindByEmail(ctx, resolvedEmail)
 	if err != nil {
 		return apperrors.Internal(adminLoadAdminUserMessage, err)
 	}
@@ -46,6 +62,17 @@ func RequestAdminPasswordReset(ctx context.Context, email, locale string) error
 		return apperrors.Config("admin password reset mail transport is not configured", err)
 	}
 
+	return s.sendAdminPasswordResetLink(ctx, userRecord, locale, siteURL, mailCfg)
+}
+
+// sendAdminPasswordResetLink stores a new password reset request for the admin and emails the link to it.
+func (s *Service) sendAdminPasswordResetLink(
+	ctx context.Context,
+	userRecord *domain.AdminUserRecord,
+	locale string,
+	siteURL string,
+	mailCfg appconfig.MailConfig,
+) error {
 	token, err := generateConfirmTokenFn()
 	if err != nil {
 		return apperrors.Internal("failed to issue admin password reset token", err)
@@ -66,22 +93,22 @@ func RequestAdminPasswordReset(ctx context.Context, email, locale string) error
 		ExpiresAt:   expiresAt,
 	}
 
-	if err := adminUsersRepository.SetPendingPasswordResetByID(ctx, userRecord.ID, pending); err != nil {
+	if err := s.adminUsers.SetPendingPasswordResetByID(ctx, userRecord.ID, pending); err != nil {
 		if errors.Is(err, repository.ErrAdminUserNotFound) {
 			return nil
 		}
 		return apperrors.Internal("failed to store admin password reset request", err)
 	}
 
-	if err := sendAdminPasswordResetEmailFn(mailCfg, resolvedEmail, resetURL, resolvedLocale, siteURL); err != nil {
-		_ = adminUsersRepository.ClearPendingPasswordResetByID(ctx, userRecord.ID)
+	if err := sendAdminPasswordResetEmailFn(mailCfg, userRecord.Email, resetURL, resolvedLocale, siteURL); err != nil {
+		_ = s.adminUsers.ClearPendingPasswordResetByID(ctx, userRecord.ID)
 		return apperrors.ServiceUnavailable("failed to send admin password reset email", err)
 	}
 
 	return nil
 }
 
-func ValidateAdminPasswordResetToken(
+func (s *Service) ValidateAdminPasswordResetToken(
 	ctx context.Context,
 	token string,
 	localeHint string,
@@ -95,7 +122,7 @@ func ValidateAdminPasswordResetToken(
 		}, nil
 	}
 
-	userRecord, err := adminUsersRepository.FindByPendingPasswordResetTokenHash(ctx, hashValue(resolvedToken))
+	userRecord, err := s.adminUsers.FindByPendingPasswordResetTokenHash(ctx, hashValue(resolvedToken))
 	if err != nil {
 		return nil, apperrors.Internal("failed to load admin password reset request", err)
 	}
@@ -113,7 +140,7 @@ func ValidateAdminPasswordResetToken(
 
 	now := nowUTCFn()
 	if now.After(pending.ExpiresAt) {
-		if clearErr := adminUsersRepository.ClearPendingPasswordResetByID(ctx, userRecord.ID); clearErr != nil &&
+		if clearErr := s.adminUsers.ClearPendingPasswordResetByID(ctx, userRecord.ID); clearErr != nil &&
 			!errors.Is(clearErr, repository.ErrAdminUserNotFound) {
 			return nil, apperrors.Internal("failed to clear expired admin password reset request", clearErr)
 		}
@@ -129,7 +156,7 @@ func ValidateAdminPasswordResetToken(
 	}, nil
 }
 
-func ResetAdminPasswordWithToken(
+func (s *Service) ResetAdminPasswordWithToken(
 	ctx context.Context,
 	token string,
 	newPassword string,
@@ -146,7 +173,7 @@ func ResetAdminPasswordWithToken(
 		)
 	}
 
-	validation, err := ValidateAdminPasswordResetToken(ctx, resolvedToken, localeHint)
+	validation, err := s.ValidateAdminPasswordResetToken(ctx, resolvedToken, localeHint)
 	if err != nil {
 		return nil, err
 	}
@@ -166,7 +193,7 @@ func ResetAdminPasswordWithToken(
 		return nil, err
 	}
 
-	userRecord, err := loadAdminPasswordResetUserRecord(ctx, resolvedToken)
+	userRecord, err := s.loadAdminPasswordResetUserRecord(ctx, resolvedToken)
 	if err != nil {
 		return nil, apperrors.Internal("failed to load admin password reset request", err)
 	}
@@ -179,16 +206,19 @@ func ResetAdminPasswordWithToken(
 		return nil, apperrors.Internal("failed to hash admin password", err)
 	}
 
-	if err := adminUsersRepository.UpdatePasswordHashByID(ctx, userRecord.ID, string(passwordHashBytes)); err != nil {
+	if err := s.adminUsers.UpdatePasswordHashByID(ctx, userRecord.ID, string(passwordHashBytes)); err != nil {
 		if errors.Is(err, repository.ErrAdminUserNotFound) {
 			return nil, newAdminPasswordResetTokenInvalidError()
 		}
 		return nil, apperrors.Internal("failed to update admin password", err)
 	}
 
-	if err := adminRefreshTokensRepository.RevokeAllByUserID(ctx, userRecord.ID, nowUTCFn()); err != nil {
+	if err := s.adminRefreshTokens.RevokeAllByUserID(ctx, userRecord.ID, nowUTCFn()); err != nil {
 		return nil, toAdminSessionError(err)
 	}
+	if err := s.revokeAllAdminAccessTokens(ctx, userRecord.ID); err != nil {
+		return nil, err
+	}
 
 	return &AdminPasswordResetResult{
 		Success: true,
@@ -237,8 +267,8 @@ func validateAdminPasswordResetPassword(
 	return nil
 }
 
-func loadAdminPasswordResetUserRecord(ctx context.Context, token string) (*domain.AdminUserRecord, error) {
-	userRecord, err := adminUsersRepository.FindByPendingPasswordResetTokenHash(ctx, hashValue(token))
+func (s *Service) loadAdminPasswordResetUserRecord(ctx context.Context, token string) (*domain.AdminUserRecord, error) {
+	userRecord, err := s.adminUsers.FindByPendingPasswordResetTokenHash(ctx, hashValue(token))
 	if err != nil {
 		return nil, err
 	}
@@ -14,6 +14,7 @@ import (
 )
 
 func TestRequestAdminPasswordResetStoresPendingRequestAndSendsMail(t *testing.T) {
+	stubAdminLoginAttempts(t)
 	previousUsersRepo := adminUsersRepository
 	previousResolveSiteURLFn := resolveSiteURLFn
 	previousResolveMailConfigFn := resolveMailConfigFn
@@ -71,7 +72,7 @@ func TestRequestAdminPasswordResetStoresPendingRequestAndSendsMail(t *testing.T)
 		return nil
 	}
 
-	err = RequestAdminPasswordReset(context.Background(), "admin@example.com", "tr")
+	err = testService().RequestAdminPasswordReset(context.Background(), "admin@example.com", "tr")
 	if err != nil {
 		t.Fatalf("RequestAdminPasswordReset returned error: %v", err)
 	}
@@ -91,13 +92,14 @@ func TestRequestAdminPasswordResetStoresPendingRequestAndSendsMail(t *testing.T)
 }
 
 func TestRequestAdminPasswordResetReturnsGenericSuccessWhenAdminDoesNotExist(t *testing.T) {
+	stubAdminLoginAttempts(t)
 	previousUsersRepo := adminUsersRepository
 	t.Cleanup(func() {
 		adminUsersRepository = previousUsersRepo
 	})
 
 	adminUsersRepository = newAdminAuthEmailChangeStubUserRepository(nil)
-	if err := RequestAdminPasswordReset(context.Background(), "missing@example.com", "en"); err != nil {
+	if err := testService().RequestAdminPasswordReset(context.Background(), "missing@example.com", "en"); err != nil {
 		t.Fatalf("RequestAdminPasswordReset returned error: %v", err)
 	}
 }
@@ -128,7 +130,7 @@ func TestValidateAdminPasswordResetTokenReturnsExpiredAndClearsRequest(t *testin
 	adminUsersRepository = repo
 	nowUTCFn = func() time.Time { return time.Date(2026, time.March, 20, 10, 0, 0, 0, time.UTC) }
 
-	result, err := ValidateAdminPasswordResetToken(context.Background(), "reset-token", "en")
+	result, err := testService().ValidateAdminPasswordResetToken(context.Background(), "reset-token", "en")
 	if err != nil {
 		t.Fatalf("ValidateAdminPasswordResetToken returned error: %v", err)
 	}
@@ -170,9 +172,10 @@ func TestResetAdminPasswordWithTokenUpdatesPasswordAndRevokesSessions(t *testing
 	refreshRepo := &adminAuthEmailChangeStubRefreshRepository{}
 	adminUsersRepository = repo
 	adminRefreshTokensRepository = refreshRepo
+	tokens := stubAdminAccessTokensOwnedBy(t, "admin-1", "admin-2")
 	nowUTCFn = func() time.Time { return time.Date(2026, time.March, 20, 10, 0, 0, 0, time.UTC) }
 
-	result, err := ResetAdminPasswordWithToken(context.Background(), "reset-token", "new-password", "new-password", "en")
+	result, err := testService().ResetAdminPasswordWithToken(context.Background(), "reset-token", "new-password", "new-password", "en")
 	if err != nil {
 		t.Fatalf("ResetAdminPasswordWithToken returned error: %v", err)
 	}
@@ -185,6 +188,9 @@ func TestResetAdminPasswordWithTokenUpdatesPasswordAndRevokesSessions(t *testing
 	if len(refreshRepo.revokedUserIDs) != 1 || refreshRepo.revokedUserIDs[0] != "admin-1" {
 		t.Fatalf("expected sessions to be revoked for admin-1, got %+v", refreshRepo.revokedUserIDs)
 	}
+	if len(tokens.records) != 1 || tokens.records[0].UserID != "admin-2" {
+		t.Fatalf("expected only admin-1 access tokens to be revoked, got %+v", tokens.records)
+	}
 	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte("new-password")); err != nil {
 		t.Fatalf("expected password hash to be updated, compare returned error: %v", err)
 	}
@@ -198,7 +204,7 @@ func TestResetAdminPasswordWithTokenReturnsInvalidTokenCode(t *testing.T) {
 
 	adminUsersRepository = newAdminAuthEmailChangeStubUserRepository(nil)
 
-	_, err := ResetAdminPasswordWithToken(context.Background(), "missing-token", "new-password", "new-password", "en")
+	_, err := testService().ResetAdminPasswordWithToken(context.Background(), "missing-token", "new-password", "new-password", "en")
 	if err == nil {
 		t.Fatal("expected invalid token error")
 	}
@@ -210,7 +216,7 @@ func TestResetAdminPasswordWithTokenReturnsInvalidTokenCode(t *testing.T) {
 }
 
 func TestRequestAdminPasswordResetReturnsCustomEmailCode(t *testing.T) {
-	err := RequestAdminPasswordReset(context.Background(), "invalid-email", "en")
+	err := testService().RequestAdminPasswordReset(context.Background(), "invalid-email", "en")
 	if err == nil {
 		t.Fatal("expected email validation error")
 	}
@@ -222,6 +228,7 @@ func TestRequestAdminPasswordResetReturnsCustomEmailCode(t *testing.T) {
 }
 
 func TestRequestAdminPasswordResetClearsPendingRequestWhenMailFails(t *testing.T) {
+	stubAdminLoginAttempts(t)
 	previousUsersRepo := adminUsersRepository
 	previousResolveSiteURLFn := resolveSiteURLFn
 	previousResolveMailConfigFn := resolveMailConfigFn
@@ -251,7 +258,7 @@ func TestRequestAdminPasswordResetClearsPendingRequestWhenMailFails(t *testing.T
 		return errors.New("boom")
 	}
 
-	err := RequestAdminPasswordReset(context.Background(), "admin@example.com", "en")
+	err := testService().RequestAdminPasswordReset(context.Background(), "admin@example.com", "en")
 	if err == nil {
 		t.Fatal("expected mail error")
 	}
@@ -269,7 +276,7 @@ func TestAdminPasswordResetHelpersHandleValidationAndInvalidStates(t *testing.T)
 			nowUTCFn = previousNowUTCFn
 		})
 
-		result, err := ValidateAdminPasswordResetToken(context.Background(), "", "tr")
+		result, err := testService().ValidateAdminPasswordResetToken(context.Background(), "", "tr")
 		if err != nil {
 			t.Fatalf("ValidateAdminPasswordResetToken empty token error: %v", err)
 		}
@@ -291,7 +298,7 @@ func TestAdminPasswordResetHelpersHandleValidationAndInvalidStates(t *testing.T)
 		adminUsersRepository = repo
 		nowUTCFn = func() time.Time { return time.Date(2026, time.March, 20, 10, 0, 0, 0, time.UTC) }
 
-		result, err = ValidateAdminPasswordResetToken(context.Background(), "reset-token", "tr")
+		result, err = testService().ValidateAdminPasswordResetToken(context.Background(), "reset-token", "tr")
 		if err != nil {
 			t.Fatalf("ValidateAdminPasswordResetToken success error: %v", err)
 		}
@@ -316,7 +323,7 @@ func TestAdminPasswordResetHelpersHandleValidationAndInvalidStates(t *testing.T)
 	})
 
 	t.Run("reset password validates required and expired tokens", func(t *testing.T) {
-		if _, err := ResetAdminPasswordWithToken(context.Background(), "", "new-password", "new-password", "en"); err == nil {
+		if _, err := testService().ResetAdminPasswordWithToken(context.Background(), "", "new-password", "new-password", "en"); err == nil {
 			t.Fatal("expected token required error")
 		}
 
@@ -341,7 +348,7 @@ func TestAdminPasswordResetHelpersHandleValidationAndInvalidStates(t *testing.T)
 		adminUsersRepository = repo
 		nowUTCFn = func() time.Time { return time.Date(2026, time.March, 20, 10, 0, 0, 0, time.UTC) }
 
-		if _, err := ResetAdminPasswordWithToken(context.Background(), "expired-token", "new-password", "new-password", "en"); err == nil {
+		if _, err := testService().ResetAdminPasswordWithToken(context.Background(), "expired-token", "new-password", "new-password", "en"); err == nil {
 			t.Fatal("expected expired token error")
 		}
 	})
@@ -27,12 +27,12 @@ import (
 	_ "golang.org/x/image/webp" // Register WebP decoder.
 )
 
-func ChangeAdminUsername(ctx context.Context, adminUser *domain.AdminUser, newUsername string) (*domain.AdminUser, error) {
+func (s *Service) ChangeAdminUsername(ctx context.Context, adminUser *domain.AdminUser, newUsername string) (*domain.AdminUser, error) {
 	if err := requireAdminAuthentication(adminUser); err != nil {
 		return nil, err
 	}
 
-	userRecord, err := loadAdminUserRecord(ctx, adminUser.ID)
+	userRecord, err := s.loadAdminUserRecord(ctx, adminUser.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -48,7 +48,7 @@ func ChangeAdminUsername(ctx context.Context, adminUser *domain.AdminUser, newUs
 		return nil, apperrors.BadRequest("new username must be different from current username")
 	}
 
-	if err := adminUsersRepository.UpdateUsernameByID(ctx, userRecord.ID, resolvedUsername); err != nil {
+	if err := s.adminUsers.UpdateUsernameByID(ctx, userRecord.ID, resolvedUsername); err != nil {
 		if errors.Is(err, repository.ErrAdminUsernameAlreadyExists) {
 			return nil, apperrors.BadRequest("username is already in use")
 		}
@@ -58,15 +58,15 @@ func ChangeAdminUsername(ctx context.Context, adminUser *domain.AdminUser, newUs
 		return nil, apperrors.Internal("failed to update admin username", err)
 	}
 
-	return reloadAdminUser(ctx, userRecord.ID)
+	return s.reloadAdminUser(ctx, userRecord.ID)
 }
 
-func ChangeAdminName(ctx context.Context, adminUser *domain.AdminUser, name string) (*domain.AdminUser, error) {
+func (s *Service) ChangeAdminName(ctx context.Context, adminUser *domain.AdminUser, name string) (*domain.AdminUser, error) {
 	if err := requireAdminAuthentication(adminUser); err != nil {
 		return nil, err
 	}
 
-	userRecord, err := loadAdminUserRecord(ctx, adminUser.ID)
+	userRecord, err := s.loadAdminUserRecord(ctx, adminUser.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -76,22 +76,22 @@ func ChangeAdminName(ctx context.Context, adminUser *domain.AdminUser, name stri
 		return nil, apperrors.BadRequest("name must be between 2 and 80 characters")
 	}
 
-	if err := adminUsersRepository.UpdateNameByID(ctx, userRecord.ID, resolvedName); err != nil {
+	if err := s.adminUsers.UpdateNameByID(ctx, userRecord.ID, resolvedName); err != nil {
 		if errors.Is(err, repository.ErrAdminUserNotFound) {
 			return nil, apperrors.Unauthorized(adminAuthRequiredMessage)
 		}
 		return nil, apperrors.Internal("failed to update admin name", err)
 	}
 
-	return reloadAdminUser(ctx, userRecord.ID)
+	return s.reloadAdminUser(ctx, userRecord.ID)
 }
 
-func ChangeAdminAvatar(ctx context.Context, adminUser *domain.AdminUser, avatarURL *string) (*domain.AdminUser, error) { // NOSONAR
+func (s *Service) ChangeAdminAvatar(ctx context.Context, adminUser *domain.AdminUser, avatarURL *string) (*domain.AdminUser, error) { // NOSONAR
 	if err := requireAdminAuthentication(adminUser); err != nil {
 		return nil, err
 	}
 
-	userRecord, err := loadAdminUserRecord(ctx, adminUser.ID)
+	userRecord, err := s.loadAdminUserRecord(ctx, adminUser.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -102,18 +102,18 @@ func ChangeAdminAvatar(ctx context.Context, adminUser *domain.AdminUser, avatarU
 	}
 
 	if resolvedAvatarURL == "" {
-		if err := adminAvatarRepository.DeleteByUserID(ctx, userRecord.ID); err != nil {
+		if err := s.adminAvatars.DeleteByUserID(ctx, userRecord.ID); err != nil {
 			return nil, apperrors.Internal("failed to delete admin avatar", err)
 		}
 
-		if err := adminUsersRepository.UpdateAvatarByID(ctx, userRecord.ID, "", "", 0); err != nil {
+		if err := s.adminUsers.UpdateAvatarByID(ctx, userRecord.ID, "", "", 0); err != nil {
 			if errors.Is(err, repository.ErrAdminUserNotFound) {
 				return nil, apperrors.Unauthorized(adminAuthRequiredMessage)
 			}
 			return nil, apperrors.Internal("failed to update admin avatar", err)
 		}
 
-		return reloadAdminUser(ctx, userRecord.ID)
+		return s.reloadAdminUser(ctx, userRecord.ID)
 	}
 
 	decodedAvatar, err := decodeAdminAvatarDataURL(resolvedAvatarURL)
@@ -131,7 +131,7 @@ func ChangeAdminAvatar(ctx context.Context, adminUser *domain.AdminUser, avatarU
 		nextAvatarVersion = 1
 	}
 
-	if err := adminAvatarRepository.UpsertByUserID(ctx, domain.AdminAvatarRecord{
+	if err := s.adminAvatars.UpsertByUserID(ctx, domain.AdminAvatarRecord{
 		UserID:    userRecord.ID,
 		Digest:    avatarDigest,
 		Version:   nextAvatarVersion,
@@ -145,7 +145,7 @@ func ChangeAdminAvatar(ctx context.Context, adminUser *domain.AdminUser, avatarU
 		return nil, apperrors.Internal("failed to persist admin avatar", err)
 	}
 
-	if err := adminUsersRepository.UpdateAvatarByID(
+	if err := s.adminUsers.UpdateAvatarByID(
 		ctx,
 		userRecord.ID,
 		buildAdminAvatarURL(userRecord.ID, avatarDigest, nextAvatarVersion, adminAvatarDefaultSize),
@@ -158,18 +158,18 @@ func ChangeAdminAvatar(ctx context.Context, adminUser *domain.AdminUser, avatarU
 		return nil, apperrors.Internal("failed to update admin avatar", err)
 	}
 
-	return reloadAdminUser(ctx, userRecord.ID)
+	return s.reloadAdminUser(ctx, userRecord.ID)
 }
 
-func DeleteAdminAccount(ctx context.Context, adminUser *domain.AdminUser, currentPassword string) error {
-	if err := requireAdminAuthentication(adminUser); err != nil {
+func (s *Service) DeleteAdminAccount(ctx context.Context, adminUser *domain.AdminUser, currentPassword string) error {
+	if err := requireAdminSessionAuthentication(adminUser); err != nil {
 		return err
 	}
 	if strings.TrimSpace(currentPassword) == "" {
 		return apperrors.BadRequest("current password is required")
 	}
 
-	userRecord, err := loadAdminUserRecord(ctx, adminUser.ID)
+	userRecord, err := s.loadAdminUserRecord(ctx, adminUser.ID)
 	if err != nil {
 		return err
 	}
@@ -178,18 +178,18 @@ func DeleteAdminAccount(ctx context.Context, adminUser *domain.AdminUser, curren
 		return apperrors.BadRequest("current password is incorrect")
 	}
 
-	if err := adminUsersRepository.DisableByID(ctx, userRecord.ID); err != nil {
+	if err := s.adminUsers.DisableByID(ctx, userRecord.ID); err != nil {
 		if errors.Is(err, repository.ErrAdminUserNotFound) {
 			return apperrors.Unauthorized(adminAuthRequiredMessage)
 		}
 		return apperrors.Internal("failed to disable admin account", err)
 	}
 
-	if err := adminRefreshTokensRepository.RevokeAllByUserID(ctx, userRecord.ID, time.Now().UTC()); err != nil {
+	if err := s.adminRefreshTokens.RevokeAllByUserID(ctx, userRecord.ID, time.Now().UTC()); err != nil {
 		return toAdminSessionError(err)
 	}
 
-	return nil
+	return s.revokeAllAdminAccessTokens(ctx, userRecord.ID)
 }
 
 type decodedAdminAvatarPayload struct {
@@ -384,7 +384,7 @@ type AdminAvatarAsset struct {
 	ETag        string
 }
 
-func ResolveAdminAvatarAsset( // NOSONAR
+func (s *Service) ResolveAdminAvatarAsset( // NOSONAR
 	ctx context.Context,
 	userID string,
 	size int,
@@ -396,7 +396,7 @@ func ResolveAdminAvatarAsset( // NOSONAR
 		return nil, apperrors.BadRequest("avatar user is required")
 	}
 
-	userRecord, err := adminUsersRepository.FindByID(ctx, resolvedUserID)
+	userRecord, err := s.adminUsers.FindByID(ctx, resolvedUserID)
 	if err != nil {
 		return nil, apperrors.Internal(adminLoadAdminUserMessage, err)
 	}
@@ -420,7 +420,7 @@ func ResolveAdminAvatarAsset( // NOSONAR
 		return nil, apperrors.New("NOT_FOUND", adminAvatarNotFoundMessage, 404, nil)
 	}
 
-	avatarRecord, err := adminAvatarRepository.FindByUserID(ctx, resolvedUserID)
+	avatarRecord, err := s.adminAvatars.FindByUserID(ctx, resolvedUserID)
 	if err != nil {
 		return nil, apperrors.Internal("failed to load avatar asset", err)
 	}
@@ -456,7 +456,7 @@ func ResolveAdminAvatarAsset( // NOSONAR
 
 		avatarRecord.Variants = upsertAdminAvatarVariant(avatarRecord.Variants, generatedVariant)
 		avatarRecord.UpdatedAt = time.Now().UTC()
-		if err := adminAvatarRepository.UpsertByUserID(ctx, *avatarRecord); err != nil {
+		if err := s.adminAvatars.UpsertByUserID(ctx, *avatarRecord); err != nil {
 			return nil, apperrors.Internal("failed to cache avatar size", err)
 		}
 
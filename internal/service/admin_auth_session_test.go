@@ -195,6 +195,8 @@ type adminAuthSessionStubRefreshRepository struct {
 	revokeByJTIAndUserID func(context.Context, string, string, time.Time) (bool, error)
 	revokeByJTI          func(context.Context, string, time.Time) error
 	revokeAllByUserID    func(context.Context, string, time.Time) error
+	findByToken          func(context.Context, string, string) (*domain.AdminRefreshTokenRecord, error)
+	revokeFamily         func(context.Context, string, time.Time) (int, error)
 }
 
 func (stub adminAuthSessionStubRefreshRepository) Create(ctx context.Context, record domain.AdminRefreshTokenRecord) error {
@@ -279,7 +281,31 @@ func (stub adminAuthSessionStubRefreshRepository) RevokeAllByUserID(
 	return stub.revokeAllByUserID(ctx, userID, now)
 }
 
+func (stub adminAuthSessionStubRefreshRepository) FindByToken(
+	ctx context.Context,
+	jti, rawToken string,
+) (*domain.AdminRefreshTokenRecord, error) {
+	if stub.findByToken == nil {
+		return nil, nil
+	}
+
+	return stub.findByToken(ctx, jti, rawToken)
+}
+
+func (stub adminAuthSessionStubRefreshRepository) RevokeFamily(
+	ctx context.Context,
+	jti string,
+	now time.Time,
+) (int, error) {
+	if stub.revokeFamily == nil {
+		return 0, nil
+	}
+
+	return stub.revokeFamily(ctx, jti, now)
+}
+
 func TestLoginAdminIssuesTokens(t *testing.T) {
+	stubAdminLoginAttempts(t)
 	t.Setenv("JWT_SECRET", "admin-secret")
 
 	previousUsersRepo := adminUsersRepository
@@ -315,7 +341,7 @@ func TestLoginAdminIssuesTokens(t *testing.T) {
 		},
 	}
 
-	response, err := LoginAdmin(context.Background(), " admin@example.com ", "admin-password", true, AdminSessionMetadata{
+	response, err := testService().LoginAdmin(context.Background(), " admin@example.com ", "admin-password", true, AdminSessionMetadata{
 		UserAgent:   "browser",
 		RemoteIP:    "127.0.0.1",
 		CountryCode: "tr",
@@ -385,7 +411,7 @@ func TestRefreshAdminSessionRotatesTokens(t *testing.T) {
 		},
 	}
 
-	response, err := RefreshAdminSession(context.Background(), refreshToken, AdminSessionMetadata{})
+	response, err := testService().RefreshAdminSession(context.Background(), refreshToken, AdminSessionMetadata{})
 	if err != nil {
 		t.Fatalf("RefreshAdminSession returned error: %v", err)
 	}
@@ -424,7 +450,7 @@ func TestLogoutAdminRevokesRefreshToken(t *testing.T) {
 		},
 	}
 
-	if err := LogoutAdmin(context.Background(), refreshToken); err != nil {
+	if err := testService().LogoutAdmin(context.Background(), refreshToken); err != nil {
 		t.Fatalf("LogoutAdmin returned error: %v", err)
 	}
 	if revokedJTI != "refresh-jti" {
@@ -466,8 +492,9 @@ func TestChangeAdminPasswordUpdatesHashAndRevokesSessions(t *testing.T) {
 			return nil
 		},
 	}
+	tokens := stubAdminAccessTokensOwnedBy(t, "admin-1")
 
-	err = ChangeAdminPassword(
+	err = testService().ChangeAdminPassword(
 		context.Background(),
 		&domain.AdminUser{ID: "admin-1"},
 		"current-password",
@@ -480,6 +507,9 @@ func TestChangeAdminPasswordUpdatesHashAndRevokesSessions(t *testing.T) {
 	if revokedUserID != "admin-1" {
 		t.Fatalf("expected revoked user admin-1, got %q", revokedUserID)
 	}
+	if len(tokens.records) != 0 {
+		t.Fatalf("expected access tokens to be revoked, got %+v", tokens.records)
+	}
 	if compareErr := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte("next-password")); compareErr != nil {
 		t.Fatalf("expected password hash update, got %v", compareErr)
 	}
@@ -507,7 +537,7 @@ func TestChangeAdminUsernameUpdatesAndReloadsUser(t *testing.T) {
 		},
 	}
 
-	updated, err := ChangeAdminUsername(context.Background(), &domain.AdminUser{ID: "admin-1"}, "new-name")
+	updated, err := testService().ChangeAdminUsername(context.Background(), &domain.AdminUser{ID: "admin-1"}, "new-name")
 	if err != nil {
 		t.Fatalf("ChangeAdminUsername returned error: %v", err)
 	}
@@ -541,7 +571,7 @@ func TestChangeAdminNameUpdatesAndReloadsUser(t *testing.T) {
 		},
 	}
 
-	updated, err := ChangeAdminName(context.Background(), &domain.AdminUser{ID: "admin-1"}, "  New Name  ")
+	updated, err := testService().ChangeAdminName(context.Background(), &domain.AdminUser{ID: "admin-1"}, "  New Name  ")
 	if err != nil {
 		t.Fatalf("ChangeAdminName returned error: %v", err)
 	}
@@ -590,21 +620,22 @@ func TestDeleteAdminAccountDisablesUserAndRevokesSessions(t *testing.T) {
 			return nil
 		},
 	}
+	tokens := stubAdminAccessTokensOwnedBy(t, "admin-1")
 
-	if err := DeleteAdminAccount(context.Background(), &domain.AdminUser{ID: "admin-1"}, "current-password"); err != nil {
+	if err := testService().DeleteAdminAccount(context.Background(), &domain.AdminUser{ID: "admin-1"}, "current-password"); err != nil {
 		t.Fatalf("DeleteAdminAccount returned error: %v", err)
 	}
-	if !disabled || !revoked {
-		t.Fatalf("expected disable and revoke, got disabled=%v revoked=%v", disabled, revoked)
+	if !disabled || !revoked || len(tokens.records) != 0 {
+		t.Fatalf("expected disable and revoke, got disabled=%v revoked=%v tokens=%+v", disabled, revoked, tokens.records)
 	}
 }
 
 func TestAdminSessionAndProfileOperationsRejectInvalidState(t *testing.T) {
 	t.Run("profile auth required", func(t *testing.T) {
-		if _, err := ChangeAdminName(context.Background(), nil, "Name"); err == nil {
+		if _, err := testService().ChangeAdminName(context.Background(), nil, "Name"); err == nil {
 			t.Fatal("expected ChangeAdminName auth error")
 		}
-		if _, err := ChangeAdminUsername(context.Background(), nil, "username"); err == nil {
+		if _, err := testService().ChangeAdminUsername(context.Background(), nil, "username"); err == nil {
 			t.Fatal("expected ChangeAdminUsername auth error")
 		}
 	})
@@ -629,7 +660,7 @@ func TestAdminSessionAndProfileOperationsRejectInvalidState(t *testing.T) {
 			},
 		}
 
-		if _, err := ChangeAdminUsername(context.Background(), &domain.AdminUser{ID: "admin-1"}, "taken-name"); err == nil || err.Error() != "username is already in use" {
+		if _, err := testService().ChangeAdminUsername(context.Background(), &domain.AdminUser{ID: "admin-1"}, "taken-name"); err == nil || err.Error() != "username is already in use" {
 			t.Fatalf("expected duplicate username error, got %v", err)
 		}
 	})
@@ -652,25 +683,25 @@ func TestAdminSessionAndProfileOperationsRejectInvalidState(t *testing.T) {
 			}),
 		}
 
-		if err := DeleteAdminAccount(context.Background(), nil, "current-password"); err == nil {
+		if err := testService().DeleteAdminAccount(context.Background(), nil, "current-password"); err == nil {
 			t.Fatal("expected DeleteAdminAccount auth error")
 		}
-		if err := DeleteAdminAccount(context.Background(), &domain.AdminUser{ID: "admin-1"}, ""); err == nil {
+		if err := testService().DeleteAdminAccount(context.Background(), &domain.AdminUser{ID: "admin-1"}, ""); err == nil {
 			t.Fatal("expected DeleteAdminAccount password required error")
 		}
-		if err := DeleteAdminAccount(context.Background(), &domain.AdminUser{ID: "admin-1"}, "wrong-password"); err == nil || err.Error() != "current password is incorrect" {
+		if err := testService().DeleteAdminAccount(context.Background(), &domain.AdminUser{ID: "admin-1"}, "wrong-password"); err == nil || err.Error() != "current password is incorrect" {
 			t.Fatalf("expected incorrect password error, got %v", err)
 		}
 	})
 
 	t.Run("session auth required", func(t *testing.T) {
-		if _, err := ListActiveAdminSessions(context.Background(), nil); err == nil {
+		if _, err := testService().ListActiveAdminSessions(context.Background(), nil); err == nil {
 			t.Fatal("expected ListActiveAdminSessions auth error")
 		}
-		if _, err := RevokeAdminSession(context.Background(), nil, "session-1"); err == nil {
+		if _, err := testService().RevokeAdminSession(context.Background(), nil, "session-1"); err == nil {
 			t.Fatal("expected RevokeAdminSession auth error")
 		}
-		if err := RevokeAllAdminSessions(context.Background(), nil); err == nil {
+		if err := testService().RevokeAllAdminSessions(context.Background(), nil); err == nil {
 			t.Fatal("expected RevokeAllAdminSessions auth error")
 		}
 	})
@@ -714,7 +745,7 @@ func TestResolveAdminFromAccessTokenFallsBackToUsername(t *testing.T) {
 		},
 	}
 
-	resolved, err := ResolveAdminFromAccessToken(context.Background(), token)
+	resolved, err := testService().ResolveAdminFromAccessToken(context.Background(), token)
 	if err != nil {
 		t.Fatalf("ResolveAdminFromAccessToken returned error: %v", err)
 	}
@@ -747,7 +778,7 @@ func TestAdminSessionHelpersReturnRepositoryResults(t *testing.T) {
 		},
 	}
 
-	sessions, err := ListActiveAdminSessions(context.Background(), &domain.AdminUser{ID: "admin-1"})
+	sessions, err := testService().ListActiveAdminSessions(context.Background(), &domain.AdminUser{ID: "admin-1"})
 	if err != nil {
 		t.Fatalf("ListActiveAdminSessions returned error: %v", err)
 	}
@@ -755,7 +786,7 @@ func TestAdminSessionHelpersReturnRepositoryResults(t *testing.T) {
 		t.Fatalf("unexpected sessions: %#v", sessions)
 	}
 
-	revoked, err := RevokeAdminSession(context.Background(), &domain.AdminUser{ID: "admin-1"}, "session-1")
+	revoked, err := testService().RevokeAdminSession(context.Background(), &domain.AdminUser{ID: "admin-1"}, "session-1")
 	if err != nil {
 		t.Fatalf("RevokeAdminSession returned error: %v", err)
 	}
@@ -763,7 +794,7 @@ func TestAdminSessionHelpersReturnRepositoryResults(t *testing.T) {
 		t.Fatal("expected session revocation")
 	}
 
-	if err := RevokeAllAdminSessions(context.Background(), &domain.AdminUser{ID: "admin-1"}); err != nil {
+	if err := testService().RevokeAllAdminSessions(context.Background(), &domain.AdminUser{ID: "admin-1"}); err != nil {
 		t.Fatalf("RevokeAllAdminSessions returned error: %v", err)
 	}
 }
@@ -779,6 +810,7 @@ func TestAdminAuthSessionErrorPaths(t *testing.T) {
 	t.Setenv("JWT_SECRET", "admin-secret")
 
 	t.Run("LoginAdmin rejects invalid credentials", func(t *testing.T) {
+		stubAdminLoginAttempts(t)
 		previousUsersRepo := adminUsersRepository
 		t.Cleanup(func() {
 			adminUsersRepository = previousUsersRepo
@@ -788,7 +820,7 @@ func TestAdminAuthSessionErrorPaths(t *testing.T) {
 			adminAuthEmailChangeStubUserRepository: newAdminAuthEmailChangeStubUserRepository(nil),
 		}
 
-		if _, err := LoginAdmin(context.Background(), "missing@example.com", "password", false, AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), "invalid credentials") {
+		if _, err := testService().LoginAdmin(context.Background(), "missing@example.com", "password", false, AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), "invalid credentials") {
 			t.Fatalf("expected invalid credentials error, got %v", err)
 		}
 	})
@@ -819,13 +851,13 @@ func TestAdminAuthSessionErrorPaths(t *testing.T) {
 			},
 		}
 
-		if _, err := RefreshAdminSession(context.Background(), refreshToken, AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), "invalid admin session") {
+		if _, err := testService().RefreshAdminSession(context.Background(), refreshToken, AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), "invalid admin session") {
 			t.Fatalf("expected invalid session error, got %v", err)
 		}
 	})
 
 	t.Run("LogoutAdmin ignores invalid token", func(t *testing.T) {
-		if err := LogoutAdmin(context.Background(), "not-a-jwt"); err != nil {
+		if err := testService().LogoutAdmin(context.Background(), "not-a-jwt"); err != nil {
 			t.Fatalf("expected invalid token to be ignored, got %v", err)
 		}
 	})
@@ -848,16 +880,16 @@ func TestAdminAuthSessionErrorPaths(t *testing.T) {
 			}),
 		}
 
-		if err := ChangeAdminPassword(context.Background(), nil, "current-password", "next-password", "next-password"); err == nil || !strings.Contains(err.Error(), adminAuthRequiredMessage) {
+		if err := testService().ChangeAdminPassword(context.Background(), nil, "current-password", "next-password", "next-password"); err == nil || !strings.Contains(err.Error(), adminAuthRequiredMessage) {
 			t.Fatalf("expected auth error, got %v", err)
 		}
-		if err := ChangeAdminPassword(context.Background(), &domain.AdminUser{ID: "admin-1"}, "current-password", "short", "short"); err == nil || !strings.Contains(err.Error(), "at least 8 characters") {
+		if err := testService().ChangeAdminPassword(context.Background(), &domain.AdminUser{ID: "admin-1"}, "current-password", "short", "short"); err == nil || !strings.Contains(err.Error(), "at least 8 characters") {
 			t.Fatalf("expected length error, got %v", err)
 		}
-		if err := ChangeAdminPassword(context.Background(), &domain.AdminUser{ID: "admin-1"}, "current-password", "next-password", "different"); err == nil || !strings.Contains(err.Error(), "does not match") {
+		if err := testService().ChangeAdminPassword(context.Background(), &domain.AdminUser{ID: "admin-1"}, "current-password", "next-password", "different"); err == nil || !strings.Contains(err.Error(), "does not match") {
 			t.Fatalf("expected confirmation error, got %v", err)
 		}
-		if err := ChangeAdminPassword(context.Background(), &domain.AdminUser{ID: "admin-1"}, "current-password", "current-password", "current-password"); err == nil || !strings.Contains(err.Error(), "must be different") {
+		if err := testService().ChangeAdminPassword(context.Background(), &domain.AdminUser{ID: "admin-1"}, "current-password", "current-password", "current-password"); err == nil || !strings.Contains(err.Error(), "must be different") {
 			t.Fatalf("expected same password error, got %v", err)
 		}
 	})
@@ -881,16 +913,16 @@ func TestAdminAuthProfileAndAccessTokenFallbacks(t *testing.T) {
 			adminAuthEmailChangeStubUserRepository: newAdminAuthEmailChangeStubUserRepository(user),
 		}
 
-		if _, err := ChangeAdminUsername(context.Background(), &domain.AdminUser{ID: "admin-1"}, "ab"); err == nil {
+		if _, err := testService().ChangeAdminUsername(context.Background(), &domain.AdminUser{ID: "admin-1"}, "ab"); err == nil {
 			t.Fatal("expected username length error")
 		}
-		if _, err := ChangeAdminUsername(context.Background(), &domain.AdminUser{ID: "admin-1"}, "bad name"); err == nil {
+		if _, err := testService().ChangeAdminUsername(context.Background(), &domain.AdminUser{ID: "admin-1"}, "bad name"); err == nil {
 			t.Fatal("expected username pattern error")
 		}
-		if _, err := ChangeAdminUsername(context.Background(), &domain.AdminUser{ID: "admin-1"}, "old-name"); err == nil {
+		if _, err := testService().ChangeAdminUsername(context.Background(), &domain.AdminUser{ID: "admin-1"}, "old-name"); err == nil {
 			t.Fatal("expected same username rejection")
 		}
-		if _, err := ChangeAdminName(context.Background(), &domain.AdminUser{ID: "admin-1"}, "a"); err == nil {
+		if _, err := testService().ChangeAdminName(context.Background(), &domain.AdminUser{ID: "admin-1"}, "a"); err == nil {
 			t.Fatal("expected short name error")
 		}
 	})
@@ -901,12 +933,12 @@ func TestAdminAuthProfileAndAccessTokenFallbacks(t *testing.T) {
 			adminUsersRepository = previousUsersRepo
 		})
 
-		if user, err := ResolveAdminFromAccessToken(context.Background(), "anything"); err != nil || user != nil {
+		if user, err := testService().ResolveAdminFromAccessToken(context.Background(), "anything"); err != nil || user != nil {
 			t.Fatalf("expected nil without jwt config, got user=%#v err=%v", user, err)
 		}
 
 		t.Setenv("JWT_SECRET", "admin-secret")
-		if user, err := ResolveAdminFromAccessToken(context.Background(), "not-a-jwt"); err != nil || user != nil {
+		if user, err := testService().ResolveAdminFromAccessToken(context.Background(), "not-a-jwt"); err != nil || user != nil {
 			t.Fatalf("expected nil for invalid token, got user=%#v err=%v", user, err)
 		}
 
@@ -937,7 +969,7 @@ func TestAdminAuthProfileAndAccessTokenFallbacks(t *testing.T) {
 			},
 		}
 
-		user, err := ResolveAdminFromAccessToken(context.Background(), token)
+		user, err := testService().ResolveAdminFromAccessToken(context.Background(), token)
 		if err != nil {
 			t.Fatalf("ResolveAdminFromAccessToken fallback returned error: %v", err)
 		}
@@ -958,7 +990,7 @@ func TestAdminAuthProfileAndAccessTokenFallbacks(t *testing.T) {
 		if err != nil {
 			t.Fatalf("IssueHS256JWT returned error: %v", err)
 		}
-		if _, err := RefreshAdminSession(context.Background(), token, AdminSessionMetadata{}); err == nil {
+		if _, err := testService().RefreshAdminSession(context.Background(), token, AdminSessionMetadata{}); err == nil {
 			t.Fatal("expected invalid admin session error")
 		}
 	})
@@ -966,12 +998,13 @@ func TestAdminAuthProfileAndAccessTokenFallbacks(t *testing.T) {
 
 func TestAdminAuthHelperAndSessionErrorPaths(t *testing.T) {
 	t.Run("login maps config and repository errors", func(t *testing.T) {
+		stubAdminLoginAttempts(t)
 		previousUsersRepo := adminUsersRepository
 		t.Cleanup(func() {
 			adminUsersRepository = previousUsersRepo
 		})
 
-		if _, err := LoginAdmin(context.Background(), "admin@example.com", "password", false, AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), "admin jwt is not configured") {
+		if _, err := testService().LoginAdmin(context.Background(), "admin@example.com", "password", false, AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), "admin jwt is not configured") {
 			t.Fatalf("expected jwt config error, got %v", err)
 		}
 
@@ -982,7 +1015,7 @@ func TestAdminAuthHelperAndSessionErrorPaths(t *testing.T) {
 				return nil, errors.New("db down")
 			},
 		}
-		if _, err := LoginAdmin(context.Background(), "admin@example.com", "password", false, AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), adminLoadAdminUserMessage) {
+		if _, err := testService().LoginAdmin(context.Background(), "admin@example.com", "password", false, AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), adminLoadAdminUserMessage) {
 			t.Fatalf("expected load admin user error, got %v", err)
 		}
 	})
@@ -1060,22 +1093,22 @@ func TestAdminAuthHelperAndSessionErrorPaths(t *testing.T) {
 			},
 		}
 
-		if _, err := RefreshAdminSession(context.Background(), makeToken("repo-error", 3), AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), "admin session storage is unavailable") {
+		if _, err := testService().RefreshAdminSession(context.Background(), makeToken("repo-error", 3), AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), "admin session storage is unavailable") {
 			t.Fatalf("expected session storage error, got %v", err)
 		}
-		if _, err := RefreshAdminSession(context.Background(), makeToken("empty-user", 3), AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), "invalid admin session") {
+		if _, err := testService().RefreshAdminSession(context.Background(), makeToken("empty-user", 3), AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), "invalid admin session") {
 			t.Fatalf("expected invalid session for empty user id, got %v", err)
 		}
-		if _, err := RefreshAdminSession(context.Background(), makeToken("user-error", 3), AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), adminLoadAdminUserMessage) {
+		if _, err := testService().RefreshAdminSession(context.Background(), makeToken("user-error", 3), AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), adminLoadAdminUserMessage) {
 			t.Fatalf("expected load admin user error, got %v", err)
 		}
-		if _, err := RefreshAdminSession(context.Background(), makeToken("user-missing", 3), AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), "invalid admin session") {
+		if _, err := testService().RefreshAdminSession(context.Background(), makeToken("user-missing", 3), AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), "invalid admin session") {
 			t.Fatalf("expected invalid session for missing user, got %v", err)
 		}
-		if _, err := RefreshAdminSession(context.Background(), makeToken("password-mismatch", 3), AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), "invalid admin session") {
+		if _, err := testService().RefreshAdminSession(context.Background(), makeToken("password-mismatch", 3), AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), "invalid admin session") {
 			t.Fatalf("expected invalid session for password mismatch, got %v", err)
 		}
-		if _, err := RefreshAdminSession(context.Background(), makeToken("userid-mismatch", 3), AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), "invalid admin session") {
+		if _, err := testService().RefreshAdminSession(context.Background(), makeToken("userid-mismatch", 3), AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), "invalid admin session") {
 			t.Fatalf("expected invalid session for user id mismatch, got %v", err)
 		}
 	})
@@ -1114,19 +1147,19 @@ func TestAdminAuthHelperAndSessionErrorPaths(t *testing.T) {
 			},
 		}
 
-		if err := LogoutAdmin(context.Background(), refreshToken); err == nil || !strings.Contains(err.Error(), "admin session storage is unavailable") {
+		if err := testService().LogoutAdmin(context.Background(), refreshToken); err == nil || !strings.Contains(err.Error(), "admin session storage is unavailable") {
 			t.Fatalf("expected logout storage error, got %v", err)
 		}
-		if _, err := ListActiveAdminSessions(context.Background(), &domain.AdminUser{ID: "admin-1"}); err == nil || !strings.Contains(err.Error(), "admin session storage is unavailable") {
+		if _, err := testService().ListActiveAdminSessions(context.Background(), &domain.AdminUser{ID: "admin-1"}); err == nil || !strings.Contains(err.Error(), "admin session storage is unavailable") {
 			t.Fatalf("expected list sessions storage error, got %v", err)
 		}
-		if _, err := RevokeAdminSession(context.Background(), &domain.AdminUser{ID: "admin-1"}, " "); err == nil || !strings.Contains(err.Error(), "session id is required") {
+		if _, err := testService().RevokeAdminSession(context.Background(), &domain.AdminUser{ID: "admin-1"}, " "); err == nil || !strings.Contains(err.Error(), "session id is required") {
 			t.Fatalf("expected session id required error, got %v", err)
 		}
-		if _, err := RevokeAdminSession(context.Background(), &domain.AdminUser{ID: "admin-1"}, "session-1"); err == nil || !strings.Contains(err.Error(), "admin session storage is unavailable") {
+		if _, err := testService().RevokeAdminSession(context.Background(), &domain.AdminUser{ID: "admin-1"}, "session-1"); err == nil || !strings.Contains(err.Error(), "admin session storage is unavailable") {
 			t.Fatalf("expected revoke session storage error, got %v", err)
 		}
-		if err := RevokeAllAdminSessions(context.Background(), &domain.AdminUser{ID: "admin-1"}); err == nil || !strings.Contains(err.Error(), "admin session storage is unavailable") {
+		if err := testService().RevokeAllAdminSessions(context.Background(), &domain.AdminUser{ID: "admin-1"}); err == nil || !strings.Contains(err.Error(), "admin session storage is unavailable") {
 			t.Fatalf("expected revoke all storage error, got %v", err)
 		}
 	})
@@ -1159,13 +1192,13 @@ func TestAdminAuthHelperAndSessionErrorPaths(t *testing.T) {
 			},
 		}
 
-		if _, err := loadAdminUserRecord(context.Background(), "error"); err == nil || !strings.Contains(err.Error(), adminLoadAdminUserMessage) {
+		if _, err := testService().loadAdminUserRecord(context.Background(), "error"); err == nil || !strings.Contains(err.Error(), adminLoadAdminUserMessage) {
 			t.Fatalf("expected load user error, got %v", err)
 		}
-		if _, err := loadAdminUserRecord(context.Background(), "missing"); err == nil || !strings.Contains(err.Error(), adminAuthRequiredMessage) {
+		if _, err := testService().loadAdminUserRecord(context.Background(), "missing"); err == nil || !strings.Contains(err.Error(), adminAuthRequiredMessage) {
 			t.Fatalf("expected auth required from missing user, got %v", err)
 		}
-		if _, err := reloadAdminUser(context.Background(), "error"); err == nil || !strings.Contains(err.Error(), adminLoadAdminUserMessage) {
+		if _, err := testService().reloadAdminUser(context.Background(), "error"); err == nil || !strings.Contains(err.Error(), adminLoadAdminUserMessage) {
 			t.Fatalf("expected reload user error, got %v", err)
 		}
 		if err := validateAdminCurrentPassword(&domain.AdminUserRecord{PasswordHash: string(passwordHashBytes)}, "wrong-password"); err == nil || !strings.Contains(err.Error(), adminCurrentPasswordIncorrectMessage) {
@@ -1220,24 +1253,24 @@ func TestAdminAuthHelperAndSessionErrorPaths(t *testing.T) {
 			},
 		}
 
-		if _, err := ChangeAdminName(context.Background(), &domain.AdminUser{ID: "admin-1"}, "Valid Name"); err == nil || !strings.Contains(err.Error(), adminAuthRequiredMessage) {
+		if _, err := testService().ChangeAdminName(context.Background(), &domain.AdminUser{ID: "admin-1"}, "Valid Name"); err == nil || !strings.Contains(err.Error(), adminAuthRequiredMessage) {
 			t.Fatalf("expected unauthorized from change name update, got %v", err)
 		}
-		if err := DeleteAdminAccount(context.Background(), &domain.AdminUser{ID: "admin-1"}, "current-password"); err == nil || !strings.Contains(err.Error(), adminAuthRequiredMessage) {
+		if err := testService().DeleteAdminAccount(context.Background(), &domain.AdminUser{ID: "admin-1"}, "current-password"); err == nil || !strings.Contains(err.Error(), adminAuthRequiredMessage) {
 			t.Fatalf("expected unauthorized from disable not found, got %v", err)
 		}
 
 		config := appconfig.ResolveAdminConfig()
-		if _, err := issueAdminTokens(context.Background(), config, &domain.AdminUserRecord{}, "", false, AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), "admin id is not configured") {
+		if _, err := testService().issueAdminTokens(context.Background(), config, &domain.AdminUserRecord{}, "", false, AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), "admin id is not configured") {
 			t.Fatalf("expected admin id config error, got %v", err)
 		}
-		if _, err := issueAdminTokens(context.Background(), config, &domain.AdminUserRecord{
+		if _, err := testService().issueAdminTokens(context.Background(), config, &domain.AdminUserRecord{
 			AdminUser:       domain.AdminUser{ID: "admin-1", Email: "admin@example.com"},
 			PasswordVersion: 2,
 		}, "", false, AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), "admin session storage is unavailable") {
 			t.Fatalf("expected token create storage error, got %v", err)
 		}
-		if _, err := issueAdminTokens(context.Background(), config, &domain.AdminUserRecord{
+		if _, err := testService().issueAdminTokens(context.Background(), config, &domain.AdminUserRecord{
 			AdminUser:       domain.AdminUser{ID: "admin-1", Email: "admin@example.com"},
 			PasswordVersion: 2,
 		}, "current-jti", true, AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), "invalid admin session") {
@@ -1249,7 +1282,7 @@ func TestAdminAuthHelperAndSessionErrorPaths(t *testing.T) {
 				return repository.ErrAdminRefreshTokenRepositoryUnavailable
 			},
 		}
-		if _, err := issueAdminTokens(context.Background(), config, &domain.AdminUserRecord{
+		if _, err := testService().issueAdminTokens(context.Background(), config, &domain.AdminUserRecord{
 			AdminUser:       domain.AdminUser{ID: "admin-1", Email: "admin@example.com"},
 			PasswordVersion: 2,
 		}, "current-jti", true, AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), "admin session storage is unavailable") {
@@ -12,12 +12,12 @@ import (
 	"suaybsimsek.com/blog-api/pkg/httpauth"
 )
 
-func ListActiveAdminSessions(ctx context.Context, adminUser *domain.AdminUser) ([]domain.AdminSessionRecord, error) {
+func (s *Service) ListActiveAdminSessions(ctx context.Context, adminUser *domain.AdminUser) ([]domain.AdminSessionRecord, error) {
 	if err := requireAdminAuthentication(adminUser); err != nil {
 		return nil, err
 	}
 
-	sessions, err := adminRefreshTokensRepository.ListActiveByUserID(ctx, adminUser.ID, time.Now().UTC(), maxActiveAdminSessions)
+	sessions, err := s.adminRefreshTokens.ListActiveByUserID(ctx, adminUser.ID, time.Now().UTC(), maxActiveAdminSessions)
 	if err != nil {
 		return nil, toAdminSessionError(err)
 	}
@@ -25,8 +25,8 @@ func ListActiveAdminSessions(ctx context.Context, adminUser *domain.AdminUser) (
 	return sessions, nil
 }
 
-func RevokeAdminSession(ctx context.Context, adminUser *domain.AdminUser, sessionID string) (bool, error) {
-	if err := requireAdminAuthentication(adminUser); err != nil {
+func (s *Service) RevokeAdminSession(ctx context.Context, adminUser *domain.AdminUser, sessionID string) (bool, error) {
+	if err := requireAdminSessionAuthentication(adminUser); err != nil {
 		return false, err
 	}
 
@@ -35,7 +35,7 @@ func RevokeAdminSession(ctx context.Context, adminUser *domain.AdminUser, sessio
 		return false, apperrors.BadRequest("session id is required")
 	}
 
-	revoked, err := adminRefreshTokensRepository.RevokeByJTIAndUserID(ctx, resolvedSessionID, adminUser.ID, time.Now().UTC())
+	revoked, err := s.adminRefreshTokens.RevokeByJTIAndUserID(ctx, resolvedSessionID, adminUser.ID, time.Now().UTC())
 	if err != nil {
 		return false, toAdminSessionError(err)
 	}
@@ -43,25 +43,25 @@ func RevokeAdminSession(ctx context.Context, adminUser *domain.AdminUser, sessio
 	return revoked, nil
 }
 
-func RevokeAllAdminSessions(ctx context.Context, adminUser *domain.AdminUser) error {
-	if err := requireAdminAuthentication(adminUser); err != nil {
+func (s *Service) RevokeAllAdminSessions(ctx context.Context, adminUser *domain.AdminUser) error {
+	if err := requireAdminSessionAuthentication(adminUser); err != nil {
 		return err
 	}
 
-	if err := adminRefreshTokensRepository.RevokeAllByUserID(ctx, adminUser.ID, time.Now().UTC()); err != nil {
+	if err := s.adminRefreshTokens.RevokeAllByUserID(ctx, adminUser.ID, time.Now().UTC()); err != nil {
 		return toAdminSessionError(err)
 	}
 
 	return nil
 }
 
-func ResolveAdminFromAccessToken(ctx context.Context, token string) (*domain.AdminUser, error) { // NOSONAR
+func (s *Service) ResolveAdminFromAccessToken(ctx context.Context, token string) (*domain.AdminUser, error) { // NOSONAR
 	config := appconfig.ResolveAdminConfig()
-	if strings.TrimSpace(config.JWTSecret) == "" {
+	if !config.JWTConfigured() {
 		return nil, nil
 	}
 
-	claims, err := httpauth.VerifyHS256JWT(token, config.JWTSecret, "access", time.Now().UTC())
+	claims, err := VerifyAdminJWT(config, token, "access", time.Now().UTC())
 	if err != nil {
 		if errors.Is(err, httpauth.ErrInvalidJWT) || errors.Is(err, httpauth.ErrExpiredJWT) || errors.Is(err, httpauth.ErrUnsupportedJWT) {
 			return nil, nil
@@ -70,12 +70,12 @@ func ResolveAdminFromAccessToken(ctx context.Context, token string) (*domain.Adm
 	}
 
 	resolvedSubject := strings.TrimSpace(claims.Subject)
-	userRecord, err := adminUsersRepository.FindByID(ctx, resolvedSubject)
+	userRecord, err := s.adminUsers.FindByID(ctx, resolvedSubject)
 	if err != nil {
 		return nil, err
 	}
 	if userRecord == nil {
-		userRecord, err = adminUsersRepository.FindByUsername(ctx, resolvedSubject)
+		userRecord, err = s.adminUsers.FindByUsername(ctx, resolvedSubject)
 		if err != nil {
 			return nil, err
 		}
@@ -83,7 +83,7 @@ func ResolveAdminFromAccessToken(ctx context.Context, token string) (*domain.Adm
 	if userRecord == nil {
 		resolvedEmail := strings.TrimSpace(strings.ToLower(claims.Email))
 		if resolvedEmail != "" {
-			userRecord, err = adminUsersRepository.FindByEmail(ctx, resolvedEmail)
+			userRecord, err = s.adminUsers.FindByEmail(ctx, resolvedEmail)
 			if err != nil {
 				return nil, err
 			}
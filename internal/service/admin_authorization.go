@@ -0,0 +1,131 @@
+package service
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+)
+
+const (
+	AdminRoleOwner             = "owner"
+	AdminRoleEditor            = "editor"
+	AdminRoleModerator         = "moderator"
+	AdminRoleNewsletterManager = "newsletter-manager"
+
+	// adminRoleLegacyAdmin is stored on accounts created before roles existed and keeps full access.
+	adminRoleLegacyAdmin = "admin"
+
+	adminCodeForbidden    = "ADMIN_FORBIDDEN"
+	adminMessageForbidden = "admin permission denied"
+)
+
+// AdminPermission names one group of admin GraphQL operations. The values match the AdminPermission enum of the
+// admin schema.
+type AdminPermission string
+
+const (
+	AdminPermissionAccount             AdminPermission = "ACCOUNT"
+	AdminPermissionDashboardRead       AdminPermission = "DASHBOARD_READ"
+	AdminPermissionContentRead         AdminPermission = "CONTENT_READ"
+	AdminPermissionContentWrite        AdminPermission = "CONTENT_WRITE"
+	AdminPermissionContentDelete       AdminPermission = "CONTENT_DELETE"
+	AdminPermissionMediaRead           AdminPermission = "MEDIA_READ"
+	AdminPermissionMediaWrite          AdminPermission = "MEDIA_WRITE"
+	AdminPermissionMediaDelete         AdminPermission = "MEDIA_DELETE"
+	AdminPermissionCommentsModerate    AdminPermission = "COMMENTS_MODERATE"
+	AdminPermissionNewsletterManage    AdminPermission = "NEWSLETTER_MANAGE"
+	AdminPermissionErrorMessagesManage AdminPermission = "ERROR_MESSAGES_MANAGE"
+	AdminPermissionUsersManage         AdminPermission = "USERS_MANAGE"
+)
+
+var allAdminPermissions = []AdminPermission{
+	AdminPermissionAccount,
+	AdminPermissionDashboardRead,
+	AdminPermissionContentRead,
+	AdminPermissionContentWrite,
+	AdminPermissionContentDelete,
+	AdminPermissionMediaRead,
+	AdminPermissionMediaWrite,
+	AdminPermissionMediaDelete,
+	AdminPermissionCommentsModerate,
+	AdminPermissionNewsletterManage,
+	AdminPermissionErrorMessagesManage,
+	AdminPermissionUsersManage,
+}
+
+var adminRolePermissions = map[string][]AdminPermission{
+	AdminRoleOwner: allAdminPermissions,
+	AdminRoleEditor: {
+		AdminPermissionAccount,
+		AdminPermissionDashboardRead,
+		AdminPermissionContentRead,
+		AdminPermissionContentWrite,
+		AdminPermissionMediaRead,
+		AdminPermissionMediaWrite,
+	},
+	AdminRoleModerator: {
+		AdminPermissionAccount,
+		AdminPermissionDashboardRead,
+		AdminPermissionContentRead,
+		AdminPermissionCommentsModerate,
+	},
+	AdminRoleNewsletterManager: {
+		AdminPermissionAccount,
+		AdminPermissionDashboardRead,
+		AdminPermissionNewsletterManage,
+	},
+}
+
+// RequireAdminPermission rejects anonymous requests with ADMIN_AUTH_REQUIRED and admins whose roles do not grant
+// permission with ADMIN_FORBIDDEN.
+func RequireAdminPermission(adminUser *domain.AdminUser, permission AdminPermission) error {
+	if err := requireAdminAuthentication(adminUser); err != nil {
+		return err
+	}
+	if !slices.Contains(ResolveAdminPermissions(adminUser), permission) {
+		return apperrors.New(adminCodeForbidden, adminMessageForbidden, http.StatusForbidden, nil)
+	}
+	return nil
+}
+
+// ResolveAdminPermissions returns the union of the permissions granted by the admin's roles in matrix order.
+// Unknown roles grant nothing. Requests made with a personal access token only keep the permissions in its scopes.
+func ResolveAdminPermissions(adminUser *domain.AdminUser) []AdminPermission {
+	if adminUser == nil {
+		return []AdminPermission{}
+	}
+
+	granted := map[AdminPermission]struct{}{}
+	for _, role := range adminUser.Roles {
+		for _, permission := range adminRolePermissions[normalizeAdminRole(role)] {
+			granted[permission] = struct{}{}
+		}
+	}
+	if strings.TrimSpace(adminUser.AccessTokenID) != "" {
+		for permission := range granted {
+			if !slices.Contains(adminUser.AccessTokenScopes, string(permission)) {
+				delete(granted, permission)
+			}
+		}
+	}
+
+	permissions := make([]AdminPermission, 0, len(granted))
+	for _, permission := range allAdminPermissions {
+		if _, ok := granted[permission]; ok {
+			permissions = append(permissions, permission)
+		}
+	}
+	return permissions
+}
+
+func normalizeAdminRole(role string) string {
+	resolved := strings.ToLower(strings.TrimSpace(role))
+	resolved = strings.ReplaceAll(resolved, "_", "-")
+	if resolved == adminRoleLegacyAdmin {
+		return AdminRoleOwner
+	}
+	return resolved
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+)
+
+func TestResolveAdminPermissions(t *testing.T) {
+	tests := []struct {
+		name  string
+		roles []string
+		want  []AdminPermission
+	}{
+		{name: "owner", roles: []string{AdminRoleOwner}, want: allAdminPermissions},
+		{name: "legacy admin", roles: []string{"ADMIN"}, want: allAdminPermissions},
+		{
+			name:  "editor and moderator",
+			roles: []string{AdminRoleModerator, " Editor "},
+			want: []AdminPermission{
+				AdminPermissionAccount,
+				AdminPermissionDashboardRead,
+				AdminPermissionContentRead,
+				AdminPermissionContentWrite,
+				AdminPermissionMediaRead,
+				AdminPermissionMediaWrite,
+				AdminPermissionCommentsModerate,
+			},
+		},
+		{
+			name:  "newsletter manager with underscore",
+			roles: []string{"newsletter_manager"},
+			want: []AdminPermission{
+				AdminPermissionAccount,
+				AdminPermissionDashboardRead,
+				AdminPermissionNewsletterManage,
+			},
+		},
+		{name: "unknown role", roles: []string{"viewer"}, want: []AdminPermission{}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := ResolveAdminPermissions(&domain.AdminUser{ID: "admin-1", Roles: tc.roles})
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("ResolveAdminPermissions(%v) = %v, want %v", tc.roles, got, tc.want)
+			}
+		})
+	}
+
+	if got := ResolveAdminPermissions(nil); len(got) != 0 {
+		t.Fatalf("expected no permissions without admin, got %v", got)
+	}
+}
+
+func TestRequireAdminPermission(t *testing.T) {
+	editor := &domain.AdminUser{ID: "admin-1", Roles: []string{AdminRoleEditor}}
+	if err := RequireAdminPermission(editor, AdminPermissionContentWrite); err != nil {
+		t.Fatalf("expected editor to write content, got %v", err)
+	}
+
+	err := RequireAdminPermission(editor, AdminPermissionContentDelete)
+	appErr := apperrors.From(err)
+	if appErr.Code != adminCodeForbidden || appErr.HTTPStatus != http.StatusForbidden {
+		t.Fatalf("expected forbidden, got %v", err)
+	}
+
+	if err := RequireAdminPermission(nil, AdminPermissionAccount); apperrors.From(err).HTTPStatus != http.StatusUnauthorized {
+		t.Fatalf("expected unauthorized without admin, got %v", err)
+	}
+}
@@ -25,7 +25,7 @@ const (
 	adminCommentStatusUnsupported = "unsupported comment status"
 )
 
-func ListAdminComments(
+func (s *Service) ListAdminComments(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	filter domain.AdminCommentFilter,
@@ -49,7 +49,7 @@ func ListAdminComments(
 		}
 	}
 
-	result, err := commentRepository.ListComments(
+	result, err := s.comments.ListComments(
 		ctx,
 		domain.AdminCommentFilter{
 			Status: status,
@@ -77,7 +77,7 @@ func ListAdminComments(
 	return result, nil
 }
 
-func UpdateAdminCommentStatus(
+func (s *Service) UpdateAdminCommentStatus(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	commentID string,
@@ -97,7 +97,7 @@ func UpdateAdminCommentStatus(
 		return nil, adminCommentBadRequest(adminCommentCodeStatusInvalid, adminCommentStatusUnsupported)
 	}
 
-	updated, err := commentRepository.UpdateCommentStatusByID(
+	updated, err := s.comments.UpdateCommentStatusByID(
 		ctx,
 		resolvedID,
 		resolvedStatus,
@@ -111,7 +111,7 @@ func UpdateAdminCommentStatus(
 	return updated, nil
 }
 
-func BulkUpdateAdminCommentStatus(
+func (s *Service) BulkUpdateAdminCommentStatus(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	commentIDs []string,
@@ -131,7 +131,7 @@ func BulkUpdateAdminCommentStatus(
 		return 0, adminCommentBadRequest(adminCommentCodeStatusInvalid, adminCommentStatusUnsupported)
 	}
 
-	successCount, err := commentRepository.UpdateCommentStatusByIDs(
+	successCount, err := s.comments.UpdateCommentStatusByIDs(
 		ctx,
 		resolvedIDs,
 		resolvedStatus,
@@ -148,7 +148,7 @@ func BulkUpdateAdminCommentStatus(
 	return successCount, nil
 }
 
-func DeleteAdminComment(
+func (s *Service) DeleteAdminComment(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	commentID string,
@@ -162,7 +162,7 @@ func DeleteAdminComment(
 		return adminCommentBadRequest(adminCommentCodeIDRequired, "comment id is required")
 	}
 
-	deleted, err := commentRepository.DeleteCommentByID(ctx, resolvedID)
+	deleted, err := s.comments.DeleteCommentByID(ctx, resolvedID)
 	if err != nil {
 		return toAdminCommentError(err, "failed to delete comment")
 	}
@@ -173,7 +173,7 @@ func DeleteAdminComment(
 	return nil
 }
 
-func BulkDeleteAdminComments(
+func (s *Service) BulkDeleteAdminComments(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	commentIDs []string,
@@ -187,7 +187,7 @@ func BulkDeleteAdminComments(
 		return 0, adminCommentBadRequest(adminCommentCodeIDsRequired, "at least one comment id is required")
 	}
 
-	deletedCount, err := commentRepository.DeleteCommentsByIDs(ctx, resolvedIDs)
+	deletedCount, err := s.comments.DeleteCommentsByIDs(ctx, resolvedIDs)
 	if err != nil {
 		return 0, toAdminCommentError(err, "failed to delete comment")
 	}
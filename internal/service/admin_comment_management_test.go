@@ -33,7 +33,7 @@ func TestDeleteAdminCommentRemovesComment(t *testing.T) {
 		},
 	}
 
-	err := DeleteAdminComment(context.Background(), &domain.AdminUser{ID: "admin-1"}, " comment-1 ")
+	err := testService().DeleteAdminComment(context.Background(), &domain.AdminUser{ID: "admin-1"}, " comment-1 ")
 	if err != nil {
 		t.Fatalf("DeleteAdminComment returned error: %v", err)
 	}
@@ -54,7 +54,7 @@ func TestDeleteAdminCommentReturnsBadRequestWhenMissing(t *testing.T) {
 		},
 	}
 
-	err := DeleteAdminComment(context.Background(), &domain.AdminUser{ID: "admin-1"}, "missing")
+	err := testService().DeleteAdminComment(context.Background(), &domain.AdminUser{ID: "admin-1"}, "missing")
 	if err == nil || err.Error() != "comment not found" {
 		t.Fatalf("DeleteAdminComment error = %v", err)
 	}
@@ -78,7 +78,7 @@ func TestDeleteAdminCommentMapsRepositoryErrors(t *testing.T) {
 		},
 	}
 
-	err := DeleteAdminComment(context.Background(), &domain.AdminUser{ID: "admin-1"}, "comment-1")
+	err := testService().DeleteAdminComment(context.Background(), &domain.AdminUser{ID: "admin-1"}, "comment-1")
 	if err == nil || !strings.Contains(err.Error(), "comment moderation storage is unavailable") {
 		t.Fatalf("DeleteAdminComment error = %v", err)
 	}
@@ -106,7 +106,7 @@ func TestBulkUpdateAdminCommentStatus(t *testing.T) {
 		},
 	}
 
-	successCount, err := BulkUpdateAdminCommentStatus(
+	successCount, err := testService().BulkUpdateAdminCommentStatus(
 		context.Background(),
 		&domain.AdminUser{ID: "admin-1"},
 		[]string{" comment-1 ", "comment-2", "comment-1"},
@@ -136,7 +136,7 @@ func TestBulkDeleteAdminComments(t *testing.T) {
 		},
 	}
 
-	successCount, err := BulkDeleteAdminComments(
+	successCount, err := testService().BulkDeleteAdminComments(
 		context.Background(),
 		&domain.AdminUser{ID: "admin-1"},
 		[]string{" comment-1 ", "comment-2", "comment-1"},
@@ -161,7 +161,7 @@ func TestBulkDeleteAdminCommentsReturnsBadRequestWhenMissing(t *testing.T) {
 		},
 	}
 
-	_, err := BulkDeleteAdminComments(context.Background(), &domain.AdminUser{ID: "admin-1"}, []string{"missing"})
+	_, err := testService().BulkDeleteAdminComments(context.Background(), &domain.AdminUser{ID: "admin-1"}, []string{"missing"})
 	if err == nil || err.Error() != "comments not found" {
 		t.Fatalf("BulkDeleteAdminComments error = %v", err)
 	}
@@ -189,7 +189,7 @@ func TestListAdminCommentsNormalizesFiltersAndReturnsEmptyResult(t *testing.T) {
 		findCommentByID: func(context.Context, string) (*domain.CommentRecord, error) { return nil, nil },
 	}
 
-	result, err := ListAdminComments(context.Background(), &domain.AdminUser{ID: "admin-1"}, domain.AdminCommentFilter{
+	result, err := testService().ListAdminComments(context.Background(), &domain.AdminUser{ID: "admin-1"}, domain.AdminCommentFilter{
 		Status: " APPROVED ",
 		PostID: " Alpha-Post ",
 		Query:  " hello ",
@@ -218,7 +218,7 @@ func TestUpdateAdminCommentStatusUpdatesModerationState(t *testing.T) {
 		findCommentByID: func(context.Context, string) (*domain.CommentRecord, error) { return nil, nil },
 	}
 
-	record, err := UpdateAdminCommentStatus(context.Background(), &domain.AdminUser{ID: "admin-1"}, " comment-1 ", " SPAM ")
+	record, err := testService().UpdateAdminCommentStatus(context.Background(), &domain.AdminUser{ID: "admin-1"}, " comment-1 ", " SPAM ")
 	if err != nil {
 		t.Fatalf("UpdateAdminCommentStatus returned error: %v", err)
 	}
@@ -233,15 +233,15 @@ func TestListAdminCommentsErrorPaths(t *testing.T) {
 		commentRepository = originalCommentRepository
 	})
 
-	if _, err := ListAdminComments(context.Background(), nil, domain.AdminCommentFilter{}); err == nil || !strings.Contains(err.Error(), "admin authentication required") {
+	if _, err := testService().ListAdminComments(context.Background(), nil, domain.AdminCommentFilter{}); err == nil || !strings.Contains(err.Error(), "admin authentication required") {
 		t.Fatalf("expected auth error, got %v", err)
 	}
 
-	if _, err := ListAdminComments(context.Background(), &domain.AdminUser{ID: "admin-1"}, domain.AdminCommentFilter{Status: "weird"}); err == nil || !strings.Contains(err.Error(), adminCommentStatusUnsupported) {
+	if _, err := testService().ListAdminComments(context.Background(), &domain.AdminUser{ID: "admin-1"}, domain.AdminCommentFilter{Status: "weird"}); err == nil || !strings.Contains(err.Error(), adminCommentStatusUnsupported) {
 		t.Fatalf("expected status validation error, got %v", err)
 	}
 
-	if _, err := ListAdminComments(context.Background(), &domain.AdminUser{ID: "admin-1"}, domain.AdminCommentFilter{PostID: "??"}); err == nil || !strings.Contains(err.Error(), "invalid post id") {
+	if _, err := testService().ListAdminComments(context.Background(), &domain.AdminUser{ID: "admin-1"}, domain.AdminCommentFilter{PostID: "??"}); err == nil || !strings.Contains(err.Error(), "invalid post id") {
 		t.Fatalf("expected post id validation error, got %v", err)
 	}
 
@@ -250,7 +250,7 @@ func TestListAdminCommentsErrorPaths(t *testing.T) {
 			return nil, repository.ErrCommentRepositoryUnavailable
 		},
 	}
-	if _, err := ListAdminComments(context.Background(), &domain.AdminUser{ID: "admin-1"}, domain.AdminCommentFilter{}); err == nil || !strings.Contains(err.Error(), "comment moderation storage is unavailable") {
+	if _, err := testService().ListAdminComments(context.Background(), &domain.AdminUser{ID: "admin-1"}, domain.AdminCommentFilter{}); err == nil || !strings.Contains(err.Error(), "comment moderation storage is unavailable") {
 		t.Fatalf("expected repository unavailable error, got %v", err)
 	}
 
@@ -259,7 +259,7 @@ func TestListAdminCommentsErrorPaths(t *testing.T) {
 			return &domain.AdminCommentListResult{Items: nil, Total: 1, Page: 2, Size: 3}, nil
 		},
 	}
-	result, err := ListAdminComments(context.Background(), &domain.AdminUser{ID: "admin-1"}, domain.AdminCommentFilter{})
+	result, err := testService().ListAdminComments(context.Background(), &domain.AdminUser{ID: "admin-1"}, domain.AdminCommentFilter{})
 	if err != nil {
 		t.Fatalf("ListAdminComments returned error: %v", err)
 	}
@@ -274,15 +274,15 @@ func TestUpdateAdminCommentStatusErrorPaths(t *testing.T) {
 		commentRepository = originalCommentRepository
 	})
 
-	if _, err := UpdateAdminCommentStatus(context.Background(), nil, "comment-1", commentStatusApproved); err == nil || !strings.Contains(err.Error(), "admin authentication required") {
+	if _, err := testService().UpdateAdminCommentStatus(context.Background(), nil, "comment-1", commentStatusApproved); err == nil || !strings.Contains(err.Error(), "admin authentication required") {
 		t.Fatalf("expected auth error, got %v", err)
 	}
 
-	if _, err := UpdateAdminCommentStatus(context.Background(), &domain.AdminUser{ID: "admin-1"}, " ", commentStatusApproved); err == nil || !strings.Contains(err.Error(), "comment id is required") {
+	if _, err := testService().UpdateAdminCommentStatus(context.Background(), &domain.AdminUser{ID: "admin-1"}, " ", commentStatusApproved); err == nil || !strings.Contains(err.Error(), "comment id is required") {
 		t.Fatalf("expected id required error, got %v", err)
 	}
 
-	if _, err := UpdateAdminCommentStatus(context.Background(), &domain.AdminUser{ID: "admin-1"}, "comment-1", "weird"); err == nil || !strings.Contains(err.Error(), adminCommentStatusUnsupported) {
+	if _, err := testService().UpdateAdminCommentStatus(context.Background(), &domain.AdminUser{ID: "admin-1"}, "comment-1", "weird"); err == nil || !strings.Contains(err.Error(), adminCommentStatusUnsupported) {
 		t.Fatalf("expected invalid status error, got %v", err)
 	}
 
@@ -291,7 +291,7 @@ func TestUpdateAdminCommentStatusErrorPaths(t *testing.T) {
 			return nil, repository.ErrCommentNotFound
 		},
 	}
-	if _, err := UpdateAdminCommentStatus(context.Background(), &domain.AdminUser{ID: "admin-1"}, "comment-1", commentStatusApproved); err == nil || err.Error() != "comment not found" {
+	if _, err := testService().UpdateAdminCommentStatus(context.Background(), &domain.AdminUser{ID: "admin-1"}, "comment-1", commentStatusApproved); err == nil || err.Error() != "comment not found" {
 		t.Fatalf("expected comment not found error, got %v", err)
 	}
 
@@ -300,7 +300,7 @@ func TestUpdateAdminCommentStatusErrorPaths(t *testing.T) {
 			return nil, errors.New("boom")
 		},
 	}
-	if _, err := UpdateAdminCommentStatus(context.Background(), &domain.AdminUser{ID: "admin-1"}, "comment-1", commentStatusApproved); err == nil || !strings.Contains(err.Error(), "failed to update comment status") {
+	if _, err := testService().UpdateAdminCommentStatus(context.Background(), &domain.AdminUser{ID: "admin-1"}, "comment-1", commentStatusApproved); err == nil || !strings.Contains(err.Error(), "failed to update comment status") {
 		t.Fatalf("expected fallback internal error, got %v", err)
 	}
 }
@@ -311,15 +311,15 @@ func TestBulkUpdateAdminCommentStatusErrorPaths(t *testing.T) {
 		commentRepository = originalCommentRepository
 	})
 
-	if _, err := BulkUpdateAdminCommentStatus(context.Background(), nil, []string{"comment-1"}, commentStatusApproved); err == nil || !strings.Contains(err.Error(), "admin authentication required") {
+	if _, err := testService().BulkUpdateAdminCommentStatus(context.Background(), nil, []string{"comment-1"}, commentStatusApproved); err == nil || !strings.Contains(err.Error(), "admin authentication required") {
 		t.Fatalf("expected auth error, got %v", err)
 	}
 
-	if _, err := BulkUpdateAdminCommentStatus(context.Background(), &domain.AdminUser{ID: "admin-1"}, []string{" ", ""}, commentStatusApproved); err == nil || !strings.Contains(err.Error(), "at least one comment id is required") {
+	if _, err := testService().BulkUpdateAdminCommentStatus(context.Background(), &domain.AdminUser{ID: "admin-1"}, []string{" ", ""}, commentStatusApproved); err == nil || !strings.Contains(err.Error(), "at least one comment id is required") {
 		t.Fatalf("expected ids required error, got %v", err)
 	}
 
-	if _, err := BulkUpdateAdminCommentStatus(context.Background(), &domain.AdminUser{ID: "admin-1"}, []string{"comment-1"}, "invalid"); err == nil || !strings.Contains(err.Error(), adminCommentStatusUnsupported) {
+	if _, err := testService().BulkUpdateAdminCommentStatus(context.Background(), &domain.AdminUser{ID: "admin-1"}, []string{"comment-1"}, "invalid"); err == nil || !strings.Contains(err.Error(), adminCommentStatusUnsupported) {
 		t.Fatalf("expected invalid status error, got %v", err)
 	}
 
@@ -328,7 +328,7 @@ func TestBulkUpdateAdminCommentStatusErrorPaths(t *testing.T) {
 			return 0, repository.ErrCommentRepositoryUnavailable
 		},
 	}
-	if _, err := BulkUpdateAdminCommentStatus(context.Background(), &domain.AdminUser{ID: "admin-1"}, []string{"comment-1"}, commentStatusApproved); err == nil || !strings.Contains(err.Error(), "comment moderation storage is unavailable") {
+	if _, err := testService().BulkUpdateAdminCommentStatus(context.Background(), &domain.AdminUser{ID: "admin-1"}, []string{"comment-1"}, commentStatusApproved); err == nil || !strings.Contains(err.Error(), "comment moderation storage is unavailable") {
 		t.Fatalf("expected repository unavailable error, got %v", err)
 	}
 
@@ -337,7 +337,7 @@ func TestBulkUpdateAdminCommentStatusErrorPaths(t *testing.T) {
 			return 0, nil
 		},
 	}
-	if _, err := BulkUpdateAdminCommentStatus(context.Background(), &domain.AdminUser{ID: "admin-1"}, []string{"comment-1"}, commentStatusApproved); err == nil || err.Error() != "comments not found" {
+	if _, err := testService().BulkUpdateAdminCommentStatus(context.Background(), &domain.AdminUser{ID: "admin-1"}, []string{"comment-1"}, commentStatusApproved); err == nil || err.Error() != "comments not found" {
 		t.Fatalf("expected comments not found error, got %v", err)
 	}
 }
@@ -348,11 +348,11 @@ func TestBulkDeleteAdminCommentsErrorPathsAndHelpers(t *testing.T) {
 		commentRepository = originalCommentRepository
 	})
 
-	if _, err := BulkDeleteAdminComments(context.Background(), nil, []string{"comment-1"}); err == nil || !strings.Contains(err.Error(), "admin authentication required") {
+	if _, err := testService().BulkDeleteAdminComments(context.Background(), nil, []string{"comment-1"}); err == nil || !strings.Contains(err.Error(), "admin authentication required") {
 		t.Fatalf("expected auth error, got %v", err)
 	}
 
-	if _, err := BulkDeleteAdminComments(context.Background(), &domain.AdminUser{ID: "admin-1"}, []string{" ", ""}); err == nil || !strings.Contains(err.Error(), "at least one comment id is required") {
+	if _, err := testService().BulkDeleteAdminComments(context.Background(), &domain.AdminUser{ID: "admin-1"}, []string{" ", ""}); err == nil || !strings.Contains(err.Error(), "at least one comment id is required") {
 		t.Fatalf("expected ids required error, got %v", err)
 	}
 
@@ -361,7 +361,7 @@ func TestBulkDeleteAdminCommentsErrorPathsAndHelpers(t *testing.T) {
 			return 0, repository.ErrCommentRepositoryUnavailable
 		},
 	}
-	if _, err := BulkDeleteAdminComments(context.Background(), &domain.AdminUser{ID: "admin-1"}, []string{"comment-1"}); err == nil || !strings.Contains(err.Error(), "comment moderation storage is unavailable") {
+	if _, err := testService().BulkDeleteAdminComments(context.Background(), &domain.AdminUser{ID: "admin-1"}, []string{"comment-1"}); err == nil || !strings.Contains(err.Error(), "comment moderation storage is unavailable") {
 		t.Fatalf("expected repository unavailable error, got %v", err)
 	}
 
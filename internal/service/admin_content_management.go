@@ -49,8 +49,7 @@ const (
 )
 
 var (
-	adminContentIDPattern                                    = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,127}$`)
-	adminContentRepository repository.AdminContentRepository = repository.NewAdminContentRepository()
+	adminContentIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,127}$`)
 )
 
 func normalizeAdminContentLocale(value string, allowAll bool) (string, error) {
@@ -165,7 +164,8 @@ func normalizeAdminContentLink(value string) (string, error) {
 
 func toAdminContentError(err error, message string) error {
 	switch {
-	case errors.Is(err, repository.ErrAdminContentRepositoryUnavailable):
+	case errors.Is(err, repository.ErrAdminContentRepositoryUnavailable),
+		errors.Is(err, repository.ErrPostRepositoryUnavailable):
 		return apperrors.ServiceUnavailable("admin content management is unavailable", err)
 	case errors.Is(err, repository.ErrAdminContentPostNotFound):
 		return apperrors.BadRequest(adminContentPostNotFound)
@@ -190,7 +190,7 @@ func marshalAdminContentAuditValue(value any) string {
 	return strings.TrimSpace(string(jsonValue))
 }
 
-func createAdminContentAuditLog( // NOSONAR
+func (s *Service) createAdminContentAuditLog( // NOSONAR
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	action string,
@@ -223,7 +223,7 @@ func createAdminContentAuditLog( // NOSONAR
 		CreatedAt:   time.Now().UTC(),
 	}
 
-	if err := adminAuditLogRepo.Create(ctx, record); err != nil {
+	if err := s.adminAuditLogs.Create(ctx, record); err != nil {
 		return apperrors.Internal("failed to persist admin audit log", err)
 	}
 
@@ -13,6 +13,7 @@ import (
 )
 
 type adminContentStubRepository struct {
+	listAllPosts          func(context.Context, domain.AdminContentPostFilter) ([]domain.AdminContentPostRecord, error)
 	findPostByLocaleAndID func(context.Context, string, string) (*domain.AdminContentPostRecord, error)
 	listPostGroups        func(context.Context, domain.AdminContentPostFilter) (*domain.AdminContentPostListResult, error)
 	listPostRevisions     func(context.Context, string, string, int, int) (*domain.AdminContentPostRevisionListResult, error)
@@ -31,6 +32,9 @@ type adminContentStubRepository struct {
 	updatePostContent           func(context.Context, string, string, string, *domain.AdminContentPostRevisionStamp, time.Time) (*domain.AdminContentPostRecord, error)
 	restorePostRevision         func(context.Context, domain.AdminContentPostRevisionRecord, *domain.AdminContentPostRevisionStamp, time.Time) (*domain.AdminContentPostRecord, error)
 	deletePostByLocaleAndID     func(context.Context, string, string) (bool, error)
+	renamePost                  func(context.Context, string, string, time.Time) (*domain.AdminContentPostRenameResult, error)
+	listDueScheduledPosts       func(context.Context, time.Time, int) ([]domain.AdminContentPostRecord, error)
+	publishScheduledPost        func(context.Context, string, string, time.Time, *domain.AdminContentPostRevisionStamp, time.Time) (*domain.AdminContentPostRecord, error)
 	listTopics                  func(context.Context, string, string) ([]domain.AdminContentTopicRecord, error)
 	listTopicGroups             func(context.Context, domain.AdminContentTaxonomyFilter) (*domain.AdminContentTopicListResult, error)
 	findTopicByLocaleAndID      func(context.Context, string, string) (*domain.AdminContentTopicRecord, error)
@@ -38,6 +42,10 @@ type adminContentStubRepository struct {
 	deleteTopicByLocaleAndID    func(context.Context, string, string) (bool, error)
 	syncTopicOnPosts            func(context.Context, domain.AdminContentTopicRecord, time.Time) error
 	removeTopicFromPosts        func(context.Context, string, string, time.Time) error
+	listSeriesGroups            func(context.Context, domain.AdminContentTaxonomyFilter) (*domain.AdminContentSeriesListResult, error)
+	findSeriesByLocaleAndID     func(context.Context, string, string) (*domain.AdminContentSeriesRecord, error)
+	upsertSeries                func(context.Context, domain.AdminContentSeriesRecord, time.Time) (*domain.AdminContentSeriesRecord, error)
+	deleteSeriesByLocaleAndID   func(context.Context, string, string) (bool, error)
 	listCategories              func(context.Context, string) ([]domain.AdminContentCategoryRecord, error)
 	listCategoryGroups          func(context.Context, domain.AdminContentTaxonomyFilter) (*domain.AdminContentCategoryListResult, error)
 	findCategoryByLocaleAndID   func(context.Context, string, string) (*domain.AdminContentCategoryRecord, error)
@@ -45,14 +53,21 @@ type adminContentStubRepository struct {
 	deleteCategoryByLocaleAndID func(context.Context, string, string) (bool, error)
 	syncCategoryOnPosts         func(context.Context, domain.AdminContentCategoryRecord, time.Time) error
 	clearCategoryFromPosts      func(context.Context, string, string, time.Time) error
+	applyTaxonomyRewrite        func(context.Context, domain.AdminContentTaxonomyRewritePlan, time.Time) error
 }
 
 func intPtr(value int) *int {
 	return &value
 }
 
-func (adminContentStubRepository) ListAllPosts(context.Context, domain.AdminContentPostFilter) ([]domain.AdminContentPostRecord, error) {
-	return nil, nil
+func (stub adminContentStubRepository) ListAllPosts(
+	ctx context.Context,
+	filter domain.AdminContentPostFilter,
+) ([]domain.AdminContentPostRecord, error) {
+	if stub.listAllPosts == nil {
+		return nil, nil
+	}
+	return stub.listAllPosts(ctx, filter)
 }
 
 func (stub adminContentStubRepository) FindPostByLocaleAndID(
@@ -168,6 +183,82 @@ func (stub adminContentStubRepository) DeletePostByLocaleAndID(ctx context.Conte
 	return stub.deletePostByLocaleAndID(ctx, locale, postID)
 }
 
+func (stub adminContentStubRepository) RenamePost(
+	ctx context.Context,
+	sourceID string,
+	targetID string,
+	now time.Time,
+) (*domain.AdminContentPostRenameResult, error) {
+	if stub.renamePost == nil {
+		return nil, nil
+	}
+	return stub.renamePost(ctx, sourceID, targetID, now)
+}
+
+func (stub adminContentStubRepository) ListDueScheduledPosts(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]domain.AdminContentPostRecord, error) {
+	if stub.listDueScheduledPosts == nil {
+		return nil, nil
+	}
+	return stub.listDueScheduledPosts(ctx, now, limit)
+}
+
+func (stub adminContentStubRepository) PublishScheduledPost(
+	ctx context.Context,
+	locale string,
+	postID string,
+	publishedAt time.Time,
+	revisionStamp *domain.AdminContentPostRevisionStamp,
+	now time.Time,
+) (*domain.AdminContentPostRecord, error) {
+	if stub.publishScheduledPost == nil {
+		return nil, nil
+	}
+	return stub.publishScheduledPost(ctx, locale, postID, publishedAt, revisionStamp, now)
+}
+
+func (stub adminContentStubRepository) ListSeriesGroups(
+	ctx context.Context,
+	filter domain.AdminContentTaxonomyFilter,
+) (*domain.AdminContentSeriesListResult, error) {
+	if stub.listSeriesGroups == nil {
+		return nil, nil
+	}
+	return stub.listSeriesGroups(ctx, filter)
+}
+
+func (stub adminContentStubRepository) FindSeriesByLocaleAndID(
+	ctx context.Context,
+	locale string,
+	seriesID string,
+) (*domain.AdminContentSeriesRecord, error) {
+	if stub.findSeriesByLocaleAndID == nil {
+		return nil, nil
+	}
+	return stub.findSeriesByLocaleAndID(ctx, locale, seriesID)
+}
+
+func (stub adminContentStubRepository) UpsertSeries(
+	ctx context.Context,
+	record domain.AdminContentSeriesRecord,
+	now time.Time,
+) (*domain.AdminContentSeriesRecord, error) {
+	if stub.upsertSeries == nil {
+		return nil, nil
+	}
+	return stub.upsertSeries(ctx, record, now)
+}
+
+func (stub adminContentStubRepository) DeleteSeriesByLocaleAndID(ctx context.Context, locale, seriesID string) (bool, error) {
+	if stub.deleteSeriesByLocaleAndID == nil {
+		return false, nil
+	}
+	return stub.deleteSeriesByLocaleAndID(ctx, locale, seriesID)
+}
+
 func (stub adminContentStubRepository) ListTopics(
 	ctx context.Context,
 	locale string,
@@ -325,6 +416,17 @@ func (stub adminContentStubRepository) ClearCategoryFromPosts(
 	return stub.clearCategoryFromPosts(ctx, locale, categoryID, now)
 }
 
+func (stub adminContentStubRepository) ApplyTaxonomyRewrite(
+	ctx context.Context,
+	plan domain.AdminContentTaxonomyRewritePlan,
+	now time.Time,
+) error {
+	if stub.applyTaxonomyRewrite == nil {
+		return nil
+	}
+	return stub.applyTaxonomyRewrite(ctx, plan, now)
+}
+
 func TestListAdminContentFunctionsHandleNilResultsAndDefaults(t *testing.T) {
 	previousAdminContentRepository := adminContentRepository
 	t.Cleanup(func() {
@@ -376,7 +478,7 @@ func TestListAdminContentFunctionsHandleNilResultsAndDefaults(t *testing.T) {
 		},
 	}
 
-	posts, err := ListAdminContentPosts(context.Background(), &domain.AdminUser{ID: "admin-1"}, domain.AdminContentPostFilter{
+	posts, err := testService().ListAdminContentPosts(context.Background(), &domain.AdminUser{ID: "admin-1"}, domain.AdminContentPostFilter{
 		Locale:          "all",
 		PreferredLocale: "",
 		Source:          "all",
@@ -393,7 +495,7 @@ func TestListAdminContentFunctionsHandleNilResultsAndDefaults(t *testing.T) {
 		t.Fatalf("unexpected posts result: %#v", posts)
 	}
 
-	topics, err := ListAdminContentTopics(context.Background(), &domain.AdminUser{ID: "admin-1"}, "all", " alpha ")
+	topics, err := testService().ListAdminContentTopics(context.Background(), &domain.AdminUser{ID: "admin-1"}, "all", " alpha ")
 	if err != nil {
 		t.Fatalf("ListAdminContentTopics returned error: %v", err)
 	}
@@ -401,7 +503,7 @@ func TestListAdminContentFunctionsHandleNilResultsAndDefaults(t *testing.T) {
 		t.Fatalf("expected empty topics, got %#v", topics)
 	}
 
-	topicsPage, err := ListAdminContentTopicsPage(context.Background(), &domain.AdminUser{ID: "admin-1"}, domain.AdminContentTaxonomyFilter{})
+	topicsPage, err := testService().ListAdminContentTopicsPage(context.Background(), &domain.AdminUser{ID: "admin-1"}, domain.AdminContentTaxonomyFilter{})
 	if err != nil {
 		t.Fatalf("ListAdminContentTopicsPage returned error: %v", err)
 	}
@@ -409,7 +511,7 @@ func TestListAdminContentFunctionsHandleNilResultsAndDefaults(t *testing.T) {
 		t.Fatalf("unexpected topics page result: %#v", topicsPage)
 	}
 
-	categories, err := ListAdminContentCategories(context.Background(), &domain.AdminUser{ID: "admin-1"}, "all")
+	categories, err := testService().ListAdminContentCategories(context.Background(), &domain.AdminUser{ID: "admin-1"}, "all")
 	if err != nil {
 		t.Fatalf("ListAdminContentCategories returned error: %v", err)
 	}
@@ -417,7 +519,7 @@ func TestListAdminContentFunctionsHandleNilResultsAndDefaults(t *testing.T) {
 		t.Fatalf("expected empty categories, got %#v", categories)
 	}
 
-	categoriesPage, err := ListAdminContentCategoriesPage(context.Background(), &domain.AdminUser{ID: "admin-1"}, domain.AdminContentTaxonomyFilter{
+	categoriesPage, err := testService().ListAdminContentCategoriesPage(context.Background(), &domain.AdminUser{ID: "admin-1"}, domain.AdminContentTaxonomyFilter{
 		PreferredLocale: "tr",
 	})
 	if err != nil {
@@ -495,11 +597,11 @@ func TestAdminContentOperationsRejectInvalidStates(t *testing.T) {
 
 	adminUser := &domain.AdminUser{ID: "admin-1"}
 
-	if _, err := GetAdminContentPost(context.Background(), adminUser, "en", "alpha-post"); err == nil || !strings.Contains(err.Error(), "content post not found") {
+	if _, err := testService().GetAdminContentPost(context.Background(), adminUser, "en", "alpha-post"); err == nil || !strings.Contains(err.Error(), "content post not found") {
 		t.Fatalf("expected missing post error, got %v", err)
 	}
 
-	if _, err := UpdateAdminContentPostContent(context.Background(), adminUser, domain.AdminContentPostContentInput{
+	if _, err := testService().UpdateAdminContentPostContent(context.Background(), adminUser, domain.AdminContentPostContentInput{
 		Locale:  "en",
 		ID:      "medium-post",
 		Content: "updated",
@@ -507,11 +609,11 @@ func TestAdminContentOperationsRejectInvalidStates(t *testing.T) {
 		t.Fatalf("expected non-blog update rejection, got %v", err)
 	}
 
-	if err := DeleteAdminContentPost(context.Background(), adminUser, "en", "alpha-post"); err == nil || !strings.Contains(err.Error(), "content post not found") {
+	if err := testService().DeleteAdminContentPost(context.Background(), adminUser, "en", "alpha-post"); err == nil || !strings.Contains(err.Error(), "content post not found") {
 		t.Fatalf("expected delete missing post error, got %v", err)
 	}
 
-	if _, err := CreateAdminContentTopic(context.Background(), adminUser, domain.AdminContentTopicInput{
+	if _, err := testService().CreateAdminContentTopic(context.Background(), adminUser, domain.AdminContentTopicInput{
 		Locale: "en",
 		ID:     "existing-topic",
 		Name:   "Existing",
@@ -520,7 +622,7 @@ func TestAdminContentOperationsRejectInvalidStates(t *testing.T) {
 		t.Fatalf("expected existing topic error, got %v", err)
 	}
 
-	if _, err := UpdateAdminContentTopic(context.Background(), adminUser, domain.AdminContentTopicInput{
+	if _, err := testService().UpdateAdminContentTopic(context.Background(), adminUser, domain.AdminContentTopicInput{
 		Locale: "en",
 		ID:     "missing-topic",
 		Name:   "Missing",
@@ -529,11 +631,11 @@ func TestAdminContentOperationsRejectInvalidStates(t *testing.T) {
 		t.Fatalf("expected missing topic update error, got %v", err)
 	}
 
-	if err := DeleteAdminContentTopic(context.Background(), adminUser, "en", "existing-topic"); err == nil || !strings.Contains(err.Error(), "content topic not found") {
+	if err := testService().DeleteAdminContentTopic(context.Background(), adminUser, "en", "existing-topic"); err == nil || !strings.Contains(err.Error(), "content topic not found") {
 		t.Fatalf("expected missing topic delete error, got %v", err)
 	}
 
-	if _, err := CreateAdminContentCategory(context.Background(), adminUser, domain.AdminContentCategoryInput{
+	if _, err := testService().CreateAdminContentCategory(context.Background(), adminUser, domain.AdminContentCategoryInput{
 		Locale: "en",
 		ID:     "existing-category",
 		Name:   "Existing",
@@ -542,7 +644,7 @@ func TestAdminContentOperationsRejectInvalidStates(t *testing.T) {
 		t.Fatalf("expected existing category error, got %v", err)
 	}
 
-	if _, err := UpdateAdminContentCategory(context.Background(), adminUser, domain.AdminContentCategoryInput{
+	if _, err := testService().UpdateAdminContentCategory(context.Background(), adminUser, domain.AdminContentCategoryInput{
 		Locale: "en",
 		ID:     "missing-category",
 		Name:   "Missing",
@@ -551,11 +653,11 @@ func TestAdminContentOperationsRejectInvalidStates(t *testing.T) {
 		t.Fatalf("expected missing category update error, got %v", err)
 	}
 
-	if err := DeleteAdminContentCategory(context.Background(), adminUser, "en", "existing-category"); err == nil || !strings.Contains(err.Error(), "content category not found") {
+	if err := testService().DeleteAdminContentCategory(context.Background(), adminUser, "en", "existing-category"); err == nil || !strings.Contains(err.Error(), "content category not found") {
 		t.Fatalf("expected missing category delete error, got %v", err)
 	}
 
-	if _, err := ListAdminContentPosts(context.Background(), nil, domain.AdminContentPostFilter{}); err == nil || !strings.Contains(err.Error(), "admin authentication required") {
+	if _, err := testService().ListAdminContentPosts(context.Background(), nil, domain.AdminContentPostFilter{}); err == nil || !strings.Contains(err.Error(), "admin authentication required") {
 		t.Fatalf("expected list auth error, got %v", err)
 	}
 }
@@ -568,103 +670,103 @@ func TestAdminContentOperationsRequireAdminUser(t *testing.T) {
 		{
 			name: "ListAdminContentPosts",
 			run: func() error {
-				_, err := ListAdminContentPosts(context.Background(), nil, domain.AdminContentPostFilter{})
+				_, err := testService().ListAdminContentPosts(context.Background(), nil, domain.AdminContentPostFilter{})
 				return err
 			},
 		},
 		{
 			name: "ListAdminContentTopics",
 			run: func() error {
-				_, err := ListAdminContentTopics(context.Background(), nil, "en", "")
+				_, err := testService().ListAdminContentTopics(context.Background(), nil, "en", "")
 				return err
 			},
 		},
 		{
 			name: "ListAdminContentTopicsPage",
 			run: func() error {
-				_, err := ListAdminContentTopicsPage(context.Background(), nil, domain.AdminContentTaxonomyFilter{})
+				_, err := testService().ListAdminContentTopicsPage(context.Background(), nil, domain.AdminContentTaxonomyFilter{})
 				return err
 			},
 		},
 		{
 			name: "ListAdminContentCategories",
 			run: func() error {
-				_, err := ListAdminContentCategories(context.Background(), nil, "en")
+				_, err := testService().ListAdminContentCategories(context.Background(), nil, "en")
 				return err
 			},
 		},
 		{
 			name: "ListAdminContentCategoriesPage",
 			run: func() error {
-				_, err := ListAdminContentCategoriesPage(context.Background(), nil, domain.AdminContentTaxonomyFilter{})
+				_, err := testService().ListAdminContentCategoriesPage(context.Background(), nil, domain.AdminContentTaxonomyFilter{})
 				return err
 			},
 		},
 		{
 			name: "UpdateAdminContentPostMetadata",
 			run: func() error {
-				_, err := UpdateAdminContentPostMetadata(context.Background(), nil, domain.AdminContentPostMetadataInput{})
+				_, err := testService().UpdateAdminContentPostMetadata(context.Background(), nil, domain.AdminContentPostMetadataInput{})
 				return err
 			},
 		},
 		{
 			name: "GetAdminContentPost",
 			run: func() error {
-				_, err := GetAdminContentPost(context.Background(), nil, "en", "alpha-post")
+				_, err := testService().GetAdminContentPost(context.Background(), nil, "en", "alpha-post")
 				return err
 			},
 		},
 		{
 			name: "UpdateAdminContentPostContent",
 			run: func() error {
-				_, err := UpdateAdminContentPostContent(context.Background(), nil, domain.AdminContentPostContentInput{})
+				_, err := testService().UpdateAdminContentPostContent(context.Background(), nil, domain.AdminContentPostContentInput{})
 				return err
 			},
 		},
 		{
 			name: "DeleteAdminContentPost",
 			run: func() error {
-				return DeleteAdminContentPost(context.Background(), nil, "en", "alpha-post")
+				return testService().DeleteAdminContentPost(context.Background(), nil, "en", "alpha-post")
 			},
 		},
 		{
 			name: "CreateAdminContentTopic",
 			run: func() error {
-				_, err := CreateAdminContentTopic(context.Background(), nil, domain.AdminContentTopicInput{})
+				_, err := testService().CreateAdminContentTopic(context.Background(), nil, domain.AdminContentTopicInput{})
 				return err
 			},
 		},
 		{
 			name: "UpdateAdminContentTopic",
 			run: func() error {
-				_, err := UpdateAdminContentTopic(context.Background(), nil, domain.AdminContentTopicInput{})
+				_, err := testService().UpdateAdminContentTopic(context.Background(), nil, domain.AdminContentTopicInput{})
 				return err
 			},
 		},
 		{
 			name: "DeleteAdminContentTopic",
 			run: func() error {
-				return DeleteAdminContentTopic(context.Background(), nil, "en", "alpha-topic")
+				return testService().DeleteAdminContentTopic(context.Background(), nil, "en", "alpha-topic")
 			},
 		},
 		{
 			name: "CreateAdminContentCategory",
 			run: func() error {
-				_, err := CreateAdminContentCategory(context.Background(), nil, domain.AdminContentCategoryInput{})
+				_, err := testService().CreateAdminContentCategory(context.Background(), nil, domain.AdminContentCategoryInput{})
 				return err
 			},
 		},
 		{
 			name: "UpdateAdminContentCategory",
 			run: func() error {
-				_, err := UpdateAdminContentCategory(context.Background(), nil, domain.AdminContentCategoryInput{})
+				_, err := testService().UpdateAdminContentCategory(context.Background(), nil, domain.AdminContentCategoryInput{})
 				return err
 			},
 		},
 		{
 			name: "DeleteAdminContentCategory",
 			run: func() error {
-				return DeleteAdminContentCategory(context.Background(), nil, "en", "alpha-category")
+				return testService().DeleteAdminContentCategory(context.Background(), nil, "en", "alpha-category")
 			},
 		},
 	}
@@ -802,7 +904,7 @@ func TestCreateAdminContentAuditLogIncludesTraceMetadata(t *testing.T) {
 		UserAgent:   "browser",
 	})
 
-	if err := createAdminContentAuditLog(
+	if err := testService().createAdminContentAuditLog(
 		ctx,
 		&domain.AdminUser{ID: "admin-1", Email: "admin@example.com"},
 		"content_post_updated",
@@ -827,7 +929,7 @@ func TestCreateAdminContentAuditLogIncludesTraceMetadata(t *testing.T) {
 }
 
 func TestCreateAdminContentAuditLogRequiresAdminUser(t *testing.T) {
-	if err := createAdminContentAuditLog(context.Background(), nil, "action", "scope", "en", "alpha", "", ""); err == nil || !strings.Contains(err.Error(), "admin authentication required") {
+	if err := testService().createAdminContentAuditLog(context.Background(), nil, "action", "scope", "en", "alpha", "", ""); err == nil || !strings.Contains(err.Error(), "admin authentication required") {
 		t.Fatalf("expected auth error, got %v", err)
 	}
 }
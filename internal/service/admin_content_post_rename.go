@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+)
+
+func (s *Service) RenameAdminContentPost(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	input domain.AdminContentPostRenameInput,
+) (*domain.AdminContentPostRenameResult, error) {
+	if adminUser == nil || strings.TrimSpace(adminUser.ID) == "" {
+		return nil, apperrors.Unauthorized(adminContentAuthRequired)
+	}
+
+	sourceID, err := normalizeAdminContentID(input.SourceID, "source "+adminContentPostIDField)
+	if err != nil {
+		return nil, err
+	}
+	targetID, err := normalizeAdminContentID(input.TargetID, "target "+adminContentPostIDField)
+	if err != nil {
+		return nil, err
+	}
+	if sourceID == targetID {
+		return nil, apperrors.BadRequest("target post id must differ from the source")
+	}
+
+	sourceFound := false
+	for _, locale := range adminContentTaxonomyLocales {
+		target, err := s.adminContent.FindPostByLocaleAndID(ctx, locale, targetID)
+		if err != nil {
+			return nil, toAdminContentError(err, adminContentLoadPostFailed)
+		}
+		if target != nil {
+			return nil, apperrors.BadRequest("content post already exists: " + targetID)
+		}
+
+		source, err := s.adminContent.FindPostByLocaleAndID(ctx, locale, sourceID)
+		if err != nil {
+			return nil, toAdminContentError(err, adminContentLoadPostFailed)
+		}
+		sourceFound = sourceFound || source != nil
+	}
+	if !sourceFound {
+		return nil, apperrors.BadRequest(adminContentPostNotFound)
+	}
+
+	// An alias still redirecting to another post would silently hijack its old links.
+	aliasTarget, err := s.posts.FindPostIDAlias(ctx, targetID)
+	if err != nil {
+		return nil, toAdminContentError(err, "failed to load post id alias")
+	}
+	if aliasTarget != "" && aliasTarget != sourceID {
+		return nil, apperrors.BadRequest("post id is reserved as an alias of " + aliasTarget)
+	}
+
+	result, err := s.adminContent.RenamePost(ctx, sourceID, targetID, time.Now().UTC())
+	if err != nil {
+		return nil, toAdminContentError(err, "failed to rename content post")
+	}
+	if result == nil {
+		return nil, apperrors.Internal("failed to rename content post", nil)
+	}
+
+	if err := s.createAdminContentAuditLog(
+		ctx,
+		adminUser,
+		"content_post_renamed",
+		"post",
+		"",
+		targetID,
+		sourceID,
+		marshalAdminContentAuditValue(result),
+	); err != nil {
+		return nil, err
+	}
+
+	s.refreshAdminContentRelatedPosts(ctx, adminContentTaxonomyLocales...)
+	return result, nil
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/repository"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestRenameAdminContentPostMigratesAndAudits(t *testing.T) {
+	previousAdminContentRepository := adminContentRepository
+	previousPostsRepository := postsRepository
+	previousAuditRepo := adminAuditLogRepo
+	t.Cleanup(func() {
+		adminContentRepository = previousAdminContentRepository
+		postsRepository = previousPostsRepository
+		adminAuditLogRepo = previousAuditRepo
+	})
+
+	audit := &adminErrorMessageManagementAuditStub{}
+	adminAuditLogRepo = audit
+	posts := map[string]bool{"en|old-post": true, "tr|old-post": true, "en|taken-post": true}
+	aliases := map[string]string{"older-post": "old-post", "reserved-post": "another-post"}
+
+	var renamed []string
+	adminContentRepository = adminContentStubRepository{
+		findPostByLocaleAndID: func(_ context.Context, locale, postID string) (*domain.AdminContentPostRecord, error) {
+			if !posts[locale+"|"+postID] {
+				return nil, nil
+			}
+			return &domain.AdminContentPostRecord{Locale: locale, ID: postID}, nil
+		},
+		renamePost: func(_ context.Context, sourceID, targetID string, now time.Time) (*domain.AdminContentPostRenameResult, error) {
+			if now.IsZero() {
+				t.Fatal("expected rename timestamp")
+			}
+			renamed = append(renamed, sourceID+"->"+targetID)
+			return &domain.AdminContentPostRenameResult{
+				SourceID:          sourceID,
+				TargetID:          targetID,
+				PostsUpdated:      2,
+				CommentsUpdated:   4,
+				AliasesRedirected: 1,
+			}, nil
+		},
+	}
+	var refreshedLocales []string
+	postsRepository = postStubRepository{
+		findPostIDAlias: func(_ context.Context, aliasID string) (string, error) {
+			return aliases[aliasID], nil
+		},
+		findPosts: func(context.Context, bson.M, string, int64, int64) ([]domain.PostRecord, error) {
+			return nil, nil
+		},
+		replaceRelatedPosts: func(_ context.Context, locale string, _ []domain.PostRelatedRecord) error {
+			refreshedLocales = append(refreshedLocales, locale)
+			return nil
+		},
+	}
+
+	adminUser := &domain.AdminUser{ID: "admin-1"}
+	for _, input := range []domain.AdminContentPostRenameInput{
+		{SourceID: "old-post", TargetID: "old-post"},
+		{SourceID: "old-post", TargetID: "taken-post"},
+		{SourceID: "missing-post", TargetID: "fresh-post"},
+		{SourceID: "old-post", TargetID: "reserved-post"},
+		{SourceID: "old-post", TargetID: "bad id"},
+	} {
+		var appErr *apperrors.AppError
+		if _, err := testService().RenameAdminContentPost(context.Background(), adminUser, input); !errors.As(err, &appErr) || appErr.HTTPStatus != http.StatusBadRequest {
+			t.Fatalf("expected %#v to be rejected, got %v", input, err)
+		}
+	}
+	if len(renamed) != 0 {
+		t.Fatalf("expected rejected renames to leave storage untouched: %#v", renamed)
+	}
+
+	result, err := testService().RenameAdminContentPost(context.Background(), adminUser, domain.AdminContentPostRenameInput{
+		SourceID: " Old-Post ",
+		TargetID: "older-post",
+	})
+	if err != nil || result == nil || result.PostsUpdated != 2 || result.CommentsUpdated != 4 {
+		t.Fatalf("RenameAdminContentPost() = %#v, %v", result, err)
+	}
+	if len(renamed) != 1 || renamed[0] != "old-post->older-post" {
+		t.Fatalf("unexpected rename calls: %#v", renamed)
+	}
+	if len(refreshedLocales) != 2 {
+		t.Fatalf("expected related posts to be refreshed in both locales, got %#v", refreshedLocales)
+	}
+	if len(audit.records) != 1 || audit.records[0].Action != "content_post_renamed" || audit.records[0].BeforeValue != "old-post" {
+		t.Fatalf("unexpected audit records: %#v", audit.records)
+	}
+
+	if _, err := testService().RenameAdminContentPost(context.Background(), nil, domain.AdminContentPostRenameInput{}); err == nil {
+		t.Fatal("expected unauthenticated rename to fail")
+	}
+
+	postsRepository = postStubRepository{
+		findPostIDAlias: func(context.Context, string) (string, error) {
+			return "", repository.ErrPostRepositoryUnavailable
+		},
+	}
+	var appErr *apperrors.AppError
+	if _, err := testService().RenameAdminContentPost(context.Background(), adminUser, domain.AdminContentPostRenameInput{
+		SourceID: "old-post",
+		TargetID: "fresh-post",
+	}); !errors.As(err, &appErr) || appErr.HTTPStatus != http.StatusServiceUnavailable {
+		t.Fatalf("expected service unavailable error, got %v", err)
+	}
+}
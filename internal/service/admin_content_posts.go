@@ -10,7 +10,7 @@ import (
 	"suaybsimsek.com/blog-api/pkg/apperrors"
 )
 
-func ListAdminContentPosts(
+func (s *Service) ListAdminContentPosts(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	filter domain.AdminContentPostFilter,
@@ -51,7 +51,7 @@ func ListAdminContentPosts(
 		}
 	}
 
-	result, err := adminContentRepository.ListPostGroups(ctx, domain.AdminContentPostFilter{
+	result, err := s.adminContent.ListPostGroups(ctx, domain.AdminContentPostFilter{
 		Locale:          resolvedLocale,
 		PreferredLocale: resolvedPreferredLocale,
 		Source:          resolvedSource,
@@ -73,11 +73,11 @@ func ListAdminContentPosts(
 		}, nil
 	}
 
-	populateAdminContentPostGroupAnalytics(ctx, result.Items)
+	s.populateAdminContentPostGroupAnalytics(ctx, result.Items)
 	return result, nil
 }
 
-func UpdateAdminContentPostMetadata( // NOSONAR
+func (s *Service) UpdateAdminContentPostMetadata( // NOSONAR
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	input domain.AdminContentPostMetadataInput,
@@ -95,7 +95,7 @@ func UpdateAdminContentPostMetadata( // NOSONAR
 		return nil, err
 	}
 
-	before, err := adminContentRepository.FindPostByLocaleAndID(ctx, resolvedLocale, resolvedPostID)
+	before, err := s.adminContent.FindPostByLocaleAndID(ctx, resolvedLocale, resolvedPostID)
 	if err != nil {
 		return nil, toAdminContentError(err, adminContentLoadPostFailed)
 	}
@@ -117,7 +117,7 @@ func UpdateAdminContentPostMetadata( // NOSONAR
 
 	var category *domain.AdminContentCategoryRecord
 	if resolvedCategoryID != "" {
-		category, err = adminContentRepository.FindCategoryByLocaleAndID(ctx, resolvedLocale, resolvedCategoryID)
+		category, err = s.adminContent.FindCategoryByLocaleAndID(ctx, resolvedLocale, resolvedCategoryID)
 		if err != nil {
 			return nil, toAdminContentError(err, adminContentLoadCategoryFailed)
 		}
@@ -132,7 +132,7 @@ func UpdateAdminContentPostMetadata( // NOSONAR
 	}
 	topics := make([]domain.AdminContentTopicRecord, 0, len(resolvedTopicIDs))
 	for _, topicID := range resolvedTopicIDs {
-		topic, topicErr := adminContentRepository.FindTopicByLocaleAndID(ctx, resolvedLocale, topicID)
+		topic, topicErr := s.adminContent.FindTopicByLocaleAndID(ctx, resolvedLocale, topicID)
 		if topicErr != nil {
 			return nil, toAdminContentError(topicErr, adminContentLoadTopicFailed)
 		}
@@ -145,7 +145,7 @@ func UpdateAdminContentPostMetadata( // NOSONAR
 	now := time.Now().UTC()
 	var revisionStamp *domain.AdminContentPostRevisionStamp
 	if adminContentMetadataChanged(*before, metadataFields, resolvedCategoryID, resolvedTopicIDs) {
-		savedRevision, revisionErr := adminContentRepository.CreatePostRevision(
+		savedRevision, revisionErr := s.adminContent.CreatePostRevision(
 			ctx,
 			*before,
 			before.RevisionCount+1,
@@ -160,7 +160,7 @@ func UpdateAdminContentPostMetadata( // NOSONAR
 		}
 	}
 
-	updated, err := adminContentRepository.UpdatePostMetadata(
+	updated, err := s.adminContent.UpdatePostMetadata(
 		ctx,
 		resolvedLocale,
 		resolvedPostID,
@@ -174,7 +174,7 @@ func UpdateAdminContentPostMetadata( // NOSONAR
 		return nil, toAdminContentError(err, "failed to update content post")
 	}
 
-	if err := createAdminContentAuditLog(
+	if err := s.createAdminContentAuditLog(
 		ctx,
 		adminUser,
 		"content_post_updated",
@@ -187,10 +187,18 @@ func UpdateAdminContentPostMetadata( // NOSONAR
 		return nil, err
 	}
 
-	return populateAdminContentPostAnalytics(ctx, updated), nil
+	s.refreshAdminContentRelatedPosts(ctx, resolvedLocale)
+	if isAdminContentPublishTransition(before, updated) {
+		emitAdminContentPostPublished(
+			ctx,
+			newAdminContentPostPublishedEvent(*updated, domain.AdminContentPublishTriggerManual, before.ScheduledAt),
+		)
+	}
+
+	return s.populateAdminContentPostAnalytics(ctx, updated), nil
 }
 
-func GetAdminContentPost(
+func (s *Service) GetAdminContentPost(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	locale string,
@@ -209,7 +217,7 @@ func GetAdminContentPost(
 		return nil, err
 	}
 
-	record, err := adminContentRepository.FindPostByLocaleAndID(ctx, resolvedLocale, resolvedPostID)
+	record, err := s.adminContent.FindPostByLocaleAndID(ctx, resolvedLocale, resolvedPostID)
 	if err != nil {
 		return nil, toAdminContentError(err, adminContentLoadPostFailed)
 	}
@@ -217,10 +225,10 @@ func GetAdminContentPost(
 		return nil, apperrors.BadRequest(adminContentPostNotFound)
 	}
 
-	return populateAdminContentPostAnalytics(ctx, record), nil
+	return s.populateAdminContentPostAnalytics(ctx, record), nil
 }
 
-func ListAdminContentPostRevisions(
+func (s *Service) ListAdminContentPostRevisions(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	locale string,
@@ -243,7 +251,7 @@ func ListAdminContentPostRevisions(
 
 	resolvedPage := clampPositiveInt(page, 1, 100000)
 	resolvedSize := clampPositiveInt(size, adminContentRevisionDefaultPageSize, adminContentMaxPageSize)
-	result, err := adminContentRepository.ListPostRevisions(ctx, resolvedLocale, resolvedPostID, resolvedPage, resolvedSize)
+	result, err := s.adminContent.ListPostRevisions(ctx, resolvedLocale, resolvedPostID, resolvedPage, resolvedSize)
 	if err != nil {
 		return nil, toAdminContentError(err, "failed to list content post revisions")
 	}
@@ -259,7 +267,7 @@ func ListAdminContentPostRevisions(
 	return result, nil
 }
 
-func UpdateAdminContentPostContent(
+func (s *Service) UpdateAdminContentPostContent(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	input domain.AdminContentPostContentInput,
@@ -281,7 +289,7 @@ func UpdateAdminContentPostContent(
 		return nil, err
 	}
 
-	before, err := adminContentRepository.FindPostByLocaleAndID(ctx, resolvedLocale, resolvedPostID)
+	before, err := s.adminContent.FindPostByLocaleAndID(ctx, resolvedLocale, resolvedPostID)
 	if err != nil {
 		return nil, toAdminContentError(err, adminContentLoadPostFailed)
 	}
@@ -295,7 +303,7 @@ func UpdateAdminContentPostContent(
 	now := time.Now().UTC()
 	var revisionStamp *domain.AdminContentPostRevisionStamp
 	if strings.TrimSpace(before.Content) != strings.TrimSpace(resolvedContent) {
-		savedRevision, revisionErr := adminContentRepository.CreatePostRevision(
+		savedRevision, revisionErr := s.adminContent.CreatePostRevision(
 			ctx,
 			*before,
 			before.RevisionCount+1,
@@ -310,7 +318,7 @@ func UpdateAdminContentPostContent(
 		}
 	}
 
-	updated, err := adminContentRepository.UpdatePostContent(
+	updated, err := s.adminContent.UpdatePostContent(
 		ctx,
 		resolvedLocale,
 		resolvedPostID,
@@ -322,7 +330,7 @@ func UpdateAdminContentPostContent(
 		return nil, toAdminContentError(err, "failed to update content post content")
 	}
 
-	if err := createAdminContentAuditLog(
+	if err := s.createAdminContentAuditLog(
 		ctx,
 		adminUser,
 		"content_post_content_updated",
@@ -335,10 +343,11 @@ func UpdateAdminContentPostContent(
 		return nil, err
 	}
 
-	return populateAdminContentPostAnalytics(ctx, updated), nil
+	s.refreshAdminContentRelatedPosts(ctx, resolvedLocale)
+	return s.populateAdminContentPostAnalytics(ctx, updated), nil
 }
 
-func RestoreAdminContentPostRevision(
+func (s *Service) RestoreAdminContentPostRevision(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	locale string,
@@ -362,7 +371,7 @@ func RestoreAdminContentPostRevision(
 		return nil, apperrors.BadRequest("content post revision id is required")
 	}
 
-	before, err := adminContentRepository.FindPostByLocaleAndID(ctx, resolvedLocale, resolvedPostID)
+	before, err := s.adminContent.FindPostByLocaleAndID(ctx, resolvedLocale, resolvedPostID)
 	if err != nil {
 		return nil, toAdminContentError(err, adminContentLoadPostFailed)
 	}
@@ -370,7 +379,7 @@ func RestoreAdminContentPostRevision(
 		return nil, apperrors.BadRequest(adminContentPostNotFound)
 	}
 
-	revision, err := adminContentRepository.FindPostRevisionByID(ctx, resolvedLocale, resolvedPostID, resolvedRevisionID)
+	revision, err := s.adminContent.FindPostRevisionByID(ctx, resolvedLocale, resolvedPostID, resolvedRevisionID)
 	if err != nil {
 		return nil, toAdminContentError(err, "failed to load content post revision")
 	}
@@ -379,12 +388,12 @@ func RestoreAdminContentPostRevision(
 	}
 
 	now := time.Now().UTC()
-	savedRevision, err := adminContentRepository.CreatePostRevision(ctx, *before, before.RevisionCount+1, now)
+	savedRevision, err := s.adminContent.CreatePostRevision(ctx, *before, before.RevisionCount+1, now)
 	if err != nil {
 		return nil, toAdminContentError(err, "failed to create content post revision")
 	}
 
-	updated, err := adminContentRepository.RestorePostRevision(
+	updated, err := s.adminContent.RestorePostRevision(
 		ctx,
 		*revision,
 		&domain.AdminContentPostRevisionStamp{
@@ -397,7 +406,7 @@ func RestoreAdminContentPostRevision(
 		return nil, toAdminContentError(err, "failed to restore content post revision")
 	}
 
-	if err := createAdminContentAuditLog(
+	if err := s.createAdminContentAuditLog(
 		ctx,
 		adminUser,
 		"content_post_revision_restored",
@@ -410,10 +419,11 @@ func RestoreAdminContentPostRevision(
 		return nil, err
 	}
 
-	return populateAdminContentPostAnalytics(ctx, updated), nil
+	s.refreshAdminContentRelatedPosts(ctx, resolvedLocale)
+	return s.populateAdminContentPostAnalytics(ctx, updated), nil
 }
 
-func DeleteAdminContentPost(
+func (s *Service) DeleteAdminContentPost(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	locale string,
@@ -432,7 +442,7 @@ func DeleteAdminContentPost(
 		return err
 	}
 
-	before, err := adminContentRepository.FindPostByLocaleAndID(ctx, resolvedLocale, resolvedPostID)
+	before, err := s.adminContent.FindPostByLocaleAndID(ctx, resolvedLocale, resolvedPostID)
 	if err != nil {
 		return toAdminContentError(err, adminContentLoadPostFailed)
 	}
@@ -440,7 +450,7 @@ func DeleteAdminContentPost(
 		return apperrors.BadRequest(adminContentPostNotFound)
 	}
 
-	deleted, err := adminContentRepository.DeletePostByLocaleAndID(ctx, resolvedLocale, resolvedPostID)
+	deleted, err := s.adminContent.DeletePostByLocaleAndID(ctx, resolvedLocale, resolvedPostID)
 	if err != nil {
 		return toAdminContentError(err, "failed to delete content post")
 	}
@@ -448,7 +458,7 @@ func DeleteAdminContentPost(
 		return apperrors.BadRequest(adminContentPostNotFound)
 	}
 
-	return createAdminContentAuditLog(
+	if err := s.createAdminContentAuditLog(
 		ctx,
 		adminUser,
 		"content_post_deleted",
@@ -457,10 +467,15 @@ func DeleteAdminContentPost(
 		resolvedPostID,
 		marshalAdminContentAuditValue(before),
 		"",
-	)
+	); err != nil {
+		return err
+	}
+
+	s.refreshAdminContentRelatedPosts(ctx, resolvedLocale)
+	return nil
 }
 
-func populateAdminContentPostAnalytics(
+func (s *Service) populateAdminContentPostAnalytics(
 	ctx context.Context,
 	record *domain.AdminContentPostRecord,
 ) *domain.AdminContentPostRecord {
@@ -471,20 +486,20 @@ func populateAdminContentPostAnalytics(
 	postScope := []domain.PostRecord{{ID: record.ID}}
 	postIDs := []string{record.ID}
 
-	if likesByPostID := postsRepository.ResolveLikesByPostID(ctx, postScope); likesByPostID != nil {
+	if likesByPostID := s.posts.ResolveLikesByPostID(ctx, postScope); likesByPostID != nil {
 		record.LikeCount = likesByPostID[record.ID]
 	}
-	if hitsByPostID := postsRepository.ResolveHitsByPostID(ctx, postScope); hitsByPostID != nil {
+	if hitsByPostID := s.posts.ResolveHitsByPostID(ctx, postScope); hitsByPostID != nil {
 		record.ViewCount = hitsByPostID[record.ID]
 	}
-	if commentsByPostID, err := postCommentRepository.CountApprovedByPosts(ctx, postIDs); err == nil && commentsByPostID != nil {
+	if commentsByPostID, err := s.comments.CountApprovedByPosts(ctx, postIDs); err == nil && commentsByPostID != nil {
 		record.CommentCount = commentsByPostID[record.ID]
 	}
 
 	return record
 }
 
-func populateAdminContentPostGroupAnalytics(ctx context.Context, groups []domain.AdminContentPostGroupRecord) {
+func (s *Service) populateAdminContentPostGroupAnalytics(ctx context.Context, groups []domain.AdminContentPostGroupRecord) {
 	if len(groups) == 0 {
 		return
 	}
@@ -515,9 +530,9 @@ func populateAdminContentPostGroupAnalytics(ctx context.Context, groups []domain
 		return
 	}
 
-	likesByPostID := postsRepository.ResolveLikesByPostID(ctx, postScope)
-	hitsByPostID := postsRepository.ResolveHitsByPostID(ctx, postScope)
-	commentsByPostID, _ := postCommentRepository.CountApprovedByPosts(ctx, postIDs)
+	likesByPostID := s.posts.ResolveLikesByPostID(ctx, postScope)
+	hitsByPostID := s.posts.ResolveHitsByPostID(ctx, postScope)
+	commentsByPostID, _ := s.comments.CountApprovedByPosts(ctx, postIDs)
 
 	apply := func(item *domain.AdminContentPostRecord) {
 		if item == nil {
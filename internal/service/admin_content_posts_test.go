@@ -14,11 +14,11 @@ import (
 func TestGetAdminContentPostPopulatesAnalytics(t *testing.T) {
 	previousAdminContentRepository := adminContentRepository
 	previousPostsRepository := postsRepository
-	previousCommentRepository := postCommentRepository
+	previousCommentRepository := commentRepository
 	t.Cleanup(func() {
 		adminContentRepository = previousAdminContentRepository
 		postsRepository = previousPostsRepository
-		postCommentRepository = previousCommentRepository
+		commentRepository = previousCommentRepository
 	})
 
 	adminContentRepository = adminContentStubRepository{
@@ -48,13 +48,13 @@ func TestGetAdminContentPostPopulatesAnalytics(t *testing.T) {
 		incrementPostLike: func(context.Context, string, time.Time) (int64, error) { return 0, nil },
 		incrementPostHit:  func(context.Context, string, time.Time) (int64, error) { return 0, nil },
 	}
-	postCommentRepository = postCommentStubRepository{
+	commentRepository = postCommentStubRepository{
 		countApprovedByPosts: func(_ context.Context, postIDs []string) (map[string]int64, error) {
 			return map[string]int64{postIDs[0]: 7}, nil
 		},
 	}
 
-	record, err := GetAdminContentPost(context.Background(), &domain.AdminUser{ID: "admin-1"}, "en", "alpha-post")
+	record, err := testService().GetAdminContentPost(context.Background(), &domain.AdminUser{ID: "admin-1"}, "en", "alpha-post")
 	if err != nil {
 		t.Fatalf("GetAdminContentPost returned error: %v", err)
 	}
@@ -69,11 +69,11 @@ func TestGetAdminContentPostPopulatesAnalytics(t *testing.T) {
 func TestListAdminContentPostsPopulatesAnalytics(t *testing.T) {
 	previousAdminContentRepository := adminContentRepository
 	previousPostsRepository := postsRepository
-	previousCommentRepository := postCommentRepository
+	previousCommentRepository := commentRepository
 	t.Cleanup(func() {
 		adminContentRepository = previousAdminContentRepository
 		postsRepository = previousPostsRepository
-		postCommentRepository = previousCommentRepository
+		commentRepository = previousCommentRepository
 	})
 
 	adminContentRepository = adminContentStubRepository{
@@ -113,13 +113,13 @@ func TestListAdminContentPostsPopulatesAnalytics(t *testing.T) {
 		incrementPostLike: func(context.Context, string, time.Time) (int64, error) { return 0, nil },
 		incrementPostHit:  func(context.Context, string, time.Time) (int64, error) { return 0, nil },
 	}
-	postCommentRepository = postCommentStubRepository{
+	commentRepository = postCommentStubRepository{
 		countApprovedByPosts: func(_ context.Context, postIDs []string) (map[string]int64, error) {
 			return map[string]int64{postIDs[0]: 4}, nil
 		},
 	}
 
-	result, err := ListAdminContentPosts(context.Background(), &domain.AdminUser{ID: "admin-1"}, domain.AdminContentPostFilter{})
+	result, err := testService().ListAdminContentPosts(context.Background(), &domain.AdminUser{ID: "admin-1"}, domain.AdminContentPostFilter{})
 	if err != nil {
 		t.Fatalf("ListAdminContentPosts returned error: %v", err)
 	}
@@ -136,12 +136,12 @@ func TestUpdateAdminContentPostMetadataUpdatesAndAudits(t *testing.T) {
 	previousAdminContentRepository := adminContentRepository
 	previousAuditRepo := adminAuditLogRepo
 	previousPostsRepository := postsRepository
-	previousCommentRepository := postCommentRepository
+	previousCommentRepository := commentRepository
 	t.Cleanup(func() {
 		adminContentRepository = previousAdminContentRepository
 		adminAuditLogRepo = previousAuditRepo
 		postsRepository = previousPostsRepository
-		postCommentRepository = previousCommentRepository
+		commentRepository = previousCommentRepository
 	})
 
 	audit := &adminErrorMessageManagementAuditStub{}
@@ -241,13 +241,13 @@ func TestUpdateAdminContentPostMetadataUpdatesAndAudits(t *testing.T) {
 		incrementPostLike: func(context.Context, string, time.Time) (int64, error) { return 0, nil },
 		incrementPostHit:  func(context.Context, string, time.Time) (int64, error) { return 0, nil },
 	}
-	postCommentRepository = postCommentStubRepository{
+	commentRepository = postCommentStubRepository{
 		countApprovedByPosts: func(_ context.Context, postIDs []string) (map[string]int64, error) {
 			return map[string]int64{postIDs[0]: 5}, nil
 		},
 	}
 
-	record, err := UpdateAdminContentPostMetadata(context.Background(), &domain.AdminUser{ID: "admin-1", Email: "admin@example.com"}, domain.AdminContentPostMetadataInput{
+	record, err := testService().UpdateAdminContentPostMetadata(context.Background(), &domain.AdminUser{ID: "admin-1", Email: "admin@example.com"}, domain.AdminContentPostMetadataInput{
 		Locale:        " en ",
 		ID:            " alpha-post ",
 		Title:         &title,
@@ -273,12 +273,12 @@ func TestUpdateAdminContentPostContentUpdatesAndAudits(t *testing.T) {
 	previousAdminContentRepository := adminContentRepository
 	previousAuditRepo := adminAuditLogRepo
 	previousPostsRepository := postsRepository
-	previousCommentRepository := postCommentRepository
+	previousCommentRepository := commentRepository
 	t.Cleanup(func() {
 		adminContentRepository = previousAdminContentRepository
 		adminAuditLogRepo = previousAuditRepo
 		postsRepository = previousPostsRepository
-		postCommentRepository = previousCommentRepository
+		commentRepository = previousCommentRepository
 	})
 
 	audit := &adminErrorMessageManagementAuditStub{}
@@ -322,13 +322,13 @@ func TestUpdateAdminContentPostContentUpdatesAndAudits(t *testing.T) {
 		incrementPostLike: func(context.Context, string, time.Time) (int64, error) { return 0, nil },
 		incrementPostHit:  func(context.Context, string, time.Time) (int64, error) { return 0, nil },
 	}
-	postCommentRepository = postCommentStubRepository{
+	commentRepository = postCommentStubRepository{
 		countApprovedByPosts: func(_ context.Context, postIDs []string) (map[string]int64, error) {
 			return map[string]int64{postIDs[0]: 3}, nil
 		},
 	}
 
-	record, err := UpdateAdminContentPostContent(context.Background(), &domain.AdminUser{ID: "admin-1", Email: "admin@example.com"}, domain.AdminContentPostContentInput{
+	record, err := testService().UpdateAdminContentPostContent(context.Background(), &domain.AdminUser{ID: "admin-1", Email: "admin@example.com"}, domain.AdminContentPostContentInput{
 		Locale:  "en",
 		ID:      "alpha-post",
 		Content: "  # Updated body  ",
@@ -366,7 +366,7 @@ func TestDeleteAdminContentPostDeletesAndAudits(t *testing.T) {
 		},
 	}
 
-	if err := DeleteAdminContentPost(context.Background(), &domain.AdminUser{ID: "admin-1", Email: "admin@example.com"}, "en", "alpha-post"); err != nil {
+	if err := testService().DeleteAdminContentPost(context.Background(), &domain.AdminUser{ID: "admin-1", Email: "admin@example.com"}, "en", "alpha-post"); err != nil {
 		t.Fatalf("DeleteAdminContentPost returned error: %v", err)
 	}
 	if len(audit.records) != 1 || audit.records[0].Action != "content_post_deleted" {
@@ -404,7 +404,7 @@ func TestUpdateAdminContentPostMetadataRejectsMissingRelations(t *testing.T) {
 			},
 		}
 
-		_, err := UpdateAdminContentPostMetadata(context.Background(), adminUser, domain.AdminContentPostMetadataInput{
+		_, err := testService().UpdateAdminContentPostMetadata(context.Background(), adminUser, domain.AdminContentPostMetadataInput{
 			Locale:     "en",
 			ID:         "alpha-post",
 			CategoryID: "tech",
@@ -428,7 +428,7 @@ func TestUpdateAdminContentPostMetadataRejectsMissingRelations(t *testing.T) {
 			},
 		}
 
-		_, err := UpdateAdminContentPostMetadata(context.Background(), adminUser, domain.AdminContentPostMetadataInput{
+		_, err := testService().UpdateAdminContentPostMetadata(context.Background(), adminUser, domain.AdminContentPostMetadataInput{
 			Locale:   "en",
 			ID:       "alpha-post",
 			TopicIDs: []string{"missing-topic"},
@@ -0,0 +1,292 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/repository"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpapi"
+)
+
+const (
+	adminContentSchedulerActorID    = "system:content-scheduler"
+	adminContentSchedulerRunTimeout = 45 * time.Second
+)
+
+// AdminContentPostPublishedSubscriber receives an event after a post becomes published.
+type AdminContentPostPublishedSubscriber func(context.Context, domain.AdminContentPostPublishedEvent) error
+
+type adminContentPublishedHub struct {
+	mu          sync.RWMutex
+	nextID      int
+	subscribers map[int]AdminContentPostPublishedSubscriber
+}
+
+var (
+	adminContentSchedulerActor = &domain.AdminUser{
+		ID:   adminContentSchedulerActorID,
+		Name: "Content Scheduler",
+	}
+	adminContentPublishedEvents = &adminContentPublishedHub{
+		subscribers: map[int]AdminContentPostPublishedSubscriber{
+			0: notifyNewsletterOnAdminContentPostPublished,
+		},
+		nextID: 1,
+	}
+	resolveContentSchedulerConfigFn = appconfig.ResolveContentSchedulerConfig
+	resolveNewsletterConfigFn       = appconfig.ResolveNewsletterConfig
+)
+
+// SubscribeAdminContentPostPublished registers a subscriber and returns a function that removes it.
+func SubscribeAdminContentPostPublished(subscriber AdminContentPostPublishedSubscriber) func() {
+	if subscriber == nil {
+		return func() {}
+	}
+
+	hub := adminContentPublishedEvents
+	hub.mu.Lock()
+	id := hub.nextID
+	hub.nextID++
+	hub.subscribers[id] = subscriber
+	hub.mu.Unlock()
+
+	return func() {
+		hub.mu.Lock()
+		delete(hub.subscribers, id)
+		hub.mu.Unlock()
+	}
+}
+
+func emitAdminContentPostPublished(ctx context.Context, event domain.AdminContentPostPublishedEvent) {
+	hub := adminContentPublishedEvents
+	hub.mu.RLock()
+	subscribers := make([]AdminContentPostPublishedSubscriber, 0, len(hub.subscribers))
+	for _, subscriber := range hub.subscribers {
+		subscribers = append(subscribers, subscriber)
+	}
+	hub.mu.RUnlock()
+
+	for _, subscriber := range subscribers {
+		if err := subscriber(ctx, event); err != nil {
+			httpapi.LogError(
+				ctx,
+				"content post published subscriber failed",
+				err,
+				slog.String("locale", event.Locale),
+				slog.String("postId", event.PostID),
+				slog.String("trigger", event.Trigger),
+			)
+		}
+	}
+}
+
+func newAdminContentPostPublishedEvent(record domain.AdminContentPostRecord, trigger string, scheduledAt time.Time) domain.AdminContentPostPublishedEvent {
+	return domain.AdminContentPostPublishedEvent{
+		Locale:      record.Locale,
+		PostID:      record.ID,
+		Title:       record.Title,
+		Source:      record.Source,
+		Trigger:     trigger,
+		ScheduledAt: scheduledAt,
+		PublishedAt: record.PublishedAt,
+	}
+}
+
+// notifyNewsletterOnAdminContentPostPublished dispatches the newsletter for a manual publish. Scheduled publishes are
+// batched by the scheduler run, which dispatches once for all of them.
+func notifyNewsletterOnAdminContentPostPublished(ctx context.Context, event domain.AdminContentPostPublishedEvent) error {
+	if event.Trigger == domain.AdminContentPublishTriggerSchedule {
+		return nil
+	}
+
+	return dispatchNewsletterForAdminContentPosts(ctx, []domain.AdminContentPostPublishedEvent{event})
+}
+
+// dispatchNewsletterForAdminContentPosts sends one newsletter dispatch request when any of the published posts is a
+// blog post; the dispatch endpoint itself picks up every new post.
+func dispatchNewsletterForAdminContentPosts(ctx context.Context, events []domain.AdminContentPostPublishedEvent) error {
+	if !resolveContentSchedulerConfigFn().NotifyNewsletter {
+		return nil
+	}
+	hasBlogPost := slices.ContainsFunc(events, func(event domain.AdminContentPostPublishedEvent) bool {
+		return event.Source == "" || event.Source == "blog"
+	})
+	if !hasBlogPost {
+		return nil
+	}
+
+	config, err := resolveNewsletterConfigFn()
+	if err != nil {
+		return apperrors.Config("newsletter dispatch is not configured", err)
+	}
+
+	_, err = executeAdminNewsletterDispatchRequest(ctx, config, nil)
+	return err
+}
+
+// PublishDueAdminContentPosts publishes scheduled posts whose scheduledAt is not after now.
+func (s *Service) PublishDueAdminContentPosts(ctx context.Context, now time.Time) (*domain.AdminContentScheduleRunResult, error) {
+	resolvedNow := now.UTC()
+	if now.IsZero() {
+		resolvedNow = time.Now().UTC()
+	}
+
+	config := resolveContentSchedulerConfigFn()
+	duePosts, err := s.adminContent.ListDueScheduledPosts(ctx, resolvedNow, config.BatchSize)
+	if err != nil {
+		return nil, toAdminContentError(err, "failed to load scheduled content posts")
+	}
+
+	result := &domain.AdminContentScheduleRunResult{
+		Published: make([]domain.AdminContentPostPublishedEvent, 0, len(duePosts)),
+		RanAt:     resolvedNow,
+	}
+	for _, due := range duePosts {
+		event, publishErr := s.publishDueAdminContentPost(ctx, due, resolvedNow)
+		if errors.Is(publishErr, repository.ErrAdminContentPostNotFound) {
+			continue
+		}
+		if publishErr != nil {
+			result.Failed++
+			httpapi.LogError(
+				ctx,
+				"scheduled content post publish failed",
+				publishErr,
+				slog.String("locale", due.Locale),
+				slog.String("postId", due.ID),
+			)
+			continue
+		}
+
+		result.Published = append(result.Published, *event)
+		emitAdminContentPostPublished(ctx, *event)
+	}
+
+	publishedLocales := make([]string, 0, len(result.Published))
+	for _, event := range result.Published {
+		publishedLocales = append(publishedLocales, event.Locale)
+	}
+	s.refreshAdminContentRelatedPosts(ctx, publishedLocales...)
+
+	if err := dispatchNewsletterForAdminContentPosts(ctx, result.Published); err != nil {
+		httpapi.LogError(ctx, "scheduled content newsletter dispatch failed", err, slog.Int("published", len(result.Published)))
+	}
+
+	return result, nil
+}
+
+func (s *Service) publishDueAdminContentPost(
+	ctx context.Context,
+	due domain.AdminContentPostRecord,
+	now time.Time,
+) (*domain.AdminContentPostPublishedEvent, error) {
+	// The revision is written only after the status-guarded publish succeeds, so a run that loses the race against
+	// another scheduler leaves no orphan revision behind.
+	revisionStamp := &domain.AdminContentPostRevisionStamp{
+		Number:    due.RevisionCount + 1,
+		CreatedAt: now,
+	}
+
+	publishedAt := due.ScheduledAt
+	if publishedAt.IsZero() {
+		publishedAt = now
+	}
+	updated, err := s.adminContent.PublishScheduledPost(
+		ctx,
+		due.Locale,
+		due.ID,
+		publishedAt,
+		revisionStamp,
+		now,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.adminContent.CreatePostRevision(ctx, due, revisionStamp.Number, now); err != nil {
+		httpapi.LogError(
+			ctx,
+			"scheduled content post revision failed",
+			err,
+			slog.String("locale", due.Locale),
+			slog.String("postId", due.ID),
+		)
+	}
+
+	if err := s.createAdminContentAuditLog(
+		ctx,
+		adminContentSchedulerActor,
+		"content_post_published",
+		"post",
+		due.Locale,
+		due.ID,
+		marshalAdminContentAuditValue(due),
+		marshalAdminContentAuditValue(updated),
+	); err != nil {
+		return nil, err
+	}
+
+	event := newAdminContentPostPublishedEvent(*updated, domain.AdminContentPublishTriggerSchedule, due.ScheduledAt)
+	return &event, nil
+}
+
+// StartAdminContentScheduler publishes due posts on a fixed interval until ctx is canceled.
+func (s *Service) StartAdminContentScheduler(ctx context.Context) {
+	config := resolveContentSchedulerConfigFn()
+	if !config.Enabled || config.Interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(config.Interval)
+		defer ticker.Stop()
+
+		for {
+			s.runAdminContentScheduler(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (s *Service) runAdminContentScheduler(ctx context.Context) {
+	runCtx, cancel := withTimeoutContext(ctx, adminContentSchedulerRunTimeout)
+	defer cancel()
+
+	result, err := s.PublishDueAdminContentPosts(runCtx, time.Now().UTC())
+	if err != nil {
+		if errors.Is(err, repository.ErrAdminContentRepositoryUnavailable) {
+			return
+		}
+		httpapi.LogError(runCtx, "content scheduler run failed", err)
+		return
+	}
+	if len(result.Published) > 0 || result.Failed > 0 {
+		slog.InfoContext(
+			runCtx,
+			"content scheduler run completed",
+			slog.Int("published", len(result.Published)),
+			slog.Int("failed", result.Failed),
+		)
+	}
+}
+
+func isAdminContentPublishTransition(before, after *domain.AdminContentPostRecord) bool {
+	if before == nil || after == nil {
+		return false
+	}
+	return strings.TrimSpace(before.Status) != domain.AdminContentPostStatusPublished &&
+		strings.TrimSpace(after.Status) == domain.AdminContentPostStatusPublished
+}
@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/repository"
+)
+
+func TestPublishDueAdminContentPostsPublishesAuditsAndEmits(t *testing.T) {
+	previousAdminContentRepository := adminContentRepository
+	previousAuditRepo := adminAuditLogRepo
+	previousResolveConfig := resolveContentSchedulerConfigFn
+	t.Cleanup(func() {
+		adminContentRepository = previousAdminContentRepository
+		adminAuditLogRepo = previousAuditRepo
+		resolveContentSchedulerConfigFn = previousResolveConfig
+	})
+
+	audit := &adminErrorMessageManagementAuditStub{}
+	adminAuditLogRepo = audit
+	resolveContentSchedulerConfigFn = func() appconfig.ContentSchedulerConfig {
+		return appconfig.ContentSchedulerConfig{Enabled: true, Interval: time.Minute, BatchSize: 25}
+	}
+
+	now := time.Date(2026, time.March, 20, 10, 0, 0, 0, time.UTC)
+	scheduledAt := now.Add(-5 * time.Minute)
+	revisions := 0
+	adminContentRepository = adminContentStubRepository{
+		listDueScheduledPosts: func(_ context.Context, gotNow time.Time, limit int) ([]domain.AdminContentPostRecord, error) {
+			if !gotNow.Equal(now) || limit != 25 {
+				t.Fatalf("ListDueScheduledPosts args = %v %d", gotNow, limit)
+			}
+			return []domain.AdminContentPostRecord{
+				{Locale: "en", ID: "alpha-post", Title: "Alpha", Source: "blog", Status: "scheduled", ScheduledAt: scheduledAt, RevisionCount: 2},
+				{Locale: "en", ID: "beta-post", Title: "Beta", Source: "blog", Status: "scheduled", ScheduledAt: scheduledAt},
+				{Locale: "tr", ID: "gamma-post", Title: "Gamma", Source: "blog", Status: "scheduled", ScheduledAt: scheduledAt},
+			}, nil
+		},
+		createPostRevision: func(_ context.Context, post domain.AdminContentPostRecord, revisionNumber int, createdAt time.Time) (*domain.AdminContentPostRevisionRecord, error) {
+			revisions++
+			if post.ID == "alpha-post" && revisionNumber != 3 {
+				t.Fatalf("unexpected revision number %d", revisionNumber)
+			}
+			return &domain.AdminContentPostRevisionRecord{RevisionNumber: revisionNumber, CreatedAt: createdAt}, nil
+		},
+		publishScheduledPost: func(
+			_ context.Context,
+			locale string,
+			postID string,
+			publishedAt time.Time,
+			revisionStamp *domain.AdminContentPostRevisionStamp,
+			_ time.Time,
+		) (*domain.AdminContentPostRecord, error) {
+			switch postID {
+			case "beta-post":
+				return nil, repository.ErrAdminContentPostNotFound
+			case "gamma-post":
+				return nil, errors.New("write failed")
+			}
+			if !publishedAt.Equal(scheduledAt) || revisionStamp == nil || revisionStamp.Number != 3 {
+				t.Fatalf("unexpected publish args: %v %#v", publishedAt, revisionStamp)
+			}
+			return &domain.AdminContentPostRecord{
+				Locale:      locale,
+				ID:          postID,
+				Title:       "Alpha",
+				Source:      "blog",
+				Status:      domain.AdminContentPostStatusPublished,
+				PublishedAt: publishedAt,
+			}, nil
+		},
+	}
+
+	var events []domain.AdminContentPostPublishedEvent
+	unsubscribe := SubscribeAdminContentPostPublished(func(_ context.Context, event domain.AdminContentPostPublishedEvent) error {
+		events = append(events, event)
+		return nil
+	})
+	t.Cleanup(unsubscribe)
+
+	result, err := testService().PublishDueAdminContentPosts(context.Background(), now)
+	if err != nil {
+		t.Fatalf("PublishDueAdminContentPosts returned error: %v", err)
+	}
+	if len(result.Published) != 1 || result.Published[0].PostID != "alpha-post" || result.Failed != 1 {
+		t.Fatalf("unexpected run result: %#v", result)
+	}
+	if revisions != 1 {
+		t.Fatalf("expected a revision only for the post this run published, got %d", revisions)
+	}
+	if len(audit.records) != 1 || audit.records[0].Action != "content_post_published" || audit.records[0].ActorID != adminContentSchedulerActorID {
+		t.Fatalf("unexpected audit records: %#v", audit.records)
+	}
+	if len(events) != 1 || events[0].Trigger != domain.AdminContentPublishTriggerSchedule || !events[0].PublishedAt.Equal(scheduledAt) {
+		t.Fatalf("unexpected events: %#v", events)
+	}
+
+	unsubscribe()
+	emitAdminContentPostPublished(context.Background(), events[0])
+	if len(events) != 1 {
+		t.Fatalf("expected unsubscribed handler to stop receiving events, got %d", len(events))
+	}
+}
+
+func TestPublishDueAdminContentPostsDispatchesNewsletterOncePerRun(t *testing.T) {
+	previousAdminContentRepository := adminContentRepository
+	previousAuditRepo := adminAuditLogRepo
+	previousResolveConfig := resolveContentSchedulerConfigFn
+	previousResolveNewsletterConfig := resolveNewsletterConfigFn
+	previousClient := adminNewsletterDispatchClient
+	t.Cleanup(func() {
+		adminContentRepository = previousAdminContentRepository
+		adminAuditLogRepo = previousAuditRepo
+		resolveContentSchedulerConfigFn = previousResolveConfig
+		resolveNewsletterConfigFn = previousResolveNewsletterConfig
+		adminNewsletterDispatchClient = previousClient
+	})
+
+	dispatches := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		dispatches++
+		_, _ = w.Write([]byte(`{"status":"success","message":"completed","timestamp":"2026-03-20T10:00:00Z"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	adminNewsletterDispatchClient = server.Client()
+	adminAuditLogRepo = &adminErrorMessageManagementAuditStub{}
+	resolveContentSchedulerConfigFn = func() appconfig.ContentSchedulerConfig {
+		return appconfig.ContentSchedulerConfig{Enabled: true, Interval: time.Minute, BatchSize: 25, NotifyNewsletter: true}
+	}
+	resolveNewsletterConfigFn = func() (appconfig.NewsletterConfig, error) {
+		return appconfig.NewsletterConfig{SiteURL: server.URL, CronSecret: "cron-secret"}, nil
+	}
+
+	now := time.Date(2026, time.March, 20, 10, 0, 0, 0, time.UTC)
+	adminContentRepository = adminContentStubRepository{
+		listDueScheduledPosts: func(context.Context, time.Time, int) ([]domain.AdminContentPostRecord, error) {
+			return []domain.AdminContentPostRecord{
+				{Locale: "en", ID: "alpha-post", Source: "blog", Status: "scheduled", ScheduledAt: now},
+				{Locale: "tr", ID: "alpha-post", Source: "blog", Status: "scheduled", ScheduledAt: now},
+			}, nil
+		},
+		createPostRevision: func(_ context.Context, _ domain.AdminContentPostRecord, revisionNumber int, createdAt time.Time) (*domain.AdminContentPostRevisionRecord, error) {
+			return &domain.AdminContentPostRevisionRecord{RevisionNumber: revisionNumber, CreatedAt: createdAt}, nil
+		},
+		publishScheduledPost: func(
+			_ context.Context,
+			locale string,
+			postID string,
+			publishedAt time.Time,
+			_ *domain.AdminContentPostRevisionStamp,
+			_ time.Time,
+		) (*domain.AdminContentPostRecord, error) {
+			return &domain.AdminContentPostRecord{
+				Locale:      locale,
+				ID:          postID,
+				Source:      "blog",
+				Status:      domain.AdminContentPostStatusPublished,
+				PublishedAt: publishedAt,
+			}, nil
+		},
+	}
+
+	result, err := testService().PublishDueAdminContentPosts(context.Background(), now)
+	if err != nil || len(result.Published) != 2 {
+		t.Fatalf("PublishDueAdminContentPosts() = %#v, %v", result, err)
+	}
+	if dispatches != 1 {
+		t.Fatalf("expected one newsletter dispatch per run, got %d", dispatches)
+	}
+}
+
+func TestPublishDueAdminContentPostsMapsRepositoryErrors(t *testing.T) {
+	previousAdminContentRepository := adminContentRepository
+	t.Cleanup(func() {
+		adminContentRepository = previousAdminContentRepository
+	})
+
+	adminContentRepository = adminContentStubRepository{
+		listDueScheduledPosts: func(context.Context, time.Time, int) ([]domain.AdminContentPostRecord, error) {
+			return nil, repository.ErrAdminContentRepositoryUnavailable
+		},
+	}
+
+	if _, err := testService().PublishDueAdminContentPosts(context.Background(), time.Now()); !errors.Is(err, repository.ErrAdminContentRepositoryUnavailable) {
+		t.Fatalf("PublishDueAdminContentPosts error = %v", err)
+	}
+}
+
+func TestIsAdminContentPublishTransition(t *testing.T) {
+	scheduled := &domain.AdminContentPostRecord{Status: domain.AdminContentPostStatusScheduled}
+	published := &domain.AdminContentPostRecord{Status: domain.AdminContentPostStatusPublished}
+
+	if !isAdminContentPublishTransition(scheduled, published) {
+		t.Fatal("expected scheduled to published to be a transition")
+	}
+	if isAdminContentPublishTransition(published, published) {
+		t.Fatal("expected published to published not to be a transition")
+	}
+	if isAdminContentPublishTransition(nil, published) {
+		t.Fatal("expected nil before not to be a transition")
+	}
+}
@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+)
+
+const (
+	adminContentSeriesIDField        = "series id"
+	adminContentSeriesNotFound       = "content series not found"
+	adminContentLoadSeriesFailed     = "failed to load content series"
+	adminContentSeriesMaxPosts       = 200
+	adminContentSeriesMaxDescription = 2000
+)
+
+func (s *Service) ListAdminContentSeriesPage(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	filter domain.AdminContentTaxonomyFilter,
+) (*domain.AdminContentSeriesListResult, error) {
+	if adminUser == nil || strings.TrimSpace(adminUser.ID) == "" {
+		return nil, apperrors.Unauthorized(adminContentAuthRequired)
+	}
+
+	page := clampPositiveInt(filter.Page, 1, 100000)
+	size := clampPositiveInt(filter.Size, adminContentDefaultPageSize, adminContentMaxPageSize)
+	resolvedLocale, err := normalizeAdminContentLocale(filter.Locale, true)
+	if err != nil {
+		return nil, err
+	}
+	resolvedPreferredLocale, err := normalizeAdminContentLocale(filter.PreferredLocale, true)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.adminContent.ListSeriesGroups(
+		ctx,
+		domain.AdminContentTaxonomyFilter{
+			Locale:          resolvedLocale,
+			PreferredLocale: resolvedPreferredLocale,
+			Query:           strings.TrimSpace(filter.Query),
+			Page:            &page,
+			Size:            &size,
+		},
+	)
+	if err != nil {
+		return nil, toAdminContentError(err, "failed to list content series")
+	}
+	if result == nil {
+		return &domain.AdminContentSeriesListResult{
+			Items: []domain.AdminContentSeriesGroupRecord{},
+			Total: 0,
+			Page:  1,
+			Size:  size,
+		}, nil
+	}
+
+	return result, nil
+}
+
+func (s *Service) CreateAdminContentSeries(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	input domain.AdminContentSeriesInput,
+) (*domain.AdminContentSeriesRecord, error) {
+	if adminUser == nil || strings.TrimSpace(adminUser.ID) == "" {
+		return nil, apperrors.Unauthorized(adminContentAuthRequired)
+	}
+
+	record, err := normalizeAdminContentSeriesInput(input)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.adminContent.FindSeriesByLocaleAndID(ctx, record.Locale, record.ID)
+	if err != nil {
+		return nil, toAdminContentError(err, adminContentLoadSeriesFailed)
+	}
+	if existing != nil {
+		return nil, apperrors.BadRequest("content series already exists")
+	}
+	if err := s.ensureAdminContentSeriesPostsExist(ctx, record); err != nil {
+		return nil, err
+	}
+
+	saved, err := s.adminContent.UpsertSeries(ctx, record, time.Now().UTC())
+	if err != nil {
+		return nil, toAdminContentError(err, "failed to create content series")
+	}
+	if saved == nil {
+		return nil, apperrors.Internal("failed to create content series", nil)
+	}
+
+	if err := s.createAdminContentAuditLog(
+		ctx,
+		adminUser,
+		"content_series_created",
+		"series",
+		saved.Locale,
+		saved.ID,
+		"",
+		marshalAdminContentAuditValue(saved),
+	); err != nil {
+		return nil, err
+	}
+
+	return saved, nil
+}
+
+func (s *Service) UpdateAdminContentSeries(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	input domain.AdminContentSeriesInput,
+) (*domain.AdminContentSeriesRecord, error) {
+	if adminUser == nil || strings.TrimSpace(adminUser.ID) == "" {
+		return nil, apperrors.Unauthorized(adminContentAuthRequired)
+	}
+
+	record, err := normalizeAdminContentSeriesInput(input)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.adminContent.FindSeriesByLocaleAndID(ctx, record.Locale, record.ID)
+	if err != nil {
+		return nil, toAdminContentError(err, adminContentLoadSeriesFailed)
+	}
+	if existing == nil {
+		return nil, apperrors.BadRequest(adminContentSeriesNotFound)
+	}
+	if err := s.ensureAdminContentSeriesPostsExist(ctx, record); err != nil {
+		return nil, err
+	}
+
+	saved, err := s.adminContent.UpsertSeries(ctx, record, time.Now().UTC())
+	if err != nil {
+		return nil, toAdminContentError(err, "failed to update content series")
+	}
+	if saved == nil {
+		return nil, apperrors.Internal("failed to update content series", nil)
+	}
+
+	if err := s.createAdminContentAuditLog(
+		ctx,
+		adminUser,
+		"content_series_updated",
+		"series",
+		saved.Locale,
+		saved.ID,
+		marshalAdminContentAuditValue(existing),
+		marshalAdminContentAuditValue(saved),
+	); err != nil {
+		return nil, err
+	}
+
+	return saved, nil
+}
+
+func (s *Service) DeleteAdminContentSeries(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	locale string,
+	seriesID string,
+) error {
+	if adminUser == nil || strings.TrimSpace(adminUser.ID) == "" {
+		return apperrors.Unauthorized(adminContentAuthRequired)
+	}
+
+	resolvedLocale, err := normalizeAdminContentLocale(locale, false)
+	if err != nil {
+		return err
+	}
+	resolvedSeriesID, err := normalizeAdminContentID(seriesID, adminContentSeriesIDField)
+	if err != nil {
+		return err
+	}
+
+	existing, err := s.adminContent.FindSeriesByLocaleAndID(ctx, resolvedLocale, resolvedSeriesID)
+	if err != nil {
+		return toAdminContentError(err, adminContentLoadSeriesFailed)
+	}
+	if existing == nil {
+		return apperrors.BadRequest(adminContentSeriesNotFound)
+	}
+
+	deleted, err := s.adminContent.DeleteSeriesByLocaleAndID(ctx, resolvedLocale, resolvedSeriesID)
+	if err != nil {
+		return toAdminContentError(err, "failed to delete content series")
+	}
+	if !deleted {
+		return apperrors.BadRequest(adminContentSeriesNotFound)
+	}
+
+	return s.createAdminContentAuditLog(
+		ctx,
+		adminUser,
+		"content_series_deleted",
+		"series",
+		resolvedLocale,
+		resolvedSeriesID,
+		marshalAdminContentAuditValue(existing),
+		"",
+	)
+}
+
+func normalizeAdminContentSeriesInput(input domain.AdminContentSeriesInput) (domain.AdminContentSeriesRecord, error) {
+	resolvedLocale, err := normalizeAdminContentLocale(input.Locale, false)
+	if err != nil {
+		return domain.AdminContentSeriesRecord{}, err
+	}
+	resolvedID, err := normalizeAdminContentID(input.ID, adminContentSeriesIDField)
+	if err != nil {
+		return domain.AdminContentSeriesRecord{}, err
+	}
+	resolvedName, err := normalizeAdminContentName(input.Name, "series name")
+	if err != nil {
+		return domain.AdminContentSeriesRecord{}, err
+	}
+	resolvedDescription := strings.TrimSpace(input.Description)
+	if len(resolvedDescription) > adminContentSeriesMaxDescription {
+		return domain.AdminContentSeriesRecord{}, apperrors.BadRequest("series description is too long")
+	}
+	resolvedPostIDs, err := normalizeAdminContentSeriesPostIDs(input.PostIDs)
+	if err != nil {
+		return domain.AdminContentSeriesRecord{}, err
+	}
+
+	return domain.AdminContentSeriesRecord{
+		Locale:      resolvedLocale,
+		ID:          resolvedID,
+		Name:        resolvedName,
+		Description: resolvedDescription,
+		PostIDs:     resolvedPostIDs,
+	}, nil
+}
+
+// normalizeAdminContentSeriesPostIDs keeps the editor's order, unlike normalizeAdminContentIDs which sorts.
+func normalizeAdminContentSeriesPostIDs(values []string) ([]string, error) {
+	seen := make(map[string]struct{}, len(values))
+	postIDs := make([]string, 0, len(values))
+	for _, value := range values {
+		postID, err := normalizeAdminContentID(value, adminContentPostIDField)
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := seen[postID]; exists {
+			return nil, apperrors.BadRequest("duplicate post id in series: " + postID)
+		}
+		seen[postID] = struct{}{}
+		postIDs = append(postIDs, postID)
+	}
+	if len(postIDs) > adminContentSeriesMaxPosts {
+		return nil, apperrors.BadRequest(fmt.Sprintf("series cannot contain more than %d posts", adminContentSeriesMaxPosts))
+	}
+	return postIDs, nil
+}
+
+func (s *Service) ensureAdminContentSeriesPostsExist(ctx context.Context, record domain.AdminContentSeriesRecord) error {
+	for _, postID := range record.PostIDs {
+		post, err := s.adminContent.FindPostByLocaleAndID(ctx, record.Locale, postID)
+		if err != nil {
+			return toAdminContentError(err, adminContentLoadPostFailed)
+		}
+		if post == nil {
+			return apperrors.BadRequest(adminContentPostNotFound + ": " + postID)
+		}
+	}
+	return nil
+}
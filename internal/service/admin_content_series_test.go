@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/repository"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+)
+
+func TestAdminContentSeriesWorkflowsPersistAndAudit(t *testing.T) {
+	previousAdminContentRepository := adminContentRepository
+	previousAuditRepo := adminAuditLogRepo
+	t.Cleanup(func() {
+		adminContentRepository = previousAdminContentRepository
+		adminAuditLogRepo = previousAuditRepo
+	})
+
+	audit := &adminErrorMessageManagementAuditStub{}
+	adminAuditLogRepo = audit
+	series := map[string]domain.AdminContentSeriesRecord{}
+	seriesKey := func(locale, id string) string { return locale + "|" + id }
+	posts := map[string]bool{"en|part-one": true, "en|part-two": true, "en|part-three": true}
+
+	adminContentRepository = adminContentStubRepository{
+		findPostByLocaleAndID: func(_ context.Context, locale, postID string) (*domain.AdminContentPostRecord, error) {
+			if !posts[locale+"|"+postID] {
+				return nil, nil
+			}
+			return &domain.AdminContentPostRecord{Locale: locale, ID: postID}, nil
+		},
+		findSeriesByLocaleAndID: func(_ context.Context, locale, seriesID string) (*domain.AdminContentSeriesRecord, error) {
+			record, ok := series[seriesKey(locale, seriesID)]
+			if !ok {
+				return nil, nil
+			}
+			copyRecord := record
+			return &copyRecord, nil
+		},
+		upsertSeries: func(_ context.Context, record domain.AdminContentSeriesRecord, now time.Time) (*domain.AdminContentSeriesRecord, error) {
+			record.UpdatedAt = now
+			series[seriesKey(record.Locale, record.ID)] = record
+			copyRecord := record
+			return &copyRecord, nil
+		},
+		deleteSeriesByLocaleAndID: func(_ context.Context, locale, seriesID string) (bool, error) {
+			delete(series, seriesKey(locale, seriesID))
+			return true, nil
+		},
+	}
+
+	adminUser := &domain.AdminUser{ID: "admin-1", Email: "admin@example.com"}
+	created, err := testService().CreateAdminContentSeries(context.Background(), adminUser, domain.AdminContentSeriesInput{
+		Locale:      "en",
+		ID:          "Go-Basics",
+		Name:        " Go Basics ",
+		Description: "Learn Go step by step.",
+		PostIDs:     []string{"part-two", " Part-One "},
+	})
+	if err != nil || created == nil {
+		t.Fatalf("CreateAdminContentSeries result = %#v, err=%v", created, err)
+	}
+	if created.ID != "go-basics" || created.Name != "Go Basics" || len(created.PostIDs) != 2 ||
+		created.PostIDs[0] != "part-two" || created.PostIDs[1] != "part-one" {
+		t.Fatalf("expected normalized series with editor order, got %#v", created)
+	}
+
+	if _, err := testService().CreateAdminContentSeries(context.Background(), adminUser, domain.AdminContentSeriesInput{
+		Locale: "en",
+		ID:     "go-basics",
+		Name:   "Go Basics",
+	}); err == nil {
+		t.Fatal("expected duplicate series to be rejected")
+	}
+
+	_, err = testService().UpdateAdminContentSeries(context.Background(), adminUser, domain.AdminContentSeriesInput{
+		Locale:  "en",
+		ID:      "go-basics",
+		Name:    "Go Basics",
+		PostIDs: []string{"part-one", "missing-post"},
+	})
+	var appErr *apperrors.AppError
+	if !errors.As(err, &appErr) || appErr.HTTPStatus != http.StatusBadRequest {
+		t.Fatalf("expected missing post to be rejected, got %v", err)
+	}
+
+	updated, err := testService().UpdateAdminContentSeries(context.Background(), adminUser, domain.AdminContentSeriesInput{
+		Locale:  "en",
+		ID:      "go-basics",
+		Name:    "Go Basics",
+		PostIDs: []string{"part-one", "part-two", "part-three"},
+	})
+	if err != nil || updated == nil || len(updated.PostIDs) != 3 || updated.Description != "" {
+		t.Fatalf("UpdateAdminContentSeries result = %#v, err=%v", updated, err)
+	}
+
+	if err := testService().DeleteAdminContentSeries(context.Background(), adminUser, "en", "go-basics"); err != nil {
+		t.Fatalf("DeleteAdminContentSeries returned error: %v", err)
+	}
+	if err := testService().DeleteAdminContentSeries(context.Background(), adminUser, "en", "go-basics"); err == nil {
+		t.Fatal("expected deleting a missing series to fail")
+	}
+
+	if len(audit.records) != 3 ||
+		audit.records[0].Action != "content_series_created" ||
+		audit.records[1].Action != "content_series_updated" ||
+		audit.records[2].Action != "content_series_deleted" {
+		t.Fatalf("unexpected audit records: %#v", audit.records)
+	}
+}
+
+func TestAdminContentSeriesValidationAndErrors(t *testing.T) {
+	previousAdminContentRepository := adminContentRepository
+	t.Cleanup(func() {
+		adminContentRepository = previousAdminContentRepository
+	})
+
+	if _, err := testService().CreateAdminContentSeries(context.Background(), nil, domain.AdminContentSeriesInput{}); err == nil {
+		t.Fatal("expected unauthenticated create to fail")
+	}
+	if _, err := testService().ListAdminContentSeriesPage(context.Background(), nil, domain.AdminContentTaxonomyFilter{}); err == nil {
+		t.Fatal("expected unauthenticated list to fail")
+	}
+
+	if _, err := normalizeAdminContentSeriesInput(domain.AdminContentSeriesInput{
+		Locale:  "en",
+		ID:      "go-basics",
+		Name:    "Go Basics",
+		PostIDs: []string{"part-one", "part-one"},
+	}); err == nil {
+		t.Fatal("expected duplicate post ids to be rejected")
+	}
+
+	tooMany := make([]string, 0, adminContentSeriesMaxPosts+1)
+	for index := 0; index <= adminContentSeriesMaxPosts; index++ {
+		tooMany = append(tooMany, "part-"+strconv.Itoa(index))
+	}
+	if _, err := normalizeAdminContentSeriesPostIDs(tooMany); err == nil {
+		t.Fatal("expected oversized series to be rejected")
+	}
+
+	adminContentRepository = adminContentStubRepository{
+		listSeriesGroups: func(_ context.Context, filter domain.AdminContentTaxonomyFilter) (*domain.AdminContentSeriesListResult, error) {
+			if filter.PreferredLocale != "tr" || filter.Size == nil || *filter.Size != adminContentDefaultPageSize {
+				t.Fatalf("unexpected series filter: %#v", filter)
+			}
+			return nil, nil
+		},
+	}
+	page, err := testService().ListAdminContentSeriesPage(context.Background(), &domain.AdminUser{ID: "admin-1"}, domain.AdminContentTaxonomyFilter{PreferredLocale: "tr"})
+	if err != nil || page == nil || page.Total != 0 || len(page.Items) != 0 {
+		t.Fatalf("ListAdminContentSeriesPage result = %#v, err=%v", page, err)
+	}
+
+	adminContentRepository = adminContentStubRepository{
+		listSeriesGroups: func(context.Context, domain.AdminContentTaxonomyFilter) (*domain.AdminContentSeriesListResult, error) {
+			return nil, repository.ErrAdminContentRepositoryUnavailable
+		},
+	}
+	var appErr *apperrors.AppError
+	if _, err := testService().ListAdminContentSeriesPage(context.Background(), &domain.AdminUser{ID: "admin-1"}, domain.AdminContentTaxonomyFilter{}); !errors.As(err, &appErr) || appErr.HTTPStatus != http.StatusServiceUnavailable {
+		t.Fatalf("expected service unavailable error, got %v", err)
+	}
+}
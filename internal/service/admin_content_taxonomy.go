@@ -9,7 +9,7 @@ import (
 	"suaybsimsek.com/blog-api/pkg/apperrors"
 )
 
-func ListAdminContentTopics(
+func (s *Service) ListAdminContentTopics(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	locale string,
@@ -24,7 +24,7 @@ func ListAdminContentTopics(
 		return nil, err
 	}
 
-	items, err := adminContentRepository.ListTopics(ctx, resolvedLocale, strings.TrimSpace(query))
+	items, err := s.adminContent.ListTopics(ctx, resolvedLocale, strings.TrimSpace(query))
 	if err != nil {
 		return nil, toAdminContentError(err, "failed to list content topics")
 	}
@@ -35,7 +35,7 @@ func ListAdminContentTopics(
 	return items, nil
 }
 
-func ListAdminContentTopicsPage(
+func (s *Service) ListAdminContentTopicsPage(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	filter domain.AdminContentTaxonomyFilter,
@@ -55,7 +55,7 @@ func ListAdminContentTopicsPage(
 		return nil, err
 	}
 
-	result, err := adminContentRepository.ListTopicGroups(
+	result, err := s.adminContent.ListTopicGroups(
 		ctx,
 		domain.AdminContentTaxonomyFilter{
 			Locale:          resolvedLocale,
@@ -80,7 +80,7 @@ func ListAdminContentTopicsPage(
 	return result, nil
 }
 
-func ListAdminContentCategories(
+func (s *Service) ListAdminContentCategories(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	locale string,
@@ -94,7 +94,7 @@ func ListAdminContentCategories(
 		return nil, err
 	}
 
-	items, err := adminContentRepository.ListCategories(ctx, resolvedLocale)
+	items, err := s.adminContent.ListCategories(ctx, resolvedLocale)
 	if err != nil {
 		return nil, toAdminContentError(err, "failed to list content categories")
 	}
@@ -105,7 +105,7 @@ func ListAdminContentCategories(
 	return items, nil
 }
 
-func ListAdminContentCategoriesPage(
+func (s *Service) ListAdminContentCategoriesPage(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	filter domain.AdminContentTaxonomyFilter,
@@ -125,7 +125,7 @@ func ListAdminContentCategoriesPage(
 		return nil, err
 	}
 
-	result, err := adminContentRepository.ListCategoryGroups(
+	result, err := s.adminContent.ListCategoryGroups(
 		ctx,
 		domain.AdminContentTaxonomyFilter{
 			Locale:          resolvedLocale,
@@ -150,7 +150,7 @@ func ListAdminContentCategoriesPage(
 	return result, nil
 }
 
-func CreateAdminContentTopic(
+func (s *Service) CreateAdminContentTopic(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	input domain.AdminContentTopicInput,
@@ -164,7 +164,7 @@ func CreateAdminContentTopic(
 		return nil, err
 	}
 
-	existing, err := adminContentRepository.FindTopicByLocaleAndID(ctx, record.Locale, record.ID)
+	existing, err := s.adminContent.FindTopicByLocaleAndID(ctx, record.Locale, record.ID)
 	if err != nil {
 		return nil, toAdminContentError(err, "failed to load content topic")
 	}
@@ -173,7 +173,7 @@ func CreateAdminContentTopic(
 	}
 
 	now := time.Now().UTC()
-	saved, err := adminContentRepository.UpsertTopic(ctx, record, now)
+	saved, err := s.adminContent.UpsertTopic(ctx, record, now)
 	if err != nil {
 		return nil, toAdminContentError(err, "failed to create content topic")
 	}
@@ -181,11 +181,11 @@ func CreateAdminContentTopic(
 		return nil, apperrors.Internal("failed to create content topic", nil)
 	}
 
-	if err := adminContentRepository.SyncTopicOnPosts(ctx, *saved, now); err != nil {
+	if err := s.adminContent.SyncTopicOnPosts(ctx, *saved, now); err != nil {
 		return nil, toAdminContentError(err, "failed to sync content topic on posts")
 	}
 
-	if err := createAdminContentAuditLog(
+	if err := s.createAdminContentAuditLog(
 		ctx,
 		adminUser,
 		"content_topic_created",
@@ -201,7 +201,7 @@ func CreateAdminContentTopic(
 	return saved, nil
 }
 
-func UpdateAdminContentTopic(
+func (s *Service) UpdateAdminContentTopic(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	input domain.AdminContentTopicInput,
@@ -215,7 +215,7 @@ func UpdateAdminContentTopic(
 		return nil, err
 	}
 
-	existing, err := adminContentRepository.FindTopicByLocaleAndID(ctx, record.Locale, record.ID)
+	existing, err := s.adminContent.FindTopicByLocaleAndID(ctx, record.Locale, record.ID)
 	if err != nil {
 		return nil, toAdminContentError(err, "failed to load content topic")
 	}
@@ -224,7 +224,7 @@ func UpdateAdminContentTopic(
 	}
 
 	now := time.Now().UTC()
-	saved, err := adminContentRepository.UpsertTopic(ctx, record, now)
+	saved, err := s.adminContent.UpsertTopic(ctx, record, now)
 	if err != nil {
 		return nil, toAdminContentError(err, "failed to update content topic")
 	}
@@ -232,11 +232,11 @@ func UpdateAdminContentTopic(
 		return nil, apperrors.Internal("failed to update content topic", nil)
 	}
 
-	if err := adminContentRepository.SyncTopicOnPosts(ctx, *saved, now); err != nil {
+	if err := s.adminContent.SyncTopicOnPosts(ctx, *saved, now); err != nil {
 		return nil, toAdminContentError(err, "failed to sync content topic on posts")
 	}
 
-	if err := createAdminContentAuditLog(
+	if err := s.createAdminContentAuditLog(
 		ctx,
 		adminUser,
 		"content_topic_updated",
@@ -252,7 +252,7 @@ func UpdateAdminContentTopic(
 	return saved, nil
 }
 
-func DeleteAdminContentTopic(
+func (s *Service) DeleteAdminContentTopic(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	locale string,
@@ -271,7 +271,7 @@ func DeleteAdminContentTopic(
 		return err
 	}
 
-	existing, err := adminContentRepository.FindTopicByLocaleAndID(ctx, resolvedLocale, resolvedTopicID)
+	existing, err := s.adminContent.FindTopicByLocaleAndID(ctx, resolvedLocale, resolvedTopicID)
 	if err != nil {
 		return toAdminContentError(err, "failed to load content topic")
 	}
@@ -279,7 +279,7 @@ func DeleteAdminContentTopic(
 		return apperrors.BadRequest("content topic not found")
 	}
 
-	deleted, err := adminContentRepository.DeleteTopicByLocaleAndID(ctx, resolvedLocale, resolvedTopicID)
+	deleted, err := s.adminContent.DeleteTopicByLocaleAndID(ctx, resolvedLocale, resolvedTopicID)
 	if err != nil {
 		return toAdminContentError(err, "failed to delete content topic")
 	}
@@ -288,11 +288,11 @@ func DeleteAdminContentTopic(
 	}
 
 	now := time.Now().UTC()
-	if err := adminContentRepository.RemoveTopicFromPosts(ctx, resolvedLocale, resolvedTopicID, now); err != nil {
+	if err := s.adminContent.RemoveTopicFromPosts(ctx, resolvedLocale, resolvedTopicID, now); err != nil {
 		return toAdminContentError(err, "failed to remove content topic from posts")
 	}
 
-	return createAdminContentAuditLog(
+	if err := s.createAdminContentAuditLog(
 		ctx,
 		adminUser,
 		"content_topic_deleted",
@@ -301,10 +301,15 @@ func DeleteAdminContentTopic(
 		resolvedTopicID,
 		marshalAdminContentAuditValue(existing),
 		"",
-	)
+	); err != nil {
+		return err
+	}
+
+	s.refreshAdminContentRelatedPosts(ctx, resolvedLocale)
+	return nil
 }
 
-func CreateAdminContentCategory(
+func (s *Service) CreateAdminContentCategory(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	input domain.AdminContentCategoryInput,
@@ -318,7 +323,7 @@ func CreateAdminContentCategory(
 		return nil, err
 	}
 
-	existing, err := adminContentRepository.FindCategoryByLocaleAndID(ctx, record.Locale, record.ID)
+	existing, err := s.adminContent.FindCategoryByLocaleAndID(ctx, record.Locale, record.ID)
 	if err != nil {
 		return nil, toAdminContentError(err, "failed to load content category")
 	}
@@ -327,7 +332,7 @@ func CreateAdminContentCategory(
 	}
 
 	now := time.Now().UTC()
-	saved, err := adminContentRepository.UpsertCategory(ctx, record, now)
+	saved, err := s.adminContent.UpsertCategory(ctx, record, now)
 	if err != nil {
 		return nil, toAdminContentError(err, "failed to create content category")
 	}
@@ -335,11 +340,11 @@ func CreateAdminContentCategory(
 		return nil, apperrors.Internal("failed to create content category", nil)
 	}
 
-	if err := adminContentRepository.SyncCategoryOnPosts(ctx, *saved, now); err != nil {
+	if err := s.adminContent.SyncCategoryOnPosts(ctx, *saved, now); err != nil {
 		return nil, toAdminContentError(err, "failed to sync content category on posts")
 	}
 
-	if err := createAdminContentAuditLog(
+	if err := s.createAdminContentAuditLog(
 		ctx,
 		adminUser,
 		"content_category_created",
@@ -355,7 +360,7 @@ func CreateAdminContentCategory(
 	return saved, nil
 }
 
-func UpdateAdminContentCategory(
+func (s *Service) UpdateAdminContentCategory(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	input domain.AdminContentCategoryInput,
@@ -369,7 +374,7 @@ func UpdateAdminContentCategory(
 		return nil, err
 	}
 
-	existing, err := adminContentRepository.FindCategoryByLocaleAndID(ctx, record.Locale, record.ID)
+	existing, err := s.adminContent.FindCategoryByLocaleAndID(ctx, record.Locale, record.ID)
 	if err != nil {
 		return nil, toAdminContentError(err, "failed to load content category")
 	}
@@ -378,7 +383,7 @@ func UpdateAdminContentCategory(
 	}
 
 	now := time.Now().UTC()
-	saved, err := adminContentRepository.UpsertCategory(ctx, record, now)
+	saved, err := s.adminContent.UpsertCategory(ctx, record, now)
 	if err != nil {
 		return nil, toAdminContentError(err, "failed to update content category")
 	}
@@ -386,11 +391,11 @@ func UpdateAdminContentCategory(
 		return nil, apperrors.Internal("failed to update content category", nil)
 	}
 
-	if err := adminContentRepository.SyncCategoryOnPosts(ctx, *saved, now); err != nil {
+	if err := s.adminContent.SyncCategoryOnPosts(ctx, *saved, now); err != nil {
 		return nil, toAdminContentError(err, "failed to sync content category on posts")
 	}
 
-	if err := createAdminContentAuditLog(
+	if err := s.createAdminContentAuditLog(
 		ctx,
 		adminUser,
 		"content_category_updated",
@@ -406,7 +411,7 @@ func UpdateAdminContentCategory(
 	return saved, nil
 }
 
-func DeleteAdminContentCategory(
+func (s *Service) DeleteAdminContentCategory(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	locale string,
@@ -425,7 +430,7 @@ func DeleteAdminContentCategory(
 		return err
 	}
 
-	existing, err := adminContentRepository.FindCategoryByLocaleAndID(ctx, resolvedLocale, resolvedCategoryID)
+	existing, err := s.adminContent.FindCategoryByLocaleAndID(ctx, resolvedLocale, resolvedCategoryID)
 	if err != nil {
 		return toAdminContentError(err, "failed to load content category")
 	}
@@ -433,7 +438,7 @@ func DeleteAdminContentCategory(
 		return apperrors.BadRequest("content category not found")
 	}
 
-	deleted, err := adminContentRepository.DeleteCategoryByLocaleAndID(ctx, resolvedLocale, resolvedCategoryID)
+	deleted, err := s.adminContent.DeleteCategoryByLocaleAndID(ctx, resolvedLocale, resolvedCategoryID)
 	if err != nil {
 		return toAdminContentError(err, "failed to delete content category")
 	}
@@ -442,11 +447,11 @@ func DeleteAdminContentCategory(
 	}
 
 	now := time.Now().UTC()
-	if err := adminContentRepository.ClearCategoryFromPosts(ctx, resolvedLocale, resolvedCategoryID, now); err != nil {
+	if err := s.adminContent.ClearCategoryFromPosts(ctx, resolvedLocale, resolvedCategoryID, now); err != nil {
 		return toAdminContentError(err, "failed to remove content category from posts")
 	}
 
-	return createAdminContentAuditLog(
+	if err := s.createAdminContentAuditLog(
 		ctx,
 		adminUser,
 		"content_category_deleted",
@@ -455,7 +460,12 @@ func DeleteAdminContentCategory(
 		resolvedCategoryID,
 		marshalAdminContentAuditValue(existing),
 		"",
-	)
+	); err != nil {
+		return err
+	}
+
+	s.refreshAdminContentRelatedPosts(ctx, resolvedLocale)
+	return nil
 }
 
 func normalizeAdminContentTopicInput(input domain.AdminContentTopicInput) (domain.AdminContentTopicRecord, error) {
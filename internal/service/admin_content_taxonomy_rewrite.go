@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+)
+
+const adminContentTaxonomyRewriteFailed = "failed to rewrite content taxonomy"
+
+var adminContentTaxonomyLocales = []string{adminErrorLocaleEN, adminErrorLocaleTR}
+
+type adminContentTaxonomyRewriteAudit struct {
+	SourceIDs         []string `json:"sourceIds"`
+	TargetID          string   `json:"targetId"`
+	PostsUpdated      int      `json:"postsUpdated"`
+	RevisionsCreated  int      `json:"revisionsCreated"`
+	TaxonomiesRemoved int      `json:"taxonomiesRemoved"`
+}
+
+func (s *Service) MergeAdminContentTopics(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	input domain.AdminContentTopicMergeInput,
+) (*domain.AdminContentTaxonomyRewriteResult, error) {
+	if adminUser == nil || strings.TrimSpace(adminUser.ID) == "" {
+		return nil, apperrors.Unauthorized(adminContentAuthRequired)
+	}
+
+	targetID, err := normalizeAdminContentID(input.TargetID, "target "+adminContentTopicIDField)
+	if err != nil {
+		return nil, err
+	}
+	sourceIDs, err := normalizeAdminContentIDs(input.SourceIDs, "source "+adminContentTopicIDField)
+	if err != nil {
+		return nil, err
+	}
+	sourceIDs = slices.DeleteFunc(sourceIDs, func(sourceID string) bool { return sourceID == targetID })
+	if len(sourceIDs) == 0 {
+		return nil, apperrors.BadRequest("at least one source topic different from the target is required")
+	}
+
+	plan := domain.AdminContentTaxonomyRewritePlan{}
+	for _, locale := range adminContentTaxonomyLocales {
+		target, err := s.adminContent.FindTopicByLocaleAndID(ctx, locale, targetID)
+		if err != nil {
+			return nil, toAdminContentError(err, adminContentLoadTopicFailed)
+		}
+		for _, sourceID := range sourceIDs {
+			source, err := s.adminContent.FindTopicByLocaleAndID(ctx, locale, sourceID)
+			if err != nil {
+				return nil, toAdminContentError(err, adminContentLoadTopicFailed)
+			}
+			if source == nil {
+				continue
+			}
+			if target == nil {
+				return nil, apperrors.BadRequest("target content topic not found in locale " + locale)
+			}
+			plan.DeleteTopics = append(plan.DeleteTopics, *source)
+		}
+	}
+	if len(plan.DeleteTopics) == 0 {
+		return nil, apperrors.BadRequest(adminContentTopicNotFound)
+	}
+
+	posts, err := s.listAdminContentTaxonomyPosts(ctx, sourceIDs, func(sourceID string) domain.AdminContentPostFilter {
+		return domain.AdminContentPostFilter{TopicID: sourceID}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	topicCache := map[string]*domain.AdminContentTopicRecord{}
+	for _, post := range posts {
+		topics, err := s.resolveAdminContentMergedTopics(ctx, topicCache, post, sourceIDs, targetID)
+		if err != nil {
+			return nil, err
+		}
+		plan.Posts = append(plan.Posts, domain.AdminContentPostTaxonomyRewrite{Post: post, Topics: topics})
+	}
+
+	return s.applyAdminContentTaxonomyRewrite(
+		ctx,
+		adminUser,
+		plan,
+		input.DryRun,
+		"content_topics_merged",
+		"topic",
+		adminContentTaxonomyRewriteAudit{SourceIDs: sourceIDs, TargetID: targetID},
+		len(plan.DeleteTopics),
+	)
+}
+
+func (s *Service) RenameAdminContentCategory(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	input domain.AdminContentCategoryRenameInput,
+) (*domain.AdminContentTaxonomyRewriteResult, error) {
+	if adminUser == nil || strings.TrimSpace(adminUser.ID) == "" {
+		return nil, apperrors.Unauthorized(adminContentAuthRequired)
+	}
+
+	sourceID, err := normalizeAdminContentID(input.SourceID, "source "+adminContentCategoryIDField)
+	if err != nil {
+		return nil, err
+	}
+	targetID, err := normalizeAdminContentID(input.TargetID, "target "+adminContentCategoryIDField)
+	if err != nil {
+		return nil, err
+	}
+	if sourceID == targetID {
+		return nil, apperrors.BadRequest("target category id must differ from the source")
+	}
+
+	plan := domain.AdminContentTaxonomyRewritePlan{}
+	renamedByLocale := make(map[string]domain.AdminContentCategoryRecord, len(adminContentTaxonomyLocales))
+	for _, locale := range adminContentTaxonomyLocales {
+		existingTarget, err := s.adminContent.FindCategoryByLocaleAndID(ctx, locale, targetID)
+		if err != nil {
+			return nil, toAdminContentError(err, adminContentLoadCategoryFailed)
+		}
+		if existingTarget != nil {
+			return nil, apperrors.BadRequest("content category already exists: " + targetID)
+		}
+
+		source, err := s.adminContent.FindCategoryByLocaleAndID(ctx, locale, sourceID)
+		if err != nil {
+			return nil, toAdminContentError(err, adminContentLoadCategoryFailed)
+		}
+		if source == nil {
+			continue
+		}
+
+		renamed := *source
+		renamed.Locale = locale
+		renamed.ID = targetID
+		renamedByLocale[locale] = renamed
+		plan.UpsertCategories = append(plan.UpsertCategories, renamed)
+		plan.DeleteCategories = append(plan.DeleteCategories, *source)
+	}
+	if len(plan.DeleteCategories) == 0 {
+		return nil, apperrors.BadRequest(adminContentCategoryNotFound)
+	}
+
+	posts, err := s.listAdminContentTaxonomyPosts(ctx, []string{sourceID}, func(categoryID string) domain.AdminContentPostFilter {
+		return domain.AdminContentPostFilter{CategoryID: categoryID}
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, post := range posts {
+		renamed, exists := renamedByLocale[post.Locale]
+		if !exists {
+			// The post points at a category that was never created in its locale; keep the reference stable.
+			renamed = domain.AdminContentCategoryRecord{Locale: post.Locale, ID: targetID, Name: post.CategoryName}
+		}
+		plan.Posts = append(plan.Posts, domain.AdminContentPostTaxonomyRewrite{Post: post, Category: &renamed})
+	}
+
+	return s.applyAdminContentTaxonomyRewrite(
+		ctx,
+		adminUser,
+		plan,
+		input.DryRun,
+		"content_category_renamed",
+		"category",
+		adminContentTaxonomyRewriteAudit{SourceIDs: []string{sourceID}, TargetID: targetID},
+		len(plan.DeleteCategories),
+	)
+}
+
+func (s *Service) applyAdminContentTaxonomyRewrite(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	plan domain.AdminContentTaxonomyRewritePlan,
+	dryRun bool,
+	action string,
+	scope string,
+	audit adminContentTaxonomyRewriteAudit,
+	taxonomiesRemoved int,
+) (*domain.AdminContentTaxonomyRewriteResult, error) {
+	result := &domain.AdminContentTaxonomyRewriteResult{
+		DryRun: dryRun,
+		Posts:  make([]domain.AdminContentTaxonomyRewritePost, 0, len(plan.Posts)),
+	}
+	locales := make([]string, 0, len(plan.Posts))
+	for _, rewrite := range plan.Posts {
+		result.Posts = append(result.Posts, domain.AdminContentTaxonomyRewritePost{
+			Locale: rewrite.Post.Locale,
+			ID:     rewrite.Post.ID,
+			Title:  rewrite.Post.Title,
+		})
+		locales = append(locales, rewrite.Post.Locale)
+	}
+	if dryRun {
+		return result, nil
+	}
+
+	if err := s.adminContent.ApplyTaxonomyRewrite(ctx, plan, time.Now().UTC()); err != nil {
+		return nil, toAdminContentError(err, adminContentTaxonomyRewriteFailed)
+	}
+	result.PostsUpdated = len(plan.Posts)
+	result.RevisionsCreated = len(plan.Posts)
+	result.TaxonomiesRemoved = taxonomiesRemoved
+
+	audit.PostsUpdated = result.PostsUpdated
+	audit.RevisionsCreated = result.RevisionsCreated
+	audit.TaxonomiesRemoved = result.TaxonomiesRemoved
+	if err := s.createAdminContentAuditLog(
+		ctx,
+		adminUser,
+		action,
+		scope,
+		"",
+		audit.TargetID,
+		marshalAdminContentAuditValue(audit.SourceIDs),
+		marshalAdminContentAuditValue(audit),
+	); err != nil {
+		return nil, err
+	}
+
+	s.refreshAdminContentRelatedPosts(ctx, locales...)
+	return result, nil
+}
+
+// listAdminContentTaxonomyPosts loads every post referencing one of the ids in any locale,
+// reloading each one in full so the recorded revision keeps the post content.
+func (s *Service) listAdminContentTaxonomyPosts(
+	ctx context.Context,
+	ids []string,
+	buildFilter func(id string) domain.AdminContentPostFilter,
+) ([]domain.AdminContentPostRecord, error) {
+	seen := map[string]struct{}{}
+	posts := make([]domain.AdminContentPostRecord, 0)
+	for _, id := range ids {
+		items, err := s.adminContent.ListAllPosts(ctx, buildFilter(id))
+		if err != nil {
+			return nil, toAdminContentError(err, "failed to list content posts")
+		}
+		for _, item := range items {
+			key := item.Locale + "|" + item.ID
+			if _, exists := seen[key]; exists {
+				continue
+			}
+			seen[key] = struct{}{}
+
+			post, err := s.adminContent.FindPostByLocaleAndID(ctx, item.Locale, item.ID)
+			if err != nil {
+				return nil, toAdminContentError(err, adminContentLoadPostFailed)
+			}
+			if post == nil {
+				continue
+			}
+			posts = append(posts, *post)
+		}
+	}
+	return posts, nil
+}
+
+func (s *Service) resolveAdminContentMergedTopics(
+	ctx context.Context,
+	cache map[string]*domain.AdminContentTopicRecord,
+	post domain.AdminContentPostRecord,
+	sourceIDs []string,
+	targetID string,
+) ([]domain.AdminContentTopicRecord, error) {
+	topicIDs := make([]string, 0, len(post.TopicIDs)+1)
+	for _, topicID := range post.TopicIDs {
+		if !slices.Contains(sourceIDs, topicID) {
+			topicIDs = append(topicIDs, topicID)
+		}
+	}
+	topicIDs = append(topicIDs, targetID)
+	slices.Sort(topicIDs)
+	topicIDs = slices.Compact(topicIDs)
+
+	topics := make([]domain.AdminContentTopicRecord, 0, len(topicIDs))
+	for _, topicID := range topicIDs {
+		key := post.Locale + "|" + topicID
+		topic, cached := cache[key]
+		if !cached {
+			loaded, err := s.adminContent.FindTopicByLocaleAndID(ctx, post.Locale, topicID)
+			if err != nil {
+				return nil, toAdminContentError(err, adminContentLoadTopicFailed)
+			}
+			cache[key] = loaded
+			topic = loaded
+		}
+		// Stale ids pointing at deleted topics are dropped while the post is being rewritten anyway.
+		if topic != nil {
+			topics = append(topics, *topic)
+		}
+	}
+	return topics, nil
+}
@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/repository"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+)
+
+func TestMergeAdminContentTopicsRewritesPostsAcrossLocales(t *testing.T) {
+	previousAdminContentRepository := adminContentRepository
+	previousAuditRepo := adminAuditLogRepo
+	t.Cleanup(func() {
+		adminContentRepository = previousAdminContentRepository
+		adminAuditLogRepo = previousAuditRepo
+	})
+
+	audit := &adminErrorMessageManagementAuditStub{}
+	adminAuditLogRepo = audit
+	topics := map[string]domain.AdminContentTopicRecord{
+		"en|golang":  {Locale: "en", ID: "golang", Name: "Golang"},
+		"en|go":      {Locale: "en", ID: "go", Name: "Go"},
+		"en|testing": {Locale: "en", ID: "testing", Name: "Testing"},
+		"tr|golang":  {Locale: "tr", ID: "golang", Name: "Golang"},
+		"tr|go":      {Locale: "tr", ID: "go", Name: "Go"},
+	}
+	posts := map[string]domain.AdminContentPostRecord{
+		"en|first":  {Locale: "en", ID: "first", Title: "First", Content: "body", TopicIDs: []string{"golang", "testing"}, RevisionCount: 2},
+		"en|second": {Locale: "en", ID: "second", Title: "Second", TopicIDs: []string{"go", "golang", "removed"}},
+		"tr|first":  {Locale: "tr", ID: "first", Title: "Birinci", TopicIDs: []string{"golang"}},
+	}
+
+	var applied []domain.AdminContentTaxonomyRewritePlan
+	adminContentRepository = adminContentStubRepository{
+		findTopicByLocaleAndID: func(_ context.Context, locale, topicID string) (*domain.AdminContentTopicRecord, error) {
+			topic, ok := topics[locale+"|"+topicID]
+			if !ok {
+				return nil, nil
+			}
+			return &topic, nil
+		},
+		listAllPosts: func(_ context.Context, filter domain.AdminContentPostFilter) ([]domain.AdminContentPostRecord, error) {
+			if filter.Locale != "" || filter.TopicID != "golang" {
+				t.Fatalf("unexpected post filter: %#v", filter)
+			}
+			items := make([]domain.AdminContentPostRecord, 0)
+			for _, key := range []string{"en|first", "en|second", "tr|first"} {
+				post := posts[key]
+				items = append(items, domain.AdminContentPostRecord{Locale: post.Locale, ID: post.ID, TopicIDs: post.TopicIDs})
+			}
+			return items, nil
+		},
+		findPostByLocaleAndID: func(_ context.Context, locale, postID string) (*domain.AdminContentPostRecord, error) {
+			post, ok := posts[locale+"|"+postID]
+			if !ok {
+				return nil, nil
+			}
+			return &post, nil
+		},
+		applyTaxonomyRewrite: func(_ context.Context, plan domain.AdminContentTaxonomyRewritePlan, _ time.Time) error {
+			applied = append(applied, plan)
+			return nil
+		},
+	}
+
+	adminUser := &domain.AdminUser{ID: "admin-1", Email: "admin@example.com"}
+	preview, err := testService().MergeAdminContentTopics(context.Background(), adminUser, domain.AdminContentTopicMergeInput{
+		SourceIDs: []string{" Golang ", "go"},
+		TargetID:  "Go",
+		DryRun:    true,
+	})
+	if err != nil || preview == nil || !preview.DryRun || len(preview.Posts) != 3 || preview.PostsUpdated != 0 {
+		t.Fatalf("dry run result = %#v, err=%v", preview, err)
+	}
+	if len(applied) != 0 || len(audit.records) != 0 {
+		t.Fatalf("expected dry run to leave storage untouched, applied=%d audits=%d", len(applied), len(audit.records))
+	}
+
+	result, err := testService().MergeAdminContentTopics(context.Background(), adminUser, domain.AdminContentTopicMergeInput{
+		SourceIDs: []string{"golang"},
+		TargetID:  "go",
+	})
+	if err != nil || result == nil || result.DryRun || result.PostsUpdated != 3 || result.RevisionsCreated != 3 || result.TaxonomiesRemoved != 2 {
+		t.Fatalf("merge result = %#v, err=%v", result, err)
+	}
+	if len(applied) != 1 || len(applied[0].DeleteTopics) != 2 {
+		t.Fatalf("unexpected applied plans: %#v", applied)
+	}
+
+	rewritten := map[string][]string{}
+	for _, rewrite := range applied[0].Posts {
+		ids := make([]string, 0, len(rewrite.Topics))
+		for _, topic := range rewrite.Topics {
+			ids = append(ids, topic.ID)
+		}
+		rewritten[rewrite.Post.Locale+"|"+rewrite.Post.ID] = ids
+	}
+	if strings.Join(rewritten["en|first"], ",") != "go,testing" ||
+		strings.Join(rewritten["en|second"], ",") != "go" ||
+		strings.Join(rewritten["tr|first"], ",") != "go" {
+		t.Fatalf("unexpected rewritten topics: %#v", rewritten)
+	}
+	if applied[0].Posts[0].Post.Content != "body" || applied[0].Posts[0].Post.RevisionCount != 2 {
+		t.Fatalf("expected full post to be captured for the revision: %#v", applied[0].Posts[0].Post)
+	}
+
+	if len(audit.records) != 1 || audit.records[0].Action != "content_topics_merged" ||
+		!strings.Contains(audit.records[0].AfterValue, `"postsUpdated":3`) {
+		t.Fatalf("unexpected audit records: %#v", audit.records)
+	}
+}
+
+func TestMergeAdminContentTopicsValidation(t *testing.T) {
+	previousAdminContentRepository := adminContentRepository
+	t.Cleanup(func() {
+		adminContentRepository = previousAdminContentRepository
+	})
+
+	adminUser := &domain.AdminUser{ID: "admin-1"}
+	if _, err := testService().MergeAdminContentTopics(context.Background(), nil, domain.AdminContentTopicMergeInput{}); err == nil {
+		t.Fatal("expected unauthenticated merge to fail")
+	}
+	if _, err := testService().MergeAdminContentTopics(context.Background(), adminUser, domain.AdminContentTopicMergeInput{
+		SourceIDs: []string{"go"},
+		TargetID:  "go",
+	}); err == nil {
+		t.Fatal("expected merging a topic into itself to fail")
+	}
+
+	adminContentRepository = adminContentStubRepository{
+		findTopicByLocaleAndID: func(_ context.Context, locale, topicID string) (*domain.AdminContentTopicRecord, error) {
+			if locale == "tr" && topicID == "golang" {
+				return &domain.AdminContentTopicRecord{Locale: locale, ID: topicID}, nil
+			}
+			return nil, nil
+		},
+	}
+	var appErr *apperrors.AppError
+	if _, err := testService().MergeAdminContentTopics(context.Background(), adminUser, domain.AdminContentTopicMergeInput{
+		SourceIDs: []string{"golang"},
+		TargetID:  "go",
+	}); !errors.As(err, &appErr) || appErr.HTTPStatus != http.StatusBadRequest {
+		t.Fatalf("expected missing target locale to be rejected, got %v", err)
+	}
+
+	adminContentRepository = adminContentStubRepository{
+		findTopicByLocaleAndID: func(_ context.Context, locale, topicID string) (*domain.AdminContentTopicRecord, error) {
+			return &domain.AdminContentTopicRecord{Locale: locale, ID: topicID}, nil
+		},
+		applyTaxonomyRewrite: func(context.Context, domain.AdminContentTaxonomyRewritePlan, time.Time) error {
+			return repository.ErrAdminContentRepositoryUnavailable
+		},
+	}
+	if _, err := testService().MergeAdminContentTopics(context.Background(), adminUser, domain.AdminContentTopicMergeInput{
+		SourceIDs: []string{"golang"},
+		TargetID:  "go",
+	}); !errors.As(err, &appErr) || appErr.HTTPStatus != http.StatusServiceUnavailable {
+		t.Fatalf("expected service unavailable error, got %v", err)
+	}
+}
+
+func TestRenameAdminContentCategoryMovesCategoryAndPosts(t *testing.T) {
+	previousAdminContentRepository := adminContentRepository
+	previousAuditRepo := adminAuditLogRepo
+	t.Cleanup(func() {
+		adminContentRepository = previousAdminContentRepository
+		adminAuditLogRepo = previousAuditRepo
+	})
+
+	audit := &adminErrorMessageManagementAuditStub{}
+	adminAuditLogRepo = audit
+	categories := map[string]domain.AdminContentCategoryRecord{
+		"en|programming": {Locale: "en", ID: "programming", Name: "Programming", Color: "blue", Icon: "code"},
+		"tr|programming": {Locale: "tr", ID: "programming", Name: "Programlama", Color: "blue"},
+		"en|design":      {Locale: "en", ID: "design", Name: "Design"},
+	}
+
+	var applied domain.AdminContentTaxonomyRewritePlan
+	adminContentRepository = adminContentStubRepository{
+		findCategoryByLocaleAndID: func(_ context.Context, locale, categoryID string) (*domain.AdminContentCategoryRecord, error) {
+			category, ok := categories[locale+"|"+categoryID]
+			if !ok {
+				return nil, nil
+			}
+			return &category, nil
+		},
+		listAllPosts: func(_ context.Context, filter domain.AdminContentPostFilter) ([]domain.AdminContentPostRecord, error) {
+			if filter.CategoryID != "programming" {
+				t.Fatalf("unexpected post filter: %#v", filter)
+			}
+			return []domain.AdminContentPostRecord{{Locale: "en", ID: "first"}, {Locale: "tr", ID: "first"}}, nil
+		},
+		findPostByLocaleAndID: func(_ context.Context, locale, postID string) (*domain.AdminContentPostRecord, error) {
+			return &domain.AdminContentPostRecord{Locale: locale, ID: postID, CategoryID: "programming"}, nil
+		},
+		applyTaxonomyRewrite: func(_ context.Context, plan domain.AdminContentTaxonomyRewritePlan, _ time.Time) error {
+			applied = plan
+			return nil
+		},
+	}
+
+	adminUser := &domain.AdminUser{ID: "admin-1"}
+	if _, err := testService().RenameAdminContentCategory(context.Background(), adminUser, domain.AdminContentCategoryRenameInput{
+		SourceID: "programming",
+		TargetID: "design",
+	}); err == nil {
+		t.Fatal("expected renaming onto an existing category to fail")
+	}
+	if _, err := testService().RenameAdminContentCategory(context.Background(), adminUser, domain.AdminContentCategoryRenameInput{
+		SourceID: "missing",
+		TargetID: "software",
+	}); err == nil {
+		t.Fatal("expected renaming a missing category to fail")
+	}
+
+	result, err := testService().RenameAdminContentCategory(context.Background(), adminUser, domain.AdminContentCategoryRenameInput{
+		SourceID: "Programming",
+		TargetID: "software",
+	})
+	if err != nil || result == nil || result.PostsUpdated != 2 || result.TaxonomiesRemoved != 2 {
+		t.Fatalf("rename result = %#v, err=%v", result, err)
+	}
+	if len(applied.UpsertCategories) != 2 || len(applied.DeleteCategories) != 2 {
+		t.Fatalf("unexpected category changes: %#v", applied)
+	}
+	for _, rewrite := range applied.Posts {
+		if rewrite.Category == nil || rewrite.Category.ID != "software" || rewrite.Category.Locale != rewrite.Post.Locale || rewrite.Topics != nil {
+			t.Fatalf("unexpected post rewrite: %#v", rewrite)
+		}
+	}
+	if applied.Posts[0].Category.Icon != "code" || applied.Posts[1].Category.Name != "Programlama" {
+		t.Fatalf("expected localized category copies, got %#v %#v", applied.Posts[0].Category, applied.Posts[1].Category)
+	}
+	if len(audit.records) != 1 || audit.records[0].Action != "content_category_renamed" {
+		t.Fatalf("unexpected audit records: %#v", audit.records)
+	}
+}
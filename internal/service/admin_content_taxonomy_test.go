@@ -47,24 +47,24 @@ func TestListAdminContentTaxonomiesReturnsRepositoryResults(t *testing.T) {
 		},
 	}
 
-	topics, err := ListAdminContentTopics(context.Background(), &domain.AdminUser{ID: "admin-1"}, "en", "alpha")
+	topics, err := testService().ListAdminContentTopics(context.Background(), &domain.AdminUser{ID: "admin-1"}, "en", "alpha")
 	if err != nil || len(topics) != 1 {
 		t.Fatalf("ListAdminContentTopics result = %#v, err=%v", topics, err)
 	}
 
-	topicsPage, err := ListAdminContentTopicsPage(context.Background(), &domain.AdminUser{ID: "admin-1"}, domain.AdminContentTaxonomyFilter{
+	topicsPage, err := testService().ListAdminContentTopicsPage(context.Background(), &domain.AdminUser{ID: "admin-1"}, domain.AdminContentTaxonomyFilter{
 		PreferredLocale: "tr",
 	})
 	if err != nil || topicsPage == nil || topicsPage.Total != 1 {
 		t.Fatalf("ListAdminContentTopicsPage result = %#v, err=%v", topicsPage, err)
 	}
 
-	categories, err := ListAdminContentCategories(context.Background(), &domain.AdminUser{ID: "admin-1"}, "tr")
+	categories, err := testService().ListAdminContentCategories(context.Background(), &domain.AdminUser{ID: "admin-1"}, "tr")
 	if err != nil || len(categories) != 1 {
 		t.Fatalf("ListAdminContentCategories result = %#v, err=%v", categories, err)
 	}
 
-	categoriesPage, err := ListAdminContentCategoriesPage(context.Background(), &domain.AdminUser{ID: "admin-1"}, domain.AdminContentTaxonomyFilter{})
+	categoriesPage, err := testService().ListAdminContentCategoriesPage(context.Background(), &domain.AdminUser{ID: "admin-1"}, domain.AdminContentTaxonomyFilter{})
 	if err != nil || categoriesPage == nil || categoriesPage.Total != 1 {
 		t.Fatalf("ListAdminContentCategoriesPage result = %#v, err=%v", categoriesPage, err)
 	}
@@ -120,7 +120,7 @@ func TestAdminContentTopicWorkflowsPersistAndAudit(t *testing.T) {
 		},
 	}
 
-	created, err := CreateAdminContentTopic(context.Background(), &domain.AdminUser{ID: "admin-1", Email: "admin@example.com"}, domain.AdminContentTopicInput{
+	created, err := testService().CreateAdminContentTopic(context.Background(), &domain.AdminUser{ID: "admin-1", Email: "admin@example.com"}, domain.AdminContentTopicInput{
 		Locale: "en",
 		ID:     "alpha-topic",
 		Name:   "Alpha Topic",
@@ -131,7 +131,7 @@ func TestAdminContentTopicWorkflowsPersistAndAudit(t *testing.T) {
 		t.Fatalf("CreateAdminContentTopic result = %#v, err=%v", created, err)
 	}
 
-	updated, err := UpdateAdminContentTopic(context.Background(), &domain.AdminUser{ID: "admin-1", Email: "admin@example.com"}, domain.AdminContentTopicInput{
+	updated, err := testService().UpdateAdminContentTopic(context.Background(), &domain.AdminUser{ID: "admin-1", Email: "admin@example.com"}, domain.AdminContentTopicInput{
 		Locale: "en",
 		ID:     "alpha-topic",
 		Name:   "Alpha Topic Updated",
@@ -142,7 +142,7 @@ func TestAdminContentTopicWorkflowsPersistAndAudit(t *testing.T) {
 		t.Fatalf("UpdateAdminContentTopic result = %#v, err=%v", updated, err)
 	}
 
-	if err := DeleteAdminContentTopic(context.Background(), &domain.AdminUser{ID: "admin-1", Email: "admin@example.com"}, "en", "alpha-topic"); err != nil {
+	if err := testService().DeleteAdminContentTopic(context.Background(), &domain.AdminUser{ID: "admin-1", Email: "admin@example.com"}, "en", "alpha-topic"); err != nil {
 		t.Fatalf("DeleteAdminContentTopic returned error: %v", err)
 	}
 
@@ -204,7 +204,7 @@ func TestAdminContentCategoryWorkflowsPersistAndAudit(t *testing.T) {
 		},
 	}
 
-	created, err := CreateAdminContentCategory(context.Background(), &domain.AdminUser{ID: "admin-1", Email: "admin@example.com"}, domain.AdminContentCategoryInput{
+	created, err := testService().CreateAdminContentCategory(context.Background(), &domain.AdminUser{ID: "admin-1", Email: "admin@example.com"}, domain.AdminContentCategoryInput{
 		Locale: "en",
 		ID:     "alpha-category",
 		Name:   "Alpha Category",
@@ -216,7 +216,7 @@ func TestAdminContentCategoryWorkflowsPersistAndAudit(t *testing.T) {
 		t.Fatalf("CreateAdminContentCategory result = %#v, err=%v", created, err)
 	}
 
-	updated, err := UpdateAdminContentCategory(context.Background(), &domain.AdminUser{ID: "admin-1", Email: "admin@example.com"}, domain.AdminContentCategoryInput{
+	updated, err := testService().UpdateAdminContentCategory(context.Background(), &domain.AdminUser{ID: "admin-1", Email: "admin@example.com"}, domain.AdminContentCategoryInput{
 		Locale: "en",
 		ID:     "alpha-category",
 		Name:   "Alpha Category Updated",
@@ -228,7 +228,7 @@ func TestAdminContentCategoryWorkflowsPersistAndAudit(t *testing.T) {
 		t.Fatalf("UpdateAdminContentCategory result = %#v, err=%v", updated, err)
 	}
 
-	if err := DeleteAdminContentCategory(context.Background(), &domain.AdminUser{ID: "admin-1", Email: "admin@example.com"}, "en", "alpha-category"); err != nil {
+	if err := testService().DeleteAdminContentCategory(context.Background(), &domain.AdminUser{ID: "admin-1", Email: "admin@example.com"}, "en", "alpha-category"); err != nil {
 		t.Fatalf("DeleteAdminContentCategory returned error: %v", err)
 	}
 
@@ -262,7 +262,7 @@ func TestAdminContentTopicAndCategorySyncFailures(t *testing.T) {
 			},
 		}
 
-		_, err := CreateAdminContentTopic(context.Background(), adminUser, domain.AdminContentTopicInput{
+		_, err := testService().CreateAdminContentTopic(context.Background(), adminUser, domain.AdminContentTopicInput{
 			Locale: "en",
 			ID:     "alpha-topic",
 			Name:   "Alpha Topic",
@@ -287,7 +287,7 @@ func TestAdminContentTopicAndCategorySyncFailures(t *testing.T) {
 			},
 		}
 
-		_, err := CreateAdminContentCategory(context.Background(), adminUser, domain.AdminContentCategoryInput{
+		_, err := testService().CreateAdminContentCategory(context.Background(), adminUser, domain.AdminContentCategoryInput{
 			Locale: "en",
 			ID:     "alpha-category",
 			Name:   "Alpha Category",
@@ -10,33 +10,31 @@ import (
 	"suaybsimsek.com/blog-api/pkg/apperrors"
 )
 
-var adminDashboardRepository repository.AdminDashboardRepository = repository.NewAdminDashboardMongoRepository()
-
-func QueryAdminDashboard(ctx context.Context) (*domain.AdminDashboard, error) {
+func (s *Service) QueryAdminDashboard(ctx context.Context) (*domain.AdminDashboard, error) {
 	operationCtx, cancel := context.WithTimeout(ctx, 15*time.Second)
 	defer cancel()
 
-	totalPosts, err := adminDashboardRepository.CountDistinctPosts(operationCtx)
+	totalPosts, err := s.adminDashboard.CountDistinctPosts(operationCtx)
 	if err != nil {
 		return nil, toAdminDashboardError(err)
 	}
 
-	totalSubscribers, err := adminDashboardRepository.CountActiveSubscribers(operationCtx)
+	totalSubscribers, err := s.adminDashboard.CountActiveSubscribers(operationCtx)
 	if err != nil {
 		return nil, toAdminDashboardError(err)
 	}
 
-	topViewedPosts, err := adminDashboardRepository.ListTopPostsByHits(operationCtx, 5)
+	topViewedPosts, err := s.adminDashboard.ListTopPostsByHits(operationCtx, 5)
 	if err != nil {
 		return nil, toAdminDashboardError(err)
 	}
 
-	topLikedPosts, err := adminDashboardRepository.ListTopPostsByLikes(operationCtx, 5)
+	topLikedPosts, err := s.adminDashboard.ListTopPostsByLikes(operationCtx, 5)
 	if err != nil {
 		return nil, toAdminDashboardError(err)
 	}
 
-	contentHealth, err := adminDashboardRepository.BuildContentHealthSummary(operationCtx)
+	contentHealth, err := s.adminDashboard.BuildContentHealthSummary(operationCtx)
 	if err != nil {
 		return nil, toAdminDashboardError(err)
 	}
@@ -75,7 +75,7 @@ func TestQueryAdminDashboardAggregatesRepositoryData(t *testing.T) {
 		},
 	}
 
-	result, err := QueryAdminDashboard(context.Background())
+	result, err := testService().QueryAdminDashboard(context.Background())
 	if err != nil {
 		t.Fatalf("QueryAdminDashboard returned error: %v", err)
 	}
@@ -164,7 +164,7 @@ func TestQueryAdminDashboardMapsRepositoryErrors(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			adminDashboardRepository = testCase.repo
 
-			_, err := QueryAdminDashboard(context.Background())
+			_, err := testService().QueryAdminDashboard(context.Background())
 			if err == nil || err.Error() == "" || !strings.Contains(err.Error(), testCase.contains) {
 				t.Fatalf("expected error containing %q, got %v", testCase.contains, err)
 			}
@@ -8,7 +8,6 @@ import (
 	"time"
 
 	appconfig "suaybsimsek.com/blog-api/internal/config"
-	"suaybsimsek.com/blog-api/internal/repository"
 	"suaybsimsek.com/blog-api/pkg/httpapi"
 	newsletterpkg "suaybsimsek.com/blog-api/pkg/newsletter"
 )
@@ -22,8 +21,6 @@ const (
 	adminErrorCodeUnauthorized = "UNAUTHORIZED"
 )
 
-var adminErrorMessageRepository repository.ErrorMessageRepository = repository.NewErrorMessageRepository()
-
 var (
 	adminErrorCatalogMu     sync.RWMutex
 	adminErrorCatalogCache  map[string]map[string]string
@@ -43,6 +40,7 @@ func resolveEmbeddedAdminErrorCatalog() map[string]map[string]string {
 			"INVALID_CREDENTIALS":                     "Invalid email or password.",
 			"ADMIN_SESSION_INVALID":                   "Your session expired. Sign in again.",
 			"ADMIN_AUTH_REQUIRED":                     "Your session expired. Sign in again.",
+			"ADMIN_FORBIDDEN":                         "You do not have permission to perform this action.",
 			"INVALID_CSRF_TOKEN":                      "Security verification failed. Refresh the page and try again.",
 			"SERVICE_UNAVAILABLE":                     "Admin service is temporarily unavailable. Please try again.",
 			"ADMIN_COMMENT_STATUS_INVALID":            "Select a valid comment status.",
@@ -58,13 +56,36 @@ func resolveEmbeddedAdminErrorCatalog() map[string]map[string]string {
 			"ADMIN_PASSWORD_RESET_PASSWORD_REQUIRED":  "Enter a new password.",
 			"ADMIN_PASSWORD_RESET_PASSWORD_TOO_SHORT": "Use at least 8 characters.",
 			"ADMIN_PASSWORD_RESET_CONFIRM_MISMATCH":   "Password confirmation does not match.",
+			"ADMIN_USER_NOT_FOUND":                    "The selected admin was not found.",
+			"ADMIN_USER_EMAIL_TAKEN":                  "An admin with this email address already exists.",
+			"ADMIN_USER_ROLES_INVALID":                "Select at least one valid role.",
+			"ADMIN_USER_SELF_CHANGE":                  "You cannot change your own access.",
+			"ADMIN_INVITATION_TOKEN_INVALID":          "This invitation link is invalid.",
+			"ADMIN_INVITATION_TOKEN_EXPIRED":          "This invitation link has expired.",
+			"ADMIN_TWO_FACTOR_ALREADY_ENABLED":        "Two-factor authentication is already enabled.",
+			"ADMIN_TWO_FACTOR_NOT_ENABLED":            "Two-factor authentication is not enabled.",
+			"ADMIN_TWO_FACTOR_ENROLLMENT_REQUIRED":    "Start two-factor setup again to get a new secret.",
+			"ADMIN_TWO_FACTOR_CODE_INVALID":           "The verification code is invalid.",
+			"ADMIN_TWO_FACTOR_CHALLENGE_EXPIRED":      "Your sign-in attempt has expired. Sign in again.",
+			"ADMIN_PASSKEY_INVALID":                   "The passkey could not be verified. Try again.",
+			"ADMIN_PASSKEY_ALREADY_REGISTERED":        "This passkey is already registered.",
+			"ADMIN_PASSKEY_LOGIN_FAILED":              "Passkey sign-in failed.",
+			"ADMIN_PASSKEY_CHALLENGE_EXPIRED":         "The passkey request has expired. Try again.",
+			"ADMIN_LOGIN_THROTTLED":                   "Too many sign-in attempts. Wait a moment and try again.",
+			"ADMIN_LOGIN_LOCKED":                      "Sign-in is temporarily locked after too many failed attempts. Try again later.",
+			"ADMIN_PASSWORD_RESET_THROTTLED":          "Too many password reset requests. Try again later.",
+			"ADMIN_ACCESS_TOKEN_NAME_REQUIRED":        "Enter a name for the access token.",
+			"ADMIN_ACCESS_TOKEN_SCOPES_INVALID":       "Select at least one permission you have.",
+			"ADMIN_ACCESS_TOKEN_EXPIRY_INVALID":       "Access tokens must expire within 1 to 365 days.",
+			"ADMIN_ACCESS_TOKEN_LIMIT_REACHED":        "You have too many access tokens. Revoke one before creating another.",
+			"ADMIN_ACCESS_TOKEN_SESSION_REQUIRED":     "Access tokens and account security can only be managed after signing in.",
 			adminErrorCodeBadRequest:                  "Request is invalid.",
 			adminErrorCodeUnauthorized:                "Authentication is required.",
 		},
 	}
 }
 
-func ResolveAdminErrorMessage(ctx context.Context, code, fallbackMessage string) string {
+func (s *Service) ResolveAdminErrorMessage(ctx context.Context, code, fallbackMessage string) string {
 	normalizedCode := strings.TrimSpace(strings.ToUpper(code))
 	resolvedFallback := strings.TrimSpace(fallbackMessage)
 	if normalizedCode == "" {
@@ -75,7 +96,7 @@ func ResolveAdminErrorMessage(ctx context.Context, code, fallbackMessage string)
 	}
 
 	locale := resolveAdminErrorLocale(ctx)
-	catalog := getAdminErrorCatalog(ctx)
+	catalog := s.getAdminErrorCatalog(ctx)
 
 	if localized := lookupAdminErrorMessage(catalog, locale, normalizedCode); localized != "" {
 		return localized
@@ -98,7 +119,7 @@ func resolveAdminErrorLocale(ctx context.Context) string {
 	return newsletterpkg.ResolveLocale("", acceptLanguage)
 }
 
-func getAdminErrorCatalog(ctx context.Context) map[string]map[string]string {
+func (s *Service) getAdminErrorCatalog(ctx context.Context) map[string]map[string]string {
 	now := time.Now().UTC()
 
 	adminErrorCatalogMu.RLock()
@@ -118,7 +139,7 @@ func getAdminErrorCatalog(ctx context.Context) map[string]map[string]string {
 
 	catalog := cloneAdminErrorCatalog(defaultAdminErrorCatalog)
 
-	records, err := adminErrorMessageRepository.ListByScope(ctx, adminErrorMessageScope)
+	records, err := s.errorMessages.ListByScope(ctx, adminErrorMessageScope)
 	if err == nil {
 		for _, record := range records {
 			locale := strings.TrimSpace(strings.ToLower(record.Locale))
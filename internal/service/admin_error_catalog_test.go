@@ -51,7 +51,7 @@ func TestResolveAdminErrorMessageUsesLocaleDefaults(t *testing.T) {
 	adminErrorCatalogExpiry = time.Time{}
 
 	ctx := httpapi.WithRequestTrace(context.Background(), httpapi.RequestTrace{AcceptLang: "tr-TR,tr;q=0.9"})
-	message := ResolveAdminErrorMessage(ctx, "INVALID_CREDENTIALS", "invalid credentials")
+	message := testService().ResolveAdminErrorMessage(ctx, "INVALID_CREDENTIALS", "invalid credentials")
 	if message != "E-posta veya parola hatalı." {
 		t.Fatalf("message = %q", message)
 	}
@@ -83,7 +83,7 @@ func TestResolveAdminErrorMessageUsesRepositoryOverride(t *testing.T) {
 	adminErrorCatalogExpiry = time.Time{}
 
 	ctx := httpapi.WithRequestTrace(context.Background(), httpapi.RequestTrace{AcceptLang: "tr"})
-	message := ResolveAdminErrorMessage(ctx, "INVALID_CREDENTIALS", "invalid credentials")
+	message := testService().ResolveAdminErrorMessage(ctx, "INVALID_CREDENTIALS", "invalid credentials")
 	if message != "Özel DB mesajı" {
 		t.Fatalf("message = %q", message)
 	}
@@ -103,7 +103,7 @@ func TestResolveAdminErrorMessageFallsBackToInputMessage(t *testing.T) {
 	adminErrorCatalogCache = nil
 	adminErrorCatalogExpiry = time.Time{}
 
-	message := ResolveAdminErrorMessage(context.Background(), "UNKNOWN_CODE", "fallback message")
+	message := testService().ResolveAdminErrorMessage(context.Background(), "UNKNOWN_CODE", "fallback message")
 	if message != "fallback message" {
 		t.Fatalf("message = %q", message)
 	}
@@ -122,10 +122,10 @@ func TestAdminErrorCatalogFallbacksAndCacheHelpers(t *testing.T) {
 	})
 
 	t.Run("empty code uses fallback or default", func(t *testing.T) {
-		if message := ResolveAdminErrorMessage(context.Background(), " ", " fallback "); message != "fallback" {
+		if message := testService().ResolveAdminErrorMessage(context.Background(), " ", " fallback "); message != "fallback" {
 			t.Fatalf("expected trimmed fallback, got %q", message)
 		}
-		if message := ResolveAdminErrorMessage(context.Background(), "", " "); message != "Admin request failed." {
+		if message := testService().ResolveAdminErrorMessage(context.Background(), "", " "); message != "Admin request failed." {
 			t.Fatalf("expected default message, got %q", message)
 		}
 	})
@@ -136,7 +136,7 @@ func TestAdminErrorCatalogFallbacksAndCacheHelpers(t *testing.T) {
 		adminErrorCatalogExpiry = time.Time{}
 
 		ctx := httpapi.WithRequestTrace(context.Background(), httpapi.RequestTrace{AcceptLang: "fr-FR"})
-		message := ResolveAdminErrorMessage(ctx, adminErrorCodeUnauthorized, "")
+		message := testService().ResolveAdminErrorMessage(ctx, adminErrorCodeUnauthorized, "")
 		if message != "Authentication is required." {
 			t.Fatalf("expected english fallback, got %q", message)
 		}
@@ -190,8 +190,8 @@ func TestAdminErrorCatalogFallbacksAndCacheHelpers(t *testing.T) {
 		adminErrorCatalogCache = nil
 		adminErrorCatalogExpiry = time.Time{}
 
-		first := getAdminErrorCatalog(context.Background())
-		second := getAdminErrorCatalog(context.Background())
+		first := testService().getAdminErrorCatalog(context.Background())
+		second := testService().getAdminErrorCatalog(context.Background())
 		if calls != 1 {
 			t.Fatalf("expected repository to be read once, got %d", calls)
 		}
@@ -9,7 +9,6 @@ import (
 	"time"
 
 	"suaybsimsek.com/blog-api/internal/domain"
-	"suaybsimsek.com/blog-api/internal/repository"
 	"suaybsimsek.com/blog-api/pkg/apperrors"
 	"suaybsimsek.com/blog-api/pkg/httpapi"
 )
@@ -25,11 +24,10 @@ const (
 )
 
 var (
-	adminErrorCodePattern                                    = regexp.MustCompile(`^[A-Z0-9_]{2,120}$`)
-	adminAuditLogRepo     repository.AdminAuditLogRepository = repository.NewAdminAuditLogRepository()
+	adminErrorCodePattern = regexp.MustCompile(`^[A-Z0-9_]{2,120}$`)
 )
 
-func ListAdminErrorMessages(
+func (s *Service) ListAdminErrorMessages(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	filter domain.AdminErrorMessageFilter,
@@ -38,7 +36,7 @@ func ListAdminErrorMessages(
 		return nil, apperrors.Unauthorized(adminErrorMessageAuthRequired)
 	}
 
-	records, err := adminErrorMessageRepository.ListByScope(ctx, adminErrorMessageScope)
+	records, err := s.errorMessages.ListByScope(ctx, adminErrorMessageScope)
 	if err != nil {
 		return nil, apperrors.Internal("failed to load admin error messages", err)
 	}
@@ -92,7 +90,7 @@ func ListAdminErrorMessages(
 	}, nil
 }
 
-func CreateAdminErrorMessage(
+func (s *Service) CreateAdminErrorMessage(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	key domain.AdminErrorMessageKey,
@@ -115,7 +113,7 @@ func CreateAdminErrorMessage(
 		return nil, apperrors.BadRequest("error message is too long")
 	}
 
-	beforeValue, err := loadCurrentAdminErrorMessageValue(ctx, resolvedKey)
+	beforeValue, err := s.loadCurrentAdminErrorMessageValue(ctx, resolvedKey)
 	if err != nil {
 		return nil, err
 	}
@@ -124,7 +122,7 @@ func CreateAdminErrorMessage(
 	}
 
 	now := time.Now().UTC()
-	if err := adminErrorMessageRepository.UpsertMany(ctx, []domain.ErrorMessageRecord{
+	if err := s.errorMessages.UpsertMany(ctx, []domain.ErrorMessageRecord{
 		{
 			Scope:     resolvedKey.Scope,
 			Locale:    resolvedKey.Locale,
@@ -138,7 +136,7 @@ func CreateAdminErrorMessage(
 
 	InvalidateAdminErrorCatalogCache()
 
-	if err := createAdminErrorMessageAuditLog(
+	if err := s.createAdminErrorMessageAuditLog(
 		ctx,
 		adminUser,
 		"error_message_created",
@@ -158,7 +156,7 @@ func CreateAdminErrorMessage(
 	}, nil
 }
 
-func UpdateAdminErrorMessage(
+func (s *Service) UpdateAdminErrorMessage(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	key domain.AdminErrorMessageKey,
@@ -181,7 +179,7 @@ func UpdateAdminErrorMessage(
 		return nil, apperrors.BadRequest("error message is too long")
 	}
 
-	beforeValue, err := loadCurrentAdminErrorMessageValue(ctx, resolvedKey)
+	beforeValue, err := s.loadCurrentAdminErrorMessageValue(ctx, resolvedKey)
 	if err != nil {
 		return nil, err
 	}
@@ -190,7 +188,7 @@ func UpdateAdminErrorMessage(
 	}
 
 	now := time.Now().UTC()
-	if err := adminErrorMessageRepository.UpsertMany(ctx, []domain.ErrorMessageRecord{
+	if err := s.errorMessages.UpsertMany(ctx, []domain.ErrorMessageRecord{
 		{
 			Scope:     resolvedKey.Scope,
 			Locale:    resolvedKey.Locale,
@@ -204,7 +202,7 @@ func UpdateAdminErrorMessage(
 
 	InvalidateAdminErrorCatalogCache()
 
-	if err := createAdminErrorMessageAuditLog(
+	if err := s.createAdminErrorMessageAuditLog(
 		ctx,
 		adminUser,
 		"error_message_updated",
@@ -224,7 +222,7 @@ func UpdateAdminErrorMessage(
 	}, nil
 }
 
-func DeleteAdminErrorMessage(
+func (s *Service) DeleteAdminErrorMessage(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	key domain.AdminErrorMessageKey,
@@ -238,7 +236,7 @@ func DeleteAdminErrorMessage(
 		return err
 	}
 
-	beforeValue, err := loadCurrentAdminErrorMessageValue(ctx, resolvedKey)
+	beforeValue, err := s.loadCurrentAdminErrorMessageValue(ctx, resolvedKey)
 	if err != nil {
 		return err
 	}
@@ -246,7 +244,7 @@ func DeleteAdminErrorMessage(
 		return apperrors.BadRequest(adminErrorMessageNotFound)
 	}
 
-	deleted, err := adminErrorMessageRepository.DeleteByKey(
+	deleted, err := s.errorMessages.DeleteByKey(
 		ctx,
 		resolvedKey.Scope,
 		resolvedKey.Locale,
@@ -261,7 +259,7 @@ func DeleteAdminErrorMessage(
 
 	InvalidateAdminErrorCatalogCache()
 
-	if err := createAdminErrorMessageAuditLog(
+	if err := s.createAdminErrorMessageAuditLog(
 		ctx,
 		adminUser,
 		"error_message_deleted",
@@ -277,7 +275,7 @@ func DeleteAdminErrorMessage(
 	return nil
 }
 
-func ListAdminErrorMessageAuditLogs(
+func (s *Service) ListAdminErrorMessageAuditLogs(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	limit int,
@@ -286,7 +284,7 @@ func ListAdminErrorMessageAuditLogs(
 		return nil, apperrors.Unauthorized(adminErrorMessageAuthRequired)
 	}
 
-	logs, err := adminAuditLogRepo.ListRecentByResource(ctx, adminErrorMessageAuditResource, limit)
+	logs, err := s.adminAuditLogs.ListRecentByResource(ctx, adminErrorMessageAuditResource, limit)
 	if err != nil {
 		return nil, apperrors.Internal("failed to load admin audit logs", err)
 	}
@@ -365,8 +363,8 @@ func mapAdminErrorMessageRecord(record domain.ErrorMessageRecord) domain.AdminEr
 	}
 }
 
-func loadCurrentAdminErrorMessageValue(ctx context.Context, key domain.AdminErrorMessageKey) (string, error) {
-	records, err := adminErrorMessageRepository.ListByScope(ctx, key.Scope)
+func (s *Service) loadCurrentAdminErrorMessageValue(ctx context.Context, key domain.AdminErrorMessageKey) (string, error) {
+	records, err := s.errorMessages.ListByScope(ctx, key.Scope)
 	if err != nil {
 		return "", apperrors.Internal("failed to load admin error messages", err)
 	}
@@ -382,7 +380,7 @@ func loadCurrentAdminErrorMessageValue(ctx context.Context, key domain.AdminErro
 	return "", nil
 }
 
-func createAdminErrorMessageAuditLog( // NOSONAR
+func (s *Service) createAdminErrorMessageAuditLog( // NOSONAR
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	action string,
@@ -416,18 +414,18 @@ func createAdminErrorMessageAuditLog( // NOSONAR
 		CreatedAt:   time.Now().UTC(),
 	}
 
-	if err := adminAuditLogRepo.Create(ctx, record); err != nil {
+	if err := s.adminAuditLogs.Create(ctx, record); err != nil {
 		return apperrors.Internal("failed to persist admin audit log", err)
 	}
 
 	return nil
 }
 
-func SaveAdminErrorMessages(ctx context.Context, records []domain.ErrorMessageRecord) error {
+func (s *Service) SaveAdminErrorMessages(ctx context.Context, records []domain.ErrorMessageRecord) error {
 	if len(records) == 0 {
 		return nil
 	}
-	if err := adminErrorMessageRepository.UpsertMany(ctx, records); err != nil {
+	if err := s.errorMessages.UpsertMany(ctx, records); err != nil {
 		return err
 	}
 
@@ -126,7 +126,7 @@ func TestListAdminErrorMessagesAppliesFilterAndPagination(t *testing.T) {
 
 	page := 2
 	size := 1
-	result, err := ListAdminErrorMessages(
+	result, err := testService().ListAdminErrorMessages(
 		context.Background(),
 		&domain.AdminUser{ID: "admin-1"},
 		domain.AdminErrorMessageFilter{
@@ -170,7 +170,7 @@ func TestCreateAdminErrorMessagePersistsAndAudits(t *testing.T) {
 	adminErrorMessageRepository = repo
 	adminAuditLogRepo = audit
 
-	saved, err := CreateAdminErrorMessage(
+	saved, err := testService().CreateAdminErrorMessage(
 		context.Background(),
 		&domain.AdminUser{
 			ID:    "admin-1",
@@ -228,7 +228,7 @@ func TestUpdateAdminErrorMessagePersistsAndAudits(t *testing.T) {
 	adminErrorMessageRepository = repo
 	adminAuditLogRepo = audit
 
-	updated, err := UpdateAdminErrorMessage(
+	updated, err := testService().UpdateAdminErrorMessage(
 		context.Background(),
 		&domain.AdminUser{
 			ID:    "admin-1",
@@ -285,7 +285,7 @@ func TestDeleteAdminErrorMessageRemovesAndAudits(t *testing.T) {
 	adminErrorMessageRepository = repo
 	adminAuditLogRepo = audit
 
-	err := DeleteAdminErrorMessage(
+	err := testService().DeleteAdminErrorMessage(
 		context.Background(),
 		&domain.AdminUser{
 			ID:    "admin-1",
@@ -328,7 +328,7 @@ func TestCreateAdminErrorMessageReturnsInternalOnAuditFailure(t *testing.T) {
 	adminErrorMessageRepository = repo
 	adminAuditLogRepo = audit
 
-	_, err := CreateAdminErrorMessage(
+	_, err := testService().CreateAdminErrorMessage(
 		context.Background(),
 		&domain.AdminUser{
 			ID:    "admin-1",
@@ -360,7 +360,7 @@ func TestListAdminErrorMessageAuditLogsReturnsRecentRecords(t *testing.T) {
 		},
 	}
 
-	records, err := ListAdminErrorMessageAuditLogs(context.Background(), &domain.AdminUser{ID: "admin-1"}, 1)
+	records, err := testService().ListAdminErrorMessageAuditLogs(context.Background(), &domain.AdminUser{ID: "admin-1"}, 1)
 	if err != nil {
 		t.Fatalf("ListAdminErrorMessageAuditLogs returned error: %v", err)
 	}
@@ -378,7 +378,7 @@ func TestSaveAdminErrorMessagesPersistsRecordsAndInvalidatesCache(t *testing.T)
 	repo := newAdminErrorMessageManagementStubRepository(nil)
 	adminErrorMessageRepository = repo
 
-	if err := SaveAdminErrorMessages(context.Background(), []domain.ErrorMessageRecord{
+	if err := testService().SaveAdminErrorMessages(context.Background(), []domain.ErrorMessageRecord{
 		{
 			Scope:   adminErrorMessageScope,
 			Locale:  "en",
@@ -394,7 +394,7 @@ func TestSaveAdminErrorMessagesPersistsRecordsAndInvalidatesCache(t *testing.T)
 		t.Fatalf("unexpected persisted record: %#v", record)
 	}
 
-	if err := SaveAdminErrorMessages(context.Background(), nil); err != nil {
+	if err := testService().SaveAdminErrorMessages(context.Background(), nil); err != nil {
 		t.Fatalf("SaveAdminErrorMessages(nil) returned error: %v", err)
 	}
 }
@@ -447,7 +447,7 @@ func TestNormalizeAdminErrorMessageKeyAndAuditHelpers(t *testing.T) {
 		UserAgent:   "Mozilla/5.0",
 	})
 
-	err = createAdminErrorMessageAuditLog(
+	err = testService().createAdminErrorMessageAuditLog(
 		traceCtx,
 		&domain.AdminUser{ID: "admin-1", Email: "admin@example.com"},
 		"error_message_updated",
@@ -476,22 +476,22 @@ func TestAdminErrorMessageManagementErrorPaths(t *testing.T) {
 	t.Run("requires admin authentication", func(t *testing.T) {
 		key := domain.AdminErrorMessageKey{Locale: "en", Code: "ADMIN_TEST"}
 
-		if _, err := ListAdminErrorMessages(context.Background(), nil, domain.AdminErrorMessageFilter{}); err == nil || err.Error() != "admin authentication required" {
+		if _, err := testService().ListAdminErrorMessages(context.Background(), nil, domain.AdminErrorMessageFilter{}); err == nil || err.Error() != "admin authentication required" {
 			t.Fatalf("expected auth error for list, got %v", err)
 		}
-		if _, err := CreateAdminErrorMessage(context.Background(), nil, key, "value"); err == nil || err.Error() != "admin authentication required" {
+		if _, err := testService().CreateAdminErrorMessage(context.Background(), nil, key, "value"); err == nil || err.Error() != "admin authentication required" {
 			t.Fatalf("expected auth error for create, got %v", err)
 		}
-		if _, err := UpdateAdminErrorMessage(context.Background(), nil, key, "value"); err == nil || err.Error() != "admin authentication required" {
+		if _, err := testService().UpdateAdminErrorMessage(context.Background(), nil, key, "value"); err == nil || err.Error() != "admin authentication required" {
 			t.Fatalf("expected auth error for update, got %v", err)
 		}
-		if err := DeleteAdminErrorMessage(context.Background(), nil, key); err == nil || err.Error() != "admin authentication required" {
+		if err := testService().DeleteAdminErrorMessage(context.Background(), nil, key); err == nil || err.Error() != "admin authentication required" {
 			t.Fatalf("expected auth error for delete, got %v", err)
 		}
-		if _, err := ListAdminErrorMessageAuditLogs(context.Background(), nil, 5); err == nil || err.Error() != "admin authentication required" {
+		if _, err := testService().ListAdminErrorMessageAuditLogs(context.Background(), nil, 5); err == nil || err.Error() != "admin authentication required" {
 			t.Fatalf("expected auth error for audit logs, got %v", err)
 		}
-		if err := createAdminErrorMessageAuditLog(context.Background(), nil, "action", key, "", "", adminAuditStatusSuccess, ""); err == nil || err.Error() != "admin authentication required" {
+		if err := testService().createAdminErrorMessageAuditLog(context.Background(), nil, "action", key, "", "", adminAuditStatusSuccess, ""); err == nil || err.Error() != "admin authentication required" {
 			t.Fatalf("expected auth error for audit create, got %v", err)
 		}
 	})
@@ -501,13 +501,13 @@ func TestAdminErrorMessageManagementErrorPaths(t *testing.T) {
 		repo.listErr = errors.New("list down")
 		adminErrorMessageRepository = repo
 
-		if _, err := ListAdminErrorMessages(context.Background(), &domain.AdminUser{ID: "admin-1"}, domain.AdminErrorMessageFilter{}); err == nil || !strings.Contains(err.Error(), "failed to load admin error messages") {
+		if _, err := testService().ListAdminErrorMessages(context.Background(), &domain.AdminUser{ID: "admin-1"}, domain.AdminErrorMessageFilter{}); err == nil || !strings.Contains(err.Error(), "failed to load admin error messages") {
 			t.Fatalf("expected list error, got %v", err)
 		}
 
 		audit := &adminErrorMessageManagementAuditStub{err: errors.New("audit list down")}
 		adminAuditLogRepo = audit
-		if _, err := ListAdminErrorMessageAuditLogs(context.Background(), &domain.AdminUser{ID: "admin-1"}, 1); err == nil || !strings.Contains(err.Error(), "failed to load admin audit logs") {
+		if _, err := testService().ListAdminErrorMessageAuditLogs(context.Background(), &domain.AdminUser{ID: "admin-1"}, 1); err == nil || !strings.Contains(err.Error(), "failed to load admin audit logs") {
 			t.Fatalf("expected audit list error, got %v", err)
 		}
 	})
@@ -520,24 +520,24 @@ func TestAdminErrorMessageManagementErrorPaths(t *testing.T) {
 		adminAuditLogRepo = &adminErrorMessageManagementAuditStub{}
 		admin := &domain.AdminUser{ID: "admin-1", Email: "admin@example.com"}
 
-		if _, err := CreateAdminErrorMessage(context.Background(), admin, domain.AdminErrorMessageKey{Locale: "en", Code: "ADMIN_NEW"}, " "); err == nil || err.Error() != "error message is required" {
+		if _, err := testService().CreateAdminErrorMessage(context.Background(), admin, domain.AdminErrorMessageKey{Locale: "en", Code: "ADMIN_NEW"}, " "); err == nil || err.Error() != "error message is required" {
 			t.Fatalf("expected create required message error, got %v", err)
 		}
-		if _, err := CreateAdminErrorMessage(context.Background(), admin, domain.AdminErrorMessageKey{Locale: "en", Code: "ADMIN_TOO_LONG"}, strings.Repeat("a", adminErrorMessageMaxLength+1)); err == nil || err.Error() != "error message is too long" {
+		if _, err := testService().CreateAdminErrorMessage(context.Background(), admin, domain.AdminErrorMessageKey{Locale: "en", Code: "ADMIN_TOO_LONG"}, strings.Repeat("a", adminErrorMessageMaxLength+1)); err == nil || err.Error() != "error message is too long" {
 			t.Fatalf("expected create too long error, got %v", err)
 		}
-		if _, err := CreateAdminErrorMessage(context.Background(), admin, domain.AdminErrorMessageKey{Locale: "en", Code: "ADMIN_EXISTS"}, "New value"); err == nil || err.Error() != "admin error message already exists" {
+		if _, err := testService().CreateAdminErrorMessage(context.Background(), admin, domain.AdminErrorMessageKey{Locale: "en", Code: "ADMIN_EXISTS"}, "New value"); err == nil || err.Error() != "admin error message already exists" {
 			t.Fatalf("expected duplicate create error, got %v", err)
 		}
 
-		if _, err := UpdateAdminErrorMessage(context.Background(), admin, domain.AdminErrorMessageKey{Locale: "en", Code: "ADMIN_MISSING"}, "Updated"); err == nil || err.Error() != "admin error message not found" {
+		if _, err := testService().UpdateAdminErrorMessage(context.Background(), admin, domain.AdminErrorMessageKey{Locale: "en", Code: "ADMIN_MISSING"}, "Updated"); err == nil || err.Error() != "admin error message not found" {
 			t.Fatalf("expected update missing error, got %v", err)
 		}
-		if _, err := UpdateAdminErrorMessage(context.Background(), admin, domain.AdminErrorMessageKey{Locale: "en", Code: "ADMIN_EXISTS"}, strings.Repeat("b", adminErrorMessageMaxLength+1)); err == nil || err.Error() != "error message is too long" {
+		if _, err := testService().UpdateAdminErrorMessage(context.Background(), admin, domain.AdminErrorMessageKey{Locale: "en", Code: "ADMIN_EXISTS"}, strings.Repeat("b", adminErrorMessageMaxLength+1)); err == nil || err.Error() != "error message is too long" {
 			t.Fatalf("expected update too long error, got %v", err)
 		}
 
-		if err := DeleteAdminErrorMessage(context.Background(), admin, domain.AdminErrorMessageKey{Locale: "en", Code: "ADMIN_MISSING"}); err == nil || err.Error() != "admin error message not found" {
+		if err := testService().DeleteAdminErrorMessage(context.Background(), admin, domain.AdminErrorMessageKey{Locale: "en", Code: "ADMIN_MISSING"}); err == nil || err.Error() != "admin error message not found" {
 			t.Fatalf("expected delete missing error, got %v", err)
 		}
 	})
@@ -549,7 +549,7 @@ func TestAdminErrorMessageManagementErrorPaths(t *testing.T) {
 		repo.upsertErr = errors.New("upsert failed")
 		adminErrorMessageRepository = repo
 		adminAuditLogRepo = &adminErrorMessageManagementAuditStub{}
-		if _, err := CreateAdminErrorMessage(context.Background(), admin, domain.AdminErrorMessageKey{Locale: "en", Code: "ADMIN_CREATE_FAIL"}, "Value"); err == nil || !strings.Contains(err.Error(), "failed to persist admin error message") {
+		if _, err := testService().CreateAdminErrorMessage(context.Background(), admin, domain.AdminErrorMessageKey{Locale: "en", Code: "ADMIN_CREATE_FAIL"}, "Value"); err == nil || !strings.Contains(err.Error(), "failed to persist admin error message") {
 			t.Fatalf("expected create persist error, got %v", err)
 		}
 
@@ -558,7 +558,7 @@ func TestAdminErrorMessageManagementErrorPaths(t *testing.T) {
 		})
 		repo.upsertErr = errors.New("upsert failed")
 		adminErrorMessageRepository = repo
-		if _, err := UpdateAdminErrorMessage(context.Background(), admin, domain.AdminErrorMessageKey{Locale: "en", Code: "ADMIN_UPDATE_FAIL"}, "New"); err == nil || !strings.Contains(err.Error(), "failed to persist admin error message") {
+		if _, err := testService().UpdateAdminErrorMessage(context.Background(), admin, domain.AdminErrorMessageKey{Locale: "en", Code: "ADMIN_UPDATE_FAIL"}, "New"); err == nil || !strings.Contains(err.Error(), "failed to persist admin error message") {
 			t.Fatalf("expected update persist error, got %v", err)
 		}
 
@@ -567,7 +567,7 @@ func TestAdminErrorMessageManagementErrorPaths(t *testing.T) {
 		})
 		repo.deleteErr = errors.New("delete failed")
 		adminErrorMessageRepository = repo
-		if err := DeleteAdminErrorMessage(context.Background(), admin, domain.AdminErrorMessageKey{Locale: "en", Code: "ADMIN_DELETE_FAIL"}); err == nil || !strings.Contains(err.Error(), "failed to delete admin error message") {
+		if err := testService().DeleteAdminErrorMessage(context.Background(), admin, domain.AdminErrorMessageKey{Locale: "en", Code: "ADMIN_DELETE_FAIL"}); err == nil || !strings.Contains(err.Error(), "failed to delete admin error message") {
 			t.Fatalf("expected delete failure, got %v", err)
 		}
 
@@ -575,7 +575,7 @@ func TestAdminErrorMessageManagementErrorPaths(t *testing.T) {
 		adminErrorMessageRepository = newAdminErrorMessageManagementStubRepository([]domain.ErrorMessageRecord{
 			{Scope: adminErrorMessageScope, Locale: "en", Code: "ADMIN_DELETE_MISSING", Message: "Old"},
 		})
-		if err := createAdminErrorMessageAuditLog(context.Background(), admin, "action", domain.AdminErrorMessageKey{Locale: "en", Code: "ADMIN_TEST"}, "", "", adminAuditStatusSuccess, ""); err == nil || !strings.Contains(err.Error(), "failed to persist admin audit log") {
+		if err := testService().createAdminErrorMessageAuditLog(context.Background(), admin, "action", domain.AdminErrorMessageKey{Locale: "en", Code: "ADMIN_TEST"}, "", "", adminAuditStatusSuccess, ""); err == nil || !strings.Contains(err.Error(), "failed to persist admin audit log") {
 			t.Fatalf("expected audit create failure, got %v", err)
 		}
 	})
@@ -607,7 +607,7 @@ func TestAdminErrorMessageHelpersMapAndFilterRecords(t *testing.T) {
 	adminErrorMessageRepository = newAdminErrorMessageManagementStubRepository([]domain.ErrorMessageRecord{
 		{Scope: adminErrorMessageScope, Locale: "en", Code: "ADMIN_TEST", Message: "Stored"},
 	})
-	value, err := loadCurrentAdminErrorMessageValue(context.Background(), domain.AdminErrorMessageKey{
+	value, err := testService().loadCurrentAdminErrorMessageValue(context.Background(), domain.AdminErrorMessageKey{
 		Scope:  adminErrorMessageScope,
 		Locale: "en",
 		Code:   "ADMIN_TEST",
@@ -617,7 +617,7 @@ func TestAdminErrorMessageHelpersMapAndFilterRecords(t *testing.T) {
 	}
 
 	adminErrorMessageRepository = &adminErrorMessageManagementStubRepository{listErr: errors.New("list failed")}
-	if _, err := loadCurrentAdminErrorMessageValue(context.Background(), domain.AdminErrorMessageKey{
+	if _, err := testService().loadCurrentAdminErrorMessageValue(context.Background(), domain.AdminErrorMessageKey{
 		Scope:  adminErrorMessageScope,
 		Locale: "en",
 		Code:   "ADMIN_TEST",
@@ -56,13 +56,13 @@ var (
 	fetchGithubUserInfoFn             = fetchGithubUserInfo
 )
 
-func QueryAdminGithubAuthStatus(ctx context.Context) (*AdminGithubAuthStatusResult, error) {
+func (s *Service) QueryAdminGithubAuthStatus(ctx context.Context) (*AdminGithubAuthStatusResult, error) {
 	config := resolveGithubConfigFn()
 	if !config.Enabled() {
 		return &AdminGithubAuthStatusResult{}, nil
 	}
 
-	hasLink, err := adminUsersRepository.HasAnyGithubLink(ctx)
+	hasLink, err := s.adminUsers.HasAnyGithubLink(ctx)
 	if err != nil {
 		return nil, apperrors.Internal("failed to load github auth status", err)
 	}
@@ -105,7 +105,7 @@ func ResolveAdminGithubIdentityFromCode(
 	return identity, nil
 }
 
-func LinkAdminGithubAccount(
+func (s *Service) LinkAdminGithubAccount(
 	ctx context.Context,
 	adminUserID string,
 	identity *AdminGithubIdentity,
@@ -115,7 +115,7 @@ func LinkAdminGithubAccount(
 		return nil, apperrors.Unauthorized(adminAuthRequiredMessage)
 	}
 
-	userRecord, err := loadAdminUserRecord(ctx, resolvedAdminUserID)
+	userRecord, err := s.loadAdminUserRecord(ctx, resolvedAdminUserID)
 	if err != nil {
 		return nil, err
 	}
@@ -124,12 +124,12 @@ func LinkAdminGithubAccount(
 	if err != nil {
 		return nil, err
 	}
-	if err := ensureAdminGithubAccountLinkable(ctx, userRecord, resolvedAdminUserID, resolvedSubject); err != nil {
+	if err := s.ensureAdminGithubAccountLinkable(ctx, userRecord, resolvedAdminUserID, resolvedSubject); err != nil {
 		return nil, err
 	}
 
 	linkedAt := nowUTCFn()
-	if err := adminUsersRepository.UpdateGithubLinkByID(ctx, resolvedAdminUserID, resolvedSubject, resolvedEmail, linkedAt); err != nil {
+	if err := s.adminUsers.UpdateGithubLinkByID(ctx, resolvedAdminUserID, resolvedSubject, resolvedEmail, linkedAt); err != nil {
 		if errors.Is(err, repository.ErrAdminGithubAlreadyExists) {
 			return nil, apperrors.BadRequest("github account is already linked to another admin")
 		}
@@ -139,7 +139,7 @@ func LinkAdminGithubAccount(
 		return nil, apperrors.Internal("failed to link github account", err)
 	}
 
-	return reloadAdminUser(ctx, resolvedAdminUserID)
+	return s.reloadAdminUser(ctx, resolvedAdminUserID)
 }
 
 func StartAdminGithubConnect(
@@ -147,8 +147,8 @@ func StartAdminGithubConnect(
 	adminUser *domain.AdminUser,
 	locale string,
 ) (*AdminGithubConnectResult, error) {
-	if adminUser == nil || strings.TrimSpace(adminUser.ID) == "" {
-		return nil, apperrors.Unauthorized(adminAuthRequiredMessage)
+	if err := requireAdminSessionAuthentication(adminUser); err != nil {
+		return nil, err
 	}
 
 	if !resolveGithubConfigFn().Enabled() {
@@ -160,15 +160,15 @@ func StartAdminGithubConnect(
 	}, nil
 }
 
-func DisconnectAdminGithubAccount(
+func (s *Service) DisconnectAdminGithubAccount(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 ) (*domain.AdminUser, error) {
-	if adminUser == nil || strings.TrimSpace(adminUser.ID) == "" {
-		return nil, apperrors.Unauthorized(adminAuthRequiredMessage)
+	if err := requireAdminSessionAuthentication(adminUser); err != nil {
+		return nil, err
 	}
 
-	userRecord, err := loadAdminUserRecord(ctx, adminUser.ID)
+	userRecord, err := s.loadAdminUserRecord(ctx, adminUser.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -176,28 +176,28 @@ func DisconnectAdminGithubAccount(
 		return nil, apperrors.BadRequest("github account is not linked")
 	}
 
-	if err := adminUsersRepository.ClearGithubLinkByID(ctx, userRecord.ID); err != nil {
+	if err := s.adminUsers.ClearGithubLinkByID(ctx, userRecord.ID); err != nil {
 		if errors.Is(err, repository.ErrAdminUserNotFound) {
 			return nil, apperrors.Unauthorized(adminAuthRequiredMessage)
 		}
 		return nil, apperrors.Internal("failed to disconnect github account", err)
 	}
 
-	return reloadAdminUser(ctx, userRecord.ID)
+	return s.reloadAdminUser(ctx, userRecord.ID)
 }
 
-func LoginAdminWithGithubSubject(
+func (s *Service) LoginAdminWithGithubSubject(
 	ctx context.Context,
 	subject string,
 	rememberMe bool,
 	metadata AdminSessionMetadata,
 ) (*AdminAuthResponse, error) {
 	config := appconfig.ResolveAdminConfig()
-	if strings.TrimSpace(config.JWTSecret) == "" {
+	if !config.JWTConfigured() {
 		return nil, apperrors.Config("admin jwt is not configured", nil)
 	}
 
-	userRecord, err := adminUsersRepository.FindByGithubSubject(ctx, strings.TrimSpace(subject))
+	userRecord, err := s.adminUsers.FindByGithubSubject(ctx, strings.TrimSpace(subject))
 	if err != nil {
 		return nil, apperrors.Internal(adminLoadAdminUserMessage, err)
 	}
@@ -205,7 +205,15 @@ func LoginAdminWithGithubSubject(
 		return nil, apperrors.Unauthorized("invalid credentials")
 	}
 
-	return issueAdminTokens(ctx, config, userRecord, "", rememberMe, metadata)
+	throttleSubjects := adminLoginThrottleSubjects(userRecord.Email, metadata.RemoteIP)
+	if err := s.checkAdminThrottle(ctx, throttleSubjects, adminCodeLoginThrottled, adminCodeLoginLocked); err != nil {
+		return nil, err
+	}
+	if userRecord.TwoFactor != nil {
+		return issueAdminMFAChallenge(config, userRecord, rememberMe)
+	}
+
+	return s.issueAdminTokens(ctx, config, userRecord, "", rememberMe, metadata)
 }
 
 func exchangeGithubAuthorizationCode(
@@ -354,7 +362,7 @@ func normalizeAdminGithubIdentity(identity *AdminGithubIdentity) (string, string
 	return resolvedSubject, resolvedEmail, nil
 }
 
-func ensureAdminGithubAccountLinkable(
+func (s *Service) ensureAdminGithubAccountLinkable(
 	ctx context.Context,
 	userRecord *domain.AdminUserRecord,
 	resolvedAdminUserID string,
@@ -364,7 +372,7 @@ func ensureAdminGithubAccountLinkable(
 		return apperrors.BadRequest("disconnect the current github account before linking a different one")
 	}
 
-	matchedUser, err := adminUsersRepository.FindByGithubSubject(ctx, resolvedSubject)
+	matchedUser, err := s.adminUsers.FindByGithubSubject(ctx, resolvedSubject)
 	if err != nil {
 		return apperrors.Internal("failed to load github account link", err)
 	}
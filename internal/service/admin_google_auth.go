@@ -42,13 +42,13 @@ var (
 	fetchGoogleUserInfoFn             = fetchGoogleUserInfo
 )
 
-func QueryAdminGoogleAuthStatus(ctx context.Context) (*AdminGoogleAuthStatusResult, error) {
+func (s *Service) QueryAdminGoogleAuthStatus(ctx context.Context) (*AdminGoogleAuthStatusResult, error) {
 	config := resolveGoogleConfigFn()
 	if !config.Enabled() {
 		return &AdminGoogleAuthStatusResult{}, nil
 	}
 
-	hasLink, err := adminUsersRepository.HasAnyGoogleLink(ctx)
+	hasLink, err := s.adminUsers.HasAnyGoogleLink(ctx)
 	if err != nil {
 		return nil, apperrors.Internal("failed to load google auth status", err)
 	}
@@ -91,7 +91,7 @@ func ResolveAdminGoogleIdentityFromCode(
 	return identity, nil
 }
 
-func LinkAdminGoogleAccount(
+func (s *Service) LinkAdminGoogleAccount(
 	ctx context.Context,
 	adminUserID string,
 	identity *AdminGoogleIdentity,
@@ -101,7 +101,7 @@ func LinkAdminGoogleAccount(
 		return nil, apperrors.Unauthorized(adminAuthRequiredMessage)
 	}
 
-	userRecord, err := loadAdminUserRecord(ctx, resolvedAdminUserID)
+	userRecord, err := s.loadAdminUserRecord(ctx, resolvedAdminUserID)
 	if err != nil {
 		return nil, err
 	}
@@ -110,12 +110,12 @@ func LinkAdminGoogleAccount(
 	if err != nil {
 		return nil, err
 	}
-	if err := ensureAdminGoogleAccountLinkable(ctx, userRecord, resolvedAdminUserID, resolvedSubject); err != nil {
+	if err := s.ensureAdminGoogleAccountLinkable(ctx, userRecord, resolvedAdminUserID, resolvedSubject); err != nil {
 		return nil, err
 	}
 
 	linkedAt := nowUTCFn()
-	if err := adminUsersRepository.UpdateGoogleLinkByID(ctx, resolvedAdminUserID, resolvedSubject, resolvedEmail, linkedAt); err != nil {
+	if err := s.adminUsers.UpdateGoogleLinkByID(ctx, resolvedAdminUserID, resolvedSubject, resolvedEmail, linkedAt); err != nil {
 		if errors.Is(err, repository.ErrAdminGoogleAlreadyExists) {
 			return nil, apperrors.BadRequest("google account is already linked to another admin")
 		}
@@ -125,7 +125,7 @@ func LinkAdminGoogleAccount(
 		return nil, apperrors.Internal("failed to link google account", err)
 	}
 
-	return reloadAdminUser(ctx, resolvedAdminUserID)
+	return s.reloadAdminUser(ctx, resolvedAdminUserID)
 }
 
 func StartAdminGoogleConnect(
@@ -133,8 +133,8 @@ func StartAdminGoogleConnect(
 	adminUser *domain.AdminUser,
 	locale string,
 ) (*AdminGoogleConnectResult, error) {
-	if adminUser == nil || strings.TrimSpace(adminUser.ID) == "" {
-		return nil, apperrors.Unauthorized(adminAuthRequiredMessage)
+	if err := requireAdminSessionAuthentication(adminUser); err != nil {
+		return nil, err
 	}
 
 	if !resolveGoogleConfigFn().Enabled() {
@@ -146,15 +146,15 @@ func StartAdminGoogleConnect(
 	}, nil
 }
 
-func DisconnectAdminGoogleAccount(
+func (s *Service) DisconnectAdminGoogleAccount(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 ) (*domain.AdminUser, error) {
-	if adminUser == nil || strings.TrimSpace(adminUser.ID) == "" {
-		return nil, apperrors.Unauthorized(adminAuthRequiredMessage)
+	if err := requireAdminSessionAuthentication(adminUser); err != nil {
+		return nil, err
 	}
 
-	userRecord, err := loadAdminUserRecord(ctx, adminUser.ID)
+	userRecord, err := s.loadAdminUserRecord(ctx, adminUser.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -162,28 +162,28 @@ func DisconnectAdminGoogleAccount(
 		return nil, apperrors.BadRequest("google account is not linked")
 	}
 
-	if err := adminUsersRepository.ClearGoogleLinkByID(ctx, userRecord.ID); err != nil {
+	if err := s.adminUsers.ClearGoogleLinkByID(ctx, userRecord.ID); err != nil {
 		if errors.Is(err, repository.ErrAdminUserNotFound) {
 			return nil, apperrors.Unauthorized(adminAuthRequiredMessage)
 		}
 		return nil, apperrors.Internal("failed to disconnect google account", err)
 	}
 
-	return reloadAdminUser(ctx, userRecord.ID)
+	return s.reloadAdminUser(ctx, userRecord.ID)
 }
 
-func LoginAdminWithGoogleSubject(
+func (s *Service) LoginAdminWithGoogleSubject(
 	ctx context.Context,
 	subject string,
 	rememberMe bool,
 	metadata AdminSessionMetadata,
 ) (*AdminAuthResponse, error) {
 	config := appconfig.ResolveAdminConfig()
-	if strings.TrimSpace(config.JWTSecret) == "" {
+	if !config.JWTConfigured() {
 		return nil, apperrors.Config("admin jwt is not configured", nil)
 	}
 
-	userRecord, err := adminUsersRepository.FindByGoogleSubject(ctx, strings.TrimSpace(subject))
+	userRecord, err := s.adminUsers.FindByGoogleSubject(ctx, strings.TrimSpace(subject))
 	if err != nil {
 		return nil, apperrors.Internal(adminLoadAdminUserMessage, err)
 	}
@@ -191,7 +191,15 @@ func LoginAdminWithGoogleSubject(
 		return nil, apperrors.Unauthorized("invalid credentials")
 	}
 
-	return issueAdminTokens(ctx, config, userRecord, "", rememberMe, metadata)
+	throttleSubjects := adminLoginThrottleSubjects(userRecord.Email, metadata.RemoteIP)
+	if err := s.checkAdminThrottle(ctx, throttleSubjects, adminCodeLoginThrottled, adminCodeLoginLocked); err != nil {
+		return nil, err
+	}
+	if userRecord.TwoFactor != nil {
+		return issueAdminMFAChallenge(config, userRecord, rememberMe)
+	}
+
+	return s.issueAdminTokens(ctx, config, userRecord, "", rememberMe, metadata)
 }
 
 func exchangeGoogleAuthorizationCode(
@@ -295,7 +303,7 @@ func normalizeAdminGoogleIdentity(identity *AdminGoogleIdentity) (string, string
 	return resolvedSubject, resolvedEmail, nil
 }
 
-func ensureAdminGoogleAccountLinkable(
+func (s *Service) ensureAdminGoogleAccountLinkable(
 	ctx context.Context,
 	userRecord *domain.AdminUserRecord,
 	resolvedAdminUserID string,
@@ -305,7 +313,7 @@ func ensureAdminGoogleAccountLinkable(
 		return apperrors.BadRequest("disconnect the current google account before linking a different one")
 	}
 
-	matchedUser, err := adminUsersRepository.FindByGoogleSubject(ctx, resolvedSubject)
+	matchedUser, err := s.adminUsers.FindByGoogleSubject(ctx, resolvedSubject)
 	if err != nil {
 		return apperrors.Internal("failed to load google account link", err)
 	}
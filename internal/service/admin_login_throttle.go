@@ -0,0 +1,301 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpapi"
+)
+
+const (
+	adminCodeLoginThrottled         = "ADMIN_LOGIN_THROTTLED"
+	adminCodeLoginLocked            = "ADMIN_LOGIN_LOCKED"
+	adminCodePasswordResetThrottled = "ADMIN_PASSWORD_RESET_THROTTLED"
+
+	adminLoginThrottleAuditResource  = "admin_login_throttle"
+	adminLoginThrottleAuditStatus    = "success"
+	adminLoginThrottleActionLocked   = "locked"
+	adminLoginThrottleActionUnlocked = "unlocked"
+)
+
+// adminThrottlePolicy describes how failures under one key slow down and eventually lock further attempts. Failures
+// older than window are forgotten; from delayAfter failures on, each attempt must wait an exponentially growing delay
+// after the previous failure, and lockAfter failures lock the key for lockDuration.
+type adminThrottlePolicy struct {
+	scope        string
+	window       time.Duration
+	delayAfter   int
+	baseDelay    time.Duration
+	maxDelay     time.Duration
+	lockAfter    int
+	lockDuration time.Duration
+}
+
+type adminThrottleSubject struct {
+	key    string
+	value  string
+	policy adminThrottlePolicy
+}
+
+var (
+	sendAdminLockoutNoticeEmailFn = sendAdminLockoutNoticeEmail
+
+	adminLoginAccountThrottlePolicy = adminThrottlePolicy{
+		scope:        "login:account",
+		window:       15 * time.Minute,
+		delayAfter:   3,
+		baseDelay:    time.Second,
+		maxDelay:     30 * time.Second,
+		lockAfter:    10,
+		lockDuration: 15 * time.Minute,
+	}
+	adminLoginIPThrottlePolicy = adminThrottlePolicy{
+		scope:        "login:ip",
+		window:       time.Hour,
+		delayAfter:   10,
+		baseDelay:    time.Second,
+		maxDelay:     time.Minute,
+		lockAfter:    50,
+		lockDuration: 30 * time.Minute,
+	}
+	// Password reset requests are counted whether or not they succeed, since every request sends an email.
+	adminPasswordResetAccountThrottlePolicy = adminThrottlePolicy{
+		scope:        "password-reset:account",
+		window:       time.Hour,
+		delayAfter:   1,
+		baseDelay:    time.Minute,
+		maxDelay:     10 * time.Minute,
+		lockAfter:    5,
+		lockDuration: time.Hour,
+	}
+	adminPasswordResetIPThrottlePolicy = adminThrottlePolicy{
+		scope:        "password-reset:ip",
+		window:       time.Hour,
+		delayAfter:   5,
+		baseDelay:    10 * time.Second,
+		maxDelay:     5 * time.Minute,
+		lockAfter:    20,
+		lockDuration: time.Hour,
+	}
+)
+
+// UnlockAdminUser clears the failed sign-in and password reset counters of another admin, lifting an active lockout.
+func (s *Service) UnlockAdminUser(ctx context.Context, adminUser *domain.AdminUser, id string) (*domain.AdminUser, error) {
+	target, err := s.loadManagedAdminUserTarget(ctx, adminUser, id)
+	if err != nil {
+		return nil, err
+	}
+
+	subjects := []adminThrottleSubject{
+		newAdminThrottleSubject(adminLoginAccountThrottlePolicy, target.Email),
+		newAdminThrottleSubject(adminPasswordResetAccountThrottlePolicy, target.Email),
+	}
+	keys := make([]string, 0, len(subjects))
+	for _, subject := range subjects {
+		keys = append(keys, subject.key)
+	}
+	if err := s.adminLoginAttempts.DeleteByKeys(ctx, keys...); err != nil {
+		return nil, apperrors.Internal("failed to unlock admin user", err)
+	}
+
+	for _, subject := range subjects {
+		if err := s.recordAdminThrottleAudit(ctx, adminLoginThrottleActionUnlocked, subject, adminUser, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.loadManagedAdminUser(ctx, target.ID)
+}
+
+func adminLoginThrottleSubjects(email, remoteIP string) []adminThrottleSubject {
+	return newAdminThrottleSubjects(adminLoginAccountThrottlePolicy, adminLoginIPThrottlePolicy, email, remoteIP)
+}
+
+func adminPasswordResetThrottleSubjects(email, remoteIP string) []adminThrottleSubject {
+	return newAdminThrottleSubjects(
+		adminPasswordResetAccountThrottlePolicy,
+		adminPasswordResetIPThrottlePolicy,
+		email,
+		remoteIP,
+	)
+}
+
+func newAdminThrottleSubjects(
+	accountPolicy adminThrottlePolicy,
+	ipPolicy adminThrottlePolicy,
+	email string,
+	remoteIP string,
+) []adminThrottleSubject {
+	subjects := make([]adminThrottleSubject, 0, 2)
+	if resolvedEmail := strings.TrimSpace(strings.ToLower(email)); resolvedEmail != "" {
+		subjects = append(subjects, newAdminThrottleSubject(accountPolicy, resolvedEmail))
+	}
+	if resolvedIP := strings.TrimSpace(remoteIP); resolvedIP != "" {
+		subjects = append(subjects, newAdminThrottleSubject(ipPolicy, resolvedIP))
+	}
+	return subjects
+}
+
+func newAdminThrottleSubject(policy adminThrottlePolicy, value string) adminThrottleSubject {
+	resolvedValue := strings.TrimSpace(strings.ToLower(value))
+	return adminThrottleSubject{
+		key:    policy.scope + ":" + resolvedValue,
+		value:  resolvedValue,
+		policy: policy,
+	}
+}
+
+// checkAdminThrottle rejects an attempt while any subject is locked or still inside its progressive delay.
+func (s *Service) checkAdminThrottle(ctx context.Context, subjects []adminThrottleSubject, throttledCode, lockedCode string) error {
+	now := nowUTCFn()
+	for _, subject := range subjects {
+		record, err := s.adminLoginAttempts.FindByKey(ctx, subject.key)
+		if err != nil {
+			return apperrors.Internal("failed to load admin login attempts", err)
+		}
+		if record == nil {
+			continue
+		}
+		if record.LockedUntil != nil && now.Before(*record.LockedUntil) {
+			return newAdminThrottleError(lockedCode)
+		}
+		if !now.Before(record.ExpiresAt) {
+			continue
+		}
+		if delay := subject.policy.delayFor(record.Failures); delay > 0 && now.Before(record.LastFailureAt.Add(delay)) {
+			return newAdminThrottleError(throttledCode)
+		}
+	}
+
+	return nil
+}
+
+// recordAdminThrottleFailure counts a failure for every subject and locks the ones that reached their limit. It
+// returns the subjects this failure locked; a subject that was already locked is not reported again.
+func (s *Service) recordAdminThrottleFailure(
+	ctx context.Context,
+	subjects []adminThrottleSubject,
+	userRecord *domain.AdminUserRecord,
+) ([]adminThrottleSubject, error) {
+	now := nowUTCFn()
+	locked := make([]adminThrottleSubject, 0)
+	for _, subject := range subjects {
+		record, err := s.adminLoginAttempts.RecordFailure(ctx, subject.key, now, subject.policy.window)
+		if err != nil {
+			return nil, apperrors.Internal("failed to record admin login attempt", err)
+		}
+		if record == nil || record.Failures < subject.policy.lockAfter {
+			continue
+		}
+
+		lockedUntil := now.Add(subject.policy.lockDuration)
+		applied, err := s.adminLoginAttempts.Lock(ctx, subject.key, now, lockedUntil)
+		if err != nil {
+			return nil, apperrors.Internal("failed to lock admin login attempts", err)
+		}
+		if !applied {
+			continue
+		}
+
+		var actor *domain.AdminUser
+		if userRecord != nil && strings.HasSuffix(subject.policy.scope, ":account") {
+			actor = &userRecord.AdminUser
+		}
+		if err := s.recordAdminThrottleAudit(ctx, adminLoginThrottleActionLocked, subject, actor, &lockedUntil); err != nil {
+			return nil, err
+		}
+		locked = append(locked, subject)
+	}
+
+	return locked, nil
+}
+
+// failAdminLogin records a failed sign-in step and returns the error the caller should answer with. The admin is
+// emailed when the failure locks their account.
+func (s *Service) failAdminLogin(
+	ctx context.Context,
+	subjects []adminThrottleSubject,
+	userRecord *domain.AdminUserRecord,
+	failure error,
+) error {
+	locked, err := s.recordAdminThrottleFailure(ctx, subjects, userRecord)
+	if err != nil {
+		return err
+	}
+	if len(locked) == 0 {
+		return failure
+	}
+
+	for _, subject := range locked {
+		if subject.policy.scope == adminLoginAccountThrottlePolicy.scope && userRecord != nil {
+			notifyAdminSecurityEvent(ctx, userRecord, "lockout", sendAdminLockoutNoticeEmailFn)
+		}
+	}
+	return newAdminThrottleError(adminCodeLoginLocked)
+}
+
+func (s *Service) clearAdminLoginFailures(ctx context.Context, email string) error {
+	subject := newAdminThrottleSubject(adminLoginAccountThrottlePolicy, email)
+	if err := s.adminLoginAttempts.DeleteByKeys(ctx, subject.key); err != nil {
+		return apperrors.Internal("failed to reset admin login attempts", err)
+	}
+	return nil
+}
+
+func (s *Service) recordAdminThrottleAudit(
+	ctx context.Context,
+	action string,
+	subject adminThrottleSubject,
+	actor *domain.AdminUser,
+	lockedUntil *time.Time,
+) error {
+	trace, _ := httpapi.RequestTraceFromContext(ctx)
+	record := domain.AdminAuditLogRecord{
+		Action:      action,
+		Resource:    adminLoginThrottleAuditResource,
+		Scope:       subject.policy.scope,
+		BeforeValue: subject.value,
+		Status:      adminLoginThrottleAuditStatus,
+		RequestID:   httpapi.RequestIDFromContext(ctx),
+		RemoteIP:    strings.TrimSpace(trace.RemoteIP),
+		CountryCode: strings.TrimSpace(strings.ToUpper(trace.CountryCode)),
+		UserAgent:   strings.TrimSpace(trace.UserAgent),
+		CreatedAt:   nowUTCFn(),
+	}
+	if actor != nil {
+		record.ActorID = strings.TrimSpace(actor.ID)
+		record.ActorEmail = strings.TrimSpace(strings.ToLower(actor.Email))
+	}
+	if lockedUntil != nil {
+		record.AfterValue = lockedUntil.UTC().Format(time.RFC3339)
+	}
+
+	if err := s.adminAuditLogs.Create(ctx, record); err != nil {
+		return apperrors.Internal("failed to persist admin audit log", err)
+	}
+	return nil
+}
+
+func (policy adminThrottlePolicy) delayFor(failures int) time.Duration {
+	if failures < policy.delayAfter {
+		return 0
+	}
+
+	delay := policy.baseDelay
+	for step := policy.delayAfter; step < failures && delay < policy.maxDelay; step++ {
+		delay *= 2
+	}
+	return min(delay, policy.maxDelay)
+}
+
+func newAdminThrottleError(code string) error {
+	message := "too many attempts, try again later"
+	if code == adminCodeLoginLocked {
+		message = "sign-in is temporarily locked after too many failed attempts"
+	}
+	return apperrors.New(code, message, http.StatusTooManyRequests, nil)
+}
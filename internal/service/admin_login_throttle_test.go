@@ -0,0 +1,301 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpapi"
+)
+
+type adminLoginAttemptStubRepository struct {
+	records map[string]*domain.AdminLoginAttemptRecord
+}
+
+func (stub *adminLoginAttemptStubRepository) FindByKey(
+	_ context.Context,
+	key string,
+) (*domain.AdminLoginAttemptRecord, error) {
+	record, ok := stub.records[key]
+	if !ok {
+		return nil, nil
+	}
+	copied := *record
+	return &copied, nil
+}
+
+func (stub *adminLoginAttemptStubRepository) RecordFailure(
+	_ context.Context,
+	key string,
+	at time.Time,
+	window time.Duration,
+) (*domain.AdminLoginAttemptRecord, error) {
+	record, ok := stub.records[key]
+	if !ok {
+		record = &domain.AdminLoginAttemptRecord{Key: key}
+		stub.records[key] = record
+	}
+	if record.ExpiresAt.After(at) {
+		record.Failures++
+	} else {
+		record.Failures = 1
+	}
+	record.LastFailureAt = at
+	record.ExpiresAt = at.Add(window)
+	if record.LockedUntil != nil && record.LockedUntil.After(record.ExpiresAt) {
+		record.ExpiresAt = *record.LockedUntil
+	}
+
+	copied := *record
+	return &copied, nil
+}
+
+func (stub *adminLoginAttemptStubRepository) Lock(_ context.Context, key string, at, until time.Time) (bool, error) {
+	record, ok := stub.records[key]
+	if !ok || (record.LockedUntil != nil && record.LockedUntil.After(at)) {
+		return false, nil
+	}
+	record.LockedUntil = &until
+	record.Failures = 0
+	if until.After(record.ExpiresAt) {
+		record.ExpiresAt = until
+	}
+	return true, nil
+}
+
+func (stub *adminLoginAttemptStubRepository) DeleteByKeys(_ context.Context, keys ...string) error {
+	for _, key := range keys {
+		delete(stub.records, key)
+	}
+	return nil
+}
+
+func stubAdminLoginAttempts(t *testing.T) (*adminLoginAttemptStubRepository, *adminErrorMessageManagementAuditStub) {
+	t.Helper()
+
+	previousAttemptsRepo := adminLoginAttemptsRepository
+	previousAuditRepo := adminAuditLogRepo
+	previousSendLockoutNotice := sendAdminLockoutNoticeEmailFn
+	previousResolveMailConfigFn := resolveMailConfigFn
+	t.Cleanup(func() {
+		adminLoginAttemptsRepository = previousAttemptsRepo
+		adminAuditLogRepo = previousAuditRepo
+		sendAdminLockoutNoticeEmailFn = previousSendLockoutNotice
+		resolveMailConfigFn = previousResolveMailConfigFn
+	})
+
+	attempts := &adminLoginAttemptStubRepository{records: map[string]*domain.AdminLoginAttemptRecord{}}
+	audit := &adminErrorMessageManagementAuditStub{}
+	adminLoginAttemptsRepository = attempts
+	adminAuditLogRepo = audit
+	sendAdminLockoutNoticeEmailFn = func(appconfig.MailConfig, string, string, string) error { return nil }
+	resolveMailConfigFn = func() (appconfig.MailConfig, error) { return appconfig.MailConfig{}, nil }
+
+	return attempts, audit
+}
+
+func TestLoginAdminDelaysRepeatedFailures(t *testing.T) {
+	_, now := stubAdminTwoFactor(t)
+	metadata := AdminSessionMetadata{RemoteIP: "203.0.113.10"}
+
+	for range adminLoginAccountThrottlePolicy.delayAfter {
+		_, err := testService().LoginAdmin(context.Background(), "admin@example.com", "wrong-password", false, metadata)
+		if apperrors.From(err).Code != "UNAUTHORIZED" {
+			t.Fatalf("expected invalid credentials, got %v", err)
+		}
+	}
+
+	_, err := testService().LoginAdmin(context.Background(), "admin@example.com", "admin-password", false, metadata)
+	if apperrors.From(err).Code != adminCodeLoginThrottled {
+		t.Fatalf("expected throttled login, got %v", err)
+	}
+
+	*now = now.Add(adminLoginAccountThrottlePolicy.baseDelay)
+	response, err := testService().LoginAdmin(context.Background(), "admin@example.com", "admin-password", false, metadata)
+	if err != nil || response.AccessToken == "" {
+		t.Fatalf("expected login after the delay, got %#v %v", response, err)
+	}
+
+	// A successful login forgets earlier failures, so the next mistake is not delayed.
+	if _, err := testService().LoginAdmin(context.Background(), "admin@example.com", "wrong-password", false, metadata); apperrors.From(err).Code != "UNAUTHORIZED" {
+		t.Fatalf("expected invalid credentials, got %v", err)
+	}
+	if _, err := testService().LoginAdmin(context.Background(), "admin@example.com", "admin-password", false, metadata); err != nil {
+		t.Fatalf("expected login without delay, got %v", err)
+	}
+}
+
+func TestLoginAdminLocksAccountAndOwnerCanUnlock(t *testing.T) {
+	user, now := stubAdminTwoFactor(t)
+	attempts, audit := stubAdminLoginAttempts(t)
+	owner := &domain.AdminUserRecord{
+		AdminUser: domain.AdminUser{ID: "owner-1", Email: "owner@example.com", Roles: []string{AdminRoleOwner}},
+	}
+	users := newAdminAuthEmailChangeStubUserRepository(user)
+	users.byID[owner.ID] = owner
+	users.byEmail[owner.Email] = owner
+	adminUsersRepository = &adminAuthSessionStubUserRepository{adminAuthEmailChangeStubUserRepository: users}
+
+	var notified []string
+	sendAdminLockoutNoticeEmailFn = func(_ appconfig.MailConfig, recipient, _, siteURL string) error {
+		if siteURL != "https://blog.example.com" {
+			t.Fatalf("unexpected site url %q", siteURL)
+		}
+		notified = append(notified, recipient)
+		return nil
+	}
+
+	ctx := httpapi.WithRequestTrace(context.Background(), httpapi.RequestTrace{RemoteIP: "203.0.113.10"})
+	metadata := AdminSessionMetadata{RemoteIP: "203.0.113.10"}
+	var err error
+	for range adminLoginAccountThrottlePolicy.lockAfter {
+		*now = now.Add(adminLoginAccountThrottlePolicy.maxDelay)
+		_, err = testService().LoginAdmin(ctx, "admin@example.com", "wrong-password", false, metadata)
+	}
+	if apperrors.From(err).Code != adminCodeLoginLocked {
+		t.Fatalf("expected lockout on the last failure, got %v", err)
+	}
+	if len(notified) != 1 || notified[0] != "admin@example.com" {
+		t.Fatalf("expected one lockout notice, got %v", notified)
+	}
+	if len(audit.records) != 1 {
+		t.Fatalf("expected one lockout audit record, got %#v", audit.records)
+	}
+	record := audit.records[0]
+	if record.Action != adminLoginThrottleActionLocked || record.ActorID != "admin-1" ||
+		record.Scope != adminLoginAccountThrottlePolicy.scope || record.RemoteIP != "203.0.113.10" || record.AfterValue == "" {
+		t.Fatalf("unexpected lockout audit record: %#v", record)
+	}
+
+	*now = now.Add(adminLoginAccountThrottlePolicy.maxDelay)
+	if _, err := testService().LoginAdmin(ctx, "admin@example.com", "admin-password", false, metadata); apperrors.From(err).Code != adminCodeLoginLocked {
+		t.Fatalf("expected locked account to reject the correct password, got %v", err)
+	}
+
+	if _, err := testService().UnlockAdminUser(ctx, &user.AdminUser, "admin-1"); apperrors.From(err).Code != adminCodeUserSelfChange {
+		t.Fatalf("expected self unlock to be rejected, got %v", err)
+	}
+	unlocked, err := testService().UnlockAdminUser(ctx, &owner.AdminUser, "admin-1")
+	if err != nil || unlocked.ID != "admin-1" {
+		t.Fatalf("UnlockAdminUser returned %#v %v", unlocked, err)
+	}
+	if _, ok := attempts.records[newAdminThrottleSubject(adminLoginAccountThrottlePolicy, user.Email).key]; ok {
+		t.Fatal("expected account attempts to be cleared")
+	}
+	last := audit.records[len(audit.records)-1]
+	if last.Action != adminLoginThrottleActionUnlocked || last.ActorID != "owner-1" {
+		t.Fatalf("unexpected unlock audit record: %#v", last)
+	}
+
+	if _, err := testService().LoginAdmin(ctx, "admin@example.com", "admin-password", false, metadata); err != nil {
+		t.Fatalf("expected login after unlock, got %v", err)
+	}
+}
+
+func TestLoginAdminLockoutAppliesToUnknownAccounts(t *testing.T) {
+	_, now := stubAdminTwoFactor(t)
+	_, audit := stubAdminLoginAttempts(t)
+	sendAdminLockoutNoticeEmailFn = func(appconfig.MailConfig, string, string, string) error {
+		t.Fatal("unknown accounts must not be notified")
+		return nil
+	}
+
+	var err error
+	for range adminLoginAccountThrottlePolicy.lockAfter {
+		*now = now.Add(adminLoginAccountThrottlePolicy.maxDelay)
+		_, err = testService().LoginAdmin(context.Background(), "missing@example.com", "password", false, AdminSessionMetadata{})
+	}
+	if apperrors.From(err).Code != adminCodeLoginLocked {
+		t.Fatalf("expected lockout, got %v", err)
+	}
+	if len(audit.records) != 1 || audit.records[0].ActorID != "" || audit.records[0].BeforeValue != "missing@example.com" {
+		t.Fatalf("unexpected audit records: %#v", audit.records)
+	}
+}
+
+func TestCompleteAdminTwoFactorLoginCountsInvalidCodes(t *testing.T) {
+	user, _ := stubAdminTwoFactor(t)
+	attempts, _ := stubAdminLoginAttempts(t)
+	user.TwoFactor = &domain.AdminTwoFactor{Secret: "JBSWY3DPEHPK3PXP"}
+
+	challenge, err := testService().LoginAdmin(context.Background(), "admin@example.com", "admin-password", false, AdminSessionMetadata{})
+	if err != nil || !challenge.MFARequired {
+		t.Fatalf("expected mfa challenge, got %#v %v", challenge, err)
+	}
+	if _, err := testService().CompleteAdminTwoFactorLogin(context.Background(), challenge.MFAToken, "abcde-fghij", AdminSessionMetadata{}); err == nil {
+		t.Fatal("expected invalid code error")
+	}
+
+	record := attempts.records[newAdminThrottleSubject(adminLoginAccountThrottlePolicy, user.Email).key]
+	if record == nil || record.Failures != 1 {
+		t.Fatalf("expected one recorded failure, got %#v", record)
+	}
+}
+
+func TestRequestAdminPasswordResetThrottlesRepeatedRequests(t *testing.T) {
+	previousUsersRepo := adminUsersRepository
+	previousNowUTCFn := nowUTCFn
+	t.Cleanup(func() {
+		adminUsersRepository = previousUsersRepo
+		nowUTCFn = previousNowUTCFn
+	})
+	_, audit := stubAdminLoginAttempts(t)
+
+	now := time.Date(2026, time.March, 20, 10, 0, 0, 0, time.UTC)
+	nowUTCFn = func() time.Time { return now }
+	adminUsersRepository = newAdminAuthEmailChangeStubUserRepository(nil)
+
+	if err := testService().RequestAdminPasswordReset(context.Background(), "missing@example.com", "en"); err != nil {
+		t.Fatalf("RequestAdminPasswordReset returned error: %v", err)
+	}
+	err := testService().RequestAdminPasswordReset(context.Background(), "missing@example.com", "en")
+	if apperrors.From(err).Code != adminCodePasswordResetThrottled {
+		t.Fatalf("expected throttled reset request, got %v", err)
+	}
+
+	for range adminPasswordResetAccountThrottlePolicy.lockAfter - 1 {
+		now = now.Add(adminPasswordResetAccountThrottlePolicy.maxDelay)
+		if err := testService().RequestAdminPasswordReset(context.Background(), "missing@example.com", "en"); err != nil {
+			t.Fatalf("RequestAdminPasswordReset returned error: %v", err)
+		}
+	}
+	now = now.Add(adminPasswordResetAccountThrottlePolicy.maxDelay)
+	if err := testService().RequestAdminPasswordReset(context.Background(), "missing@example.com", "en"); apperrors.From(err).Code != adminCodePasswordResetThrottled {
+		t.Fatalf("expected locked reset requests, got %v", err)
+	}
+	if len(audit.records) != 1 || audit.records[0].Scope != adminPasswordResetAccountThrottlePolicy.scope {
+		t.Fatalf("unexpected audit records: %#v", audit.records)
+	}
+}
+
+func TestAdminThrottlePolicyDelayGrowsUntilCap(t *testing.T) {
+	policy := adminThrottlePolicy{delayAfter: 2, baseDelay: time.Second, maxDelay: 5 * time.Second}
+
+	for failures, want := range map[int]time.Duration{
+		0: 0,
+		1: 0,
+		2: time.Second,
+		3: 2 * time.Second,
+		4: 4 * time.Second,
+		5: 5 * time.Second,
+		9: 5 * time.Second,
+	} {
+		if got := policy.delayFor(failures); got != want {
+			t.Fatalf("delayFor(%d) = %s, want %s", failures, got, want)
+		}
+	}
+}
+
+func TestNewAdminThrottleSubjectsSkipsMissingValues(t *testing.T) {
+	subjects := adminLoginThrottleSubjects(" Admin@Example.com ", "")
+	if len(subjects) != 1 || subjects[0].key != "login:account:admin@example.com" {
+		t.Fatalf("unexpected subjects: %#v", subjects)
+	}
+	if !strings.HasPrefix(adminPasswordResetThrottleSubjects("", "203.0.113.10")[0].key, "password-reset:ip:") {
+		t.Fatal("expected password reset ip subject")
+	}
+}
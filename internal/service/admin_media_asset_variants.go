@@ -0,0 +1,241 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+
+	chaiwebp "github.com/chai2010/webp"
+	xdraw "golang.org/x/image/draw"
+)
+
+const (
+	adminMediaFormatWEBP     = "webp"
+	adminMediaFormatJPEG     = "jpeg"
+	adminMediaFormatPNG      = "png"
+	adminMediaVariantQuality = 82
+)
+
+// adminMediaVariantWidths is the ladder requested widths snap up to, so caches only ever hold a handful of sizes.
+var adminMediaVariantWidths = []int{160, 320, 480, 640, 960, 1280, 1920}
+
+type AdminMediaAssetVariantRequest struct {
+	Width  int
+	Format string
+	Accept string
+}
+
+// ResolveAdminMediaAssetVariant serves an uploaded asset resized to the width ladder and re-encoded in the
+// requested or negotiated format. Variants are generated on first request and cached next to the asset.
+func (s *Service) ResolveAdminMediaAssetVariant(
+	ctx context.Context,
+	id string,
+	request AdminMediaAssetVariantRequest,
+) (*AdminMediaAsset, error) {
+	if request.Width < 0 {
+		return nil, apperrors.BadRequest("media width must be a positive integer")
+	}
+	requestedFormat, err := normalizeAdminMediaFormat(request.Format)
+	if err != nil {
+		return nil, err
+	}
+	if request.Width == 0 && requestedFormat == "" {
+		return s.ResolveAdminMediaAsset(ctx, id)
+	}
+
+	resolvedID := strings.TrimSpace(id)
+	if resolvedID == "" {
+		return nil, apperrors.BadRequest("media asset id is required")
+	}
+
+	record, err := s.adminMediaAssets.FindMediaAssetByID(ctx, resolvedID)
+	if err != nil {
+		return nil, toAdminMediaLibraryError(err, "failed to load admin media asset")
+	}
+	if record == nil || strings.TrimSpace(record.ContentType) == "" {
+		return nil, apperrors.New("NOT_FOUND", "media asset not found", 404, nil)
+	}
+
+	sourceContentType := strings.TrimSpace(record.ContentType)
+	targetContentType := resolveAdminMediaVariantContentType(requestedFormat, request.Accept, sourceContentType)
+	targetWidth := snapAdminMediaVariantWidth(request.Width, record.Width)
+	digest := strings.TrimSpace(record.Digest)
+
+	if targetWidth == 0 && targetContentType == sourceContentType {
+		return s.mapAdminMediaAssetFromRecord(*record)
+	}
+
+	variant, err := s.adminMediaAssets.FindMediaAssetVariant(ctx, resolvedID, digest, targetWidth, targetContentType)
+	if err != nil {
+		return nil, toAdminMediaLibraryError(err, "failed to load media asset variant")
+	}
+	if variant == nil || len(variant.Data) == 0 {
+		sourceData, err := s.readAdminMediaAssetData(ctx, *record)
+		if err != nil {
+			return nil, err
+		}
+		generated, err := buildAdminMediaAssetVariant(*record, sourceData, targetWidth, targetContentType)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.adminMediaAssets.UpsertMediaAssetVariant(ctx, generated); err != nil {
+			return nil, toAdminMediaLibraryError(err, "failed to cache media asset variant")
+		}
+		variant = &generated
+	}
+
+	return &AdminMediaAsset{
+		ContentType: targetContentType,
+		Data:        append([]byte(nil), variant.Data...),
+		SizeBytes:   len(variant.Data),
+		ETag:        fmt.Sprintf(`"%s-w%d-%s"`, digest, targetWidth, adminMediaFormatFromContentType(targetContentType)),
+	}, nil
+}
+
+func normalizeAdminMediaFormat(value string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "":
+		return "", nil
+	case adminMediaFormatWEBP:
+		return adminMediaFormatWEBP, nil
+	case adminMediaFormatJPEG, "jpg":
+		return adminMediaFormatJPEG, nil
+	case adminMediaFormatPNG:
+		return adminMediaFormatPNG, nil
+	default:
+		return "", apperrors.BadRequest("media format must be webp, jpeg, or png")
+	}
+}
+
+// resolveAdminMediaVariantContentType prefers an explicit format, then WebP when the client accepts it,
+// and otherwise keeps the uploaded format.
+func resolveAdminMediaVariantContentType(format, accept, sourceContentType string) string {
+	switch format {
+	case adminMediaFormatWEBP:
+		return adminAvatarContentTypeWEBP
+	case adminMediaFormatJPEG:
+		return adminAvatarContentTypeJPEG
+	case adminMediaFormatPNG:
+		return adminAvatarContentTypePNG
+	}
+
+	if sourceContentType != adminAvatarContentTypeWEBP && acceptsAdminMediaContentType(accept, adminAvatarContentTypeWEBP) {
+		return adminAvatarContentTypeWEBP
+	}
+	return sourceContentType
+}
+
+func acceptsAdminMediaContentType(accept, contentType string) bool {
+	for candidate := range strings.SplitSeq(accept, ",") {
+		mediaRange, params, _ := strings.Cut(strings.TrimSpace(candidate), ";")
+		if !strings.EqualFold(strings.TrimSpace(mediaRange), contentType) {
+			continue
+		}
+		for param := range strings.SplitSeq(params, ";") {
+			key, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+			if !strings.EqualFold(strings.TrimSpace(key), "q") {
+				continue
+			}
+			quality, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			return err == nil && quality > 0
+		}
+		return true
+	}
+	return false
+}
+
+// snapAdminMediaVariantWidth rounds the requested width up to the ladder and returns 0 when
+// the result would not be smaller than the original image.
+func snapAdminMediaVariantWidth(requested, originalWidth int) int {
+	if requested <= 0 {
+		return 0
+	}
+
+	snapped := adminMediaVariantWidths[len(adminMediaVariantWidths)-1]
+	if index, _ := slices.BinarySearch(adminMediaVariantWidths, requested); index < len(adminMediaVariantWidths) {
+		snapped = adminMediaVariantWidths[index]
+	}
+	if originalWidth > 0 && snapped >= originalWidth {
+		return 0
+	}
+	return snapped
+}
+
+func buildAdminMediaAssetVariant(
+	record domain.AdminMediaAssetRecord,
+	sourceData []byte,
+	width int,
+	contentType string,
+) (domain.AdminMediaAssetVariant, error) {
+	sourceImage, _, err := image.Decode(bytes.NewReader(sourceData))
+	if err != nil {
+		return domain.AdminMediaAssetVariant{}, apperrors.Internal("failed to decode media asset", err)
+	}
+
+	sourceBounds := sourceImage.Bounds()
+	targetWidth := sourceBounds.Dx()
+	targetHeight := sourceBounds.Dy()
+	if width > 0 && width < targetWidth {
+		targetHeight = max(1, targetHeight*width/targetWidth)
+		targetWidth = width
+	}
+
+	destinationBounds := image.Rect(0, 0, targetWidth, targetHeight)
+	destinationImage := image.NewRGBA(destinationBounds)
+	if contentType == adminAvatarContentTypeJPEG {
+		xdraw.Draw(destinationImage, destinationBounds, image.NewUniform(color.White), image.Point{}, xdraw.Src)
+	}
+	xdraw.CatmullRom.Scale(destinationImage, destinationBounds, sourceImage, sourceBounds, xdraw.Over, nil)
+
+	buffer := bytes.NewBuffer(make([]byte, 0, len(sourceData)/2))
+	if err := encodeAdminMediaImage(buffer, destinationImage, contentType, adminMediaVariantQuality); err != nil {
+		return domain.AdminMediaAssetVariant{}, apperrors.Internal("failed to encode media asset variant", err)
+	}
+	if buffer.Len() == 0 {
+		return domain.AdminMediaAssetVariant{}, apperrors.Internal("failed to encode media asset variant", nil)
+	}
+
+	return domain.AdminMediaAssetVariant{
+		AssetID:     strings.TrimSpace(record.ID),
+		Digest:      strings.TrimSpace(record.Digest),
+		Width:       width,
+		Height:      targetHeight,
+		ContentType: contentType,
+		Data:        append([]byte(nil), buffer.Bytes()...),
+		CreatedAt:   time.Now().UTC(),
+	}, nil
+}
+
+func encodeAdminMediaImage(buffer *bytes.Buffer, destinationImage *image.RGBA, contentType string, quality int) error {
+	switch contentType {
+	case adminAvatarContentTypePNG:
+		encoder := png.Encoder{CompressionLevel: png.DefaultCompression}
+		return encoder.Encode(buffer, destinationImage)
+	case adminAvatarContentTypeWEBP:
+		return chaiwebp.Encode(buffer, destinationImage, &chaiwebp.Options{Quality: float32(quality)})
+	default:
+		return jpeg.Encode(buffer, destinationImage, &jpeg.Options{Quality: quality})
+	}
+}
+
+func adminMediaFormatFromContentType(contentType string) string {
+	switch contentType {
+	case adminAvatarContentTypeWEBP:
+		return adminMediaFormatWEBP
+	case adminAvatarContentTypePNG:
+		return adminMediaFormatPNG
+	default:
+		return adminMediaFormatJPEG
+	}
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+)
+
+func TestSnapAdminMediaVariantWidth(t *testing.T) {
+	cases := []struct {
+		requested int
+		original  int
+		expected  int
+	}{
+		{requested: 0, original: 2000, expected: 0},
+		{requested: 100, original: 2000, expected: 160},
+		{requested: 641, original: 2000, expected: 960},
+		{requested: 5000, original: 4000, expected: 1920},
+		{requested: 900, original: 800, expected: 0},
+	}
+	for _, testCase := range cases {
+		if actual := snapAdminMediaVariantWidth(testCase.requested, testCase.original); actual != testCase.expected {
+			t.Fatalf("snap(%d, %d) = %d, want %d", testCase.requested, testCase.original, actual, testCase.expected)
+		}
+	}
+}
+
+func TestResolveAdminMediaVariantContentTypeNegotiatesAccept(t *testing.T) {
+	if actual := resolveAdminMediaVariantContentType("", "image/webp,*/*", "image/png"); actual != "image/webp" {
+		t.Fatalf("expected webp when accepted, got %q", actual)
+	}
+	if actual := resolveAdminMediaVariantContentType("", "image/webp;q=0, image/*", "image/jpeg"); actual != "image/jpeg" {
+		t.Fatalf("expected original type when webp is refused, got %q", actual)
+	}
+	if actual := resolveAdminMediaVariantContentType("png", "image/webp", "image/jpeg"); actual != "image/png" {
+		t.Fatalf("expected explicit format to win, got %q", actual)
+	}
+}
+
+func TestResolveAdminMediaAssetVariantGeneratesAndCaches(t *testing.T) {
+	originalRepository := adminMediaAssetRepository
+	t.Cleanup(func() {
+		adminMediaAssetRepository = originalRepository
+	})
+
+	source := image.NewRGBA(image.Rect(0, 0, 1000, 500))
+	for x := range 1000 {
+		source.Set(x, x%500, color.RGBA{R: 200, A: 255})
+	}
+	var encoded bytes.Buffer
+	if err := png.Encode(&encoded, source); err != nil {
+		t.Fatalf("encode source: %v", err)
+	}
+
+	cached := map[string]domain.AdminMediaAssetVariant{}
+	upserts := 0
+	adminMediaAssetRepository = adminMediaAssetStubRepository{
+		findMediaAssetByID: func(context.Context, string) (*domain.AdminMediaAssetRecord, error) {
+			return &domain.AdminMediaAssetRecord{
+				ID:          "cover",
+				ContentType: "image/png",
+				Digest:      "abc",
+				Width:       1000,
+				Height:      500,
+				Data:        encoded.Bytes(),
+			}, nil
+		},
+		findMediaAssetVariant: func(_ context.Context, assetID, digest string, width int, contentType string) (*domain.AdminMediaAssetVariant, error) {
+			variant, ok := cached[fmt.Sprintf("%s|%s|%d|%s", assetID, digest, width, contentType)]
+			if !ok {
+				return nil, nil
+			}
+			return &variant, nil
+		},
+		upsertMediaAssetVariant: func(_ context.Context, variant domain.AdminMediaAssetVariant) error {
+			upserts++
+			cached[fmt.Sprintf("%s|%s|%d|%s", variant.AssetID, variant.Digest, variant.Width, variant.ContentType)] = variant
+			return nil
+		},
+	}
+
+	asset, err := testService().ResolveAdminMediaAssetVariant(context.Background(), "cover", AdminMediaAssetVariantRequest{
+		Width:  600,
+		Accept: "image/webp",
+	})
+	if err != nil || asset == nil || asset.ContentType != "image/webp" || asset.ETag != `"abc-w640-webp"` {
+		t.Fatalf("variant = %#v, err=%v", asset, err)
+	}
+	config, format, err := image.DecodeConfig(bytes.NewReader(asset.Data))
+	if err != nil || format != "webp" || config.Width != 640 || config.Height != 320 {
+		t.Fatalf("unexpected variant image %s %#v err=%v", format, config, err)
+	}
+
+	if _, err := testService().ResolveAdminMediaAssetVariant(context.Background(), "cover", AdminMediaAssetVariantRequest{
+		Width:  640,
+		Accept: "image/webp",
+	}); err != nil || upserts != 1 {
+		t.Fatalf("expected cached variant to be reused, upserts=%d err=%v", upserts, err)
+	}
+
+	original, err := testService().ResolveAdminMediaAssetVariant(context.Background(), "cover", AdminMediaAssetVariantRequest{
+		Width: 1600,
+	})
+	if err != nil || original.ETag != `"abc"` || !bytes.Equal(original.Data, encoded.Bytes()) {
+		t.Fatalf("expected oversized request to serve the original, got %#v err=%v", original, err)
+	}
+
+	if _, err := testService().ResolveAdminMediaAssetVariant(context.Background(), "cover", AdminMediaAssetVariantRequest{
+		Format: "gif",
+	}); err == nil {
+		t.Fatal("expected unsupported format to fail")
+	}
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpapi"
+)
+
+var resolveMediaGarbageCollectionConfigFn = appconfig.ResolveMediaGarbageCollectionConfig
+
+// CollectAdminMediaGarbage runs media garbage collection on behalf of an admin.
+func (s *Service) CollectAdminMediaGarbage(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	dryRun bool,
+) (*domain.AdminMediaGarbageCollectionResult, error) {
+	if err := requireAdminAuthentication(adminUser); err != nil {
+		return nil, err
+	}
+	return s.RunAdminMediaGarbageCollection(ctx, time.Now().UTC(), dryRun)
+}
+
+// RunAdminMediaGarbageCollection quarantines uploaded assets that nothing references anymore, restores quarantined
+// assets that are referenced again and hard-deletes quarantined assets whose grace period has ended. A dry run only
+// reports what would happen.
+func (s *Service) RunAdminMediaGarbageCollection(
+	ctx context.Context,
+	now time.Time,
+	dryRun bool,
+) (*domain.AdminMediaGarbageCollectionResult, error) {
+	resolvedNow := now.UTC()
+	if now.IsZero() {
+		resolvedNow = time.Now().UTC()
+	}
+	config := resolveMediaGarbageCollectionConfigFn()
+
+	referencedIDs, err := s.adminMediaAssets.ListReferencedMediaAssetIDs(ctx)
+	if err != nil {
+		return nil, toAdminMediaLibraryError(err, "failed to load media asset references")
+	}
+	records, err := s.adminMediaAssets.ListMediaAssetsForGarbageCollection(ctx)
+	if err != nil {
+		return nil, toAdminMediaLibraryError(err, "failed to list media assets")
+	}
+
+	referenced := make(map[string]struct{}, len(referencedIDs))
+	for _, id := range referencedIDs {
+		referenced[id] = struct{}{}
+	}
+
+	result := &domain.AdminMediaGarbageCollectionResult{DryRun: dryRun, RanAt: resolvedNow}
+	quarantineIDs := make([]string, 0)
+	restoreIDs := make([]string, 0)
+	purgeRecords := make([]domain.AdminMediaAssetRecord, 0)
+	for _, record := range records {
+		result.Scanned++
+		_, used := referenced[record.ID]
+		quarantined := !record.QuarantinedAt.IsZero()
+
+		switch {
+		case quarantined && used:
+			restoreIDs = append(restoreIDs, record.ID)
+		case quarantined && !record.PurgeAfter.After(resolvedNow):
+			purgeRecords = append(purgeRecords, record)
+		// Recently uploaded or replaced assets may belong to a post that has not been saved yet.
+		case !quarantined && !used && !record.UpdatedAt.After(resolvedNow.Add(-config.MinimumAge)):
+			quarantineIDs = append(quarantineIDs, record.ID)
+		}
+	}
+
+	if dryRun {
+		result.Restored = len(restoreIDs)
+		result.Quarantined = len(quarantineIDs)
+		result.Purged = len(purgeRecords)
+		for _, record := range purgeRecords {
+			result.ReclaimedBytes += int64(record.SizeBytes)
+		}
+		return result, nil
+	}
+
+	if result.Restored, err = s.adminMediaAssets.RestoreMediaAssets(ctx, restoreIDs); err != nil {
+		return nil, toAdminMediaLibraryError(err, "failed to restore media assets")
+	}
+	result.Quarantined, err = s.adminMediaAssets.QuarantineMediaAssets(
+		ctx,
+		quarantineIDs,
+		resolvedNow,
+		resolvedNow.Add(config.GracePeriod),
+	)
+	if err != nil {
+		return nil, toAdminMediaLibraryError(err, "failed to quarantine media assets")
+	}
+
+	for _, record := range purgeRecords {
+		deleted, err := s.adminMediaAssets.DeleteQuarantinedMediaAsset(ctx, record.ID, resolvedNow)
+		if err != nil {
+			httpapi.LogError(ctx, "admin media garbage collection purge failed", err, slog.String("assetId", record.ID))
+			result.Failed++
+			continue
+		}
+		if !deleted {
+			// The asset was restored or deleted while the run was in progress.
+			continue
+		}
+		s.deleteAdminMediaAssetBlob(ctx, record.Storage, record.StorageKey)
+		result.Purged++
+		result.ReclaimedBytes += int64(record.SizeBytes)
+	}
+
+	return result, nil
+}
+
+// RestoreAdminMediaAsset takes a quarantined asset out of quarantine so that garbage collection no longer purges it.
+func (s *Service) RestoreAdminMediaAsset(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	id string,
+) (*domain.AdminMediaLibraryItem, error) {
+	if err := requireAdminAuthentication(adminUser); err != nil {
+		return nil, err
+	}
+
+	resolvedID := strings.TrimSpace(id)
+	if resolvedID == "" {
+		return nil, apperrors.BadRequest("media asset id is required")
+	}
+
+	record, err := s.adminMediaAssets.FindMediaAssetByID(ctx, resolvedID)
+	if err != nil {
+		return nil, toAdminMediaLibraryError(err, "failed to load admin media asset")
+	}
+	if record == nil {
+		return nil, apperrors.New("NOT_FOUND", "media asset not found", 404, nil)
+	}
+	if record.QuarantinedAt.IsZero() {
+		return nil, apperrors.BadRequest("media asset is not quarantined")
+	}
+
+	if _, err := s.adminMediaAssets.RestoreMediaAssets(ctx, []string{resolvedID}); err != nil {
+		return nil, toAdminMediaLibraryError(err, "failed to restore admin media asset")
+	}
+
+	usageCount, err := s.adminMediaAssets.CountMediaAssetUsage(ctx, "/api/media/"+resolvedID)
+	if err != nil {
+		return nil, toAdminMediaLibraryError(err, "failed to load media asset usage")
+	}
+
+	record.QuarantinedAt = time.Time{}
+	record.PurgeAfter = time.Time{}
+	item := mapAdminMediaLibraryItemFromAsset(*record)
+	item.UsageCount = usageCount
+	return &item, nil
+}
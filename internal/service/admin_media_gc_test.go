@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/repository"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+)
+
+func stubAdminMediaGarbageCollectionConfig(t *testing.T) {
+	t.Helper()
+
+	originalResolveConfig := resolveMediaGarbageCollectionConfigFn
+	t.Cleanup(func() {
+		resolveMediaGarbageCollectionConfigFn = originalResolveConfig
+	})
+	resolveMediaGarbageCollectionConfigFn = func() appconfig.MediaGarbageCollectionConfig {
+		return appconfig.MediaGarbageCollectionConfig{GracePeriod: 72 * time.Hour, MinimumAge: time.Hour}
+	}
+}
+
+func TestRunAdminMediaGarbageCollection(t *testing.T) {
+	originalRepository := adminMediaAssetRepository
+	t.Cleanup(func() {
+		adminMediaAssetRepository = originalRepository
+	})
+	stubAdminMediaGarbageCollectionConfig(t)
+
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	old := now.Add(-48 * time.Hour)
+	var (
+		quarantined []string
+		purgeAfter  time.Time
+		restored    []string
+		deleted     []string
+	)
+	adminMediaAssetRepository = adminMediaAssetStubRepository{
+		listReferencedIDs: func(context.Context) ([]string, error) {
+			return []string{"used", "reused"}, nil
+		},
+		listMediaAssetsForGC: func(context.Context) ([]domain.AdminMediaAssetRecord, error) {
+			return []domain.AdminMediaAssetRecord{
+				{ID: "used", SizeBytes: 10, UpdatedAt: old},
+				{ID: "orphan", SizeBytes: 20, UpdatedAt: old},
+				{ID: "fresh", SizeBytes: 30, UpdatedAt: now.Add(-time.Minute)},
+				{ID: "reused", SizeBytes: 40, UpdatedAt: old, QuarantinedAt: old, PurgeAfter: now.Add(-time.Hour)},
+				{ID: "expired", SizeBytes: 50, UpdatedAt: old, QuarantinedAt: old, PurgeAfter: now.Add(-time.Hour)},
+				{ID: "waiting", SizeBytes: 60, UpdatedAt: old, QuarantinedAt: old, PurgeAfter: now.Add(time.Hour)},
+			}, nil
+		},
+		quarantineMediaAssets: func(_ context.Context, ids []string, at time.Time, until time.Time) (int, error) {
+			if !at.Equal(now) {
+				t.Fatalf("unexpected quarantine time %v", at)
+			}
+			quarantined = ids
+			purgeAfter = until
+			return len(ids), nil
+		},
+		restoreMediaAssets: func(_ context.Context, ids []string) (int, error) {
+			restored = ids
+			return len(ids), nil
+		},
+		deleteQuarantinedAsset: func(_ context.Context, id string, at time.Time) (bool, error) {
+			if !at.Equal(now) {
+				t.Fatalf("unexpected purge time %v", at)
+			}
+			deleted = append(deleted, id)
+			return true, nil
+		},
+	}
+
+	result, err := testService().RunAdminMediaGarbageCollection(context.Background(), now, false)
+	if err != nil {
+		t.Fatalf("RunAdminMediaGarbageCollection() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(quarantined, []string{"orphan"}) || !purgeAfter.Equal(now.Add(72*time.Hour)) {
+		t.Fatalf("unexpected quarantine %#v until %v", quarantined, purgeAfter)
+	}
+	if !reflect.DeepEqual(restored, []string{"reused"}) || !reflect.DeepEqual(deleted, []string{"expired"}) {
+		t.Fatalf("unexpected restored %#v and deleted %#v", restored, deleted)
+	}
+	expected := domain.AdminMediaGarbageCollectionResult{
+		Scanned:        6,
+		Quarantined:    1,
+		Restored:       1,
+		Purged:         1,
+		ReclaimedBytes: 50,
+		RanAt:          now,
+	}
+	if !reflect.DeepEqual(*result, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, *result)
+	}
+}
+
+func TestRunAdminMediaGarbageCollectionDryRunChangesNothing(t *testing.T) {
+	originalRepository := adminMediaAssetRepository
+	t.Cleanup(func() {
+		adminMediaAssetRepository = originalRepository
+	})
+	stubAdminMediaGarbageCollectionConfig(t)
+
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	old := now.Add(-48 * time.Hour)
+	adminMediaAssetRepository = adminMediaAssetStubRepository{
+		listMediaAssetsForGC: func(context.Context) ([]domain.AdminMediaAssetRecord, error) {
+			return []domain.AdminMediaAssetRecord{
+				{ID: "orphan", SizeBytes: 20, UpdatedAt: old},
+				{ID: "expired", SizeBytes: 50, UpdatedAt: old, QuarantinedAt: old, PurgeAfter: old},
+			}, nil
+		},
+		quarantineMediaAssets: func(context.Context, []string, time.Time, time.Time) (int, error) {
+			t.Fatal("dry run must not quarantine assets")
+			return 0, nil
+		},
+		deleteQuarantinedAsset: func(context.Context, string, time.Time) (bool, error) {
+			t.Fatal("dry run must not delete assets")
+			return false, nil
+		},
+	}
+
+	result, err := testService().RunAdminMediaGarbageCollection(context.Background(), now, true)
+	if err != nil {
+		t.Fatalf("RunAdminMediaGarbageCollection() error = %v", err)
+	}
+	if !result.DryRun || result.Quarantined != 1 || result.Purged != 1 || result.ReclaimedBytes != 50 {
+		t.Fatalf("unexpected dry run result %#v", result)
+	}
+}
+
+func TestRunAdminMediaGarbageCollectionStopsWhenReferencesFail(t *testing.T) {
+	originalRepository := adminMediaAssetRepository
+	t.Cleanup(func() {
+		adminMediaAssetRepository = originalRepository
+	})
+	stubAdminMediaGarbageCollectionConfig(t)
+
+	adminMediaAssetRepository = adminMediaAssetStubRepository{
+		listReferencedIDs: func(context.Context) ([]string, error) {
+			return nil, repository.ErrAdminMediaAssetRepositoryUnavailable
+		},
+		listMediaAssetsForGC: func(context.Context) ([]domain.AdminMediaAssetRecord, error) {
+			t.Fatal("assets must not be listed without references")
+			return nil, nil
+		},
+	}
+
+	_, err := testService().RunAdminMediaGarbageCollection(context.Background(), time.Now(), false)
+	if apperrors.From(err).HTTPStatus != 503 {
+		t.Fatalf("expected service unavailable, got %v", err)
+	}
+}
+
+func TestRestoreAdminMediaAsset(t *testing.T) {
+	originalRepository := adminMediaAssetRepository
+	t.Cleanup(func() {
+		adminMediaAssetRepository = originalRepository
+	})
+
+	quarantinedAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	var restored []string
+	adminMediaAssetRepository = adminMediaAssetStubRepository{
+		findMediaAssetByID: func(_ context.Context, id string) (*domain.AdminMediaAssetRecord, error) {
+			switch id {
+			case "quarantined":
+				return &domain.AdminMediaAssetRecord{
+					ID:            id,
+					Name:          "cover.webp",
+					QuarantinedAt: quarantinedAt,
+					PurgeAfter:    quarantinedAt.Add(72 * time.Hour),
+				}, nil
+			case "active":
+				return &domain.AdminMediaAssetRecord{ID: id, Name: "hero.webp"}, nil
+			default:
+				return nil, nil
+			}
+		},
+		restoreMediaAssets: func(_ context.Context, ids []string) (int, error) {
+			restored = ids
+			return len(ids), nil
+		},
+	}
+
+	adminUser := &domain.AdminUser{ID: "admin-1"}
+	item, err := testService().RestoreAdminMediaAsset(context.Background(), adminUser, " quarantined ")
+	if err != nil {
+		t.Fatalf("RestoreAdminMediaAsset() error = %v", err)
+	}
+	if !reflect.DeepEqual(restored, []string{"quarantined"}) {
+		t.Fatalf("unexpected restored ids %#v", restored)
+	}
+	if !item.QuarantinedAt.IsZero() || !item.PurgeAfter.IsZero() || item.Value != "/api/media/quarantined" {
+		t.Fatalf("unexpected item %#v", item)
+	}
+
+	if _, err := testService().RestoreAdminMediaAsset(context.Background(), adminUser, "active"); apperrors.From(err).HTTPStatus != 400 {
+		t.Fatalf("expected bad request for an active asset, got %v", err)
+	}
+	if _, err := testService().RestoreAdminMediaAsset(context.Background(), adminUser, "missing"); apperrors.From(err).HTTPStatus != 404 {
+		t.Fatalf("expected not found, got %v", err)
+	}
+	if _, err := testService().RestoreAdminMediaAsset(context.Background(), nil, "quarantined"); apperrors.From(err).HTTPStatus != 401 {
+		t.Fatalf("expected unauthorized without admin, got %v", err)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"image"
 	_ "image/jpeg" // Register JPEG decoder for image.DecodeConfig.
 	_ "image/png"  // Register PNG decoder for image.DecodeConfig.
+	"io"
 	"net/url"
 	"os"
 	"path"
@@ -29,19 +30,18 @@ const (
 	adminMediaLibraryDefaultPage = 1
 	adminMediaLibraryDefaultSize = 10
 	adminMediaLibraryMaxSize     = 48
-	maxAdminMediaAssetBytes      = 5 * 1024 * 1024
 	maxAdminMediaAssetNameLength = 180
 )
 
-var adminMediaAssetRepository repository.AdminMediaAssetRepository = repository.NewAdminMediaAssetRepository()
-
 type AdminMediaAsset struct {
 	ContentType string
 	Data        []byte
+	SizeBytes   int
 	ETag        string
+	open        func(ctx context.Context) (io.ReadCloser, error)
 }
 
-func ListAdminMediaLibrary(
+func (s *Service) ListAdminMediaLibrary(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	filter domain.AdminMediaLibraryFilter,
@@ -59,12 +59,20 @@ func ListAdminMediaLibrary(
 		resolvedSize = min(filter.Size, adminMediaLibraryMaxSize)
 	}
 
-	payload, err := adminMediaAssetRepository.ListMediaLibraryItems(ctx, domain.AdminMediaLibraryFilter{
-		Query: strings.TrimSpace(filter.Query),
-		Kind:  strings.TrimSpace(strings.ToUpper(filter.Kind)),
-		Sort:  strings.TrimSpace(strings.ToUpper(filter.Sort)),
-		Page:  resolvedPage,
-		Size:  resolvedSize,
+	resolvedFolder, err := normalizeAdminMediaFolder(filter.Folder)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := s.adminMediaAssets.ListMediaLibraryItems(ctx, domain.AdminMediaLibraryFilter{
+		Query:       strings.TrimSpace(filter.Query),
+		Kind:        strings.TrimSpace(strings.ToUpper(filter.Kind)),
+		Tag:         normalizeAdminMediaTag(filter.Tag),
+		Folder:      resolvedFolder,
+		Quarantined: filter.Quarantined,
+		Sort:        strings.TrimSpace(strings.ToUpper(filter.Sort)),
+		Page:        resolvedPage,
+		Size:        resolvedSize,
 	})
 	if err != nil {
 		return nil, toAdminMediaLibraryError(err, "failed to list admin media library")
@@ -88,7 +96,7 @@ func ListAdminMediaLibrary(
 	return payload, nil
 }
 
-func UploadAdminMediaAsset(
+func (s *Service) UploadAdminMediaAsset(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	input domain.AdminMediaUploadInput,
@@ -97,29 +105,46 @@ func UploadAdminMediaAsset(
 		return nil, err
 	}
 
-	payload, err := decodeAdminMediaDataURL(strings.TrimSpace(input.DataURL))
+	payload, err := resolveAdminMediaUploadPayload(input)
 	if err != nil {
 		return nil, err
 	}
-	name := normalizeAdminMediaAssetName(input.FileName)
+	return s.createAdminMediaAssetFromPayload(ctx, adminUser, input.FileName, payload)
+}
+
+// createAdminMediaAssetFromPayload stores a decoded upload, reusing an existing asset with the same digest.
+func (s *Service) createAdminMediaAssetFromPayload(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	fileName string,
+	payload *decodedAdminMediaPayload,
+) (*domain.AdminMediaLibraryItem, error) {
+	name := normalizeAdminMediaAssetName(fileName)
 	if name == "" {
 		name = defaultAdminMediaAssetName(payload.ContentType)
 	}
 	digest := hashAdminMediaPayload(payload.Data)
 
-	existing, err := adminMediaAssetRepository.FindMediaAssetByDigest(ctx, digest)
+	existing, err := s.adminMediaAssets.FindMediaAssetByDigest(ctx, digest)
 	if err != nil {
 		return nil, toAdminMediaLibraryError(err, "failed to load admin media asset")
 	}
 	if existing != nil {
+		// Uploading a quarantined image again means it is wanted after all.
+		if !existing.QuarantinedAt.IsZero() {
+			if _, err := s.adminMediaAssets.RestoreMediaAssets(ctx, []string{existing.ID}); err != nil {
+				return nil, toAdminMediaLibraryError(err, "failed to restore admin media asset")
+			}
+			existing.QuarantinedAt = time.Time{}
+			existing.PurgeAfter = time.Time{}
+		}
 		item := mapAdminMediaLibraryItemFromAsset(*existing)
 		return &item, nil
 	}
 
-	id := primitive.NewObjectID().Hex()
 	now := time.Now().UTC()
-	created, err := adminMediaAssetRepository.CreateMediaAsset(ctx, domain.AdminMediaAssetRecord{
-		ID:          id,
+	record := domain.AdminMediaAssetRecord{
+		ID:          primitive.NewObjectID().Hex(),
 		Name:        name,
 		ContentType: payload.ContentType,
 		Digest:      digest,
@@ -127,11 +152,21 @@ func UploadAdminMediaAsset(
 		Width:       payload.Width,
 		Height:      payload.Height,
 		Data:        payload.Data,
-		CreatedBy:   strings.TrimSpace(adminUser.ID),
-		CreatedAt:   now,
-		UpdatedAt:   now,
-	})
+		Placeholder: domain.MediaPlaceholder{
+			DominantColor: payload.DominantColor,
+			BlurHash:      payload.BlurHash,
+		},
+		CreatedBy: strings.TrimSpace(adminUser.ID),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.storeAdminMediaAssetBlob(ctx, &record); err != nil {
+		return nil, err
+	}
+
+	created, err := s.adminMediaAssets.CreateMediaAsset(ctx, record)
 	if err != nil {
+		s.deleteAdminMediaAssetBlob(ctx, record.Storage, record.StorageKey)
 		return nil, toAdminMediaLibraryError(err, "failed to store admin media asset")
 	}
 
@@ -139,7 +174,7 @@ func UploadAdminMediaAsset(
 	return &item, nil
 }
 
-func ReplaceAdminMediaAsset(
+func (s *Service) ReplaceAdminMediaAsset(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	id string,
@@ -154,7 +189,7 @@ func ReplaceAdminMediaAsset(
 		return nil, apperrors.BadRequest("media asset id is required")
 	}
 
-	existing, err := adminMediaAssetRepository.FindMediaAssetByID(ctx, resolvedID)
+	existing, err := s.adminMediaAssets.FindMediaAssetByID(ctx, resolvedID)
 	if err != nil {
 		return nil, toAdminMediaLibraryError(err, "failed to load admin media asset")
 	}
@@ -162,7 +197,7 @@ func ReplaceAdminMediaAsset(
 		return nil, apperrors.New("NOT_FOUND", "media asset not found", 404, nil)
 	}
 
-	payload, err := decodeAdminMediaDataURL(strings.TrimSpace(input.DataURL))
+	payload, err := resolveAdminMediaUploadPayload(input)
 	if err != nil {
 		return nil, err
 	}
@@ -172,7 +207,7 @@ func ReplaceAdminMediaAsset(
 		name = defaultAdminMediaAssetName(payload.ContentType)
 	}
 
-	replaced, err := adminMediaAssetRepository.ReplaceMediaAsset(ctx, domain.AdminMediaAssetRecord{
+	record := domain.AdminMediaAssetRecord{
 		ID:          resolvedID,
 		Name:        name,
 		ContentType: payload.ContentType,
@@ -181,15 +216,31 @@ func ReplaceAdminMediaAsset(
 		Width:       payload.Width,
 		Height:      payload.Height,
 		Data:        payload.Data,
-		CreatedBy:   existing.CreatedBy,
-		CreatedAt:   existing.CreatedAt,
-		UpdatedAt:   time.Now().UTC(),
-	})
+		Placeholder: domain.MediaPlaceholder{
+			DominantColor: payload.DominantColor,
+			BlurHash:      payload.BlurHash,
+		},
+		Metadata:  existing.Metadata,
+		CreatedBy: existing.CreatedBy,
+		CreatedAt: existing.CreatedAt,
+		UpdatedAt: time.Now().UTC(),
+	}
+	if err := s.storeAdminMediaAssetBlob(ctx, &record); err != nil {
+		return nil, err
+	}
+
+	replaced, err := s.adminMediaAssets.ReplaceMediaAsset(ctx, record)
 	if err != nil {
+		if record.StorageKey != existing.StorageKey || record.Storage != existing.Storage {
+			s.deleteAdminMediaAssetBlob(ctx, record.Storage, record.StorageKey)
+		}
 		return nil, toAdminMediaLibraryError(err, "failed to replace admin media asset")
 	}
+	if record.StorageKey != existing.StorageKey || record.Storage != existing.Storage {
+		s.deleteAdminMediaAssetBlob(ctx, existing.Storage, existing.StorageKey)
+	}
 
-	usageCount, err := adminMediaAssetRepository.CountMediaAssetUsage(ctx, "/api/media/"+resolvedID)
+	usageCount, err := s.adminMediaAssets.CountMediaAssetUsage(ctx, "/api/media/"+resolvedID)
 	if err != nil {
 		return nil, toAdminMediaLibraryError(err, "failed to load media asset usage")
 	}
@@ -199,7 +250,7 @@ func ReplaceAdminMediaAsset(
 	return &item, nil
 }
 
-func DeleteAdminMediaAsset(ctx context.Context, adminUser *domain.AdminUser, id string) error {
+func (s *Service) DeleteAdminMediaAsset(ctx context.Context, adminUser *domain.AdminUser, id string) error {
 	if err := requireAdminAuthentication(adminUser); err != nil {
 		return err
 	}
@@ -209,7 +260,7 @@ func DeleteAdminMediaAsset(ctx context.Context, adminUser *domain.AdminUser, id
 		return apperrors.BadRequest("media asset id is required")
 	}
 
-	record, err := adminMediaAssetRepository.FindMediaAssetByID(ctx, resolvedID)
+	record, err := s.adminMediaAssets.FindMediaAssetByID(ctx, resolvedID)
 	if err != nil {
 		return toAdminMediaLibraryError(err, "failed to load admin media asset")
 	}
@@ -217,7 +268,7 @@ func DeleteAdminMediaAsset(ctx context.Context, adminUser *domain.AdminUser, id
 		return apperrors.New("NOT_FOUND", "media asset not found", 404, nil)
 	}
 
-	usageCount, err := adminMediaAssetRepository.CountMediaAssetUsage(ctx, "/api/media/"+resolvedID)
+	usageCount, err := s.adminMediaAssets.CountMediaAssetUsage(ctx, "/api/media/"+resolvedID)
 	if err != nil {
 		return toAdminMediaLibraryError(err, "failed to load media asset usage")
 	}
@@ -225,43 +276,65 @@ func DeleteAdminMediaAsset(ctx context.Context, adminUser *domain.AdminUser, id
 		return apperrors.BadRequest("media asset is still used by posts")
 	}
 
-	deleted, err := adminMediaAssetRepository.DeleteMediaAssetByID(ctx, resolvedID)
+	deleted, err := s.adminMediaAssets.DeleteMediaAssetByID(ctx, resolvedID)
 	if err != nil {
 		return toAdminMediaLibraryError(err, "failed to delete admin media asset")
 	}
 	if !deleted {
 		return apperrors.New("NOT_FOUND", "media asset not found", 404, nil)
 	}
+	s.deleteAdminMediaAssetBlob(ctx, record.Storage, record.StorageKey)
 
 	return nil
 }
 
-func ResolveAdminMediaAsset(ctx context.Context, id string) (*AdminMediaAsset, error) {
+func (s *Service) ResolveAdminMediaAsset(ctx context.Context, id string) (*AdminMediaAsset, error) {
 	resolvedID := strings.TrimSpace(id)
 	if resolvedID == "" {
 		return nil, apperrors.BadRequest("media asset id is required")
 	}
 
-	record, err := adminMediaAssetRepository.FindMediaAssetByID(ctx, resolvedID)
+	record, err := s.adminMediaAssets.FindMediaAssetByID(ctx, resolvedID)
 	if err != nil {
 		return nil, toAdminMediaLibraryError(err, "failed to load admin media asset")
 	}
-	if record == nil || len(record.Data) == 0 || strings.TrimSpace(record.ContentType) == "" {
+	if record == nil || strings.TrimSpace(record.ContentType) == "" {
 		return nil, apperrors.New("NOT_FOUND", "media asset not found", 404, nil)
 	}
+	return s.mapAdminMediaAssetFromRecord(*record)
+}
 
+func (s *Service) mapAdminMediaAssetFromRecord(record domain.AdminMediaAssetRecord) (*AdminMediaAsset, error) {
+	if isAdminMediaAssetInline(record) {
+		if len(record.Data) == 0 {
+			return nil, apperrors.New("NOT_FOUND", "media asset not found", 404, nil)
+		}
+		return &AdminMediaAsset{
+			ContentType: strings.TrimSpace(record.ContentType),
+			Data:        append([]byte(nil), record.Data...),
+			SizeBytes:   len(record.Data),
+			ETag:        `"` + strings.TrimSpace(record.Digest) + `"`,
+		}, nil
+	}
+
+	// External blobs are opened only once the caller knows the client needs the body.
 	return &AdminMediaAsset{
 		ContentType: strings.TrimSpace(record.ContentType),
-		Data:        append([]byte(nil), record.Data...),
+		SizeBytes:   record.SizeBytes,
 		ETag:        `"` + strings.TrimSpace(record.Digest) + `"`,
+		open: func(ctx context.Context) (io.ReadCloser, error) {
+			return s.openAdminMediaAssetBlob(ctx, record)
+		},
 	}, nil
 }
 
 type decodedAdminMediaPayload struct {
-	ContentType string
-	Data        []byte
-	Width       int
-	Height      int
+	ContentType   string
+	Data          []byte
+	Width         int
+	Height        int
+	DominantColor string
+	BlurHash      string
 }
 
 func decodeAdminMediaDataURL(value string) (*decodedAdminMediaPayload, error) {
@@ -295,11 +368,42 @@ func decodeAdminMediaDataURL(value string) (*decodedAdminMediaPayload, error) {
 	if len(decodedPayload) == 0 {
 		return nil, apperrors.BadRequest("media asset image is required")
 	}
-	if len(decodedPayload) > maxAdminMediaAssetBytes {
+
+	payload, err := inspectAdminMediaPayload(decodedPayload)
+	if err != nil {
+		return nil, err
+	}
+	payload.ContentType = contentType
+	return payload, nil
+}
+
+func resolveAdminMediaUploadPayload(input domain.AdminMediaUploadInput) (*decodedAdminMediaPayload, error) {
+	if input.File != nil {
+		return readAdminMediaUploadFile(input.File)
+	}
+	return decodeAdminMediaDataURL(strings.TrimSpace(input.DataURL))
+}
+
+// readAdminMediaUploadFile reads a raw multipart upload without buffering more than the configured limit.
+func readAdminMediaUploadFile(file io.Reader) (*decodedAdminMediaPayload, error) {
+	maxBytes := resolveAdminMediaStorageConfig().MaxAssetBytes
+	data, err := io.ReadAll(io.LimitReader(file, int64(maxBytes)+1))
+	if err != nil {
+		return nil, apperrors.BadRequest("media asset upload could not be read")
+	}
+	if len(data) == 0 {
+		return nil, apperrors.BadRequest("media asset image is required")
+	}
+	return inspectAdminMediaPayload(data)
+}
+
+// inspectAdminMediaPayload enforces the size limit and sniffs the image format and dimensions.
+func inspectAdminMediaPayload(data []byte) (*decodedAdminMediaPayload, error) {
+	if len(data) > resolveAdminMediaStorageConfig().MaxAssetBytes {
 		return nil, apperrors.BadRequest("media asset image is too large")
 	}
 
-	config, _, err := image.DecodeConfig(bytes.NewReader(decodedPayload))
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
 	if err != nil {
 		return nil, apperrors.BadRequest("media asset must be a valid image")
 	}
@@ -307,12 +411,24 @@ func decodeAdminMediaDataURL(value string) (*decodedAdminMediaPayload, error) {
 		return nil, apperrors.BadRequest("media asset must be a valid image")
 	}
 
-	return &decodedAdminMediaPayload{
+	contentType := ""
+	switch format {
+	case "png":
+		contentType = "image/png"
+	case "jpeg":
+		contentType = "image/jpeg"
+	case "webp":
+		contentType = "image/webp"
+	default:
+		return nil, apperrors.BadRequest("media asset must be a png, jpeg, or webp image")
+	}
+
+	return normalizeAdminMediaPayload(&decodedAdminMediaPayload{
 		ContentType: contentType,
-		Data:        decodedPayload,
+		Data:        data,
 		Width:       config.Width,
 		Height:      config.Height,
-	}, nil
+	})
 }
 
 func normalizeAdminMediaAssetName(value string) string {
@@ -501,18 +617,22 @@ func hashAdminMediaPayload(payload []byte) string {
 func mapAdminMediaLibraryItemFromAsset(record domain.AdminMediaAssetRecord) domain.AdminMediaLibraryItem {
 	value := "/api/media/" + strings.TrimSpace(record.ID)
 	return domain.AdminMediaLibraryItem{
-		ID:          strings.TrimSpace(record.ID),
-		Kind:        "UPLOADED",
-		Name:        strings.TrimSpace(record.Name),
-		Value:       value,
-		PreviewURL:  value,
-		ContentType: strings.TrimSpace(record.ContentType),
-		Width:       record.Width,
-		Height:      record.Height,
-		SizeBytes:   record.SizeBytes,
-		UsageCount:  0,
-		CreatedAt:   record.CreatedAt,
-		UpdatedAt:   record.UpdatedAt,
+		ID:            strings.TrimSpace(record.ID),
+		Kind:          "UPLOADED",
+		Name:          strings.TrimSpace(record.Name),
+		Value:         value,
+		PreviewURL:    value,
+		ContentType:   strings.TrimSpace(record.ContentType),
+		Width:         record.Width,
+		Height:        record.Height,
+		Placeholder:   record.Placeholder,
+		Metadata:      record.Metadata,
+		SizeBytes:     record.SizeBytes,
+		UsageCount:    0,
+		QuarantinedAt: record.QuarantinedAt,
+		PurgeAfter:    record.PurgeAfter,
+		CreatedAt:     record.CreatedAt,
+		UpdatedAt:     record.UpdatedAt,
 	}
 }
 
@@ -520,6 +640,8 @@ func toAdminMediaLibraryError(err error, message string) error {
 	switch {
 	case errors.Is(err, repository.ErrAdminMediaAssetRepositoryUnavailable):
 		return apperrors.ServiceUnavailable("admin media library is unavailable", err)
+	case errors.Is(err, repository.ErrAdminMediaAssetNotFound):
+		return apperrors.New("NOT_FOUND", "media asset not found", 404, err)
 	default:
 		return apperrors.Internal(message, err)
 	}
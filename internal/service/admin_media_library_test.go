@@ -13,13 +13,26 @@ import (
 )
 
 type adminMediaAssetStubRepository struct {
-	listMediaLibraryItems  func(context.Context, domain.AdminMediaLibraryFilter) (*domain.AdminMediaLibraryListPayload, error)
-	findMediaAssetByID     func(context.Context, string) (*domain.AdminMediaAssetRecord, error)
-	findMediaAssetByDigest func(context.Context, string) (*domain.AdminMediaAssetRecord, error)
-	countMediaAssetUsage   func(context.Context, string) (int, error)
-	createMediaAsset       func(context.Context, domain.AdminMediaAssetRecord) (*domain.AdminMediaAssetRecord, error)
-	replaceMediaAsset      func(context.Context, domain.AdminMediaAssetRecord) (*domain.AdminMediaAssetRecord, error)
-	deleteMediaAssetByID   func(context.Context, string) (bool, error)
+	listMediaLibraryItems    func(context.Context, domain.AdminMediaLibraryFilter) (*domain.AdminMediaLibraryListPayload, error)
+	findMediaAssetByID       func(context.Context, string) (*domain.AdminMediaAssetRecord, error)
+	findMediaAssetByDigest   func(context.Context, string) (*domain.AdminMediaAssetRecord, error)
+	findMediaAssetPreview    func(context.Context, string) (*domain.AdminMediaAssetRecord, error)
+	listMediaLibraryFacets   func(context.Context) (*domain.AdminMediaLibraryFacets, error)
+	countMediaAssetUsage     func(context.Context, string) (int, error)
+	createMediaAsset         func(context.Context, domain.AdminMediaAssetRecord) (*domain.AdminMediaAssetRecord, error)
+	replaceMediaAsset        func(context.Context, domain.AdminMediaAssetRecord) (*domain.AdminMediaAssetRecord, error)
+	updateMediaAssetMetadata func(context.Context, string, domain.AdminMediaAssetMetadata, time.Time) error
+	moveMediaAssetsToFolder  func(context.Context, []string, string, time.Time) (int, error)
+	deleteMediaAssetByID     func(context.Context, string) (bool, error)
+	findMediaAssetVariant    func(context.Context, string, string, int, string) (*domain.AdminMediaAssetVariant, error)
+	upsertMediaAssetVariant  func(context.Context, domain.AdminMediaAssetVariant) error
+	listMediaAssetsOutside   func(context.Context, string, int) ([]domain.AdminMediaAssetRecord, error)
+	updateMediaAssetStorage  func(context.Context, domain.AdminMediaAssetRecord, string, string, []byte) (bool, error)
+	listReferencedIDs        func(context.Context) ([]string, error)
+	listMediaAssetsForGC     func(context.Context) ([]domain.AdminMediaAssetRecord, error)
+	quarantineMediaAssets    func(context.Context, []string, time.Time, time.Time) (int, error)
+	restoreMediaAssets       func(context.Context, []string) (int, error)
+	deleteQuarantinedAsset   func(context.Context, string, time.Time) (bool, error)
 }
 
 func (stub adminMediaAssetStubRepository) ListMediaLibraryItems(
@@ -52,6 +65,25 @@ func (stub adminMediaAssetStubRepository) FindMediaAssetByDigest(
 	return stub.findMediaAssetByDigest(ctx, digest)
 }
 
+func (stub adminMediaAssetStubRepository) FindMediaAssetPreview(
+	ctx context.Context,
+	id string,
+) (*domain.AdminMediaAssetRecord, error) {
+	if stub.findMediaAssetPreview == nil {
+		return nil, nil
+	}
+	return stub.findMediaAssetPreview(ctx, id)
+}
+
+func (stub adminMediaAssetStubRepository) ListMediaLibraryFacets(
+	ctx context.Context,
+) (*domain.AdminMediaLibraryFacets, error) {
+	if stub.listMediaLibraryFacets == nil {
+		return nil, nil
+	}
+	return stub.listMediaLibraryFacets(ctx)
+}
+
 func (stub adminMediaAssetStubRepository) CountMediaAssetUsage(ctx context.Context, value string) (int, error) {
 	if stub.countMediaAssetUsage == nil {
 		return 0, nil
@@ -79,6 +111,30 @@ func (stub adminMediaAssetStubRepository) ReplaceMediaAsset(
 	return stub.replaceMediaAsset(ctx, record)
 }
 
+func (stub adminMediaAssetStubRepository) UpdateMediaAssetMetadata(
+	ctx context.Context,
+	id string,
+	metadata domain.AdminMediaAssetMetadata,
+	updatedAt time.Time,
+) error {
+	if stub.updateMediaAssetMetadata == nil {
+		return nil
+	}
+	return stub.updateMediaAssetMetadata(ctx, id, metadata, updatedAt)
+}
+
+func (stub adminMediaAssetStubRepository) MoveMediaAssetsToFolder(
+	ctx context.Context,
+	ids []string,
+	folder string,
+	updatedAt time.Time,
+) (int, error) {
+	if stub.moveMediaAssetsToFolder == nil {
+		return 0, nil
+	}
+	return stub.moveMediaAssetsToFolder(ctx, ids, folder, updatedAt)
+}
+
 func (stub adminMediaAssetStubRepository) DeleteMediaAssetByID(ctx context.Context, id string) (bool, error) {
 	if stub.deleteMediaAssetByID == nil {
 		return false, nil
@@ -86,6 +142,99 @@ func (stub adminMediaAssetStubRepository) DeleteMediaAssetByID(ctx context.Conte
 	return stub.deleteMediaAssetByID(ctx, id)
 }
 
+func (stub adminMediaAssetStubRepository) FindMediaAssetVariant(
+	ctx context.Context,
+	assetID string,
+	digest string,
+	width int,
+	contentType string,
+) (*domain.AdminMediaAssetVariant, error) {
+	if stub.findMediaAssetVariant == nil {
+		return nil, nil
+	}
+	return stub.findMediaAssetVariant(ctx, assetID, digest, width, contentType)
+}
+
+func (stub adminMediaAssetStubRepository) UpsertMediaAssetVariant(
+	ctx context.Context,
+	variant domain.AdminMediaAssetVariant,
+) error {
+	if stub.upsertMediaAssetVariant == nil {
+		return nil
+	}
+	return stub.upsertMediaAssetVariant(ctx, variant)
+}
+
+func (stub adminMediaAssetStubRepository) ListMediaAssetsOutsideStorage(
+	ctx context.Context,
+	backend string,
+	limit int,
+) ([]domain.AdminMediaAssetRecord, error) {
+	if stub.listMediaAssetsOutside == nil {
+		return nil, nil
+	}
+	return stub.listMediaAssetsOutside(ctx, backend, limit)
+}
+
+func (stub adminMediaAssetStubRepository) UpdateMediaAssetStorage(
+	ctx context.Context,
+	record domain.AdminMediaAssetRecord,
+	storage string,
+	storageKey string,
+	data []byte,
+) (bool, error) {
+	if stub.updateMediaAssetStorage == nil {
+		return false, nil
+	}
+	return stub.updateMediaAssetStorage(ctx, record, storage, storageKey, data)
+}
+
+func (stub adminMediaAssetStubRepository) ListReferencedMediaAssetIDs(ctx context.Context) ([]string, error) {
+	if stub.listReferencedIDs == nil {
+		return nil, nil
+	}
+	return stub.listReferencedIDs(ctx)
+}
+
+func (stub adminMediaAssetStubRepository) ListMediaAssetsForGarbageCollection(
+	ctx context.Context,
+) ([]domain.AdminMediaAssetRecord, error) {
+	if stub.listMediaAssetsForGC == nil {
+		return nil, nil
+	}
+	return stub.listMediaAssetsForGC(ctx)
+}
+
+func (stub adminMediaAssetStubRepository) QuarantineMediaAssets(
+	ctx context.Context,
+	ids []string,
+	quarantinedAt time.Time,
+	purgeAfter time.Time,
+) (int, error) {
+	if stub.quarantineMediaAssets == nil {
+		return 0, nil
+	}
+	return stub.quarantineMediaAssets(ctx, ids, quarantinedAt, purgeAfter)
+}
+
+func (stub adminMediaAssetStubRepository) RestoreMediaAssets(ctx context.Context, ids []string) (int, error) {
+	if stub.restoreMediaAssets == nil {
+		return 0, nil
+	}
+	return stub.restoreMediaAssets(ctx, ids)
+}
+
+func (stub adminMediaAssetStubRepository) DeleteQuarantinedMediaAsset(
+	ctx context.Context,
+	id string,
+	now time.Time,
+) (bool, error) {
+	if stub.deleteQuarantinedAsset == nil {
+		return false, nil
+	}
+	return stub.deleteQuarantinedAsset(ctx, id, now)
+}
+
 func TestDeleteAdminMediaAssetRejectsUsedAssets(t *testing.T) {
 	originalRepository := adminMediaAssetRepository
 	t.Cleanup(func() {
@@ -114,7 +263,7 @@ func TestDeleteAdminMediaAssetRejectsUsedAssets(t *testing.T) {
 		},
 	}
 
-	err := DeleteAdminMediaAsset(context.Background(), &domain.AdminUser{ID: "admin-1"}, "asset-1")
+	err := testService().DeleteAdminMediaAsset(context.Background(), &domain.AdminUser{ID: "admin-1"}, "asset-1")
 	if err == nil || err.Error() != "media asset is still used by posts" {
 		t.Fatalf("expected in-use error, got %v", err)
 	}
@@ -149,7 +298,7 @@ func TestDeleteAdminMediaAssetDeletesUnusedAsset(t *testing.T) {
 		},
 	}
 
-	if err := DeleteAdminMediaAsset(context.Background(), &domain.AdminUser{ID: "admin-1"}, "asset-2"); err != nil {
+	if err := testService().DeleteAdminMediaAsset(context.Background(), &domain.AdminUser{ID: "admin-1"}, "asset-2"); err != nil {
 		t.Fatalf("expected delete to succeed, got %v", err)
 	}
 	if deletedID != "asset-2" {
@@ -169,7 +318,7 @@ func TestDeleteAdminMediaAssetMapsRepositoryFailure(t *testing.T) {
 		},
 	}
 
-	err := DeleteAdminMediaAsset(context.Background(), &domain.AdminUser{ID: "admin-1"}, "asset-3")
+	err := testService().DeleteAdminMediaAsset(context.Background(), &domain.AdminUser{ID: "admin-1"}, "asset-3")
 	if err == nil || !errors.Is(err, repository.ErrAdminMediaAssetRepositoryUnavailable) {
 		t.Fatalf("expected repository unavailable error, got %v", err)
 	}
@@ -213,9 +362,9 @@ func TestReplaceAdminMediaAssetReplacesUploadedAsset(t *testing.T) {
 		},
 	}
 
-	item, err := ReplaceAdminMediaAsset(context.Background(), &domain.AdminUser{ID: "admin-1"}, "asset-9", domain.AdminMediaUploadInput{
+	item, err := testService().ReplaceAdminMediaAsset(context.Background(), &domain.AdminUser{ID: "admin-1"}, "asset-9", domain.AdminMediaUploadInput{
 		FileName: "new-image.png",
-		DataURL:  "data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAIAAACQd1PeAAAADElEQVR42mP4z8AAAAMBAQDJ/pLvAAAAAElFTkSuQmCC",
+		DataURL:  "data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAIAAACQd1PeAAAADElEQVR42mP4z8AAAAMBAQD3A0FDAAAAAElFTkSuQmCC",
 	})
 	if err != nil {
 		t.Fatalf("expected replace to succeed, got %v", err)
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+)
+
+const (
+	maxAdminMediaAltTextLength   = 250
+	maxAdminMediaCaptionLength   = 500
+	maxAdminMediaCreditLength    = 200
+	maxAdminMediaTagCount        = 20
+	maxAdminMediaTagLength       = 40
+	maxAdminMediaFolderLength    = 120
+	maxAdminMediaFolderDepth     = 5
+	maxAdminMediaMoveAssetsCount = 100
+)
+
+func (s *Service) UpdateAdminMediaAssetMetadata(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	id string,
+	input domain.AdminMediaAssetMetadata,
+) (*domain.AdminMediaLibraryItem, error) {
+	if err := requireAdminAuthentication(adminUser); err != nil {
+		return nil, err
+	}
+
+	resolvedID := strings.TrimSpace(id)
+	if resolvedID == "" {
+		return nil, apperrors.BadRequest("media asset id is required")
+	}
+
+	metadata, err := normalizeAdminMediaAssetMetadata(input)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.adminMediaAssets.FindMediaAssetByID(ctx, resolvedID)
+	if err != nil {
+		return nil, toAdminMediaLibraryError(err, "failed to load admin media asset")
+	}
+	if existing == nil {
+		return nil, apperrors.New("NOT_FOUND", "media asset not found", 404, nil)
+	}
+
+	updatedAt := time.Now().UTC()
+	if err := s.adminMediaAssets.UpdateMediaAssetMetadata(ctx, resolvedID, metadata, updatedAt); err != nil {
+		return nil, toAdminMediaLibraryError(err, "failed to update admin media asset metadata")
+	}
+
+	usageCount, err := s.adminMediaAssets.CountMediaAssetUsage(ctx, "/api/media/"+resolvedID)
+	if err != nil {
+		return nil, toAdminMediaLibraryError(err, "failed to load media asset usage")
+	}
+
+	existing.Metadata = metadata
+	existing.UpdatedAt = updatedAt
+	item := mapAdminMediaLibraryItemFromAsset(*existing)
+	item.UsageCount = usageCount
+	return &item, nil
+}
+
+// MoveAdminMediaAssetsToFolder sets the virtual folder of several assets at once; an empty folder moves them back
+// to the library root. It returns the number of assets that exist.
+func (s *Service) MoveAdminMediaAssetsToFolder(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	ids []string,
+	folder string,
+) (int, error) {
+	if err := requireAdminAuthentication(adminUser); err != nil {
+		return 0, err
+	}
+
+	resolvedIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if resolvedID := strings.TrimSpace(id); resolvedID != "" && !slices.Contains(resolvedIDs, resolvedID) {
+			resolvedIDs = append(resolvedIDs, resolvedID)
+		}
+	}
+	if len(resolvedIDs) == 0 {
+		return 0, apperrors.BadRequest("media asset ids are required")
+	}
+	if len(resolvedIDs) > maxAdminMediaMoveAssetsCount {
+		return 0, apperrors.BadRequest("too many media assets in one move")
+	}
+
+	resolvedFolder, err := normalizeAdminMediaFolder(folder)
+	if err != nil {
+		return 0, err
+	}
+
+	moved, err := s.adminMediaAssets.MoveMediaAssetsToFolder(ctx, resolvedIDs, resolvedFolder, time.Now().UTC())
+	if err != nil {
+		return 0, toAdminMediaLibraryError(err, "failed to move admin media assets")
+	}
+	return moved, nil
+}
+
+func (s *Service) ListAdminMediaLibraryFacets(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+) (*domain.AdminMediaLibraryFacets, error) {
+	if err := requireAdminAuthentication(adminUser); err != nil {
+		return nil, err
+	}
+
+	facets, err := s.adminMediaAssets.ListMediaLibraryFacets(ctx)
+	if err != nil {
+		return nil, toAdminMediaLibraryError(err, "failed to list admin media library facets")
+	}
+	if facets == nil {
+		return &domain.AdminMediaLibraryFacets{Folders: []string{}, Tags: []string{}}, nil
+	}
+	return facets, nil
+}
+
+func normalizeAdminMediaAssetMetadata(input domain.AdminMediaAssetMetadata) (domain.AdminMediaAssetMetadata, error) {
+	altTexts := make([]domain.MediaAltText, 0, len(input.AltTexts))
+	for _, altText := range input.AltTexts {
+		locale, err := normalizeAdminContentLocale(altText.Locale, false)
+		if err != nil {
+			return domain.AdminMediaAssetMetadata{}, err
+		}
+		text := strings.Join(strings.Fields(altText.Text), " ")
+		if text == "" {
+			continue
+		}
+		if utf8.RuneCountInString(text) > maxAdminMediaAltTextLength {
+			return domain.AdminMediaAssetMetadata{}, apperrors.BadRequest("media alt text is too long")
+		}
+		if slices.ContainsFunc(altTexts, func(existing domain.MediaAltText) bool { return existing.Locale == locale }) {
+			return domain.AdminMediaAssetMetadata{}, apperrors.BadRequest("media alt text is duplicated for a locale")
+		}
+		altTexts = append(altTexts, domain.MediaAltText{Locale: locale, Text: text})
+	}
+	slices.SortFunc(altTexts, func(left, right domain.MediaAltText) int {
+		return strings.Compare(left.Locale, right.Locale)
+	})
+
+	caption := strings.TrimSpace(input.Caption)
+	if utf8.RuneCountInString(caption) > maxAdminMediaCaptionLength {
+		return domain.AdminMediaAssetMetadata{}, apperrors.BadRequest("media caption is too long")
+	}
+	credit := strings.Join(strings.Fields(input.Credit), " ")
+	if utf8.RuneCountInString(credit) > maxAdminMediaCreditLength {
+		return domain.AdminMediaAssetMetadata{}, apperrors.BadRequest("media credit is too long")
+	}
+
+	tags := make([]string, 0, len(input.Tags))
+	for _, value := range input.Tags {
+		tag := normalizeAdminMediaTag(value)
+		if tag == "" || slices.Contains(tags, tag) {
+			continue
+		}
+		if utf8.RuneCountInString(tag) > maxAdminMediaTagLength {
+			return domain.AdminMediaAssetMetadata{}, apperrors.BadRequest("media tag is too long")
+		}
+		tags = append(tags, tag)
+	}
+	if len(tags) > maxAdminMediaTagCount {
+		return domain.AdminMediaAssetMetadata{}, apperrors.BadRequest("media asset has too many tags")
+	}
+	slices.Sort(tags)
+
+	folder, err := normalizeAdminMediaFolder(input.Folder)
+	if err != nil {
+		return domain.AdminMediaAssetMetadata{}, err
+	}
+
+	return domain.AdminMediaAssetMetadata{
+		AltTexts: altTexts,
+		Caption:  caption,
+		Credit:   credit,
+		Tags:     tags,
+		Folder:   folder,
+	}, nil
+}
+
+// normalizeAdminMediaTag lowercases a tag and collapses inner whitespace so that "Travel  Photos" and
+// "travel photos" filter the same assets.
+func normalizeAdminMediaTag(value string) string {
+	return strings.ToLower(strings.Join(strings.Fields(value), " "))
+}
+
+// normalizeAdminMediaFolder cleans a virtual folder path such as " /Blog / 2026/ " into "Blog/2026". Folder names
+// keep their case but may not contain control characters or relative segments.
+func normalizeAdminMediaFolder(value string) (string, error) {
+	segments := make([]string, 0, maxAdminMediaFolderDepth)
+	for _, segment := range strings.Split(value, "/") {
+		resolved := strings.Join(strings.Fields(segment), " ")
+		if resolved == "" {
+			continue
+		}
+		if resolved == "." || resolved == ".." || strings.ContainsFunc(resolved, unicode.IsControl) {
+			return "", apperrors.BadRequest("media folder is invalid")
+		}
+		segments = append(segments, resolved)
+	}
+	if len(segments) > maxAdminMediaFolderDepth {
+		return "", apperrors.BadRequest("media folder is nested too deeply")
+	}
+
+	folder := strings.Join(segments, "/")
+	if utf8.RuneCountInString(folder) > maxAdminMediaFolderLength {
+		return "", apperrors.BadRequest("media folder is too long")
+	}
+	return folder, nil
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+)
+
+func TestNormalizeAdminMediaAssetMetadata(t *testing.T) {
+	metadata, err := normalizeAdminMediaAssetMetadata(domain.AdminMediaAssetMetadata{
+		AltTexts: []domain.MediaAltText{
+			{Locale: "TR", Text: "  Galata   Kulesi "},
+			{Locale: "en", Text: "Galata Tower"},
+		},
+		Caption: "  Sunset over the Golden Horn ",
+		Credit:  " Jane   Doe ",
+		Tags:    []string{"Travel", " travel ", "Istanbul  Photos", ""},
+		Folder:  " /Blog / 2026/ ",
+	})
+	if err != nil {
+		t.Fatalf("normalizeAdminMediaAssetMetadata() error = %v", err)
+	}
+
+	expected := domain.AdminMediaAssetMetadata{
+		AltTexts: []domain.MediaAltText{
+			{Locale: "en", Text: "Galata Tower"},
+			{Locale: "tr", Text: "Galata Kulesi"},
+		},
+		Caption: "Sunset over the Golden Horn",
+		Credit:  "Jane Doe",
+		Tags:    []string{"istanbul photos", "travel"},
+		Folder:  "Blog/2026",
+	}
+	if !reflect.DeepEqual(metadata, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, metadata)
+	}
+
+	invalid := []domain.AdminMediaAssetMetadata{
+		{AltTexts: []domain.MediaAltText{{Locale: "en", Text: "a"}, {Locale: "EN", Text: "b"}}},
+		{AltTexts: []domain.MediaAltText{{Locale: "de", Text: "Galata-Turm"}}},
+		{Folder: "blog/../secrets"},
+		{Folder: "a/b/c/d/e/f"},
+	}
+	for _, input := range invalid {
+		if _, err := normalizeAdminMediaAssetMetadata(input); apperrors.From(err).HTTPStatus != 400 {
+			t.Fatalf("expected bad request for %#v, got %v", input, err)
+		}
+	}
+}
+
+func TestUpdateAdminMediaAssetMetadataStoresNormalizedMetadata(t *testing.T) {
+	originalRepository := adminMediaAssetRepository
+	t.Cleanup(func() {
+		adminMediaAssetRepository = originalRepository
+	})
+
+	var stored domain.AdminMediaAssetMetadata
+	adminMediaAssetRepository = adminMediaAssetStubRepository{
+		findMediaAssetByID: func(_ context.Context, id string) (*domain.AdminMediaAssetRecord, error) {
+			if id != "asset-1" {
+				return nil, nil
+			}
+			return &domain.AdminMediaAssetRecord{ID: id, Name: "cover.webp", ContentType: "image/webp"}, nil
+		},
+		updateMediaAssetMetadata: func(
+			_ context.Context,
+			id string,
+			metadata domain.AdminMediaAssetMetadata,
+			updatedAt time.Time,
+		) error {
+			if id != "asset-1" || updatedAt.IsZero() {
+				t.Fatalf("unexpected update %q at %v", id, updatedAt)
+			}
+			stored = metadata
+			return nil
+		},
+		countMediaAssetUsage: func(context.Context, string) (int, error) {
+			return 2, nil
+		},
+	}
+
+	adminUser := &domain.AdminUser{ID: "admin-1"}
+	item, err := testService().UpdateAdminMediaAssetMetadata(context.Background(), adminUser, " asset-1 ", domain.AdminMediaAssetMetadata{
+		AltTexts: []domain.MediaAltText{{Locale: "en", Text: "Galata Tower"}},
+		Tags:     []string{"Travel"},
+		Folder:   "blog/",
+	})
+	if err != nil {
+		t.Fatalf("UpdateAdminMediaAssetMetadata() error = %v", err)
+	}
+	if stored.Folder != "blog" || !reflect.DeepEqual(stored.Tags, []string{"travel"}) {
+		t.Fatalf("unexpected stored metadata %#v", stored)
+	}
+	if item.UsageCount != 2 || item.Metadata.Folder != "blog" || item.Value != "/api/media/asset-1" {
+		t.Fatalf("unexpected item %#v", item)
+	}
+
+	_, err = testService().UpdateAdminMediaAssetMetadata(context.Background(), adminUser, "missing", domain.AdminMediaAssetMetadata{})
+	if apperrors.From(err).HTTPStatus != 404 {
+		t.Fatalf("expected not found, got %v", err)
+	}
+}
+
+func TestMoveAdminMediaAssetsToFolderDeduplicatesIDs(t *testing.T) {
+	originalRepository := adminMediaAssetRepository
+	t.Cleanup(func() {
+		adminMediaAssetRepository = originalRepository
+	})
+
+	adminMediaAssetRepository = adminMediaAssetStubRepository{
+		moveMediaAssetsToFolder: func(_ context.Context, ids []string, folder string, _ time.Time) (int, error) {
+			if !reflect.DeepEqual(ids, []string{"asset-1", "asset-2"}) || folder != "Blog/Covers" {
+				t.Fatalf("unexpected move %#v to %q", ids, folder)
+			}
+			return len(ids), nil
+		},
+	}
+
+	adminUser := &domain.AdminUser{ID: "admin-1"}
+	moved, err := testService().MoveAdminMediaAssetsToFolder(
+		context.Background(),
+		adminUser,
+		[]string{"asset-1", " asset-2 ", "asset-1", ""},
+		"Blog/Covers/",
+	)
+	if err != nil || moved != 2 {
+		t.Fatalf("MoveAdminMediaAssetsToFolder() = %d, %v", moved, err)
+	}
+
+	if _, err := testService().MoveAdminMediaAssetsToFolder(context.Background(), adminUser, nil, "blog"); apperrors.From(err).HTTPStatus != 400 {
+		t.Fatalf("expected bad request without ids, got %v", err)
+	}
+	if _, err := testService().MoveAdminMediaAssetsToFolder(context.Background(), nil, []string{"asset-1"}, ""); apperrors.From(err).HTTPStatus != 401 {
+		t.Fatalf("expected unauthorized without admin, got %v", err)
+	}
+}
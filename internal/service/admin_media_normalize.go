@@ -0,0 +1,482 @@
+package service
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"image"
+	"image/draw"
+	"strings"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+)
+
+const (
+	adminMediaNormalizedQuality = 90
+
+	adminMediaOrientationNormal = 1
+	adminMediaExifCopyrightTag  = 0x8298
+	adminMediaExifOrientation   = 0x0112
+
+	webpVP8XFlagXMP  = 0x04
+	webpVP8XFlagEXIF = 0x08
+)
+
+var (
+	resolveAdminMediaCopyright = appconfig.ResolveMediaCopyright
+
+	pngSignature         = []byte("\x89PNG\r\n\x1a\n")
+	jpegExifIdentifier   = []byte("Exif\x00\x00")
+	jpegXMPIdentifier    = []byte("http://ns.adobe.com/xap/1.0/")
+	jpegXMPExtIdentifier = []byte("http://ns.adobe.com/xmp/extension/")
+
+	errInvalidAdminMediaContainer = errors.New("invalid image container")
+)
+
+// normalizeAdminMediaPayload bakes the EXIF orientation into the pixels, drops EXIF/XMP metadata (GPS, camera
+// serials, editing history) and records the placeholder shown while the real image loads. When a copyright notice
+// is configured it is written back as the only remaining metadata field.
+func normalizeAdminMediaPayload(payload *decodedAdminMediaPayload) (*decodedAdminMediaPayload, error) {
+	orientation, stripped, err := stripAdminMediaMetadata(payload.ContentType, payload.Data)
+	if err != nil {
+		return nil, apperrors.BadRequest("media asset must be a valid image")
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(payload.Data))
+	if err != nil {
+		return nil, apperrors.BadRequest("media asset must be a valid image")
+	}
+
+	data := stripped
+	if orientation > adminMediaOrientationNormal && orientation <= 8 {
+		decoded = applyAdminMediaOrientation(decoded, orientation)
+		// Re-encoding drops every metadata block, so the stripped copy is no longer needed.
+		var buffer bytes.Buffer
+		if err := encodeAdminMediaImage(&buffer, toAdminMediaRGBA(decoded), payload.ContentType, adminMediaNormalizedQuality); err != nil {
+			return nil, apperrors.Internal("failed to normalize media asset orientation", err)
+		}
+		data = buffer.Bytes()
+	}
+
+	bounds := decoded.Bounds()
+	if copyright := strings.TrimSpace(resolveAdminMediaCopyright()); copyright != "" {
+		data = embedAdminMediaCopyright(payload.ContentType, data, copyright, bounds.Dx(), bounds.Dy())
+	}
+
+	placeholderSource := scaleAdminMediaPlaceholderSource(decoded)
+	return &decodedAdminMediaPayload{
+		ContentType:   payload.ContentType,
+		Data:          data,
+		Width:         bounds.Dx(),
+		Height:        bounds.Dy(),
+		DominantColor: computeAdminMediaDominantColor(placeholderSource),
+		BlurHash:      encodeAdminMediaBlurHash(placeholderSource),
+	}, nil
+}
+
+// stripAdminMediaMetadata removes EXIF and XMP blocks without touching the compressed image data and reports
+// the EXIF orientation found on the way (1 when absent).
+func stripAdminMediaMetadata(contentType string, data []byte) (int, []byte, error) {
+	switch contentType {
+	case adminAvatarContentTypeJPEG:
+		return stripJPEGMetadata(data)
+	case adminAvatarContentTypePNG:
+		return stripPNGMetadata(data)
+	case adminAvatarContentTypeWEBP:
+		return stripWebPMetadata(data)
+	default:
+		return adminMediaOrientationNormal, data, nil
+	}
+}
+
+func stripJPEGMetadata(data []byte) (int, []byte, error) {
+	if len(data) < 4 || data[0] != 0xff || data[1] != 0xd8 {
+		return 0, nil, errInvalidAdminMediaContainer
+	}
+
+	orientation := adminMediaOrientationNormal
+	output := make([]byte, 0, len(data))
+	output = append(output, data[:2]...)
+	position := 2
+	for position+4 <= len(data) {
+		if data[position] != 0xff {
+			return 0, nil, errInvalidAdminMediaContainer
+		}
+		marker := data[position+1]
+		if marker == 0xff {
+			// Fill bytes may precede a marker.
+			position++
+			continue
+		}
+		if marker == 0xda {
+			// Entropy-coded data starts after SOS and runs to EOI; it carries no metadata.
+			output = append(output, data[position:]...)
+			return orientation, output, nil
+		}
+		if marker == 0x01 || (marker >= 0xd0 && marker <= 0xd7) {
+			output = append(output, data[position:position+2]...)
+			position += 2
+			continue
+		}
+
+		segmentLength := int(binary.BigEndian.Uint16(data[position+2 : position+4]))
+		segmentEnd := position + 2 + segmentLength
+		if segmentLength < 2 || segmentEnd > len(data) {
+			return 0, nil, errInvalidAdminMediaContainer
+		}
+		segmentPayload := data[position+4 : segmentEnd]
+
+		drop := false
+		switch marker {
+		case 0xe1: // APP1 carries EXIF or XMP.
+			switch {
+			case bytes.HasPrefix(segmentPayload, jpegExifIdentifier):
+				orientation = readAdminMediaTIFFOrientation(segmentPayload[len(jpegExifIdentifier):])
+				drop = true
+			case bytes.HasPrefix(segmentPayload, jpegXMPIdentifier), bytes.HasPrefix(segmentPayload, jpegXMPExtIdentifier):
+				drop = true
+			}
+		case 0xed, 0xfe: // APP13 (Photoshop/IPTC) and comments.
+			drop = true
+		}
+		if !drop {
+			output = append(output, data[position:segmentEnd]...)
+		}
+		position = segmentEnd
+	}
+
+	return 0, nil, errInvalidAdminMediaContainer
+}
+
+func stripPNGMetadata(data []byte) (int, []byte, error) {
+	if !bytes.HasPrefix(data, pngSignature) {
+		return 0, nil, errInvalidAdminMediaContainer
+	}
+
+	orientation := adminMediaOrientationNormal
+	output := make([]byte, 0, len(data))
+	output = append(output, pngSignature...)
+	position := len(pngSignature)
+	for position+12 <= len(data) {
+		chunkLength := int(binary.BigEndian.Uint32(data[position : position+4]))
+		chunkEnd := position + 12 + chunkLength
+		if chunkEnd > len(data) {
+			return 0, nil, errInvalidAdminMediaContainer
+		}
+		chunkType := string(data[position+4 : position+8])
+
+		switch chunkType {
+		case "eXIf":
+			orientation = readAdminMediaTIFFOrientation(data[position+8 : position+8+chunkLength])
+		case "tEXt", "zTXt", "iTXt", "tIME":
+		default:
+			output = append(output, data[position:chunkEnd]...)
+		}
+		position = chunkEnd
+		if chunkType == "IEND" {
+			return orientation, output, nil
+		}
+	}
+
+	return 0, nil, errInvalidAdminMediaContainer
+}
+
+func stripWebPMetadata(data []byte) (int, []byte, error) {
+	if len(data) < 12 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return 0, nil, errInvalidAdminMediaContainer
+	}
+
+	orientation := adminMediaOrientationNormal
+	output := make([]byte, 0, len(data))
+	output = append(output, data[:12]...)
+	position := 12
+	for position+8 <= len(data) {
+		chunkType := string(data[position : position+4])
+		chunkLength := int(binary.LittleEndian.Uint32(data[position+4 : position+8]))
+		if position+8+chunkLength > len(data) {
+			return 0, nil, errInvalidAdminMediaContainer
+		}
+		// Chunks are padded to an even length; tolerate files that omit the final pad byte.
+		chunkEnd := min(position+8+chunkLength+chunkLength%2, len(data))
+
+		switch chunkType {
+		case "EXIF":
+			payload := data[position+8 : position+8+chunkLength]
+			// Some encoders keep the JPEG-style identifier in front of the TIFF header.
+			orientation = readAdminMediaTIFFOrientation(bytes.TrimPrefix(payload, jpegExifIdentifier))
+		case "XMP ":
+		case "VP8X":
+			chunk := append([]byte(nil), data[position:chunkEnd]...)
+			if len(chunk) > 8 {
+				chunk[8] &^= webpVP8XFlagEXIF | webpVP8XFlagXMP
+			}
+			output = append(output, chunk...)
+		default:
+			output = append(output, data[position:chunkEnd]...)
+		}
+		position = chunkEnd
+	}
+
+	binary.LittleEndian.PutUint32(output[4:8], uint32(len(output)-8))
+	return orientation, output, nil
+}
+
+// readAdminMediaTIFFOrientation reads the orientation tag from IFD0 of an EXIF TIFF block and falls back to 1.
+func readAdminMediaTIFFOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return adminMediaOrientationNormal
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[0:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return adminMediaOrientationNormal
+	}
+	if order.Uint16(tiff[2:4]) != 42 {
+		return adminMediaOrientationNormal
+	}
+
+	ifdOffset := int(order.Uint32(tiff[4:8]))
+	if ifdOffset < 8 || ifdOffset+2 > len(tiff) {
+		return adminMediaOrientationNormal
+	}
+	entryCount := int(order.Uint16(tiff[ifdOffset : ifdOffset+2]))
+	for index := range entryCount {
+		entry := ifdOffset + 2 + index*12
+		if entry+12 > len(tiff) {
+			break
+		}
+		if order.Uint16(tiff[entry:entry+2]) != adminMediaExifOrientation {
+			continue
+		}
+		value := int(order.Uint16(tiff[entry+8 : entry+10]))
+		if value >= 1 && value <= 8 {
+			return value
+		}
+		break
+	}
+	return adminMediaOrientationNormal
+}
+
+// applyAdminMediaOrientation returns the image as it should be displayed for the given EXIF orientation.
+func applyAdminMediaOrientation(source image.Image, orientation int) image.Image {
+	bounds := source.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	destinationBounds := image.Rect(0, 0, width, height)
+	if orientation >= 5 {
+		destinationBounds = image.Rect(0, 0, height, width)
+	}
+	destination := image.NewRGBA(destinationBounds)
+
+	for y := range height {
+		for x := range width {
+			targetX, targetY := x, y
+			switch orientation {
+			case 2:
+				targetX = width - 1 - x
+			case 3:
+				targetX, targetY = width-1-x, height-1-y
+			case 4:
+				targetY = height - 1 - y
+			case 5:
+				targetX, targetY = y, x
+			case 6:
+				targetX, targetY = height-1-y, x
+			case 7:
+				targetX, targetY = height-1-y, width-1-x
+			case 8:
+				targetX, targetY = y, width-1-x
+			}
+			destination.Set(targetX, targetY, source.At(bounds.Min.X+x, bounds.Min.Y+y))
+		}
+	}
+	return destination
+}
+
+func toAdminMediaRGBA(source image.Image) *image.RGBA {
+	if rgba, ok := source.(*image.RGBA); ok {
+		return rgba
+	}
+	bounds := source.Bounds()
+	destination := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(destination, destination.Bounds(), source, bounds.Min, draw.Src)
+	return destination
+}
+
+func embedAdminMediaCopyright(contentType string, data []byte, copyright string, width, height int) []byte {
+	switch contentType {
+	case adminAvatarContentTypeJPEG:
+		return embedJPEGCopyright(data, copyright)
+	case adminAvatarContentTypePNG:
+		return embedPNGCopyright(data, copyright)
+	case adminAvatarContentTypeWEBP:
+		return embedWebPCopyright(data, copyright, width, height)
+	default:
+		return data
+	}
+}
+
+// buildAdminMediaCopyrightExif returns a little-endian TIFF block whose IFD0 holds only the Copyright tag.
+func buildAdminMediaCopyrightExif(copyright string) []byte {
+	value := append([]byte(strings.ToValidUTF8(copyright, "")), 0)
+	const valueOffset = 8 + 2 + 12 + 4
+
+	tiff := make([]byte, valueOffset, valueOffset+len(value))
+	copy(tiff[0:4], "II*\x00")
+	binary.LittleEndian.PutUint32(tiff[4:8], 8)
+	binary.LittleEndian.PutUint16(tiff[8:10], 1)
+	binary.LittleEndian.PutUint16(tiff[10:12], adminMediaExifCopyrightTag)
+	binary.LittleEndian.PutUint16(tiff[12:14], 2) // ASCII
+	binary.LittleEndian.PutUint32(tiff[14:18], uint32(len(value)))
+	if len(value) <= 4 {
+		copy(tiff[18:22], value)
+		return tiff
+	}
+	binary.LittleEndian.PutUint32(tiff[18:22], valueOffset)
+	// The next-IFD offset at 22..26 stays zero.
+	return append(tiff, value...)
+}
+
+func embedJPEGCopyright(data []byte, copyright string) []byte {
+	exif := append(append([]byte(nil), jpegExifIdentifier...), buildAdminMediaCopyrightExif(copyright)...)
+	if len(exif)+2 > 0xffff {
+		return data
+	}
+
+	// EXIF belongs right after SOI, or after the JFIF APP0 segment when one is present.
+	insertAt := 2
+	if len(data) > 6 && data[2] == 0xff && data[3] == 0xe0 {
+		insertAt = 4 + int(binary.BigEndian.Uint16(data[4:6]))
+	}
+	if insertAt > len(data) {
+		return data
+	}
+
+	segment := make([]byte, 4, 4+len(exif))
+	segment[0], segment[1] = 0xff, 0xe1
+	binary.BigEndian.PutUint16(segment[2:4], uint16(len(exif)+2))
+	segment = append(segment, exif...)
+
+	output := make([]byte, 0, len(data)+len(segment))
+	output = append(output, data[:insertAt]...)
+	output = append(output, segment...)
+	return append(output, data[insertAt:]...)
+}
+
+func embedPNGCopyright(data []byte, copyright string) []byte {
+	// iTXt keeps UTF-8 intact: keyword, NUL, no compression, empty language and translated keyword, then text.
+	var text bytes.Buffer
+	text.WriteString("Copyright")
+	text.Write([]byte{0, 0, 0, 0, 0})
+	text.WriteString(strings.ToValidUTF8(copyright, ""))
+
+	chunk := make([]byte, 8, 12+text.Len())
+	binary.BigEndian.PutUint32(chunk[0:4], uint32(text.Len()))
+	copy(chunk[4:8], "iTXt")
+	chunk = append(chunk, text.Bytes()...)
+	chunk = binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(chunk[4:]))
+
+	// IHDR must stay the first chunk.
+	insertAt := len(pngSignature)
+	if len(data) >= insertAt+8 {
+		insertAt += 12 + int(binary.BigEndian.Uint32(data[insertAt:insertAt+4]))
+	}
+	if insertAt > len(data) {
+		return data
+	}
+
+	output := make([]byte, 0, len(data)+len(chunk))
+	output = append(output, data[:insertAt]...)
+	output = append(output, chunk...)
+	return append(output, data[insertAt:]...)
+}
+
+func embedWebPCopyright(data []byte, copyright string, width, height int) []byte {
+	if len(data) < 20 || width <= 0 || height <= 0 {
+		return data
+	}
+
+	exif := buildAdminMediaCopyrightExif(copyright)
+	exifChunk := make([]byte, 8, 8+len(exif)+1)
+	copy(exifChunk[0:4], "EXIF")
+	binary.LittleEndian.PutUint32(exifChunk[4:8], uint32(len(exif)))
+	exifChunk = append(exifChunk, exif...)
+	if len(exif)%2 == 1 {
+		exifChunk = append(exifChunk, 0)
+	}
+
+	output := make([]byte, 0, len(data)+len(exifChunk)+18)
+	output = append(output, data[:12]...)
+	body := data[12:]
+	if string(body[0:4]) == "VP8X" && len(body) >= 18 {
+		header := append([]byte(nil), body[:18]...)
+		header[8] |= webpVP8XFlagEXIF
+		output = append(output, header...)
+		output = append(output, body[18:]...)
+	} else {
+		// Simple-format files need an extended header before they may carry metadata chunks.
+		header := make([]byte, 18)
+		copy(header[0:4], "VP8X")
+		binary.LittleEndian.PutUint32(header[4:8], 10)
+		header[8] = webpVP8XFlagEXIF
+		if string(body[0:4]) == "VP8L" {
+			header[8] |= 0x10 // Lossless bitstreams may carry alpha.
+		}
+		putUint24LE(header[12:15], uint32(width-1))
+		putUint24LE(header[15:18], uint32(height-1))
+		output = append(output, header...)
+		output = append(output, body...)
+	}
+	output = append(output, exifChunk...)
+	binary.LittleEndian.PutUint32(output[4:8], uint32(len(output)-8))
+	return output
+}
+
+func putUint24LE(target []byte, value uint32) {
+	target[0] = byte(value)
+	target[1] = byte(value >> 8)
+	target[2] = byte(value >> 16)
+}
+
+// computeAdminMediaDominantColor buckets pixels into a 4-bit-per-channel histogram and returns the mean color of
+// the fullest bucket as #rrggbb. The placeholder source is already flattened onto white.
+func computeAdminMediaDominantColor(source *image.RGBA) string {
+	type bucket struct {
+		count, red, green, blue int
+	}
+	buckets := map[int]*bucket{}
+	best := (*bucket)(nil)
+
+	bounds := source.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			pixel := source.RGBAAt(x, y)
+			key := int(pixel.R>>4)<<8 | int(pixel.G>>4)<<4 | int(pixel.B>>4)
+			entry := buckets[key]
+			if entry == nil {
+				entry = &bucket{}
+				buckets[key] = entry
+			}
+			entry.count++
+			entry.red += int(pixel.R)
+			entry.green += int(pixel.G)
+			entry.blue += int(pixel.B)
+			if best == nil || entry.count > best.count {
+				best = entry
+			}
+		}
+	}
+	if best == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("#%02x%02x%02x", best.red/best.count, best.green/best.count, best.blue/best.count)
+}
@@ -0,0 +1,279 @@
+package service
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+func buildAdminMediaTestOrientationExif(orientation uint16) []byte {
+	tiff := make([]byte, 26)
+	copy(tiff[0:4], "MM\x00*")
+	binary.BigEndian.PutUint32(tiff[4:8], 8)
+	binary.BigEndian.PutUint16(tiff[8:10], 1)
+	binary.BigEndian.PutUint16(tiff[10:12], adminMediaExifOrientation)
+	binary.BigEndian.PutUint16(tiff[12:14], 3) // SHORT
+	binary.BigEndian.PutUint32(tiff[14:18], 1)
+	binary.BigEndian.PutUint16(tiff[18:20], orientation)
+	return tiff
+}
+
+func buildAdminMediaTestJPEG(t *testing.T, width, height int, orientation uint16) []byte {
+	t.Helper()
+
+	source := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := range height {
+		for x := range width {
+			source.Set(x, y, color.RGBA{R: 200, G: 40, B: 40, A: 255})
+		}
+	}
+	var encoded bytes.Buffer
+	if err := jpeg.Encode(&encoded, source, &jpeg.Options{Quality: 90}); err != nil {
+		t.Fatalf("jpeg.Encode() error = %v", err)
+	}
+
+	exif := append(append([]byte(nil), jpegExifIdentifier...), buildAdminMediaTestOrientationExif(orientation)...)
+	exif = append(exif, []byte("GPS 41.0082 28.9784")...)
+	segment := []byte{0xff, 0xe1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:4], uint16(len(exif)+2))
+	segment = append(segment, exif...)
+
+	xmp := append(append([]byte(nil), jpegXMPIdentifier...), 0)
+	xmp = append(xmp, []byte("<x:xmpmeta>camera</x:xmpmeta>")...)
+	xmpSegment := []byte{0xff, 0xe1, 0, 0}
+	binary.BigEndian.PutUint16(xmpSegment[2:4], uint16(len(xmp)+2))
+	xmpSegment = append(xmpSegment, xmp...)
+
+	data := encoded.Bytes()
+	output := append([]byte(nil), data[:2]...)
+	output = append(output, segment...)
+	output = append(output, xmpSegment...)
+	return append(output, data[2:]...)
+}
+
+func buildAdminMediaTestPNGChunk(chunkType string, payload []byte) []byte {
+	chunk := make([]byte, 8, 12+len(payload))
+	binary.BigEndian.PutUint32(chunk[0:4], uint32(len(payload)))
+	copy(chunk[4:8], chunkType)
+	chunk = append(chunk, payload...)
+	return binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(chunk[4:]))
+}
+
+func useAdminMediaTestCopyright(t *testing.T, value string) {
+	t.Helper()
+	original := resolveAdminMediaCopyright
+	t.Cleanup(func() {
+		resolveAdminMediaCopyright = original
+	})
+	resolveAdminMediaCopyright = func() string { return value }
+}
+
+func TestNormalizeAdminMediaPayloadAppliesJPEGOrientationAndStripsMetadata(t *testing.T) {
+	useAdminMediaTestCopyright(t, "")
+
+	data := buildAdminMediaTestJPEG(t, 8, 4, 6)
+	normalized, err := normalizeAdminMediaPayload(&decodedAdminMediaPayload{
+		ContentType: adminAvatarContentTypeJPEG,
+		Data:        data,
+	})
+	if err != nil {
+		t.Fatalf("normalizeAdminMediaPayload() error = %v", err)
+	}
+	if normalized.Width != 4 || normalized.Height != 8 {
+		t.Fatalf("expected rotated 4x8 dimensions, got %dx%d", normalized.Width, normalized.Height)
+	}
+	if bytes.Contains(normalized.Data, []byte("GPS")) || bytes.Contains(normalized.Data, jpegExifIdentifier) {
+		t.Fatal("expected EXIF data to be removed")
+	}
+	if bytes.Contains(normalized.Data, []byte("xmpmeta")) {
+		t.Fatal("expected XMP data to be removed")
+	}
+
+	config, err := jpeg.DecodeConfig(bytes.NewReader(normalized.Data))
+	if err != nil {
+		t.Fatalf("normalized JPEG does not decode: %v", err)
+	}
+	if config.Width != 4 || config.Height != 8 {
+		t.Fatalf("expected stored pixels to be rotated, got %dx%d", config.Width, config.Height)
+	}
+	if !strings.HasPrefix(normalized.DominantColor, "#") || len(normalized.DominantColor) != 7 {
+		t.Fatalf("unexpected dominant color %q", normalized.DominantColor)
+	}
+	if normalized.BlurHash == "" {
+		t.Fatal("expected a BlurHash placeholder")
+	}
+}
+
+func TestNormalizeAdminMediaPayloadKeepsUprightJPEGBitstream(t *testing.T) {
+	useAdminMediaTestCopyright(t, "")
+
+	data := buildAdminMediaTestJPEG(t, 8, 4, 1)
+	normalized, err := normalizeAdminMediaPayload(&decodedAdminMediaPayload{
+		ContentType: adminAvatarContentTypeJPEG,
+		Data:        data,
+	})
+	if err != nil {
+		t.Fatalf("normalizeAdminMediaPayload() error = %v", err)
+	}
+	if normalized.Width != 8 || normalized.Height != 4 {
+		t.Fatalf("expected 8x4 dimensions, got %dx%d", normalized.Width, normalized.Height)
+	}
+	if bytes.Contains(normalized.Data, []byte("GPS")) {
+		t.Fatal("expected EXIF data to be removed")
+	}
+	sosIndex := bytes.Index(data, []byte{0xff, 0xda})
+	if !bytes.HasSuffix(normalized.Data, data[sosIndex:]) {
+		t.Fatal("expected scan data to be copied without re-encoding")
+	}
+}
+
+func TestNormalizeAdminMediaPayloadEmbedsConfiguredCopyright(t *testing.T) {
+	useAdminMediaTestCopyright(t, "(c) Example Author")
+
+	normalized, err := normalizeAdminMediaPayload(&decodedAdminMediaPayload{
+		ContentType: adminAvatarContentTypeJPEG,
+		Data:        buildAdminMediaTestJPEG(t, 8, 4, 1),
+	})
+	if err != nil {
+		t.Fatalf("normalizeAdminMediaPayload() error = %v", err)
+	}
+	if bytes.Contains(normalized.Data, []byte("GPS")) {
+		t.Fatal("expected camera EXIF data to be removed")
+	}
+	exifIndex := bytes.Index(normalized.Data, jpegExifIdentifier)
+	if exifIndex < 0 || !bytes.Contains(normalized.Data, []byte("(c) Example Author\x00")) {
+		t.Fatal("expected copyright EXIF segment")
+	}
+	if orientation := readAdminMediaTIFFOrientation(normalized.Data[exifIndex+len(jpegExifIdentifier):]); orientation != 1 {
+		t.Fatalf("expected no orientation tag, got %d", orientation)
+	}
+	if _, err := jpeg.Decode(bytes.NewReader(normalized.Data)); err != nil {
+		t.Fatalf("JPEG with copyright does not decode: %v", err)
+	}
+}
+
+func TestNormalizeAdminMediaPayloadStripsPNGTextChunks(t *testing.T) {
+	useAdminMediaTestCopyright(t, "Example Author")
+
+	source := image.NewNRGBA(image.Rect(0, 0, 3, 2))
+	for index := range source.Pix {
+		source.Pix[index] = 255
+	}
+	var encoded bytes.Buffer
+	if err := png.Encode(&encoded, source); err != nil {
+		t.Fatalf("png.Encode() error = %v", err)
+	}
+	data := encoded.Bytes()
+	ihdrEnd := len(pngSignature) + 12 + 13
+	withText := append([]byte(nil), data[:ihdrEnd]...)
+	withText = append(withText, buildAdminMediaTestPNGChunk("tEXt", []byte("Comment\x00taken at home"))...)
+	withText = append(withText, buildAdminMediaTestPNGChunk("eXIf", buildAdminMediaTestOrientationExif(3))...)
+	withText = append(withText, data[ihdrEnd:]...)
+
+	normalized, err := normalizeAdminMediaPayload(&decodedAdminMediaPayload{
+		ContentType: adminAvatarContentTypePNG,
+		Data:        withText,
+	})
+	if err != nil {
+		t.Fatalf("normalizeAdminMediaPayload() error = %v", err)
+	}
+	if bytes.Contains(normalized.Data, []byte("taken at home")) || bytes.Contains(normalized.Data, []byte("eXIf")) {
+		t.Fatal("expected PNG metadata chunks to be removed")
+	}
+	if !bytes.Contains(normalized.Data, []byte("iTXtCopyright")) {
+		t.Fatal("expected iTXt copyright chunk")
+	}
+	if _, err := png.Decode(bytes.NewReader(normalized.Data)); err != nil {
+		t.Fatalf("normalized PNG does not decode: %v", err)
+	}
+	if normalized.DominantColor != "#ffffff" {
+		t.Fatalf("expected white dominant color, got %q", normalized.DominantColor)
+	}
+}
+
+func TestStripWebPMetadataDropsExifAndXMPChunks(t *testing.T) {
+	chunk := func(chunkType string, payload []byte) []byte {
+		header := make([]byte, 8, 8+len(payload)+1)
+		copy(header[0:4], chunkType)
+		binary.LittleEndian.PutUint32(header[4:8], uint32(len(payload)))
+		header = append(header, payload...)
+		if len(payload)%2 == 1 {
+			header = append(header, 0)
+		}
+		return header
+	}
+
+	vp8x := make([]byte, 10)
+	vp8x[0] = webpVP8XFlagEXIF | webpVP8XFlagXMP
+	body := chunk("VP8X", vp8x)
+	body = append(body, chunk("VP8L", []byte("bitstream"))...)
+	body = append(body, chunk("EXIF", buildAdminMediaTestOrientationExif(8))...)
+	body = append(body, chunk("XMP ", []byte("<x:xmpmeta/>"))...)
+	data := append([]byte("RIFF\x00\x00\x00\x00WEBP"), body...)
+	binary.LittleEndian.PutUint32(data[4:8], uint32(len(data)-8))
+
+	orientation, stripped, err := stripWebPMetadata(data)
+	if err != nil {
+		t.Fatalf("stripWebPMetadata() error = %v", err)
+	}
+	if orientation != 8 {
+		t.Fatalf("expected orientation 8, got %d", orientation)
+	}
+	if bytes.Contains(stripped, []byte("EXIF")) || bytes.Contains(stripped, []byte("xmpmeta")) {
+		t.Fatal("expected metadata chunks to be removed")
+	}
+	if stripped[20]&(webpVP8XFlagEXIF|webpVP8XFlagXMP) != 0 {
+		t.Fatalf("expected metadata flags to be cleared, got %08b", stripped[20])
+	}
+	if size := binary.LittleEndian.Uint32(stripped[4:8]); int(size) != len(stripped)-8 {
+		t.Fatalf("expected RIFF size %d, got %d", len(stripped)-8, size)
+	}
+}
+
+func TestApplyAdminMediaOrientationRotatesClockwise(t *testing.T) {
+	source := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	source.Set(0, 0, color.RGBA{R: 255, A: 255})
+	source.Set(1, 0, color.RGBA{B: 255, A: 255})
+
+	rotated := applyAdminMediaOrientation(source, 6)
+	if rotated.Bounds().Dx() != 1 || rotated.Bounds().Dy() != 2 {
+		t.Fatalf("expected 1x2 image, got %v", rotated.Bounds())
+	}
+	if red, _, _, _ := rotated.At(0, 0).RGBA(); red == 0 {
+		t.Fatal("expected the left pixel to move to the top")
+	}
+	if _, _, blue, _ := rotated.At(0, 1).RGBA(); blue == 0 {
+		t.Fatal("expected the right pixel to move to the bottom")
+	}
+}
+
+func TestEncodeAdminMediaBlurHashForSolidColor(t *testing.T) {
+	source := image.NewRGBA(image.Rect(0, 0, 8, 6))
+	for index := 0; index < len(source.Pix); index += 4 {
+		source.Pix[index], source.Pix[index+1], source.Pix[index+2], source.Pix[index+3] = 255, 0, 0, 255
+	}
+
+	hash := encodeAdminMediaBlurHash(source)
+	// 1 size + 1 maximum + 4 DC + 11 AC components of 2 characters each.
+	if len(hash) != 28 {
+		t.Fatalf("expected 28 characters, got %d (%q)", len(hash), hash)
+	}
+	if !strings.HasPrefix(hash, "L") {
+		t.Fatalf("expected 4x3 component header, got %q", hash)
+	}
+	if hash[2:6] != encodeAdminMediaBase83(0xff0000, 4) {
+		t.Fatalf("expected red DC component, got %q", hash[2:6])
+	}
+	if encodeAdminMediaBlurHash(source) != hash {
+		t.Fatal("expected the BlurHash to be deterministic")
+	}
+	if color := computeAdminMediaDominantColor(source); color != "#ff0000" {
+		t.Fatalf("expected #ff0000, got %q", color)
+	}
+}
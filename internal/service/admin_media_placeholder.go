@@ -0,0 +1,134 @@
+package service
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"strings"
+
+	xdraw "golang.org/x/image/draw"
+)
+
+const (
+	adminMediaPlaceholderMaxSide = 32
+	adminMediaBlurHashAlphabet   = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz#$%*+,-.:;=?@[]^_{|}~"
+)
+
+// scaleAdminMediaPlaceholderSource shrinks the image to at most 32px per side over a white background. Both the
+// dominant color and the BlurHash only need a coarse view of the image.
+func scaleAdminMediaPlaceholderSource(source image.Image) *image.RGBA {
+	bounds := source.Bounds()
+	width, height := max(1, bounds.Dx()), max(1, bounds.Dy())
+	if longest := max(width, height); longest > adminMediaPlaceholderMaxSide {
+		width = max(1, width*adminMediaPlaceholderMaxSide/longest)
+		height = max(1, height*adminMediaPlaceholderMaxSide/longest)
+	}
+
+	destinationBounds := image.Rect(0, 0, width, height)
+	destination := image.NewRGBA(destinationBounds)
+	xdraw.Draw(destination, destinationBounds, image.NewUniform(color.White), image.Point{}, xdraw.Src)
+	xdraw.ApproxBiLinear.Scale(destination, destinationBounds, source, bounds, xdraw.Over, nil)
+	return destination
+}
+
+// encodeAdminMediaBlurHash encodes the image with the BlurHash algorithm (https://blurha.sh) using four
+// components along the longer side and three along the shorter one.
+func encodeAdminMediaBlurHash(source *image.RGBA) string {
+	bounds := source.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= 0 || height <= 0 {
+		return ""
+	}
+
+	componentsX, componentsY := 4, 3
+	if height > width {
+		componentsX, componentsY = 3, 4
+	}
+
+	factors := make([][3]float64, 0, componentsX*componentsY)
+	for j := range componentsY {
+		for i := range componentsX {
+			normalisation := 2.0
+			if i == 0 && j == 0 {
+				normalisation = 1
+			}
+
+			var red, green, blue float64
+			for y := range height {
+				basisY := math.Cos(math.Pi * float64(j) * float64(y) / float64(height))
+				for x := range width {
+					basis := normalisation * math.Cos(math.Pi*float64(i)*float64(x)/float64(width)) * basisY
+					pixel := source.RGBAAt(bounds.Min.X+x, bounds.Min.Y+y)
+					red += basis * adminMediaSRGBToLinear(pixel.R)
+					green += basis * adminMediaSRGBToLinear(pixel.G)
+					blue += basis * adminMediaSRGBToLinear(pixel.B)
+				}
+			}
+
+			scale := 1 / float64(width*height)
+			factors = append(factors, [3]float64{red * scale, green * scale, blue * scale})
+		}
+	}
+
+	var hash strings.Builder
+	hash.WriteString(encodeAdminMediaBase83((componentsX-1)+(componentsY-1)*9, 1))
+
+	maximumValue := 1.0
+	if len(factors) > 1 {
+		actualMaximum := 0.0
+		for _, factor := range factors[1:] {
+			for _, component := range factor {
+				actualMaximum = math.Max(actualMaximum, math.Abs(component))
+			}
+		}
+		quantisedMaximum := int(math.Max(0, math.Min(82, math.Floor(actualMaximum*166-0.5))))
+		maximumValue = float64(quantisedMaximum+1) / 166
+		hash.WriteString(encodeAdminMediaBase83(quantisedMaximum, 1))
+	} else {
+		hash.WriteString(encodeAdminMediaBase83(0, 1))
+	}
+
+	dc := factors[0]
+	hash.WriteString(encodeAdminMediaBase83(
+		adminMediaLinearToSRGB(dc[0])<<16+adminMediaLinearToSRGB(dc[1])<<8+adminMediaLinearToSRGB(dc[2]),
+		4,
+	))
+	for _, factor := range factors[1:] {
+		quantised := [3]int{}
+		for index, component := range factor {
+			quantised[index] = int(math.Max(0, math.Min(18, math.Floor(adminMediaSignPow(component/maximumValue, 0.5)*9+9.5))))
+		}
+		hash.WriteString(encodeAdminMediaBase83(quantised[0]*19*19+quantised[1]*19+quantised[2], 2))
+	}
+
+	return hash.String()
+}
+
+func encodeAdminMediaBase83(value, length int) string {
+	encoded := make([]byte, length)
+	for index := range length {
+		divisor := int(math.Pow(83, float64(length-index-1)))
+		encoded[index] = adminMediaBlurHashAlphabet[(value/divisor)%83]
+	}
+	return string(encoded)
+}
+
+func adminMediaSRGBToLinear(value uint8) float64 {
+	normalized := float64(value) / 255
+	if normalized <= 0.04045 {
+		return normalized / 12.92
+	}
+	return math.Pow((normalized+0.055)/1.055, 2.4)
+}
+
+func adminMediaLinearToSRGB(value float64) int {
+	clamped := math.Max(0, math.Min(1, value))
+	if clamped <= 0.0031308 {
+		return int(clamped*12.92*255 + 0.5)
+	}
+	return int((1.055*math.Pow(clamped, 1/2.4)-0.055)*255 + 0.5)
+}
+
+func adminMediaSignPow(value, exponent float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(value), exponent), value)
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/repository"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpapi"
+)
+
+const adminMediaStorageMigrationBatchSize = 50
+
+var resolveAdminMediaStorageConfig = appconfig.ResolveMediaStorageConfig
+
+// Open streams the asset bytes from whichever backend holds them.
+func (asset *AdminMediaAsset) Open(ctx context.Context) (io.ReadCloser, error) {
+	if asset.open != nil {
+		return asset.open(ctx)
+	}
+	return io.NopCloser(bytes.NewReader(asset.Data)), nil
+}
+
+// storeAdminMediaAssetBlob moves record.Data into the configured blob store and records where it went.
+// Inline storage leaves the bytes on the record so they are persisted with the asset document.
+func (s *Service) storeAdminMediaAssetBlob(ctx context.Context, record *domain.AdminMediaAssetRecord) error {
+	storageConfig := resolveAdminMediaStorageConfig()
+	store, err := s.mediaBlobStores.ForBackend(storageConfig.Backend, storageConfig)
+	if err != nil {
+		return toAdminMediaLibraryError(err, "failed to open media storage")
+	}
+	if store == nil {
+		record.Storage = appconfig.MediaStorageInline
+		record.StorageKey = ""
+		return nil
+	}
+
+	key := buildAdminMediaBlobKey(record.ID, record.Digest)
+	if err := store.Put(ctx, key, record.ContentType, bytes.NewReader(record.Data), int64(len(record.Data))); err != nil {
+		return toAdminMediaLibraryError(err, "failed to store media asset blob")
+	}
+
+	record.Storage = store.Backend()
+	record.StorageKey = key
+	record.Data = nil
+	return nil
+}
+
+func (s *Service) openAdminMediaAssetBlob(ctx context.Context, record domain.AdminMediaAssetRecord) (io.ReadCloser, error) {
+	if isAdminMediaAssetInline(record) {
+		if len(record.Data) == 0 {
+			return nil, apperrors.NotFound("media asset not found")
+		}
+		return io.NopCloser(bytes.NewReader(record.Data)), nil
+	}
+
+	store, err := s.mediaBlobStores.ForBackend(record.Storage, resolveAdminMediaStorageConfig())
+	if err != nil {
+		return nil, toAdminMediaLibraryError(err, "failed to open media storage")
+	}
+	if store == nil {
+		return nil, apperrors.NotFound("media asset not found")
+	}
+
+	body, err := store.Open(ctx, record.StorageKey)
+	if errors.Is(err, repository.ErrMediaBlobNotFound) {
+		return nil, apperrors.NotFound("media asset not found")
+	}
+	if err != nil {
+		return nil, toAdminMediaLibraryError(err, "failed to load media asset blob")
+	}
+	return body, nil
+}
+
+func (s *Service) readAdminMediaAssetData(ctx context.Context, record domain.AdminMediaAssetRecord) ([]byte, error) {
+	body, err := s.openAdminMediaAssetBlob(ctx, record)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = body.Close()
+	}()
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, toAdminMediaLibraryError(err, "failed to load media asset blob")
+	}
+	return data, nil
+}
+
+// deleteAdminMediaAssetBlob removes an external blob once no asset document points at it anymore.
+// Failures are only logged: an orphaned blob is harmless, a missing one is not.
+func (s *Service) deleteAdminMediaAssetBlob(ctx context.Context, storage, key string) {
+	if strings.TrimSpace(key) == "" || isAdminMediaAssetInline(domain.AdminMediaAssetRecord{Storage: storage}) {
+		return
+	}
+
+	store, err := s.mediaBlobStores.ForBackend(storage, resolveAdminMediaStorageConfig())
+	if err == nil && store != nil {
+		err = store.Delete(ctx, key)
+	}
+	if err != nil {
+		httpapi.LogError(
+			ctx,
+			"admin media blob cleanup failed",
+			err,
+			slog.String("storage", storage),
+			slog.String("key", key),
+		)
+	}
+}
+
+// MigrateAdminMediaStorage copies every asset blob into targetBackend and repoints the asset documents.
+// Blobs left behind in the previous backend are removed after the document update succeeds.
+func (s *Service) MigrateAdminMediaStorage(
+	ctx context.Context,
+	targetBackend string,
+	dryRun bool,
+) (*domain.AdminMediaStorageMigrationResult, error) {
+	resolvedTarget := appconfig.NormalizeMediaStorageBackend(targetBackend)
+	if resolvedTarget == "" {
+		return nil, apperrors.BadRequest("media storage backend must be inline, gridfs, filesystem, or s3")
+	}
+
+	storageConfig := resolveAdminMediaStorageConfig()
+	targetStore, err := s.mediaBlobStores.ForBackend(resolvedTarget, storageConfig)
+	if err != nil {
+		return nil, toAdminMediaLibraryError(err, "failed to open media storage")
+	}
+
+	result := &domain.AdminMediaStorageMigrationResult{TargetBackend: resolvedTarget, DryRun: dryRun}
+	seen := map[string]struct{}{}
+	for {
+		records, err := s.adminMediaAssets.ListMediaAssetsOutsideStorage(
+			ctx,
+			resolvedTarget,
+			adminMediaStorageMigrationBatchSize+len(seen),
+		)
+		if err != nil {
+			return nil, toAdminMediaLibraryError(err, "failed to list media assets")
+		}
+
+		progressed := false
+		for _, record := range records {
+			if _, skip := seen[record.ID]; skip {
+				continue
+			}
+			progressed = true
+			result.Scanned++
+			if dryRun {
+				seen[record.ID] = struct{}{}
+				continue
+			}
+
+			migrated, err := s.migrateAdminMediaAsset(ctx, record, targetStore, resolvedTarget)
+			switch {
+			case err != nil:
+				httpapi.LogError(ctx, "admin media storage migration failed", err, slog.String("assetId", record.ID))
+				seen[record.ID] = struct{}{}
+				result.Failed++
+			case migrated:
+				result.Migrated++
+			default:
+				// The asset changed while it was being copied; the next batch picks it up again if needed.
+				result.Skipped++
+			}
+		}
+		if !progressed {
+			return result, nil
+		}
+	}
+}
+
+func (s *Service) migrateAdminMediaAsset(
+	ctx context.Context,
+	record domain.AdminMediaAssetRecord,
+	targetStore repository.MediaBlobStore,
+	targetBackend string,
+) (bool, error) {
+	data, err := s.readAdminMediaAssetData(ctx, record)
+	if err != nil {
+		return false, err
+	}
+
+	storageKey := ""
+	inlineData := data
+	if targetStore != nil {
+		storageKey = buildAdminMediaBlobKey(record.ID, record.Digest)
+		if err := targetStore.Put(ctx, storageKey, record.ContentType, bytes.NewReader(data), int64(len(data))); err != nil {
+			return false, err
+		}
+		inlineData = nil
+	}
+
+	updated, err := s.adminMediaAssets.UpdateMediaAssetStorage(ctx, record, targetBackend, storageKey, inlineData)
+	if err != nil || !updated {
+		if targetStore != nil {
+			s.deleteAdminMediaAssetBlob(ctx, targetBackend, storageKey)
+		}
+		return false, err
+	}
+
+	s.deleteAdminMediaAssetBlob(ctx, record.Storage, record.StorageKey)
+	return true, nil
+}
+
+func isAdminMediaAssetInline(record domain.AdminMediaAssetRecord) bool {
+	backend := appconfig.NormalizeMediaStorageBackend(record.Storage)
+	return backend == "" || backend == appconfig.MediaStorageInline
+}
+
+func buildAdminMediaBlobKey(id, digest string) string {
+	return strings.ToLower(strings.TrimSpace(id)) + "-" + strings.ToLower(strings.TrimSpace(digest))
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+)
+
+const adminMediaStorageTestPNG = "iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAIAAACQd1PeAAAADElEQVR42mP4z8AAAAMBAQD3A0FDAAAAAElFTkSuQmCC"
+
+func useAdminMediaFilesystemStorage(t *testing.T, backend string) string {
+	t.Helper()
+	originalResolver := resolveAdminMediaStorageConfig
+	t.Cleanup(func() {
+		resolveAdminMediaStorageConfig = originalResolver
+	})
+
+	dir := t.TempDir()
+	resolveAdminMediaStorageConfig = func() appconfig.MediaStorageConfig {
+		return appconfig.MediaStorageConfig{
+			Backend:       backend,
+			MaxAssetBytes: appconfig.DefaultExternalMediaMaxBytes,
+			FilesystemDir: dir,
+		}
+	}
+	return dir
+}
+
+func TestUploadAdminMediaAssetStoresBlobOutsideDocument(t *testing.T) {
+	originalRepository := adminMediaAssetRepository
+	t.Cleanup(func() {
+		adminMediaAssetRepository = originalRepository
+	})
+	dir := useAdminMediaFilesystemStorage(t, appconfig.MediaStorageFilesystem)
+
+	var stored domain.AdminMediaAssetRecord
+	adminMediaAssetRepository = adminMediaAssetStubRepository{
+		createMediaAsset: func(_ context.Context, record domain.AdminMediaAssetRecord) (*domain.AdminMediaAssetRecord, error) {
+			stored = record
+			return &record, nil
+		},
+		findMediaAssetByID: func(context.Context, string) (*domain.AdminMediaAssetRecord, error) {
+			return &stored, nil
+		},
+	}
+
+	if _, err := testService().UploadAdminMediaAsset(context.Background(), &domain.AdminUser{ID: "admin-1"}, domain.AdminMediaUploadInput{
+		FileName: "pixel.png",
+		DataURL:  "data:image/png;base64," + adminMediaStorageTestPNG,
+	}); err != nil {
+		t.Fatalf("upload failed: %v", err)
+	}
+	if stored.Storage != appconfig.MediaStorageFilesystem || stored.StorageKey == "" || len(stored.Data) != 0 {
+		t.Fatalf("expected blob to leave the document, got storage=%q key=%q data=%d", stored.Storage, stored.StorageKey, len(stored.Data))
+	}
+	if _, err := os.Stat(filepath.Join(dir, stored.StorageKey)); err != nil {
+		t.Fatalf("expected blob file to exist: %v", err)
+	}
+
+	asset, err := testService().ResolveAdminMediaAsset(context.Background(), stored.ID)
+	if err != nil {
+		t.Fatalf("resolve failed: %v", err)
+	}
+	body, err := asset.Open(context.Background())
+	if err != nil {
+		t.Fatalf("open failed: %v", err)
+	}
+	defer func() {
+		_ = body.Close()
+	}()
+	data, _ := io.ReadAll(body)
+	expected, _ := base64.StdEncoding.DecodeString(adminMediaStorageTestPNG)
+	if !bytes.Equal(data, expected) || asset.SizeBytes != len(expected) {
+		t.Fatalf("unexpected streamed blob (%d bytes, size %d)", len(data), asset.SizeBytes)
+	}
+}
+
+func TestMigrateAdminMediaStorageMovesInlineAssets(t *testing.T) {
+	originalRepository := adminMediaAssetRepository
+	t.Cleanup(func() {
+		adminMediaAssetRepository = originalRepository
+	})
+	dir := useAdminMediaFilesystemStorage(t, appconfig.MediaStorageInline)
+
+	records := map[string]domain.AdminMediaAssetRecord{
+		"asset-1": {ID: "asset-1", Digest: "aaa", ContentType: "image/png", Data: []byte("first")},
+		"asset-2": {ID: "asset-2", Digest: "bbb", ContentType: "image/png", Storage: appconfig.MediaStorageInline},
+	}
+	adminMediaAssetRepository = adminMediaAssetStubRepository{
+		listMediaAssetsOutside: func(_ context.Context, backend string, _ int) ([]domain.AdminMediaAssetRecord, error) {
+			items := make([]domain.AdminMediaAssetRecord, 0)
+			for _, id := range []string{"asset-1", "asset-2"} {
+				if record := records[id]; resolveAdminMediaTestBackend(record) != backend {
+					items = append(items, record)
+				}
+			}
+			return items, nil
+		},
+		updateMediaAssetStorage: func(
+			_ context.Context,
+			record domain.AdminMediaAssetRecord,
+			storage string,
+			storageKey string,
+			data []byte,
+		) (bool, error) {
+			updated := records[record.ID]
+			updated.Storage = storage
+			updated.StorageKey = storageKey
+			updated.Data = data
+			records[record.ID] = updated
+			return true, nil
+		},
+	}
+
+	preview, err := testService().MigrateAdminMediaStorage(context.Background(), "filesystem", true)
+	if err != nil || preview.Scanned != 2 || preview.Migrated != 0 {
+		t.Fatalf("dry run = %#v, err=%v", preview, err)
+	}
+
+	result, err := testService().MigrateAdminMediaStorage(context.Background(), "filesystem", false)
+	if err != nil || result.Migrated != 1 || result.Failed != 1 {
+		t.Fatalf("migration = %#v, err=%v", result, err)
+	}
+	migrated := records["asset-1"]
+	if migrated.Storage != appconfig.MediaStorageFilesystem || len(migrated.Data) != 0 {
+		t.Fatalf("unexpected migrated record: %#v", migrated)
+	}
+	if data, err := os.ReadFile(filepath.Join(dir, migrated.StorageKey)); err != nil || string(data) != "first" {
+		t.Fatalf("unexpected migrated blob %q, err=%v", data, err)
+	}
+	if records["asset-2"].Storage != appconfig.MediaStorageInline {
+		t.Fatal("expected asset without bytes to stay in place")
+	}
+
+	back, err := testService().MigrateAdminMediaStorage(context.Background(), "inline", false)
+	if err != nil || back.Migrated != 1 || string(records["asset-1"].Data) != "first" || records["asset-1"].StorageKey != "" {
+		t.Fatalf("migration back = %#v, record=%#v, err=%v", back, records["asset-1"], err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, migrated.StorageKey)); !os.IsNotExist(err) {
+		t.Fatalf("expected source blob to be removed, got %v", err)
+	}
+
+	if _, err := testService().MigrateAdminMediaStorage(context.Background(), "dropbox", false); err == nil {
+		t.Fatal("expected unknown backend to fail")
+	}
+}
+
+func resolveAdminMediaTestBackend(record domain.AdminMediaAssetRecord) string {
+	if isAdminMediaAssetInline(record) {
+		return appconfig.MediaStorageInline
+	}
+	return record.Storage
+}
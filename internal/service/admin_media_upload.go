@@ -0,0 +1,234 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"regexp"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpapi"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// AdminMediaUploadChunkSize is the largest chunk accepted by a resumable upload session. It stays well under
+	// the MongoDB document limit and the request body limits of serverless hosts.
+	AdminMediaUploadChunkSize  = 4 << 20
+	adminMediaUploadSessionTTL = 24 * time.Hour
+)
+
+var (
+	adminMediaUploadChecksumPattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+)
+
+func (s *Service) CreateAdminMediaUploadSession(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	input domain.AdminMediaUploadSessionInput,
+) (*domain.AdminMediaUploadSession, error) {
+	if err := RequireAdminPermission(adminUser, AdminPermissionMediaWrite); err != nil {
+		return nil, err
+	}
+
+	if input.SizeBytes <= 0 {
+		return nil, apperrors.BadRequest("media upload size is required")
+	}
+	if input.SizeBytes > resolveAdminMediaStorageConfig().MaxAssetBytes {
+		return nil, apperrors.BadRequest("media asset image is too large")
+	}
+	checksum, ok := normalizeAdminMediaUploadChecksum(input.Checksum)
+	if !ok {
+		return nil, apperrors.BadRequest("media upload checksum must be a sha256 hex digest")
+	}
+
+	now := time.Now().UTC()
+	session, err := s.adminMediaUploads.CreateMediaUploadSession(ctx, domain.AdminMediaUploadSession{
+		ID:        primitive.NewObjectID().Hex(),
+		FileName:  normalizeAdminMediaAssetName(input.FileName),
+		SizeBytes: input.SizeBytes,
+		Checksum:  checksum,
+		ChunkSize: AdminMediaUploadChunkSize,
+		CreatedBy: strings.TrimSpace(adminUser.ID),
+		CreatedAt: now,
+		ExpiresAt: now.Add(adminMediaUploadSessionTTL),
+	})
+	if err != nil {
+		return nil, toAdminMediaLibraryError(err, "failed to create media upload session")
+	}
+	return session, nil
+}
+
+func (s *Service) GetAdminMediaUploadSession(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	id string,
+) (*domain.AdminMediaUploadSession, error) {
+	if err := RequireAdminPermission(adminUser, AdminPermissionMediaWrite); err != nil {
+		return nil, err
+	}
+	return s.loadAdminMediaUploadSession(ctx, adminUser, id)
+}
+
+// AppendAdminMediaUploadChunk writes the next chunk of a session. Offsets must be sent in order so a client that
+// lost a response can ask for the session status and resume from UploadedBytes.
+func (s *Service) AppendAdminMediaUploadChunk(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	id string,
+	offset int,
+	checksum string,
+	data []byte,
+) (*domain.AdminMediaUploadSession, error) {
+	if err := RequireAdminPermission(adminUser, AdminPermissionMediaWrite); err != nil {
+		return nil, err
+	}
+
+	session, err := s.loadAdminMediaUploadSession(ctx, adminUser, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) == 0 {
+		return nil, apperrors.BadRequest("media upload chunk is empty")
+	}
+	if len(data) > session.ChunkSize {
+		return nil, apperrors.BadRequest("media upload chunk is too large")
+	}
+	if offset != session.UploadedBytes {
+		return nil, newAdminMediaUploadOffsetConflict()
+	}
+	if offset+len(data) > session.SizeBytes {
+		return nil, apperrors.BadRequest("media upload chunk exceeds the declared size")
+	}
+	expectedChecksum, ok := normalizeAdminMediaUploadChecksum(checksum)
+	if !ok {
+		return nil, apperrors.BadRequest("media upload chunk checksum must be a sha256 hex digest")
+	}
+	if hashAdminMediaUploadPayload(data) != expectedChecksum {
+		return nil, apperrors.BadRequest("media upload chunk checksum does not match")
+	}
+
+	updated, err := s.adminMediaUploads.AppendMediaUploadChunk(ctx, *session, offset, data)
+	if err != nil {
+		return nil, toAdminMediaLibraryError(err, "failed to store media upload chunk")
+	}
+	if updated == nil {
+		return nil, newAdminMediaUploadOffsetConflict()
+	}
+	return updated, nil
+}
+
+// CompleteAdminMediaUploadSession assembles the uploaded chunks, verifies the whole-file checksum and stores the
+// result through the same digest-deduplicated path as single-request uploads.
+func (s *Service) CompleteAdminMediaUploadSession(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	id string,
+) (*domain.AdminMediaLibraryItem, error) {
+	if err := RequireAdminPermission(adminUser, AdminPermissionMediaWrite); err != nil {
+		return nil, err
+	}
+
+	session, err := s.loadAdminMediaUploadSession(ctx, adminUser, id)
+	if err != nil {
+		return nil, err
+	}
+	if session.UploadedBytes != session.SizeBytes {
+		return nil, apperrors.New("CONFLICT", "media upload is incomplete", 409, nil)
+	}
+
+	chunks, err := s.adminMediaUploads.ListMediaUploadChunks(ctx, session.ID)
+	if err != nil {
+		return nil, toAdminMediaLibraryError(err, "failed to load media upload chunks")
+	}
+
+	var buffer bytes.Buffer
+	buffer.Grow(session.SizeBytes)
+	for _, chunk := range chunks {
+		if chunk.Offset != buffer.Len() {
+			return nil, apperrors.New("CONFLICT", "media upload chunks are not contiguous", 409, nil)
+		}
+		buffer.Write(chunk.Data)
+	}
+	data := buffer.Bytes()
+	if len(data) != session.SizeBytes || hashAdminMediaUploadPayload(data) != session.Checksum {
+		return nil, apperrors.BadRequest("media upload checksum does not match")
+	}
+
+	payload, err := inspectAdminMediaPayload(data)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.createAdminMediaAssetFromPayload(ctx, adminUser, session.FileName, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.adminMediaUploads.DeleteMediaUploadSession(ctx, session.ID); err != nil {
+		// The TTL index removes the leftovers; the asset itself is already stored.
+		httpapi.LogError(ctx, "admin media upload session cleanup failed", err, slog.String("sessionId", session.ID))
+	}
+	return item, nil
+}
+
+func (s *Service) AbortAdminMediaUploadSession(ctx context.Context, adminUser *domain.AdminUser, id string) error {
+	if err := RequireAdminPermission(adminUser, AdminPermissionMediaWrite); err != nil {
+		return err
+	}
+
+	session, err := s.loadAdminMediaUploadSession(ctx, adminUser, id)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.adminMediaUploads.DeleteMediaUploadSession(ctx, session.ID); err != nil {
+		return toAdminMediaLibraryError(err, "failed to delete media upload session")
+	}
+	return nil
+}
+
+// loadAdminMediaUploadSession hides sessions owned by other admins behind the same not-found error.
+func (s *Service) loadAdminMediaUploadSession(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	id string,
+) (*domain.AdminMediaUploadSession, error) {
+	resolvedID := strings.TrimSpace(id)
+	if resolvedID == "" {
+		return nil, apperrors.BadRequest("media upload id is required")
+	}
+
+	session, err := s.adminMediaUploads.FindMediaUploadSession(ctx, resolvedID)
+	if err != nil {
+		return nil, toAdminMediaLibraryError(err, "failed to load media upload session")
+	}
+	if session == nil || session.CreatedBy != strings.TrimSpace(adminUser.ID) {
+		return nil, apperrors.New("NOT_FOUND", "media upload session not found", 404, nil)
+	}
+	return session, nil
+}
+
+func newAdminMediaUploadOffsetConflict() error {
+	return apperrors.New("CONFLICT", "media upload offset does not match the session", 409, nil)
+}
+
+func normalizeAdminMediaUploadChecksum(value string) (string, bool) {
+	resolved := strings.ToLower(strings.TrimSpace(value))
+	resolved = strings.TrimPrefix(resolved, "sha256:")
+	if !adminMediaUploadChecksumPattern.MatchString(resolved) {
+		return "", false
+	}
+	return resolved, true
+}
+
+func hashAdminMediaUploadPayload(payload []byte) string {
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:])
+}
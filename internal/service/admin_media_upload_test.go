@@ -0,0 +1,324 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+)
+
+type adminMediaUploadSessionStubRepository struct {
+	sessions map[string]*domain.AdminMediaUploadSession
+	chunks   map[string][]domain.AdminMediaUploadChunk
+}
+
+func newAdminMediaUploadSessionStubRepository() *adminMediaUploadSessionStubRepository {
+	return &adminMediaUploadSessionStubRepository{
+		sessions: map[string]*domain.AdminMediaUploadSession{},
+		chunks:   map[string][]domain.AdminMediaUploadChunk{},
+	}
+}
+
+func (stub *adminMediaUploadSessionStubRepository) CreateMediaUploadSession(
+	_ context.Context,
+	session domain.AdminMediaUploadSession,
+) (*domain.AdminMediaUploadSession, error) {
+	created := session
+	stub.sessions[session.ID] = &created
+	copySession := created
+	return &copySession, nil
+}
+
+func (stub *adminMediaUploadSessionStubRepository) FindMediaUploadSession(
+	_ context.Context,
+	id string,
+) (*domain.AdminMediaUploadSession, error) {
+	session, ok := stub.sessions[id]
+	if !ok {
+		return nil, nil
+	}
+	copySession := *session
+	return &copySession, nil
+}
+
+func (stub *adminMediaUploadSessionStubRepository) AppendMediaUploadChunk(
+	_ context.Context,
+	session domain.AdminMediaUploadSession,
+	offset int,
+	data []byte,
+) (*domain.AdminMediaUploadSession, error) {
+	stored, ok := stub.sessions[session.ID]
+	if !ok || stored.UploadedBytes != offset {
+		return nil, nil
+	}
+	stub.chunks[session.ID] = append(stub.chunks[session.ID], domain.AdminMediaUploadChunk{
+		Offset: offset,
+		Data:   append([]byte(nil), data...),
+	})
+	stored.UploadedBytes += len(data)
+	copySession := *stored
+	return &copySession, nil
+}
+
+func (stub *adminMediaUploadSessionStubRepository) ListMediaUploadChunks(
+	_ context.Context,
+	id string,
+) ([]domain.AdminMediaUploadChunk, error) {
+	return stub.chunks[id], nil
+}
+
+func (stub *adminMediaUploadSessionStubRepository) DeleteMediaUploadSession(_ context.Context, id string) (bool, error) {
+	_, ok := stub.sessions[id]
+	delete(stub.sessions, id)
+	delete(stub.chunks, id)
+	return ok, nil
+}
+
+func useAdminMediaUploadSessionStub(t *testing.T) *adminMediaUploadSessionStubRepository {
+	t.Helper()
+	originalRepository := adminMediaUploadSessionRepository
+	t.Cleanup(func() {
+		adminMediaUploadSessionRepository = originalRepository
+	})
+
+	stub := newAdminMediaUploadSessionStubRepository()
+	adminMediaUploadSessionRepository = stub
+	return stub
+}
+
+func TestAdminMediaUploadSessionAssemblesChunksIntoAsset(t *testing.T) {
+	sessions := useAdminMediaUploadSessionStub(t)
+	originalRepository := adminMediaAssetRepository
+	t.Cleanup(func() {
+		adminMediaAssetRepository = originalRepository
+	})
+
+	var created domain.AdminMediaAssetRecord
+	adminMediaAssetRepository = adminMediaAssetStubRepository{
+		createMediaAsset: func(_ context.Context, record domain.AdminMediaAssetRecord) (*domain.AdminMediaAssetRecord, error) {
+			created = record
+			return &record, nil
+		},
+	}
+
+	adminUser := &domain.AdminUser{ID: "admin-1", Roles: []string{AdminRoleEditor}}
+	payload, _ := base64.StdEncoding.DecodeString(adminMediaStorageTestPNG)
+	session, err := testService().CreateAdminMediaUploadSession(context.Background(), adminUser, domain.AdminMediaUploadSessionInput{
+		FileName:  "pixel.png",
+		SizeBytes: len(payload),
+		Checksum:  "sha256:" + hashAdminMediaUploadPayload(payload),
+	})
+	if err != nil {
+		t.Fatalf("create session failed: %v", err)
+	}
+	if session.ChunkSize != AdminMediaUploadChunkSize || session.ExpiresAt.IsZero() {
+		t.Fatalf("unexpected session %+v", session)
+	}
+
+	split := len(payload) / 2
+	for _, part := range [][]byte{payload[:split], payload[split:]} {
+		offset := session.UploadedBytes
+		session, err = testService().AppendAdminMediaUploadChunk(
+			context.Background(),
+			adminUser,
+			session.ID,
+			offset,
+			hashAdminMediaUploadPayload(part),
+			part,
+		)
+		if err != nil {
+			t.Fatalf("append chunk at %d failed: %v", offset, err)
+		}
+	}
+
+	item, err := testService().CompleteAdminMediaUploadSession(context.Background(), adminUser, session.ID)
+	if err != nil {
+		t.Fatalf("complete failed: %v", err)
+	}
+	if item.Name != "pixel.png" || item.Width != 1 || item.Height != 1 {
+		t.Fatalf("unexpected item %+v", item)
+	}
+	if !bytes.Equal(created.Data, payload) || created.ContentType != "image/png" {
+		t.Fatalf("unexpected stored asset %q (%d bytes)", created.ContentType, len(created.Data))
+	}
+	if len(sessions.sessions) != 0 {
+		t.Fatalf("expected session to be removed after completion")
+	}
+}
+
+func TestAdminMediaUploadSessionReusesAssetWithSameDigest(t *testing.T) {
+	useAdminMediaUploadSessionStub(t)
+	originalRepository := adminMediaAssetRepository
+	t.Cleanup(func() {
+		adminMediaAssetRepository = originalRepository
+	})
+
+	payload, _ := base64.StdEncoding.DecodeString(adminMediaStorageTestPNG)
+	adminMediaAssetRepository = adminMediaAssetStubRepository{
+		findMediaAssetByDigest: func(_ context.Context, digest string) (*domain.AdminMediaAssetRecord, error) {
+			if digest != hashAdminMediaPayload(payload) {
+				t.Fatalf("unexpected digest %q", digest)
+			}
+			return &domain.AdminMediaAssetRecord{ID: "asset-existing", Name: "existing.png", ContentType: "image/png"}, nil
+		},
+		createMediaAsset: func(context.Context, domain.AdminMediaAssetRecord) (*domain.AdminMediaAssetRecord, error) {
+			t.Fatal("expected existing asset to be reused")
+			return nil, nil
+		},
+	}
+
+	adminUser := &domain.AdminUser{ID: "admin-1", Roles: []string{AdminRoleEditor}}
+	session, err := testService().CreateAdminMediaUploadSession(context.Background(), adminUser, domain.AdminMediaUploadSessionInput{
+		FileName:  "copy.png",
+		SizeBytes: len(payload),
+		Checksum:  hashAdminMediaUploadPayload(payload),
+	})
+	if err != nil {
+		t.Fatalf("create session failed: %v", err)
+	}
+	if _, err := testService().AppendAdminMediaUploadChunk(
+		context.Background(),
+		adminUser,
+		session.ID,
+		0,
+		hashAdminMediaUploadPayload(payload),
+		payload,
+	); err != nil {
+		t.Fatalf("append chunk failed: %v", err)
+	}
+
+	item, err := testService().CompleteAdminMediaUploadSession(context.Background(), adminUser, session.ID)
+	if err != nil {
+		t.Fatalf("complete failed: %v", err)
+	}
+	if item.ID != "asset-existing" {
+		t.Fatalf("expected existing asset, got %q", item.ID)
+	}
+}
+
+func TestAppendAdminMediaUploadChunkRejectsInvalidChunks(t *testing.T) {
+	useAdminMediaUploadSessionStub(t)
+
+	adminUser := &domain.AdminUser{ID: "admin-1", Roles: []string{AdminRoleEditor}}
+	payload := []byte("0123456789")
+	session, err := testService().CreateAdminMediaUploadSession(context.Background(), adminUser, domain.AdminMediaUploadSessionInput{
+		FileName:  "file.png",
+		SizeBytes: len(payload),
+		Checksum:  hashAdminMediaUploadPayload(payload),
+	})
+	if err != nil {
+		t.Fatalf("create session failed: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		user     *domain.AdminUser
+		offset   int
+		checksum string
+		data     []byte
+		status   int
+	}{
+		{name: "offset gap", user: adminUser, offset: 4, checksum: hashAdminMediaUploadPayload(payload[4:]), data: payload[4:], status: 409},
+		{name: "checksum mismatch", user: adminUser, offset: 0, checksum: hashAdminMediaUploadPayload([]byte("x")), data: payload, status: 400},
+		{name: "exceeds declared size", user: adminUser, offset: 0, checksum: hashAdminMediaUploadPayload(append(payload, 'x')), data: append(payload, 'x'), status: 400},
+		{name: "other admin", user: &domain.AdminUser{ID: "admin-2", Roles: []string{AdminRoleEditor}}, offset: 0, checksum: hashAdminMediaUploadPayload(payload), data: payload, status: 404},
+		{name: "anonymous", user: nil, offset: 0, checksum: hashAdminMediaUploadPayload(payload), data: payload, status: 401},
+		{name: "moderator", user: &domain.AdminUser{ID: "admin-1", Roles: []string{AdminRoleModerator}}, offset: 0, checksum: hashAdminMediaUploadPayload(payload), data: payload, status: 403},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := testService().AppendAdminMediaUploadChunk(context.Background(), tc.user, session.ID, tc.offset, tc.checksum, tc.data)
+			if err == nil {
+				t.Fatal("expected chunk to be rejected")
+			}
+			if status := apperrors.From(err).HTTPStatus; status != tc.status {
+				t.Fatalf("expected status %d, got %d (%v)", tc.status, status, err)
+			}
+		})
+	}
+}
+
+func TestCompleteAdminMediaUploadSessionRejectsIncompleteOrCorruptUploads(t *testing.T) {
+	sessions := useAdminMediaUploadSessionStub(t)
+
+	adminUser := &domain.AdminUser{ID: "admin-1", Roles: []string{AdminRoleEditor}}
+	payload, _ := base64.StdEncoding.DecodeString(adminMediaStorageTestPNG)
+	session, err := testService().CreateAdminMediaUploadSession(context.Background(), adminUser, domain.AdminMediaUploadSessionInput{
+		FileName:  "pixel.png",
+		SizeBytes: len(payload),
+		Checksum:  hashAdminMediaUploadPayload(payload),
+	})
+	if err != nil {
+		t.Fatalf("create session failed: %v", err)
+	}
+
+	if _, err := testService().CompleteAdminMediaUploadSession(context.Background(), adminUser, session.ID); apperrors.From(err).HTTPStatus != 409 {
+		t.Fatalf("expected incomplete upload conflict, got %v", err)
+	}
+
+	// Simulate a chunk overwritten by a losing concurrent writer.
+	corrupted := append([]byte(nil), payload...)
+	corrupted[len(corrupted)-1] ^= 0xff
+	sessions.chunks[session.ID] = []domain.AdminMediaUploadChunk{{Offset: 0, Data: corrupted}}
+	sessions.sessions[session.ID].UploadedBytes = len(payload)
+
+	if _, err := testService().CompleteAdminMediaUploadSession(context.Background(), adminUser, session.ID); apperrors.From(err).HTTPStatus != 400 {
+		t.Fatalf("expected checksum failure, got %v", err)
+	}
+}
+
+func TestCreateAdminMediaUploadSessionValidatesInput(t *testing.T) {
+	useAdminMediaUploadSessionStub(t)
+
+	adminUser := &domain.AdminUser{ID: "admin-1", Roles: []string{AdminRoleEditor}}
+	checksum := hashAdminMediaUploadPayload([]byte("x"))
+	tests := []domain.AdminMediaUploadSessionInput{
+		{FileName: "a.png", SizeBytes: 0, Checksum: checksum},
+		{FileName: "a.png", SizeBytes: resolveAdminMediaStorageConfig().MaxAssetBytes + 1, Checksum: checksum},
+		{FileName: "a.png", SizeBytes: 10, Checksum: "not-a-digest"},
+	}
+	for _, input := range tests {
+		if _, err := testService().CreateAdminMediaUploadSession(context.Background(), adminUser, input); apperrors.From(err).HTTPStatus != 400 {
+			t.Fatalf("expected bad request for %+v, got %v", input, err)
+		}
+	}
+}
+
+func TestUploadAdminMediaAssetReadsMultipartFile(t *testing.T) {
+	originalRepository := adminMediaAssetRepository
+	t.Cleanup(func() {
+		adminMediaAssetRepository = originalRepository
+	})
+
+	var created domain.AdminMediaAssetRecord
+	adminMediaAssetRepository = adminMediaAssetStubRepository{
+		createMediaAsset: func(_ context.Context, record domain.AdminMediaAssetRecord) (*domain.AdminMediaAssetRecord, error) {
+			created = record
+			return &record, nil
+		},
+	}
+
+	payload, _ := base64.StdEncoding.DecodeString(adminMediaStorageTestPNG)
+	item, err := testService().UploadAdminMediaAsset(context.Background(), &domain.AdminUser{ID: "admin-1", Roles: []string{AdminRoleEditor}}, domain.AdminMediaUploadInput{
+		FileName: "pixel.png",
+		File:     bytes.NewReader(payload),
+	})
+	if err != nil {
+		t.Fatalf("upload failed: %v", err)
+	}
+	if item.Width != 1 || created.ContentType != "image/png" || !bytes.Equal(created.Data, payload) {
+		t.Fatalf("unexpected stored asset %+v", created)
+	}
+
+	_, err = testService().UploadAdminMediaAsset(context.Background(), &domain.AdminUser{ID: "admin-1", Roles: []string{AdminRoleEditor}}, domain.AdminMediaUploadInput{
+		FileName: "note.txt",
+		File:     bytes.NewReader([]byte("not an image")),
+	})
+	if apperrors.From(err).HTTPStatus != 400 {
+		t.Fatalf("expected non-image upload to be rejected, got %v", err)
+	}
+}
@@ -27,8 +27,6 @@ const (
 	adminNewsletterDispatchTimeout = 45 * time.Second
 )
 
-var adminNewsletterRepository repository.AdminNewsletterRepository = repository.NewAdminNewsletterRepository()
-
 var adminNewsletterDispatchClient = &http.Client{
 	Timeout: adminNewsletterDispatchTimeout,
 }
@@ -51,7 +49,7 @@ type adminNewsletterDispatchResponsePayload struct {
 	Locales   map[string]adminNewsletterDispatchLocalePayload `json:"locales"`
 }
 
-func ListAdminNewsletterSubscribers(
+func (s *Service) ListAdminNewsletterSubscribers(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	filter domain.AdminNewsletterSubscriberFilter,
@@ -71,7 +69,7 @@ func ListAdminNewsletterSubscribers(
 		return nil, err
 	}
 
-	result, err := adminNewsletterRepository.ListSubscribers(
+	result, err := s.adminNewsletter.ListSubscribers(
 		ctx,
 		domain.AdminNewsletterSubscriberFilter{
 			Locale: locale,
@@ -100,7 +98,7 @@ func ListAdminNewsletterSubscribers(
 	return result, nil
 }
 
-func UpdateAdminNewsletterSubscriberStatus(
+func (s *Service) UpdateAdminNewsletterSubscriberStatus(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	email string,
@@ -120,7 +118,7 @@ func UpdateAdminNewsletterSubscriberStatus(
 		return nil, err
 	}
 
-	updated, err := adminNewsletterRepository.UpdateSubscriberStatusByEmail(
+	updated, err := s.adminNewsletter.UpdateSubscriberStatusByEmail(
 		ctx,
 		resolvedEmail,
 		resolvedStatus,
@@ -133,7 +131,7 @@ func UpdateAdminNewsletterSubscriberStatus(
 	return updated, nil
 }
 
-func DeleteAdminNewsletterSubscriber(ctx context.Context, adminUser *domain.AdminUser, email string) error {
+func (s *Service) DeleteAdminNewsletterSubscriber(ctx context.Context, adminUser *domain.AdminUser, email string) error {
 	if adminUser == nil || strings.TrimSpace(adminUser.ID) == "" {
 		return apperrors.Unauthorized(adminNewsletterAuthRequired)
 	}
@@ -143,7 +141,7 @@ func DeleteAdminNewsletterSubscriber(ctx context.Context, adminUser *domain.Admi
 		return apperrors.BadRequest("invalid subscriber email")
 	}
 
-	deleted, err := adminNewsletterRepository.DeleteSubscriberByEmail(ctx, resolvedEmail)
+	deleted, err := s.adminNewsletter.DeleteSubscriberByEmail(ctx, resolvedEmail)
 	if err != nil {
 		return toAdminNewsletterError(err, "failed to delete newsletter subscriber")
 	}
@@ -154,7 +152,7 @@ func DeleteAdminNewsletterSubscriber(ctx context.Context, adminUser *domain.Admi
 	return nil
 }
 
-func ListAdminNewsletterCampaigns(
+func (s *Service) ListAdminNewsletterCampaigns(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	filter domain.AdminNewsletterCampaignFilter,
@@ -174,7 +172,7 @@ func ListAdminNewsletterCampaigns(
 		return nil, err
 	}
 
-	result, err := adminNewsletterRepository.ListCampaigns(
+	result, err := s.adminNewsletter.ListCampaigns(
 		ctx,
 		domain.AdminNewsletterCampaignFilter{
 			Locale: locale,
@@ -203,7 +201,7 @@ func ListAdminNewsletterCampaigns(
 	return result, nil
 }
 
-func ListAdminNewsletterDeliveryFailures(
+func (s *Service) ListAdminNewsletterDeliveryFailures(
 	ctx context.Context,
 	adminUser *domain.AdminUser,
 	filter domain.AdminNewsletterDeliveryFailureFilter,
@@ -224,7 +222,7 @@ func ListAdminNewsletterDeliveryFailures(
 		return nil, apperrors.BadRequest("newsletter campaign item key is required")
 	}
 
-	result, err := adminNewsletterRepository.ListDeliveryFailures(
+	result, err := s.adminNewsletter.ListDeliveryFailures(
 		ctx,
 		domain.AdminNewsletterDeliveryFailureFilter{
 			Locale:  locale,
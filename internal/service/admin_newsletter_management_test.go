@@ -173,7 +173,7 @@ func TestListAdminNewsletterSubscribersFiltersByLocaleAndStatus(t *testing.T) {
 
 	page := 1
 	size := 10
-	result, err := ListAdminNewsletterSubscribers(
+	result, err := testService().ListAdminNewsletterSubscribers(
 		context.Background(),
 		&domain.AdminUser{ID: "admin-1"},
 		domain.AdminNewsletterSubscriberFilter{
@@ -210,7 +210,7 @@ func TestUpdateAdminNewsletterSubscriberStatusUpdatesRecord(t *testing.T) {
 		},
 	}
 
-	updated, err := UpdateAdminNewsletterSubscriberStatus(
+	updated, err := testService().UpdateAdminNewsletterSubscriberStatus(
 		context.Background(),
 		&domain.AdminUser{ID: "admin-1"},
 		"member@example.com",
@@ -240,7 +240,7 @@ func TestListAdminNewsletterCampaignsFiltersByLocale(t *testing.T) {
 
 	page := 1
 	size := 5
-	result, err := ListAdminNewsletterCampaigns(
+	result, err := testService().ListAdminNewsletterCampaigns(
 		context.Background(),
 		&domain.AdminUser{ID: "admin-1"},
 		domain.AdminNewsletterCampaignFilter{
@@ -269,7 +269,7 @@ func TestListAdminNewsletterDeliveryFailuresRequiresCampaignIdentity(t *testing.
 
 	adminNewsletterRepository = &adminNewsletterManagementStubRepository{}
 
-	_, err := ListAdminNewsletterDeliveryFailures(
+	_, err := testService().ListAdminNewsletterDeliveryFailures(
 		context.Background(),
 		&domain.AdminUser{ID: "admin-1"},
 		domain.AdminNewsletterDeliveryFailureFilter{},
@@ -289,7 +289,7 @@ func TestDeleteAdminNewsletterSubscriberReturnsBadRequestWhenMissing(t *testing.
 		items: []domain.AdminNewsletterSubscriberRecord{},
 	}
 
-	err := DeleteAdminNewsletterSubscriber(context.Background(), &domain.AdminUser{ID: "admin-1"}, "missing@example.com")
+	err := testService().DeleteAdminNewsletterSubscriber(context.Background(), &domain.AdminUser{ID: "admin-1"}, "missing@example.com")
 	if err == nil {
 		t.Fatal("expected error")
 	}
@@ -305,7 +305,7 @@ func TestListAdminNewsletterSubscribersReturnsServiceUnavailable(t *testing.T) {
 		listErr: repository.ErrAdminNewsletterRepositoryUnavailable,
 	}
 
-	_, err := ListAdminNewsletterSubscribers(
+	_, err := testService().ListAdminNewsletterSubscribers(
 		context.Background(),
 		&domain.AdminUser{ID: "admin-1"},
 		domain.AdminNewsletterSubscriberFilter{},
@@ -325,7 +325,7 @@ func TestUpdateAdminNewsletterSubscriberStatusReturnsInternalOnRepoError(t *test
 		updateErr: errors.New("db down"),
 	}
 
-	_, err := UpdateAdminNewsletterSubscriberStatus(
+	_, err := testService().UpdateAdminNewsletterSubscriberStatus(
 		context.Background(),
 		&domain.AdminUser{ID: "admin-1"},
 		"member@example.com",
@@ -471,7 +471,7 @@ func TestListAdminNewsletterDeliveryFailuresReturnsFilteredRecords(t *testing.T)
 
 	page := 1
 	size := 5
-	result, err := ListAdminNewsletterDeliveryFailures(
+	result, err := testService().ListAdminNewsletterDeliveryFailures(
 		context.Background(),
 		&domain.AdminUser{ID: "admin-1"},
 		domain.AdminNewsletterDeliveryFailureFilter{
@@ -502,19 +502,19 @@ func TestAdminNewsletterNormalizersRejectInvalidValues(t *testing.T) {
 }
 
 func TestAdminNewsletterManagementRequiresAuthentication(t *testing.T) {
-	if _, err := ListAdminNewsletterSubscribers(context.Background(), nil, domain.AdminNewsletterSubscriberFilter{}); err == nil || err.Error() != "admin authentication required" {
+	if _, err := testService().ListAdminNewsletterSubscribers(context.Background(), nil, domain.AdminNewsletterSubscriberFilter{}); err == nil || err.Error() != "admin authentication required" {
 		t.Fatalf("expected auth error for subscribers, got %v", err)
 	}
-	if _, err := UpdateAdminNewsletterSubscriberStatus(context.Background(), nil, "reader@example.com", adminNewsletterStatusActive); err == nil || err.Error() != "admin authentication required" {
+	if _, err := testService().UpdateAdminNewsletterSubscriberStatus(context.Background(), nil, "reader@example.com", adminNewsletterStatusActive); err == nil || err.Error() != "admin authentication required" {
 		t.Fatalf("expected auth error for subscriber update, got %v", err)
 	}
-	if err := DeleteAdminNewsletterSubscriber(context.Background(), nil, "reader@example.com"); err == nil || err.Error() != "admin authentication required" {
+	if err := testService().DeleteAdminNewsletterSubscriber(context.Background(), nil, "reader@example.com"); err == nil || err.Error() != "admin authentication required" {
 		t.Fatalf("expected auth error for subscriber delete, got %v", err)
 	}
-	if _, err := ListAdminNewsletterCampaigns(context.Background(), nil, domain.AdminNewsletterCampaignFilter{}); err == nil || err.Error() != "admin authentication required" {
+	if _, err := testService().ListAdminNewsletterCampaigns(context.Background(), nil, domain.AdminNewsletterCampaignFilter{}); err == nil || err.Error() != "admin authentication required" {
 		t.Fatalf("expected auth error for campaigns, got %v", err)
 	}
-	if _, err := ListAdminNewsletterDeliveryFailures(context.Background(), nil, domain.AdminNewsletterDeliveryFailureFilter{}); err == nil || err.Error() != "admin authentication required" {
+	if _, err := testService().ListAdminNewsletterDeliveryFailures(context.Background(), nil, domain.AdminNewsletterDeliveryFailureFilter{}); err == nil || err.Error() != "admin authentication required" {
 		t.Fatalf("expected auth error for failures, got %v", err)
 	}
 	if _, err := TriggerAdminNewsletterDispatch(context.Background(), nil); err == nil || err.Error() != "admin authentication required" {
@@ -533,19 +533,19 @@ func TestAdminNewsletterManagementValidationAndRepositoryErrors(t *testing.T) {
 
 	admin := &domain.AdminUser{ID: "admin-1"}
 
-	if _, err := UpdateAdminNewsletterSubscriberStatus(context.Background(), admin, "bad", adminNewsletterStatusActive); err == nil || err.Error() != "invalid subscriber email" {
+	if _, err := testService().UpdateAdminNewsletterSubscriberStatus(context.Background(), admin, "bad", adminNewsletterStatusActive); err == nil || err.Error() != "invalid subscriber email" {
 		t.Fatalf("expected invalid subscriber email error, got %v", err)
 	}
-	if _, err := UpdateAdminNewsletterSubscriberStatus(context.Background(), admin, "reader@example.com", "pending"); err == nil || err.Error() != "unsupported newsletter status" {
+	if _, err := testService().UpdateAdminNewsletterSubscriberStatus(context.Background(), admin, "reader@example.com", "pending"); err == nil || err.Error() != "unsupported newsletter status" {
 		t.Fatalf("expected invalid subscriber status error, got %v", err)
 	}
-	if err := DeleteAdminNewsletterSubscriber(context.Background(), admin, "bad"); err == nil || err.Error() != "invalid subscriber email" {
+	if err := testService().DeleteAdminNewsletterSubscriber(context.Background(), admin, "bad"); err == nil || err.Error() != "invalid subscriber email" {
 		t.Fatalf("expected invalid delete email error, got %v", err)
 	}
-	if _, err := ListAdminNewsletterCampaigns(context.Background(), admin, domain.AdminNewsletterCampaignFilter{Status: "broken"}); err == nil || err.Error() != "unsupported newsletter campaign status" {
+	if _, err := testService().ListAdminNewsletterCampaigns(context.Background(), admin, domain.AdminNewsletterCampaignFilter{Status: "broken"}); err == nil || err.Error() != "unsupported newsletter campaign status" {
 		t.Fatalf("expected invalid campaign status error, got %v", err)
 	}
-	if _, err := ListAdminNewsletterDeliveryFailures(context.Background(), admin, domain.AdminNewsletterDeliveryFailureFilter{Locale: "de", ItemKey: "post:alpha"}); err == nil || err.Error() != "newsletter campaign locale is required" {
+	if _, err := testService().ListAdminNewsletterDeliveryFailures(context.Background(), admin, domain.AdminNewsletterDeliveryFailureFilter{Locale: "de", ItemKey: "post:alpha"}); err == nil || err.Error() != "newsletter campaign locale is required" {
 		t.Fatalf("expected locale required error, got %v", err)
 	}
 	if _, err := SendAdminNewsletterTestEmail(context.Background(), admin, "bad", "en", "post:alpha"); err == nil || err.Error() != "invalid test email" {
@@ -561,21 +561,21 @@ func TestAdminNewsletterManagementValidationAndRepositoryErrors(t *testing.T) {
 	adminNewsletterRepository = &adminNewsletterManagementStubRepository{
 		campaignsErr: repository.ErrAdminNewsletterRepositoryUnavailable,
 	}
-	if _, err := ListAdminNewsletterCampaigns(context.Background(), admin, domain.AdminNewsletterCampaignFilter{}); err == nil || !strings.Contains(err.Error(), "admin newsletter is unavailable") {
+	if _, err := testService().ListAdminNewsletterCampaigns(context.Background(), admin, domain.AdminNewsletterCampaignFilter{}); err == nil || !strings.Contains(err.Error(), "admin newsletter is unavailable") {
 		t.Fatalf("expected campaigns unavailable error, got %v", err)
 	}
 
 	adminNewsletterRepository = &adminNewsletterManagementStubRepository{
 		failuresErr: repository.ErrAdminNewsletterRepositoryUnavailable,
 	}
-	if _, err := ListAdminNewsletterDeliveryFailures(context.Background(), admin, domain.AdminNewsletterDeliveryFailureFilter{Locale: "en", ItemKey: "post:alpha"}); err == nil || !strings.Contains(err.Error(), "admin newsletter is unavailable") {
+	if _, err := testService().ListAdminNewsletterDeliveryFailures(context.Background(), admin, domain.AdminNewsletterDeliveryFailureFilter{Locale: "en", ItemKey: "post:alpha"}); err == nil || !strings.Contains(err.Error(), "admin newsletter is unavailable") {
 		t.Fatalf("expected failures unavailable error, got %v", err)
 	}
 
 	adminNewsletterRepository = &adminNewsletterManagementStubRepository{
 		deleteErr: repository.ErrAdminNewsletterRepositoryUnavailable,
 	}
-	if err := DeleteAdminNewsletterSubscriber(context.Background(), admin, "reader@example.com"); err == nil || !strings.Contains(err.Error(), "admin newsletter is unavailable") {
+	if err := testService().DeleteAdminNewsletterSubscriber(context.Background(), admin, "reader@example.com"); err == nil || !strings.Contains(err.Error(), "admin newsletter is unavailable") {
 		t.Fatalf("expected delete unavailable error, got %v", err)
 	}
 }
@@ -11,6 +11,8 @@ import (
 	appconfig "suaybsimsek.com/blog-api/internal/config"
 	"suaybsimsek.com/blog-api/internal/domain"
 	"suaybsimsek.com/blog-api/internal/repository"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/totp"
 )
 
 func TestResolveAdminGithubIdentityFromCodeReturnsVerifiedIdentity(t *testing.T) {
@@ -62,7 +64,7 @@ func TestLinkAndDisconnectAdminGithubAccount(t *testing.T) {
 	adminUsersRepository = repo
 	nowUTCFn = func() time.Time { return time.Date(2026, time.March, 21, 10, 0, 0, 0, time.UTC) }
 
-	updated, err := LinkAdminGithubAccount(context.Background(), "admin-1", &AdminGithubIdentity{
+	updated, err := testService().LinkAdminGithubAccount(context.Background(), "admin-1", &AdminGithubIdentity{
 		Subject: "github-123",
 		Email:   "admin@example.com",
 	})
@@ -73,7 +75,7 @@ func TestLinkAndDisconnectAdminGithubAccount(t *testing.T) {
 		t.Fatalf("unexpected linked user: %#v", updated)
 	}
 
-	updated, err = DisconnectAdminGithubAccount(context.Background(), &domain.AdminUser{ID: "admin-1"})
+	updated, err = testService().DisconnectAdminGithubAccount(context.Background(), &domain.AdminUser{ID: "admin-1"})
 	if err != nil {
 		t.Fatalf("DisconnectAdminGithubAccount returned error: %v", err)
 	}
@@ -131,7 +133,7 @@ func TestLinkAndDisconnectAdminGoogleAccount(t *testing.T) {
 	adminUsersRepository = repo
 	nowUTCFn = func() time.Time { return time.Date(2026, time.March, 21, 10, 0, 0, 0, time.UTC) }
 
-	updated, err := LinkAdminGoogleAccount(context.Background(), "admin-1", &AdminGoogleIdentity{
+	updated, err := testService().LinkAdminGoogleAccount(context.Background(), "admin-1", &AdminGoogleIdentity{
 		Subject: "google-123",
 		Email:   "admin@example.com",
 	})
@@ -142,7 +144,7 @@ func TestLinkAndDisconnectAdminGoogleAccount(t *testing.T) {
 		t.Fatalf("unexpected linked user: %#v", updated)
 	}
 
-	updated, err = DisconnectAdminGoogleAccount(context.Background(), &domain.AdminUser{ID: "admin-1"})
+	updated, err = testService().DisconnectAdminGoogleAccount(context.Background(), &domain.AdminUser{ID: "admin-1"})
 	if err != nil {
 		t.Fatalf("DisconnectAdminGoogleAccount returned error: %v", err)
 	}
@@ -179,7 +181,7 @@ func TestOAuthStatusQueriesAndConnectURLs(t *testing.T) {
 		return appconfig.AdminGoogleConfig{ClientID: "client-id", ClientSecret: "client-secret"}
 	}
 
-	githubStatus, err := QueryAdminGithubAuthStatus(context.Background())
+	githubStatus, err := testService().QueryAdminGithubAuthStatus(context.Background())
 	if err != nil {
 		t.Fatalf("QueryAdminGithubAuthStatus returned error: %v", err)
 	}
@@ -187,7 +189,7 @@ func TestOAuthStatusQueriesAndConnectURLs(t *testing.T) {
 		t.Fatalf("unexpected github status: %#v", githubStatus)
 	}
 
-	googleStatus, err := QueryAdminGoogleAuthStatus(context.Background())
+	googleStatus, err := testService().QueryAdminGoogleAuthStatus(context.Background())
 	if err != nil {
 		t.Fatalf("QueryAdminGoogleAuthStatus returned error: %v", err)
 	}
@@ -233,18 +235,68 @@ func TestAdminOAuthLoginFunctionsIssueTokens(t *testing.T) {
 	adminRefreshTokensRepository = adminAuthSessionStubRefreshRepository{
 		create: func(context.Context, domain.AdminRefreshTokenRecord) error { return nil },
 	}
+	stubAdminLoginAttempts(t)
 
-	githubResponse, err := LoginAdminWithGithubSubject(context.Background(), "github-123", false, AdminSessionMetadata{})
+	githubResponse, err := testService().LoginAdminWithGithubSubject(context.Background(), "github-123", false, AdminSessionMetadata{})
 	if err != nil || githubResponse == nil || githubResponse.AccessToken == "" {
 		t.Fatalf("unexpected github login result: %#v, err=%v", githubResponse, err)
 	}
 
-	googleResponse, err := LoginAdminWithGoogleSubject(context.Background(), "google-123", true, AdminSessionMetadata{})
+	googleResponse, err := testService().LoginAdminWithGoogleSubject(context.Background(), "google-123", true, AdminSessionMetadata{})
 	if err != nil || googleResponse == nil || googleResponse.AccessToken == "" {
 		t.Fatalf("unexpected google login result: %#v, err=%v", googleResponse, err)
 	}
 }
 
+func TestAdminOAuthLoginAppliesLockoutAndTwoFactor(t *testing.T) {
+	user, now := stubAdminTwoFactor(t)
+	attempts, _ := stubAdminLoginAttempts(t)
+	metadata := AdminSessionMetadata{RemoteIP: "203.0.113.10"}
+	user.GithubSubject = "github-123"
+	user.GoogleSubject = "google-123"
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret returned error: %v", err)
+	}
+	user.TwoFactor = &domain.AdminTwoFactor{Secret: secret, EnabledAt: *now}
+
+	logins := map[string]func(rememberMe bool) (*AdminAuthResponse, error){
+		"github": func(rememberMe bool) (*AdminAuthResponse, error) {
+			return testService().LoginAdminWithGithubSubject(context.Background(), "github-123", rememberMe, metadata)
+		},
+		"google": func(rememberMe bool) (*AdminAuthResponse, error) {
+			return testService().LoginAdminWithGoogleSubject(context.Background(), "google-123", rememberMe, metadata)
+		},
+	}
+	for provider, login := range logins {
+		response, err := login(true)
+		if err != nil || !response.MFARequired || response.MFAToken == "" || response.AccessToken != "" || response.RefreshToken != "" {
+			t.Fatalf("%s: expected an mfa challenge without tokens, got %#v %v", provider, response, err)
+		}
+		completed, err := testService().CompleteAdminTwoFactorLogin(
+			context.Background(),
+			response.MFAToken,
+			currentAdminTOTPCode(t, secret, *now),
+			metadata,
+		)
+		if err != nil || completed.AccessToken == "" || !completed.RememberMe {
+			t.Fatalf("%s: CompleteAdminTwoFactorLogin() = %#v, %v", provider, completed, err)
+		}
+		// A used code is rejected as a replay, so the next provider signs in during the following step.
+		*now = now.Add(time.Minute)
+	}
+
+	lockedUntil := now.Add(time.Hour)
+	key := newAdminThrottleSubject(adminLoginAccountThrottlePolicy, user.Email).key
+	attempts.records[key] = &domain.AdminLoginAttemptRecord{Key: key, LockedUntil: &lockedUntil, ExpiresAt: lockedUntil}
+	for provider, login := range logins {
+		if _, err := login(false); apperrors.From(err).Code != adminCodeLoginLocked {
+			t.Fatalf("%s: expected a locked account to be rejected, got %v", provider, err)
+		}
+	}
+}
+
 func TestGithubAndGoogleHTTPHelpersParseResponses(t *testing.T) {
 	previousClient := http.DefaultClient
 	t.Cleanup(func() {
@@ -403,10 +455,10 @@ func TestAdminOAuthBranchErrorsAndValidation(t *testing.T) {
 			t.Fatal("expected google connect config error")
 		}
 
-		if _, err := LoginAdminWithGithubSubject(context.Background(), "github-123", false, AdminSessionMetadata{}); err == nil {
+		if _, err := testService().LoginAdminWithGithubSubject(context.Background(), "github-123", false, AdminSessionMetadata{}); err == nil {
 			t.Fatal("expected github login config error")
 		}
-		if _, err := LoginAdminWithGoogleSubject(context.Background(), "google-123", false, AdminSessionMetadata{}); err == nil {
+		if _, err := testService().LoginAdminWithGoogleSubject(context.Background(), "google-123", false, AdminSessionMetadata{}); err == nil {
 			t.Fatal("expected google login config error")
 		}
 
@@ -414,10 +466,10 @@ func TestAdminOAuthBranchErrorsAndValidation(t *testing.T) {
 		adminUsersRepository = &adminAuthSessionStubUserRepository{
 			adminAuthEmailChangeStubUserRepository: newAdminAuthEmailChangeStubUserRepository(nil),
 		}
-		if _, err := LoginAdminWithGithubSubject(context.Background(), "github-123", false, AdminSessionMetadata{}); err == nil {
+		if _, err := testService().LoginAdminWithGithubSubject(context.Background(), "github-123", false, AdminSessionMetadata{}); err == nil {
 			t.Fatal("expected github invalid credentials error")
 		}
-		if _, err := LoginAdminWithGoogleSubject(context.Background(), "google-123", false, AdminSessionMetadata{}); err == nil {
+		if _, err := testService().LoginAdminWithGoogleSubject(context.Background(), "google-123", false, AdminSessionMetadata{}); err == nil {
 			t.Fatal("expected google invalid credentials error")
 		}
 	})
@@ -443,38 +495,38 @@ func TestAdminOAuthBranchErrorsAndValidation(t *testing.T) {
 		}
 		nowUTCFn = func() time.Time { return time.Date(2026, time.March, 21, 10, 0, 0, 0, time.UTC) }
 
-		if _, err := LinkAdminGithubAccount(context.Background(), "", &AdminGithubIdentity{Subject: "github-123", Email: "admin@example.com"}); err == nil {
+		if _, err := testService().LinkAdminGithubAccount(context.Background(), "", &AdminGithubIdentity{Subject: "github-123", Email: "admin@example.com"}); err == nil {
 			t.Fatal("expected github auth required error")
 		}
-		if _, err := LinkAdminGoogleAccount(context.Background(), "", &AdminGoogleIdentity{Subject: "google-123", Email: "admin@example.com"}); err == nil {
+		if _, err := testService().LinkAdminGoogleAccount(context.Background(), "", &AdminGoogleIdentity{Subject: "google-123", Email: "admin@example.com"}); err == nil {
 			t.Fatal("expected google auth required error")
 		}
-		if _, err := LinkAdminGithubAccount(context.Background(), "admin-1", nil); err == nil {
+		if _, err := testService().LinkAdminGithubAccount(context.Background(), "admin-1", nil); err == nil {
 			t.Fatal("expected github invalid identity error")
 		}
-		if _, err := LinkAdminGoogleAccount(context.Background(), "admin-1", nil); err == nil {
+		if _, err := testService().LinkAdminGoogleAccount(context.Background(), "admin-1", nil); err == nil {
 			t.Fatal("expected google invalid identity error")
 		}
-		if _, err := LinkAdminGithubAccount(context.Background(), "admin-1", &AdminGithubIdentity{Subject: "github-new", Email: "admin@example.com"}); err == nil {
+		if _, err := testService().LinkAdminGithubAccount(context.Background(), "admin-1", &AdminGithubIdentity{Subject: "github-new", Email: "admin@example.com"}); err == nil {
 			t.Fatal("expected github current-link conflict")
 		}
-		if _, err := LinkAdminGoogleAccount(context.Background(), "admin-1", &AdminGoogleIdentity{Subject: "google-new", Email: "admin@example.com"}); err == nil {
+		if _, err := testService().LinkAdminGoogleAccount(context.Background(), "admin-1", &AdminGoogleIdentity{Subject: "google-new", Email: "admin@example.com"}); err == nil {
 			t.Fatal("expected google current-link conflict")
 		}
 
-		if _, err := DisconnectAdminGithubAccount(context.Background(), nil); err == nil {
+		if _, err := testService().DisconnectAdminGithubAccount(context.Background(), nil); err == nil {
 			t.Fatal("expected github disconnect auth error")
 		}
-		if _, err := DisconnectAdminGoogleAccount(context.Background(), nil); err == nil {
+		if _, err := testService().DisconnectAdminGoogleAccount(context.Background(), nil); err == nil {
 			t.Fatal("expected google disconnect auth error")
 		}
 
 		user.GithubSubject = ""
 		user.GoogleSubject = ""
-		if _, err := DisconnectAdminGithubAccount(context.Background(), &domain.AdminUser{ID: "admin-1"}); err == nil {
+		if _, err := testService().DisconnectAdminGithubAccount(context.Background(), &domain.AdminUser{ID: "admin-1"}); err == nil {
 			t.Fatal("expected github not linked error")
 		}
-		if _, err := DisconnectAdminGoogleAccount(context.Background(), &domain.AdminUser{ID: "admin-1"}); err == nil {
+		if _, err := testService().DisconnectAdminGoogleAccount(context.Background(), &domain.AdminUser{ID: "admin-1"}); err == nil {
 			t.Fatal("expected google not linked error")
 		}
 	})
@@ -513,18 +565,18 @@ func TestAdminOAuthRepositoryAndHTTPErrorPaths(t *testing.T) {
 			},
 		}
 
-		if _, err := QueryAdminGithubAuthStatus(context.Background()); err == nil || !strings.Contains(err.Error(), "failed to load github auth status") {
+		if _, err := testService().QueryAdminGithubAuthStatus(context.Background()); err == nil || !strings.Contains(err.Error(), "failed to load github auth status") {
 			t.Fatalf("expected github status error, got %v", err)
 		}
-		if _, err := QueryAdminGoogleAuthStatus(context.Background()); err == nil || !strings.Contains(err.Error(), "failed to load google auth status") {
+		if _, err := testService().QueryAdminGoogleAuthStatus(context.Background()); err == nil || !strings.Contains(err.Error(), "failed to load google auth status") {
 			t.Fatalf("expected google status error, got %v", err)
 		}
 
 		t.Setenv("JWT_SECRET", "admin-secret")
-		if _, err := LoginAdminWithGithubSubject(context.Background(), "github-123", false, AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), adminLoadAdminUserMessage) {
+		if _, err := testService().LoginAdminWithGithubSubject(context.Background(), "github-123", false, AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), adminLoadAdminUserMessage) {
 			t.Fatalf("expected github login load error, got %v", err)
 		}
-		if _, err := LoginAdminWithGoogleSubject(context.Background(), "google-123", false, AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), adminLoadAdminUserMessage) {
+		if _, err := testService().LoginAdminWithGoogleSubject(context.Background(), "google-123", false, AdminSessionMetadata{}); err == nil || !strings.Contains(err.Error(), adminLoadAdminUserMessage) {
 			t.Fatalf("expected google login load error, got %v", err)
 		}
 	})
@@ -553,10 +605,10 @@ func TestAdminOAuthRepositoryAndHTTPErrorPaths(t *testing.T) {
 				return nil, errors.New("db down")
 			},
 		}
-		if _, err := LinkAdminGithubAccount(context.Background(), "admin-1", &AdminGithubIdentity{Subject: "github-new", Email: "admin@example.com"}); err == nil || !strings.Contains(err.Error(), "failed to load github account link") {
+		if _, err := testService().LinkAdminGithubAccount(context.Background(), "admin-1", &AdminGithubIdentity{Subject: "github-new", Email: "admin@example.com"}); err == nil || !strings.Contains(err.Error(), "failed to load github account link") {
 			t.Fatalf("expected github link lookup error, got %v", err)
 		}
-		if _, err := LinkAdminGoogleAccount(context.Background(), "admin-1", &AdminGoogleIdentity{Subject: "google-new", Email: "admin@example.com"}); err == nil || !strings.Contains(err.Error(), "failed to load google account link") {
+		if _, err := testService().LinkAdminGoogleAccount(context.Background(), "admin-1", &AdminGoogleIdentity{Subject: "google-new", Email: "admin@example.com"}); err == nil || !strings.Contains(err.Error(), "failed to load google account link") {
 			t.Fatalf("expected google link lookup error, got %v", err)
 		}
 
@@ -577,16 +629,16 @@ func TestAdminOAuthRepositoryAndHTTPErrorPaths(t *testing.T) {
 				return errors.New("boom")
 			},
 		}
-		if _, err := LinkAdminGithubAccount(context.Background(), "admin-1", &AdminGithubIdentity{Subject: "github-123", Email: "admin@example.com"}); err == nil || !strings.Contains(err.Error(), "already linked to another admin") {
+		if _, err := testService().LinkAdminGithubAccount(context.Background(), "admin-1", &AdminGithubIdentity{Subject: "github-123", Email: "admin@example.com"}); err == nil || !strings.Contains(err.Error(), "already linked to another admin") {
 			t.Fatalf("expected github duplicate link error, got %v", err)
 		}
-		if _, err := LinkAdminGoogleAccount(context.Background(), "admin-1", &AdminGoogleIdentity{Subject: "google-123", Email: "admin@example.com"}); err == nil || !strings.Contains(err.Error(), "already linked to another admin") {
+		if _, err := testService().LinkAdminGoogleAccount(context.Background(), "admin-1", &AdminGoogleIdentity{Subject: "google-123", Email: "admin@example.com"}); err == nil || !strings.Contains(err.Error(), "already linked to another admin") {
 			t.Fatalf("expected google duplicate link error, got %v", err)
 		}
-		if _, err := DisconnectAdminGithubAccount(context.Background(), &domain.AdminUser{ID: "admin-1"}); err == nil || !strings.Contains(err.Error(), "failed to disconnect github account") {
+		if _, err := testService().DisconnectAdminGithubAccount(context.Background(), &domain.AdminUser{ID: "admin-1"}); err == nil || !strings.Contains(err.Error(), "failed to disconnect github account") {
 			t.Fatalf("expected github disconnect error, got %v", err)
 		}
-		if _, err := DisconnectAdminGoogleAccount(context.Background(), &domain.AdminUser{ID: "admin-1"}); err == nil || !strings.Contains(err.Error(), "failed to disconnect google account") {
+		if _, err := testService().DisconnectAdminGoogleAccount(context.Background(), &domain.AdminUser{ID: "admin-1"}); err == nil || !strings.Contains(err.Error(), "failed to disconnect google account") {
 			t.Fatalf("expected google disconnect error, got %v", err)
 		}
 	})
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/repository"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+)
+
+type AdminOIDCProviderStatus struct {
+	ID             string
+	Name           string
+	LoginAvailable bool
+}
+
+type AdminOIDCLink struct {
+	Provider     string
+	ProviderName string
+	Email        string
+	LinkedAt     time.Time
+}
+
+type AdminOIDCConnectResult struct {
+	URL string
+}
+
+const adminOIDCAlreadyLinkedMessage = "account is already linked to another admin"
+
+// QueryAdminOIDCProviders lists the generic providers. LoginAvailable is set once any admin has linked the provider,
+// like the Google and GitHub status queries.
+func (s *Service) QueryAdminOIDCProviders(ctx context.Context) ([]AdminOIDCProviderStatus, error) {
+	providers, err := ListOIDCProviders()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]AdminOIDCProviderStatus, 0, len(providers))
+	for _, provider := range providers {
+		hasLink, err := s.oidcIdentities.HasAnyByProvider(ctx, domain.OIDCAccountTypeAdmin, provider.ID)
+		if err != nil {
+			return nil, apperrors.Internal("failed to load sign-in provider status", err)
+		}
+		statuses = append(statuses, AdminOIDCProviderStatus{ID: provider.ID, Name: provider.Name, LoginAvailable: hasLink})
+	}
+
+	return statuses, nil
+}
+
+func (s *Service) ListAdminOIDCLinks(ctx context.Context, adminUser *domain.AdminUser) ([]AdminOIDCLink, error) {
+	if err := requireAdminAuthentication(adminUser); err != nil {
+		return nil, err
+	}
+
+	records, err := s.oidcIdentities.ListByUserID(ctx, domain.OIDCAccountTypeAdmin, adminUser.ID)
+	if err != nil {
+		return nil, apperrors.Internal("failed to load linked accounts", err)
+	}
+
+	links := make([]AdminOIDCLink, 0, len(records))
+	for _, record := range records {
+		links = append(links, AdminOIDCLink{
+			Provider:     record.Provider,
+			ProviderName: resolveOIDCProviderName(record.Provider),
+			Email:        record.Email,
+			LinkedAt:     record.LinkedAt,
+		})
+	}
+
+	return links, nil
+}
+
+func StartAdminOIDCConnect(
+	_ context.Context,
+	adminUser *domain.AdminUser,
+	providerID string,
+	locale string,
+) (*AdminOIDCConnectResult, error) {
+	if err := requireAdminSessionAuthentication(adminUser); err != nil {
+		return nil, err
+	}
+
+	config, err := resolveOIDCProviderConfig(providerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AdminOIDCConnectResult{
+		URL: "/api/oauth/connect?provider=" + url.QueryEscape(config.ID) +
+			"&flow=admin&intent=connect&locale=" + url.QueryEscape(strings.TrimSpace(locale)),
+	}, nil
+}
+
+func (s *Service) LinkAdminOIDCAccount(ctx context.Context, adminUserID string, identity *OIDCIdentity) (*domain.AdminUser, error) {
+	resolvedAdminUserID := strings.TrimSpace(adminUserID)
+	if resolvedAdminUserID == "" {
+		return nil, apperrors.Unauthorized(adminAuthRequiredMessage)
+	}
+
+	if _, err := s.loadAdminUserRecord(ctx, resolvedAdminUserID); err != nil {
+		return nil, err
+	}
+	if err := s.linkAdminOIDCIdentity(ctx, resolvedAdminUserID, identity); err != nil {
+		return nil, err
+	}
+
+	return s.reloadAdminUser(ctx, resolvedAdminUserID)
+}
+
+func (s *Service) DisconnectAdminOIDCAccount(ctx context.Context, adminUser *domain.AdminUser, providerID string) (bool, error) {
+	if err := requireAdminSessionAuthentication(adminUser); err != nil {
+		return false, err
+	}
+
+	unlinked, err := s.oidcIdentities.Unlink(
+		ctx,
+		domain.OIDCAccountTypeAdmin,
+		adminUser.ID,
+		strings.ToLower(strings.TrimSpace(providerID)),
+	)
+	if err != nil {
+		return false, apperrors.Internal("failed to disconnect account", err)
+	}
+	if !unlinked {
+		return false, apperrors.BadRequest("account is not linked")
+	}
+
+	return true, nil
+}
+
+// LoginAdminWithOIDCIdentity signs in the admin the identity is linked to. It honours the same lockout as password
+// sign-in, and an admin with two-factor authentication gets an mfa-pending token instead of a session.
+func (s *Service) LoginAdminWithOIDCIdentity(
+	ctx context.Context,
+	identity *OIDCIdentity,
+	rememberMe bool,
+	metadata AdminSessionMetadata,
+) (*AdminAuthResponse, error) {
+	config := appconfig.ResolveAdminConfig()
+	if !config.JWTConfigured() {
+		return nil, apperrors.Config("admin jwt is not configured", nil)
+	}
+
+	normalized, err := normalizeOIDCIdentity(identity)
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := s.oidcIdentities.FindBySubject(ctx, domain.OIDCAccountTypeAdmin, normalized.Provider, normalized.Subject)
+	if err != nil {
+		return nil, apperrors.Internal("failed to load linked account", err)
+	}
+	if link == nil {
+		return nil, apperrors.Unauthorized("invalid credentials")
+	}
+
+	userRecord, err := s.adminUsers.FindByID(ctx, link.UserID)
+	if err != nil {
+		return nil, apperrors.Internal(adminLoadAdminUserMessage, err)
+	}
+	if userRecord == nil {
+		return nil, apperrors.Unauthorized("invalid credentials")
+	}
+
+	throttleSubjects := adminLoginThrottleSubjects(userRecord.Email, metadata.RemoteIP)
+	if err := s.checkAdminThrottle(ctx, throttleSubjects, adminCodeLoginThrottled, adminCodeLoginLocked); err != nil {
+		return nil, err
+	}
+	if userRecord.TwoFactor != nil {
+		return issueAdminMFAChallenge(config, userRecord, rememberMe)
+	}
+
+	return s.issueAdminTokens(ctx, config, userRecord, "", rememberMe, metadata)
+}
+
+// AcceptAdminInvitationWithOIDC activates an invited admin and links the provider account used to accept.
+func (s *Service) AcceptAdminInvitationWithOIDC(ctx context.Context, token string, identity *OIDCIdentity) (*domain.AdminUser, error) {
+	userRecord, err := s.loadPendingAdminInvitation(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	normalized, err := normalizeOIDCIdentity(identity)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureAdminOIDCIdentityLinkable(ctx, userRecord.ID, normalized); err != nil {
+		return nil, err
+	}
+	if err := s.acceptAdminInvitationRecord(ctx, userRecord.ID, ""); err != nil {
+		return nil, err
+	}
+
+	return s.LinkAdminOIDCAccount(ctx, userRecord.ID, normalized)
+}
+
+func (s *Service) linkAdminOIDCIdentity(ctx context.Context, adminUserID string, identity *OIDCIdentity) error {
+	normalized, err := normalizeOIDCIdentity(identity)
+	if err != nil {
+		return err
+	}
+	if err := s.ensureAdminOIDCIdentityLinkable(ctx, adminUserID, normalized); err != nil {
+		return err
+	}
+
+	err = s.oidcIdentities.Link(ctx, domain.OIDCIdentityRecord{
+		AccountType: domain.OIDCAccountTypeAdmin,
+		UserID:      adminUserID,
+		Provider:    normalized.Provider,
+		Subject:     normalized.Subject,
+		Email:       normalized.Email,
+		LinkedAt:    nowUTCFn(),
+	})
+	if errors.Is(err, repository.ErrOIDCIdentityAlreadyLinked) {
+		return apperrors.BadRequest(adminOIDCAlreadyLinkedMessage)
+	}
+	if err != nil {
+		return apperrors.Internal("failed to link account", err)
+	}
+
+	return nil
+}
+
+func (s *Service) ensureAdminOIDCIdentityLinkable(ctx context.Context, adminUserID string, identity *OIDCIdentity) error {
+	existing, err := s.oidcIdentities.FindBySubject(ctx, domain.OIDCAccountTypeAdmin, identity.Provider, identity.Subject)
+	if err != nil {
+		return apperrors.Internal("failed to load linked account", err)
+	}
+	if existing != nil && existing.UserID != adminUserID {
+		return apperrors.BadRequest(adminOIDCAlreadyLinkedMessage)
+	}
+
+	links, err := s.oidcIdentities.ListByUserID(ctx, domain.OIDCAccountTypeAdmin, adminUserID)
+	if err != nil {
+		return apperrors.Internal("failed to load linked accounts", err)
+	}
+	for _, link := range links {
+		if link.Provider == identity.Provider && link.Subject != identity.Subject {
+			return apperrors.BadRequest("disconnect the current account before linking a different one")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,371 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/repository"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpauth"
+	"suaybsimsek.com/blog-api/pkg/webauthn"
+)
+
+// AdminPasskeyCeremony carries WebAuthn options for the browser and the signed token that binds the reply to the
+// challenge inside them.
+type AdminPasskeyCeremony struct {
+	OptionsJSON    string
+	ChallengeToken string
+	ExpiresAt      time.Time
+}
+
+const (
+	adminPasskeyRegistrationTokenType = "webauthn-registration"
+	adminPasskeyLoginTokenType        = "webauthn-login"
+	adminPasskeyLoginSubject          = "passkey"
+	adminPasskeyCeremonyTTL           = 5 * time.Minute
+	maxAdminPasskeyNameLength         = 64
+	defaultAdminPasskeyName           = "Passkey"
+
+	adminCodePasskeyInvalid          = "ADMIN_PASSKEY_INVALID"
+	adminCodePasskeyExists           = "ADMIN_PASSKEY_ALREADY_REGISTERED"
+	adminCodePasskeyLoginFailed      = "ADMIN_PASSKEY_LOGIN_FAILED"
+	adminCodePasskeyChallengeExpired = "ADMIN_PASSKEY_CHALLENGE_EXPIRED"
+)
+
+// StartAdminPasskeyRegistration returns creation options for a new passkey. Passkeys the admin already has are
+// excluded so the same authenticator is not registered twice.
+func (s *Service) StartAdminPasskeyRegistration(ctx context.Context, adminUser *domain.AdminUser) (*AdminPasskeyCeremony, error) {
+	if err := requireAdminSessionAuthentication(adminUser); err != nil {
+		return nil, err
+	}
+	userRecord, err := s.loadAdminUserRecord(ctx, adminUser.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	relyingParty, err := resolveAdminRelyingParty()
+	if err != nil {
+		return nil, err
+	}
+	passkeys, err := s.adminPasskeys.ListByUserID(ctx, userRecord.ID)
+	if err != nil {
+		return nil, apperrors.Internal("failed to load passkeys", err)
+	}
+	exclude := make([]webauthn.CredentialDescriptor, 0, len(passkeys))
+	for _, passkey := range passkeys {
+		credentialID, err := base64.RawURLEncoding.DecodeString(passkey.CredentialID)
+		if err != nil {
+			continue
+		}
+		exclude = append(exclude, webauthn.NewCredentialDescriptor(credentialID, passkey.Transports))
+	}
+
+	displayName := strings.TrimSpace(userRecord.Name)
+	if displayName == "" {
+		displayName = userRecord.Email
+	}
+
+	return issueAdminPasskeyCeremony(
+		adminPasskeyRegistrationTokenType,
+		userRecord.ID,
+		userRecord.PasswordVersion,
+		func(challenge string) any {
+			return relyingParty.CreationOptions(
+				userRecord.ID,
+				userRecord.Email,
+				displayName,
+				challenge,
+				adminPasskeyCeremonyTTL,
+				exclude,
+			)
+		},
+	)
+}
+
+// FinishAdminPasskeyRegistration verifies the browser's registration response and stores the passkey.
+func (s *Service) FinishAdminPasskeyRegistration(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	challengeToken string,
+	credential string,
+	name string,
+) (*domain.AdminPasskeyRecord, error) {
+	if err := requireAdminSessionAuthentication(adminUser); err != nil {
+		return nil, err
+	}
+
+	claims, err := verifyAdminPasskeyCeremony(challengeToken, adminPasskeyRegistrationTokenType)
+	if err != nil || claims.Subject != strings.TrimSpace(adminUser.ID) {
+		return nil, newAdminPasskeyChallengeExpiredError()
+	}
+	userRecord, err := s.loadAdminUserRecord(ctx, adminUser.ID)
+	if err != nil {
+		return nil, err
+	}
+	if claims.PasswordVersion != userRecord.PasswordVersion {
+		return nil, newAdminPasskeyChallengeExpiredError()
+	}
+
+	relyingParty, err := resolveAdminRelyingParty()
+	if err != nil {
+		return nil, err
+	}
+	verified, err := relyingParty.VerifyRegistration([]byte(credential), claims.ID)
+	if err != nil {
+		return nil, apperrors.New(adminCodePasskeyInvalid, "passkey registration is invalid", http.StatusBadRequest, err)
+	}
+
+	passkeyID, err := httpauth.GenerateOpaqueToken(18)
+	if err != nil {
+		return nil, apperrors.Internal("failed to generate passkey id", err)
+	}
+	record := domain.AdminPasskeyRecord{
+		ID:           passkeyID,
+		UserID:       userRecord.ID,
+		CredentialID: base64.RawURLEncoding.EncodeToString(verified.ID),
+		PublicKey:    verified.PublicKey,
+		Algorithm:    verified.Algorithm,
+		SignCount:    verified.SignCount,
+		Transports:   verified.Transports,
+		Name:         normalizeAdminPasskeyName(name),
+		CreatedAt:    nowUTCFn(),
+	}
+	if err := s.adminPasskeys.Create(ctx, record); err != nil {
+		if errors.Is(err, repository.ErrAdminPasskeyExists) {
+			return nil, apperrors.New(
+				adminCodePasskeyExists,
+				"passkey is already registered",
+				http.StatusConflict,
+				nil,
+			)
+		}
+		return nil, apperrors.Internal("failed to store passkey", err)
+	}
+
+	return &record, nil
+}
+
+// ListAdminPasskeys returns the passkeys registered to the signed-in admin.
+func (s *Service) ListAdminPasskeys(ctx context.Context, adminUser *domain.AdminUser) ([]domain.AdminPasskeyRecord, error) {
+	if err := requireAdminAuthentication(adminUser); err != nil {
+		return nil, err
+	}
+
+	passkeys, err := s.adminPasskeys.ListByUserID(ctx, adminUser.ID)
+	if err != nil {
+		return nil, apperrors.Internal("failed to load passkeys", err)
+	}
+
+	return passkeys, nil
+}
+
+// RevokeAdminPasskey deletes one of the admin's passkeys and reports whether it existed.
+func (s *Service) RevokeAdminPasskey(ctx context.Context, adminUser *domain.AdminUser, passkeyID string) (bool, error) {
+	if err := requireAdminSessionAuthentication(adminUser); err != nil {
+		return false, err
+	}
+
+	resolvedPasskeyID := strings.TrimSpace(passkeyID)
+	if resolvedPasskeyID == "" {
+		return false, apperrors.BadRequest("passkey id is required")
+	}
+
+	revoked, err := s.adminPasskeys.DeleteByIDAndUserID(ctx, resolvedPasskeyID, adminUser.ID)
+	if err != nil {
+		return false, apperrors.Internal("failed to revoke passkey", err)
+	}
+
+	return revoked, nil
+}
+
+// StartAdminPasskeyLogin returns request options for a usernameless sign-in with any discoverable passkey.
+func StartAdminPasskeyLogin(context.Context) (*AdminPasskeyCeremony, error) {
+	relyingParty, err := resolveAdminRelyingParty()
+	if err != nil {
+		return nil, err
+	}
+
+	return issueAdminPasskeyCeremony(adminPasskeyLoginTokenType, adminPasskeyLoginSubject, 0, func(challenge string) any {
+		return relyingParty.RequestOptions(challenge, adminPasskeyCeremonyTTL)
+	})
+}
+
+// LoginAdminWithPasskey verifies an assertion and signs the admin in. A user-verified passkey already combines
+// possession and a local PIN or biometric, so it is not followed by the TOTP step.
+func (s *Service) LoginAdminWithPasskey(
+	ctx context.Context,
+	challengeToken string,
+	credential string,
+	rememberMe bool,
+	metadata AdminSessionMetadata,
+) (*AdminAuthResponse, error) {
+	config := appconfig.ResolveAdminConfig()
+	if !config.JWTConfigured() {
+		return nil, apperrors.Config("admin jwt is not configured", nil)
+	}
+
+	claims, err := verifyAdminPasskeyCeremony(challengeToken, adminPasskeyLoginTokenType)
+	if err != nil {
+		return nil, newAdminPasskeyChallengeExpiredError()
+	}
+	assertion, err := webauthn.ParseAssertion([]byte(credential))
+	if err != nil {
+		return nil, newAdminPasskeyLoginFailedError(err)
+	}
+
+	passkey, err := s.adminPasskeys.FindByCredentialID(
+		ctx,
+		base64.RawURLEncoding.EncodeToString(assertion.CredentialID),
+	)
+	if err != nil {
+		return nil, apperrors.Internal("failed to load passkey", err)
+	}
+	if passkey == nil || (len(assertion.UserHandle) > 0 && string(assertion.UserHandle) != passkey.UserID) {
+		return nil, newAdminPasskeyLoginFailedError(nil)
+	}
+
+	userRecord, err := s.adminUsers.FindByID(ctx, passkey.UserID)
+	if err != nil {
+		return nil, apperrors.Internal(adminLoadAdminUserMessage, err)
+	}
+	if userRecord == nil {
+		return nil, newAdminPasskeyLoginFailedError(nil)
+	}
+
+	relyingParty, err := resolveAdminRelyingParty()
+	if err != nil {
+		return nil, err
+	}
+	signCount, err := relyingParty.VerifyAssertion(assertion, claims.ID, passkey.PublicKey, passkey.SignCount)
+	if err != nil {
+		return nil, newAdminPasskeyLoginFailedError(err)
+	}
+	recorded, err := s.adminPasskeys.RecordAssertion(
+		ctx,
+		passkey.ID,
+		passkey.SignCount,
+		signCount,
+		hashValue(claims.ID),
+		nowUTCFn(),
+	)
+	if err != nil {
+		return nil, apperrors.Internal("failed to update passkey", err)
+	}
+	if !recorded {
+		return nil, newAdminPasskeyLoginFailedError(nil)
+	}
+
+	return s.issueAdminTokens(ctx, config, userRecord, "", rememberMe, metadata)
+}
+
+// issueAdminPasskeyCeremony signs a short-lived token whose id is the WebAuthn challenge, so no server-side state is
+// needed between the two halves of a ceremony.
+func issueAdminPasskeyCeremony(
+	tokenType string,
+	subject string,
+	passwordVersion int64,
+	buildOptions func(challenge string) any,
+) (*AdminPasskeyCeremony, error) {
+	config := appconfig.ResolveAdminConfig()
+	if !config.JWTConfigured() {
+		return nil, apperrors.Config("admin jwt is not configured", nil)
+	}
+
+	challenge, err := webauthn.NewChallenge()
+	if err != nil {
+		return nil, apperrors.Internal("failed to generate passkey challenge", err)
+	}
+	optionsJSON, err := json.Marshal(buildOptions(challenge))
+	if err != nil {
+		return nil, apperrors.Internal("failed to encode passkey options", err)
+	}
+
+	now := nowUTCFn()
+	expiresAt := now.Add(adminPasskeyCeremonyTTL)
+	challengeToken, err := issueAdminJWT(
+		config,
+		httpauth.JWTClaims{
+			ID:              challenge,
+			Subject:         strings.TrimSpace(subject),
+			PasswordVersion: passwordVersion,
+			Type:            tokenType,
+			Issuer:          config.JWTIssuer,
+			Audience:        config.JWTAudience,
+			IssuedAt:        now.Unix(),
+			ExpiresAt:       expiresAt.Unix(),
+		},
+	)
+	if err != nil {
+		return nil, apperrors.Internal("failed to issue passkey challenge", err)
+	}
+
+	return &AdminPasskeyCeremony{
+		OptionsJSON:    string(optionsJSON),
+		ChallengeToken: challengeToken,
+		ExpiresAt:      expiresAt,
+	}, nil
+}
+
+func verifyAdminPasskeyCeremony(challengeToken, tokenType string) (*httpauth.JWTClaims, error) {
+	config := appconfig.ResolveAdminConfig()
+	claims, err := VerifyAdminJWT(config, strings.TrimSpace(challengeToken), tokenType, nowUTCFn())
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(claims.ID) == "" {
+		return nil, httpauth.ErrInvalidJWT
+	}
+
+	return claims, nil
+}
+
+// resolveAdminRelyingParty binds passkeys to the public site host, which serves the admin panel.
+func resolveAdminRelyingParty() (webauthn.RelyingParty, error) {
+	siteURL, err := resolveSiteURLFn()
+	if err != nil {
+		return webauthn.RelyingParty{}, apperrors.Config("site url is not configured", err)
+	}
+	parsed, err := url.Parse(strings.TrimSpace(siteURL))
+	if err != nil || parsed.Hostname() == "" || parsed.Scheme == "" {
+		return webauthn.RelyingParty{}, apperrors.Config("site url is invalid", err)
+	}
+
+	return webauthn.RelyingParty{
+		ID:     parsed.Hostname(),
+		Name:   parsed.Hostname(),
+		Origin: parsed.Scheme + "://" + parsed.Host,
+	}, nil
+}
+
+func normalizeAdminPasskeyName(name string) string {
+	resolvedName := strings.Join(strings.Fields(name), " ")
+	if resolvedName == "" {
+		return defaultAdminPasskeyName
+	}
+	if runes := []rune(resolvedName); len(runes) > maxAdminPasskeyNameLength {
+		return string(runes[:maxAdminPasskeyNameLength])
+	}
+
+	return resolvedName
+}
+
+func newAdminPasskeyLoginFailedError(cause error) error {
+	return apperrors.New(adminCodePasskeyLoginFailed, "passkey sign-in failed", http.StatusUnauthorized, cause)
+}
+
+func newAdminPasskeyChallengeExpiredError() error {
+	return apperrors.New(
+		adminCodePasskeyChallengeExpired,
+		"passkey request expired, try again",
+		http.StatusUnauthorized,
+		nil,
+	)
+}
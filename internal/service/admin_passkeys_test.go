@@ -0,0 +1,382 @@
+package service
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/repository"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+)
+
+type adminPasskeyStubRepository struct {
+	records map[string]*domain.AdminPasskeyRecord
+	// lastChallenges mirrors the lastChallenge field the Mongo repository keeps per passkey.
+	lastChallenges map[string]string
+}
+
+func newAdminPasskeyStubRepository() *adminPasskeyStubRepository {
+	return &adminPasskeyStubRepository{
+		records:        map[string]*domain.AdminPasskeyRecord{},
+		lastChallenges: map[string]string{},
+	}
+}
+
+func (r *adminPasskeyStubRepository) Create(_ context.Context, record domain.AdminPasskeyRecord) error {
+	for _, existing := range r.records {
+		if existing.CredentialID == record.CredentialID {
+			return repository.ErrAdminPasskeyExists
+		}
+	}
+	r.records[record.ID] = &record
+	return nil
+}
+
+func (r *adminPasskeyStubRepository) FindByCredentialID(_ context.Context, credentialID string) (*domain.AdminPasskeyRecord, error) {
+	for _, record := range r.records {
+		if record.CredentialID == credentialID {
+			copied := *record
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *adminPasskeyStubRepository) ListByUserID(_ context.Context, userID string) ([]domain.AdminPasskeyRecord, error) {
+	records := make([]domain.AdminPasskeyRecord, 0, len(r.records))
+	for _, record := range r.records {
+		if record.UserID == userID {
+			records = append(records, *record)
+		}
+	}
+	return records, nil
+}
+
+func (r *adminPasskeyStubRepository) RecordAssertion(
+	_ context.Context,
+	id string,
+	previous uint32,
+	next uint32,
+	challengeHash string,
+	usedAt time.Time,
+) (bool, error) {
+	record := r.records[id]
+	if record == nil || record.SignCount != previous || r.lastChallenges[id] == challengeHash {
+		return false, nil
+	}
+	record.SignCount = next
+	record.LastUsedAt = &usedAt
+	r.lastChallenges[id] = challengeHash
+	return true, nil
+}
+
+func (r *adminPasskeyStubRepository) DeleteByIDAndUserID(_ context.Context, id, userID string) (bool, error) {
+	record := r.records[id]
+	if record == nil || record.UserID != userID {
+		return false, nil
+	}
+	delete(r.records, id)
+	return true, nil
+}
+
+func (r *adminPasskeyStubRepository) ListIDs() []string {
+	ids := make([]string, 0, len(r.records))
+	for id := range r.records {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+type adminPasskeyTestAuthenticator struct {
+	privateKey   *ecdsa.PrivateKey
+	credentialID []byte
+	signCount    uint32
+}
+
+func (a *adminPasskeyTestAuthenticator) authenticatorData(rpID string, attested bool) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	data := append([]byte{}, rpIDHash[:]...)
+	flags := byte(0x05)
+	if attested {
+		flags |= 0x40
+	}
+	data = append(data, flags)
+	data = binary.BigEndian.AppendUint32(data, a.signCount)
+	if !attested {
+		return data
+	}
+
+	data = append(data, make([]byte, 16)...)
+	data = binary.BigEndian.AppendUint16(data, uint16(len(a.credentialID)))
+	data = append(data, a.credentialID...)
+	// COSE EC2 key {1: 2, 3: -7, -1: 1, -2: x, -3: y} in canonical CBOR.
+	data = append(data, 0xa5, 0x01, 0x02, 0x03, 0x26, 0x20, 0x01, 0x21, 0x58, 0x20)
+	data = append(data, a.privateKey.X.FillBytes(make([]byte, 32))...)
+	data = append(data, 0x22, 0x58, 0x20)
+	data = append(data, a.privateKey.Y.FillBytes(make([]byte, 32))...)
+	return data
+}
+
+func (a *adminPasskeyTestAuthenticator) register(t *testing.T, optionsJSON string) string {
+	t.Helper()
+
+	var options struct {
+		Challenge string `json:"challenge"`
+		RP        struct {
+			ID string `json:"id"`
+		} `json:"rp"`
+	}
+	if err := json.Unmarshal([]byte(optionsJSON), &options); err != nil {
+		t.Fatalf("Unmarshal creation options returned error: %v", err)
+	}
+
+	authData := a.authenticatorData(options.RP.ID, true)
+	// {"fmt": "none", "attStmt": {}, "authData": authData}
+	attestationObject := []byte{0xa3, 0x63, 'f', 'm', 't', 0x64, 'n', 'o', 'n', 'e'}
+	attestationObject = append(attestationObject, 0x67, 'a', 't', 't', 'S', 't', 'm', 't', 0xa0)
+	attestationObject = append(attestationObject, 0x68, 'a', 'u', 't', 'h', 'D', 'a', 't', 'a', 0x59)
+	attestationObject = binary.BigEndian.AppendUint16(attestationObject, uint16(len(authData)))
+	attestationObject = append(attestationObject, authData...)
+
+	return marshalAdminPasskeyCredential(t, map[string]any{
+		"clientDataJSON":    adminPasskeyClientData(t, "webauthn.create", options.Challenge),
+		"attestationObject": base64.RawURLEncoding.EncodeToString(attestationObject),
+	}, a.credentialID)
+}
+
+func (a *adminPasskeyTestAuthenticator) assert(t *testing.T, optionsJSON string) string {
+	t.Helper()
+
+	var options struct {
+		Challenge string `json:"challenge"`
+		RPID      string `json:"rpId"`
+	}
+	if err := json.Unmarshal([]byte(optionsJSON), &options); err != nil {
+		t.Fatalf("Unmarshal request options returned error: %v", err)
+	}
+
+	authData := a.authenticatorData(options.RPID, false)
+	clientDataJSON := adminPasskeyClientData(t, "webauthn.get", options.Challenge)
+	decodedClientData, _ := base64.RawURLEncoding.DecodeString(clientDataJSON)
+	clientDataHash := sha256.Sum256(decodedClientData)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.privateKey, digest[:])
+	if err != nil {
+		t.Fatalf("SignASN1 returned error: %v", err)
+	}
+
+	return marshalAdminPasskeyCredential(t, map[string]any{
+		"clientDataJSON":    clientDataJSON,
+		"authenticatorData": base64.RawURLEncoding.EncodeToString(authData),
+		"signature":         base64.RawURLEncoding.EncodeToString(signature),
+		"userHandle":        base64.RawURLEncoding.EncodeToString([]byte("admin-1")),
+	}, a.credentialID)
+}
+
+func adminPasskeyClientData(t *testing.T, clientDataType, challenge string) string {
+	t.Helper()
+
+	encoded, err := json.Marshal(map[string]any{
+		"type":      clientDataType,
+		"challenge": challenge,
+		"origin":    "https://blog.example.com",
+	})
+	if err != nil {
+		t.Fatalf("Marshal client data returned error: %v", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(encoded)
+}
+
+func marshalAdminPasskeyCredential(t *testing.T, response map[string]any, credentialID []byte) string {
+	t.Helper()
+
+	encoded, err := json.Marshal(map[string]any{
+		"id":       base64.RawURLEncoding.EncodeToString(credentialID),
+		"rawId":    base64.RawURLEncoding.EncodeToString(credentialID),
+		"type":     "public-key",
+		"response": response,
+	})
+	if err != nil {
+		t.Fatalf("Marshal credential returned error: %v", err)
+	}
+	return string(encoded)
+}
+
+func stubAdminPasskeys(t *testing.T) (*domain.AdminUserRecord, *adminPasskeyStubRepository, *adminPasskeyTestAuthenticator) {
+	t.Helper()
+
+	user, _ := stubAdminTwoFactor(t)
+	previousPasskeysRepo := adminPasskeysRepository
+	t.Cleanup(func() {
+		adminPasskeysRepository = previousPasskeysRepo
+	})
+	passkeys := newAdminPasskeyStubRepository()
+	adminPasskeysRepository = passkeys
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey returned error: %v", err)
+	}
+	return user, passkeys, &adminPasskeyTestAuthenticator{privateKey: privateKey, credentialID: []byte("credential-1")}
+}
+
+func TestAdminPasskeyRegistrationAndLogin(t *testing.T) {
+	user, passkeys, authenticator := stubAdminPasskeys(t)
+	adminUser := &domain.AdminUser{ID: "admin-1"}
+
+	registration, err := testService().StartAdminPasskeyRegistration(context.Background(), adminUser)
+	if err != nil {
+		t.Fatalf("StartAdminPasskeyRegistration returned error: %v", err)
+	}
+	var creationOptions map[string]any
+	if err := json.Unmarshal([]byte(registration.OptionsJSON), &creationOptions); err != nil {
+		t.Fatalf("OptionsJSON is not JSON: %v", err)
+	}
+	if rp, _ := creationOptions["rp"].(map[string]any); rp["id"] != "blog.example.com" {
+		t.Fatalf("unexpected relying party %v", creationOptions["rp"])
+	}
+
+	passkey, err := testService().FinishAdminPasskeyRegistration(
+		context.Background(),
+		adminUser,
+		registration.ChallengeToken,
+		authenticator.register(t, registration.OptionsJSON),
+		"  Work   laptop ",
+	)
+	if err != nil {
+		t.Fatalf("FinishAdminPasskeyRegistration returned error: %v", err)
+	}
+	if passkey.Name != "Work laptop" || passkey.UserID != "admin-1" || passkey.CredentialID != "Y3JlZGVudGlhbC0x" {
+		t.Fatalf("unexpected passkey %#v", passkey)
+	}
+
+	_, err = testService().FinishAdminPasskeyRegistration(
+		context.Background(),
+		adminUser,
+		registration.ChallengeToken,
+		authenticator.register(t, registration.OptionsJSON),
+		"",
+	)
+	if appErr := apperrors.From(err); appErr.Code != adminCodePasskeyExists || appErr.HTTPStatus != http.StatusConflict {
+		t.Fatalf("expected duplicate passkey error, got %v", err)
+	}
+
+	next, err := testService().StartAdminPasskeyRegistration(context.Background(), adminUser)
+	if err != nil {
+		t.Fatalf("StartAdminPasskeyRegistration returned error: %v", err)
+	}
+	var nextOptions struct {
+		ExcludeCredentials []struct {
+			ID string `json:"id"`
+		} `json:"excludeCredentials"`
+	}
+	if err := json.Unmarshal([]byte(next.OptionsJSON), &nextOptions); err != nil || len(nextOptions.ExcludeCredentials) != 1 {
+		t.Fatalf("expected registered passkey to be excluded, got %s", next.OptionsJSON)
+	}
+
+	// Enabling TOTP must not add a second step to passkey sign-in.
+	user.TwoFactor = &domain.AdminTwoFactor{Secret: "JBSWY3DPEHPK3PXP"}
+	login, err := StartAdminPasskeyLogin(context.Background())
+	if err != nil {
+		t.Fatalf("StartAdminPasskeyLogin returned error: %v", err)
+	}
+	authenticator.signCount = 1
+	assertion := authenticator.assert(t, login.OptionsJSON)
+	response, err := testService().LoginAdminWithPasskey(context.Background(), login.ChallengeToken, assertion, true, AdminSessionMetadata{})
+	if err != nil {
+		t.Fatalf("LoginAdminWithPasskey returned error: %v", err)
+	}
+	if !response.Success || response.AccessToken == "" || !response.RememberMe || response.User.ID != "admin-1" {
+		t.Fatalf("unexpected login response %#v", response)
+	}
+	if stored := passkeys.records[passkey.ID]; stored.SignCount != 1 || stored.LastUsedAt == nil {
+		t.Fatalf("expected sign count to be recorded, got %#v", stored)
+	}
+
+	if _, err := testService().LoginAdminWithPasskey(context.Background(), login.ChallengeToken, assertion, false, AdminSessionMetadata{}); apperrors.From(err).Code != adminCodePasskeyLoginFailed {
+		t.Fatalf("expected replayed assertion to be rejected, got %v", err)
+	}
+}
+
+func TestAdminPasskeyLoginWithZeroSignCountIsSingleUse(t *testing.T) {
+	_, passkeys, authenticator := stubAdminPasskeys(t)
+	adminUser := &domain.AdminUser{ID: "admin-1"}
+
+	registration, err := testService().StartAdminPasskeyRegistration(context.Background(), adminUser)
+	if err != nil {
+		t.Fatalf("StartAdminPasskeyRegistration returned error: %v", err)
+	}
+	if _, err := testService().FinishAdminPasskeyRegistration(
+		context.Background(),
+		adminUser,
+		registration.ChallengeToken,
+		authenticator.register(t, registration.OptionsJSON),
+		"",
+	); err != nil {
+		t.Fatalf("FinishAdminPasskeyRegistration returned error: %v", err)
+	}
+
+	login, err := StartAdminPasskeyLogin(context.Background())
+	if err != nil {
+		t.Fatalf("StartAdminPasskeyLogin returned error: %v", err)
+	}
+	assertion := authenticator.assert(t, login.OptionsJSON)
+	if _, err := testService().LoginAdminWithPasskey(context.Background(), login.ChallengeToken, assertion, false, AdminSessionMetadata{}); err != nil {
+		t.Fatalf("LoginAdminWithPasskey returned error: %v", err)
+	}
+	if _, err := testService().LoginAdminWithPasskey(context.Background(), login.ChallengeToken, assertion, false, AdminSessionMetadata{}); apperrors.From(err).Code != adminCodePasskeyLoginFailed {
+		t.Fatalf("expected replayed assertion to be rejected, got %v", err)
+	}
+
+	revoked, err := testService().RevokeAdminPasskey(context.Background(), adminUser, passkeys.ListIDs()[0])
+	if err != nil || !revoked {
+		t.Fatalf("RevokeAdminPasskey = %v, %v", revoked, err)
+	}
+	second, err := StartAdminPasskeyLogin(context.Background())
+	if err != nil {
+		t.Fatalf("StartAdminPasskeyLogin returned error: %v", err)
+	}
+	if _, err := testService().LoginAdminWithPasskey(context.Background(), second.ChallengeToken, authenticator.assert(t, second.OptionsJSON), false, AdminSessionMetadata{}); apperrors.From(err).Code != adminCodePasskeyLoginFailed {
+		t.Fatalf("expected revoked passkey to be rejected, got %v", err)
+	}
+}
+
+func TestAdminPasskeyCeremonyTokensAreBound(t *testing.T) {
+	_, _, authenticator := stubAdminPasskeys(t)
+
+	registration, err := testService().StartAdminPasskeyRegistration(context.Background(), &domain.AdminUser{ID: "admin-1"})
+	if err != nil {
+		t.Fatalf("StartAdminPasskeyRegistration returned error: %v", err)
+	}
+	_, err = testService().FinishAdminPasskeyRegistration(
+		context.Background(),
+		&domain.AdminUser{ID: "admin-2"},
+		registration.ChallengeToken,
+		authenticator.register(t, registration.OptionsJSON),
+		"",
+	)
+	if apperrors.From(err).Code != adminCodePasskeyChallengeExpired {
+		t.Fatalf("expected token for another admin to be rejected, got %v", err)
+	}
+
+	if _, err := testService().LoginAdminWithPasskey(context.Background(), registration.ChallengeToken, "{}", false, AdminSessionMetadata{}); apperrors.From(err).Code != adminCodePasskeyChallengeExpired {
+		t.Fatalf("expected registration token to be rejected for login, got %v", err)
+	}
+
+	login, err := StartAdminPasskeyLogin(context.Background())
+	if err != nil {
+		t.Fatalf("StartAdminPasskeyLogin returned error: %v", err)
+	}
+	if _, err := testService().LoginAdminWithPasskey(context.Background(), login.ChallengeToken, "not-json", false, AdminSessionMetadata{}); apperrors.From(err).Code != adminCodePasskeyLoginFailed {
+		t.Fatalf("expected malformed credential to fail, got %v", err)
+	}
+}
@@ -0,0 +1,393 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/repository"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpauth"
+	"suaybsimsek.com/blog-api/pkg/totp"
+)
+
+type AdminTwoFactorEnrollment struct {
+	Secret          string
+	ProvisioningURI string
+	ExpiresAt       time.Time
+}
+
+type AdminTwoFactorRecoveryCodes struct {
+	Codes []string
+	User  *domain.AdminUser
+}
+
+const (
+	adminMFAPendingTokenType         = "mfa-pending"
+	adminMFAPendingTTL               = 5 * time.Minute
+	adminTwoFactorEnrollmentTTL      = 10 * time.Minute
+	adminTwoFactorSkewSteps          = 1
+	adminTwoFactorRecoveryCodeCount  = 10
+	adminTwoFactorRecoveryCodeLength = 10
+
+	adminCodeTwoFactorAlreadyEnabled    = "ADMIN_TWO_FACTOR_ALREADY_ENABLED"
+	adminCodeTwoFactorNotEnabled        = "ADMIN_TWO_FACTOR_NOT_ENABLED"
+	adminCodeTwoFactorEnrollmentMissing = "ADMIN_TWO_FACTOR_ENROLLMENT_REQUIRED"
+	adminCodeTwoFactorCodeInvalid       = "ADMIN_TWO_FACTOR_CODE_INVALID"
+	adminCodeTwoFactorChallengeExpired  = "ADMIN_TWO_FACTOR_CHALLENGE_EXPIRED"
+)
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// StartAdminTwoFactorEnrollment issues a new TOTP secret that becomes active once EnableAdminTwoFactor verifies a
+// code generated from it.
+func (s *Service) StartAdminTwoFactorEnrollment(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	currentPassword string,
+) (*AdminTwoFactorEnrollment, error) {
+	userRecord, err := s.loadAdminTwoFactorUser(ctx, adminUser, currentPassword)
+	if err != nil {
+		return nil, err
+	}
+	if userRecord.TwoFactor != nil {
+		return nil, newAdminTwoFactorAlreadyEnabledError()
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, apperrors.Internal("failed to generate two-factor secret", err)
+	}
+
+	expiresAt := nowUTCFn().Add(adminTwoFactorEnrollmentTTL)
+	if err := s.adminUsers.SetPendingTwoFactorByID(ctx, userRecord.ID, domain.AdminPendingTwoFactor{
+		Secret:    secret,
+		ExpiresAt: expiresAt,
+	}); err != nil {
+		if errors.Is(err, repository.ErrAdminUserNotFound) {
+			return nil, newAdminTwoFactorAlreadyEnabledError()
+		}
+		return nil, apperrors.Internal("failed to store two-factor enrollment", err)
+	}
+
+	return &AdminTwoFactorEnrollment{
+		Secret:          secret,
+		ProvisioningURI: totp.ProvisioningURI(resolveAdminTwoFactorIssuer(), userRecord.Email, secret),
+		ExpiresAt:       expiresAt,
+	}, nil
+}
+
+// EnableAdminTwoFactor verifies the first code from the pending secret, turns on two-factor authentication and
+// returns the recovery codes. The plain codes are only ever returned here and by
+// RegenerateAdminTwoFactorRecoveryCodes.
+func (s *Service) EnableAdminTwoFactor(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	currentPassword string,
+	code string,
+) (*AdminTwoFactorRecoveryCodes, error) {
+	userRecord, err := s.loadAdminTwoFactorUser(ctx, adminUser, currentPassword)
+	if err != nil {
+		return nil, err
+	}
+	if userRecord.TwoFactor != nil {
+		return nil, newAdminTwoFactorAlreadyEnabledError()
+	}
+
+	now := nowUTCFn()
+	pending := userRecord.PendingTwoFactor
+	if pending == nil || pending.Secret == "" || now.After(pending.ExpiresAt) {
+		return nil, apperrors.New(
+			adminCodeTwoFactorEnrollmentMissing,
+			"start two-factor enrollment again",
+			http.StatusBadRequest,
+			nil,
+		)
+	}
+	step, ok := totp.Validate(pending.Secret, code, now, adminTwoFactorSkewSteps)
+	if !ok {
+		return nil, newAdminTwoFactorCodeInvalidError(http.StatusBadRequest)
+	}
+
+	codes, hashes, err := generateAdminRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.adminUsers.EnableTwoFactorByID(ctx, userRecord.ID, domain.AdminTwoFactor{
+		Secret:             pending.Secret,
+		RecoveryCodeHashes: hashes,
+		LastUsedStep:       step,
+		EnabledAt:          now,
+	}); err != nil {
+		if errors.Is(err, repository.ErrAdminUserNotFound) {
+			return nil, newAdminTwoFactorAlreadyEnabledError()
+		}
+		return nil, apperrors.Internal("failed to enable two-factor authentication", err)
+	}
+
+	user, err := s.reloadAdminUser(ctx, userRecord.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &AdminTwoFactorRecoveryCodes{Codes: codes, User: user}, nil
+}
+
+// DisableAdminTwoFactor turns off two-factor authentication and discards the secret and recovery codes.
+func (s *Service) DisableAdminTwoFactor(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	currentPassword string,
+) (*domain.AdminUser, error) {
+	userRecord, err := s.loadAdminTwoFactorUser(ctx, adminUser, currentPassword)
+	if err != nil {
+		return nil, err
+	}
+	if userRecord.TwoFactor == nil {
+		return nil, newAdminTwoFactorNotEnabledError()
+	}
+
+	if err := s.adminUsers.DisableTwoFactorByID(ctx, userRecord.ID); err != nil {
+		if errors.Is(err, repository.ErrAdminUserNotFound) {
+			return nil, apperrors.Unauthorized(adminAuthRequiredMessage)
+		}
+		return nil, apperrors.Internal("failed to disable two-factor authentication", err)
+	}
+
+	return s.reloadAdminUser(ctx, userRecord.ID)
+}
+
+// RegenerateAdminTwoFactorRecoveryCodes replaces every recovery code, used or not.
+func (s *Service) RegenerateAdminTwoFactorRecoveryCodes(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	currentPassword string,
+) (*AdminTwoFactorRecoveryCodes, error) {
+	userRecord, err := s.loadAdminTwoFactorUser(ctx, adminUser, currentPassword)
+	if err != nil {
+		return nil, err
+	}
+	if userRecord.TwoFactor == nil {
+		return nil, newAdminTwoFactorNotEnabledError()
+	}
+
+	codes, hashes, err := generateAdminRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.adminUsers.ReplaceTwoFactorRecoveryCodesByID(ctx, userRecord.ID, hashes); err != nil {
+		if errors.Is(err, repository.ErrAdminUserNotFound) {
+			return nil, newAdminTwoFactorNotEnabledError()
+		}
+		return nil, apperrors.Internal("failed to regenerate recovery codes", err)
+	}
+
+	user, err := s.reloadAdminUser(ctx, userRecord.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &AdminTwoFactorRecoveryCodes{Codes: codes, User: user}, nil
+}
+
+// CompleteAdminTwoFactorLogin finishes a login that LoginAdmin or an OIDC sign-in answered with an mfa-pending token. The code may be a
+// TOTP code or an unused recovery code.
+func (s *Service) CompleteAdminTwoFactorLogin(
+	ctx context.Context,
+	mfaToken string,
+	code string,
+	metadata AdminSessionMetadata,
+) (*AdminAuthResponse, error) {
+	config := appconfig.ResolveAdminConfig()
+	if !config.JWTConfigured() {
+		return nil, apperrors.Config("admin jwt is not configured", nil)
+	}
+
+	claims, err := VerifyAdminJWT(config, strings.TrimSpace(mfaToken), adminMFAPendingTokenType, nowUTCFn())
+	if err != nil {
+		return nil, newAdminTwoFactorChallengeExpiredError()
+	}
+
+	userRecord, err := s.adminUsers.FindByID(ctx, claims.Subject)
+	if err != nil {
+		return nil, apperrors.Internal(adminLoadAdminUserMessage, err)
+	}
+	if userRecord == nil || userRecord.TwoFactor == nil || claims.PasswordVersion != userRecord.PasswordVersion {
+		return nil, newAdminTwoFactorChallengeExpiredError()
+	}
+
+	throttleSubjects := adminLoginThrottleSubjects(userRecord.Email, metadata.RemoteIP)
+	if err := s.checkAdminThrottle(ctx, throttleSubjects, adminCodeLoginThrottled, adminCodeLoginLocked); err != nil {
+		return nil, err
+	}
+	if err := s.verifyAdminSecondFactor(ctx, userRecord, code); err != nil {
+		if apperrors.From(err).Code != adminCodeTwoFactorCodeInvalid {
+			return nil, err
+		}
+		return nil, s.failAdminLogin(ctx, throttleSubjects, userRecord, err)
+	}
+	if err := s.clearAdminLoginFailures(ctx, userRecord.Email); err != nil {
+		return nil, err
+	}
+
+	return s.issueAdminTokens(ctx, config, userRecord, "", claims.RememberMe, metadata)
+}
+
+func issueAdminMFAChallenge(
+	config appconfig.AdminConfig,
+	userRecord *domain.AdminUserRecord,
+	rememberMe bool,
+) (*AdminAuthResponse, error) {
+	now := nowUTCFn()
+	expiresAt := now.Add(adminMFAPendingTTL)
+	mfaToken, err := issueAdminJWT(
+		config,
+		httpauth.JWTClaims{
+			Subject:         strings.TrimSpace(userRecord.ID),
+			PasswordVersion: userRecord.PasswordVersion,
+			RememberMe:      rememberMe,
+			Type:            adminMFAPendingTokenType,
+			Issuer:          config.JWTIssuer,
+			Audience:        config.JWTAudience,
+			IssuedAt:        now.Unix(),
+			ExpiresAt:       expiresAt.Unix(),
+		},
+	)
+	if err != nil {
+		return nil, apperrors.Internal("failed to issue admin mfa token", err)
+	}
+
+	return &AdminAuthResponse{
+		MFARequired:  true,
+		MFAToken:     mfaToken,
+		MFAExpiresAt: expiresAt,
+		RememberMe:   rememberMe,
+	}, nil
+}
+
+func (s *Service) verifyAdminSecondFactor(ctx context.Context, userRecord *domain.AdminUserRecord, code string) error {
+	if step, ok := totp.Validate(userRecord.TwoFactor.Secret, code, nowUTCFn(), adminTwoFactorSkewSteps); ok {
+		consumed, err := s.adminUsers.ConsumeTwoFactorStepByID(ctx, userRecord.ID, step)
+		if err != nil {
+			return apperrors.Internal("failed to verify two-factor code", err)
+		}
+		if !consumed {
+			return newAdminTwoFactorCodeInvalidError(http.StatusUnauthorized)
+		}
+		return nil
+	}
+
+	normalized := normalizeAdminRecoveryCode(code)
+	if normalized == "" {
+		return newAdminTwoFactorCodeInvalidError(http.StatusUnauthorized)
+	}
+	consumed, err := s.adminUsers.ConsumeTwoFactorRecoveryCodeByID(ctx, userRecord.ID, hashValue(normalized))
+	if err != nil {
+		return apperrors.Internal("failed to verify recovery code", err)
+	}
+	if !consumed {
+		return newAdminTwoFactorCodeInvalidError(http.StatusUnauthorized)
+	}
+
+	return nil
+}
+
+func (s *Service) loadAdminTwoFactorUser(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	currentPassword string,
+) (*domain.AdminUserRecord, error) {
+	if err := requireAdminSessionAuthentication(adminUser); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(currentPassword) == "" {
+		return nil, apperrors.BadRequest("current password is required")
+	}
+
+	userRecord, err := s.loadAdminUserRecord(ctx, adminUser.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateAdminCurrentPassword(userRecord, currentPassword); err != nil {
+		return nil, err
+	}
+
+	return userRecord, nil
+}
+
+// generateAdminRecoveryCodes returns the codes to show once, formatted as xxxxx-xxxxx, and the hashes to store.
+func generateAdminRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, adminTwoFactorRecoveryCodeCount)
+	hashes := make([]string, 0, adminTwoFactorRecoveryCodeCount)
+	buffer := make([]byte, adminTwoFactorRecoveryCodeLength*5/8)
+	for range adminTwoFactorRecoveryCodeCount {
+		if _, err := rand.Read(buffer); err != nil {
+			return nil, nil, apperrors.Internal("failed to generate recovery codes", err)
+		}
+
+		raw := strings.ToLower(recoveryCodeEncoding.EncodeToString(buffer))
+		half := adminTwoFactorRecoveryCodeLength / 2
+		codes = append(codes, raw[:half]+"-"+raw[half:])
+		hashes = append(hashes, hashValue(raw))
+	}
+
+	return codes, hashes, nil
+}
+
+func normalizeAdminRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.TrimSpace(code))
+	normalized = strings.ReplaceAll(normalized, "-", "")
+	normalized = strings.ReplaceAll(normalized, " ", "")
+	if len(normalized) != adminTwoFactorRecoveryCodeLength {
+		return ""
+	}
+
+	return normalized
+}
+
+// resolveAdminTwoFactorIssuer names the account in authenticator apps after the site host.
+func resolveAdminTwoFactorIssuer() string {
+	if siteURL, err := resolveSiteURLFn(); err == nil {
+		if parsed, err := url.Parse(siteURL); err == nil && parsed.Hostname() != "" {
+			return parsed.Hostname()
+		}
+	}
+
+	return appconfig.ResolveAdminConfig().JWTIssuer
+}
+
+func newAdminTwoFactorAlreadyEnabledError() error {
+	return apperrors.New(
+		adminCodeTwoFactorAlreadyEnabled,
+		"two-factor authentication is already enabled",
+		http.StatusBadRequest,
+		nil,
+	)
+}
+
+func newAdminTwoFactorNotEnabledError() error {
+	return apperrors.New(
+		adminCodeTwoFactorNotEnabled,
+		"two-factor authentication is not enabled",
+		http.StatusBadRequest,
+		nil,
+	)
+}
+
+func newAdminTwoFactorCodeInvalidError(status int) error {
+	return apperrors.New(adminCodeTwoFactorCodeInvalid, "two-factor code is invalid", status, nil)
+}
+
+func newAdminTwoFactorChallengeExpiredError() error {
+	return apperrors.New(
+		adminCodeTwoFactorChallengeExpired,
+		"two-factor sign-in expired, sign in again",
+		http.StatusUnauthorized,
+		nil,
+	)
+}
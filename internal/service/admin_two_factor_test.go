@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/totp"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func stubAdminTwoFactor(t *testing.T) (*domain.AdminUserRecord, *time.Time) {
+	t.Helper()
+	t.Setenv("JWT_SECRET", "admin-secret")
+
+	previousUsersRepo := adminUsersRepository
+	previousRefreshRepo := adminRefreshTokensRepository
+	previousResolveSiteURLFn := resolveSiteURLFn
+	previousNowUTCFn := nowUTCFn
+	t.Cleanup(func() {
+		adminUsersRepository = previousUsersRepo
+		adminRefreshTokensRepository = previousRefreshRepo
+		resolveSiteURLFn = previousResolveSiteURLFn
+		nowUTCFn = previousNowUTCFn
+	})
+
+	passwordHashBytes, err := bcrypt.GenerateFromPassword([]byte("admin-password"), bcrypt.DefaultCost)
+	if err != nil {
+		t.Fatalf("GenerateFromPassword returned error: %v", err)
+	}
+	user := &domain.AdminUserRecord{
+		AdminUser: domain.AdminUser{
+			ID:    "admin-1",
+			Email: "admin@example.com",
+			Roles: []string{AdminRoleOwner},
+		},
+		PasswordHash:    string(passwordHashBytes),
+		PasswordVersion: 2,
+	}
+
+	now := time.Date(2026, time.March, 15, 20, 0, 0, 0, time.UTC)
+	adminUsersRepository = &adminAuthSessionStubUserRepository{
+		adminAuthEmailChangeStubUserRepository: newAdminAuthEmailChangeStubUserRepository(user),
+	}
+	adminRefreshTokensRepository = &adminAuthEmailChangeStubRefreshRepository{}
+	resolveSiteURLFn = func() (string, error) { return "https://blog.example.com", nil }
+	nowUTCFn = func() time.Time { return now }
+	stubAdminLoginAttempts(t)
+
+	return user, &now
+}
+
+func currentAdminTOTPCode(t *testing.T, secret string, now time.Time) string {
+	t.Helper()
+
+	code, err := totp.Code(secret, totp.Step(now))
+	if err != nil {
+		t.Fatalf("totp.Code returned error: %v", err)
+	}
+	return code
+}
+
+func TestEnableAdminTwoFactorVerifiesFirstCode(t *testing.T) {
+	user, now := stubAdminTwoFactor(t)
+	adminUser := &domain.AdminUser{ID: "admin-1"}
+
+	if _, err := testService().StartAdminTwoFactorEnrollment(context.Background(), adminUser, "wrong-password"); err == nil {
+		t.Fatal("expected wrong password to be rejected")
+	}
+
+	enrollment, err := testService().StartAdminTwoFactorEnrollment(context.Background(), adminUser, "admin-password")
+	if err != nil {
+		t.Fatalf("StartAdminTwoFactorEnrollment returned error: %v", err)
+	}
+	if enrollment.Secret == "" || user.PendingTwoFactor == nil || user.PendingTwoFactor.Secret != enrollment.Secret {
+		t.Fatalf("pending secret not stored: %#v", user.PendingTwoFactor)
+	}
+	wantURI := totp.ProvisioningURI("blog.example.com", "admin@example.com", enrollment.Secret)
+	if enrollment.ProvisioningURI != wantURI {
+		t.Fatalf("ProvisioningURI = %q, want %q", enrollment.ProvisioningURI, wantURI)
+	}
+
+	_, err = testService().EnableAdminTwoFactor(context.Background(), adminUser, "admin-password", "000000")
+	if appErr := apperrors.From(err); appErr.Code != adminCodeTwoFactorCodeInvalid {
+		t.Fatalf("expected invalid code error, got %v", err)
+	}
+
+	result, err := testService().EnableAdminTwoFactor(
+		context.Background(),
+		adminUser,
+		"admin-password",
+		currentAdminTOTPCode(t, enrollment.Secret, *now),
+	)
+	if err != nil {
+		t.Fatalf("EnableAdminTwoFactor returned error: %v", err)
+	}
+	if len(result.Codes) != adminTwoFactorRecoveryCodeCount || len(result.Codes[0]) != adminTwoFactorRecoveryCodeLength+1 {
+		t.Fatalf("unexpected recovery codes: %v", result.Codes)
+	}
+	if user.TwoFactor == nil || user.PendingTwoFactor != nil || user.TwoFactor.LastUsedStep != totp.Step(*now) {
+		t.Fatalf("two-factor not enabled: %#v", user.TwoFactor)
+	}
+	if user.TwoFactor.RecoveryCodeHashes[0] != hashValue(normalizeAdminRecoveryCode(result.Codes[0])) {
+		t.Fatal("expected recovery codes to be stored hashed")
+	}
+	if result.User == nil || result.User.TwoFactorEnabledAt == nil || result.User.RecoveryCodesLeft != adminTwoFactorRecoveryCodeCount {
+		t.Fatalf("unexpected reloaded user: %#v", result.User)
+	}
+
+	_, err = testService().StartAdminTwoFactorEnrollment(context.Background(), adminUser, "admin-password")
+	if appErr := apperrors.From(err); appErr.Code != adminCodeTwoFactorAlreadyEnabled {
+		t.Fatalf("expected already enabled error, got %v", err)
+	}
+}
+
+func TestEnableAdminTwoFactorRequiresUnexpiredEnrollment(t *testing.T) {
+	user, now := stubAdminTwoFactor(t)
+	user.PendingTwoFactor = &domain.AdminPendingTwoFactor{Secret: "JBSWY3DPEHPK3PXP", ExpiresAt: now.Add(-time.Second)}
+
+	_, err := testService().EnableAdminTwoFactor(
+		context.Background(),
+		&domain.AdminUser{ID: "admin-1"},
+		"admin-password",
+		currentAdminTOTPCode(t, "JBSWY3DPEHPK3PXP", *now),
+	)
+	if appErr := apperrors.From(err); appErr.Code != adminCodeTwoFactorEnrollmentMissing {
+		t.Fatalf("expected enrollment required error, got %v", err)
+	}
+}
+
+func TestLoginAdminRequiresSecondFactorWhenEnabled(t *testing.T) {
+	user, now := stubAdminTwoFactor(t)
+	user.TwoFactor = &domain.AdminTwoFactor{
+		Secret:             "JBSWY3DPEHPK3PXP",
+		RecoveryCodeHashes: []string{hashValue("abcdefghij")},
+		EnabledAt:          now.Add(-time.Hour),
+	}
+
+	challenge, err := testService().LoginAdmin(context.Background(), "admin@example.com", "admin-password", true, AdminSessionMetadata{})
+	if err != nil {
+		t.Fatalf("LoginAdmin returned error: %v", err)
+	}
+	if !challenge.MFARequired || challenge.MFAToken == "" || challenge.AccessToken != "" || challenge.Success {
+		t.Fatalf("expected mfa challenge, got %#v", challenge)
+	}
+	if !challenge.MFAExpiresAt.Equal(now.Add(adminMFAPendingTTL)) {
+		t.Fatalf("unexpected challenge expiry %v", challenge.MFAExpiresAt)
+	}
+
+	_, err = testService().CompleteAdminTwoFactorLogin(context.Background(), challenge.MFAToken, "000000", AdminSessionMetadata{})
+	if appErr := apperrors.From(err); appErr.Code != adminCodeTwoFactorCodeInvalid || appErr.HTTPStatus != http.StatusUnauthorized {
+		t.Fatalf("expected invalid code error, got %v", err)
+	}
+
+	code := currentAdminTOTPCode(t, "JBSWY3DPEHPK3PXP", *now)
+	response, err := testService().CompleteAdminTwoFactorLogin(context.Background(), challenge.MFAToken, code, AdminSessionMetadata{})
+	if err != nil {
+		t.Fatalf("CompleteAdminTwoFactorLogin returned error: %v", err)
+	}
+	if !response.Success || response.AccessToken == "" || !response.RememberMe {
+		t.Fatalf("expected remembered session tokens, got %#v", response)
+	}
+
+	if _, err := testService().CompleteAdminTwoFactorLogin(context.Background(), challenge.MFAToken, code, AdminSessionMetadata{}); err == nil {
+		t.Fatal("expected replayed code to be rejected")
+	}
+
+	if _, err := testService().CompleteAdminTwoFactorLogin(context.Background(), challenge.MFAToken, "ABCDE-FGHIJ", AdminSessionMetadata{}); err != nil {
+		t.Fatalf("expected recovery code to complete login, got %v", err)
+	}
+	if len(user.TwoFactor.RecoveryCodeHashes) != 0 {
+		t.Fatal("expected recovery code to be consumed")
+	}
+	if _, err := testService().CompleteAdminTwoFactorLogin(context.Background(), challenge.MFAToken, "abcde-fghij", AdminSessionMetadata{}); err == nil {
+		t.Fatal("expected used recovery code to be rejected")
+	}
+}
+
+func TestCompleteAdminTwoFactorLoginRejectsStaleChallenge(t *testing.T) {
+	user, now := stubAdminTwoFactor(t)
+	user.TwoFactor = &domain.AdminTwoFactor{Secret: "JBSWY3DPEHPK3PXP", EnabledAt: now.Add(-time.Hour)}
+
+	challenge, err := testService().LoginAdmin(context.Background(), "admin@example.com", "admin-password", false, AdminSessionMetadata{})
+	if err != nil {
+		t.Fatalf("LoginAdmin returned error: %v", err)
+	}
+
+	user.PasswordVersion++
+	_, err = testService().CompleteAdminTwoFactorLogin(
+		context.Background(),
+		challenge.MFAToken,
+		currentAdminTOTPCode(t, "JBSWY3DPEHPK3PXP", *now),
+		AdminSessionMetadata{},
+	)
+	if appErr := apperrors.From(err); appErr.Code != adminCodeTwoFactorChallengeExpired {
+		t.Fatalf("expected challenge expired after password change, got %v", err)
+	}
+
+	if _, err := testService().CompleteAdminTwoFactorLogin(context.Background(), "not-a-token", "123456", AdminSessionMetadata{}); apperrors.From(err).Code != adminCodeTwoFactorChallengeExpired {
+		t.Fatalf("expected challenge expired for invalid token, got %v", err)
+	}
+}
+
+func TestDisableAndRegenerateAdminTwoFactor(t *testing.T) {
+	user, now := stubAdminTwoFactor(t)
+	adminUser := &domain.AdminUser{ID: "admin-1"}
+
+	if _, err := testService().RegenerateAdminTwoFactorRecoveryCodes(context.Background(), adminUser, "admin-password"); apperrors.From(err).Code != adminCodeTwoFactorNotEnabled {
+		t.Fatalf("expected not enabled error, got %v", err)
+	}
+
+	user.TwoFactor = &domain.AdminTwoFactor{
+		Secret:             "JBSWY3DPEHPK3PXP",
+		RecoveryCodeHashes: []string{hashValue("abcdefghij")},
+		EnabledAt:          *now,
+	}
+	regenerated, err := testService().RegenerateAdminTwoFactorRecoveryCodes(context.Background(), adminUser, "admin-password")
+	if err != nil {
+		t.Fatalf("RegenerateAdminTwoFactorRecoveryCodes returned error: %v", err)
+	}
+	if len(regenerated.Codes) != adminTwoFactorRecoveryCodeCount || user.TwoFactor.RecoveryCodeHashes[0] == hashValue("abcdefghij") {
+		t.Fatalf("expected fresh recovery codes, got %v", user.TwoFactor.RecoveryCodeHashes)
+	}
+
+	if _, err := testService().DisableAdminTwoFactor(context.Background(), adminUser, ""); err == nil {
+		t.Fatal("expected missing password to be rejected")
+	}
+	disabled, err := testService().DisableAdminTwoFactor(context.Background(), adminUser, "admin-password")
+	if err != nil {
+		t.Fatalf("DisableAdminTwoFactor returned error: %v", err)
+	}
+	if user.TwoFactor != nil || disabled.TwoFactorEnabledAt != nil {
+		t.Fatalf("expected two-factor to be disabled, got %#v", user.TwoFactor)
+	}
+}
@@ -0,0 +1,566 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/repository"
+	adminmailpkg "suaybsimsek.com/blog-api/pkg/adminmail"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpauth"
+	newsletterpkg "suaybsimsek.com/blog-api/pkg/newsletter"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+type AdminInvitationValidationResult struct {
+	Status string
+	Locale string
+	Email  string
+}
+
+type AdminInvitationAcceptResult struct {
+	Success bool
+	Locale  string
+}
+
+const (
+	adminInvitationTTL = 7 * 24 * time.Hour
+
+	adminCodeUserNotFound           = "ADMIN_USER_NOT_FOUND"
+	adminCodeUserEmailInvalid       = "ADMIN_USER_EMAIL_INVALID"
+	adminCodeUserEmailTaken         = "ADMIN_USER_EMAIL_TAKEN"
+	adminCodeUserRolesInvalid       = "ADMIN_USER_ROLES_INVALID"
+	adminCodeUserSelfChange         = "ADMIN_USER_SELF_CHANGE"
+	adminCodeUserNotDisabled        = "ADMIN_USER_NOT_DISABLED"
+	adminCodeInvitationTokenInvalid = "ADMIN_INVITATION_TOKEN_INVALID"
+	adminCodeInvitationTokenExpired = "ADMIN_INVITATION_TOKEN_EXPIRED"
+	adminCodeBootstrapOwnerExists   = "ADMIN_BOOTSTRAP_OWNER_EXISTS"
+)
+
+var sendAdminInvitationEmailFn = sendAdminInvitationEmail
+
+// ListAdminUsers returns every admin account, including pending invitations and disabled accounts.
+func (s *Service) ListAdminUsers(ctx context.Context, adminUser *domain.AdminUser) ([]domain.AdminUser, error) {
+	if err := RequireAdminPermission(adminUser, AdminPermissionUsersManage); err != nil {
+		return nil, err
+	}
+
+	records, err := s.adminUsers.List(ctx)
+	if err != nil {
+		return nil, apperrors.Internal("failed to list admin users", err)
+	}
+
+	items := make([]domain.AdminUser, 0, len(records))
+	for _, record := range records {
+		items = append(items, record.AdminUser)
+	}
+	return items, nil
+}
+
+// InviteAdminUser creates an invited admin with the given roles and emails a single-use link that expires after
+// adminInvitationTTL. Inviting an address that still has a pending invitation replaces its roles and link.
+func (s *Service) InviteAdminUser(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	input domain.AdminInvitationInput,
+) (*domain.AdminUser, error) {
+	if err := RequireAdminPermission(adminUser, AdminPermissionUsersManage); err != nil {
+		return nil, err
+	}
+
+	resolvedEmail, err := newsletterpkg.NormalizeSubscriberEmail(input.Email)
+	if err != nil {
+		return nil, apperrors.New(adminCodeUserEmailInvalid, "email address is invalid", http.StatusBadRequest, nil)
+	}
+	roles, err := resolveAdminUserRoles(input.Roles)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.findAdminUserRecordByEmailAnyStatus(ctx, resolvedEmail)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && existing.Status != domain.AdminUserStatusInvited {
+		return nil, apperrors.New(adminCodeUserEmailTaken, "admin email is already in use", http.StatusConflict, nil)
+	}
+
+	siteURL, err := resolveSiteURLFn()
+	if err != nil {
+		return nil, apperrors.Config("admin invitation site url is not configured", err)
+	}
+	mailCfg, err := resolveMailConfigFn()
+	if err != nil {
+		return nil, apperrors.Config("admin invitation mail transport is not configured", err)
+	}
+	token, err := generateConfirmTokenFn()
+	if err != nil {
+		return nil, apperrors.Internal("failed to issue admin invitation token", err)
+	}
+
+	now := nowUTCFn()
+	resolvedLocale := newsletterpkg.ResolveLocale(input.Locale, "")
+	invitationURL, err := buildAdminInvitationURL(siteURL, token, resolvedLocale)
+	if err != nil {
+		return nil, apperrors.Config("admin invitation url is invalid", err)
+	}
+	pending := domain.AdminPendingInvitation{
+		TokenHash: hashValue(token),
+		Locale:    resolvedLocale,
+		InvitedBy: strings.TrimSpace(adminUser.ID),
+		InvitedAt: now,
+		ExpiresAt: now.Add(adminInvitationTTL),
+	}
+
+	userID, err := s.storeAdminInvitation(ctx, existing, resolvedEmail, strings.TrimSpace(input.Name), roles, pending)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sendAdminInvitationEmailFn(mailCfg, resolvedEmail, invitationURL, resolvedLocale, siteURL); err != nil {
+		return nil, apperrors.ServiceUnavailable("failed to send admin invitation email", err)
+	}
+
+	return s.loadManagedAdminUser(ctx, userID)
+}
+
+// ValidateAdminInvitationToken reports whether an invitation link can still be accepted.
+func (s *Service) ValidateAdminInvitationToken(
+	ctx context.Context,
+	token string,
+	localeHint string,
+) (*AdminInvitationValidationResult, error) {
+	resolvedLocale := newsletterpkg.ResolveLocale(localeHint, "")
+	userRecord, err := s.findAdminInvitationRecord(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if userRecord == nil {
+		return &AdminInvitationValidationResult{
+			Status: string(adminmailpkg.StatusInvalidLink),
+			Locale: resolvedLocale,
+		}, nil
+	}
+
+	pending := userRecord.PendingInvitation
+	if pending.Locale != "" {
+		resolvedLocale = newsletterpkg.ResolveLocale(pending.Locale, "")
+	}
+	if nowUTCFn().After(pending.ExpiresAt) {
+		return &AdminInvitationValidationResult{
+			Status: string(adminmailpkg.StatusExpired),
+			Locale: resolvedLocale,
+		}, nil
+	}
+
+	return &AdminInvitationValidationResult{
+		Status: string(adminmailpkg.StatusSuccess),
+		Locale: resolvedLocale,
+		Email:  userRecord.Email,
+	}, nil
+}
+
+// AcceptAdminInvitation activates an invited admin with the password they chose.
+func (s *Service) AcceptAdminInvitation(
+	ctx context.Context,
+	token string,
+	newPassword string,
+	confirmPassword string,
+	localeHint string,
+) (*AdminInvitationAcceptResult, error) {
+	userRecord, err := s.loadPendingAdminInvitation(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateAdminPasswordResetPassword(newPassword, confirmPassword); err != nil {
+		return nil, err
+	}
+
+	resolvedLocale := newsletterpkg.ResolveLocale(localeHint, "")
+	if userRecord.PendingInvitation.Locale != "" {
+		resolvedLocale = newsletterpkg.ResolveLocale(userRecord.PendingInvitation.Locale, "")
+	}
+
+	passwordHashBytes, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, apperrors.Internal("failed to hash admin password", err)
+	}
+	if err := s.acceptAdminInvitationRecord(ctx, userRecord.ID, string(passwordHashBytes)); err != nil {
+		return nil, err
+	}
+
+	return &AdminInvitationAcceptResult{Success: true, Locale: resolvedLocale}, nil
+}
+
+// AcceptAdminInvitationWithGoogle activates an invited admin and links the Google account used to accept.
+func (s *Service) AcceptAdminInvitationWithGoogle(
+	ctx context.Context,
+	token string,
+	identity *AdminGoogleIdentity,
+) (*domain.AdminUser, error) {
+	userRecord, err := s.loadPendingAdminInvitation(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	resolvedSubject, _, err := normalizeAdminGoogleIdentity(identity)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureAdminGoogleAccountLinkable(ctx, userRecord, userRecord.ID, resolvedSubject); err != nil {
+		return nil, err
+	}
+	if err := s.acceptAdminInvitationRecord(ctx, userRecord.ID, ""); err != nil {
+		return nil, err
+	}
+
+	return s.LinkAdminGoogleAccount(ctx, userRecord.ID, identity)
+}
+
+// AcceptAdminInvitationWithGithub activates an invited admin and links the GitHub account used to accept.
+func (s *Service) AcceptAdminInvitationWithGithub(
+	ctx context.Context,
+	token string,
+	identity *AdminGithubIdentity,
+) (*domain.AdminUser, error) {
+	userRecord, err := s.loadPendingAdminInvitation(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	resolvedSubject, _, err := normalizeAdminGithubIdentity(identity)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureAdminGithubAccountLinkable(ctx, userRecord, userRecord.ID, resolvedSubject); err != nil {
+		return nil, err
+	}
+	if err := s.acceptAdminInvitationRecord(ctx, userRecord.ID, ""); err != nil {
+		return nil, err
+	}
+
+	return s.LinkAdminGithubAccount(ctx, userRecord.ID, identity)
+}
+
+// DisableAdminUser blocks an admin from signing in, revokes their sessions and personal access tokens and cancels a
+// pending invitation.
+func (s *Service) DisableAdminUser(ctx context.Context, adminUser *domain.AdminUser, id string) (*domain.AdminUser, error) {
+	target, err := s.loadManagedAdminUserTarget(ctx, adminUser, id)
+	if err != nil {
+		return nil, err
+	}
+	if target.Status == domain.AdminUserStatusDisabled {
+		return &target.AdminUser, nil
+	}
+
+	if err := s.adminUsers.DisableByID(ctx, target.ID); err != nil && !errors.Is(err, repository.ErrAdminUserNotFound) {
+		return nil, apperrors.Internal("failed to disable admin user", err)
+	}
+	if err := s.adminRefreshTokens.RevokeAllByUserID(ctx, target.ID, nowUTCFn()); err != nil {
+		return nil, toAdminSessionError(err)
+	}
+	if err := s.revokeAllAdminAccessTokens(ctx, target.ID); err != nil {
+		return nil, err
+	}
+
+	return s.loadManagedAdminUser(ctx, target.ID)
+}
+
+// EnableAdminUser lets a disabled admin sign in again.
+func (s *Service) EnableAdminUser(ctx context.Context, adminUser *domain.AdminUser, id string) (*domain.AdminUser, error) {
+	target, err := s.loadManagedAdminUserTarget(ctx, adminUser, id)
+	if err != nil {
+		return nil, err
+	}
+	if target.Status != domain.AdminUserStatusDisabled {
+		return nil, apperrors.New(adminCodeUserNotDisabled, "admin user is not disabled", http.StatusBadRequest, nil)
+	}
+
+	if err := s.adminUsers.EnableByID(ctx, target.ID); err != nil && !errors.Is(err, repository.ErrAdminUserNotFound) {
+		return nil, apperrors.Internal("failed to enable admin user", err)
+	}
+
+	return s.loadManagedAdminUser(ctx, target.ID)
+}
+
+// UpdateAdminUserRoles replaces the roles of another admin. Changes apply to their next request.
+func (s *Service) UpdateAdminUserRoles(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	id string,
+	roles []string,
+) (*domain.AdminUser, error) {
+	target, err := s.loadManagedAdminUserTarget(ctx, adminUser, id)
+	if err != nil {
+		return nil, err
+	}
+	resolvedRoles, err := resolveAdminUserRoles(roles)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.adminUsers.UpdateRolesByID(ctx, target.ID, resolvedRoles); err != nil {
+		if errors.Is(err, repository.ErrAdminUserNotFound) {
+			return nil, newAdminUserNotFoundError()
+		}
+		return nil, apperrors.Internal("failed to update admin roles", err)
+	}
+
+	return s.loadManagedAdminUser(ctx, target.ID)
+}
+
+// BootstrapAdminOwner creates the first owner account. It refuses to run once an active owner exists.
+func (s *Service) BootstrapAdminOwner(ctx context.Context, email, name, password string) (*domain.AdminUser, error) {
+	resolvedEmail, err := newsletterpkg.NormalizeSubscriberEmail(email)
+	if err != nil {
+		return nil, apperrors.New(adminCodeUserEmailInvalid, "email address is invalid", http.StatusBadRequest, nil)
+	}
+	if err := validateAdminPasswordResetPassword(password, password); err != nil {
+		return nil, err
+	}
+
+	records, err := s.adminUsers.List(ctx)
+	if err != nil {
+		return nil, apperrors.Internal("failed to list admin users", err)
+	}
+	for _, record := range records {
+		if record.Status == domain.AdminUserStatusActive &&
+			slices.Contains(ResolveAdminPermissions(&record.AdminUser), AdminPermissionUsersManage) {
+			return nil, apperrors.New(adminCodeBootstrapOwnerExists, "an admin owner already exists", http.StatusConflict, nil)
+		}
+	}
+
+	passwordHashBytes, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, apperrors.Internal("failed to hash admin password", err)
+	}
+	userID, err := httpauth.GenerateOpaqueToken(18)
+	if err != nil {
+		return nil, apperrors.Internal("failed to create admin user", err)
+	}
+
+	record := domain.AdminUserRecord{
+		AdminUser: domain.AdminUser{
+			ID:     userID,
+			Name:   strings.TrimSpace(name),
+			Email:  resolvedEmail,
+			Roles:  []string{AdminRoleOwner},
+			Status: domain.AdminUserStatusActive,
+		},
+		PasswordHash:    string(passwordHashBytes),
+		PasswordVersion: 1,
+	}
+	if err := s.adminUsers.Create(ctx, record); err != nil {
+		if errors.Is(err, repository.ErrAdminEmailAlreadyExists) {
+			return nil, apperrors.New(adminCodeUserEmailTaken, "admin email is already in use", http.StatusConflict, nil)
+		}
+		return nil, apperrors.Internal("failed to create admin user", err)
+	}
+
+	return s.loadManagedAdminUser(ctx, userID)
+}
+
+func (s *Service) storeAdminInvitation(
+	ctx context.Context,
+	existing *domain.AdminUserRecord,
+	email string,
+	name string,
+	roles []string,
+	pending domain.AdminPendingInvitation,
+) (string, error) {
+	if existing != nil {
+		if err := s.adminUsers.RefreshInvitationByID(ctx, existing.ID, roles, pending); err != nil {
+			if errors.Is(err, repository.ErrAdminUserNotFound) {
+				return "", apperrors.New(adminCodeUserEmailTaken, "admin email is already in use", http.StatusConflict, nil)
+			}
+			return "", apperrors.Internal("failed to store admin invitation", err)
+		}
+		return existing.ID, nil
+	}
+
+	userID, err := httpauth.GenerateOpaqueToken(18)
+	if err != nil {
+		return "", apperrors.Internal("failed to create admin user", err)
+	}
+	record := domain.AdminUserRecord{
+		AdminUser: domain.AdminUser{
+			ID:     userID,
+			Name:   name,
+			Email:  email,
+			Roles:  roles,
+			Status: domain.AdminUserStatusInvited,
+		},
+		PendingInvitation: &pending,
+	}
+	if err := s.adminUsers.Create(ctx, record); err != nil {
+		if errors.Is(err, repository.ErrAdminEmailAlreadyExists) {
+			return "", apperrors.New(adminCodeUserEmailTaken, "admin email is already in use", http.StatusConflict, nil)
+		}
+		return "", apperrors.Internal("failed to store admin invitation", err)
+	}
+
+	return userID, nil
+}
+
+func (s *Service) loadManagedAdminUserTarget(
+	ctx context.Context,
+	adminUser *domain.AdminUser,
+	id string,
+) (*domain.AdminUserRecord, error) {
+	if err := RequireAdminPermission(adminUser, AdminPermissionUsersManage); err != nil {
+		return nil, err
+	}
+
+	resolvedID := strings.TrimSpace(id)
+	if resolvedID == "" {
+		return nil, newAdminUserNotFoundError()
+	}
+	// Owners cannot lock themselves out, which also keeps at least one owner enabled.
+	if resolvedID == strings.TrimSpace(adminUser.ID) {
+		return nil, apperrors.New(adminCodeUserSelfChange, "admins cannot change their own access", http.StatusBadRequest, nil)
+	}
+
+	target, err := s.adminUsers.FindByIDAnyStatus(ctx, resolvedID)
+	if err != nil {
+		return nil, apperrors.Internal(adminLoadAdminUserMessage, err)
+	}
+	if target == nil {
+		return nil, newAdminUserNotFoundError()
+	}
+
+	return target, nil
+}
+
+func (s *Service) loadManagedAdminUser(ctx context.Context, id string) (*domain.AdminUser, error) {
+	record, err := s.adminUsers.FindByIDAnyStatus(ctx, id)
+	if err != nil {
+		return nil, apperrors.Internal(adminLoadAdminUserMessage, err)
+	}
+	if record == nil {
+		return nil, newAdminUserNotFoundError()
+	}
+
+	return &record.AdminUser, nil
+}
+
+func (s *Service) findAdminUserRecordByEmailAnyStatus(ctx context.Context, email string) (*domain.AdminUserRecord, error) {
+	records, err := s.adminUsers.List(ctx)
+	if err != nil {
+		return nil, apperrors.Internal("failed to list admin users", err)
+	}
+	for index := range records {
+		if records[index].Email == email {
+			return &records[index], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *Service) findAdminInvitationRecord(ctx context.Context, token string) (*domain.AdminUserRecord, error) {
+	resolvedToken := strings.TrimSpace(token)
+	if resolvedToken == "" {
+		return nil, nil
+	}
+
+	userRecord, err := s.adminUsers.FindByPendingInvitationTokenHash(ctx, hashValue(resolvedToken))
+	if err != nil {
+		return nil, apperrors.Internal("failed to load admin invitation", err)
+	}
+	if userRecord == nil || userRecord.PendingInvitation == nil {
+		return nil, nil
+	}
+
+	return userRecord, nil
+}
+
+func (s *Service) loadPendingAdminInvitation(ctx context.Context, token string) (*domain.AdminUserRecord, error) {
+	userRecord, err := s.findAdminInvitationRecord(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if userRecord == nil {
+		return nil, apperrors.New(adminCodeInvitationTokenInvalid, "admin invitation is invalid", http.StatusBadRequest, nil)
+	}
+	if nowUTCFn().After(userRecord.PendingInvitation.ExpiresAt) {
+		return nil, apperrors.New(adminCodeInvitationTokenExpired, "admin invitation has expired", http.StatusBadRequest, nil)
+	}
+
+	return userRecord, nil
+}
+
+func (s *Service) acceptAdminInvitationRecord(ctx context.Context, id, passwordHash string) error {
+	if err := s.adminUsers.AcceptInvitationByID(ctx, id, passwordHash); err != nil {
+		if errors.Is(err, repository.ErrAdminUserNotFound) {
+			return apperrors.New(adminCodeInvitationTokenInvalid, "admin invitation is invalid", http.StatusBadRequest, nil)
+		}
+		return apperrors.Internal("failed to accept admin invitation", err)
+	}
+
+	return nil
+}
+
+// resolveAdminUserRoles normalizes requested roles and rejects empty or unknown ones.
+func resolveAdminUserRoles(roles []string) ([]string, error) {
+	resolved := make([]string, 0, len(roles))
+	for _, role := range roles {
+		normalized := normalizeAdminRole(role)
+		if _, ok := adminRolePermissions[normalized]; !ok {
+			return nil, apperrors.New(
+				adminCodeUserRolesInvalid,
+				fmt.Sprintf("unknown admin role %q", strings.TrimSpace(role)),
+				http.StatusBadRequest,
+				nil,
+			)
+		}
+		if !slices.Contains(resolved, normalized) {
+			resolved = append(resolved, normalized)
+		}
+	}
+	if len(resolved) == 0 {
+		return nil, apperrors.New(adminCodeUserRolesInvalid, "at least one admin role is required", http.StatusBadRequest, nil)
+	}
+
+	return resolved, nil
+}
+
+func newAdminUserNotFoundError() error {
+	return apperrors.New(adminCodeUserNotFound, "admin user not found", http.StatusNotFound, nil)
+}
+
+func buildAdminInvitationURL(siteURL, token, locale string) (string, error) {
+	parsed, err := url.Parse(siteURL)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return "", errors.New("invalid SITE_URL")
+	}
+
+	resolvedLocale := newsletterpkg.ResolveLocale(locale, "")
+	parsed.Path = strings.TrimRight(parsed.Path, "/") + "/" + resolvedLocale + "/admin/accept-invitation"
+	query := parsed.Query()
+	query.Set("token", strings.TrimSpace(token))
+	parsed.RawQuery = query.Encode()
+
+	return parsed.String(), nil
+}
+
+func sendAdminInvitationEmail(
+	cfg appconfig.MailConfig,
+	recipientEmail,
+	invitationURL,
+	locale,
+	siteURL string,
+) error {
+	subject, htmlBody, err := adminmailpkg.InvitationEmail(locale, invitationURL, siteURL)
+	if err != nil {
+		return fmt.Errorf("build admin invitation email failed: %w", err)
+	}
+
+	return newsletterpkg.SendHTMLEmail(cfg, recipientEmail, subject, htmlBody, nil)
+}
@@ -0,0 +1,326 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+var adminUserManagementOwner = &domain.AdminUser{ID: "owner-1", Roles: []string{AdminRoleOwner}}
+
+func stubAdminUserManagement(t *testing.T, records ...*domain.AdminUserRecord) (
+	*adminAuthEmailChangeStubUserRepository,
+	*adminAuthEmailChangeStubRefreshRepository,
+	*[]string,
+) {
+	t.Helper()
+
+	previousUsersRepo := adminUsersRepository
+	previousRefreshRepo := adminRefreshTokensRepository
+	previousResolveSiteURLFn := resolveSiteURLFn
+	previousResolveMailConfigFn := resolveMailConfigFn
+	previousGenerateConfirmTokenFn := generateConfirmTokenFn
+	previousNowUTCFn := nowUTCFn
+	previousSendInvitation := sendAdminInvitationEmailFn
+	t.Cleanup(func() {
+		adminUsersRepository = previousUsersRepo
+		adminRefreshTokensRepository = previousRefreshRepo
+		resolveSiteURLFn = previousResolveSiteURLFn
+		resolveMailConfigFn = previousResolveMailConfigFn
+		generateConfirmTokenFn = previousGenerateConfirmTokenFn
+		nowUTCFn = previousNowUTCFn
+		sendAdminInvitationEmailFn = previousSendInvitation
+	})
+
+	repo := newAdminAuthEmailChangeStubUserRepository(nil)
+	for _, record := range records {
+		repo.byID[record.ID] = record
+		repo.byEmail[record.Email] = record
+	}
+	refreshRepo := &adminAuthEmailChangeStubRefreshRepository{}
+	adminUsersRepository = repo
+	adminRefreshTokensRepository = refreshRepo
+	resolveSiteURLFn = func() (string, error) { return "https://example.com", nil }
+	resolveMailConfigFn = func() (appconfig.MailConfig, error) { return appconfig.MailConfig{}, nil }
+	generateConfirmTokenFn = func() (string, error) { return "invite-token", nil }
+	fixedNow := time.Date(2026, time.March, 15, 20, 0, 0, 0, time.UTC)
+	nowUTCFn = func() time.Time { return fixedNow }
+
+	sentURLs := []string{}
+	sendAdminInvitationEmailFn = func(_ appconfig.MailConfig, _, invitationURL, _, _ string) error {
+		sentURLs = append(sentURLs, invitationURL)
+		return nil
+	}
+
+	return repo, refreshRepo, &sentURLs
+}
+
+func TestInviteAdminUserCreatesInvitedAdminAndSendsLink(t *testing.T) {
+	repo, _, sentURLs := stubAdminUserManagement(t)
+
+	invited, err := testService().InviteAdminUser(context.Background(), adminUserManagementOwner, domain.AdminInvitationInput{
+		Email:  " Editor@Example.com ",
+		Name:   "Editor",
+		Roles:  []string{" Editor ", "editor"},
+		Locale: "tr",
+	})
+	if err != nil {
+		t.Fatalf("InviteAdminUser returned error: %v", err)
+	}
+
+	if invited.Email != "editor@example.com" || invited.Status != domain.AdminUserStatusInvited {
+		t.Fatalf("unexpected invited admin: %#v", invited)
+	}
+	if len(invited.Roles) != 1 || invited.Roles[0] != AdminRoleEditor {
+		t.Fatalf("expected normalized editor role, got %v", invited.Roles)
+	}
+	record := repo.byID[invited.ID]
+	if record.PendingInvitation == nil || record.PendingInvitation.TokenHash != hashValue("invite-token") ||
+		record.PendingInvitation.InvitedBy != "owner-1" {
+		t.Fatalf("pending invitation not stored: %#v", record.PendingInvitation)
+	}
+	if len(*sentURLs) != 1 || (*sentURLs)[0] != "https://example.com/tr/admin/accept-invitation?token=invite-token" {
+		t.Fatalf("unexpected invitation urls: %v", *sentURLs)
+	}
+
+	generateConfirmTokenFn = func() (string, error) { return "second-token", nil }
+	reinvited, err := testService().InviteAdminUser(context.Background(), adminUserManagementOwner, domain.AdminInvitationInput{
+		Email: "editor@example.com",
+		Roles: []string{AdminRoleModerator},
+	})
+	if err != nil {
+		t.Fatalf("InviteAdminUser re-invite returned error: %v", err)
+	}
+	if reinvited.ID != invited.ID || reinvited.Roles[0] != AdminRoleModerator {
+		t.Fatalf("expected re-invite to update the same admin, got %#v", reinvited)
+	}
+	if record.PendingInvitation.TokenHash != hashValue("second-token") {
+		t.Fatal("expected re-invite to replace the invitation token")
+	}
+}
+
+func TestInviteAdminUserRejectsInvalidRequests(t *testing.T) {
+	stubAdminUserManagement(t, &domain.AdminUserRecord{
+		AdminUser: domain.AdminUser{ID: "admin-2", Email: "taken@example.com", Status: domain.AdminUserStatusActive},
+	})
+
+	tests := []struct {
+		name      string
+		adminUser *domain.AdminUser
+		input     domain.AdminInvitationInput
+		code      string
+	}{
+		{
+			name:      "editor",
+			adminUser: &domain.AdminUser{ID: "editor-1", Roles: []string{AdminRoleEditor}},
+			input:     domain.AdminInvitationInput{Email: "new@example.com", Roles: []string{AdminRoleEditor}},
+			code:      adminCodeForbidden,
+		},
+		{
+			name:      "invalid email",
+			adminUser: adminUserManagementOwner,
+			input:     domain.AdminInvitationInput{Email: "invalid", Roles: []string{AdminRoleEditor}},
+			code:      adminCodeUserEmailInvalid,
+		},
+		{
+			name:      "unknown role",
+			adminUser: adminUserManagementOwner,
+			input:     domain.AdminInvitationInput{Email: "new@example.com", Roles: []string{"superuser"}},
+			code:      adminCodeUserRolesInvalid,
+		},
+		{
+			name:      "missing roles",
+			adminUser: adminUserManagementOwner,
+			input:     domain.AdminInvitationInput{Email: "new@example.com"},
+			code:      adminCodeUserRolesInvalid,
+		},
+		{
+			name:      "taken email",
+			adminUser: adminUserManagementOwner,
+			input:     domain.AdminInvitationInput{Email: "taken@example.com", Roles: []string{AdminRoleEditor}},
+			code:      adminCodeUserEmailTaken,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := testService().InviteAdminUser(context.Background(), tc.adminUser, tc.input)
+			if appErr := apperrors.From(err); appErr.Code != tc.code {
+				t.Fatalf("expected %s, got %v", tc.code, err)
+			}
+		})
+	}
+}
+
+func TestAcceptAdminInvitationActivatesAdminWithPassword(t *testing.T) {
+	expiresAt := time.Date(2026, time.March, 20, 20, 0, 0, 0, time.UTC)
+	record := &domain.AdminUserRecord{
+		AdminUser: domain.AdminUser{
+			ID:     "admin-2",
+			Email:  "editor@example.com",
+			Roles:  []string{AdminRoleEditor},
+			Status: domain.AdminUserStatusInvited,
+		},
+		PendingInvitation: &domain.AdminPendingInvitation{
+			TokenHash: hashValue("invite-token"),
+			Locale:    "tr",
+			ExpiresAt: expiresAt,
+		},
+	}
+	stubAdminUserManagement(t, record)
+
+	validation, err := testService().ValidateAdminInvitationToken(context.Background(), "invite-token", "en")
+	if err != nil {
+		t.Fatalf("ValidateAdminInvitationToken returned error: %v", err)
+	}
+	if validation.Status != "success" || validation.Locale != "tr" || validation.Email != "editor@example.com" {
+		t.Fatalf("unexpected validation result: %#v", validation)
+	}
+
+	result, err := testService().AcceptAdminInvitation(context.Background(), "invite-token", "new-password", "new-password", "en")
+	if err != nil {
+		t.Fatalf("AcceptAdminInvitation returned error: %v", err)
+	}
+	if !result.Success || result.Locale != "tr" {
+		t.Fatalf("unexpected accept result: %#v", result)
+	}
+	if record.Status != domain.AdminUserStatusActive || record.PendingInvitation != nil {
+		t.Fatalf("expected active admin without invitation, got %#v", record)
+	}
+	if bcrypt.CompareHashAndPassword([]byte(record.PasswordHash), []byte("new-password")) != nil {
+		t.Fatal("expected password hash to match the chosen password")
+	}
+
+	_, err = testService().AcceptAdminInvitation(context.Background(), "invite-token", "new-password", "new-password", "en")
+	if appErr := apperrors.From(err); appErr.Code != adminCodeInvitationTokenInvalid {
+		t.Fatalf("expected reused invitation to be invalid, got %v", err)
+	}
+}
+
+func TestAcceptAdminInvitationRejectsExpiredInvitation(t *testing.T) {
+	stubAdminUserManagement(t, &domain.AdminUserRecord{
+		AdminUser: domain.AdminUser{ID: "admin-2", Email: "editor@example.com", Status: domain.AdminUserStatusInvited},
+		PendingInvitation: &domain.AdminPendingInvitation{
+			TokenHash: hashValue("invite-token"),
+			ExpiresAt: time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC),
+		},
+	})
+
+	validation, err := testService().ValidateAdminInvitationToken(context.Background(), "invite-token", "en")
+	if err != nil || validation.Status != "expired" {
+		t.Fatalf("expected expired validation, got %#v %v", validation, err)
+	}
+
+	_, err = testService().AcceptAdminInvitation(context.Background(), "invite-token", "new-password", "new-password", "en")
+	if appErr := apperrors.From(err); appErr.Code != adminCodeInvitationTokenExpired {
+		t.Fatalf("expected expired invitation error, got %v", err)
+	}
+}
+
+func TestManageAdminUserAccess(t *testing.T) {
+	record := &domain.AdminUserRecord{
+		AdminUser: domain.AdminUser{
+			ID:     "admin-2",
+			Email:  "editor@example.com",
+			Roles:  []string{AdminRoleEditor},
+			Status: domain.AdminUserStatusActive,
+		},
+	}
+	_, refreshRepo, _ := stubAdminUserManagement(t, record)
+	tokens := stubAdminAccessTokensOwnedBy(t, "admin-2")
+
+	if _, err := testService().DisableAdminUser(context.Background(), adminUserManagementOwner, "owner-1"); apperrors.From(err).Code != adminCodeUserSelfChange {
+		t.Fatalf("expected self change error, got %v", err)
+	}
+	if _, err := testService().EnableAdminUser(context.Background(), adminUserManagementOwner, "admin-2"); apperrors.From(err).Code != adminCodeUserNotDisabled {
+		t.Fatalf("expected not disabled error, got %v", err)
+	}
+
+	disabled, err := testService().DisableAdminUser(context.Background(), adminUserManagementOwner, "admin-2")
+	if err != nil {
+		t.Fatalf("DisableAdminUser returned error: %v", err)
+	}
+	if disabled.Status != domain.AdminUserStatusDisabled {
+		t.Fatalf("expected disabled admin, got %#v", disabled)
+	}
+	if len(refreshRepo.revokedUserIDs) != 1 || refreshRepo.revokedUserIDs[0] != "admin-2" {
+		t.Fatalf("expected sessions to be revoked, got %v", refreshRepo.revokedUserIDs)
+	}
+	// Re-enabling the admin must not bring back tokens issued before the account was disabled.
+	if len(tokens.records) != 0 {
+		t.Fatalf("expected access tokens to be revoked, got %+v", tokens.records)
+	}
+
+	enabled, err := testService().EnableAdminUser(context.Background(), adminUserManagementOwner, "admin-2")
+	if err != nil || enabled.Status != domain.AdminUserStatusActive {
+		t.Fatalf("expected enabled admin, got %#v %v", enabled, err)
+	}
+
+	updated, err := testService().UpdateAdminUserRoles(context.Background(), adminUserManagementOwner, "admin-2", []string{"Moderator"})
+	if err != nil {
+		t.Fatalf("UpdateAdminUserRoles returned error: %v", err)
+	}
+	if len(updated.Roles) != 1 || updated.Roles[0] != AdminRoleModerator {
+		t.Fatalf("unexpected roles: %v", updated.Roles)
+	}
+
+	_, err = testService().UpdateAdminUserRoles(context.Background(), adminUserManagementOwner, "missing", []string{AdminRoleEditor})
+	if appErr := apperrors.From(err); appErr.Code != adminCodeUserNotFound || appErr.HTTPStatus != http.StatusNotFound {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestBootstrapAdminOwner(t *testing.T) {
+	repo, _, _ := stubAdminUserManagement(t, &domain.AdminUserRecord{
+		AdminUser: domain.AdminUser{
+			ID:     "owner-0",
+			Email:  "disabled-owner@example.com",
+			Roles:  []string{AdminRoleOwner},
+			Status: domain.AdminUserStatusDisabled,
+		},
+	})
+
+	owner, err := testService().BootstrapAdminOwner(context.Background(), "Owner@Example.com", "Owner", "owner-password")
+	if err != nil {
+		t.Fatalf("BootstrapAdminOwner returned error: %v", err)
+	}
+	if owner.Email != "owner@example.com" || owner.Status != domain.AdminUserStatusActive ||
+		len(owner.Roles) != 1 || owner.Roles[0] != AdminRoleOwner {
+		t.Fatalf("unexpected owner: %#v", owner)
+	}
+	if repo.byID[owner.ID].PasswordVersion != 1 {
+		t.Fatalf("expected password version 1, got %d", repo.byID[owner.ID].PasswordVersion)
+	}
+
+	_, err = testService().BootstrapAdminOwner(context.Background(), "second@example.com", "Second", "owner-password")
+	if appErr := apperrors.From(err); appErr.Code != adminCodeBootstrapOwnerExists {
+		t.Fatalf("expected owner exists error, got %v", err)
+	}
+}
+
+func TestBuildAdminInvitationURL(t *testing.T) {
+	got, err := buildAdminInvitationURL("https://example.com/blog/", "token value", "tr")
+	if err != nil {
+		t.Fatalf("buildAdminInvitationURL returned error: %v", err)
+	}
+
+	parsed, err := url.Parse(got)
+	if err != nil {
+		t.Fatalf("url.Parse returned error: %v", err)
+	}
+	if parsed.Path != "/blog/tr/admin/accept-invitation" || parsed.Query().Get("token") != "token value" {
+		t.Fatalf("unexpected invitation url: %s", got)
+	}
+
+	if _, err := buildAdminInvitationURL("not a url", "token", "en"); err == nil {
+		t.Fatal("expected invalid site url error")
+	}
+}
@@ -37,9 +37,7 @@ const (
 )
 
 var (
-	commentRepository repository.CommentRepository = repository.NewCommentRepository()
-	commentLimiter                                 = newRateLimiter(3, 10*time.Minute)
-	urlPattern                                     = regexp.MustCompile(`https?://|www\.`)
+	urlPattern = regexp.MustCompile(`https?://|www\.`)
 )
 
 type CommentQueryInput struct {
@@ -57,7 +55,7 @@ type AddCommentInput struct {
 	Content                      string
 }
 
-func ListComments(ctx context.Context, input CommentQueryInput) domain.CommentListResult {
+func (s *Service) ListComments(ctx context.Context, input CommentQueryInput) domain.CommentListResult {
 	postID, ok := normalizePostID(input.PostID)
 	if !ok {
 		return domain.CommentListResult{Status: statusInvalidPostID}
@@ -66,7 +64,7 @@ func ListComments(ctx context.Context, input CommentQueryInput) domain.CommentLi
 	operationCtx, cancel := withTimeoutContext(ctx, 10*time.Second)
 	defer cancel()
 
-	post, queryErr := postsRepository.FindPostByIDAnyLocale(operationCtx, postID)
+	post, queryErr := s.posts.FindPostByIDAnyLocale(operationCtx, postID)
 	if queryErr != nil {
 		if errors.Is(queryErr, repository.ErrPostRepositoryUnavailable) {
 			return domain.CommentListResult{Status: statusServiceUnavailable, PostID: postID}
@@ -77,7 +75,7 @@ func ListComments(ctx context.Context, input CommentQueryInput) domain.CommentLi
 		return domain.CommentListResult{Status: commentStatusNotFound, PostID: postID}
 	}
 
-	items, err := commentRepository.ListApprovedByPost(operationCtx, postID)
+	items, err := s.comments.ListApprovedByPost(operationCtx, postID)
 	if err != nil {
 		if errors.Is(err, repository.ErrCommentRepositoryUnavailable) {
 			return domain.CommentListResult{Status: statusServiceUnavailable, PostID: postID}
@@ -96,7 +94,7 @@ func ListComments(ctx context.Context, input CommentQueryInput) domain.CommentLi
 	}
 }
 
-func AddComment(ctx context.Context, input AddCommentInput, meta RequestMetadata) domain.CommentMutationResult {
+func (s *Service) AddComment(ctx context.Context, input AddCommentInput, meta RequestMetadata) domain.CommentMutationResult {
 	postID, ok := normalizePostID(input.PostID)
 	if !ok {
 		return domain.CommentMutationResult{Status: statusInvalidPostID}
@@ -123,14 +121,18 @@ func AddComment(ctx context.Context, input AddCommentInput, meta RequestMetadata
 		return domain.CommentMutationResult{Status: commentStatusInvalidContent, PostID: postID}
 	}
 
-	if !commentLimiter.allow(strings.TrimSpace(meta.ClientIP)) {
-		return domain.CommentMutationResult{Status: commentStatusRateLimited, PostID: postID}
+	if decision := s.commentLimiter.Allow(ctx, strings.TrimSpace(meta.ClientIP)); !decision.Allowed {
+		return domain.CommentMutationResult{
+			Status:            commentStatusRateLimited,
+			PostID:            postID,
+			RetryAfterSeconds: decision.RetryAfterSeconds(),
+		}
 	}
 
 	operationCtx, cancel := withTimeoutContext(ctx, 10*time.Second)
 	defer cancel()
 
-	post, queryErr := postsRepository.FindPostByIDAnyLocale(operationCtx, postID)
+	post, queryErr := s.posts.FindPostByIDAnyLocale(operationCtx, postID)
 	if queryErr != nil {
 		if errors.Is(queryErr, repository.ErrPostRepositoryUnavailable) {
 			return domain.CommentMutationResult{Status: statusServiceUnavailable, PostID: postID}
@@ -144,7 +146,7 @@ func AddComment(ctx context.Context, input AddCommentInput, meta RequestMetadata
 	var parentID *string
 	resolvedParentID := strings.TrimSpace(input.ParentID)
 	if resolvedParentID != "" {
-		parentComment, err := commentRepository.FindCommentByID(operationCtx, resolvedParentID)
+		parentComment, err := s.comments.FindCommentByID(operationCtx, resolvedParentID)
 		if err != nil {
 			if errors.Is(err, repository.ErrCommentRepositoryUnavailable) {
 				return domain.CommentMutationResult{Status: statusServiceUnavailable, PostID: postID}
@@ -194,7 +196,7 @@ func AddComment(ctx context.Context, input AddCommentInput, meta RequestMetadata
 		ModerationNote:  moderationNote,
 	}
 
-	if err := commentRepository.CreateComment(operationCtx, record); err != nil {
+	if err := s.comments.CreateComment(operationCtx, record); err != nil {
 		if errors.Is(err, repository.ErrCommentRepositoryUnavailable) {
 			return domain.CommentMutationResult{Status: statusServiceUnavailable, PostID: postID}
 		}
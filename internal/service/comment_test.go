@@ -23,6 +23,9 @@ type commentStubRepository struct {
 	updateCommentStatusByIDs func(context.Context, []string, string, string, time.Time) (int, error)
 	deleteCommentByID        func(context.Context, string) (bool, error)
 	deleteCommentsByIDs      func(context.Context, []string) (int, error)
+	listByAuthorEmail        func(context.Context, string) ([]domain.CommentRecord, error)
+	anonymizeByAuthorEmail   func(context.Context, string, string, time.Time) (int, error)
+	deleteByAuthorEmail      func(context.Context, string) (int, error)
 }
 
 func (stub commentStubRepository) ListApprovedByPost(ctx context.Context, postID string) ([]domain.CommentRecord, error) {
@@ -97,6 +100,32 @@ func (stub commentStubRepository) DeleteCommentsByIDs(ctx context.Context, ids [
 	return stub.deleteCommentsByIDs(ctx, ids)
 }
 
+func (stub commentStubRepository) ListCommentsByAuthorEmail(ctx context.Context, email string) ([]domain.CommentRecord, error) {
+	if stub.listByAuthorEmail == nil {
+		return []domain.CommentRecord{}, nil
+	}
+	return stub.listByAuthorEmail(ctx, email)
+}
+
+func (stub commentStubRepository) AnonymizeCommentsByAuthorEmail(
+	ctx context.Context,
+	email string,
+	authorName string,
+	now time.Time,
+) (int, error) {
+	if stub.anonymizeByAuthorEmail == nil {
+		return 0, nil
+	}
+	return stub.anonymizeByAuthorEmail(ctx, email, authorName, now)
+}
+
+func (stub commentStubRepository) DeleteCommentsByAuthorEmail(ctx context.Context, email string) (int, error) {
+	if stub.deleteByAuthorEmail == nil {
+		return 0, nil
+	}
+	return stub.deleteByAuthorEmail(ctx, email)
+}
+
 func TestListComments(t *testing.T) {
 	originalPostRepository := postsRepository
 	originalCommentRepository := commentRepository
@@ -145,7 +174,7 @@ func TestListComments(t *testing.T) {
 		},
 	}
 
-	result := ListComments(context.Background(), CommentQueryInput{PostID: "Alpha-Post"})
+	result := testService().ListComments(context.Background(), CommentQueryInput{PostID: "Alpha-Post"})
 	if result.Status != "success" || result.Total != 1 || len(result.Comments) != 1 {
 		t.Fatalf("result = %#v", result)
 	}
@@ -194,7 +223,7 @@ func TestAddComment(t *testing.T) {
 		},
 	}
 
-	result := AddComment(
+	result := testService().AddComment(
 		context.Background(),
 		AddCommentInput{
 			PostID:                       "Alpha-Post",
@@ -282,7 +311,7 @@ func TestAddCommentReplyValidationAndRateLimit(t *testing.T) {
 		},
 	}
 
-	invalidParent := AddComment(
+	invalidParent := testService().AddComment(
 		context.Background(),
 		AddCommentInput{
 			PostID:      "alpha-post",
@@ -297,7 +326,7 @@ func TestAddCommentReplyValidationAndRateLimit(t *testing.T) {
 		t.Fatalf("invalidParent = %#v", invalidParent)
 	}
 
-	nestedParent := AddComment(
+	nestedParent := testService().AddComment(
 		context.Background(),
 		AddCommentInput{
 			PostID:      "alpha-post",
@@ -312,7 +341,7 @@ func TestAddCommentReplyValidationAndRateLimit(t *testing.T) {
 		t.Fatalf("nestedParent = %#v", nestedParent)
 	}
 
-	validSharedReply := AddComment(
+	validSharedReply := testService().AddComment(
 		context.Background(),
 		AddCommentInput{
 			PostID:      "alpha-post",
@@ -327,7 +356,7 @@ func TestAddCommentReplyValidationAndRateLimit(t *testing.T) {
 		t.Fatalf("validSharedReply = %#v", validSharedReply)
 	}
 
-	first := AddComment(
+	first := testService().AddComment(
 		context.Background(),
 		AddCommentInput{
 			PostID:      "alpha-post",
@@ -341,7 +370,7 @@ func TestAddCommentReplyValidationAndRateLimit(t *testing.T) {
 		t.Fatalf("first = %#v", first)
 	}
 
-	rateLimited := AddComment(
+	rateLimited := testService().AddComment(
 		context.Background(),
 		AddCommentInput{
 			PostID:      "alpha-post",
@@ -351,7 +380,7 @@ func TestAddCommentReplyValidationAndRateLimit(t *testing.T) {
 		},
 		RequestMetadata{ClientIP: "203.0.113.12"},
 	)
-	if rateLimited.Status != commentStatusRateLimited {
+	if rateLimited.Status != commentStatusRateLimited || rateLimited.RetryAfterSeconds <= 0 || rateLimited.RetryAfterSeconds > 3600 {
 		t.Fatalf("rateLimited = %#v", rateLimited)
 	}
 
@@ -367,7 +396,7 @@ func TestAddCommentReplyValidationAndRateLimit(t *testing.T) {
 		},
 	}
 
-	failed := AddComment(
+	failed := testService().AddComment(
 		context.Background(),
 		AddCommentInput{
 			PostID:      "alpha-post",
@@ -393,7 +422,7 @@ func TestCommentServiceErrorAndValidationPaths(t *testing.T) {
 	})
 
 	t.Run("ListComments handles invalid post id and repository failures", func(t *testing.T) {
-		if result := ListComments(context.Background(), CommentQueryInput{PostID: "??"}); result.Status != statusInvalidPostID {
+		if result := testService().ListComments(context.Background(), CommentQueryInput{PostID: "??"}); result.Status != statusInvalidPostID {
 			t.Fatalf("expected invalid post id, got %#v", result)
 		}
 
@@ -409,7 +438,7 @@ func TestCommentServiceErrorAndValidationPaths(t *testing.T) {
 			createComment:      func(context.Context, domain.CommentRecord) error { return nil },
 			findCommentByID:    func(context.Context, string) (*domain.CommentRecord, error) { return nil, nil },
 		}
-		if result := ListComments(context.Background(), CommentQueryInput{PostID: "alpha-post"}); result.Status != statusServiceUnavailable {
+		if result := testService().ListComments(context.Background(), CommentQueryInput{PostID: "alpha-post"}); result.Status != statusServiceUnavailable {
 			t.Fatalf("expected service unavailable, got %#v", result)
 		}
 
@@ -420,7 +449,7 @@ func TestCommentServiceErrorAndValidationPaths(t *testing.T) {
 				return nil, nil
 			},
 		}
-		if result := ListComments(context.Background(), CommentQueryInput{PostID: "alpha-post"}); result.Status != commentStatusNotFound {
+		if result := testService().ListComments(context.Background(), CommentQueryInput{PostID: "alpha-post"}); result.Status != commentStatusNotFound {
 			t.Fatalf("expected not found, got %#v", result)
 		}
 
@@ -438,7 +467,7 @@ func TestCommentServiceErrorAndValidationPaths(t *testing.T) {
 			createComment:   func(context.Context, domain.CommentRecord) error { return nil },
 			findCommentByID: func(context.Context, string) (*domain.CommentRecord, error) { return nil, nil },
 		}
-		if result := ListComments(context.Background(), CommentQueryInput{PostID: "alpha-post"}); result.Status != statusServiceUnavailable {
+		if result := testService().ListComments(context.Background(), CommentQueryInput{PostID: "alpha-post"}); result.Status != statusServiceUnavailable {
 			t.Fatalf("expected comment storage unavailable, got %#v", result)
 		}
 	})
@@ -459,13 +488,13 @@ func TestCommentServiceErrorAndValidationPaths(t *testing.T) {
 			findCommentByID:    func(context.Context, string) (*domain.CommentRecord, error) { return nil, nil },
 		}
 
-		if result := AddComment(context.Background(), AddCommentInput{PostID: "alpha-post", Content: "hello"}, RequestMetadata{}); result.Status != commentStatusInvalidAuthor {
+		if result := testService().AddComment(context.Background(), AddCommentInput{PostID: "alpha-post", Content: "hello"}, RequestMetadata{}); result.Status != commentStatusInvalidAuthor {
 			t.Fatalf("expected invalid author, got %#v", result)
 		}
-		if result := AddComment(context.Background(), AddCommentInput{PostID: "alpha-post", AuthorName: "Alice", Content: "hello"}, RequestMetadata{}); result.Status != commentStatusInvalidEmail {
+		if result := testService().AddComment(context.Background(), AddCommentInput{PostID: "alpha-post", AuthorName: "Alice", Content: "hello"}, RequestMetadata{}); result.Status != commentStatusInvalidEmail {
 			t.Fatalf("expected invalid email, got %#v", result)
 		}
-		if result := AddComment(context.Background(), AddCommentInput{PostID: "alpha-post", AuthorName: "Alice", AuthorEmail: "alice@example.com", Content: "  "}, RequestMetadata{}); result.Status != commentStatusInvalidContent {
+		if result := testService().AddComment(context.Background(), AddCommentInput{PostID: "alpha-post", AuthorName: "Alice", AuthorEmail: "alice@example.com", Content: "  "}, RequestMetadata{}); result.Status != commentStatusInvalidContent {
 			t.Fatalf("expected invalid content, got %#v", result)
 		}
 
@@ -478,7 +507,7 @@ func TestCommentServiceErrorAndValidationPaths(t *testing.T) {
 			},
 			findCommentByID: func(context.Context, string) (*domain.CommentRecord, error) { return nil, nil },
 		}
-		result := AddComment(context.Background(), AddCommentInput{
+		result := testService().AddComment(context.Background(), AddCommentInput{
 			PostID:      "alpha-post",
 			AuthorName:  "Alice",
 			AuthorEmail: "alice@example.com",
@@ -495,7 +524,7 @@ func TestCommentServiceErrorAndValidationPaths(t *testing.T) {
 				return nil, repository.ErrPostRepositoryUnavailable
 			},
 		}
-		if result := AddComment(context.Background(), AddCommentInput{
+		if result := testService().AddComment(context.Background(), AddCommentInput{
 			PostID:      "alpha-post",
 			AuthorName:  "Alice",
 			AuthorEmail: "alice@example.com",
@@ -511,7 +540,7 @@ func TestCommentServiceErrorAndValidationPaths(t *testing.T) {
 				return nil, nil
 			},
 		}
-		if result := AddComment(context.Background(), AddCommentInput{
+		if result := testService().AddComment(context.Background(), AddCommentInput{
 			PostID:      "alpha-post",
 			AuthorName:  "Alice",
 			AuthorEmail: "alice@example.com",
@@ -534,7 +563,7 @@ func TestCommentServiceErrorAndValidationPaths(t *testing.T) {
 				return nil, repository.ErrCommentRepositoryUnavailable
 			},
 		}
-		if result := AddComment(context.Background(), AddCommentInput{
+		if result := testService().AddComment(context.Background(), AddCommentInput{
 			PostID:      "alpha-post",
 			ParentID:    "root",
 			AuthorName:  "Alice",
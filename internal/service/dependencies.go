@@ -0,0 +1,99 @@
+package service
+
+import (
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/repository"
+)
+
+// Dependencies lists the repositories the services work with. The application container builds them once per process
+// and passes them to New.
+type Dependencies struct {
+	AdminAccessTokens   repository.AdminAccessTokenRepository
+	AdminAuditLogs      repository.AdminAuditLogRepository
+	AdminAvatars        repository.AdminAvatarRepository
+	AdminContent        repository.AdminContentRepository
+	AdminDashboard      repository.AdminDashboardRepository
+	AdminLoginAttempts  repository.AdminLoginAttemptRepository
+	AdminMediaAssets    repository.AdminMediaAssetRepository
+	AdminMediaUploads   repository.AdminMediaUploadSessionRepository
+	AdminNewsletter     repository.AdminNewsletterRepository
+	AdminPasskeys       repository.AdminPasskeyRepository
+	AdminRefreshTokens  repository.AdminRefreshTokenRepository
+	AdminUsers          repository.AdminUserRepository
+	Comments            repository.CommentRepository
+	ErrorMessages       repository.ErrorMessageRepository
+	LoginHistory        repository.LoginHistoryRepository
+	MediaBlobStores     *repository.MediaBlobStores
+	Newsletter          repository.NewsletterRepository
+	OIDCIdentities      repository.OIDCIdentityRepository
+	Posts               repository.PostRepository
+	RateLimits          repository.RateLimitRepository
+	ReaderPostLikes     repository.ReaderPostLikeRepository
+	ReaderRefreshTokens repository.ReaderRefreshTokenRepository
+	ReaderUsers         repository.ReaderUserRepository
+}
+
+// Service runs the use cases of the API on the repositories it was built with. The application container builds one
+// per process with New and hands it to the handlers.
+type Service struct {
+	adminAccessTokens   repository.AdminAccessTokenRepository
+	adminAuditLogs      repository.AdminAuditLogRepository
+	adminAvatars        repository.AdminAvatarRepository
+	adminContent        repository.AdminContentRepository
+	adminDashboard      repository.AdminDashboardRepository
+	adminLoginAttempts  repository.AdminLoginAttemptRepository
+	adminMediaAssets    repository.AdminMediaAssetRepository
+	adminMediaUploads   repository.AdminMediaUploadSessionRepository
+	adminNewsletter     repository.AdminNewsletterRepository
+	adminPasskeys       repository.AdminPasskeyRepository
+	adminRefreshTokens  repository.AdminRefreshTokenRepository
+	adminUsers          repository.AdminUserRepository
+	comments            repository.CommentRepository
+	errorMessages       repository.ErrorMessageRepository
+	loginHistory        repository.LoginHistoryRepository
+	mediaBlobStores     *repository.MediaBlobStores
+	newsletter          repository.NewsletterRepository
+	oidcIdentities      repository.OIDCIdentityRepository
+	posts               repository.PostRepository
+	readerPostLikes     repository.ReaderPostLikeRepository
+	readerRefreshTokens repository.ReaderRefreshTokenRepository
+	readerUsers         repository.ReaderUserRepository
+
+	commentLimiter   RateLimiter
+	subscribeLimiter RateLimiter
+	resendLimiter    RateLimiter
+}
+
+// New builds a Service on dependencies. Every repository has to be set; the comment and newsletter limiters count
+// attempts in the RateLimits repository.
+func New(dependencies Dependencies) *Service {
+	return &Service{
+		adminAccessTokens:   dependencies.AdminAccessTokens,
+		adminAuditLogs:      dependencies.AdminAuditLogs,
+		adminAvatars:        dependencies.AdminAvatars,
+		adminContent:        dependencies.AdminContent,
+		adminDashboard:      dependencies.AdminDashboard,
+		adminLoginAttempts:  dependencies.AdminLoginAttempts,
+		adminMediaAssets:    dependencies.AdminMediaAssets,
+		adminMediaUploads:   dependencies.AdminMediaUploads,
+		adminNewsletter:     dependencies.AdminNewsletter,
+		adminPasskeys:       dependencies.AdminPasskeys,
+		adminRefreshTokens:  dependencies.AdminRefreshTokens,
+		adminUsers:          dependencies.AdminUsers,
+		comments:            dependencies.Comments,
+		errorMessages:       dependencies.ErrorMessages,
+		loginHistory:        dependencies.LoginHistory,
+		mediaBlobStores:     dependencies.MediaBlobStores,
+		newsletter:          dependencies.Newsletter,
+		oidcIdentities:      dependencies.OIDCIdentities,
+		posts:               dependencies.Posts,
+		readerPostLikes:     dependencies.ReaderPostLikes,
+		readerRefreshTokens: dependencies.ReaderRefreshTokens,
+		readerUsers:         dependencies.ReaderUsers,
+
+		commentLimiter:   newOperationRateLimiter(dependencies.RateLimits, rateLimitOperationComment, 3, 10*time.Minute),
+		subscribeLimiter: newOperationRateLimiter(dependencies.RateLimits, rateLimitOperationNewsletterSubscribe, 5, time.Minute),
+		resendLimiter:    newOperationRateLimiter(dependencies.RateLimits, rateLimitOperationNewsletterResend, 5, time.Minute),
+	}
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/repository"
+)
+
+// The tests swap these repositories and limiters for stubs and run the code under test on testService, which builds a
+// Service from their current values.
+var (
+	adminAccessTokensRepository       repository.AdminAccessTokenRepository        = repository.NewAdminAccessTokenRepository(nil)
+	adminAuditLogRepo                 repository.AdminAuditLogRepository           = repository.NewAdminAuditLogRepository(nil)
+	adminAvatarRepository             repository.AdminAvatarRepository             = repository.NewAdminAvatarRepository(nil)
+	adminContentRepository            repository.AdminContentRepository            = repository.NewAdminContentRepository(nil)
+	adminDashboardRepository          repository.AdminDashboardRepository          = repository.NewAdminDashboardMongoRepository(nil)
+	adminLoginAttemptsRepository      repository.AdminLoginAttemptRepository       = repository.NewAdminLoginAttemptRepository(nil)
+	adminMediaAssetRepository         repository.AdminMediaAssetRepository         = repository.NewAdminMediaAssetRepository(nil)
+	adminMediaUploadSessionRepository repository.AdminMediaUploadSessionRepository = repository.NewAdminMediaUploadSessionRepository(nil)
+	adminNewsletterRepository         repository.AdminNewsletterRepository         = repository.NewAdminNewsletterRepository(nil)
+	adminPasskeysRepository           repository.AdminPasskeyRepository            = repository.NewAdminPasskeyRepository(nil)
+	adminRefreshTokensRepository      repository.AdminRefreshTokenRepository       = repository.NewAdminRefreshTokenMongoRepository(nil)
+	adminUsersRepository              repository.AdminUserRepository               = repository.NewAdminUserRepository(nil)
+	commentRepository                 repository.CommentRepository                 = repository.NewCommentRepository(nil)
+	adminErrorMessageRepository       repository.ErrorMessageRepository            = repository.NewErrorMessageRepository(nil)
+	loginHistoryRepository            repository.LoginHistoryRepository            = repository.NewLoginHistoryRepository(nil)
+	newsletterRepository              repository.NewsletterRepository              = repository.NewNewsletterMongoRepository(nil)
+	oidcIdentitiesRepository          repository.OIDCIdentityRepository            = repository.NewOIDCIdentityRepository(nil)
+	postsRepository                   repository.PostRepository                    = repository.NewPostMongoRepository(nil)
+	readerPostLikesRepository         repository.ReaderPostLikeRepository          = repository.NewReaderPostLikeRepository(nil)
+	readerRefreshTokensRepository     repository.ReaderRefreshTokenRepository      = repository.NewReaderRefreshTokenMongoRepository(nil)
+	readerUsersRepository             repository.ReaderUserRepository              = repository.NewReaderUserRepository(nil)
+
+	commentLimiter   RateLimiter = newOperationRateLimiter(repository.NewRateLimitRepository(nil), rateLimitOperationComment, 3, 10*time.Minute)
+	subscribeLimiter RateLimiter = newOperationRateLimiter(repository.NewRateLimitRepository(nil), rateLimitOperationNewsletterSubscribe, 5, time.Minute)
+	resendLimiter    RateLimiter = newOperationRateLimiter(repository.NewRateLimitRepository(nil), rateLimitOperationNewsletterResend, 5, time.Minute)
+)
+
+func testService() *Service {
+	return &Service{
+		adminAccessTokens:   adminAccessTokensRepository,
+		adminAuditLogs:      adminAuditLogRepo,
+		adminAvatars:        adminAvatarRepository,
+		adminContent:        adminContentRepository,
+		adminDashboard:      adminDashboardRepository,
+		adminLoginAttempts:  adminLoginAttemptsRepository,
+		adminMediaAssets:    adminMediaAssetRepository,
+		adminMediaUploads:   adminMediaUploadSessionRepository,
+		adminNewsletter:     adminNewsletterRepository,
+		adminPasskeys:       adminPasskeysRepository,
+		adminRefreshTokens:  adminRefreshTokensRepository,
+		adminUsers:          adminUsersRepository,
+		comments:            commentRepository,
+		errorMessages:       adminErrorMessageRepository,
+		loginHistory:        loginHistoryRepository,
+		mediaBlobStores:     repository.NewMediaBlobStores(nil),
+		newsletter:          newsletterRepository,
+		oidcIdentities:      oidcIdentitiesRepository,
+		posts:               postsRepository,
+		readerPostLikes:     readerPostLikesRepository,
+		readerRefreshTokens: readerRefreshTokensRepository,
+		readerUsers:         readerUsersRepository,
+
+		commentLimiter:   commentLimiter,
+		subscribeLimiter: subscribeLimiter,
+		resendLimiter:    resendLimiter,
+	}
+}
+
+func TestNewBuildsServiceOnDependencies(t *testing.T) {
+	comments := commentStubRepository{}
+	likes := &stubReaderPostLikeRepository{}
+	rateLimits := &stubRateLimitRepository{}
+	service := New(Dependencies{Comments: comments, ReaderPostLikes: likes, RateLimits: rateLimits})
+
+	if _, ok := service.comments.(commentStubRepository); !ok {
+		t.Fatalf("comments = %T", service.comments)
+	}
+	if service.readerPostLikes != likes || service.posts != nil {
+		t.Fatalf("unexpected repositories readerPostLikes = %T, posts = %T", service.readerPostLikes, service.posts)
+	}
+
+	for name, limiter := range map[string]RateLimiter{
+		"comment":   service.commentLimiter,
+		"subscribe": service.subscribeLimiter,
+		"resend":    service.resendLimiter,
+	} {
+		operationLimiter, ok := limiter.(*operationRateLimiter)
+		if !ok || operationLimiter.repository != rateLimits {
+			t.Fatalf("%s limiter = %#v, want one counting in the RateLimits repository", name, limiter)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpauth"
+)
+
+// VerifyAdminJWT checks an admin token against every active verification key, including the HS256 secret.
+func VerifyAdminJWT(
+	config appconfig.AdminConfig,
+	token string,
+	tokenType string,
+	now time.Time,
+) (*httpauth.JWTClaims, error) {
+	keys, err := resolveJWTKeySet(config.JWTSecret, config.JWTAlgorithm, config.JWTKeys, config.JWTSigningKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return httpauth.VerifyJWT(token, keys, tokenType, now)
+}
+
+func issueAdminJWT(config appconfig.AdminConfig, claims httpauth.JWTClaims) (string, error) {
+	keys, err := resolveJWTKeySet(config.JWTSecret, config.JWTAlgorithm, config.JWTKeys, config.JWTSigningKeyID)
+	if err != nil {
+		return "", err
+	}
+
+	return httpauth.IssueJWT(claims, keys)
+}
+
+func verifyReaderJWT(
+	config appconfig.ReaderConfig,
+	token string,
+	tokenType string,
+	now time.Time,
+) (*httpauth.JWTClaims, error) {
+	keys, err := resolveJWTKeySet(config.JWTSecret, config.JWTAlgorithm, config.JWTKeys, config.JWTSigningKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return httpauth.VerifyJWT(token, keys, tokenType, now)
+}
+
+func issueReaderJWT(config appconfig.ReaderConfig, claims httpauth.JWTClaims) (string, error) {
+	keys, err := resolveJWTKeySet(config.JWTSecret, config.JWTAlgorithm, config.JWTKeys, config.JWTSigningKeyID)
+	if err != nil {
+		return "", err
+	}
+
+	return httpauth.IssueJWT(claims, keys)
+}
+
+// ResolvePublicJWKS lists the public keys that verify admin and reader tokens, so other services can check them
+// without sharing a secret.
+func ResolvePublicJWKS() (httpauth.JSONWebKeySet, error) {
+	keys, err := httpauth.ParseJWKSet(appconfig.ResolveJWTKeys())
+	if err != nil {
+		return httpauth.JSONWebKeySet{}, apperrors.Config("jwt keys are invalid", err)
+	}
+
+	return httpauth.JWTKeySet{Keys: keys}.PublicJWKS(), nil
+}
+
+func resolveJWTKeySet(secret, algorithm, rawKeys, signingKeyID string) (httpauth.JWTKeySet, error) {
+	resolvedAlgorithm, err := httpauth.ParseJWTAlgorithm(algorithm)
+	if err != nil {
+		return httpauth.JWTKeySet{}, apperrors.Config("jwt algorithm is not supported", err)
+	}
+
+	keys, err := httpauth.ParseJWKSet(rawKeys)
+	if err != nil {
+		return httpauth.JWTKeySet{}, apperrors.Config("jwt keys are invalid", err)
+	}
+
+	return httpauth.JWTKeySet{
+		Algorithm:    resolvedAlgorithm,
+		Secret:       secret,
+		SigningKeyID: signingKeyID,
+		Keys:         keys,
+	}, nil
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpauth"
+)
+
+func setTestJWTKeys(t *testing.T, keys ...httpauth.JWTKey) {
+	t.Helper()
+
+	set := httpauth.JSONWebKeySet{}
+	for _, key := range keys {
+		jwk, err := key.PrivateJWK()
+		if err != nil {
+			t.Fatalf("PrivateJWK returned error: %v", err)
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	encoded, err := json.Marshal(set)
+	if err != nil {
+		t.Fatalf("marshal jwk set: %v", err)
+	}
+	t.Setenv("JWT_KEYS", string(encoded))
+}
+
+func TestAdminJWTSwitchesAlgorithmAndKeepsHS256Tokens(t *testing.T) {
+	t.Setenv("JWT_SECRET", "admin-secret")
+	now := time.Now().UTC()
+	claims := httpauth.JWTClaims{Subject: "admin-1", Type: "access", IssuedAt: now.Unix(), ExpiresAt: now.Add(time.Hour).Unix()}
+
+	legacyToken, err := issueAdminJWT(appconfig.ResolveAdminConfig(), claims)
+	if err != nil {
+		t.Fatalf("issueAdminJWT returned error: %v", err)
+	}
+
+	edKey, _ := httpauth.GenerateJWTKey(httpauth.JWTAlgorithmEdDSA, "ed-1")
+	ecKey, _ := httpauth.GenerateJWTKey(httpauth.JWTAlgorithmES256, "ec-1")
+	setTestJWTKeys(t, edKey, ecKey)
+	t.Setenv("JWT_ALGORITHM", "EdDSA")
+	t.Setenv("READER_JWT_ALGORITHM", "ES256")
+
+	adminConfig := appconfig.ResolveAdminConfig()
+	adminToken, err := issueAdminJWT(adminConfig, claims)
+	if err != nil {
+		t.Fatalf("issueAdminJWT returned error: %v", err)
+	}
+	readerConfig := appconfig.ResolveReaderConfig()
+	readerToken, err := issueReaderJWT(readerConfig, claims)
+	if err != nil {
+		t.Fatalf("issueReaderJWT returned error: %v", err)
+	}
+	if adminToken == legacyToken || strings.Count(readerToken, ".") != 2 {
+		t.Fatalf("unexpected tokens %q %q", adminToken, readerToken)
+	}
+
+	for _, token := range []string{legacyToken, adminToken} {
+		if _, err := VerifyAdminJWT(adminConfig, token, "access", now); err != nil {
+			t.Fatalf("expected admin token to verify, got %v", err)
+		}
+	}
+	if _, err := verifyReaderJWT(readerConfig, readerToken, "access", now); err != nil {
+		t.Fatalf("expected reader token to verify, got %v", err)
+	}
+
+	jwks, err := ResolvePublicJWKS()
+	if err != nil || len(jwks.Keys) != 2 || jwks.Keys[0].KeyID != "ed-1" || jwks.Keys[0].D != "" {
+		t.Fatalf("unexpected public jwks %#v, %v", jwks, err)
+	}
+}
+
+func TestAdminJWTRejectsInvalidConfiguration(t *testing.T) {
+	t.Setenv("JWT_SECRET", "admin-secret")
+	t.Setenv("ADMIN_JWT_ALGORITHM", "RS256")
+	if _, err := issueAdminJWT(appconfig.ResolveAdminConfig(), httpauth.JWTClaims{Subject: "admin-1"}); apperrors.From(err).Code != "CONFIG_ERROR" {
+		t.Fatalf("expected config error, got %v", err)
+	}
+
+	t.Setenv("ADMIN_JWT_ALGORITHM", "EdDSA")
+	t.Setenv("JWT_KEYS", "{")
+	if _, err := ResolvePublicJWKS(); apperrors.From(err).Code != "CONFIG_ERROR" {
+		t.Fatalf("expected config error, got %v", err)
+	}
+	t.Setenv("JWT_KEYS", "")
+	if appconfig.ResolveAdminConfig().JWTConfigured() {
+		t.Fatal("expected EdDSA without keys to be unconfigured")
+	}
+}
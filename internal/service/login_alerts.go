@@ -0,0 +1,375 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/repository"
+	adminmailpkg "suaybsimsek.com/blog-api/pkg/adminmail"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpapi"
+	"suaybsimsek.com/blog-api/pkg/httpauth"
+	newsletterpkg "suaybsimsek.com/blog-api/pkg/newsletter"
+)
+
+// LoginAlertResult describes the account secured from a login alert link.
+type LoginAlertResult struct {
+	AccountType string
+}
+
+const (
+	// loginAlertTokenTTL keeps a "this wasn't me" link usable long enough for an alert read days later.
+	loginAlertTokenTTL = 7 * 24 * time.Hour
+	// loginHistoryRetention forgets devices and countries that have not been used for a year.
+	loginHistoryRetention = 365 * 24 * time.Hour
+
+	adminLoginAlertTokenType  = "admin-login-alert"
+	readerLoginAlertTokenType = "reader-login-alert"
+	loginAlertPath            = "/api/login-alert"
+	loginAlertInvalidCode     = "LOGIN_ALERT_LINK_INVALID"
+	loginAlertInvalidMessage  = "login alert link is invalid or has already been used"
+
+	maxLoginDeviceProductLength = 40
+)
+
+var (
+	sendLoginAlertEmailFn = sendLoginAlertEmail
+)
+
+// notifyAdminNewLoginSource emails an admin when a new sign-in comes from a device or country the account has not used.
+func (s *Service) notifyAdminNewLoginSource(
+	ctx context.Context,
+	config appconfig.AdminConfig,
+	userRecord *domain.AdminUserRecord,
+	metadata AdminSessionMetadata,
+	now time.Time,
+) {
+	if !s.isNewLoginSource(ctx, domain.AccountTypeAdmin, userRecord.ID, metadata.UserAgent, metadata.CountryCode, now) {
+		return
+	}
+
+	// The token carries the password version, so it stops working once the password changes or the link is used.
+	token, err := issueAdminJWT(config, httpauth.JWTClaims{
+		Subject:         userRecord.ID,
+		PasswordVersion: userRecord.PasswordVersion,
+		Type:            adminLoginAlertTokenType,
+		Issuer:          config.JWTIssuer,
+		Audience:        config.JWTAudience,
+		IssuedAt:        now.Unix(),
+		ExpiresAt:       now.Add(loginAlertTokenTTL).Unix(),
+	})
+	if err != nil {
+		httpapi.LogError(ctx, "admin login alert token could not be issued", err, slog.String("userId", userRecord.ID))
+		return
+	}
+
+	notifyLoginAlert(ctx, userRecord.ID, userRecord.Email, token, adminmailpkg.AdminLoginAlertEmail, adminmailpkg.LoginAlertDetails{
+		Device:      describeLoginDevice(metadata.UserAgent),
+		CountryCode: metadata.CountryCode,
+		IPAddress:   metadata.RemoteIP,
+		Time:        now,
+	})
+}
+
+// notifyReaderNewLoginSource is the reader counterpart of notifyAdminNewLoginSource.
+func (s *Service) notifyReaderNewLoginSource(
+	ctx context.Context,
+	config appconfig.ReaderConfig,
+	userRecord *domain.ReaderUserRecord,
+	metadata ReaderSessionMetadata,
+	now time.Time,
+) {
+	// Readers signing in with a provider that shares no email cannot be alerted.
+	if strings.TrimSpace(userRecord.Email) == "" {
+		return
+	}
+	if !s.isNewLoginSource(ctx, domain.AccountTypeReader, userRecord.ID, metadata.UserAgent, metadata.CountryCode, now) {
+		return
+	}
+
+	token, err := issueReaderJWT(config, httpauth.JWTClaims{
+		Subject:         userRecord.ID,
+		PasswordVersion: userRecord.SessionVersion,
+		Type:            readerLoginAlertTokenType,
+		Issuer:          config.JWTIssuer,
+		Audience:        config.JWTAudience,
+		IssuedAt:        now.Unix(),
+		ExpiresAt:       now.Add(loginAlertTokenTTL).Unix(),
+	})
+	if err != nil {
+		httpapi.LogError(ctx, "reader login alert token could not be issued", err, slog.String("userId", userRecord.ID))
+		return
+	}
+
+	notifyLoginAlert(ctx, userRecord.ID, userRecord.Email, token, adminmailpkg.ReaderLoginAlertEmail, adminmailpkg.LoginAlertDetails{
+		Device:      describeLoginDevice(metadata.UserAgent),
+		CountryCode: metadata.CountryCode,
+		IPAddress:   metadata.RemoteIP,
+		Time:        now,
+	})
+}
+
+// isNewLoginSource remembers the device and country of a sign-in and reports whether either is new to an account that
+// has signed in before. Storage failures are logged and reported as nothing new, so they never block a sign-in.
+func (s *Service) isNewLoginSource(ctx context.Context, accountType, userID, userAgent, countryCode string, now time.Time) bool {
+	known, err := s.loginHistory.HasAny(ctx, accountType, userID)
+	if err != nil {
+		httpapi.LogError(ctx, "login history lookup failed", err, slog.String("userId", userID))
+		return false
+	}
+
+	records := make([]domain.LoginHistoryRecord, 0, 2)
+	if fingerprint := loginDeviceFingerprint(userAgent); fingerprint != "" {
+		records = append(records, domain.LoginHistoryRecord{Kind: domain.LoginHistoryKindDevice, Value: fingerprint})
+	}
+	if country := strings.ToUpper(strings.TrimSpace(countryCode)); country != "" {
+		records = append(records, domain.LoginHistoryRecord{Kind: domain.LoginHistoryKindCountry, Value: country})
+	}
+
+	isNew := false
+	for _, record := range records {
+		record.AccountType = accountType
+		record.UserID = userID
+		record.FirstSeenAt = now
+		record.LastSeenAt = now
+		record.ExpiresAt = now.Add(loginHistoryRetention)
+
+		remembered, err := s.loginHistory.Remember(ctx, record)
+		if err != nil {
+			httpapi.LogError(ctx, "login history update failed", err, slog.String("userId", userID))
+			return false
+		}
+		isNew = isNew || remembered
+	}
+
+	return known && isNew
+}
+
+func notifyLoginAlert(
+	ctx context.Context,
+	userID string,
+	email string,
+	token string,
+	build func(locale, secureURL, siteURL string, details adminmailpkg.LoginAlertDetails) (string, string, error),
+	details adminmailpkg.LoginAlertDetails,
+) {
+	siteURL, err := resolveSiteURLFn()
+	if err != nil {
+		httpapi.LogError(ctx, "login alert site url is not configured", err)
+		return
+	}
+	mailCfg, err := resolveMailConfigFn()
+	if err != nil {
+		httpapi.LogError(ctx, "login alert mail transport is not configured", err)
+		return
+	}
+
+	locale := resolveAdminErrorLocale(ctx)
+	secureURL, err := buildLoginAlertURL(siteURL, token, locale)
+	if err != nil {
+		httpapi.LogError(ctx, "login alert url is invalid", err)
+		return
+	}
+
+	subject, htmlBody, err := build(locale, secureURL, siteURL, details)
+	if err != nil {
+		httpapi.LogError(ctx, "login alert email could not be built", err, slog.String("userId", userID))
+		return
+	}
+	if err := sendLoginAlertEmailFn(mailCfg, email, subject, htmlBody); err != nil {
+		httpapi.LogError(ctx, "login alert email failed", err, slog.String("userId", userID))
+	}
+}
+
+func sendLoginAlertEmail(cfg appconfig.MailConfig, recipientEmail, subject, htmlBody string) error {
+	if err := newsletterpkg.SendHTMLEmail(cfg, recipientEmail, subject, htmlBody, nil); err != nil {
+		return fmt.Errorf("send login alert email failed: %w", err)
+	}
+	return nil
+}
+
+func buildLoginAlertURL(siteURL, token, locale string) (string, error) {
+	parsed, err := url.Parse(siteURL)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return "", errors.New("invalid SITE_URL")
+	}
+
+	parsed.Path = strings.TrimRight(parsed.Path, "/") + loginAlertPath
+	query := parsed.Query()
+	query.Set("token", strings.TrimSpace(token))
+	query.Set("locale", newsletterpkg.ResolveLocale(locale, ""))
+	parsed.RawQuery = query.Encode()
+
+	return parsed.String(), nil
+}
+
+// SecureAccountFromLoginAlert handles the "this wasn't me" link of a login alert. Every session of the account is
+// revoked. Admins also lose their password and are emailed a reset link, since readers have no password to reset.
+func (s *Service) SecureAccountFromLoginAlert(ctx context.Context, token, locale string) (*LoginAlertResult, error) {
+	resolvedToken := strings.TrimSpace(token)
+	if resolvedToken == "" {
+		return nil, newLoginAlertInvalidError()
+	}
+
+	now := nowUTCFn()
+	adminConfig := appconfig.ResolveAdminConfig()
+	if !adminConfig.JWTConfigured() {
+		return nil, apperrors.Config("admin jwt is not configured", nil)
+	}
+	if claims, err := VerifyAdminJWT(adminConfig, resolvedToken, adminLoginAlertTokenType, now); err == nil {
+		if err := s.secureAdminAccountFromLoginAlert(ctx, claims, locale, now); err != nil {
+			return nil, err
+		}
+		return &LoginAlertResult{AccountType: domain.AccountTypeAdmin}, nil
+	}
+
+	readerConfig := appconfig.ResolveReaderConfig()
+	if claims, err := verifyReaderJWT(readerConfig, resolvedToken, readerLoginAlertTokenType, now); err == nil {
+		if err := s.secureReaderAccountFromLoginAlert(ctx, claims, now); err != nil {
+			return nil, err
+		}
+		return &LoginAlertResult{AccountType: domain.AccountTypeReader}, nil
+	}
+
+	return nil, newLoginAlertInvalidError()
+}
+
+func (s *Service) secureAdminAccountFromLoginAlert(ctx context.Context, claims *httpauth.JWTClaims, locale string, now time.Time) error {
+	userRecord, err := s.adminUsers.FindByID(ctx, strings.TrimSpace(claims.Subject))
+	if err != nil {
+		return apperrors.Internal(adminLoadAdminUserMessage, err)
+	}
+	if userRecord == nil || userRecord.PasswordVersion != claims.PasswordVersion {
+		return newLoginAlertInvalidError()
+	}
+
+	// Clearing the hash bumps the password version, which ends every access token and this link as well.
+	if err := s.adminUsers.UpdatePasswordHashByID(ctx, userRecord.ID, ""); err != nil {
+		if errors.Is(err, repository.ErrAdminUserNotFound) {
+			return newLoginAlertInvalidError()
+		}
+		return apperrors.Internal("failed to update admin password", err)
+	}
+	if err := s.adminRefreshTokens.RevokeAllByUserID(ctx, userRecord.ID, now); err != nil {
+		return toAdminSessionError(err)
+	}
+	if err := s.revokeAllAdminAccessTokens(ctx, userRecord.ID); err != nil {
+		return err
+	}
+
+	siteURL, err := resolveSiteURLFn()
+	if err != nil {
+		return apperrors.Config("admin password reset site url is not configured", err)
+	}
+	mailCfg, err := resolveMailConfigFn()
+	if err != nil {
+		return apperrors.Config("admin password reset mail transport is not configured", err)
+	}
+
+	return s.sendAdminPasswordResetLink(ctx, userRecord, locale, siteURL, mailCfg)
+}
+
+func (s *Service) secureReaderAccountFromLoginAlert(ctx context.Context, claims *httpauth.JWTClaims, now time.Time) error {
+	userRecord, err := s.readerUsers.FindByID(ctx, strings.TrimSpace(claims.Subject))
+	if err != nil {
+		return apperrors.Internal("failed to load reader user", err)
+	}
+	if userRecord == nil || userRecord.SessionVersion != claims.PasswordVersion {
+		return newLoginAlertInvalidError()
+	}
+
+	if err := s.readerUsers.IncrementSessionVersionByID(ctx, userRecord.ID); err != nil {
+		if errors.Is(err, repository.ErrReaderUserNotFound) {
+			return newLoginAlertInvalidError()
+		}
+		return apperrors.Internal("failed to update reader account", err)
+	}
+	if err := s.readerRefreshTokens.RevokeAllByUserID(ctx, userRecord.ID, now); err != nil {
+		return toReaderSessionError(err)
+	}
+
+	return nil
+}
+
+func newLoginAlertInvalidError() error {
+	return apperrors.New(loginAlertInvalidCode, loginAlertInvalidMessage, 400, nil)
+}
+
+// loginDeviceFingerprint identifies a device by browser and operating system family, so browser updates do not look
+// like a new device.
+func loginDeviceFingerprint(userAgent string) string {
+	browser, system := parseLoginUserAgent(userAgent)
+	if browser == "" && system == "" {
+		return ""
+	}
+
+	return strings.ToLower(browser + "/" + system)
+}
+
+func describeLoginDevice(userAgent string) string {
+	browser, system := parseLoginUserAgent(userAgent)
+	switch {
+	case browser != "" && system != "":
+		return browser + " / " + system
+	case browser != "":
+		return browser
+	default:
+		return system
+	}
+}
+
+func parseLoginUserAgent(userAgent string) (string, string) {
+	value := strings.TrimSpace(userAgent)
+	if value == "" {
+		return "", ""
+	}
+
+	browser := ""
+	switch {
+	case strings.Contains(value, "Edg/") || strings.Contains(value, "EdgA/") || strings.Contains(value, "EdgiOS/"):
+		browser = "Edge"
+	case strings.Contains(value, "OPR/") || strings.Contains(value, "Opera"):
+		browser = "Opera"
+	case strings.Contains(value, "Firefox/") || strings.Contains(value, "FxiOS/"):
+		browser = "Firefox"
+	case strings.Contains(value, "Chrome/") || strings.Contains(value, "CriOS/"):
+		browser = "Chrome"
+	case strings.Contains(value, "Safari/"):
+		browser = "Safari"
+	}
+
+	system := ""
+	switch {
+	case strings.Contains(value, "Windows"):
+		system = "Windows"
+	case strings.Contains(value, "iPhone") || strings.Contains(value, "iPad"):
+		system = "iOS"
+	case strings.Contains(value, "Android"):
+		system = "Android"
+	case strings.Contains(value, "CrOS"):
+		system = "ChromeOS"
+	case strings.Contains(value, "Mac OS X") || strings.Contains(value, "Macintosh"):
+		system = "macOS"
+	case strings.Contains(value, "Linux"):
+		system = "Linux"
+	}
+
+	// Clients that are not browsers are still told apart by their product name.
+	if browser == "" && system == "" {
+		product, _, _ := strings.Cut(value, "/")
+		product = strings.TrimSpace(product)
+		if len(product) > maxLoginDeviceProductLength {
+			product = product[:maxLoginDeviceProductLength]
+		}
+		return product, ""
+	}
+
+	return browser, system
+}
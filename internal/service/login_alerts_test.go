@@ -0,0 +1,272 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+)
+
+type stubLoginHistoryRepository struct {
+	values map[string]bool
+}
+
+func (stub *stubLoginHistoryRepository) HasAny(_ context.Context, accountType, userID string) (bool, error) {
+	prefix := accountType + "|" + userID + "|"
+	for key := range stub.values {
+		if strings.HasPrefix(key, prefix) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (stub *stubLoginHistoryRepository) Remember(_ context.Context, record domain.LoginHistoryRecord) (bool, error) {
+	key := record.AccountType + "|" + record.UserID + "|" + record.Kind + "|" + record.Value
+	if stub.values[key] {
+		return false, nil
+	}
+	stub.values[key] = true
+	return true, nil
+}
+
+func (stub *stubLoginHistoryRepository) DeleteByUserID(_ context.Context, accountType, userID string) error {
+	prefix := accountType + "|" + userID + "|"
+	for key := range stub.values {
+		if strings.HasPrefix(key, prefix) {
+			delete(stub.values, key)
+		}
+	}
+	return nil
+}
+
+type sentLoginAlert struct {
+	recipient string
+	subject   string
+	body      string
+}
+
+func setupLoginAlertTest(t *testing.T) *[]sentLoginAlert {
+	t.Helper()
+	t.Setenv("JWT_SECRET", "alert-secret")
+
+	previousHistoryRepo := loginHistoryRepository
+	previousSendFn := sendLoginAlertEmailFn
+	previousResolveSiteURLFn := resolveSiteURLFn
+	previousResolveMailConfigFn := resolveMailConfigFn
+	t.Cleanup(func() {
+		loginHistoryRepository = previousHistoryRepo
+		sendLoginAlertEmailFn = previousSendFn
+		resolveSiteURLFn = previousResolveSiteURLFn
+		resolveMailConfigFn = previousResolveMailConfigFn
+	})
+
+	loginHistoryRepository = &stubLoginHistoryRepository{values: map[string]bool{}}
+	resolveSiteURLFn = func() (string, error) { return "https://blog.example.com", nil }
+	resolveMailConfigFn = func() (appconfig.MailConfig, error) { return appconfig.MailConfig{}, nil }
+
+	sent := []sentLoginAlert{}
+	sendLoginAlertEmailFn = func(_ appconfig.MailConfig, recipient, subject, body string) error {
+		sent = append(sent, sentLoginAlert{recipient: recipient, subject: subject, body: body})
+		return nil
+	}
+	return &sent
+}
+
+func loginAlertTokenFromEmail(t *testing.T, body string) string {
+	t.Helper()
+
+	start := strings.Index(body, "https://blog.example.com/api/login-alert?")
+	if start < 0 {
+		t.Fatalf("expected a login alert link in %q", body)
+	}
+	end := strings.IndexByte(body[start:], '"')
+	link, err := url.Parse(strings.ReplaceAll(body[start:start+end], "&amp;", "&"))
+	if err != nil {
+		t.Fatalf("invalid login alert link: %v", err)
+	}
+	return link.Query().Get("token")
+}
+
+func TestAdminLoginAlertOnNewDeviceOrCountry(t *testing.T) {
+	sent := setupLoginAlertTest(t)
+
+	previousUsersRepo := adminUsersRepository
+	previousRefreshRepo := adminRefreshTokensRepository
+	previousResetEmailFn := sendAdminPasswordResetEmailFn
+	t.Cleanup(func() {
+		adminUsersRepository = previousUsersRepo
+		adminRefreshTokensRepository = previousRefreshRepo
+		sendAdminPasswordResetEmailFn = previousResetEmailFn
+	})
+
+	userRecord := &domain.AdminUserRecord{
+		AdminUser:       domain.AdminUser{ID: "admin-1", Email: "admin@example.com"},
+		PasswordHash:    "hash",
+		PasswordVersion: 3,
+	}
+	users := newAdminAuthEmailChangeStubUserRepository(userRecord)
+	adminUsersRepository = users
+	revokedFor := []string{}
+	adminRefreshTokensRepository = adminAuthSessionStubRefreshRepository{
+		revokeAllByUserID: func(_ context.Context, userID string, _ time.Time) error {
+			revokedFor = append(revokedFor, userID)
+			return nil
+		},
+	}
+	tokens := stubAdminAccessTokensOwnedBy(t, "admin-1")
+	resetEmails := []string{}
+	sendAdminPasswordResetEmailFn = func(_ appconfig.MailConfig, recipient, resetURL, _, _ string) error {
+		resetEmails = append(resetEmails, recipient+" "+resetURL)
+		return nil
+	}
+
+	config := appconfig.ResolveAdminConfig()
+	windowsChrome := "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0 Safari/537.36"
+	login := func(userAgent, country string) {
+		t.Helper()
+		metadata := AdminSessionMetadata{UserAgent: userAgent, RemoteIP: "203.0.113.9", CountryCode: country}
+		if _, err := testService().issueAdminTokens(context.Background(), config, userRecord, "", false, metadata); err != nil {
+			t.Fatalf("issueAdminTokens returned error: %v", err)
+		}
+	}
+
+	// The first sign-in only records history, and a newer browser version is the same device.
+	login(windowsChrome, "TR")
+	login(strings.Replace(windowsChrome, "Chrome/126.0", "Chrome/127.0", 1), "tr")
+	if len(*sent) != 0 {
+		t.Fatalf("expected no alert for known sign-ins, got %#v", *sent)
+	}
+
+	login(windowsChrome, "DE")
+	if len(*sent) != 1 || (*sent)[0].recipient != "admin@example.com" {
+		t.Fatalf("expected one alert for a new country, got %#v", *sent)
+	}
+	if !strings.Contains((*sent)[0].body, "Chrome / Windows") || !strings.Contains((*sent)[0].body, "DE") {
+		t.Fatalf("expected the alert to describe the sign-in, got %q", (*sent)[0].body)
+	}
+
+	login("Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 Version/17.0 Mobile/15E148 Safari/604.1", "DE")
+	if len(*sent) != 2 {
+		t.Fatalf("expected an alert for a new device, got %d", len(*sent))
+	}
+
+	token := loginAlertTokenFromEmail(t, (*sent)[1].body)
+	result, err := testService().SecureAccountFromLoginAlert(context.Background(), token, "en")
+	if err != nil {
+		t.Fatalf("SecureAccountFromLoginAlert returned error: %v", err)
+	}
+	if result.AccountType != domain.AccountTypeAdmin {
+		t.Fatalf("unexpected result %#v", result)
+	}
+	if userRecord.PasswordHash != "" || userRecord.PasswordVersion != 4 {
+		t.Fatalf("expected the password to be cleared, got %#v", userRecord)
+	}
+	if len(revokedFor) != 1 || revokedFor[0] != "admin-1" {
+		t.Fatalf("expected every admin session to be revoked, got %v", revokedFor)
+	}
+	if len(tokens.records) != 0 {
+		t.Fatalf("expected every access token to be revoked, got %+v", tokens.records)
+	}
+	if len(resetEmails) != 1 || !strings.HasPrefix(resetEmails[0], "admin@example.com https://blog.example.com/en/admin/reset-password?token=") {
+		t.Fatalf("expected a password reset email, got %v", resetEmails)
+	}
+	if userRecord.PendingPasswordReset == nil {
+		t.Fatal("expected a pending password reset")
+	}
+
+	// The older alert was bound to the previous password version as well.
+	for _, alert := range *sent {
+		_, err := testService().SecureAccountFromLoginAlert(context.Background(), loginAlertTokenFromEmail(t, alert.body), "en")
+		if apperrors.From(err).Code != loginAlertInvalidCode {
+			t.Fatalf("expected a used link to be rejected, got %v", err)
+		}
+	}
+}
+
+func TestReaderLoginAlertRevokesReaderSessions(t *testing.T) {
+	sent := setupLoginAlertTest(t)
+
+	previousUsersRepo := readerUsersRepository
+	previousRefreshRepo := readerRefreshTokensRepository
+	t.Cleanup(func() {
+		readerUsersRepository = previousUsersRepo
+		readerRefreshTokensRepository = previousRefreshRepo
+	})
+
+	userRecord := &domain.ReaderUserRecord{
+		ReaderUser:     domain.ReaderUser{ID: "reader-1", Email: "reader@example.com"},
+		SessionVersion: 1,
+	}
+	readerUsersRepository = stubReaderUserRepository{
+		findByID: func(_ context.Context, id string) (*domain.ReaderUserRecord, error) {
+			if id != userRecord.ID {
+				return nil, nil
+			}
+			copied := *userRecord
+			return &copied, nil
+		},
+		incrementSession: func(_ context.Context, _ string) error {
+			userRecord.SessionVersion++
+			return nil
+		},
+	}
+	revokedFor := []string{}
+	readerRefreshTokensRepository = stubReaderRefreshTokenRepository{
+		revokeAll: func(_ context.Context, userID string, _ time.Time) error {
+			revokedFor = append(revokedFor, userID)
+			return nil
+		},
+	}
+
+	config := appconfig.ResolveReaderConfig()
+	for _, country := range []string{"TR", "US"} {
+		metadata := ReaderSessionMetadata{UserAgent: "Mozilla/5.0 (X11; Linux x86_64; rv:128.0) Gecko/20100101 Firefox/128.0", CountryCode: country}
+		if _, err := testService().issueReaderTokens(context.Background(), config, userRecord, "", false, metadata); err != nil {
+			t.Fatalf("issueReaderTokens returned error: %v", err)
+		}
+	}
+	if len(*sent) != 1 || (*sent)[0].recipient != "reader@example.com" {
+		t.Fatalf("expected one reader alert, got %#v", *sent)
+	}
+
+	token := loginAlertTokenFromEmail(t, (*sent)[0].body)
+	result, err := testService().SecureAccountFromLoginAlert(context.Background(), token, "tr")
+	if err != nil {
+		t.Fatalf("SecureAccountFromLoginAlert returned error: %v", err)
+	}
+	if result.AccountType != domain.AccountTypeReader || userRecord.SessionVersion != 2 {
+		t.Fatalf("unexpected result %#v with session version %d", result, userRecord.SessionVersion)
+	}
+	if len(revokedFor) != 1 || revokedFor[0] != "reader-1" {
+		t.Fatalf("expected every reader session to be revoked, got %v", revokedFor)
+	}
+
+	if _, err := testService().SecureAccountFromLoginAlert(context.Background(), token, "tr"); apperrors.From(err).Code != loginAlertInvalidCode {
+		t.Fatalf("expected a used link to be rejected, got %v", err)
+	}
+	if _, err := testService().SecureAccountFromLoginAlert(context.Background(), "not-a-token", "tr"); apperrors.From(err).Code != loginAlertInvalidCode {
+		t.Fatalf("expected an invalid link to be rejected, got %v", err)
+	}
+}
+
+func TestDescribeLoginDevice(t *testing.T) {
+	cases := map[string]string{
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0 Safari/537.36 Edg/126.0": "Edge / Windows",
+		"Mozilla/5.0 (Macintosh; Intel Mac OS X 14_5) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.5 Safari/605.1.15":    "Safari / macOS",
+		"Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0 Mobile Safari/537.36":     "Chrome / Android",
+		"curl/8.7.1": "curl",
+		"":           "",
+	}
+
+	for userAgent, want := range cases {
+		if got := describeLoginDevice(userAgent); got != want {
+			t.Fatalf("describeLoginDevice(%q) = %q, want %q", userAgent, got, want)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/pkg/httpapi"
+)
+
+const mediaAssetPathPrefix = "/api/media/"
+
+// MediaPlaceholder describes the low-quality preview recorded for an uploaded media asset.
+type MediaPlaceholder struct {
+	Width         int
+	Height        int
+	DominantColor string
+	BlurHash      string
+}
+
+// QueryMediaPlaceholder returns the placeholder recorded for a thumbnail served from the media library. External
+// thumbnails, unknown assets and lookup failures resolve to nil so that posts still render without a placeholder.
+func (s *Service) QueryMediaPlaceholder(ctx context.Context, thumbnail string) *MediaPlaceholder {
+	record := s.findMediaAssetPreview(ctx, thumbnail)
+	if record == nil || (record.Placeholder.DominantColor == "" && record.Placeholder.BlurHash == "") {
+		return nil
+	}
+
+	return &MediaPlaceholder{
+		Width:         record.Width,
+		Height:        record.Height,
+		DominantColor: record.Placeholder.DominantColor,
+		BlurHash:      record.Placeholder.BlurHash,
+	}
+}
+
+// QueryMediaAltText returns the alt text written for a media library thumbnail in the given locale, or "" when
+// the asset has none for that locale.
+func (s *Service) QueryMediaAltText(ctx context.Context, thumbnail, locale string) string {
+	record := s.findMediaAssetPreview(ctx, thumbnail)
+	if record == nil {
+		return ""
+	}
+
+	resolvedLocale := strings.ToLower(strings.TrimSpace(locale))
+	for _, altText := range record.Metadata.AltTexts {
+		if altText.Locale == resolvedLocale {
+			return altText.Text
+		}
+	}
+	return ""
+}
+
+func (s *Service) findMediaAssetPreview(ctx context.Context, thumbnail string) *domain.AdminMediaAssetRecord {
+	assetID, ok := resolveMediaAssetIDFromURL(thumbnail, strings.TrimSpace(os.Getenv("SITE_URL")))
+	if !ok {
+		return nil
+	}
+
+	operationCtx, cancel := withTimeoutContext(ctx, 5*time.Second)
+	defer cancel()
+
+	record, err := s.adminMediaAssets.FindMediaAssetPreview(operationCtx, assetID)
+	if err != nil {
+		httpapi.LogError(ctx, "media preview lookup failed", err, slog.String("assetId", assetID))
+		return nil
+	}
+	return record
+}
+
+// resolveMediaAssetIDFromURL extracts the asset id from /api/media/{id} values, accepting absolute URLs only when
+// they point at the configured site.
+func resolveMediaAssetIDFromURL(value, siteURL string) (string, bool) {
+	parsedURL, err := url.Parse(strings.TrimSpace(value))
+	if err != nil {
+		return "", false
+	}
+	if parsedURL.Scheme != "" || parsedURL.Host != "" {
+		if !isAdminMediaSameSiteURL(parsedURL, siteURL) {
+			return "", false
+		}
+	}
+
+	cleanPath := path.Clean("/" + strings.TrimPrefix(parsedURL.Path, "/"))
+	assetID := strings.TrimPrefix(cleanPath, mediaAssetPathPrefix)
+	if assetID == cleanPath || assetID == "" || strings.Contains(assetID, "/") {
+		return "", false
+	}
+	return assetID, true
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/repository"
+)
+
+func TestResolveMediaAssetIDFromURL(t *testing.T) {
+	tests := []struct {
+		value  string
+		wantID string
+		wantOK bool
+	}{
+		{value: "/api/media/asset-1", wantID: "asset-1", wantOK: true},
+		{value: "/api/media/asset-1?w=640&format=webp", wantID: "asset-1", wantOK: true},
+		{value: "https://blog.example.com/api/media/asset-1", wantID: "asset-1", wantOK: true},
+		{value: "https://cdn.example.net/api/media/asset-1", wantOK: false},
+		{value: "/images/cover.webp", wantOK: false},
+		{value: "/api/media/", wantOK: false},
+		{value: "/api/media/asset-1/../../secret", wantOK: false},
+	}
+
+	for _, test := range tests {
+		id, ok := resolveMediaAssetIDFromURL(test.value, "https://blog.example.com")
+		if id != test.wantID || ok != test.wantOK {
+			t.Fatalf("resolveMediaAssetIDFromURL(%q) = %q, %v; want %q, %v", test.value, id, ok, test.wantID, test.wantOK)
+		}
+	}
+}
+
+func TestQueryMediaPlaceholder(t *testing.T) {
+	originalRepository := adminMediaAssetRepository
+	t.Cleanup(func() {
+		adminMediaAssetRepository = originalRepository
+	})
+
+	lookups := 0
+	adminMediaAssetRepository = adminMediaAssetStubRepository{
+		findMediaAssetPreview: func(_ context.Context, id string) (*domain.AdminMediaAssetRecord, error) {
+			lookups++
+			switch id {
+			case "asset-1":
+				return &domain.AdminMediaAssetRecord{
+					ID:          id,
+					Width:       1200,
+					Height:      630,
+					Placeholder: domain.MediaPlaceholder{DominantColor: "#336699", BlurHash: "LEHV6nWB2yk8pyo0adR*.7kCMdnj"},
+					Metadata: domain.AdminMediaAssetMetadata{
+						AltTexts: []domain.MediaAltText{{Locale: "tr", Text: "Galata Kulesi"}},
+					},
+				}, nil
+			case "legacy":
+				return &domain.AdminMediaAssetRecord{ID: id, Width: 10, Height: 10}, nil
+			case "broken":
+				return nil, repository.ErrAdminMediaAssetRepositoryUnavailable
+			default:
+				return nil, nil
+			}
+		},
+	}
+
+	placeholder := testService().QueryMediaPlaceholder(context.Background(), "/api/media/asset-1")
+	if placeholder == nil || placeholder.Width != 1200 || placeholder.Height != 630 || placeholder.DominantColor != "#336699" {
+		t.Fatalf("unexpected placeholder %#v", placeholder)
+	}
+
+	for _, thumbnail := range []string{"/api/media/legacy", "/api/media/missing", "/api/media/broken", "/images/cover.webp"} {
+		if placeholder := testService().QueryMediaPlaceholder(context.Background(), thumbnail); placeholder != nil {
+			t.Fatalf("expected nil placeholder for %q, got %#v", thumbnail, placeholder)
+		}
+	}
+	if lookups != 4 {
+		t.Fatalf("expected external thumbnails to skip the repository, got %d lookups", lookups)
+	}
+}
+
+func TestQueryMediaAltTextMatchesLocale(t *testing.T) {
+	originalRepository := adminMediaAssetRepository
+	t.Cleanup(func() {
+		adminMediaAssetRepository = originalRepository
+	})
+
+	adminMediaAssetRepository = adminMediaAssetStubRepository{
+		findMediaAssetPreview: func(_ context.Context, id string) (*domain.AdminMediaAssetRecord, error) {
+			return &domain.AdminMediaAssetRecord{
+				ID: id,
+				Metadata: domain.AdminMediaAssetMetadata{
+					AltTexts: []domain.MediaAltText{{Locale: "tr", Text: "Galata Kulesi"}},
+				},
+			}, nil
+		},
+	}
+
+	if alt := testService().QueryMediaAltText(context.Background(), "/api/media/asset-1", " TR "); alt != "Galata Kulesi" {
+		t.Fatalf("expected Turkish alt text, got %q", alt)
+	}
+	if alt := testService().QueryMediaAltText(context.Background(), "/api/media/asset-1", "en"); alt != "" {
+		t.Fatalf("expected no English alt text, got %q", alt)
+	}
+	if alt := testService().QueryMediaAltText(context.Background(), "https://cdn.example.com/cover.webp", "tr"); alt != "" {
+		t.Fatalf("expected no alt text for external thumbnails, got %q", alt)
+	}
+}
@@ -9,7 +9,6 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
-	"sync"
 	"time"
 
 	appconfig "suaybsimsek.com/blog-api/internal/config"
@@ -50,96 +49,23 @@ type ResendInput struct {
 }
 
 type Result struct {
-	Status    string
-	ForwardTo string
+	Status            string
+	ForwardTo         string
+	RetryAfterSeconds int
 }
 
 type PendingSubscription = domain.NewsletterPendingSubscription
 
-type rateLimiter struct {
-	mu              sync.Mutex
-	maxAttempts     int
-	window          time.Duration
-	cleanupInterval time.Duration
-	lastCleanup     time.Time
-	entries         map[string][]time.Time
-}
-
-func newRateLimiter(maxAttempts int, window time.Duration) *rateLimiter {
-	return &rateLimiter{
-		maxAttempts:     maxAttempts,
-		window:          window,
-		cleanupInterval: window,
-		lastCleanup:     time.Now().UTC(),
-		entries:         make(map[string][]time.Time),
-	}
-}
-
-func (limit *rateLimiter) pruneStaleEntriesLocked(cutoff time.Time) {
-	for clientID, timestamps := range limit.entries {
-		filtered := timestamps[:0]
-		for _, ts := range timestamps {
-			if ts.After(cutoff) {
-				filtered = append(filtered, ts)
-			}
-		}
-
-		if len(filtered) == 0 {
-			delete(limit.entries, clientID)
-			continue
-		}
-
-		limit.entries[clientID] = filtered
-	}
-}
-
-func (limit *rateLimiter) allow(clientID string) bool {
-	if clientID == "" {
-		return true
-	}
-
-	now := time.Now().UTC()
-	cutoff := now.Add(-limit.window)
-
-	limit.mu.Lock()
-	defer limit.mu.Unlock()
-
-	if now.Sub(limit.lastCleanup) >= limit.cleanupInterval {
-		limit.pruneStaleEntriesLocked(cutoff)
-		limit.lastCleanup = now
-	}
-
-	timestamps := limit.entries[clientID]
-	filtered := timestamps[:0]
-	for _, ts := range timestamps {
-		if ts.After(cutoff) {
-			filtered = append(filtered, ts)
-		}
-	}
-
-	if len(filtered) >= limit.maxAttempts {
-		limit.entries[clientID] = filtered
-		return false
-	}
-
-	filtered = append(filtered, now)
-	limit.entries[clientID] = filtered
-	return true
-}
-
 var (
-	subscribeLimiter                                           = newRateLimiter(5, time.Minute)
-	resendLimiter                                              = newRateLimiter(5, time.Minute)
-	newsletterRepository       repository.NewsletterRepository = repository.NewNewsletterMongoRepository()
-	resolveSiteURLFn                                           = appconfig.ResolveSiteURL
-	resolveMailConfigFn                                        = appconfig.ResolveMailConfig
-	resolveDatabaseConfigFn                                    = appconfig.ResolveDatabaseConfig
-	resolveUnsubscribeSecretFn                                 = appconfig.ResolveUnsubscribeSecret
-	parseUnsubscribeTokenFn                                    = newsletterpkg.ParseUnsubscribeToken
-	sendConfirmationEmailFn                                    = sendConfirmationEmail
-	generateConfirmTokenFn                                     = generateConfirmToken
-	nowUTCFn                                                   = func() time.Time { return time.Now().UTC() }
-	errRepositoryUnavailable                                   = repository.ErrNewsletterRepositoryUnavailable
+	resolveSiteURLFn           = appconfig.ResolveSiteURL
+	resolveMailConfigFn        = appconfig.ResolveMailConfig
+	resolveDatabaseConfigFn    = appconfig.ResolveDatabaseConfig
+	resolveUnsubscribeSecretFn = appconfig.ResolveUnsubscribeSecret
+	parseUnsubscribeTokenFn    = newsletterpkg.ParseUnsubscribeToken
+	sendConfirmationEmailFn    = sendConfirmationEmail
+	generateConfirmTokenFn     = generateConfirmToken
+	nowUTCFn                   = func() time.Time { return time.Now().UTC() }
+	errRepositoryUnavailable   = repository.ErrNewsletterRepositoryUnavailable
 )
 
 func normalizeEmail(value string) (string, error) {
@@ -239,7 +165,12 @@ type confirmationDispatch struct {
 	tokenHash    string
 }
 
-func resolveConfirmationContext(inputLocale, inputEmail string, meta RequestMetadata, limiter *rateLimiter) (confirmationContext, Result, bool) {
+func resolveConfirmationContext(
+	ctx context.Context,
+	inputLocale, inputEmail string,
+	meta RequestMetadata,
+	limiter RateLimiter,
+) (confirmationContext, Result, bool) {
 	siteURL, err := resolveSiteURLFn()
 	if err != nil {
 		return confirmationContext{}, Result{Status: statusUnknownError}, false
@@ -255,8 +186,8 @@ func resolveConfirmationContext(inputLocale, inputEmail string, meta RequestMeta
 		return confirmationContext{}, Result{Status: "invalid-email"}, false
 	}
 
-	if !limiter.allow(strings.TrimSpace(meta.ClientIP)) {
-		return confirmationContext{}, Result{Status: "rate-limited"}, false
+	if decision := limiter.Allow(ctx, strings.TrimSpace(meta.ClientIP)); !decision.Allowed {
+		return confirmationContext{}, Result{Status: "rate-limited", RetryAfterSeconds: decision.RetryAfterSeconds()}, false
 	}
 
 	return confirmationContext{
@@ -289,12 +220,12 @@ func newConfirmationDispatch(siteURL, locale string, meta RequestMetadata) (conf
 	}, nil
 }
 
-func Subscribe(ctx context.Context, input SubscribeInput, meta RequestMetadata) Result {
+func (s *Service) Subscribe(ctx context.Context, input SubscribeInput, meta RequestMetadata) Result {
 	if input.Terms {
 		return Result{Status: "success"}
 	}
 
-	confirmationCtx, result, ok := resolveConfirmationContext(input.Locale, input.Email, meta, subscribeLimiter)
+	confirmationCtx, result, ok := resolveConfirmationContext(ctx, input.Locale, input.Email, meta, s.subscribeLimiter)
 	if !ok {
 		return result
 	}
@@ -302,7 +233,7 @@ func Subscribe(ctx context.Context, input SubscribeInput, meta RequestMetadata)
 	lookupCtx, lookupCancel := context.WithTimeout(ctx, 5*time.Second)
 	defer lookupCancel()
 
-	existingStatus, found, err := newsletterRepository.GetStatusByEmail(lookupCtx, confirmationCtx.email)
+	existingStatus, found, err := s.newsletter.GetStatusByEmail(lookupCtx, confirmationCtx.email)
 	if err != nil {
 		return Result{Status: statusUnknownError}
 	}
@@ -320,7 +251,7 @@ func Subscribe(ctx context.Context, input SubscribeInput, meta RequestMetadata)
 	defer updateCancel()
 	createdAt := dispatch.requestedAt
 
-	err = newsletterRepository.UpsertPendingSubscription(updateCtx, domain.NewsletterPendingSubscription{
+	err = s.newsletter.UpsertPendingSubscription(updateCtx, domain.NewsletterPendingSubscription{
 		Email:                 confirmationCtx.email,
 		Locale:                confirmationCtx.locale,
 		Tags:                  normalizeTags(input.Tags),
@@ -345,12 +276,12 @@ func Subscribe(ctx context.Context, input SubscribeInput, meta RequestMetadata)
 	return Result{Status: "success"}
 }
 
-func Resend(ctx context.Context, input ResendInput, meta RequestMetadata) Result {
+func (s *Service) Resend(ctx context.Context, input ResendInput, meta RequestMetadata) Result {
 	if input.Terms {
 		return Result{Status: "success"}
 	}
 
-	confirmationCtx, result, ok := resolveConfirmationContext(input.Locale, input.Email, meta, resendLimiter)
+	confirmationCtx, result, ok := resolveConfirmationContext(ctx, input.Locale, input.Email, meta, s.resendLimiter)
 	if !ok {
 		return result
 	}
@@ -358,7 +289,7 @@ func Resend(ctx context.Context, input ResendInput, meta RequestMetadata) Result
 	lookupCtx, lookupCancel := context.WithTimeout(ctx, 5*time.Second)
 	defer lookupCancel()
 
-	existingStatus, found, err := newsletterRepository.GetStatusByEmail(lookupCtx, confirmationCtx.email)
+	existingStatus, found, err := s.newsletter.GetStatusByEmail(lookupCtx, confirmationCtx.email)
 	if err != nil {
 		return Result{Status: statusUnknownError}
 	}
@@ -378,7 +309,7 @@ func Resend(ctx context.Context, input ResendInput, meta RequestMetadata) Result
 	updateCtx, updateCancel := context.WithTimeout(ctx, 10*time.Second)
 	defer updateCancel()
 
-	err = newsletterRepository.UpdatePendingSubscription(updateCtx, domain.NewsletterPendingSubscription{
+	err = s.newsletter.UpdatePendingSubscription(updateCtx, domain.NewsletterPendingSubscription{
 		Email:                 confirmationCtx.email,
 		Locale:                confirmationCtx.locale,
 		UpdatedAt:             dispatch.requestedAt,
@@ -399,7 +330,7 @@ func Resend(ctx context.Context, input ResendInput, meta RequestMetadata) Result
 	return Result{Status: "success"}
 }
 
-func Confirm(ctx context.Context, token string) Result {
+func (s *Service) Confirm(ctx context.Context, token string) Result {
 	if strings.TrimSpace(token) == "" {
 		return Result{Status: statusInvalidLink}
 	}
@@ -413,7 +344,7 @@ func Confirm(ctx context.Context, token string) Result {
 	updateCtx, updateCancel := context.WithTimeout(ctx, 10*time.Second)
 	defer updateCancel()
 
-	matched, err := newsletterRepository.ConfirmByTokenHash(updateCtx, hashValue(strings.TrimSpace(token)), now)
+	matched, err := s.newsletter.ConfirmByTokenHash(updateCtx, hashValue(strings.TrimSpace(token)), now)
 	if err != nil {
 		if errors.Is(err, repository.ErrNewsletterRepositoryUnavailable) {
 			return Result{Status: "service-unavailable"}
@@ -427,7 +358,7 @@ func Confirm(ctx context.Context, token string) Result {
 	return Result{Status: "success"}
 }
 
-func Unsubscribe(ctx context.Context, token string) Result {
+func (s *Service) Unsubscribe(ctx context.Context, token string) Result {
 	if strings.TrimSpace(token) == "" {
 		return Result{Status: statusInvalidLink}
 	}
@@ -451,7 +382,7 @@ func Unsubscribe(ctx context.Context, token string) Result {
 	updateCtx, updateCancel := context.WithTimeout(ctx, 10*time.Second)
 	defer updateCancel()
 
-	err = newsletterRepository.UnsubscribeByEmail(updateCtx, email, now)
+	err = s.newsletter.UnsubscribeByEmail(updateCtx, email, now)
 	if err != nil {
 		if errors.Is(err, repository.ErrNewsletterRepositoryUnavailable) {
 			return Result{Status: "service-unavailable"}
@@ -178,7 +178,7 @@ func TestSubscribeAndResend(t *testing.T) {
 		unsubscribeByEmail: func(context.Context, string, time.Time) error { return nil },
 	}
 
-	subscribeResult := Subscribe(context.Background(), SubscribeInput{
+	subscribeResult := testService().Subscribe(context.Background(), SubscribeInput{
 		Locale:   "tr",
 		Email:    " Reader@Example.com ",
 		Tags:     []string{"news", "news", ""},
@@ -197,7 +197,7 @@ func TestSubscribeAndResend(t *testing.T) {
 		t.Fatalf("stored token data = %#v", stored)
 	}
 
-	resendResult := Resend(context.Background(), ResendInput{
+	resendResult := testService().Resend(context.Background(), ResendInput{
 		Locale: "tr",
 		Email:  "reader@example.com",
 	}, RequestMetadata{ClientIP: "127.0.0.2", UserAgent: "Browser", AcceptLanguage: "tr-TR"})
@@ -260,10 +260,10 @@ func TestNewsletterServiceBranches(t *testing.T) {
 		},
 	}
 
-	if result := Subscribe(context.Background(), SubscribeInput{Terms: true}, RequestMetadata{}); result.Status != "success" {
+	if result := testService().Subscribe(context.Background(), SubscribeInput{Terms: true}, RequestMetadata{}); result.Status != "success" {
 		t.Fatalf("terms subscribe = %#v", result)
 	}
-	if result := Subscribe(context.Background(), SubscribeInput{Email: "reader@example.com"}, RequestMetadata{}); result.Status != "unknown-error" {
+	if result := testService().Subscribe(context.Background(), SubscribeInput{Email: "reader@example.com"}, RequestMetadata{}); result.Status != "unknown-error" {
 		t.Fatalf("site url subscribe = %#v", result)
 	}
 
@@ -271,18 +271,18 @@ func TestNewsletterServiceBranches(t *testing.T) {
 	resolveMailConfigFn = func() (appconfig.MailConfig, error) {
 		return appconfig.MailConfig{}, errors.New("missing smtp")
 	}
-	if result := Subscribe(context.Background(), SubscribeInput{Email: "reader@example.com"}, RequestMetadata{}); result.Status != "unknown-error" {
+	if result := testService().Subscribe(context.Background(), SubscribeInput{Email: "reader@example.com"}, RequestMetadata{}); result.Status != "unknown-error" {
 		t.Fatalf("smtp subscribe = %#v", result)
 	}
 
 	resolveMailConfigFn = func() (appconfig.MailConfig, error) { return appconfig.MailConfig{}, nil }
-	if result := Subscribe(context.Background(), SubscribeInput{Email: "bad-email"}, RequestMetadata{}); result.Status != "invalid-email" {
+	if result := testService().Subscribe(context.Background(), SubscribeInput{Email: "bad-email"}, RequestMetadata{}); result.Status != "invalid-email" {
 		t.Fatalf("invalid email subscribe = %#v", result)
 	}
-	if result := Subscribe(context.Background(), SubscribeInput{Email: "reader@example.com"}, RequestMetadata{ClientIP: "127.0.0.1"}); result.Status != "unknown-error" {
+	if result := testService().Subscribe(context.Background(), SubscribeInput{Email: "reader@example.com"}, RequestMetadata{ClientIP: "127.0.0.1"}); result.Status != "unknown-error" {
 		t.Fatalf("repository subscribe = %#v", result)
 	}
-	if result := Subscribe(context.Background(), SubscribeInput{Email: "reader@example.com"}, RequestMetadata{ClientIP: "127.0.0.1"}); result.Status != "rate-limited" {
+	if result := testService().Subscribe(context.Background(), SubscribeInput{Email: "reader@example.com"}, RequestMetadata{ClientIP: "127.0.0.1"}); result.Status != "rate-limited" {
 		t.Fatalf("rate limited subscribe = %#v", result)
 	}
 
@@ -294,31 +294,31 @@ func TestNewsletterServiceBranches(t *testing.T) {
 		unsubscribeByEmail:        func(context.Context, string, time.Time) error { return nil },
 	}
 	resendLimiter = newRateLimiter(1, time.Hour)
-	if result := Resend(context.Background(), ResendInput{Terms: true}, RequestMetadata{}); result.Status != "success" {
+	if result := testService().Resend(context.Background(), ResendInput{Terms: true}, RequestMetadata{}); result.Status != "success" {
 		t.Fatalf("terms resend = %#v", result)
 	}
-	if result := Resend(context.Background(), ResendInput{Email: "bad"}, RequestMetadata{}); result.Status != "invalid-email" {
+	if result := testService().Resend(context.Background(), ResendInput{Email: "bad"}, RequestMetadata{}); result.Status != "invalid-email" {
 		t.Fatalf("invalid email resend = %#v", result)
 	}
-	if result := Resend(context.Background(), ResendInput{Email: "reader@example.com"}, RequestMetadata{ClientIP: "127.0.0.1"}); result.Status != "success" {
+	if result := testService().Resend(context.Background(), ResendInput{Email: "reader@example.com"}, RequestMetadata{ClientIP: "127.0.0.1"}); result.Status != "success" {
 		t.Fatalf("active resend = %#v", result)
 	}
-	if result := Resend(context.Background(), ResendInput{Email: "reader@example.com"}, RequestMetadata{ClientIP: "127.0.0.1"}); result.Status != "rate-limited" {
+	if result := testService().Resend(context.Background(), ResendInput{Email: "reader@example.com"}, RequestMetadata{ClientIP: "127.0.0.1"}); result.Status != "rate-limited" {
 		t.Fatalf("rate limited resend = %#v", result)
 	}
 
 	resolveDatabaseConfigFn = func() (appconfig.DatabaseConfig, error) { return appconfig.DatabaseConfig{}, errors.New("missing db") }
-	if result := Confirm(context.Background(), ""); result.Status != "invalid-link" {
+	if result := testService().Confirm(context.Background(), ""); result.Status != "invalid-link" {
 		t.Fatalf("empty confirm = %#v", result)
 	}
-	if result := Confirm(context.Background(), "token"); result.Status != "config-error" {
+	if result := testService().Confirm(context.Background(), "token"); result.Status != "config-error" {
 		t.Fatalf("config confirm = %#v", result)
 	}
 
 	resolveDatabaseConfigFn = func() (appconfig.DatabaseConfig, error) {
 		return appconfig.DatabaseConfig{Name: "blog"}, nil
 	}
-	if result := Confirm(context.Background(), "token"); result.Status != "expired" {
+	if result := testService().Confirm(context.Background(), "token"); result.Status != "expired" {
 		t.Fatalf("expired confirm = %#v", result)
 	}
 	newsletterRepository = newsletterStubRepository{
@@ -328,20 +328,20 @@ func TestNewsletterServiceBranches(t *testing.T) {
 		confirmByTokenHash:        func(context.Context, string, time.Time) (bool, error) { return true, nil },
 		unsubscribeByEmail:        func(context.Context, string, time.Time) error { return nil },
 	}
-	if result := Confirm(context.Background(), "token"); result.Status != "success" {
+	if result := testService().Confirm(context.Background(), "token"); result.Status != "success" {
 		t.Fatalf("success confirm = %#v", result)
 	}
 
 	resolveUnsubscribeSecretFn = func() (string, error) { return "", errors.New("missing secret") }
-	if result := Unsubscribe(context.Background(), ""); result.Status != "invalid-link" {
+	if result := testService().Unsubscribe(context.Background(), ""); result.Status != "invalid-link" {
 		t.Fatalf("empty unsubscribe = %#v", result)
 	}
-	if result := Unsubscribe(context.Background(), "token"); result.Status != "config-error" {
+	if result := testService().Unsubscribe(context.Background(), "token"); result.Status != "config-error" {
 		t.Fatalf("config secret unsubscribe = %#v", result)
 	}
 	resolveUnsubscribeSecretFn = func() (string, error) { return "secret", nil }
 	parseUnsubscribeTokenFn = func(string, string, time.Time) (string, error) { return "", errors.New("bad token") }
-	if result := Unsubscribe(context.Background(), "token"); result.Status != "invalid-link" {
+	if result := testService().Unsubscribe(context.Background(), "token"); result.Status != "invalid-link" {
 		t.Fatalf("invalid token unsubscribe = %#v", result)
 	}
 
@@ -353,7 +353,7 @@ func TestNewsletterServiceBranches(t *testing.T) {
 		confirmByTokenHash:        func(context.Context, string, time.Time) (bool, error) { return false, nil },
 		unsubscribeByEmail:        func(context.Context, string, time.Time) error { return errRepositoryUnavailable },
 	}
-	if result := Unsubscribe(context.Background(), "token"); result.Status != "service-unavailable" {
+	if result := testService().Unsubscribe(context.Background(), "token"); result.Status != "service-unavailable" {
 		t.Fatalf("service unavailable unsubscribe = %#v", result)
 	}
 	newsletterRepository = newsletterStubRepository{
@@ -363,7 +363,7 @@ func TestNewsletterServiceBranches(t *testing.T) {
 		confirmByTokenHash:        func(context.Context, string, time.Time) (bool, error) { return false, nil },
 		unsubscribeByEmail:        func(context.Context, string, time.Time) error { return nil },
 	}
-	if result := Unsubscribe(context.Background(), "token"); result.Status != "success" {
+	if result := testService().Unsubscribe(context.Background(), "token"); result.Status != "success" {
 		t.Fatalf("success unsubscribe = %#v", result)
 	}
 }
@@ -424,7 +424,7 @@ func TestNewsletterServiceAdditionalBranches(t *testing.T) {
 			unsubscribeByEmail:        func(context.Context, string, time.Time) error { return nil },
 		}
 
-		if result := Subscribe(context.Background(), SubscribeInput{Email: "reader@example.com"}, RequestMetadata{ClientIP: "203.0.113.1"}); result.Status != "success" {
+		if result := testService().Subscribe(context.Background(), SubscribeInput{Email: "reader@example.com"}, RequestMetadata{ClientIP: "203.0.113.1"}); result.Status != "success" {
 			t.Fatalf("active subscribe = %#v", result)
 		}
 	})
@@ -441,13 +441,13 @@ func TestNewsletterServiceAdditionalBranches(t *testing.T) {
 
 		resolveSiteURLFn = func() (string, error) { return "https://example.com", nil }
 		generateConfirmTokenFn = func() (string, error) { return "", errors.New("boom") }
-		if result := Subscribe(context.Background(), SubscribeInput{Email: "reader@example.com"}, RequestMetadata{ClientIP: "203.0.113.2"}); result.Status != statusUnknownError {
+		if result := testService().Subscribe(context.Background(), SubscribeInput{Email: "reader@example.com"}, RequestMetadata{ClientIP: "203.0.113.2"}); result.Status != statusUnknownError {
 			t.Fatalf("token failure subscribe = %#v", result)
 		}
 
 		generateConfirmTokenFn = func() (string, error) { return "token", nil }
 		resolveSiteURLFn = func() (string, error) { return "not-a-url", nil }
-		if result := Subscribe(context.Background(), SubscribeInput{Email: "reader@example.com"}, RequestMetadata{ClientIP: "203.0.113.3"}); result.Status != statusUnknownError {
+		if result := testService().Subscribe(context.Background(), SubscribeInput{Email: "reader@example.com"}, RequestMetadata{ClientIP: "203.0.113.3"}); result.Status != statusUnknownError {
 			t.Fatalf("url failure subscribe = %#v", result)
 		}
 
@@ -461,7 +461,7 @@ func TestNewsletterServiceAdditionalBranches(t *testing.T) {
 			confirmByTokenHash:        func(context.Context, string, time.Time) (bool, error) { return false, nil },
 			unsubscribeByEmail:        func(context.Context, string, time.Time) error { return nil },
 		}
-		if result := Subscribe(context.Background(), SubscribeInput{Email: "reader@example.com"}, RequestMetadata{ClientIP: "203.0.113.4"}); result.Status != statusUnknownError {
+		if result := testService().Subscribe(context.Background(), SubscribeInput{Email: "reader@example.com"}, RequestMetadata{ClientIP: "203.0.113.4"}); result.Status != statusUnknownError {
 			t.Fatalf("upsert failure subscribe = %#v", result)
 		}
 
@@ -475,7 +475,7 @@ func TestNewsletterServiceAdditionalBranches(t *testing.T) {
 		sendConfirmationEmailFn = func(appconfig.MailConfig, string, string, string, string) error {
 			return errors.New("smtp failure")
 		}
-		if result := Subscribe(context.Background(), SubscribeInput{Email: "reader@example.com"}, RequestMetadata{ClientIP: "203.0.113.5"}); result.Status != statusUnknownError {
+		if result := testService().Subscribe(context.Background(), SubscribeInput{Email: "reader@example.com"}, RequestMetadata{ClientIP: "203.0.113.5"}); result.Status != statusUnknownError {
 			t.Fatalf("email failure subscribe = %#v", result)
 		}
 	})
@@ -493,7 +493,7 @@ func TestNewsletterServiceAdditionalBranches(t *testing.T) {
 			confirmByTokenHash:        func(context.Context, string, time.Time) (bool, error) { return false, nil },
 			unsubscribeByEmail:        func(context.Context, string, time.Time) error { return nil },
 		}
-		if result := Resend(context.Background(), ResendInput{Email: "reader@example.com"}, RequestMetadata{ClientIP: "203.0.113.10"}); result.Status != "success" {
+		if result := testService().Resend(context.Background(), ResendInput{Email: "reader@example.com"}, RequestMetadata{ClientIP: "203.0.113.10"}); result.Status != "success" {
 			t.Fatalf("not found resend = %#v", result)
 		}
 
@@ -505,13 +505,13 @@ func TestNewsletterServiceAdditionalBranches(t *testing.T) {
 			unsubscribeByEmail:        func(context.Context, string, time.Time) error { return nil },
 		}
 		generateConfirmTokenFn = func() (string, error) { return "", errors.New("boom") }
-		if result := Resend(context.Background(), ResendInput{Email: "reader@example.com"}, RequestMetadata{ClientIP: "203.0.113.11"}); result.Status != statusUnknownError {
+		if result := testService().Resend(context.Background(), ResendInput{Email: "reader@example.com"}, RequestMetadata{ClientIP: "203.0.113.11"}); result.Status != statusUnknownError {
 			t.Fatalf("token failure resend = %#v", result)
 		}
 
 		generateConfirmTokenFn = func() (string, error) { return "token", nil }
 		resolveSiteURLFn = func() (string, error) { return "not-a-url", nil }
-		if result := Resend(context.Background(), ResendInput{Email: "reader@example.com"}, RequestMetadata{ClientIP: "203.0.113.12"}); result.Status != statusUnknownError {
+		if result := testService().Resend(context.Background(), ResendInput{Email: "reader@example.com"}, RequestMetadata{ClientIP: "203.0.113.12"}); result.Status != statusUnknownError {
 			t.Fatalf("url failure resend = %#v", result)
 		}
 
@@ -527,7 +527,7 @@ func TestNewsletterServiceAdditionalBranches(t *testing.T) {
 			confirmByTokenHash: func(context.Context, string, time.Time) (bool, error) { return false, nil },
 			unsubscribeByEmail: func(context.Context, string, time.Time) error { return nil },
 		}
-		if result := Resend(context.Background(), ResendInput{Email: "reader@example.com"}, RequestMetadata{ClientIP: "203.0.113.13"}); result.Status != statusUnknownError {
+		if result := testService().Resend(context.Background(), ResendInput{Email: "reader@example.com"}, RequestMetadata{ClientIP: "203.0.113.13"}); result.Status != statusUnknownError {
 			t.Fatalf("update failure resend = %#v", result)
 		}
 
@@ -541,7 +541,7 @@ func TestNewsletterServiceAdditionalBranches(t *testing.T) {
 		sendConfirmationEmailFn = func(appconfig.MailConfig, string, string, string, string) error {
 			return errors.New("smtp failure")
 		}
-		if result := Resend(context.Background(), ResendInput{Email: "reader@example.com"}, RequestMetadata{ClientIP: "203.0.113.14"}); result.Status != statusUnknownError {
+		if result := testService().Resend(context.Background(), ResendInput{Email: "reader@example.com"}, RequestMetadata{ClientIP: "203.0.113.14"}); result.Status != statusUnknownError {
 			t.Fatalf("email failure resend = %#v", result)
 		}
 	})
@@ -557,10 +557,10 @@ func TestNewsletterServiceAdditionalBranches(t *testing.T) {
 			unsubscribeByEmail:        func(context.Context, string, time.Time) error { return errors.New("db failure") },
 		}
 
-		if result := Confirm(context.Background(), "token"); result.Status != "failed" {
+		if result := testService().Confirm(context.Background(), "token"); result.Status != "failed" {
 			t.Fatalf("failed confirm = %#v", result)
 		}
-		if result := Unsubscribe(context.Background(), "token"); result.Status != "failed" {
+		if result := testService().Unsubscribe(context.Background(), "token"); result.Status != "failed" {
 			t.Fatalf("failed unsubscribe = %#v", result)
 		}
 	})
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/oidc"
+)
+
+// OIDCProvider is a configured generic OpenID Connect provider as shown on sign-in pages.
+type OIDCProvider struct {
+	ID   string
+	Name string
+}
+
+// OIDCIdentity is the verified user returned by a generic OpenID Connect provider.
+type OIDCIdentity struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	AvatarURL     string
+}
+
+// OIDCAuthorization is a started sign-in. URL sends the browser to the provider; Request must be kept by the caller,
+// out of reach of the provider, until the callback arrives.
+type OIDCAuthorization struct {
+	URL     string
+	Request oidc.AuthRequest
+}
+
+const (
+	oidcUnavailableMessage     = "sign-in provider is unavailable"
+	oidcUnknownProviderMessage = "sign-in provider is not configured"
+	oidcInvalidIdentityMessage = "sign-in provider account is invalid"
+)
+
+var (
+	resolveOIDCProvidersFn = appconfig.ResolveOIDCProviders
+	oidcClient             = oidc.NewClient(nil)
+)
+
+// ListOIDCProviders returns the generic OpenID Connect providers available for sign-in.
+func ListOIDCProviders() ([]OIDCProvider, error) {
+	configs, err := resolveOIDCProvidersFn()
+	if err != nil {
+		return nil, apperrors.Config("oidc providers are misconfigured", err)
+	}
+
+	providers := make([]OIDCProvider, 0, len(configs))
+	for _, config := range configs {
+		providers = append(providers, OIDCProvider{ID: config.ID, Name: config.Name})
+	}
+
+	return providers, nil
+}
+
+// StartOIDCAuthorization prepares a sign-in with a fresh state, nonce and PKCE verifier.
+func StartOIDCAuthorization(ctx context.Context, providerID, redirectURI string) (*OIDCAuthorization, error) {
+	config, err := resolveOIDCProviderConfig(providerID)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := oidc.NewAuthRequest()
+	if err != nil {
+		return nil, apperrors.Internal("failed to start sign-in", err)
+	}
+
+	authorizeURL, err := oidcClient.AuthorizeURL(ctx, toOIDCProvider(config), redirectURI, request)
+	if err != nil {
+		return nil, apperrors.ServiceUnavailable(oidcUnavailableMessage, err)
+	}
+
+	return &OIDCAuthorization{URL: authorizeURL, Request: request}, nil
+}
+
+// ResolveOIDCIdentityFromCode redeems the authorization code with the PKCE verifier and checks the ID token against
+// the nonce of the started sign-in.
+func ResolveOIDCIdentityFromCode(
+	ctx context.Context,
+	providerID string,
+	code string,
+	redirectURI string,
+	request oidc.AuthRequest,
+) (*OIDCIdentity, error) {
+	config, err := resolveOIDCProviderConfig(providerID)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := oidcClient.Authenticate(ctx, toOIDCProvider(config), strings.TrimSpace(code), redirectURI, request)
+	if err != nil {
+		if errors.Is(err, oidc.ErrInvalidIDToken) || errors.Is(err, oidc.ErrNonceMismatch) {
+			return nil, apperrors.BadRequest(oidcInvalidIdentityMessage)
+		}
+		return nil, apperrors.ServiceUnavailable(oidcUnavailableMessage, err)
+	}
+
+	name := claims.Name
+	if name == "" {
+		name = claims.PreferredUsername
+	}
+
+	return &OIDCIdentity{
+		Provider:      config.ID,
+		Subject:       strings.TrimSpace(claims.Subject),
+		Email:         strings.TrimSpace(strings.ToLower(claims.Email)),
+		EmailVerified: claims.EmailVerified,
+		Name:          strings.TrimSpace(name),
+		AvatarURL:     strings.TrimSpace(claims.Picture),
+	}, nil
+}
+
+// resolveOIDCProviderName returns the display name of a provider, or its id when it is no longer configured.
+func resolveOIDCProviderName(providerID string) string {
+	configs, err := resolveOIDCProvidersFn()
+	if err == nil {
+		for _, config := range configs {
+			if config.ID == providerID {
+				return config.Name
+			}
+		}
+	}
+
+	return providerID
+}
+
+func resolveOIDCProviderConfig(providerID string) (appconfig.OIDCProviderConfig, error) {
+	configs, err := resolveOIDCProvidersFn()
+	if err != nil {
+		return appconfig.OIDCProviderConfig{}, apperrors.Config("oidc providers are misconfigured", err)
+	}
+
+	resolvedID := strings.ToLower(strings.TrimSpace(providerID))
+	for _, config := range configs {
+		if config.ID == resolvedID {
+			return config, nil
+		}
+	}
+
+	return appconfig.OIDCProviderConfig{}, apperrors.BadRequest(oidcUnknownProviderMessage)
+}
+
+func normalizeOIDCIdentity(identity *OIDCIdentity) (*OIDCIdentity, error) {
+	if identity == nil {
+		return nil, apperrors.BadRequest(oidcInvalidIdentityMessage)
+	}
+
+	normalized := *identity
+	normalized.Provider = strings.ToLower(strings.TrimSpace(identity.Provider))
+	normalized.Subject = strings.TrimSpace(identity.Subject)
+	normalized.Email = strings.TrimSpace(strings.ToLower(identity.Email))
+	if normalized.Provider == "" || normalized.Subject == "" {
+		return nil, apperrors.BadRequest(oidcInvalidIdentityMessage)
+	}
+
+	return &normalized, nil
+}
+
+func toOIDCProvider(config appconfig.OIDCProviderConfig) oidc.Provider {
+	return oidc.Provider{
+		Issuer:       config.Issuer,
+		ClientID:     config.ClientID,
+		ClientSecret: config.ClientSecret,
+		Scopes:       config.Scopes,
+	}
+}
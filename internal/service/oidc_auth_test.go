@@ -0,0 +1,383 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/repository"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/oidc"
+	"suaybsimsek.com/blog-api/pkg/oidc/oidctest"
+	"suaybsimsek.com/blog-api/pkg/totp"
+)
+
+const testOIDCRedirectURI = "https://blog.example.com/api/oidc/callback"
+
+type stubOIDCIdentityRepository struct {
+	mu      sync.Mutex
+	records []domain.OIDCIdentityRecord
+}
+
+func (s *stubOIDCIdentityRepository) FindBySubject(
+	_ context.Context,
+	accountType, provider, subject string,
+) (*domain.OIDCIdentityRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, record := range s.records {
+		if record.AccountType == accountType && record.Provider == provider && record.Subject == subject {
+			found := record
+			return &found, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *stubOIDCIdentityRepository) ListByUserID(
+	_ context.Context,
+	accountType, userID string,
+) ([]domain.OIDCIdentityRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	records := make([]domain.OIDCIdentityRecord, 0)
+	for _, record := range s.records {
+		if record.AccountType == accountType && record.UserID == userID {
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+func (s *stubOIDCIdentityRepository) HasAnyByProvider(_ context.Context, accountType, provider string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, record := range s.records {
+		if record.AccountType == accountType && record.Provider == provider {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *stubOIDCIdentityRepository) Link(_ context.Context, record domain.OIDCIdentityRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for index, existing := range s.records {
+		if existing.AccountType != record.AccountType || existing.Provider != record.Provider {
+			continue
+		}
+		if existing.Subject == record.Subject && existing.UserID != record.UserID {
+			return repository.ErrOIDCIdentityAlreadyLinked
+		}
+		if existing.UserID == record.UserID {
+			s.records[index] = record
+			return nil
+		}
+	}
+	s.records = append(s.records, record)
+	return nil
+}
+
+func (s *stubOIDCIdentityRepository) Unlink(_ context.Context, accountType, userID, provider string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for index, existing := range s.records {
+		if existing.AccountType == accountType && existing.UserID == userID && existing.Provider == provider {
+			s.records = append(s.records[:index], s.records[index+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *stubOIDCIdentityRepository) DeleteByUserID(_ context.Context, accountType, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kept := s.records[:0]
+	for _, record := range s.records {
+		if record.AccountType != accountType || record.UserID != userID {
+			kept = append(kept, record)
+		}
+	}
+	s.records = kept
+	return nil
+}
+
+func setupOIDCTestProvider(t *testing.T) (*oidctest.Issuer, *stubOIDCIdentityRepository) {
+	t.Helper()
+
+	issuer := oidctest.NewIssuer(t)
+	t.Setenv("OIDC_PROVIDERS", "gitlab")
+	t.Setenv("OIDC_GITLAB_NAME", "GitLab")
+	t.Setenv("OIDC_GITLAB_ISSUER", issuer.URL())
+	t.Setenv("OIDC_GITLAB_CLIENT_ID", issuer.ClientID)
+	t.Setenv("OIDC_GITLAB_CLIENT_SECRET", issuer.ClientSecret)
+
+	previousClient := oidcClient
+	previousRepo := oidcIdentitiesRepository
+	identities := &stubOIDCIdentityRepository{}
+	oidcClient = oidc.NewClient(nil)
+	oidcIdentitiesRepository = identities
+	t.Cleanup(func() {
+		oidcClient = previousClient
+		oidcIdentitiesRepository = previousRepo
+	})
+
+	return issuer, identities
+}
+
+func signInWithOIDCTestProvider(t *testing.T, issuer *oidctest.Issuer) *OIDCIdentity {
+	t.Helper()
+
+	authorization, err := StartOIDCAuthorization(context.Background(), "gitlab", testOIDCRedirectURI)
+	if err != nil {
+		t.Fatalf("StartOIDCAuthorization returned error: %v", err)
+	}
+	callback := issuer.Authorize(t, authorization.URL)
+	if callback.Get("state") != authorization.Request.State {
+		t.Fatalf("unexpected callback %v", callback)
+	}
+
+	identity, err := ResolveOIDCIdentityFromCode(
+		context.Background(),
+		"gitlab",
+		callback.Get("code"),
+		testOIDCRedirectURI,
+		authorization.Request,
+	)
+	if err != nil {
+		t.Fatalf("ResolveOIDCIdentityFromCode returned error: %v", err)
+	}
+	return identity
+}
+
+func TestResolveOIDCIdentityFromCodeAgainstMockIssuer(t *testing.T) {
+	issuer, _ := setupOIDCTestProvider(t)
+
+	identity := signInWithOIDCTestProvider(t, issuer)
+	if identity.Provider != "gitlab" || identity.Subject != "oidc-user-1" || identity.Email != "reader@example.com" ||
+		!identity.EmailVerified || identity.Name != "OIDC Reader" {
+		t.Fatalf("unexpected identity %#v", identity)
+	}
+
+	authorization, err := StartOIDCAuthorization(context.Background(), "gitlab", testOIDCRedirectURI)
+	if err != nil {
+		t.Fatalf("StartOIDCAuthorization returned error: %v", err)
+	}
+	callback := issuer.Authorize(t, authorization.URL)
+	tampered := authorization.Request
+	tampered.Nonce = "another-nonce"
+	_, err = ResolveOIDCIdentityFromCode(context.Background(), "gitlab", callback.Get("code"), testOIDCRedirectURI, tampered)
+	if appErr := apperrors.From(err); appErr == nil || appErr.Message != oidcInvalidIdentityMessage {
+		t.Fatalf("expected invalid identity error, got %v", err)
+	}
+
+	if _, err := StartOIDCAuthorization(context.Background(), "keycloak", testOIDCRedirectURI); err == nil ||
+		!strings.Contains(err.Error(), oidcUnknownProviderMessage) {
+		t.Fatalf("expected unknown provider error, got %v", err)
+	}
+}
+
+func TestLoginReaderWithOIDCIdentity(t *testing.T) {
+	t.Setenv("JWT_SECRET", "reader-secret")
+	issuer, identities := setupOIDCTestProvider(t)
+
+	previousUsersRepo := readerUsersRepository
+	previousRefreshRepo := readerRefreshTokensRepository
+	t.Cleanup(func() {
+		readerUsersRepository = previousUsersRepo
+		readerRefreshTokensRepository = previousRefreshRepo
+	})
+
+	readers := map[string]domain.ReaderUserRecord{}
+	readerUsersRepository = stubReaderUserRepository{
+		findByID: func(_ context.Context, id string) (*domain.ReaderUserRecord, error) {
+			record, ok := readers[id]
+			if !ok {
+				return nil, nil
+			}
+			return &record, nil
+		},
+		create: func(_ context.Context, record domain.ReaderUserRecord) error {
+			readers[record.ID] = record
+			return nil
+		},
+		updateLastSeenProvider: func(_ context.Context, id, provider string) error {
+			record, ok := readers[id]
+			if !ok {
+				return repository.ErrReaderUserNotFound
+			}
+			record.LastLoginProvider = provider
+			readers[id] = record
+			return nil
+		},
+	}
+	readerRefreshTokensRepository = stubReaderRefreshTokenRepository{
+		create: func(context.Context, domain.ReaderRefreshTokenRecord) error { return nil },
+	}
+
+	response, err := testService().LoginReaderWithOIDCIdentity(context.Background(), signInWithOIDCTestProvider(t, issuer), false, ReaderSessionMetadata{})
+	if err != nil {
+		t.Fatalf("LoginReaderWithOIDCIdentity returned error: %v", err)
+	}
+	if response == nil || response.AccessToken == "" || response.User == nil || response.User.LastLoginProvider != "gitlab" {
+		t.Fatalf("unexpected response %#v", response)
+	}
+	readerID := response.User.ID
+
+	// The verified email is no longer needed once the identity is linked.
+	issuer.User.Email = "changed@example.com"
+	issuer.User.EmailVerified = false
+	second, err := testService().LoginReaderWithOIDCIdentity(context.Background(), signInWithOIDCTestProvider(t, issuer), false, ReaderSessionMetadata{})
+	if err != nil || second.User.ID != readerID || len(readers) != 1 {
+		t.Fatalf("expected the linked reader, got %#v, %v", second, err)
+	}
+
+	providers, err := testService().ListReaderOIDCProviders(context.Background(), readerID)
+	if err != nil || len(providers) != 1 || providers[0] != "gitlab" {
+		t.Fatalf("ListReaderOIDCProviders() = %v, %v", providers, err)
+	}
+
+	issuer.User.Subject = "oidc-user-2"
+	if _, err := testService().LoginReaderWithOIDCIdentity(context.Background(), signInWithOIDCTestProvider(t, issuer), false, ReaderSessionMetadata{}); err == nil ||
+		!strings.Contains(err.Error(), "not verified") {
+		t.Fatalf("expected unverified email error, got %v", err)
+	}
+
+	unlinked, err := testService().UnlinkReaderOIDCAccount(context.Background(), &domain.ReaderUser{ID: readerID}, "GitLab")
+	if err != nil || !unlinked || len(identities.records) != 0 {
+		t.Fatalf("UnlinkReaderOIDCAccount() = %v, %v", unlinked, err)
+	}
+}
+
+func TestLinkReaderOIDCAccountRejectsIdentityOfAnotherReader(t *testing.T) {
+	_, identities := setupOIDCTestProvider(t)
+	identities.records = []domain.OIDCIdentityRecord{{
+		AccountType: domain.OIDCAccountTypeReader,
+		UserID:      "reader-1",
+		Provider:    "gitlab",
+		Subject:     "oidc-user-1",
+	}}
+
+	identity := &OIDCIdentity{Provider: "gitlab", Subject: "oidc-user-1"}
+	if err := testService().LinkReaderOIDCAccount(context.Background(), "reader-2", identity); err == nil ||
+		!strings.Contains(err.Error(), readerOIDCAlreadyLinkedMessage) {
+		t.Fatalf("expected conflict, got %v", err)
+	}
+	if err := testService().LinkReaderOIDCAccount(context.Background(), "reader-1", identity); err != nil {
+		t.Fatalf("relinking the same reader returned error: %v", err)
+	}
+}
+
+func TestAdminOIDCLinking(t *testing.T) {
+	t.Setenv("JWT_SECRET", "admin-secret")
+	issuer, _ := setupOIDCTestProvider(t)
+
+	previousUsersRepo := adminUsersRepository
+	t.Cleanup(func() {
+		adminUsersRepository = previousUsersRepo
+	})
+	owner := &domain.AdminUserRecord{
+		AdminUser: domain.AdminUser{ID: "admin-1", Email: "owner@example.com", Roles: []string{"owner"}},
+	}
+	editor := &domain.AdminUserRecord{
+		AdminUser: domain.AdminUser{ID: "admin-2", Email: "editor@example.com", Roles: []string{"editor"}},
+	}
+	repo := newAdminAuthEmailChangeStubUserRepository(owner)
+	repo.byID[editor.ID] = editor
+	adminUsersRepository = repo
+
+	identity := signInWithOIDCTestProvider(t, issuer)
+	if _, err := testService().LoginAdminWithOIDCIdentity(context.Background(), identity, false, AdminSessionMetadata{}); err == nil ||
+		apperrors.From(err).Code != "UNAUTHORIZED" {
+		t.Fatalf("expected unlinked login to be unauthorized, got %v", err)
+	}
+
+	if _, err := testService().LinkAdminOIDCAccount(context.Background(), owner.ID, identity); err != nil {
+		t.Fatalf("LinkAdminOIDCAccount returned error: %v", err)
+	}
+	if _, err := testService().LinkAdminOIDCAccount(context.Background(), editor.ID, identity); err == nil ||
+		!strings.Contains(err.Error(), adminOIDCAlreadyLinkedMessage) {
+		t.Fatalf("expected conflict, got %v", err)
+	}
+	other := *identity
+	other.Subject = "oidc-user-2"
+	if _, err := testService().LinkAdminOIDCAccount(context.Background(), owner.ID, &other); err == nil ||
+		!strings.Contains(err.Error(), "disconnect the current account") {
+		t.Fatalf("expected replacement to be rejected, got %v", err)
+	}
+
+	statuses, err := testService().QueryAdminOIDCProviders(context.Background())
+	if err != nil || len(statuses) != 1 || statuses[0].Name != "GitLab" || !statuses[0].LoginAvailable {
+		t.Fatalf("QueryAdminOIDCProviders() = %#v, %v", statuses, err)
+	}
+	links, err := testService().ListAdminOIDCLinks(context.Background(), &owner.AdminUser)
+	if err != nil || len(links) != 1 || links[0].ProviderName != "GitLab" || links[0].LinkedAt.After(time.Now()) {
+		t.Fatalf("ListAdminOIDCLinks() = %#v, %v", links, err)
+	}
+
+	connect, err := StartAdminOIDCConnect(context.Background(), &owner.AdminUser, "gitlab", "tr")
+	if err != nil || connect.URL != "/api/oauth/connect?provider=gitlab&flow=admin&intent=connect&locale=tr" {
+		t.Fatalf("StartAdminOIDCConnect() = %#v, %v", connect, err)
+	}
+
+	if disconnected, err := testService().DisconnectAdminOIDCAccount(context.Background(), &owner.AdminUser, "gitlab"); err != nil || !disconnected {
+		t.Fatalf("DisconnectAdminOIDCAccount() = %v, %v", disconnected, err)
+	}
+	if _, err := testService().DisconnectAdminOIDCAccount(context.Background(), &owner.AdminUser, "gitlab"); err == nil {
+		t.Fatal("expected disconnecting an unlinked provider to fail")
+	}
+}
+
+func TestLoginAdminWithOIDCIdentityAppliesLockoutAndTwoFactor(t *testing.T) {
+	issuer, _ := setupOIDCTestProvider(t)
+	user, now := stubAdminTwoFactor(t)
+	attempts, _ := stubAdminLoginAttempts(t)
+	metadata := AdminSessionMetadata{RemoteIP: "203.0.113.10"}
+
+	identity := signInWithOIDCTestProvider(t, issuer)
+	if _, err := testService().LinkAdminOIDCAccount(context.Background(), user.ID, identity); err != nil {
+		t.Fatalf("LinkAdminOIDCAccount returned error: %v", err)
+	}
+
+	response, err := testService().LoginAdminWithOIDCIdentity(context.Background(), identity, false, metadata)
+	if err != nil || response.MFARequired || response.AccessToken == "" {
+		t.Fatalf("expected a session without two-factor, got %#v %v", response, err)
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret returned error: %v", err)
+	}
+	user.TwoFactor = &domain.AdminTwoFactor{Secret: secret, EnabledAt: *now}
+	response, err = testService().LoginAdminWithOIDCIdentity(context.Background(), identity, true, metadata)
+	if err != nil || !response.MFARequired || response.MFAToken == "" || response.AccessToken != "" {
+		t.Fatalf("expected an mfa challenge, got %#v %v", response, err)
+	}
+	completed, err := testService().CompleteAdminTwoFactorLogin(
+		context.Background(),
+		response.MFAToken,
+		currentAdminTOTPCode(t, secret, *now),
+		metadata,
+	)
+	if err != nil || completed.AccessToken == "" || !completed.RememberMe {
+		t.Fatalf("CompleteAdminTwoFactorLogin() = %#v, %v", completed, err)
+	}
+
+	lockedUntil := now.Add(time.Hour)
+	key := newAdminThrottleSubject(adminLoginAccountThrottlePolicy, user.Email).key
+	attempts.records[key] = &domain.AdminLoginAttemptRecord{Key: key, LockedUntil: &lockedUntil, ExpiresAt: lockedUntil}
+	if _, err := testService().LoginAdminWithOIDCIdentity(context.Background(), identity, false, metadata); apperrors.From(err).Code != adminCodeLoginLocked {
+		t.Fatalf("expected a locked account to be rejected, got %v", err)
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"suaybsimsek.com/blog-api/internal/domain"
 	"suaybsimsek.com/blog-api/internal/repository"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
 	"suaybsimsek.com/blog-api/pkg/newsletter"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -20,12 +21,11 @@ type (
 	CategoryRecord  = domain.PostCategory
 	PostRecord      = domain.PostRecord
 	ContentResponse = domain.PostContentResponse
+	SeriesResponse  = domain.PostSeriesResponse
 )
 
 var (
-	postIDPattern                                      = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,127}$`)
-	postsRepository       repository.PostRepository    = repository.NewPostMongoRepository()
-	postCommentRepository repository.CommentRepository = repository.NewCommentRepository()
+	postIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,127}$`)
 )
 
 const (
@@ -51,7 +51,7 @@ type PostQueryInput struct {
 }
 
 // QueryContent returns posts, engagement, and pagination metadata without HTTP routing.
-func QueryContent(ctx context.Context, input ContentQueryInput) ContentResponse {
+func (s *Service) QueryContent(ctx context.Context, input ContentQueryInput) ContentResponse {
 	locale := newsletter.ResolveLocale(strings.TrimSpace(input.Locale), "")
 	sortOrder := normalizeSortOrder(input.Sort)
 
@@ -69,7 +69,7 @@ func QueryContent(ctx context.Context, input ContentQueryInput) ContentResponse
 	operationCtx, cancel := withTimeoutContext(ctx, 15*time.Second)
 	defer cancel()
 
-	total, countErr := postsRepository.CountPosts(operationCtx, filter)
+	total, countErr := s.posts.CountPosts(operationCtx, filter)
 	if countErr != nil {
 		if errors.Is(countErr, repository.ErrPostRepositoryUnavailable) {
 			return ContentResponse{Status: statusServiceUnavailable}
@@ -95,7 +95,7 @@ func QueryContent(ctx context.Context, input ContentQueryInput) ContentResponse
 	limit := int64(size)
 	skip := int64((resolvedPage - 1) * size)
 
-	posts, queryErr := postsRepository.FindPosts(operationCtx, filter, sortOrder, skip, limit)
+	posts, queryErr := s.posts.FindPosts(operationCtx, filter, sortOrder, skip, limit)
 	if queryErr != nil {
 		if errors.Is(queryErr, repository.ErrPostRepositoryUnavailable) {
 			return ContentResponse{Status: statusServiceUnavailable}
@@ -107,9 +107,9 @@ func QueryContent(ctx context.Context, input ContentQueryInput) ContentResponse
 		Status:           "success",
 		Locale:           locale,
 		Posts:            posts,
-		LikesByPostID:    postsRepository.ResolveLikesByPostID(operationCtx, posts),
-		HitsByPostID:     postsRepository.ResolveHitsByPostID(operationCtx, posts),
-		CommentsByPostID: resolveCommentCountsByPostID(operationCtx, posts),
+		LikesByPostID:    s.posts.ResolveLikesByPostID(operationCtx, posts),
+		HitsByPostID:     s.posts.ResolveHitsByPostID(operationCtx, posts),
+		CommentsByPostID: s.resolveCommentCountsByPostID(operationCtx, posts),
 		Total:            total,
 		Page:             resolvedPage,
 		Size:             size,
@@ -118,7 +118,7 @@ func QueryContent(ctx context.Context, input ContentQueryInput) ContentResponse
 }
 
 // QueryPost returns a single post and its engagement metrics.
-func QueryPost(ctx context.Context, input PostQueryInput) ContentResponse {
+func (s *Service) QueryPost(ctx context.Context, input PostQueryInput) ContentResponse {
 	locale := newsletter.ResolveLocale(strings.TrimSpace(input.Locale), "")
 	postID, ok := normalizePostID(input.PostID)
 	if !ok {
@@ -128,7 +128,16 @@ func QueryPost(ctx context.Context, input PostQueryInput) ContentResponse {
 	operationCtx, cancel := withTimeoutContext(ctx, 15*time.Second)
 	defer cancel()
 
-	post, queryErr := postsRepository.FindPostByID(operationCtx, locale, postID)
+	post, queryErr := s.posts.FindPostByID(operationCtx, locale, postID)
+	redirectTo := ""
+	if queryErr == nil && post == nil {
+		// Renamed posts keep an alias so old links resolve to the current id.
+		redirectTo, queryErr = s.posts.FindPostIDAlias(operationCtx, postID)
+		if queryErr == nil && redirectTo != "" {
+			postID = redirectTo
+			post, queryErr = s.posts.FindPostByID(operationCtx, locale, postID)
+		}
+	}
 	if queryErr != nil {
 		if errors.Is(queryErr, repository.ErrPostRepositoryUnavailable) {
 			return ContentResponse{Status: statusServiceUnavailable, Locale: locale, PostID: postID}
@@ -148,20 +157,41 @@ func QueryPost(ctx context.Context, input PostQueryInput) ContentResponse {
 		Locale:           locale,
 		Posts:            posts,
 		PostID:           postID,
-		LikesByPostID:    postsRepository.ResolveLikesByPostID(operationCtx, posts),
-		HitsByPostID:     postsRepository.ResolveHitsByPostID(operationCtx, posts),
-		CommentsByPostID: resolveCommentCountsByPostID(operationCtx, posts),
+		RedirectTo:       redirectTo,
+		LikesByPostID:    s.posts.ResolveLikesByPostID(operationCtx, posts),
+		HitsByPostID:     s.posts.ResolveHitsByPostID(operationCtx, posts),
+		CommentsByPostID: s.resolveCommentCountsByPostID(operationCtx, posts),
+	}
+}
+
+// ResolvePostRedirect returns the current id for a renamed post, or an empty string when no alias exists.
+func (s *Service) ResolvePostRedirect(ctx context.Context, postID string) (string, error) {
+	normalizedPostID, ok := normalizePostID(postID)
+	if !ok {
+		return "", apperrors.BadRequest("invalid post id")
+	}
+
+	operationCtx, cancel := withTimeoutContext(ctx, 10*time.Second)
+	defer cancel()
+
+	redirectTo, err := s.posts.FindPostIDAlias(operationCtx, normalizedPostID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPostRepositoryUnavailable) {
+			return "", apperrors.ServiceUnavailable("post redirects are unavailable", err)
+		}
+		return "", apperrors.Internal("failed to resolve post redirect", err)
 	}
+	return redirectTo, nil
 }
 
 // IncrementLike increases like count for a post.
-func IncrementLike(ctx context.Context, postID string) ContentResponse {
-	return incrementPostMetric(ctx, postID, postsRepository.IncrementPostLike, true)
+func (s *Service) IncrementLike(ctx context.Context, postID string) ContentResponse {
+	return incrementPostMetric(ctx, postID, s.posts.IncrementPostLike, true)
 }
 
 // IncrementHit increases hit count for a post.
-func IncrementHit(ctx context.Context, postID string) ContentResponse {
-	return incrementPostMetric(ctx, postID, postsRepository.IncrementPostHit, false)
+func (s *Service) IncrementHit(ctx context.Context, postID string) ContentResponse {
+	return incrementPostMetric(ctx, postID, s.posts.IncrementPostHit, false)
 }
 
 func incrementPostMetric(
@@ -294,7 +324,7 @@ func isPublicPost(post PostRecord, now time.Time) bool {
 	}
 }
 
-func resolveCommentCountsByPostID(ctx context.Context, posts []PostRecord) map[string]int64 {
+func (s *Service) resolveCommentCountsByPostID(ctx context.Context, posts []PostRecord) map[string]int64 {
 	postIDs := make([]string, 0, len(posts))
 	for _, post := range posts {
 		postID, ok := normalizePostID(post.ID)
@@ -308,7 +338,7 @@ func resolveCommentCountsByPostID(ctx context.Context, posts []PostRecord) map[s
 		return map[string]int64{}
 	}
 
-	counts, err := postCommentRepository.CountApprovedByPosts(ctx, postIDs)
+	counts, err := s.comments.CountApprovedByPosts(ctx, postIDs)
 	if err != nil {
 		return map[string]int64{}
 	}
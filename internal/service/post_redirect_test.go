@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/repository"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+)
+
+func TestQueryPostResolvesRenamedPostAliases(t *testing.T) {
+	originalRepository := postsRepository
+	originalCommentRepository := commentRepository
+	t.Cleanup(func() {
+		postsRepository = originalRepository
+		commentRepository = originalCommentRepository
+	})
+
+	postsRepository = postStubRepository{
+		findPostByID: func(_ context.Context, _ string, postID string) (*domain.PostRecord, error) {
+			if postID != "new-post" {
+				return nil, nil
+			}
+			return &domain.PostRecord{ID: postID, Title: "New", PublishedDate: "2026-03-01"}, nil
+		},
+		findPostIDAlias: func(_ context.Context, aliasID string) (string, error) {
+			if aliasID == "old-post" {
+				return "new-post", nil
+			}
+			return "", nil
+		},
+		resolveLikesByPostID: func(context.Context, []domain.PostRecord) map[string]int64 { return nil },
+		resolveHitsByPostID:  func(context.Context, []domain.PostRecord) map[string]int64 { return nil },
+	}
+	commentRepository = postCommentStubRepository{}
+
+	result := testService().QueryPost(context.Background(), PostQueryInput{Locale: "en", PostID: "old-post"})
+	if result.Status != "success" || result.RedirectTo != "new-post" || result.PostID != "new-post" || len(result.Posts) != 1 {
+		t.Fatalf("unexpected aliased post response: %#v", result)
+	}
+
+	current := testService().QueryPost(context.Background(), PostQueryInput{Locale: "en", PostID: "new-post"})
+	if current.Status != "success" || current.RedirectTo != "" {
+		t.Fatalf("unexpected current post response: %#v", current)
+	}
+
+	if missing := testService().QueryPost(context.Background(), PostQueryInput{Locale: "en", PostID: "unknown-post"}); missing.Status != "not-found" {
+		t.Fatalf("unexpected missing post response: %#v", missing)
+	}
+
+	target, err := testService().ResolvePostRedirect(context.Background(), " Old-Post ")
+	if err != nil || target != "new-post" {
+		t.Fatalf("ResolvePostRedirect() = %q, %v", target, err)
+	}
+	if _, err := testService().ResolvePostRedirect(context.Background(), "bad id"); err == nil {
+		t.Fatal("expected invalid post id to be rejected")
+	}
+
+	postsRepository = postStubRepository{
+		findPostIDAlias: func(context.Context, string) (string, error) {
+			return "", repository.ErrPostRepositoryUnavailable
+		},
+	}
+	var appErr *apperrors.AppError
+	if _, err := testService().ResolvePostRedirect(context.Background(), "old-post"); !errors.As(err, &appErr) || appErr.HTTPStatus != http.StatusServiceUnavailable {
+		t.Fatalf("expected service unavailable error, got %v", err)
+	}
+}
@@ -0,0 +1,336 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/repository"
+	"suaybsimsek.com/blog-api/pkg/httpapi"
+	"suaybsimsek.com/blog-api/pkg/newsletter"
+)
+
+const (
+	relatedPostsDefaultLimit      = 3
+	relatedPostsMaxLimit          = 12
+	relatedPostsCategoryWeight    = 0.75
+	relatedPostsTextWeight        = 2.0
+	relatedPostsRecencyWeight     = 0.35
+	relatedPostsRecencyHalfLife   = 180 * 24 * time.Hour
+	relatedPostsMinTextSimilarity = 0.05
+	relatedPostsMinTokenLength    = 3
+)
+
+type RelatedPostsQueryInput struct {
+	Locale string
+	PostID string
+	Limit  *int
+}
+
+type relatedPostsCandidate struct {
+	post        PostRecord
+	topicIDs    map[string]struct{}
+	categoryID  string
+	terms       map[string]float64
+	publishedAt time.Time
+}
+
+// QueryRelatedPosts returns the precomputed related posts that are still publicly visible.
+func (s *Service) QueryRelatedPosts(ctx context.Context, input RelatedPostsQueryInput) ContentResponse {
+	locale := newsletter.ResolveLocale(strings.TrimSpace(input.Locale), "")
+	postID, ok := normalizePostID(input.PostID)
+	if !ok {
+		return ContentResponse{Status: statusInvalidPostID, Locale: locale}
+	}
+	limit := clampPositiveInt(input.Limit, relatedPostsDefaultLimit, relatedPostsMaxLimit)
+
+	operationCtx, cancel := withTimeoutContext(ctx, 10*time.Second)
+	defer cancel()
+
+	related, err := s.posts.FindRelatedPosts(operationCtx, locale, postID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPostRepositoryUnavailable) {
+			return ContentResponse{Status: statusServiceUnavailable, Locale: locale, PostID: postID}
+		}
+		return ContentResponse{Status: "failed", Locale: locale, PostID: postID}
+	}
+	if related == nil || len(related.Items) == 0 {
+		return ContentResponse{Status: "success", Locale: locale, PostID: postID, Posts: []PostRecord{}}
+	}
+
+	relatedIDs := make([]string, 0, len(related.Items))
+	for _, item := range related.Items {
+		relatedIDs = append(relatedIDs, item.PostID)
+	}
+
+	posts, err := s.posts.FindPosts(
+		operationCtx,
+		buildContentFilter(locale, relatedIDs, time.Now().UTC()),
+		"desc",
+		0,
+		int64(len(relatedIDs)),
+	)
+	if err != nil {
+		if errors.Is(err, repository.ErrPostRepositoryUnavailable) {
+			return ContentResponse{Status: statusServiceUnavailable, Locale: locale, PostID: postID}
+		}
+		return ContentResponse{Status: "failed", Locale: locale, PostID: postID}
+	}
+
+	postsByID := make(map[string]PostRecord, len(posts))
+	for _, post := range posts {
+		postsByID[post.ID] = post
+	}
+	ordered := make([]PostRecord, 0, limit)
+	for _, relatedID := range relatedIDs {
+		post, exists := postsByID[relatedID]
+		if !exists {
+			continue
+		}
+		ordered = append(ordered, post)
+		if len(ordered) == limit {
+			break
+		}
+	}
+
+	return ContentResponse{
+		Status: "success",
+		Locale: locale,
+		PostID: postID,
+		Posts:  ordered,
+		Total:  len(ordered),
+	}
+}
+
+// RefreshRelatedPosts recomputes and stores related posts for every public post in a locale.
+func (s *Service) RefreshRelatedPosts(ctx context.Context, locale string) error {
+	resolvedLocale := newsletter.ResolveLocale(strings.TrimSpace(locale), "")
+	now := time.Now().UTC()
+
+	posts, err := s.posts.FindPosts(ctx, buildContentFilter(resolvedLocale, nil, now), "desc", 0, 0)
+	if err != nil {
+		return err
+	}
+
+	return s.posts.ReplaceRelatedPosts(
+		ctx,
+		resolvedLocale,
+		computeRelatedPosts(resolvedLocale, posts, relatedPostsMaxLimit, now),
+	)
+}
+
+// RefreshAllRelatedPosts backfills related posts for every content locale. Content loaded outside the admin, such as
+// a fresh database or the seeded memory store, has none until this runs.
+func (s *Service) RefreshAllRelatedPosts(ctx context.Context) error {
+	errs := make([]error, 0)
+	for _, locale := range adminContentTaxonomyLocales {
+		if err := s.RefreshRelatedPosts(ctx, locale); err != nil {
+			errs = append(errs, fmt.Errorf("refresh %s related posts: %w", locale, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *Service) refreshAdminContentRelatedPosts(ctx context.Context, locales ...string) {
+	seen := make(map[string]struct{}, len(locales))
+	for _, locale := range locales {
+		resolvedLocale := strings.TrimSpace(strings.ToLower(locale))
+		if resolvedLocale == "" {
+			continue
+		}
+		if _, exists := seen[resolvedLocale]; exists {
+			continue
+		}
+		seen[resolvedLocale] = struct{}{}
+
+		if err := s.RefreshRelatedPosts(ctx, resolvedLocale); err != nil {
+			httpapi.LogError(ctx, "related posts refresh failed", err, slog.String("locale", resolvedLocale))
+		}
+	}
+}
+
+func computeRelatedPosts(locale string, posts []PostRecord, limit int, now time.Time) []domain.PostRelatedRecord {
+	candidates := make([]relatedPostsCandidate, 0, len(posts))
+	topicFrequency := make(map[string]int)
+	termFrequency := make(map[string]int)
+	termCounts := make([]map[string]int, 0, len(posts))
+	for _, post := range posts {
+		candidate := relatedPostsCandidate{
+			post:        post,
+			topicIDs:    make(map[string]struct{}, len(post.Topics)),
+			publishedAt: resolveRelatedPostPublishedAt(post),
+		}
+		for _, topic := range post.Topics {
+			topicID := strings.TrimSpace(strings.ToLower(topic.ID))
+			if topicID == "" {
+				continue
+			}
+			if _, exists := candidate.topicIDs[topicID]; exists {
+				continue
+			}
+			candidate.topicIDs[topicID] = struct{}{}
+			topicFrequency[topicID]++
+		}
+		if post.Category != nil {
+			candidate.categoryID = strings.TrimSpace(strings.ToLower(post.Category.ID))
+		}
+
+		counts := tokenizeRelatedPostText(post.SearchText)
+		for term := range counts {
+			termFrequency[term]++
+		}
+		termCounts = append(termCounts, counts)
+		candidates = append(candidates, candidate)
+	}
+
+	total := float64(len(candidates))
+	for index := range candidates {
+		candidates[index].terms = buildRelatedPostTermVector(termCounts[index], termFrequency, total)
+	}
+
+	records := make([]domain.PostRelatedRecord, 0, len(candidates))
+	for index, source := range candidates {
+		items := make([]domain.PostRelatedItem, 0)
+		publishedAtByID := make(map[string]time.Time)
+		for candidateIndex, candidate := range candidates {
+			if candidateIndex == index || candidate.post.ID == source.post.ID {
+				continue
+			}
+
+			relevance := 0.0
+			for topicID := range candidate.topicIDs {
+				if _, shared := source.topicIDs[topicID]; shared {
+					relevance += math.Log((total + 1) / float64(topicFrequency[topicID]+1))
+				}
+			}
+			if source.categoryID != "" && source.categoryID == candidate.categoryID {
+				relevance += relatedPostsCategoryWeight
+			}
+			if similarity := cosineRelatedPostTerms(source.terms, candidate.terms); similarity >= relatedPostsMinTextSimilarity {
+				relevance += relatedPostsTextWeight * similarity
+			}
+			if relevance <= 0 {
+				continue
+			}
+
+			score := relevance + relatedPostsRecencyWeight*resolveRelatedPostRecency(candidate.publishedAt, now)
+			items = append(items, domain.PostRelatedItem{
+				PostID: candidate.post.ID,
+				Score:  math.Round(score*10000) / 10000,
+			})
+			publishedAtByID[candidate.post.ID] = candidate.publishedAt
+		}
+
+		sort.SliceStable(items, func(left, right int) bool {
+			if items[left].Score != items[right].Score {
+				return items[left].Score > items[right].Score
+			}
+			leftPublishedAt := publishedAtByID[items[left].PostID]
+			rightPublishedAt := publishedAtByID[items[right].PostID]
+			if !leftPublishedAt.Equal(rightPublishedAt) {
+				return leftPublishedAt.After(rightPublishedAt)
+			}
+			return items[left].PostID < items[right].PostID
+		})
+		if limit > 0 && len(items) > limit {
+			items = items[:limit]
+		}
+
+		records = append(records, domain.PostRelatedRecord{
+			Locale:     locale,
+			PostID:     source.post.ID,
+			Items:      items,
+			ComputedAt: now,
+		})
+	}
+
+	return records
+}
+
+func tokenizeRelatedPostText(value string) map[string]int {
+	counts := make(map[string]int)
+	fields := strings.FieldsFunc(strings.ToLower(value), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, field := range fields {
+		if len([]rune(field)) < relatedPostsMinTokenLength {
+			continue
+		}
+		counts[field]++
+	}
+	return counts
+}
+
+func buildRelatedPostTermVector(counts map[string]int, documentFrequency map[string]int, total float64) map[string]float64 {
+	vector := make(map[string]float64, len(counts))
+	tokenCount := 0
+	for _, count := range counts {
+		tokenCount += count
+	}
+	if tokenCount == 0 {
+		return vector
+	}
+
+	norm := 0.0
+	for term, count := range counts {
+		// Terms present in every post carry no signal, so they are dropped instead of smoothed.
+		idf := math.Log((total + 1) / float64(documentFrequency[term]+1))
+		if idf <= 0 {
+			continue
+		}
+		weight := float64(count) / float64(tokenCount) * idf
+		vector[term] = weight
+		norm += weight * weight
+	}
+	if norm == 0 {
+		return vector
+	}
+
+	norm = math.Sqrt(norm)
+	for term, weight := range vector {
+		vector[term] = weight / norm
+	}
+	return vector
+}
+
+func cosineRelatedPostTerms(left, right map[string]float64) float64 {
+	if len(left) > len(right) {
+		left, right = right, left
+	}
+
+	similarity := 0.0
+	for term, weight := range left {
+		similarity += weight * right[term]
+	}
+	return similarity
+}
+
+func resolveRelatedPostPublishedAt(post PostRecord) time.Time {
+	if !post.PublishedAt.IsZero() {
+		return post.PublishedAt.UTC()
+	}
+	parsed, err := time.Parse(time.DateOnly, strings.TrimSpace(post.PublishedDate))
+	if err != nil {
+		return time.Time{}
+	}
+	return parsed.UTC()
+}
+
+func resolveRelatedPostRecency(publishedAt, now time.Time) float64 {
+	if publishedAt.IsZero() {
+		return 0
+	}
+	age := now.Sub(publishedAt)
+	if age <= 0 {
+		return 1
+	}
+	return math.Pow(0.5, float64(age)/float64(relatedPostsRecencyHalfLife))
+}
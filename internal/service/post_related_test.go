@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/repository"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestComputeRelatedPostsScoresTopicsCategoryTextAndRecency(t *testing.T) {
+	now := time.Date(2026, time.March, 20, 0, 0, 0, 0, time.UTC)
+	goCategory := &domain.PostCategory{ID: "programming", Name: "Programming"}
+	posts := []domain.PostRecord{
+		{
+			ID:          "go-generics",
+			Category:    goCategory,
+			Topics:      []domain.PostTopic{{ID: "go"}, {ID: "generics"}},
+			SearchText:  "go generics type parameters constraints",
+			PublishedAt: now.AddDate(0, -1, 0),
+		},
+		{
+			ID:          "go-interfaces",
+			Category:    goCategory,
+			Topics:      []domain.PostTopic{{ID: "go"}},
+			SearchText:  "go interfaces type embedding",
+			PublishedAt: now.AddDate(0, -2, 0),
+		},
+		{
+			ID:          "go-constraints",
+			Topics:      []domain.PostTopic{{ID: "generics"}},
+			SearchText:  "generics constraints type parameters",
+			PublishedAt: now.AddDate(-2, 0, 0),
+		},
+		{
+			ID:            "travel-notes",
+			Topics:        []domain.PostTopic{{ID: "travel"}},
+			SearchText:    "mountains coffee trains",
+			PublishedDate: "2026-03-01",
+		},
+	}
+
+	records := computeRelatedPosts("en", posts, 2, now)
+	if len(records) != len(posts) {
+		t.Fatalf("expected one record per post, got %d", len(records))
+	}
+
+	source := records[0]
+	if source.Locale != "en" || source.PostID != "go-generics" || !source.ComputedAt.Equal(now) {
+		t.Fatalf("unexpected record metadata: %#v", source)
+	}
+	if len(source.Items) != 2 {
+		t.Fatalf("expected limited related items, got %#v", source.Items)
+	}
+	if source.Items[0].PostID != "go-constraints" || source.Items[1].PostID != "go-interfaces" {
+		t.Fatalf("unexpected related order: %#v", source.Items)
+	}
+	if source.Items[0].Score <= source.Items[1].Score {
+		t.Fatalf("expected descending scores: %#v", source.Items)
+	}
+	for _, item := range source.Items {
+		if item.PostID == "travel-notes" || item.PostID == source.PostID {
+			t.Fatalf("unexpected related item: %#v", item)
+		}
+	}
+	if len(records[3].Items) != 0 {
+		t.Fatalf("expected unrelated post to have no related items, got %#v", records[3].Items)
+	}
+}
+
+func TestQueryRelatedPostsKeepsStoredOrderAndVisibility(t *testing.T) {
+	originalRepository := postsRepository
+	t.Cleanup(func() {
+		postsRepository = originalRepository
+	})
+
+	postsRepository = postStubRepository{
+		findRelatedPosts: func(_ context.Context, locale, postID string) (*domain.PostRelatedRecord, error) {
+			if locale != "tr" || postID != "alpha-post" {
+				t.Fatalf("FindRelatedPosts args = %q %q", locale, postID)
+			}
+			return &domain.PostRelatedRecord{Items: []domain.PostRelatedItem{
+				{PostID: "gamma-post", Score: 3},
+				{PostID: "hidden-post", Score: 2},
+				{PostID: "beta-post", Score: 1},
+			}}, nil
+		},
+		findPosts: func(_ context.Context, filter bson.M, _ string, _, limit int64) ([]domain.PostRecord, error) {
+			if filter["locale"] != "tr" || limit != 3 {
+				t.Fatalf("unexpected filter %#v limit %d", filter, limit)
+			}
+			return []domain.PostRecord{{ID: "beta-post"}, {ID: "gamma-post"}}, nil
+		},
+	}
+
+	limit := 5
+	result := testService().QueryRelatedPosts(context.Background(), RelatedPostsQueryInput{Locale: "tr", PostID: " Alpha-Post ", Limit: &limit})
+	if result.Status != "success" || len(result.Posts) != 2 || result.Posts[0].ID != "gamma-post" || result.Posts[1].ID != "beta-post" {
+		t.Fatalf("unexpected related response: %#v", result)
+	}
+
+	if invalid := testService().QueryRelatedPosts(context.Background(), RelatedPostsQueryInput{Locale: "tr", PostID: "bad id"}); invalid.Status != statusInvalidPostID {
+		t.Fatalf("unexpected invalid status: %#v", invalid)
+	}
+
+	postsRepository = postStubRepository{
+		findRelatedPosts: func(context.Context, string, string) (*domain.PostRelatedRecord, error) {
+			return nil, repository.ErrPostRepositoryUnavailable
+		},
+	}
+	if unavailable := testService().QueryRelatedPosts(context.Background(), RelatedPostsQueryInput{Locale: "en", PostID: "alpha-post"}); unavailable.Status != statusServiceUnavailable {
+		t.Fatalf("unexpected unavailable status: %#v", unavailable)
+	}
+}
+
+func TestRefreshRelatedPostsStoresComputedRecords(t *testing.T) {
+	originalRepository := postsRepository
+	t.Cleanup(func() {
+		postsRepository = originalRepository
+	})
+
+	var stored []domain.PostRelatedRecord
+	postsRepository = postStubRepository{
+		findPosts: func(_ context.Context, filter bson.M, _ string, _, limit int64) ([]domain.PostRecord, error) {
+			if filter["locale"] != "en" || limit != 0 {
+				t.Fatalf("unexpected filter %#v limit %d", filter, limit)
+			}
+			return []domain.PostRecord{
+				{ID: "alpha-post", Topics: []domain.PostTopic{{ID: "go"}}},
+				{ID: "beta-post", Topics: []domain.PostTopic{{ID: "go"}}},
+				{ID: "gamma-post", Topics: []domain.PostTopic{{ID: "rust"}}},
+			}, nil
+		},
+		replaceRelatedPosts: func(_ context.Context, locale string, records []domain.PostRelatedRecord) error {
+			if locale != "en" {
+				t.Fatalf("unexpected locale %q", locale)
+			}
+			stored = records
+			return nil
+		},
+	}
+
+	if err := testService().RefreshRelatedPosts(context.Background(), "en"); err != nil {
+		t.Fatalf("RefreshRelatedPosts returned error: %v", err)
+	}
+	if len(stored) != 3 || len(stored[0].Items) != 1 || stored[0].Items[0].PostID != "beta-post" {
+		t.Fatalf("unexpected stored records: %#v", stored)
+	}
+}
+
+func TestRefreshAllRelatedPostsCoversEveryLocale(t *testing.T) {
+	originalRepository := postsRepository
+	t.Cleanup(func() {
+		postsRepository = originalRepository
+	})
+
+	refreshed := make([]string, 0)
+	postsRepository = postStubRepository{
+		findPosts: func(_ context.Context, filter bson.M, _ string, _, _ int64) ([]domain.PostRecord, error) {
+			if filter["locale"] == "tr" {
+				return nil, errors.New("boom")
+			}
+			return []domain.PostRecord{{ID: "alpha-post"}}, nil
+		},
+		replaceRelatedPosts: func(_ context.Context, locale string, _ []domain.PostRelatedRecord) error {
+			refreshed = append(refreshed, locale)
+			return nil
+		},
+	}
+
+	err := testService().RefreshAllRelatedPosts(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "refresh tr related posts") {
+		t.Fatalf("expected the tr failure to be reported, got %v", err)
+	}
+	if len(refreshed) != 1 || refreshed[0] != "en" {
+		t.Fatalf("expected en to be refreshed despite the tr failure, got %#v", refreshed)
+	}
+}
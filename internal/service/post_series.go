@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/repository"
+	"suaybsimsek.com/blog-api/pkg/newsletter"
+)
+
+type SeriesQueryInput struct {
+	Locale   string
+	SeriesID string
+}
+
+type PostSeriesQueryInput struct {
+	Locale string
+	PostID string
+}
+
+// QuerySeries returns a series and its publicly visible posts in series order.
+func (s *Service) QuerySeries(ctx context.Context, input SeriesQueryInput) SeriesResponse {
+	locale := newsletter.ResolveLocale(strings.TrimSpace(input.Locale), "")
+	seriesID, ok := normalizePostID(input.SeriesID)
+	if !ok {
+		return SeriesResponse{Status: "not-found", Locale: locale}
+	}
+
+	operationCtx, cancel := withTimeoutContext(ctx, 10*time.Second)
+	defer cancel()
+
+	series, err := s.posts.FindSeriesByID(operationCtx, locale, seriesID)
+	if err != nil {
+		return SeriesResponse{Status: resolveSeriesQueryErrorStatus(err), Locale: locale}
+	}
+	if series == nil {
+		return SeriesResponse{Status: "not-found", Locale: locale}
+	}
+
+	posts, err := s.findPublicSeriesPosts(operationCtx, locale, series.PostIDs)
+	if err != nil {
+		return SeriesResponse{Status: resolveSeriesQueryErrorStatus(err), Locale: locale}
+	}
+
+	return SeriesResponse{
+		Status: "success",
+		Locale: locale,
+		Series: series,
+		Posts:  posts,
+	}
+}
+
+// QueryPostSeries returns the series containing a post together with its previous and next neighbours.
+func (s *Service) QueryPostSeries(ctx context.Context, input PostSeriesQueryInput) SeriesResponse {
+	locale := newsletter.ResolveLocale(strings.TrimSpace(input.Locale), "")
+	postID, ok := normalizePostID(input.PostID)
+	if !ok {
+		return SeriesResponse{Status: statusInvalidPostID, Locale: locale}
+	}
+
+	operationCtx, cancel := withTimeoutContext(ctx, 10*time.Second)
+	defer cancel()
+
+	series, err := s.posts.FindSeriesByPostID(operationCtx, locale, postID)
+	if err != nil {
+		return SeriesResponse{Status: resolveSeriesQueryErrorStatus(err), Locale: locale, PostID: postID}
+	}
+	if series == nil {
+		return SeriesResponse{Status: "not-found", Locale: locale, PostID: postID}
+	}
+
+	posts, err := s.findPublicSeriesPosts(operationCtx, locale, series.PostIDs)
+	if err != nil {
+		return SeriesResponse{Status: resolveSeriesQueryErrorStatus(err), Locale: locale, PostID: postID}
+	}
+
+	// Navigation only walks public posts, so drafts inside a series are skipped transparently.
+	index := -1
+	for postIndex, post := range posts {
+		if post.ID == postID {
+			index = postIndex
+			break
+		}
+	}
+	if index < 0 {
+		return SeriesResponse{Status: "not-found", Locale: locale, PostID: postID}
+	}
+
+	response := SeriesResponse{
+		Status:   "success",
+		Locale:   locale,
+		PostID:   postID,
+		Series:   series,
+		Posts:    posts,
+		Position: index + 1,
+	}
+	if index > 0 {
+		previous := posts[index-1]
+		response.Previous = &previous
+	}
+	if index+1 < len(posts) {
+		next := posts[index+1]
+		response.Next = &next
+	}
+	return response
+}
+
+func (s *Service) findPublicSeriesPosts(ctx context.Context, locale string, postIDs []string) ([]PostRecord, error) {
+	if len(postIDs) == 0 {
+		return []PostRecord{}, nil
+	}
+
+	posts, err := s.posts.FindPosts(
+		ctx,
+		buildContentFilter(locale, postIDs, time.Now().UTC()),
+		"desc",
+		0,
+		int64(len(postIDs)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	postsByID := make(map[string]PostRecord, len(posts))
+	for _, post := range posts {
+		postsByID[post.ID] = post
+	}
+	ordered := make([]PostRecord, 0, len(posts))
+	for _, postID := range postIDs {
+		if post, exists := postsByID[postID]; exists {
+			ordered = append(ordered, post)
+		}
+	}
+	return ordered, nil
+}
+
+func resolveSeriesQueryErrorStatus(err error) string {
+	if errors.Is(err, repository.ErrPostRepositoryUnavailable) {
+		return statusServiceUnavailable
+	}
+	return "failed"
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/repository"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestQueryPostSeriesBuildsNavigationFromPublicPosts(t *testing.T) {
+	originalRepository := postsRepository
+	t.Cleanup(func() {
+		postsRepository = originalRepository
+	})
+
+	series := &domain.PostSeriesRecord{
+		Locale:  "en",
+		ID:      "go-basics",
+		Name:    "Go Basics",
+		PostIDs: []string{"part-one", "part-draft", "part-two", "part-three"},
+	}
+	postsRepository = postStubRepository{
+		findSeriesByPostID: func(_ context.Context, locale, postID string) (*domain.PostSeriesRecord, error) {
+			if locale != "en" || postID != "part-two" {
+				t.Fatalf("FindSeriesByPostID args = %q %q", locale, postID)
+			}
+			return series, nil
+		},
+		findPosts: func(_ context.Context, filter bson.M, _ string, _, limit int64) ([]domain.PostRecord, error) {
+			if filter["locale"] != "en" || limit != 4 {
+				t.Fatalf("unexpected filter %#v limit %d", filter, limit)
+			}
+			return []domain.PostRecord{{ID: "part-three"}, {ID: "part-two"}, {ID: "part-one"}}, nil
+		},
+	}
+
+	result := testService().QueryPostSeries(context.Background(), PostSeriesQueryInput{Locale: "en", PostID: " Part-Two "})
+	if result.Status != "success" || result.Series == nil || result.Series.ID != "go-basics" {
+		t.Fatalf("unexpected series response: %#v", result)
+	}
+	if result.Position != 2 || len(result.Posts) != 3 {
+		t.Fatalf("unexpected position %d posts %#v", result.Position, result.Posts)
+	}
+	if result.Previous == nil || result.Previous.ID != "part-one" || result.Next == nil || result.Next.ID != "part-three" {
+		t.Fatalf("unexpected navigation: previous=%#v next=%#v", result.Previous, result.Next)
+	}
+
+	postsRepository = postStubRepository{
+		findSeriesByPostID: func(context.Context, string, string) (*domain.PostSeriesRecord, error) {
+			return series, nil
+		},
+		findPosts: func(context.Context, bson.M, string, int64, int64) ([]domain.PostRecord, error) {
+			return []domain.PostRecord{{ID: "part-one"}}, nil
+		},
+	}
+	if hidden := testService().QueryPostSeries(context.Background(), PostSeriesQueryInput{Locale: "en", PostID: "part-draft"}); hidden.Status != "not-found" {
+		t.Fatalf("expected non-public post to have no navigation, got %#v", hidden)
+	}
+	if invalid := testService().QueryPostSeries(context.Background(), PostSeriesQueryInput{Locale: "en", PostID: "bad id"}); invalid.Status != statusInvalidPostID {
+		t.Fatalf("unexpected invalid status: %#v", invalid)
+	}
+
+	postsRepository = postStubRepository{}
+	if missing := testService().QueryPostSeries(context.Background(), PostSeriesQueryInput{Locale: "en", PostID: "part-one"}); missing.Status != "not-found" {
+		t.Fatalf("unexpected missing status: %#v", missing)
+	}
+}
+
+func TestQuerySeriesReturnsOrderedPostsAndMapsErrors(t *testing.T) {
+	originalRepository := postsRepository
+	t.Cleanup(func() {
+		postsRepository = originalRepository
+	})
+
+	postsRepository = postStubRepository{
+		findSeriesByID: func(_ context.Context, locale, seriesID string) (*domain.PostSeriesRecord, error) {
+			if locale != "tr" || seriesID != "go-basics" {
+				t.Fatalf("FindSeriesByID args = %q %q", locale, seriesID)
+			}
+			return &domain.PostSeriesRecord{ID: "go-basics", PostIDs: []string{"part-two", "part-one"}}, nil
+		},
+		findPosts: func(context.Context, bson.M, string, int64, int64) ([]domain.PostRecord, error) {
+			return []domain.PostRecord{{ID: "part-one"}, {ID: "part-two"}}, nil
+		},
+	}
+
+	result := testService().QuerySeries(context.Background(), SeriesQueryInput{Locale: "tr", SeriesID: "go-basics"})
+	if result.Status != "success" || len(result.Posts) != 2 || result.Posts[0].ID != "part-two" || result.Posts[1].ID != "part-one" {
+		t.Fatalf("unexpected series response: %#v", result)
+	}
+
+	postsRepository = postStubRepository{
+		findSeriesByID: func(context.Context, string, string) (*domain.PostSeriesRecord, error) {
+			return &domain.PostSeriesRecord{ID: "empty-series"}, nil
+		},
+	}
+	if empty := testService().QuerySeries(context.Background(), SeriesQueryInput{Locale: "en", SeriesID: "empty-series"}); empty.Status != "success" || len(empty.Posts) != 0 {
+		t.Fatalf("unexpected empty series response: %#v", empty)
+	}
+
+	postsRepository = postStubRepository{
+		findSeriesByID: func(context.Context, string, string) (*domain.PostSeriesRecord, error) {
+			return nil, repository.ErrPostRepositoryUnavailable
+		},
+	}
+	if unavailable := testService().QuerySeries(context.Background(), SeriesQueryInput{Locale: "en", SeriesID: "go-basics"}); unavailable.Status != statusServiceUnavailable {
+		t.Fatalf("unexpected unavailable status: %#v", unavailable)
+	}
+	if invalid := testService().QuerySeries(context.Background(), SeriesQueryInput{Locale: "en", SeriesID: "bad id"}); invalid.Status != "not-found" {
+		t.Fatalf("unexpected invalid status: %#v", invalid)
+	}
+}
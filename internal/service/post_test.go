@@ -21,6 +21,11 @@ type postStubRepository struct {
 	resolveHitsByPostID   func(context.Context, []domain.PostRecord) map[string]int64
 	incrementPostLike     func(context.Context, string, time.Time) (int64, error)
 	incrementPostHit      func(context.Context, string, time.Time) (int64, error)
+	findRelatedPosts      func(context.Context, string, string) (*domain.PostRelatedRecord, error)
+	replaceRelatedPosts   func(context.Context, string, []domain.PostRelatedRecord) error
+	findSeriesByID        func(context.Context, string, string) (*domain.PostSeriesRecord, error)
+	findSeriesByPostID    func(context.Context, string, string) (*domain.PostSeriesRecord, error)
+	findPostIDAlias       func(context.Context, string) (string, error)
 }
 
 type postCommentStubRepository struct {
@@ -65,6 +70,41 @@ func (stub postStubRepository) IncrementPostHit(ctx context.Context, postID stri
 	return stub.incrementPostHit(ctx, postID, now)
 }
 
+func (stub postStubRepository) FindRelatedPosts(ctx context.Context, locale, postID string) (*domain.PostRelatedRecord, error) {
+	if stub.findRelatedPosts == nil {
+		return nil, nil
+	}
+	return stub.findRelatedPosts(ctx, locale, postID)
+}
+
+func (stub postStubRepository) ReplaceRelatedPosts(ctx context.Context, locale string, records []domain.PostRelatedRecord) error {
+	if stub.replaceRelatedPosts == nil {
+		return nil
+	}
+	return stub.replaceRelatedPosts(ctx, locale, records)
+}
+
+func (stub postStubRepository) FindSeriesByID(ctx context.Context, locale, seriesID string) (*domain.PostSeriesRecord, error) {
+	if stub.findSeriesByID == nil {
+		return nil, nil
+	}
+	return stub.findSeriesByID(ctx, locale, seriesID)
+}
+
+func (stub postStubRepository) FindSeriesByPostID(ctx context.Context, locale, postID string) (*domain.PostSeriesRecord, error) {
+	if stub.findSeriesByPostID == nil {
+		return nil, nil
+	}
+	return stub.findSeriesByPostID(ctx, locale, postID)
+}
+
+func (stub postStubRepository) FindPostIDAlias(ctx context.Context, aliasID string) (string, error) {
+	if stub.findPostIDAlias == nil {
+		return "", nil
+	}
+	return stub.findPostIDAlias(ctx, aliasID)
+}
+
 func (postCommentStubRepository) ListApprovedByPost(context.Context, string) ([]domain.CommentRecord, error) {
 	return nil, nil
 }
@@ -128,12 +168,24 @@ func (postCommentStubRepository) DeleteCommentsByIDs(context.Context, []string)
 	return 0, nil
 }
 
+func (postCommentStubRepository) ListCommentsByAuthorEmail(context.Context, string) ([]domain.CommentRecord, error) {
+	return nil, nil
+}
+
+func (postCommentStubRepository) AnonymizeCommentsByAuthorEmail(context.Context, string, string, time.Time) (int, error) {
+	return 0, nil
+}
+
+func (postCommentStubRepository) DeleteCommentsByAuthorEmail(context.Context, string) (int, error) {
+	return 0, nil
+}
+
 func TestQueryContent(t *testing.T) {
 	originalRepository := postsRepository
-	originalCommentRepository := postCommentRepository
+	originalCommentRepository := commentRepository
 	t.Cleanup(func() {
 		postsRepository = originalRepository
-		postCommentRepository = originalCommentRepository
+		commentRepository = originalCommentRepository
 	})
 
 	postsRepository = postStubRepository{
@@ -162,7 +214,7 @@ func TestQueryContent(t *testing.T) {
 		incrementPostLike: func(context.Context, string, time.Time) (int64, error) { return 0, nil },
 		incrementPostHit:  func(context.Context, string, time.Time) (int64, error) { return 0, nil },
 	}
-	postCommentRepository = postCommentStubRepository{
+	commentRepository = postCommentStubRepository{
 		countApprovedByPosts: func(_ context.Context, postIDs []string) (map[string]int64, error) {
 			if len(postIDs) != 2 {
 				t.Fatalf("postIDs = %#v", postIDs)
@@ -176,7 +228,7 @@ func TestQueryContent(t *testing.T) {
 
 	page := 0
 	size := 2
-	result := QueryContent(context.Background(), ContentQueryInput{
+	result := testService().QueryContent(context.Background(), ContentQueryInput{
 		Locale:   "tr",
 		Sort:     "asc",
 		ScopeIDs: []string{"Alpha-Post", " alpha-post ", "beta-post"},
@@ -197,10 +249,10 @@ func TestQueryContent(t *testing.T) {
 
 func TestQueryContentBranches(t *testing.T) {
 	originalRepository := postsRepository
-	originalCommentRepository := postCommentRepository
+	originalCommentRepository := commentRepository
 	t.Cleanup(func() {
 		postsRepository = originalRepository
-		postCommentRepository = originalCommentRepository
+		commentRepository = originalCommentRepository
 	})
 
 	postsRepository = postStubRepository{
@@ -214,13 +266,13 @@ func TestQueryContentBranches(t *testing.T) {
 		incrementPostLike:    func(context.Context, string, time.Time) (int64, error) { return 0, nil },
 		incrementPostHit:     func(context.Context, string, time.Time) (int64, error) { return 0, nil },
 	}
-	postCommentRepository = postCommentStubRepository{}
+	commentRepository = postCommentStubRepository{}
 
-	if result := QueryContent(context.Background(), ContentQueryInput{ScopeIDs: []string{"bad id"}}); result.Status != "invalid-scope-ids" {
+	if result := testService().QueryContent(context.Background(), ContentQueryInput{ScopeIDs: []string{"bad id"}}); result.Status != "invalid-scope-ids" {
 		t.Fatalf("invalid scope result = %#v", result)
 	}
 
-	result := QueryContent(context.Background(), ContentQueryInput{})
+	result := testService().QueryContent(context.Background(), ContentQueryInput{})
 	if result.Status != "success" || result.Total != 0 || result.Page != 1 || len(result.Posts) != 0 {
 		t.Fatalf("empty result = %#v", result)
 	}
@@ -236,17 +288,17 @@ func TestQueryContentBranches(t *testing.T) {
 		incrementPostLike:    func(context.Context, string, time.Time) (int64, error) { return 0, nil },
 		incrementPostHit:     func(context.Context, string, time.Time) (int64, error) { return 0, nil },
 	}
-	if result := QueryContent(context.Background(), ContentQueryInput{}); result.Status != "service-unavailable" {
+	if result := testService().QueryContent(context.Background(), ContentQueryInput{}); result.Status != "service-unavailable" {
 		t.Fatalf("service unavailable result = %#v", result)
 	}
 }
 
 func TestQueryPostAndMetrics(t *testing.T) {
 	originalRepository := postsRepository
-	originalCommentRepository := postCommentRepository
+	originalCommentRepository := commentRepository
 	t.Cleanup(func() {
 		postsRepository = originalRepository
-		postCommentRepository = originalCommentRepository
+		commentRepository = originalCommentRepository
 	})
 
 	now := time.Now().UTC()
@@ -278,7 +330,7 @@ func TestQueryPostAndMetrics(t *testing.T) {
 			return 43, nil
 		},
 	}
-	postCommentRepository = postCommentStubRepository{
+	commentRepository = postCommentStubRepository{
 		countApprovedByPosts: func(_ context.Context, postIDs []string) (map[string]int64, error) {
 			if len(postIDs) != 1 || postIDs[0] != "alpha-post" {
 				t.Fatalf("postIDs = %#v", postIDs)
@@ -287,7 +339,7 @@ func TestQueryPostAndMetrics(t *testing.T) {
 		},
 	}
 
-	postResult := QueryPost(context.Background(), PostQueryInput{Locale: "en", PostID: "Alpha-Post"})
+	postResult := testService().QueryPost(context.Background(), PostQueryInput{Locale: "en", PostID: "Alpha-Post"})
 	if postResult.Status != "success" || postResult.PostID != "alpha-post" || len(postResult.Posts) != 1 {
 		t.Fatalf("postResult = %#v", postResult)
 	}
@@ -296,12 +348,12 @@ func TestQueryPostAndMetrics(t *testing.T) {
 		t.Fatalf("engagement = %#v", postResult)
 	}
 
-	likeResult := IncrementLike(context.Background(), "Alpha-Post")
+	likeResult := testService().IncrementLike(context.Background(), "Alpha-Post")
 	if likeResult.Status != "success" || likeResult.Likes != 13 {
 		t.Fatalf("likeResult = %#v", likeResult)
 	}
 
-	hitResult := IncrementHit(context.Background(), "Alpha-Post")
+	hitResult := testService().IncrementHit(context.Background(), "Alpha-Post")
 	if hitResult.Status != "success" || hitResult.Hits != 43 {
 		t.Fatalf("hitResult = %#v", hitResult)
 	}
@@ -309,10 +361,10 @@ func TestQueryPostAndMetrics(t *testing.T) {
 
 func TestQueryPostAndMetricBranches(t *testing.T) {
 	originalRepository := postsRepository
-	originalCommentRepository := postCommentRepository
+	originalCommentRepository := commentRepository
 	t.Cleanup(func() {
 		postsRepository = originalRepository
-		postCommentRepository = originalCommentRepository
+		commentRepository = originalCommentRepository
 	})
 
 	postsRepository = postStubRepository{
@@ -328,21 +380,21 @@ func TestQueryPostAndMetricBranches(t *testing.T) {
 		},
 		incrementPostHit: func(context.Context, string, time.Time) (int64, error) { return 0, errors.New("boom") },
 	}
-	postCommentRepository = postCommentStubRepository{}
+	commentRepository = postCommentStubRepository{}
 
-	if result := QueryPost(context.Background(), PostQueryInput{Locale: "en", PostID: "bad id"}); result.Status != "invalid-post-id" {
+	if result := testService().QueryPost(context.Background(), PostQueryInput{Locale: "en", PostID: "bad id"}); result.Status != "invalid-post-id" {
 		t.Fatalf("invalid post result = %#v", result)
 	}
-	if result := QueryPost(context.Background(), PostQueryInput{Locale: "en", PostID: "alpha-post"}); result.Status != "service-unavailable" {
+	if result := testService().QueryPost(context.Background(), PostQueryInput{Locale: "en", PostID: "alpha-post"}); result.Status != "service-unavailable" {
 		t.Fatalf("service unavailable post result = %#v", result)
 	}
-	if result := IncrementLike(context.Background(), "bad id"); result.Status != "invalid-post-id" {
+	if result := testService().IncrementLike(context.Background(), "bad id"); result.Status != "invalid-post-id" {
 		t.Fatalf("invalid like result = %#v", result)
 	}
-	if result := IncrementLike(context.Background(), "alpha-post"); result.Status != "service-unavailable" {
+	if result := testService().IncrementLike(context.Background(), "alpha-post"); result.Status != "service-unavailable" {
 		t.Fatalf("service unavailable like result = %#v", result)
 	}
-	if result := IncrementHit(context.Background(), "alpha-post"); result.Status != "failed" {
+	if result := testService().IncrementHit(context.Background(), "alpha-post"); result.Status != "failed" {
 		t.Fatalf("failed hit result = %#v", result)
 	}
 }
@@ -399,7 +451,7 @@ func TestPostServiceAdditionalBranches(t *testing.T) {
 			incrementPostHit:     func(context.Context, string, time.Time) (int64, error) { return 0, nil },
 		}
 
-		if result := QueryContent(context.Background(), ContentQueryInput{}); result.Status != "failed" {
+		if result := testService().QueryContent(context.Background(), ContentQueryInput{}); result.Status != "failed" {
 			t.Fatalf("failed count result = %#v", result)
 		}
 	})
@@ -417,7 +469,7 @@ func TestPostServiceAdditionalBranches(t *testing.T) {
 			incrementPostHit:     func(context.Context, string, time.Time) (int64, error) { return 0, nil },
 		}
 
-		if result := QueryContent(context.Background(), ContentQueryInput{}); result.Status != "failed" {
+		if result := testService().QueryContent(context.Background(), ContentQueryInput{}); result.Status != "failed" {
 			t.Fatalf("failed find result = %#v", result)
 		}
 	})
@@ -435,7 +487,7 @@ func TestPostServiceAdditionalBranches(t *testing.T) {
 			incrementPostHit:     func(context.Context, string, time.Time) (int64, error) { return 0, nil },
 		}
 
-		if result := QueryPost(context.Background(), PostQueryInput{Locale: "en", PostID: "alpha-post"}); result.Status != "not-found" {
+		if result := testService().QueryPost(context.Background(), PostQueryInput{Locale: "en", PostID: "alpha-post"}); result.Status != "not-found" {
 			t.Fatalf("not found post result = %#v", result)
 		}
 
@@ -451,7 +503,7 @@ func TestPostServiceAdditionalBranches(t *testing.T) {
 			incrementPostHit:     func(context.Context, string, time.Time) (int64, error) { return 0, nil },
 		}
 
-		if result := QueryPost(context.Background(), PostQueryInput{Locale: "en", PostID: "alpha-post"}); result.Status != "failed" {
+		if result := testService().QueryPost(context.Background(), PostQueryInput{Locale: "en", PostID: "alpha-post"}); result.Status != "failed" {
 			t.Fatalf("failed post result = %#v", result)
 		}
 	})
@@ -469,13 +521,13 @@ func TestPostServiceAdditionalBranches(t *testing.T) {
 			},
 		}
 
-		if result := IncrementLike(context.Background(), "alpha-post"); result.Status != "failed" {
+		if result := testService().IncrementLike(context.Background(), "alpha-post"); result.Status != "failed" {
 			t.Fatalf("failed like result = %#v", result)
 		}
-		if result := IncrementHit(context.Background(), "bad id"); result.Status != statusInvalidPostID {
+		if result := testService().IncrementHit(context.Background(), "bad id"); result.Status != statusInvalidPostID {
 			t.Fatalf("invalid hit result = %#v", result)
 		}
-		if result := IncrementHit(context.Background(), "alpha-post"); result.Status != statusServiceUnavailable {
+		if result := testService().IncrementHit(context.Background(), "alpha-post"); result.Status != statusServiceUnavailable {
 			t.Fatalf("service unavailable hit result = %#v", result)
 		}
 	})
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/repository"
+	"suaybsimsek.com/blog-api/pkg/httpapi"
+)
+
+const (
+	rateLimitOperationNewsletterSubscribe = "newsletter-subscribe"
+	rateLimitOperationNewsletterResend    = "newsletter-resend"
+	rateLimitOperationComment             = "comment"
+)
+
+// RateLimiter decides whether a client may attempt an operation again. Clients without an id are never limited.
+type RateLimiter interface {
+	Allow(ctx context.Context, clientID string) RateLimitDecision
+}
+
+// RateLimitDecision reports whether an attempt is allowed and, when it is not, how long the client should wait.
+type RateLimitDecision struct {
+	Allowed    bool
+	RetryAfter time.Duration
+}
+
+// RetryAfterSeconds rounds the wait up to whole seconds, and is zero when the attempt was allowed.
+func (decision RateLimitDecision) RetryAfterSeconds() int {
+	if decision.Allowed || decision.RetryAfter <= 0 {
+		return 0
+	}
+	return int(math.Ceil(decision.RetryAfter.Seconds()))
+}
+
+// rateLimiter counts attempts in process memory. Each instance keeps its own counters, so it only suits tests and
+// single-process deployments.
+type rateLimiter struct {
+	mu              sync.Mutex
+	maxAttempts     int
+	window          time.Duration
+	cleanupInterval time.Duration
+	lastCleanup     time.Time
+	entries         map[string][]time.Time
+}
+
+func newRateLimiter(maxAttempts int, window time.Duration) *rateLimiter {
+	return &rateLimiter{
+		maxAttempts:     maxAttempts,
+		window:          window,
+		cleanupInterval: window,
+		lastCleanup:     time.Now().UTC(),
+		entries:         make(map[string][]time.Time),
+	}
+}
+
+func (limit *rateLimiter) pruneStaleEntriesLocked(cutoff time.Time) {
+	for clientID, timestamps := range limit.entries {
+		filtered := timestamps[:0]
+		for _, ts := range timestamps {
+			if ts.After(cutoff) {
+				filtered = append(filtered, ts)
+			}
+		}
+
+		if len(filtered) == 0 {
+			delete(limit.entries, clientID)
+			continue
+		}
+
+		limit.entries[clientID] = filtered
+	}
+}
+
+func (limit *rateLimiter) allow(clientID string) bool {
+	return limit.Allow(context.Background(), clientID).Allowed
+}
+
+func (limit *rateLimiter) Allow(_ context.Context, clientID string) RateLimitDecision {
+	if clientID == "" {
+		return RateLimitDecision{Allowed: true}
+	}
+
+	now := time.Now().UTC()
+	cutoff := now.Add(-limit.window)
+
+	limit.mu.Lock()
+	defer limit.mu.Unlock()
+
+	if now.Sub(limit.lastCleanup) >= limit.cleanupInterval {
+		limit.pruneStaleEntriesLocked(cutoff)
+		limit.lastCleanup = now
+	}
+
+	timestamps := limit.entries[clientID]
+	filtered := timestamps[:0]
+	for _, ts := range timestamps {
+		if ts.After(cutoff) {
+			filtered = append(filtered, ts)
+		}
+	}
+
+	if len(filtered) >= limit.maxAttempts {
+		limit.entries[clientID] = filtered
+		return RateLimitDecision{RetryAfter: filtered[0].Add(limit.window).Sub(now)}
+	}
+
+	filtered = append(filtered, now)
+	limit.entries[clientID] = filtered
+	return RateLimitDecision{Allowed: true}
+}
+
+// operationRateLimiter limits one operation with the policy resolved from the environment on each attempt. Attempts
+// are counted in MongoDB so every instance shares them, unless RATE_LIMIT_STORE selects the in-memory store.
+type operationRateLimiter struct {
+	repository  repository.RateLimitRepository
+	operation   string
+	maxAttempts int
+	window      time.Duration
+
+	memoryOnce sync.Once
+	memory     *rateLimiter
+}
+
+func newOperationRateLimiter(
+	rateLimits repository.RateLimitRepository,
+	operation string,
+	maxAttempts int,
+	window time.Duration,
+) *operationRateLimiter {
+	return &operationRateLimiter{repository: rateLimits, operation: operation, maxAttempts: maxAttempts, window: window}
+}
+
+func (limiter *operationRateLimiter) Allow(ctx context.Context, clientID string) RateLimitDecision {
+	resolvedClientID := strings.TrimSpace(clientID)
+	if resolvedClientID == "" {
+		return RateLimitDecision{Allowed: true}
+	}
+
+	policy := appconfig.ResolveRateLimitPolicy(limiter.operation, limiter.maxAttempts, limiter.window)
+	if policy.Store == appconfig.RateLimitStoreMemory {
+		limiter.memoryOnce.Do(func() {
+			limiter.memory = newRateLimiter(policy.MaxAttempts, policy.Window)
+		})
+		return limiter.memory.Allow(ctx, resolvedClientID)
+	}
+
+	now := nowUTCFn()
+	record, err := limiter.repository.Hit(
+		ctx,
+		limiter.operation+":"+hashValue(resolvedClientID),
+		now,
+		policy.MaxAttempts,
+		policy.Window,
+	)
+	// The limited operations need the same database, so refusing every client during an outage would only hide the
+	// real error; let the attempt through instead.
+	if err != nil {
+		httpapi.LogError(ctx, "rate limit check failed", err, slog.String("operation", limiter.operation))
+		return RateLimitDecision{Allowed: true}
+	}
+	if record.Allowed || len(record.Hits) == 0 {
+		return RateLimitDecision{Allowed: true}
+	}
+
+	return RateLimitDecision{RetryAfter: record.Hits[0].Add(policy.Window).Sub(now)}
+}
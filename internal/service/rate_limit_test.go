@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+)
+
+type stubRateLimitRepository struct {
+	hit func(context.Context, string, time.Time, int, time.Duration) (*domain.RateLimitRecord, error)
+}
+
+func (stub stubRateLimitRepository) Hit(
+	ctx context.Context,
+	key string,
+	now time.Time,
+	maxAttempts int,
+	window time.Duration,
+) (*domain.RateLimitRecord, error) {
+	return stub.hit(ctx, key, now, maxAttempts, window)
+}
+
+func TestRateLimiterRetryAfter(t *testing.T) {
+	limiter := newRateLimiter(1, time.Hour)
+	if decision := limiter.Allow(context.Background(), "203.0.113.9"); !decision.Allowed || decision.RetryAfterSeconds() != 0 {
+		t.Fatalf("first Allow() = %#v", decision)
+	}
+
+	decision := limiter.Allow(context.Background(), "203.0.113.9")
+	if decision.Allowed || decision.RetryAfter <= 59*time.Minute || decision.RetryAfterSeconds() > 3600 {
+		t.Fatalf("second Allow() = %#v", decision)
+	}
+	if got := (RateLimitDecision{RetryAfter: 1500 * time.Millisecond}).RetryAfterSeconds(); got != 2 {
+		t.Fatalf("RetryAfterSeconds() = %d, want rounding up", got)
+	}
+}
+
+func TestOperationRateLimiter(t *testing.T) {
+	originalNowUTCFn := nowUTCFn
+	t.Cleanup(func() {
+		nowUTCFn = originalNowUTCFn
+	})
+
+	now := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	nowUTCFn = func() time.Time { return now }
+	t.Setenv("RATE_LIMIT_STORE", "")
+	t.Setenv("RATE_LIMIT_COMMENT_MAX", "2")
+	t.Setenv("RATE_LIMIT_COMMENT_WINDOW", "10m")
+
+	var hitKeys []string
+	rateLimits := stubRateLimitRepository{
+		hit: func(_ context.Context, key string, at time.Time, maxAttempts int, window time.Duration) (*domain.RateLimitRecord, error) {
+			if maxAttempts != 2 || window != 10*time.Minute || !at.Equal(now) {
+				t.Fatalf("unexpected policy %d %s at %s", maxAttempts, window, at)
+			}
+			hitKeys = append(hitKeys, key)
+			if len(hitKeys) == 1 {
+				return &domain.RateLimitRecord{Key: key, Allowed: true, Hits: []time.Time{now}}, nil
+			}
+			return &domain.RateLimitRecord{Key: key, Hits: []time.Time{now.Add(-4 * time.Minute), now.Add(-time.Minute)}}, nil
+		},
+	}
+
+	limiter := newOperationRateLimiter(rateLimits, rateLimitOperationComment, 3, time.Hour)
+	if decision := limiter.Allow(context.Background(), ""); !decision.Allowed || len(hitKeys) != 0 {
+		t.Fatalf("expected clients without an id to skip the store, got %#v", decision)
+	}
+	if decision := limiter.Allow(context.Background(), " 203.0.113.9 "); !decision.Allowed {
+		t.Fatalf("first Allow() = %#v", decision)
+	}
+	decision := limiter.Allow(context.Background(), "203.0.113.9")
+	if decision.Allowed || decision.RetryAfter != 6*time.Minute || decision.RetryAfterSeconds() != 360 {
+		t.Fatalf("second Allow() = %#v", decision)
+	}
+	if hitKeys[0] != hitKeys[1] || hitKeys[0] != "comment:"+hashValue("203.0.113.9") {
+		t.Fatalf("unexpected keys %#v", hitKeys)
+	}
+
+	limiter.repository = stubRateLimitRepository{
+		hit: func(context.Context, string, time.Time, int, time.Duration) (*domain.RateLimitRecord, error) {
+			return nil, errors.New("mongo down")
+		},
+	}
+	if decision := limiter.Allow(context.Background(), "203.0.113.9"); !decision.Allowed {
+		t.Fatalf("expected a store failure to let the attempt through, got %#v", decision)
+	}
+
+	t.Setenv("RATE_LIMIT_STORE", "memory")
+	t.Setenv("RATE_LIMIT_COMMENT_MAX", "1")
+	memoryLimiter := newOperationRateLimiter(rateLimits, rateLimitOperationComment, 3, time.Hour)
+	if !memoryLimiter.Allow(context.Background(), "203.0.113.9").Allowed {
+		t.Fatal("first in-memory attempt should be allowed")
+	}
+	if decision := memoryLimiter.Allow(context.Background(), "203.0.113.9"); decision.Allowed || decision.RetryAfterSeconds() == 0 {
+		t.Fatalf("second in-memory Allow() = %#v", decision)
+	}
+}
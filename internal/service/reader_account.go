@@ -0,0 +1,536 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/repository"
+	"suaybsimsek.com/blog-api/pkg/httpapi"
+)
+
+const (
+	readerAccountStatusSuccess          = "success"
+	readerAccountStatusUnauthorized     = "unauthorized"
+	readerAccountStatusInvalidName      = "invalid-name"
+	readerAccountStatusInvalidProvider  = "invalid-provider"
+	readerAccountStatusInvalidOption    = "invalid-option"
+	readerAccountStatusLastSignInMethod = "last-sign-in-method"
+	readerAccountStatusNotFound         = "not-found"
+	readerAccountStatusFailed           = "failed"
+
+	readerProviderGoogle = "google"
+	readerProviderGithub = "github"
+
+	// ReaderCommentsAnonymize keeps the comments of a deleted reader under a neutral author name.
+	ReaderCommentsAnonymize = "anonymize"
+	// ReaderCommentsDelete removes the comments of a deleted reader.
+	ReaderCommentsDelete = "delete"
+
+	readerAnonymizedAuthorName = "Former reader"
+	maxActiveReaderSessions    = 20
+)
+
+// ReaderLinkedProvider is a sign-in method attached to a reader account.
+type ReaderLinkedProvider struct {
+	ID       string
+	Name     string
+	Email    string
+	LinkedAt *time.Time
+}
+
+type ReaderAccount struct {
+	User      domain.ReaderUser
+	CreatedAt time.Time
+	Providers []ReaderLinkedProvider
+}
+
+type ReaderAccountResult struct {
+	Status  string
+	Account *ReaderAccount
+}
+
+type ReaderSession struct {
+	domain.ReaderSessionRecord
+	Device  string
+	Current bool
+}
+
+type ReaderSessionsResult struct {
+	Status   string
+	Sessions []ReaderSession
+}
+
+type ReaderAccountMutationResult struct {
+	Status string
+}
+
+type DeleteReaderAccountResult struct {
+	Status           string
+	CommentsAffected int
+}
+
+// ReaderDataExport is everything stored about a reader, in the shape it is handed to them.
+type ReaderDataExport struct {
+	ExportedAt time.Time                   `json:"exportedAt"`
+	Account    ReaderDataExportAccount     `json:"account"`
+	Sessions   []ReaderDataExportSession   `json:"sessions"`
+	Comments   []ReaderDataExportComment   `json:"comments"`
+	Likes      []ReaderDataExportLike      `json:"likes"`
+	Newsletter *ReaderDataExportNewsletter `json:"newsletter"`
+}
+
+type ReaderDataExportAccount struct {
+	ID                string                     `json:"id"`
+	Name              string                     `json:"name"`
+	Email             string                     `json:"email"`
+	AvatarURL         string                     `json:"avatarUrl,omitempty"`
+	LastLoginProvider string                     `json:"lastLoginProvider,omitempty"`
+	CreatedAt         *time.Time                 `json:"createdAt,omitempty"`
+	Providers         []ReaderDataExportProvider `json:"providers"`
+}
+
+type ReaderDataExportProvider struct {
+	ID       string     `json:"id"`
+	Email    string     `json:"email,omitempty"`
+	LinkedAt *time.Time `json:"linkedAt,omitempty"`
+}
+
+type ReaderDataExportSession struct {
+	UserAgent   string    `json:"userAgent,omitempty"`
+	RemoteIP    string    `json:"remoteIp,omitempty"`
+	CountryCode string    `json:"countryCode,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+	LastSeenAt  time.Time `json:"lastSeenAt"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+}
+
+type ReaderDataExportComment struct {
+	ID         string    `json:"id"`
+	PostID     string    `json:"postId"`
+	ParentID   string    `json:"parentId,omitempty"`
+	AuthorName string    `json:"authorName"`
+	Content    string    `json:"content"`
+	Status     string    `json:"status"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+type ReaderDataExportLike struct {
+	PostID      string    `json:"postId"`
+	Count       int64     `json:"count"`
+	FirstLikeAt time.Time `json:"firstLikedAt"`
+	LastLikeAt  time.Time `json:"lastLikedAt"`
+}
+
+type ReaderDataExportNewsletter struct {
+	Email  string `json:"email"`
+	Status string `json:"status"`
+}
+
+type ReaderDataExportResult struct {
+	Status string
+	Data   *ReaderDataExport
+}
+
+// ResolveReaderSessionID returns the session id carried by a reader refresh token, or an empty string when the token
+// is not valid.
+func ResolveReaderSessionID(token string) string {
+	config := appconfig.ResolveReaderConfig()
+	if !config.JWTConfigured() || strings.TrimSpace(token) == "" {
+		return ""
+	}
+
+	claims, err := verifyReaderJWT(config, token, "refresh", time.Now().UTC())
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(claims.ID)
+}
+
+func (s *Service) GetReaderAccount(ctx context.Context, reader *domain.ReaderUser) ReaderAccountResult {
+	record, status := s.loadReaderAccountRecord(ctx, reader)
+	if record == nil {
+		return ReaderAccountResult{Status: status}
+	}
+
+	providers, err := s.listReaderLinkedProviders(ctx, record)
+	if err != nil {
+		return ReaderAccountResult{Status: toReaderAccountStatus(ctx, "reader linked providers lookup failed", err)}
+	}
+
+	return ReaderAccountResult{
+		Status: readerAccountStatusSuccess,
+		Account: &ReaderAccount{
+			User:      record.ReaderUser,
+			CreatedAt: record.CreatedAt,
+			Providers: providers,
+		},
+	}
+}
+
+// UpdateReaderProfile changes the display name shown with the reader's comments. Later provider sign-ins keep it.
+func (s *Service) UpdateReaderProfile(ctx context.Context, reader *domain.ReaderUser, name string) ReaderAccountResult {
+	record, status := s.loadReaderAccountRecord(ctx, reader)
+	if record == nil {
+		return ReaderAccountResult{Status: status}
+	}
+
+	resolvedName := normalizeCommentAuthorName(name)
+	if resolvedName == "" {
+		return ReaderAccountResult{Status: readerAccountStatusInvalidName}
+	}
+
+	if err := s.readerUsers.UpdateNameByID(ctx, record.ID, resolvedName); err != nil {
+		if errors.Is(err, repository.ErrReaderUserNotFound) {
+			return ReaderAccountResult{Status: readerAccountStatusUnauthorized}
+		}
+		return ReaderAccountResult{Status: toReaderAccountStatus(ctx, "reader profile update failed", err)}
+	}
+
+	return s.GetReaderAccount(ctx, &record.ReaderUser)
+}
+
+// UnlinkReaderProvider detaches a sign-in method. The last remaining method cannot be removed, since the reader could
+// not sign in again; deleting the account is the way to drop it.
+func (s *Service) UnlinkReaderProvider(ctx context.Context, reader *domain.ReaderUser, providerID string) ReaderAccountMutationResult {
+	record, status := s.loadReaderAccountRecord(ctx, reader)
+	if record == nil {
+		return ReaderAccountMutationResult{Status: status}
+	}
+
+	providers, err := s.listReaderLinkedProviders(ctx, record)
+	if err != nil {
+		return ReaderAccountMutationResult{Status: toReaderAccountStatus(ctx, "reader linked providers lookup failed", err)}
+	}
+
+	resolvedProvider := strings.ToLower(strings.TrimSpace(providerID))
+	linked := false
+	for _, provider := range providers {
+		if provider.ID == resolvedProvider {
+			linked = true
+			break
+		}
+	}
+	if !linked {
+		return ReaderAccountMutationResult{Status: readerAccountStatusInvalidProvider}
+	}
+	if len(providers) <= 1 {
+		return ReaderAccountMutationResult{Status: readerAccountStatusLastSignInMethod}
+	}
+
+	switch resolvedProvider {
+	case readerProviderGoogle, readerProviderGithub:
+		_, err = s.readerUsers.UnlinkProviderByID(ctx, record.ID, resolvedProvider)
+	default:
+		_, err = s.oidcIdentities.Unlink(ctx, domain.OIDCAccountTypeReader, record.ID, resolvedProvider)
+	}
+	if err != nil {
+		return ReaderAccountMutationResult{Status: toReaderAccountStatus(ctx, "reader provider unlink failed", err)}
+	}
+
+	return ReaderAccountMutationResult{Status: readerAccountStatusSuccess}
+}
+
+func (s *Service) ListReaderSessions(ctx context.Context, reader *domain.ReaderUser, currentSessionID string) ReaderSessionsResult {
+	if reader == nil || strings.TrimSpace(reader.ID) == "" {
+		return ReaderSessionsResult{Status: readerAccountStatusUnauthorized}
+	}
+
+	records, err := s.readerRefreshTokens.ListActiveByUserID(
+		ctx,
+		reader.ID,
+		time.Now().UTC(),
+		maxActiveReaderSessions,
+	)
+	if err != nil {
+		return ReaderSessionsResult{Status: toReaderAccountStatus(ctx, "reader sessions lookup failed", err)}
+	}
+
+	resolvedCurrentID := strings.TrimSpace(currentSessionID)
+	sessions := make([]ReaderSession, 0, len(records))
+	for _, record := range records {
+		sessions = append(sessions, ReaderSession{
+			ReaderSessionRecord: record,
+			Device:              describeLoginDevice(record.UserAgent),
+			Current:             resolvedCurrentID != "" && record.ID == resolvedCurrentID,
+		})
+	}
+
+	return ReaderSessionsResult{Status: readerAccountStatusSuccess, Sessions: sessions}
+}
+
+func (s *Service) RevokeReaderSession(ctx context.Context, reader *domain.ReaderUser, sessionID string) ReaderAccountMutationResult {
+	if reader == nil || strings.TrimSpace(reader.ID) == "" {
+		return ReaderAccountMutationResult{Status: readerAccountStatusUnauthorized}
+	}
+
+	resolvedSessionID := strings.TrimSpace(sessionID)
+	if resolvedSessionID == "" {
+		return ReaderAccountMutationResult{Status: readerAccountStatusNotFound}
+	}
+
+	revoked, err := s.readerRefreshTokens.RevokeByJTIAndUserID(ctx, resolvedSessionID, reader.ID, time.Now().UTC())
+	if err != nil {
+		return ReaderAccountMutationResult{Status: toReaderAccountStatus(ctx, "reader session revoke failed", err)}
+	}
+	if !revoked {
+		return ReaderAccountMutationResult{Status: readerAccountStatusNotFound}
+	}
+
+	return ReaderAccountMutationResult{Status: readerAccountStatusSuccess}
+}
+
+// ExportReaderData collects the reader's account, active sessions, comments, likes and newsletter subscription.
+func (s *Service) ExportReaderData(ctx context.Context, reader *domain.ReaderUser) ReaderDataExportResult {
+	record, status := s.loadReaderAccountRecord(ctx, reader)
+	if record == nil {
+		return ReaderDataExportResult{Status: status}
+	}
+
+	providers, err := s.listReaderLinkedProviders(ctx, record)
+	if err != nil {
+		return ReaderDataExportResult{Status: toReaderAccountStatus(ctx, "reader export providers lookup failed", err)}
+	}
+	sessions, err := s.readerRefreshTokens.ListActiveByUserID(ctx, record.ID, time.Now().UTC(), maxActiveReaderSessions)
+	if err != nil {
+		return ReaderDataExportResult{Status: toReaderAccountStatus(ctx, "reader export sessions lookup failed", err)}
+	}
+	comments, err := s.comments.ListCommentsByAuthorEmail(ctx, record.Email)
+	if err != nil {
+		return ReaderDataExportResult{Status: toReaderAccountStatus(ctx, "reader export comments lookup failed", err)}
+	}
+	likes, err := s.readerPostLikes.ListByReaderID(ctx, record.ID)
+	if err != nil {
+		return ReaderDataExportResult{Status: toReaderAccountStatus(ctx, "reader export likes lookup failed", err)}
+	}
+	newsletterStatus, subscribed, err := s.newsletter.GetStatusByEmail(ctx, record.Email)
+	if err != nil {
+		return ReaderDataExportResult{Status: toReaderAccountStatus(ctx, "reader export newsletter lookup failed", err)}
+	}
+
+	data := &ReaderDataExport{
+		ExportedAt: nowUTCFn(),
+		Account: ReaderDataExportAccount{
+			ID:                record.ID,
+			Name:              record.Name,
+			Email:             record.Email,
+			AvatarURL:         record.AvatarURL,
+			LastLoginProvider: record.LastLoginProvider,
+			Providers:         make([]ReaderDataExportProvider, 0, len(providers)),
+		},
+		Sessions: make([]ReaderDataExportSession, 0, len(sessions)),
+		Comments: make([]ReaderDataExportComment, 0, len(comments)),
+		Likes:    make([]ReaderDataExportLike, 0, len(likes)),
+	}
+	if !record.CreatedAt.IsZero() {
+		createdAt := record.CreatedAt.UTC()
+		data.Account.CreatedAt = &createdAt
+	}
+	for _, provider := range providers {
+		data.Account.Providers = append(data.Account.Providers, ReaderDataExportProvider{
+			ID:       provider.ID,
+			Email:    provider.Email,
+			LinkedAt: provider.LinkedAt,
+		})
+	}
+	for _, session := range sessions {
+		data.Sessions = append(data.Sessions, ReaderDataExportSession{
+			UserAgent:   session.UserAgent,
+			RemoteIP:    session.RemoteIP,
+			CountryCode: session.CountryCode,
+			CreatedAt:   session.CreatedAt,
+			LastSeenAt:  session.LastSeenAt,
+			ExpiresAt:   session.ExpiresAt,
+		})
+	}
+	for _, comment := range comments {
+		data.Comments = append(data.Comments, ReaderDataExportComment{
+			ID:         comment.ID,
+			PostID:     comment.PostID,
+			ParentID:   derefCommentParentID(comment.ParentID),
+			AuthorName: comment.AuthorName,
+			Content:    comment.Content,
+			Status:     comment.Status,
+			CreatedAt:  comment.CreatedAt,
+		})
+	}
+	for _, like := range likes {
+		data.Likes = append(data.Likes, ReaderDataExportLike{
+			PostID:      like.PostID,
+			Count:       like.Count,
+			FirstLikeAt: like.FirstLikeAt,
+			LastLikeAt:  like.LastLikeAt,
+		})
+	}
+	if subscribed {
+		data.Newsletter = &ReaderDataExportNewsletter{Email: record.Email, Status: newsletterStatus}
+	}
+
+	return ReaderDataExportResult{Status: readerAccountStatusSuccess, Data: data}
+}
+
+// DeleteReaderAccount removes the reader and everything linked to the account. Comments are anonymized or deleted as
+// the reader chose. The account record goes last, so a failed step can be retried from the same session. The
+// newsletter subscription is left alone; it has its own unsubscribe link.
+func (s *Service) DeleteReaderAccount(ctx context.Context, reader *domain.ReaderUser, commentsOption string) DeleteReaderAccountResult {
+	resolvedOption := strings.ToLower(strings.TrimSpace(commentsOption))
+	if resolvedOption != ReaderCommentsAnonymize && resolvedOption != ReaderCommentsDelete {
+		return DeleteReaderAccountResult{Status: readerAccountStatusInvalidOption}
+	}
+
+	record, status := s.loadReaderAccountRecord(ctx, reader)
+	if record == nil {
+		return DeleteReaderAccountResult{Status: status}
+	}
+
+	var (
+		affected int
+		err      error
+	)
+	if resolvedOption == ReaderCommentsDelete {
+		affected, err = s.comments.DeleteCommentsByAuthorEmail(ctx, record.Email)
+	} else {
+		affected, err = s.comments.AnonymizeCommentsByAuthorEmail(ctx, record.Email, readerAnonymizedAuthorName, nowUTCFn())
+	}
+	if err != nil {
+		return DeleteReaderAccountResult{Status: toReaderAccountStatus(ctx, "reader comments cleanup failed", err)}
+	}
+
+	cleanups := []struct {
+		message string
+		run     func() error
+	}{
+		{"reader likes cleanup failed", func() error {
+			return s.readerPostLikes.DeleteByReaderID(ctx, record.ID)
+		}},
+		{"reader linked accounts cleanup failed", func() error {
+			return s.oidcIdentities.DeleteByUserID(ctx, domain.OIDCAccountTypeReader, record.ID)
+		}},
+		{"reader login history cleanup failed", func() error {
+			return s.loginHistory.DeleteByUserID(ctx, domain.AccountTypeReader, record.ID)
+		}},
+		{"reader sessions cleanup failed", func() error {
+			return s.readerRefreshTokens.DeleteAllByUserID(ctx, record.ID)
+		}},
+	}
+	for _, cleanup := range cleanups {
+		if err := cleanup.run(); err != nil {
+			return DeleteReaderAccountResult{Status: toReaderAccountStatus(ctx, cleanup.message, err)}
+		}
+	}
+
+	if _, err := s.readerUsers.DeleteByID(ctx, record.ID); err != nil {
+		return DeleteReaderAccountResult{Status: toReaderAccountStatus(ctx, "reader account delete failed", err)}
+	}
+
+	return DeleteReaderAccountResult{Status: readerAccountStatusSuccess, CommentsAffected: affected}
+}
+
+// RecordReaderPostLike adds a like to the reader's history. The post counter is already updated, so a failure is only
+// logged.
+func (s *Service) RecordReaderPostLike(ctx context.Context, reader *domain.ReaderUser, postID string) {
+	if reader == nil || strings.TrimSpace(reader.ID) == "" {
+		return
+	}
+
+	normalizedPostID, ok := normalizePostID(postID)
+	if !ok {
+		return
+	}
+
+	if err := s.readerPostLikes.Record(ctx, reader.ID, normalizedPostID, nowUTCFn()); err != nil {
+		httpapi.LogError(ctx, "reader like history update failed", err, slog.String("userId", reader.ID))
+	}
+}
+
+func (s *Service) loadReaderAccountRecord(ctx context.Context, reader *domain.ReaderUser) (*domain.ReaderUserRecord, string) {
+	if reader == nil || strings.TrimSpace(reader.ID) == "" {
+		return nil, readerAccountStatusUnauthorized
+	}
+
+	record, err := s.readerUsers.FindByID(ctx, strings.TrimSpace(reader.ID))
+	if err != nil {
+		return nil, toReaderAccountStatus(ctx, "reader account lookup failed", err)
+	}
+	if record == nil {
+		return nil, readerAccountStatusUnauthorized
+	}
+
+	return record, ""
+}
+
+func (s *Service) listReaderLinkedProviders(ctx context.Context, record *domain.ReaderUserRecord) ([]ReaderLinkedProvider, error) {
+	providers := make([]ReaderLinkedProvider, 0, 2)
+	if record.GoogleSubject != "" {
+		providers = append(providers, ReaderLinkedProvider{
+			ID:       readerProviderGoogle,
+			Name:     "Google",
+			Email:    record.GoogleEmail,
+			LinkedAt: record.GoogleLinkedAt,
+		})
+	}
+	if record.GithubSubject != "" {
+		providers = append(providers, ReaderLinkedProvider{
+			ID:       readerProviderGithub,
+			Name:     "GitHub",
+			Email:    record.GithubEmail,
+			LinkedAt: record.GithubLinkedAt,
+		})
+	}
+
+	links, err := s.oidcIdentities.ListByUserID(ctx, domain.OIDCAccountTypeReader, record.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(links) == 0 {
+		return providers, nil
+	}
+
+	names := map[string]string{}
+	if configured, err := ListOIDCProviders(); err == nil {
+		for _, provider := range configured {
+			names[provider.ID] = provider.Name
+		}
+	}
+	for _, link := range links {
+		name := names[link.Provider]
+		if name == "" {
+			name = link.Provider
+		}
+		linkedAt := link.LinkedAt
+		providers = append(providers, ReaderLinkedProvider{
+			ID:       link.Provider,
+			Name:     name,
+			Email:    link.Email,
+			LinkedAt: &linkedAt,
+		})
+	}
+
+	return providers, nil
+}
+
+func derefCommentParentID(parentID *string) string {
+	if parentID == nil {
+		return ""
+	}
+	return strings.TrimSpace(*parentID)
+}
+
+func toReaderAccountStatus(ctx context.Context, message string, err error) string {
+	httpapi.LogError(ctx, message, err)
+	if errors.Is(err, repository.ErrReaderUserRepositoryUnavailable) ||
+		errors.Is(err, repository.ErrReaderRefreshTokenRepositoryUnavailable) ||
+		errors.Is(err, repository.ErrOIDCIdentityRepositoryUnavailable) ||
+		errors.Is(err, repository.ErrCommentRepositoryUnavailable) ||
+		errors.Is(err, repository.ErrReaderPostLikeRepositoryUnavailable) ||
+		errors.Is(err, repository.ErrLoginHistoryRepositoryUnavailable) ||
+		errors.Is(err, repository.ErrNewsletterRepositoryUnavailable) {
+		return statusServiceUnavailable
+	}
+	return readerAccountStatusFailed
+}
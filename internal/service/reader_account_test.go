@@ -0,0 +1,373 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/repository"
+)
+
+type stubReaderPostLikeRepository struct {
+	likes   []domain.ReaderPostLikeRecord
+	deleted []string
+}
+
+func (stub *stubReaderPostLikeRepository) Record(_ context.Context, readerID, postID string, now time.Time) error {
+	for index := range stub.likes {
+		if stub.likes[index].ReaderID == readerID && stub.likes[index].PostID == postID {
+			stub.likes[index].Count++
+			stub.likes[index].LastLikeAt = now
+			return nil
+		}
+	}
+	stub.likes = append(stub.likes, domain.ReaderPostLikeRecord{
+		ReaderID:    readerID,
+		PostID:      postID,
+		Count:       1,
+		FirstLikeAt: now,
+		LastLikeAt:  now,
+	})
+	return nil
+}
+
+func (stub *stubReaderPostLikeRepository) ListByReaderID(_ context.Context, readerID string) ([]domain.ReaderPostLikeRecord, error) {
+	likes := make([]domain.ReaderPostLikeRecord, 0, len(stub.likes))
+	for _, like := range stub.likes {
+		if like.ReaderID == readerID {
+			likes = append(likes, like)
+		}
+	}
+	return likes, nil
+}
+
+func (stub *stubReaderPostLikeRepository) DeleteByReaderID(_ context.Context, readerID string) error {
+	stub.deleted = append(stub.deleted, readerID)
+	return nil
+}
+
+func stubReaderAccountRepositories(t *testing.T) {
+	t.Helper()
+
+	originalUsers := readerUsersRepository
+	originalRefreshTokens := readerRefreshTokensRepository
+	originalComments := commentRepository
+	originalLikes := readerPostLikesRepository
+	originalIdentities := oidcIdentitiesRepository
+	originalLoginHistory := loginHistoryRepository
+	originalNewsletter := newsletterRepository
+	originalNowUTCFn := nowUTCFn
+	t.Cleanup(func() {
+		readerUsersRepository = originalUsers
+		readerRefreshTokensRepository = originalRefreshTokens
+		commentRepository = originalComments
+		readerPostLikesRepository = originalLikes
+		oidcIdentitiesRepository = originalIdentities
+		loginHistoryRepository = originalLoginHistory
+		newsletterRepository = originalNewsletter
+		nowUTCFn = originalNowUTCFn
+	})
+
+	oidcIdentitiesRepository = &stubOIDCIdentityRepository{}
+	nowUTCFn = func() time.Time { return time.Date(2026, 4, 1, 12, 0, 0, 0, time.UTC) }
+}
+
+func testReaderAccountRecord() *domain.ReaderUserRecord {
+	linkedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	return &domain.ReaderUserRecord{
+		ReaderUser: domain.ReaderUser{
+			ID:                "reader-1",
+			Name:              "Reader One",
+			Email:             "reader@example.com",
+			LastLoginProvider: readerProviderGoogle,
+			GoogleSubject:     "google-sub",
+			GoogleEmail:       "reader@example.com",
+			GoogleLinkedAt:    &linkedAt,
+		},
+		CreatedAt: linkedAt,
+	}
+}
+
+func TestUpdateReaderProfileKeepsCustomNameOnLogin(t *testing.T) {
+	stubReaderAccountRepositories(t)
+
+	record := testReaderAccountRecord()
+	readerUsersRepository = stubReaderUserRepository{
+		findByID: func(context.Context, string) (*domain.ReaderUserRecord, error) {
+			found := *record
+			return &found, nil
+		},
+		updateName: func(_ context.Context, id, name string) error {
+			if id != "reader-1" {
+				t.Fatalf("unexpected reader id %q", id)
+			}
+			record.Name = name
+			record.NameCustomized = true
+			return nil
+		},
+	}
+
+	result := testService().UpdateReaderProfile(context.Background(), &record.ReaderUser, "   ")
+	if result.Status != readerAccountStatusInvalidName {
+		t.Fatalf("expected invalid-name, got %#v", result)
+	}
+
+	result = testService().UpdateReaderProfile(context.Background(), &domain.ReaderUser{ID: "reader-1"}, "  Quiet   Reader ")
+	if result.Status != readerAccountStatusSuccess || result.Account == nil || result.Account.User.Name != "Quiet Reader" {
+		t.Fatalf("UpdateReaderProfile() = %#v", result)
+	}
+	if len(result.Account.Providers) != 1 || result.Account.Providers[0].ID != readerProviderGoogle {
+		t.Fatalf("unexpected providers %#v", result.Account.Providers)
+	}
+
+	if got := resolveReaderLoginName(record, "Google Name"); got != "Quiet Reader" {
+		t.Fatalf("resolveReaderLoginName() = %q, want the custom name", got)
+	}
+	if got := resolveReaderLoginName(&domain.ReaderUserRecord{}, "Google Name"); got != "Google Name" {
+		t.Fatalf("resolveReaderLoginName() = %q, want the provider name", got)
+	}
+}
+
+func TestUnlinkReaderProvider(t *testing.T) {
+	stubReaderAccountRepositories(t)
+
+	record := testReaderAccountRecord()
+	unlinked := ""
+	readerUsersRepository = stubReaderUserRepository{
+		findByID: func(context.Context, string) (*domain.ReaderUserRecord, error) {
+			found := *record
+			return &found, nil
+		},
+		unlinkProvider: func(_ context.Context, _ string, provider string) (bool, error) {
+			unlinked = provider
+			return true, nil
+		},
+	}
+	reader := &domain.ReaderUser{ID: "reader-1"}
+
+	if result := testService().UnlinkReaderProvider(context.Background(), reader, "github"); result.Status != readerAccountStatusInvalidProvider {
+		t.Fatalf("expected invalid-provider for an unlinked method, got %#v", result)
+	}
+	if result := testService().UnlinkReaderProvider(context.Background(), reader, "google"); result.Status != readerAccountStatusLastSignInMethod {
+		t.Fatalf("expected last-sign-in-method, got %#v", result)
+	}
+	if unlinked != "" {
+		t.Fatalf("expected the last sign-in method to stay linked, unlinked %q", unlinked)
+	}
+
+	record.GithubSubject = "github-sub"
+	if result := testService().UnlinkReaderProvider(context.Background(), reader, " Google "); result.Status != readerAccountStatusSuccess {
+		t.Fatalf("UnlinkReaderProvider() = %#v", result)
+	}
+	if unlinked != readerProviderGoogle {
+		t.Fatalf("expected google to be unlinked, got %q", unlinked)
+	}
+
+	if result := testService().UnlinkReaderProvider(context.Background(), nil, "google"); result.Status != readerAccountStatusUnauthorized {
+		t.Fatalf("expected unauthorized without a reader, got %#v", result)
+	}
+}
+
+func TestReaderSessions(t *testing.T) {
+	stubReaderAccountRepositories(t)
+
+	readerRefreshTokensRepository = stubReaderRefreshTokenRepository{
+		listActive: func(_ context.Context, userID string, _ time.Time, limit int) ([]domain.ReaderSessionRecord, error) {
+			if userID != "reader-1" || limit != maxActiveReaderSessions {
+				t.Fatalf("unexpected session lookup %q %d", userID, limit)
+			}
+			return []domain.ReaderSessionRecord{
+				{ID: "jti-1", UserAgent: "Mozilla/5.0 (X11; Linux x86_64) Firefox/128.0"},
+				{ID: "jti-2"},
+			}, nil
+		},
+		revokeForUser: func(_ context.Context, jti, userID string, _ time.Time) (bool, error) {
+			return jti == "jti-2" && userID == "reader-1", nil
+		},
+	}
+	reader := &domain.ReaderUser{ID: "reader-1"}
+
+	sessions := testService().ListReaderSessions(context.Background(), reader, "jti-1")
+	if sessions.Status != readerAccountStatusSuccess || len(sessions.Sessions) != 2 {
+		t.Fatalf("ListReaderSessions() = %#v", sessions)
+	}
+	if !sessions.Sessions[0].Current || sessions.Sessions[1].Current || sessions.Sessions[0].Device == "" {
+		t.Fatalf("unexpected sessions %#v", sessions.Sessions)
+	}
+
+	if result := testService().RevokeReaderSession(context.Background(), reader, "jti-2"); result.Status != readerAccountStatusSuccess {
+		t.Fatalf("RevokeReaderSession() = %#v", result)
+	}
+	if result := testService().RevokeReaderSession(context.Background(), reader, "jti-3"); result.Status != readerAccountStatusNotFound {
+		t.Fatalf("expected not-found for another session, got %#v", result)
+	}
+	if result := testService().RevokeReaderSession(context.Background(), nil, "jti-2"); result.Status != readerAccountStatusUnauthorized {
+		t.Fatalf("expected unauthorized without a reader, got %#v", result)
+	}
+}
+
+func TestExportReaderData(t *testing.T) {
+	stubReaderAccountRepositories(t)
+
+	record := testReaderAccountRecord()
+	parentID := "comment-0"
+	readerUsersRepository = stubReaderUserRepository{
+		findByID: func(context.Context, string) (*domain.ReaderUserRecord, error) {
+			return record, nil
+		},
+	}
+	readerRefreshTokensRepository = stubReaderRefreshTokenRepository{
+		listActive: func(context.Context, string, time.Time, int) ([]domain.ReaderSessionRecord, error) {
+			return []domain.ReaderSessionRecord{{ID: "jti-1", RemoteIP: "203.0.113.7", CountryCode: "TR"}}, nil
+		},
+	}
+	commentRepository = commentStubRepository{
+		listByAuthorEmail: func(_ context.Context, email string) ([]domain.CommentRecord, error) {
+			if email != "reader@example.com" {
+				t.Fatalf("unexpected comment author %q", email)
+			}
+			return []domain.CommentRecord{{
+				ID:          "comment-1",
+				PostID:      "hello-world",
+				ParentID:    &parentID,
+				AuthorName:  "Reader One",
+				AuthorEmail: email,
+				Content:     "Nice post",
+				Status:      "approved",
+				IPHash:      "ip-hash",
+			}}, nil
+		},
+	}
+	likes := &stubReaderPostLikeRepository{}
+	readerPostLikesRepository = likes
+	newsletterRepository = newsletterStubRepository{
+		getStatusByEmail: func(context.Context, string) (string, bool, error) {
+			return "active", true, nil
+		},
+	}
+
+	testService().RecordReaderPostLike(context.Background(), &record.ReaderUser, "Hello-World")
+	testService().RecordReaderPostLike(context.Background(), &record.ReaderUser, "hello-world")
+	testService().RecordReaderPostLike(context.Background(), nil, "hello-world")
+
+	result := testService().ExportReaderData(context.Background(), &record.ReaderUser)
+	if result.Status != readerAccountStatusSuccess || result.Data == nil {
+		t.Fatalf("ExportReaderData() = %#v", result)
+	}
+
+	payload, err := json.Marshal(result.Data)
+	if err != nil {
+		t.Fatalf("marshal export: %v", err)
+	}
+	for _, want := range []string{
+		`"email":"reader@example.com"`,
+		`"id":"google"`,
+		`"remoteIp":"203.0.113.7"`,
+		`"parentId":"comment-0"`,
+		`"content":"Nice post"`,
+		`"postId":"hello-world","count":2`,
+		`"newsletter":{"email":"reader@example.com","status":"active"}`,
+	} {
+		if !strings.Contains(string(payload), want) {
+			t.Fatalf("export %s does not contain %s", payload, want)
+		}
+	}
+	if strings.Contains(string(payload), "ip-hash") {
+		t.Fatalf("export leaks comment hashes: %s", payload)
+	}
+
+	readerPostLikesRepository = stubReaderPostLikeRepositoryError{}
+	if result := testService().ExportReaderData(context.Background(), &record.ReaderUser); result.Status != statusServiceUnavailable {
+		t.Fatalf("expected service-unavailable, got %#v", result)
+	}
+}
+
+type stubReaderPostLikeRepositoryError struct{}
+
+func (stubReaderPostLikeRepositoryError) Record(context.Context, string, string, time.Time) error {
+	return repository.ErrReaderPostLikeRepositoryUnavailable
+}
+
+func (stubReaderPostLikeRepositoryError) ListByReaderID(context.Context, string) ([]domain.ReaderPostLikeRecord, error) {
+	return nil, repository.ErrReaderPostLikeRepositoryUnavailable
+}
+
+func (stubReaderPostLikeRepositoryError) DeleteByReaderID(context.Context, string) error {
+	return repository.ErrReaderPostLikeRepositoryUnavailable
+}
+
+func TestDeleteReaderAccount(t *testing.T) {
+	tests := []struct {
+		name          string
+		option        string
+		wantAnonymize bool
+		wantDelete    bool
+	}{
+		{name: "anonymize", option: ReaderCommentsAnonymize, wantAnonymize: true},
+		{name: "delete", option: " DELETE ", wantDelete: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stubReaderAccountRepositories(t)
+
+			record := testReaderAccountRecord()
+			deletedUser := ""
+			deletedTokens := ""
+			readerUsersRepository = stubReaderUserRepository{
+				findByID: func(context.Context, string) (*domain.ReaderUserRecord, error) {
+					return record, nil
+				},
+				deleteByID: func(_ context.Context, id string) (bool, error) {
+					deletedUser = id
+					return true, nil
+				},
+			}
+			readerRefreshTokensRepository = stubReaderRefreshTokenRepository{
+				deleteAll: func(_ context.Context, userID string) error {
+					deletedTokens = userID
+					return nil
+				},
+			}
+			anonymized, deleted := false, false
+			commentRepository = commentStubRepository{
+				anonymizeByAuthorEmail: func(_ context.Context, email, authorName string, _ time.Time) (int, error) {
+					if email != "reader@example.com" || authorName != readerAnonymizedAuthorName {
+						t.Fatalf("unexpected anonymize %q %q", email, authorName)
+					}
+					anonymized = true
+					return 2, nil
+				},
+				deleteByAuthorEmail: func(context.Context, string) (int, error) {
+					deleted = true
+					return 2, nil
+				},
+			}
+			likes := &stubReaderPostLikeRepository{}
+			readerPostLikesRepository = likes
+			history := &stubLoginHistoryRepository{values: map[string]bool{
+				domain.AccountTypeReader + "|reader-1|country|TR": true,
+			}}
+			loginHistoryRepository = history
+
+			result := testService().DeleteReaderAccount(context.Background(), &record.ReaderUser, tt.option)
+			if result.Status != readerAccountStatusSuccess || result.CommentsAffected != 2 {
+				t.Fatalf("DeleteReaderAccount() = %#v", result)
+			}
+			if anonymized != tt.wantAnonymize || deleted != tt.wantDelete {
+				t.Fatalf("anonymized=%v deleted=%v", anonymized, deleted)
+			}
+			if deletedUser != "reader-1" || deletedTokens != "reader-1" || len(likes.deleted) != 1 || len(history.values) != 0 {
+				t.Fatalf("expected account data to be removed, user=%q tokens=%q likes=%v history=%v",
+					deletedUser, deletedTokens, likes.deleted, history.values)
+			}
+		})
+	}
+
+	if result := testService().DeleteReaderAccount(context.Background(), &domain.ReaderUser{ID: "reader-1"}, "keep"); result.Status != readerAccountStatusInvalidOption {
+		t.Fatalf("expected invalid-option, got %#v", result)
+	}
+}
@@ -30,9 +30,7 @@ type ReaderSessionMetadata struct {
 }
 
 var (
-	readerUsersRepository         repository.ReaderUserRepository         = repository.NewReaderUserRepository()
-	readerRefreshTokensRepository repository.ReaderRefreshTokenRepository = repository.NewReaderRefreshTokenMongoRepository()
-	readerDisplayWordPattern                                              = regexp.MustCompile(`[._-]+`)
+	readerDisplayWordPattern = regexp.MustCompile(`[._-]+`)
 )
 
 const (
@@ -42,13 +40,13 @@ const (
 	readerSessionFailedText      = "reader session failed"
 )
 
-func ResolveReaderFromAccessToken(ctx context.Context, token string) (*domain.ReaderUser, error) {
+func (s *Service) ResolveReaderFromAccessToken(ctx context.Context, token string) (*domain.ReaderUser, error) {
 	config := appconfig.ResolveReaderConfig()
-	if strings.TrimSpace(config.JWTSecret) == "" || strings.TrimSpace(token) == "" {
+	if !config.JWTConfigured() || strings.TrimSpace(token) == "" {
 		return nil, apperrors.Unauthorized(readerAuthRequiredMessage)
 	}
 
-	claims, err := httpauth.VerifyHS256JWT(token, config.JWTSecret, "access", time.Now().UTC())
+	claims, err := verifyReaderJWT(config, token, "access", time.Now().UTC())
 	if err != nil {
 		return nil, apperrors.Unauthorized(readerAuthRequiredMessage)
 	}
@@ -56,7 +54,7 @@ func ResolveReaderFromAccessToken(ctx context.Context, token string) (*domain.Re
 		return nil, apperrors.Unauthorized(readerAuthRequiredMessage)
 	}
 
-	userRecord, err := readerUsersRepository.FindByID(ctx, strings.TrimSpace(claims.Subject))
+	userRecord, err := s.readerUsers.FindByID(ctx, strings.TrimSpace(claims.Subject))
 	if err != nil {
 		return nil, apperrors.Internal("failed to load reader user", err)
 	}
@@ -70,13 +68,13 @@ func ResolveReaderFromAccessToken(ctx context.Context, token string) (*domain.Re
 	return &userRecord.ReaderUser, nil
 }
 
-func RefreshReaderSession(ctx context.Context, token string, metadata ReaderSessionMetadata) (*ReaderAuthResponse, error) {
+func (s *Service) RefreshReaderSession(ctx context.Context, token string, metadata ReaderSessionMetadata) (*ReaderAuthResponse, error) {
 	config := appconfig.ResolveReaderConfig()
-	if strings.TrimSpace(config.JWTSecret) == "" {
+	if !config.JWTConfigured() {
 		return nil, apperrors.Config("reader jwt is not configured", nil)
 	}
 
-	claims, err := httpauth.VerifyHS256JWT(token, config.JWTSecret, "refresh", time.Now().UTC())
+	claims, err := verifyReaderJWT(config, token, "refresh", time.Now().UTC())
 	if err != nil {
 		return nil, apperrors.Unauthorized(readerInvalidSessionMessage)
 	}
@@ -84,15 +82,18 @@ func RefreshReaderSession(ctx context.Context, token string, metadata ReaderSess
 		return nil, apperrors.Unauthorized(readerInvalidSessionMessage)
 	}
 
-	record, err := readerRefreshTokensRepository.FindActiveByToken(ctx, claims.ID, token, time.Now().UTC())
+	record, err := s.readerRefreshTokens.FindActiveByToken(ctx, claims.ID, token, time.Now().UTC())
 	if err != nil {
 		return nil, toReaderSessionError(err)
 	}
 	if record == nil {
+		if err := s.handleReaderRefreshTokenReuse(ctx, claims.ID, token); err != nil {
+			return nil, err
+		}
 		return nil, apperrors.Unauthorized(readerInvalidSessionMessage)
 	}
 
-	userRecord, err := readerUsersRepository.FindByID(ctx, strings.TrimSpace(record.UserID))
+	userRecord, err := s.readerUsers.FindByID(ctx, strings.TrimSpace(record.UserID))
 	if err != nil {
 		return nil, apperrors.Internal("failed to load reader user", err)
 	}
@@ -103,16 +104,16 @@ func RefreshReaderSession(ctx context.Context, token string, metadata ReaderSess
 		return nil, apperrors.Unauthorized(readerInvalidSessionMessage)
 	}
 
-	return issueReaderTokens(ctx, config, userRecord, claims.ID, record.Persistent, metadata)
+	return s.issueReaderTokens(ctx, config, userRecord, claims.ID, record.Persistent, metadata)
 }
 
-func LogoutReader(ctx context.Context, token string) error {
+func (s *Service) LogoutReader(ctx context.Context, token string) error {
 	config := appconfig.ResolveReaderConfig()
-	if strings.TrimSpace(config.JWTSecret) == "" || strings.TrimSpace(token) == "" {
+	if !config.JWTConfigured() || strings.TrimSpace(token) == "" {
 		return nil
 	}
 
-	claims, err := httpauth.VerifyHS256JWT(token, config.JWTSecret, "refresh", time.Now().UTC())
+	claims, err := verifyReaderJWT(config, token, "refresh", time.Now().UTC())
 	if err != nil {
 		if errors.Is(err, httpauth.ErrInvalidJWT) || errors.Is(err, httpauth.ErrExpiredJWT) || errors.Is(err, httpauth.ErrUnsupportedJWT) {
 			return nil
@@ -123,14 +124,14 @@ func LogoutReader(ctx context.Context, token string) error {
 		return nil
 	}
 
-	if err := readerRefreshTokensRepository.RevokeByJTI(ctx, claims.ID, time.Now().UTC()); err != nil {
+	if err := s.readerRefreshTokens.RevokeByJTI(ctx, claims.ID, time.Now().UTC()); err != nil {
 		return toReaderSessionError(err)
 	}
 
 	return nil
 }
 
-func issueReaderTokens(
+func (s *Service) issueReaderTokens(
 	ctx context.Context,
 	config appconfig.ReaderConfig,
 	userRecord *domain.ReaderUserRecord,
@@ -149,7 +150,7 @@ func issueReaderTokens(
 		refreshTTL = config.RememberRefreshTTL
 	}
 
-	accessToken, err := httpauth.IssueHS256JWT(httpauth.JWTClaims{
+	accessToken, err := issueReaderJWT(config, httpauth.JWTClaims{
 		Subject:         readerID,
 		Email:           userRecord.Email,
 		PasswordVersion: userRecord.SessionVersion,
@@ -158,7 +159,7 @@ func issueReaderTokens(
 		Audience:        config.JWTAudience,
 		IssuedAt:        now.Unix(),
 		ExpiresAt:       now.Add(config.AccessTTL).Unix(),
-	}, config.JWTSecret)
+	})
 	if err != nil {
 		return nil, apperrors.Internal("failed to issue reader access token", err)
 	}
@@ -168,7 +169,7 @@ func issueReaderTokens(
 		return nil, apperrors.Internal("failed to issue reader refresh token", err)
 	}
 
-	refreshToken, err := httpauth.IssueHS256JWT(httpauth.JWTClaims{
+	refreshToken, err := issueReaderJWT(config, httpauth.JWTClaims{
 		ID:              refreshJTI,
 		Subject:         readerID,
 		Email:           userRecord.Email,
@@ -178,7 +179,7 @@ func issueReaderTokens(
 		Audience:        config.JWTAudience,
 		IssuedAt:        now.Unix(),
 		ExpiresAt:       now.Add(refreshTTL).Unix(),
-	}, config.JWTSecret)
+	})
 	if err != nil {
 		return nil, apperrors.Internal("failed to issue reader refresh token", err)
 	}
@@ -197,11 +198,12 @@ func issueReaderTokens(
 	}
 
 	if strings.TrimSpace(currentRefreshJTI) == "" {
-		if err := readerRefreshTokensRepository.Create(ctx, refreshRecord); err != nil {
+		if err := s.readerRefreshTokens.Create(ctx, refreshRecord); err != nil {
 			return nil, toReaderSessionError(err)
 		}
+		s.notifyReaderNewLoginSource(ctx, config, userRecord, metadata, now)
 	} else {
-		if err := readerRefreshTokensRepository.Rotate(ctx, currentRefreshJTI, refreshRecord, now); err != nil {
+		if err := s.readerRefreshTokens.Rotate(ctx, currentRefreshJTI, refreshRecord, now); err != nil {
 			if errors.Is(err, repository.ErrReaderRefreshTokenNotFound) {
 				return nil, apperrors.Unauthorized(readerInvalidSessionMessage)
 			}
@@ -246,6 +248,14 @@ func normalizeReaderDisplayName(name, email string) string {
 	return resolvedName
 }
 
+// resolveReaderLoginName keeps a display name the reader chose over the one reported by the provider.
+func resolveReaderLoginName(record *domain.ReaderUserRecord, providerName string) string {
+	if record != nil && record.NameCustomized && strings.TrimSpace(record.Name) != "" {
+		return record.Name
+	}
+	return providerName
+}
+
 func toReaderSessionError(err error) error {
 	if errors.Is(err, repository.ErrReaderRefreshTokenRepositoryUnavailable) || errors.Is(err, repository.ErrReaderUserRepositoryUnavailable) {
 		return apperrors.ServiceUnavailable(readerSessionUnavailableText, err)
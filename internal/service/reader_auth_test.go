@@ -24,6 +24,10 @@ type stubReaderUserRepository struct {
 	updateGoogleIdentity   func(context.Context, string, string, string, string, string, time.Time) error
 	updateGithubIdentity   func(context.Context, string, string, string, string, string, time.Time) error
 	updateLastSeenProvider func(context.Context, string, string) error
+	incrementSession       func(context.Context, string) error
+	updateName             func(context.Context, string, string) error
+	unlinkProvider         func(context.Context, string, string) (bool, error)
+	deleteByID             func(context.Context, string) (bool, error)
 }
 
 func (s stubReaderUserRepository) FindByID(ctx context.Context, id string) (*domain.ReaderUserRecord, error) {
@@ -90,11 +94,45 @@ func (s stubReaderUserRepository) UpdateLastSeenProviderByID(ctx context.Context
 	return s.updateLastSeenProvider(ctx, id, provider)
 }
 
+func (s stubReaderUserRepository) IncrementSessionVersionByID(ctx context.Context, id string) error {
+	if s.incrementSession == nil {
+		return nil
+	}
+	return s.incrementSession(ctx, id)
+}
+
+func (s stubReaderUserRepository) UpdateNameByID(ctx context.Context, id, name string) error {
+	if s.updateName == nil {
+		return nil
+	}
+	return s.updateName(ctx, id, name)
+}
+
+func (s stubReaderUserRepository) UnlinkProviderByID(ctx context.Context, id, provider string) (bool, error) {
+	if s.unlinkProvider == nil {
+		return false, nil
+	}
+	return s.unlinkProvider(ctx, id, provider)
+}
+
+func (s stubReaderUserRepository) DeleteByID(ctx context.Context, id string) (bool, error) {
+	if s.deleteByID == nil {
+		return false, nil
+	}
+	return s.deleteByID(ctx, id)
+}
+
 type stubReaderRefreshTokenRepository struct {
 	create            func(context.Context, domain.ReaderRefreshTokenRecord) error
 	findActiveByToken func(context.Context, string, string, time.Time) (*domain.ReaderRefreshTokenRecord, error)
 	rotate            func(context.Context, string, domain.ReaderRefreshTokenRecord, time.Time) error
 	revokeByJTI       func(context.Context, string, time.Time) error
+	findByToken       func(context.Context, string, string) (*domain.ReaderRefreshTokenRecord, error)
+	revokeFamily      func(context.Context, string, time.Time) (int, error)
+	revokeAll         func(context.Context, string, time.Time) error
+	listActive        func(context.Context, string, time.Time, int) ([]domain.ReaderSessionRecord, error)
+	revokeForUser     func(context.Context, string, string, time.Time) (bool, error)
+	deleteAll         func(context.Context, string) error
 }
 
 func (s stubReaderRefreshTokenRepository) Create(ctx context.Context, record domain.ReaderRefreshTokenRecord) error {
@@ -134,6 +172,60 @@ func (s stubReaderRefreshTokenRepository) RevokeByJTI(ctx context.Context, jti s
 	return s.revokeByJTI(ctx, jti, now)
 }
 
+func (s stubReaderRefreshTokenRepository) FindByToken(
+	ctx context.Context,
+	jti, rawToken string,
+) (*domain.ReaderRefreshTokenRecord, error) {
+	if s.findByToken == nil {
+		return nil, nil
+	}
+	return s.findByToken(ctx, jti, rawToken)
+}
+
+func (s stubReaderRefreshTokenRepository) RevokeFamily(ctx context.Context, jti string, now time.Time) (int, error) {
+	if s.revokeFamily == nil {
+		return 0, nil
+	}
+	return s.revokeFamily(ctx, jti, now)
+}
+
+func (s stubReaderRefreshTokenRepository) RevokeAllByUserID(ctx context.Context, userID string, now time.Time) error {
+	if s.revokeAll == nil {
+		return nil
+	}
+	return s.revokeAll(ctx, userID, now)
+}
+
+func (s stubReaderRefreshTokenRepository) ListActiveByUserID(
+	ctx context.Context,
+	userID string,
+	now time.Time,
+	limit int,
+) ([]domain.ReaderSessionRecord, error) {
+	if s.listActive == nil {
+		return nil, nil
+	}
+	return s.listActive(ctx, userID, now, limit)
+}
+
+func (s stubReaderRefreshTokenRepository) RevokeByJTIAndUserID(
+	ctx context.Context,
+	jti, userID string,
+	now time.Time,
+) (bool, error) {
+	if s.revokeForUser == nil {
+		return false, nil
+	}
+	return s.revokeForUser(ctx, jti, userID, now)
+}
+
+func (s stubReaderRefreshTokenRepository) DeleteAllByUserID(ctx context.Context, userID string) error {
+	if s.deleteAll == nil {
+		return nil
+	}
+	return s.deleteAll(ctx, userID)
+}
+
 type roundTripFunc func(*http.Request) (*http.Response, error)
 
 func (fn roundTripFunc) RoundTrip(request *http.Request) (*http.Response, error) {
@@ -185,7 +277,7 @@ func TestResolveReaderFromAccessTokenReturnsReaderUser(t *testing.T) {
 		},
 	}
 
-	user, err := ResolveReaderFromAccessToken(context.Background(), token)
+	user, err := testService().ResolveReaderFromAccessToken(context.Background(), token)
 	if err != nil {
 		t.Fatalf("ResolveReaderFromAccessToken returned error: %v", err)
 	}
@@ -201,7 +293,7 @@ func TestResolveReaderFromAccessTokenErrorPaths(t *testing.T) {
 	})
 
 	t.Run("requires jwt secret and token", func(t *testing.T) {
-		if _, err := ResolveReaderFromAccessToken(context.Background(), " "); err == nil || !strings.Contains(err.Error(), readerAuthRequiredMessage) {
+		if _, err := testService().ResolveReaderFromAccessToken(context.Background(), " "); err == nil || !strings.Contains(err.Error(), readerAuthRequiredMessage) {
 			t.Fatalf("expected auth required error, got %v", err)
 		}
 	})
@@ -209,7 +301,7 @@ func TestResolveReaderFromAccessTokenErrorPaths(t *testing.T) {
 	t.Run("rejects invalid token", func(t *testing.T) {
 		t.Setenv("JWT_SECRET", "reader-secret")
 
-		if _, err := ResolveReaderFromAccessToken(context.Background(), "invalid-token"); err == nil || !strings.Contains(err.Error(), readerAuthRequiredMessage) {
+		if _, err := testService().ResolveReaderFromAccessToken(context.Background(), "invalid-token"); err == nil || !strings.Contains(err.Error(), readerAuthRequiredMessage) {
 			t.Fatalf("expected auth required error, got %v", err)
 		}
 	})
@@ -226,7 +318,7 @@ func TestResolveReaderFromAccessTokenErrorPaths(t *testing.T) {
 			t.Fatalf("IssueHS256JWT returned error: %v", err)
 		}
 
-		if _, err := ResolveReaderFromAccessToken(context.Background(), token); err == nil || !strings.Contains(err.Error(), readerAuthRequiredMessage) {
+		if _, err := testService().ResolveReaderFromAccessToken(context.Background(), token); err == nil || !strings.Contains(err.Error(), readerAuthRequiredMessage) {
 			t.Fatalf("expected auth required error, got %v", err)
 		}
 	})
@@ -261,7 +353,7 @@ func TestResolveReaderFromAccessTokenErrorPaths(t *testing.T) {
 			},
 		}
 
-		if _, err := ResolveReaderFromAccessToken(context.Background(), token); err == nil || !strings.Contains(err.Error(), "failed to load reader user") {
+		if _, err := testService().ResolveReaderFromAccessToken(context.Background(), token); err == nil || !strings.Contains(err.Error(), "failed to load reader user") {
 			t.Fatalf("expected load error, got %v", err)
 		}
 
@@ -275,7 +367,7 @@ func TestResolveReaderFromAccessTokenErrorPaths(t *testing.T) {
 		if err != nil {
 			t.Fatalf("IssueHS256JWT returned error: %v", err)
 		}
-		if _, err := ResolveReaderFromAccessToken(context.Background(), missingUserToken); err == nil || !strings.Contains(err.Error(), readerAuthRequiredMessage) {
+		if _, err := testService().ResolveReaderFromAccessToken(context.Background(), missingUserToken); err == nil || !strings.Contains(err.Error(), readerAuthRequiredMessage) {
 			t.Fatalf("expected missing user auth error, got %v", err)
 		}
 
@@ -289,7 +381,7 @@ func TestResolveReaderFromAccessTokenErrorPaths(t *testing.T) {
 		if err != nil {
 			t.Fatalf("IssueHS256JWT returned error: %v", err)
 		}
-		if _, err := ResolveReaderFromAccessToken(context.Background(), mismatchToken); err == nil || !strings.Contains(err.Error(), readerAuthRequiredMessage) {
+		if _, err := testService().ResolveReaderFromAccessToken(context.Background(), mismatchToken); err == nil || !strings.Contains(err.Error(), readerAuthRequiredMessage) {
 			t.Fatalf("expected session mismatch auth error, got %v", err)
 		}
 	})
@@ -351,7 +443,7 @@ func TestRefreshReaderSessionRotatesTokens(t *testing.T) {
 		},
 	}
 
-	response, err := RefreshReaderSession(context.Background(), refreshToken, ReaderSessionMetadata{
+	response, err := testService().RefreshReaderSession(context.Background(), refreshToken, ReaderSessionMetadata{
 		UserAgent:   "test-agent",
 		RemoteIP:    "127.0.0.1",
 		CountryCode: "tr",
@@ -376,7 +468,7 @@ func TestRefreshReaderSessionErrorPaths(t *testing.T) {
 	})
 
 	t.Run("requires jwt config", func(t *testing.T) {
-		if _, err := RefreshReaderSession(context.Background(), "token", ReaderSessionMetadata{}); err == nil || !strings.Contains(err.Error(), "reader jwt is not configured") {
+		if _, err := testService().RefreshReaderSession(context.Background(), "token", ReaderSessionMetadata{}); err == nil || !strings.Contains(err.Error(), "reader jwt is not configured") {
 			t.Fatalf("expected jwt config error, got %v", err)
 		}
 	})
@@ -384,7 +476,7 @@ func TestRefreshReaderSessionErrorPaths(t *testing.T) {
 	t.Run("rejects invalid refresh tokens", func(t *testing.T) {
 		t.Setenv("JWT_SECRET", "reader-secret")
 
-		if _, err := RefreshReaderSession(context.Background(), "bad-token", ReaderSessionMetadata{}); err == nil || !strings.Contains(err.Error(), readerInvalidSessionMessage) {
+		if _, err := testService().RefreshReaderSession(context.Background(), "bad-token", ReaderSessionMetadata{}); err == nil || !strings.Contains(err.Error(), readerInvalidSessionMessage) {
 			t.Fatalf("expected invalid session error, got %v", err)
 		}
 
@@ -397,7 +489,7 @@ func TestRefreshReaderSessionErrorPaths(t *testing.T) {
 		if err != nil {
 			t.Fatalf("IssueHS256JWT returned error: %v", err)
 		}
-		if _, err := RefreshReaderSession(context.Background(), tokenWithoutID, ReaderSessionMetadata{}); err == nil || !strings.Contains(err.Error(), readerInvalidSessionMessage) {
+		if _, err := testService().RefreshReaderSession(context.Background(), tokenWithoutID, ReaderSessionMetadata{}); err == nil || !strings.Contains(err.Error(), readerInvalidSessionMessage) {
 			t.Fatalf("expected invalid session error, got %v", err)
 		}
 	})
@@ -454,7 +546,7 @@ func TestRefreshReaderSessionErrorPaths(t *testing.T) {
 			},
 		}
 
-		if _, err := RefreshReaderSession(context.Background(), refreshToken, ReaderSessionMetadata{}); err == nil || !strings.Contains(err.Error(), readerSessionUnavailableText) {
+		if _, err := testService().RefreshReaderSession(context.Background(), refreshToken, ReaderSessionMetadata{}); err == nil || !strings.Contains(err.Error(), readerSessionUnavailableText) {
 			t.Fatalf("expected session unavailable error, got %v", err)
 		}
 
@@ -469,7 +561,7 @@ func TestRefreshReaderSessionErrorPaths(t *testing.T) {
 		if err != nil {
 			t.Fatalf("IssueHS256JWT returned error: %v", err)
 		}
-		if _, err := RefreshReaderSession(context.Background(), nilRecordToken, ReaderSessionMetadata{}); err == nil || !strings.Contains(err.Error(), readerInvalidSessionMessage) {
+		if _, err := testService().RefreshReaderSession(context.Background(), nilRecordToken, ReaderSessionMetadata{}); err == nil || !strings.Contains(err.Error(), readerInvalidSessionMessage) {
 			t.Fatalf("expected invalid session error, got %v", err)
 		}
 
@@ -484,7 +576,7 @@ func TestRefreshReaderSessionErrorPaths(t *testing.T) {
 		if err != nil {
 			t.Fatalf("IssueHS256JWT returned error: %v", err)
 		}
-		if _, err := RefreshReaderSession(context.Background(), userErrorToken, ReaderSessionMetadata{}); err == nil || !strings.Contains(err.Error(), "failed to load reader user") {
+		if _, err := testService().RefreshReaderSession(context.Background(), userErrorToken, ReaderSessionMetadata{}); err == nil || !strings.Contains(err.Error(), "failed to load reader user") {
 			t.Fatalf("expected user load error, got %v", err)
 		}
 
@@ -499,7 +591,7 @@ func TestRefreshReaderSessionErrorPaths(t *testing.T) {
 		if err != nil {
 			t.Fatalf("IssueHS256JWT returned error: %v", err)
 		}
-		if _, err := RefreshReaderSession(context.Background(), missingUserToken, ReaderSessionMetadata{}); err == nil || !strings.Contains(err.Error(), readerInvalidSessionMessage) {
+		if _, err := testService().RefreshReaderSession(context.Background(), missingUserToken, ReaderSessionMetadata{}); err == nil || !strings.Contains(err.Error(), readerInvalidSessionMessage) {
 			t.Fatalf("expected missing user invalid session error, got %v", err)
 		}
 
@@ -514,7 +606,7 @@ func TestRefreshReaderSessionErrorPaths(t *testing.T) {
 		if err != nil {
 			t.Fatalf("IssueHS256JWT returned error: %v", err)
 		}
-		if _, err := RefreshReaderSession(context.Background(), mismatchUserToken, ReaderSessionMetadata{}); err == nil || !strings.Contains(err.Error(), readerInvalidSessionMessage) {
+		if _, err := testService().RefreshReaderSession(context.Background(), mismatchUserToken, ReaderSessionMetadata{}); err == nil || !strings.Contains(err.Error(), readerInvalidSessionMessage) {
 			t.Fatalf("expected session mismatch error, got %v", err)
 		}
 	})
@@ -547,7 +639,7 @@ func TestLogoutReaderRevokesRefreshToken(t *testing.T) {
 		},
 	}
 
-	if err := LogoutReader(context.Background(), refreshToken); err != nil {
+	if err := testService().LogoutReader(context.Background(), refreshToken); err != nil {
 		t.Fatalf("LogoutReader returned error: %v", err)
 	}
 	if revokedJTI != "refresh-jti" {
@@ -561,16 +653,16 @@ func TestLogoutReaderErrorPaths(t *testing.T) {
 		readerRefreshTokensRepository = previousRefreshRepo
 	})
 
-	if err := LogoutReader(context.Background(), "token"); err != nil {
+	if err := testService().LogoutReader(context.Background(), "token"); err != nil {
 		t.Fatalf("LogoutReader without config returned error: %v", err)
 	}
 
 	t.Setenv("JWT_SECRET", "reader-secret")
 
-	if err := LogoutReader(context.Background(), ""); err != nil {
+	if err := testService().LogoutReader(context.Background(), ""); err != nil {
 		t.Fatalf("LogoutReader with empty token returned error: %v", err)
 	}
-	if err := LogoutReader(context.Background(), "bad-token"); err != nil {
+	if err := testService().LogoutReader(context.Background(), "bad-token"); err != nil {
 		t.Fatalf("LogoutReader with invalid token returned error: %v", err)
 	}
 
@@ -583,7 +675,7 @@ func TestLogoutReaderErrorPaths(t *testing.T) {
 	if err != nil {
 		t.Fatalf("IssueHS256JWT returned error: %v", err)
 	}
-	if err := LogoutReader(context.Background(), noIDToken); err != nil {
+	if err := testService().LogoutReader(context.Background(), noIDToken); err != nil {
 		t.Fatalf("LogoutReader without jti returned error: %v", err)
 	}
 
@@ -603,7 +695,7 @@ func TestLogoutReaderErrorPaths(t *testing.T) {
 			return repository.ErrReaderRefreshTokenRepositoryUnavailable
 		},
 	}
-	if err := LogoutReader(context.Background(), revokeToken); err == nil || !strings.Contains(err.Error(), readerSessionUnavailableText) {
+	if err := testService().LogoutReader(context.Background(), revokeToken); err == nil || !strings.Contains(err.Error(), readerSessionUnavailableText) {
 		t.Fatalf("expected session unavailable error, got %v", err)
 	}
 }
@@ -616,7 +708,7 @@ func TestIssueReaderTokensErrorPaths(t *testing.T) {
 
 	t.Setenv("JWT_SECRET", "reader-secret")
 
-	_, err := issueReaderTokens(context.Background(), appconfig.ResolveReaderConfig(), &domain.ReaderUserRecord{}, "", false, ReaderSessionMetadata{})
+	_, err := testService().issueReaderTokens(context.Background(), appconfig.ResolveReaderConfig(), &domain.ReaderUserRecord{}, "", false, ReaderSessionMetadata{})
 	if err == nil || !strings.Contains(err.Error(), "reader id is not configured") {
 		t.Fatalf("expected missing reader id error, got %v", err)
 	}
@@ -635,7 +727,7 @@ func TestIssueReaderTokensErrorPaths(t *testing.T) {
 			return repository.ErrReaderRefreshTokenRepositoryUnavailable
 		},
 	}
-	if _, err := issueReaderTokens(context.Background(), config, record, "", false, ReaderSessionMetadata{}); err == nil || !strings.Contains(err.Error(), readerSessionUnavailableText) {
+	if _, err := testService().issueReaderTokens(context.Background(), config, record, "", false, ReaderSessionMetadata{}); err == nil || !strings.Contains(err.Error(), readerSessionUnavailableText) {
 		t.Fatalf("expected create session error, got %v", err)
 	}
 
@@ -644,7 +736,7 @@ func TestIssueReaderTokensErrorPaths(t *testing.T) {
 			return repository.ErrReaderRefreshTokenNotFound
 		},
 	}
-	if _, err := issueReaderTokens(context.Background(), config, record, "current-jti", true, ReaderSessionMetadata{}); err == nil || !strings.Contains(err.Error(), readerInvalidSessionMessage) {
+	if _, err := testService().issueReaderTokens(context.Background(), config, record, "current-jti", true, ReaderSessionMetadata{}); err == nil || !strings.Contains(err.Error(), readerInvalidSessionMessage) {
 		t.Fatalf("expected invalid session error, got %v", err)
 	}
 
@@ -653,7 +745,7 @@ func TestIssueReaderTokensErrorPaths(t *testing.T) {
 			return repository.ErrReaderRefreshTokenRepositoryUnavailable
 		},
 	}
-	if _, err := issueReaderTokens(context.Background(), config, record, "current-jti", true, ReaderSessionMetadata{}); err == nil || !strings.Contains(err.Error(), readerSessionUnavailableText) {
+	if _, err := testService().issueReaderTokens(context.Background(), config, record, "current-jti", true, ReaderSessionMetadata{}); err == nil || !strings.Contains(err.Error(), readerSessionUnavailableText) {
 		t.Fatalf("expected rotated session error, got %v", err)
 	}
 }
@@ -743,7 +835,7 @@ func TestResolveReaderRecordForGithubCreatesReaderWhenMissing(t *testing.T) {
 		},
 	}
 
-	record, err := resolveReaderRecordForGithub(context.Background(), &ReaderGithubIdentity{
+	record, err := testService().resolveReaderRecordForGithub(context.Background(), &ReaderGithubIdentity{
 		Subject:       "github-subject",
 		Email:         "reader@example.com",
 		EmailVerified: true,
@@ -791,7 +883,7 @@ func TestResolveReaderRecordForGoogleUpdatesExistingReader(t *testing.T) {
 		},
 	}
 
-	record, err := resolveReaderRecordForGoogle(context.Background(), &ReaderGoogleIdentity{
+	record, err := testService().resolveReaderRecordForGoogle(context.Background(), &ReaderGoogleIdentity{
 		Subject:       "google-subject",
 		Email:         "reader@example.com",
 		EmailVerified: true,
@@ -825,7 +917,7 @@ func TestResolveReaderRecordForGoogleCreatesReaderWhenMissing(t *testing.T) {
 		},
 	}
 
-	record, err := resolveReaderRecordForGoogle(context.Background(), &ReaderGoogleIdentity{
+	record, err := testService().resolveReaderRecordForGoogle(context.Background(), &ReaderGoogleIdentity{
 		Subject:       "google-subject",
 		Email:         "reader@example.com",
 		EmailVerified: true,
@@ -879,7 +971,7 @@ func TestResolveReaderRecordForGithubUpdatesExistingReaderByEmail(t *testing.T)
 		},
 	}
 
-	record, err := resolveReaderRecordForGithub(context.Background(), &ReaderGithubIdentity{
+	record, err := testService().resolveReaderRecordForGithub(context.Background(), &ReaderGithubIdentity{
 		Subject:       "github-subject",
 		Email:         "reader@example.com",
 		EmailVerified: true,
@@ -944,7 +1036,7 @@ func TestLoginReaderWithGithubIdentityIssuesTokens(t *testing.T) {
 		},
 	}
 
-	response, err := LoginReaderWithGithubIdentity(context.Background(), &ReaderGithubIdentity{
+	response, err := testService().LoginReaderWithGithubIdentity(context.Background(), &ReaderGithubIdentity{
 		Subject:       "github-subject",
 		Email:         "reader@example.com",
 		EmailVerified: true,
@@ -1009,7 +1101,7 @@ func TestLoginReaderWithGoogleIdentityIssuesTokens(t *testing.T) {
 		},
 	}
 
-	response, err := LoginReaderWithGoogleIdentity(context.Background(), &ReaderGoogleIdentity{
+	response, err := testService().LoginReaderWithGoogleIdentity(context.Background(), &ReaderGoogleIdentity{
 		Subject:       "google-subject",
 		Email:         "reader@example.com",
 		EmailVerified: true,
@@ -1086,19 +1178,19 @@ func TestReaderOAuthErrorPaths(t *testing.T) {
 	})
 
 	t.Run("reader login requires valid identity and jwt config", func(t *testing.T) {
-		if _, err := LoginReaderWithGithubIdentity(context.Background(), nil, false, ReaderSessionMetadata{}); err == nil || !strings.Contains(err.Error(), readerGithubInvalidMessage) {
+		if _, err := testService().LoginReaderWithGithubIdentity(context.Background(), nil, false, ReaderSessionMetadata{}); err == nil || !strings.Contains(err.Error(), readerGithubInvalidMessage) {
 			t.Fatalf("expected github identity error, got %v", err)
 		}
-		if _, err := LoginReaderWithGoogleIdentity(context.Background(), nil, false, ReaderSessionMetadata{}); err == nil || !strings.Contains(err.Error(), readerGoogleInvalidMessage) {
+		if _, err := testService().LoginReaderWithGoogleIdentity(context.Background(), nil, false, ReaderSessionMetadata{}); err == nil || !strings.Contains(err.Error(), readerGoogleInvalidMessage) {
 			t.Fatalf("expected google identity error, got %v", err)
 		}
-		if _, err := LoginReaderWithGithubIdentity(context.Background(), &ReaderGithubIdentity{
+		if _, err := testService().LoginReaderWithGithubIdentity(context.Background(), &ReaderGithubIdentity{
 			Subject: "github-subject",
 			Email:   "reader@example.com",
 		}, false, ReaderSessionMetadata{}); err == nil || !strings.Contains(err.Error(), "reader jwt is not configured") {
 			t.Fatalf("expected reader jwt config error, got %v", err)
 		}
-		if _, err := LoginReaderWithGoogleIdentity(context.Background(), &ReaderGoogleIdentity{
+		if _, err := testService().LoginReaderWithGoogleIdentity(context.Background(), &ReaderGoogleIdentity{
 			Subject: "google-subject",
 			Email:   "reader@example.com",
 		}, false, ReaderSessionMetadata{}); err == nil || !strings.Contains(err.Error(), "reader jwt is not configured") {
@@ -93,7 +93,7 @@ func ResolveReaderGithubIdentityFromCode(ctx context.Context, code, redirectURI
 	return identity, nil
 }
 
-func LoginReaderWithGithubIdentity(
+func (s *Service) LoginReaderWithGithubIdentity(
 	ctx context.Context,
 	identity *ReaderGithubIdentity,
 	rememberMe bool,
@@ -104,45 +104,45 @@ func LoginReaderWithGithubIdentity(
 	}
 
 	config := appconfig.ResolveReaderConfig()
-	if strings.TrimSpace(config.JWTSecret) == "" {
+	if !config.JWTConfigured() {
 		return nil, apperrors.Config("reader jwt is not configured", nil)
 	}
 
-	record, err := resolveReaderRecordForGithub(ctx, identity)
+	record, err := s.resolveReaderRecordForGithub(ctx, identity)
 	if err != nil {
 		return nil, err
 	}
 
-	return issueReaderTokens(ctx, config, record, "", rememberMe, metadata)
+	return s.issueReaderTokens(ctx, config, record, "", rememberMe, metadata)
 }
 
-func resolveReaderRecordForGithub(ctx context.Context, identity *ReaderGithubIdentity) (*domain.ReaderUserRecord, error) {
+func (s *Service) resolveReaderRecordForGithub(ctx context.Context, identity *ReaderGithubIdentity) (*domain.ReaderUserRecord, error) {
 	subject := strings.TrimSpace(identity.Subject)
 	email := strings.TrimSpace(strings.ToLower(identity.Email))
 	name := normalizeReaderDisplayName(identity.Name, identity.Email)
 	avatarURL := sanitizeReaderAvatarURL(identity.AvatarURL)
 	now := time.Now().UTC()
 
-	existingBySubject, err := readerUsersRepository.FindByGithubSubject(ctx, subject)
+	existingBySubject, err := s.readerUsers.FindByGithubSubject(ctx, subject)
 	if err != nil {
 		return nil, apperrors.Internal("failed to load reader account", err)
 	}
 	if existingBySubject != nil {
-		if err := readerUsersRepository.UpdateGithubIdentityByID(ctx, existingBySubject.ID, subject, email, name, avatarURL, now); err != nil {
+		if err := s.readerUsers.UpdateGithubIdentityByID(ctx, existingBySubject.ID, subject, email, resolveReaderLoginName(existingBySubject, name), avatarURL, now); err != nil {
 			return nil, apperrors.Internal("failed to update reader account", err)
 		}
-		return readerUsersRepository.FindByID(ctx, existingBySubject.ID)
+		return s.readerUsers.FindByID(ctx, existingBySubject.ID)
 	}
 
-	existingByEmail, err := readerUsersRepository.FindByEmail(ctx, email)
+	existingByEmail, err := s.readerUsers.FindByEmail(ctx, email)
 	if err != nil {
 		return nil, apperrors.Internal("failed to load reader account", err)
 	}
 	if existingByEmail != nil {
-		if err := readerUsersRepository.UpdateGithubIdentityByID(ctx, existingByEmail.ID, subject, email, name, avatarURL, now); err != nil {
+		if err := s.readerUsers.UpdateGithubIdentityByID(ctx, existingByEmail.ID, subject, email, resolveReaderLoginName(existingByEmail, name), avatarURL, now); err != nil {
 			return nil, apperrors.Internal("failed to update reader account", err)
 		}
-		return readerUsersRepository.FindByID(ctx, existingByEmail.ID)
+		return s.readerUsers.FindByID(ctx, existingByEmail.ID)
 	}
 
 	readerID, err := httpauth.GenerateOpaqueToken(18)
@@ -164,11 +164,11 @@ func resolveReaderRecordForGithub(ctx context.Context, identity *ReaderGithubIde
 		SessionVersion: 1,
 	}
 
-	if err := readerUsersRepository.Create(ctx, record); err != nil {
+	if err := s.readerUsers.Create(ctx, record); err != nil {
 		return nil, apperrors.Internal("failed to create reader account", err)
 	}
 
-	return readerUsersRepository.FindByID(ctx, readerID)
+	return s.readerUsers.FindByID(ctx, readerID)
 }
 
 func sanitizeReaderAvatarURL(value string) string {
@@ -118,7 +118,7 @@ func ResolveReaderGoogleIdentityFromCode(ctx context.Context, code, redirectURI
 	return identity, nil
 }
 
-func LoginReaderWithGoogleIdentity(
+func (s *Service) LoginReaderWithGoogleIdentity(
 	ctx context.Context,
 	identity *ReaderGoogleIdentity,
 	rememberMe bool,
@@ -129,45 +129,45 @@ func LoginReaderWithGoogleIdentity(
 	}
 
 	config := appconfig.ResolveReaderConfig()
-	if strings.TrimSpace(config.JWTSecret) == "" {
+	if !config.JWTConfigured() {
 		return nil, apperrors.Config("reader jwt is not configured", nil)
 	}
 
-	record, err := resolveReaderRecordForGoogle(ctx, identity)
+	record, err := s.resolveReaderRecordForGoogle(ctx, identity)
 	if err != nil {
 		return nil, err
 	}
 
-	return issueReaderTokens(ctx, config, record, "", rememberMe, metadata)
+	return s.issueReaderTokens(ctx, config, record, "", rememberMe, metadata)
 }
 
-func resolveReaderRecordForGoogle(ctx context.Context, identity *ReaderGoogleIdentity) (*domain.ReaderUserRecord, error) {
+func (s *Service) resolveReaderRecordForGoogle(ctx context.Context, identity *ReaderGoogleIdentity) (*domain.ReaderUserRecord, error) {
 	subject := strings.TrimSpace(identity.Subject)
 	email := strings.TrimSpace(strings.ToLower(identity.Email))
 	name := normalizeReaderDisplayName(identity.Name, identity.Email)
 	avatarURL := sanitizeReaderAvatarURL(identity.AvatarURL)
 	now := time.Now().UTC()
 
-	existingBySubject, err := readerUsersRepository.FindByGoogleSubject(ctx, subject)
+	existingBySubject, err := s.readerUsers.FindByGoogleSubject(ctx, subject)
 	if err != nil {
 		return nil, apperrors.Internal("failed to load reader account", err)
 	}
 	if existingBySubject != nil {
-		if err := readerUsersRepository.UpdateGoogleIdentityByID(ctx, existingBySubject.ID, subject, email, name, avatarURL, now); err != nil {
+		if err := s.readerUsers.UpdateGoogleIdentityByID(ctx, existingBySubject.ID, subject, email, resolveReaderLoginName(existingBySubject, name), avatarURL, now); err != nil {
 			return nil, apperrors.Internal("failed to update reader account", err)
 		}
-		return readerUsersRepository.FindByID(ctx, existingBySubject.ID)
+		return s.readerUsers.FindByID(ctx, existingBySubject.ID)
 	}
 
-	existingByEmail, err := readerUsersRepository.FindByEmail(ctx, email)
+	existingByEmail, err := s.readerUsers.FindByEmail(ctx, email)
 	if err != nil {
 		return nil, apperrors.Internal("failed to load reader account", err)
 	}
 	if existingByEmail != nil {
-		if err := readerUsersRepository.UpdateGoogleIdentityByID(ctx, existingByEmail.ID, subject, email, name, avatarURL, now); err != nil {
+		if err := s.readerUsers.UpdateGoogleIdentityByID(ctx, existingByEmail.ID, subject, email, resolveReaderLoginName(existingByEmail, name), avatarURL, now); err != nil {
 			return nil, apperrors.Internal("failed to update reader account", err)
 		}
-		return readerUsersRepository.FindByID(ctx, existingByEmail.ID)
+		return s.readerUsers.FindByID(ctx, existingByEmail.ID)
 	}
 
 	readerID, err := httpauth.GenerateOpaqueToken(18)
@@ -189,9 +189,9 @@ func resolveReaderRecordForGoogle(ctx context.Context, identity *ReaderGoogleIde
 		SessionVersion: 1,
 	}
 
-	if err := readerUsersRepository.Create(ctx, record); err != nil {
+	if err := s.readerUsers.Create(ctx, record); err != nil {
 		return nil, apperrors.Internal("failed to create reader account", err)
 	}
 
-	return readerUsersRepository.FindByID(ctx, readerID)
+	return s.readerUsers.FindByID(ctx, readerID)
 }
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/repository"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpauth"
+)
+
+const readerOIDCAlreadyLinkedMessage = "account is already linked to another reader"
+
+// LoginReaderWithOIDCIdentity signs a reader in with a generic provider. A new identity is attached to the reader with
+// the same verified email, or to a new reader account when there is none.
+func (s *Service) LoginReaderWithOIDCIdentity(
+	ctx context.Context,
+	identity *OIDCIdentity,
+	rememberMe bool,
+	metadata ReaderSessionMetadata,
+) (*ReaderAuthResponse, error) {
+	config := appconfig.ResolveReaderConfig()
+	if !config.JWTConfigured() {
+		return nil, apperrors.Config("reader jwt is not configured", nil)
+	}
+
+	normalized, err := normalizeOIDCIdentity(identity)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := s.resolveReaderRecordForOIDC(ctx, normalized)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issueReaderTokens(ctx, config, record, "", rememberMe, metadata)
+}
+
+// LinkReaderOIDCAccount attaches a provider account to a signed-in reader, whatever its email.
+func (s *Service) LinkReaderOIDCAccount(ctx context.Context, readerID string, identity *OIDCIdentity) error {
+	resolvedReaderID := strings.TrimSpace(readerID)
+	if resolvedReaderID == "" {
+		return apperrors.Unauthorized(readerAuthRequiredMessage)
+	}
+
+	normalized, err := normalizeOIDCIdentity(identity)
+	if err != nil {
+		return err
+	}
+
+	existing, err := s.oidcIdentities.FindBySubject(ctx, domain.OIDCAccountTypeReader, normalized.Provider, normalized.Subject)
+	if err != nil {
+		return apperrors.Internal("failed to load linked account", err)
+	}
+	if existing != nil && existing.UserID != resolvedReaderID {
+		return apperrors.BadRequest(readerOIDCAlreadyLinkedMessage)
+	}
+
+	return s.linkReaderOIDCIdentity(ctx, resolvedReaderID, normalized)
+}
+
+func (s *Service) UnlinkReaderOIDCAccount(ctx context.Context, reader *domain.ReaderUser, providerID string) (bool, error) {
+	if reader == nil || strings.TrimSpace(reader.ID) == "" {
+		return false, apperrors.Unauthorized(readerAuthRequiredMessage)
+	}
+
+	unlinked, err := s.oidcIdentities.Unlink(
+		ctx,
+		domain.OIDCAccountTypeReader,
+		reader.ID,
+		strings.ToLower(strings.TrimSpace(providerID)),
+	)
+	if err != nil {
+		return false, apperrors.Internal("failed to disconnect account", err)
+	}
+
+	return unlinked, nil
+}
+
+// ListReaderOIDCProviders returns the ids of the generic providers linked to a reader.
+func (s *Service) ListReaderOIDCProviders(ctx context.Context, readerID string) ([]string, error) {
+	records, err := s.oidcIdentities.ListByUserID(ctx, domain.OIDCAccountTypeReader, strings.TrimSpace(readerID))
+	if err != nil {
+		return nil, apperrors.Internal("failed to load linked accounts", err)
+	}
+
+	providers := make([]string, 0, len(records))
+	for _, record := range records {
+		providers = append(providers, record.Provider)
+	}
+
+	return providers, nil
+}
+
+func (s *Service) resolveReaderRecordForOIDC(ctx context.Context, identity *OIDCIdentity) (*domain.ReaderUserRecord, error) {
+	link, err := s.oidcIdentities.FindBySubject(ctx, domain.OIDCAccountTypeReader, identity.Provider, identity.Subject)
+	if err != nil {
+		return nil, apperrors.Internal("failed to load linked account", err)
+	}
+	if link != nil {
+		return s.loadReaderRecordForOIDC(ctx, link.UserID, identity.Provider)
+	}
+
+	// Matching by email is only safe when the provider vouches for the address.
+	if identity.Email == "" || !identity.EmailVerified {
+		return nil, apperrors.BadRequest("sign-in provider account email is not verified")
+	}
+
+	existingByEmail, err := s.readerUsers.FindByEmail(ctx, identity.Email)
+	if err != nil {
+		return nil, apperrors.Internal("failed to load reader account", err)
+	}
+	if existingByEmail != nil {
+		if err := s.linkReaderOIDCIdentity(ctx, existingByEmail.ID, identity); err != nil {
+			return nil, err
+		}
+		return s.loadReaderRecordForOIDC(ctx, existingByEmail.ID, identity.Provider)
+	}
+
+	readerID, err := httpauth.GenerateOpaqueToken(18)
+	if err != nil {
+		return nil, apperrors.Internal("failed to create reader account", err)
+	}
+
+	record := domain.ReaderUserRecord{
+		ReaderUser: domain.ReaderUser{
+			ID:                readerID,
+			Name:              normalizeReaderDisplayName(identity.Name, identity.Email),
+			Email:             identity.Email,
+			AvatarURL:         sanitizeReaderAvatarURL(identity.AvatarURL),
+			LastLoginProvider: identity.Provider,
+		},
+		SessionVersion: 1,
+	}
+	if err := s.readerUsers.Create(ctx, record); err != nil {
+		return nil, apperrors.Internal("failed to create reader account", err)
+	}
+	if err := s.linkReaderOIDCIdentity(ctx, readerID, identity); err != nil {
+		return nil, err
+	}
+
+	return s.readerUsers.FindByID(ctx, readerID)
+}
+
+func (s *Service) loadReaderRecordForOIDC(ctx context.Context, readerID, provider string) (*domain.ReaderUserRecord, error) {
+	if err := s.readerUsers.UpdateLastSeenProviderByID(ctx, readerID, provider); err != nil {
+		if errors.Is(err, repository.ErrReaderUserNotFound) {
+			return nil, apperrors.Unauthorized(readerAuthRequiredMessage)
+		}
+		return nil, apperrors.Internal("failed to update reader account", err)
+	}
+
+	record, err := s.readerUsers.FindByID(ctx, readerID)
+	if err != nil {
+		return nil, apperrors.Internal("failed to load reader account", err)
+	}
+	if record == nil {
+		return nil, apperrors.Unauthorized(readerAuthRequiredMessage)
+	}
+
+	return record, nil
+}
+
+func (s *Service) linkReaderOIDCIdentity(ctx context.Context, readerID string, identity *OIDCIdentity) error {
+	err := s.oidcIdentities.Link(ctx, domain.OIDCIdentityRecord{
+		AccountType: domain.OIDCAccountTypeReader,
+		UserID:      readerID,
+		Provider:    identity.Provider,
+		Subject:     identity.Subject,
+		Email:       identity.Email,
+		LinkedAt:    nowUTCFn(),
+	})
+	if errors.Is(err, repository.ErrOIDCIdentityAlreadyLinked) {
+		return apperrors.BadRequest(readerOIDCAlreadyLinkedMessage)
+	}
+	if err != nil {
+		return apperrors.Internal("failed to link account", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+	adminmailpkg "suaybsimsek.com/blog-api/pkg/adminmail"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpapi"
+	newsletterpkg "suaybsimsek.com/blog-api/pkg/newsletter"
+)
+
+const (
+	// refreshTokenReuseGracePeriod tolerates a rotated token arriving again shortly after rotation, as happens when two
+	// tabs refresh at the same time. Later replays are treated as theft.
+	refreshTokenReuseGracePeriod = 30 * time.Second
+
+	adminSessionAuditResource       = "admin_session"
+	readerSessionAuditResource      = "reader_session"
+	refreshTokenReusedAuditAction   = "refresh_token_reused"
+	refreshTokenReusedAuditStatus   = "revoked"
+	refreshTokenReusedAuditFailCode = "REFRESH_TOKEN_REUSED"
+)
+
+var (
+	sendAdminSessionReuseNoticeEmailFn  = sendAdminSessionReuseNoticeEmail
+	sendReaderSessionReuseNoticeEmailFn = sendReaderSessionReuseNoticeEmail
+)
+
+// handleAdminRefreshTokenReuse is called when a refresh token is not active. If it was rotated before, the token has
+// been replayed: every token issued from it is revoked, the event is audited and the admin is emailed. The caller still
+// answers with an invalid session either way.
+func (s *Service) handleAdminRefreshTokenReuse(ctx context.Context, jti, token string) error {
+	record, err := s.adminRefreshTokens.FindByToken(ctx, jti, token)
+	if err != nil {
+		return toAdminSessionError(err)
+	}
+
+	now := time.Now().UTC()
+	if record == nil || !isReplayedRefreshToken(record.RotatedAt, record.RevokedAt, now) {
+		return nil
+	}
+
+	revoked, err := s.adminRefreshTokens.RevokeFamily(ctx, record.JTI, now)
+	if err != nil {
+		return toAdminSessionError(err)
+	}
+	// Another request already handled this replay.
+	if revoked == 0 {
+		return nil
+	}
+
+	userRecord, err := s.adminUsers.FindByID(ctx, strings.TrimSpace(record.UserID))
+	if err != nil {
+		return apperrors.Internal(adminLoadAdminUserMessage, err)
+	}
+
+	actorEmail := ""
+	if userRecord != nil {
+		actorEmail = userRecord.Email
+	}
+	if err := s.recordRefreshTokenReuseAudit(ctx, adminSessionAuditResource, record.UserID, actorEmail, record.JTI, revoked); err != nil {
+		return err
+	}
+	if userRecord != nil {
+		notifyAdminSecurityEvent(ctx, userRecord, "session reuse", sendAdminSessionReuseNoticeEmailFn)
+	}
+
+	return nil
+}
+
+// handleReaderRefreshTokenReuse applies the same replay detection and notice to reader sessions.
+func (s *Service) handleReaderRefreshTokenReuse(ctx context.Context, jti, token string) error {
+	record, err := s.readerRefreshTokens.FindByToken(ctx, jti, token)
+	if err != nil {
+		return toReaderSessionError(err)
+	}
+
+	now := time.Now().UTC()
+	if record == nil || !isReplayedRefreshToken(record.RotatedAt, record.RevokedAt, now) {
+		return nil
+	}
+
+	revoked, err := s.readerRefreshTokens.RevokeFamily(ctx, record.JTI, now)
+	if err != nil {
+		return toReaderSessionError(err)
+	}
+	if revoked == 0 {
+		return nil
+	}
+
+	userRecord, err := s.readerUsers.FindByID(ctx, strings.TrimSpace(record.UserID))
+	if err != nil {
+		return apperrors.Internal("failed to load reader user", err)
+	}
+
+	actorEmail := ""
+	if userRecord != nil {
+		actorEmail = userRecord.Email
+	}
+	if err := s.recordRefreshTokenReuseAudit(ctx, readerSessionAuditResource, record.UserID, actorEmail, record.JTI, revoked); err != nil {
+		return err
+	}
+	// Readers signing in with a provider that shares no email cannot be told.
+	if userRecord != nil && strings.TrimSpace(userRecord.Email) != "" {
+		notifyReaderSessionReuse(ctx, userRecord)
+	}
+
+	return nil
+}
+
+// notifyReaderSessionReuse emails a reader whose session family was revoked. Like the admin notice, failures are only
+// logged.
+func notifyReaderSessionReuse(ctx context.Context, userRecord *domain.ReaderUserRecord) {
+	siteURL, err := resolveSiteURLFn()
+	if err != nil {
+		httpapi.LogError(ctx, "reader session reuse notice site url is not configured", err)
+		return
+	}
+	mailCfg, err := resolveMailConfigFn()
+	if err != nil {
+		httpapi.LogError(ctx, "reader session reuse notice mail transport is not configured", err)
+		return
+	}
+
+	if err := sendReaderSessionReuseNoticeEmailFn(mailCfg, userRecord.Email, resolveAdminErrorLocale(ctx), siteURL); err != nil {
+		httpapi.LogError(ctx, "reader session reuse notice email failed", err, slog.String("userId", userRecord.ID))
+	}
+}
+
+func sendReaderSessionReuseNoticeEmail(cfg appconfig.MailConfig, recipientEmail, locale, siteURL string) error {
+	subject, htmlBody, err := adminmailpkg.ReaderSessionReuseNoticeEmail(locale, siteURL)
+	if err != nil {
+		return fmt.Errorf("build reader session reuse notice email failed: %w", err)
+	}
+
+	return newsletterpkg.SendHTMLEmail(cfg, recipientEmail, subject, htmlBody, nil)
+}
+
+func isReplayedRefreshToken(rotatedAt, revokedAt *time.Time, now time.Time) bool {
+	if rotatedAt == nil || revokedAt != nil {
+		return false
+	}
+
+	return !now.Before(rotatedAt.Add(refreshTokenReuseGracePeriod))
+}
+
+func (s *Service) recordRefreshTokenReuseAudit(
+	ctx context.Context,
+	resource string,
+	userID string,
+	email string,
+	jti string,
+	revoked int,
+) error {
+	trace, _ := httpapi.RequestTraceFromContext(ctx)
+	record := domain.AdminAuditLogRecord{
+		ActorID:     strings.TrimSpace(userID),
+		ActorEmail:  strings.TrimSpace(strings.ToLower(email)),
+		Action:      refreshTokenReusedAuditAction,
+		Resource:    resource,
+		BeforeValue: strings.TrimSpace(jti),
+		AfterValue:  strconv.Itoa(revoked),
+		Status:      refreshTokenReusedAuditStatus,
+		FailureCode: refreshTokenReusedAuditFailCode,
+		RequestID:   httpapi.RequestIDFromContext(ctx),
+		RemoteIP:    strings.TrimSpace(trace.RemoteIP),
+		CountryCode: strings.TrimSpace(strings.ToUpper(trace.CountryCode)),
+		UserAgent:   strings.TrimSpace(trace.UserAgent),
+		CreatedAt:   time.Now().UTC(),
+	}
+
+	if err := s.adminAuditLogs.Create(ctx, record); err != nil {
+		return apperrors.Internal("failed to persist admin audit log", err)
+	}
+	return nil
+}
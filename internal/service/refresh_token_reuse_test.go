@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpauth"
+)
+
+func issueTestRefreshToken(t *testing.T, secret, jti, subject string) string {
+	t.Helper()
+
+	token, err := httpauth.IssueHS256JWT(httpauth.JWTClaims{
+		ID:              jti,
+		Subject:         subject,
+		Type:            "refresh",
+		PasswordVersion: 2,
+		IssuedAt:        time.Now().Add(-time.Hour).Unix(),
+		ExpiresAt:       time.Now().Add(time.Hour).Unix(),
+	}, secret)
+	if err != nil {
+		t.Fatalf("IssueHS256JWT returned error: %v", err)
+	}
+	return token
+}
+
+func TestRefreshAdminSessionRevokesFamilyOnReuse(t *testing.T) {
+	t.Setenv("JWT_SECRET", "admin-secret")
+
+	previousUsersRepo := adminUsersRepository
+	previousRefreshRepo := adminRefreshTokensRepository
+	previousResolveSiteURLFn := resolveSiteURLFn
+	previousResolveMailConfigFn := resolveMailConfigFn
+	previousSendNotice := sendAdminSessionReuseNoticeEmailFn
+	previousAuditRepo := adminAuditLogRepo
+	t.Cleanup(func() {
+		adminUsersRepository = previousUsersRepo
+		adminRefreshTokensRepository = previousRefreshRepo
+		resolveSiteURLFn = previousResolveSiteURLFn
+		resolveMailConfigFn = previousResolveMailConfigFn
+		sendAdminSessionReuseNoticeEmailFn = previousSendNotice
+		adminAuditLogRepo = previousAuditRepo
+	})
+	audit := &adminErrorMessageManagementAuditStub{}
+	adminAuditLogRepo = audit
+	resolveMailConfigFn = func() (appconfig.MailConfig, error) { return appconfig.MailConfig{}, nil }
+
+	adminUsersRepository = &adminAuthSessionStubUserRepository{
+		adminAuthEmailChangeStubUserRepository: newAdminAuthEmailChangeStubUserRepository(&domain.AdminUserRecord{
+			AdminUser:       domain.AdminUser{ID: "admin-1", Email: "admin@example.com"},
+			PasswordVersion: 2,
+		}),
+	}
+	resolveSiteURLFn = func() (string, error) { return "https://blog.example.com", nil }
+	var notified []string
+	sendAdminSessionReuseNoticeEmailFn = func(_ appconfig.MailConfig, recipient, _, _ string) error {
+		notified = append(notified, recipient)
+		return nil
+	}
+
+	rotatedAt := time.Now().UTC().Add(-time.Hour)
+	revokedFamilies := []string{}
+	familyRevoked := false
+	adminRefreshTokensRepository = adminAuthSessionStubRefreshRepository{
+		findByToken: func(_ context.Context, jti, _ string) (*domain.AdminRefreshTokenRecord, error) {
+			switch jti {
+			case "rotated-jti":
+				record := &domain.AdminRefreshTokenRecord{JTI: jti, UserID: "admin-1", RotatedAt: &rotatedAt, ReplacedBy: "next-jti"}
+				if familyRevoked {
+					record.RevokedAt = &rotatedAt
+				}
+				return record, nil
+			case "fresh-jti":
+				recent := time.Now().UTC()
+				return &domain.AdminRefreshTokenRecord{JTI: jti, UserID: "admin-1", RotatedAt: &recent}, nil
+			}
+			return nil, nil
+		},
+		revokeFamily: func(_ context.Context, jti string, _ time.Time) (int, error) {
+			revokedFamilies = append(revokedFamilies, jti)
+			familyRevoked = true
+			return 2, nil
+		},
+	}
+
+	replayed := issueTestRefreshToken(t, "admin-secret", "rotated-jti", "admin-1")
+	if _, err := testService().RefreshAdminSession(context.Background(), replayed, AdminSessionMetadata{}); apperrors.From(err).Code != "UNAUTHORIZED" {
+		t.Fatalf("expected invalid session, got %v", err)
+	}
+	if len(revokedFamilies) != 1 || revokedFamilies[0] != "rotated-jti" {
+		t.Fatalf("unexpected revoked families %v", revokedFamilies)
+	}
+	if len(notified) != 1 || notified[0] != "admin@example.com" {
+		t.Fatalf("expected one reuse notice, got %v", notified)
+	}
+	if len(audit.records) != 1 {
+		t.Fatalf("expected one audit record, got %#v", audit.records)
+	}
+	record := audit.records[0]
+	if record.Action != refreshTokenReusedAuditAction || record.Resource != adminSessionAuditResource ||
+		record.ActorID != "admin-1" || record.BeforeValue != "rotated-jti" || record.AfterValue != "2" {
+		t.Fatalf("unexpected audit record %#v", record)
+	}
+
+	// Replaying the same token again does not repeat the alert once the family is revoked.
+	if _, err := testService().RefreshAdminSession(context.Background(), replayed, AdminSessionMetadata{}); apperrors.From(err).Code != "UNAUTHORIZED" {
+		t.Fatalf("expected invalid session, got %v", err)
+	}
+	// A token rotated moments ago is a concurrent refresh, not a replay.
+	fresh := issueTestRefreshToken(t, "admin-secret", "fresh-jti", "admin-1")
+	if _, err := testService().RefreshAdminSession(context.Background(), fresh, AdminSessionMetadata{}); apperrors.From(err).Code != "UNAUTHORIZED" {
+		t.Fatalf("expected invalid session, got %v", err)
+	}
+	if len(revokedFamilies) != 1 || len(notified) != 1 || len(audit.records) != 1 {
+		t.Fatalf("expected no further action, got %v %v %d", revokedFamilies, notified, len(audit.records))
+	}
+}
+
+func TestRefreshReaderSessionRevokesFamilyOnReuse(t *testing.T) {
+	t.Setenv("JWT_SECRET", "reader-secret")
+
+	previousUsersRepo := readerUsersRepository
+	previousRefreshRepo := readerRefreshTokensRepository
+	previousResolveSiteURLFn := resolveSiteURLFn
+	previousResolveMailConfigFn := resolveMailConfigFn
+	previousSendNotice := sendReaderSessionReuseNoticeEmailFn
+	previousAuditRepo := adminAuditLogRepo
+	t.Cleanup(func() {
+		readerUsersRepository = previousUsersRepo
+		readerRefreshTokensRepository = previousRefreshRepo
+		resolveSiteURLFn = previousResolveSiteURLFn
+		resolveMailConfigFn = previousResolveMailConfigFn
+		sendReaderSessionReuseNoticeEmailFn = previousSendNotice
+		adminAuditLogRepo = previousAuditRepo
+	})
+	audit := &adminErrorMessageManagementAuditStub{}
+	adminAuditLogRepo = audit
+	resolveSiteURLFn = func() (string, error) { return "https://blog.example.com", nil }
+	resolveMailConfigFn = func() (appconfig.MailConfig, error) { return appconfig.MailConfig{}, nil }
+
+	readerUsersRepository = stubReaderUserRepository{
+		findByID: func(_ context.Context, id string) (*domain.ReaderUserRecord, error) {
+			if id != "reader-1" {
+				return nil, nil
+			}
+			return &domain.ReaderUserRecord{ReaderUser: domain.ReaderUser{ID: id, Email: "reader@example.com"}}, nil
+		},
+	}
+	var notified []string
+	sendReaderSessionReuseNoticeEmailFn = func(_ appconfig.MailConfig, recipient, _, _ string) error {
+		notified = append(notified, recipient)
+		return nil
+	}
+
+	rotatedAt := time.Now().UTC().Add(-time.Hour)
+	var revokedFamily string
+	readerRefreshTokensRepository = stubReaderRefreshTokenRepository{
+		findByToken: func(_ context.Context, jti, _ string) (*domain.ReaderRefreshTokenRecord, error) {
+			return &domain.ReaderRefreshTokenRecord{JTI: jti, UserID: "reader-1", RotatedAt: &rotatedAt}, nil
+		},
+		revokeFamily: func(_ context.Context, jti string, _ time.Time) (int, error) {
+			revokedFamily = jti
+			return 3, nil
+		},
+	}
+
+	replayed := issueTestRefreshToken(t, "reader-secret", "rotated-jti", "reader-1")
+	if _, err := testService().RefreshReaderSession(context.Background(), replayed, ReaderSessionMetadata{}); apperrors.From(err).Code != "UNAUTHORIZED" {
+		t.Fatalf("expected invalid session, got %v", err)
+	}
+	if revokedFamily != "rotated-jti" {
+		t.Fatalf("unexpected revoked family %q", revokedFamily)
+	}
+	if len(notified) != 1 || notified[0] != "reader@example.com" {
+		t.Fatalf("expected one reuse notice, got %v", notified)
+	}
+	if len(audit.records) != 1 || audit.records[0].Resource != readerSessionAuditResource ||
+		audit.records[0].ActorID != "reader-1" || audit.records[0].ActorEmail != "reader@example.com" {
+		t.Fatalf("unexpected audit records %#v", audit.records)
+	}
+}
+
+func TestIsReplayedRefreshToken(t *testing.T) {
+	now := time.Date(2026, time.March, 20, 10, 0, 0, 0, time.UTC)
+	old := now.Add(-time.Hour)
+	recent := now.Add(-time.Second)
+
+	if isReplayedRefreshToken(nil, nil, now) {
+		t.Fatal("active token must not be a replay")
+	}
+	if isReplayedRefreshToken(&old, &old, now) {
+		t.Fatal("revoked token must not be a replay")
+	}
+	if isReplayedRefreshToken(&recent, nil, now) {
+		t.Fatal("token inside the grace period must not be a replay")
+	}
+	if !isReplayedRefreshToken(&old, nil, now) {
+		t.Fatal("expected rotated token to be a replay")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	htmltemplate "html/template"
 	"strings"
 	"sync"
+	"time"
 
 	"suaybsimsek.com/blog-api/pkg/newsletter"
 )
@@ -24,6 +25,12 @@ type emailTemplateData struct {
 	ButtonLabel  string
 	FallbackLead string
 	ActionURL    string
+	Details      []emailDetail
+}
+
+type emailDetail struct {
+	Label string
+	Value string
 }
 
 type noticeTemplateData struct {
@@ -56,6 +63,23 @@ type emailCopy struct {
 	FallbackLead string
 }
 
+type loginAlertCopy struct {
+	emailCopy
+	DeviceLabel    string
+	CountryLabel   string
+	IPAddressLabel string
+	TimeLabel      string
+	Unknown        string
+}
+
+// LoginAlertDetails describes the sign-in an alert email reports.
+type LoginAlertDetails struct {
+	Device      string
+	CountryCode string
+	IPAddress   string
+	Time        time.Time
+}
+
 type noticeCopy struct {
 	Subject      string
 	EyebrowLabel string
@@ -133,6 +157,97 @@ var passwordResetByLocale = map[string]emailCopy{
 	},
 }
 
+var invitationByLocale = map[string]emailCopy{
+	"en": {
+		Subject:      "You have been invited to the admin panel",
+		EyebrowLabel: "Admin access",
+		Title:        "Suayb's Blog",
+		Heading:      "Join the admin panel",
+		Body:         "You have been invited to help manage this blog. Use the button below to set your password or sign in with Google or GitHub. If you were not expecting this invitation, you can ignore this email.",
+		ButtonLabel:  "Accept invitation",
+		FallbackLead: "If the button does not work, copy and paste this link into your browser:",
+	},
+	"tr": {
+		Subject:      "Yonetim paneline davet edildiniz",
+		EyebrowLabel: "Yonetici erisimi",
+		Title:        "Suayb's Blog",
+		Heading:      "Yonetim paneline katilin",
+		Body:         "Bu blogun yonetimine katilmaniz icin davet edildiniz. Parolanizi belirlemek veya Google ya da GitHub ile giris yapmak icin asagidaki butonu kullanin. Bu daveti beklemiyorsaniz bu e-postayi yok sayabilirsiniz.",
+		ButtonLabel:  "Daveti kabul et",
+		FallbackLead: "Buton calismazsa bu baglantiyi tarayiciniza yapistirin:",
+	},
+}
+
+var adminLoginAlertByLocale = map[string]loginAlertCopy{
+	"en": {
+		emailCopy: emailCopy{
+			Subject:      "New sign-in to your admin account",
+			EyebrowLabel: "Admin security",
+			Title:        "Suayb's Blog",
+			Heading:      "Your admin account was signed in from somewhere new",
+			Body:         "Your admin account was just signed in from a device or country it has not used before. If this was you, there is nothing to do. If it was not, the button below signs out every session, clears your password and sends you a link to choose a new one.",
+			ButtonLabel:  "This wasn't me",
+			FallbackLead: "If the button does not work, copy and paste this link into your browser:",
+		},
+		DeviceLabel:    "Device",
+		CountryLabel:   "Country",
+		IPAddressLabel: "IP address",
+		TimeLabel:      "Time",
+		Unknown:        "Unknown",
+	},
+	"tr": {
+		emailCopy: emailCopy{
+			Subject:      "Yonetici hesabinizda yeni giris",
+			EyebrowLabel: "Yonetici guvenligi",
+			Title:        "Suayb's Blog",
+			Heading:      "Yonetici hesabiniza yeni bir yerden giris yapildi",
+			Body:         "Yonetici hesabiniza daha once kullanilmamis bir cihazdan veya ulkeden giris yapildi. Bu siz idiyseniz bir sey yapmaniza gerek yok. Degilse asagidaki buton tum oturumlari kapatir, parolanizi siler ve yenisini belirlemeniz icin bir baglanti gonderir.",
+			ButtonLabel:  "Bu ben degildim",
+			FallbackLead: "Buton calismazsa bu baglantiyi tarayiciniza yapistirin:",
+		},
+		DeviceLabel:    "Cihaz",
+		CountryLabel:   "Ulke",
+		IPAddressLabel: "IP adresi",
+		TimeLabel:      "Zaman",
+		Unknown:        "Bilinmiyor",
+	},
+}
+
+var readerLoginAlertByLocale = map[string]loginAlertCopy{
+	"en": {
+		emailCopy: emailCopy{
+			Subject:      "New sign-in to your account",
+			EyebrowLabel: "Account security",
+			Title:        "Suayb's Blog",
+			Heading:      "Your account was signed in from somewhere new",
+			Body:         "Your reader account was just signed in from a device or country it has not used before. If this was you, there is nothing to do. If it was not, the button below signs out every session. Then review the security of the account you sign in with.",
+			ButtonLabel:  "This wasn't me",
+			FallbackLead: "If the button does not work, copy and paste this link into your browser:",
+		},
+		DeviceLabel:    "Device",
+		CountryLabel:   "Country",
+		IPAddressLabel: "IP address",
+		TimeLabel:      "Time",
+		Unknown:        "Unknown",
+	},
+	"tr": {
+		emailCopy: emailCopy{
+			Subject:      "Hesabinizda yeni giris",
+			EyebrowLabel: "Hesap guvenligi",
+			Title:        "Suayb's Blog",
+			Heading:      "Hesabiniza yeni bir yerden giris yapildi",
+			Body:         "Okuyucu hesabiniza daha once kullanilmamis bir cihazdan veya ulkeden giris yapildi. Bu siz idiyseniz bir sey yapmaniza gerek yok. Degilse asagidaki buton tum oturumlari kapatir. Ardindan giris yaptiginiz hesabin guvenligini kontrol edin.",
+			ButtonLabel:  "Bu ben degildim",
+			FallbackLead: "Buton calismazsa bu baglantiyi tarayiciniza yapistirin:",
+		},
+		DeviceLabel:    "Cihaz",
+		CountryLabel:   "Ulke",
+		IPAddressLabel: "IP adresi",
+		TimeLabel:      "Zaman",
+		Unknown:        "Bilinmiyor",
+	},
+}
+
 var noticeByLocale = map[string]noticeCopy{
 	"en": {
 		Subject:      "Admin email change requested",
@@ -152,6 +267,63 @@ var noticeByLocale = map[string]noticeCopy{
 	},
 }
 
+var lockoutNoticeByLocale = map[string]noticeCopy{
+	"en": {
+		Subject:      "Admin sign-in temporarily locked",
+		EyebrowLabel: "Admin security",
+		Title:        "Suayb's Blog",
+		Heading:      "Your admin sign-in was temporarily locked",
+		Body:         "Too many failed sign-in attempts were made for your admin account, so password sign-in is paused for a while. It unlocks automatically, or an owner can unlock it sooner.",
+		FooterNote:   "If these attempts were not yours, change your password and enable two-factor authentication.",
+	},
+	"tr": {
+		Subject:      "Yonetici girisi gecici olarak kilitlendi",
+		EyebrowLabel: "Yonetici guvenligi",
+		Title:        "Suayb's Blog",
+		Heading:      "Yonetici girisiniz gecici olarak kilitlendi",
+		Body:         "Yonetici hesabiniz icin cok fazla basarisiz giris denemesi yapildi, bu yuzden sifre ile giris bir sure durduruldu. Kilit kendiliginden kalkar veya bir sahip daha once kaldirabilir.",
+		FooterNote:   "Bu denemeler size ait degilse sifrenizi degistirin ve iki adimli dogrulamayi etkinlestirin.",
+	},
+}
+
+var sessionReuseNoticeByLocale = map[string]noticeCopy{
+	"en": {
+		Subject:      "Admin session signed out for your safety",
+		EyebrowLabel: "Admin security",
+		Title:        "Suayb's Blog",
+		Heading:      "An old session token was used again",
+		Body:         "A refresh token from one of your admin sessions was presented after it had already been replaced, which can mean it was copied from your browser. That session has been signed out on every device that continued it.",
+		FooterNote:   "If you did not expect this, change your password and review your active sessions.",
+	},
+	"tr": {
+		Subject:      "Yonetici oturumu guvenliginiz icin kapatildi",
+		EyebrowLabel: "Yonetici guvenligi",
+		Title:        "Suayb's Blog",
+		Heading:      "Eski bir oturum anahtari tekrar kullanildi",
+		Body:         "Yonetici oturumlarinizdan birine ait yenileme anahtari, yenisiyle degistirildikten sonra tekrar kullanildi. Bu, anahtarin tarayicinizdan kopyalandigi anlamina gelebilir. Bu oturum, onu devam ettiren tum cihazlarda kapatildi.",
+		FooterNote:   "Bunu beklemiyorsaniz sifrenizi degistirin ve aktif oturumlarinizi kontrol edin.",
+	},
+}
+
+var readerSessionReuseNoticeByLocale = map[string]noticeCopy{
+	"en": {
+		Subject:      "Signed out for your safety",
+		EyebrowLabel: "Account security",
+		Title:        "Suayb's Blog",
+		Heading:      "An old session token was used again",
+		Body:         "A refresh token from one of your reader sessions was presented after it had already been replaced, which can mean it was copied from your browser. That session has been signed out on every device that continued it.",
+		FooterNote:   "If you did not expect this, review the security of the account you sign in with and your active sessions.",
+	},
+	"tr": {
+		Subject:      "Guvenliginiz icin oturumunuz kapatildi",
+		EyebrowLabel: "Hesap guvenligi",
+		Title:        "Suayb's Blog",
+		Heading:      "Eski bir oturum anahtari tekrar kullanildi",
+		Body:         "Okuyucu oturumlarinizdan birine ait yenileme anahtari, yenisiyle degistirildikten sonra tekrar kullanildi. Bu, anahtarin tarayicinizdan kopyalandigi anlamina gelebilir. Bu oturum, onu devam ettiren tum cihazlarda kapatildi.",
+		FooterNote:   "Bunu beklemiyorsaniz giris yaptiginiz hesabin guvenligini ve aktif oturumlarinizi kontrol edin.",
+	},
+}
+
 var statusByLocale = map[string]map[StatusKey]statusCopy{
 	"en": {
 		StatusSuccess: {
@@ -231,6 +403,79 @@ var statusByLocale = map[string]map[StatusKey]statusCopy{
 	},
 }
 
+var loginAlertStatusByLocale = map[string]map[StatusKey]statusCopy{
+	"en": {
+		StatusSuccess: {
+			Title:       "Account secured",
+			Heading:     "Every session has been signed out",
+			Message:     "Sign in again to continue.",
+			ButtonLabel: "Go to home",
+		},
+		StatusInvalidLink: {
+			Title:       "Invalid link",
+			Heading:     "This link is invalid or has already been used",
+			Message:     "If you still do not recognise a sign-in, sign in and review your account.",
+			ButtonLabel: "Go to home",
+		},
+		StatusFailed: {
+			Title:       "Request failed",
+			Heading:     "Your account could not be secured",
+			Message:     "Please try the link again.",
+			ButtonLabel: "Go to home",
+		},
+		StatusServiceUnavailable: {
+			Title:       "Service unavailable",
+			Heading:     "Your account could not be secured right now",
+			Message:     "Please try the link again in a few minutes.",
+			ButtonLabel: "Go to home",
+		},
+		StatusConfigError: {
+			Title:       "Configuration error",
+			Heading:     "This service is not configured correctly",
+			Message:     "Please contact the administrator.",
+			ButtonLabel: "Go to home",
+		},
+	},
+	"tr": {
+		StatusSuccess: {
+			Title:       "Hesap guvenceye alindi",
+			Heading:     "Tum oturumlar kapatildi",
+			Message:     "Devam etmek icin yeniden giris yapin.",
+			ButtonLabel: "Ana sayfaya git",
+		},
+		StatusInvalidLink: {
+			Title:       "Gecersiz baglanti",
+			Heading:     "Bu baglanti gecersiz veya daha once kullanilmis",
+			Message:     "Tanimadiginiz bir giris varsa giris yapip hesabinizi kontrol edin.",
+			ButtonLabel: "Ana sayfaya git",
+		},
+		StatusFailed: {
+			Title:       "Istek basarisiz",
+			Heading:     "Hesabiniz guvenceye alinamadi",
+			Message:     "Lutfen baglantiyi tekrar deneyin.",
+			ButtonLabel: "Ana sayfaya git",
+		},
+		StatusServiceUnavailable: {
+			Title:       "Servis kullanilamiyor",
+			Heading:     "Hesabiniz su anda guvenceye alinamadi",
+			Message:     "Lutfen birkac dakika sonra baglantiyi tekrar deneyin.",
+			ButtonLabel: "Ana sayfaya git",
+		},
+		StatusConfigError: {
+			Title:       "Yapilandirma hatasi",
+			Heading:     "Bu servis dogru sekilde yapilandirilmamis",
+			Message:     "Lutfen yonetici ile iletisime gecin.",
+			ButtonLabel: "Ana sayfaya git",
+		},
+	},
+}
+
+// adminLoginAlertSuccessMessage replaces the success message for admins, whose password is cleared as well.
+var adminLoginAlertSuccessMessage = map[string]string{
+	"en": "Your password was cleared too. Check your inbox for a link to choose a new one.",
+	"tr": "Parolaniz da silindi. Yenisini belirlemek icin gelen kutunuzdaki baglantiyi kullanin.",
+}
+
 func ConfirmationEmail(locale, confirmURL, siteURL string) (string, string, error) {
 	if err := ensureTemplates(); err != nil {
 		return "", "", err
@@ -285,13 +530,79 @@ func PasswordResetEmail(locale, resetURL, siteURL string) (string, string, error
 	return content.Subject, htmlBody, nil
 }
 
+func InvitationEmail(locale, invitationURL, siteURL string) (string, string, error) {
+	if err := ensureTemplates(); err != nil {
+		return "", "", err
+	}
+
+	resolved := resolveLocale(locale)
+	content := invitationByLocale[resolved]
+	data := emailTemplateData{
+		Lang:         resolved,
+		FaviconURL:   newsletter.BuildFaviconURL(siteURL),
+		EyebrowLabel: content.EyebrowLabel,
+		Title:        content.Title,
+		Heading:      content.Heading,
+		Body:         content.Body,
+		ButtonLabel:  content.ButtonLabel,
+		FallbackLead: content.FallbackLead,
+		ActionURL:    strings.TrimSpace(invitationURL),
+	}
+
+	htmlBody, err := renderTemplate(confirmationTemplate, data)
+	if err != nil {
+		return "", "", fmt.Errorf("render admin invitation email template: %w", err)
+	}
+
+	return content.Subject, htmlBody, nil
+}
+
 func ChangeRequestedNoticeEmail(locale, siteURL string) (string, string, error) {
+	subject, htmlBody, err := renderNoticeEmail(noticeByLocale, locale, siteURL)
+	if err != nil {
+		return "", "", fmt.Errorf("render admin email change notice template: %w", err)
+	}
+
+	return subject, htmlBody, nil
+}
+
+// AccountLockedNoticeEmail tells an admin that password sign-in was locked after repeated failed attempts.
+func AccountLockedNoticeEmail(locale, siteURL string) (string, string, error) {
+	subject, htmlBody, err := renderNoticeEmail(lockoutNoticeByLocale, locale, siteURL)
+	if err != nil {
+		return "", "", fmt.Errorf("render admin lockout notice template: %w", err)
+	}
+
+	return subject, htmlBody, nil
+}
+
+// SessionReuseNoticeEmail tells an admin that a replayed refresh token signed one of their sessions out.
+func SessionReuseNoticeEmail(locale, siteURL string) (string, string, error) {
+	subject, htmlBody, err := renderNoticeEmail(sessionReuseNoticeByLocale, locale, siteURL)
+	if err != nil {
+		return "", "", fmt.Errorf("render admin session reuse notice template: %w", err)
+	}
+
+	return subject, htmlBody, nil
+}
+
+// ReaderSessionReuseNoticeEmail is the reader counterpart of SessionReuseNoticeEmail.
+func ReaderSessionReuseNoticeEmail(locale, siteURL string) (string, string, error) {
+	subject, htmlBody, err := renderNoticeEmail(readerSessionReuseNoticeByLocale, locale, siteURL)
+	if err != nil {
+		return "", "", fmt.Errorf("render reader session reuse notice template: %w", err)
+	}
+
+	return subject, htmlBody, nil
+}
+
+func renderNoticeEmail(copies map[string]noticeCopy, locale, siteURL string) (string, string, error) {
 	if err := ensureTemplates(); err != nil {
 		return "", "", err
 	}
 
 	resolved := resolveLocale(locale)
-	content := noticeByLocale[resolved]
+	content := copies[resolved]
 	data := noticeTemplateData{
 		Lang:         resolved,
 		FaviconURL:   newsletter.BuildFaviconURL(siteURL),
@@ -304,12 +615,105 @@ func ChangeRequestedNoticeEmail(locale, siteURL string) (string, string, error)
 
 	htmlBody, err := renderTemplate(noticeTemplate, data)
 	if err != nil {
-		return "", "", fmt.Errorf("render admin email change notice template: %w", err)
+		return "", "", err
+	}
+
+	return content.Subject, htmlBody, nil
+}
+
+// AdminLoginAlertEmail tells an admin about a sign-in from a new device or country. secureURL signs every session out
+// and starts a password reset.
+func AdminLoginAlertEmail(locale, secureURL, siteURL string, details LoginAlertDetails) (string, string, error) {
+	subject, htmlBody, err := renderLoginAlertEmail(adminLoginAlertByLocale, locale, secureURL, siteURL, details)
+	if err != nil {
+		return "", "", fmt.Errorf("render admin login alert email template: %w", err)
+	}
+
+	return subject, htmlBody, nil
+}
+
+// ReaderLoginAlertEmail tells a reader about a sign-in from a new device or country. secureURL signs every session out.
+func ReaderLoginAlertEmail(locale, secureURL, siteURL string, details LoginAlertDetails) (string, string, error) {
+	subject, htmlBody, err := renderLoginAlertEmail(readerLoginAlertByLocale, locale, secureURL, siteURL, details)
+	if err != nil {
+		return "", "", fmt.Errorf("render reader login alert email template: %w", err)
+	}
+
+	return subject, htmlBody, nil
+}
+
+func renderLoginAlertEmail(
+	copies map[string]loginAlertCopy,
+	locale string,
+	secureURL string,
+	siteURL string,
+	details LoginAlertDetails,
+) (string, string, error) {
+	if err := ensureTemplates(); err != nil {
+		return "", "", err
+	}
+
+	resolved := resolveLocale(locale)
+	content := copies[resolved]
+	valueOrUnknown := func(value string) string {
+		if strings.TrimSpace(value) == "" {
+			return content.Unknown
+		}
+		return strings.TrimSpace(value)
+	}
+
+	data := emailTemplateData{
+		Lang:         resolved,
+		FaviconURL:   newsletter.BuildFaviconURL(siteURL),
+		EyebrowLabel: content.EyebrowLabel,
+		Title:        content.Title,
+		Heading:      content.Heading,
+		Body:         content.Body,
+		ButtonLabel:  content.ButtonLabel,
+		FallbackLead: content.FallbackLead,
+		ActionURL:    strings.TrimSpace(secureURL),
+		Details: []emailDetail{
+			{Label: content.DeviceLabel, Value: valueOrUnknown(details.Device)},
+			{Label: content.CountryLabel, Value: valueOrUnknown(strings.ToUpper(details.CountryCode))},
+			{Label: content.IPAddressLabel, Value: valueOrUnknown(details.IPAddress)},
+			{Label: content.TimeLabel, Value: details.Time.UTC().Format("2006-01-02 15:04 UTC")},
+		},
+	}
+
+	htmlBody, err := renderTemplate(confirmationTemplate, data)
+	if err != nil {
+		return "", "", err
 	}
 
 	return content.Subject, htmlBody, nil
 }
 
+// LoginAlertStatusPage renders the page shown after a "this wasn't me" link from a login alert is opened.
+func LoginAlertStatusPage(locale string, status StatusKey, siteURL string, admin bool) (string, error) {
+	if err := ensureTemplates(); err != nil {
+		return "", err
+	}
+
+	resolved := resolveLocale(locale)
+	content := loginAlertStatusByLocale[resolved][status]
+	buttonHref := strings.TrimRight(siteURL, "/") + "/" + resolved
+	if admin && status == StatusSuccess {
+		content.Message = adminLoginAlertSuccessMessage[resolved]
+		content.ButtonLabel = statusByLocale[resolved][StatusSuccess].ButtonLabel
+		buttonHref += "/admin/login"
+	}
+
+	return renderTemplate(statusTemplate, statusTemplateData{
+		Lang:        resolved,
+		FaviconURL:  newsletter.BuildFaviconURL(siteURL),
+		Title:       content.Title,
+		Heading:     content.Heading,
+		Message:     content.Message,
+		ButtonLabel: content.ButtonLabel,
+		ButtonHref:  buttonHref,
+	})
+}
+
 func StatusPage(locale string, status StatusKey, siteURL string) (string, error) {
 	if err := ensureTemplates(); err != nil {
 		return "", err
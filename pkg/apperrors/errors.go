@@ -59,6 +59,10 @@ func Unauthorized(message string) *AppError {
 	return New("UNAUTHORIZED", message, http.StatusUnauthorized, nil)
 }
 
+func NotFound(message string) *AppError {
+	return New("NOT_FOUND", message, http.StatusNotFound, nil)
+}
+
 func MethodNotAllowed(message string) *AppError {
 	return New("METHOD_NOT_ALLOWED", message, http.StatusMethodNotAllowed, nil)
 }
@@ -44,6 +44,7 @@ func TestFactoryHelpersAndFrom(t *testing.T) {
 	}{
 		{name: "bad request", err: BadRequest("bad"), wantCode: "BAD_REQUEST", wantStatus: http.StatusBadRequest},
 		{name: "unauthorized", err: Unauthorized("nope"), wantCode: "UNAUTHORIZED", wantStatus: http.StatusUnauthorized},
+		{name: "not found", err: NotFound("missing"), wantCode: "NOT_FOUND", wantStatus: http.StatusNotFound},
 		{name: "method not allowed", err: MethodNotAllowed("no"), wantCode: "METHOD_NOT_ALLOWED", wantStatus: http.StatusMethodNotAllowed},
 		{name: "config", err: Config("cfg", nil), wantCode: "CONFIG_ERROR", wantStatus: http.StatusInternalServerError},
 		{name: "service unavailable", err: ServiceUnavailable("down", nil), wantCode: "SERVICE_UNAVAILABLE", wantStatus: http.StatusServiceUnavailable},
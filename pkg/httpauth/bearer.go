@@ -0,0 +1,21 @@
+package httpauth
+
+import (
+	"net/http"
+	"strings"
+)
+
+// BearerToken returns the token of an "Authorization: Bearer" header. The scheme is matched case-insensitively.
+func BearerToken(r *http.Request) (string, bool) {
+	if r == nil {
+		return "", false
+	}
+
+	scheme, token, found := strings.Cut(strings.TrimSpace(r.Header.Get("Authorization")), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
@@ -0,0 +1,293 @@
+package httpauth
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+var (
+	ErrInvalidJWK               = errors.New("invalid jwk")
+	ErrUnsupportedJWTAlgorithm  = errors.New("unsupported jwt algorithm")
+	errDuplicateJWKID           = errors.New("duplicate jwk kid")
+	errMismatchedJWKPrivatePart = errors.New("jwk private key does not match its public key")
+)
+
+// JWTKey is one asymmetric key of a key set. Keys without a private part only verify tokens, which lets a retired
+// signing key keep accepting the tokens it issued until they expire.
+type JWTKey struct {
+	ID         string
+	Algorithm  string
+	PublicKey  crypto.PublicKey
+	PrivateKey crypto.Signer
+}
+
+// JWTKeySet holds everything needed to issue and verify tokens: the algorithm used for new tokens, the HS256 secret and
+// the asymmetric keys. SigningKeyID picks the active key; when empty, the first key of the algorithm with a private
+// part signs.
+type JWTKeySet struct {
+	Algorithm    string
+	Secret       string
+	SigningKeyID string
+	Keys         []JWTKey
+}
+
+// JSONWebKey is the RFC 7517 representation of an Ed25519 or P-256 key. D is only set for private keys.
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	Y         string `json:"y,omitempty"`
+	D         string `json:"d,omitempty"`
+	KeyID     string `json:"kid"`
+	Algorithm string `json:"alg,omitempty"`
+	Use       string `json:"use,omitempty"`
+}
+
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// ParseJWTAlgorithm normalizes a configured algorithm name. An empty value selects HS256.
+func ParseJWTAlgorithm(value string) (string, error) {
+	switch strings.ToUpper(strings.TrimSpace(value)) {
+	case "", JWTAlgorithmHS256:
+		return JWTAlgorithmHS256, nil
+	case strings.ToUpper(JWTAlgorithmEdDSA):
+		return JWTAlgorithmEdDSA, nil
+	case JWTAlgorithmES256:
+		return JWTAlgorithmES256, nil
+	}
+
+	return "", fmt.Errorf("%w: %s", ErrUnsupportedJWTAlgorithm, strings.TrimSpace(value))
+}
+
+// ParseJWKSet reads a JSON Web Key Set of Ed25519 (kty OKP) and P-256 (kty EC) keys. Every key needs a unique kid.
+func ParseJWKSet(raw string) ([]JWTKey, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+
+	var set JSONWebKeySet
+	if err := json.Unmarshal([]byte(raw), &set); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidJWK, err)
+	}
+
+	keys := make([]JWTKey, 0, len(set.Keys))
+	seen := make(map[string]struct{}, len(set.Keys))
+	for _, jwk := range set.Keys {
+		key, err := jwk.toJWTKey()
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := seen[key.ID]; exists {
+			return nil, fmt.Errorf("%w: %w %q", ErrInvalidJWK, errDuplicateJWKID, key.ID)
+		}
+		seen[key.ID] = struct{}{}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// GenerateJWTKey creates a new EdDSA or ES256 signing key.
+func GenerateJWTKey(algorithm, id string) (JWTKey, error) {
+	resolvedID := strings.TrimSpace(id)
+	if resolvedID == "" {
+		return JWTKey{}, fmt.Errorf("%w: kid is required", ErrInvalidJWK)
+	}
+
+	switch algorithm {
+	case JWTAlgorithmEdDSA:
+		publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return JWTKey{}, err
+		}
+		return JWTKey{ID: resolvedID, Algorithm: algorithm, PublicKey: publicKey, PrivateKey: privateKey}, nil
+	case JWTAlgorithmES256:
+		privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return JWTKey{}, err
+		}
+		return JWTKey{ID: resolvedID, Algorithm: algorithm, PublicKey: &privateKey.PublicKey, PrivateKey: privateKey}, nil
+	}
+
+	return JWTKey{}, fmt.Errorf("%w: %s", ErrUnsupportedJWTAlgorithm, algorithm)
+}
+
+// PublicJWKS lists the public half of every asymmetric key, for publishing at /.well-known/jwks.json.
+func (keys JWTKeySet) PublicJWKS() JSONWebKeySet {
+	set := JSONWebKeySet{Keys: make([]JSONWebKey, 0, len(keys.Keys))}
+	for _, key := range keys.Keys {
+		if jwk, ok := key.jsonWebKey(false); ok {
+			set.Keys = append(set.Keys, jwk)
+		}
+	}
+
+	return set
+}
+
+// PrivateJWK returns the key including its private part, in the form ParseJWKSet reads back.
+func (key JWTKey) PrivateJWK() (JSONWebKey, error) {
+	jwk, ok := key.jsonWebKey(true)
+	if !ok || jwk.D == "" {
+		return JSONWebKey{}, ErrMissingJWTKey
+	}
+
+	return jwk, nil
+}
+
+func (keys JWTKeySet) resolveAlgorithm() string {
+	if algorithm, err := ParseJWTAlgorithm(keys.Algorithm); err == nil {
+		return algorithm
+	}
+
+	return strings.TrimSpace(keys.Algorithm)
+}
+
+func (keys JWTKeySet) signingKey(algorithm string) (JWTKey, error) {
+	if algorithm != JWTAlgorithmEdDSA && algorithm != JWTAlgorithmES256 {
+		return JWTKey{}, fmt.Errorf("%w: %s", ErrUnsupportedJWTAlgorithm, algorithm)
+	}
+
+	signingKeyID := strings.TrimSpace(keys.SigningKeyID)
+	for _, key := range keys.Keys {
+		if key.Algorithm != algorithm || key.PrivateKey == nil {
+			continue
+		}
+		if signingKeyID == "" || key.ID == signingKeyID {
+			return key, nil
+		}
+	}
+
+	return JWTKey{}, ErrMissingJWTKey
+}
+
+func (keys JWTKeySet) verificationKey(id, algorithm string) *JWTKey {
+	if strings.TrimSpace(id) == "" {
+		return nil
+	}
+
+	for index := range keys.Keys {
+		if keys.Keys[index].ID == id && keys.Keys[index].Algorithm == algorithm {
+			return &keys.Keys[index]
+		}
+	}
+
+	return nil
+}
+
+func (jwk JSONWebKey) toJWTKey() (JWTKey, error) {
+	id := strings.TrimSpace(jwk.KeyID)
+	if id == "" {
+		return JWTKey{}, fmt.Errorf("%w: kid is required", ErrInvalidJWK)
+	}
+	invalid := func(reason string) error {
+		return fmt.Errorf("%w %q: %s", ErrInvalidJWK, id, reason)
+	}
+
+	switch {
+	case jwk.KeyType == "OKP" && jwk.Curve == "Ed25519":
+		if jwk.Algorithm != "" && jwk.Algorithm != JWTAlgorithmEdDSA {
+			return JWTKey{}, invalid("alg must be EdDSA")
+		}
+		x, err := decodeJWKCoordinate(jwk.X, ed25519.PublicKeySize)
+		if err != nil {
+			return JWTKey{}, invalid("x is malformed")
+		}
+		key := JWTKey{ID: id, Algorithm: JWTAlgorithmEdDSA, PublicKey: ed25519.PublicKey(x)}
+		if jwk.D == "" {
+			return key, nil
+		}
+		seed, err := decodeJWKCoordinate(jwk.D, ed25519.SeedSize)
+		if err != nil {
+			return JWTKey{}, invalid("d is malformed")
+		}
+		privateKey := ed25519.NewKeyFromSeed(seed)
+		if !bytes.Equal(privateKey.Public().(ed25519.PublicKey), x) {
+			return JWTKey{}, fmt.Errorf("%w %q: %w", ErrInvalidJWK, id, errMismatchedJWKPrivatePart)
+		}
+		key.PrivateKey = privateKey
+		return key, nil
+	case jwk.KeyType == "EC" && jwk.Curve == "P-256":
+		if jwk.Algorithm != "" && jwk.Algorithm != JWTAlgorithmES256 {
+			return JWTKey{}, invalid("alg must be ES256")
+		}
+		x, errX := decodeJWKCoordinate(jwk.X, 32)
+		y, errY := decodeJWKCoordinate(jwk.Y, 32)
+		if errX != nil || errY != nil {
+			return JWTKey{}, invalid("x or y is malformed")
+		}
+		point := append(append([]byte{4}, x...), y...)
+		if _, err := ecdh.P256().NewPublicKey(point); err != nil {
+			return JWTKey{}, invalid("point is not on the P-256 curve")
+		}
+		publicKey := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		key := JWTKey{ID: id, Algorithm: JWTAlgorithmES256, PublicKey: publicKey}
+		if jwk.D == "" {
+			return key, nil
+		}
+		d, err := decodeJWKCoordinate(jwk.D, 32)
+		if err != nil {
+			return JWTKey{}, invalid("d is malformed")
+		}
+		privateECDH, err := ecdh.P256().NewPrivateKey(d)
+		if err != nil {
+			return JWTKey{}, invalid("d is out of range")
+		}
+		if !bytes.Equal(privateECDH.PublicKey().Bytes(), point) {
+			return JWTKey{}, fmt.Errorf("%w %q: %w", ErrInvalidJWK, id, errMismatchedJWKPrivatePart)
+		}
+		key.PrivateKey = &ecdsa.PrivateKey{PublicKey: *publicKey, D: new(big.Int).SetBytes(d)}
+		return key, nil
+	}
+
+	return JWTKey{}, invalid("only OKP/Ed25519 and EC/P-256 keys are supported")
+}
+
+func (key JWTKey) jsonWebKey(includePrivate bool) (JSONWebKey, bool) {
+	jwk := JSONWebKey{KeyID: key.ID, Algorithm: key.Algorithm, Use: "sig"}
+	switch publicKey := key.PublicKey.(type) {
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Curve = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(publicKey)
+		if privateKey, ok := key.PrivateKey.(ed25519.PrivateKey); ok && includePrivate {
+			jwk.D = base64.RawURLEncoding.EncodeToString(privateKey.Seed())
+		}
+	case *ecdsa.PublicKey:
+		jwk.KeyType = "EC"
+		jwk.Curve = "P-256"
+		jwk.X = base64.RawURLEncoding.EncodeToString(publicKey.X.FillBytes(make([]byte, 32)))
+		jwk.Y = base64.RawURLEncoding.EncodeToString(publicKey.Y.FillBytes(make([]byte, 32)))
+		if privateKey, ok := key.PrivateKey.(*ecdsa.PrivateKey); ok && includePrivate {
+			jwk.D = base64.RawURLEncoding.EncodeToString(privateKey.D.FillBytes(make([]byte, 32)))
+		}
+	default:
+		return JSONWebKey{}, false
+	}
+
+	return jwk, true
+}
+
+func decodeJWKCoordinate(value string, size int) ([]byte, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimSpace(value))
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) != size {
+		return nil, ErrInvalidJWK
+	}
+
+	return decoded, nil
+}
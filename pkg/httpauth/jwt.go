@@ -1,6 +1,8 @@
 package httpauth
 
 import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/hmac"
 	"crypto/rand"
 	"crypto/sha256"
@@ -8,16 +10,24 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"strings"
 	"time"
 )
 
+const (
+	JWTAlgorithmHS256 = "HS256"
+	JWTAlgorithmEdDSA = "EdDSA"
+	JWTAlgorithmES256 = "ES256"
+)
+
 type JWTClaims struct {
 	ID              string   `json:"jti,omitempty"`
 	Subject         string   `json:"sub"`
 	Email           string   `json:"email,omitempty"`
 	Roles           []string `json:"roles,omitempty"`
 	PasswordVersion int64    `json:"pwdv,omitempty"`
+	RememberMe      bool     `json:"rme,omitempty"`
 	Type            string   `json:"typ"`
 	Issuer          string   `json:"iss,omitempty"`
 	Audience        string   `json:"aud,omitempty"`
@@ -30,8 +40,15 @@ var (
 	ErrExpiredJWT       = errors.New("expired jwt")
 	ErrUnsupportedJWT   = errors.New("unsupported jwt")
 	ErrMissingJWTSecret = errors.New("missing jwt secret")
+	ErrMissingJWTKey    = errors.New("missing jwt signing key")
 )
 
+type jwtHeader struct {
+	Algorithm string `json:"alg"`
+	Type      string `json:"typ"`
+	KeyID     string `json:"kid,omitempty"`
+}
+
 func GenerateOpaqueToken(byteLength int) (string, error) {
 	if byteLength <= 0 {
 		byteLength = 32
@@ -51,7 +68,7 @@ func IssueHS256JWT(claims JWTClaims, secret string) (string, error) {
 	}
 
 	headerJSON, err := json.Marshal(map[string]string{
-		"alg": "HS256",
+		"alg": JWTAlgorithmHS256,
 		"typ": "JWT",
 	})
 	if err != nil {
@@ -86,23 +103,112 @@ func VerifyHS256JWT(token, secret, expectedType string, now time.Time) (*JWTClai
 		return nil, ErrInvalidJWT
 	}
 
-	headerBytes, err := base64.RawURLEncoding.DecodeString(parts[0])
+	header, err := decodeJWTHeader(parts[0])
 	if err != nil {
-		return nil, ErrInvalidJWT
+		return nil, err
 	}
+	if header.Algorithm != JWTAlgorithmHS256 || header.Type != "JWT" {
+		return nil, ErrUnsupportedJWT
+	}
+
+	return decodeJWTClaims(parts[1], expectedType, now)
+}
 
-	var header struct {
-		Algorithm string `json:"alg"`
-		Type      string `json:"typ"`
+// IssueJWT signs claims with the algorithm selected by the key set. HS256 uses the shared secret; EdDSA and ES256 use
+// the active signing key and name it in the kid header so verifiers can pick the matching public key.
+func IssueJWT(claims JWTClaims, keys JWTKeySet) (string, error) {
+	algorithm := keys.resolveAlgorithm()
+	if algorithm == JWTAlgorithmHS256 {
+		return IssueHS256JWT(claims, keys.Secret)
 	}
-	if err := json.Unmarshal(headerBytes, &header); err != nil {
+
+	key, err := keys.signingKey(algorithm)
+	if err != nil {
+		return "", err
+	}
+
+	headerJSON, err := json.Marshal(jwtHeader{Algorithm: algorithm, Type: "JWT", KeyID: key.ID})
+	if err != nil {
+		return "", err
+	}
+
+	payloadJSON, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := fmt.Sprintf(
+		"%s.%s",
+		base64.RawURLEncoding.EncodeToString(headerJSON),
+		base64.RawURLEncoding.EncodeToString(payloadJSON),
+	)
+	signature, err := key.sign(signingInput)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s.%s", signingInput, base64.RawURLEncoding.EncodeToString(signature)), nil
+}
+
+// VerifyJWT accepts a token signed by any key in the set, whatever algorithm is currently used for issuing. HS256
+// tokens keep verifying against the shared secret, so switching algorithms does not end existing sessions.
+func VerifyJWT(token string, keys JWTKeySet, expectedType string, now time.Time) (*JWTClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
 		return nil, ErrInvalidJWT
 	}
-	if header.Algorithm != "HS256" || header.Type != "JWT" {
+
+	header, err := decodeJWTHeader(parts[0])
+	if err != nil {
+		return nil, err
+	}
+	if header.Type != "JWT" {
+		return nil, ErrUnsupportedJWT
+	}
+
+	switch header.Algorithm {
+	case JWTAlgorithmHS256:
+		if strings.TrimSpace(keys.Secret) == "" {
+			return nil, ErrUnsupportedJWT
+		}
+		return VerifyHS256JWT(token, keys.Secret, expectedType, now)
+	case JWTAlgorithmEdDSA, JWTAlgorithmES256:
+	default:
 		return nil, ErrUnsupportedJWT
 	}
 
-	payloadBytes, err := base64.RawURLEncoding.DecodeString(parts[1])
+	key := keys.verificationKey(header.KeyID, header.Algorithm)
+	if key == nil {
+		return nil, ErrInvalidJWT
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrInvalidJWT
+	}
+	if !key.verify(fmt.Sprintf("%s.%s", parts[0], parts[1]), signature) {
+		return nil, ErrInvalidJWT
+	}
+
+	return decodeJWTClaims(parts[1], expectedType, now)
+}
+
+func decodeJWTHeader(segment string) (jwtHeader, error) {
+	headerBytes, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return jwtHeader{}, ErrInvalidJWT
+	}
+
+	var header jwtHeader
+	if err := json.Unmarshal(headerBytes, &header); err != nil {
+		return jwtHeader{}, ErrInvalidJWT
+	}
+
+	return header, nil
+}
+
+func decodeJWTClaims(segment, expectedType string, now time.Time) (*JWTClaims, error) {
+	payloadBytes, err := base64.RawURLEncoding.DecodeString(segment)
 	if err != nil {
 		return nil, ErrInvalidJWT
 	}
@@ -129,3 +235,40 @@ func signHS256(signingInput, secret string) string {
 	_, _ = mac.Write([]byte(signingInput))
 	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
 }
+
+func (key JWTKey) sign(signingInput string) ([]byte, error) {
+	switch privateKey := key.PrivateKey.(type) {
+	case ed25519.PrivateKey:
+		return ed25519.Sign(privateKey, []byte(signingInput)), nil
+	case *ecdsa.PrivateKey:
+		digest := sha256.Sum256([]byte(signingInput))
+		r, s, err := ecdsa.Sign(rand.Reader, privateKey, digest[:])
+		if err != nil {
+			return nil, err
+		}
+		// JWS encodes ES256 signatures as the fixed-size concatenation of r and s rather than ASN.1.
+		signature := make([]byte, 64)
+		r.FillBytes(signature[:32])
+		s.FillBytes(signature[32:])
+		return signature, nil
+	}
+
+	return nil, ErrMissingJWTKey
+}
+
+func (key JWTKey) verify(signingInput string, signature []byte) bool {
+	switch publicKey := key.PublicKey.(type) {
+	case ed25519.PublicKey:
+		return ed25519.Verify(publicKey, []byte(signingInput), signature)
+	case *ecdsa.PublicKey:
+		if len(signature) != 64 {
+			return false
+		}
+		digest := sha256.Sum256([]byte(signingInput))
+		r := new(big.Int).SetBytes(signature[:32])
+		s := new(big.Int).SetBytes(signature[32:])
+		return ecdsa.Verify(publicKey, digest[:], r, s)
+	}
+
+	return false
+}
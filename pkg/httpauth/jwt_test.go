@@ -0,0 +1,215 @@
+package httpauth
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testJWTClaims(now time.Time) JWTClaims {
+	return JWTClaims{
+		ID:        "jti-1",
+		Subject:   "user-1",
+		Type:      "access",
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(time.Hour).Unix(),
+	}
+}
+
+func encodeTestJWKSet(t *testing.T, keys ...JWTKey) string {
+	t.Helper()
+
+	set := JSONWebKeySet{}
+	for _, key := range keys {
+		jwk, err := key.PrivateJWK()
+		if err != nil {
+			jwk = JWTKeySet{Keys: []JWTKey{key}}.PublicJWKS().Keys[0]
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	encoded, err := json.Marshal(set)
+	if err != nil {
+		t.Fatalf("marshal jwk set: %v", err)
+	}
+	return string(encoded)
+}
+
+func TestIssueAndVerifyJWTWithAsymmetricKeys(t *testing.T) {
+	now := time.Now().UTC()
+	for _, algorithm := range []string{JWTAlgorithmEdDSA, JWTAlgorithmES256} {
+		t.Run(algorithm, func(t *testing.T) {
+			key, err := GenerateJWTKey(algorithm, "key-1")
+			if err != nil {
+				t.Fatalf("GenerateJWTKey returned error: %v", err)
+			}
+			parsed, err := ParseJWKSet(encodeTestJWKSet(t, key))
+			if err != nil {
+				t.Fatalf("ParseJWKSet returned error: %v", err)
+			}
+			keys := JWTKeySet{Algorithm: algorithm, Keys: parsed}
+
+			token, err := IssueJWT(testJWTClaims(now), keys)
+			if err != nil {
+				t.Fatalf("IssueJWT returned error: %v", err)
+			}
+			header, err := decodeJWTHeader(strings.Split(token, ".")[0])
+			if err != nil || header.Algorithm != algorithm || header.KeyID != "key-1" {
+				t.Fatalf("unexpected header %#v (%v)", header, err)
+			}
+
+			claims, err := VerifyJWT(token, keys, "access", now)
+			if err != nil || claims.Subject != "user-1" {
+				t.Fatalf("VerifyJWT returned %#v, %v", claims, err)
+			}
+			if _, err := VerifyJWT(token, keys, "refresh", now); !errors.Is(err, ErrUnsupportedJWT) {
+				t.Fatalf("expected unsupported type, got %v", err)
+			}
+			if _, err := VerifyJWT(token, keys, "access", now.Add(2*time.Hour)); !errors.Is(err, ErrExpiredJWT) {
+				t.Fatalf("expected expired token, got %v", err)
+			}
+
+			// A verify-only copy of the key accepts the token; a tampered payload does not verify.
+			public := JWTKeySet{Keys: []JWTKey{{ID: key.ID, Algorithm: key.Algorithm, PublicKey: key.PublicKey}}}
+			if _, err := VerifyJWT(token, public, "access", now); err != nil {
+				t.Fatalf("expected public key to verify, got %v", err)
+			}
+			parts := strings.Split(token, ".")
+			forged := testJWTClaims(now)
+			forged.Subject = "user-2"
+			payload, _ := json.Marshal(forged)
+			parts[1] = base64.RawURLEncoding.EncodeToString(payload)
+			if _, err := VerifyJWT(strings.Join(parts, "."), keys, "access", now); !errors.Is(err, ErrInvalidJWT) {
+				t.Fatalf("expected invalid signature, got %v", err)
+			}
+		})
+	}
+}
+
+func TestVerifyJWTSupportsKeyRotationAndHS256Tokens(t *testing.T) {
+	now := time.Now().UTC()
+	oldKey, _ := GenerateJWTKey(JWTAlgorithmEdDSA, "old")
+	newKey, _ := GenerateJWTKey(JWTAlgorithmEdDSA, "new")
+
+	oldToken, err := IssueJWT(testJWTClaims(now), JWTKeySet{Algorithm: JWTAlgorithmEdDSA, Keys: []JWTKey{oldKey}})
+	if err != nil {
+		t.Fatalf("IssueJWT returned error: %v", err)
+	}
+	legacyToken, err := IssueHS256JWT(testJWTClaims(now), "secret")
+	if err != nil {
+		t.Fatalf("IssueHS256JWT returned error: %v", err)
+	}
+
+	// The old key is demoted to verify-only and the new one signs.
+	retired := JWTKey{ID: oldKey.ID, Algorithm: oldKey.Algorithm, PublicKey: oldKey.PublicKey}
+	rotated := JWTKeySet{Algorithm: JWTAlgorithmEdDSA, Secret: "secret", Keys: []JWTKey{retired, newKey}}
+
+	newToken, err := IssueJWT(testJWTClaims(now), rotated)
+	if err != nil {
+		t.Fatalf("IssueJWT returned error: %v", err)
+	}
+	if header, _ := decodeJWTHeader(strings.Split(newToken, ".")[0]); header.KeyID != "new" {
+		t.Fatalf("expected new signing key, got %#v", header)
+	}
+	for _, token := range []string{oldToken, newToken, legacyToken} {
+		if _, err := VerifyJWT(token, rotated, "access", now); err != nil {
+			t.Fatalf("expected token to verify after rotation, got %v", err)
+		}
+	}
+
+	withoutSecret := rotated
+	withoutSecret.Secret = ""
+	if _, err := VerifyJWT(legacyToken, withoutSecret, "access", now); !errors.Is(err, ErrUnsupportedJWT) {
+		t.Fatalf("expected HS256 token to be rejected without a secret, got %v", err)
+	}
+	withoutOldKey := JWTKeySet{Algorithm: JWTAlgorithmEdDSA, Keys: []JWTKey{newKey}}
+	if _, err := VerifyJWT(oldToken, withoutOldKey, "access", now); !errors.Is(err, ErrInvalidJWT) {
+		t.Fatalf("expected unknown kid to be rejected, got %v", err)
+	}
+
+	pinned := rotated
+	pinned.SigningKeyID = "old"
+	if _, err := IssueJWT(testJWTClaims(now), pinned); !errors.Is(err, ErrMissingJWTKey) {
+		t.Fatalf("expected verify-only key to be unusable for signing, got %v", err)
+	}
+}
+
+func TestIssueJWTDefaultsToHS256(t *testing.T) {
+	now := time.Now().UTC()
+	token, err := IssueJWT(testJWTClaims(now), JWTKeySet{Secret: "secret"})
+	if err != nil {
+		t.Fatalf("IssueJWT returned error: %v", err)
+	}
+	if _, err := VerifyHS256JWT(token, "secret", "access", now); err != nil {
+		t.Fatalf("expected HS256 token, got %v", err)
+	}
+	if _, err := IssueJWT(testJWTClaims(now), JWTKeySet{Algorithm: "RS256"}); !errors.Is(err, ErrUnsupportedJWTAlgorithm) {
+		t.Fatalf("expected unsupported algorithm, got %v", err)
+	}
+}
+
+func TestParseJWKSetRejectsInvalidKeys(t *testing.T) {
+	key, _ := GenerateJWTKey(JWTAlgorithmEdDSA, "dup")
+	other, _ := GenerateJWTKey(JWTAlgorithmEdDSA, "other")
+	mismatched, _ := key.PrivateJWK()
+	otherJWK, _ := other.PrivateJWK()
+	mismatched.D = otherJWK.D
+
+	cases := map[string]string{
+		"malformed json": "{",
+		"missing kid":    `{"keys":[{"kty":"OKP","crv":"Ed25519","x":"` + mismatched.X + `"}]}`,
+		"unsupported":    `{"keys":[{"kty":"RSA","kid":"rsa"}]}`,
+		"duplicate kid":  encodeTestJWKSet(t, key, key),
+		"bad point":      `{"keys":[{"kty":"EC","crv":"P-256","kid":"ec","x":"` + mismatched.X + `","y":"` + mismatched.X + `"}]}`,
+	}
+	mismatchedSet, _ := json.Marshal(JSONWebKeySet{Keys: []JSONWebKey{mismatched}})
+	cases["mismatched private part"] = string(mismatchedSet)
+
+	for name, raw := range cases {
+		if _, err := ParseJWKSet(raw); !errors.Is(err, ErrInvalidJWK) {
+			t.Fatalf("%s: expected invalid jwk, got %v", name, err)
+		}
+	}
+
+	keys, err := ParseJWKSet("")
+	if err != nil || keys != nil {
+		t.Fatalf("expected empty key set, got %#v, %v", keys, err)
+	}
+}
+
+func TestPublicJWKSOmitsPrivateParts(t *testing.T) {
+	edKey, _ := GenerateJWTKey(JWTAlgorithmEdDSA, "ed")
+	ecKey, _ := GenerateJWTKey(JWTAlgorithmES256, "ec")
+
+	set := JWTKeySet{Keys: []JWTKey{edKey, ecKey}}.PublicJWKS()
+	if len(set.Keys) != 2 {
+		t.Fatalf("expected two keys, got %#v", set)
+	}
+	for _, jwk := range set.Keys {
+		if jwk.D != "" || jwk.Use != "sig" {
+			t.Fatalf("unexpected public jwk %#v", jwk)
+		}
+	}
+	if set.Keys[1].KeyType != "EC" || set.Keys[1].Y == "" || set.Keys[1].Algorithm != JWTAlgorithmES256 {
+		t.Fatalf("unexpected ec jwk %#v", set.Keys[1])
+	}
+
+	encoded, _ := json.Marshal(set)
+	parsed, err := ParseJWKSet(string(encoded))
+	if err != nil || len(parsed) != 2 || parsed[0].PrivateKey != nil {
+		t.Fatalf("expected published keys to parse as verify-only, got %#v, %v", parsed, err)
+	}
+}
+
+func TestParseJWTAlgorithm(t *testing.T) {
+	for input, expected := range map[string]string{"": JWTAlgorithmHS256, "hs256": JWTAlgorithmHS256, "eddsa": JWTAlgorithmEdDSA, "ES256": JWTAlgorithmES256} {
+		if algorithm, err := ParseJWTAlgorithm(input); err != nil || algorithm != expected {
+			t.Fatalf("ParseJWTAlgorithm(%q) = %q, %v", input, algorithm, err)
+		}
+	}
+	if _, err := ParseJWTAlgorithm("none"); !errors.Is(err, ErrUnsupportedJWTAlgorithm) {
+		t.Fatalf("expected unsupported algorithm, got %v", err)
+	}
+}
@@ -0,0 +1,372 @@
+package oidc
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Signing algorithms accepted for ID tokens.
+const (
+	AlgorithmRS256 = "RS256"
+	AlgorithmES256 = "ES256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+const minRSAKeyBits = 2048
+
+type idTokenHeader struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid,omitempty"`
+}
+
+type idTokenClaims struct {
+	Issuer            string       `json:"iss"`
+	Subject           string       `json:"sub"`
+	Audience          audience     `json:"aud"`
+	AuthorizedParty   string       `json:"azp,omitempty"`
+	ExpiresAt         int64        `json:"exp"`
+	IssuedAt          int64        `json:"iat"`
+	Nonce             string       `json:"nonce,omitempty"`
+	Email             string       `json:"email,omitempty"`
+	EmailVerified     flexibleBool `json:"email_verified,omitempty"`
+	Name              string       `json:"name,omitempty"`
+	PreferredUsername string       `json:"preferred_username,omitempty"`
+	Picture           string       `json:"picture,omitempty"`
+}
+
+// audience accepts both the single string and the array form of the aud claim.
+type audience []string
+
+func (value *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*value = audience{single}
+		return nil
+	}
+
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return err
+	}
+	*value = multiple
+	return nil
+}
+
+// flexibleBool accepts email_verified as a boolean or as the "true"/"false" string some providers send.
+type flexibleBool bool
+
+func (value *flexibleBool) UnmarshalJSON(data []byte) error {
+	var parsed bool
+	if err := json.Unmarshal(data, &parsed); err == nil {
+		*value = flexibleBool(parsed)
+		return nil
+	}
+
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	*value = flexibleBool(strings.EqualFold(strings.TrimSpace(text), "true"))
+	return nil
+}
+
+type jsonWebKey struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use,omitempty"`
+	Algorithm string `json:"alg,omitempty"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+	Y         string `json:"y,omitempty"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+}
+
+type verificationKey struct {
+	id        string
+	algorithm string
+	publicKey crypto.PublicKey
+}
+
+type cachedKeySet struct {
+	keys      []verificationKey
+	fetchedAt time.Time
+	expiresAt time.Time
+	// unknownKeyIDs holds kids that a refetch did not find. They fail without another fetch until the set expires.
+	unknownKeyIDs map[string]struct{}
+}
+
+func (client *Client) verifyIDToken(
+	ctx context.Context,
+	document *Discovery,
+	provider Provider,
+	rawIDToken string,
+	nonce string,
+) (*Claims, error) {
+	parts := strings.Split(strings.TrimSpace(rawIDToken), ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidIDToken)
+	}
+
+	var header idTokenHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+	}
+	if !slices.Contains([]string{AlgorithmRS256, AlgorithmES256, AlgorithmEdDSA}, header.Algorithm) {
+		return nil, fmt.Errorf("%w: unsupported alg %q", ErrInvalidIDToken, header.Algorithm)
+	}
+	signature, err := encoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+	}
+
+	key, err := client.resolveVerificationKey(ctx, document.JWKSURI, header)
+	if err != nil {
+		return nil, err
+	}
+	if !verifySignature(key, []byte(parts[0]+"."+parts[1]), signature) {
+		return nil, fmt.Errorf("%w: signature mismatch", ErrInvalidIDToken)
+	}
+
+	var payload idTokenClaims
+	if err := decodeSegment(parts[1], &payload); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+	}
+	if err := validateIDTokenClaims(payload, document.Issuer, provider.ClientID, nonce, client.now()); err != nil {
+		return nil, err
+	}
+
+	return &Claims{
+		Issuer:            payload.Issuer,
+		Subject:           payload.Subject,
+		Audience:          []string(payload.Audience),
+		AuthorizedParty:   payload.AuthorizedParty,
+		ExpiresAt:         payload.ExpiresAt,
+		IssuedAt:          payload.IssuedAt,
+		Nonce:             payload.Nonce,
+		Email:             strings.TrimSpace(payload.Email),
+		EmailVerified:     bool(payload.EmailVerified),
+		Name:              strings.TrimSpace(payload.Name),
+		PreferredUsername: strings.TrimSpace(payload.PreferredUsername),
+		Picture:           strings.TrimSpace(payload.Picture),
+	}, nil
+}
+
+func validateIDTokenClaims(payload idTokenClaims, issuer, clientID, nonce string, now time.Time) error {
+	switch {
+	case payload.Issuer != issuer:
+		return fmt.Errorf("%w: unexpected issuer %q", ErrInvalidIDToken, payload.Issuer)
+	case strings.TrimSpace(payload.Subject) == "":
+		return fmt.Errorf("%w: missing subject", ErrInvalidIDToken)
+	case !slices.Contains(payload.Audience, clientID):
+		return fmt.Errorf("%w: token was not issued to this client", ErrInvalidIDToken)
+	case len(payload.Audience) > 1 && payload.AuthorizedParty != clientID:
+		return fmt.Errorf("%w: unexpected authorized party %q", ErrInvalidIDToken, payload.AuthorizedParty)
+	case payload.ExpiresAt == 0 || now.Add(-clockSkew).Unix() >= payload.ExpiresAt:
+		return fmt.Errorf("%w: token expired", ErrInvalidIDToken)
+	case payload.IssuedAt > now.Add(clockSkew).Unix():
+		return fmt.Errorf("%w: token issued in the future", ErrInvalidIDToken)
+	case nonce == "" || !constantTimeEqual(payload.Nonce, nonce):
+		return ErrNonceMismatch
+	}
+
+	return nil
+}
+
+// resolveVerificationKey looks the signing key up in the cached JWKS and refetches the set once when the kid is
+// unknown, which is how a provider's key rotation shows up. Since anyone can send a token with a made-up kid, the
+// refetch happens at most once per keySetMinRefetchInterval and kids it did not find are remembered as unknown.
+func (client *Client) resolveVerificationKey(ctx context.Context, jwksURI string, header idTokenHeader) (verificationKey, error) {
+	keys, err := client.loadKeySet(ctx, jwksURI, false)
+	if err != nil {
+		return verificationKey{}, err
+	}
+	if key, ok := selectVerificationKey(keys, header); ok {
+		return key, nil
+	}
+
+	if client.canRefetchKeySet(jwksURI, header.KeyID) {
+		keys, err := client.loadKeySet(ctx, jwksURI, true)
+		if err != nil {
+			return verificationKey{}, err
+		}
+		if key, ok := selectVerificationKey(keys, header); ok {
+			return key, nil
+		}
+		client.rememberUnknownKeyID(jwksURI, header.KeyID)
+	}
+
+	return verificationKey{}, fmt.Errorf("%w: no key for kid %q", ErrInvalidIDToken, header.KeyID)
+}
+
+func (client *Client) canRefetchKeySet(jwksURI, keyID string) bool {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+
+	cached := client.keySets[jwksURI]
+	if _, unknown := cached.unknownKeyIDs[keyID]; unknown {
+		return false
+	}
+	return !client.now().Before(cached.fetchedAt.Add(keySetMinRefetchInterval))
+}
+
+func (client *Client) rememberUnknownKeyID(jwksURI, keyID string) {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+
+	cached, ok := client.keySets[jwksURI]
+	if !ok {
+		return
+	}
+	if cached.unknownKeyIDs == nil {
+		cached.unknownKeyIDs = make(map[string]struct{})
+	}
+	cached.unknownKeyIDs[keyID] = struct{}{}
+	client.keySets[jwksURI] = cached
+}
+
+func (client *Client) loadKeySet(ctx context.Context, jwksURI string, refresh bool) ([]verificationKey, error) {
+	client.mu.Lock()
+	cached, ok := client.keySets[jwksURI]
+	client.mu.Unlock()
+	now := client.now()
+	if ok && !refresh && now.Before(cached.expiresAt) {
+		return cached.keys, nil
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := client.getJSON(ctx, jwksURI, "", &set); err != nil {
+		return nil, fmt.Errorf("%w: jwks: %v", ErrDiscovery, err)
+	}
+
+	keys := make([]verificationKey, 0, len(set.Keys))
+	for _, jwk := range set.Keys {
+		// Keys meant for encryption or of unsupported types are skipped rather than failing the whole set.
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		if key, ok := jwk.toVerificationKey(); ok {
+			keys = append(keys, key)
+		}
+	}
+
+	next := cachedKeySet{keys: keys, fetchedAt: now, expiresAt: now.Add(keySetCacheTTL)}
+	// A refetch for an unknown kid keeps the kids already known to be unknown, and expiry clears them.
+	if ok && refresh {
+		next.expiresAt = cached.expiresAt
+		next.unknownKeyIDs = cached.unknownKeyIDs
+	}
+	client.mu.Lock()
+	client.keySets[jwksURI] = next
+	client.mu.Unlock()
+
+	return keys, nil
+}
+
+func selectVerificationKey(keys []verificationKey, header idTokenHeader) (verificationKey, bool) {
+	var candidates []verificationKey
+	for _, key := range keys {
+		if key.algorithm != header.Algorithm {
+			continue
+		}
+		if header.KeyID != "" && key.id == header.KeyID {
+			return key, true
+		}
+		candidates = append(candidates, key)
+	}
+	// Without a kid the token can only be matched when the provider publishes a single key for the algorithm.
+	if header.KeyID == "" && len(candidates) == 1 {
+		return candidates[0], true
+	}
+
+	return verificationKey{}, false
+}
+
+func (jwk jsonWebKey) toVerificationKey() (verificationKey, bool) {
+	key := verificationKey{id: strings.TrimSpace(jwk.KeyID)}
+
+	switch {
+	case jwk.KeyType == "RSA":
+		modulus, errN := encoding.DecodeString(jwk.N)
+		exponent, errE := encoding.DecodeString(jwk.E)
+		if errN != nil || errE != nil || len(exponent) == 0 || len(exponent) > 4 {
+			return verificationKey{}, false
+		}
+		publicKey := &rsa.PublicKey{N: new(big.Int).SetBytes(modulus), E: int(new(big.Int).SetBytes(exponent).Int64())}
+		if publicKey.N.BitLen() < minRSAKeyBits || publicKey.E < 3 {
+			return verificationKey{}, false
+		}
+		key.algorithm = AlgorithmRS256
+		key.publicKey = publicKey
+	case jwk.KeyType == "EC" && jwk.Curve == "P-256":
+		x, errX := encoding.DecodeString(jwk.X)
+		y, errY := encoding.DecodeString(jwk.Y)
+		if errX != nil || errY != nil || len(x) != 32 || len(y) != 32 {
+			return verificationKey{}, false
+		}
+		if _, err := ecdh.P256().NewPublicKey(append(append([]byte{4}, x...), y...)); err != nil {
+			return verificationKey{}, false
+		}
+		key.algorithm = AlgorithmES256
+		key.publicKey = &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+	case jwk.KeyType == "OKP" && jwk.Curve == "Ed25519":
+		x, err := encoding.DecodeString(jwk.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return verificationKey{}, false
+		}
+		key.algorithm = AlgorithmEdDSA
+		key.publicKey = ed25519.PublicKey(bytes.Clone(x))
+	default:
+		return verificationKey{}, false
+	}
+
+	if jwk.Algorithm != "" && jwk.Algorithm != key.algorithm {
+		return verificationKey{}, false
+	}
+
+	return key, true
+}
+
+func verifySignature(key verificationKey, signingInput, signature []byte) bool {
+	switch publicKey := key.publicKey.(type) {
+	case *rsa.PublicKey:
+		digest := sha256.Sum256(signingInput)
+		return rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, digest[:], signature) == nil
+	case *ecdsa.PublicKey:
+		if len(signature) != 64 {
+			return false
+		}
+		digest := sha256.Sum256(signingInput)
+		r := new(big.Int).SetBytes(signature[:32])
+		s := new(big.Int).SetBytes(signature[32:])
+		return ecdsa.Verify(publicKey, digest[:], r, s)
+	case ed25519.PublicKey:
+		return ed25519.Verify(publicKey, signingInput, signature)
+	}
+
+	return false
+}
+
+func decodeSegment(segment string, target any) error {
+	decoded, err := encoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(decoded, target)
+}
@@ -0,0 +1,401 @@
+// Package oidc implements the relying party side of the OpenID Connect authorization code flow: discovery, PKCE
+// (S256), nonce validation and ID token verification against the issuer's JWKS. ID tokens may be signed with RS256,
+// ES256 or EdDSA.
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	discoveryPath       = "/.well-known/openid-configuration"
+	discoveryCacheTTL   = time.Hour
+	keySetCacheTTL      = time.Hour
+	clockSkew           = 2 * time.Minute
+	maxResponseBytes    = 1 << 20
+	randomValueBytes    = 32
+	codeChallengeMethod = "S256"
+
+	// keySetMinRefetchInterval bounds how often tokens with an unknown kid can make the client refetch a JWKS.
+	keySetMinRefetchInterval = 5 * time.Minute
+
+	authMethodClientSecretBasic = "client_secret_basic"
+	authMethodClientSecretPost  = "client_secret_post"
+)
+
+var (
+	ErrDiscovery      = errors.New("oidc discovery failed")
+	ErrInsecureURL    = errors.New("oidc endpoints must use https")
+	ErrTokenExchange  = errors.New("oidc token exchange failed")
+	ErrInvalidIDToken = errors.New("invalid oidc id token")
+	ErrNonceMismatch  = errors.New("oidc nonce mismatch")
+	ErrUserInfo       = errors.New("oidc userinfo request failed")
+
+	encoding = base64.RawURLEncoding
+)
+
+// DefaultScopes are requested when a provider does not configure its own.
+var DefaultScopes = []string{"openid", "email", "profile"}
+
+// Provider is one configured OpenID Connect client registration.
+type Provider struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+}
+
+// Discovery is the subset of the provider metadata document the authorization code flow needs.
+type Discovery struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserInfoEndpoint                  string   `json:"userinfo_endpoint,omitempty"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported,omitempty"`
+}
+
+// AuthRequest holds the values bound to one sign-in attempt. State and Nonce travel through the provider; the
+// CodeVerifier stays with the relying party until the code is redeemed.
+type AuthRequest struct {
+	State        string
+	Nonce        string
+	CodeVerifier string
+}
+
+// Claims are the verified ID token claims, completed from the userinfo endpoint when the ID token has no email.
+type Claims struct {
+	Issuer            string
+	Subject           string
+	Audience          []string
+	AuthorizedParty   string
+	ExpiresAt         int64
+	IssuedAt          int64
+	Nonce             string
+	Email             string
+	EmailVerified     bool
+	Name              string
+	PreferredUsername string
+	Picture           string
+}
+
+// Client talks to OpenID Connect providers and caches their discovery documents and signing keys.
+type Client struct {
+	httpClient *http.Client
+	now        func() time.Time
+
+	mu          sync.Mutex
+	discoveries map[string]cachedDiscovery
+	keySets     map[string]cachedKeySet
+}
+
+type cachedDiscovery struct {
+	document  Discovery
+	expiresAt time.Time
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	IDToken     string `json:"id_token"`
+	TokenType   string `json:"token_type"`
+}
+
+// NewClient returns a client that uses httpClient, or http.DefaultClient when nil.
+func NewClient(httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return &Client{
+		httpClient:  httpClient,
+		now:         time.Now,
+		discoveries: make(map[string]cachedDiscovery),
+		keySets:     make(map[string]cachedKeySet),
+	}
+}
+
+// NewAuthRequest generates a fresh state, nonce and PKCE code verifier.
+func NewAuthRequest() (AuthRequest, error) {
+	values := make([]string, 3)
+	for index := range values {
+		buffer := make([]byte, randomValueBytes)
+		if _, err := rand.Read(buffer); err != nil {
+			return AuthRequest{}, err
+		}
+		values[index] = encoding.EncodeToString(buffer)
+	}
+
+	return AuthRequest{State: values[0], Nonce: values[1], CodeVerifier: values[2]}, nil
+}
+
+// CodeChallenge derives the S256 PKCE challenge sent with the authorization request.
+func CodeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return encoding.EncodeToString(sum[:])
+}
+
+// Discover loads and caches the provider metadata published under the issuer. The document must name the same issuer.
+func (client *Client) Discover(ctx context.Context, issuer string) (*Discovery, error) {
+	resolvedIssuer := strings.TrimRight(strings.TrimSpace(issuer), "/")
+	if err := validateEndpointURL(resolvedIssuer); err != nil {
+		return nil, err
+	}
+
+	client.mu.Lock()
+	cached, ok := client.discoveries[resolvedIssuer]
+	client.mu.Unlock()
+	if ok && client.now().Before(cached.expiresAt) {
+		document := cached.document
+		return &document, nil
+	}
+
+	var document Discovery
+	if err := client.getJSON(ctx, resolvedIssuer+discoveryPath, "", &document); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDiscovery, err)
+	}
+	if strings.TrimRight(strings.TrimSpace(document.Issuer), "/") != resolvedIssuer {
+		return nil, fmt.Errorf("%w: issuer %q does not match %q", ErrDiscovery, document.Issuer, resolvedIssuer)
+	}
+	for _, endpoint := range []string{document.AuthorizationEndpoint, document.TokenEndpoint, document.JWKSURI} {
+		if err := validateEndpointURL(endpoint); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrDiscovery, err)
+		}
+	}
+	if document.UserInfoEndpoint != "" {
+		if err := validateEndpointURL(document.UserInfoEndpoint); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrDiscovery, err)
+		}
+	}
+
+	client.mu.Lock()
+	client.discoveries[resolvedIssuer] = cachedDiscovery{document: document, expiresAt: client.now().Add(discoveryCacheTTL)}
+	client.mu.Unlock()
+
+	return &document, nil
+}
+
+// AuthorizeURL builds the URL that sends the browser to the provider's authorization endpoint.
+func (client *Client) AuthorizeURL(
+	ctx context.Context,
+	provider Provider,
+	redirectURI string,
+	request AuthRequest,
+) (string, error) {
+	document, err := client.Discover(ctx, provider.Issuer)
+	if err != nil {
+		return "", err
+	}
+
+	parsed, err := url.Parse(document.AuthorizationEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrDiscovery, err)
+	}
+
+	scopes := provider.Scopes
+	if len(scopes) == 0 {
+		scopes = DefaultScopes
+	}
+
+	query := parsed.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", provider.ClientID)
+	query.Set("redirect_uri", strings.TrimSpace(redirectURI))
+	query.Set("scope", strings.Join(scopes, " "))
+	query.Set("state", request.State)
+	query.Set("nonce", request.Nonce)
+	query.Set("code_challenge", CodeChallenge(request.CodeVerifier))
+	query.Set("code_challenge_method", codeChallengeMethod)
+	parsed.RawQuery = query.Encode()
+
+	return parsed.String(), nil
+}
+
+// Authenticate redeems an authorization code and returns the verified identity. The ID token must carry the nonce of
+// request; the userinfo endpoint fills in the email and profile when the ID token leaves them out.
+func (client *Client) Authenticate(
+	ctx context.Context,
+	provider Provider,
+	code string,
+	redirectURI string,
+	request AuthRequest,
+) (*Claims, error) {
+	document, err := client.Discover(ctx, provider.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := client.exchangeCode(ctx, document, provider, code, redirectURI, request.CodeVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := client.verifyIDToken(ctx, document, provider, token.IDToken, request.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Email == "" && document.UserInfoEndpoint != "" && strings.TrimSpace(token.AccessToken) != "" {
+		if err := client.completeFromUserInfo(ctx, document, token.AccessToken, claims); err != nil {
+			return nil, err
+		}
+	}
+
+	return claims, nil
+}
+
+// VerifyIDToken checks the signature, issuer, audience, lifetime and nonce of an ID token.
+func (client *Client) VerifyIDToken(ctx context.Context, provider Provider, rawIDToken, nonce string) (*Claims, error) {
+	document, err := client.Discover(ctx, provider.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.verifyIDToken(ctx, document, provider, rawIDToken, nonce)
+}
+
+func (client *Client) exchangeCode(
+	ctx context.Context,
+	document *Discovery,
+	provider Provider,
+	code string,
+	redirectURI string,
+	codeVerifier string,
+) (*tokenResponse, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", strings.TrimSpace(code))
+	form.Set("redirect_uri", strings.TrimSpace(redirectURI))
+	form.Set("code_verifier", codeVerifier)
+
+	useBasicAuth := provider.ClientSecret != "" && supportsClientSecretBasic(document.TokenEndpointAuthMethodsSupported)
+	if !useBasicAuth {
+		form.Set("client_id", provider.ClientID)
+		if provider.ClientSecret != "" {
+			form.Set("client_secret", provider.ClientSecret)
+		}
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, document.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrTokenExchange, err)
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+	if useBasicAuth {
+		request.SetBasicAuth(url.QueryEscape(provider.ClientID), url.QueryEscape(provider.ClientSecret))
+	}
+
+	var payload tokenResponse
+	if err := client.doJSON(request, &payload); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrTokenExchange, err)
+	}
+	if strings.TrimSpace(payload.IDToken) == "" {
+		return nil, fmt.Errorf("%w: token response has no id_token", ErrTokenExchange)
+	}
+
+	return &payload, nil
+}
+
+func (client *Client) completeFromUserInfo(ctx context.Context, document *Discovery, accessToken string, claims *Claims) error {
+	var payload idTokenClaims
+	if err := client.getJSON(ctx, document.UserInfoEndpoint, accessToken, &payload); err != nil {
+		return fmt.Errorf("%w: %v", ErrUserInfo, err)
+	}
+	// The userinfo response must describe the same user as the ID token, or it could be a token substitution.
+	if payload.Subject != claims.Subject {
+		return fmt.Errorf("%w: subject mismatch", ErrUserInfo)
+	}
+
+	claims.Email = strings.TrimSpace(payload.Email)
+	claims.EmailVerified = bool(payload.EmailVerified)
+	if claims.Name == "" {
+		claims.Name = strings.TrimSpace(payload.Name)
+	}
+	if claims.PreferredUsername == "" {
+		claims.PreferredUsername = strings.TrimSpace(payload.PreferredUsername)
+	}
+	if claims.Picture == "" {
+		claims.Picture = strings.TrimSpace(payload.Picture)
+	}
+
+	return nil
+}
+
+func (client *Client) getJSON(ctx context.Context, endpoint, bearerToken string, target any) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "application/json")
+	if bearerToken != "" {
+		request.Header.Set("Authorization", "Bearer "+bearerToken)
+	}
+
+	return client.doJSON(request, target)
+}
+
+func (client *Client) doJSON(request *http.Request, target any) error {
+	response, err := client.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status %d from %s", response.StatusCode, request.URL.Redacted())
+	}
+
+	return json.NewDecoder(io.LimitReader(response.Body, maxResponseBytes)).Decode(target)
+}
+
+// supportsClientSecretBasic follows the discovery default of client_secret_basic and only switches to
+// client_secret_post when the provider lists post but not basic.
+func supportsClientSecretBasic(methods []string) bool {
+	return slices.Contains(methods, authMethodClientSecretBasic) || !slices.Contains(methods, authMethodClientSecretPost)
+}
+
+// validateEndpointURL requires https, allowing plain http only for loopback hosts so a local issuer can be used in
+// development and tests.
+func validateEndpointURL(value string) error {
+	parsed, err := url.Parse(strings.TrimSpace(value))
+	if err != nil || parsed.Host == "" {
+		return fmt.Errorf("%w: %q is not an absolute url", ErrInsecureURL, value)
+	}
+	if parsed.Scheme == "https" {
+		return nil
+	}
+	if parsed.Scheme == "http" && isLoopbackHost(parsed.Hostname()) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %q", ErrInsecureURL, value)
+}
+
+func isLoopbackHost(host string) bool {
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func constantTimeEqual(left, right string) bool {
+	return subtle.ConstantTimeCompare([]byte(left), []byte(right)) == 1
+}
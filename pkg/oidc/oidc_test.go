@@ -0,0 +1,249 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"suaybsimsek.com/blog-api/pkg/oidc/oidctest"
+)
+
+const testRedirectURI = "https://blog.example.com/api/oidc/callback"
+
+func testProvider(issuer *oidctest.Issuer) Provider {
+	return Provider{Issuer: issuer.URL(), ClientID: issuer.ClientID, ClientSecret: issuer.ClientSecret}
+}
+
+func signIn(t *testing.T, client *Client, issuer *oidctest.Issuer, request AuthRequest) url.Values {
+	t.Helper()
+
+	authorizeURL, err := client.AuthorizeURL(context.Background(), testProvider(issuer), testRedirectURI, request)
+	if err != nil {
+		t.Fatalf("AuthorizeURL returned error: %v", err)
+	}
+	callback := issuer.Authorize(t, authorizeURL)
+	if callback.Get("error") != "" || callback.Get("state") != request.State {
+		t.Fatalf("unexpected callback %v", callback)
+	}
+	return callback
+}
+
+func TestAuthenticateRunsAuthorizationCodeFlowWithPKCE(t *testing.T) {
+	issuer := oidctest.NewIssuer(t)
+	client := NewClient(nil)
+
+	request, err := NewAuthRequest()
+	if err != nil {
+		t.Fatalf("NewAuthRequest returned error: %v", err)
+	}
+	authorizeURL, err := client.AuthorizeURL(context.Background(), testProvider(issuer), testRedirectURI, request)
+	if err != nil {
+		t.Fatalf("AuthorizeURL returned error: %v", err)
+	}
+	query, _ := url.Parse(authorizeURL)
+	if query.Query().Get("code_challenge") != CodeChallenge(request.CodeVerifier) ||
+		query.Query().Get("nonce") != request.Nonce ||
+		strings.Contains(authorizeURL, request.CodeVerifier) {
+		t.Fatalf("unexpected authorize url %s", authorizeURL)
+	}
+
+	callback := issuer.Authorize(t, authorizeURL)
+	claims, err := client.Authenticate(context.Background(), testProvider(issuer), callback.Get("code"), testRedirectURI, request)
+	if err != nil {
+		t.Fatalf("Authenticate returned error: %v", err)
+	}
+	if claims.Subject != "oidc-user-1" || claims.Email != "reader@example.com" || !claims.EmailVerified || claims.Name != "OIDC Reader" {
+		t.Fatalf("unexpected claims %#v", claims)
+	}
+
+	// Codes are single use.
+	if _, err := client.Authenticate(context.Background(), testProvider(issuer), callback.Get("code"), testRedirectURI, request); !errors.Is(err, ErrTokenExchange) {
+		t.Fatalf("expected reused code to fail, got %v", err)
+	}
+}
+
+func TestAuthenticateRejectsWrongVerifierAndNonce(t *testing.T) {
+	issuer := oidctest.NewIssuer(t)
+	client := NewClient(nil)
+
+	request, _ := NewAuthRequest()
+	callback := signIn(t, client, issuer, request)
+	wrongVerifier := request
+	wrongVerifier.CodeVerifier = "not-the-verifier"
+	if _, err := client.Authenticate(context.Background(), testProvider(issuer), callback.Get("code"), testRedirectURI, wrongVerifier); !errors.Is(err, ErrTokenExchange) {
+		t.Fatalf("expected PKCE failure, got %v", err)
+	}
+
+	callback = signIn(t, client, issuer, request)
+	wrongNonce := request
+	wrongNonce.Nonce = "replayed"
+	if _, err := client.Authenticate(context.Background(), testProvider(issuer), callback.Get("code"), testRedirectURI, wrongNonce); !errors.Is(err, ErrNonceMismatch) {
+		t.Fatalf("expected nonce mismatch, got %v", err)
+	}
+}
+
+func TestAuthenticateValidatesIDTokenClaims(t *testing.T) {
+	cases := map[string]func(claims map[string]any){
+		"wrong audience":       func(claims map[string]any) { claims["aud"] = "other-client" },
+		"wrong issuer":         func(claims map[string]any) { claims["iss"] = "https://evil.example.com" },
+		"expired":              func(claims map[string]any) { claims["exp"] = time.Now().Add(-time.Hour).Unix() },
+		"missing subject":      func(claims map[string]any) { delete(claims, "sub") },
+		"foreign azp":          func(claims map[string]any) { claims["aud"] = []string{"test-client", "other"}; claims["azp"] = "other" },
+		"issued in the future": func(claims map[string]any) { claims["iat"] = time.Now().Add(time.Hour).Unix() },
+	}
+
+	for name, tamper := range cases {
+		t.Run(name, func(t *testing.T) {
+			issuer := oidctest.NewIssuer(t)
+			issuer.Tamper = tamper
+			client := NewClient(nil)
+
+			request, _ := NewAuthRequest()
+			callback := signIn(t, client, issuer, request)
+			if _, err := client.Authenticate(context.Background(), testProvider(issuer), callback.Get("code"), testRedirectURI, request); !errors.Is(err, ErrInvalidIDToken) {
+				t.Fatalf("expected invalid id token, got %v", err)
+			}
+		})
+	}
+}
+
+func TestVerifyIDTokenRefreshesKeysAfterRotationAndRejectsForgeries(t *testing.T) {
+	issuer := oidctest.NewIssuer(t)
+	client := NewClient(nil)
+	now := time.Now()
+	client.now = func() time.Time { return now }
+	provider := testProvider(issuer)
+	claims := map[string]any{
+		"iss":   issuer.URL(),
+		"sub":   "user-1",
+		"aud":   []string{issuer.ClientID},
+		"exp":   now.Add(time.Hour).Unix(),
+		"iat":   now.Unix(),
+		"nonce": "nonce-1",
+	}
+
+	if _, err := client.VerifyIDToken(context.Background(), provider, issuer.SignIDToken(t, claims), "nonce-1"); err != nil {
+		t.Fatalf("VerifyIDToken returned error: %v", err)
+	}
+
+	// The cached JWKS does not know the new kid, so the client has to refetch it.
+	issuer.RotateKey(t)
+	now = now.Add(keySetMinRefetchInterval)
+	rotated := issuer.SignIDToken(t, claims)
+	if _, err := client.VerifyIDToken(context.Background(), provider, rotated, "nonce-1"); err != nil {
+		t.Fatalf("expected rotated key to verify, got %v", err)
+	}
+
+	parts := strings.Split(rotated, ".")
+	forged := parts[0] + "." + encoding.EncodeToString([]byte(`{"iss":"`+issuer.URL()+`","sub":"admin","aud":"test-client","exp":9999999999,"nonce":"nonce-1"}`)) + "." + parts[2]
+	if _, err := client.VerifyIDToken(context.Background(), provider, forged, "nonce-1"); !errors.Is(err, ErrInvalidIDToken) {
+		t.Fatalf("expected forged token to fail, got %v", err)
+	}
+
+	unsigned := encoding.EncodeToString([]byte(`{"alg":"none"}`)) + "." + parts[1] + "."
+	if _, err := client.VerifyIDToken(context.Background(), provider, unsigned, "nonce-1"); !errors.Is(err, ErrInvalidIDToken) {
+		t.Fatalf("expected alg none to fail, got %v", err)
+	}
+}
+
+func TestVerifyIDTokenThrottlesJWKSRefetchForUnknownKeyIDs(t *testing.T) {
+	issuer := oidctest.NewIssuer(t)
+	client := NewClient(nil)
+	now := time.Now()
+	client.now = func() time.Time { return now }
+	provider := testProvider(issuer)
+	claims := map[string]any{
+		"iss":   issuer.URL(),
+		"sub":   "user-1",
+		"aud":   []string{issuer.ClientID},
+		"exp":   now.Add(time.Hour).Unix(),
+		"iat":   now.Unix(),
+		"nonce": "nonce-1",
+	}
+
+	valid := issuer.SignIDToken(t, claims)
+	if _, err := client.VerifyIDToken(context.Background(), provider, valid, "nonce-1"); err != nil {
+		t.Fatalf("VerifyIDToken returned error: %v", err)
+	}
+	parts := strings.Split(valid, ".")
+	withKeyID := func(keyID string) string {
+		return encoding.EncodeToString([]byte(`{"alg":"RS256","kid":"`+keyID+`"}`)) + "." + parts[1] + "." + parts[2]
+	}
+	verifyForged := func(keyID string, wantRequests int) {
+		t.Helper()
+		if _, err := client.VerifyIDToken(context.Background(), provider, withKeyID(keyID), "nonce-1"); !errors.Is(err, ErrInvalidIDToken) {
+			t.Fatalf("expected kid %q to fail, got %v", keyID, err)
+		}
+		if got := issuer.JWKSRequests(); got != wantRequests {
+			t.Fatalf("after kid %q: JWKS fetched %d times, want %d", keyID, got, wantRequests)
+		}
+	}
+
+	// Right after the first fetch, unknown kids fail without reaching the provider.
+	verifyForged("forged-1", 1)
+	verifyForged("forged-2", 1)
+
+	// Once the interval has passed one refetch is allowed, and the kid it did not find stays unknown.
+	now = now.Add(keySetMinRefetchInterval)
+	verifyForged("forged-1", 2)
+	now = now.Add(keySetMinRefetchInterval)
+	verifyForged("forged-1", 2)
+	verifyForged("forged-2", 3)
+	verifyForged("forged-3", 3)
+
+	// The cached set expiring clears the unknown kids.
+	now = now.Add(keySetCacheTTL)
+	verifyForged("forged-1", 4)
+}
+
+func TestAuthenticateFetchesEmailFromUserInfo(t *testing.T) {
+	issuer := oidctest.NewIssuer(t)
+	issuer.OmitEmailFromIDToken = true
+	issuer.User.Picture = "https://cdn.example.com/avatar.png"
+	client := NewClient(nil)
+
+	request, _ := NewAuthRequest()
+	callback := signIn(t, client, issuer, request)
+	claims, err := client.Authenticate(context.Background(), testProvider(issuer), callback.Get("code"), testRedirectURI, request)
+	if err != nil {
+		t.Fatalf("Authenticate returned error: %v", err)
+	}
+	if claims.Email != "reader@example.com" || !claims.EmailVerified || claims.Picture != "https://cdn.example.com/avatar.png" {
+		t.Fatalf("expected userinfo claims, got %#v", claims)
+	}
+}
+
+func TestDiscoverRequiresHTTPSAndMatchingIssuer(t *testing.T) {
+	client := NewClient(nil)
+	if _, err := client.Discover(context.Background(), "http://idp.example.com"); !errors.Is(err, ErrInsecureURL) {
+		t.Fatalf("expected insecure issuer to be rejected, got %v", err)
+	}
+
+	issuer := oidctest.NewIssuer(t)
+	if _, err := client.Discover(context.Background(), issuer.URL()+"/"); err != nil {
+		t.Fatalf("expected trailing slash to be ignored, got %v", err)
+	}
+	if _, err := client.Discover(context.Background(), issuer.URL()+"/realms/other"); !errors.Is(err, ErrDiscovery) {
+		t.Fatalf("expected unknown issuer to fail discovery, got %v", err)
+	}
+}
+
+func TestSupportsClientSecretBasic(t *testing.T) {
+	cases := map[string]struct {
+		methods  []string
+		expected bool
+	}{
+		"default":    {nil, true},
+		"basic":      {[]string{"client_secret_post", "client_secret_basic"}, true},
+		"post only":  {[]string{"client_secret_post"}, false},
+		"other only": {[]string{"private_key_jwt"}, true},
+	}
+	for name, testCase := range cases {
+		if supportsClientSecretBasic(testCase.methods) != testCase.expected {
+			t.Fatalf("%s: expected %v", name, testCase.expected)
+		}
+	}
+}
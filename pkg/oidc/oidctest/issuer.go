@@ -0,0 +1,361 @@
+// Package oidctest runs an in-process OpenID Connect issuer for tests. It publishes a discovery document and JWKS,
+// approves every authorization request for the configured user, enforces PKCE at the token endpoint and signs ID
+// tokens with RS256.
+package oidctest
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	DefaultClientID     = "test-client"
+	DefaultClientSecret = "test-secret"
+)
+
+var encoding = base64.RawURLEncoding
+
+// User is the identity the issuer signs in.
+type User struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Picture       string
+}
+
+// Issuer is a running mock provider. Fields may be changed between sign-ins.
+type Issuer struct {
+	ClientID     string
+	ClientSecret string
+	User         User
+	// OmitEmailFromIDToken moves the email claims to the userinfo endpoint only, like providers that keep the ID
+	// token small.
+	OmitEmailFromIDToken bool
+	// Tamper, when set, can change the ID token claims before they are signed.
+	Tamper func(claims map[string]any)
+
+	server *httptest.Server
+
+	mu           sync.Mutex
+	key          *rsa.PrivateKey
+	keyID        string
+	keyVersion   int
+	codes        map[string]authorization
+	accessTokens map[string]User
+	jwksRequests int
+}
+
+type authorization struct {
+	redirectURI   string
+	codeChallenge string
+	nonce         string
+	user          User
+}
+
+// NewIssuer starts an issuer that is shut down when the test ends.
+func NewIssuer(t testing.TB) *Issuer {
+	t.Helper()
+
+	issuer := &Issuer{
+		ClientID:     DefaultClientID,
+		ClientSecret: DefaultClientSecret,
+		User: User{
+			Subject:       "oidc-user-1",
+			Email:         "reader@example.com",
+			EmailVerified: true,
+			Name:          "OIDC Reader",
+		},
+		codes:        make(map[string]authorization),
+		accessTokens: make(map[string]User),
+	}
+	issuer.RotateKey(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", issuer.handleDiscovery)
+	mux.HandleFunc("/authorize", issuer.handleAuthorize)
+	mux.HandleFunc("/token", issuer.handleToken)
+	mux.HandleFunc("/jwks", issuer.handleJWKS)
+	mux.HandleFunc("/userinfo", issuer.handleUserInfo)
+	issuer.server = httptest.NewServer(mux)
+	t.Cleanup(issuer.server.Close)
+
+	return issuer
+}
+
+// URL is the issuer identifier, which is also the base URL of every endpoint.
+func (issuer *Issuer) URL() string {
+	return issuer.server.URL
+}
+
+// RotateKey replaces the signing key with a new one under a new kid.
+func (issuer *Issuer) RotateKey(t testing.TB) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate issuer key: %v", err)
+	}
+
+	issuer.mu.Lock()
+	defer issuer.mu.Unlock()
+	issuer.keyVersion++
+	issuer.key = key
+	issuer.keyID = "test-key-" + strconv.Itoa(issuer.keyVersion)
+}
+
+// JWKSRequests reports how many times the JWKS has been fetched.
+func (issuer *Issuer) JWKSRequests() int {
+	issuer.mu.Lock()
+	defer issuer.mu.Unlock()
+
+	return issuer.jwksRequests
+}
+
+// Authorize plays the browser: it requests authorizeURL and returns the query of the redirect back to the client,
+// which carries either code and state or an error.
+func (issuer *Issuer) Authorize(t testing.TB, authorizeURL string) url.Values {
+	t.Helper()
+
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	response, err := client.Get(authorizeURL)
+	if err != nil {
+		t.Fatalf("authorize request failed: %v", err)
+	}
+	_ = response.Body.Close()
+	if response.StatusCode != http.StatusFound {
+		t.Fatalf("authorize returned status %d", response.StatusCode)
+	}
+
+	location, err := url.Parse(response.Header.Get("Location"))
+	if err != nil {
+		t.Fatalf("authorize returned invalid redirect: %v", err)
+	}
+	return location.Query()
+}
+
+// SignIDToken signs arbitrary claims with the current key, for tests that hand-craft tokens.
+func (issuer *Issuer) SignIDToken(t testing.TB, claims map[string]any) string {
+	t.Helper()
+
+	token, err := issuer.sign(claims)
+	if err != nil {
+		t.Fatalf("sign id token: %v", err)
+	}
+	return token
+}
+
+func (issuer *Issuer) handleDiscovery(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                issuer.URL(),
+		"authorization_endpoint":                issuer.URL() + "/authorize",
+		"token_endpoint":                        issuer.URL() + "/token",
+		"userinfo_endpoint":                     issuer.URL() + "/userinfo",
+		"jwks_uri":                              issuer.URL() + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{"S256"},
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post"},
+	})
+}
+
+func (issuer *Issuer) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	redirectURI, err := url.Parse(query.Get("redirect_uri"))
+	if err != nil || redirectURI.Host == "" || query.Get("client_id") != issuer.ClientID {
+		http.Error(w, "invalid client", http.StatusBadRequest)
+		return
+	}
+
+	callback := redirectURI.Query()
+	callback.Set("state", query.Get("state"))
+	if query.Get("response_type") != "code" ||
+		query.Get("code_challenge_method") != "S256" ||
+		query.Get("code_challenge") == "" ||
+		!strings.Contains(" "+query.Get("scope")+" ", " openid ") {
+		callback.Set("error", "invalid_request")
+	} else {
+		code := randomValue()
+		issuer.mu.Lock()
+		issuer.codes[code] = authorization{
+			redirectURI:   redirectURI.String(),
+			codeChallenge: query.Get("code_challenge"),
+			nonce:         query.Get("nonce"),
+			user:          issuer.User,
+		}
+		issuer.mu.Unlock()
+		callback.Set("code", code)
+	}
+
+	redirectURI.RawQuery = callback.Encode()
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+func (issuer *Issuer) handleToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.ParseForm() != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+	if !issuer.authenticateClient(r) {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	code := r.PostForm.Get("code")
+	issuer.mu.Lock()
+	pending, ok := issuer.codes[code]
+	delete(issuer.codes, code)
+	issuer.mu.Unlock()
+
+	challenge := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if !ok ||
+		r.PostForm.Get("grant_type") != "authorization_code" ||
+		r.PostForm.Get("redirect_uri") != pending.redirectURI ||
+		encoding.EncodeToString(challenge[:]) != pending.codeChallenge {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	now := time.Now()
+	claims := map[string]any{
+		"iss":   issuer.URL(),
+		"sub":   pending.user.Subject,
+		"aud":   issuer.ClientID,
+		"iat":   now.Unix(),
+		"exp":   now.Add(5 * time.Minute).Unix(),
+		"nonce": pending.nonce,
+		"name":  pending.user.Name,
+	}
+	if pending.user.Picture != "" {
+		claims["picture"] = pending.user.Picture
+	}
+	if !issuer.OmitEmailFromIDToken {
+		claims["email"] = pending.user.Email
+		claims["email_verified"] = pending.user.EmailVerified
+	}
+	if issuer.Tamper != nil {
+		issuer.Tamper(claims)
+	}
+
+	idToken, err := issuer.sign(claims)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+
+	accessToken := randomValue()
+	issuer.mu.Lock()
+	issuer.accessTokens[accessToken] = pending.user
+	issuer.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": accessToken,
+		"token_type":   "Bearer",
+		"expires_in":   300,
+		"id_token":     idToken,
+	})
+}
+
+func (issuer *Issuer) handleJWKS(w http.ResponseWriter, _ *http.Request) {
+	issuer.mu.Lock()
+	issuer.jwksRequests++
+	publicKey := issuer.key.PublicKey
+	keyID := issuer.keyID
+	issuer.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": keyID,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   encoding.EncodeToString(publicKey.N.Bytes()),
+			"e":   encoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+		}},
+	})
+}
+
+func (issuer *Issuer) handleUserInfo(w http.ResponseWriter, r *http.Request) {
+	accessToken := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	issuer.mu.Lock()
+	user, ok := issuer.accessTokens[accessToken]
+	issuer.mu.Unlock()
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"sub":            user.Subject,
+		"email":          user.Email,
+		"email_verified": user.EmailVerified,
+		"name":           user.Name,
+		"picture":        user.Picture,
+	})
+}
+
+func (issuer *Issuer) authenticateClient(r *http.Request) bool {
+	clientID, clientSecret, ok := r.BasicAuth()
+	if ok {
+		clientID, _ = url.QueryUnescape(clientID)
+		clientSecret, _ = url.QueryUnescape(clientSecret)
+	} else {
+		clientID = r.PostForm.Get("client_id")
+		clientSecret = r.PostForm.Get("client_secret")
+	}
+
+	return clientID == issuer.ClientID &&
+		subtle.ConstantTimeCompare([]byte(clientSecret), []byte(issuer.ClientSecret)) == 1
+}
+
+func (issuer *Issuer) sign(claims map[string]any) (string, error) {
+	issuer.mu.Lock()
+	key := issuer.key
+	keyID := issuer.keyID
+	issuer.mu.Unlock()
+
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": keyID})
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := encoding.EncodeToString(header) + "." + encoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+
+	return signingInput + "." + encoding.EncodeToString(signature), nil
+}
+
+func randomValue() string {
+	buffer := make([]byte, 24)
+	_, _ = rand.Read(buffer)
+	return encoding.EncodeToString(buffer)
+}
+
+func writeJSON(w http.ResponseWriter, status int, payload any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(payload)
+}
@@ -0,0 +1,120 @@
+// Package totp implements RFC 6238 time-based one-time passwords with the parameters authenticator apps expect:
+// HMAC-SHA1, six digits and a thirty second step.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	Digits      = 6
+	Period      = 30 * time.Second
+	secretBytes = 20
+)
+
+var (
+	ErrInvalidSecret = errors.New("invalid totp secret")
+
+	secretEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+)
+
+// GenerateSecret returns a random 160-bit secret encoded as unpadded base32.
+func GenerateSecret() (string, error) {
+	buffer := make([]byte, secretBytes)
+	if _, err := rand.Read(buffer); err != nil {
+		return "", err
+	}
+
+	return secretEncoding.EncodeToString(buffer), nil
+}
+
+// ProvisioningURI builds the otpauth:// URI that authenticator apps import, usually by scanning it as a QR code.
+func ProvisioningURI(issuer, accountName, secret string) string {
+	resolvedIssuer := strings.TrimSpace(issuer)
+	label := strings.TrimSpace(accountName)
+	if resolvedIssuer != "" {
+		label = resolvedIssuer + ":" + label
+	}
+
+	query := url.Values{}
+	query.Set("secret", strings.TrimSpace(secret))
+	if resolvedIssuer != "" {
+		query.Set("issuer", resolvedIssuer)
+	}
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", strconv.Itoa(Digits))
+	query.Set("period", strconv.Itoa(int(Period.Seconds())))
+
+	return (&url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + label,
+		RawQuery: query.Encode(),
+	}).String()
+}
+
+// Step returns the RFC 6238 time step counter for the given time.
+func Step(now time.Time) int64 {
+	return now.UTC().Unix() / int64(Period.Seconds())
+}
+
+// Code returns the one-time password for a time step.
+func Code(secret string, step int64) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(step))
+	mac := hmac.New(sha1.New, key)
+	_, _ = mac.Write(counter[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", Digits, value%1_000_000), nil
+}
+
+// Validate reports the time step a code belongs to, accepting codes up to skew steps before or after now. Callers
+// should reject steps at or before the last accepted one to stop a code being replayed.
+func Validate(secret, code string, now time.Time, skew int) (int64, bool) {
+	resolvedCode := strings.ReplaceAll(strings.TrimSpace(code), " ", "")
+	if len(resolvedCode) != Digits {
+		return 0, false
+	}
+
+	current := Step(now)
+	for offset := -skew; offset <= skew; offset++ {
+		step := current + int64(offset)
+		expected, err := Code(secret, step)
+		if err != nil {
+			return 0, false
+		}
+		if hmac.Equal([]byte(expected), []byte(resolvedCode)) {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	normalized := strings.ToUpper(strings.TrimRight(strings.ReplaceAll(strings.TrimSpace(secret), " ", ""), "="))
+	key, err := secretEncoding.DecodeString(normalized)
+	if err != nil || len(key) == 0 {
+		return nil, ErrInvalidSecret
+	}
+
+	return key, nil
+}
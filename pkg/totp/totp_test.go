@@ -0,0 +1,78 @@
+package totp
+
+import (
+	"encoding/base32"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA1 seed from RFC 6238 appendix B.
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestCodeMatchesRFC6238Vectors(t *testing.T) {
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+	}
+
+	for _, tc := range tests {
+		got, err := Code(rfcSecret, Step(time.Unix(tc.unix, 0)))
+		if err != nil {
+			t.Fatalf("Code returned error: %v", err)
+		}
+		if got != tc.want {
+			t.Fatalf("Code(%d) = %q, want %q", tc.unix, got, tc.want)
+		}
+	}
+}
+
+func TestValidateAcceptsSkewAndReturnsStep(t *testing.T) {
+	now := time.Unix(1111111109, 0)
+	previous, err := Code(rfcSecret, Step(now)-1)
+	if err != nil {
+		t.Fatalf("Code returned error: %v", err)
+	}
+
+	step, ok := Validate(rfcSecret, previous[:3]+" "+previous[3:], now, 1)
+	if !ok || step != Step(now)-1 {
+		t.Fatalf("expected previous step to validate, got %d %v", step, ok)
+	}
+	if _, ok := Validate(rfcSecret, previous, now, 0); ok {
+		t.Fatal("expected previous step to fail without skew")
+	}
+	if _, ok := Validate(rfcSecret, "12345", now, 1); ok {
+		t.Fatal("expected short code to fail")
+	}
+	if _, ok := Validate("not base32!", "123456", now, 1); ok {
+		t.Fatal("expected invalid secret to fail")
+	}
+}
+
+func TestGenerateSecretAndProvisioningURI(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret returned error: %v", err)
+	}
+	if len(secret) != 32 || strings.Contains(secret, "=") {
+		t.Fatalf("unexpected secret %q", secret)
+	}
+
+	parsed, err := url.Parse(ProvisioningURI("Blog Admin", "owner@example.com", secret))
+	if err != nil {
+		t.Fatalf("url.Parse returned error: %v", err)
+	}
+	if parsed.Scheme != "otpauth" || parsed.Host != "totp" || parsed.Path != "/Blog Admin:owner@example.com" {
+		t.Fatalf("unexpected provisioning uri %s", parsed)
+	}
+	query := parsed.Query()
+	if query.Get("secret") != secret || query.Get("issuer") != "Blog Admin" || query.Get("digits") != "6" {
+		t.Fatalf("unexpected provisioning query %v", query)
+	}
+}
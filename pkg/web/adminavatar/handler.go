@@ -11,7 +11,14 @@ import (
 	"suaybsimsek.com/blog-api/pkg/httpapi"
 )
 
-func Handler(w http.ResponseWriter, r *http.Request) {
+// NewHandler returns the endpoint that serves admin avatars.
+func NewHandler(services *service.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		handle(w, r, services)
+	}
+}
+
+func handle(w http.ResponseWriter, r *http.Request, services *service.Service) {
 	r = httpapi.EnsureRequestContext(w, r)
 	if r == nil {
 		httpapi.WriteErrorWithContext(context.Background(), w, apperrors.Internal("invalid request context", nil))
@@ -49,7 +56,7 @@ func Handler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	asset, err := service.ResolveAdminAvatarAsset(
+	asset, err := services.ResolveAdminAvatarAsset(
 		r.Context(),
 		userID,
 		size,
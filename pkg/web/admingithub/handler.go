@@ -33,11 +33,19 @@ type githubOAuthState struct {
 	Intent     string `json:"intent"`
 	Locale     string `json:"locale"`
 	UserID     string `json:"userId,omitempty"`
+	Invitation string `json:"invitation,omitempty"`
 	RememberMe bool   `json:"rememberMe,omitempty"`
 	ExpiresAt  int64  `json:"exp"`
 }
 
-func Handler(w http.ResponseWriter, r *http.Request) {
+// NewHandler returns the GitHub OAuth callback of the admin sign-in, link and invitation flows.
+func NewHandler(services *appservice.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		handle(w, r, services)
+	}
+}
+
+func handle(w http.ResponseWriter, r *http.Request, services *appservice.Service) {
 	r = httpapi.EnsureRequestContext(w, r)
 	if r == nil {
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
@@ -46,23 +54,31 @@ func Handler(w http.ResponseWriter, r *http.Request) {
 
 	switch r.URL.Path {
 	case githubOAuthCallbackPath:
-		handleGithubOAuthCallback(w, r)
+		handleGithubOAuthCallback(w, r, services)
 	default:
 		http.NotFound(w, r)
 	}
 }
 
-func Start(w http.ResponseWriter, r *http.Request) {
+// NewStartHandler returns the handler that sends an admin to GitHub to sign in, link an account or accept an
+// invitation.
+func NewStartHandler(services *appservice.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		start(w, r, services)
+	}
+}
+
+func start(w http.ResponseWriter, r *http.Request, services *appservice.Service) {
 	r = httpapi.EnsureRequestContext(w, r)
 	if r == nil {
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
 	}
 
-	handleGithubOAuthStart(w, r)
+	handleGithubOAuthStart(w, r, services)
 }
 
-func handleGithubOAuthStart(w http.ResponseWriter, r *http.Request) {
+func handleGithubOAuthStart(w http.ResponseWriter, r *http.Request, services *appservice.Service) {
 	if r.Method != http.MethodGet {
 		w.Header().Set("Allow", http.MethodGet)
 		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
@@ -83,7 +99,7 @@ func handleGithubOAuthStart(w http.ResponseWriter, r *http.Request) {
 	}
 
 	intent := strings.TrimSpace(strings.ToLower(r.URL.Query().Get("intent")))
-	if intent != "connect" && intent != "login" {
+	if intent != "connect" && intent != "login" && intent != "invite" {
 		intent = "login"
 	}
 
@@ -93,12 +109,18 @@ func handleGithubOAuthStart(w http.ResponseWriter, r *http.Request) {
 		ExpiresAt: time.Now().UTC().Add(githubOAuthStateTTL).Unix(),
 	}
 	if intent == "connect" {
-		adminUser, err := resolveAuthenticatedAdminUser(r)
+		adminUser, err := resolveAuthenticatedAdminUser(r, services)
 		if err != nil || adminUser == nil || strings.TrimSpace(adminUser.ID) == "" {
 			redirectToAdminFlow(w, r, state.Locale, intent, "failed")
 			return
 		}
 		state.UserID = strings.TrimSpace(adminUser.ID)
+	} else if intent == "invite" {
+		state.Invitation = strings.TrimSpace(r.URL.Query().Get("token"))
+		if state.Invitation == "" {
+			redirectToAdminFlow(w, r, state.Locale, intent, "invalid-link")
+			return
+		}
 	} else {
 		state.RememberMe = resolveBooleanQueryValue(r.URL.Query().Get("rememberMe"))
 	}
@@ -127,7 +149,7 @@ func handleGithubOAuthStart(w http.ResponseWriter, r *http.Request) {
 	http.Redirect(w, r, authorizeURL, http.StatusTemporaryRedirect)
 }
 
-func handleGithubOAuthCallback(w http.ResponseWriter, r *http.Request) {
+func handleGithubOAuthCallback(w http.ResponseWriter, r *http.Request, services *appservice.Service) {
 	if r.Method != http.MethodGet {
 		w.Header().Set("Allow", http.MethodGet)
 		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
@@ -188,13 +210,29 @@ func handleGithubOAuthCallback(w http.ResponseWriter, r *http.Request) {
 
 	switch intent {
 	case "connect":
-		if _, err := appservice.LinkAdminGithubAccount(ctx, state.UserID, identity); err != nil {
+		if _, err := services.LinkAdminGithubAccount(ctx, state.UserID, identity); err != nil {
 			redirectToAdminFlow(w, r, locale, intent, mapGithubOAuthErrorToStatus(err, intent))
 			return
 		}
 		redirectToAdminFlow(w, r, locale, intent, "connected")
+	case "invite":
+		if _, err := services.AcceptAdminInvitationWithGithub(ctx, state.Invitation, identity); err != nil {
+			redirectToAdminFlow(w, r, locale, intent, mapGithubOAuthErrorToStatus(err, intent))
+			return
+		}
+		payload, err := services.LoginAdminWithGithubSubject(ctx, identity.Subject, false, resolveAdminSessionMetadata(ctx, r))
+		if err != nil {
+			redirectToAdminFlow(w, r, locale, intent, mapGithubOAuthErrorToStatus(err, intent))
+			return
+		}
+		if payload.MFARequired {
+			redirectToAdminMFA(w, r, locale, payload.MFAToken)
+			return
+		}
+		setAdminSessionCookies(w, adminConfig, payload)
+		http.Redirect(w, r, "/"+locale+"/admin", http.StatusSeeOther)
 	default:
-		payload, err := appservice.LoginAdminWithGithubSubject(
+		payload, err := services.LoginAdminWithGithubSubject(
 			ctx,
 			identity.Subject,
 			state.RememberMe,
@@ -204,6 +242,10 @@ func handleGithubOAuthCallback(w http.ResponseWriter, r *http.Request) {
 			redirectToAdminFlow(w, r, locale, intent, mapGithubOAuthErrorToStatus(err, intent))
 			return
 		}
+		if payload.MFARequired {
+			redirectToAdminMFA(w, r, locale, payload.MFAToken)
+			return
+		}
 		setAdminSessionCookies(w, adminConfig, payload)
 		http.Redirect(w, r, "/"+locale+"/admin", http.StatusSeeOther)
 	}
@@ -230,7 +272,7 @@ func buildGithubAuthorizeURL(config appconfig.AdminGithubConfig, redirectURI, st
 	return parsed.String(), nil
 }
 
-func resolveAuthenticatedAdminUser(r *http.Request) (*domain.AdminUser, error) {
+func resolveAuthenticatedAdminUser(r *http.Request, services *appservice.Service) (*domain.AdminUser, error) {
 	if r == nil {
 		return nil, apperrors.Unauthorized("admin authentication required")
 	}
@@ -245,7 +287,7 @@ func resolveAuthenticatedAdminUser(r *http.Request) (*domain.AdminUser, error) {
 		return nil, apperrors.Unauthorized("admin authentication required")
 	}
 
-	adminUser, err := appservice.ResolveAdminFromAccessToken(r.Context(), cookie.Value)
+	adminUser, err := services.ResolveAdminFromAccessToken(r.Context(), cookie.Value)
 	if err != nil {
 		return nil, err
 	}
@@ -290,7 +332,7 @@ func parseGithubOAuthState(token, secret string, now time.Time) (*githubOAuthSta
 	if payload.ExpiresAt <= now.UTC().Unix() {
 		return nil, errors.New("expired oauth state")
 	}
-	if payload.Intent != "connect" && payload.Intent != "login" {
+	if payload.Intent != "connect" && payload.Intent != "login" && payload.Intent != "invite" {
 		return nil, errors.New("invalid oauth intent")
 	}
 
@@ -378,6 +420,16 @@ func mapGithubOAuthErrorToStatus(err error, intent string) string {
 		return "failed"
 	}
 
+	switch strings.TrimSpace(appErr.Code) {
+	case "ADMIN_INVITATION_TOKEN_INVALID":
+		return "invalid-link"
+	case "ADMIN_INVITATION_TOKEN_EXPIRED":
+		return "expired"
+	case "ADMIN_LOGIN_LOCKED":
+		return "locked"
+	case "ADMIN_LOGIN_THROTTLED":
+		return "throttled"
+	}
 	if intent == "login" && strings.EqualFold(strings.TrimSpace(appErr.Code), "UNAUTHORIZED") {
 		return "not-linked"
 	}
@@ -391,8 +443,22 @@ func mapGithubOAuthErrorToStatus(err error, intent string) string {
 
 func redirectToAdminFlow(w http.ResponseWriter, r *http.Request, locale, intent, status string) {
 	redirectPath := fmt.Sprintf("/%s/admin/login?github=%s", resolveAdminLocale(locale), url.QueryEscape(status))
-	if intent == "connect" {
+	switch intent {
+	case "connect":
 		redirectPath = fmt.Sprintf("/%s/admin/settings/security?github=%s", resolveAdminLocale(locale), url.QueryEscape(status))
+	case "invite":
+		redirectPath = fmt.Sprintf("/%s/admin/accept-invitation?github=%s", resolveAdminLocale(locale), url.QueryEscape(status))
 	}
 	http.Redirect(w, r, redirectPath, http.StatusSeeOther)
 }
+
+// redirectToAdminMFA sends an admin with two-factor authentication to the login page for the code, passing the
+// mfa-pending token in the fragment as the OIDC callback does.
+func redirectToAdminMFA(w http.ResponseWriter, r *http.Request, locale, mfaToken string) {
+	redirectPath := fmt.Sprintf(
+		"/%s/admin/login?github=mfa-required#mfaToken=%s",
+		resolveAdminLocale(locale),
+		url.QueryEscape(mfaToken),
+	)
+	http.Redirect(w, r, redirectPath, http.StatusSeeOther)
+}
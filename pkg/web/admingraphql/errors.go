@@ -43,14 +43,14 @@ const (
 	adminMessageServiceUnavailable = "admin service unavailable"
 )
 
-func presentAdminError(ctx context.Context, err error) *apperrors.AppError {
+func presentAdminError(ctx context.Context, services *appservice.Service, err error) *apperrors.AppError {
 	appErr := apperrors.From(err)
 	if appErr == nil {
 		return apperrors.Internal("Internal server error", nil)
 	}
 
 	normalized := mapAdminErrorToCode(appErr)
-	localizedMessage := appservice.ResolveAdminErrorMessage(ctx, normalized.Code, normalized.Message)
+	localizedMessage := services.ResolveAdminErrorMessage(ctx, normalized.Code, normalized.Message)
 	return apperrors.New(normalized.Code, localizedMessage, normalized.HTTPStatus, normalized.Cause)
 }
 
@@ -137,10 +137,10 @@ func isRetryableAdminError(appErr *apperrors.AppError) bool {
 	}
 }
 
-func writeAdminErrorWithContext(ctx context.Context, w http.ResponseWriter, err error) {
+func writeAdminErrorWithContext(ctx context.Context, services *appservice.Service, w http.ResponseWriter, err error) {
 	if ctx == nil {
 		ctx = context.Background()
 	}
 
-	httpapi.WriteErrorWithContext(ctx, w, presentAdminError(ctx, err))
+	httpapi.WriteErrorWithContext(ctx, w, presentAdminError(ctx, services, err))
 }
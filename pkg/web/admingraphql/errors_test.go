@@ -39,6 +39,13 @@ func TestPresentAdminError(t *testing.T) {
 			wantStatus: http.StatusUnauthorized,
 			wantMsg:    "Your session expired. Sign in again.",
 		},
+		{
+			name:       "localizes forbidden permission errors",
+			err:        apperrors.New("ADMIN_FORBIDDEN", "admin permission denied", http.StatusForbidden, nil),
+			wantCode:   "ADMIN_FORBIDDEN",
+			wantStatus: http.StatusForbidden,
+			wantMsg:    "You do not have permission to perform this action.",
+		},
 		{
 			name:       "maps csrf to stable code",
 			err:        apperrors.Unauthorized("invalid csrf token"),
@@ -59,7 +66,7 @@ func TestPresentAdminError(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			got := presentAdminError(context.Background(), tc.err)
+			got := presentAdminError(context.Background(), newTestServices(), tc.err)
 			if got.Code != tc.wantCode {
 				t.Fatalf("code = %q, want %q", got.Code, tc.wantCode)
 			}
@@ -6,9 +6,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
 	"strings"
-	"sync"
 
 	"github.com/99designs/gqlgen/graphql"
 	graphqlhandler "github.com/99designs/gqlgen/graphql/handler"
@@ -20,26 +20,34 @@ import (
 
 	appconfig "suaybsimsek.com/blog-api/internal/config"
 	admingraphql "suaybsimsek.com/blog-api/internal/graphql/admin"
+	appservice "suaybsimsek.com/blog-api/internal/service"
 	"suaybsimsek.com/blog-api/pkg/apperrors"
 	"suaybsimsek.com/blog-api/pkg/httpapi"
 	"suaybsimsek.com/blog-api/pkg/httpauth"
 )
 
-var (
-	adminGraphQLServer     *graphqlhandler.Server
-	adminGraphQLServerOnce sync.Once
-)
+// adminGraphQLMultipartMaxMemory caps how much of a multipart upload is held in memory before spilling to disk.
+const adminGraphQLMultipartMaxMemory = 8 << 20
 
-func newAdminGraphQLServer() *graphqlhandler.Server {
+func newAdminGraphQLServer(services *appservice.Service) *graphqlhandler.Server {
 	graphQLConfig := appconfig.ResolveGraphQLConfig()
+	mediaConfig := appconfig.ResolveMediaStorageConfig()
 
 	server := graphqlhandler.New(
 		admingraphql.NewExecutableSchema(
-			admingraphql.Config{Resolvers: &admingraphql.Resolver{}},
+			admingraphql.Config{
+				Resolvers:  &admingraphql.Resolver{Service: services},
+				Directives: admingraphql.NewDirectiveRoot(),
+			},
 		),
 	)
 	server.AddTransport(transport.Options{})
 	server.AddTransport(transport.GET{})
+	server.AddTransport(transport.MultipartForm{
+		// Leave headroom for the operations and map parts alongside the file itself.
+		MaxUploadSize: int64(mediaConfig.MaxAssetBytes) + 1<<20,
+		MaxMemory:     adminGraphQLMultipartMaxMemory,
+	})
 	server.AddTransport(transport.POST{})
 	server.SetQueryCache(lru.New[*ast.QueryDocument](graphQLConfig.QueryCacheSize))
 	server.Use(extension.AutomaticPersistedQuery{
@@ -49,7 +57,7 @@ func newAdminGraphQLServer() *graphqlhandler.Server {
 		httpapi.LogError(ctx, "admin graphql execution failed", err, "component", "admin_graphql")
 
 		gqlErr := graphql.DefaultErrorPresenter(ctx, err)
-		presentedErr := presentAdminError(ctx, err)
+		presentedErr := presentAdminError(ctx, services, err)
 
 		gqlErr.Message = presentedErr.Message
 		if gqlErr.Extensions == nil {
@@ -78,24 +86,25 @@ func newAdminGraphQLServer() *graphqlhandler.Server {
 	return server
 }
 
-func getAdminGraphQLServer() *graphqlhandler.Server {
-	adminGraphQLServerOnce.Do(func() {
-		adminGraphQLServer = newAdminGraphQLServer()
-	})
+// NewHandler returns the admin GraphQL endpoint. Its resolvers, auth and error messages all run on services.
+func NewHandler(services *appservice.Service) http.HandlerFunc {
+	server := newAdminGraphQLServer(services)
 
-	return adminGraphQLServer
+	return func(w http.ResponseWriter, r *http.Request) {
+		handle(w, r, services, server)
+	}
 }
 
-func Handler(w http.ResponseWriter, r *http.Request) {
+func handle(w http.ResponseWriter, r *http.Request, services *appservice.Service, server *graphqlhandler.Server) {
 	r = httpapi.EnsureRequestContext(w, r)
 	if r == nil {
-		writeAdminErrorWithContext(context.Background(), w, apperrors.Internal("invalid request context", nil))
+		writeAdminErrorWithContext(context.Background(), services, w, apperrors.Internal("invalid request context", nil))
 		return
 	}
 
 	httpConfig := appconfig.ResolveHTTPConfig()
 	if httpConfig.AllowedOrigin == "" {
-		writeAdminErrorWithContext(r.Context(), w, apperrors.Config("configuration error", nil))
+		writeAdminErrorWithContext(r.Context(), services, w, apperrors.Config("configuration error", nil))
 		return
 	}
 
@@ -113,33 +122,65 @@ func Handler(w http.ResponseWriter, r *http.Request) {
 
 	if r.Method != http.MethodGet && r.Method != http.MethodPost {
 		w.Header().Set("Allow", "GET, POST, OPTIONS")
-		writeAdminErrorWithContext(r.Context(), w, apperrors.MethodNotAllowed("method not allowed"))
+		writeAdminErrorWithContext(r.Context(), services, w, apperrors.MethodNotAllowed("method not allowed"))
 		return
 	}
 
 	adminConfig := appconfig.ResolveAdminConfig()
 	if _, err := httpauth.EnsureCSRFCookie(w, r, adminConfig.CSRFCookieName, adminConfig.SecureCookies, "/"); err != nil {
-		writeAdminErrorWithContext(r.Context(), w, apperrors.Internal("failed to initialize csrf token", err))
+		writeAdminErrorWithContext(r.Context(), services, w, apperrors.Internal("failed to initialize csrf token", err))
 		return
 	}
 
-	if isMutation, err := isAdminMutationRequest(r); err != nil {
-		writeAdminErrorWithContext(r.Context(), w, apperrors.BadRequest("invalid GraphQL request payload"))
+	if err := validateAdminCSRF(r, adminConfig.CSRFCookieName); err != nil {
+		writeAdminErrorWithContext(r.Context(), services, w, err)
 		return
-	} else if isMutation && !isCSRFExemptOperation(r) {
-		if err := httpauth.ValidateDoubleSubmitCSRF(r, adminConfig.CSRFCookieName); err != nil {
-			writeAdminErrorWithContext(r.Context(), w, apperrors.Unauthorized("invalid csrf token"))
-			return
-		}
 	}
 
-	requestWithContext, err := admingraphql.WithAdminRequestContext(r.Context(), r, w)
+	requestWithContext, err := admingraphql.WithAdminRequestContext(r.Context(), services, r, w)
 	if err != nil {
-		writeAdminErrorWithContext(r.Context(), w, apperrors.Internal("failed to resolve admin auth", err))
+		writeAdminErrorWithContext(r.Context(), services, w, apperrors.Internal("failed to resolve admin auth", err))
 		return
 	}
 
-	getAdminGraphQLServer().ServeHTTP(w, r.WithContext(requestWithContext))
+	server.ServeHTTP(w, r.WithContext(requestWithContext))
+}
+
+// validateAdminCSRF requires the double-submit CSRF token on mutations. Bearer requests are authenticated by a personal
+// access token instead of cookies, so they cannot be forged cross-site and skip the check.
+func validateAdminCSRF(r *http.Request, cookieName string) error {
+	if _, ok := httpauth.BearerToken(r); ok {
+		return nil
+	}
+
+	if isAdminMultipartRequest(r) {
+		// Multipart bodies are only used for uploads, so they always carry a mutation and need a CSRF token.
+		if err := httpauth.ValidateDoubleSubmitCSRF(r, cookieName); err != nil {
+			return apperrors.Unauthorized("invalid csrf token")
+		}
+		return nil
+	}
+
+	isMutation, err := isAdminMutationRequest(r)
+	if err != nil {
+		return apperrors.BadRequest("invalid GraphQL request payload")
+	}
+	if isMutation && !isCSRFExemptOperation(r) {
+		if err := httpauth.ValidateDoubleSubmitCSRF(r, cookieName); err != nil {
+			return apperrors.Unauthorized("invalid csrf token")
+		}
+	}
+
+	return nil
+}
+
+func isAdminMultipartRequest(r *http.Request) bool {
+	if r == nil || r.Method != http.MethodPost {
+		return false
+	}
+
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return err == nil && mediaType == "multipart/form-data"
 }
 
 func isAdminMutationRequest(r *http.Request) (bool, error) {
@@ -187,5 +228,9 @@ func isCSRFExemptOperation(r *http.Request) bool {
 		strings.Contains(trimmedQuery, "mutation AdminRefreshSession") ||
 		strings.Contains(trimmedQuery, "mutation AdminConfirmEmailChange") ||
 		strings.Contains(trimmedQuery, "mutation AdminRequestPasswordReset") ||
-		strings.Contains(trimmedQuery, "mutation AdminConfirmPasswordReset")
+		strings.Contains(trimmedQuery, "mutation AdminConfirmPasswordReset") ||
+		strings.Contains(trimmedQuery, "mutation AdminAcceptInvitation") ||
+		strings.Contains(trimmedQuery, "mutation AdminVerifyTwoFactorLogin") ||
+		strings.Contains(trimmedQuery, "mutation AdminStartPasskeyLogin") ||
+		strings.Contains(trimmedQuery, "mutation AdminPasskeyLogin")
 }
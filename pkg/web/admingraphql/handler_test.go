@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"suaybsimsek.com/blog-api/internal/repository"
+	appservice "suaybsimsek.com/blog-api/internal/service"
+)
+
+// newTestServices returns services without a Mongo client, so the error catalog falls back to its built-in messages.
+func newTestServices() *appservice.Service {
+	return appservice.New(appservice.Dependencies{ErrorMessages: repository.NewErrorMessageRepository(nil)})
+}
+
+func TestHandlerRequiresCSRFTokenForMultipartUploads(t *testing.T) {
+	t.Setenv("API_CORS_ORIGIN", "https://admin.example.com")
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	_ = writer.WriteField("operations", `{"query":"mutation Upload($file: Upload!) { uploadMediaAsset(input: {fileName: \"a.png\", file: $file}) { id } }","variables":{"file":null}}`)
+	_ = writer.WriteField("map", `{"0":["variables.file"]}`)
+	part, _ := writer.CreateFormFile("0", "a.png")
+	_, _ = part.Write([]byte("png"))
+	_ = writer.Close()
+
+	request := httptest.NewRequest(http.MethodPost, "/api/admin/graphql", &body)
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+	request.AddCookie(&http.Cookie{Name: "admin_csrf", Value: "csrf-token"})
+	if !isAdminMultipartRequest(request) {
+		t.Fatal("expected multipart request to be detected")
+	}
+
+	recorder := httptest.NewRecorder()
+	NewHandler(newTestServices())(recorder, request)
+	if recorder.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 without csrf header, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+}
+
+func TestValidateAdminCSRFSkipsBearerRequests(t *testing.T) {
+	newMutationRequest := func() *http.Request {
+		request := httptest.NewRequest(
+			http.MethodPost,
+			"/api/admin/graphql",
+			bytes.NewBufferString(`{"query":"mutation { triggerNewsletterDispatch { success } }"}`),
+		)
+		request.Header.Set("Content-Type", "application/json")
+		request.AddCookie(&http.Cookie{Name: "admin_csrf", Value: "csrf-token"})
+		return request
+	}
+
+	if err := validateAdminCSRF(newMutationRequest(), "admin_csrf"); err == nil {
+		t.Fatal("expected cookie mutation without csrf header to be rejected")
+	}
+
+	request := newMutationRequest()
+	request.Header.Set("Authorization", "Bearer blog_pat_token")
+	if err := validateAdminCSRF(request, "admin_csrf"); err != nil {
+		t.Fatalf("expected bearer mutation to skip csrf, got %v", err)
+	}
+}
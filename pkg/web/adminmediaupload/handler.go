@@ -0,0 +1,306 @@
+package adminmediaupload
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/service"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpapi"
+	"suaybsimsek.com/blog-api/pkg/httpauth"
+)
+
+const (
+	adminMediaUploadPathPrefix     = "/api/admin/media-uploads"
+	adminMediaUploadCompleteAction = "complete"
+	adminMediaUploadOffsetHeader   = "Upload-Offset"
+	adminMediaUploadChecksumHeader = "Upload-Checksum"
+	maxAdminMediaUploadCreateBytes = 16 << 10
+)
+
+var (
+	resolveAdminFromAccessTokenFn     = (*service.Service).ResolveAdminFromAccessToken
+	createAdminMediaUploadSessionFn   = (*service.Service).CreateAdminMediaUploadSession
+	getAdminMediaUploadSessionFn      = (*service.Service).GetAdminMediaUploadSession
+	appendAdminMediaUploadChunkFn     = (*service.Service).AppendAdminMediaUploadChunk
+	completeAdminMediaUploadSessionFn = (*service.Service).CompleteAdminMediaUploadSession
+	abortAdminMediaUploadSessionFn    = (*service.Service).AbortAdminMediaUploadSession
+	maxAdminMediaUploadChunkBytes     = int64(service.AdminMediaUploadChunkSize)
+)
+
+type adminMediaUploadCreateRequest struct {
+	FileName  string `json:"fileName"`
+	SizeBytes int    `json:"sizeBytes"`
+	Checksum  string `json:"checksum"`
+}
+
+type adminMediaUploadSessionResponse struct {
+	ID            string `json:"id"`
+	FileName      string `json:"fileName"`
+	SizeBytes     int    `json:"sizeBytes"`
+	UploadedBytes int    `json:"uploadedBytes"`
+	ChunkSize     int    `json:"chunkSize"`
+	Checksum      string `json:"checksum"`
+	ExpiresAt     string `json:"expiresAt"`
+}
+
+type adminMediaUploadItemResponse struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Value       string `json:"value"`
+	PreviewURL  string `json:"previewUrl"`
+	ContentType string `json:"contentType"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	SizeBytes   int    `json:"sizeBytes"`
+}
+
+// NewHandler returns the handler for resumable media uploads in the admin panel:
+//
+//	POST   /api/admin/media-uploads               create a session
+//	GET    /api/admin/media-uploads/{id}          read the session offset
+//	PUT    /api/admin/media-uploads/{id}          append a chunk at Upload-Offset
+//	POST   /api/admin/media-uploads/{id}/complete assemble and store the asset
+//	DELETE /api/admin/media-uploads/{id}          abort the session
+func NewHandler(services *service.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		handle(w, r, services)
+	}
+}
+
+func handle(w http.ResponseWriter, r *http.Request, services *service.Service) {
+	r = httpapi.EnsureRequestContext(w, r)
+	if r == nil {
+		httpapi.WriteErrorWithContext(context.Background(), w, apperrors.Internal("invalid request context", nil))
+		return
+	}
+
+	httpConfig := appconfig.ResolveHTTPConfig()
+	if httpConfig.AllowedOrigin == "" {
+		httpapi.WriteErrorWithContext(r.Context(), w, apperrors.Config("configuration error", nil))
+		return
+	}
+
+	w.Header().Set("Access-Control-Allow-Origin", httpConfig.AllowedOrigin)
+	w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+	w.Header().Set(
+		"Access-Control-Allow-Headers",
+		"Content-Type, Accept-Language, X-CSRF-Token, "+adminMediaUploadOffsetHeader+", "+adminMediaUploadChecksumHeader,
+	)
+	w.Header().Set("Access-Control-Expose-Headers", adminMediaUploadOffsetHeader)
+	w.Header().Set("Access-Control-Allow-Credentials", "true")
+	w.Header().Set("Vary", "Origin")
+	w.Header().Set("Cache-Control", "no-store")
+
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	sessionID, action := resolveAdminMediaUploadTarget(r)
+	if action != "" && action != adminMediaUploadCompleteAction {
+		httpapi.WriteErrorWithContext(r.Context(), w, apperrors.NotFound("media upload route not found"))
+		return
+	}
+	allowed := resolveAdminMediaUploadAllowedMethods(sessionID, action)
+	if !strings.Contains(allowed, r.Method) {
+		w.Header().Set("Allow", allowed+", OPTIONS")
+		httpapi.WriteErrorWithContext(r.Context(), w, apperrors.MethodNotAllowed("method not allowed"))
+		return
+	}
+
+	adminConfig := appconfig.ResolveAdminConfig()
+	if _, err := httpauth.EnsureCSRFCookie(w, r, adminConfig.CSRFCookieName, adminConfig.SecureCookies, "/"); err != nil {
+		httpapi.WriteErrorWithContext(r.Context(), w, apperrors.Internal("failed to initialize csrf token", err))
+		return
+	}
+	if r.Method != http.MethodGet {
+		if err := httpauth.ValidateDoubleSubmitCSRF(r, adminConfig.CSRFCookieName); err != nil {
+			httpapi.WriteErrorWithContext(r.Context(), w, apperrors.Unauthorized("invalid csrf token"))
+			return
+		}
+	}
+
+	adminUser := resolveAdminMediaUploadUser(r, services, adminConfig.AccessCookieName)
+
+	switch {
+	case sessionID == "":
+		handleCreateAdminMediaUpload(w, r, services, adminUser)
+	case action == adminMediaUploadCompleteAction:
+		handleCompleteAdminMediaUpload(w, r, services, adminUser, sessionID)
+	case r.Method == http.MethodGet:
+		session, err := getAdminMediaUploadSessionFn(services, r.Context(), adminUser, sessionID)
+		writeAdminMediaUploadSession(w, r, http.StatusOK, session, err)
+	case r.Method == http.MethodPut:
+		handleAppendAdminMediaUploadChunk(w, r, services, adminUser, sessionID)
+	default:
+		if err := abortAdminMediaUploadSessionFn(services, r.Context(), adminUser, sessionID); err != nil {
+			httpapi.WriteErrorWithContext(r.Context(), w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func handleCreateAdminMediaUpload(
+	w http.ResponseWriter,
+	r *http.Request,
+	services *service.Service,
+	adminUser *domain.AdminUser,
+) {
+	var request adminMediaUploadCreateRequest
+	body := http.MaxBytesReader(w, r.Body, maxAdminMediaUploadCreateBytes)
+	if err := json.NewDecoder(body).Decode(&request); err != nil {
+		httpapi.WriteErrorWithContext(r.Context(), w, apperrors.BadRequest("invalid media upload request payload"))
+		return
+	}
+
+	session, err := createAdminMediaUploadSessionFn(services, r.Context(), adminUser, domain.AdminMediaUploadSessionInput{
+		FileName:  request.FileName,
+		SizeBytes: request.SizeBytes,
+		Checksum:  request.Checksum,
+	})
+	if err == nil {
+		w.Header().Set("Location", adminMediaUploadPathPrefix+"/"+session.ID)
+	}
+	writeAdminMediaUploadSession(w, r, http.StatusCreated, session, err)
+}
+
+func handleAppendAdminMediaUploadChunk(
+	w http.ResponseWriter,
+	r *http.Request,
+	services *service.Service,
+	adminUser *domain.AdminUser,
+	sessionID string,
+) {
+	offset, err := strconv.Atoi(strings.TrimSpace(r.Header.Get(adminMediaUploadOffsetHeader)))
+	if err != nil || offset < 0 {
+		httpapi.WriteErrorWithContext(r.Context(), w, apperrors.BadRequest("media upload offset header is invalid"))
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxAdminMediaUploadChunkBytes))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			httpapi.WriteErrorWithContext(r.Context(), w, apperrors.BadRequest("media upload chunk is too large"))
+			return
+		}
+		httpapi.WriteErrorWithContext(r.Context(), w, apperrors.BadRequest("media upload chunk could not be read"))
+		return
+	}
+
+	session, err := appendAdminMediaUploadChunkFn(
+		services,
+		r.Context(),
+		adminUser,
+		sessionID,
+		offset,
+		r.Header.Get(adminMediaUploadChecksumHeader),
+		data,
+	)
+	writeAdminMediaUploadSession(w, r, http.StatusOK, session, err)
+}
+
+func handleCompleteAdminMediaUpload(
+	w http.ResponseWriter,
+	r *http.Request,
+	services *service.Service,
+	adminUser *domain.AdminUser,
+	sessionID string,
+) {
+	item, err := completeAdminMediaUploadSessionFn(services, r.Context(), adminUser, sessionID)
+	if err != nil {
+		httpapi.WriteErrorWithContext(r.Context(), w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(adminMediaUploadItemResponse{
+		ID:          item.ID,
+		Name:        item.Name,
+		Value:       item.Value,
+		PreviewURL:  item.PreviewURL,
+		ContentType: item.ContentType,
+		Width:       item.Width,
+		Height:      item.Height,
+		SizeBytes:   item.SizeBytes,
+	})
+}
+
+func writeAdminMediaUploadSession(
+	w http.ResponseWriter,
+	r *http.Request,
+	status int,
+	session *domain.AdminMediaUploadSession,
+	err error,
+) {
+	if err != nil {
+		httpapi.WriteErrorWithContext(r.Context(), w, err)
+		return
+	}
+
+	w.Header().Set(adminMediaUploadOffsetHeader, strconv.Itoa(session.UploadedBytes))
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(adminMediaUploadSessionResponse{
+		ID:            session.ID,
+		FileName:      session.FileName,
+		SizeBytes:     session.SizeBytes,
+		UploadedBytes: session.UploadedBytes,
+		ChunkSize:     session.ChunkSize,
+		Checksum:      session.Checksum,
+		ExpiresAt:     session.ExpiresAt.UTC().Format(time.RFC3339),
+	})
+}
+
+func resolveAdminMediaUploadUser(r *http.Request, services *service.Service, accessCookieName string) *domain.AdminUser {
+	if accessCookieName == "" {
+		return nil
+	}
+	cookie, err := r.Cookie(accessCookieName)
+	if err != nil {
+		return nil
+	}
+	adminUser, err := resolveAdminFromAccessTokenFn(services, r.Context(), cookie.Value)
+	if err != nil {
+		return nil
+	}
+	return adminUser
+}
+
+// resolveAdminMediaUploadTarget reads the query parameters set by the Vercel rewrite and falls back to the
+// /api/admin/media-uploads/{id}[/complete] path served directly by the local runner.
+func resolveAdminMediaUploadTarget(r *http.Request) (string, string) {
+	query := r.URL.Query()
+	sessionID := strings.TrimSpace(query.Get("id"))
+	action := strings.TrimSpace(query.Get("action"))
+	if sessionID == "" && strings.HasPrefix(r.URL.Path, adminMediaUploadPathPrefix) {
+		segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, adminMediaUploadPathPrefix), "/"), "/")
+		sessionID = strings.TrimSpace(segments[0])
+		if len(segments) > 1 {
+			action = strings.TrimSpace(segments[1])
+		}
+	}
+	return sessionID, action
+}
+
+func resolveAdminMediaUploadAllowedMethods(sessionID, action string) string {
+	switch {
+	case sessionID == "":
+		return http.MethodPost
+	case action == adminMediaUploadCompleteAction:
+		return http.MethodPost
+	default:
+		return "GET, PUT, DELETE"
+	}
+}
@@ -0,0 +1,150 @@
+package adminmediaupload
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/service"
+	"suaybsimsek.com/blog-api/pkg/httpauth"
+)
+
+func useAdminMediaUploadTestEnv(t *testing.T) {
+	t.Helper()
+	t.Setenv("API_CORS_ORIGIN", "https://admin.example.com")
+
+	originalResolveAdminFn := resolveAdminFromAccessTokenFn
+	t.Cleanup(func() {
+		resolveAdminFromAccessTokenFn = originalResolveAdminFn
+	})
+	resolveAdminFromAccessTokenFn = func(_ *service.Service, _ context.Context, token string) (*domain.AdminUser, error) {
+		if token != "access-token" {
+			return nil, nil
+		}
+		return &domain.AdminUser{ID: "admin-1"}, nil
+	}
+}
+
+func newAdminMediaUploadRequest(method, target, body string) *http.Request {
+	request := httptest.NewRequest(method, target, strings.NewReader(body))
+	request.AddCookie(&http.Cookie{Name: "admin_access", Value: "access-token"})
+	request.AddCookie(&http.Cookie{Name: "admin_csrf", Value: "csrf-token"})
+	request.Header.Set(httpauth.CSRFHeaderName, "csrf-token")
+	return request
+}
+
+func TestHandlerRejectsChunkWithoutCSRFToken(t *testing.T) {
+	useAdminMediaUploadTestEnv(t)
+
+	originalAppendFn := appendAdminMediaUploadChunkFn
+	t.Cleanup(func() {
+		appendAdminMediaUploadChunkFn = originalAppendFn
+	})
+	appendAdminMediaUploadChunkFn = func(
+		*service.Service,
+		context.Context,
+		*domain.AdminUser,
+		string,
+		int,
+		string,
+		[]byte,
+	) (*domain.AdminMediaUploadSession, error) {
+		t.Fatal("expected chunk to be rejected before reaching the service")
+		return nil, nil
+	}
+
+	request := newAdminMediaUploadRequest(http.MethodPut, "/api/admin/media-uploads/upload-1", "chunk")
+	request.Header.Del(httpauth.CSRFHeaderName)
+	recorder := httptest.NewRecorder()
+	NewHandler(nil)(recorder, request)
+	if recorder.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 without csrf header, got %d", recorder.Code)
+	}
+}
+
+func TestHandlerAppendsChunkFromPathAndHeaders(t *testing.T) {
+	useAdminMediaUploadTestEnv(t)
+
+	originalAppendFn := appendAdminMediaUploadChunkFn
+	t.Cleanup(func() {
+		appendAdminMediaUploadChunkFn = originalAppendFn
+	})
+	appendAdminMediaUploadChunkFn = func(
+		_ *service.Service,
+		_ context.Context,
+		adminUser *domain.AdminUser,
+		id string,
+		offset int,
+		checksum string,
+		data []byte,
+	) (*domain.AdminMediaUploadSession, error) {
+		if adminUser == nil || adminUser.ID != "admin-1" {
+			t.Fatalf("expected authenticated admin, got %#v", adminUser)
+		}
+		if id != "upload-1" || offset != 5 || checksum != "abc" || string(data) != "chunk" {
+			t.Fatalf("unexpected chunk id=%q offset=%d checksum=%q data=%q", id, offset, checksum, data)
+		}
+		return &domain.AdminMediaUploadSession{
+			ID:            id,
+			SizeBytes:     10,
+			UploadedBytes: 10,
+			ChunkSize:     4 << 20,
+			ExpiresAt:     time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+		}, nil
+	}
+
+	request := newAdminMediaUploadRequest(http.MethodPut, "/api/admin/media-uploads/upload-1", "chunk")
+	request.Header.Set(adminMediaUploadOffsetHeader, "5")
+	request.Header.Set(adminMediaUploadChecksumHeader, "abc")
+	recorder := httptest.NewRecorder()
+	NewHandler(nil)(recorder, request)
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+	if recorder.Header().Get(adminMediaUploadOffsetHeader) != "10" {
+		t.Fatalf("expected Upload-Offset 10, got %q", recorder.Header().Get(adminMediaUploadOffsetHeader))
+	}
+	if !strings.Contains(recorder.Body.String(), `"uploadedBytes":10`) {
+		t.Fatalf("unexpected body %s", recorder.Body.String())
+	}
+}
+
+func TestHandlerCompletesSessionFromRewriteQuery(t *testing.T) {
+	useAdminMediaUploadTestEnv(t)
+
+	originalCompleteFn := completeAdminMediaUploadSessionFn
+	t.Cleanup(func() {
+		completeAdminMediaUploadSessionFn = originalCompleteFn
+	})
+	completeAdminMediaUploadSessionFn = func(
+		_ *service.Service,
+		_ context.Context,
+		_ *domain.AdminUser,
+		id string,
+	) (*domain.AdminMediaLibraryItem, error) {
+		if id != "upload-1" {
+			t.Fatalf("unexpected session id %q", id)
+		}
+		return &domain.AdminMediaLibraryItem{ID: "asset-1", Value: "/api/media/asset-1"}, nil
+	}
+
+	recorder := httptest.NewRecorder()
+	NewHandler(nil)(recorder, newAdminMediaUploadRequest(
+		http.MethodPost,
+		"/api/admin-media-upload?id=upload-1&action=complete",
+		"",
+	))
+	if recorder.Code != http.StatusCreated || !strings.Contains(recorder.Body.String(), `"value":"/api/media/asset-1"`) {
+		t.Fatalf("unexpected response %d %s", recorder.Code, recorder.Body.String())
+	}
+
+	recorder = httptest.NewRecorder()
+	NewHandler(nil)(recorder, newAdminMediaUploadRequest(http.MethodGet, "/api/admin/media-uploads/upload-1/complete", ""))
+	if recorder.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("expected 405 for GET complete, got %d", recorder.Code)
+	}
+}
@@ -0,0 +1,95 @@
+package contentscheduler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/service"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpapi"
+)
+
+type schedulerResponse struct {
+	Status         string                   `json:"status"`
+	Message        string                   `json:"message"`
+	Timestamp      string                   `json:"timestamp"`
+	PublishedCount int                      `json:"publishedCount"`
+	FailedCount    int                      `json:"failedCount"`
+	Published      []schedulerPublishedPost `json:"published"`
+}
+
+type schedulerPublishedPost struct {
+	Locale      string `json:"locale"`
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	PublishedAt string `json:"publishedAt"`
+}
+
+// NewHandler returns the cron endpoint that publishes the scheduled posts that are due.
+func NewHandler(services *service.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		handle(w, r, services)
+	}
+}
+
+func handle(w http.ResponseWriter, r *http.Request, services *service.Service) {
+	r = httpapi.EnsureRequestContext(w, r)
+	if r == nil {
+		httpapi.WriteErrorWithContext(context.Background(), w, apperrors.Internal("invalid request context", nil))
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+
+	if r.Method == http.MethodOptions {
+		w.Header().Set("Allow", "GET, OPTIONS")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET, OPTIONS")
+		httpapi.WriteErrorWithContext(r.Context(), w, apperrors.MethodNotAllowed("method not allowed"))
+		return
+	}
+
+	cronSecret, err := appconfig.ResolveCronSecret()
+	if err != nil {
+		httpapi.WriteErrorWithContext(r.Context(), w, apperrors.Config("configuration error", err))
+		return
+	}
+	if strings.TrimSpace(r.Header.Get("Authorization")) != "Bearer "+cronSecret {
+		httpapi.WriteErrorWithContext(r.Context(), w, apperrors.Unauthorized("unauthorized"))
+		return
+	}
+
+	result, err := services.PublishDueAdminContentPosts(r.Context(), time.Now().UTC())
+	if err != nil {
+		httpapi.WriteErrorWithContext(r.Context(), w, err)
+		return
+	}
+
+	published := make([]schedulerPublishedPost, 0, len(result.Published))
+	for _, event := range result.Published {
+		published = append(published, schedulerPublishedPost{
+			Locale:      event.Locale,
+			ID:          event.PostID,
+			Title:       event.Title,
+			PublishedAt: event.PublishedAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(schedulerResponse{
+		Status:         "success",
+		Message:        "scheduled posts processed",
+		Timestamp:      result.RanAt.UTC().Format(time.RFC3339),
+		PublishedCount: len(result.Published),
+		FailedCount:    result.Failed,
+		Published:      published,
+	})
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
-	"sync"
 
 	"github.com/99designs/gqlgen/graphql"
 	graphqlhandler "github.com/99designs/gqlgen/graphql/handler"
@@ -17,22 +16,18 @@ import (
 
 	appconfig "suaybsimsek.com/blog-api/internal/config"
 	graphschema "suaybsimsek.com/blog-api/internal/graphql"
+	appservice "suaybsimsek.com/blog-api/internal/service"
 	"suaybsimsek.com/blog-api/pkg/apperrors"
 	graphqlapi "suaybsimsek.com/blog-api/pkg/graphql"
 	"suaybsimsek.com/blog-api/pkg/httpapi"
 )
 
-var (
-	graphQLServer     *graphqlhandler.Server
-	graphQLServerOnce sync.Once
-)
-
-func newGraphQLServer() *graphqlhandler.Server {
+func newGraphQLServer(services *appservice.Service) *graphqlhandler.Server {
 	graphQLConfig := appconfig.ResolveGraphQLConfig()
 
 	server := graphqlhandler.New(
 		graphschema.NewExecutableSchema(
-			graphschema.Config{Resolvers: &graphschema.Resolver{}},
+			graphschema.Config{Resolvers: &graphschema.Resolver{Service: services}},
 		),
 	)
 	server.AddTransport(transport.Options{})
@@ -76,15 +71,16 @@ func newGraphQLServer() *graphqlhandler.Server {
 	return server
 }
 
-func getGraphQLServer() *graphqlhandler.Server {
-	graphQLServerOnce.Do(func() {
-		graphQLServer = newGraphQLServer()
-	})
+// NewHandler returns the public GraphQL endpoint, with its resolvers and reader auth running on services.
+func NewHandler(services *appservice.Service) http.HandlerFunc {
+	server := newGraphQLServer(services)
 
-	return graphQLServer
+	return func(w http.ResponseWriter, r *http.Request) {
+		handle(w, r, services, server)
+	}
 }
 
-func Handler(w http.ResponseWriter, r *http.Request) {
+func handle(w http.ResponseWriter, r *http.Request, services *appservice.Service, server *graphqlhandler.Server) {
 	r = httpapi.EnsureRequestContext(w, r)
 	if r == nil {
 		httpapi.WriteErrorWithContext(context.Background(), w, apperrors.Internal("invalid request context", nil))
@@ -121,13 +117,17 @@ func Handler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	requestWithMetadata := r.WithContext(graphschema.WithRequestMetadata(r.Context(), r))
-	requestWithContext, err := graphschema.WithPublicRequestContext(requestWithMetadata.Context(), requestWithMetadata)
+	requestWithContext, err := graphschema.WithPublicRequestContext(
+		requestWithMetadata.Context(),
+		services,
+		requestWithMetadata,
+	)
 	if err != nil {
 		httpapi.WriteErrorWithContext(r.Context(), w, apperrors.Internal("failed to resolve reader auth", err))
 		return
 	}
 
-	getGraphQLServer().ServeHTTP(w, requestWithMetadata.WithContext(requestWithContext))
+	server.ServeHTTP(w, requestWithMetadata.WithContext(requestWithContext))
 }
 
 func shouldServeGraphiQL(r *http.Request) bool {
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
-	"sync"
 	"testing"
 )
 
@@ -16,7 +15,7 @@ func TestHandlerServesGraphiQLOnGraphiQLRoute(t *testing.T) {
 	request := httptest.NewRequest(http.MethodGet, "/graphiql", nil)
 	recorder := httptest.NewRecorder()
 
-	Handler(recorder, request)
+	NewHandler(nil)(recorder, request)
 
 	if recorder.Code != http.StatusOK {
 		t.Fatalf("status = %d, want %d", recorder.Code, http.StatusOK)
@@ -32,7 +31,7 @@ func TestHandlerBranches(t *testing.T) {
 		request := httptest.NewRequest(http.MethodGet, "/graphql", nil)
 		recorder := httptest.NewRecorder()
 
-		Handler(recorder, request)
+		NewHandler(nil)(recorder, request)
 
 		if recorder.Code != http.StatusInternalServerError {
 			t.Fatalf("status = %d", recorder.Code)
@@ -44,7 +43,7 @@ func TestHandlerBranches(t *testing.T) {
 		request := httptest.NewRequest(http.MethodOptions, "/graphql", nil)
 		recorder := httptest.NewRecorder()
 
-		Handler(recorder, request)
+		NewHandler(nil)(recorder, request)
 
 		if recorder.Code != http.StatusNoContent {
 			t.Fatalf("status = %d", recorder.Code)
@@ -59,7 +58,7 @@ func TestHandlerBranches(t *testing.T) {
 		request := httptest.NewRequest(http.MethodDelete, "/graphql", nil)
 		recorder := httptest.NewRecorder()
 
-		Handler(recorder, request)
+		NewHandler(nil)(recorder, request)
 
 		if recorder.Code != http.StatusMethodNotAllowed {
 			t.Fatalf("status = %d", recorder.Code)
@@ -90,46 +89,22 @@ func TestShouldServeGraphiQL(t *testing.T) {
 	}
 }
 
-func TestGetGraphQLServerInitializesSingleton(t *testing.T) {
-	originalServer := graphQLServer
-	originalOnce := graphQLServerOnce
-	t.Cleanup(func() {
-		graphQLServer = originalServer
-		graphQLServerOnce = originalOnce
-	})
-
-	graphQLServer = nil
-	graphQLServerOnce = sync.Once{}
-
-	server := getGraphQLServer()
-	if server == nil {
-		t.Fatal("expected graphql server")
-	}
-	if getGraphQLServer() != server {
-		t.Fatal("expected singleton graphql server")
-	}
-	if newGraphQLServer() == nil {
+func TestNewHandlerBuildsGraphQLServer(t *testing.T) {
+	if newGraphQLServer(nil) == nil {
 		t.Fatal("expected new graphql server instance")
 	}
+	if NewHandler(nil) == nil {
+		t.Fatal("expected graphql handler")
+	}
 }
 
 func TestHandlerServesGraphQLRequests(t *testing.T) {
-	originalServer := graphQLServer
-	originalOnce := graphQLServerOnce
-	t.Cleanup(func() {
-		graphQLServer = originalServer
-		graphQLServerOnce = originalOnce
-	})
-
-	graphQLServer = nil
-	graphQLServerOnce = sync.Once{}
-
 	t.Setenv("API_CORS_ORIGIN", "http://localhost:3000")
 	request := httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewBufferString(`{"query":"query { __typename }"}`))
 	request.Header.Set("Content-Type", "application/json")
 	recorder := httptest.NewRecorder()
 
-	Handler(recorder, request)
+	NewHandler(nil)(recorder, request)
 
 	if recorder.Code != http.StatusOK {
 		t.Fatalf("status = %d body=%s", recorder.Code, recorder.Body.String())
@@ -146,16 +121,6 @@ func TestHandlerServesGraphQLRequests(t *testing.T) {
 }
 
 func TestHandlerSupportsIntrospectionWhenEnabled(t *testing.T) {
-	originalServer := graphQLServer
-	originalOnce := graphQLServerOnce
-	t.Cleanup(func() {
-		graphQLServer = originalServer
-		graphQLServerOnce = originalOnce
-	})
-
-	graphQLServer = nil
-	graphQLServerOnce = sync.Once{}
-
 	t.Setenv("API_CORS_ORIGIN", "http://localhost:3000")
 	t.Setenv("GRAPHQL_INTROSPECTION_ENABLED", "true")
 	request := httptest.NewRequest(
@@ -166,7 +131,7 @@ func TestHandlerSupportsIntrospectionWhenEnabled(t *testing.T) {
 	request.Header.Set("Content-Type", "application/json")
 	recorder := httptest.NewRecorder()
 
-	Handler(recorder, request)
+	NewHandler(nil)(recorder, request)
 
 	if recorder.Code != http.StatusOK {
 		t.Fatalf("status = %d body=%s", recorder.Code, recorder.Body.String())
@@ -177,16 +142,6 @@ func TestHandlerSupportsIntrospectionWhenEnabled(t *testing.T) {
 }
 
 func TestHandlerUsesGraphQLErrorPresenter(t *testing.T) {
-	originalServer := graphQLServer
-	originalOnce := graphQLServerOnce
-	t.Cleanup(func() {
-		graphQLServer = originalServer
-		graphQLServerOnce = originalOnce
-	})
-
-	graphQLServer = nil
-	graphQLServerOnce = sync.Once{}
-
 	t.Setenv("API_CORS_ORIGIN", "http://localhost:3000")
 	request := httptest.NewRequest(
 		http.MethodPost,
@@ -196,7 +151,7 @@ func TestHandlerUsesGraphQLErrorPresenter(t *testing.T) {
 	request.Header.Set("Content-Type", "application/json")
 	recorder := httptest.NewRecorder()
 
-	Handler(recorder, request)
+	NewHandler(nil)(recorder, request)
 
 	if recorder.Code != http.StatusOK {
 		t.Fatalf("status = %d body=%s", recorder.Code, recorder.Body.String())
@@ -0,0 +1,49 @@
+package jwks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"suaybsimsek.com/blog-api/internal/service"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpapi"
+)
+
+var resolvePublicJWKSFn = service.ResolvePublicJWKS
+
+// Handler publishes the public token verification keys at /.well-known/jwks.json. Retired keys stay listed while
+// they are configured, so caches see a rotation before tokens signed with the new key arrive.
+func Handler(w http.ResponseWriter, r *http.Request) {
+	r = httpapi.EnsureRequestContext(w, r)
+	if r == nil {
+		httpapi.WriteErrorWithContext(context.Background(), w, apperrors.Internal("invalid request context", nil))
+		return
+	}
+
+	if r.Method == http.MethodOptions {
+		w.Header().Set("Allow", "GET, HEAD, OPTIONS")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD, OPTIONS")
+		httpapi.WriteErrorWithContext(r.Context(), w, apperrors.MethodNotAllowed("method not allowed"))
+		return
+	}
+
+	keySet, err := resolvePublicJWKSFn()
+	if err != nil {
+		httpapi.WriteErrorWithContext(r.Context(), w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/jwk-set+json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodHead {
+		return
+	}
+	_ = json.NewEncoder(w).Encode(keySet)
+}
@@ -0,0 +1,54 @@
+package jwks
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpauth"
+)
+
+func TestHandlerPublishesPublicKeys(t *testing.T) {
+	originalResolveFn := resolvePublicJWKSFn
+	t.Cleanup(func() {
+		resolvePublicJWKSFn = originalResolveFn
+	})
+
+	key, err := httpauth.GenerateJWTKey(httpauth.JWTAlgorithmEdDSA, "2026-10")
+	if err != nil {
+		t.Fatalf("GenerateJWTKey returned error: %v", err)
+	}
+	resolvePublicJWKSFn = func() (httpauth.JSONWebKeySet, error) {
+		return httpauth.JWTKeySet{Keys: []httpauth.JWTKey{key}}.PublicJWKS(), nil
+	}
+
+	recorder := httptest.NewRecorder()
+	Handler(recorder, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+	if recorder.Code != http.StatusOK || recorder.Header().Get("Content-Type") != "application/jwk-set+json" {
+		t.Fatalf("unexpected response %d %q", recorder.Code, recorder.Header().Get("Content-Type"))
+	}
+	var payload httpauth.JSONWebKeySet
+	if err := json.Unmarshal(recorder.Body.Bytes(), &payload); err != nil {
+		t.Fatalf("decode jwks: %v", err)
+	}
+	if len(payload.Keys) != 1 || payload.Keys[0].KeyID != "2026-10" || payload.Keys[0].D != "" {
+		t.Fatalf("unexpected jwks %#v", payload)
+	}
+
+	recorder = httptest.NewRecorder()
+	Handler(recorder, httptest.NewRequest(http.MethodPost, "/.well-known/jwks.json", nil))
+	if recorder.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("expected 405 for POST, got %d", recorder.Code)
+	}
+
+	resolvePublicJWKSFn = func() (httpauth.JSONWebKeySet, error) {
+		return httpauth.JSONWebKeySet{}, apperrors.Config("jwt keys are invalid", nil)
+	}
+	recorder = httptest.NewRecorder()
+	Handler(recorder, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+	if recorder.Code != http.StatusInternalServerError {
+		t.Fatalf("expected 500 for invalid keys, got %d", recorder.Code)
+	}
+}
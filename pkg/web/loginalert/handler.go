@@ -0,0 +1,96 @@
+package loginalert
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/service"
+	adminmailpkg "suaybsimsek.com/blog-api/pkg/adminmail"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpapi"
+	newsletterpkg "suaybsimsek.com/blog-api/pkg/newsletter"
+)
+
+var (
+	secureAccountFromLoginAlertFn = (*service.Service).SecureAccountFromLoginAlert
+	resolveSiteURLFn              = appconfig.ResolveSiteURLOrRoot
+)
+
+// NewHandler returns the handler for the "this wasn't me" link of a login alert email. Opening it signs the account
+// out everywhere and shows the outcome as a page, so it works straight from the mail client.
+func NewHandler(services *service.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		handle(w, r, services)
+	}
+}
+
+func handle(w http.ResponseWriter, r *http.Request, services *service.Service) {
+	r = httpapi.EnsureRequestContext(w, r)
+	if r == nil {
+		httpapi.WriteErrorWithContext(context.Background(), w, apperrors.Internal("invalid request context", nil))
+		return
+	}
+
+	// Only GET acts on the link, so link checkers that probe with HEAD leave the account alone.
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET")
+		httpapi.WriteErrorWithContext(r.Context(), w, apperrors.MethodNotAllowed("method not allowed"))
+		return
+	}
+
+	query := r.URL.Query()
+	locale := newsletterpkg.ResolveLocale(query.Get("locale"), r.Header.Get("Accept-Language"))
+	siteURL := resolveSiteURLFn()
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Referrer-Policy", "no-referrer")
+
+	result, err := secureAccountFromLoginAlertFn(services, r.Context(), query.Get("token"), locale)
+	if err != nil {
+		status, statusCode := mapLoginAlertError(err)
+		if statusCode >= http.StatusInternalServerError {
+			httpapi.LogError(r.Context(), "login alert link could not be handled", err)
+		}
+		renderStatusPage(r.Context(), w, statusCode, locale, status, siteURL, false)
+		return
+	}
+
+	renderStatusPage(r.Context(), w, http.StatusOK, locale, adminmailpkg.StatusSuccess, siteURL, result.AccountType == domain.AccountTypeAdmin)
+}
+
+func mapLoginAlertError(err error) (adminmailpkg.StatusKey, int) {
+	appErr := apperrors.From(err)
+	switch strings.TrimSpace(appErr.Code) {
+	case "LOGIN_ALERT_LINK_INVALID":
+		return adminmailpkg.StatusInvalidLink, http.StatusBadRequest
+	case "SERVICE_UNAVAILABLE":
+		return adminmailpkg.StatusServiceUnavailable, http.StatusServiceUnavailable
+	case "CONFIG_ERROR":
+		return adminmailpkg.StatusConfigError, http.StatusInternalServerError
+	default:
+		return adminmailpkg.StatusFailed, http.StatusInternalServerError
+	}
+}
+
+func renderStatusPage(
+	ctx context.Context,
+	w http.ResponseWriter,
+	statusCode int,
+	locale string,
+	status adminmailpkg.StatusKey,
+	siteURL string,
+	admin bool,
+) {
+	page, err := adminmailpkg.LoginAlertStatusPage(locale, status, siteURL, admin)
+	if err != nil {
+		httpapi.WriteErrorWithContext(ctx, w, apperrors.Internal("failed to render login alert page", err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(statusCode)
+	_, _ = w.Write([]byte(page))
+}
@@ -0,0 +1,92 @@
+package loginalert
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"suaybsimsek.com/blog-api/internal/domain"
+	"suaybsimsek.com/blog-api/internal/service"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+)
+
+func stubLoginAlertHandler(t *testing.T, fn func(context.Context, string, string) (*service.LoginAlertResult, error)) {
+	t.Helper()
+
+	previousSecureFn := secureAccountFromLoginAlertFn
+	previousResolveSiteURLFn := resolveSiteURLFn
+	t.Cleanup(func() {
+		secureAccountFromLoginAlertFn = previousSecureFn
+		resolveSiteURLFn = previousResolveSiteURLFn
+	})
+	secureAccountFromLoginAlertFn = func(
+		_ *service.Service,
+		ctx context.Context,
+		token string,
+		locale string,
+	) (*service.LoginAlertResult, error) {
+		return fn(ctx, token, locale)
+	}
+	resolveSiteURLFn = func() string { return "https://blog.example.com" }
+}
+
+func TestHandlerSecuresAccountAndRendersStatusPage(t *testing.T) {
+	var gotToken, gotLocale string
+	stubLoginAlertHandler(t, func(_ context.Context, token, locale string) (*service.LoginAlertResult, error) {
+		gotToken, gotLocale = token, locale
+		return &service.LoginAlertResult{AccountType: domain.AccountTypeAdmin}, nil
+	})
+
+	recorder := httptest.NewRecorder()
+	NewHandler(nil)(recorder, httptest.NewRequest(http.MethodGet, "/api/login-alert?token=abc&locale=tr", nil))
+
+	if recorder.Code != http.StatusOK || gotToken != "abc" || gotLocale != "tr" {
+		t.Fatalf("unexpected response %d for token %q locale %q", recorder.Code, gotToken, gotLocale)
+	}
+	body := recorder.Body.String()
+	if !strings.Contains(body, "https://blog.example.com/tr/admin/login") || !strings.Contains(body, "Tum oturumlar kapatildi") {
+		t.Fatalf("unexpected status page %q", body)
+	}
+	if recorder.Header().Get("Cache-Control") != "no-store" || recorder.Header().Get("Referrer-Policy") != "no-referrer" {
+		t.Fatalf("unexpected headers %v", recorder.Header())
+	}
+}
+
+func TestHandlerRendersFailures(t *testing.T) {
+	cases := []struct {
+		err  error
+		code int
+		text string
+	}{
+		{apperrors.New("LOGIN_ALERT_LINK_INVALID", "invalid", http.StatusBadRequest, nil), http.StatusBadRequest, "already been used"},
+		{apperrors.ServiceUnavailable("down", nil), http.StatusServiceUnavailable, "could not be secured right now"},
+		{apperrors.Internal("boom", nil), http.StatusInternalServerError, "could not be secured"},
+	}
+
+	for _, tc := range cases {
+		stubLoginAlertHandler(t, func(context.Context, string, string) (*service.LoginAlertResult, error) {
+			return nil, tc.err
+		})
+
+		recorder := httptest.NewRecorder()
+		NewHandler(nil)(recorder, httptest.NewRequest(http.MethodGet, "/api/login-alert?token=abc&locale=en", nil))
+		if recorder.Code != tc.code || !strings.Contains(recorder.Body.String(), tc.text) {
+			t.Fatalf("unexpected response %d %q for %v", recorder.Code, recorder.Body.String(), tc.err)
+		}
+	}
+}
+
+func TestHandlerOnlyActsOnGet(t *testing.T) {
+	stubLoginAlertHandler(t, func(context.Context, string, string) (*service.LoginAlertResult, error) {
+		t.Fatal("expected the account to be left alone")
+		return nil, nil
+	})
+
+	recorder := httptest.NewRecorder()
+	NewHandler(nil)(recorder, httptest.NewRequest(http.MethodHead, "/api/login-alert?token=abc", nil))
+	if recorder.Code != http.StatusMethodNotAllowed || recorder.Header().Get("Allow") != http.MethodGet {
+		t.Fatalf("unexpected response %d", recorder.Code)
+	}
+}
@@ -2,7 +2,9 @@ package mediaasset
 
 import (
 	"context"
+	"io"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"suaybsimsek.com/blog-api/internal/service"
@@ -10,7 +12,16 @@ import (
 	"suaybsimsek.com/blog-api/pkg/httpapi"
 )
 
-func Handler(w http.ResponseWriter, r *http.Request) {
+var resolveAdminMediaAssetVariantFn = (*service.Service).ResolveAdminMediaAssetVariant
+
+// NewHandler returns the public media endpoint that serves stored assets and their resized variants.
+func NewHandler(services *service.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		handle(w, r, services)
+	}
+}
+
+func handle(w http.ResponseWriter, r *http.Request, services *service.Service) {
 	r = httpapi.EnsureRequestContext(w, r)
 	if r == nil {
 		httpapi.WriteErrorWithContext(context.Background(), w, apperrors.Internal("invalid request context", nil))
@@ -35,12 +46,24 @@ func Handler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	asset, err := service.ResolveAdminMediaAsset(r.Context(), mediaID)
+	query := r.URL.Query()
+	width, err := parseMediaAssetWidth(query.Get("w"))
+	if err != nil {
+		httpapi.WriteErrorWithContext(r.Context(), w, err)
+		return
+	}
+
+	asset, err := resolveAdminMediaAssetVariantFn(services, r.Context(), mediaID, service.AdminMediaAssetVariantRequest{
+		Width:  width,
+		Format: query.Get("format"),
+		Accept: r.Header.Get("Accept"),
+	})
 	if err != nil {
 		httpapi.WriteErrorWithContext(r.Context(), w, err)
 		return
 	}
 
+	w.Header().Set("Vary", "Accept")
 	if mediaAssetETagMatches(r.Header.Values("If-None-Match"), asset.ETag) {
 		w.Header().Set("ETag", asset.ETag)
 		w.Header().Set("Cache-Control", "public, max-age=0, must-revalidate")
@@ -48,15 +71,30 @@ func Handler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	var body io.ReadCloser
+	if r.Method != http.MethodHead {
+		body, err = asset.Open(r.Context())
+		if err != nil {
+			httpapi.WriteErrorWithContext(r.Context(), w, err)
+			return
+		}
+		defer func() {
+			_ = body.Close()
+		}()
+	}
+
 	w.Header().Set("Content-Type", asset.ContentType)
 	w.Header().Set("Cache-Control", "public, max-age=0, must-revalidate")
 	w.Header().Set("ETag", asset.ETag)
 	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if asset.SizeBytes > 0 {
+		w.Header().Set("Content-Length", strconv.Itoa(asset.SizeBytes))
+	}
 	w.WriteHeader(http.StatusOK)
-	if r.Method == http.MethodHead {
+	if body == nil {
 		return
 	}
-	_, _ = w.Write(asset.Data)
+	_, _ = io.Copy(w, body)
 }
 
 func resolveMediaAssetID(r *http.Request) string {
@@ -66,6 +104,20 @@ func resolveMediaAssetID(r *http.Request) string {
 	return strings.TrimSpace(strings.TrimPrefix(r.URL.Path, "/api/media/"))
 }
 
+func parseMediaAssetWidth(value string) (int, error) {
+	resolved := strings.TrimSpace(value)
+	if resolved == "" {
+		return 0, nil
+	}
+
+	width, err := strconv.Atoi(resolved)
+	if err != nil || width <= 0 {
+		return 0, apperrors.BadRequest("media width must be a positive integer")
+	}
+
+	return width, nil
+}
+
 func mediaAssetETagMatches(values []string, current string) bool {
 	resolvedCurrent := strings.TrimSpace(current)
 	if resolvedCurrent == "" {
@@ -0,0 +1,58 @@
+package mediaasset
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"suaybsimsek.com/blog-api/internal/service"
+)
+
+func TestHandlerServesRequestedVariant(t *testing.T) {
+	originalResolveFn := resolveAdminMediaAssetVariantFn
+	t.Cleanup(func() {
+		resolveAdminMediaAssetVariantFn = originalResolveFn
+	})
+
+	var captured service.AdminMediaAssetVariantRequest
+	resolveAdminMediaAssetVariantFn = func(_ *service.Service,
+		_ context.Context,
+		id string,
+		request service.AdminMediaAssetVariantRequest,
+	) (*service.AdminMediaAsset, error) {
+		if id != "cover" {
+			t.Fatalf("unexpected media id %q", id)
+		}
+		captured = request
+		return &service.AdminMediaAsset{ContentType: "image/webp", Data: []byte("webp"), ETag: `"abc-w640-webp"`}, nil
+	}
+
+	request := httptest.NewRequest(http.MethodGet, "/api/media/cover?w=600&format=webp", nil)
+	request.Header.Set("Accept", "image/avif,image/webp,*/*")
+	recorder := httptest.NewRecorder()
+	NewHandler(nil)(recorder, request)
+	if recorder.Code != http.StatusOK || recorder.Body.String() != "webp" {
+		t.Fatalf("unexpected response %d %q", recorder.Code, recorder.Body.String())
+	}
+	if captured.Width != 600 || captured.Format != "webp" || captured.Accept != "image/avif,image/webp,*/*" {
+		t.Fatalf("unexpected variant request %#v", captured)
+	}
+	if recorder.Header().Get("Vary") != "Accept" || recorder.Header().Get("Content-Type") != "image/webp" {
+		t.Fatalf("unexpected headers %#v", recorder.Header())
+	}
+
+	request = httptest.NewRequest(http.MethodGet, "/api/media/cover?w=600", nil)
+	request.Header.Set("If-None-Match", `W/"abc-w640-webp"`)
+	recorder = httptest.NewRecorder()
+	NewHandler(nil)(recorder, request)
+	if recorder.Code != http.StatusNotModified {
+		t.Fatalf("expected 304 for matching etag, got %d", recorder.Code)
+	}
+
+	recorder = httptest.NewRecorder()
+	NewHandler(nil)(recorder, httptest.NewRequest(http.MethodGet, "/api/media/cover?w=wide", nil))
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for invalid width, got %d", recorder.Code)
+	}
+}
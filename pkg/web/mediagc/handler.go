@@ -0,0 +1,88 @@
+package mediagc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/service"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpapi"
+)
+
+type garbageCollectionResponse struct {
+	Status           string `json:"status"`
+	Message          string `json:"message"`
+	Timestamp        string `json:"timestamp"`
+	DryRun           bool   `json:"dryRun"`
+	ScannedCount     int    `json:"scannedCount"`
+	QuarantinedCount int    `json:"quarantinedCount"`
+	RestoredCount    int    `json:"restoredCount"`
+	PurgedCount      int    `json:"purgedCount"`
+	FailedCount      int    `json:"failedCount"`
+	ReclaimedBytes   int64  `json:"reclaimedBytes"`
+}
+
+// NewHandler returns the cron endpoint that quarantines and purges unused media uploads.
+func NewHandler(services *service.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		handle(w, r, services)
+	}
+}
+
+func handle(w http.ResponseWriter, r *http.Request, services *service.Service) {
+	r = httpapi.EnsureRequestContext(w, r)
+	if r == nil {
+		httpapi.WriteErrorWithContext(context.Background(), w, apperrors.Internal("invalid request context", nil))
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+
+	if r.Method == http.MethodOptions {
+		w.Header().Set("Allow", "GET, OPTIONS")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET, OPTIONS")
+		httpapi.WriteErrorWithContext(r.Context(), w, apperrors.MethodNotAllowed("method not allowed"))
+		return
+	}
+
+	cronSecret, err := appconfig.ResolveCronSecret()
+	if err != nil {
+		httpapi.WriteErrorWithContext(r.Context(), w, apperrors.Config("configuration error", err))
+		return
+	}
+	if strings.TrimSpace(r.Header.Get("Authorization")) != "Bearer "+cronSecret {
+		httpapi.WriteErrorWithContext(r.Context(), w, apperrors.Unauthorized("unauthorized"))
+		return
+	}
+
+	dryRun, _ := strconv.ParseBool(strings.TrimSpace(r.URL.Query().Get("dryRun")))
+	result, err := services.RunAdminMediaGarbageCollection(r.Context(), time.Now().UTC(), dryRun)
+	if err != nil {
+		httpapi.WriteErrorWithContext(r.Context(), w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(garbageCollectionResponse{
+		Status:           "success",
+		Message:          "media garbage collection finished",
+		Timestamp:        result.RanAt.UTC().Format(time.RFC3339),
+		DryRun:           result.DryRun,
+		ScannedCount:     result.Scanned,
+		QuarantinedCount: result.Quarantined,
+		RestoredCount:    result.Restored,
+		PurgedCount:      result.Purged,
+		FailedCount:      result.Failed,
+		ReclaimedBytes:   result.ReclaimedBytes,
+	})
+}
@@ -115,11 +115,9 @@ type topicBadgeStyle struct {
 	BorderColor string
 }
 
-var (
-	dispatchClient  *mongo.Client
-	dispatchInitErr error
-	dispatchOnce    sync.Once
+var errDispatchClientUnavailable = errors.New("mongodb client is not configured")
 
+var (
 	dispatchSubscriberIndexOnce sync.Once
 	dispatchSubscriberIndexErr  error
 
@@ -268,33 +266,6 @@ func resolveRSSURL(siteURL, locale string) string {
 	}
 }
 
-func getDispatchClient() (*mongo.Client, error) {
-	dispatchOnce.Do(func() {
-		databaseConfig, err := appconfig.ResolveDatabaseConfig()
-		if err != nil {
-			dispatchInitErr = err
-			return
-		}
-
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
-
-		client, err := appconfig.NewMongoClient(ctx, databaseConfig, "blog-api-newsletter-dispatch")
-		if err != nil {
-			dispatchInitErr = fmt.Errorf("mongodb connect failed: %w", err)
-			return
-		}
-
-		dispatchClient = client
-	})
-
-	if dispatchInitErr != nil {
-		return nil, dispatchInitErr
-	}
-
-	return dispatchClient, nil
-}
-
 func ensureCampaignIndexes(collection *mongo.Collection) error {
 	dispatchIndexOnce.Do(func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -1045,7 +1016,14 @@ func handleTestDispatch(
 	})
 }
 
-func Handler(w http.ResponseWriter, r *http.Request) {
+// NewHandler returns the dispatch endpoint. It works on client, the application's shared MongoDB client.
+func NewHandler(client *mongo.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		handle(w, r, client)
+	}
+}
+
+func handle(w http.ResponseWriter, r *http.Request, client *mongo.Client) {
 	httpConfig := appconfig.ResolveHTTPConfig()
 	if httpConfig.AllowedOrigin != "" {
 		w.Header().Set("Access-Control-Allow-Origin", httpConfig.AllowedOrigin)
@@ -1089,9 +1067,8 @@ func Handler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	client, err := getDispatchClient()
-	if err != nil {
-		writeDispatchError(w, apperrors.ServiceUnavailable("database unavailable", err))
+	if client == nil {
+		writeDispatchError(w, apperrors.ServiceUnavailable("database unavailable", errDispatchClientUnavailable))
 		return
 	}
 
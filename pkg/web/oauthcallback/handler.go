@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"strings"
 
+	appservice "suaybsimsek.com/blog-api/internal/service"
 	admingithubhandler "suaybsimsek.com/blog-api/pkg/web/admingithub"
 	admingooglehandler "suaybsimsek.com/blog-api/pkg/web/admingoogle"
 	readergithubhandler "suaybsimsek.com/blog-api/pkg/web/readergithub"
@@ -21,34 +22,46 @@ type callbackTarget struct {
 	handler    func(http.ResponseWriter, *http.Request)
 }
 
-func GoogleHandler(w http.ResponseWriter, r *http.Request) {
-	dispatchProviderCallback(w, r, []callbackTarget{
+// NewGoogleHandler returns the shared Google callback, which hands the request to the admin or reader flow whose state
+// cookie matches.
+func NewGoogleHandler(services *appservice.Service) http.HandlerFunc {
+	targets := []callbackTarget{
 		{
 			cookieName: "admin_google_oauth_state",
 			targetPath: googleCallbackPath,
-			handler:    admingooglehandler.Handler,
+			handler:    admingooglehandler.NewHandler(services),
 		},
 		{
 			cookieName: "reader_google_oauth_state",
 			targetPath: googleCallbackPath,
-			handler:    readergooglehandler.Handler,
+			handler:    readergooglehandler.NewHandler(services),
 		},
-	})
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		dispatchProviderCallback(w, r, targets)
+	}
 }
 
-func GithubHandler(w http.ResponseWriter, r *http.Request) {
-	dispatchProviderCallback(w, r, []callbackTarget{
+// NewGithubHandler returns the shared GitHub callback, which hands the request to the admin or reader flow whose state
+// cookie matches.
+func NewGithubHandler(services *appservice.Service) http.HandlerFunc {
+	targets := []callbackTarget{
 		{
 			cookieName: "admin_github_oauth_state",
 			targetPath: githubCallbackPath,
-			handler:    admingithubhandler.Handler,
+			handler:    admingithubhandler.NewHandler(services),
 		},
 		{
 			cookieName: "reader_github_oauth_state",
 			targetPath: githubCallbackPath,
-			handler:    readergithubhandler.Handler,
+			handler:    readergithubhandler.NewHandler(services),
 		},
-	})
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		dispatchProviderCallback(w, r, targets)
+	}
 }
 
 func dispatchProviderCallback(w http.ResponseWriter, r *http.Request, targets []callbackTarget) {
@@ -0,0 +1,553 @@
+package oidcauth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	appservice "suaybsimsek.com/blog-api/internal/service"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpapi"
+	"suaybsimsek.com/blog-api/pkg/httpauth"
+	"suaybsimsek.com/blog-api/pkg/oidc"
+	readeroauth "suaybsimsek.com/blog-api/pkg/web/readeroauth"
+)
+
+const (
+	oidcCallbackPath       = "/api/oidc/callback"
+	adminOIDCStateCookie   = "admin_oidc_auth_state"
+	readerOIDCStateCookie  = "reader_oidc_auth_state"
+	oidcStateCookiePath    = "/api/oidc"
+	oidcStateTTL           = 10 * time.Minute
+	oidcStateVersion       = "v1"
+	oidcFlowAdmin          = "admin"
+	oidcFlowReader         = "reader"
+	oidcIntentLogin        = "login"
+	oidcIntentConnect      = "connect"
+	oidcIntentInvite       = "invite"
+	oidcCallbackTimeout    = 15 * time.Second
+	oidcStartFailedMessage = "oidc auth start failed"
+)
+
+// oidcAuthState is kept in a signed cookie between the start and the callback. Unlike the Google and GitHub state it
+// also carries the nonce and PKCE verifier, so only the random State value is sent to the provider.
+type oidcAuthState struct {
+	Provider     string `json:"provider"`
+	Flow         string `json:"flow"`
+	Intent       string `json:"intent"`
+	Locale       string `json:"locale"`
+	ReturnTo     string `json:"returnTo,omitempty"`
+	UserID       string `json:"userId,omitempty"`
+	Invitation   string `json:"invitation,omitempty"`
+	RememberMe   bool   `json:"rememberMe,omitempty"`
+	State        string `json:"state"`
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"codeVerifier"`
+	ExpiresAt    int64  `json:"exp"`
+}
+
+// NewHandler returns the callback shared by every provider in OIDC_PROVIDERS, for both admin and reader flows.
+func NewHandler(services *appservice.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		handle(w, r, services)
+	}
+}
+
+func handle(w http.ResponseWriter, r *http.Request, services *appservice.Service) {
+	r = httpapi.EnsureRequestContext(w, r)
+	if r == nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	switch r.URL.Path {
+	case oidcCallbackPath:
+		handleOIDCCallback(w, r, services)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// NewStartHandler returns the handler that begins a sign-in with a provider listed in OIDC_PROVIDERS. Unknown
+// providers get a 404 like any other unsupported provider on /api/oauth/connect.
+func NewStartHandler(services *appservice.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		start(w, r, services)
+	}
+}
+
+func start(w http.ResponseWriter, r *http.Request, services *appservice.Service) {
+	r = httpapi.EnsureRequestContext(w, r)
+	if r == nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	handleOIDCStart(w, r, services)
+}
+
+func handleOIDCStart(w http.ResponseWriter, r *http.Request, services *appservice.Service) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	providerID := strings.TrimSpace(strings.ToLower(query.Get("provider")))
+	flow := strings.TrimSpace(strings.ToLower(query.Get("flow")))
+	if flow != oidcFlowAdmin && flow != oidcFlowReader {
+		http.NotFound(w, r)
+		return
+	}
+
+	_, found, err := appconfig.ResolveOIDCProvider(providerID)
+	if err != nil {
+		slog.WarnContext(r.Context(), oidcStartFailedMessage, "reason", "provider_config_invalid", "error", err)
+	}
+	if err != nil || !found {
+		http.NotFound(w, r)
+		return
+	}
+
+	state := oidcAuthState{
+		Provider:  providerID,
+		Flow:      flow,
+		Intent:    resolveOIDCIntent(flow, query.Get("intent")),
+		ExpiresAt: time.Now().UTC().Add(oidcStateTTL).Unix(),
+	}
+	if flow == oidcFlowAdmin {
+		state.Locale = resolveAdminLocale(query.Get("locale"))
+	} else {
+		state.Locale = readeroauth.ResolveReaderLocale(query.Get("locale"))
+		state.ReturnTo = readeroauth.SanitizeReaderReturnTo(query.Get("returnTo"), query.Get("locale"))
+	}
+
+	secret, secureCookies := resolveFlowCookieSettings(flow)
+	siteURL, err := appconfig.ResolveSiteURL()
+	if strings.TrimSpace(secret) == "" || err != nil {
+		slog.WarnContext(r.Context(), oidcStartFailedMessage, "reason", "flow_not_configured", "flow", flow, "error", err)
+		redirectToFlow(w, r, state, "failed")
+		return
+	}
+
+	switch state.Intent {
+	case oidcIntentConnect:
+		userID, err := resolveAuthenticatedUserID(r, services, flow)
+		if err != nil {
+			redirectToFlow(w, r, state, "failed")
+			return
+		}
+		state.UserID = userID
+	case oidcIntentInvite:
+		state.Invitation = strings.TrimSpace(query.Get("token"))
+		if state.Invitation == "" {
+			redirectToFlow(w, r, state, "invalid-link")
+			return
+		}
+	default:
+		state.RememberMe = readeroauth.ResolveBooleanQueryValue(query.Get("rememberMe"))
+	}
+
+	authorization, err := appservice.StartOIDCAuthorization(r.Context(), providerID, strings.TrimRight(siteURL, "/")+oidcCallbackPath)
+	if err != nil {
+		slog.WarnContext(r.Context(), oidcStartFailedMessage, "reason", "authorize_url_build_failed", "provider", providerID, "error", err)
+		redirectToFlow(w, r, state, "failed")
+		return
+	}
+	state.State = authorization.Request.State
+	state.Nonce = authorization.Request.Nonce
+	state.CodeVerifier = authorization.Request.CodeVerifier
+
+	stateToken, err := issueOIDCAuthState(state, secret)
+	if err != nil {
+		slog.WarnContext(r.Context(), oidcStartFailedMessage, "reason", "state_issue_failed", "error", err)
+		redirectToFlow(w, r, state, "failed")
+		return
+	}
+
+	httpauth.SetCookie(w, stateCookieName(flow), stateToken, int(oidcStateTTL.Seconds()), secureCookies, oidcStateCookiePath)
+	http.Redirect(w, r, authorization.URL, http.StatusTemporaryRedirect)
+}
+
+func handleOIDCCallback(w http.ResponseWriter, r *http.Request, services *appservice.Service) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	state := resolveCallbackState(r, strings.TrimSpace(query.Get("state")))
+	clearOIDCStateCookies(w)
+	if state == nil {
+		slog.WarnContext(r.Context(), "oidc auth callback failed", "reason", "state_mismatch")
+		http.Redirect(w, r, "/"+resolveAdminLocale(query.Get("locale")), http.StatusSeeOther)
+		return
+	}
+
+	if providerError := strings.TrimSpace(query.Get("error")); providerError != "" {
+		slog.WarnContext(r.Context(), "oidc auth callback cancelled", "provider", state.Provider, "providerError", providerError)
+		redirectToFlow(w, r, *state, "cancelled")
+		return
+	}
+
+	code := strings.TrimSpace(query.Get("code"))
+	siteURL, err := appconfig.ResolveSiteURL()
+	if code == "" || err != nil {
+		redirectToFlow(w, r, *state, "failed")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), oidcCallbackTimeout)
+	defer cancel()
+
+	identity, err := appservice.ResolveOIDCIdentityFromCode(
+		ctx,
+		state.Provider,
+		code,
+		strings.TrimRight(siteURL, "/")+oidcCallbackPath,
+		oidc.AuthRequest{State: state.State, Nonce: state.Nonce, CodeVerifier: state.CodeVerifier},
+	)
+	if err != nil {
+		slog.WarnContext(r.Context(), "oidc auth callback failed", "reason", "identity_exchange_failed", "provider", state.Provider, "error", err)
+		redirectToFlow(w, r, *state, "failed")
+		return
+	}
+
+	if state.Flow == oidcFlowAdmin {
+		completeAdminOIDCFlow(ctx, w, r, services, *state, identity)
+		return
+	}
+	completeReaderOIDCFlow(ctx, w, r, services, *state, identity)
+}
+
+func completeAdminOIDCFlow(
+	ctx context.Context,
+	w http.ResponseWriter,
+	r *http.Request,
+	services *appservice.Service,
+	state oidcAuthState,
+	identity *appservice.OIDCIdentity,
+) {
+	adminConfig := appconfig.ResolveAdminConfig()
+	rememberMe := state.RememberMe
+
+	switch state.Intent {
+	case oidcIntentConnect:
+		if _, err := services.LinkAdminOIDCAccount(ctx, state.UserID, identity); err != nil {
+			redirectToFlow(w, r, state, mapOIDCErrorToStatus(err, state.Intent))
+			return
+		}
+		redirectToFlow(w, r, state, "connected")
+		return
+	case oidcIntentInvite:
+		if _, err := services.AcceptAdminInvitationWithOIDC(ctx, state.Invitation, identity); err != nil {
+			redirectToFlow(w, r, state, mapOIDCErrorToStatus(err, state.Intent))
+			return
+		}
+		rememberMe = false
+	}
+
+	payload, err := services.LoginAdminWithOIDCIdentity(ctx, identity, rememberMe, resolveAdminSessionMetadata(ctx, r))
+	if err != nil {
+		redirectToFlow(w, r, state, mapOIDCErrorToStatus(err, state.Intent))
+		return
+	}
+	if payload.MFARequired {
+		redirectToAdminMFA(w, r, state, payload.MFAToken)
+		return
+	}
+
+	setAdminSessionCookies(w, adminConfig, payload)
+	http.Redirect(w, r, "/"+state.Locale+"/admin", http.StatusSeeOther)
+}
+
+func completeReaderOIDCFlow(
+	ctx context.Context,
+	w http.ResponseWriter,
+	r *http.Request,
+	services *appservice.Service,
+	state oidcAuthState,
+	identity *appservice.OIDCIdentity,
+) {
+	if state.Intent == oidcIntentConnect {
+		if err := services.LinkReaderOIDCAccount(ctx, state.UserID, identity); err != nil {
+			slog.WarnContext(r.Context(), "oidc auth callback failed", "reason", "reader_link_failed", "provider", state.Provider, "error", err)
+			redirectToFlow(w, r, state, mapOIDCErrorToStatus(err, state.Intent))
+			return
+		}
+		redirectToFlow(w, r, state, "connected")
+		return
+	}
+
+	trace, _ := httpapi.RequestTraceFromContext(r.Context())
+	payload, err := services.LoginReaderWithOIDCIdentity(ctx, identity, state.RememberMe, appservice.ReaderSessionMetadata{
+		UserAgent:   strings.TrimSpace(r.UserAgent()),
+		RemoteIP:    trace.RemoteIP,
+		CountryCode: trace.CountryCode,
+	})
+	if err != nil {
+		slog.WarnContext(r.Context(), "oidc auth callback failed", "reason", "reader_login_failed", "provider", state.Provider, "error", err)
+		redirectToFlow(w, r, state, mapOIDCErrorToStatus(err, state.Intent))
+		return
+	}
+
+	readeroauth.SetReaderSessionCookies(w, appconfig.ResolveReaderConfig(), payload)
+	redirectToFlow(w, r, state, "connected")
+}
+
+// resolveCallbackState returns the admin or reader state whose random value matches the callback. Both cookies can
+// exist at once when a reader and an admin sign-in overlap in the same browser.
+func resolveCallbackState(r *http.Request, stateValue string) *oidcAuthState {
+	if stateValue == "" {
+		return nil
+	}
+
+	for _, flow := range []string{oidcFlowAdmin, oidcFlowReader} {
+		cookie, err := r.Cookie(stateCookieName(flow))
+		if err != nil || strings.TrimSpace(cookie.Value) == "" {
+			continue
+		}
+
+		secret, _ := resolveFlowCookieSettings(flow)
+		state, err := parseOIDCAuthState(cookie.Value, secret, time.Now().UTC())
+		if err != nil || state.Flow != flow {
+			continue
+		}
+		if hmac.Equal([]byte(state.State), []byte(stateValue)) {
+			return state
+		}
+	}
+
+	return nil
+}
+
+func resolveOIDCIntent(flow, value string) string {
+	intent := strings.TrimSpace(strings.ToLower(value))
+	switch {
+	case intent == oidcIntentConnect:
+		return intent
+	case intent == oidcIntentInvite && flow == oidcFlowAdmin:
+		return intent
+	default:
+		return oidcIntentLogin
+	}
+}
+
+func resolveAuthenticatedUserID(r *http.Request, services *appservice.Service, flow string) (string, error) {
+	if flow == oidcFlowAdmin {
+		cookie, err := r.Cookie(appconfig.ResolveAdminConfig().AccessCookieName)
+		if err != nil || strings.TrimSpace(cookie.Value) == "" {
+			return "", apperrors.Unauthorized("admin authentication required")
+		}
+		adminUser, err := services.ResolveAdminFromAccessToken(r.Context(), cookie.Value)
+		if err != nil {
+			return "", err
+		}
+		if adminUser == nil || strings.TrimSpace(adminUser.ID) == "" {
+			return "", apperrors.Unauthorized("admin authentication required")
+		}
+		return strings.TrimSpace(adminUser.ID), nil
+	}
+
+	cookie, err := r.Cookie(appconfig.ResolveReaderConfig().AccessCookieName)
+	if err != nil || strings.TrimSpace(cookie.Value) == "" {
+		return "", apperrors.Unauthorized("reader authentication required")
+	}
+	reader, err := services.ResolveReaderFromAccessToken(r.Context(), cookie.Value)
+	if err != nil {
+		return "", err
+	}
+	if reader == nil || strings.TrimSpace(reader.ID) == "" {
+		return "", apperrors.Unauthorized("reader authentication required")
+	}
+	return strings.TrimSpace(reader.ID), nil
+}
+
+func resolveFlowCookieSettings(flow string) (string, bool) {
+	if flow == oidcFlowAdmin {
+		config := appconfig.ResolveAdminConfig()
+		return config.JWTSecret, config.SecureCookies
+	}
+
+	config := appconfig.ResolveReaderConfig()
+	return config.JWTSecret, config.SecureCookies
+}
+
+func stateCookieName(flow string) string {
+	if flow == oidcFlowAdmin {
+		return adminOIDCStateCookie
+	}
+	return readerOIDCStateCookie
+}
+
+func clearOIDCStateCookies(w http.ResponseWriter) {
+	httpauth.ClearCookie(w, adminOIDCStateCookie, appconfig.ResolveAdminConfig().SecureCookies, oidcStateCookiePath)
+	httpauth.ClearCookie(w, readerOIDCStateCookie, appconfig.ResolveReaderConfig().SecureCookies, oidcStateCookiePath)
+}
+
+func issueOIDCAuthState(state oidcAuthState, secret string) (string, error) {
+	payloadBytes, err := json.Marshal(state)
+	if err != nil {
+		return "", err
+	}
+
+	payload := base64.RawURLEncoding.EncodeToString(payloadBytes)
+	return oidcStateVersion + "." + payload + "." + signOIDCAuthState(payload, secret), nil
+}
+
+func parseOIDCAuthState(token, secret string, now time.Time) (*oidcAuthState, error) {
+	parts := strings.Split(strings.TrimSpace(token), ".")
+	if len(parts) != 3 || parts[0] != oidcStateVersion {
+		return nil, errors.New("invalid oidc state")
+	}
+	if !hmac.Equal([]byte(parts[2]), []byte(signOIDCAuthState(parts[1], secret))) {
+		return nil, errors.New("invalid oidc state signature")
+	}
+
+	payloadBytes, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	var payload oidcAuthState
+	if err := json.Unmarshal(payloadBytes, &payload); err != nil {
+		return nil, err
+	}
+	if payload.ExpiresAt <= now.UTC().Unix() {
+		return nil, errors.New("expired oidc state")
+	}
+	if payload.State == "" || payload.Nonce == "" || payload.CodeVerifier == "" {
+		return nil, errors.New("incomplete oidc state")
+	}
+	if payload.Intent != resolveOIDCIntent(payload.Flow, payload.Intent) {
+		return nil, errors.New("invalid oidc intent")
+	}
+
+	return &payload, nil
+}
+
+func signOIDCAuthState(payload, secret string) string {
+	mac := hmac.New(sha256.New, []byte(strings.TrimSpace(secret)))
+	_, _ = mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func mapOIDCErrorToStatus(err error, intent string) string {
+	appErr := apperrors.From(err)
+	if appErr == nil {
+		return "failed"
+	}
+
+	switch strings.TrimSpace(appErr.Code) {
+	case "ADMIN_INVITATION_TOKEN_INVALID":
+		return "invalid-link"
+	case "ADMIN_INVITATION_TOKEN_EXPIRED":
+		return "expired"
+	case "ADMIN_LOGIN_LOCKED":
+		return "locked"
+	case "ADMIN_LOGIN_THROTTLED":
+		return "throttled"
+	}
+	if intent == oidcIntentLogin && strings.EqualFold(strings.TrimSpace(appErr.Code), "UNAUTHORIZED") {
+		return "not-linked"
+	}
+	if strings.EqualFold(strings.TrimSpace(appErr.Code), "BAD_REQUEST") {
+		message := strings.ToLower(strings.TrimSpace(appErr.Message))
+		if strings.Contains(message, "already linked") || strings.Contains(message, "disconnect the current account") {
+			return "conflict"
+		}
+	}
+
+	return "failed"
+}
+
+func redirectToFlow(w http.ResponseWriter, r *http.Request, state oidcAuthState, status string) {
+	if state.Flow == oidcFlowReader {
+		readeroauth.RedirectToReaderFlow(w, r, state.ReturnTo, state.Provider, status)
+		return
+	}
+
+	page := "login"
+	switch state.Intent {
+	case oidcIntentConnect:
+		page = "settings/security"
+	case oidcIntentInvite:
+		page = "accept-invitation"
+	}
+
+	redirectPath := fmt.Sprintf(
+		"/%s/admin/%s?oidc=%s&provider=%s",
+		resolveAdminLocale(state.Locale),
+		page,
+		url.QueryEscape(status),
+		url.QueryEscape(state.Provider),
+	)
+	http.Redirect(w, r, redirectPath, http.StatusSeeOther)
+}
+
+// redirectToAdminMFA sends an admin with two-factor authentication back to the login page to enter a code. The
+// mfa-pending token travels in the fragment, which browsers do not send to servers or put in Referer headers.
+func redirectToAdminMFA(w http.ResponseWriter, r *http.Request, state oidcAuthState, mfaToken string) {
+	redirectPath := fmt.Sprintf(
+		"/%s/admin/login?oidc=mfa-required&provider=%s#mfaToken=%s",
+		resolveAdminLocale(state.Locale),
+		url.QueryEscape(state.Provider),
+		url.QueryEscape(mfaToken),
+	)
+	http.Redirect(w, r, redirectPath, http.StatusSeeOther)
+}
+
+func resolveAdminLocale(value string) string {
+	if strings.EqualFold(strings.TrimSpace(value), "tr") {
+		return "tr"
+	}
+	return "en"
+}
+
+func resolveAdminSessionMetadata(ctx context.Context, request *http.Request) appservice.AdminSessionMetadata {
+	metadata := appservice.AdminSessionMetadata{}
+	if trace, ok := httpapi.RequestTraceFromContext(ctx); ok {
+		metadata.RemoteIP = strings.TrimSpace(trace.RemoteIP)
+		metadata.UserAgent = strings.TrimSpace(trace.UserAgent)
+		metadata.CountryCode = strings.TrimSpace(strings.ToUpper(trace.CountryCode))
+	}
+
+	if request != nil && strings.TrimSpace(metadata.UserAgent) == "" {
+		metadata.UserAgent = strings.TrimSpace(request.UserAgent())
+	}
+
+	return metadata
+}
+
+func setAdminSessionCookies(w http.ResponseWriter, config appconfig.AdminConfig, payload *appservice.AdminAuthResponse) {
+	if payload == nil {
+		return
+	}
+
+	httpauth.SetSessionCookie(w, config.AccessCookieName, payload.AccessToken, config.SecureCookies, "/")
+	if payload.RememberMe {
+		httpauth.SetCookie(
+			w,
+			config.RefreshCookieName,
+			payload.RefreshToken,
+			int(payload.RefreshTTL.Seconds()),
+			config.SecureCookies,
+			"/api/admin",
+		)
+		return
+	}
+
+	httpauth.SetSessionCookie(w, config.RefreshCookieName, payload.RefreshToken, config.SecureCookies, "/api/admin")
+}
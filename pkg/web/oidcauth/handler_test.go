@@ -0,0 +1,209 @@
+package oidcauth
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/oidc/oidctest"
+)
+
+func configureOIDCTestProvider(t *testing.T) *oidctest.Issuer {
+	t.Helper()
+
+	issuer := oidctest.NewIssuer(t)
+	t.Setenv("JWT_SECRET", "state-secret")
+	t.Setenv("SITE_URL", "https://blog.example.com")
+	t.Setenv("OIDC_PROVIDERS", "keycloak")
+	t.Setenv("OIDC_KEYCLOAK_ISSUER", issuer.URL())
+	t.Setenv("OIDC_KEYCLOAK_CLIENT_ID", issuer.ClientID)
+	return issuer
+}
+
+func findResponseCookie(recorder *httptest.ResponseRecorder, name string) *http.Cookie {
+	for _, cookie := range recorder.Result().Cookies() {
+		if cookie.Name == name {
+			return cookie
+		}
+	}
+	return nil
+}
+
+func TestStartRedirectsReaderToProviderWithPKCE(t *testing.T) {
+	issuer := configureOIDCTestProvider(t)
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/api/oauth/connect?provider=keycloak&flow=reader&locale=tr&returnTo=/tr/blog/post&rememberMe=true",
+		nil,
+	)
+	recorder := httptest.NewRecorder()
+	NewStartHandler(nil)(recorder, req)
+
+	if recorder.Code != http.StatusTemporaryRedirect {
+		t.Fatalf("expected 307, got %d", recorder.Code)
+	}
+	location, err := url.Parse(recorder.Header().Get("Location"))
+	if err != nil || !strings.HasPrefix(location.String(), issuer.URL()+"/authorize") {
+		t.Fatalf("unexpected redirect %q", recorder.Header().Get("Location"))
+	}
+
+	cookie := findResponseCookie(recorder, readerOIDCStateCookie)
+	if cookie == nil || cookie.Path != oidcStateCookiePath || !cookie.HttpOnly {
+		t.Fatalf("unexpected state cookie %#v", cookie)
+	}
+	state, err := parseOIDCAuthState(cookie.Value, "state-secret", time.Now().UTC())
+	if err != nil {
+		t.Fatalf("parseOIDCAuthState returned error: %v", err)
+	}
+
+	query := location.Query()
+	if query.Get("state") != state.State || query.Get("nonce") != state.Nonce || query.Get("code_challenge") == "" {
+		t.Fatalf("authorize query does not match the stored state: %v", query)
+	}
+	if strings.Contains(location.String(), state.CodeVerifier) {
+		t.Fatal("expected the PKCE verifier to stay out of the authorize url")
+	}
+	if query.Get("redirect_uri") != "https://blog.example.com/api/oidc/callback" {
+		t.Fatalf("unexpected redirect_uri %q", query.Get("redirect_uri"))
+	}
+	if state.Flow != oidcFlowReader || state.Intent != oidcIntentLogin || state.ReturnTo != "/tr/blog/post" || !state.RememberMe {
+		t.Fatalf("unexpected state %#v", state)
+	}
+}
+
+func TestStartRejectsUnknownProvidersAndIncompleteRequests(t *testing.T) {
+	configureOIDCTestProvider(t)
+
+	recorder := httptest.NewRecorder()
+	NewStartHandler(nil)(recorder, httptest.NewRequest(http.MethodGet, "/api/oauth/connect?provider=gitlab&flow=reader", nil))
+	if recorder.Code != http.StatusNotFound {
+		t.Fatalf("expected unknown provider to 404, got %d", recorder.Code)
+	}
+
+	recorder = httptest.NewRecorder()
+	NewStartHandler(nil)(recorder, httptest.NewRequest(http.MethodGet, "/api/oauth/connect?provider=keycloak&flow=admin&intent=invite", nil))
+	if location := recorder.Header().Get("Location"); location != "/en/admin/accept-invitation?oidc=invalid-link&provider=keycloak" {
+		t.Fatalf("unexpected invite redirect %q", location)
+	}
+
+	recorder = httptest.NewRecorder()
+	NewStartHandler(nil)(recorder, httptest.NewRequest(http.MethodGet, "/api/oauth/connect?provider=keycloak&flow=admin&intent=connect&locale=tr", nil))
+	if location := recorder.Header().Get("Location"); location != "/tr/admin/settings/security?oidc=failed&provider=keycloak" {
+		t.Fatalf("expected unauthenticated connect to fail, got %q", location)
+	}
+}
+
+func TestCallbackRejectsStateMismatch(t *testing.T) {
+	configureOIDCTestProvider(t)
+
+	token, err := issueOIDCAuthState(oidcAuthState{
+		Provider:     "keycloak",
+		Flow:         oidcFlowReader,
+		Intent:       oidcIntentLogin,
+		Locale:       "en",
+		ReturnTo:     "/en",
+		State:        "expected-state",
+		Nonce:        "nonce",
+		CodeVerifier: "verifier",
+		ExpiresAt:    time.Now().Add(time.Minute).Unix(),
+	}, "state-secret")
+	if err != nil {
+		t.Fatalf("issueOIDCAuthState returned error: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/oidc/callback?state=other-state&code=abc", nil)
+	req.AddCookie(&http.Cookie{Name: readerOIDCStateCookie, Value: token})
+	recorder := httptest.NewRecorder()
+	NewHandler(nil)(recorder, req)
+
+	if recorder.Code != http.StatusSeeOther || recorder.Header().Get("Location") != "/en" {
+		t.Fatalf("unexpected response %d %q", recorder.Code, recorder.Header().Get("Location"))
+	}
+	if cookie := findResponseCookie(recorder, readerOIDCStateCookie); cookie == nil || cookie.MaxAge >= 0 {
+		t.Fatalf("expected the state cookie to be cleared, got %#v", cookie)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/oidc/callback?state=expected-state&error=access_denied", nil)
+	req.AddCookie(&http.Cookie{Name: readerOIDCStateCookie, Value: token})
+	recorder = httptest.NewRecorder()
+	NewHandler(nil)(recorder, req)
+	if location := recorder.Header().Get("Location"); location != "/en?commentAuth=cancelled&commentProvider=keycloak" {
+		t.Fatalf("unexpected cancel redirect %q", location)
+	}
+}
+
+func TestParseOIDCAuthStateRejectsTampering(t *testing.T) {
+	state := oidcAuthState{
+		Provider:     "keycloak",
+		Flow:         oidcFlowAdmin,
+		Intent:       oidcIntentConnect,
+		UserID:       "admin-1",
+		State:        "state",
+		Nonce:        "nonce",
+		CodeVerifier: "verifier",
+		ExpiresAt:    time.Now().Add(time.Minute).Unix(),
+	}
+	token, err := issueOIDCAuthState(state, "secret")
+	if err != nil {
+		t.Fatalf("issueOIDCAuthState returned error: %v", err)
+	}
+
+	if _, err := parseOIDCAuthState(token, "other-secret", time.Now()); err == nil {
+		t.Fatal("expected a state signed with another secret to fail")
+	}
+	if _, err := parseOIDCAuthState(token, "secret", time.Now().Add(2*time.Minute)); err == nil {
+		t.Fatal("expected an expired state to fail")
+	}
+
+	state.Flow = oidcFlowReader
+	state.Intent = oidcIntentInvite
+	token, _ = issueOIDCAuthState(state, "secret")
+	if _, err := parseOIDCAuthState(token, "secret", time.Now()); err == nil {
+		t.Fatal("expected reader invite intent to fail")
+	}
+}
+
+func TestMapOIDCErrorToStatus(t *testing.T) {
+	cases := []struct {
+		err    error
+		intent string
+		want   string
+	}{
+		{apperrors.Unauthorized("invalid credentials"), oidcIntentLogin, "not-linked"},
+		{apperrors.BadRequest("account is already linked to another admin"), oidcIntentConnect, "conflict"},
+		{apperrors.BadRequest("disconnect the current account before linking a different one"), oidcIntentConnect, "conflict"},
+		{apperrors.New("ADMIN_INVITATION_TOKEN_EXPIRED", "expired", http.StatusBadRequest, nil), oidcIntentInvite, "expired"},
+		{apperrors.New("ADMIN_LOGIN_LOCKED", "locked", http.StatusTooManyRequests, nil), oidcIntentLogin, "locked"},
+		{apperrors.New("ADMIN_LOGIN_THROTTLED", "throttled", http.StatusTooManyRequests, nil), oidcIntentLogin, "throttled"},
+		{errors.New("boom"), oidcIntentLogin, "failed"},
+	}
+
+	for _, tc := range cases {
+		if got := mapOIDCErrorToStatus(tc.err, tc.intent); got != tc.want {
+			t.Fatalf("mapOIDCErrorToStatus(%v, %q) = %q, want %q", tc.err, tc.intent, got, tc.want)
+		}
+	}
+}
+
+func TestRedirectToAdminMFAKeepsTokenOutOfTheQuery(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/oidc/callback", nil)
+	redirectToAdminMFA(recorder, request, oidcAuthState{Locale: "tr", Provider: "keycloak"}, "mfa.token/value")
+
+	location, err := url.Parse(recorder.Header().Get("Location"))
+	if err != nil || recorder.Code != http.StatusSeeOther {
+		t.Fatalf("unexpected redirect %d %q", recorder.Code, recorder.Header().Get("Location"))
+	}
+	if location.Path != "/tr/admin/login" || location.Query().Get("oidc") != "mfa-required" || location.Query().Get("provider") != "keycloak" {
+		t.Fatalf("unexpected redirect %s", location)
+	}
+	if location.Query().Has("mfaToken") || location.Fragment != "mfaToken=mfa.token/value" {
+		t.Fatalf("expected the token in the fragment only, got %s", location)
+	}
+}
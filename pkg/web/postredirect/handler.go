@@ -0,0 +1,82 @@
+package postredirect
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/service"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
+	"suaybsimsek.com/blog-api/pkg/httpapi"
+	"suaybsimsek.com/blog-api/pkg/newsletter"
+)
+
+var resolvePostRedirectFn = (*service.Service).ResolvePostRedirect
+
+// NewHandler returns the endpoint that redirects old post ids to the current post URL.
+func NewHandler(services *service.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		handle(w, r, services)
+	}
+}
+
+func handle(w http.ResponseWriter, r *http.Request, services *service.Service) {
+	r = httpapi.EnsureRequestContext(w, r)
+	if r == nil {
+		httpapi.WriteErrorWithContext(context.Background(), w, apperrors.Internal("invalid request context", nil))
+		return
+	}
+
+	if r.Method == http.MethodOptions {
+		w.Header().Set("Allow", "GET, HEAD, OPTIONS")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD, OPTIONS")
+		httpapi.WriteErrorWithContext(r.Context(), w, apperrors.MethodNotAllowed("method not allowed"))
+		return
+	}
+
+	locale, postID := resolvePostRedirectTarget(r)
+	if postID == "" {
+		httpapi.WriteErrorWithContext(r.Context(), w, apperrors.BadRequest("post id is required"))
+		return
+	}
+
+	redirectTo, err := resolvePostRedirectFn(services, r.Context(), postID)
+	if err != nil {
+		httpapi.WriteErrorWithContext(r.Context(), w, err)
+		return
+	}
+	if redirectTo == "" {
+		httpapi.WriteErrorWithContext(r.Context(), w, apperrors.NotFound("post redirect not found"))
+		return
+	}
+
+	w.Header().Set("Cache-Control", "public, max-age=3600")
+	http.Redirect(w, r, buildPostURL(appconfig.ResolveSiteURLOrRoot(), locale, redirectTo), http.StatusMovedPermanently)
+}
+
+// resolvePostRedirectTarget reads the query parameters set by the Vercel rewrite and falls back to the
+// /api/post-redirect/{locale}/{id} path served directly by the local runner.
+func resolvePostRedirectTarget(r *http.Request) (string, string) {
+	query := r.URL.Query()
+	locale := query.Get("locale")
+	postID := strings.TrimSpace(query.Get("id"))
+	if postID == "" {
+		segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/post-redirect"), "/"), "/")
+		if len(segments) == 2 {
+			locale = segments[0]
+			postID = strings.TrimSpace(segments[1])
+		}
+	}
+	return newsletter.ResolveLocale(locale, ""), postID
+}
+
+func buildPostURL(siteURL, locale, postID string) string {
+	return strings.TrimRight(siteURL, "/") + "/" + locale + "/posts/" + url.PathEscape(postID)
+}
@@ -0,0 +1,58 @@
+package postredirect
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"suaybsimsek.com/blog-api/internal/service"
+)
+
+func TestHandlerRedirectsRenamedPosts(t *testing.T) {
+	originalResolveFn := resolvePostRedirectFn
+	t.Cleanup(func() {
+		resolvePostRedirectFn = originalResolveFn
+	})
+	t.Setenv("SITE_URL", "https://example.com/")
+
+	resolvePostRedirectFn = func(_ *service.Service, _ context.Context, postID string) (string, error) {
+		if postID == "old-post" {
+			return "new-post", nil
+		}
+		return "", nil
+	}
+
+	recorder := httptest.NewRecorder()
+	NewHandler(nil)(recorder, httptest.NewRequest(http.MethodGet, "/api/post-redirect?locale=tr&id=old-post", nil))
+	if recorder.Code != http.StatusMovedPermanently {
+		t.Fatalf("expected 301 response, got %d", recorder.Code)
+	}
+	if location := recorder.Header().Get("Location"); location != "https://example.com/tr/posts/new-post" {
+		t.Fatalf("unexpected redirect location %q", location)
+	}
+
+	recorder = httptest.NewRecorder()
+	NewHandler(nil)(recorder, httptest.NewRequest(http.MethodGet, "/api/post-redirect/en/old-post", nil))
+	if location := recorder.Header().Get("Location"); recorder.Code != http.StatusMovedPermanently || location != "https://example.com/en/posts/new-post" {
+		t.Fatalf("unexpected path-style redirect %d %q", recorder.Code, location)
+	}
+
+	recorder = httptest.NewRecorder()
+	NewHandler(nil)(recorder, httptest.NewRequest(http.MethodGet, "/api/post-redirect?id=current-post", nil))
+	if recorder.Code != http.StatusNotFound {
+		t.Fatalf("expected 404 for posts without alias, got %d", recorder.Code)
+	}
+
+	recorder = httptest.NewRecorder()
+	NewHandler(nil)(recorder, httptest.NewRequest(http.MethodGet, "/api/post-redirect", nil))
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 without post id, got %d", recorder.Code)
+	}
+
+	recorder = httptest.NewRecorder()
+	NewHandler(nil)(recorder, httptest.NewRequest(http.MethodPost, "/api/post-redirect?id=old-post", nil))
+	if recorder.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("expected 405 for POST, got %d", recorder.Code)
+	}
+}
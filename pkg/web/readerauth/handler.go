@@ -3,12 +3,16 @@ package readerauth
 import (
 	"context"
 	"encoding/json"
+	"io"
+	"log/slog"
+	"mime"
 	"net/http"
 	"strings"
 
 	appconfig "suaybsimsek.com/blog-api/internal/config"
 	"suaybsimsek.com/blog-api/internal/domain"
 	appservice "suaybsimsek.com/blog-api/internal/service"
+	"suaybsimsek.com/blog-api/pkg/apperrors"
 	"suaybsimsek.com/blog-api/pkg/httpapi"
 	readeroauth "suaybsimsek.com/blog-api/pkg/web/readeroauth"
 )
@@ -16,24 +20,43 @@ import (
 const (
 	readerAuthSessionPath = "/api/reader-auth/session"
 	readerAuthLogoutPath  = "/api/reader-auth/logout"
+	readerAuthUnlinkPath  = "/api/reader-auth/unlink"
+
+	maxReaderAuthUnlinkBodyBytes = 1 << 10
 )
 
+type readerAuthProvider struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type readerAuthViewer struct {
+	ID              string   `json:"id"`
+	Name            string   `json:"name"`
+	Email           string   `json:"email"`
+	AvatarURL       string   `json:"avatarUrl,omitempty"`
+	Provider        string   `json:"provider,omitempty"`
+	LinkedProviders []string `json:"linkedProviders"`
+}
+
 type readerAuthSessionResponse struct {
 	Authenticated bool `json:"authenticated"`
 	Providers     struct {
-		Google bool `json:"google"`
-		Github bool `json:"github"`
+		Google bool                 `json:"google"`
+		Github bool                 `json:"github"`
+		OIDC   []readerAuthProvider `json:"oidc"`
 	} `json:"providers"`
-	Viewer *struct {
-		ID        string `json:"id"`
-		Name      string `json:"name"`
-		Email     string `json:"email"`
-		AvatarURL string `json:"avatarUrl,omitempty"`
-		Provider  string `json:"provider,omitempty"`
-	} `json:"viewer,omitempty"`
+	Viewer *readerAuthViewer `json:"viewer,omitempty"`
 }
 
-func Handler(w http.ResponseWriter, r *http.Request) {
+// NewHandler returns the reader session endpoints: the session probe, logout and provider unlink.
+func NewHandler(services *appservice.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		handle(w, r, services)
+	}
+}
+
+func handle(w http.ResponseWriter, r *http.Request, services *appservice.Service) {
 	r = httpapi.EnsureRequestContext(w, r)
 	if r == nil {
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
@@ -46,9 +69,11 @@ func Handler(w http.ResponseWriter, r *http.Request) {
 
 	switch r.URL.Path {
 	case readerAuthSessionPath:
-		handleReaderAuthSession(w, r)
+		handleReaderAuthSession(w, r, services)
 	case readerAuthLogoutPath:
-		handleReaderAuthLogout(w, r)
+		handleReaderAuthLogout(w, r, services)
+	case readerAuthUnlinkPath:
+		handleReaderAuthUnlink(w, r, services)
 	default:
 		http.NotFound(w, r)
 	}
@@ -76,7 +101,7 @@ func applyReaderAuthCORS(w http.ResponseWriter, r *http.Request) bool {
 	return true
 }
 
-func handleReaderAuthSession(w http.ResponseWriter, r *http.Request) {
+func handleReaderAuthSession(w http.ResponseWriter, r *http.Request, services *appservice.Service) {
 	if r.Method != http.MethodGet {
 		w.Header().Set("Allow", "GET, OPTIONS")
 		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
@@ -86,22 +111,31 @@ func handleReaderAuthSession(w http.ResponseWriter, r *http.Request) {
 	response := readerAuthSessionResponse{}
 	response.Providers.Google = appconfig.ResolveReaderGoogleConfig().Enabled()
 	response.Providers.Github = appconfig.ResolveReaderGithubConfig().Enabled()
+	response.Providers.OIDC = []readerAuthProvider{}
+	providers, err := appservice.ListOIDCProviders()
+	if err != nil {
+		slog.WarnContext(r.Context(), "reader auth session providers unavailable", "error", err)
+	}
+	for _, provider := range providers {
+		response.Providers.OIDC = append(response.Providers.OIDC, readerAuthProvider{ID: provider.ID, Name: provider.Name})
+	}
 
-	user := resolveAuthenticatedReader(r.Context(), r, w)
+	user := resolveAuthenticatedReader(r.Context(), r, services, w)
 	if user != nil {
+		linkedProviders, err := services.ListReaderOIDCProviders(r.Context(), user.ID)
+		if err != nil {
+			slog.WarnContext(r.Context(), "reader auth session linked providers unavailable", "error", err)
+			linkedProviders = []string{}
+		}
+
 		response.Authenticated = true
-		response.Viewer = &struct {
-			ID        string `json:"id"`
-			Name      string `json:"name"`
-			Email     string `json:"email"`
-			AvatarURL string `json:"avatarUrl,omitempty"`
-			Provider  string `json:"provider,omitempty"`
-		}{
-			ID:        strings.TrimSpace(user.ID),
-			Name:      strings.TrimSpace(user.Name),
-			Email:     strings.TrimSpace(user.Email),
-			AvatarURL: strings.TrimSpace(user.AvatarURL),
-			Provider:  strings.TrimSpace(user.LastLoginProvider),
+		response.Viewer = &readerAuthViewer{
+			ID:              strings.TrimSpace(user.ID),
+			Name:            strings.TrimSpace(user.Name),
+			Email:           strings.TrimSpace(user.Email),
+			AvatarURL:       strings.TrimSpace(user.AvatarURL),
+			Provider:        strings.TrimSpace(user.LastLoginProvider),
+			LinkedProviders: linkedProviders,
 		}
 	}
 
@@ -109,7 +143,7 @@ func handleReaderAuthSession(w http.ResponseWriter, r *http.Request) {
 	_ = json.NewEncoder(w).Encode(response)
 }
 
-func handleReaderAuthLogout(w http.ResponseWriter, r *http.Request) {
+func handleReaderAuthLogout(w http.ResponseWriter, r *http.Request, services *appservice.Service) {
 	if r.Method != http.MethodPost {
 		w.Header().Set("Allow", "POST, OPTIONS")
 		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
@@ -119,7 +153,7 @@ func handleReaderAuthLogout(w http.ResponseWriter, r *http.Request) {
 	readerConfig := appconfig.ResolveReaderConfig()
 	refreshCookie, _ := r.Cookie(readerConfig.RefreshCookieName)
 	if refreshCookie != nil && strings.TrimSpace(refreshCookie.Value) != "" {
-		_ = appservice.LogoutReader(r.Context(), refreshCookie.Value)
+		_ = services.LogoutReader(r.Context(), refreshCookie.Value)
 	}
 
 	readeroauth.ClearReaderSessionCookies(w, readerConfig)
@@ -127,12 +161,68 @@ func handleReaderAuthLogout(w http.ResponseWriter, r *http.Request) {
 	_ = json.NewEncoder(w).Encode(map[string]bool{"success": true})
 }
 
-func resolveAuthenticatedReader(_ context.Context, r *http.Request, w http.ResponseWriter) *domain.ReaderUser {
+// handleReaderAuthUnlink removes a sign-in provider from the signed-in reader, keeping at least one. It only accepts
+// JSON bodies so that a cross-site form post cannot trigger it with the reader's cookies.
+func handleReaderAuthUnlink(w http.ResponseWriter, r *http.Request, services *appservice.Service) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST, OPTIONS")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || mediaType != "application/json" {
+		http.Error(w, http.StatusText(http.StatusUnsupportedMediaType), http.StatusUnsupportedMediaType)
+		return
+	}
+
+	var payload struct {
+		Provider string `json:"provider"`
+	}
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxReaderAuthUnlinkBodyBytes)).Decode(&payload); err != nil ||
+		strings.TrimSpace(payload.Provider) == "" {
+		httpapi.WriteErrorWithContext(r.Context(), w, apperrors.BadRequest("provider is required"))
+		return
+	}
+
+	user := resolveAuthenticatedReader(r.Context(), r, services, w)
+	if user == nil {
+		httpapi.WriteErrorWithContext(r.Context(), w, apperrors.Unauthorized("reader authentication required"))
+		return
+	}
+
+	result := services.UnlinkReaderProvider(r.Context(), user, payload.Provider)
+	switch result.Status {
+	case "success", "invalid-provider":
+	case "last-sign-in-method":
+		httpapi.WriteErrorWithContext(r.Context(), w, apperrors.BadRequest("the last sign-in method cannot be removed"))
+		return
+	case "unauthorized":
+		httpapi.WriteErrorWithContext(r.Context(), w, apperrors.Unauthorized("reader authentication required"))
+		return
+	case "service-unavailable":
+		httpapi.WriteErrorWithContext(r.Context(), w, apperrors.ServiceUnavailable("reader account is unavailable", nil))
+		return
+	default:
+		httpapi.WriteErrorWithContext(r.Context(), w, apperrors.Internal("failed to disconnect account", nil))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	_ = json.NewEncoder(w).Encode(map[string]bool{"success": result.Status == "success"})
+}
+
+func resolveAuthenticatedReader(
+	_ context.Context,
+	r *http.Request,
+	services *appservice.Service,
+	w http.ResponseWriter,
+) *domain.ReaderUser {
 	readerConfig := appconfig.ResolveReaderConfig()
 	trace, _ := httpapi.RequestTraceFromContext(r.Context())
 
 	if accessCookie, err := r.Cookie(readerConfig.AccessCookieName); err == nil && strings.TrimSpace(accessCookie.Value) != "" {
-		user, err := appservice.ResolveReaderFromAccessToken(r.Context(), accessCookie.Value)
+		user, err := services.ResolveReaderFromAccessToken(r.Context(), accessCookie.Value)
 		if err == nil && user != nil {
 			return user
 		}
@@ -143,7 +233,7 @@ func resolveAuthenticatedReader(_ context.Context, r *http.Request, w http.Respo
 		return nil
 	}
 
-	payload, err := appservice.RefreshReaderSession(r.Context(), refreshCookie.Value, appservice.ReaderSessionMetadata{
+	payload, err := services.RefreshReaderSession(r.Context(), refreshCookie.Value, appservice.ReaderSessionMetadata{
 		UserAgent:   strings.TrimSpace(r.UserAgent()),
 		RemoteIP:    trace.RemoteIP,
 		CountryCode: trace.CountryCode,
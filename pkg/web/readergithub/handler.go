@@ -34,7 +34,14 @@ type readerGithubOAuthState struct {
 	ExpiresAt  int64  `json:"exp"`
 }
 
-func Handler(w http.ResponseWriter, r *http.Request) {
+// NewHandler returns the GitHub OAuth callback of the reader sign-in.
+func NewHandler(services *appservice.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		handle(w, r, services)
+	}
+}
+
+func handle(w http.ResponseWriter, r *http.Request, services *appservice.Service) {
 	r = httpapi.EnsureRequestContext(w, r)
 	if r == nil {
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
@@ -43,23 +50,30 @@ func Handler(w http.ResponseWriter, r *http.Request) {
 
 	switch r.URL.Path {
 	case readerGithubOAuthCallbackPath:
-		handleReaderGithubOAuthCallback(w, r)
+		handleReaderGithubOAuthCallback(w, r, services)
 	default:
 		http.NotFound(w, r)
 	}
 }
 
-func Start(w http.ResponseWriter, r *http.Request) {
+// NewStartHandler returns the handler that sends a reader to GitHub to sign in.
+func NewStartHandler(services *appservice.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		start(w, r, services)
+	}
+}
+
+func start(w http.ResponseWriter, r *http.Request, services *appservice.Service) {
 	r = httpapi.EnsureRequestContext(w, r)
 	if r == nil {
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
 	}
 
-	handleReaderGithubOAuthStart(w, r)
+	handleReaderGithubOAuthStart(w, r, services)
 }
 
-func handleReaderGithubOAuthStart(w http.ResponseWriter, r *http.Request) {
+func handleReaderGithubOAuthStart(w http.ResponseWriter, r *http.Request, services *appservice.Service) {
 	if r.Method != http.MethodGet {
 		w.Header().Set("Allow", http.MethodGet)
 		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
@@ -114,7 +128,7 @@ func handleReaderGithubOAuthStart(w http.ResponseWriter, r *http.Request) {
 	http.Redirect(w, r, authorizeURL, http.StatusTemporaryRedirect)
 }
 
-func handleReaderGithubOAuthCallback(w http.ResponseWriter, r *http.Request) {
+func handleReaderGithubOAuthCallback(w http.ResponseWriter, r *http.Request, services *appservice.Service) {
 	if r.Method != http.MethodGet {
 		w.Header().Set("Allow", http.MethodGet)
 		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
@@ -164,7 +178,7 @@ func handleReaderGithubOAuthCallback(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	payload, err := appservice.LoginReaderWithGithubIdentity(ctx, identity, state.RememberMe, appservice.ReaderSessionMetadata{
+	payload, err := services.LoginReaderWithGithubIdentity(ctx, identity, state.RememberMe, appservice.ReaderSessionMetadata{
 		UserAgent:   strings.TrimSpace(r.UserAgent()),
 		RemoteIP:    trace.RemoteIP,
 		CountryCode: trace.CountryCode,
@@ -35,7 +35,14 @@ type readerGoogleOAuthState struct {
 	ExpiresAt  int64  `json:"exp"`
 }
 
-func Handler(w http.ResponseWriter, r *http.Request) {
+// NewHandler returns the Google OAuth callback of the reader sign-in.
+func NewHandler(services *appservice.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		handle(w, r, services)
+	}
+}
+
+func handle(w http.ResponseWriter, r *http.Request, services *appservice.Service) {
 	r = httpapi.EnsureRequestContext(w, r)
 	if r == nil {
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
@@ -44,23 +51,30 @@ func Handler(w http.ResponseWriter, r *http.Request) {
 
 	switch r.URL.Path {
 	case readerGoogleOAuthCallbackPath:
-		handleReaderGoogleOAuthCallback(w, r)
+		handleReaderGoogleOAuthCallback(w, r, services)
 	default:
 		http.NotFound(w, r)
 	}
 }
 
-func Start(w http.ResponseWriter, r *http.Request) {
+// NewStartHandler returns the handler that sends a reader to Google to sign in.
+func NewStartHandler(services *appservice.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		start(w, r, services)
+	}
+}
+
+func start(w http.ResponseWriter, r *http.Request, services *appservice.Service) {
 	r = httpapi.EnsureRequestContext(w, r)
 	if r == nil {
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
 	}
 
-	handleReaderGoogleOAuthStart(w, r)
+	handleReaderGoogleOAuthStart(w, r, services)
 }
 
-func handleReaderGoogleOAuthStart(w http.ResponseWriter, r *http.Request) {
+func handleReaderGoogleOAuthStart(w http.ResponseWriter, r *http.Request, services *appservice.Service) {
 	if r.Method != http.MethodGet {
 		w.Header().Set("Allow", http.MethodGet)
 		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
@@ -119,7 +133,7 @@ func handleReaderGoogleOAuthStart(w http.ResponseWriter, r *http.Request) {
 	http.Redirect(w, r, authorizeURL, http.StatusTemporaryRedirect)
 }
 
-func handleReaderGoogleOAuthCallback(w http.ResponseWriter, r *http.Request) {
+func handleReaderGoogleOAuthCallback(w http.ResponseWriter, r *http.Request, services *appservice.Service) {
 	if r.Method != http.MethodGet {
 		w.Header().Set("Allow", http.MethodGet)
 		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
@@ -191,7 +205,7 @@ func handleReaderGoogleOAuthCallback(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	payload, err := appservice.LoginReaderWithGoogleIdentity(ctx, identity, state.RememberMe, appservice.ReaderSessionMetadata{
+	payload, err := services.LoginReaderWithGoogleIdentity(ctx, identity, state.RememberMe, appservice.ReaderSessionMetadata{
 		UserAgent:   strings.TrimSpace(r.UserAgent()),
 		RemoteIP:    trace.RemoteIP,
 		CountryCode: trace.CountryCode,
@@ -0,0 +1,158 @@
+package webauthn
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// cborMaxDepth bounds nesting so a hostile payload cannot exhaust the stack.
+const cborMaxDepth = 16
+
+var errInvalidCBOR = errors.New("invalid cbor")
+
+// decodeCBOR decodes the subset of CBOR used by WebAuthn attestation objects and COSE keys: integers, byte and text
+// strings, arrays, maps with integer or text keys, booleans and null. It returns the value and the number of bytes
+// read, so callers can locate data that follows an embedded item.
+func decodeCBOR(data []byte) (any, int, error) {
+	decoder := cborDecoder{data: data}
+	value, err := decoder.decode(0)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return value, decoder.offset, nil
+}
+
+type cborDecoder struct {
+	data   []byte
+	offset int
+}
+
+func (d *cborDecoder) decode(depth int) (any, error) {
+	if depth > cborMaxDepth {
+		return nil, errInvalidCBOR
+	}
+
+	major, argument, err := d.readHead()
+	if err != nil {
+		return nil, err
+	}
+
+	switch major {
+	case 0:
+		if argument > 1<<63-1 {
+			return nil, errInvalidCBOR
+		}
+		return int64(argument), nil
+	case 1:
+		if argument > 1<<63-1 {
+			return nil, errInvalidCBOR
+		}
+		return -1 - int64(argument), nil
+	case 2:
+		return d.readBytes(argument)
+	case 3:
+		value, err := d.readBytes(argument)
+		if err != nil {
+			return nil, err
+		}
+		return string(value), nil
+	case 4:
+		if argument > uint64(len(d.data)-d.offset) {
+			return nil, errInvalidCBOR
+		}
+		items := make([]any, 0, argument)
+		for range argument {
+			item, err := d.decode(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	case 5:
+		if argument > uint64(len(d.data)-d.offset) {
+			return nil, errInvalidCBOR
+		}
+		items := make(map[any]any, argument)
+		for range argument {
+			key, err := d.decode(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			switch key.(type) {
+			case int64, string:
+			default:
+				return nil, errInvalidCBOR
+			}
+			if _, exists := items[key]; exists {
+				return nil, errInvalidCBOR
+			}
+			value, err := d.decode(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			items[key] = value
+		}
+		return items, nil
+	case 7:
+		switch argument {
+		case 20:
+			return false, nil
+		case 21:
+			return true, nil
+		case 22:
+			return nil, nil
+		}
+	}
+
+	return nil, errInvalidCBOR
+}
+
+func (d *cborDecoder) readHead() (byte, uint64, error) {
+	if d.offset >= len(d.data) {
+		return 0, 0, errInvalidCBOR
+	}
+
+	initial := d.data[d.offset]
+	d.offset++
+	major := initial >> 5
+	info := initial & 0x1f
+
+	switch {
+	case info < 24:
+		return major, uint64(info), nil
+	case info <= 27:
+		size := 1 << (info - 24)
+		if len(d.data)-d.offset < size {
+			return 0, 0, errInvalidCBOR
+		}
+		var argument uint64
+		switch size {
+		case 1:
+			argument = uint64(d.data[d.offset])
+		case 2:
+			argument = uint64(binary.BigEndian.Uint16(d.data[d.offset:]))
+		case 4:
+			argument = uint64(binary.BigEndian.Uint32(d.data[d.offset:]))
+		default:
+			argument = binary.BigEndian.Uint64(d.data[d.offset:])
+		}
+		d.offset += size
+		return major, argument, nil
+	default:
+		// Indefinite lengths and reserved values never appear in WebAuthn payloads.
+		return 0, 0, errInvalidCBOR
+	}
+}
+
+func (d *cborDecoder) readBytes(length uint64) ([]byte, error) {
+	if length > uint64(len(d.data)-d.offset) {
+		return nil, errInvalidCBOR
+	}
+
+	end := d.offset + int(length)
+	value := append([]byte{}, d.data[d.offset:end]...)
+	d.offset = end
+	return value, nil
+}
@@ -0,0 +1,98 @@
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"math/big"
+)
+
+// COSE algorithm identifiers accepted for passkeys.
+const (
+	AlgorithmES256 int64 = -7
+	AlgorithmRS256 int64 = -257
+)
+
+const (
+	coseKeyType      = 1
+	coseKeyAlgorithm = 3
+	coseEC2Curve     = -1
+	coseEC2X         = -2
+	coseEC2Y         = -3
+	coseRSAModulus   = -1
+	coseRSAExponent  = -2
+
+	coseKeyTypeEC2     = 2
+	coseKeyTypeRSA     = 3
+	coseCurveP256      = 1
+	minRSAKeyBits      = 2048
+	maxRSAExponent     = 1<<31 - 1
+	p256CoordinateSize = 32
+)
+
+// parsePublicKey decodes a COSE_Key and returns the algorithm it is bound to along with the Go public key.
+func parsePublicKey(coseKey []byte) (int64, crypto.PublicKey, error) {
+	value, read, err := decodeCBOR(coseKey)
+	if err != nil || read != len(coseKey) {
+		return 0, nil, ErrUnsupportedKey
+	}
+	key, ok := value.(map[any]any)
+	if !ok {
+		return 0, nil, ErrUnsupportedKey
+	}
+
+	keyType, _ := key[int64(coseKeyType)].(int64)
+	algorithm, _ := key[int64(coseKeyAlgorithm)].(int64)
+	switch {
+	case keyType == coseKeyTypeEC2 && algorithm == AlgorithmES256:
+		curve, _ := key[int64(coseEC2Curve)].(int64)
+		x, _ := key[int64(coseEC2X)].([]byte)
+		y, _ := key[int64(coseEC2Y)].([]byte)
+		if curve != coseCurveP256 || len(x) != p256CoordinateSize || len(y) != p256CoordinateSize {
+			return 0, nil, ErrUnsupportedKey
+		}
+		publicKey := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !publicKey.Curve.IsOnCurve(publicKey.X, publicKey.Y) {
+			return 0, nil, ErrUnsupportedKey
+		}
+		return algorithm, publicKey, nil
+	case keyType == coseKeyTypeRSA && algorithm == AlgorithmRS256:
+		modulus, _ := key[int64(coseRSAModulus)].([]byte)
+		exponent, _ := key[int64(coseRSAExponent)].([]byte)
+		publicExponent := new(big.Int).SetBytes(exponent)
+		publicKey := &rsa.PublicKey{N: new(big.Int).SetBytes(modulus)}
+		if publicKey.N.BitLen() < minRSAKeyBits || !publicExponent.IsInt64() ||
+			publicExponent.Int64() < 3 || publicExponent.Int64() > maxRSAExponent {
+			return 0, nil, ErrUnsupportedKey
+		}
+		publicKey.E = int(publicExponent.Int64())
+		return algorithm, publicKey, nil
+	default:
+		return 0, nil, ErrUnsupportedAlgorithm
+	}
+}
+
+func verifySignature(coseKey, signedData, signature []byte) error {
+	algorithm, publicKey, err := parsePublicKey(coseKey)
+	if err != nil {
+		return err
+	}
+
+	digest := sha256.Sum256(signedData)
+	switch algorithm {
+	case AlgorithmES256:
+		ecdsaKey, _ := publicKey.(*ecdsa.PublicKey)
+		if !ecdsa.VerifyASN1(ecdsaKey, digest[:], signature) {
+			return ErrInvalidSignature
+		}
+	case AlgorithmRS256:
+		rsaKey, _ := publicKey.(*rsa.PublicKey)
+		if rsa.VerifyPKCS1v15(rsaKey, crypto.SHA256, digest[:], signature) != nil {
+			return ErrInvalidSignature
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,433 @@
+// Package webauthn implements the relying party side of WebAuthn registration and assertion ceremonies for passkeys
+// that use ES256 or RS256 keys with the "none" attestation format. Options and responses use the JSON shapes of
+// PublicKeyCredential.parseCreationOptionsFromJSON, parseRequestOptionsFromJSON and toJSON.
+package webauthn
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	challengeBytes = 32
+
+	clientDataTypeCreate = "webauthn.create"
+	clientDataTypeGet    = "webauthn.get"
+	credentialType       = "public-key"
+	attestationNone      = "none"
+
+	flagUserPresent            = 0x01
+	flagUserVerified           = 0x04
+	flagAttestedCredentialData = 0x40
+
+	authenticatorDataMinLength = 37
+	aaguidLength               = 16
+	maxCredentialIDLength      = 1023
+)
+
+var (
+	ErrInvalidResponse        = errors.New("invalid webauthn response")
+	ErrChallengeMismatch      = errors.New("webauthn challenge mismatch")
+	ErrOriginMismatch         = errors.New("webauthn origin mismatch")
+	ErrRelyingPartyMismatch   = errors.New("webauthn relying party mismatch")
+	ErrUserNotVerified        = errors.New("webauthn user not verified")
+	ErrUnsupportedAttestation = errors.New("unsupported webauthn attestation")
+	ErrUnsupportedAlgorithm   = errors.New("unsupported webauthn algorithm")
+	ErrUnsupportedKey         = errors.New("unsupported webauthn public key")
+	ErrInvalidSignature       = errors.New("invalid webauthn signature")
+	ErrSignCountRegressed     = errors.New("webauthn sign count did not increase")
+
+	encoding = base64.RawURLEncoding
+)
+
+// RelyingParty identifies the site passkeys are bound to. ID is the registrable domain and Origin the exact origin
+// the browser reports, for example "example.com" and "https://example.com".
+type RelyingParty struct {
+	ID     string
+	Name   string
+	Origin string
+}
+
+// Credential is a verified passkey ready to be stored.
+type Credential struct {
+	ID         []byte
+	PublicKey  []byte
+	Algorithm  int64
+	SignCount  uint32
+	Transports []string
+}
+
+// Assertion is a parsed authentication response. CredentialID selects the stored credential to verify it with.
+type Assertion struct {
+	CredentialID      []byte
+	UserHandle        []byte
+	clientDataJSON    []byte
+	authenticatorData []byte
+	signature         []byte
+}
+
+type CredentialDescriptor struct {
+	Type       string   `json:"type"`
+	ID         string   `json:"id"`
+	Transports []string `json:"transports,omitempty"`
+}
+
+type CredentialParameter struct {
+	Type      string `json:"type"`
+	Algorithm int64  `json:"alg"`
+}
+
+type CreationOptions struct {
+	RP struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"rp"`
+	User struct {
+		ID          string `json:"id"`
+		Name        string `json:"name"`
+		DisplayName string `json:"displayName"`
+	} `json:"user"`
+	Challenge              string                 `json:"challenge"`
+	PubKeyCredParams       []CredentialParameter  `json:"pubKeyCredParams"`
+	Timeout                int64                  `json:"timeout"`
+	ExcludeCredentials     []CredentialDescriptor `json:"excludeCredentials"`
+	AuthenticatorSelection struct {
+		ResidentKey      string `json:"residentKey"`
+		RequireResident  bool   `json:"requireResidentKey"`
+		UserVerification string `json:"userVerification"`
+	} `json:"authenticatorSelection"`
+	Attestation string `json:"attestation"`
+}
+
+type RequestOptions struct {
+	Challenge        string                 `json:"challenge"`
+	RPID             string                 `json:"rpId"`
+	Timeout          int64                  `json:"timeout"`
+	AllowCredentials []CredentialDescriptor `json:"allowCredentials"`
+	UserVerification string                 `json:"userVerification"`
+}
+
+type clientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin"`
+}
+
+type authenticatorData struct {
+	rpIDHash  []byte
+	flags     byte
+	signCount uint32
+	// Present only when flagAttestedCredentialData is set.
+	credentialID []byte
+	publicKey    []byte
+}
+
+// NewChallenge returns a random 256-bit challenge encoded as unpadded base64url.
+func NewChallenge() (string, error) {
+	buffer := make([]byte, challengeBytes)
+	if _, err := rand.Read(buffer); err != nil {
+		return "", err
+	}
+
+	return encoding.EncodeToString(buffer), nil
+}
+
+// CreationOptions builds options for a discoverable, user-verified passkey. userID becomes the user handle returned
+// by later assertions and must not contain personal data.
+func (rp RelyingParty) CreationOptions(
+	userID, userName, displayName, challenge string,
+	timeout time.Duration,
+	exclude []CredentialDescriptor,
+) CreationOptions {
+	options := CreationOptions{
+		Challenge:          challenge,
+		Timeout:            timeout.Milliseconds(),
+		ExcludeCredentials: append([]CredentialDescriptor{}, exclude...),
+		Attestation:        attestationNone,
+	}
+	options.RP.ID = rp.ID
+	options.RP.Name = rp.Name
+	options.User.ID = encoding.EncodeToString([]byte(userID))
+	options.User.Name = userName
+	options.User.DisplayName = displayName
+	for _, algorithm := range []int64{AlgorithmES256, AlgorithmRS256} {
+		options.PubKeyCredParams = append(options.PubKeyCredParams, CredentialParameter{
+			Type:      credentialType,
+			Algorithm: algorithm,
+		})
+	}
+	options.AuthenticatorSelection.ResidentKey = "required"
+	options.AuthenticatorSelection.RequireResident = true
+	options.AuthenticatorSelection.UserVerification = "required"
+
+	return options
+}
+
+// RequestOptions builds options for a usernameless assertion with a discoverable credential.
+func (rp RelyingParty) RequestOptions(challenge string, timeout time.Duration) RequestOptions {
+	return RequestOptions{
+		Challenge:        challenge,
+		RPID:             rp.ID,
+		Timeout:          timeout.Milliseconds(),
+		AllowCredentials: []CredentialDescriptor{},
+		UserVerification: "required",
+	}
+}
+
+// NewCredentialDescriptor describes a stored credential for excludeCredentials or allowCredentials.
+func NewCredentialDescriptor(credentialID []byte, transports []string) CredentialDescriptor {
+	return CredentialDescriptor{
+		Type:       credentialType,
+		ID:         encoding.EncodeToString(credentialID),
+		Transports: append([]string{}, transports...),
+	}
+}
+
+// VerifyRegistration checks a registration response against the challenge it was created for and returns the new
+// credential.
+func (rp RelyingParty) VerifyRegistration(response []byte, challenge string) (*Credential, error) {
+	var payload struct {
+		ID       string `json:"id"`
+		RawID    string `json:"rawId"`
+		Type     string `json:"type"`
+		Response struct {
+			ClientDataJSON    string   `json:"clientDataJSON"`
+			AttestationObject string   `json:"attestationObject"`
+			Transports        []string `json:"transports"`
+		} `json:"response"`
+	}
+	if err := json.Unmarshal(response, &payload); err != nil || payload.Type != credentialType {
+		return nil, ErrInvalidResponse
+	}
+
+	clientDataJSON, err := decodeField(payload.Response.ClientDataJSON)
+	if err != nil {
+		return nil, err
+	}
+	if err := rp.verifyClientData(clientDataJSON, clientDataTypeCreate, challenge); err != nil {
+		return nil, err
+	}
+
+	attestationObject, err := decodeField(payload.Response.AttestationObject)
+	if err != nil {
+		return nil, err
+	}
+	rawAuthData, err := parseAttestationObject(attestationObject)
+	if err != nil {
+		return nil, err
+	}
+
+	authData, err := parseAuthenticatorData(rawAuthData)
+	if err != nil {
+		return nil, err
+	}
+	if err := rp.verifyAuthenticatorData(authData); err != nil {
+		return nil, err
+	}
+	if authData.credentialID == nil {
+		return nil, ErrInvalidResponse
+	}
+	if rawID, err := decodeField(payload.RawID); err != nil || !bytes.Equal(rawID, authData.credentialID) {
+		return nil, ErrInvalidResponse
+	}
+
+	algorithm, _, err := parsePublicKey(authData.publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Credential{
+		ID:         authData.credentialID,
+		PublicKey:  authData.publicKey,
+		Algorithm:  algorithm,
+		SignCount:  authData.signCount,
+		Transports: append([]string{}, payload.Response.Transports...),
+	}, nil
+}
+
+// ParseAssertion decodes an authentication response so the caller can look up the credential it names.
+func ParseAssertion(response []byte) (*Assertion, error) {
+	var payload struct {
+		RawID    string `json:"rawId"`
+		Type     string `json:"type"`
+		Response struct {
+			ClientDataJSON    string `json:"clientDataJSON"`
+			AuthenticatorData string `json:"authenticatorData"`
+			Signature         string `json:"signature"`
+			UserHandle        string `json:"userHandle"`
+		} `json:"response"`
+	}
+	if err := json.Unmarshal(response, &payload); err != nil || payload.Type != credentialType {
+		return nil, ErrInvalidResponse
+	}
+
+	assertion := &Assertion{}
+	fields := []struct {
+		value    string
+		target   *[]byte
+		optional bool
+	}{
+		{value: payload.RawID, target: &assertion.CredentialID},
+		{value: payload.Response.ClientDataJSON, target: &assertion.clientDataJSON},
+		{value: payload.Response.AuthenticatorData, target: &assertion.authenticatorData},
+		{value: payload.Response.Signature, target: &assertion.signature},
+		{value: payload.Response.UserHandle, target: &assertion.UserHandle, optional: true},
+	}
+	for _, field := range fields {
+		if field.optional && field.value == "" {
+			continue
+		}
+		decoded, err := decodeField(field.value)
+		if err != nil {
+			return nil, err
+		}
+		*field.target = decoded
+	}
+	if len(assertion.CredentialID) > maxCredentialIDLength {
+		return nil, ErrInvalidResponse
+	}
+
+	return assertion, nil
+}
+
+// VerifyAssertion checks an assertion against the challenge and the stored public key and returns the new sign
+// count. A counter that does not move forward is rejected unless the authenticator never counts, which passkeys
+// synced across devices commonly report as zero.
+func (rp RelyingParty) VerifyAssertion(
+	assertion *Assertion,
+	challenge string,
+	publicKey []byte,
+	storedSignCount uint32,
+) (uint32, error) {
+	if assertion == nil {
+		return 0, ErrInvalidResponse
+	}
+	if err := rp.verifyClientData(assertion.clientDataJSON, clientDataTypeGet, challenge); err != nil {
+		return 0, err
+	}
+
+	authData, err := parseAuthenticatorData(assertion.authenticatorData)
+	if err != nil {
+		return 0, err
+	}
+	if err := rp.verifyAuthenticatorData(authData); err != nil {
+		return 0, err
+	}
+
+	clientDataHash := sha256.Sum256(assertion.clientDataJSON)
+	signedData := append(append([]byte{}, assertion.authenticatorData...), clientDataHash[:]...)
+	if err := verifySignature(publicKey, signedData, assertion.signature); err != nil {
+		return 0, err
+	}
+
+	if (authData.signCount != 0 || storedSignCount != 0) && authData.signCount <= storedSignCount {
+		return 0, ErrSignCountRegressed
+	}
+
+	return authData.signCount, nil
+}
+
+func (rp RelyingParty) verifyClientData(raw []byte, expectedType, challenge string) error {
+	var data clientData
+	if err := json.Unmarshal(raw, &data); err != nil || data.Type != expectedType {
+		return ErrInvalidResponse
+	}
+	if challenge == "" || subtle.ConstantTimeCompare([]byte(data.Challenge), []byte(challenge)) != 1 {
+		return ErrChallengeMismatch
+	}
+	if data.CrossOrigin || !strings.EqualFold(strings.TrimRight(data.Origin, "/"), strings.TrimRight(rp.Origin, "/")) {
+		return ErrOriginMismatch
+	}
+
+	return nil
+}
+
+func (rp RelyingParty) verifyAuthenticatorData(authData *authenticatorData) error {
+	rpIDHash := sha256.Sum256([]byte(rp.ID))
+	if subtle.ConstantTimeCompare(authData.rpIDHash, rpIDHash[:]) != 1 {
+		return ErrRelyingPartyMismatch
+	}
+	if authData.flags&flagUserPresent == 0 || authData.flags&flagUserVerified == 0 {
+		return ErrUserNotVerified
+	}
+
+	return nil
+}
+
+// parseAttestationObject accepts only the "none" format, which carries an empty statement, and returns authData.
+func parseAttestationObject(raw []byte) ([]byte, error) {
+	value, read, err := decodeCBOR(raw)
+	if err != nil || read != len(raw) {
+		return nil, ErrInvalidResponse
+	}
+	object, ok := value.(map[any]any)
+	if !ok {
+		return nil, ErrInvalidResponse
+	}
+
+	format, _ := object["fmt"].(string)
+	statement, _ := object["attStmt"].(map[any]any)
+	if format != attestationNone || len(statement) != 0 {
+		return nil, ErrUnsupportedAttestation
+	}
+
+	authData, ok := object["authData"].([]byte)
+	if !ok {
+		return nil, ErrInvalidResponse
+	}
+	return authData, nil
+}
+
+func parseAuthenticatorData(raw []byte) (*authenticatorData, error) {
+	if len(raw) < authenticatorDataMinLength {
+		return nil, ErrInvalidResponse
+	}
+
+	authData := &authenticatorData{
+		rpIDHash:  raw[:32],
+		flags:     raw[32],
+		signCount: binary.BigEndian.Uint32(raw[33:37]),
+	}
+	if authData.flags&flagAttestedCredentialData == 0 {
+		return authData, nil
+	}
+
+	rest := raw[authenticatorDataMinLength:]
+	if len(rest) < aaguidLength+2 {
+		return nil, ErrInvalidResponse
+	}
+	rest = rest[aaguidLength:]
+	credentialIDLength := int(binary.BigEndian.Uint16(rest))
+	rest = rest[2:]
+	if credentialIDLength == 0 || credentialIDLength > maxCredentialIDLength || len(rest) < credentialIDLength {
+		return nil, ErrInvalidResponse
+	}
+	authData.credentialID = append([]byte{}, rest[:credentialIDLength]...)
+	rest = rest[credentialIDLength:]
+
+	_, read, err := decodeCBOR(rest)
+	if err != nil {
+		return nil, ErrInvalidResponse
+	}
+	authData.publicKey = append([]byte{}, rest[:read]...)
+
+	return authData, nil
+}
+
+func decodeField(value string) ([]byte, error) {
+	decoded, err := encoding.DecodeString(strings.TrimRight(strings.TrimSpace(value), "="))
+	if err != nil || len(decoded) == 0 {
+		return nil, ErrInvalidResponse
+	}
+
+	return decoded, nil
+}
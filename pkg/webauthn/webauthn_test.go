@@ -0,0 +1,352 @@
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sort"
+	"testing"
+	"time"
+)
+
+var testRelyingParty = RelyingParty{ID: "blog.example.com", Name: "Blog", Origin: "https://blog.example.com"}
+
+type testAuthenticator struct {
+	credentialID []byte
+	coseKey      []byte
+	sign         func(digest []byte) []byte
+	signCount    uint32
+	flags        byte
+}
+
+func newES256Authenticator(t *testing.T) *testAuthenticator {
+	t.Helper()
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey returned error: %v", err)
+	}
+	return &testAuthenticator{
+		credentialID: []byte("es256-credential"),
+		coseKey: encodeTestCBOR(map[int64]any{
+			1:  int64(2),
+			3:  AlgorithmES256,
+			-1: int64(1),
+			-2: privateKey.X.FillBytes(make([]byte, 32)),
+			-3: privateKey.Y.FillBytes(make([]byte, 32)),
+		}),
+		sign: func(digest []byte) []byte {
+			signature, err := ecdsa.SignASN1(rand.Reader, privateKey, digest)
+			if err != nil {
+				t.Fatalf("SignASN1 returned error: %v", err)
+			}
+			return signature
+		},
+		flags: flagUserPresent | flagUserVerified,
+	}
+}
+
+func newRS256Authenticator(t *testing.T) *testAuthenticator {
+	t.Helper()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey returned error: %v", err)
+	}
+	return &testAuthenticator{
+		credentialID: []byte("rs256-credential"),
+		coseKey: encodeTestCBOR(map[int64]any{
+			1:  int64(3),
+			3:  AlgorithmRS256,
+			-1: privateKey.N.Bytes(),
+			-2: big.NewInt(int64(privateKey.E)).Bytes(),
+		}),
+		sign: func(digest []byte) []byte {
+			signature, err := rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA256, digest)
+			if err != nil {
+				t.Fatalf("SignPKCS1v15 returned error: %v", err)
+			}
+			return signature
+		},
+		flags: flagUserPresent | flagUserVerified,
+	}
+}
+
+func (a *testAuthenticator) authenticatorData(attested bool) []byte {
+	rpIDHash := sha256.Sum256([]byte(testRelyingParty.ID))
+	data := append([]byte{}, rpIDHash[:]...)
+	flags := a.flags
+	if attested {
+		flags |= flagAttestedCredentialData
+	}
+	data = append(data, flags)
+	data = binary.BigEndian.AppendUint32(data, a.signCount)
+	if attested {
+		data = append(data, make([]byte, aaguidLength)...)
+		data = binary.BigEndian.AppendUint16(data, uint16(len(a.credentialID)))
+		data = append(data, a.credentialID...)
+		data = append(data, a.coseKey...)
+	}
+	return data
+}
+
+func (a *testAuthenticator) register(t *testing.T, challenge, origin, format string) []byte {
+	t.Helper()
+
+	attestationObject := encodeTestCBOR(map[string]any{
+		"fmt":      format,
+		"attStmt":  map[string]any{},
+		"authData": a.authenticatorData(true),
+	})
+	return marshalTestJSON(t, map[string]any{
+		"id":    encoding.EncodeToString(a.credentialID),
+		"rawId": encoding.EncodeToString(a.credentialID),
+		"type":  credentialType,
+		"response": map[string]any{
+			"clientDataJSON":    encoding.EncodeToString(testClientData(t, clientDataTypeCreate, challenge, origin)),
+			"attestationObject": encoding.EncodeToString(attestationObject),
+			"transports":        []string{"internal"},
+		},
+	})
+}
+
+func (a *testAuthenticator) assert(t *testing.T, challenge string) []byte {
+	t.Helper()
+
+	authData := a.authenticatorData(false)
+	clientDataJSON := testClientData(t, clientDataTypeGet, challenge, testRelyingParty.Origin)
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	return marshalTestJSON(t, map[string]any{
+		"id":    encoding.EncodeToString(a.credentialID),
+		"rawId": encoding.EncodeToString(a.credentialID),
+		"type":  credentialType,
+		"response": map[string]any{
+			"clientDataJSON":    encoding.EncodeToString(clientDataJSON),
+			"authenticatorData": encoding.EncodeToString(authData),
+			"signature":         encoding.EncodeToString(a.sign(digest[:])),
+			"userHandle":        encoding.EncodeToString([]byte("admin-1")),
+		},
+	})
+}
+
+func TestRegistrationAndAssertion(t *testing.T) {
+	for name, newAuthenticator := range map[string]func(*testing.T) *testAuthenticator{
+		"ES256": newES256Authenticator,
+		"RS256": newRS256Authenticator,
+	} {
+		t.Run(name, func(t *testing.T) {
+			authenticator := newAuthenticator(t)
+			authenticator.signCount = 1
+
+			credential, err := testRelyingParty.VerifyRegistration(
+				authenticator.register(t, "register-challenge", testRelyingParty.Origin, attestationNone),
+				"register-challenge",
+			)
+			if err != nil {
+				t.Fatalf("VerifyRegistration returned error: %v", err)
+			}
+			if string(credential.ID) != string(authenticator.credentialID) || credential.SignCount != 1 {
+				t.Fatalf("unexpected credential: %#v", credential)
+			}
+			if len(credential.Transports) != 1 || credential.Transports[0] != "internal" {
+				t.Fatalf("unexpected transports: %v", credential.Transports)
+			}
+
+			authenticator.signCount = 2
+			assertion, err := ParseAssertion(authenticator.assert(t, "login-challenge"))
+			if err != nil {
+				t.Fatalf("ParseAssertion returned error: %v", err)
+			}
+			if string(assertion.CredentialID) != string(authenticator.credentialID) || string(assertion.UserHandle) != "admin-1" {
+				t.Fatalf("unexpected assertion: %#v", assertion)
+			}
+			signCount, err := testRelyingParty.VerifyAssertion(assertion, "login-challenge", credential.PublicKey, credential.SignCount)
+			if err != nil || signCount != 2 {
+				t.Fatalf("VerifyAssertion = %d, %v", signCount, err)
+			}
+
+			if _, err := testRelyingParty.VerifyAssertion(assertion, "login-challenge", credential.PublicKey, 2); !errors.Is(err, ErrSignCountRegressed) {
+				t.Fatalf("expected sign count regression, got %v", err)
+			}
+			if _, err := testRelyingParty.VerifyAssertion(assertion, "other-challenge", credential.PublicKey, 1); !errors.Is(err, ErrChallengeMismatch) {
+				t.Fatalf("expected challenge mismatch, got %v", err)
+			}
+		})
+	}
+}
+
+func TestVerifyRegistrationRejectsUntrustedResponses(t *testing.T) {
+	authenticator := newES256Authenticator(t)
+
+	if _, err := testRelyingParty.VerifyRegistration(
+		authenticator.register(t, "challenge", "https://evil.example.com", attestationNone),
+		"challenge",
+	); !errors.Is(err, ErrOriginMismatch) {
+		t.Fatalf("expected origin mismatch, got %v", err)
+	}
+
+	if _, err := testRelyingParty.VerifyRegistration(
+		authenticator.register(t, "challenge", testRelyingParty.Origin, "packed"),
+		"challenge",
+	); !errors.Is(err, ErrUnsupportedAttestation) {
+		t.Fatalf("expected unsupported attestation, got %v", err)
+	}
+
+	otherSite := RelyingParty{ID: "other.example.com", Origin: testRelyingParty.Origin}
+	if _, err := otherSite.VerifyRegistration(
+		authenticator.register(t, "challenge", testRelyingParty.Origin, attestationNone),
+		"challenge",
+	); !errors.Is(err, ErrRelyingPartyMismatch) {
+		t.Fatalf("expected relying party mismatch, got %v", err)
+	}
+
+	authenticator.flags = flagUserPresent
+	if _, err := testRelyingParty.VerifyRegistration(
+		authenticator.register(t, "challenge", testRelyingParty.Origin, attestationNone),
+		"challenge",
+	); !errors.Is(err, ErrUserNotVerified) {
+		t.Fatalf("expected user verification error, got %v", err)
+	}
+}
+
+func TestVerifyAssertionAllowsZeroSignCount(t *testing.T) {
+	authenticator := newES256Authenticator(t)
+	credential, err := testRelyingParty.VerifyRegistration(
+		authenticator.register(t, "challenge", testRelyingParty.Origin, attestationNone),
+		"challenge",
+	)
+	if err != nil {
+		t.Fatalf("VerifyRegistration returned error: %v", err)
+	}
+
+	assertion, err := ParseAssertion(authenticator.assert(t, "login"))
+	if err != nil {
+		t.Fatalf("ParseAssertion returned error: %v", err)
+	}
+	if signCount, err := testRelyingParty.VerifyAssertion(assertion, "login", credential.PublicKey, 0); err != nil || signCount != 0 {
+		t.Fatalf("VerifyAssertion = %d, %v", signCount, err)
+	}
+
+	other := newES256Authenticator(t)
+	if _, err := testRelyingParty.VerifyAssertion(assertion, "login", other.coseKey, 0); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("expected invalid signature, got %v", err)
+	}
+}
+
+func TestCreationOptionsJSON(t *testing.T) {
+	options := testRelyingParty.CreationOptions(
+		"admin-1",
+		"admin@example.com",
+		"Admin",
+		"challenge",
+		time.Minute,
+		[]CredentialDescriptor{NewCredentialDescriptor([]byte("existing"), nil)},
+	)
+
+	var decoded map[string]any
+	if err := json.Unmarshal(marshalTestJSON(t, options), &decoded); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+	if decoded["attestation"] != attestationNone || decoded["timeout"] != float64(60000) {
+		t.Fatalf("unexpected options: %v", decoded)
+	}
+	user, _ := decoded["user"].(map[string]any)
+	if user["id"] != encoding.EncodeToString([]byte("admin-1")) {
+		t.Fatalf("unexpected user handle: %v", user)
+	}
+	params, _ := decoded["pubKeyCredParams"].([]any)
+	if len(params) != 2 {
+		t.Fatalf("unexpected algorithms: %v", params)
+	}
+}
+
+func TestDecodeCBORRejectsMalformedInput(t *testing.T) {
+	for _, input := range [][]byte{
+		{},
+		{0x5f},                         // indefinite-length byte string
+		{0x42, 0x01},                   // truncated byte string
+		{0xa1, 0x01},                   // map without value
+		{0xa2, 0x01, 0x01, 0x01, 0x02}, // duplicate key
+		{0x9b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	} {
+		if _, _, err := decodeCBOR(input); err == nil {
+			t.Fatalf("expected error for % x", input)
+		}
+	}
+}
+
+func testClientData(t *testing.T, clientDataType, challenge, origin string) []byte {
+	t.Helper()
+
+	return marshalTestJSON(t, clientData{Type: clientDataType, Challenge: challenge, Origin: origin})
+}
+
+func marshalTestJSON(t *testing.T, value any) []byte {
+	t.Helper()
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+	return encoded
+}
+
+// encodeTestCBOR writes the canonical CBOR the decoder expects from authenticators.
+func encodeTestCBOR(value any) []byte {
+	head := func(major byte, argument uint64) []byte {
+		switch {
+		case argument < 24:
+			return []byte{major<<5 | byte(argument)}
+		case argument <= 0xff:
+			return []byte{major<<5 | 24, byte(argument)}
+		default:
+			return binary.BigEndian.AppendUint16([]byte{major<<5 | 25}, uint16(argument))
+		}
+	}
+
+	switch typed := value.(type) {
+	case int64:
+		if typed < 0 {
+			return head(1, uint64(-1-typed))
+		}
+		return head(0, uint64(typed))
+	case []byte:
+		return append(head(2, uint64(len(typed))), typed...)
+	case string:
+		return append(head(3, uint64(len(typed))), typed...)
+	case map[int64]any:
+		keys := make([]int64, 0, len(typed))
+		for key := range typed {
+			keys = append(keys, key)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		encoded := head(5, uint64(len(typed)))
+		for _, key := range keys {
+			encoded = append(encoded, encodeTestCBOR(key)...)
+			encoded = append(encoded, encodeTestCBOR(typed[key])...)
+		}
+		return encoded
+	case map[string]any:
+		keys := make([]string, 0, len(typed))
+		for key := range typed {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		encoded := head(5, uint64(len(typed)))
+		for _, key := range keys {
+			encoded = append(encoded, encodeTestCBOR(key)...)
+			encoded = append(encoded, encodeTestCBOR(typed[key])...)
+		}
+		return encoded
+	default:
+		panic("unsupported test cbor value")
+	}
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/app"
+)
+
+func loadDotEnv(path string) {
+	file, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		key := strings.TrimSpace(parts[0])
+		value := strings.TrimSpace(parts[1])
+		if key == "" {
+			continue
+		}
+		if _, exists := os.LookupEnv(key); exists {
+			continue
+		}
+		_ = os.Setenv(key, value)
+	}
+}
+
+func main() {
+	loadDotEnv(filepath.Join(".", ".env.local"))
+
+	email := flag.String("email", "", "email address of the first owner")
+	name := flag.String("name", "", "display name of the first owner")
+	timeout := flag.Duration("timeout", 30*time.Second, "overall bootstrap timeout")
+	flag.Parse()
+
+	if strings.TrimSpace(*email) == "" {
+		failf("usage: bootstrap-admin-owner -email owner@example.com [-name \"Owner Name\"]")
+	}
+
+	password, err := readPassword(os.Stdin)
+	if err != nil {
+		failf("read owner password: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	container, err := app.New(ctx)
+	if err != nil {
+		failf("application container init failed: %v", err)
+	}
+	defer func() {
+		_ = container.Close(context.Background())
+	}()
+
+	owner, err := container.Services.BootstrapAdminOwner(ctx, *email, *name, password)
+	if err != nil {
+		failf("bootstrap admin owner: %v", err)
+	}
+
+	fmt.Printf("created owner %s (%s)\n", owner.Email, owner.ID)
+}
+
+// readPassword prefers ADMIN_BOOTSTRAP_PASSWORD so the password never appears in the process arguments, and
+// otherwise reads the first line of stdin.
+func readPassword(stdin io.Reader) (string, error) {
+	if password := os.Getenv("ADMIN_BOOTSTRAP_PASSWORD"); password != "" {
+		return password, nil
+	}
+
+	_, _ = fmt.Fprint(os.Stderr, "owner password: ")
+	line, err := bufio.NewReader(stdin).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func failf(format string, args ...any) {
+	_, _ = fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
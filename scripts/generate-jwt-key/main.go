@@ -0,0 +1,49 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/pkg/httpauth"
+)
+
+func main() {
+	algorithm := flag.String("alg", httpauth.JWTAlgorithmEdDSA, "signing algorithm: EdDSA or ES256")
+	keyID := flag.String("kid", "", "key id, defaults to the current date")
+	flag.Parse()
+
+	resolvedAlgorithm, err := httpauth.ParseJWTAlgorithm(*algorithm)
+	if err != nil || resolvedAlgorithm == httpauth.JWTAlgorithmHS256 {
+		failf("usage: generate-jwt-key [-alg EdDSA|ES256] [-kid 2026-10]")
+	}
+
+	resolvedKeyID := strings.TrimSpace(*keyID)
+	if resolvedKeyID == "" {
+		resolvedKeyID = time.Now().UTC().Format("2006-01-02")
+	}
+
+	key, err := httpauth.GenerateJWTKey(resolvedAlgorithm, resolvedKeyID)
+	if err != nil {
+		failf("generate jwt key: %v", err)
+	}
+	jwk, err := key.PrivateJWK()
+	if err != nil {
+		failf("encode jwt key: %v", err)
+	}
+
+	// The key is printed as a single line so it can be added to the keys array of JWT_KEYS as is.
+	encoded, err := json.Marshal(jwk)
+	if err != nil {
+		failf("encode jwt key: %v", err)
+	}
+	fmt.Println(string(encoded))
+}
+
+func failf(format string, args ...any) {
+	_, _ = fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
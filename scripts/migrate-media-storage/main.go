@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/app"
+	appconfig "suaybsimsek.com/blog-api/internal/config"
+)
+
+func loadDotEnv(path string) {
+	file, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		key := strings.TrimSpace(parts[0])
+		value := strings.TrimSpace(parts[1])
+		if key == "" {
+			continue
+		}
+		if _, exists := os.LookupEnv(key); exists {
+			continue
+		}
+		_ = os.Setenv(key, value)
+	}
+}
+
+func main() {
+	loadDotEnv(filepath.Join(".", ".env.local"))
+
+	target := flag.String("to", "", "target media storage backend (inline, gridfs, filesystem, s3); defaults to MEDIA_STORAGE_BACKEND")
+	dryRun := flag.Bool("dry-run", false, "only count the assets that would be moved")
+	timeout := flag.Duration("timeout", 30*time.Minute, "overall migration timeout")
+	flag.Parse()
+
+	resolvedTarget := strings.TrimSpace(*target)
+	if resolvedTarget == "" {
+		resolvedTarget = appconfig.ResolveMediaStorageConfig().Backend
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	container, err := app.New(ctx)
+	if err != nil {
+		failf("application container init failed: %v", err)
+	}
+	defer func() {
+		_ = container.Close(context.Background())
+	}()
+
+	result, err := container.Services.MigrateAdminMediaStorage(ctx, resolvedTarget, *dryRun)
+	if err != nil {
+		failf("migrate media storage: %v", err)
+	}
+
+	if result.DryRun {
+		fmt.Printf("%d media assets would move to %q\n", result.Scanned, result.TargetBackend)
+		return
+	}
+	fmt.Printf(
+		"moved %d of %d media assets to %q (%d skipped, %d failed)\n",
+		result.Migrated,
+		result.Scanned,
+		result.TargetBackend,
+		result.Skipped,
+		result.Failed,
+	)
+	if result.Failed > 0 {
+		os.Exit(1)
+	}
+}
+
+func failf(format string, args ...any) {
+	_, _ = fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"suaybsimsek.com/blog-api/internal/app"
+)
+
+func loadDotEnv(path string) {
+	file, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		key := strings.TrimSpace(parts[0])
+		value := strings.TrimSpace(parts[1])
+		if key == "" {
+			continue
+		}
+		if _, exists := os.LookupEnv(key); exists {
+			continue
+		}
+		_ = os.Setenv(key, value)
+	}
+}
+
+func main() {
+	loadDotEnv(filepath.Join(".", ".env.local"))
+
+	timeout := flag.Duration("timeout", 2*time.Minute, "overall refresh timeout")
+	flag.Parse()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	container, err := app.New(ctx)
+	if err != nil {
+		failf("application container init failed: %v", err)
+	}
+	defer func() {
+		_ = container.Close(context.Background())
+	}()
+
+	if err := container.Services.RefreshAllRelatedPosts(ctx); err != nil {
+		failf("refresh related posts: %v", err)
+	}
+
+	fmt.Println("related posts refreshed")
+}
+
+func failf(format string, args ...any) {
+	_, _ = fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
@@ -10,9 +10,9 @@ import (
 	"strings"
 	"time"
 
+	"suaybsimsek.com/blog-api/internal/app"
 	appconfig "suaybsimsek.com/blog-api/internal/config"
 	"suaybsimsek.com/blog-api/internal/domain"
-	"suaybsimsek.com/blog-api/internal/repository"
 )
 
 const (
@@ -67,8 +67,15 @@ func main() {
 		failf("no records to sync")
 	}
 
-	repo := repository.NewErrorMessageRepository()
-	if err := repo.UpsertMany(ctx, records); err != nil {
+	container, err := app.New(ctx)
+	if err != nil {
+		failf("application container init failed: %v", err)
+	}
+	defer func() {
+		_ = container.Close(context.Background())
+	}()
+
+	if err := container.Repositories.ErrorMessages.UpsertMany(ctx, records); err != nil {
 		failf("upsert admin error messages: %v", err)
 	}
 
@@ -26,6 +26,7 @@ import (
 	"strings"
 	"time"
 
+	"suaybsimsek.com/blog-api/internal/app"
 	appconfig "suaybsimsek.com/blog-api/internal/config"
 	"suaybsimsek.com/blog-api/pkg/newsletter"
 
@@ -1349,25 +1350,24 @@ func main() {
 	if err != nil {
 		log.Fatalf("SITE_URL error: %v", err)
 	}
-	databaseConfig, err := appconfig.ResolveDatabaseConfig()
-	if err != nil {
-		log.Fatalf("database config error: %v", err)
-	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
 	defer cancel()
 
-	client, err := appconfig.NewMongoClient(ctx, databaseConfig, "blog-content-sync-script")
+	container, err := app.New(ctx)
 	if err != nil {
-		log.Fatalf("mongodb connect failed: %v", err)
+		log.Fatalf("application container init failed: %v", err)
 	}
 	defer func() {
 		disconnectCtx, disconnectCancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer disconnectCancel()
-		_ = client.Disconnect(disconnectCtx)
+		_ = container.Close(disconnectCtx)
 	}()
 
-	db := client.Database(databaseConfig.Name)
+	db, err := container.MongoDatabase()
+	if err != nil {
+		log.Fatalf("database config error: %v", err)
+	}
 	postsCollection := db.Collection(postsCollectionName)
 	topicsCollection := db.Collection(topicsCollectionName)
 	categoriesCollection := db.Collection(categoriesCollectionName)
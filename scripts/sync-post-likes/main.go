@@ -13,7 +13,7 @@ import (
 	"strings"
 	"time"
 
-	appconfig "suaybsimsek.com/blog-api/internal/config"
+	"suaybsimsek.com/blog-api/internal/app"
 	"suaybsimsek.com/blog-api/pkg/newsletter"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -255,11 +255,6 @@ func upsertPostLike(
 func main() {
 	loadDotEnv(filepath.Join(".", ".env.local"))
 
-	databaseConfig, err := appconfig.ResolveDatabaseConfig()
-	if err != nil {
-		log.Fatalf("database config error: %v", err)
-	}
-
 	postsByID, err := readPosts([]string{newsletter.LocaleEN, newsletter.LocaleTR})
 	if err != nil {
 		log.Fatalf("read posts failed: %v", err)
@@ -268,17 +263,22 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
 	defer cancel()
 
-	client, err := appconfig.NewMongoClient(ctx, databaseConfig, "blog-post-likes-sync-script")
+	container, err := app.New(ctx)
 	if err != nil {
-		log.Fatalf("mongodb connect failed: %v", err)
+		log.Fatalf("application container init failed: %v", err)
 	}
 	defer func() {
 		disconnectCtx, disconnectCancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer disconnectCancel()
-		_ = client.Disconnect(disconnectCtx)
+		_ = container.Close(disconnectCtx)
 	}()
 
-	collection := client.Database(databaseConfig.Name).Collection(likesCollectionName)
+	database, err := container.MongoDatabase()
+	if err != nil {
+		log.Fatalf("database config error: %v", err)
+	}
+
+	collection := database.Collection(likesCollectionName)
 	if err := ensureLikesIndex(collection); err != nil {
 		log.Fatalf("ensure index failed: %v", err)
 	}
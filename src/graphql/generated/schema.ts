@@ -116,6 +116,19 @@ export enum ContentSource {
   Medium = 'medium'
 }
 
+/** Low-quality preview computed when an image is uploaded. */
+export type MediaPlaceholder = {
+  __typename?: 'MediaPlaceholder';
+  /** BlurHash string decoded client-side into a blurred preview. */
+  blurHash?: Maybe<Scalars['String']['output']>;
+  /** Most common color as a #rrggbb hex string. */
+  dominantColor?: Maybe<Scalars['String']['output']>;
+  /** Intrinsic image height in pixels. */
+  height: Scalars['Int']['output'];
+  /** Intrinsic image width in pixels. */
+  width: Scalars['Int']['output'];
+};
+
 /** Write operations for engagement counters and newsletter flows. */
 export type Mutation = {
   __typename?: 'Mutation';
@@ -243,8 +256,12 @@ export type Post = {
   publishedDate: Scalars['Date']['output'];
   /** Estimated reading time in minutes. */
   readingTime: Scalars['Int']['output'];
+  /** Precomputed related posts in the same locale, ordered by relevance. Limit defaults to 3 and is capped at 12. */
+  relatedPosts: Array<Post>;
   /** Full-text search index string generated for client-side search. */
   searchText: Scalars['String']['output'];
+  /** Series membership with previous and next navigation when the post belongs to a series. */
+  series?: Maybe<PostSeriesNavigation>;
   /** Human-readable URL slug for the post. */
   slug: Scalars['String']['output'];
   /** Content source such as local or medium. */
@@ -253,6 +270,10 @@ export type Post = {
   summary: Scalars['String']['output'];
   /** Thumbnail image path. */
   thumbnail?: Maybe<Scalars['String']['output']>;
+  /** Alt text written for an uploaded thumbnail in the requested locale; null when none was provided. */
+  thumbnailAlt?: Maybe<Scalars['String']['output']>;
+  /** Placeholder to render while an uploaded thumbnail loads; null for external thumbnails. */
+  thumbnailPlaceholder?: Maybe<MediaPlaceholder>;
   /** Display title. */
   title: Scalars['String']['output'];
   /** Topic badges linked to the post. */
@@ -263,6 +284,12 @@ export type Post = {
   url?: Maybe<Scalars['URL']['output']>;
 };
 
+
+/** Blog post summary returned by content queries. */
+export type PostRelatedPostsArgs = {
+  limit?: InputMaybe<Scalars['Int']['input']>;
+};
+
 /** Category badge metadata displayed with a post. */
 export type PostCategory = {
   __typename?: 'PostCategory';
@@ -344,10 +371,25 @@ export type PostResult = {
   locale: Scalars['Locale']['output'];
   /** Resolved post when found. */
   node?: Maybe<Post>;
+  /** Current post identifier when the requested identifier belongs to a renamed post. */
+  redirectTo?: Maybe<Scalars['ID']['output']>;
   /** Operation status such as success, not-found, or failed. */
   status: ContentQueryStatus;
 };
 
+/** Position of a post inside its series with neighbouring parts. */
+export type PostSeriesNavigation = {
+  __typename?: 'PostSeriesNavigation';
+  /** Next part in reading order, if any. */
+  next?: Maybe<Post>;
+  /** One-based position of the post among the publicly visible parts. */
+  position: Scalars['Int']['output'];
+  /** Previous part in reading order, if any. */
+  previous?: Maybe<Post>;
+  /** Series the post belongs to. */
+  series: Series;
+};
+
 /** Pagination and filtering controls for the posts query. */
 export type PostsQueryInput = {
   /** One-based page index. Values below 1 fall back to the default page. */
@@ -369,6 +411,8 @@ export type Query = {
   post: PostResult;
   /** Returns a paginated list of posts for the given locale with engagement data. */
   posts: PostConnection;
+  /** Returns a post series with its publicly visible posts in reading order. */
+  series: SeriesResult;
 };
 
 
@@ -391,6 +435,39 @@ export type QueryPostsArgs = {
   locale: Scalars['Locale']['input'];
 };
 
+
+/** Read-only operations for blog content discovery. */
+export type QuerySeriesArgs = {
+  id: Scalars['ID']['input'];
+  locale: Scalars['Locale']['input'];
+};
+
+/** Ordered collection of posts published as a multi-part article. */
+export type Series = {
+  __typename?: 'Series';
+  /** Optional series introduction. */
+  description?: Maybe<Scalars['String']['output']>;
+  /** Stable series identifier. */
+  id: Scalars['ID']['output'];
+  /** Display name. */
+  name: Scalars['String']['output'];
+  /** Publicly visible posts in reading order. */
+  posts: Array<Post>;
+  /** Number of publicly visible posts in the series. */
+  total: Scalars['Int']['output'];
+};
+
+/** Single series lookup payload. */
+export type SeriesResult = {
+  __typename?: 'SeriesResult';
+  /** Locale used to resolve the response. */
+  locale: Scalars['Locale']['output'];
+  /** Resolved series when found. */
+  node?: Maybe<Series>;
+  /** Operation status such as success, not-found, or failed. */
+  status: ContentQueryStatus;
+};
+
 /** Supported published date sort directions. */
 export enum SortOrder {
   /** Ascending order. */